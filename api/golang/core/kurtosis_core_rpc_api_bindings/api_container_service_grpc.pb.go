@@ -38,6 +38,13 @@ const (
 	ApiContainerService_GetStarlarkRun_FullMethodName                             = "/api_container_api.ApiContainerService/GetStarlarkRun"
 	ApiContainerService_GetStarlarkScriptPlanYaml_FullMethodName                  = "/api_container_api.ApiContainerService/GetStarlarkScriptPlanYaml"
 	ApiContainerService_GetStarlarkPackagePlanYaml_FullMethodName                 = "/api_container_api.ApiContainerService/GetStarlarkPackagePlanYaml"
+	ApiContainerService_GetLastActivityTime_FullMethodName                        = "/api_container_api.ApiContainerService/GetLastActivityTime"
+	ApiContainerService_GetEnclavePlan_FullMethodName                             = "/api_container_api.ApiContainerService/GetEnclavePlan"
+	ApiContainerService_WatchEvents_FullMethodName                                = "/api_container_api.ApiContainerService/WatchEvents"
+	ApiContainerService_GetAuditLog_FullMethodName                                = "/api_container_api.ApiContainerService/GetAuditLog"
+	ApiContainerService_RenameService_FullMethodName                              = "/api_container_api.ApiContainerService/RenameService"
+	ApiContainerService_GetServiceStats_FullMethodName                            = "/api_container_api.ApiContainerService/GetServiceStats"
+	ApiContainerService_RunStarlarkPackageUnitTests_FullMethodName                = "/api_container_api.ApiContainerService/RunStarlarkPackageUnitTests"
 )
 
 // ApiContainerServiceClient is the client API for ApiContainerService service.
@@ -78,6 +85,21 @@ type ApiContainerServiceClient interface {
 	GetStarlarkScriptPlanYaml(ctx context.Context, in *StarlarkScriptPlanYamlArgs, opts ...grpc.CallOption) (*PlanYaml, error)
 	// Gets yaml representing the plan the package will execute in an enclave
 	GetStarlarkPackagePlanYaml(ctx context.Context, in *StarlarkPackagePlanYamlArgs, opts ...grpc.CallOption) (*PlanYaml, error)
+	// Gets when the API container last served a request other than this one, which tells whether the enclave is idle
+	GetLastActivityTime(ctx context.Context, in *emptypb.Empty, opts ...grpc.CallOption) (*GetLastActivityTimeResponse, error)
+	// Gets the instructions that brought the enclave to its current state, as persisted across the Starlark runs
+	GetEnclavePlan(ctx context.Context, in *emptypb.Empty, opts ...grpc.CallOption) (*GetEnclavePlanResponse, error)
+	// Streams the Starlark runs and service changes happening in the enclave, starting from when the stream is opened
+	WatchEvents(ctx context.Context, in *emptypb.Empty, opts ...grpc.CallOption) (ApiContainerService_WatchEventsClient, error)
+	// Gets the changes made through the API container, oldest first
+	GetAuditLog(ctx context.Context, in *emptypb.Empty, opts ...grpc.CallOption) (*GetAuditLogResponse, error)
+	// Gives a running service a new name, keeping its UUID, and updates the enclave plan to match
+	RenameService(ctx context.Context, in *RenameServiceArgs, opts ...grpc.CallOption) (*emptypb.Empty, error)
+	// Samples the resources used by the services of the enclave, once or repeatedly until the stream gets closed
+	GetServiceStats(ctx context.Context, in *GetServiceStatsArgs, opts ...grpc.CallOption) (ApiContainerService_GetServiceStatsClient, error)
+	// Runs the unit tests of a package uploaded with UploadStarlarkPackage against a fake enclave, without starting any
+	// service
+	RunStarlarkPackageUnitTests(ctx context.Context, in *RunStarlarkPackageUnitTestsArgs, opts ...grpc.CallOption) (*RunStarlarkPackageUnitTestsResponse, error)
 }
 
 type apiContainerServiceClient struct {
@@ -369,6 +391,115 @@ func (c *apiContainerServiceClient) GetStarlarkPackagePlanYaml(ctx context.Conte
 	return out, nil
 }
 
+func (c *apiContainerServiceClient) GetLastActivityTime(ctx context.Context, in *emptypb.Empty, opts ...grpc.CallOption) (*GetLastActivityTimeResponse, error) {
+	out := new(GetLastActivityTimeResponse)
+	err := c.cc.Invoke(ctx, ApiContainerService_GetLastActivityTime_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *apiContainerServiceClient) GetEnclavePlan(ctx context.Context, in *emptypb.Empty, opts ...grpc.CallOption) (*GetEnclavePlanResponse, error) {
+	out := new(GetEnclavePlanResponse)
+	err := c.cc.Invoke(ctx, ApiContainerService_GetEnclavePlan_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *apiContainerServiceClient) WatchEvents(ctx context.Context, in *emptypb.Empty, opts ...grpc.CallOption) (ApiContainerService_WatchEventsClient, error) {
+	stream, err := c.cc.NewStream(ctx, &ApiContainerService_ServiceDesc.Streams[5], ApiContainerService_WatchEvents_FullMethodName, opts...)
+	if err != nil {
+		return nil, err
+	}
+	x := &apiContainerServiceWatchEventsClient{stream}
+	if err := x.ClientStream.SendMsg(in); err != nil {
+		return nil, err
+	}
+	if err := x.ClientStream.CloseSend(); err != nil {
+		return nil, err
+	}
+	return x, nil
+}
+
+type ApiContainerService_WatchEventsClient interface {
+	Recv() (*ApiContainerEvent, error)
+	grpc.ClientStream
+}
+
+type apiContainerServiceWatchEventsClient struct {
+	grpc.ClientStream
+}
+
+func (x *apiContainerServiceWatchEventsClient) Recv() (*ApiContainerEvent, error) {
+	m := new(ApiContainerEvent)
+	if err := x.ClientStream.RecvMsg(m); err != nil {
+		return nil, err
+	}
+	return m, nil
+}
+
+func (c *apiContainerServiceClient) GetAuditLog(ctx context.Context, in *emptypb.Empty, opts ...grpc.CallOption) (*GetAuditLogResponse, error) {
+	out := new(GetAuditLogResponse)
+	err := c.cc.Invoke(ctx, ApiContainerService_GetAuditLog_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *apiContainerServiceClient) RenameService(ctx context.Context, in *RenameServiceArgs, opts ...grpc.CallOption) (*emptypb.Empty, error) {
+	out := new(emptypb.Empty)
+	err := c.cc.Invoke(ctx, ApiContainerService_RenameService_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *apiContainerServiceClient) GetServiceStats(ctx context.Context, in *GetServiceStatsArgs, opts ...grpc.CallOption) (ApiContainerService_GetServiceStatsClient, error) {
+	stream, err := c.cc.NewStream(ctx, &ApiContainerService_ServiceDesc.Streams[6], ApiContainerService_GetServiceStats_FullMethodName, opts...)
+	if err != nil {
+		return nil, err
+	}
+	x := &apiContainerServiceGetServiceStatsClient{stream}
+	if err := x.ClientStream.SendMsg(in); err != nil {
+		return nil, err
+	}
+	if err := x.ClientStream.CloseSend(); err != nil {
+		return nil, err
+	}
+	return x, nil
+}
+
+type ApiContainerService_GetServiceStatsClient interface {
+	Recv() (*GetServiceStatsResponse, error)
+	grpc.ClientStream
+}
+
+type apiContainerServiceGetServiceStatsClient struct {
+	grpc.ClientStream
+}
+
+func (x *apiContainerServiceGetServiceStatsClient) Recv() (*GetServiceStatsResponse, error) {
+	m := new(GetServiceStatsResponse)
+	if err := x.ClientStream.RecvMsg(m); err != nil {
+		return nil, err
+	}
+	return m, nil
+}
+
+func (c *apiContainerServiceClient) RunStarlarkPackageUnitTests(ctx context.Context, in *RunStarlarkPackageUnitTestsArgs, opts ...grpc.CallOption) (*RunStarlarkPackageUnitTestsResponse, error) {
+	out := new(RunStarlarkPackageUnitTestsResponse)
+	err := c.cc.Invoke(ctx, ApiContainerService_RunStarlarkPackageUnitTests_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 // ApiContainerServiceServer is the server API for ApiContainerService service.
 // All implementations should embed UnimplementedApiContainerServiceServer
 // for forward compatibility
@@ -407,6 +538,21 @@ type ApiContainerServiceServer interface {
 	GetStarlarkScriptPlanYaml(context.Context, *StarlarkScriptPlanYamlArgs) (*PlanYaml, error)
 	// Gets yaml representing the plan the package will execute in an enclave
 	GetStarlarkPackagePlanYaml(context.Context, *StarlarkPackagePlanYamlArgs) (*PlanYaml, error)
+	// Gets when the API container last served a request other than this one, which tells whether the enclave is idle
+	GetLastActivityTime(context.Context, *emptypb.Empty) (*GetLastActivityTimeResponse, error)
+	// Gets the instructions that brought the enclave to its current state, as persisted across the Starlark runs
+	GetEnclavePlan(context.Context, *emptypb.Empty) (*GetEnclavePlanResponse, error)
+	// Streams the Starlark runs and service changes happening in the enclave, starting from when the stream is opened
+	WatchEvents(*emptypb.Empty, ApiContainerService_WatchEventsServer) error
+	// Gets the changes made through the API container, oldest first
+	GetAuditLog(context.Context, *emptypb.Empty) (*GetAuditLogResponse, error)
+	// Gives a running service a new name, keeping its UUID, and updates the enclave plan to match
+	RenameService(context.Context, *RenameServiceArgs) (*emptypb.Empty, error)
+	// Samples the resources used by the services of the enclave, once or repeatedly until the stream gets closed
+	GetServiceStats(*GetServiceStatsArgs, ApiContainerService_GetServiceStatsServer) error
+	// Runs the unit tests of a package uploaded with UploadStarlarkPackage against a fake enclave, without starting any
+	// service
+	RunStarlarkPackageUnitTests(context.Context, *RunStarlarkPackageUnitTestsArgs) (*RunStarlarkPackageUnitTestsResponse, error)
 }
 
 // UnimplementedApiContainerServiceServer should be embedded to have forward compatible implementations.
@@ -467,6 +613,27 @@ func (UnimplementedApiContainerServiceServer) GetStarlarkScriptPlanYaml(context.
 func (UnimplementedApiContainerServiceServer) GetStarlarkPackagePlanYaml(context.Context, *StarlarkPackagePlanYamlArgs) (*PlanYaml, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method GetStarlarkPackagePlanYaml not implemented")
 }
+func (UnimplementedApiContainerServiceServer) GetLastActivityTime(context.Context, *emptypb.Empty) (*GetLastActivityTimeResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetLastActivityTime not implemented")
+}
+func (UnimplementedApiContainerServiceServer) GetEnclavePlan(context.Context, *emptypb.Empty) (*GetEnclavePlanResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetEnclavePlan not implemented")
+}
+func (UnimplementedApiContainerServiceServer) WatchEvents(*emptypb.Empty, ApiContainerService_WatchEventsServer) error {
+	return status.Errorf(codes.Unimplemented, "method WatchEvents not implemented")
+}
+func (UnimplementedApiContainerServiceServer) GetAuditLog(context.Context, *emptypb.Empty) (*GetAuditLogResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetAuditLog not implemented")
+}
+func (UnimplementedApiContainerServiceServer) RenameService(context.Context, *RenameServiceArgs) (*emptypb.Empty, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method RenameService not implemented")
+}
+func (UnimplementedApiContainerServiceServer) GetServiceStats(*GetServiceStatsArgs, ApiContainerService_GetServiceStatsServer) error {
+	return status.Errorf(codes.Unimplemented, "method GetServiceStats not implemented")
+}
+func (UnimplementedApiContainerServiceServer) RunStarlarkPackageUnitTests(context.Context, *RunStarlarkPackageUnitTestsArgs) (*RunStarlarkPackageUnitTestsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method RunStarlarkPackageUnitTests not implemented")
+}
 
 // UnsafeApiContainerServiceServer may be embedded to opt out of forward compatibility for this service.
 // Use of this interface is not recommended, as added methods to ApiContainerServiceServer will
@@ -828,6 +995,138 @@ func _ApiContainerService_GetStarlarkPackagePlanYaml_Handler(srv interface{}, ct
 	return interceptor(ctx, in, info, handler)
 }
 
+func _ApiContainerService_GetLastActivityTime_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(emptypb.Empty)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ApiContainerServiceServer).GetLastActivityTime(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: ApiContainerService_GetLastActivityTime_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ApiContainerServiceServer).GetLastActivityTime(ctx, req.(*emptypb.Empty))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _ApiContainerService_GetEnclavePlan_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(emptypb.Empty)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ApiContainerServiceServer).GetEnclavePlan(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: ApiContainerService_GetEnclavePlan_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ApiContainerServiceServer).GetEnclavePlan(ctx, req.(*emptypb.Empty))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _ApiContainerService_WatchEvents_Handler(srv interface{}, stream grpc.ServerStream) error {
+	m := new(emptypb.Empty)
+	if err := stream.RecvMsg(m); err != nil {
+		return err
+	}
+	return srv.(ApiContainerServiceServer).WatchEvents(m, &apiContainerServiceWatchEventsServer{stream})
+}
+
+type ApiContainerService_WatchEventsServer interface {
+	Send(*ApiContainerEvent) error
+	grpc.ServerStream
+}
+
+type apiContainerServiceWatchEventsServer struct {
+	grpc.ServerStream
+}
+
+func (x *apiContainerServiceWatchEventsServer) Send(m *ApiContainerEvent) error {
+	return x.ServerStream.SendMsg(m)
+}
+
+func _ApiContainerService_GetAuditLog_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(emptypb.Empty)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ApiContainerServiceServer).GetAuditLog(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: ApiContainerService_GetAuditLog_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ApiContainerServiceServer).GetAuditLog(ctx, req.(*emptypb.Empty))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _ApiContainerService_RenameService_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(RenameServiceArgs)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ApiContainerServiceServer).RenameService(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: ApiContainerService_RenameService_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ApiContainerServiceServer).RenameService(ctx, req.(*RenameServiceArgs))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _ApiContainerService_GetServiceStats_Handler(srv interface{}, stream grpc.ServerStream) error {
+	m := new(GetServiceStatsArgs)
+	if err := stream.RecvMsg(m); err != nil {
+		return err
+	}
+	return srv.(ApiContainerServiceServer).GetServiceStats(m, &apiContainerServiceGetServiceStatsServer{stream})
+}
+
+type ApiContainerService_GetServiceStatsServer interface {
+	Send(*GetServiceStatsResponse) error
+	grpc.ServerStream
+}
+
+type apiContainerServiceGetServiceStatsServer struct {
+	grpc.ServerStream
+}
+
+func (x *apiContainerServiceGetServiceStatsServer) Send(m *GetServiceStatsResponse) error {
+	return x.ServerStream.SendMsg(m)
+}
+
+func _ApiContainerService_RunStarlarkPackageUnitTests_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(RunStarlarkPackageUnitTestsArgs)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ApiContainerServiceServer).RunStarlarkPackageUnitTests(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: ApiContainerService_RunStarlarkPackageUnitTests_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ApiContainerServiceServer).RunStarlarkPackageUnitTests(ctx, req.(*RunStarlarkPackageUnitTestsArgs))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 // ApiContainerService_ServiceDesc is the grpc.ServiceDesc for ApiContainerService service.
 // It's only intended for direct use with grpc.RegisterService,
 // and not to be introspected or modified (even as a copy)
@@ -887,6 +1186,26 @@ var ApiContainerService_ServiceDesc = grpc.ServiceDesc{
 			MethodName: "GetStarlarkPackagePlanYaml",
 			Handler:    _ApiContainerService_GetStarlarkPackagePlanYaml_Handler,
 		},
+		{
+			MethodName: "GetLastActivityTime",
+			Handler:    _ApiContainerService_GetLastActivityTime_Handler,
+		},
+		{
+			MethodName: "GetEnclavePlan",
+			Handler:    _ApiContainerService_GetEnclavePlan_Handler,
+		},
+		{
+			MethodName: "GetAuditLog",
+			Handler:    _ApiContainerService_GetAuditLog_Handler,
+		},
+		{
+			MethodName: "RenameService",
+			Handler:    _ApiContainerService_RenameService_Handler,
+		},
+		{
+			MethodName: "RunStarlarkPackageUnitTests",
+			Handler:    _ApiContainerService_RunStarlarkPackageUnitTests_Handler,
+		},
 	},
 	Streams: []grpc.StreamDesc{
 		{
@@ -914,6 +1233,16 @@ var ApiContainerService_ServiceDesc = grpc.ServiceDesc{
 			Handler:       _ApiContainerService_DownloadFilesArtifact_Handler,
 			ServerStreams: true,
 		},
+		{
+			StreamName:    "WatchEvents",
+			Handler:       _ApiContainerService_WatchEvents_Handler,
+			ServerStreams: true,
+		},
+		{
+			StreamName:    "GetServiceStats",
+			Handler:       _ApiContainerService_GetServiceStats_Handler,
+			ServerStreams: true,
+		},
 	},
 	Metadata: "api_container_service.proto",
 }
@@ -88,6 +88,27 @@ const (
 	// ApiContainerServiceGetStarlarkPackagePlanYamlProcedure is the fully-qualified name of the
 	// ApiContainerService's GetStarlarkPackagePlanYaml RPC.
 	ApiContainerServiceGetStarlarkPackagePlanYamlProcedure = "/api_container_api.ApiContainerService/GetStarlarkPackagePlanYaml"
+	// ApiContainerServiceGetLastActivityTimeProcedure is the fully-qualified name of the
+	// ApiContainerService's GetLastActivityTime RPC.
+	ApiContainerServiceGetLastActivityTimeProcedure = "/api_container_api.ApiContainerService/GetLastActivityTime"
+	// ApiContainerServiceGetEnclavePlanProcedure is the fully-qualified name of the
+	// ApiContainerService's GetEnclavePlan RPC.
+	ApiContainerServiceGetEnclavePlanProcedure = "/api_container_api.ApiContainerService/GetEnclavePlan"
+	// ApiContainerServiceWatchEventsProcedure is the fully-qualified name of the ApiContainerService's
+	// WatchEvents RPC.
+	ApiContainerServiceWatchEventsProcedure = "/api_container_api.ApiContainerService/WatchEvents"
+	// ApiContainerServiceGetAuditLogProcedure is the fully-qualified name of the ApiContainerService's
+	// GetAuditLog RPC.
+	ApiContainerServiceGetAuditLogProcedure = "/api_container_api.ApiContainerService/GetAuditLog"
+	// ApiContainerServiceRenameServiceProcedure is the fully-qualified name of the
+	// ApiContainerService's RenameService RPC.
+	ApiContainerServiceRenameServiceProcedure = "/api_container_api.ApiContainerService/RenameService"
+	// ApiContainerServiceGetServiceStatsProcedure is the fully-qualified name of the
+	// ApiContainerService's GetServiceStats RPC.
+	ApiContainerServiceGetServiceStatsProcedure = "/api_container_api.ApiContainerService/GetServiceStats"
+	// ApiContainerServiceRunStarlarkPackageUnitTestsProcedure is the fully-qualified name of the
+	// ApiContainerService's RunStarlarkPackageUnitTests RPC.
+	ApiContainerServiceRunStarlarkPackageUnitTestsProcedure = "/api_container_api.ApiContainerService/RunStarlarkPackageUnitTests"
 )
 
 // ApiContainerServiceClient is a client for the api_container_api.ApiContainerService service.
@@ -126,6 +147,21 @@ type ApiContainerServiceClient interface {
 	GetStarlarkScriptPlanYaml(context.Context, *connect.Request[kurtosis_core_rpc_api_bindings.StarlarkScriptPlanYamlArgs]) (*connect.Response[kurtosis_core_rpc_api_bindings.PlanYaml], error)
 	// Gets yaml representing the plan the package will execute in an enclave
 	GetStarlarkPackagePlanYaml(context.Context, *connect.Request[kurtosis_core_rpc_api_bindings.StarlarkPackagePlanYamlArgs]) (*connect.Response[kurtosis_core_rpc_api_bindings.PlanYaml], error)
+	// Gets when the API container last served a request other than this one, which tells whether the enclave is idle
+	GetLastActivityTime(context.Context, *connect.Request[emptypb.Empty]) (*connect.Response[kurtosis_core_rpc_api_bindings.GetLastActivityTimeResponse], error)
+	// Gets the instructions that brought the enclave to its current state, as persisted across the Starlark runs
+	GetEnclavePlan(context.Context, *connect.Request[emptypb.Empty]) (*connect.Response[kurtosis_core_rpc_api_bindings.GetEnclavePlanResponse], error)
+	// Streams the Starlark runs and service changes happening in the enclave, starting from when the stream is opened
+	WatchEvents(context.Context, *connect.Request[emptypb.Empty]) (*connect.ServerStreamForClient[kurtosis_core_rpc_api_bindings.ApiContainerEvent], error)
+	// Gets the changes made through the API container, oldest first
+	GetAuditLog(context.Context, *connect.Request[emptypb.Empty]) (*connect.Response[kurtosis_core_rpc_api_bindings.GetAuditLogResponse], error)
+	// Gives a running service a new name, keeping its UUID, and updates the enclave plan to match
+	RenameService(context.Context, *connect.Request[kurtosis_core_rpc_api_bindings.RenameServiceArgs]) (*connect.Response[emptypb.Empty], error)
+	// Samples the resources used by the services of the enclave, once or repeatedly until the stream gets closed
+	GetServiceStats(context.Context, *connect.Request[kurtosis_core_rpc_api_bindings.GetServiceStatsArgs]) (*connect.ServerStreamForClient[kurtosis_core_rpc_api_bindings.GetServiceStatsResponse], error)
+	// Runs the unit tests of a package uploaded with UploadStarlarkPackage against a fake enclave, without starting any
+	// service
+	RunStarlarkPackageUnitTests(context.Context, *connect.Request[kurtosis_core_rpc_api_bindings.RunStarlarkPackageUnitTestsArgs]) (*connect.Response[kurtosis_core_rpc_api_bindings.RunStarlarkPackageUnitTestsResponse], error)
 }
 
 // NewApiContainerServiceClient constructs a client for the api_container_api.ApiContainerService
@@ -228,6 +264,41 @@ func NewApiContainerServiceClient(httpClient connect.HTTPClient, baseURL string,
 			baseURL+ApiContainerServiceGetStarlarkPackagePlanYamlProcedure,
 			opts...,
 		),
+		getLastActivityTime: connect.NewClient[emptypb.Empty, kurtosis_core_rpc_api_bindings.GetLastActivityTimeResponse](
+			httpClient,
+			baseURL+ApiContainerServiceGetLastActivityTimeProcedure,
+			opts...,
+		),
+		getEnclavePlan: connect.NewClient[emptypb.Empty, kurtosis_core_rpc_api_bindings.GetEnclavePlanResponse](
+			httpClient,
+			baseURL+ApiContainerServiceGetEnclavePlanProcedure,
+			opts...,
+		),
+		watchEvents: connect.NewClient[emptypb.Empty, kurtosis_core_rpc_api_bindings.ApiContainerEvent](
+			httpClient,
+			baseURL+ApiContainerServiceWatchEventsProcedure,
+			opts...,
+		),
+		getAuditLog: connect.NewClient[emptypb.Empty, kurtosis_core_rpc_api_bindings.GetAuditLogResponse](
+			httpClient,
+			baseURL+ApiContainerServiceGetAuditLogProcedure,
+			opts...,
+		),
+		renameService: connect.NewClient[kurtosis_core_rpc_api_bindings.RenameServiceArgs, emptypb.Empty](
+			httpClient,
+			baseURL+ApiContainerServiceRenameServiceProcedure,
+			opts...,
+		),
+		getServiceStats: connect.NewClient[kurtosis_core_rpc_api_bindings.GetServiceStatsArgs, kurtosis_core_rpc_api_bindings.GetServiceStatsResponse](
+			httpClient,
+			baseURL+ApiContainerServiceGetServiceStatsProcedure,
+			opts...,
+		),
+		runStarlarkPackageUnitTests: connect.NewClient[kurtosis_core_rpc_api_bindings.RunStarlarkPackageUnitTestsArgs, kurtosis_core_rpc_api_bindings.RunStarlarkPackageUnitTestsResponse](
+			httpClient,
+			baseURL+ApiContainerServiceRunStarlarkPackageUnitTestsProcedure,
+			opts...,
+		),
 	}
 }
 
@@ -251,6 +322,13 @@ type apiContainerServiceClient struct {
 	getStarlarkRun                             *connect.Client[emptypb.Empty, kurtosis_core_rpc_api_bindings.GetStarlarkRunResponse]
 	getStarlarkScriptPlanYaml                  *connect.Client[kurtosis_core_rpc_api_bindings.StarlarkScriptPlanYamlArgs, kurtosis_core_rpc_api_bindings.PlanYaml]
 	getStarlarkPackagePlanYaml                 *connect.Client[kurtosis_core_rpc_api_bindings.StarlarkPackagePlanYamlArgs, kurtosis_core_rpc_api_bindings.PlanYaml]
+	getLastActivityTime                        *connect.Client[emptypb.Empty, kurtosis_core_rpc_api_bindings.GetLastActivityTimeResponse]
+	getEnclavePlan                             *connect.Client[emptypb.Empty, kurtosis_core_rpc_api_bindings.GetEnclavePlanResponse]
+	watchEvents                                *connect.Client[emptypb.Empty, kurtosis_core_rpc_api_bindings.ApiContainerEvent]
+	getAuditLog                                *connect.Client[emptypb.Empty, kurtosis_core_rpc_api_bindings.GetAuditLogResponse]
+	renameService                              *connect.Client[kurtosis_core_rpc_api_bindings.RenameServiceArgs, emptypb.Empty]
+	getServiceStats                            *connect.Client[kurtosis_core_rpc_api_bindings.GetServiceStatsArgs, kurtosis_core_rpc_api_bindings.GetServiceStatsResponse]
+	runStarlarkPackageUnitTests                *connect.Client[kurtosis_core_rpc_api_bindings.RunStarlarkPackageUnitTestsArgs, kurtosis_core_rpc_api_bindings.RunStarlarkPackageUnitTestsResponse]
 }
 
 // RunStarlarkScript calls api_container_api.ApiContainerService.RunStarlarkScript.
@@ -350,6 +428,42 @@ func (c *apiContainerServiceClient) GetStarlarkPackagePlanYaml(ctx context.Conte
 	return c.getStarlarkPackagePlanYaml.CallUnary(ctx, req)
 }
 
+// GetLastActivityTime calls api_container_api.ApiContainerService.GetLastActivityTime.
+func (c *apiContainerServiceClient) GetLastActivityTime(ctx context.Context, req *connect.Request[emptypb.Empty]) (*connect.Response[kurtosis_core_rpc_api_bindings.GetLastActivityTimeResponse], error) {
+	return c.getLastActivityTime.CallUnary(ctx, req)
+}
+
+// GetEnclavePlan calls api_container_api.ApiContainerService.GetEnclavePlan.
+func (c *apiContainerServiceClient) GetEnclavePlan(ctx context.Context, req *connect.Request[emptypb.Empty]) (*connect.Response[kurtosis_core_rpc_api_bindings.GetEnclavePlanResponse], error) {
+	return c.getEnclavePlan.CallUnary(ctx, req)
+}
+
+// WatchEvents calls api_container_api.ApiContainerService.WatchEvents.
+func (c *apiContainerServiceClient) WatchEvents(ctx context.Context, req *connect.Request[emptypb.Empty]) (*connect.ServerStreamForClient[kurtosis_core_rpc_api_bindings.ApiContainerEvent], error) {
+	return c.watchEvents.CallServerStream(ctx, req)
+}
+
+// GetAuditLog calls api_container_api.ApiContainerService.GetAuditLog.
+func (c *apiContainerServiceClient) GetAuditLog(ctx context.Context, req *connect.Request[emptypb.Empty]) (*connect.Response[kurtosis_core_rpc_api_bindings.GetAuditLogResponse], error) {
+	return c.getAuditLog.CallUnary(ctx, req)
+}
+
+// RenameService calls api_container_api.ApiContainerService.RenameService.
+func (c *apiContainerServiceClient) RenameService(ctx context.Context, req *connect.Request[kurtosis_core_rpc_api_bindings.RenameServiceArgs]) (*connect.Response[emptypb.Empty], error) {
+	return c.renameService.CallUnary(ctx, req)
+}
+
+// GetServiceStats calls api_container_api.ApiContainerService.GetServiceStats.
+func (c *apiContainerServiceClient) GetServiceStats(ctx context.Context, req *connect.Request[kurtosis_core_rpc_api_bindings.GetServiceStatsArgs]) (*connect.ServerStreamForClient[kurtosis_core_rpc_api_bindings.GetServiceStatsResponse], error) {
+	return c.getServiceStats.CallServerStream(ctx, req)
+}
+
+// RunStarlarkPackageUnitTests calls
+// api_container_api.ApiContainerService.RunStarlarkPackageUnitTests.
+func (c *apiContainerServiceClient) RunStarlarkPackageUnitTests(ctx context.Context, req *connect.Request[kurtosis_core_rpc_api_bindings.RunStarlarkPackageUnitTestsArgs]) (*connect.Response[kurtosis_core_rpc_api_bindings.RunStarlarkPackageUnitTestsResponse], error) {
+	return c.runStarlarkPackageUnitTests.CallUnary(ctx, req)
+}
+
 // ApiContainerServiceHandler is an implementation of the api_container_api.ApiContainerService
 // service.
 type ApiContainerServiceHandler interface {
@@ -387,6 +501,21 @@ type ApiContainerServiceHandler interface {
 	GetStarlarkScriptPlanYaml(context.Context, *connect.Request[kurtosis_core_rpc_api_bindings.StarlarkScriptPlanYamlArgs]) (*connect.Response[kurtosis_core_rpc_api_bindings.PlanYaml], error)
 	// Gets yaml representing the plan the package will execute in an enclave
 	GetStarlarkPackagePlanYaml(context.Context, *connect.Request[kurtosis_core_rpc_api_bindings.StarlarkPackagePlanYamlArgs]) (*connect.Response[kurtosis_core_rpc_api_bindings.PlanYaml], error)
+	// Gets when the API container last served a request other than this one, which tells whether the enclave is idle
+	GetLastActivityTime(context.Context, *connect.Request[emptypb.Empty]) (*connect.Response[kurtosis_core_rpc_api_bindings.GetLastActivityTimeResponse], error)
+	// Gets the instructions that brought the enclave to its current state, as persisted across the Starlark runs
+	GetEnclavePlan(context.Context, *connect.Request[emptypb.Empty]) (*connect.Response[kurtosis_core_rpc_api_bindings.GetEnclavePlanResponse], error)
+	// Streams the Starlark runs and service changes happening in the enclave, starting from when the stream is opened
+	WatchEvents(context.Context, *connect.Request[emptypb.Empty], *connect.ServerStream[kurtosis_core_rpc_api_bindings.ApiContainerEvent]) error
+	// Gets the changes made through the API container, oldest first
+	GetAuditLog(context.Context, *connect.Request[emptypb.Empty]) (*connect.Response[kurtosis_core_rpc_api_bindings.GetAuditLogResponse], error)
+	// Gives a running service a new name, keeping its UUID, and updates the enclave plan to match
+	RenameService(context.Context, *connect.Request[kurtosis_core_rpc_api_bindings.RenameServiceArgs]) (*connect.Response[emptypb.Empty], error)
+	// Samples the resources used by the services of the enclave, once or repeatedly until the stream gets closed
+	GetServiceStats(context.Context, *connect.Request[kurtosis_core_rpc_api_bindings.GetServiceStatsArgs], *connect.ServerStream[kurtosis_core_rpc_api_bindings.GetServiceStatsResponse]) error
+	// Runs the unit tests of a package uploaded with UploadStarlarkPackage against a fake enclave, without starting any
+	// service
+	RunStarlarkPackageUnitTests(context.Context, *connect.Request[kurtosis_core_rpc_api_bindings.RunStarlarkPackageUnitTestsArgs]) (*connect.Response[kurtosis_core_rpc_api_bindings.RunStarlarkPackageUnitTestsResponse], error)
 }
 
 // NewApiContainerServiceHandler builds an HTTP handler from the service implementation. It returns
@@ -485,6 +614,41 @@ func NewApiContainerServiceHandler(svc ApiContainerServiceHandler, opts ...conne
 		svc.GetStarlarkPackagePlanYaml,
 		opts...,
 	)
+	apiContainerServiceGetLastActivityTimeHandler := connect.NewUnaryHandler(
+		ApiContainerServiceGetLastActivityTimeProcedure,
+		svc.GetLastActivityTime,
+		opts...,
+	)
+	apiContainerServiceGetEnclavePlanHandler := connect.NewUnaryHandler(
+		ApiContainerServiceGetEnclavePlanProcedure,
+		svc.GetEnclavePlan,
+		opts...,
+	)
+	apiContainerServiceWatchEventsHandler := connect.NewServerStreamHandler(
+		ApiContainerServiceWatchEventsProcedure,
+		svc.WatchEvents,
+		opts...,
+	)
+	apiContainerServiceGetAuditLogHandler := connect.NewUnaryHandler(
+		ApiContainerServiceGetAuditLogProcedure,
+		svc.GetAuditLog,
+		opts...,
+	)
+	apiContainerServiceRenameServiceHandler := connect.NewUnaryHandler(
+		ApiContainerServiceRenameServiceProcedure,
+		svc.RenameService,
+		opts...,
+	)
+	apiContainerServiceGetServiceStatsHandler := connect.NewServerStreamHandler(
+		ApiContainerServiceGetServiceStatsProcedure,
+		svc.GetServiceStats,
+		opts...,
+	)
+	apiContainerServiceRunStarlarkPackageUnitTestsHandler := connect.NewUnaryHandler(
+		ApiContainerServiceRunStarlarkPackageUnitTestsProcedure,
+		svc.RunStarlarkPackageUnitTests,
+		opts...,
+	)
 	return "/api_container_api.ApiContainerService/", http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
 		switch r.URL.Path {
 		case ApiContainerServiceRunStarlarkScriptProcedure:
@@ -523,6 +687,20 @@ func NewApiContainerServiceHandler(svc ApiContainerServiceHandler, opts ...conne
 			apiContainerServiceGetStarlarkScriptPlanYamlHandler.ServeHTTP(w, r)
 		case ApiContainerServiceGetStarlarkPackagePlanYamlProcedure:
 			apiContainerServiceGetStarlarkPackagePlanYamlHandler.ServeHTTP(w, r)
+		case ApiContainerServiceGetLastActivityTimeProcedure:
+			apiContainerServiceGetLastActivityTimeHandler.ServeHTTP(w, r)
+		case ApiContainerServiceGetEnclavePlanProcedure:
+			apiContainerServiceGetEnclavePlanHandler.ServeHTTP(w, r)
+		case ApiContainerServiceWatchEventsProcedure:
+			apiContainerServiceWatchEventsHandler.ServeHTTP(w, r)
+		case ApiContainerServiceGetAuditLogProcedure:
+			apiContainerServiceGetAuditLogHandler.ServeHTTP(w, r)
+		case ApiContainerServiceRenameServiceProcedure:
+			apiContainerServiceRenameServiceHandler.ServeHTTP(w, r)
+		case ApiContainerServiceGetServiceStatsProcedure:
+			apiContainerServiceGetServiceStatsHandler.ServeHTTP(w, r)
+		case ApiContainerServiceRunStarlarkPackageUnitTestsProcedure:
+			apiContainerServiceRunStarlarkPackageUnitTestsHandler.ServeHTTP(w, r)
 		default:
 			http.NotFound(w, r)
 		}
@@ -603,3 +781,31 @@ func (UnimplementedApiContainerServiceHandler) GetStarlarkScriptPlanYaml(context
 func (UnimplementedApiContainerServiceHandler) GetStarlarkPackagePlanYaml(context.Context, *connect.Request[kurtosis_core_rpc_api_bindings.StarlarkPackagePlanYamlArgs]) (*connect.Response[kurtosis_core_rpc_api_bindings.PlanYaml], error) {
 	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("api_container_api.ApiContainerService.GetStarlarkPackagePlanYaml is not implemented"))
 }
+
+func (UnimplementedApiContainerServiceHandler) GetLastActivityTime(context.Context, *connect.Request[emptypb.Empty]) (*connect.Response[kurtosis_core_rpc_api_bindings.GetLastActivityTimeResponse], error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("api_container_api.ApiContainerService.GetLastActivityTime is not implemented"))
+}
+
+func (UnimplementedApiContainerServiceHandler) GetEnclavePlan(context.Context, *connect.Request[emptypb.Empty]) (*connect.Response[kurtosis_core_rpc_api_bindings.GetEnclavePlanResponse], error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("api_container_api.ApiContainerService.GetEnclavePlan is not implemented"))
+}
+
+func (UnimplementedApiContainerServiceHandler) WatchEvents(context.Context, *connect.Request[emptypb.Empty], *connect.ServerStream[kurtosis_core_rpc_api_bindings.ApiContainerEvent]) error {
+	return connect.NewError(connect.CodeUnimplemented, errors.New("api_container_api.ApiContainerService.WatchEvents is not implemented"))
+}
+
+func (UnimplementedApiContainerServiceHandler) GetAuditLog(context.Context, *connect.Request[emptypb.Empty]) (*connect.Response[kurtosis_core_rpc_api_bindings.GetAuditLogResponse], error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("api_container_api.ApiContainerService.GetAuditLog is not implemented"))
+}
+
+func (UnimplementedApiContainerServiceHandler) RenameService(context.Context, *connect.Request[kurtosis_core_rpc_api_bindings.RenameServiceArgs]) (*connect.Response[emptypb.Empty], error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("api_container_api.ApiContainerService.RenameService is not implemented"))
+}
+
+func (UnimplementedApiContainerServiceHandler) GetServiceStats(context.Context, *connect.Request[kurtosis_core_rpc_api_bindings.GetServiceStatsArgs], *connect.ServerStream[kurtosis_core_rpc_api_bindings.GetServiceStatsResponse]) error {
+	return connect.NewError(connect.CodeUnimplemented, errors.New("api_container_api.ApiContainerService.GetServiceStats is not implemented"))
+}
+
+func (UnimplementedApiContainerServiceHandler) RunStarlarkPackageUnitTests(context.Context, *connect.Request[kurtosis_core_rpc_api_bindings.RunStarlarkPackageUnitTestsArgs]) (*connect.Response[kurtosis_core_rpc_api_bindings.RunStarlarkPackageUnitTestsResponse], error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("api_container_api.ApiContainerService.RunStarlarkPackageUnitTests is not implemented"))
+}
@@ -49,6 +49,7 @@ func NewRunStarlarkScriptArgs(
 	imageDownloadMode kurtosis_core_rpc_api_bindings.ImageDownloadMode,
 	nonBlockingMode bool,
 	parallel bool,
+	secrets map[string]string,
 ) *kurtosis_core_rpc_api_bindings.RunStarlarkScriptArgs {
 	cloudInstanceIdCopy := new(string)
 	*cloudInstanceIdCopy = cloudInstanceId
@@ -72,6 +73,7 @@ func NewRunStarlarkScriptArgs(
 		ImageDownloadMode:    imageDownloadModeCopy,
 		NonBlockingMode:      &nonBlockingMode,
 		Parallel:             parallelCopy,
+		Secrets:              secrets,
 	}
 }
 
@@ -89,6 +91,7 @@ func NewRunStarlarkPackageArgs(
 	nonBlockingMode bool,
 	parallel bool,
 	githubAuthToken string,
+	secrets map[string]string,
 ) *kurtosis_core_rpc_api_bindings.RunStarlarkPackageArgs {
 	parallelismCopy := new(int32)
 	*parallelismCopy = parallelism
@@ -119,6 +122,7 @@ func NewRunStarlarkPackageArgs(
 		NonBlockingMode:        &nonBlockingMode,
 		GithubAuthToken:        githubAuthTokenCopy,
 		Parallel:               parallelCopy,
+		Secrets:                secrets,
 	}
 }
 
@@ -136,6 +140,7 @@ func NewRunStarlarkRemotePackageArgs(
 	nonBlockingMode bool,
 	parallel bool,
 	githubAuthToken string,
+	secrets map[string]string,
 ) *kurtosis_core_rpc_api_bindings.RunStarlarkPackageArgs {
 	parallelismCopy := new(int32)
 	*parallelismCopy = parallelism
@@ -166,6 +171,7 @@ func NewRunStarlarkRemotePackageArgs(
 		NonBlockingMode:        &nonBlockingMode,
 		Parallel:               &parallel,
 		GithubAuthToken:        githubAuthTokenCopy,
+		Secrets:                secrets,
 	}
 }
 
@@ -461,6 +467,7 @@ func NewServiceInfo(
 	labels map[string]string,
 	tiniEnabled bool,
 	ttyEnabled bool,
+	serviceDirPathsToPersistentKeys map[string]string,
 
 ) *kurtosis_core_rpc_api_bindings.ServiceInfo {
 	return &kurtosis_core_rpc_api_bindings.ServiceInfo{
@@ -484,6 +491,7 @@ func NewServiceInfo(
 		Labels:                              labels,
 		TiniEnabled:                         &tiniEnabled,
 		TtyEnabled:                          &ttyEnabled,
+		ServiceDirPathsToPersistentKeys:     serviceDirPathsToPersistentKeys,
 	}
 }
 
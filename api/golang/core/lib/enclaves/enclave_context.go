@@ -24,6 +24,7 @@ import (
 	"os"
 	"path"
 	"strings"
+	"time"
 
 	path_compression "github.com/kurtosis-tech/kurtosis/path-compression"
 
@@ -36,6 +37,7 @@ import (
 	"github.com/kurtosis-tech/stacktrace"
 	"github.com/sirupsen/logrus"
 	"google.golang.org/grpc"
+	"google.golang.org/protobuf/types/known/durationpb"
 	"google.golang.org/protobuf/types/known/emptypb"
 )
 
@@ -118,7 +120,8 @@ func (enclaveCtx *EnclaveContext) RunStarlarkScript(
 		runConfig.CloudUserId,
 		runConfig.ImageDownload,
 		runConfig.NonBlockingMode,
-		runConfig.Parallel)
+		runConfig.Parallel,
+		runConfig.Secrets)
 	starlarkResponseLineChan := make(chan *kurtosis_core_rpc_api_bindings.StarlarkRunResponseLine)
 
 	stream, err := enclaveCtx.client.RunStarlarkScript(ctxWithCancel, executeStartosisScriptArgs)
@@ -181,7 +184,8 @@ func (enclaveCtx *EnclaveContext) RunStarlarkPackage(
 		runConfig.ImageDownload,
 		runConfig.NonBlockingMode,
 		runConfig.GitHubAuthToken,
-		runConfig.Parallel)
+		runConfig.Parallel,
+		runConfig.Secrets)
 	if err != nil {
 		return nil, nil, stacktrace.Propagate(err, "Error preparing package '%s' for execution", packageRootPath)
 	}
@@ -304,7 +308,7 @@ func (enclaveCtx *EnclaveContext) RunStarlarkRemotePackage(
 	}()
 
 	starlarkResponseLineChan := make(chan *kurtosis_core_rpc_api_bindings.StarlarkRunResponseLine)
-	executeStartosisScriptArgs := binding_constructors.NewRunStarlarkRemotePackageArgs(packageId, runConfig.RelativePathToMainFile, runConfig.MainFunctionName, serializedParams, runConfig.DryRun, runConfig.Parallelism, runConfig.ExperimentalFeatureFlags, runConfig.CloudInstanceId, runConfig.CloudUserId, runConfig.ImageDownload, runConfig.NonBlockingMode, runConfig.Parallel, runConfig.GitHubAuthToken)
+	executeStartosisScriptArgs := binding_constructors.NewRunStarlarkRemotePackageArgs(packageId, runConfig.RelativePathToMainFile, runConfig.MainFunctionName, serializedParams, runConfig.DryRun, runConfig.Parallelism, runConfig.ExperimentalFeatureFlags, runConfig.CloudInstanceId, runConfig.CloudUserId, runConfig.ImageDownload, runConfig.NonBlockingMode, runConfig.Parallel, runConfig.GitHubAuthToken, runConfig.Secrets)
 
 	stream, err := enclaveCtx.client.RunStarlarkPackage(ctxWithCancel, executeStartosisScriptArgs)
 	if err != nil {
@@ -507,6 +511,62 @@ func (enclaveCtx *EnclaveContext) ConnectServices(ctx context.Context, connect k
 	return nil
 }
 
+// RenameService gives a running service a new name, keeping its UUID. The service gets restarted so other services
+// can reach it under its new name, though the ones that were passed its old hostname keep using it until updated
+func (enclaveCtx *EnclaveContext) RenameService(ctx context.Context, serviceIdentifier string, newServiceName string) error {
+	args := &kurtosis_core_rpc_api_bindings.RenameServiceArgs{
+		ServiceIdentifier: serviceIdentifier,
+		NewName:           newServiceName,
+	}
+	if _, err := enclaveCtx.client.RenameService(ctx, args); err != nil {
+		return stacktrace.Propagate(err, "An error occurred renaming service '%v' to '%v'", serviceIdentifier, newServiceName)
+	}
+	return nil
+}
+
+// GetServiceStats samples the resources used by the given services, or by all the running services if none are given.
+// The stream returns a single sample unless following, in which case it keeps sampling every interval (or at the API
+// container's default interval if zero) until the context gets cancelled
+func (enclaveCtx *EnclaveContext) GetServiceStats(
+	ctx context.Context,
+	serviceIdentifiers []string,
+	shouldFollow bool,
+	interval time.Duration,
+) (kurtosis_core_rpc_api_bindings.ApiContainerService_GetServiceStatsClient, error) {
+	args := &kurtosis_core_rpc_api_bindings.GetServiceStatsArgs{
+		ServiceIdentifiers: serviceIdentifiers,
+		Follow:             shouldFollow,
+		Interval:           nil,
+	}
+	if interval > 0 {
+		args.Interval = durationpb.New(interval)
+	}
+	stream, err := enclaveCtx.client.GetServiceStats(ctx, args)
+	if err != nil {
+		return nil, stacktrace.Propagate(err, "An error occurred getting the stats of services '%v'", serviceIdentifiers)
+	}
+	return stream, nil
+}
+
+// RunStarlarkPackageUnitTests uploads the package and runs its unit tests in the API container, against a fake enclave
+// of their own; no service gets started and the services of this enclave are left as they are
+func (enclaveCtx *EnclaveContext) RunStarlarkPackageUnitTests(ctx context.Context, packageRootPath string) ([]*kurtosis_core_rpc_api_bindings.StarlarkUnitTestResult, error) {
+	packageName, _, err := getPackageNameAndReplaceOptions(packageRootPath)
+	if err != nil {
+		return nil, err
+	}
+	if err := enclaveCtx.uploadStarlarkPackage(packageName, packageRootPath); err != nil {
+		return nil, stacktrace.Propagate(err, "Error uploading package '%s' prior to running its unit tests", packageRootPath)
+	}
+	response, err := enclaveCtx.client.RunStarlarkPackageUnitTests(ctx, &kurtosis_core_rpc_api_bindings.RunStarlarkPackageUnitTestsArgs{
+		PackageId: packageName,
+	})
+	if err != nil {
+		return nil, stacktrace.Propagate(err, "An error occurred running the unit tests of package '%s'", packageName)
+	}
+	return response.GetResults(), nil
+}
+
 func (enclaveCtx *EnclaveContext) GetStarlarkRun(ctx context.Context) (*kurtosis_core_rpc_api_bindings.GetStarlarkRunResponse, error) {
 	response, err := enclaveCtx.client.GetStarlarkRun(ctx, &emptypb.Empty{})
 	if err != nil {
@@ -673,6 +733,7 @@ func (enclaveCtx *EnclaveContext) assembleRunStartosisPackageArg(
 	nonBlockingMode bool,
 	githubAuthToken string,
 	parallel bool,
+	secrets map[string]string,
 ) (*kurtosis_core_rpc_api_bindings.RunStarlarkPackageArgs, error) {
 
 	return binding_constructors.NewRunStarlarkPackageArgs(
@@ -688,7 +749,8 @@ func (enclaveCtx *EnclaveContext) assembleRunStartosisPackageArg(
 		imageDownloadMode,
 		nonBlockingMode,
 		parallel,
-		githubAuthToken), nil
+		githubAuthToken,
+		secrets), nil
 }
 
 func (enclaveCtx *EnclaveContext) uploadStarlarkPackage(packageId string, packageRootPath string) error {
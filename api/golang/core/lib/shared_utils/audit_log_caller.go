@@ -0,0 +1,78 @@
+package shared_utils
+
+import (
+	"crypto/hmac"
+	"crypto/sha256"
+	"encoding/base64"
+	"encoding/hex"
+	"encoding/json"
+	"strings"
+	"time"
+
+	"github.com/kurtosis-tech/stacktrace"
+)
+
+// AuditLogCallerMetadataKey is the gRPC metadata through which a caller of the API container tells who it's calling on
+// behalf of, like the engine forwarding a request made with an API token. It ends up in the audit log of the enclave
+const AuditLogCallerMetadataKey = "kurtosis-audit-caller"
+
+// AuditLogEngineCallerKeyMetadataKey is the gRPC metadata through which the engine proves to the API container that it's
+// the one forwarding the caller. The callers sent without it are recorded as unverified
+const AuditLogEngineCallerKeyMetadataKey = "kurtosis-audit-engine-caller-key"
+
+// AuditLogCallerCredentialMetadataKey is the gRPC metadata through which the callers calling the API container directly,
+// rather than through the engine, send the credential the engine issued to them
+const AuditLogCallerCredentialMetadataKey = "kurtosis-audit-caller-credential"
+
+const (
+	auditLogCallerCredentialSeparator = "."
+)
+
+type auditLogCallerCredentialPayload struct {
+	Caller         string    `json:"caller"`
+	ExpirationTime time.Time `json:"expirationTime"`
+}
+
+// NewAuditLogCallerCredential returns a credential vouching for the caller until the expiration time, signed with the
+// key the engine launched the API container of the enclave with
+func NewAuditLogCallerCredential(enclaveCallerKey string, caller string, expirationTime time.Time) (string, error) {
+	payloadBytes, err := json.Marshal(&auditLogCallerCredentialPayload{
+		Caller:         caller,
+		ExpirationTime: expirationTime,
+	})
+	if err != nil {
+		return "", stacktrace.Propagate(err, "An error occurred serializing the credential of caller '%v'", caller)
+	}
+	payload := base64.RawURLEncoding.EncodeToString(payloadBytes)
+	return payload + auditLogCallerCredentialSeparator + signAuditLogCallerCredentialPayload(enclaveCallerKey, payload), nil
+}
+
+// VerifyAuditLogCallerCredential returns the caller the credential vouches for, or an error if it isn't signed with the
+// key or has expired
+func VerifyAuditLogCallerCredential(enclaveCallerKey string, credential string, now time.Time) (string, error) {
+	payload, signature, found := strings.Cut(credential, auditLogCallerCredentialSeparator)
+	if !found {
+		return "", stacktrace.NewError("The caller credential is malformed")
+	}
+	if !hmac.Equal([]byte(signature), []byte(signAuditLogCallerCredentialPayload(enclaveCallerKey, payload))) {
+		return "", stacktrace.NewError("The caller credential isn't signed by the engine")
+	}
+	payloadBytes, err := base64.RawURLEncoding.DecodeString(payload)
+	if err != nil {
+		return "", stacktrace.Propagate(err, "An error occurred decoding the caller credential")
+	}
+	decodedPayload := &auditLogCallerCredentialPayload{} // nolint: exhaustruct
+	if err := json.Unmarshal(payloadBytes, decodedPayload); err != nil {
+		return "", stacktrace.Propagate(err, "An error occurred deserializing the caller credential")
+	}
+	if !now.Before(decodedPayload.ExpirationTime) {
+		return "", stacktrace.NewError("The credential of caller '%v' expired at '%v'", decodedPayload.Caller, decodedPayload.ExpirationTime)
+	}
+	return decodedPayload.Caller, nil
+}
+
+func signAuditLogCallerCredentialPayload(enclaveCallerKey string, payload string) string {
+	mac := hmac.New(sha256.New, []byte(enclaveCallerKey))
+	mac.Write([]byte(payload))
+	return hex.EncodeToString(mac.Sum(nil))
+}
@@ -0,0 +1,53 @@
+package shared_utils
+
+import (
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+)
+
+const (
+	testEnclaveCallerKey      = "enclave-caller-key"
+	testOtherEnclaveCallerKey = "other-enclave-caller-key"
+	testCaller                = "API token 'ci' (0123456789abcdef)"
+)
+
+func TestAuditLogCallerCredential_VerifiesUntilExpiration(t *testing.T) {
+	now := time.Now()
+	credential, err := NewAuditLogCallerCredential(testEnclaveCallerKey, testCaller, now.Add(time.Hour))
+	require.NoError(t, err)
+
+	caller, err := VerifyAuditLogCallerCredential(testEnclaveCallerKey, credential, now)
+	require.NoError(t, err)
+	require.Equal(t, testCaller, caller)
+
+	_, err = VerifyAuditLogCallerCredential(testEnclaveCallerKey, credential, now.Add(time.Hour))
+	require.Error(t, err)
+}
+
+func TestAuditLogCallerCredential_RejectsCredentialsOfOtherEnclaves(t *testing.T) {
+	now := time.Now()
+	credential, err := NewAuditLogCallerCredential(testOtherEnclaveCallerKey, testCaller, now.Add(time.Hour))
+	require.NoError(t, err)
+
+	_, err = VerifyAuditLogCallerCredential(testEnclaveCallerKey, credential, now)
+	require.Error(t, err)
+}
+
+func TestAuditLogCallerCredential_RejectsTamperedCaller(t *testing.T) {
+	now := time.Now()
+	credential, err := NewAuditLogCallerCredential(testEnclaveCallerKey, testCaller, now.Add(time.Hour))
+	require.NoError(t, err)
+	forgedCredential, err := NewAuditLogCallerCredential(testOtherEnclaveCallerKey, "API token 'admin' (fedcba9876543210)", now.Add(time.Hour))
+	require.NoError(t, err)
+
+	forgedPayload, _, _ := strings.Cut(forgedCredential, auditLogCallerCredentialSeparator)
+	_, genuineSignature, _ := strings.Cut(credential, auditLogCallerCredentialSeparator)
+	_, err = VerifyAuditLogCallerCredential(testEnclaveCallerKey, forgedPayload+auditLogCallerCredentialSeparator+genuineSignature, now)
+	require.Error(t, err)
+
+	_, err = VerifyAuditLogCallerCredential(testEnclaveCallerKey, "malformed", now)
+	require.Error(t, err)
+}
@@ -19,6 +19,7 @@ const (
 )
 
 var defaultExperimentalFeatureFlags = []kurtosis_core_rpc_api_bindings.KurtosisFeatureFlag(nil)
+var defaultSecrets = map[string]string(nil)
 
 type StarlarkRunConfig struct {
 	RelativePathToMainFile   string
@@ -33,6 +34,7 @@ type StarlarkRunConfig struct {
 	NonBlockingMode          bool
 	GitHubAuthToken          string
 	Parallel                 bool
+	Secrets                  map[string]string
 }
 
 type starlarkRunConfigOption func(*StarlarkRunConfig)
@@ -51,6 +53,7 @@ func NewRunStarlarkConfig(opts ...starlarkRunConfigOption) *StarlarkRunConfig {
 		NonBlockingMode:          defaultNonBlockingMode,
 		GitHubAuthToken:          defaultGitHubAuthToken,
 		Parallel:                 defaultParallel,
+		Secrets:                  defaultSecrets,
 	}
 
 	for _, opt := range opts {
@@ -133,3 +136,11 @@ func WithParallel(parallel bool) starlarkRunConfigOption {
 		config.Parallel = parallel
 	}
 }
+
+// WithSecrets passes secret values to the run. Starlark code references them with Secret(name); the values are never
+// persisted in the enclave and are masked in the run output
+func WithSecrets(secrets map[string]string) starlarkRunConfigOption {
+	return func(config *StarlarkRunConfig) {
+		config.Secrets = secrets
+	}
+}
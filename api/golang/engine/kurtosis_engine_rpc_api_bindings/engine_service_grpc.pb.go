@@ -27,8 +27,19 @@ const (
 	EngineService_GetExistingAndHistoricalEnclaveIdentifiers_FullMethodName = "/engine_api.EngineService/GetExistingAndHistoricalEnclaveIdentifiers"
 	EngineService_StopEnclave_FullMethodName                                = "/engine_api.EngineService/StopEnclave"
 	EngineService_DestroyEnclave_FullMethodName                             = "/engine_api.EngineService/DestroyEnclave"
+	EngineService_ExtendEnclaveTtl_FullMethodName                           = "/engine_api.EngineService/ExtendEnclaveTtl"
+	EngineService_UpdateEnclaveLabels_FullMethodName                        = "/engine_api.EngineService/UpdateEnclaveLabels"
+	EngineService_RenameEnclave_FullMethodName                              = "/engine_api.EngineService/RenameEnclave"
+	EngineService_CloneEnclave_FullMethodName                               = "/engine_api.EngineService/CloneEnclave"
+	EngineService_ExportEnclave_FullMethodName                              = "/engine_api.EngineService/ExportEnclave"
+	EngineService_ImportEnclave_FullMethodName                              = "/engine_api.EngineService/ImportEnclave"
 	EngineService_Clean_FullMethodName                                      = "/engine_api.EngineService/Clean"
 	EngineService_GetServiceLogs_FullMethodName                             = "/engine_api.EngineService/GetServiceLogs"
+	EngineService_WatchEvents_FullMethodName                                = "/engine_api.EngineService/WatchEvents"
+	EngineService_CreateApiToken_FullMethodName                             = "/engine_api.EngineService/CreateApiToken"
+	EngineService_RevokeApiToken_FullMethodName                             = "/engine_api.EngineService/RevokeApiToken"
+	EngineService_GetAuditLog_FullMethodName                                = "/engine_api.EngineService/GetAuditLog"
+	EngineService_GetEnclaveCallerCredential_FullMethodName                 = "/engine_api.EngineService/GetEnclaveCallerCredential"
 )
 
 // EngineServiceClient is the client API for EngineService service.
@@ -44,8 +55,8 @@ type EngineServiceClient interface {
 	// ==============================================================================================
 	// Creates a new Kurtosis Enclave
 	CreateEnclave(ctx context.Context, in *CreateEnclaveArgs, opts ...grpc.CallOption) (*CreateEnclaveResponse, error)
-	// Returns information about the requested enclaves or all enclaves if none specified.
-	GetEnclaves(ctx context.Context, in *emptypb.Empty, opts ...grpc.CallOption) (*GetEnclavesResponse, error)
+	// Returns information about the enclaves matching the label selector, or all enclaves if none specified.
+	GetEnclaves(ctx context.Context, in *GetEnclavesArgs, opts ...grpc.CallOption) (*GetEnclavesResponse, error)
 	// Returns information about the requested enclaves or all enclaves if none specified.
 	GetEnclavesByUuids(ctx context.Context, in *GetEnclavesByUuidsArgs, opts ...grpc.CallOption) (*GetEnclavesResponse, error)
 	// Returns information about all existing & historical enclaves
@@ -54,10 +65,32 @@ type EngineServiceClient interface {
 	StopEnclave(ctx context.Context, in *StopEnclaveArgs, opts ...grpc.CallOption) (*emptypb.Empty, error)
 	// Destroys an enclave, removing all artifacts associated with it
 	DestroyEnclave(ctx context.Context, in *DestroyEnclaveArgs, opts ...grpc.CallOption) (*emptypb.Empty, error)
+	// Makes an enclave expire after the given time-to-live, counting from now
+	ExtendEnclaveTtl(ctx context.Context, in *ExtendEnclaveTtlArgs, opts ...grpc.CallOption) (*emptypb.Empty, error)
+	// Sets and removes labels of an enclave
+	UpdateEnclaveLabels(ctx context.Context, in *UpdateEnclaveLabelsArgs, opts ...grpc.CallOption) (*emptypb.Empty, error)
+	// Changes the name of an existing enclave, keeping its UUID
+	RenameEnclave(ctx context.Context, in *RenameEnclaveArgs, opts ...grpc.CallOption) (*emptypb.Empty, error)
+	// Creates a new enclave with the files artifacts, persistent directories and services of an existing one
+	CloneEnclave(ctx context.Context, in *CloneEnclaveArgs, opts ...grpc.CallOption) (*CloneEnclaveResponse, error)
+	// Streams an archive of an enclave, with its plan, files artifacts, persistent directories and mode, that ImportEnclave recreates it from
+	ExportEnclave(ctx context.Context, in *ExportEnclaveArgs, opts ...grpc.CallOption) (EngineService_ExportEnclaveClient, error)
+	// Creates an enclave from an archive streamed by ExportEnclave, possibly on another engine
+	ImportEnclave(ctx context.Context, opts ...grpc.CallOption) (EngineService_ImportEnclaveClient, error)
 	// Gets rid of old enclaves
 	Clean(ctx context.Context, in *CleanArgs, opts ...grpc.CallOption) (*CleanResponse, error)
 	// Get service logs
 	GetServiceLogs(ctx context.Context, in *GetServiceLogsArgs, opts ...grpc.CallOption) (EngineService_GetServiceLogsClient, error)
+	// Streams what happens to the enclaves of the engine, starting from when the stream is opened
+	WatchEvents(ctx context.Context, in *WatchEventsArgs, opts ...grpc.CallOption) (EngineService_WatchEventsClient, error)
+	// Creates an API token, only available when the engine enforces API authentication
+	CreateApiToken(ctx context.Context, in *CreateApiTokenArgs, opts ...grpc.CallOption) (*CreateApiTokenResponse, error)
+	// Revokes an API token so that it can't be used anymore
+	RevokeApiToken(ctx context.Context, in *RevokeApiTokenArgs, opts ...grpc.CallOption) (*emptypb.Empty, error)
+	// Gets the changes made through the engine and the API containers of its running enclaves, newest first
+	GetAuditLog(ctx context.Context, in *GetAuditLogArgs, opts ...grpc.CallOption) (*GetAuditLogResponse, error)
+	// Gets a credential proving who the caller is to the API container of an enclave, so that the calls it makes to the API container directly are attributed to it in the audit log
+	GetEnclaveCallerCredential(ctx context.Context, in *GetEnclaveCallerCredentialArgs, opts ...grpc.CallOption) (*GetEnclaveCallerCredentialResponse, error)
 }
 
 type engineServiceClient struct {
@@ -86,7 +119,7 @@ func (c *engineServiceClient) CreateEnclave(ctx context.Context, in *CreateEncla
 	return out, nil
 }
 
-func (c *engineServiceClient) GetEnclaves(ctx context.Context, in *emptypb.Empty, opts ...grpc.CallOption) (*GetEnclavesResponse, error) {
+func (c *engineServiceClient) GetEnclaves(ctx context.Context, in *GetEnclavesArgs, opts ...grpc.CallOption) (*GetEnclavesResponse, error) {
 	out := new(GetEnclavesResponse)
 	err := c.cc.Invoke(ctx, EngineService_GetEnclaves_FullMethodName, in, out, opts...)
 	if err != nil {
@@ -131,6 +164,108 @@ func (c *engineServiceClient) DestroyEnclave(ctx context.Context, in *DestroyEnc
 	return out, nil
 }
 
+func (c *engineServiceClient) ExtendEnclaveTtl(ctx context.Context, in *ExtendEnclaveTtlArgs, opts ...grpc.CallOption) (*emptypb.Empty, error) {
+	out := new(emptypb.Empty)
+	err := c.cc.Invoke(ctx, EngineService_ExtendEnclaveTtl_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *engineServiceClient) UpdateEnclaveLabels(ctx context.Context, in *UpdateEnclaveLabelsArgs, opts ...grpc.CallOption) (*emptypb.Empty, error) {
+	out := new(emptypb.Empty)
+	err := c.cc.Invoke(ctx, EngineService_UpdateEnclaveLabels_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *engineServiceClient) RenameEnclave(ctx context.Context, in *RenameEnclaveArgs, opts ...grpc.CallOption) (*emptypb.Empty, error) {
+	out := new(emptypb.Empty)
+	err := c.cc.Invoke(ctx, EngineService_RenameEnclave_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *engineServiceClient) CloneEnclave(ctx context.Context, in *CloneEnclaveArgs, opts ...grpc.CallOption) (*CloneEnclaveResponse, error) {
+	out := new(CloneEnclaveResponse)
+	err := c.cc.Invoke(ctx, EngineService_CloneEnclave_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *engineServiceClient) ExportEnclave(ctx context.Context, in *ExportEnclaveArgs, opts ...grpc.CallOption) (EngineService_ExportEnclaveClient, error) {
+	stream, err := c.cc.NewStream(ctx, &EngineService_ServiceDesc.Streams[0], EngineService_ExportEnclave_FullMethodName, opts...)
+	if err != nil {
+		return nil, err
+	}
+	x := &engineServiceExportEnclaveClient{stream}
+	if err := x.ClientStream.SendMsg(in); err != nil {
+		return nil, err
+	}
+	if err := x.ClientStream.CloseSend(); err != nil {
+		return nil, err
+	}
+	return x, nil
+}
+
+type EngineService_ExportEnclaveClient interface {
+	Recv() (*EnclaveArchiveChunk, error)
+	grpc.ClientStream
+}
+
+type engineServiceExportEnclaveClient struct {
+	grpc.ClientStream
+}
+
+func (x *engineServiceExportEnclaveClient) Recv() (*EnclaveArchiveChunk, error) {
+	m := new(EnclaveArchiveChunk)
+	if err := x.ClientStream.RecvMsg(m); err != nil {
+		return nil, err
+	}
+	return m, nil
+}
+
+func (c *engineServiceClient) ImportEnclave(ctx context.Context, opts ...grpc.CallOption) (EngineService_ImportEnclaveClient, error) {
+	stream, err := c.cc.NewStream(ctx, &EngineService_ServiceDesc.Streams[1], EngineService_ImportEnclave_FullMethodName, opts...)
+	if err != nil {
+		return nil, err
+	}
+	x := &engineServiceImportEnclaveClient{stream}
+	return x, nil
+}
+
+type EngineService_ImportEnclaveClient interface {
+	Send(*ImportEnclaveArgs) error
+	CloseAndRecv() (*ImportEnclaveResponse, error)
+	grpc.ClientStream
+}
+
+type engineServiceImportEnclaveClient struct {
+	grpc.ClientStream
+}
+
+func (x *engineServiceImportEnclaveClient) Send(m *ImportEnclaveArgs) error {
+	return x.ClientStream.SendMsg(m)
+}
+
+func (x *engineServiceImportEnclaveClient) CloseAndRecv() (*ImportEnclaveResponse, error) {
+	if err := x.ClientStream.CloseSend(); err != nil {
+		return nil, err
+	}
+	m := new(ImportEnclaveResponse)
+	if err := x.ClientStream.RecvMsg(m); err != nil {
+		return nil, err
+	}
+	return m, nil
+}
+
 func (c *engineServiceClient) Clean(ctx context.Context, in *CleanArgs, opts ...grpc.CallOption) (*CleanResponse, error) {
 	out := new(CleanResponse)
 	err := c.cc.Invoke(ctx, EngineService_Clean_FullMethodName, in, out, opts...)
@@ -141,7 +276,7 @@ func (c *engineServiceClient) Clean(ctx context.Context, in *CleanArgs, opts ...
 }
 
 func (c *engineServiceClient) GetServiceLogs(ctx context.Context, in *GetServiceLogsArgs, opts ...grpc.CallOption) (EngineService_GetServiceLogsClient, error) {
-	stream, err := c.cc.NewStream(ctx, &EngineService_ServiceDesc.Streams[0], EngineService_GetServiceLogs_FullMethodName, opts...)
+	stream, err := c.cc.NewStream(ctx, &EngineService_ServiceDesc.Streams[2], EngineService_GetServiceLogs_FullMethodName, opts...)
 	if err != nil {
 		return nil, err
 	}
@@ -172,6 +307,74 @@ func (x *engineServiceGetServiceLogsClient) Recv() (*GetServiceLogsResponse, err
 	return m, nil
 }
 
+func (c *engineServiceClient) WatchEvents(ctx context.Context, in *WatchEventsArgs, opts ...grpc.CallOption) (EngineService_WatchEventsClient, error) {
+	stream, err := c.cc.NewStream(ctx, &EngineService_ServiceDesc.Streams[3], EngineService_WatchEvents_FullMethodName, opts...)
+	if err != nil {
+		return nil, err
+	}
+	x := &engineServiceWatchEventsClient{stream}
+	if err := x.ClientStream.SendMsg(in); err != nil {
+		return nil, err
+	}
+	if err := x.ClientStream.CloseSend(); err != nil {
+		return nil, err
+	}
+	return x, nil
+}
+
+type EngineService_WatchEventsClient interface {
+	Recv() (*EngineEvent, error)
+	grpc.ClientStream
+}
+
+type engineServiceWatchEventsClient struct {
+	grpc.ClientStream
+}
+
+func (x *engineServiceWatchEventsClient) Recv() (*EngineEvent, error) {
+	m := new(EngineEvent)
+	if err := x.ClientStream.RecvMsg(m); err != nil {
+		return nil, err
+	}
+	return m, nil
+}
+
+func (c *engineServiceClient) CreateApiToken(ctx context.Context, in *CreateApiTokenArgs, opts ...grpc.CallOption) (*CreateApiTokenResponse, error) {
+	out := new(CreateApiTokenResponse)
+	err := c.cc.Invoke(ctx, EngineService_CreateApiToken_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *engineServiceClient) RevokeApiToken(ctx context.Context, in *RevokeApiTokenArgs, opts ...grpc.CallOption) (*emptypb.Empty, error) {
+	out := new(emptypb.Empty)
+	err := c.cc.Invoke(ctx, EngineService_RevokeApiToken_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *engineServiceClient) GetAuditLog(ctx context.Context, in *GetAuditLogArgs, opts ...grpc.CallOption) (*GetAuditLogResponse, error) {
+	out := new(GetAuditLogResponse)
+	err := c.cc.Invoke(ctx, EngineService_GetAuditLog_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *engineServiceClient) GetEnclaveCallerCredential(ctx context.Context, in *GetEnclaveCallerCredentialArgs, opts ...grpc.CallOption) (*GetEnclaveCallerCredentialResponse, error) {
+	out := new(GetEnclaveCallerCredentialResponse)
+	err := c.cc.Invoke(ctx, EngineService_GetEnclaveCallerCredential_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 // EngineServiceServer is the server API for EngineService service.
 // All implementations should embed UnimplementedEngineServiceServer
 // for forward compatibility
@@ -185,8 +388,8 @@ type EngineServiceServer interface {
 	// ==============================================================================================
 	// Creates a new Kurtosis Enclave
 	CreateEnclave(context.Context, *CreateEnclaveArgs) (*CreateEnclaveResponse, error)
-	// Returns information about the requested enclaves or all enclaves if none specified.
-	GetEnclaves(context.Context, *emptypb.Empty) (*GetEnclavesResponse, error)
+	// Returns information about the enclaves matching the label selector, or all enclaves if none specified.
+	GetEnclaves(context.Context, *GetEnclavesArgs) (*GetEnclavesResponse, error)
 	// Returns information about the requested enclaves or all enclaves if none specified.
 	GetEnclavesByUuids(context.Context, *GetEnclavesByUuidsArgs) (*GetEnclavesResponse, error)
 	// Returns information about all existing & historical enclaves
@@ -195,10 +398,32 @@ type EngineServiceServer interface {
 	StopEnclave(context.Context, *StopEnclaveArgs) (*emptypb.Empty, error)
 	// Destroys an enclave, removing all artifacts associated with it
 	DestroyEnclave(context.Context, *DestroyEnclaveArgs) (*emptypb.Empty, error)
+	// Makes an enclave expire after the given time-to-live, counting from now
+	ExtendEnclaveTtl(context.Context, *ExtendEnclaveTtlArgs) (*emptypb.Empty, error)
+	// Sets and removes labels of an enclave
+	UpdateEnclaveLabels(context.Context, *UpdateEnclaveLabelsArgs) (*emptypb.Empty, error)
+	// Changes the name of an existing enclave, keeping its UUID
+	RenameEnclave(context.Context, *RenameEnclaveArgs) (*emptypb.Empty, error)
+	// Creates a new enclave with the files artifacts, persistent directories and services of an existing one
+	CloneEnclave(context.Context, *CloneEnclaveArgs) (*CloneEnclaveResponse, error)
+	// Streams an archive of an enclave, with its plan, files artifacts, persistent directories and mode, that ImportEnclave recreates it from
+	ExportEnclave(*ExportEnclaveArgs, EngineService_ExportEnclaveServer) error
+	// Creates an enclave from an archive streamed by ExportEnclave, possibly on another engine
+	ImportEnclave(EngineService_ImportEnclaveServer) error
 	// Gets rid of old enclaves
 	Clean(context.Context, *CleanArgs) (*CleanResponse, error)
 	// Get service logs
 	GetServiceLogs(*GetServiceLogsArgs, EngineService_GetServiceLogsServer) error
+	// Streams what happens to the enclaves of the engine, starting from when the stream is opened
+	WatchEvents(*WatchEventsArgs, EngineService_WatchEventsServer) error
+	// Creates an API token, only available when the engine enforces API authentication
+	CreateApiToken(context.Context, *CreateApiTokenArgs) (*CreateApiTokenResponse, error)
+	// Revokes an API token so that it can't be used anymore
+	RevokeApiToken(context.Context, *RevokeApiTokenArgs) (*emptypb.Empty, error)
+	// Gets the changes made through the engine and the API containers of its running enclaves, newest first
+	GetAuditLog(context.Context, *GetAuditLogArgs) (*GetAuditLogResponse, error)
+	// Gets a credential proving who the caller is to the API container of an enclave, so that the calls it makes to the API container directly are attributed to it in the audit log
+	GetEnclaveCallerCredential(context.Context, *GetEnclaveCallerCredentialArgs) (*GetEnclaveCallerCredentialResponse, error)
 }
 
 // UnimplementedEngineServiceServer should be embedded to have forward compatible implementations.
@@ -211,7 +436,7 @@ func (UnimplementedEngineServiceServer) GetEngineInfo(context.Context, *emptypb.
 func (UnimplementedEngineServiceServer) CreateEnclave(context.Context, *CreateEnclaveArgs) (*CreateEnclaveResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method CreateEnclave not implemented")
 }
-func (UnimplementedEngineServiceServer) GetEnclaves(context.Context, *emptypb.Empty) (*GetEnclavesResponse, error) {
+func (UnimplementedEngineServiceServer) GetEnclaves(context.Context, *GetEnclavesArgs) (*GetEnclavesResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method GetEnclaves not implemented")
 }
 func (UnimplementedEngineServiceServer) GetEnclavesByUuids(context.Context, *GetEnclavesByUuidsArgs) (*GetEnclavesResponse, error) {
@@ -226,12 +451,45 @@ func (UnimplementedEngineServiceServer) StopEnclave(context.Context, *StopEnclav
 func (UnimplementedEngineServiceServer) DestroyEnclave(context.Context, *DestroyEnclaveArgs) (*emptypb.Empty, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method DestroyEnclave not implemented")
 }
+func (UnimplementedEngineServiceServer) ExtendEnclaveTtl(context.Context, *ExtendEnclaveTtlArgs) (*emptypb.Empty, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ExtendEnclaveTtl not implemented")
+}
+func (UnimplementedEngineServiceServer) UpdateEnclaveLabels(context.Context, *UpdateEnclaveLabelsArgs) (*emptypb.Empty, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method UpdateEnclaveLabels not implemented")
+}
+func (UnimplementedEngineServiceServer) RenameEnclave(context.Context, *RenameEnclaveArgs) (*emptypb.Empty, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method RenameEnclave not implemented")
+}
+func (UnimplementedEngineServiceServer) CloneEnclave(context.Context, *CloneEnclaveArgs) (*CloneEnclaveResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method CloneEnclave not implemented")
+}
+func (UnimplementedEngineServiceServer) ExportEnclave(*ExportEnclaveArgs, EngineService_ExportEnclaveServer) error {
+	return status.Errorf(codes.Unimplemented, "method ExportEnclave not implemented")
+}
+func (UnimplementedEngineServiceServer) ImportEnclave(EngineService_ImportEnclaveServer) error {
+	return status.Errorf(codes.Unimplemented, "method ImportEnclave not implemented")
+}
 func (UnimplementedEngineServiceServer) Clean(context.Context, *CleanArgs) (*CleanResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method Clean not implemented")
 }
 func (UnimplementedEngineServiceServer) GetServiceLogs(*GetServiceLogsArgs, EngineService_GetServiceLogsServer) error {
 	return status.Errorf(codes.Unimplemented, "method GetServiceLogs not implemented")
 }
+func (UnimplementedEngineServiceServer) WatchEvents(*WatchEventsArgs, EngineService_WatchEventsServer) error {
+	return status.Errorf(codes.Unimplemented, "method WatchEvents not implemented")
+}
+func (UnimplementedEngineServiceServer) CreateApiToken(context.Context, *CreateApiTokenArgs) (*CreateApiTokenResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method CreateApiToken not implemented")
+}
+func (UnimplementedEngineServiceServer) RevokeApiToken(context.Context, *RevokeApiTokenArgs) (*emptypb.Empty, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method RevokeApiToken not implemented")
+}
+func (UnimplementedEngineServiceServer) GetAuditLog(context.Context, *GetAuditLogArgs) (*GetAuditLogResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetAuditLog not implemented")
+}
+func (UnimplementedEngineServiceServer) GetEnclaveCallerCredential(context.Context, *GetEnclaveCallerCredentialArgs) (*GetEnclaveCallerCredentialResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetEnclaveCallerCredential not implemented")
+}
 
 // UnsafeEngineServiceServer may be embedded to opt out of forward compatibility for this service.
 // Use of this interface is not recommended, as added methods to EngineServiceServer will
@@ -281,7 +539,7 @@ func _EngineService_CreateEnclave_Handler(srv interface{}, ctx context.Context,
 }
 
 func _EngineService_GetEnclaves_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(emptypb.Empty)
+	in := new(GetEnclavesArgs)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
@@ -293,7 +551,7 @@ func _EngineService_GetEnclaves_Handler(srv interface{}, ctx context.Context, de
 		FullMethod: EngineService_GetEnclaves_FullMethodName,
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(EngineServiceServer).GetEnclaves(ctx, req.(*emptypb.Empty))
+		return srv.(EngineServiceServer).GetEnclaves(ctx, req.(*GetEnclavesArgs))
 	}
 	return interceptor(ctx, in, info, handler)
 }
@@ -370,6 +628,125 @@ func _EngineService_DestroyEnclave_Handler(srv interface{}, ctx context.Context,
 	return interceptor(ctx, in, info, handler)
 }
 
+func _EngineService_ExtendEnclaveTtl_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ExtendEnclaveTtlArgs)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(EngineServiceServer).ExtendEnclaveTtl(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: EngineService_ExtendEnclaveTtl_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(EngineServiceServer).ExtendEnclaveTtl(ctx, req.(*ExtendEnclaveTtlArgs))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _EngineService_UpdateEnclaveLabels_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(UpdateEnclaveLabelsArgs)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(EngineServiceServer).UpdateEnclaveLabels(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: EngineService_UpdateEnclaveLabels_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(EngineServiceServer).UpdateEnclaveLabels(ctx, req.(*UpdateEnclaveLabelsArgs))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _EngineService_RenameEnclave_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(RenameEnclaveArgs)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(EngineServiceServer).RenameEnclave(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: EngineService_RenameEnclave_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(EngineServiceServer).RenameEnclave(ctx, req.(*RenameEnclaveArgs))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _EngineService_CloneEnclave_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(CloneEnclaveArgs)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(EngineServiceServer).CloneEnclave(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: EngineService_CloneEnclave_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(EngineServiceServer).CloneEnclave(ctx, req.(*CloneEnclaveArgs))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _EngineService_ExportEnclave_Handler(srv interface{}, stream grpc.ServerStream) error {
+	m := new(ExportEnclaveArgs)
+	if err := stream.RecvMsg(m); err != nil {
+		return err
+	}
+	return srv.(EngineServiceServer).ExportEnclave(m, &engineServiceExportEnclaveServer{stream})
+}
+
+type EngineService_ExportEnclaveServer interface {
+	Send(*EnclaveArchiveChunk) error
+	grpc.ServerStream
+}
+
+type engineServiceExportEnclaveServer struct {
+	grpc.ServerStream
+}
+
+func (x *engineServiceExportEnclaveServer) Send(m *EnclaveArchiveChunk) error {
+	return x.ServerStream.SendMsg(m)
+}
+
+func _EngineService_ImportEnclave_Handler(srv interface{}, stream grpc.ServerStream) error {
+	return srv.(EngineServiceServer).ImportEnclave(&engineServiceImportEnclaveServer{stream})
+}
+
+type EngineService_ImportEnclaveServer interface {
+	SendAndClose(*ImportEnclaveResponse) error
+	Recv() (*ImportEnclaveArgs, error)
+	grpc.ServerStream
+}
+
+type engineServiceImportEnclaveServer struct {
+	grpc.ServerStream
+}
+
+func (x *engineServiceImportEnclaveServer) SendAndClose(m *ImportEnclaveResponse) error {
+	return x.ServerStream.SendMsg(m)
+}
+
+func (x *engineServiceImportEnclaveServer) Recv() (*ImportEnclaveArgs, error) {
+	m := new(ImportEnclaveArgs)
+	if err := x.ServerStream.RecvMsg(m); err != nil {
+		return nil, err
+	}
+	return m, nil
+}
+
 func _EngineService_Clean_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
 	in := new(CleanArgs)
 	if err := dec(in); err != nil {
@@ -409,6 +786,99 @@ func (x *engineServiceGetServiceLogsServer) Send(m *GetServiceLogsResponse) erro
 	return x.ServerStream.SendMsg(m)
 }
 
+func _EngineService_WatchEvents_Handler(srv interface{}, stream grpc.ServerStream) error {
+	m := new(WatchEventsArgs)
+	if err := stream.RecvMsg(m); err != nil {
+		return err
+	}
+	return srv.(EngineServiceServer).WatchEvents(m, &engineServiceWatchEventsServer{stream})
+}
+
+type EngineService_WatchEventsServer interface {
+	Send(*EngineEvent) error
+	grpc.ServerStream
+}
+
+type engineServiceWatchEventsServer struct {
+	grpc.ServerStream
+}
+
+func (x *engineServiceWatchEventsServer) Send(m *EngineEvent) error {
+	return x.ServerStream.SendMsg(m)
+}
+
+func _EngineService_CreateApiToken_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(CreateApiTokenArgs)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(EngineServiceServer).CreateApiToken(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: EngineService_CreateApiToken_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(EngineServiceServer).CreateApiToken(ctx, req.(*CreateApiTokenArgs))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _EngineService_RevokeApiToken_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(RevokeApiTokenArgs)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(EngineServiceServer).RevokeApiToken(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: EngineService_RevokeApiToken_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(EngineServiceServer).RevokeApiToken(ctx, req.(*RevokeApiTokenArgs))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _EngineService_GetAuditLog_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetAuditLogArgs)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(EngineServiceServer).GetAuditLog(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: EngineService_GetAuditLog_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(EngineServiceServer).GetAuditLog(ctx, req.(*GetAuditLogArgs))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _EngineService_GetEnclaveCallerCredential_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetEnclaveCallerCredentialArgs)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(EngineServiceServer).GetEnclaveCallerCredential(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: EngineService_GetEnclaveCallerCredential_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(EngineServiceServer).GetEnclaveCallerCredential(ctx, req.(*GetEnclaveCallerCredentialArgs))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 // EngineService_ServiceDesc is the grpc.ServiceDesc for EngineService service.
 // It's only intended for direct use with grpc.RegisterService,
 // and not to be introspected or modified (even as a copy)
@@ -444,17 +914,64 @@ var EngineService_ServiceDesc = grpc.ServiceDesc{
 			MethodName: "DestroyEnclave",
 			Handler:    _EngineService_DestroyEnclave_Handler,
 		},
+		{
+			MethodName: "ExtendEnclaveTtl",
+			Handler:    _EngineService_ExtendEnclaveTtl_Handler,
+		},
+		{
+			MethodName: "UpdateEnclaveLabels",
+			Handler:    _EngineService_UpdateEnclaveLabels_Handler,
+		},
+		{
+			MethodName: "RenameEnclave",
+			Handler:    _EngineService_RenameEnclave_Handler,
+		},
+		{
+			MethodName: "CloneEnclave",
+			Handler:    _EngineService_CloneEnclave_Handler,
+		},
 		{
 			MethodName: "Clean",
 			Handler:    _EngineService_Clean_Handler,
 		},
+		{
+			MethodName: "CreateApiToken",
+			Handler:    _EngineService_CreateApiToken_Handler,
+		},
+		{
+			MethodName: "RevokeApiToken",
+			Handler:    _EngineService_RevokeApiToken_Handler,
+		},
+		{
+			MethodName: "GetAuditLog",
+			Handler:    _EngineService_GetAuditLog_Handler,
+		},
+		{
+			MethodName: "GetEnclaveCallerCredential",
+			Handler:    _EngineService_GetEnclaveCallerCredential_Handler,
+		},
 	},
 	Streams: []grpc.StreamDesc{
+		{
+			StreamName:    "ExportEnclave",
+			Handler:       _EngineService_ExportEnclave_Handler,
+			ServerStreams: true,
+		},
+		{
+			StreamName:    "ImportEnclave",
+			Handler:       _EngineService_ImportEnclave_Handler,
+			ClientStreams: true,
+		},
 		{
 			StreamName:    "GetServiceLogs",
 			Handler:       _EngineService_GetServiceLogs_Handler,
 			ServerStreams: true,
 		},
+		{
+			StreamName:    "WatchEvents",
+			Handler:       _EngineService_WatchEvents_Handler,
+			ServerStreams: true,
+		},
 	},
 	Metadata: "engine_service.proto",
 }
@@ -55,11 +55,44 @@ const (
 	// EngineServiceDestroyEnclaveProcedure is the fully-qualified name of the EngineService's
 	// DestroyEnclave RPC.
 	EngineServiceDestroyEnclaveProcedure = "/engine_api.EngineService/DestroyEnclave"
+	// EngineServiceExtendEnclaveTtlProcedure is the fully-qualified name of the EngineService's
+	// ExtendEnclaveTtl RPC.
+	EngineServiceExtendEnclaveTtlProcedure = "/engine_api.EngineService/ExtendEnclaveTtl"
+	// EngineServiceUpdateEnclaveLabelsProcedure is the fully-qualified name of the EngineService's
+	// UpdateEnclaveLabels RPC.
+	EngineServiceUpdateEnclaveLabelsProcedure = "/engine_api.EngineService/UpdateEnclaveLabels"
+	// EngineServiceRenameEnclaveProcedure is the fully-qualified name of the EngineService's
+	// RenameEnclave RPC.
+	EngineServiceRenameEnclaveProcedure = "/engine_api.EngineService/RenameEnclave"
+	// EngineServiceCloneEnclaveProcedure is the fully-qualified name of the EngineService's
+	// CloneEnclave RPC.
+	EngineServiceCloneEnclaveProcedure = "/engine_api.EngineService/CloneEnclave"
+	// EngineServiceExportEnclaveProcedure is the fully-qualified name of the EngineService's
+	// ExportEnclave RPC.
+	EngineServiceExportEnclaveProcedure = "/engine_api.EngineService/ExportEnclave"
+	// EngineServiceImportEnclaveProcedure is the fully-qualified name of the EngineService's
+	// ImportEnclave RPC.
+	EngineServiceImportEnclaveProcedure = "/engine_api.EngineService/ImportEnclave"
 	// EngineServiceCleanProcedure is the fully-qualified name of the EngineService's Clean RPC.
 	EngineServiceCleanProcedure = "/engine_api.EngineService/Clean"
 	// EngineServiceGetServiceLogsProcedure is the fully-qualified name of the EngineService's
 	// GetServiceLogs RPC.
 	EngineServiceGetServiceLogsProcedure = "/engine_api.EngineService/GetServiceLogs"
+	// EngineServiceWatchEventsProcedure is the fully-qualified name of the EngineService's WatchEvents
+	// RPC.
+	EngineServiceWatchEventsProcedure = "/engine_api.EngineService/WatchEvents"
+	// EngineServiceCreateApiTokenProcedure is the fully-qualified name of the EngineService's
+	// CreateApiToken RPC.
+	EngineServiceCreateApiTokenProcedure = "/engine_api.EngineService/CreateApiToken"
+	// EngineServiceRevokeApiTokenProcedure is the fully-qualified name of the EngineService's
+	// RevokeApiToken RPC.
+	EngineServiceRevokeApiTokenProcedure = "/engine_api.EngineService/RevokeApiToken"
+	// EngineServiceGetAuditLogProcedure is the fully-qualified name of the EngineService's GetAuditLog
+	// RPC.
+	EngineServiceGetAuditLogProcedure = "/engine_api.EngineService/GetAuditLog"
+	// EngineServiceGetEnclaveCallerCredentialProcedure is the fully-qualified name of the
+	// EngineService's GetEnclaveCallerCredential RPC.
+	EngineServiceGetEnclaveCallerCredentialProcedure = "/engine_api.EngineService/GetEnclaveCallerCredential"
 )
 
 // EngineServiceClient is a client for the engine_api.EngineService service.
@@ -73,8 +106,8 @@ type EngineServiceClient interface {
 	// ==============================================================================================
 	// Creates a new Kurtosis Enclave
 	CreateEnclave(context.Context, *connect.Request[kurtosis_engine_rpc_api_bindings.CreateEnclaveArgs]) (*connect.Response[kurtosis_engine_rpc_api_bindings.CreateEnclaveResponse], error)
-	// Returns information about the requested enclaves or all enclaves if none specified.
-	GetEnclaves(context.Context, *connect.Request[emptypb.Empty]) (*connect.Response[kurtosis_engine_rpc_api_bindings.GetEnclavesResponse], error)
+	// Returns information about the enclaves matching the label selector, or all enclaves if none specified.
+	GetEnclaves(context.Context, *connect.Request[kurtosis_engine_rpc_api_bindings.GetEnclavesArgs]) (*connect.Response[kurtosis_engine_rpc_api_bindings.GetEnclavesResponse], error)
 	// Returns information about the requested enclaves or all enclaves if none specified.
 	GetEnclavesByUuids(context.Context, *connect.Request[kurtosis_engine_rpc_api_bindings.GetEnclavesByUuidsArgs]) (*connect.Response[kurtosis_engine_rpc_api_bindings.GetEnclavesResponse], error)
 	// Returns information about all existing & historical enclaves
@@ -83,10 +116,32 @@ type EngineServiceClient interface {
 	StopEnclave(context.Context, *connect.Request[kurtosis_engine_rpc_api_bindings.StopEnclaveArgs]) (*connect.Response[emptypb.Empty], error)
 	// Destroys an enclave, removing all artifacts associated with it
 	DestroyEnclave(context.Context, *connect.Request[kurtosis_engine_rpc_api_bindings.DestroyEnclaveArgs]) (*connect.Response[emptypb.Empty], error)
+	// Makes an enclave expire after the given time-to-live, counting from now
+	ExtendEnclaveTtl(context.Context, *connect.Request[kurtosis_engine_rpc_api_bindings.ExtendEnclaveTtlArgs]) (*connect.Response[emptypb.Empty], error)
+	// Sets and removes labels of an enclave
+	UpdateEnclaveLabels(context.Context, *connect.Request[kurtosis_engine_rpc_api_bindings.UpdateEnclaveLabelsArgs]) (*connect.Response[emptypb.Empty], error)
+	// Changes the name of an existing enclave, keeping its UUID
+	RenameEnclave(context.Context, *connect.Request[kurtosis_engine_rpc_api_bindings.RenameEnclaveArgs]) (*connect.Response[emptypb.Empty], error)
+	// Creates a new enclave with the files artifacts, persistent directories and services of an existing one
+	CloneEnclave(context.Context, *connect.Request[kurtosis_engine_rpc_api_bindings.CloneEnclaveArgs]) (*connect.Response[kurtosis_engine_rpc_api_bindings.CloneEnclaveResponse], error)
+	// Streams an archive of an enclave, with its plan, files artifacts, persistent directories and mode, that ImportEnclave recreates it from
+	ExportEnclave(context.Context, *connect.Request[kurtosis_engine_rpc_api_bindings.ExportEnclaveArgs]) (*connect.ServerStreamForClient[kurtosis_engine_rpc_api_bindings.EnclaveArchiveChunk], error)
+	// Creates an enclave from an archive streamed by ExportEnclave, possibly on another engine
+	ImportEnclave(context.Context) *connect.ClientStreamForClient[kurtosis_engine_rpc_api_bindings.ImportEnclaveArgs, kurtosis_engine_rpc_api_bindings.ImportEnclaveResponse]
 	// Gets rid of old enclaves
 	Clean(context.Context, *connect.Request[kurtosis_engine_rpc_api_bindings.CleanArgs]) (*connect.Response[kurtosis_engine_rpc_api_bindings.CleanResponse], error)
 	// Get service logs
 	GetServiceLogs(context.Context, *connect.Request[kurtosis_engine_rpc_api_bindings.GetServiceLogsArgs]) (*connect.ServerStreamForClient[kurtosis_engine_rpc_api_bindings.GetServiceLogsResponse], error)
+	// Streams what happens to the enclaves of the engine, starting from when the stream is opened
+	WatchEvents(context.Context, *connect.Request[kurtosis_engine_rpc_api_bindings.WatchEventsArgs]) (*connect.ServerStreamForClient[kurtosis_engine_rpc_api_bindings.EngineEvent], error)
+	// Creates an API token, only available when the engine enforces API authentication
+	CreateApiToken(context.Context, *connect.Request[kurtosis_engine_rpc_api_bindings.CreateApiTokenArgs]) (*connect.Response[kurtosis_engine_rpc_api_bindings.CreateApiTokenResponse], error)
+	// Revokes an API token so that it can't be used anymore
+	RevokeApiToken(context.Context, *connect.Request[kurtosis_engine_rpc_api_bindings.RevokeApiTokenArgs]) (*connect.Response[emptypb.Empty], error)
+	// Gets the changes made through the engine and the API containers of its running enclaves, newest first
+	GetAuditLog(context.Context, *connect.Request[kurtosis_engine_rpc_api_bindings.GetAuditLogArgs]) (*connect.Response[kurtosis_engine_rpc_api_bindings.GetAuditLogResponse], error)
+	// Gets a credential proving who the caller is to the API container of an enclave, so that the calls it makes to the API container directly are attributed to it in the audit log
+	GetEnclaveCallerCredential(context.Context, *connect.Request[kurtosis_engine_rpc_api_bindings.GetEnclaveCallerCredentialArgs]) (*connect.Response[kurtosis_engine_rpc_api_bindings.GetEnclaveCallerCredentialResponse], error)
 }
 
 // NewEngineServiceClient constructs a client for the engine_api.EngineService service. By default,
@@ -109,7 +164,7 @@ func NewEngineServiceClient(httpClient connect.HTTPClient, baseURL string, opts
 			baseURL+EngineServiceCreateEnclaveProcedure,
 			opts...,
 		),
-		getEnclaves: connect.NewClient[emptypb.Empty, kurtosis_engine_rpc_api_bindings.GetEnclavesResponse](
+		getEnclaves: connect.NewClient[kurtosis_engine_rpc_api_bindings.GetEnclavesArgs, kurtosis_engine_rpc_api_bindings.GetEnclavesResponse](
 			httpClient,
 			baseURL+EngineServiceGetEnclavesProcedure,
 			opts...,
@@ -134,6 +189,36 @@ func NewEngineServiceClient(httpClient connect.HTTPClient, baseURL string, opts
 			baseURL+EngineServiceDestroyEnclaveProcedure,
 			opts...,
 		),
+		extendEnclaveTtl: connect.NewClient[kurtosis_engine_rpc_api_bindings.ExtendEnclaveTtlArgs, emptypb.Empty](
+			httpClient,
+			baseURL+EngineServiceExtendEnclaveTtlProcedure,
+			opts...,
+		),
+		updateEnclaveLabels: connect.NewClient[kurtosis_engine_rpc_api_bindings.UpdateEnclaveLabelsArgs, emptypb.Empty](
+			httpClient,
+			baseURL+EngineServiceUpdateEnclaveLabelsProcedure,
+			opts...,
+		),
+		renameEnclave: connect.NewClient[kurtosis_engine_rpc_api_bindings.RenameEnclaveArgs, emptypb.Empty](
+			httpClient,
+			baseURL+EngineServiceRenameEnclaveProcedure,
+			opts...,
+		),
+		cloneEnclave: connect.NewClient[kurtosis_engine_rpc_api_bindings.CloneEnclaveArgs, kurtosis_engine_rpc_api_bindings.CloneEnclaveResponse](
+			httpClient,
+			baseURL+EngineServiceCloneEnclaveProcedure,
+			opts...,
+		),
+		exportEnclave: connect.NewClient[kurtosis_engine_rpc_api_bindings.ExportEnclaveArgs, kurtosis_engine_rpc_api_bindings.EnclaveArchiveChunk](
+			httpClient,
+			baseURL+EngineServiceExportEnclaveProcedure,
+			opts...,
+		),
+		importEnclave: connect.NewClient[kurtosis_engine_rpc_api_bindings.ImportEnclaveArgs, kurtosis_engine_rpc_api_bindings.ImportEnclaveResponse](
+			httpClient,
+			baseURL+EngineServiceImportEnclaveProcedure,
+			opts...,
+		),
 		clean: connect.NewClient[kurtosis_engine_rpc_api_bindings.CleanArgs, kurtosis_engine_rpc_api_bindings.CleanResponse](
 			httpClient,
 			baseURL+EngineServiceCleanProcedure,
@@ -144,6 +229,31 @@ func NewEngineServiceClient(httpClient connect.HTTPClient, baseURL string, opts
 			baseURL+EngineServiceGetServiceLogsProcedure,
 			opts...,
 		),
+		watchEvents: connect.NewClient[kurtosis_engine_rpc_api_bindings.WatchEventsArgs, kurtosis_engine_rpc_api_bindings.EngineEvent](
+			httpClient,
+			baseURL+EngineServiceWatchEventsProcedure,
+			opts...,
+		),
+		createApiToken: connect.NewClient[kurtosis_engine_rpc_api_bindings.CreateApiTokenArgs, kurtosis_engine_rpc_api_bindings.CreateApiTokenResponse](
+			httpClient,
+			baseURL+EngineServiceCreateApiTokenProcedure,
+			opts...,
+		),
+		revokeApiToken: connect.NewClient[kurtosis_engine_rpc_api_bindings.RevokeApiTokenArgs, emptypb.Empty](
+			httpClient,
+			baseURL+EngineServiceRevokeApiTokenProcedure,
+			opts...,
+		),
+		getAuditLog: connect.NewClient[kurtosis_engine_rpc_api_bindings.GetAuditLogArgs, kurtosis_engine_rpc_api_bindings.GetAuditLogResponse](
+			httpClient,
+			baseURL+EngineServiceGetAuditLogProcedure,
+			opts...,
+		),
+		getEnclaveCallerCredential: connect.NewClient[kurtosis_engine_rpc_api_bindings.GetEnclaveCallerCredentialArgs, kurtosis_engine_rpc_api_bindings.GetEnclaveCallerCredentialResponse](
+			httpClient,
+			baseURL+EngineServiceGetEnclaveCallerCredentialProcedure,
+			opts...,
+		),
 	}
 }
 
@@ -151,13 +261,24 @@ func NewEngineServiceClient(httpClient connect.HTTPClient, baseURL string, opts
 type engineServiceClient struct {
 	getEngineInfo                              *connect.Client[emptypb.Empty, kurtosis_engine_rpc_api_bindings.GetEngineInfoResponse]
 	createEnclave                              *connect.Client[kurtosis_engine_rpc_api_bindings.CreateEnclaveArgs, kurtosis_engine_rpc_api_bindings.CreateEnclaveResponse]
-	getEnclaves                                *connect.Client[emptypb.Empty, kurtosis_engine_rpc_api_bindings.GetEnclavesResponse]
+	getEnclaves                                *connect.Client[kurtosis_engine_rpc_api_bindings.GetEnclavesArgs, kurtosis_engine_rpc_api_bindings.GetEnclavesResponse]
 	getEnclavesByUuids                         *connect.Client[kurtosis_engine_rpc_api_bindings.GetEnclavesByUuidsArgs, kurtosis_engine_rpc_api_bindings.GetEnclavesResponse]
 	getExistingAndHistoricalEnclaveIdentifiers *connect.Client[emptypb.Empty, kurtosis_engine_rpc_api_bindings.GetExistingAndHistoricalEnclaveIdentifiersResponse]
 	stopEnclave                                *connect.Client[kurtosis_engine_rpc_api_bindings.StopEnclaveArgs, emptypb.Empty]
 	destroyEnclave                             *connect.Client[kurtosis_engine_rpc_api_bindings.DestroyEnclaveArgs, emptypb.Empty]
+	extendEnclaveTtl                           *connect.Client[kurtosis_engine_rpc_api_bindings.ExtendEnclaveTtlArgs, emptypb.Empty]
+	updateEnclaveLabels                        *connect.Client[kurtosis_engine_rpc_api_bindings.UpdateEnclaveLabelsArgs, emptypb.Empty]
+	renameEnclave                              *connect.Client[kurtosis_engine_rpc_api_bindings.RenameEnclaveArgs, emptypb.Empty]
+	cloneEnclave                               *connect.Client[kurtosis_engine_rpc_api_bindings.CloneEnclaveArgs, kurtosis_engine_rpc_api_bindings.CloneEnclaveResponse]
+	exportEnclave                              *connect.Client[kurtosis_engine_rpc_api_bindings.ExportEnclaveArgs, kurtosis_engine_rpc_api_bindings.EnclaveArchiveChunk]
+	importEnclave                              *connect.Client[kurtosis_engine_rpc_api_bindings.ImportEnclaveArgs, kurtosis_engine_rpc_api_bindings.ImportEnclaveResponse]
 	clean                                      *connect.Client[kurtosis_engine_rpc_api_bindings.CleanArgs, kurtosis_engine_rpc_api_bindings.CleanResponse]
 	getServiceLogs                             *connect.Client[kurtosis_engine_rpc_api_bindings.GetServiceLogsArgs, kurtosis_engine_rpc_api_bindings.GetServiceLogsResponse]
+	watchEvents                                *connect.Client[kurtosis_engine_rpc_api_bindings.WatchEventsArgs, kurtosis_engine_rpc_api_bindings.EngineEvent]
+	createApiToken                             *connect.Client[kurtosis_engine_rpc_api_bindings.CreateApiTokenArgs, kurtosis_engine_rpc_api_bindings.CreateApiTokenResponse]
+	revokeApiToken                             *connect.Client[kurtosis_engine_rpc_api_bindings.RevokeApiTokenArgs, emptypb.Empty]
+	getAuditLog                                *connect.Client[kurtosis_engine_rpc_api_bindings.GetAuditLogArgs, kurtosis_engine_rpc_api_bindings.GetAuditLogResponse]
+	getEnclaveCallerCredential                 *connect.Client[kurtosis_engine_rpc_api_bindings.GetEnclaveCallerCredentialArgs, kurtosis_engine_rpc_api_bindings.GetEnclaveCallerCredentialResponse]
 }
 
 // GetEngineInfo calls engine_api.EngineService.GetEngineInfo.
@@ -171,7 +292,7 @@ func (c *engineServiceClient) CreateEnclave(ctx context.Context, req *connect.Re
 }
 
 // GetEnclaves calls engine_api.EngineService.GetEnclaves.
-func (c *engineServiceClient) GetEnclaves(ctx context.Context, req *connect.Request[emptypb.Empty]) (*connect.Response[kurtosis_engine_rpc_api_bindings.GetEnclavesResponse], error) {
+func (c *engineServiceClient) GetEnclaves(ctx context.Context, req *connect.Request[kurtosis_engine_rpc_api_bindings.GetEnclavesArgs]) (*connect.Response[kurtosis_engine_rpc_api_bindings.GetEnclavesResponse], error) {
 	return c.getEnclaves.CallUnary(ctx, req)
 }
 
@@ -196,6 +317,36 @@ func (c *engineServiceClient) DestroyEnclave(ctx context.Context, req *connect.R
 	return c.destroyEnclave.CallUnary(ctx, req)
 }
 
+// ExtendEnclaveTtl calls engine_api.EngineService.ExtendEnclaveTtl.
+func (c *engineServiceClient) ExtendEnclaveTtl(ctx context.Context, req *connect.Request[kurtosis_engine_rpc_api_bindings.ExtendEnclaveTtlArgs]) (*connect.Response[emptypb.Empty], error) {
+	return c.extendEnclaveTtl.CallUnary(ctx, req)
+}
+
+// UpdateEnclaveLabels calls engine_api.EngineService.UpdateEnclaveLabels.
+func (c *engineServiceClient) UpdateEnclaveLabels(ctx context.Context, req *connect.Request[kurtosis_engine_rpc_api_bindings.UpdateEnclaveLabelsArgs]) (*connect.Response[emptypb.Empty], error) {
+	return c.updateEnclaveLabels.CallUnary(ctx, req)
+}
+
+// RenameEnclave calls engine_api.EngineService.RenameEnclave.
+func (c *engineServiceClient) RenameEnclave(ctx context.Context, req *connect.Request[kurtosis_engine_rpc_api_bindings.RenameEnclaveArgs]) (*connect.Response[emptypb.Empty], error) {
+	return c.renameEnclave.CallUnary(ctx, req)
+}
+
+// CloneEnclave calls engine_api.EngineService.CloneEnclave.
+func (c *engineServiceClient) CloneEnclave(ctx context.Context, req *connect.Request[kurtosis_engine_rpc_api_bindings.CloneEnclaveArgs]) (*connect.Response[kurtosis_engine_rpc_api_bindings.CloneEnclaveResponse], error) {
+	return c.cloneEnclave.CallUnary(ctx, req)
+}
+
+// ExportEnclave calls engine_api.EngineService.ExportEnclave.
+func (c *engineServiceClient) ExportEnclave(ctx context.Context, req *connect.Request[kurtosis_engine_rpc_api_bindings.ExportEnclaveArgs]) (*connect.ServerStreamForClient[kurtosis_engine_rpc_api_bindings.EnclaveArchiveChunk], error) {
+	return c.exportEnclave.CallServerStream(ctx, req)
+}
+
+// ImportEnclave calls engine_api.EngineService.ImportEnclave.
+func (c *engineServiceClient) ImportEnclave(ctx context.Context) *connect.ClientStreamForClient[kurtosis_engine_rpc_api_bindings.ImportEnclaveArgs, kurtosis_engine_rpc_api_bindings.ImportEnclaveResponse] {
+	return c.importEnclave.CallClientStream(ctx)
+}
+
 // Clean calls engine_api.EngineService.Clean.
 func (c *engineServiceClient) Clean(ctx context.Context, req *connect.Request[kurtosis_engine_rpc_api_bindings.CleanArgs]) (*connect.Response[kurtosis_engine_rpc_api_bindings.CleanResponse], error) {
 	return c.clean.CallUnary(ctx, req)
@@ -206,6 +357,31 @@ func (c *engineServiceClient) GetServiceLogs(ctx context.Context, req *connect.R
 	return c.getServiceLogs.CallServerStream(ctx, req)
 }
 
+// WatchEvents calls engine_api.EngineService.WatchEvents.
+func (c *engineServiceClient) WatchEvents(ctx context.Context, req *connect.Request[kurtosis_engine_rpc_api_bindings.WatchEventsArgs]) (*connect.ServerStreamForClient[kurtosis_engine_rpc_api_bindings.EngineEvent], error) {
+	return c.watchEvents.CallServerStream(ctx, req)
+}
+
+// CreateApiToken calls engine_api.EngineService.CreateApiToken.
+func (c *engineServiceClient) CreateApiToken(ctx context.Context, req *connect.Request[kurtosis_engine_rpc_api_bindings.CreateApiTokenArgs]) (*connect.Response[kurtosis_engine_rpc_api_bindings.CreateApiTokenResponse], error) {
+	return c.createApiToken.CallUnary(ctx, req)
+}
+
+// RevokeApiToken calls engine_api.EngineService.RevokeApiToken.
+func (c *engineServiceClient) RevokeApiToken(ctx context.Context, req *connect.Request[kurtosis_engine_rpc_api_bindings.RevokeApiTokenArgs]) (*connect.Response[emptypb.Empty], error) {
+	return c.revokeApiToken.CallUnary(ctx, req)
+}
+
+// GetAuditLog calls engine_api.EngineService.GetAuditLog.
+func (c *engineServiceClient) GetAuditLog(ctx context.Context, req *connect.Request[kurtosis_engine_rpc_api_bindings.GetAuditLogArgs]) (*connect.Response[kurtosis_engine_rpc_api_bindings.GetAuditLogResponse], error) {
+	return c.getAuditLog.CallUnary(ctx, req)
+}
+
+// GetEnclaveCallerCredential calls engine_api.EngineService.GetEnclaveCallerCredential.
+func (c *engineServiceClient) GetEnclaveCallerCredential(ctx context.Context, req *connect.Request[kurtosis_engine_rpc_api_bindings.GetEnclaveCallerCredentialArgs]) (*connect.Response[kurtosis_engine_rpc_api_bindings.GetEnclaveCallerCredentialResponse], error) {
+	return c.getEnclaveCallerCredential.CallUnary(ctx, req)
+}
+
 // EngineServiceHandler is an implementation of the engine_api.EngineService service.
 type EngineServiceHandler interface {
 	// Endpoint for getting information about the engine, which is also what we use to verify that the engine has become available
@@ -217,8 +393,8 @@ type EngineServiceHandler interface {
 	// ==============================================================================================
 	// Creates a new Kurtosis Enclave
 	CreateEnclave(context.Context, *connect.Request[kurtosis_engine_rpc_api_bindings.CreateEnclaveArgs]) (*connect.Response[kurtosis_engine_rpc_api_bindings.CreateEnclaveResponse], error)
-	// Returns information about the requested enclaves or all enclaves if none specified.
-	GetEnclaves(context.Context, *connect.Request[emptypb.Empty]) (*connect.Response[kurtosis_engine_rpc_api_bindings.GetEnclavesResponse], error)
+	// Returns information about the enclaves matching the label selector, or all enclaves if none specified.
+	GetEnclaves(context.Context, *connect.Request[kurtosis_engine_rpc_api_bindings.GetEnclavesArgs]) (*connect.Response[kurtosis_engine_rpc_api_bindings.GetEnclavesResponse], error)
 	// Returns information about the requested enclaves or all enclaves if none specified.
 	GetEnclavesByUuids(context.Context, *connect.Request[kurtosis_engine_rpc_api_bindings.GetEnclavesByUuidsArgs]) (*connect.Response[kurtosis_engine_rpc_api_bindings.GetEnclavesResponse], error)
 	// Returns information about all existing & historical enclaves
@@ -227,10 +403,32 @@ type EngineServiceHandler interface {
 	StopEnclave(context.Context, *connect.Request[kurtosis_engine_rpc_api_bindings.StopEnclaveArgs]) (*connect.Response[emptypb.Empty], error)
 	// Destroys an enclave, removing all artifacts associated with it
 	DestroyEnclave(context.Context, *connect.Request[kurtosis_engine_rpc_api_bindings.DestroyEnclaveArgs]) (*connect.Response[emptypb.Empty], error)
+	// Makes an enclave expire after the given time-to-live, counting from now
+	ExtendEnclaveTtl(context.Context, *connect.Request[kurtosis_engine_rpc_api_bindings.ExtendEnclaveTtlArgs]) (*connect.Response[emptypb.Empty], error)
+	// Sets and removes labels of an enclave
+	UpdateEnclaveLabels(context.Context, *connect.Request[kurtosis_engine_rpc_api_bindings.UpdateEnclaveLabelsArgs]) (*connect.Response[emptypb.Empty], error)
+	// Changes the name of an existing enclave, keeping its UUID
+	RenameEnclave(context.Context, *connect.Request[kurtosis_engine_rpc_api_bindings.RenameEnclaveArgs]) (*connect.Response[emptypb.Empty], error)
+	// Creates a new enclave with the files artifacts, persistent directories and services of an existing one
+	CloneEnclave(context.Context, *connect.Request[kurtosis_engine_rpc_api_bindings.CloneEnclaveArgs]) (*connect.Response[kurtosis_engine_rpc_api_bindings.CloneEnclaveResponse], error)
+	// Streams an archive of an enclave, with its plan, files artifacts, persistent directories and mode, that ImportEnclave recreates it from
+	ExportEnclave(context.Context, *connect.Request[kurtosis_engine_rpc_api_bindings.ExportEnclaveArgs], *connect.ServerStream[kurtosis_engine_rpc_api_bindings.EnclaveArchiveChunk]) error
+	// Creates an enclave from an archive streamed by ExportEnclave, possibly on another engine
+	ImportEnclave(context.Context, *connect.ClientStream[kurtosis_engine_rpc_api_bindings.ImportEnclaveArgs]) (*connect.Response[kurtosis_engine_rpc_api_bindings.ImportEnclaveResponse], error)
 	// Gets rid of old enclaves
 	Clean(context.Context, *connect.Request[kurtosis_engine_rpc_api_bindings.CleanArgs]) (*connect.Response[kurtosis_engine_rpc_api_bindings.CleanResponse], error)
 	// Get service logs
 	GetServiceLogs(context.Context, *connect.Request[kurtosis_engine_rpc_api_bindings.GetServiceLogsArgs], *connect.ServerStream[kurtosis_engine_rpc_api_bindings.GetServiceLogsResponse]) error
+	// Streams what happens to the enclaves of the engine, starting from when the stream is opened
+	WatchEvents(context.Context, *connect.Request[kurtosis_engine_rpc_api_bindings.WatchEventsArgs], *connect.ServerStream[kurtosis_engine_rpc_api_bindings.EngineEvent]) error
+	// Creates an API token, only available when the engine enforces API authentication
+	CreateApiToken(context.Context, *connect.Request[kurtosis_engine_rpc_api_bindings.CreateApiTokenArgs]) (*connect.Response[kurtosis_engine_rpc_api_bindings.CreateApiTokenResponse], error)
+	// Revokes an API token so that it can't be used anymore
+	RevokeApiToken(context.Context, *connect.Request[kurtosis_engine_rpc_api_bindings.RevokeApiTokenArgs]) (*connect.Response[emptypb.Empty], error)
+	// Gets the changes made through the engine and the API containers of its running enclaves, newest first
+	GetAuditLog(context.Context, *connect.Request[kurtosis_engine_rpc_api_bindings.GetAuditLogArgs]) (*connect.Response[kurtosis_engine_rpc_api_bindings.GetAuditLogResponse], error)
+	// Gets a credential proving who the caller is to the API container of an enclave, so that the calls it makes to the API container directly are attributed to it in the audit log
+	GetEnclaveCallerCredential(context.Context, *connect.Request[kurtosis_engine_rpc_api_bindings.GetEnclaveCallerCredentialArgs]) (*connect.Response[kurtosis_engine_rpc_api_bindings.GetEnclaveCallerCredentialResponse], error)
 }
 
 // NewEngineServiceHandler builds an HTTP handler from the service implementation. It returns the
@@ -274,6 +472,36 @@ func NewEngineServiceHandler(svc EngineServiceHandler, opts ...connect.HandlerOp
 		svc.DestroyEnclave,
 		opts...,
 	)
+	engineServiceExtendEnclaveTtlHandler := connect.NewUnaryHandler(
+		EngineServiceExtendEnclaveTtlProcedure,
+		svc.ExtendEnclaveTtl,
+		opts...,
+	)
+	engineServiceUpdateEnclaveLabelsHandler := connect.NewUnaryHandler(
+		EngineServiceUpdateEnclaveLabelsProcedure,
+		svc.UpdateEnclaveLabels,
+		opts...,
+	)
+	engineServiceRenameEnclaveHandler := connect.NewUnaryHandler(
+		EngineServiceRenameEnclaveProcedure,
+		svc.RenameEnclave,
+		opts...,
+	)
+	engineServiceCloneEnclaveHandler := connect.NewUnaryHandler(
+		EngineServiceCloneEnclaveProcedure,
+		svc.CloneEnclave,
+		opts...,
+	)
+	engineServiceExportEnclaveHandler := connect.NewServerStreamHandler(
+		EngineServiceExportEnclaveProcedure,
+		svc.ExportEnclave,
+		opts...,
+	)
+	engineServiceImportEnclaveHandler := connect.NewClientStreamHandler(
+		EngineServiceImportEnclaveProcedure,
+		svc.ImportEnclave,
+		opts...,
+	)
 	engineServiceCleanHandler := connect.NewUnaryHandler(
 		EngineServiceCleanProcedure,
 		svc.Clean,
@@ -284,6 +512,31 @@ func NewEngineServiceHandler(svc EngineServiceHandler, opts ...connect.HandlerOp
 		svc.GetServiceLogs,
 		opts...,
 	)
+	engineServiceWatchEventsHandler := connect.NewServerStreamHandler(
+		EngineServiceWatchEventsProcedure,
+		svc.WatchEvents,
+		opts...,
+	)
+	engineServiceCreateApiTokenHandler := connect.NewUnaryHandler(
+		EngineServiceCreateApiTokenProcedure,
+		svc.CreateApiToken,
+		opts...,
+	)
+	engineServiceRevokeApiTokenHandler := connect.NewUnaryHandler(
+		EngineServiceRevokeApiTokenProcedure,
+		svc.RevokeApiToken,
+		opts...,
+	)
+	engineServiceGetAuditLogHandler := connect.NewUnaryHandler(
+		EngineServiceGetAuditLogProcedure,
+		svc.GetAuditLog,
+		opts...,
+	)
+	engineServiceGetEnclaveCallerCredentialHandler := connect.NewUnaryHandler(
+		EngineServiceGetEnclaveCallerCredentialProcedure,
+		svc.GetEnclaveCallerCredential,
+		opts...,
+	)
 	return "/engine_api.EngineService/", http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
 		switch r.URL.Path {
 		case EngineServiceGetEngineInfoProcedure:
@@ -300,10 +553,32 @@ func NewEngineServiceHandler(svc EngineServiceHandler, opts ...connect.HandlerOp
 			engineServiceStopEnclaveHandler.ServeHTTP(w, r)
 		case EngineServiceDestroyEnclaveProcedure:
 			engineServiceDestroyEnclaveHandler.ServeHTTP(w, r)
+		case EngineServiceExtendEnclaveTtlProcedure:
+			engineServiceExtendEnclaveTtlHandler.ServeHTTP(w, r)
+		case EngineServiceUpdateEnclaveLabelsProcedure:
+			engineServiceUpdateEnclaveLabelsHandler.ServeHTTP(w, r)
+		case EngineServiceRenameEnclaveProcedure:
+			engineServiceRenameEnclaveHandler.ServeHTTP(w, r)
+		case EngineServiceCloneEnclaveProcedure:
+			engineServiceCloneEnclaveHandler.ServeHTTP(w, r)
+		case EngineServiceExportEnclaveProcedure:
+			engineServiceExportEnclaveHandler.ServeHTTP(w, r)
+		case EngineServiceImportEnclaveProcedure:
+			engineServiceImportEnclaveHandler.ServeHTTP(w, r)
 		case EngineServiceCleanProcedure:
 			engineServiceCleanHandler.ServeHTTP(w, r)
 		case EngineServiceGetServiceLogsProcedure:
 			engineServiceGetServiceLogsHandler.ServeHTTP(w, r)
+		case EngineServiceWatchEventsProcedure:
+			engineServiceWatchEventsHandler.ServeHTTP(w, r)
+		case EngineServiceCreateApiTokenProcedure:
+			engineServiceCreateApiTokenHandler.ServeHTTP(w, r)
+		case EngineServiceRevokeApiTokenProcedure:
+			engineServiceRevokeApiTokenHandler.ServeHTTP(w, r)
+		case EngineServiceGetAuditLogProcedure:
+			engineServiceGetAuditLogHandler.ServeHTTP(w, r)
+		case EngineServiceGetEnclaveCallerCredentialProcedure:
+			engineServiceGetEnclaveCallerCredentialHandler.ServeHTTP(w, r)
 		default:
 			http.NotFound(w, r)
 		}
@@ -321,7 +596,7 @@ func (UnimplementedEngineServiceHandler) CreateEnclave(context.Context, *connect
 	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("engine_api.EngineService.CreateEnclave is not implemented"))
 }
 
-func (UnimplementedEngineServiceHandler) GetEnclaves(context.Context, *connect.Request[emptypb.Empty]) (*connect.Response[kurtosis_engine_rpc_api_bindings.GetEnclavesResponse], error) {
+func (UnimplementedEngineServiceHandler) GetEnclaves(context.Context, *connect.Request[kurtosis_engine_rpc_api_bindings.GetEnclavesArgs]) (*connect.Response[kurtosis_engine_rpc_api_bindings.GetEnclavesResponse], error) {
 	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("engine_api.EngineService.GetEnclaves is not implemented"))
 }
 
@@ -341,6 +616,30 @@ func (UnimplementedEngineServiceHandler) DestroyEnclave(context.Context, *connec
 	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("engine_api.EngineService.DestroyEnclave is not implemented"))
 }
 
+func (UnimplementedEngineServiceHandler) ExtendEnclaveTtl(context.Context, *connect.Request[kurtosis_engine_rpc_api_bindings.ExtendEnclaveTtlArgs]) (*connect.Response[emptypb.Empty], error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("engine_api.EngineService.ExtendEnclaveTtl is not implemented"))
+}
+
+func (UnimplementedEngineServiceHandler) UpdateEnclaveLabels(context.Context, *connect.Request[kurtosis_engine_rpc_api_bindings.UpdateEnclaveLabelsArgs]) (*connect.Response[emptypb.Empty], error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("engine_api.EngineService.UpdateEnclaveLabels is not implemented"))
+}
+
+func (UnimplementedEngineServiceHandler) RenameEnclave(context.Context, *connect.Request[kurtosis_engine_rpc_api_bindings.RenameEnclaveArgs]) (*connect.Response[emptypb.Empty], error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("engine_api.EngineService.RenameEnclave is not implemented"))
+}
+
+func (UnimplementedEngineServiceHandler) CloneEnclave(context.Context, *connect.Request[kurtosis_engine_rpc_api_bindings.CloneEnclaveArgs]) (*connect.Response[kurtosis_engine_rpc_api_bindings.CloneEnclaveResponse], error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("engine_api.EngineService.CloneEnclave is not implemented"))
+}
+
+func (UnimplementedEngineServiceHandler) ExportEnclave(context.Context, *connect.Request[kurtosis_engine_rpc_api_bindings.ExportEnclaveArgs], *connect.ServerStream[kurtosis_engine_rpc_api_bindings.EnclaveArchiveChunk]) error {
+	return connect.NewError(connect.CodeUnimplemented, errors.New("engine_api.EngineService.ExportEnclave is not implemented"))
+}
+
+func (UnimplementedEngineServiceHandler) ImportEnclave(context.Context, *connect.ClientStream[kurtosis_engine_rpc_api_bindings.ImportEnclaveArgs]) (*connect.Response[kurtosis_engine_rpc_api_bindings.ImportEnclaveResponse], error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("engine_api.EngineService.ImportEnclave is not implemented"))
+}
+
 func (UnimplementedEngineServiceHandler) Clean(context.Context, *connect.Request[kurtosis_engine_rpc_api_bindings.CleanArgs]) (*connect.Response[kurtosis_engine_rpc_api_bindings.CleanResponse], error) {
 	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("engine_api.EngineService.Clean is not implemented"))
 }
@@ -348,3 +647,23 @@ func (UnimplementedEngineServiceHandler) Clean(context.Context, *connect.Request
 func (UnimplementedEngineServiceHandler) GetServiceLogs(context.Context, *connect.Request[kurtosis_engine_rpc_api_bindings.GetServiceLogsArgs], *connect.ServerStream[kurtosis_engine_rpc_api_bindings.GetServiceLogsResponse]) error {
 	return connect.NewError(connect.CodeUnimplemented, errors.New("engine_api.EngineService.GetServiceLogs is not implemented"))
 }
+
+func (UnimplementedEngineServiceHandler) WatchEvents(context.Context, *connect.Request[kurtosis_engine_rpc_api_bindings.WatchEventsArgs], *connect.ServerStream[kurtosis_engine_rpc_api_bindings.EngineEvent]) error {
+	return connect.NewError(connect.CodeUnimplemented, errors.New("engine_api.EngineService.WatchEvents is not implemented"))
+}
+
+func (UnimplementedEngineServiceHandler) CreateApiToken(context.Context, *connect.Request[kurtosis_engine_rpc_api_bindings.CreateApiTokenArgs]) (*connect.Response[kurtosis_engine_rpc_api_bindings.CreateApiTokenResponse], error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("engine_api.EngineService.CreateApiToken is not implemented"))
+}
+
+func (UnimplementedEngineServiceHandler) RevokeApiToken(context.Context, *connect.Request[kurtosis_engine_rpc_api_bindings.RevokeApiTokenArgs]) (*connect.Response[emptypb.Empty], error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("engine_api.EngineService.RevokeApiToken is not implemented"))
+}
+
+func (UnimplementedEngineServiceHandler) GetAuditLog(context.Context, *connect.Request[kurtosis_engine_rpc_api_bindings.GetAuditLogArgs]) (*connect.Response[kurtosis_engine_rpc_api_bindings.GetAuditLogResponse], error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("engine_api.EngineService.GetAuditLog is not implemented"))
+}
+
+func (UnimplementedEngineServiceHandler) GetEnclaveCallerCredential(context.Context, *connect.Request[kurtosis_engine_rpc_api_bindings.GetEnclaveCallerCredentialArgs]) (*connect.Response[kurtosis_engine_rpc_api_bindings.GetEnclaveCallerCredentialResponse], error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("engine_api.EngineService.GetEnclaveCallerCredential is not implemented"))
+}
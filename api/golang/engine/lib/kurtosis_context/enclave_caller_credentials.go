@@ -0,0 +1,78 @@
+package kurtosis_context
+
+import (
+	"context"
+	"sync"
+	"time"
+
+	"github.com/kurtosis-tech/kurtosis/api/golang/core/lib/shared_utils"
+	"github.com/kurtosis-tech/kurtosis/api/golang/engine/kurtosis_engine_rpc_api_bindings"
+	"github.com/sirupsen/logrus"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+const (
+	// The credential gets renewed that long before it expires, so that it doesn't expire while a call is on its way
+	enclaveCallerCredentialRenewalMargin = time.Minute
+)
+
+// WithEnclaveCallerCredential makes the connection to the API container of the enclave send the credential the engine
+// issues to prove who the caller is, so that the calls made to the API container directly are attributed to the API
+// token in the enclave audit log rather than recorded as coming from an unverified caller
+func WithEnclaveCallerCredential(engineClient kurtosis_engine_rpc_api_bindings.EngineServiceClient, enclaveUuid string) grpc.DialOption {
+	return grpc.WithPerRPCCredentials(&enclaveCallerCredentials{
+		engineClient:   engineClient,
+		enclaveUuid:    enclaveUuid,
+		mutex:          &sync.Mutex{},
+		credential:     "",
+		expirationTime: time.Time{},
+		isUnsupported:  false,
+	})
+}
+
+type enclaveCallerCredentials struct {
+	engineClient kurtosis_engine_rpc_api_bindings.EngineServiceClient
+
+	enclaveUuid string
+
+	mutex *sync.Mutex
+
+	credential     string
+	expirationTime time.Time
+
+	// Set once an engine predating the caller credentials got asked for one
+	isUnsupported bool
+}
+
+// A credential that can't be gotten doesn't fail the call, which the API container then records as coming from an
+// unverified caller
+func (credentials *enclaveCallerCredentials) GetRequestMetadata(ctx context.Context, _ ...string) (map[string]string, error) {
+	credentials.mutex.Lock()
+	defer credentials.mutex.Unlock()
+
+	if credentials.isUnsupported {
+		return nil, nil
+	}
+	if time.Until(credentials.expirationTime) < enclaveCallerCredentialRenewalMargin {
+		response, err := credentials.engineClient.GetEnclaveCallerCredential(ctx, &kurtosis_engine_rpc_api_bindings.GetEnclaveCallerCredentialArgs{
+			EnclaveIdentifier: credentials.enclaveUuid,
+		})
+		if err != nil {
+			if status.Code(err) == codes.Unimplemented {
+				credentials.isUnsupported = true
+			}
+			logrus.Debugf("Couldn't get the credential proving who the caller is to the API container of enclave '%v', the calls to it are recorded as coming from an unverified caller. Error was:\n%v", credentials.enclaveUuid, err)
+			return nil, nil
+		}
+		credentials.credential = response.GetCredential()
+		credentials.expirationTime = response.GetExpirationTime().AsTime()
+	}
+	return map[string]string{shared_utils.AuditLogCallerCredentialMetadataKey: credentials.credential}, nil
+}
+
+// The API container is reached over plaintext, as all the other Kurtosis connections
+func (credentials *enclaveCallerCredentials) RequireTransportSecurity() bool {
+	return false
+}
@@ -0,0 +1,67 @@
+package kurtosis_context
+
+import (
+	"context"
+	"errors"
+	"io/fs"
+	"os"
+	"strings"
+
+	"github.com/adrg/xdg"
+	"github.com/kurtosis-tech/stacktrace"
+	"google.golang.org/grpc"
+)
+
+const (
+	// EngineApiTokenEnvVar holds the API token to authenticate to the engine with, it takes precedence over the token
+	// stored by the Kurtosis CLI
+	EngineApiTokenEnvVar = "KURTOSIS_API_TOKEN"
+
+	// Where the Kurtosis CLI stores its engine API token, relative to the XDG state directory
+	cliEngineApiTokenXdgRelFilepath = "kurtosis/engine-api-token"
+
+	authorizationMetadataKey = "authorization"
+	bearerPrefix             = "Bearer "
+)
+
+// GetEngineApiToken returns the API token to authenticate to the engine with, or an empty string if there's none,
+// which is fine for the engines that don't enforce API authentication
+func GetEngineApiToken() (string, error) {
+	if token := os.Getenv(EngineApiTokenEnvVar); token != "" {
+		return token, nil
+	}
+	tokenFilepath, err := xdg.SearchStateFile(cliEngineApiTokenXdgRelFilepath)
+	if err != nil {
+		// The CLI didn't store any token
+		return "", nil
+	}
+	tokenBytes, err := os.ReadFile(tokenFilepath)
+	if err != nil {
+		if errors.Is(err, fs.ErrNotExist) {
+			return "", nil
+		}
+		return "", stacktrace.Propagate(err, "An error occurred reading the engine API token stored by the Kurtosis CLI at '%v'", tokenFilepath)
+	}
+	return strings.TrimSpace(string(tokenBytes)), nil
+}
+
+// WithEngineApiToken makes the connection send the API token along with every call, or does nothing if the token is empty
+func WithEngineApiToken(token string) grpc.DialOption {
+	if token == "" {
+		return grpc.EmptyDialOption{}
+	}
+	return grpc.WithPerRPCCredentials(engineApiTokenCredentials{token: token})
+}
+
+type engineApiTokenCredentials struct {
+	token string
+}
+
+func (credentials engineApiTokenCredentials) GetRequestMetadata(_ context.Context, _ ...string) (map[string]string, error) {
+	return map[string]string{authorizationMetadataKey: bearerPrefix + credentials.token}, nil
+}
+
+// The engine is reached over plaintext, as all the other Kurtosis connections
+func (credentials engineApiTokenCredentials) RequireTransportSecurity() bool {
+	return false
+}
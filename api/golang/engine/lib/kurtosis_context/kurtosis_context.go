@@ -4,6 +4,7 @@ import (
 	"context"
 	"fmt"
 	"io"
+	"time"
 
 	"github.com/Masterminds/semver/v3"
 	portal_constructors "github.com/kurtosis-tech/kurtosis-portal/api/golang/constructors"
@@ -38,6 +39,8 @@ const (
 
 	validUuidMatchesAllowed = 1
 
+	enclaveArchiveChunkSizeBytes = 1024 * 1024
+
 	portalIsRequired = true
 
 	defaultShouldAPICRunInDebugMode = false
@@ -72,8 +75,13 @@ func NewKurtosisContextFromLocalEngine() (*KurtosisContext, error) {
 	ctx := context.Background()
 	kurtosisEngineSocketStr := fmt.Sprintf("%v:%v", localHostIPAddressStr, DefaultGrpcEngineServerPortNum)
 
+	engineApiToken, err := GetEngineApiToken()
+	if err != nil {
+		return nil, stacktrace.Propagate(err, "An error occurred getting the API token to authenticate to the Kurtosis Engine Server with")
+	}
+
 	// TODO SECURITY: Use HTTPS to ensure we're connecting to the real Kurtosis API servers
-	conn, err := grpc.Dial(kurtosisEngineSocketStr, grpc.WithTransportCredentials(insecure.NewCredentials()), grpc.WithDefaultCallOptions(grpc.MaxCallRecvMsgSize(hundredMegabytes)))
+	conn, err := grpc.Dial(kurtosisEngineSocketStr, grpc.WithTransportCredentials(insecure.NewCredentials()), grpc.WithDefaultCallOptions(grpc.MaxCallRecvMsgSize(hundredMegabytes)), WithEngineApiToken(engineApiToken))
 	if err != nil {
 		return nil, stacktrace.Propagate(
 			err,
@@ -117,7 +125,7 @@ func (kurtosisCtx *KurtosisContext) CreateEnclave(ctx context.Context, enclaveNa
 		return nil, stacktrace.Propagate(err, "An error occurred creating an enclave with name '%v'", enclaveName)
 	}
 
-	enclaveContext, err := newEnclaveContextFromEnclaveInfo(ctx, kurtosisCtx.portalClient, response.EnclaveInfo)
+	enclaveContext, err := newEnclaveContextFromEnclaveInfo(ctx, kurtosisCtx.engineClient, kurtosisCtx.portalClient, response.EnclaveInfo)
 	if err != nil {
 		return nil, stacktrace.Propagate(err, "An error occurred creating an enclave context from a newly-created enclave; this should never happen")
 	}
@@ -137,7 +145,7 @@ func (kurtosisCtx *KurtosisContext) CreateEnclaveWithDebugEnabled(
 		return nil, stacktrace.Propagate(err, "An error occurred creating an enclave with name '%v'", enclaveName)
 	}
 
-	enclaveContext, err := newEnclaveContextFromEnclaveInfo(ctx, kurtosisCtx.portalClient, response.EnclaveInfo)
+	enclaveContext, err := newEnclaveContextFromEnclaveInfo(ctx, kurtosisCtx.engineClient, kurtosisCtx.portalClient, response.EnclaveInfo)
 	if err != nil {
 		return nil, stacktrace.Propagate(err, "An error occurred creating an enclave context from a newly-created enclave; this should never happen")
 	}
@@ -154,7 +162,7 @@ func (kurtosisCtx *KurtosisContext) CreateProductionEnclave(ctx context.Context,
 		return nil, stacktrace.Propagate(err, "An error occurred creating an enclave with name '%v'", enclaveName)
 	}
 
-	enclaveContext, err := newEnclaveContextFromEnclaveInfo(ctx, kurtosisCtx.portalClient, response.EnclaveInfo)
+	enclaveContext, err := newEnclaveContextFromEnclaveInfo(ctx, kurtosisCtx.engineClient, kurtosisCtx.portalClient, response.EnclaveInfo)
 	if err != nil {
 		return nil, stacktrace.Propagate(err, "An error occurred creating an enclave context from a newly-created enclave; this should never happen")
 	}
@@ -171,7 +179,92 @@ func (kurtosisCtx *KurtosisContext) CreateProductionEnclaveWithDebugEnabled(ctx
 		return nil, stacktrace.Propagate(err, "An error occurred creating an enclave with name '%v'", enclaveName)
 	}
 
-	enclaveContext, err := newEnclaveContextFromEnclaveInfo(ctx, kurtosisCtx.portalClient, response.EnclaveInfo)
+	enclaveContext, err := newEnclaveContextFromEnclaveInfo(ctx, kurtosisCtx.engineClient, kurtosisCtx.portalClient, response.EnclaveInfo)
+	if err != nil {
+		return nil, stacktrace.Propagate(err, "An error occurred creating an enclave context from a newly-created enclave; this should never happen")
+	}
+
+	return enclaveContext, nil
+}
+
+// CreateEnclaveWithExpiration creates an enclave that gets stopped or destroyed, depending on the expired enclave action, once
+// the given time-to-live has elapsed or once it has been idle for longer than the given idle timeout
+// A zero time-to-live or idle timeout means the enclave doesn't expire for that reason
+func (kurtosisCtx *KurtosisContext) CreateEnclaveWithExpiration(
+	ctx context.Context,
+	enclaveName string,
+	isProduction bool,
+	ttl time.Duration,
+	idleTimeout time.Duration,
+	expiredEnclaveAction kurtosis_engine_rpc_api_bindings.ExpiredEnclaveAction,
+) (*enclaves.EnclaveContext, error) {
+
+	createEnclaveArgs := newCreateEnclaveArgsWithDefaultValues(enclaveName)
+	if isProduction {
+		createEnclaveArgs = newCreateProductionEnclaveWithDefaultValues(enclaveName)
+	}
+	if ttl > 0 {
+		ttlSeconds := uint32(ttl.Seconds())
+		createEnclaveArgs.TtlSeconds = &ttlSeconds
+	}
+	if idleTimeout > 0 {
+		idleTimeoutSeconds := uint32(idleTimeout.Seconds())
+		createEnclaveArgs.IdleTimeoutSeconds = &idleTimeoutSeconds
+	}
+	createEnclaveArgs.ExpiredEnclaveAction = &expiredEnclaveAction
+
+	response, err := kurtosisCtx.engineClient.CreateEnclave(ctx, createEnclaveArgs)
+	if err != nil {
+		return nil, stacktrace.Propagate(err, "An error occurred creating an enclave with name '%v'", enclaveName)
+	}
+
+	enclaveContext, err := newEnclaveContextFromEnclaveInfo(ctx, kurtosisCtx.engineClient, kurtosisCtx.portalClient, response.EnclaveInfo)
+	if err != nil {
+		return nil, stacktrace.Propagate(err, "An error occurred creating an enclave context from a newly-created enclave; this should never happen")
+	}
+
+	return enclaveContext, nil
+}
+
+// CreateEnclaveWithLabels creates an enclave with the given key/value labels, which can be used to select it later
+func (kurtosisCtx *KurtosisContext) CreateEnclaveWithLabels(ctx context.Context, enclaveName string, labels map[string]string) (*enclaves.EnclaveContext, error) {
+
+	createEnclaveArgs := newCreateEnclaveArgsWithDefaultValues(enclaveName)
+	createEnclaveArgs.Labels = labels
+
+	response, err := kurtosisCtx.engineClient.CreateEnclave(ctx, createEnclaveArgs)
+	if err != nil {
+		return nil, stacktrace.Propagate(err, "An error occurred creating an enclave with name '%v'", enclaveName)
+	}
+
+	enclaveContext, err := newEnclaveContextFromEnclaveInfo(ctx, kurtosisCtx.engineClient, kurtosisCtx.portalClient, response.EnclaveInfo)
+	if err != nil {
+		return nil, stacktrace.Propagate(err, "An error occurred creating an enclave context from a newly-created enclave; this should never happen")
+	}
+
+	return enclaveContext, nil
+}
+
+// CreateEnclaveWithSeedPackage creates an enclave where the given package, with the given serialized JSON params, already
+// ran. The enclave is handed out right away if the engine's warm pool was pre-seeded with the same package and params;
+// otherwise, the engine runs the package in the new enclave before returning it
+func (kurtosisCtx *KurtosisContext) CreateEnclaveWithSeedPackage(
+	ctx context.Context,
+	enclaveName string,
+	seedPackageLocator string,
+	serializedSeedPackageParams string,
+) (*enclaves.EnclaveContext, error) {
+
+	createEnclaveArgs := newCreateEnclaveArgsWithDefaultValues(enclaveName)
+	createEnclaveArgs.SeedPackageLocator = &seedPackageLocator
+	createEnclaveArgs.SeedPackageParams = &serializedSeedPackageParams
+
+	response, err := kurtosisCtx.engineClient.CreateEnclave(ctx, createEnclaveArgs)
+	if err != nil {
+		return nil, stacktrace.Propagate(err, "An error occurred creating an enclave with name '%v' seeded with package '%v'", enclaveName, seedPackageLocator)
+	}
+
+	enclaveContext, err := newEnclaveContextFromEnclaveInfo(ctx, kurtosisCtx.engineClient, kurtosisCtx.portalClient, response.EnclaveInfo)
 	if err != nil {
 		return nil, stacktrace.Propagate(err, "An error occurred creating an enclave context from a newly-created enclave; this should never happen")
 	}
@@ -185,7 +278,7 @@ func (kurtosisCtx *KurtosisContext) GetEnclaveContext(ctx context.Context, encla
 		return nil, stacktrace.Propagate(err, "An error occurred while getting enclave with identifier '%v'", enclaveIdentifier)
 	}
 
-	enclaveCtx, err := newEnclaveContextFromEnclaveInfo(ctx, kurtosisCtx.portalClient, enclaveInfo)
+	enclaveCtx, err := newEnclaveContextFromEnclaveInfo(ctx, kurtosisCtx.engineClient, kurtosisCtx.portalClient, enclaveInfo)
 	if err != nil {
 		return nil, stacktrace.Propagate(err, "An error occurred creating an enclave context from the returned enclave info")
 	}
@@ -194,7 +287,7 @@ func (kurtosisCtx *KurtosisContext) GetEnclaveContext(ctx context.Context, encla
 }
 
 func (kurtosisCtx *KurtosisContext) GetEnclaveContextFromEnclaveInfo(ctx context.Context, enclaveInfo *kurtosis_engine_rpc_api_bindings.EnclaveInfo) (*enclaves.EnclaveContext, error) {
-	enclaveCtx, err := newEnclaveContextFromEnclaveInfo(ctx, kurtosisCtx.portalClient, enclaveInfo)
+	enclaveCtx, err := newEnclaveContextFromEnclaveInfo(ctx, kurtosisCtx.engineClient, kurtosisCtx.portalClient, enclaveInfo)
 	if err != nil {
 		return nil, stacktrace.Propagate(err, "An error occurred creating an enclave context from the provided enclave info")
 	}
@@ -212,20 +305,19 @@ func (kurtosisCtx *KurtosisContext) GetEnclaves(ctx context.Context) (*Enclaves,
 		)
 	}
 
-	enclavesByUuid := map[string]*kurtosis_engine_rpc_api_bindings.EnclaveInfo{}
-	enclavesByName := map[string][]*kurtosis_engine_rpc_api_bindings.EnclaveInfo{}
-	enclavesByShortenedUuid := map[string][]*kurtosis_engine_rpc_api_bindings.EnclaveInfo{}
-	for enclaveUuid, enclaveInfo := range response.EnclaveInfo {
-		enclavesByUuid[enclaveUuid] = response.EnclaveInfo[enclaveUuid]
-		enclavesByName[enclaveInfo.Name] = append(enclavesByShortenedUuid[enclaveInfo.GetName()], response.EnclaveInfo[enclaveUuid])
-		enclavesByShortenedUuid[enclaveInfo.ShortenedUuid] = append(enclavesByShortenedUuid[enclaveInfo.ShortenedUuid], response.EnclaveInfo[enclaveUuid])
+	return newEnclavesFromEnclaveInfos(response.EnclaveInfo), nil
+}
+
+// GetEnclavesMatchingLabels returns the enclaves that have all the labels of the selector, an empty selector matching them all
+func (kurtosisCtx *KurtosisContext) GetEnclavesMatchingLabels(ctx context.Context, labelSelector map[string]string) (*Enclaves, error) {
+	response, err := kurtosisCtx.engineClient.GetEnclaves(ctx, &kurtosis_engine_rpc_api_bindings.GetEnclavesArgs{
+		LabelSelector: labelSelector,
+	})
+	if err != nil {
+		return nil, stacktrace.Propagate(err, "An error occurred getting the enclaves matching label selector '%+v'", labelSelector)
 	}
 
-	return &Enclaves{
-		enclavesByUuid:          enclavesByUuid,
-		enclavesByName:          enclavesByName,
-		enclavesByShortenedUuid: enclavesByShortenedUuid,
-	}, nil
+	return newEnclavesFromEnclaveInfos(response.EnclaveInfo), nil
 }
 
 func (kurtosisCtx *KurtosisContext) GetEnclave(ctx context.Context, enclaveIdentifier string) (*kurtosis_engine_rpc_api_bindings.EnclaveInfo, error) {
@@ -298,6 +390,143 @@ func (kurtosisCtx *KurtosisContext) DestroyEnclave(ctx context.Context, enclaveI
 	return nil
 }
 
+// ExtendEnclaveTtl makes the enclave expire once the given time-to-live has elapsed, counting from now
+func (kurtosisCtx *KurtosisContext) ExtendEnclaveTtl(ctx context.Context, enclaveIdentifier string, ttl time.Duration) error {
+	extendEnclaveTtlArgs := &kurtosis_engine_rpc_api_bindings.ExtendEnclaveTtlArgs{
+		EnclaveIdentifier: enclaveIdentifier,
+		TtlSeconds:        uint32(ttl.Seconds()),
+	}
+
+	if _, err := kurtosisCtx.engineClient.ExtendEnclaveTtl(ctx, extendEnclaveTtlArgs); err != nil {
+		return stacktrace.Propagate(err, "An error occurred extending the time-to-live of enclave with identifier '%v'", enclaveIdentifier)
+	}
+
+	return nil
+}
+
+// UpdateEnclaveLabels sets and removes labels of the enclave, leaving its other labels untouched
+func (kurtosisCtx *KurtosisContext) UpdateEnclaveLabels(ctx context.Context, enclaveIdentifier string, labelsToSet map[string]string, labelsToRemove []string) error {
+	updateEnclaveLabelsArgs := &kurtosis_engine_rpc_api_bindings.UpdateEnclaveLabelsArgs{
+		EnclaveIdentifier: enclaveIdentifier,
+		LabelsToSet:       labelsToSet,
+		LabelsToRemove:    labelsToRemove,
+	}
+
+	if _, err := kurtosisCtx.engineClient.UpdateEnclaveLabels(ctx, updateEnclaveLabelsArgs); err != nil {
+		return stacktrace.Propagate(err, "An error occurred updating the labels of enclave with identifier '%v'", enclaveIdentifier)
+	}
+
+	return nil
+}
+
+// RenameEnclave changes the name of the enclave, which keeps its UUID
+func (kurtosisCtx *KurtosisContext) RenameEnclave(ctx context.Context, enclaveIdentifier string, newEnclaveName string) error {
+	renameEnclaveArgs := &kurtosis_engine_rpc_api_bindings.RenameEnclaveArgs{
+		EnclaveIdentifier: enclaveIdentifier,
+		NewName:           newEnclaveName,
+	}
+
+	if _, err := kurtosisCtx.engineClient.RenameEnclave(ctx, renameEnclaveArgs); err != nil {
+		return stacktrace.Propagate(err, "An error occurred renaming enclave with identifier '%v' to '%v'", enclaveIdentifier, newEnclaveName)
+	}
+
+	return nil
+}
+
+// CloneEnclave creates a new enclave with the files artifacts, persistent directories and services of an existing one.
+// Along with the clone, it returns descriptions of what couldn't be cloned and of what may differ from the existing
+// enclave. If the new enclave name is blank, a random one is used. Unless allowPartial is set, the enclave doesn't get
+// cloned when some instructions of its plan can't be replayed
+func (kurtosisCtx *KurtosisContext) CloneEnclave(ctx context.Context, enclaveIdentifier string, newEnclaveName string, allowPartial bool) (*enclaves.EnclaveContext, []string, []string, error) {
+	cloneEnclaveArgs := &kurtosis_engine_rpc_api_bindings.CloneEnclaveArgs{
+		EnclaveIdentifier: enclaveIdentifier,
+		EnclaveName:       &newEnclaveName,
+		AllowPartial:      &allowPartial,
+	}
+
+	response, err := kurtosisCtx.engineClient.CloneEnclave(ctx, cloneEnclaveArgs)
+	if err != nil {
+		return nil, nil, nil, stacktrace.Propagate(err, "An error occurred cloning enclave with identifier '%v'", enclaveIdentifier)
+	}
+
+	enclaveContext, err := newEnclaveContextFromEnclaveInfo(ctx, kurtosisCtx.engineClient, kurtosisCtx.portalClient, response.EnclaveInfo)
+	if err != nil {
+		return nil, nil, nil, stacktrace.Propagate(err, "An error occurred creating an enclave context from a newly-cloned enclave; this should never happen")
+	}
+
+	return enclaveContext, response.UncloneableItems, response.Warnings, nil
+}
+
+// ExportEnclave writes an archive of a running enclave to destination, which ImportEnclave can recreate the enclave from
+// on another engine
+func (kurtosisCtx *KurtosisContext) ExportEnclave(ctx context.Context, enclaveIdentifier string, destination io.Writer) error {
+	exportEnclaveArgs := &kurtosis_engine_rpc_api_bindings.ExportEnclaveArgs{
+		EnclaveIdentifier: enclaveIdentifier,
+	}
+	stream, err := kurtosisCtx.engineClient.ExportEnclave(ctx, exportEnclaveArgs)
+	if err != nil {
+		return stacktrace.Propagate(err, "An error occurred exporting enclave with identifier '%v'", enclaveIdentifier)
+	}
+	for {
+		archiveChunk, err := stream.Recv()
+		if err == io.EOF {
+			return nil
+		}
+		if err != nil {
+			return stacktrace.Propagate(err, "An error occurred receiving the archive of enclave with identifier '%v'", enclaveIdentifier)
+		}
+		if _, err := destination.Write(archiveChunk.GetData()); err != nil {
+			return stacktrace.Propagate(err, "An error occurred writing the archive of enclave with identifier '%v'", enclaveIdentifier)
+		}
+	}
+}
+
+// ImportEnclave creates an enclave from an archive written by ExportEnclave, named newEnclaveName or after the exported
+// enclave if blank. Besides the new enclave, it returns a description of everything that couldn't be carried over.
+func (kurtosisCtx *KurtosisContext) ImportEnclave(ctx context.Context, archive io.Reader, newEnclaveName string) (*enclaves.EnclaveContext, []string, error) {
+	stream, err := kurtosisCtx.engineClient.ImportEnclave(ctx)
+	if err != nil {
+		return nil, nil, stacktrace.Propagate(err, "An error occurred starting to import an enclave archive")
+	}
+	chunk := make([]byte, enclaveArchiveChunkSizeBytes)
+	isFirstChunk := true
+	for {
+		numBytesRead, readErr := io.ReadFull(archive, chunk)
+		if readErr != nil && readErr != io.EOF && readErr != io.ErrUnexpectedEOF {
+			return nil, nil, stacktrace.Propagate(readErr, "An error occurred reading the enclave archive")
+		}
+		// The enclave name only gets read from the first message, which is sent even if the archive is empty
+		if numBytesRead > 0 || isFirstChunk {
+			importEnclaveArgs := &kurtosis_engine_rpc_api_bindings.ImportEnclaveArgs{
+				EnclaveName: nil,
+				Data:        chunk[:numBytesRead],
+			}
+			if isFirstChunk {
+				importEnclaveArgs.EnclaveName = &newEnclaveName
+			}
+			if err := stream.Send(importEnclaveArgs); err != nil {
+				return nil, nil, stacktrace.Propagate(err, "An error occurred sending the enclave archive")
+			}
+			isFirstChunk = false
+		}
+		if readErr != nil {
+			break
+		}
+	}
+
+	response, err := stream.CloseAndRecv()
+	if err != nil {
+		return nil, nil, stacktrace.Propagate(err, "An error occurred importing the enclave archive")
+	}
+
+	enclaveContext, err := newEnclaveContextFromEnclaveInfo(ctx, kurtosisCtx.engineClient, kurtosisCtx.portalClient, response.EnclaveInfo)
+	if err != nil {
+		return nil, nil, stacktrace.Propagate(err, "An error occurred creating an enclave context from a newly-imported enclave; this should never happen")
+	}
+
+	return enclaveContext, response.UnimportableItems, nil
+}
+
 func (kurtosisCtx *KurtosisContext) Clean(ctx context.Context, shouldCleanAll bool) ([]*kurtosis_engine_rpc_api_bindings.EnclaveNameAndUuid, error) {
 	cleanArgs := &kurtosis_engine_rpc_api_bindings.CleanArgs{
 		ShouldCleanAll: &shouldCleanAll,
@@ -310,6 +539,42 @@ func (kurtosisCtx *KurtosisContext) Clean(ctx context.Context, shouldCleanAll bo
 	return cleanResponse.RemovedEnclaveNameAndUuids, nil
 }
 
+// CleanMatchingLabels is like Clean but only removes the enclaves that have all the labels of the selector
+func (kurtosisCtx *KurtosisContext) CleanMatchingLabels(ctx context.Context, shouldCleanAll bool, labelSelector map[string]string) ([]*kurtosis_engine_rpc_api_bindings.EnclaveNameAndUuid, error) {
+	cleanArgs := &kurtosis_engine_rpc_api_bindings.CleanArgs{
+		ShouldCleanAll: &shouldCleanAll,
+		LabelSelector:  labelSelector,
+	}
+	cleanResponse, err := kurtosisCtx.engineClient.Clean(ctx, cleanArgs)
+	if err != nil {
+		return nil, stacktrace.Propagate(err, "An error occurred when trying to perform a clean with the clean-all arg set to '%v' and label selector '%+v'", shouldCleanAll, labelSelector)
+	}
+
+	return cleanResponse.RemovedEnclaveNameAndUuids, nil
+}
+
+// GetAuditLog returns a page of the audit log, newest entries first, starting after the given page token or at the
+// newest entry if it's empty. An empty enclave identifier returns the entries of every enclave and those of the engine,
+// and a page size of 0 uses the engine's default
+func (kurtosisCtx *KurtosisContext) GetAuditLog(ctx context.Context, enclaveIdentifier string, pageSize uint32, pageToken string) (*kurtosis_engine_rpc_api_bindings.GetAuditLogResponse, error) {
+	getAuditLogArgs := &kurtosis_engine_rpc_api_bindings.GetAuditLogArgs{
+		EnclaveIdentifier: nil,
+		PageSize:          &pageSize,
+		PageToken:         nil,
+	}
+	if enclaveIdentifier != "" {
+		getAuditLogArgs.EnclaveIdentifier = &enclaveIdentifier
+	}
+	if pageToken != "" {
+		getAuditLogArgs.PageToken = &pageToken
+	}
+	response, err := kurtosisCtx.engineClient.GetAuditLog(ctx, getAuditLogArgs)
+	if err != nil {
+		return nil, stacktrace.Propagate(err, "An error occurred getting the audit log")
+	}
+	return response, nil
+}
+
 func (kurtosisCtx *KurtosisContext) GetServiceLogs(
 	ctx context.Context,
 	enclaveIdentifier string,
@@ -420,8 +685,26 @@ func runReceiveStreamLogsFromTheServerRoutine(
 	}
 }
 
+func newEnclavesFromEnclaveInfos(enclaveInfos map[string]*kurtosis_engine_rpc_api_bindings.EnclaveInfo) *Enclaves {
+	enclavesByUuid := map[string]*kurtosis_engine_rpc_api_bindings.EnclaveInfo{}
+	enclavesByName := map[string][]*kurtosis_engine_rpc_api_bindings.EnclaveInfo{}
+	enclavesByShortenedUuid := map[string][]*kurtosis_engine_rpc_api_bindings.EnclaveInfo{}
+	for enclaveUuid, enclaveInfo := range enclaveInfos {
+		enclavesByUuid[enclaveUuid] = enclaveInfos[enclaveUuid]
+		enclavesByName[enclaveInfo.Name] = append(enclavesByShortenedUuid[enclaveInfo.GetName()], enclaveInfos[enclaveUuid])
+		enclavesByShortenedUuid[enclaveInfo.ShortenedUuid] = append(enclavesByShortenedUuid[enclaveInfo.ShortenedUuid], enclaveInfos[enclaveUuid])
+	}
+
+	return &Enclaves{
+		enclavesByUuid:          enclavesByUuid,
+		enclavesByName:          enclavesByName,
+		enclavesByShortenedUuid: enclavesByShortenedUuid,
+	}
+}
+
 func newEnclaveContextFromEnclaveInfo(
 	ctx context.Context,
+	engineClient kurtosis_engine_rpc_api_bindings.EngineServiceClient,
 	portalClient portal_api.KurtosisPortalClientClient,
 	enclaveInfo *kurtosis_engine_rpc_api_bindings.EnclaveInfo,
 ) (*enclaves.EnclaveContext, error) {
@@ -471,7 +754,7 @@ func newEnclaveContextFromEnclaveInfo(
 		apiContainerHostMachineInfo.GrpcPortOnHostMachine,
 	)
 	// TODO SECURITY: use HTTPS!
-	apiContainerConn, err := grpc.Dial(apiContainerHostMachineUrl, grpc.WithTransportCredentials(insecure.NewCredentials()), grpc.WithDefaultCallOptions(grpc.MaxCallRecvMsgSize(hundredMegabytes)))
+	apiContainerConn, err := grpc.Dial(apiContainerHostMachineUrl, grpc.WithTransportCredentials(insecure.NewCredentials()), grpc.WithDefaultCallOptions(grpc.MaxCallRecvMsgSize(hundredMegabytes)), WithEnclaveCallerCredential(engineClient, enclaveInfo.GetEnclaveUuid()))
 	if err != nil {
 		return nil, stacktrace.Propagate(err, "An error occurred connecting to the API container on host machine URL '%v'", apiContainerHostMachineUrl)
 	}
@@ -52,7 +52,26 @@ const (
 
 // Defines values for EnclaveTargetStatus.
 const (
-	STOP EnclaveTargetStatus = "STOP"
+	EnclaveTargetStatusSTOP EnclaveTargetStatus = "STOP"
+)
+
+// Defines values for EngineEventType.
+const (
+	APICONTAINERRESTARTED EngineEventType = "API_CONTAINER_RESTARTED"
+	ENCLAVECREATED        EngineEventType = "ENCLAVE_CREATED"
+	ENCLAVEDESTROYED      EngineEventType = "ENCLAVE_DESTROYED"
+	ENCLAVESTOPPED        EngineEventType = "ENCLAVE_STOPPED"
+	SERVICEADDED          EngineEventType = "SERVICE_ADDED"
+	SERVICEREMOVED        EngineEventType = "SERVICE_REMOVED"
+	SERVICESTOPPED        EngineEventType = "SERVICE_STOPPED"
+	STARLARKRUNFINISHED   EngineEventType = "STARLARK_RUN_FINISHED"
+	STARLARKRUNSTARTED    EngineEventType = "STARLARK_RUN_STARTED"
+)
+
+// Defines values for ExpiredEnclaveAction.
+const (
+	ExpiredEnclaveActionDESTROY ExpiredEnclaveAction = "DESTROY"
+	ExpiredEnclaveActionSTOP    ExpiredEnclaveAction = "STOP"
 )
 
 // Defines values for HttpMethodAvailability.
@@ -121,6 +140,35 @@ type AsyncStarlarkExecutionLogs struct {
 	} `json:"async_starlark_execution_logs"`
 }
 
+// AuditLog defines model for AuditLog.
+type AuditLog struct {
+	Entries []AuditLogEntry `json:"entries"`
+
+	// NextPageToken Empty on the last page
+	NextPageToken string `json:"next_page_token"`
+}
+
+// AuditLogEntry defines model for AuditLogEntry.
+type AuditLogEntry struct {
+	Action string `json:"action"`
+
+	// Arguments The arguments of the change, secrets redacted
+	Arguments map[string]string `json:"arguments"`
+
+	// Caller The API token, or the address when the engine doesn't require API tokens, the change was made by
+	Caller string `json:"caller"`
+
+	// EnclaveName Only set if the change is about a single enclave
+	EnclaveName *string `json:"enclave_name,omitempty"`
+
+	// EnclaveUuid Only set if the change is about a single enclave
+	EnclaveUuid *string `json:"enclave_uuid,omitempty"`
+
+	// Error Only set if the change failed
+	Error     *string   `json:"error,omitempty"`
+	Timestamp Timestamp `json:"timestamp"`
+}
+
 // Connect 0 - CONNECT // Best effort port forwarding
 // 1 - NO_CONNECT // Port forwarding disabled
 type Connect string
@@ -146,11 +194,29 @@ type ContainerStatus string
 // CreateEnclave defines model for CreateEnclave.
 type CreateEnclave struct {
 	// ApiContainerLogLevel Enclave log level, defaults to INFO
-	ApiContainerLogLevel     *string                `json:"api_container_log_level,omitempty"`
-	ApiContainerVersionTag   string                 `json:"api_container_version_tag"`
-	EnclaveName              string                 `json:"enclave_name"`
-	Mode                     *EnclaveMode           `json:"mode,omitempty"`
+	ApiContainerLogLevel   *string `json:"api_container_log_level,omitempty"`
+	ApiContainerVersionTag string  `json:"api_container_version_tag"`
+	EnclaveName            string  `json:"enclave_name"`
+
+	// ExpiredEnclaveAction What happens to the enclave once it expires, defaults to DESTROY
+	ExpiredEnclaveAction *ExpiredEnclaveAction `json:"expired_enclave_action,omitempty"`
+
+	// IdleTimeoutSeconds How long the enclave can go without its API container serving any request before it expires, it doesn't expire for being idle if unset
+	IdleTimeoutSeconds *uint32 `json:"idle_timeout_seconds,omitempty"`
+
+	// Labels Arbitrary key/value labels attached to the enclave
+	Labels *map[string]string `json:"labels,omitempty"`
+	Mode   *EnclaveMode       `json:"mode,omitempty"`
+
+	// SeedPackageLocator A package to run in the enclave before returning it, the enclave is handed out from the warm pool if its enclaves were pre-seeded with the same package and params
+	SeedPackageLocator *string `json:"seed_package_locator,omitempty"`
+
+	// SeedPackageParams The serialized JSON params of the seed package
+	SeedPackageParams        *string                `json:"seed_package_params,omitempty"`
 	ShouldApicRunInDebugMode *ApiContainerDebugMode `json:"should_apic_run_in_debug_mode,omitempty"`
+
+	// TtlSeconds How long the enclave lives for before it expires, it doesn't expire with age if unset
+	TtlSeconds *uint32 `json:"ttl_seconds,omitempty"`
 }
 
 // DeletionSummary defines model for DeletionSummary.
@@ -172,6 +238,13 @@ type EnclaveAPIContainerInfo struct {
 	IpInsideEnclave       string `json:"ip_inside_enclave"`
 }
 
+// EnclaveExpiration defines model for EnclaveExpiration.
+type EnclaveExpiration struct {
+	Action             ExpiredEnclaveAction `json:"action"`
+	ExpirationTime     *Timestamp           `json:"expiration_time,omitempty"`
+	IdleTimeoutSeconds *uint32              `json:"idle_timeout_seconds,omitempty"`
+}
+
 // EnclaveIdentifiers defines model for EnclaveIdentifiers.
 type EnclaveIdentifiers struct {
 	EnclaveUuid   string `json:"enclave_uuid"`
@@ -187,6 +260,8 @@ type EnclaveInfo struct {
 	ContainersStatus            EnclaveStatus                       `json:"containers_status"`
 	CreationTime                Timestamp                           `json:"creation_time"`
 	EnclaveUuid                 string                              `json:"enclave_uuid"`
+	Expiration                  *EnclaveExpiration                  `json:"expiration,omitempty"`
+	Labels                      *map[string]string                  `json:"labels,omitempty"`
 	Mode                        EnclaveMode                         `json:"mode"`
 	Name                        string                              `json:"name"`
 	ShortenedUuid               string                              `json:"shortened_uuid"`
@@ -207,6 +282,26 @@ type EnclaveStatus string
 // EnclaveTargetStatus defines model for EnclaveTargetStatus.
 type EnclaveTargetStatus string
 
+// EngineEvent defines model for EngineEvent.
+type EngineEvent struct {
+	EnclaveName string `json:"enclave_name"`
+	EnclaveUuid string `json:"enclave_uuid"`
+
+	// ServiceName The service the event is about, only set for service events
+	ServiceName *string `json:"service_name,omitempty"`
+
+	// StarlarkPackageId The package that was run, only set for Starlark run events of packages
+	StarlarkPackageId *string `json:"starlark_package_id,omitempty"`
+
+	// StarlarkRunSucceeded Whether the run succeeded, only set for STARLARK_RUN_FINISHED events
+	StarlarkRunSucceeded *bool           `json:"starlark_run_succeeded,omitempty"`
+	Timestamp            Timestamp       `json:"timestamp"`
+	Type                 EngineEventType `json:"type"`
+}
+
+// EngineEventType defines model for EngineEventType.
+type EngineEventType string
+
 // EngineInfo defines model for EngineInfo.
 type EngineInfo struct {
 	EngineVersion string `json:"engine_version"`
@@ -225,6 +320,9 @@ type ExecCommandResult struct {
 	LogOutput string `json:"log_output"`
 }
 
+// ExpiredEnclaveAction defines model for ExpiredEnclaveAction.
+type ExpiredEnclaveAction string
+
 // FileArtifactDescription defines model for FileArtifactDescription.
 type FileArtifactDescription struct {
 	// Path Path relative to the file artifact
@@ -432,6 +530,36 @@ type ServiceLogs struct {
 	ServiceLogsByServiceUuid *map[string]LogLine `json:"service_logs_by_service_uuid,omitempty"`
 }
 
+// ServiceStats defines model for ServiceStats.
+type ServiceStats struct {
+	// CpuPercent Like in `docker stats`, 100 being one CPU fully used
+	CpuPercent float64 `json:"cpu_percent"`
+
+	// DiskReadBytes Total since the service started, zero on Kubernetes
+	DiskReadBytes int64 `json:"disk_read_bytes"`
+
+	// DiskWrittenBytes Total since the service started, zero on Kubernetes
+	DiskWrittenBytes int64 `json:"disk_written_bytes"`
+
+	// MemoryLimitBytes Zero if the service has no memory limit
+	MemoryLimitBytes int64 `json:"memory_limit_bytes"`
+	MemoryUsageBytes int64 `json:"memory_usage_bytes"`
+
+	// NetworkReceivedBytes Total since the service started, zero on Kubernetes
+	NetworkReceivedBytes int64 `json:"network_received_bytes"`
+
+	// NetworkSentBytes Total since the service started, zero on Kubernetes
+	NetworkSentBytes int64  `json:"network_sent_bytes"`
+	ServiceName      string `json:"service_name"`
+	ServiceUuid      string `json:"service_uuid"`
+}
+
+// ServiceStatsSample defines model for ServiceStatsSample.
+type ServiceStatsSample struct {
+	Services  []ServiceStats `json:"services"`
+	Timestamp Timestamp      `json:"timestamp"`
+}
+
 // ServiceStatus 0 - STOPPED
 // 1 - RUNNING
 // 2 - UNKNOWN
@@ -593,12 +721,18 @@ type TransportProtocol string
 // ArtifactIdentifier defines model for artifact_identifier.
 type ArtifactIdentifier = string
 
+// AuditLogEnclaveIdentifier defines model for audit_log_enclave_identifier.
+type AuditLogEnclaveIdentifier = string
+
 // ConjunctiveFilters defines model for conjunctive_filters.
 type ConjunctiveFilters = []LogLineFilter
 
 // EnclaveIdentifier defines model for enclave_identifier.
 type EnclaveIdentifier = string
 
+// EventTypes defines model for event_types.
+type EventTypes = []EngineEventType
+
 // ExpectedResponse defines model for expected_response.
 type ExpectedResponse = string
 
@@ -611,12 +745,21 @@ type HttpMethod = HttpMethodAvailability
 // InitialDelayMilliseconds defines model for initial_delay_milliseconds.
 type InitialDelayMilliseconds = int32
 
+// LabelSelector defines model for label_selector.
+type LabelSelector = string
+
 // NumLogLines defines model for num_log_lines.
 type NumLogLines = int
 
 // PackageId defines model for package_id.
 type PackageId = string
 
+// PageSize defines model for page_size.
+type PageSize = int32
+
+// PageToken defines model for page_token.
+type PageToken = string
+
 // Path defines model for path.
 type Path = string
 
@@ -653,10 +796,31 @@ type StarlarkExecutionUuid = string
 // NotOk defines model for NotOk.
 type NotOk = ResponseInfo
 
+// GetEngineAuditParams defines parameters for GetEngineAudit.
+type GetEngineAuditParams struct {
+	// EnclaveIdentifier UUID, shortened UUID, or name of the enclave to get the audit log entries of, destroyed enclaves included
+	EnclaveIdentifier *AuditLogEnclaveIdentifier `form:"enclave_identifier,omitempty" json:"enclave_identifier,omitempty"`
+
+	// PageSize The maximum number of entries of the page, defaults to 100
+	PageSize *PageSize `form:"page_size,omitempty" json:"page_size,omitempty"`
+
+	// PageToken The token of the page to get, as returned with the previous page, the first page being returned if unset
+	PageToken *PageToken `form:"page_token,omitempty" json:"page_token,omitempty"`
+}
+
 // DeleteEnclavesParams defines parameters for DeleteEnclaves.
 type DeleteEnclavesParams struct {
 	// RemoveAll If true, remove all enclaves. Otherwise only remove stopped enclaves. Default is false
 	RemoveAll *RemoveAll `form:"remove_all,omitempty" json:"remove_all,omitempty"`
+
+	// LabelSelector Comma-separated list of key=value labels, only the enclaves having all of them are considered
+	LabelSelector *LabelSelector `form:"label_selector,omitempty" json:"label_selector,omitempty"`
+}
+
+// GetEnclavesParams defines parameters for GetEnclaves.
+type GetEnclavesParams struct {
+	// LabelSelector Comma-separated list of key=value labels, only the enclaves having all of them are considered
+	LabelSelector *LabelSelector `form:"label_selector,omitempty" json:"label_selector,omitempty"`
 }
 
 // PostEnclavesEnclaveIdentifierArtifactsLocalFileMultipartBody defines parameters for PostEnclavesEnclaveIdentifierArtifactsLocalFile.
@@ -677,6 +841,12 @@ type GetEnclavesEnclaveIdentifierServicesParams struct {
 	Services *[]string `form:"services,omitempty" json:"services,omitempty"`
 }
 
+// GetEnclavesEnclaveIdentifierServicesStatsParams defines parameters for GetEnclavesEnclaveIdentifierServicesStats.
+type GetEnclavesEnclaveIdentifierServicesStatsParams struct {
+	// Services Select services to sample, all the running services if not set
+	Services *[]string `form:"services,omitempty" json:"services,omitempty"`
+}
+
 // GetEnclavesEnclaveIdentifierServicesServiceIdentifierEndpointsPortNumberAvailabilityParams defines parameters for GetEnclavesEnclaveIdentifierServicesServiceIdentifierEndpointsPortNumberAvailability.
 type GetEnclavesEnclaveIdentifierServicesServiceIdentifierEndpointsPortNumberAvailabilityParams struct {
 	// HttpMethod The HTTP method used to check availability. Default is GET.
@@ -724,6 +894,12 @@ type PostEnclavesEnclaveIdentifierStarlarkScriptsParams struct {
 	RetrieveLogsAsync *RetrieveLogsAsync `form:"retrieve_logs_async,omitempty" json:"retrieve_logs_async,omitempty"`
 }
 
+// GetEngineEventsParams defines parameters for GetEngineEvents.
+type GetEngineEventsParams struct {
+	// EventTypes The types of events to stream, all of them get streamed if unset
+	EventTypes *EventTypes `form:"event_types,omitempty" json:"event_types,omitempty"`
+}
+
 // PostEnclavesJSONRequestBody defines body for PostEnclaves for application/json ContentType.
 type PostEnclavesJSONRequestBody = CreateEnclave
 
@@ -94,7 +94,7 @@ type ClientInterface interface {
 	DeleteEnclaves(ctx context.Context, params *DeleteEnclavesParams, reqEditors ...RequestEditorFn) (*http.Response, error)
 
 	// GetEnclaves request
-	GetEnclaves(ctx context.Context, reqEditors ...RequestEditorFn) (*http.Response, error)
+	GetEnclaves(ctx context.Context, params *GetEnclavesParams, reqEditors ...RequestEditorFn) (*http.Response, error)
 
 	// PostEnclavesWithBody request with any body
 	PostEnclavesWithBody(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error)
@@ -146,6 +146,9 @@ type ClientInterface interface {
 	// GetEnclavesEnclaveIdentifierServicesHistory request
 	GetEnclavesEnclaveIdentifierServicesHistory(ctx context.Context, enclaveIdentifier EnclaveIdentifier, reqEditors ...RequestEditorFn) (*http.Response, error)
 
+	// GetEnclavesEnclaveIdentifierServicesStats request
+	GetEnclavesEnclaveIdentifierServicesStats(ctx context.Context, enclaveIdentifier EnclaveIdentifier, params *GetEnclavesEnclaveIdentifierServicesStatsParams, reqEditors ...RequestEditorFn) (*http.Response, error)
+
 	// GetEnclavesEnclaveIdentifierServicesServiceIdentifier request
 	GetEnclavesEnclaveIdentifierServicesServiceIdentifier(ctx context.Context, enclaveIdentifier EnclaveIdentifier, serviceIdentifier ServiceIdentifier, reqEditors ...RequestEditorFn) (*http.Response, error)
 
@@ -184,6 +187,12 @@ type ClientInterface interface {
 
 	PostEnclavesEnclaveIdentifierStatus(ctx context.Context, enclaveIdentifier EnclaveIdentifier, body PostEnclavesEnclaveIdentifierStatusJSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error)
 
+	// GetEngineAudit request
+	GetEngineAudit(ctx context.Context, params *GetEngineAuditParams, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	// GetEngineEvents request
+	GetEngineEvents(ctx context.Context, params *GetEngineEventsParams, reqEditors ...RequestEditorFn) (*http.Response, error)
+
 	// GetEngineInfo request
 	GetEngineInfo(ctx context.Context, reqEditors ...RequestEditorFn) (*http.Response, error)
 
@@ -203,8 +212,8 @@ func (c *Client) DeleteEnclaves(ctx context.Context, params *DeleteEnclavesParam
 	return c.Client.Do(req)
 }
 
-func (c *Client) GetEnclaves(ctx context.Context, reqEditors ...RequestEditorFn) (*http.Response, error) {
-	req, err := NewGetEnclavesRequest(c.Server)
+func (c *Client) GetEnclaves(ctx context.Context, params *GetEnclavesParams, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewGetEnclavesRequest(c.Server, params)
 	if err != nil {
 		return nil, err
 	}
@@ -431,6 +440,18 @@ func (c *Client) GetEnclavesEnclaveIdentifierServicesHistory(ctx context.Context
 	return c.Client.Do(req)
 }
 
+func (c *Client) GetEnclavesEnclaveIdentifierServicesStats(ctx context.Context, enclaveIdentifier EnclaveIdentifier, params *GetEnclavesEnclaveIdentifierServicesStatsParams, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewGetEnclavesEnclaveIdentifierServicesStatsRequest(c.Server, enclaveIdentifier, params)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
+
 func (c *Client) GetEnclavesEnclaveIdentifierServicesServiceIdentifier(ctx context.Context, enclaveIdentifier EnclaveIdentifier, serviceIdentifier ServiceIdentifier, reqEditors ...RequestEditorFn) (*http.Response, error) {
 	req, err := NewGetEnclavesEnclaveIdentifierServicesServiceIdentifierRequest(c.Server, enclaveIdentifier, serviceIdentifier)
 	if err != nil {
@@ -599,6 +620,30 @@ func (c *Client) PostEnclavesEnclaveIdentifierStatus(ctx context.Context, enclav
 	return c.Client.Do(req)
 }
 
+func (c *Client) GetEngineAudit(ctx context.Context, params *GetEngineAuditParams, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewGetEngineAuditRequest(c.Server, params)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
+
+func (c *Client) GetEngineEvents(ctx context.Context, params *GetEngineEventsParams, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewGetEngineEventsRequest(c.Server, params)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
+
 func (c *Client) GetEngineInfo(ctx context.Context, reqEditors ...RequestEditorFn) (*http.Response, error) {
 	req, err := NewGetEngineInfoRequest(c.Server)
 	if err != nil {
@@ -661,6 +706,22 @@ func NewDeleteEnclavesRequest(server string, params *DeleteEnclavesParams) (*htt
 
 		}
 
+		if params.LabelSelector != nil {
+
+			if queryFrag, err := runtime.StyleParamWithLocation("form", true, "label_selector", runtime.ParamLocationQuery, *params.LabelSelector); err != nil {
+				return nil, err
+			} else if parsed, err := url.ParseQuery(queryFrag); err != nil {
+				return nil, err
+			} else {
+				for k, v := range parsed {
+					for _, v2 := range v {
+						queryValues.Add(k, v2)
+					}
+				}
+			}
+
+		}
+
 		queryURL.RawQuery = queryValues.Encode()
 	}
 
@@ -673,7 +734,7 @@ func NewDeleteEnclavesRequest(server string, params *DeleteEnclavesParams) (*htt
 }
 
 // NewGetEnclavesRequest generates requests for GetEnclaves
-func NewGetEnclavesRequest(server string) (*http.Request, error) {
+func NewGetEnclavesRequest(server string, params *GetEnclavesParams) (*http.Request, error) {
 	var err error
 
 	serverURL, err := url.Parse(server)
@@ -691,6 +752,28 @@ func NewGetEnclavesRequest(server string) (*http.Request, error) {
 		return nil, err
 	}
 
+	if params != nil {
+		queryValues := queryURL.Query()
+
+		if params.LabelSelector != nil {
+
+			if queryFrag, err := runtime.StyleParamWithLocation("form", true, "label_selector", runtime.ParamLocationQuery, *params.LabelSelector); err != nil {
+				return nil, err
+			} else if parsed, err := url.ParseQuery(queryFrag); err != nil {
+				return nil, err
+			} else {
+				for k, v := range parsed {
+					for _, v2 := range v {
+						queryValues.Add(k, v2)
+					}
+				}
+			}
+
+		}
+
+		queryURL.RawQuery = queryValues.Encode()
+	}
+
 	req, err := http.NewRequest("GET", queryURL.String(), nil)
 	if err != nil {
 		return nil, err
@@ -1340,6 +1423,62 @@ func NewGetEnclavesEnclaveIdentifierServicesHistoryRequest(server string, enclav
 	return req, nil
 }
 
+// NewGetEnclavesEnclaveIdentifierServicesStatsRequest generates requests for GetEnclavesEnclaveIdentifierServicesStats
+func NewGetEnclavesEnclaveIdentifierServicesStatsRequest(server string, enclaveIdentifier EnclaveIdentifier, params *GetEnclavesEnclaveIdentifierServicesStatsParams) (*http.Request, error) {
+	var err error
+
+	var pathParam0 string
+
+	pathParam0, err = runtime.StyleParamWithLocation("simple", false, "enclave_identifier", runtime.ParamLocationPath, enclaveIdentifier)
+	if err != nil {
+		return nil, err
+	}
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/enclaves/%s/services/stats", pathParam0)
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	if params != nil {
+		queryValues := queryURL.Query()
+
+		if params.Services != nil {
+
+			if queryFrag, err := runtime.StyleParamWithLocation("form", true, "services", runtime.ParamLocationQuery, *params.Services); err != nil {
+				return nil, err
+			} else if parsed, err := url.ParseQuery(queryFrag); err != nil {
+				return nil, err
+			} else {
+				for k, v := range parsed {
+					for _, v2 := range v {
+						queryValues.Add(k, v2)
+					}
+				}
+			}
+
+		}
+
+		queryURL.RawQuery = queryValues.Encode()
+	}
+
+	req, err := http.NewRequest("GET", queryURL.String(), nil)
+	if err != nil {
+		return nil, err
+	}
+
+	return req, nil
+}
+
 // NewGetEnclavesEnclaveIdentifierServicesServiceIdentifierRequest generates requests for GetEnclavesEnclaveIdentifierServicesServiceIdentifier
 func NewGetEnclavesEnclaveIdentifierServicesServiceIdentifierRequest(server string, enclaveIdentifier EnclaveIdentifier, serviceIdentifier ServiceIdentifier) (*http.Request, error) {
 	var err error
@@ -2008,6 +2147,136 @@ func NewPostEnclavesEnclaveIdentifierStatusRequestWithBody(server string, enclav
 	return req, nil
 }
 
+// NewGetEngineAuditRequest generates requests for GetEngineAudit
+func NewGetEngineAuditRequest(server string, params *GetEngineAuditParams) (*http.Request, error) {
+	var err error
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/engine/audit")
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	if params != nil {
+		queryValues := queryURL.Query()
+
+		if params.EnclaveIdentifier != nil {
+
+			if queryFrag, err := runtime.StyleParamWithLocation("form", true, "enclave_identifier", runtime.ParamLocationQuery, *params.EnclaveIdentifier); err != nil {
+				return nil, err
+			} else if parsed, err := url.ParseQuery(queryFrag); err != nil {
+				return nil, err
+			} else {
+				for k, v := range parsed {
+					for _, v2 := range v {
+						queryValues.Add(k, v2)
+					}
+				}
+			}
+
+		}
+
+		if params.PageSize != nil {
+
+			if queryFrag, err := runtime.StyleParamWithLocation("form", true, "page_size", runtime.ParamLocationQuery, *params.PageSize); err != nil {
+				return nil, err
+			} else if parsed, err := url.ParseQuery(queryFrag); err != nil {
+				return nil, err
+			} else {
+				for k, v := range parsed {
+					for _, v2 := range v {
+						queryValues.Add(k, v2)
+					}
+				}
+			}
+
+		}
+
+		if params.PageToken != nil {
+
+			if queryFrag, err := runtime.StyleParamWithLocation("form", true, "page_token", runtime.ParamLocationQuery, *params.PageToken); err != nil {
+				return nil, err
+			} else if parsed, err := url.ParseQuery(queryFrag); err != nil {
+				return nil, err
+			} else {
+				for k, v := range parsed {
+					for _, v2 := range v {
+						queryValues.Add(k, v2)
+					}
+				}
+			}
+
+		}
+
+		queryURL.RawQuery = queryValues.Encode()
+	}
+
+	req, err := http.NewRequest("GET", queryURL.String(), nil)
+	if err != nil {
+		return nil, err
+	}
+
+	return req, nil
+}
+
+// NewGetEngineEventsRequest generates requests for GetEngineEvents
+func NewGetEngineEventsRequest(server string, params *GetEngineEventsParams) (*http.Request, error) {
+	var err error
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/engine/events")
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	if params != nil {
+		queryValues := queryURL.Query()
+
+		if params.EventTypes != nil {
+
+			if queryFrag, err := runtime.StyleParamWithLocation("form", true, "event_types", runtime.ParamLocationQuery, *params.EventTypes); err != nil {
+				return nil, err
+			} else if parsed, err := url.ParseQuery(queryFrag); err != nil {
+				return nil, err
+			} else {
+				for k, v := range parsed {
+					for _, v2 := range v {
+						queryValues.Add(k, v2)
+					}
+				}
+			}
+
+		}
+
+		queryURL.RawQuery = queryValues.Encode()
+	}
+
+	req, err := http.NewRequest("GET", queryURL.String(), nil)
+	if err != nil {
+		return nil, err
+	}
+
+	return req, nil
+}
+
 // NewGetEngineInfoRequest generates requests for GetEngineInfo
 func NewGetEngineInfoRequest(server string) (*http.Request, error) {
 	var err error
@@ -2116,7 +2385,7 @@ type ClientWithResponsesInterface interface {
 	DeleteEnclavesWithResponse(ctx context.Context, params *DeleteEnclavesParams, reqEditors ...RequestEditorFn) (*DeleteEnclavesResponse, error)
 
 	// GetEnclavesWithResponse request
-	GetEnclavesWithResponse(ctx context.Context, reqEditors ...RequestEditorFn) (*GetEnclavesResponse, error)
+	GetEnclavesWithResponse(ctx context.Context, params *GetEnclavesParams, reqEditors ...RequestEditorFn) (*GetEnclavesResponse, error)
 
 	// PostEnclavesWithBodyWithResponse request with any body
 	PostEnclavesWithBodyWithResponse(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*PostEnclavesResponse, error)
@@ -2168,6 +2437,9 @@ type ClientWithResponsesInterface interface {
 	// GetEnclavesEnclaveIdentifierServicesHistoryWithResponse request
 	GetEnclavesEnclaveIdentifierServicesHistoryWithResponse(ctx context.Context, enclaveIdentifier EnclaveIdentifier, reqEditors ...RequestEditorFn) (*GetEnclavesEnclaveIdentifierServicesHistoryResponse, error)
 
+	// GetEnclavesEnclaveIdentifierServicesStatsWithResponse request
+	GetEnclavesEnclaveIdentifierServicesStatsWithResponse(ctx context.Context, enclaveIdentifier EnclaveIdentifier, params *GetEnclavesEnclaveIdentifierServicesStatsParams, reqEditors ...RequestEditorFn) (*GetEnclavesEnclaveIdentifierServicesStatsResponse, error)
+
 	// GetEnclavesEnclaveIdentifierServicesServiceIdentifierWithResponse request
 	GetEnclavesEnclaveIdentifierServicesServiceIdentifierWithResponse(ctx context.Context, enclaveIdentifier EnclaveIdentifier, serviceIdentifier ServiceIdentifier, reqEditors ...RequestEditorFn) (*GetEnclavesEnclaveIdentifierServicesServiceIdentifierResponse, error)
 
@@ -2206,6 +2478,12 @@ type ClientWithResponsesInterface interface {
 
 	PostEnclavesEnclaveIdentifierStatusWithResponse(ctx context.Context, enclaveIdentifier EnclaveIdentifier, body PostEnclavesEnclaveIdentifierStatusJSONRequestBody, reqEditors ...RequestEditorFn) (*PostEnclavesEnclaveIdentifierStatusResponse, error)
 
+	// GetEngineAuditWithResponse request
+	GetEngineAuditWithResponse(ctx context.Context, params *GetEngineAuditParams, reqEditors ...RequestEditorFn) (*GetEngineAuditResponse, error)
+
+	// GetEngineEventsWithResponse request
+	GetEngineEventsWithResponse(ctx context.Context, params *GetEngineEventsParams, reqEditors ...RequestEditorFn) (*GetEngineEventsResponse, error)
+
 	// GetEngineInfoWithResponse request
 	GetEngineInfoWithResponse(ctx context.Context, reqEditors ...RequestEditorFn) (*GetEngineInfoResponse, error)
 
@@ -2578,6 +2856,29 @@ func (r GetEnclavesEnclaveIdentifierServicesHistoryResponse) StatusCode() int {
 	return 0
 }
 
+type GetEnclavesEnclaveIdentifierServicesStatsResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON200      *ServiceStatsSample
+	JSONDefault  *NotOk
+}
+
+// Status returns HTTPResponse.Status
+func (r GetEnclavesEnclaveIdentifierServicesStatsResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r GetEnclavesEnclaveIdentifierServicesStatsResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
 type GetEnclavesEnclaveIdentifierServicesServiceIdentifierResponse struct {
 	Body         []byte
 	HTTPResponse *http.Response
@@ -2805,6 +3106,52 @@ func (r PostEnclavesEnclaveIdentifierStatusResponse) StatusCode() int {
 	return 0
 }
 
+type GetEngineAuditResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON200      *AuditLog
+	JSONDefault  *NotOk
+}
+
+// Status returns HTTPResponse.Status
+func (r GetEngineAuditResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r GetEngineAuditResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type GetEngineEventsResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON200      *EngineEvent
+	JSONDefault  *NotOk
+}
+
+// Status returns HTTPResponse.Status
+func (r GetEngineEventsResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r GetEngineEventsResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
 type GetEngineInfoResponse struct {
 	Body         []byte
 	HTTPResponse *http.Response
@@ -2861,8 +3208,8 @@ func (c *ClientWithResponses) DeleteEnclavesWithResponse(ctx context.Context, pa
 }
 
 // GetEnclavesWithResponse request returning *GetEnclavesResponse
-func (c *ClientWithResponses) GetEnclavesWithResponse(ctx context.Context, reqEditors ...RequestEditorFn) (*GetEnclavesResponse, error) {
-	rsp, err := c.GetEnclaves(ctx, reqEditors...)
+func (c *ClientWithResponses) GetEnclavesWithResponse(ctx context.Context, params *GetEnclavesParams, reqEditors ...RequestEditorFn) (*GetEnclavesResponse, error) {
+	rsp, err := c.GetEnclaves(ctx, params, reqEditors...)
 	if err != nil {
 		return nil, err
 	}
@@ -3027,6 +3374,15 @@ func (c *ClientWithResponses) GetEnclavesEnclaveIdentifierServicesHistoryWithRes
 	return ParseGetEnclavesEnclaveIdentifierServicesHistoryResponse(rsp)
 }
 
+// GetEnclavesEnclaveIdentifierServicesStatsWithResponse request returning *GetEnclavesEnclaveIdentifierServicesStatsResponse
+func (c *ClientWithResponses) GetEnclavesEnclaveIdentifierServicesStatsWithResponse(ctx context.Context, enclaveIdentifier EnclaveIdentifier, params *GetEnclavesEnclaveIdentifierServicesStatsParams, reqEditors ...RequestEditorFn) (*GetEnclavesEnclaveIdentifierServicesStatsResponse, error) {
+	rsp, err := c.GetEnclavesEnclaveIdentifierServicesStats(ctx, enclaveIdentifier, params, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseGetEnclavesEnclaveIdentifierServicesStatsResponse(rsp)
+}
+
 // GetEnclavesEnclaveIdentifierServicesServiceIdentifierWithResponse request returning *GetEnclavesEnclaveIdentifierServicesServiceIdentifierResponse
 func (c *ClientWithResponses) GetEnclavesEnclaveIdentifierServicesServiceIdentifierWithResponse(ctx context.Context, enclaveIdentifier EnclaveIdentifier, serviceIdentifier ServiceIdentifier, reqEditors ...RequestEditorFn) (*GetEnclavesEnclaveIdentifierServicesServiceIdentifierResponse, error) {
 	rsp, err := c.GetEnclavesEnclaveIdentifierServicesServiceIdentifier(ctx, enclaveIdentifier, serviceIdentifier, reqEditors...)
@@ -3149,6 +3505,24 @@ func (c *ClientWithResponses) PostEnclavesEnclaveIdentifierStatusWithResponse(ct
 	return ParsePostEnclavesEnclaveIdentifierStatusResponse(rsp)
 }
 
+// GetEngineAuditWithResponse request returning *GetEngineAuditResponse
+func (c *ClientWithResponses) GetEngineAuditWithResponse(ctx context.Context, params *GetEngineAuditParams, reqEditors ...RequestEditorFn) (*GetEngineAuditResponse, error) {
+	rsp, err := c.GetEngineAudit(ctx, params, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseGetEngineAuditResponse(rsp)
+}
+
+// GetEngineEventsWithResponse request returning *GetEngineEventsResponse
+func (c *ClientWithResponses) GetEngineEventsWithResponse(ctx context.Context, params *GetEngineEventsParams, reqEditors ...RequestEditorFn) (*GetEngineEventsResponse, error) {
+	rsp, err := c.GetEngineEvents(ctx, params, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseGetEngineEventsResponse(rsp)
+}
+
 // GetEngineInfoWithResponse request returning *GetEngineInfoResponse
 func (c *ClientWithResponses) GetEngineInfoWithResponse(ctx context.Context, reqEditors ...RequestEditorFn) (*GetEngineInfoResponse, error) {
 	rsp, err := c.GetEngineInfo(ctx, reqEditors...)
@@ -3674,6 +4048,39 @@ func ParseGetEnclavesEnclaveIdentifierServicesHistoryResponse(rsp *http.Response
 	return response, nil
 }
 
+// ParseGetEnclavesEnclaveIdentifierServicesStatsResponse parses an HTTP response from a GetEnclavesEnclaveIdentifierServicesStatsWithResponse call
+func ParseGetEnclavesEnclaveIdentifierServicesStatsResponse(rsp *http.Response) (*GetEnclavesEnclaveIdentifierServicesStatsResponse, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
+	if err != nil {
+		return nil, err
+	}
+
+	response := &GetEnclavesEnclaveIdentifierServicesStatsResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
+	}
+
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
+		var dest ServiceStatsSample
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON200 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && true:
+		var dest NotOk
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSONDefault = &dest
+
+	}
+
+	return response, nil
+}
+
 // ParseGetEnclavesEnclaveIdentifierServicesServiceIdentifierResponse parses an HTTP response from a GetEnclavesEnclaveIdentifierServicesServiceIdentifierWithResponse call
 func ParseGetEnclavesEnclaveIdentifierServicesServiceIdentifierResponse(rsp *http.Response) (*GetEnclavesEnclaveIdentifierServicesServiceIdentifierResponse, error) {
 	bodyBytes, err := io.ReadAll(rsp.Body)
@@ -3983,6 +4390,72 @@ func ParsePostEnclavesEnclaveIdentifierStatusResponse(rsp *http.Response) (*Post
 	return response, nil
 }
 
+// ParseGetEngineAuditResponse parses an HTTP response from a GetEngineAuditWithResponse call
+func ParseGetEngineAuditResponse(rsp *http.Response) (*GetEngineAuditResponse, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
+	if err != nil {
+		return nil, err
+	}
+
+	response := &GetEngineAuditResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
+	}
+
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
+		var dest AuditLog
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON200 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && true:
+		var dest NotOk
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSONDefault = &dest
+
+	}
+
+	return response, nil
+}
+
+// ParseGetEngineEventsResponse parses an HTTP response from a GetEngineEventsWithResponse call
+func ParseGetEngineEventsResponse(rsp *http.Response) (*GetEngineEventsResponse, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
+	if err != nil {
+		return nil, err
+	}
+
+	response := &GetEngineEventsResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
+	}
+
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
+		var dest EngineEvent
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON200 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && true:
+		var dest NotOk
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSONDefault = &dest
+
+	}
+
+	return response, nil
+}
+
 // ParseGetEngineInfoResponse parses an HTTP response from a GetEngineInfoWithResponse call
 func ParseGetEngineInfoResponse(rsp *http.Response) (*GetEngineInfoResponse, error) {
 	bodyBytes, err := io.ReadAll(rsp.Body)
@@ -53,6 +53,9 @@ type ServerInterface interface {
 	// Returns information about all existing & historical services
 	// (GET /enclaves/{enclave_identifier}/services/history)
 	GetEnclavesEnclaveIdentifierServicesHistory(ctx echo.Context, enclaveIdentifier EnclaveIdentifier) error
+	// Returns the resources currently used by the services of the enclave
+	// (GET /enclaves/{enclave_identifier}/services/stats)
+	GetEnclavesEnclaveIdentifierServicesStats(ctx echo.Context, enclaveIdentifier EnclaveIdentifier, params GetEnclavesEnclaveIdentifierServicesStatsParams) error
 	// Returns detailed information about a specific service
 	// (GET /enclaves/{enclave_identifier}/services/{service_identifier})
 	GetEnclavesEnclaveIdentifierServicesServiceIdentifier(ctx echo.Context, enclaveIdentifier EnclaveIdentifier, serviceIdentifier ServiceIdentifier) error
@@ -258,6 +261,31 @@ func (w *ServerInterfaceWrapper) GetEnclavesEnclaveIdentifierServicesHistory(ctx
 	return err
 }
 
+// GetEnclavesEnclaveIdentifierServicesStats converts echo context to params.
+func (w *ServerInterfaceWrapper) GetEnclavesEnclaveIdentifierServicesStats(ctx echo.Context) error {
+	var err error
+	// ------------- Path parameter "enclave_identifier" -------------
+	var enclaveIdentifier EnclaveIdentifier
+
+	err = runtime.BindStyledParameterWithLocation("simple", false, "enclave_identifier", runtime.ParamLocationPath, ctx.Param("enclave_identifier"), &enclaveIdentifier)
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter enclave_identifier: %s", err))
+	}
+
+	// Parameter object where we will unmarshal all parameters from the context
+	var params GetEnclavesEnclaveIdentifierServicesStatsParams
+	// ------------- Optional query parameter "services" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "services", ctx.QueryParams(), &params.Services)
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter services: %s", err))
+	}
+
+	// Invoke the callback with all the unmarshaled arguments
+	err = w.Handler.GetEnclavesEnclaveIdentifierServicesStats(ctx, enclaveIdentifier, params)
+	return err
+}
+
 // GetEnclavesEnclaveIdentifierServicesServiceIdentifier converts echo context to params.
 func (w *ServerInterfaceWrapper) GetEnclavesEnclaveIdentifierServicesServiceIdentifier(ctx echo.Context) error {
 	var err error
@@ -516,6 +544,7 @@ func RegisterHandlersWithBaseURL(router EchoRouter, si ServerInterface, baseURL
 	router.GET(baseURL+"/enclaves/:enclave_identifier/services", wrapper.GetEnclavesEnclaveIdentifierServices)
 	router.POST(baseURL+"/enclaves/:enclave_identifier/services/connection", wrapper.PostEnclavesEnclaveIdentifierServicesConnection)
 	router.GET(baseURL+"/enclaves/:enclave_identifier/services/history", wrapper.GetEnclavesEnclaveIdentifierServicesHistory)
+	router.GET(baseURL+"/enclaves/:enclave_identifier/services/stats", wrapper.GetEnclavesEnclaveIdentifierServicesStats)
 	router.GET(baseURL+"/enclaves/:enclave_identifier/services/:service_identifier", wrapper.GetEnclavesEnclaveIdentifierServicesServiceIdentifier)
 	router.POST(baseURL+"/enclaves/:enclave_identifier/services/:service_identifier/command", wrapper.PostEnclavesEnclaveIdentifierServicesServiceIdentifierCommand)
 	router.GET(baseURL+"/enclaves/:enclave_identifier/services/:service_identifier/endpoints/:port_number/availability", wrapper.GetEnclavesEnclaveIdentifierServicesServiceIdentifierEndpointsPortNumberAvailability)
@@ -806,6 +835,36 @@ func (response GetEnclavesEnclaveIdentifierServicesHistorydefaultJSONResponse) V
 	return json.NewEncoder(w).Encode(response.Body)
 }
 
+type GetEnclavesEnclaveIdentifierServicesStatsRequestObject struct {
+	EnclaveIdentifier EnclaveIdentifier `json:"enclave_identifier"`
+	Params            GetEnclavesEnclaveIdentifierServicesStatsParams
+}
+
+type GetEnclavesEnclaveIdentifierServicesStatsResponseObject interface {
+	VisitGetEnclavesEnclaveIdentifierServicesStatsResponse(w http.ResponseWriter) error
+}
+
+type GetEnclavesEnclaveIdentifierServicesStats200JSONResponse ServiceStatsSample
+
+func (response GetEnclavesEnclaveIdentifierServicesStats200JSONResponse) VisitGetEnclavesEnclaveIdentifierServicesStatsResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(200)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type GetEnclavesEnclaveIdentifierServicesStatsdefaultJSONResponse struct {
+	Body       ResponseInfo
+	StatusCode int
+}
+
+func (response GetEnclavesEnclaveIdentifierServicesStatsdefaultJSONResponse) VisitGetEnclavesEnclaveIdentifierServicesStatsResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(response.StatusCode)
+
+	return json.NewEncoder(w).Encode(response.Body)
+}
+
 type GetEnclavesEnclaveIdentifierServicesServiceIdentifierRequestObject struct {
 	EnclaveIdentifier EnclaveIdentifier `json:"enclave_identifier"`
 	ServiceIdentifier ServiceIdentifier `json:"service_identifier"`
@@ -1048,6 +1107,9 @@ type StrictServerInterface interface {
 	// Returns information about all existing & historical services
 	// (GET /enclaves/{enclave_identifier}/services/history)
 	GetEnclavesEnclaveIdentifierServicesHistory(ctx context.Context, request GetEnclavesEnclaveIdentifierServicesHistoryRequestObject) (GetEnclavesEnclaveIdentifierServicesHistoryResponseObject, error)
+	// Returns the resources currently used by the services of the enclave
+	// (GET /enclaves/{enclave_identifier}/services/stats)
+	GetEnclavesEnclaveIdentifierServicesStats(ctx context.Context, request GetEnclavesEnclaveIdentifierServicesStatsRequestObject) (GetEnclavesEnclaveIdentifierServicesStatsResponseObject, error)
 	// Returns detailed information about a specific service
 	// (GET /enclaves/{enclave_identifier}/services/{service_identifier})
 	GetEnclavesEnclaveIdentifierServicesServiceIdentifier(ctx context.Context, request GetEnclavesEnclaveIdentifierServicesServiceIdentifierRequestObject) (GetEnclavesEnclaveIdentifierServicesServiceIdentifierResponseObject, error)
@@ -1336,6 +1398,32 @@ func (sh *strictHandler) GetEnclavesEnclaveIdentifierServicesHistory(ctx echo.Co
 	return nil
 }
 
+// GetEnclavesEnclaveIdentifierServicesStats operation middleware
+func (sh *strictHandler) GetEnclavesEnclaveIdentifierServicesStats(ctx echo.Context, enclaveIdentifier EnclaveIdentifier, params GetEnclavesEnclaveIdentifierServicesStatsParams) error {
+	var request GetEnclavesEnclaveIdentifierServicesStatsRequestObject
+
+	request.EnclaveIdentifier = enclaveIdentifier
+	request.Params = params
+
+	handler := func(ctx echo.Context, request interface{}) (interface{}, error) {
+		return sh.ssi.GetEnclavesEnclaveIdentifierServicesStats(ctx.Request().Context(), request.(GetEnclavesEnclaveIdentifierServicesStatsRequestObject))
+	}
+	for _, middleware := range sh.middlewares {
+		handler = middleware(handler, "GetEnclavesEnclaveIdentifierServicesStats")
+	}
+
+	response, err := handler(ctx, request)
+
+	if err != nil {
+		return err
+	} else if validResponse, ok := response.(GetEnclavesEnclaveIdentifierServicesStatsResponseObject); ok {
+		return validResponse.VisitGetEnclavesEnclaveIdentifierServicesStatsResponse(ctx.Response())
+	} else if response != nil {
+		return fmt.Errorf("unexpected response type: %T", response)
+	}
+	return nil
+}
+
 // GetEnclavesEnclaveIdentifierServicesServiceIdentifier operation middleware
 func (sh *strictHandler) GetEnclavesEnclaveIdentifierServicesServiceIdentifier(ctx echo.Context, enclaveIdentifier EnclaveIdentifier, serviceIdentifier ServiceIdentifier) error {
 	var request GetEnclavesEnclaveIdentifierServicesServiceIdentifierRequestObject
@@ -1546,83 +1634,89 @@ func (sh *strictHandler) PostEnclavesEnclaveIdentifierStarlarkScripts(ctx echo.C
 // Base64 encoded, gzipped, json marshaled Swagger object
 var swaggerSpec = []string{
 
-	"H4sIAAAAAAAC/+x9e3MbuZH4V0HN71fl3dSYdDap5KL/ZFm2WfFKLImK7mrlGoMzTRIRCEwADGWuS9/9",
-	"Cq95cDDDoV7e5Pb+OK84ePQb3Y1G51uU8nXOGTAlo6NvUY4FXoMCYf7CQpEFTlVCMmCKLAgI/XMGMhUk",
-	"V4Sz6CiarQD5gYjhNSAuUFGQLIojogfkWK2iONKfoqPgmnEk4F8FEZBFR0oUEEcyXcEa683UNtfTpBKE",
-	"LaP7+zgCllK8gV6grq4m72IkV1woYJAh+zcXDsAFUitAbqEwnIFdDgTzaw6pgiwRIHPOJLShnHg4spwT",
-	"ppAAVQgmkVoRiTaYFhCbARLEhqSA7gilaA5ojcUtZAhLhDeYUDyngH6A0XKEPgKlHF1zQbMfRx6xfxUg",
-	"tjXMWoD1I7JSKk/WoFY8C3P/42w2RXYAKiRkSHGUriC99eARStR2hN7BAhdUISLRh9NZF3j17eqA/X8B",
-	"i+go+n/jSmLH9qscf1Qq/9lMOa7taKAnjCiCaZIBxdtkTSglElLOMhlGhhXrOQgtIvWxGqU7TBQqmCIU",
-	"wVdIC0XY0rBnQYRUlgopprQDrx5A6mguuFhjZcarP/0UxZ4hhClYgjA45Ti9xUstm2Ec3HdUyS5SK6xK",
-	"+bHgQ4eG1lY/TOLNMh0AqZXXOi/MXkhGaKLQupCKvVJIKiw0nGpVo6ykWK5G6D0XiDCpMEsBfXHLjFeA",
-	"qVp96SC6w6wXai5UYrneATwXyotFULI7yFhbt4+OQxiuZ4NUyZxn204zUlMcrWISlAZ3en45i2sWpRQC",
-	"CcyYED1Vr+v5U8cMuY27dLUBVz+ZBShBIKB0P+OvNaUrtQhhpWCdK2lF1yBgQHfC6zRxSTZaDYscYZY5",
-	"A6p/wAyBEFx0Am6hOZwRZt4ga3LWb0nmoO4AGKpA6QH0KayGXWoDCeVLmWC5ZWlQlhaYSojRnPL01ggV",
-	"8geFo7nmjl4DYQHVCdSw71rIezFqghEQnTnnFDAzkDtd3+uGeNNSs3tOqFPOFCbMG0L703qtZUaueEGz",
-	"ullEhIVVOgDHIRayUuS3PHO6sCAUrnLKcfbW6bYGFZjS/7kuqCI5Fmqs+fs6w8qsG2D7nDBsiNza0+5q",
-	"+Wd2POPq/HZnI5znlKRYk3L8T6np+W3gyXvhlp6wBbco7jhizLsbTh8NP+1kvfax5v+lwoJicXtqj1XO",
-	"PvFlQKGuJCBijJqRmpXgjBeSbpEXKetN+UWsjG4IRtcwlzy9BSW1C2hEWioBeK1pFEe54DkI5Thi1k6k",
-	"AykplzPy2gaqhNn4mIcB19o7sKvxpDssqhe8Xzonfi4lgs//CalqTezHtj09jk44Y/o/W5R4g16jk/Oz",
-	"s9OTGRqP0VuQCsFioU9Pc4QuuLjDIiNsecP+iF6js/OkNnzaHIIyIrVV0T4IsGKtYXWjoziqptZA9KQx",
-	"IFptNyFNg8LpOkuwsIwkCtYyQNtyRSwE3kYm5FBia3z0B03eJBvsIqosI5pcmE4bYHUtUpGdrLVPZg1R",
-	"YLxUWBVyn7aWhLm0wwNipH9u7NbGPq6oWMOuQ1Ya+wVl5nJ2Pp2evkNWKi6uzs4mZx/QDfsJvUZXZ38/",
-	"O78+qwmBGx3FkRsZxZEfFZIFrZ8n1tSHlRf5r7vK6A6Igzm+Q9PGMiEi1SC8AFlQ1ZZa+EpUkvIMBp31",
-	"cUT5MuGFyouAmh5LWaxBoqvZ+9f/pWNgnlkz2G9hKhAay4cQek8oHLsw/1197120wgHDVAcLAihWZGPC",
-	"BBsJ0CrNEAX4LMmvgQj7kvxahvt6iRgRhuZbZfytOiH/8ucgIRV8VUkuYEPgLkBKNCfKLA9fFXLnaYzI",
-	"ogYypfxOoh8kWROKTQBxdTb571cSvVoBzl79uJfwPoTR+O2j9gUsQABLA5TQwyTyA1HDhWlyxduYdkal",
-	"TklZciPWZlsH/uhuZfxZC4M24kRpepsphSoEhBjnD7gX2W6Hti5NZTDeR1vrpXVpqIYxKXNbhRmbiHIw",
-	"Z3C+iI5+6TfPYVbex4e4YJ/vQ6dHR4JEmxZnVz+c6oNVR4tBMzrRB8I7fsc0Yj87S9S25cefro//59KZ",
-	"8p8nl5fWQPtN7Ocojvyn0FZ/L4Tiksj3gDUT31O8DG92dp5Mzi5nF1cns8n52WVycnzysblf14jQttoB",
-	"CRiQFRaQoXNDSYl+uJKQobdb9LNxzSmgU5e8kz+2PcnKq05ywRVPOQ2eH1X2YYBtVwIzaZIL9TX7BGTm",
-	"Z0z9hPs40jFoosgaeOiQ0NGUiVLdCJQVwiCiFcwBvk+5ytxHAOKQsjXkuO247Z5+RTeJ1iAlXkLPWT1M",
-	"o2Z67C5aZoFqj9hC1ofQzG3ppfL04uL8Ioqjydn78yiOro8vzrqE8gJMRmzKKUm3HVpw+o/TC6dxpX6V",
-	"CqA/RrFXvOAWBfPx19Rm/gLEp5xBklefm2Bcr0CtTGxd5R6rsNpMzkzynavRDatSDEob8Pokn5vKC0oh",
-	"QwvB1+b78XRygihPMa3WV1zACE0WiKhXEuGdz2ZpIk1i8Yat8AbQHIAha5khQ4XUJ4Y16jv4o1wQbnN9",
-	"mFI9rE0ji4eJ+LvRcJgbND4Q9bGYozksuKjHgYa/shLjMu8Ra6oXWeJTnsFsr0u5mKwSrHO1DZ2wdp1C",
-	"gnj4GpnYJqJg/bMNW4OowNccBFkDU5gmC2vYm051nz6GToRAoLUkalXME1yoVaL4LbAH4mqDn8wddsna",
-	"WZ4+CNvHozZDmLBkUbDUnABht8pcOdTupPQc5OfY25/MpdVs+pZIdBOJgt1EIdAZZ4lJ3BG2LAF/CMNy",
-	"LDClQB87n8h1/xJ/3nHEO0y6uZjsCaFt7m03kPCXmSjDChu/URPUq3Kprw2iRwFL7qORRDvjieKJZS2h",
-	"HRwtoxe7sg4E9P/Tszt4qn+8MRIz0hbhJrKznDsrODdxBvYgBw/f9gFUma1LA2HQsv9uYw6wMb/bht9t",
-	"Q1PJJAiCKfkVskSWSrYnW9uaEnIfL+1tw6SM1AP5s2MWuvQg2h9KOaVgoNbyoyPd2EqTu/RwlREuBB6S",
-	"AjiryaLbNZiJcbck+wP7vkU8gB3LmOueBhJ7F20zoQLT5QBa+/bxpSNMqaWeB6VijWo+ht65IBusICF5",
-	"grOs42psMkX6I0i5ew9PmCQZ7BTEdG6iw7jeTHYfzibEDh1UeTGnJO3GYGq+15H4Ay+UhvsPdcDR3QoE",
-	"NLGTSABOV3hO4Yadnc9Oj9C1L4PQB5HP1lUT2CuFRMEYYctm+U1GMv0tgwVhWo225l5Dmroic4uO01tg",
-	"Gco4mEVkkZuLDwH6Hx1HWDxrpF/wILEtOZ6H1l7uh90YOGH39wUvpN2XT6nZu/qxK8xdut8iVVzT7h7D",
-	"8P3uOvzh1Zt0fxE3KezetAW9UcgU+NxwFAa4Av2OemsDYdMrSV7mV/akhWrJmObRX/kgofPwUAehUYLV",
-	"nh/auUmtXkoE2dPlP7eIFJR8f4FvrvjbIud/HpYG96tNmAKRC1Am82jX3pcM93P/gSnJHjCvvM530z63",
-	"7sHM771EaC4RUEB/xw495Eq6k5ghiMrhe2/8d7fvQyXs45COX6USRRo2OnrKcIwao/ciVN94wOAF34Ny",
-	"DxpYLIu1r5UeZC8Dyx67RYK1AoY52llJdujZzlJV37sNLJGJvCV5DlmotCqOci6J3+FANKZ+ag8/vGmp",
-	"6NaJYgPWgQwqKRliVC9RBOQCpDZ12kaGaVOzsXo5E2IfFNtVs0J7DsRxWuPQbqhBizUbeDBqw99JEEoY",
-	"HFKK6nEtF3VLxB6mgbh1XabWJUh0jKnROTx8MJsC0w+xOd1z6ji3T7IA1vVB3+dwCMLQh9lFwd4TRuQK",
-	"stNNUBVFod0OOySB8BitHQVLZJGmIOWioHs1sqpu2XOUtFbeS4MAwHsoEC5XLFNJVYngJ1v0d9DJcVEw",
-	"f5H4SStZ4NSoDZ0KvtQBcqDYxn1Jwsd3WggBTCVSQV4OGV7k1ph+2H021/6mnicfYoPacDeXDIO2Vwia",
-	"1NrD/4vaC5+9BZ2+jHSYL9xTHzvUpfUC2lEa0iVnQ+T5onpBdKhrX538g13zgxz5ui4MndM+mg7YrWkG",
-	"h068xua9wgEg2iKfim+7kU5LBjflgO9zprT279MmT48WjHd7PgwHf3fCXgT81mG4uQBTW+cLt94LvnaZ",
-	"oDawwy56mgVvwQQaL0RqY/uOF5lzyWmhANmR9gVWLT1qf7UbleUKPCeuXGF/jq0GQE8BnSHPNcwbFAqZ",
-	"Fi4AXcMcNcsUB15MHE6/QgRur64uPiHFkb/cs6+hfMHkIKroZXuo0S7ECiYJZydTlyC8PJlNfXbw3bSW",
-	"GZyd6L/05yiO9KfPcei5iT/HFVFUf/PZO3RxejlbFBQdTydRHG1ASLf96I+jNxpUngPDOYmOoj+N3oze",
-	"RPZ1n+HB2GXb9R/3cfXneEWk4mK7+/O39lPa+yFjxp72Ztcl2ELKHGwh2iSLjqIPoE7dEu7f6rbsuJwd",
-	"N541d5xQ1ZBx4Onv/eedVzQ/vXlz0BuaQS5fV/3nboF565XNZene+hd75j7dXqV27VriM7ZPgszLnGK9",
-	"xpqF0ScilXnp1VQmU6mEtQfzi38iHRm/Yigvx6ZO67VPyeZcBvg65XIAYz/pld7bjOYTcdi/ztp2E632",
-	"gGu883rr/pFC8pB7ns4S5bav9yJyY2GQrhyvUbbva/lLK6RhR5dbqWD9aLESsOYKWnK1c1AApdJXFNYf",
-	"BdasPt6R+KoK8Q7me1B4iBRfGMCfUYyf5J1f+CAPiVT3Ud58KflYZXmAIX0RBTjOMmSF0Uq/4s8j8O5y",
-	"Uo6/tZ+l3j9IBVKebzvEH9ef7T+xAjhnWbbqXZ5EH+K9swKvep9VizqjhfumO/mfriSO7q/k8+rJt0DH",
-	"mfvHeZT+P15cVEPNc17eNa2XF3wf53TCZA6pKp/2A3Plsa1ngk8vOmPvKTy1DPkS0X8fWeKpAvXaPqU/",
-	"uDHBC4iJp6jsceqe0Oa4zO7+gf7ofpAE+ePyqcRkhwtAtWJ5CLVVXoIylWqao+6mNtDWQ1ZgBUzLvifT",
-	"n79D5FSv4/xOwdKFa/iVgcKEms4jJZ0RnvPChOCyYscdUSv3JqBWrPkAWS29x9S2c/A3zIeH414eT6qF",
-	"fsNxjO9eMdzXev6IWYKoOLzTKuOx7K1ycg+3NB/dGv82ebRA5fz38VO8fgfVGsFXIk1Z8E3x5s1Pf0GW",
-	"VyTFFNWs6aPY3xUbPlgWfnNB2jMFR43D4TdzFiDt9pJFVUL+HPIxTqt2KY84D1qSUuuz8p8V1ddbzARk",
-	"ZafJzMsF+O3GMi8jybY+AGyWaUk2wMpma+6xCfYPLGpJgKq2/jlk2vdYleNvtcaM92O80w7DGcYmjd6a",
-	"Vni2+V2Fk2lV2OrdWvbDi8vX40QHqoIXyxXCdpandPwUFrjsQDHlQtmmg8fN7pTfS9/2z6o3yRwwvN4b",
-	"dsjqWK2GjOtpzTpgtm/gOHzoAzdqN+4dtGWtRWfXkRk0CY+2AyemU+qCl97tbtfUp1fz4SG4qzd5mDPk",
-	"J/8WPOIhVUONjN2LnAEfQCGKpaoe1IqCPZTlbomxexYju283/O0jbj3kHaHZikgkFeT2UaA9hX1Djnr7",
-	"jhSzRjtQ04440CbloOuOncnyN35n/TwWoZs9TyUa42/V26m+W7B623VT/6QEWS5dD5uqO4v2WTLIKd+u",
-	"yxxzW7Lebv1D/BgR9YrSG2bdAYSZbQdqe5EKcHVXtvnMl46unV9sk1onhr6jeqOPqF7uhm0IrhqZjtDE",
-	"NMKhkqOcS0nm9lLF9vE1mXLTVplltrOTf/FeIWuuANc5BeUhVCu4YSbRh0pJRF8CHXy/jB6nDe7fyUtF",
-	"B7UHdoOP7WbL4ucKJgJ25oXvBUOFzS8cOLSVDHGb9SwkiFcSzWGF6eKxRsOiI5/ZTGiD+7uNeLiNuHRc",
-	"ehnL8J103fXn+T+t6nbLJ9Z0+xTfDl0SBmNXE6t/KQ1BKd86rAgrXBVbuCYIXgqbpDI1iQjYhgjOzNtE",
-	"V2xs/ndNjsYOipEpj1txqY5MPuJ+jHMSxdEGC4Ln1Lu4viOlI3T0t7/+9W+1KmDz52fNsFbDDMEz+5AB",
-	"nVBeZJ0QyRKk19/svxbbkWnoNLp1t6OjlK9DINamNCF9U/s/LVKf7/83AAD//4hMZQlraAAA",
+	"H4sIAAAAAAAC/+09a3PburF/BaPeaXo6ipVz2rlt881x7ERzElljyfW9Pc4wkAhZaChSJUA7asb/vbt4",
+	"kCAJUpRsy2l78iGWSDwWi31jsfrWmyerdRKzWIre62+9NU3pikmWqm80lXxB5zLgITTgC85SfBwyMU/5",
+	"WvIk7r3uTZeM2IYkht4kSUmW8bDX73FssKZyCZ/xFXzzjdnvpewfGU9Z2Hst04z1e2K+ZCuKk8nNGrsJ",
+	"mfL4pnd/3++xeB7RW9YK1OXl8G2fiGWSShazkOjvAJgGcEEkQG0G8sPpmWVHML+u2VyyMEiZAAwLVody",
+	"aOEI1wmPJUmZzNJYwEMuyC2NYArVQLD0ls8ZueNRRGaMrGj6BRZFBaG3lEd0FjHyO3Z0c0TesyhKyFWS",
+	"RuEPR3Zh/8hYunFWVgOsfSFLKdcBEMUyCf27/346HRPdgGQCAJMJgfHmXyx4POJyc0TesgXNIklgce9O",
+	"p03gudO5gP1Pyhbw+jeDgmIH+q0YvIc+H1WXY2dGBT2PueQ0CkIW0U2wAgxyweZJHAr/YuJsNWMpkojb",
+	"Fpd0R7kkGRBDRNhXNs8kIEhtz4KnQmoszGkUNayrBRB3mYskXVGp2ss//ASvzIbAV3YDRIhrWtP5F3qD",
+	"tOlfg3lPCtoFOKnM6UeDzxo41Bl9N4pXwzQAJJeW6ywxWyI5IkNJVpmQ8QtJhAT5AHBC8wKzIqJieUTO",
+	"gH15DC1i6P3ZDDNYMhrJ5ecGpJuVtUINMiLQu94APDSwZOGl7AY0OuO24bHLhmNvJmQwS8JNoxhxGAdZ",
+	"TDCJ4I7PJ9O+I1FyIhAgdlCEYFcc1+6PuzJiJm7i1RJc7WgG2ZZy5mG6j/Srw3Q5FxEqJVutpdCkqxag",
+	"QDfEazjxht8iG2ZrQmE5WoDiAxoTlqZJ2gi4hmb3jVD9OkmTUbskmTF5x1hMClBaAH0MqaGHApUWJTci",
+	"oGITz720tKCRAMUzixKgcyQqYhWFwTnuDo4Bap8VGqgk35HIW1dUBsNDOrMkiRiNFeSG17eaIVa0OHLP",
+	"EDVgTFIeW0GoH61WSDNgJGRR6IpFkDJ+lvbAsYuELBj5TRIaXljwiF2uo4SGbwxvI6gwPH5cATY5mGRy",
+	"gPv7MqRSjevZ9hmPqUJybU49q94/NeMokedfKhPR9Tric4qoHPxdID6/ddS8F2boYbxI9BIrhlhszQ3D",
+	"j2o/dWcc+xj3fwJSPwKb5lSr1ST+AKThMeqAArkSaopqlmkSJ5mINsSSlLam7CCaRm85JVdsJoCYGQgT",
+	"0CGKpAFBjK4QR6AB0mTNwCzV+FFjB8KAFOTDKXqtA5XDrGzM3YCrze2ZVVnSDRLVEt4vjR0/5RSRzP4O",
+	"21Dr2L7aevd+7ySJY/xYw8Qr8pKcnI9GpydTMhiQN0DnhC0WqD2VCoVPdzQNAfzr+EdoOzoPnObjchMS",
+	"coFSBW0QBuoBYTWt4UnR1QHRokaBqLlduTQlDM9XYUBTvZEcFIzw4DYfkaYp3fSUyyHTjbLR9+p8G9xS",
+	"41GFIUd00WhcAqtpkALtfIU2mRZEnvawgzIT27g1R8xEN/eQET4uzVZffb/AorO6Blopzeelmcn0fDw+",
+	"fUs0VVxcjkbD0Tv49hN8uxz9PDq/GjlEYFrDE9MSPtlWPlpA/jzRot7PvMS+rTKjURA773gFp6VhfEhy",
+	"IARpCiK/TrXsK5fBPAlZJ13f7wHzBkkm15mHTY+FyFZMkMvp2cs/ow+chFoMtkuYAoTS8L4FnYFOOzZu",
+	"/lt37uqy/A7DGJ2FFOwdyW+Vm6A9gagIM/Q8+yz4Pz0e9gSeWhsAh+iDbiezjVT2lovI//2jF5GSfZXB",
+	"OmW3nN15UElmoI9weGhGjD6FKRYOyOCP3wnyO8HBdqPKgbgcDf/vhSAvwHMJX/ywFfHWhcH1bcP2BVuw",
+	"FLbUgwlsJohtSEomTHlXrIypR1RcTIp8N/oottHxJ3dLZc9qGFCIA3YA36pLBpY5822cVXAHma6CWxOm",
+	"UivehlttpTVxKMIY5LGtTLXFAItpDHL4HKTyL+3i2b+V9/1dTLBP9z7t0RAgQdFi5Oq7U1Ss6C16xegQ",
+	"FcLb5C7GhX00kqguy48/XB3//8SI8o/DyUQLaDuJfg0P7CvfVD9nqUwEF2eM4iaeRfTGPxlYAcPRZHpx",
+	"eTIdno8mwcnxyfvyfE0tfNOiAeIRIEtwckJyrjAJfHyJ8a03G/JRmebA4KcmeCd+qFuShVUNMiSRyTyJ",
+	"vPqjiD50kO0ypbFQwQV3zDYCmdoeY9sBhkEfNJB8xRKfkkBvSnmppgUJs1QtBBnMAL6NufLYhwdiH7OV",
+	"6LhuuFW1X9aMIlBvAsi1RVd346gptq0uSw1QzNHXkLUtaGqmtFR5enFxfgEdh6Ozc/hzdXwxaiJKGAIj",
+	"YuMECGnTwAWnfz29MByX81fOAPgSvpsX3imy2PpfYx358yA/AgwF6+J1GYyrJYgW5VsXscfCrVadQxV8",
+	"T+TRdVyEGCQKcLeTjU2tswhMf7JIk5V6fzwenoDHNKdRMb5MUnZEYDAuQZvSyms1NBcqsHgdLylYEjOM",
+	"tmjJzDBQjRpDC/XK+sk65YmO9YH6xmZ1HOl1KI+/eRlm5WoZ77h8n83gKRCw6weq/RUFGedxjz5iPQsD",
+	"G/L0RntNyEVFlTBatvFpWD0OaMx0/zHCdBOkWdzeW22rdykYBEhBlIA7EAULLdjLRnUbP/o0gsfRuuFy",
+	"mc0CmsllIJMvLN5zrdr5CY2yC1ZG8rRBWFePKIbA9QkWWTxXGsBvVqkjB+dMCvsQ20ef/oQmrKbDt0DT",
+	"1z3YieueD/QYJlKBO/ieA77PhuFBIPBg9ND+XKzah/hjxRBvEOnqYLLFhdaxt6ojYQ8zCYbOlN2ICLWs",
+	"nPNrCek9jyS33kiAxjjQVqC3Fsw1/47m3oseGR0B/A97N+wpPrxWFHOEEuG6p3sZczZNEuVnUAuyV/nW",
+	"FVAhtiYKQq9k/1XG7CBjfpUNv8qGMpMByXMagXceBiJnsi3R2loXn/k40acNw9xT98TPjmPfoQdHewjM",
+	"7IgpqJF+0NPta2oyhx4mM8K4wF1CACOHFs2s3kiMOSXZ7ti3DWIBbBhGHfeUFrF10PomFGCaGEBt3rZ9",
+	"aXBTnNBzp1CsYs2H4Bus1VsqQXavA6D/hqOx4ZjgS3BaqufwIPuBdCoJMY2ToBvXGsluW7NysX2Kap3N",
+	"wMFpXsFYvXcX8XtwShHu37uAY0QoZeXVCVDGdL7Es4TreHQ+PX1NrmwaBCoiG60rOmAeAghRPEYup9+E",
+	"PMR3IHNh34CNNupcQ6i8InWKDiIDD9XDhKlBRLZWBx8pwz/oR+h1OqgHEvIhW6PjaXBt6b7biYEhdnte",
+	"cCDunjwmZ1f5o0rMTbxfQ1Xf4e4WwYC4Eh7JsM4C+Do3R67lBX/gX5Sx9znEc8oUvUIpPvfJj69eAaUi",
+	"6cC+kJPxJaiiKNqoNCtXOYYJkIyDGRN7QaOKiy8BsEAY6LB3XTokYC8R8IbnZc5RjikDpfFPliYwPfk5",
+	"gyFj1jl2rma+S7kEnB588hVbJWBORnzFZdPkf8OxK+y/pILECdHdieq+04QZBoWKCTt0hEXdJSnu0ZyB",
+	"y3D4fbIACKDMg09uWaz5YLMib7qxvuXosiRwWdC7Y166adwiL+rqHOflhG3yY0JX68gTiTMr6u7XlISS",
+	"x6HBAC/s4mq9NYqcN6xFRPM3/QK+LQt8nsNga923nkoexI/0+391S6CU6el5XfKkOvhK7ZGM2gSpjj+D",
+	"prQB6C1xcydaXfaNCifNx+K7elClHNV6f9/MZWy1YsK7PU0BhhqSvJRvM5xUDlSd5OzjbueEdrQh7Gu6",
+	"TplURzN67G2nhbbvXwE74R798nwn0+1TLVFAPW9FQnkIDwPaJCTWgq6g+ZTHB1HefGtKVHX6tqX4nUDe",
+	"8BTgy+Z+oYNduq+o1HrrgtyJOzQG2NuX3LIMmt5kK3uZpJt+qg97bAbxJlOpzUFvLqjgsx7GL943C1gu",
+	"AvGFr9cs9OWeYna44HaGHZcxtl1b9sOKlgJvjUsswdpxg3JM+jaqFSngs4JYQ1GHMtKPG0fG4nAqBrlT",
+	"8Kvo5Zuz4xrHzg5VYzFRtoo7KkYU/I0IicDn2yVX3641H9QM0bcwdVxbU7aJS0FpQxsHz/7mnbfJ030X",
+	"mdPcx11zXZN5Vu02eh7l4IWhbWUXWXzGYy6WLDy99bJimqHZoZsEzN8GuQOaiWwOtrVYZNFWjizS/7ao",
+	"ktrIW3HgAXgLBvz53Hmsvcih/qCzonfSHDC+zbT4gEzm0RpO03Ga3GAE0ZONaN4EfvU9z9IU3Twh2Tpv",
+	"0j0LuNR9t4Qf9L1VP7GPDKrDXR7SD9pWIihja8v+XzhXILdmvNs8+262cMsFgq4mrSXQhty5JjrrQs8X",
+	"xRXLXU37QvN3Ns13MuRdXujap66adpitLAa7dryi6kLXDiDqLMhi36qeTo0Gb/MGz6NTavO3cZPFRw3G",
+	"uy0vuoNf7bB1AXZqP9xJylTysc1sPUuTlYkE1YHtdhJezgj2njAkWTrXvn3DlfWZAFNMMqJb6iuqzvmR",
+	"fqonyvO5kjU3+VzbDyEcAFoyjBV6rtishCGfaMGsMWhHynncHU9ud8dflnqO9y8vPuCxvM1+0NdFbUZ5",
+	"J6zgsC3YmLrByOJwg0r2EoOMPkDrya3euOL0ZGxiipOT6dgGFN+OnWAiNIFv+BqjiPDqU993hc+qfskl",
+	"Bs3ydGVycTqZgv2EyYowwC1LhZn+6MejVwgq7FNM1xwe/eHoFTzSN6bVtg3MCSZ+gZb518GSY6Ljpvr4",
+	"W708wX2XNgO7XWrWG6aT04F+lPgZwh713jF5aoYwf4sMhOO8d79UKqJBqRVNBp5yCvefKjcTf3r1aqd7",
+	"iZ2sxKac+uqlndrNxUluEdtb0OpQS6enNM2ar2egr1mq247ZaoWXM/GUT0h1e7bMfyr7k6LR84stO9FT",
+	"pkjXvRyo3NeXNoq7ToRnX8FP7rCxH3CkMx0EfaQdtjdeN81Icy7FDio3Yu8fSCT7nJ03Xvuom4cHoRsN",
+	"gzApzqWrUPZ+VC6FEHYy2YAtv3owWaVslYDordJVRbewKBI2S9u9aO0oClqh+CKz+w5UWvsS9qHiCwX4",
+	"E5Lxo9yd9ut+H0k1a//y7fOHMssegvQgDHAcYm0H3FNN/UAxT0Lw9vRy8K1+1f9+LxYAk3HTQP7ULYXy",
+	"yAxg7GtRyyF8FH7ob+3lqZTwpFzU6GDcly3Q/3QmMXh/IZ6WT755qnjdP8yitB8OTqq+gmSHN03djITn",
+	"MU6H4EaxuczLpUAXfeWgdvX68UlnYC2Fx6Yhm3b/70NLyVwy+VKXJ9m52MsByMRiVLQYdY8oc0wweHtD",
+	"NzFqZwqy6vKxyKSyCwwT8a1sVjcbAEKV/Ys7ag53PaWSRAGWR7RsK0Px6Rk8Jzc3/pmcpQtTRDFkYIVF",
+	"qppTjmdCZ3iZmaK5lm8HVpsz96ycBPg9aDW3Hue6RI49lN7dHbf0eFIM9B37MbYiUHdb6+k9ZoFJ1HaH",
+	"K+WHHrq9RUxuf0nz3ozxbxNH89xGeh47xfK3l60J+wqIxXz53xK9TRxDJo4gfdDOC5vav/e+6zzcQ6kZ",
+	"obKI+woz6h6pvtZStOELvJSPtSK/SxXUNbHZZEsflgAVQpk+5xHEHKKbuxlktnHz5EW95PCD6LApPLE/",
+	"WX5vcYKnJZqmaoXPZI4Q9Lxg9XP3itNj08dgXlRBe4BJUqMUp3zaf1Zgya0c56GVSu24w8WY6vXiDkPJ",
+	"OquFacF3w29ZnNdQNXdIaVXBvBBFNPRJaNqWTofXTr3l+wGtVLkygrGMozeqwq2uaVusSVUgrpVkz8vc",
+	"9vOiMFgdaZkm2c0SFq56WUz3H0MC54Wl8CanriV8XC46/Vz8tr2XW/u6Q3O35HuX0TGnokO7lorrHXrb",
+	"uszdm+45Ub0ef6cpncrbTSrTKxIeLAdOVAF0LJhgzy8qxdAfn827R4FMltR+xpDt/D04ZV1y3UpB44Po",
+	"AEAfiaiQRZ0MLNiy55abIQbmMpdoPmCzB+C0Vp/jiEyxMjymruq7/loL2zpbblWuOY1LVb7Vrwx4qp/t",
+	"dOJW6Sy+87SJp5EIzdvzWKQB6j2/8dd2EOv+morK2gPBfHNjStMVRdfQZgkZQL1Z5cccdcp6s7H1dfpY",
+	"ZC6KrmNtDuCvC6jC2brEuHUCTU25zw3FuD/r2vOGDO0PpZTKg+Nw1zGWLM/rk+PPY2B9u0gkBBYt+Eyf",
+	"6+ny/OqwRv1aAqxIFWy0hWyKxapTaPSPpYUQ3l/HytEnOSWSz57C/J+PHsYN5u/wUN6Bcy20s9ou/xLB",
+	"UzkTHjlz4KNpXzr+gR2HOpNhyQCkViwcBlQ+Y0saLR4qNPRyxBOLCRS4v8qI/WXExOzSYSTDM/G6Kbv3",
+	"X83qespH5nRdQEI3veExG9As5LL8SF1NqzQz2dv4JJcXORug9+Hny8IFMSVJLLFWyvioBE0G3Jkm8UqX",
+	"GVGZ9OpXzV4PDBRHKpFzCVzyWoUt7geYFt7v3dKUY4zBiC5bj9rsR+8vf/rTX5x8dfX1E+5rrVxWmoT6",
+	"lg45wbqMjRCJHKSX3/RfvdojVc7x6Is5xz+C/feB6HQpQ/rK+YeU9+n+X3tVCVJpcAAA",
 }
 
 // GetSwagger returns the content of the embedded swagger specification file
@@ -29,7 +29,7 @@ type ServerInterface interface {
 	DeleteEnclaves(ctx echo.Context, params DeleteEnclavesParams) error
 	// List enclaves
 	// (GET /enclaves)
-	GetEnclaves(ctx echo.Context) error
+	GetEnclaves(ctx echo.Context, params GetEnclavesParams) error
 	// Create enclave
 	// (POST /enclaves)
 	PostEnclaves(ctx echo.Context) error
@@ -48,6 +48,9 @@ type ServerInterface interface {
 	// Set enclave status
 	// (POST /enclaves/{enclave_identifier}/status)
 	PostEnclavesEnclaveIdentifierStatus(ctx echo.Context, enclaveIdentifier EnclaveIdentifier) error
+	// Get the audit log
+	// (GET /engine/audit)
+	GetEngineAudit(ctx echo.Context, params GetEngineAuditParams) error
 	// Get engine info
 	// (GET /engine/info)
 	GetEngineInfo(ctx echo.Context) error
@@ -71,6 +74,13 @@ func (w *ServerInterfaceWrapper) DeleteEnclaves(ctx echo.Context) error {
 		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter remove_all: %s", err))
 	}
 
+	// ------------- Optional query parameter "label_selector" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "label_selector", ctx.QueryParams(), &params.LabelSelector)
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter label_selector: %s", err))
+	}
+
 	// Invoke the callback with all the unmarshaled arguments
 	err = w.Handler.DeleteEnclaves(ctx, params)
 	return err
@@ -80,8 +90,17 @@ func (w *ServerInterfaceWrapper) DeleteEnclaves(ctx echo.Context) error {
 func (w *ServerInterfaceWrapper) GetEnclaves(ctx echo.Context) error {
 	var err error
 
+	// Parameter object where we will unmarshal all parameters from the context
+	var params GetEnclavesParams
+	// ------------- Optional query parameter "label_selector" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "label_selector", ctx.QueryParams(), &params.LabelSelector)
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter label_selector: %s", err))
+	}
+
 	// Invoke the callback with all the unmarshaled arguments
-	err = w.Handler.GetEnclaves(ctx)
+	err = w.Handler.GetEnclaves(ctx, params)
 	return err
 }
 
@@ -167,6 +186,38 @@ func (w *ServerInterfaceWrapper) PostEnclavesEnclaveIdentifierStatus(ctx echo.Co
 	return err
 }
 
+// GetEngineAudit converts echo context to params.
+func (w *ServerInterfaceWrapper) GetEngineAudit(ctx echo.Context) error {
+	var err error
+
+	// Parameter object where we will unmarshal all parameters from the context
+	var params GetEngineAuditParams
+	// ------------- Optional query parameter "enclave_identifier" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "enclave_identifier", ctx.QueryParams(), &params.EnclaveIdentifier)
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter enclave_identifier: %s", err))
+	}
+
+	// ------------- Optional query parameter "page_size" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "page_size", ctx.QueryParams(), &params.PageSize)
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter page_size: %s", err))
+	}
+
+	// ------------- Optional query parameter "page_token" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "page_token", ctx.QueryParams(), &params.PageToken)
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter page_token: %s", err))
+	}
+
+	// Invoke the callback with all the unmarshaled arguments
+	err = w.Handler.GetEngineAudit(ctx, params)
+	return err
+}
+
 // GetEngineInfo converts echo context to params.
 func (w *ServerInterfaceWrapper) GetEngineInfo(ctx echo.Context) error {
 	var err error
@@ -212,6 +263,7 @@ func RegisterHandlersWithBaseURL(router EchoRouter, si ServerInterface, baseURL
 	router.GET(baseURL+"/enclaves/:enclave_identifier", wrapper.GetEnclavesEnclaveIdentifier)
 	router.GET(baseURL+"/enclaves/:enclave_identifier/status", wrapper.GetEnclavesEnclaveIdentifierStatus)
 	router.POST(baseURL+"/enclaves/:enclave_identifier/status", wrapper.PostEnclavesEnclaveIdentifierStatus)
+	router.GET(baseURL+"/engine/audit", wrapper.GetEngineAudit)
 	router.GET(baseURL+"/engine/info", wrapper.GetEngineInfo)
 
 }
@@ -248,6 +300,7 @@ func (response DeleteEnclavesdefaultJSONResponse) VisitDeleteEnclavesResponse(w
 }
 
 type GetEnclavesRequestObject struct {
+	Params GetEnclavesParams
 }
 
 type GetEnclavesResponseObject interface {
@@ -447,6 +500,35 @@ func (response PostEnclavesEnclaveIdentifierStatusdefaultJSONResponse) VisitPost
 	return json.NewEncoder(w).Encode(response.Body)
 }
 
+type GetEngineAuditRequestObject struct {
+	Params GetEngineAuditParams
+}
+
+type GetEngineAuditResponseObject interface {
+	VisitGetEngineAuditResponse(w http.ResponseWriter) error
+}
+
+type GetEngineAudit200JSONResponse AuditLog
+
+func (response GetEngineAudit200JSONResponse) VisitGetEngineAuditResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(200)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type GetEngineAuditdefaultJSONResponse struct {
+	Body       ResponseInfo
+	StatusCode int
+}
+
+func (response GetEngineAuditdefaultJSONResponse) VisitGetEngineAuditResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(response.StatusCode)
+
+	return json.NewEncoder(w).Encode(response.Body)
+}
+
 type GetEngineInfoRequestObject struct {
 }
 
@@ -501,6 +583,9 @@ type StrictServerInterface interface {
 	// Set enclave status
 	// (POST /enclaves/{enclave_identifier}/status)
 	PostEnclavesEnclaveIdentifierStatus(ctx context.Context, request PostEnclavesEnclaveIdentifierStatusRequestObject) (PostEnclavesEnclaveIdentifierStatusResponseObject, error)
+	// Get the audit log
+	// (GET /engine/audit)
+	GetEngineAudit(ctx context.Context, request GetEngineAuditRequestObject) (GetEngineAuditResponseObject, error)
 	// Get engine info
 	// (GET /engine/info)
 	GetEngineInfo(ctx context.Context, request GetEngineInfoRequestObject) (GetEngineInfoResponseObject, error)
@@ -544,9 +629,11 @@ func (sh *strictHandler) DeleteEnclaves(ctx echo.Context, params DeleteEnclavesP
 }
 
 // GetEnclaves operation middleware
-func (sh *strictHandler) GetEnclaves(ctx echo.Context) error {
+func (sh *strictHandler) GetEnclaves(ctx echo.Context, params GetEnclavesParams) error {
 	var request GetEnclavesRequestObject
 
+	request.Params = params
+
 	handler := func(ctx echo.Context, request interface{}) (interface{}, error) {
 		return sh.ssi.GetEnclaves(ctx.Request().Context(), request.(GetEnclavesRequestObject))
 	}
@@ -724,6 +811,31 @@ func (sh *strictHandler) PostEnclavesEnclaveIdentifierStatus(ctx echo.Context, e
 	return nil
 }
 
+// GetEngineAudit operation middleware
+func (sh *strictHandler) GetEngineAudit(ctx echo.Context, params GetEngineAuditParams) error {
+	var request GetEngineAuditRequestObject
+
+	request.Params = params
+
+	handler := func(ctx echo.Context, request interface{}) (interface{}, error) {
+		return sh.ssi.GetEngineAudit(ctx.Request().Context(), request.(GetEngineAuditRequestObject))
+	}
+	for _, middleware := range sh.middlewares {
+		handler = middleware(handler, "GetEngineAudit")
+	}
+
+	response, err := handler(ctx, request)
+
+	if err != nil {
+		return err
+	} else if validResponse, ok := response.(GetEngineAuditResponseObject); ok {
+		return validResponse.VisitGetEngineAuditResponse(ctx.Response())
+	} else if response != nil {
+		return fmt.Errorf("unexpected response type: %T", response)
+	}
+	return nil
+}
+
 // GetEngineInfo operation middleware
 func (sh *strictHandler) GetEngineInfo(ctx echo.Context) error {
 	var request GetEngineInfoRequestObject
@@ -750,34 +862,49 @@ func (sh *strictHandler) GetEngineInfo(ctx echo.Context) error {
 // Base64 encoded, gzipped, json marshaled Swagger object
 var swaggerSpec = []string{
 
-	"H4sIAAAAAAAC/9RZW2/bNhT+KwK3R9XK2oeifssSrzXW2obtYBuKQGXEY5uNRKok5TYw/N8HUtTNohU5",
-	"TQw0T5Z0rt+58BxmhyKepJwBUxINdyjFAiegQJgnYFGMtxBSAkzRFQWh3xKQkaCpopyhIbq5GV/7ntxw",
-	"oYAB8fJnLjyGE/D4ylMb8Kwg5COqeVKsNshHmgINXVp8JOBbRgUQNFQiAx/JaAMJ1urVQ6q5pBKUrdF+",
-	"r2kTvoUQx3HbvPHKMwK8nMjDcVxYIwfeVG1AfKcSPM7ih4JGKp6mQGp017DCWaw8Kr0VjmXpyLcMxEPl",
-	"Sc0Qh8V3nMeAGdrnNsuUMwkG5wlX03v9I+JMAVP6J07TmEZYuxF8ldqXXU3k7wJWaIh+C6rwBflXGcyt",
-	"6DFb8VzZQcQY/EghUtpDIbgwGFpmLfsypVecKUwZiGu4y9afOIEcWYMCGhoMfAQsS9Dwc/6kUb71W776",
-	"DXELhVVmU8vwovnNZDKevEc+Wiyns9noGvloMp2Eo3/Hi+VoskSVzCLiProSgBWMbFLptBU8BaFoDidO",
-	"aRgVKsOYr8MYtuBIDivBi/naMyS+Z32UnuLeePLXFDnUN+VvQUjKWajw2pGefpneeY44CBILb1dMraUm",
-	"EjpaG57FJMQpjUKRsZCykOhIhX1kueObJ2VRdJ+bZnf5XAWI332FSGn7riEGjfEiSxIsHtohyiuFhHUt",
-	"IWYkzDJKDAVVkMiesExwApeM3GSUaO3WHCwEfjB+teyzfJezcYnEBy7VJxxtKMsLp2XyWqRRmHKhQs7C",
-	"DZcqTHLyWlApU7AGoVXQtIOu0bwqzB08fofe236eud25E5SsIaRpiAkRIKUzN6uQU+IkqKyjTFICRUSP",
-	"gnKU7AgmDQtcEjps8B1edoA2Lg8g2caryFSdoE4kjtZ3eTYe4z1SeYbaym1J6XLDGe9mAdfzKKSWoUel",
-	"dVVMqzM+Ua5bmCyPjr69zR429SyWPcVYq2oS9IljOh5NHm2wS5qAVDhJ6+3/aN48of2fN9Vc+B2JziFQ",
-	"1ruOZC1mi2IeWI4WS+Sj2Xx6fXO1HE8nzgHA0fdbCX8UpH7QWCweK7Z+E83o02z5X5cnSyzWoNrCtIgj",
-	"fOujJxWYb8Uh3ScLGvQubxszZUthZIO44iLBCg1RRpl687qanWpHQAJS4rU7MvmLftPtUtMeemIEVDr8",
-	"3LIuh5ZWZQH4aD6fzpGP7Oz3z+XcBNMVgqrM664TrOCVTf5D2PUJaAFUVMX629+ZUFxS6c1Hi+Uqi73L",
-	"2Rj5qIwduhj8MbjQ6ngKDKcUDdGbwcXgAvlmjTL4B8Wmko+4MShoD7tmJnMtN8upl/OY5ai2rkkvk2Be",
-	"mD3HK5dD70u16XxBxjRhqn5MSkWjwiS/sVR+dke3IglqS9T+9mBRen1x8Wxr0uGM6tiUFlkUgZQ6LIUZ",
-	"yBDZPcitoLQ4yPc6s14Vg3ARBqjgUXgtqzJEt3qqAiO9iet7UDVQfwoXTAjVn3A8axRyjzOoOJ0PKuo8",
-	"4H2kUj0CXcqlA7sZl03wvmUg1Z+cPDxbPjWX0n2zNenteP+CydwIznlikftbu9ppBWPvV50p2FCpeL4K",
-	"PpbdHyzpT+J1ygZZH/3bG+T5crt2PeXRmk2PobtrX6Ltm4dBV49ugXBy03bc4R1r3udosVIJ/tCVmo+3",
-	"2LNi8qv2gPdQtmOPgMI0BuKZEecpCRtgoegKR+Yq+gTqIOYRjl+tqJ6oTmLUs4aCp3BKEFsaaTr766Dy",
-	"TpG1K34+t4yA8O8s5pj0EhbzdT/gC99PIg4izhhEeTKewlcdHCcwPTkqnRJ0YSSYkWeQBIyknOoK25mb",
-	"K5YldyaiW0xjfEdjqp7B5RNiqrCIsbg/iThIcXSP1yCfxhXs7K+Qkv1pIvLG1luv3apP7vmL4lrjl+j8",
-	"xXXV+Xt/ef3zpIH8xWF//mHfdW3Tf+R/+eAsegUnrx39EBT3EsdLpLxvetFELrWcNYu11s65pWw88AOi",
-	"TNujG659GZYvzYVp1XW1JhDbIn2b3nzUQ4sHbEsFZ4mGzkeZiNEQbZRKh4GNzMAMNxsu1dCcFPsApxT5",
-	"aIsFxXdxHgP9ofEfWvTu7dt3qPwXbf54qzE9NGMmOMnMsexdxTwjRy2SpUmvdvbG0Hg7iDTb4N5eZA0i",
-	"nrhMrLE0Lb2o/emo3+7/DwAA//9gNc1rlSAAAA==",
+	"H4sIAAAAAAAC/9Va3W/jNhL/VwjdAffitdP2oWiAe8glvl3f7dpB4uCu2AtcWqJtNpKoklSybpD//WZI",
+	"6suiFDlNAtRPlkQOf/M9HPIxCEWSiZSlWgWnj0FGJU2YZtI80TziehWL7YqlYUzv2YpHMJJvOJP4PWIq",
+	"lDzTXKTBaXBzM7sYEbUTUrOURcQ+C0lSIEnEhugdI44Q0YJsmTavzCoEVoGPWnKmYOyIAG0txR7ouCmK",
+	"cPiTRywKRgHHBX/LmdzDA9KHRw/GUSDZbzmXMOd0Q2PFRoEKdyyhiF7vM5wGy/B0Gzw9jYLX5rIAmlG9",
+	"G4xTy/wZmDFds3ilWMxCLTwQz0WS0A+KoTI1QIy50ojsju3/fk/jnBFDQQHsNN7XASuyo/ewDKFx7HhJ",
+	"CJWMhCJVgFd2Cv8AUz8DGd2yleK/szb2JYBJ6Dee5AlJ82TNJOKoDMOgxfloIRuax1qhLX13ctIBrFqr",
+	"1xg2QiZUw3ie6h++h7EONTyyLWiohK3FHUv9uM2nOkZn5SNCFZFM5xIN5oHrnR0h2T0XuXLs4KsNl6Aq",
+	"M3XNUA/lLL4heaqY7uPSQjvS5iVLBBgjKLzN1AxYQWskdpCxisJSxmQBiOUDV8yakRujtMiymteOyYXV",
+	"E+GKGDwdLNSAeBCvhYgZTQGywawgZilmgtRc6MUd/gEbBZfUJnBlWcxDimxMflXC6Ksi+VfJNkDyL5Mq",
+	"9k3sVzW5cqRn6UbYxQ7cP2XfMrBx5FBKYQ3DTUbaZxk/BxyUp0xesHW+/SIiZ+ZGCqVOGFh3cPrVPqGU",
+	"b0ctXkcNctea6tws4uYGVzfz+Wz+EeR1vVxcXk4v4N98MV9N/zu7Xk7ny6CiWWgcSGK4/Sy2JtxLkTGp",
+	"OXNkjZfhX65Zop6TVUFpCvP2SNqtRaWk5hlkpVd9XjNNMr0H8zHGH1Nn+8HIa6eFUX8tgbZXqBgW619B",
+	"TXWGLcwW1zS0YB7boqJymydFaqQRkIGRNL5szG/NageGkk4RHMIdTdHnFQvBwzE4RBRtKvDAD8EjfIkI",
+	"CZ9dzmzUMRnIJNMoAu9Q5GHHUhfZt2A8JBJMpX/TxImxmqlGNUTkASJVQiMIP/vAYztF6rIue4hogWEA",
+	"YhQGqxpN8Hu6FrkmlCigE9fTY+cKec6jN1rBuO1Q0hvK47peKjqaJ1Ci0CR7zk2W5cBDO65IlGoeFfZY",
+	"tz6fVZ9LBsl96vhsW3XGV2EROUwJF7N75onxjoKpv8yQZmKdzf+58HHfpH8PBSOQW2m69brEod20B3zL",
+	"UChlnVk5ZZ9kp3aWY+HMzgFqPIohIIBwwSagJgGckWpz/kk8ANeQZOt1aUhTshUmSaNBcZACukrJKpiI",
+	"tBVSujfOBAqEXA3lA5ihJpYP8Cn4X7icfUdgiEvqCK+e0MvaI+8qPlzN90fi0Jlccy2p3GMZOKmXgYRq",
+	"TUGiEeq7Wb22rC5x2axXK3a6SXyYHBnoNaPhHUbpWEBW9rnfGXFDEITMUyj2G3pxIrb1kBGiHjUGcKxd",
+	"U9gdENTbRorEfH6gMiEZJFQUOGqzrHQfoJrFGuwDAqzXZQoL+QINkCRmU6R8XtDgzQ3zBmqwGk5jqEIj",
+	"8q/rxdyRLNIBkilW9C4DthhHK/C5cAWyWfF0FWFtsRqiDn9FggFMx0c6R8xRcNaQB1i8kSgK8Shbb+X6",
+	"Wujoizu+IHkB+xFk6DqHHZEv+duis4o7uMoKdG7yz/BKyJn8HGafpdEN5q5WOfTkwVdErstZqaJPQukv",
+	"4I7w39SgLchbmYWrDLafK2B8B6NXiR1eCwK1wMGznnEd9ZVnzqhn3dthnPnZWUsegfPAkq508caySuW2",
+	"LGgNqNBxs1MtNNoplM5hHTJpIPBR6MEw8nDZIzST1miRALuq1ZckRlZSNunxiMqlO6ke7dGOhR4BzMrO",
+	"iPLtUpoVYssUOouMsmnTNbcj9JjRjm6LSh8bXoNvRrC6I4HZ2AkDQk1fyGiVZy+k6yemym3o0KzjNq51",
+	"N1YDyThUNQpY9r7Ifp+1G9ZwuwGoan76sgrtNcqr97V2nwo7DORQV467Hn8pWiVFe2M5vV7CtMurxcXN",
+	"+XK2mHv7GZ7c2/K5TiENE42TxXP+PqxBM/1yufy5j5MlbPyYbhNDEh3ztp3Vgt3+F4XSECtojPdy60su",
+	"NZwXoLarxc+OaS/iRpethTl0djBkXwQOrrBq7navYf2+JY5t7c/xZbXGyCLzyaRBpiaL6dXV4gomum30",
+	"f86ujD34ZLKs9xNK1iPY5H9w/nOoOUzKToCa6xi//TuXWijYC12BEjZ5jJtXmFmqPzgZfzc+weVA4Cn4",
+	"Lbz6YXwyxu45nlIY+U+KPZLdFkAJ7Wn2mNLa1+5dLoidY9rFjcOFXDHzwnR+SXnWRH6per+/BAaaDauz",
+	"qFxoWkAaNc6ovvq1Ww2Z1NrKT6NnRx8cYzzdHjSbvz85ebVW8+HmxNNtvs7DEIwPFVnAsDt710v2L1Ai",
+	"ntjeuGlRFzugQnGsEijsnlTl+8EtltPMUG9q4iPTL1bDawu2K8cOyJxFWXPgxO8j/c94Ftcv+wzKubbw",
+	"L+FtTfqu9fQPEe1fzSCbLcWnZjTEI4qnN/SGhnLeRxeW33q761AZMLwMhpMdqE7YJsJz7vHJDf2D8jqm",
+	"91DfM7V7D+9n27UzQsJrmJ6T7mP7WPypmX/60kJLCEcHKM+pfFeQeo8YbS4/9Jnm8zH6XWXyZ40BIK/S",
+	"XCOmzWkPMVXVSwx2QiERbWhoTgyPGD3Bvnj8YcOxiDtqIpY3UCG+YKY5yAhxnPt34HnH0Hos/r42jUkk",
+	"HtJY0GgQsVhshwm+4P2owWBUacqK7c4R86rEccQk3EUfie/FiuylgL6U0DR6BUosjTLB0SkfTZvUXvAB",
+	"I7gHv6NrHnO9f4VlhpuBpjKm8u6owRN3UKNeNgtYd+dFPHo6joSNhYPXde2Do9PEddG/+VMki6I1+P7p",
+	"ouxzvaiGf3Oxv/7+wNefGr5LeHvlXA9SjvUdfJiYm6c1H2lC/Ojup9qbIO5ejN5JkW939as1eESs3WWc",
+	"qjuKZ7t43izz1JxXF+46Iil7wEsD5q7fmCx37H9pcb+RlkfcUIVQexGQ4rUW7ADZ82688dS8IWgUXT/A",
+	"bt5DrN8yHLe6KyYQIBvmltTR1td7Q3hAv6W6nTl0sL3n9aaRpbwh945BpXER+hmzZffuTlrtXdEI7I72",
+	"ZY/4TWNyucq7BmTjh31Ve5lD2TcW5ogHawf3clW+NIccVQFhbnjI+8IXmtx8xpId1r7nUqR4TwtPCGQM",
+	"X3ZaZ6cTp5mxKe3xmO/UFD1Q7WQcW7JUcrqOrQ7wQ+OSaPDTjz/+FJS3RO3jLcr0EMalFFFuilJyHos8",
+	"6kSkSkgfHl2X33A7DnHa+M51jscgeh/E2pQm0pPaD7V++/R/SEFlQVUwAAA=",
 }
 
 // GetSwagger returns the content of the embedded swagger specification file
@@ -27,6 +27,9 @@ type ServerInterface interface {
 	// Get service logs
 	// (GET /enclaves/{enclave_identifier}/services/{service_identifier}/logs)
 	GetEnclavesEnclaveIdentifierServicesServiceIdentifierLogs(ctx echo.Context, enclaveIdentifier EnclaveIdentifier, serviceIdentifier ServiceIdentifier, params GetEnclavesEnclaveIdentifierServicesServiceIdentifierLogsParams) error
+	// Watch engine events
+	// (GET /engine/events)
+	GetEngineEvents(ctx echo.Context, params GetEngineEventsParams) error
 	// Get Starlark execution logs
 	// (GET /starlark/executions/{starlark_execution_uuid}/logs)
 	GetStarlarkExecutionsStarlarkExecutionUuidLogs(ctx echo.Context, starlarkExecutionUuid StarlarkExecutionUuid) error
@@ -144,6 +147,24 @@ func (w *ServerInterfaceWrapper) GetEnclavesEnclaveIdentifierServicesServiceIden
 	return err
 }
 
+// GetEngineEvents converts echo context to params.
+func (w *ServerInterfaceWrapper) GetEngineEvents(ctx echo.Context) error {
+	var err error
+
+	// Parameter object where we will unmarshal all parameters from the context
+	var params GetEngineEventsParams
+	// ------------- Optional query parameter "event_types" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "event_types", ctx.QueryParams(), &params.EventTypes)
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter event_types: %s", err))
+	}
+
+	// Invoke the callback with all the unmarshaled arguments
+	err = w.Handler.GetEngineEvents(ctx, params)
+	return err
+}
+
 // GetStarlarkExecutionsStarlarkExecutionUuidLogs converts echo context to params.
 func (w *ServerInterfaceWrapper) GetStarlarkExecutionsStarlarkExecutionUuidLogs(ctx echo.Context) error {
 	var err error
@@ -190,6 +211,7 @@ func RegisterHandlersWithBaseURL(router EchoRouter, si ServerInterface, baseURL
 
 	router.GET(baseURL+"/enclaves/:enclave_identifier/logs", wrapper.GetEnclavesEnclaveIdentifierLogs)
 	router.GET(baseURL+"/enclaves/:enclave_identifier/services/:service_identifier/logs", wrapper.GetEnclavesEnclaveIdentifierServicesServiceIdentifierLogs)
+	router.GET(baseURL+"/engine/events", wrapper.GetEngineEvents)
 	router.GET(baseURL+"/starlark/executions/:starlark_execution_uuid/logs", wrapper.GetStarlarkExecutionsStarlarkExecutionUuidLogs)
 
 }
@@ -197,40 +219,47 @@ func RegisterHandlersWithBaseURL(router EchoRouter, si ServerInterface, baseURL
 // Base64 encoded, gzipped, json marshaled Swagger object
 var swaggerSpec = []string{
 
-	"H4sIAAAAAAAC/+xa3W/buhX/VwhuwDZAtbLeh4vrt6LX7Q2WJUHirhdoA11aOrLZUKTKD7de4P99IEXJ",
-	"+qBlOQtQDFheYlPnm+f8eHToJ5yKohQcuFZ4/oRLIkkBGqT7lgr+xfBU0y0kOWX1MuV4jr8akDscYU4K",
-	"wPMgaYRVuoGCOB4NhWP+s4Qcz/Gf4oPiuCJT8ZVYX1EO7xw/3kdY70ornEhJdni/jzDwlJEtJDQDrmlO",
-	"QVqZGahU0lJTYS378OHy1wipjZAaOGSo+i4ksqYikSO9AeQF4ajypiR6c3AmoCXCEr4aKiHDcy0NtH3z",
-	"ViotKV87M3PBmPiWMLE+GrA2SUDYSggGhDtp3BSWLmGUw1F5XaKARMo1rG1Y99YXbSRPCGOjNvbJTtip",
-	"QG5pOr45yw0gT4cOdPWupIJrQjlIpDdE+6WiIDyz+2lYhlaA4DukRkOGKA9vX8CO87avFmAMzRIF+lh8",
-	"BnRjapoK6OkLZLnSRDIiH5PKVSq4UxGOpuH0q+kEUwukJUkfq0SvRdgYE3TvRaNKjC2LkqSPZH2kFI6Z",
-	"ck5AXcKpUnBVpe+10DePHl80cBdeUpaMpsQqiL8o69xTS+IYaNx50Zc8F5WyHhxw+F5CahMGpBRVAXhm",
-	"K9uDjkM/KUqQmlZmMr86deMirGkBSpOiPGXzsiGsglNH8lOltC3poVEjVl8g1VZPFycHhtvPRAs5EW5v",
-	"anLrAXzXSUm0BsnDtdE2tlHUYxyx+aZlG3BTWDG/3izuk7c318s3l9fJcvH7EkfV2vXNMrher/3zzfLt",
-	"b8nd4v3i9xBL+/HBpMP2dTJnEMVUZG77cyELovEcG8r1T69xNADUCBeglC2h40kyLYeXlrYfZSfgoCOq",
-	"LAvFuCOmFeDF3d3NHY7w5fW7Gxzhj2/uri+v3wdjcl9B2pU/Fboh4UInuTDcgl0AISfXSc1tD5VktetI",
-	"c2CQZdRWL2G3Hf0Tkrmlro7LPhCpGgYXDhEGjkK9LDjc5Hj+aVx3Le2Sa5ClBO1wrJK9j6bx/oswmj2D",
-	"b1Hjsmd76KdP5cvDWBC6IobRaKAfRsKVHC+CkEUN+UNowzrkPfVjroRLmR5ZVVqatDomQizTPepQn3So",
-	"rXgCcS5OuDziBpFrU9TN/aQePCD2jRcSKuVqc8iKQdKL5wAFWs+TqrsIEalEPdKyhCzUZEa4FIrWGs50",
-	"47ZmHdmPyrCoFbejLnZsnbhBTSRDGzUaFAmlBAXcQssWwrFRIClh9N+QJVbcljAzIXeDXCGdE328be1Q",
-	"/0RlpuCdM/X4kZpTBkcDUrdmJ+X0fG2ERnWj5W2a6NsdKMP0KJQk8ghNK85h8snbFGA/B3OO87R9Hp5k",
-	"Aa/bRD/mcAjaMObZneHvKKdqA9liGyxFaXiSe5IEwjS2OgxPlElTUCo37GRFCqNLM2Gfh5JPxiBg8IkI",
-	"3EqxlqACLV7pnyThMzM1UgLXidJQNiTTG78OOzfFqnp9mQAHWmjCHJ96TuEP7e6KDJt2MvLdaJ0Iet2d",
-	"12+b3dfU5sW86cZQzYCjs/vQwzE1uY88q+ts59BUniGOnqGtW7NTGT8SyatUnGpiLmwLfdi3fls+qIht",
-	"Q/BjAHCgfywL63gMbPx24sF08/sMJx2oVYfsXrYHKk3NZ0TDK03dMR6YNdWwpKlm9tk/jNRCUYXuFvfL",
-	"3DD05vYSR3gLUlWldzH7++zCqhMlcFJSPMc/zS5mFzhywzAXh9jPhO2XfXT4Gm+o0kLu+stPwxnyfgpN",
-	"TKSmOUm1Oo86ZiIl7JVtcM5klFAIDc/h9K/tKn4aTlzPdDZ+qj++tIw4E984EySbJKyehq+rkUYXot+D",
-	"RoVhmpasuUCoh9kKWVaUCu4PEraboeWGKgQ8KwXlGqWEI6UlkMINZh39aoeA6g1IpLS1nq8/c4I+wkqJ",
-	"9BG0lcfBQSb6q4RUFAXwDLK/ISERgzVJd+i35fLWy6V8PcORH8tRwS+zyuqF99n/v2wcvqqm+u1bnyNH",
-	"zIEkDtyOHMPXFtdgYjSBp31HMoE8dAc1ga1/yzGBpXvZsn/oDbhfX1y82Hi7PZALTLfvmz4R1SZgR5QT",
-	"/1oREt5YG1ezeDcSN0VBLJC5PPeb/BfVTXCLuMSWyCfcpBx2I6cThVVLmVSFDbAc0v88vjNA+QVQbFRC",
-	"7C+wXkBSjSQqfiqF1L5L3cdkSygjK8qofgGXT2NgfX9nCf8HQM5XkPL/fyj6ncf1f/z7YfjXzvHng55v",
-	"u6cVpSeO/X2seh5X/OQ/JTTbnyeiiutkvdocSNeUQ+wbb7vSyGyG9xZswnfJJxDnvocmIkeEH+6xD/fb",
-	"7icD30ACopxqSjRkyCjK1+gPCVpS2PobJ6J2PP1j9pkvN4DcF9+8EY5W7kcIyhSQIcHZDgmeAiI8Q/C9",
-	"pBIQyTVIT1NW9+oSvUYbYaSqH0pwme40nA+OaCI2fubTwXFw1aMGKx8MzZ6FiMd+IvBfw8JZ9xX9Acvw",
-	"dxXj2PHVgNIvAh2B1BxDEX8fWge7a+KVfatDwLdUCu5uDiJsJMNzvNG6nMe+9Gbu7W8jlJ671mAf29fY",
-	"CG+JpGTF/GhPSF9e3kH8y88//4Kj5prYfXUW9c24lSKrJjfoLRMmO2qRakx69VT9r0p8llq22aN/FZ+l",
-	"ogiZ2GLpWnrR+rNb+bD/TwAAAP//53BKX7omAAA=",
+	"H4sIAAAAAAAC/+0a227bOPZXBO0Cuwu4drbzMJi8GYnbGpOJA8dtZtAGGlqibTYyqZJUUk/gf9/Dm6wL",
+	"JcuZzhYLbF4cUed+4xEPn8OYbTNGMZUiPH8OM8TRFkvM9VPM6OecxpI84mhFUrdMaHgefskx34WDkAI8",
+	"PPpAB6GIN3iLNI7EW438d45XAP+30YHxyICJ0RVbXxGK32j8cD8I5S5TxBHnaBfuYQHTOEXAgiSASFYE",
+	"wIBmgkXMSSYJU5K9fz+9HARiw7jEFCeBeWY8UKIGbBXIDQ4sIZBRa5MhuTko4+EyCDn+khOOk/Bc8hyX",
+	"dbNSCskJXRsxHwExUuuiKd8C2OtXShYNKQLJAkDHaDsIUJpaIbfBGku7DnqQVZBTgaWTueaBMs+ytCuU",
+	"CnyyKyZ0DZ6YKJoLpZ7PGSuWpuwpStm6NSzKIB6TLRlLMaKaGs23Ci5KgW8rvSqQhyKhEq9V8OyVDWTO",
+	"aQQG7ZSxDnZEToH5I4m7Q1C52MIFBzgXe5ArEoECHJ6QtEvbLaKJito8TYIlBOhXHOdSuZ36g9Qjx2lB",
+	"6gjkOUkiFVct9mnAdbEpgqvGzxM+QiKeIv4QGVXBcpqF35o5JSBW2ZiQMpKj+MGksyOhbIyCW0s6MGRU",
+	"8mcAitYtCd8myikG1QEnII+ECd9rJmcPtopCIdLmRVmWkhgpBqPPQin3XKLYlY9zS3pKV8wwqxU9ir9m",
+	"OFYBgzlnJgEssqJdymdd5znLMJfEiOrqnbGGx3cOwPmnAeBixFFoTwftLSVGQESAliyXUJtpugMAGazA",
+	"UQ7QVMZw4GHmvGV9GrUFjX1v0uwJiYDntMatiBR45YoxhJDF7GYPKJHI4xjjBHskuNtgUJZrjRX1ArQu",
+	"wmI8vxrPf47m76+jN9Pr6e27yWVD/aIEwQrZYhBimx0LmkUBWOTfiVV/X86Aj4ZGWYBaaAyqoXRfSM+W",
+	"nyE4lRh1Fjr88q2iPrm+uBp/mEQX88l4MbkEam7ldjG7uamsXE5uF/PZb3qtYkD1sGguO7uq9cn8w/Ri",
+	"Eo0vLyvPByZuZT75ZfZBr4xvptHF7Hoxnl5P5rDuuNx74sN2Mc00S+1q3xr5Mk/XXKaZlin5nFJtvBqC",
+	"q/+RZLxn/zZz4EoD/FVCnkqgS/3bUFnYglENsUPmWUk2F0iXs8mtc1e0mPy6AHp67Xq28K67tV/Gi4t3",
+	"4N63k199KOXXPs9XinTDijFLtPsh57cIqnCYQ7Pyw+tDihe9yyAEZwm1W7UHSb/toiuLHY+Bkcxn4wqZ",
+	"cqbO57M5IE6v38zg5248v55ev/Xa5NaU8yvbgFVNQpmMViynqq/wNCO988Rhq/4tWu4q1PS+myREVWWU",
+	"3lT49wjmEjtnl73HUm4fmejNt7nDumVgMgOWH7t5O2pTiAieQYOqWwZDez/oh/sBpSR5Ad7EtUAW7b4e",
+	"PkaX+y4jVEk0rVF0WbjDXFF7EvgkKsDvfQ6rgNfYd6niT2XSsgry5bHZ/n0o/TWqQB9VqMy4BzDI3q1y",
+	"hxqIr/OtOy3o9SXpITu2RHypbJyDlim0dVVBGlWg9L69dSUiEg8ky3Di+54bhBkTxHE4UY0bh9rhDyPY",
+	"oGS3VhUrsvZ0UGFJn6M6jcIx1BUBqEid2fhtA2WUQBX5AyeRIveI0rxH7HqxfDx76nhT8lB9R03zLa3s",
+	"qe1b6oqkuNUgrjU7Sqema0F04BotK1NP3WCDzVPZWUrAan6Ykp394L3d5EE/pea045R1bu5kHq3LQN9n",
+	"c/DK0KXZPKdvCCVig5OWz2v1nbiyIBH2w6jscJ+TQqzy9GhGwpdzlvfwc5PyURt4BD5iAWir1hAFnhYv",
+	"s28i/54Z55yrc0shcVaA9G/8KujQnC7N50uPciCZRKnGEy9J/KbcVZJ+0Y5avmqtI0Z33bn72qwePxQn",
+	"G0U3FjgEkO7UPvSwTfXuI0/qOssx1BenWUdP4FbN2b6Id4hTE4p9RVTHdfclv9Xb8kZGPBYA36cANvh3",
+	"RaGzR0PGpyMv+otfRziqgGPtk3tRPlApch7Uxa/UCUnzuG+v+0xTliSRqXr3c84l9CQiUOdAUFCD8c0U",
+	"MB8xFyb1zob/Hp4pdqA4RRmBpR+GZ7A00OfO2g4je1KmHgCyeBxtiJCM7+rLz82h1L4PzAiB6VcoluI0",
+	"6FHKYpS+Ug3OiYgcbxnY8wWY9rMd4JrDjROVHT27f781jVHCnmjKUNKLmBs8rc2RRrVEv8Uy2ELdIlla",
+	"TCTd+bcIFKqaFdmNJN0NgwUEBwAmGYN9KYgRtdNBfcas4Ze7ABN97KyOqSXE8CeKgju8FCx+AHZAj2Jd",
+	"MoN/cqzGTkAOJ/9SI5IUr1G8C94tFjeWLqAPw4E9lgOcaWKknlid7e+0UPjKDNDKY+SWLeYAMvKMW9vq",
+	"awmrcWLUA6c8juwB7htq90CrDxR7oFTnmvv72izp9dnZN5sklQ/kPIOk26JPDJwIoQZaIftZ4SNeSDsy",
+	"Yy89fcq3W6QKmY5z6+R/iGqAq4qLVIp8DIuQC/WR05HEclR6ZWFRWA7hfxreCUX5gAQZKE+U78WFr5PC",
+	"yI6XvwElV3zgdca4tI0tFM1HRFK0JCmRu2/A5mjZdFNCBfg/UBdt0gn7+10L5mlY/y+Z361klmP85XXS",
+	"dur9ktICj4r594uwoDYUk/n9aSSMXXvzlfkBVM2VRyhPiKwu2Ul6WzW5NZXiSd0R2ECMYKqvY5UuiYnD",
+	"pTFFcFCUkGDFmcLEVL+2NQdKker7cVKrS59orTDZ6wbN0hT8FZWpGLu/oN6Urpb9pYlWvqXyX0q0OyTj",
+	"jfVs6dJFa67pkLJfhGqliNxiqqS2NP99oiP72m1tz1JXmejhhsrhjpO5z4I5DgglkiB16ycXKnB+h0LK",
+	"CahhRqFI7Gj8+/ATVbdh9IP9qgC6S30RDQwByPo+CnxpABBNgFEG39IBWoHzLUxm7lbx4HWwYTkX7iUI",
+	"rN5oDqdvwX3j/BPtH+iNGaRorLwHV7xo3227Jvanc+KkQVr95K95t647cb7kWMhvskF5QrNrr7KDemfs",
+	"qohX6rgBAuiRcEb1SGsQ5jyFNxsps/ORTb2hPpbYMCHPdQMKnWdG1OEL4kRN1MyZM7ww6WUVDH/68cef",
+	"9O0kc39BP2qJ6mLccJaYI8XgImV50iqRKER69Wx+TYoPY4U2fLBnREMwqE/EEkpV0rPSn3Ll/f4/J58D",
+	"WKQtAAA=",
 }
 
 // GetSwagger returns the content of the embedded swagger specification file
@@ -229,6 +229,12 @@ pub struct ServiceInfo {
     /// Wheter TTy is enabled
     #[prost(bool, optional, tag = "20")]
     pub tty_enabled: ::core::option::Option<bool>,
+    /// The persistent key of the persistent directories mounted in the service, keyed by their path in the service
+    #[prost(map = "string, string", tag = "21")]
+    pub service_dir_paths_to_persistent_keys: ::std::collections::HashMap<
+        ::prost::alloc::string::String,
+        ::prost::alloc::string::String,
+    >,
 }
 #[allow(clippy::derive_partial_eq_without_eq)]
 #[derive(Clone, PartialEq, ::prost::Message)]
@@ -263,6 +269,13 @@ pub struct RunStarlarkScriptArgs {
     /// If true, executes the package in parallel meaning each instruction runs as soon as its dependencies are finished
     #[prost(bool, optional, tag = "17")]
     pub parallel: ::core::option::Option<bool>,
+    /// Secret values, by name, that the script can reference with Secret(name). They are only kept in memory by the
+    /// APIC and masked in every output of the run
+    #[prost(map = "string, string", tag = "18")]
+    pub secrets: ::std::collections::HashMap<
+        ::prost::alloc::string::String,
+        ::prost::alloc::string::String,
+    >,
 }
 #[allow(clippy::derive_partial_eq_without_eq)]
 #[derive(Clone, PartialEq, ::prost::Message)]
@@ -315,6 +328,13 @@ pub struct RunStarlarkPackageArgs {
     /// If true, executes the package in parallel meaning each instruction runs as soon as its dependencies are finished
     #[prost(bool, optional, tag = "17")]
     pub parallel: ::core::option::Option<bool>,
+    /// Secret values, by name, that the script can reference with Secret(name). They are only kept in memory by the
+    /// APIC and masked in every output of the run
+    #[prost(map = "string, string", tag = "18")]
+    pub secrets: ::std::collections::HashMap<
+        ::prost::alloc::string::String,
+        ::prost::alloc::string::String,
+    >,
     /// Deprecated: If the package is local, it should have been uploaded with UploadStarlarkPackage prior to calling
     /// RunStarlarkPackage. If the package is remote and must be cloned within the APIC, use the standalone boolean flag
     /// clone_package below
@@ -817,6 +837,186 @@ pub struct StarlarkPackagePlanYamlArgs {
     #[prost(string, optional, tag = "5")]
     pub main_function_name: ::core::option::Option<::prost::alloc::string::String>,
 }
+/// ==============================================================================================
+///                                Get Last Activity Time
+/// ==============================================================================================
+#[allow(clippy::derive_partial_eq_without_eq)]
+#[derive(Clone, PartialEq, ::prost::Message)]
+pub struct GetLastActivityTimeResponse {
+    #[prost(message, optional, tag = "1")]
+    pub last_activity_time: ::core::option::Option<::prost_types::Timestamp>,
+}
+/// ==============================================================================================
+///                                      Get Enclave Plan
+/// ==============================================================================================
+#[allow(clippy::derive_partial_eq_without_eq)]
+#[derive(Clone, PartialEq, ::prost::Message)]
+pub struct EnclavePlanInstruction {
+    #[prost(string, tag = "1")]
+    pub uuid: ::prost::alloc::string::String,
+    /// The name of the instruction, e.g. 'add_service'
+    #[prost(string, tag = "2")]
+    pub r#type: ::prost::alloc::string::String,
+    /// The instruction as it was called, without the 'plan.' prefix
+    #[prost(string, tag = "3")]
+    pub starlark_code: ::prost::alloc::string::String,
+    /// The services the instruction created or updated
+    #[prost(string, repeated, tag = "4")]
+    pub service_names: ::prost::alloc::vec::Vec<::prost::alloc::string::String>,
+    /// The files artifacts the instruction created or retrieved
+    #[prost(string, repeated, tag = "5")]
+    pub files_artifact_names: ::prost::alloc::vec::Vec<::prost::alloc::string::String>,
+}
+#[allow(clippy::derive_partial_eq_without_eq)]
+#[derive(Clone, PartialEq, ::prost::Message)]
+pub struct GetEnclavePlanResponse {
+    #[prost(message, repeated, tag = "1")]
+    pub instructions: ::prost::alloc::vec::Vec<EnclavePlanInstruction>,
+}
+#[allow(clippy::derive_partial_eq_without_eq)]
+#[derive(Clone, PartialEq, ::prost::Message)]
+pub struct ApiContainerEvent {
+    #[prost(enumeration = "ApiContainerEventType", tag = "1")]
+    pub r#type: i32,
+    #[prost(message, optional, tag = "2")]
+    pub timestamp: ::core::option::Option<::prost_types::Timestamp>,
+    /// The service the event is about, only set for service events
+    #[prost(string, optional, tag = "3")]
+    pub service_name: ::core::option::Option<::prost::alloc::string::String>,
+    /// The package that was run, only set for Starlark run events of packages
+    #[prost(string, optional, tag = "4")]
+    pub starlark_package_id: ::core::option::Option<::prost::alloc::string::String>,
+    /// Whether the run succeeded, only set for STARLARK_RUN_FINISHED events
+    #[prost(bool, optional, tag = "5")]
+    pub starlark_run_succeeded: ::core::option::Option<bool>,
+}
+/// ==============================================================================================
+///                                          Audit Log
+/// ==============================================================================================
+#[allow(clippy::derive_partial_eq_without_eq)]
+#[derive(Clone, PartialEq, ::prost::Message)]
+pub struct AuditLogEntry {
+    /// Increases with every entry, so that entries logged at the same time keep their order
+    #[prost(uint64, tag = "1")]
+    pub sequence_number: u64,
+    #[prost(message, optional, tag = "2")]
+    pub timestamp: ::core::option::Option<::prost_types::Timestamp>,
+    /// Who made the call, as forwarded by the engine or the address the call came from
+    #[prost(string, tag = "3")]
+    pub caller: ::prost::alloc::string::String,
+    /// The name of the RPC that was called
+    #[prost(string, tag = "4")]
+    pub action: ::prost::alloc::string::String,
+    /// The arguments of the call, with the values of the secrets redacted
+    #[prost(map = "string, string", tag = "5")]
+    pub arguments: ::std::collections::HashMap<
+        ::prost::alloc::string::String,
+        ::prost::alloc::string::String,
+    >,
+    /// Why the call failed, unset if it succeeded
+    #[prost(string, optional, tag = "6")]
+    pub error: ::core::option::Option<::prost::alloc::string::String>,
+}
+#[allow(clippy::derive_partial_eq_without_eq)]
+#[derive(Clone, PartialEq, ::prost::Message)]
+pub struct GetAuditLogResponse {
+    #[prost(message, repeated, tag = "1")]
+    pub entries: ::prost::alloc::vec::Vec<AuditLogEntry>,
+}
+/// ==============================================================================================
+///                                        Rename Service
+/// ==============================================================================================
+#[allow(clippy::derive_partial_eq_without_eq)]
+#[derive(Clone, PartialEq, ::prost::Message)]
+pub struct RenameServiceArgs {
+    /// The name, UUID or shortened UUID of the service to rename
+    #[prost(string, tag = "1")]
+    pub service_identifier: ::prost::alloc::string::String,
+    #[prost(string, tag = "2")]
+    pub new_name: ::prost::alloc::string::String,
+}
+/// ==============================================================================================
+///                                        Service Stats
+/// ==============================================================================================
+#[allow(clippy::derive_partial_eq_without_eq)]
+#[derive(Clone, PartialEq, ::prost::Message)]
+pub struct GetServiceStatsArgs {
+    /// The names, UUIDs or shortened UUIDs of the services to sample; all the running services if empty
+    #[prost(string, repeated, tag = "1")]
+    pub service_identifiers: ::prost::alloc::vec::Vec<::prost::alloc::string::String>,
+    /// Keep sampling until the stream gets closed instead of returning a single sample
+    #[prost(bool, tag = "2")]
+    pub follow: bool,
+    /// How long to wait between samples when following, defaults to a few seconds
+    #[prost(message, optional, tag = "3")]
+    pub interval: ::core::option::Option<::prost_types::Duration>,
+}
+#[allow(clippy::derive_partial_eq_without_eq)]
+#[derive(Clone, PartialEq, ::prost::Message)]
+pub struct ServiceStats {
+    #[prost(string, tag = "1")]
+    pub service_name: ::prost::alloc::string::String,
+    #[prost(string, tag = "2")]
+    pub service_uuid: ::prost::alloc::string::String,
+    /// Like in `docker stats`, 100 being one CPU fully used
+    #[prost(double, tag = "3")]
+    pub cpu_percent: f64,
+    #[prost(uint64, tag = "4")]
+    pub memory_usage_bytes: u64,
+    /// Zero if the service has no memory limit
+    #[prost(uint64, tag = "5")]
+    pub memory_limit_bytes: u64,
+    /// Network and disk totals since the service started, which stay at zero on Kubernetes
+    #[prost(uint64, tag = "6")]
+    pub network_received_bytes: u64,
+    #[prost(uint64, tag = "7")]
+    pub network_sent_bytes: u64,
+    #[prost(uint64, tag = "8")]
+    pub disk_read_bytes: u64,
+    #[prost(uint64, tag = "9")]
+    pub disk_written_bytes: u64,
+}
+#[allow(clippy::derive_partial_eq_without_eq)]
+#[derive(Clone, PartialEq, ::prost::Message)]
+pub struct GetServiceStatsResponse {
+    #[prost(message, optional, tag = "1")]
+    pub timestamp: ::core::option::Option<::prost_types::Timestamp>,
+    #[prost(message, repeated, tag = "2")]
+    pub service_stats: ::prost::alloc::vec::Vec<ServiceStats>,
+}
+/// ==============================================================================================
+///                                    Starlark Package Unit Tests
+/// ==============================================================================================
+#[allow(clippy::derive_partial_eq_without_eq)]
+#[derive(Clone, PartialEq, ::prost::Message)]
+pub struct RunStarlarkPackageUnitTestsArgs {
+    /// The name of the package, as uploaded with UploadStarlarkPackage
+    #[prost(string, tag = "1")]
+    pub package_id: ::prost::alloc::string::String,
+}
+#[allow(clippy::derive_partial_eq_without_eq)]
+#[derive(Clone, PartialEq, ::prost::Message)]
+pub struct StarlarkUnitTestResult {
+    /// Path of the test file, relative to the package root
+    #[prost(string, tag = "1")]
+    pub test_file: ::prost::alloc::string::String,
+    #[prost(string, tag = "2")]
+    pub test_name: ::prost::alloc::string::String,
+    /// Empty if the test passed
+    #[prost(string, tag = "3")]
+    pub failure_message: ::prost::alloc::string::String,
+    /// Output of every instruction executed against the fake enclave, in order
+    #[prost(string, repeated, tag = "4")]
+    pub instruction_outputs: ::prost::alloc::vec::Vec<::prost::alloc::string::String>,
+    #[prost(message, optional, tag = "5")]
+    pub duration: ::core::option::Option<::prost_types::Duration>,
+}
+#[allow(clippy::derive_partial_eq_without_eq)]
+#[derive(Clone, PartialEq, ::prost::Message)]
+pub struct RunStarlarkPackageUnitTestsResponse {
+    #[prost(message, repeated, tag = "1")]
+    pub results: ::prost::alloc::vec::Vec<StarlarkUnitTestResult>,
+}
 #[derive(Clone, Copy, Debug, PartialEq, Eq, Hash, PartialOrd, Ord, ::prost::Enumeration)]
 #[repr(i32)]
 pub enum ServiceStatus {
@@ -953,6 +1153,44 @@ impl RestartPolicy {
         }
     }
 }
+/// ==============================================================================================
+///                                          Watch Events
+/// ==============================================================================================
+#[derive(Clone, Copy, Debug, PartialEq, Eq, Hash, PartialOrd, Ord, ::prost::Enumeration)]
+#[repr(i32)]
+pub enum ApiContainerEventType {
+    StarlarkRunStarted = 0,
+    StarlarkRunFinished = 1,
+    ServiceAdded = 2,
+    ServiceStopped = 3,
+    ServiceRemoved = 4,
+}
+impl ApiContainerEventType {
+    /// String value of the enum field names used in the ProtoBuf definition.
+    ///
+    /// The values are not transformed in any way and thus are considered stable
+    /// (if the ProtoBuf definition does not change) and safe for programmatic use.
+    pub fn as_str_name(&self) -> &'static str {
+        match self {
+            ApiContainerEventType::StarlarkRunStarted => "STARLARK_RUN_STARTED",
+            ApiContainerEventType::StarlarkRunFinished => "STARLARK_RUN_FINISHED",
+            ApiContainerEventType::ServiceAdded => "SERVICE_ADDED",
+            ApiContainerEventType::ServiceStopped => "SERVICE_STOPPED",
+            ApiContainerEventType::ServiceRemoved => "SERVICE_REMOVED",
+        }
+    }
+    /// Creates an enum from field names used in the ProtoBuf definition.
+    pub fn from_str_name(value: &str) -> ::core::option::Option<Self> {
+        match value {
+            "STARLARK_RUN_STARTED" => Some(Self::StarlarkRunStarted),
+            "STARLARK_RUN_FINISHED" => Some(Self::StarlarkRunFinished),
+            "SERVICE_ADDED" => Some(Self::ServiceAdded),
+            "SERVICE_STOPPED" => Some(Self::ServiceStopped),
+            "SERVICE_REMOVED" => Some(Self::ServiceRemoved),
+            _ => None,
+        }
+    }
+}
 /// Generated client implementations.
 pub mod api_container_service_client {
     #![allow(unused_variables, dead_code, missing_docs, clippy::let_unit_value)]
@@ -1583,79 +1821,294 @@ pub mod api_container_service_client {
                 );
             self.inner.unary(req, path, codec).await
         }
-    }
-}
-/// Generated server implementations.
-pub mod api_container_service_server {
-    #![allow(unused_variables, dead_code, missing_docs, clippy::let_unit_value)]
-    use tonic::codegen::*;
-    /// Generated trait containing gRPC methods that should be implemented for use with ApiContainerServiceServer.
-    #[async_trait]
-    pub trait ApiContainerService: Send + Sync + 'static {
-        /// Server streaming response type for the RunStarlarkScript method.
-        type RunStarlarkScriptStream: futures_core::Stream<
-                Item = std::result::Result<super::StarlarkRunResponseLine, tonic::Status>,
-            >
-            + Send
-            + 'static;
-        /// Executes a Starlark script on the user's behalf
-        async fn run_starlark_script(
-            &self,
-            request: tonic::Request<super::RunStarlarkScriptArgs>,
-        ) -> std::result::Result<
-            tonic::Response<Self::RunStarlarkScriptStream>,
-            tonic::Status,
-        >;
-        /// Uploads a Starlark package. This step is required before the package can be executed with RunStarlarkPackage
-        async fn upload_starlark_package(
-            &self,
-            request: tonic::Request<tonic::Streaming<super::StreamedDataChunk>>,
-        ) -> std::result::Result<tonic::Response<()>, tonic::Status>;
-        /// Server streaming response type for the RunStarlarkPackage method.
-        type RunStarlarkPackageStream: futures_core::Stream<
-                Item = std::result::Result<super::StarlarkRunResponseLine, tonic::Status>,
-            >
-            + Send
-            + 'static;
-        /// Executes a Starlark script on the user's behalf
-        async fn run_starlark_package(
-            &self,
-            request: tonic::Request<super::RunStarlarkPackageArgs>,
+        /// Gets when the API container last served a request other than this one, which tells whether the enclave is idle
+        pub async fn get_last_activity_time(
+            &mut self,
+            request: impl tonic::IntoRequest<()>,
         ) -> std::result::Result<
-            tonic::Response<Self::RunStarlarkPackageStream>,
+            tonic::Response<super::GetLastActivityTimeResponse>,
             tonic::Status,
-        >;
-        /// Returns the IDs of the current services in the enclave
-        async fn get_services(
-            &self,
-            request: tonic::Request<super::GetServicesArgs>,
+        > {
+            self.inner
+                .ready()
+                .await
+                .map_err(|e| {
+                    tonic::Status::new(
+                        tonic::Code::Unknown,
+                        format!("Service was not ready: {}", e.into()),
+                    )
+                })?;
+            let codec = tonic::codec::ProstCodec::default();
+            let path = http::uri::PathAndQuery::from_static(
+                "/api_container_api.ApiContainerService/GetLastActivityTime",
+            );
+            let mut req = request.into_request();
+            req.extensions_mut()
+                .insert(
+                    GrpcMethod::new(
+                        "api_container_api.ApiContainerService",
+                        "GetLastActivityTime",
+                    ),
+                );
+            self.inner.unary(req, path, codec).await
+        }
+        /// Gets the instructions that brought the enclave to its current state, as persisted across the Starlark runs
+        pub async fn get_enclave_plan(
+            &mut self,
+            request: impl tonic::IntoRequest<()>,
         ) -> std::result::Result<
-            tonic::Response<super::GetServicesResponse>,
+            tonic::Response<super::GetEnclavePlanResponse>,
             tonic::Status,
-        >;
-        /// Returns information about all existing & historical services
-        async fn get_existing_and_historical_service_identifiers(
-            &self,
-            request: tonic::Request<()>,
+        > {
+            self.inner
+                .ready()
+                .await
+                .map_err(|e| {
+                    tonic::Status::new(
+                        tonic::Code::Unknown,
+                        format!("Service was not ready: {}", e.into()),
+                    )
+                })?;
+            let codec = tonic::codec::ProstCodec::default();
+            let path = http::uri::PathAndQuery::from_static(
+                "/api_container_api.ApiContainerService/GetEnclavePlan",
+            );
+            let mut req = request.into_request();
+            req.extensions_mut()
+                .insert(
+                    GrpcMethod::new(
+                        "api_container_api.ApiContainerService",
+                        "GetEnclavePlan",
+                    ),
+                );
+            self.inner.unary(req, path, codec).await
+        }
+        /// Streams the Starlark runs and service changes happening in the enclave, starting from when the stream is opened
+        pub async fn watch_events(
+            &mut self,
+            request: impl tonic::IntoRequest<()>,
         ) -> std::result::Result<
-            tonic::Response<super::GetExistingAndHistoricalServiceIdentifiersResponse>,
+            tonic::Response<tonic::codec::Streaming<super::ApiContainerEvent>>,
             tonic::Status,
-        >;
-        /// Executes the given command inside a running container
-        async fn exec_command(
-            &self,
-            request: tonic::Request<super::ExecCommandArgs>,
+        > {
+            self.inner
+                .ready()
+                .await
+                .map_err(|e| {
+                    tonic::Status::new(
+                        tonic::Code::Unknown,
+                        format!("Service was not ready: {}", e.into()),
+                    )
+                })?;
+            let codec = tonic::codec::ProstCodec::default();
+            let path = http::uri::PathAndQuery::from_static(
+                "/api_container_api.ApiContainerService/WatchEvents",
+            );
+            let mut req = request.into_request();
+            req.extensions_mut()
+                .insert(
+                    GrpcMethod::new(
+                        "api_container_api.ApiContainerService",
+                        "WatchEvents",
+                    ),
+                );
+            self.inner.server_streaming(req, path, codec).await
+        }
+        /// Gets the changes made through the API container, oldest first
+        pub async fn get_audit_log(
+            &mut self,
+            request: impl tonic::IntoRequest<()>,
         ) -> std::result::Result<
-            tonic::Response<super::ExecCommandResponse>,
+            tonic::Response<super::GetAuditLogResponse>,
             tonic::Status,
-        >;
-        /// Block until the given HTTP endpoint returns available, calling it through a HTTP Get request
-        async fn wait_for_http_get_endpoint_availability(
-            &self,
-            request: tonic::Request<super::WaitForHttpGetEndpointAvailabilityArgs>,
-        ) -> std::result::Result<tonic::Response<()>, tonic::Status>;
-        /// Block until the given HTTP endpoint returns available, calling it through a HTTP Post request
-        async fn wait_for_http_post_endpoint_availability(
+        > {
+            self.inner
+                .ready()
+                .await
+                .map_err(|e| {
+                    tonic::Status::new(
+                        tonic::Code::Unknown,
+                        format!("Service was not ready: {}", e.into()),
+                    )
+                })?;
+            let codec = tonic::codec::ProstCodec::default();
+            let path = http::uri::PathAndQuery::from_static(
+                "/api_container_api.ApiContainerService/GetAuditLog",
+            );
+            let mut req = request.into_request();
+            req.extensions_mut()
+                .insert(
+                    GrpcMethod::new(
+                        "api_container_api.ApiContainerService",
+                        "GetAuditLog",
+                    ),
+                );
+            self.inner.unary(req, path, codec).await
+        }
+        /// Gives a running service a new name, keeping its UUID, and updates the enclave plan to match
+        pub async fn rename_service(
+            &mut self,
+            request: impl tonic::IntoRequest<super::RenameServiceArgs>,
+        ) -> std::result::Result<tonic::Response<()>, tonic::Status> {
+            self.inner
+                .ready()
+                .await
+                .map_err(|e| {
+                    tonic::Status::new(
+                        tonic::Code::Unknown,
+                        format!("Service was not ready: {}", e.into()),
+                    )
+                })?;
+            let codec = tonic::codec::ProstCodec::default();
+            let path = http::uri::PathAndQuery::from_static(
+                "/api_container_api.ApiContainerService/RenameService",
+            );
+            let mut req = request.into_request();
+            req.extensions_mut()
+                .insert(
+                    GrpcMethod::new(
+                        "api_container_api.ApiContainerService",
+                        "RenameService",
+                    ),
+                );
+            self.inner.unary(req, path, codec).await
+        }
+        /// Samples the resources used by the services of the enclave, once or repeatedly until the stream gets closed
+        pub async fn get_service_stats(
+            &mut self,
+            request: impl tonic::IntoRequest<super::GetServiceStatsArgs>,
+        ) -> std::result::Result<
+            tonic::Response<tonic::codec::Streaming<super::GetServiceStatsResponse>>,
+            tonic::Status,
+        > {
+            self.inner
+                .ready()
+                .await
+                .map_err(|e| {
+                    tonic::Status::new(
+                        tonic::Code::Unknown,
+                        format!("Service was not ready: {}", e.into()),
+                    )
+                })?;
+            let codec = tonic::codec::ProstCodec::default();
+            let path = http::uri::PathAndQuery::from_static(
+                "/api_container_api.ApiContainerService/GetServiceStats",
+            );
+            let mut req = request.into_request();
+            req.extensions_mut()
+                .insert(
+                    GrpcMethod::new(
+                        "api_container_api.ApiContainerService",
+                        "GetServiceStats",
+                    ),
+                );
+            self.inner.server_streaming(req, path, codec).await
+        }
+        /// Runs the unit tests of a package uploaded with UploadStarlarkPackage against a fake enclave, without starting any
+        /// service
+        pub async fn run_starlark_package_unit_tests(
+            &mut self,
+            request: impl tonic::IntoRequest<super::RunStarlarkPackageUnitTestsArgs>,
+        ) -> std::result::Result<
+            tonic::Response<super::RunStarlarkPackageUnitTestsResponse>,
+            tonic::Status,
+        > {
+            self.inner
+                .ready()
+                .await
+                .map_err(|e| {
+                    tonic::Status::new(
+                        tonic::Code::Unknown,
+                        format!("Service was not ready: {}", e.into()),
+                    )
+                })?;
+            let codec = tonic::codec::ProstCodec::default();
+            let path = http::uri::PathAndQuery::from_static(
+                "/api_container_api.ApiContainerService/RunStarlarkPackageUnitTests",
+            );
+            let mut req = request.into_request();
+            req.extensions_mut()
+                .insert(
+                    GrpcMethod::new(
+                        "api_container_api.ApiContainerService",
+                        "RunStarlarkPackageUnitTests",
+                    ),
+                );
+            self.inner.unary(req, path, codec).await
+        }
+    }
+}
+/// Generated server implementations.
+pub mod api_container_service_server {
+    #![allow(unused_variables, dead_code, missing_docs, clippy::let_unit_value)]
+    use tonic::codegen::*;
+    /// Generated trait containing gRPC methods that should be implemented for use with ApiContainerServiceServer.
+    #[async_trait]
+    pub trait ApiContainerService: Send + Sync + 'static {
+        /// Server streaming response type for the RunStarlarkScript method.
+        type RunStarlarkScriptStream: futures_core::Stream<
+                Item = std::result::Result<super::StarlarkRunResponseLine, tonic::Status>,
+            >
+            + Send
+            + 'static;
+        /// Executes a Starlark script on the user's behalf
+        async fn run_starlark_script(
+            &self,
+            request: tonic::Request<super::RunStarlarkScriptArgs>,
+        ) -> std::result::Result<
+            tonic::Response<Self::RunStarlarkScriptStream>,
+            tonic::Status,
+        >;
+        /// Uploads a Starlark package. This step is required before the package can be executed with RunStarlarkPackage
+        async fn upload_starlark_package(
+            &self,
+            request: tonic::Request<tonic::Streaming<super::StreamedDataChunk>>,
+        ) -> std::result::Result<tonic::Response<()>, tonic::Status>;
+        /// Server streaming response type for the RunStarlarkPackage method.
+        type RunStarlarkPackageStream: futures_core::Stream<
+                Item = std::result::Result<super::StarlarkRunResponseLine, tonic::Status>,
+            >
+            + Send
+            + 'static;
+        /// Executes a Starlark script on the user's behalf
+        async fn run_starlark_package(
+            &self,
+            request: tonic::Request<super::RunStarlarkPackageArgs>,
+        ) -> std::result::Result<
+            tonic::Response<Self::RunStarlarkPackageStream>,
+            tonic::Status,
+        >;
+        /// Returns the IDs of the current services in the enclave
+        async fn get_services(
+            &self,
+            request: tonic::Request<super::GetServicesArgs>,
+        ) -> std::result::Result<
+            tonic::Response<super::GetServicesResponse>,
+            tonic::Status,
+        >;
+        /// Returns information about all existing & historical services
+        async fn get_existing_and_historical_service_identifiers(
+            &self,
+            request: tonic::Request<()>,
+        ) -> std::result::Result<
+            tonic::Response<super::GetExistingAndHistoricalServiceIdentifiersResponse>,
+            tonic::Status,
+        >;
+        /// Executes the given command inside a running container
+        async fn exec_command(
+            &self,
+            request: tonic::Request<super::ExecCommandArgs>,
+        ) -> std::result::Result<
+            tonic::Response<super::ExecCommandResponse>,
+            tonic::Status,
+        >;
+        /// Block until the given HTTP endpoint returns available, calling it through a HTTP Get request
+        async fn wait_for_http_get_endpoint_availability(
+            &self,
+            request: tonic::Request<super::WaitForHttpGetEndpointAvailabilityArgs>,
+        ) -> std::result::Result<tonic::Response<()>, tonic::Status>;
+        /// Block until the given HTTP endpoint returns available, calling it through a HTTP Post request
+        async fn wait_for_http_post_endpoint_availability(
             &self,
             request: tonic::Request<super::WaitForHttpPostEndpointAvailabilityArgs>,
         ) -> std::result::Result<tonic::Response<()>, tonic::Status>;
@@ -1737,6 +2190,72 @@ pub mod api_container_service_server {
             &self,
             request: tonic::Request<super::StarlarkPackagePlanYamlArgs>,
         ) -> std::result::Result<tonic::Response<super::PlanYaml>, tonic::Status>;
+        /// Gets when the API container last served a request other than this one, which tells whether the enclave is idle
+        async fn get_last_activity_time(
+            &self,
+            request: tonic::Request<()>,
+        ) -> std::result::Result<
+            tonic::Response<super::GetLastActivityTimeResponse>,
+            tonic::Status,
+        >;
+        /// Gets the instructions that brought the enclave to its current state, as persisted across the Starlark runs
+        async fn get_enclave_plan(
+            &self,
+            request: tonic::Request<()>,
+        ) -> std::result::Result<
+            tonic::Response<super::GetEnclavePlanResponse>,
+            tonic::Status,
+        >;
+        /// Server streaming response type for the WatchEvents method.
+        type WatchEventsStream: futures_core::Stream<
+                Item = std::result::Result<super::ApiContainerEvent, tonic::Status>,
+            >
+            + Send
+            + 'static;
+        /// Streams the Starlark runs and service changes happening in the enclave, starting from when the stream is opened
+        async fn watch_events(
+            &self,
+            request: tonic::Request<()>,
+        ) -> std::result::Result<
+            tonic::Response<Self::WatchEventsStream>,
+            tonic::Status,
+        >;
+        /// Gets the changes made through the API container, oldest first
+        async fn get_audit_log(
+            &self,
+            request: tonic::Request<()>,
+        ) -> std::result::Result<
+            tonic::Response<super::GetAuditLogResponse>,
+            tonic::Status,
+        >;
+        /// Gives a running service a new name, keeping its UUID, and updates the enclave plan to match
+        async fn rename_service(
+            &self,
+            request: tonic::Request<super::RenameServiceArgs>,
+        ) -> std::result::Result<tonic::Response<()>, tonic::Status>;
+        /// Server streaming response type for the GetServiceStats method.
+        type GetServiceStatsStream: futures_core::Stream<
+                Item = std::result::Result<super::GetServiceStatsResponse, tonic::Status>,
+            >
+            + Send
+            + 'static;
+        /// Samples the resources used by the services of the enclave, once or repeatedly until the stream gets closed
+        async fn get_service_stats(
+            &self,
+            request: tonic::Request<super::GetServiceStatsArgs>,
+        ) -> std::result::Result<
+            tonic::Response<Self::GetServiceStatsStream>,
+            tonic::Status,
+        >;
+        /// Runs the unit tests of a package uploaded with UploadStarlarkPackage against a fake enclave, without starting any
+        /// service
+        async fn run_starlark_package_unit_tests(
+            &self,
+            request: tonic::Request<super::RunStarlarkPackageUnitTestsArgs>,
+        ) -> std::result::Result<
+            tonic::Response<super::RunStarlarkPackageUnitTestsResponse>,
+            tonic::Status,
+        >;
     }
     #[derive(Debug)]
     pub struct ApiContainerServiceServer<T: ApiContainerService> {
@@ -2679,6 +3198,315 @@ pub mod api_container_service_server {
                     };
                     Box::pin(fut)
                 }
+                "/api_container_api.ApiContainerService/GetLastActivityTime" => {
+                    #[allow(non_camel_case_types)]
+                    struct GetLastActivityTimeSvc<T: ApiContainerService>(pub Arc<T>);
+                    impl<T: ApiContainerService> tonic::server::UnaryService<()>
+                    for GetLastActivityTimeSvc<T> {
+                        type Response = super::GetLastActivityTimeResponse;
+                        type Future = BoxFuture<
+                            tonic::Response<Self::Response>,
+                            tonic::Status,
+                        >;
+                        fn call(&mut self, request: tonic::Request<()>) -> Self::Future {
+                            let inner = Arc::clone(&self.0);
+                            let fut = async move {
+                                (*inner).get_last_activity_time(request).await
+                            };
+                            Box::pin(fut)
+                        }
+                    }
+                    let accept_compression_encodings = self.accept_compression_encodings;
+                    let send_compression_encodings = self.send_compression_encodings;
+                    let max_decoding_message_size = self.max_decoding_message_size;
+                    let max_encoding_message_size = self.max_encoding_message_size;
+                    let inner = self.inner.clone();
+                    let fut = async move {
+                        let inner = inner.0;
+                        let method = GetLastActivityTimeSvc(inner);
+                        let codec = tonic::codec::ProstCodec::default();
+                        let mut grpc = tonic::server::Grpc::new(codec)
+                            .apply_compression_config(
+                                accept_compression_encodings,
+                                send_compression_encodings,
+                            )
+                            .apply_max_message_size_config(
+                                max_decoding_message_size,
+                                max_encoding_message_size,
+                            );
+                        let res = grpc.unary(method, req).await;
+                        Ok(res)
+                    };
+                    Box::pin(fut)
+                }
+                "/api_container_api.ApiContainerService/GetEnclavePlan" => {
+                    #[allow(non_camel_case_types)]
+                    struct GetEnclavePlanSvc<T: ApiContainerService>(pub Arc<T>);
+                    impl<T: ApiContainerService> tonic::server::UnaryService<()>
+                    for GetEnclavePlanSvc<T> {
+                        type Response = super::GetEnclavePlanResponse;
+                        type Future = BoxFuture<
+                            tonic::Response<Self::Response>,
+                            tonic::Status,
+                        >;
+                        fn call(&mut self, request: tonic::Request<()>) -> Self::Future {
+                            let inner = Arc::clone(&self.0);
+                            let fut = async move {
+                                (*inner).get_enclave_plan(request).await
+                            };
+                            Box::pin(fut)
+                        }
+                    }
+                    let accept_compression_encodings = self.accept_compression_encodings;
+                    let send_compression_encodings = self.send_compression_encodings;
+                    let max_decoding_message_size = self.max_decoding_message_size;
+                    let max_encoding_message_size = self.max_encoding_message_size;
+                    let inner = self.inner.clone();
+                    let fut = async move {
+                        let inner = inner.0;
+                        let method = GetEnclavePlanSvc(inner);
+                        let codec = tonic::codec::ProstCodec::default();
+                        let mut grpc = tonic::server::Grpc::new(codec)
+                            .apply_compression_config(
+                                accept_compression_encodings,
+                                send_compression_encodings,
+                            )
+                            .apply_max_message_size_config(
+                                max_decoding_message_size,
+                                max_encoding_message_size,
+                            );
+                        let res = grpc.unary(method, req).await;
+                        Ok(res)
+                    };
+                    Box::pin(fut)
+                }
+                "/api_container_api.ApiContainerService/WatchEvents" => {
+                    #[allow(non_camel_case_types)]
+                    struct WatchEventsSvc<T: ApiContainerService>(pub Arc<T>);
+                    impl<
+                        T: ApiContainerService,
+                    > tonic::server::ServerStreamingService<()> for WatchEventsSvc<T> {
+                        type Response = super::ApiContainerEvent;
+                        type ResponseStream = T::WatchEventsStream;
+                        type Future = BoxFuture<
+                            tonic::Response<Self::ResponseStream>,
+                            tonic::Status,
+                        >;
+                        fn call(&mut self, request: tonic::Request<()>) -> Self::Future {
+                            let inner = Arc::clone(&self.0);
+                            let fut = async move {
+                                (*inner).watch_events(request).await
+                            };
+                            Box::pin(fut)
+                        }
+                    }
+                    let accept_compression_encodings = self.accept_compression_encodings;
+                    let send_compression_encodings = self.send_compression_encodings;
+                    let max_decoding_message_size = self.max_decoding_message_size;
+                    let max_encoding_message_size = self.max_encoding_message_size;
+                    let inner = self.inner.clone();
+                    let fut = async move {
+                        let inner = inner.0;
+                        let method = WatchEventsSvc(inner);
+                        let codec = tonic::codec::ProstCodec::default();
+                        let mut grpc = tonic::server::Grpc::new(codec)
+                            .apply_compression_config(
+                                accept_compression_encodings,
+                                send_compression_encodings,
+                            )
+                            .apply_max_message_size_config(
+                                max_decoding_message_size,
+                                max_encoding_message_size,
+                            );
+                        let res = grpc.server_streaming(method, req).await;
+                        Ok(res)
+                    };
+                    Box::pin(fut)
+                }
+                "/api_container_api.ApiContainerService/GetAuditLog" => {
+                    #[allow(non_camel_case_types)]
+                    struct GetAuditLogSvc<T: ApiContainerService>(pub Arc<T>);
+                    impl<T: ApiContainerService> tonic::server::UnaryService<()>
+                    for GetAuditLogSvc<T> {
+                        type Response = super::GetAuditLogResponse;
+                        type Future = BoxFuture<
+                            tonic::Response<Self::Response>,
+                            tonic::Status,
+                        >;
+                        fn call(&mut self, request: tonic::Request<()>) -> Self::Future {
+                            let inner = Arc::clone(&self.0);
+                            let fut = async move {
+                                (*inner).get_audit_log(request).await
+                            };
+                            Box::pin(fut)
+                        }
+                    }
+                    let accept_compression_encodings = self.accept_compression_encodings;
+                    let send_compression_encodings = self.send_compression_encodings;
+                    let max_decoding_message_size = self.max_decoding_message_size;
+                    let max_encoding_message_size = self.max_encoding_message_size;
+                    let inner = self.inner.clone();
+                    let fut = async move {
+                        let inner = inner.0;
+                        let method = GetAuditLogSvc(inner);
+                        let codec = tonic::codec::ProstCodec::default();
+                        let mut grpc = tonic::server::Grpc::new(codec)
+                            .apply_compression_config(
+                                accept_compression_encodings,
+                                send_compression_encodings,
+                            )
+                            .apply_max_message_size_config(
+                                max_decoding_message_size,
+                                max_encoding_message_size,
+                            );
+                        let res = grpc.unary(method, req).await;
+                        Ok(res)
+                    };
+                    Box::pin(fut)
+                }
+                "/api_container_api.ApiContainerService/RenameService" => {
+                    #[allow(non_camel_case_types)]
+                    struct RenameServiceSvc<T: ApiContainerService>(pub Arc<T>);
+                    impl<
+                        T: ApiContainerService,
+                    > tonic::server::UnaryService<super::RenameServiceArgs>
+                    for RenameServiceSvc<T> {
+                        type Response = ();
+                        type Future = BoxFuture<
+                            tonic::Response<Self::Response>,
+                            tonic::Status,
+                        >;
+                        fn call(
+                            &mut self,
+                            request: tonic::Request<super::RenameServiceArgs>,
+                        ) -> Self::Future {
+                            let inner = Arc::clone(&self.0);
+                            let fut = async move {
+                                (*inner).rename_service(request).await
+                            };
+                            Box::pin(fut)
+                        }
+                    }
+                    let accept_compression_encodings = self.accept_compression_encodings;
+                    let send_compression_encodings = self.send_compression_encodings;
+                    let max_decoding_message_size = self.max_decoding_message_size;
+                    let max_encoding_message_size = self.max_encoding_message_size;
+                    let inner = self.inner.clone();
+                    let fut = async move {
+                        let inner = inner.0;
+                        let method = RenameServiceSvc(inner);
+                        let codec = tonic::codec::ProstCodec::default();
+                        let mut grpc = tonic::server::Grpc::new(codec)
+                            .apply_compression_config(
+                                accept_compression_encodings,
+                                send_compression_encodings,
+                            )
+                            .apply_max_message_size_config(
+                                max_decoding_message_size,
+                                max_encoding_message_size,
+                            );
+                        let res = grpc.unary(method, req).await;
+                        Ok(res)
+                    };
+                    Box::pin(fut)
+                }
+                "/api_container_api.ApiContainerService/GetServiceStats" => {
+                    #[allow(non_camel_case_types)]
+                    struct GetServiceStatsSvc<T: ApiContainerService>(pub Arc<T>);
+                    impl<
+                        T: ApiContainerService,
+                    > tonic::server::ServerStreamingService<super::GetServiceStatsArgs>
+                    for GetServiceStatsSvc<T> {
+                        type Response = super::GetServiceStatsResponse;
+                        type ResponseStream = T::GetServiceStatsStream;
+                        type Future = BoxFuture<
+                            tonic::Response<Self::ResponseStream>,
+                            tonic::Status,
+                        >;
+                        fn call(
+                            &mut self,
+                            request: tonic::Request<super::GetServiceStatsArgs>,
+                        ) -> Self::Future {
+                            let inner = Arc::clone(&self.0);
+                            let fut = async move {
+                                (*inner).get_service_stats(request).await
+                            };
+                            Box::pin(fut)
+                        }
+                    }
+                    let accept_compression_encodings = self.accept_compression_encodings;
+                    let send_compression_encodings = self.send_compression_encodings;
+                    let max_decoding_message_size = self.max_decoding_message_size;
+                    let max_encoding_message_size = self.max_encoding_message_size;
+                    let inner = self.inner.clone();
+                    let fut = async move {
+                        let inner = inner.0;
+                        let method = GetServiceStatsSvc(inner);
+                        let codec = tonic::codec::ProstCodec::default();
+                        let mut grpc = tonic::server::Grpc::new(codec)
+                            .apply_compression_config(
+                                accept_compression_encodings,
+                                send_compression_encodings,
+                            )
+                            .apply_max_message_size_config(
+                                max_decoding_message_size,
+                                max_encoding_message_size,
+                            );
+                        let res = grpc.server_streaming(method, req).await;
+                        Ok(res)
+                    };
+                    Box::pin(fut)
+                }
+                "/api_container_api.ApiContainerService/RunStarlarkPackageUnitTests" => {
+                    #[allow(non_camel_case_types)]
+                    struct RunStarlarkPackageUnitTestsSvc<T: ApiContainerService>(
+                        pub Arc<T>,
+                    );
+                    impl<
+                        T: ApiContainerService,
+                    > tonic::server::UnaryService<super::RunStarlarkPackageUnitTestsArgs>
+                    for RunStarlarkPackageUnitTestsSvc<T> {
+                        type Response = super::RunStarlarkPackageUnitTestsResponse;
+                        type Future = BoxFuture<
+                            tonic::Response<Self::Response>,
+                            tonic::Status,
+                        >;
+                        fn call(
+                            &mut self,
+                            request: tonic::Request<
+                                super::RunStarlarkPackageUnitTestsArgs,
+                            >,
+                        ) -> Self::Future {
+                            let inner = Arc::clone(&self.0);
+                            let fut = async move {
+                                (*inner).run_starlark_package_unit_tests(request).await
+                            };
+                            Box::pin(fut)
+                        }
+                    }
+                    let accept_compression_encodings = self.accept_compression_encodings;
+                    let send_compression_encodings = self.send_compression_encodings;
+                    let max_decoding_message_size = self.max_decoding_message_size;
+                    let max_encoding_message_size = self.max_encoding_message_size;
+                    let inner = self.inner.clone();
+                    let fut = async move {
+                        let inner = inner.0;
+                        let method = RunStarlarkPackageUnitTestsSvc(inner);
+                        let codec = tonic::codec::ProstCodec::default();
+                        let mut grpc = tonic::server::Grpc::new(codec)
+                            .apply_compression_config(
+                                accept_compression_encodings,
+                                send_compression_encodings,
+                            )
+                            .apply_max_message_size_config(
+                                max_decoding_message_size,
+                                max_encoding_message_size,
+                            );
+                        let res = grpc.unary(method, req).await;
+                        Ok(res)
+                    };
+                    Box::pin(fut)
+                }
                 _ => {
                     Box::pin(async move {
                         Ok(
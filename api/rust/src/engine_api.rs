@@ -7,6 +7,21 @@ pub struct GetEngineInfoResponse {
     /// Version of the engine server
     #[prost(string, tag = "1")]
     pub engine_version: ::prost::alloc::string::String,
+    /// How long the engine keeps the service logs for, as a Go duration
+    #[prost(string, tag = "2")]
+    pub log_retention_period: ::prost::alloc::string::String,
+    /// Log level the engine server logs at
+    #[prost(string, tag = "3")]
+    pub log_level: ::prost::alloc::string::String,
+    /// Number of idle enclaves the engine keeps in its pool
+    #[prost(uint32, tag = "4")]
+    pub pool_size: u32,
+    /// Whether the engine got started with its debug server
+    #[prost(bool, tag = "5")]
+    pub is_debug_mode_enabled: bool,
+    /// Domain of the enclave manager UI
+    #[prost(string, tag = "6")]
+    pub domain: ::prost::alloc::string::String,
 }
 /// ==============================================================================================
 ///                                         Create Enclave
@@ -32,6 +47,30 @@ pub struct CreateEnclaveArgs {
     /// This is not an EnclaveMode because we will need to debug both current Modes (Test and Prod)
     #[prost(bool, optional, tag = "5")]
     pub should_apic_run_in_debug_mode: ::core::option::Option<bool>,
+    /// How long the enclave lives for before it expires
+    /// If unset, the enclave doesn't expire with age
+    #[prost(uint32, optional, tag = "6")]
+    pub ttl_seconds: ::core::option::Option<u32>,
+    /// How long the enclave can go without its API container serving any request before it expires
+    /// If unset, the enclave doesn't expire for being idle
+    #[prost(uint32, optional, tag = "7")]
+    pub idle_timeout_seconds: ::core::option::Option<u32>,
+    /// What happens to the enclave once it expires, the default being to destroy it
+    #[prost(enumeration = "ExpiredEnclaveAction", optional, tag = "8")]
+    pub expired_enclave_action: ::core::option::Option<i32>,
+    /// Arbitrary key/value labels attached to the enclave, e.g. the team owning it or the commit it was created for
+    #[prost(map = "string, string", tag = "9")]
+    pub labels: ::std::collections::HashMap<
+        ::prost::alloc::string::String,
+        ::prost::alloc::string::String,
+    >,
+    /// A package to run in the enclave before returning it, e.g. the base package every test starts by deploying
+    /// The enclave is handed out from the engine's warm pool if its enclaves were pre-seeded with the same package and params
+    #[prost(string, optional, tag = "10")]
+    pub seed_package_locator: ::core::option::Option<::prost::alloc::string::String>,
+    /// The serialized JSON params of the seed package, ignored if there's no seed package
+    #[prost(string, optional, tag = "11")]
+    pub seed_package_params: ::core::option::Option<::prost::alloc::string::String>,
 }
 #[allow(clippy::derive_partial_eq_without_eq)]
 #[derive(Clone, PartialEq, ::prost::Message)]
@@ -99,6 +138,37 @@ pub struct EnclaveInfo {
     pub creation_time: ::core::option::Option<::prost_types::Timestamp>,
     #[prost(enumeration = "EnclaveMode", tag = "9")]
     pub mode: i32,
+    /// NOTE: Will not be present if the enclave never expires!!
+    #[prost(message, optional, tag = "10")]
+    pub expiration: ::core::option::Option<EnclaveExpiration>,
+    /// The key/value labels attached to the enclave
+    #[prost(map = "string, string", tag = "11")]
+    pub labels: ::std::collections::HashMap<
+        ::prost::alloc::string::String,
+        ::prost::alloc::string::String,
+    >,
+}
+#[allow(clippy::derive_partial_eq_without_eq)]
+#[derive(Clone, PartialEq, ::prost::Message)]
+pub struct EnclaveExpiration {
+    /// NOTE: Will not be present if the enclave doesn't expire with age
+    #[prost(message, optional, tag = "1")]
+    pub expiration_time: ::core::option::Option<::prost_types::Timestamp>,
+    /// NOTE: Will not be present if the enclave doesn't expire for being idle
+    #[prost(uint32, optional, tag = "2")]
+    pub idle_timeout_seconds: ::core::option::Option<u32>,
+    #[prost(enumeration = "ExpiredEnclaveAction", tag = "3")]
+    pub action: i32,
+}
+#[allow(clippy::derive_partial_eq_without_eq)]
+#[derive(Clone, PartialEq, ::prost::Message)]
+pub struct GetEnclavesArgs {
+    /// Only the enclaves having all of these labels are returned; an empty selector matches all enclaves
+    #[prost(map = "string, string", tag = "1")]
+    pub label_selector: ::std::collections::HashMap<
+        ::prost::alloc::string::String,
+        ::prost::alloc::string::String,
+    >,
 }
 #[allow(clippy::derive_partial_eq_without_eq)]
 #[derive(Clone, PartialEq, ::prost::Message)]
@@ -141,6 +211,116 @@ pub struct StopEnclaveArgs {
     pub enclave_identifier: ::prost::alloc::string::String,
 }
 /// ==============================================================================================
+///                                        Extend Enclave TTL
+/// ==============================================================================================
+#[allow(clippy::derive_partial_eq_without_eq)]
+#[derive(Clone, PartialEq, ::prost::Message)]
+pub struct ExtendEnclaveTtlArgs {
+    /// The identifier(uuid, shortened uuid, name) of the Kurtosis enclave to extend the time-to-live of
+    #[prost(string, tag = "1")]
+    pub enclave_identifier: ::prost::alloc::string::String,
+    /// The new time-to-live of the enclave, counting from now
+    #[prost(uint32, tag = "2")]
+    pub ttl_seconds: u32,
+}
+/// ==============================================================================================
+///                                        Update Enclave Labels
+/// ==============================================================================================
+#[allow(clippy::derive_partial_eq_without_eq)]
+#[derive(Clone, PartialEq, ::prost::Message)]
+pub struct UpdateEnclaveLabelsArgs {
+    /// The identifier(uuid, shortened uuid, name) of the Kurtosis enclave to update the labels of
+    #[prost(string, tag = "1")]
+    pub enclave_identifier: ::prost::alloc::string::String,
+    /// Labels to add to the enclave, overwriting the value of the ones it already has
+    #[prost(map = "string, string", tag = "2")]
+    pub labels_to_set: ::std::collections::HashMap<
+        ::prost::alloc::string::String,
+        ::prost::alloc::string::String,
+    >,
+    /// Keys of the labels to remove from the enclave
+    #[prost(string, repeated, tag = "3")]
+    pub labels_to_remove: ::prost::alloc::vec::Vec<::prost::alloc::string::String>,
+}
+/// ==============================================================================================
+///                                        Rename Enclave
+/// ==============================================================================================
+#[allow(clippy::derive_partial_eq_without_eq)]
+#[derive(Clone, PartialEq, ::prost::Message)]
+pub struct RenameEnclaveArgs {
+    /// The identifier(uuid, shortened uuid, name) of the Kurtosis enclave to rename
+    #[prost(string, tag = "1")]
+    pub enclave_identifier: ::prost::alloc::string::String,
+    /// The new name of the enclave, which no other existing enclave can have
+    #[prost(string, tag = "2")]
+    pub new_name: ::prost::alloc::string::String,
+}
+/// ==============================================================================================
+///                                        Clone Enclave
+/// ==============================================================================================
+#[allow(clippy::derive_partial_eq_without_eq)]
+#[derive(Clone, PartialEq, ::prost::Message)]
+pub struct CloneEnclaveArgs {
+    /// The identifier(uuid, shortened uuid, name) of the Kurtosis enclave to clone
+    #[prost(string, tag = "1")]
+    pub enclave_identifier: ::prost::alloc::string::String,
+    /// The name of the new enclave, a random one is used if empty
+    #[prost(string, optional, tag = "2")]
+    pub enclave_name: ::core::option::Option<::prost::alloc::string::String>,
+    /// Whether to still clone the enclave when some instructions of its plan can't be replayed, leaving out the services they'd create
+    #[prost(bool, optional, tag = "3")]
+    pub allow_partial: ::core::option::Option<bool>,
+}
+#[allow(clippy::derive_partial_eq_without_eq)]
+#[derive(Clone, PartialEq, ::prost::Message)]
+pub struct CloneEnclaveResponse {
+    /// The information of the new enclave
+    #[prost(message, optional, tag = "1")]
+    pub enclave_info: ::core::option::Option<EnclaveInfo>,
+    /// What couldn't be copied to the new enclave, e.g. services whose state isn't persisted
+    #[prost(string, repeated, tag = "2")]
+    pub uncloneable_items: ::prost::alloc::vec::Vec<::prost::alloc::string::String>,
+    /// What may differ between the new enclave and the original one, e.g. persistent directories copied while their services were running
+    #[prost(string, repeated, tag = "3")]
+    pub warnings: ::prost::alloc::vec::Vec<::prost::alloc::string::String>,
+}
+/// ==============================================================================================
+///                                    Export & Import Enclave
+/// ==============================================================================================
+#[allow(clippy::derive_partial_eq_without_eq)]
+#[derive(Clone, PartialEq, ::prost::Message)]
+pub struct ExportEnclaveArgs {
+    /// The identifier(uuid, shortened uuid, name) of the Kurtosis enclave to export
+    #[prost(string, tag = "1")]
+    pub enclave_identifier: ::prost::alloc::string::String,
+}
+#[allow(clippy::derive_partial_eq_without_eq)]
+#[derive(Clone, PartialEq, ::prost::Message)]
+pub struct EnclaveArchiveChunk {
+    #[prost(bytes = "vec", tag = "1")]
+    pub data: ::prost::alloc::vec::Vec<u8>,
+}
+#[allow(clippy::derive_partial_eq_without_eq)]
+#[derive(Clone, PartialEq, ::prost::Message)]
+pub struct ImportEnclaveArgs {
+    /// The name of the new enclave, the one the enclave had when exported is used if empty. Only read from the first message
+    #[prost(string, optional, tag = "1")]
+    pub enclave_name: ::core::option::Option<::prost::alloc::string::String>,
+    /// The next chunk of the archive
+    #[prost(bytes = "vec", tag = "2")]
+    pub data: ::prost::alloc::vec::Vec<u8>,
+}
+#[allow(clippy::derive_partial_eq_without_eq)]
+#[derive(Clone, PartialEq, ::prost::Message)]
+pub struct ImportEnclaveResponse {
+    /// The information of the new enclave
+    #[prost(message, optional, tag = "1")]
+    pub enclave_info: ::core::option::Option<EnclaveInfo>,
+    /// What couldn't be recreated in the new enclave, e.g. instructions of the plan using values computed at runtime
+    #[prost(string, repeated, tag = "2")]
+    pub unimportable_items: ::prost::alloc::vec::Vec<::prost::alloc::string::String>,
+}
+/// ==============================================================================================
 ///                                        Get Enclaves
 /// ==============================================================================================
 #[allow(clippy::derive_partial_eq_without_eq)]
@@ -169,6 +349,12 @@ pub struct CleanArgs {
     /// If true, It will clean even the running enclaves
     #[prost(bool, optional, tag = "1")]
     pub should_clean_all: ::core::option::Option<bool>,
+    /// If not empty, only the enclaves having all of these labels get cleaned
+    #[prost(map = "string, string", tag = "2")]
+    pub label_selector: ::std::collections::HashMap<
+        ::prost::alloc::string::String,
+        ::prost::alloc::string::String,
+    >,
 }
 #[allow(clippy::derive_partial_eq_without_eq)]
 #[derive(Clone, PartialEq, ::prost::Message)]
@@ -246,6 +432,128 @@ pub struct LogLineFilter {
     #[prost(string, tag = "2")]
     pub text_pattern: ::prost::alloc::string::String,
 }
+#[allow(clippy::derive_partial_eq_without_eq)]
+#[derive(Clone, PartialEq, ::prost::Message)]
+pub struct WatchEventsArgs {
+    /// The types of events to stream, all of them get streamed if empty
+    #[prost(enumeration = "EngineEventType", repeated, tag = "1")]
+    pub event_types: ::prost::alloc::vec::Vec<i32>,
+}
+#[allow(clippy::derive_partial_eq_without_eq)]
+#[derive(Clone, PartialEq, ::prost::Message)]
+pub struct EngineEvent {
+    #[prost(enumeration = "EngineEventType", tag = "1")]
+    pub r#type: i32,
+    #[prost(message, optional, tag = "2")]
+    pub timestamp: ::core::option::Option<::prost_types::Timestamp>,
+    #[prost(string, tag = "3")]
+    pub enclave_uuid: ::prost::alloc::string::String,
+    #[prost(string, tag = "4")]
+    pub enclave_name: ::prost::alloc::string::String,
+    /// The service the event is about, only set for service events
+    #[prost(string, optional, tag = "5")]
+    pub service_name: ::core::option::Option<::prost::alloc::string::String>,
+    /// The package that was run, only set for Starlark run events of packages
+    #[prost(string, optional, tag = "6")]
+    pub starlark_package_id: ::core::option::Option<::prost::alloc::string::String>,
+    /// Whether the run succeeded, only set for STARLARK_RUN_FINISHED events
+    #[prost(bool, optional, tag = "7")]
+    pub starlark_run_succeeded: ::core::option::Option<bool>,
+}
+#[allow(clippy::derive_partial_eq_without_eq)]
+#[derive(Clone, PartialEq, ::prost::Message)]
+pub struct CreateApiTokenArgs {
+    /// Human-readable name of the token, for its owner to recognize it
+    #[prost(string, tag = "1")]
+    pub name: ::prost::alloc::string::String,
+    #[prost(enumeration = "ApiTokenRole", tag = "2")]
+    pub role: i32,
+    /// The names of the enclaves the token can act on, it can act on every enclave if empty
+    #[prost(string, repeated, tag = "3")]
+    pub enclave_names: ::prost::alloc::vec::Vec<::prost::alloc::string::String>,
+}
+#[allow(clippy::derive_partial_eq_without_eq)]
+#[derive(Clone, PartialEq, ::prost::Message)]
+pub struct CreateApiTokenResponse {
+    #[prost(string, tag = "1")]
+    pub token_id: ::prost::alloc::string::String,
+    /// The secret to send as a bearer token, it can't be retrieved later on
+    #[prost(string, tag = "2")]
+    pub token: ::prost::alloc::string::String,
+}
+#[allow(clippy::derive_partial_eq_without_eq)]
+#[derive(Clone, PartialEq, ::prost::Message)]
+pub struct RevokeApiTokenArgs {
+    #[prost(string, tag = "1")]
+    pub token_id: ::prost::alloc::string::String,
+}
+/// ==============================================================================================
+///                                           Audit Log
+/// ==============================================================================================
+#[allow(clippy::derive_partial_eq_without_eq)]
+#[derive(Clone, PartialEq, ::prost::Message)]
+pub struct GetAuditLogArgs {
+    /// Only the entries about this enclave are returned if set
+    #[prost(string, optional, tag = "1")]
+    pub enclave_identifier: ::core::option::Option<::prost::alloc::string::String>,
+    /// The maximum number of entries to return, a default is used if unset
+    #[prost(uint32, optional, tag = "2")]
+    pub page_size: ::core::option::Option<u32>,
+    /// The next_page_token of the previous page, the newest entries are returned if unset
+    #[prost(string, optional, tag = "3")]
+    pub page_token: ::core::option::Option<::prost::alloc::string::String>,
+}
+#[allow(clippy::derive_partial_eq_without_eq)]
+#[derive(Clone, PartialEq, ::prost::Message)]
+pub struct AuditLogEntry {
+    #[prost(message, optional, tag = "1")]
+    pub timestamp: ::core::option::Option<::prost_types::Timestamp>,
+    /// The API token that made the call if the engine enforces API authentication, the address the call came from otherwise
+    #[prost(string, tag = "2")]
+    pub caller: ::prost::alloc::string::String,
+    /// The name of the RPC that was called
+    #[prost(string, tag = "3")]
+    pub action: ::prost::alloc::string::String,
+    /// The enclave the call acted on, unset for the calls acting on the whole engine
+    #[prost(string, optional, tag = "4")]
+    pub enclave_uuid: ::core::option::Option<::prost::alloc::string::String>,
+    #[prost(string, optional, tag = "5")]
+    pub enclave_name: ::core::option::Option<::prost::alloc::string::String>,
+    /// The arguments of the call, with the values of the secrets redacted
+    #[prost(map = "string, string", tag = "6")]
+    pub arguments: ::std::collections::HashMap<
+        ::prost::alloc::string::String,
+        ::prost::alloc::string::String,
+    >,
+    /// Why the call failed, unset if it succeeded
+    #[prost(string, optional, tag = "7")]
+    pub error: ::core::option::Option<::prost::alloc::string::String>,
+}
+#[allow(clippy::derive_partial_eq_without_eq)]
+#[derive(Clone, PartialEq, ::prost::Message)]
+pub struct GetAuditLogResponse {
+    #[prost(message, repeated, tag = "1")]
+    pub entries: ::prost::alloc::vec::Vec<AuditLogEntry>,
+    /// To pass to get the next page, empty if this is the last one
+    #[prost(string, tag = "2")]
+    pub next_page_token: ::prost::alloc::string::String,
+}
+#[allow(clippy::derive_partial_eq_without_eq)]
+#[derive(Clone, PartialEq, ::prost::Message)]
+pub struct GetEnclaveCallerCredentialArgs {
+    #[prost(string, tag = "1")]
+    pub enclave_identifier: ::prost::alloc::string::String,
+}
+#[allow(clippy::derive_partial_eq_without_eq)]
+#[derive(Clone, PartialEq, ::prost::Message)]
+pub struct GetEnclaveCallerCredentialResponse {
+    /// To send to the API container in the kurtosis-audit-caller-credential gRPC metadata
+    #[prost(string, tag = "1")]
+    pub credential: ::prost::alloc::string::String,
+    /// When the API container stops accepting the credential
+    #[prost(message, optional, tag = "2")]
+    pub expiration_time: ::core::option::Option<::prost_types::Timestamp>,
+}
 #[derive(Clone, Copy, Debug, PartialEq, Eq, Hash, PartialOrd, Ord, ::prost::Enumeration)]
 #[repr(i32)]
 pub enum EnclaveMode {
@@ -272,6 +580,33 @@ impl EnclaveMode {
         }
     }
 }
+/// NOTE: We have to prefix the enum values with the enum name due to the way Protobuf enum value uniqueness works
+#[derive(Clone, Copy, Debug, PartialEq, Eq, Hash, PartialOrd, Ord, ::prost::Enumeration)]
+#[repr(i32)]
+pub enum ExpiredEnclaveAction {
+    Destroy = 0,
+    Stop = 1,
+}
+impl ExpiredEnclaveAction {
+    /// String value of the enum field names used in the ProtoBuf definition.
+    ///
+    /// The values are not transformed in any way and thus are considered stable
+    /// (if the ProtoBuf definition does not change) and safe for programmatic use.
+    pub fn as_str_name(&self) -> &'static str {
+        match self {
+            ExpiredEnclaveAction::Destroy => "ExpiredEnclaveAction_DESTROY",
+            ExpiredEnclaveAction::Stop => "ExpiredEnclaveAction_STOP",
+        }
+    }
+    /// Creates an enum from field names used in the ProtoBuf definition.
+    pub fn from_str_name(value: &str) -> ::core::option::Option<Self> {
+        match value {
+            "ExpiredEnclaveAction_DESTROY" => Some(Self::Destroy),
+            "ExpiredEnclaveAction_STOP" => Some(Self::Stop),
+            _ => None,
+        }
+    }
+}
 /// ==============================================================================================
 ///                                             Get Enclaves
 /// ==============================================================================================
@@ -391,6 +726,99 @@ impl LogLineOperator {
         }
     }
 }
+/// ==============================================================================================
+///                                          Watch Events
+/// ==============================================================================================
+/// NOTE: We have to prefix the enum values with the enum name due to the way Protobuf enum value uniqueness works
+#[derive(Clone, Copy, Debug, PartialEq, Eq, Hash, PartialOrd, Ord, ::prost::Enumeration)]
+#[repr(i32)]
+pub enum EngineEventType {
+    EnclaveCreated = 0,
+    EnclaveStopped = 1,
+    EnclaveDestroyed = 2,
+    StarlarkRunStarted = 3,
+    StarlarkRunFinished = 4,
+    ServiceAdded = 5,
+    ServiceStopped = 6,
+    ServiceRemoved = 7,
+    ApiContainerRestarted = 8,
+}
+impl EngineEventType {
+    /// String value of the enum field names used in the ProtoBuf definition.
+    ///
+    /// The values are not transformed in any way and thus are considered stable
+    /// (if the ProtoBuf definition does not change) and safe for programmatic use.
+    pub fn as_str_name(&self) -> &'static str {
+        match self {
+            EngineEventType::EnclaveCreated => "EngineEventType_ENCLAVE_CREATED",
+            EngineEventType::EnclaveStopped => "EngineEventType_ENCLAVE_STOPPED",
+            EngineEventType::EnclaveDestroyed => "EngineEventType_ENCLAVE_DESTROYED",
+            EngineEventType::StarlarkRunStarted => "EngineEventType_STARLARK_RUN_STARTED",
+            EngineEventType::StarlarkRunFinished => {
+                "EngineEventType_STARLARK_RUN_FINISHED"
+            }
+            EngineEventType::ServiceAdded => "EngineEventType_SERVICE_ADDED",
+            EngineEventType::ServiceStopped => "EngineEventType_SERVICE_STOPPED",
+            EngineEventType::ServiceRemoved => "EngineEventType_SERVICE_REMOVED",
+            EngineEventType::ApiContainerRestarted => {
+                "EngineEventType_API_CONTAINER_RESTARTED"
+            }
+        }
+    }
+    /// Creates an enum from field names used in the ProtoBuf definition.
+    pub fn from_str_name(value: &str) -> ::core::option::Option<Self> {
+        match value {
+            "EngineEventType_ENCLAVE_CREATED" => Some(Self::EnclaveCreated),
+            "EngineEventType_ENCLAVE_STOPPED" => Some(Self::EnclaveStopped),
+            "EngineEventType_ENCLAVE_DESTROYED" => Some(Self::EnclaveDestroyed),
+            "EngineEventType_STARLARK_RUN_STARTED" => Some(Self::StarlarkRunStarted),
+            "EngineEventType_STARLARK_RUN_FINISHED" => Some(Self::StarlarkRunFinished),
+            "EngineEventType_SERVICE_ADDED" => Some(Self::ServiceAdded),
+            "EngineEventType_SERVICE_STOPPED" => Some(Self::ServiceStopped),
+            "EngineEventType_SERVICE_REMOVED" => Some(Self::ServiceRemoved),
+            "EngineEventType_API_CONTAINER_RESTARTED" => {
+                Some(Self::ApiContainerRestarted)
+            }
+            _ => None,
+        }
+    }
+}
+/// ==============================================================================================
+///                                           API Tokens
+/// ==============================================================================================
+/// NOTE: We have to prefix the enum values with the enum name due to the way Protobuf enum value uniqueness works
+#[derive(Clone, Copy, Debug, PartialEq, Eq, Hash, PartialOrd, Ord, ::prost::Enumeration)]
+#[repr(i32)]
+pub enum ApiTokenRole {
+    /// Can only call the RPCs that don't change anything
+    ReadOnly = 0,
+    /// Can also create, stop and destroy enclaves
+    Operator = 1,
+    /// Can call every RPC, including cleaning the engine and managing the API tokens
+    Admin = 2,
+}
+impl ApiTokenRole {
+    /// String value of the enum field names used in the ProtoBuf definition.
+    ///
+    /// The values are not transformed in any way and thus are considered stable
+    /// (if the ProtoBuf definition does not change) and safe for programmatic use.
+    pub fn as_str_name(&self) -> &'static str {
+        match self {
+            ApiTokenRole::ReadOnly => "ApiTokenRole_READ_ONLY",
+            ApiTokenRole::Operator => "ApiTokenRole_OPERATOR",
+            ApiTokenRole::Admin => "ApiTokenRole_ADMIN",
+        }
+    }
+    /// Creates an enum from field names used in the ProtoBuf definition.
+    pub fn from_str_name(value: &str) -> ::core::option::Option<Self> {
+        match value {
+            "ApiTokenRole_READ_ONLY" => Some(Self::ReadOnly),
+            "ApiTokenRole_OPERATOR" => Some(Self::Operator),
+            "ApiTokenRole_ADMIN" => Some(Self::Admin),
+            _ => None,
+        }
+    }
+}
 /// Generated client implementations.
 pub mod engine_service_client {
     #![allow(unused_variables, dead_code, missing_docs, clippy::let_unit_value)]
@@ -531,10 +959,10 @@ pub mod engine_service_client {
                 .insert(GrpcMethod::new("engine_api.EngineService", "CreateEnclave"));
             self.inner.unary(req, path, codec).await
         }
-        /// Returns information about the requested enclaves or all enclaves if none specified.
+        /// Returns information about the enclaves matching the label selector, or all enclaves if none specified.
         pub async fn get_enclaves(
             &mut self,
-            request: impl tonic::IntoRequest<()>,
+            request: impl tonic::IntoRequest<super::GetEnclavesArgs>,
         ) -> std::result::Result<
             tonic::Response<super::GetEnclavesResponse>,
             tonic::Status,
@@ -662,11 +1090,11 @@ pub mod engine_service_client {
                 .insert(GrpcMethod::new("engine_api.EngineService", "DestroyEnclave"));
             self.inner.unary(req, path, codec).await
         }
-        /// Gets rid of old enclaves
-        pub async fn clean(
+        /// Makes an enclave expire after the given time-to-live, counting from now
+        pub async fn extend_enclave_ttl(
             &mut self,
-            request: impl tonic::IntoRequest<super::CleanArgs>,
-        ) -> std::result::Result<tonic::Response<super::CleanResponse>, tonic::Status> {
+            request: impl tonic::IntoRequest<super::ExtendEnclaveTtlArgs>,
+        ) -> std::result::Result<tonic::Response<()>, tonic::Status> {
             self.inner
                 .ready()
                 .await
@@ -678,21 +1106,18 @@ pub mod engine_service_client {
                 })?;
             let codec = tonic::codec::ProstCodec::default();
             let path = http::uri::PathAndQuery::from_static(
-                "/engine_api.EngineService/Clean",
+                "/engine_api.EngineService/ExtendEnclaveTtl",
             );
             let mut req = request.into_request();
             req.extensions_mut()
-                .insert(GrpcMethod::new("engine_api.EngineService", "Clean"));
+                .insert(GrpcMethod::new("engine_api.EngineService", "ExtendEnclaveTtl"));
             self.inner.unary(req, path, codec).await
         }
-        /// Get service logs
-        pub async fn get_service_logs(
+        /// Sets and removes labels of an enclave
+        pub async fn update_enclave_labels(
             &mut self,
-            request: impl tonic::IntoRequest<super::GetServiceLogsArgs>,
-        ) -> std::result::Result<
-            tonic::Response<tonic::codec::Streaming<super::GetServiceLogsResponse>>,
-            tonic::Status,
-        > {
+            request: impl tonic::IntoRequest<super::UpdateEnclaveLabelsArgs>,
+        ) -> std::result::Result<tonic::Response<()>, tonic::Status> {
             self.inner
                 .ready()
                 .await
@@ -704,142 +1129,514 @@ pub mod engine_service_client {
                 })?;
             let codec = tonic::codec::ProstCodec::default();
             let path = http::uri::PathAndQuery::from_static(
-                "/engine_api.EngineService/GetServiceLogs",
+                "/engine_api.EngineService/UpdateEnclaveLabels",
             );
             let mut req = request.into_request();
             req.extensions_mut()
-                .insert(GrpcMethod::new("engine_api.EngineService", "GetServiceLogs"));
-            self.inner.server_streaming(req, path, codec).await
+                .insert(
+                    GrpcMethod::new("engine_api.EngineService", "UpdateEnclaveLabels"),
+                );
+            self.inner.unary(req, path, codec).await
         }
-    }
-}
-/// Generated server implementations.
-pub mod engine_service_server {
-    #![allow(unused_variables, dead_code, missing_docs, clippy::let_unit_value)]
-    use tonic::codegen::*;
-    /// Generated trait containing gRPC methods that should be implemented for use with EngineServiceServer.
-    #[async_trait]
-    pub trait EngineService: Send + Sync + 'static {
-        /// Endpoint for getting information about the engine, which is also what we use to verify that the engine has become available
-        async fn get_engine_info(
-            &self,
-            request: tonic::Request<()>,
-        ) -> std::result::Result<
-            tonic::Response<super::GetEngineInfoResponse>,
-            tonic::Status,
-        >;
-        /// ==============================================================================================
-        ///                                   Enclave Management
-        /// ==============================================================================================
-        /// Creates a new Kurtosis Enclave
-        async fn create_enclave(
-            &self,
-            request: tonic::Request<super::CreateEnclaveArgs>,
-        ) -> std::result::Result<
-            tonic::Response<super::CreateEnclaveResponse>,
-            tonic::Status,
-        >;
-        /// Returns information about the requested enclaves or all enclaves if none specified.
-        async fn get_enclaves(
-            &self,
-            request: tonic::Request<()>,
+        /// Changes the name of an existing enclave, keeping its UUID
+        pub async fn rename_enclave(
+            &mut self,
+            request: impl tonic::IntoRequest<super::RenameEnclaveArgs>,
+        ) -> std::result::Result<tonic::Response<()>, tonic::Status> {
+            self.inner
+                .ready()
+                .await
+                .map_err(|e| {
+                    tonic::Status::new(
+                        tonic::Code::Unknown,
+                        format!("Service was not ready: {}", e.into()),
+                    )
+                })?;
+            let codec = tonic::codec::ProstCodec::default();
+            let path = http::uri::PathAndQuery::from_static(
+                "/engine_api.EngineService/RenameEnclave",
+            );
+            let mut req = request.into_request();
+            req.extensions_mut()
+                .insert(GrpcMethod::new("engine_api.EngineService", "RenameEnclave"));
+            self.inner.unary(req, path, codec).await
+        }
+        /// Creates a new enclave with the files artifacts, persistent directories and services of an existing one
+        pub async fn clone_enclave(
+            &mut self,
+            request: impl tonic::IntoRequest<super::CloneEnclaveArgs>,
         ) -> std::result::Result<
-            tonic::Response<super::GetEnclavesResponse>,
+            tonic::Response<super::CloneEnclaveResponse>,
             tonic::Status,
-        >;
-        /// Returns information about the requested enclaves or all enclaves if none specified.
-        async fn get_enclaves_by_uuids(
-            &self,
-            request: tonic::Request<super::GetEnclavesByUuidsArgs>,
+        > {
+            self.inner
+                .ready()
+                .await
+                .map_err(|e| {
+                    tonic::Status::new(
+                        tonic::Code::Unknown,
+                        format!("Service was not ready: {}", e.into()),
+                    )
+                })?;
+            let codec = tonic::codec::ProstCodec::default();
+            let path = http::uri::PathAndQuery::from_static(
+                "/engine_api.EngineService/CloneEnclave",
+            );
+            let mut req = request.into_request();
+            req.extensions_mut()
+                .insert(GrpcMethod::new("engine_api.EngineService", "CloneEnclave"));
+            self.inner.unary(req, path, codec).await
+        }
+        /// Streams an archive of an enclave, with its plan, files artifacts, persistent directories and mode, that ImportEnclave recreates it from
+        pub async fn export_enclave(
+            &mut self,
+            request: impl tonic::IntoRequest<super::ExportEnclaveArgs>,
         ) -> std::result::Result<
-            tonic::Response<super::GetEnclavesResponse>,
+            tonic::Response<tonic::codec::Streaming<super::EnclaveArchiveChunk>>,
             tonic::Status,
-        >;
-        /// Returns information about all existing & historical enclaves
-        async fn get_existing_and_historical_enclave_identifiers(
-            &self,
-            request: tonic::Request<()>,
+        > {
+            self.inner
+                .ready()
+                .await
+                .map_err(|e| {
+                    tonic::Status::new(
+                        tonic::Code::Unknown,
+                        format!("Service was not ready: {}", e.into()),
+                    )
+                })?;
+            let codec = tonic::codec::ProstCodec::default();
+            let path = http::uri::PathAndQuery::from_static(
+                "/engine_api.EngineService/ExportEnclave",
+            );
+            let mut req = request.into_request();
+            req.extensions_mut()
+                .insert(GrpcMethod::new("engine_api.EngineService", "ExportEnclave"));
+            self.inner.server_streaming(req, path, codec).await
+        }
+        /// Creates an enclave from an archive streamed by ExportEnclave, possibly on another engine
+        pub async fn import_enclave(
+            &mut self,
+            request: impl tonic::IntoStreamingRequest<Message = super::ImportEnclaveArgs>,
         ) -> std::result::Result<
-            tonic::Response<super::GetExistingAndHistoricalEnclaveIdentifiersResponse>,
+            tonic::Response<super::ImportEnclaveResponse>,
             tonic::Status,
-        >;
-        /// Stops all containers in an enclave
-        async fn stop_enclave(
-            &self,
-            request: tonic::Request<super::StopEnclaveArgs>,
-        ) -> std::result::Result<tonic::Response<()>, tonic::Status>;
-        /// Destroys an enclave, removing all artifacts associated with it
-        async fn destroy_enclave(
-            &self,
-            request: tonic::Request<super::DestroyEnclaveArgs>,
-        ) -> std::result::Result<tonic::Response<()>, tonic::Status>;
+        > {
+            self.inner
+                .ready()
+                .await
+                .map_err(|e| {
+                    tonic::Status::new(
+                        tonic::Code::Unknown,
+                        format!("Service was not ready: {}", e.into()),
+                    )
+                })?;
+            let codec = tonic::codec::ProstCodec::default();
+            let path = http::uri::PathAndQuery::from_static(
+                "/engine_api.EngineService/ImportEnclave",
+            );
+            let mut req = request.into_streaming_request();
+            req.extensions_mut()
+                .insert(GrpcMethod::new("engine_api.EngineService", "ImportEnclave"));
+            self.inner.client_streaming(req, path, codec).await
+        }
         /// Gets rid of old enclaves
-        async fn clean(
-            &self,
-            request: tonic::Request<super::CleanArgs>,
-        ) -> std::result::Result<tonic::Response<super::CleanResponse>, tonic::Status>;
-        /// Server streaming response type for the GetServiceLogs method.
-        type GetServiceLogsStream: futures_core::Stream<
-                Item = std::result::Result<super::GetServiceLogsResponse, tonic::Status>,
-            >
-            + Send
-            + 'static;
+        pub async fn clean(
+            &mut self,
+            request: impl tonic::IntoRequest<super::CleanArgs>,
+        ) -> std::result::Result<tonic::Response<super::CleanResponse>, tonic::Status> {
+            self.inner
+                .ready()
+                .await
+                .map_err(|e| {
+                    tonic::Status::new(
+                        tonic::Code::Unknown,
+                        format!("Service was not ready: {}", e.into()),
+                    )
+                })?;
+            let codec = tonic::codec::ProstCodec::default();
+            let path = http::uri::PathAndQuery::from_static(
+                "/engine_api.EngineService/Clean",
+            );
+            let mut req = request.into_request();
+            req.extensions_mut()
+                .insert(GrpcMethod::new("engine_api.EngineService", "Clean"));
+            self.inner.unary(req, path, codec).await
+        }
         /// Get service logs
-        async fn get_service_logs(
-            &self,
-            request: tonic::Request<super::GetServiceLogsArgs>,
+        pub async fn get_service_logs(
+            &mut self,
+            request: impl tonic::IntoRequest<super::GetServiceLogsArgs>,
         ) -> std::result::Result<
-            tonic::Response<Self::GetServiceLogsStream>,
+            tonic::Response<tonic::codec::Streaming<super::GetServiceLogsResponse>>,
             tonic::Status,
-        >;
-    }
-    #[derive(Debug)]
-    pub struct EngineServiceServer<T: EngineService> {
-        inner: _Inner<T>,
-        accept_compression_encodings: EnabledCompressionEncodings,
-        send_compression_encodings: EnabledCompressionEncodings,
-        max_decoding_message_size: Option<usize>,
-        max_encoding_message_size: Option<usize>,
-    }
-    struct _Inner<T>(Arc<T>);
-    impl<T: EngineService> EngineServiceServer<T> {
-        pub fn new(inner: T) -> Self {
-            Self::from_arc(Arc::new(inner))
-        }
-        pub fn from_arc(inner: Arc<T>) -> Self {
-            let inner = _Inner(inner);
-            Self {
-                inner,
-                accept_compression_encodings: Default::default(),
-                send_compression_encodings: Default::default(),
-                max_decoding_message_size: None,
-                max_encoding_message_size: None,
-            }
+        > {
+            self.inner
+                .ready()
+                .await
+                .map_err(|e| {
+                    tonic::Status::new(
+                        tonic::Code::Unknown,
+                        format!("Service was not ready: {}", e.into()),
+                    )
+                })?;
+            let codec = tonic::codec::ProstCodec::default();
+            let path = http::uri::PathAndQuery::from_static(
+                "/engine_api.EngineService/GetServiceLogs",
+            );
+            let mut req = request.into_request();
+            req.extensions_mut()
+                .insert(GrpcMethod::new("engine_api.EngineService", "GetServiceLogs"));
+            self.inner.server_streaming(req, path, codec).await
         }
-        pub fn with_interceptor<F>(
-            inner: T,
-            interceptor: F,
-        ) -> InterceptedService<Self, F>
-        where
-            F: tonic::service::Interceptor,
-        {
-            InterceptedService::new(Self::new(inner), interceptor)
+        /// Streams what happens to the enclaves of the engine, starting from when the stream is opened
+        pub async fn watch_events(
+            &mut self,
+            request: impl tonic::IntoRequest<super::WatchEventsArgs>,
+        ) -> std::result::Result<
+            tonic::Response<tonic::codec::Streaming<super::EngineEvent>>,
+            tonic::Status,
+        > {
+            self.inner
+                .ready()
+                .await
+                .map_err(|e| {
+                    tonic::Status::new(
+                        tonic::Code::Unknown,
+                        format!("Service was not ready: {}", e.into()),
+                    )
+                })?;
+            let codec = tonic::codec::ProstCodec::default();
+            let path = http::uri::PathAndQuery::from_static(
+                "/engine_api.EngineService/WatchEvents",
+            );
+            let mut req = request.into_request();
+            req.extensions_mut()
+                .insert(GrpcMethod::new("engine_api.EngineService", "WatchEvents"));
+            self.inner.server_streaming(req, path, codec).await
         }
-        /// Enable decompressing requests with the given encoding.
-        #[must_use]
-        pub fn accept_compressed(mut self, encoding: CompressionEncoding) -> Self {
-            self.accept_compression_encodings.enable(encoding);
-            self
+        /// Creates an API token, only available when the engine enforces API authentication
+        pub async fn create_api_token(
+            &mut self,
+            request: impl tonic::IntoRequest<super::CreateApiTokenArgs>,
+        ) -> std::result::Result<
+            tonic::Response<super::CreateApiTokenResponse>,
+            tonic::Status,
+        > {
+            self.inner
+                .ready()
+                .await
+                .map_err(|e| {
+                    tonic::Status::new(
+                        tonic::Code::Unknown,
+                        format!("Service was not ready: {}", e.into()),
+                    )
+                })?;
+            let codec = tonic::codec::ProstCodec::default();
+            let path = http::uri::PathAndQuery::from_static(
+                "/engine_api.EngineService/CreateApiToken",
+            );
+            let mut req = request.into_request();
+            req.extensions_mut()
+                .insert(GrpcMethod::new("engine_api.EngineService", "CreateApiToken"));
+            self.inner.unary(req, path, codec).await
         }
-        /// Compress responses with the given encoding, if the client supports it.
-        #[must_use]
-        pub fn send_compressed(mut self, encoding: CompressionEncoding) -> Self {
-            self.send_compression_encodings.enable(encoding);
-            self
+        /// Revokes an API token so that it can't be used anymore
+        pub async fn revoke_api_token(
+            &mut self,
+            request: impl tonic::IntoRequest<super::RevokeApiTokenArgs>,
+        ) -> std::result::Result<tonic::Response<()>, tonic::Status> {
+            self.inner
+                .ready()
+                .await
+                .map_err(|e| {
+                    tonic::Status::new(
+                        tonic::Code::Unknown,
+                        format!("Service was not ready: {}", e.into()),
+                    )
+                })?;
+            let codec = tonic::codec::ProstCodec::default();
+            let path = http::uri::PathAndQuery::from_static(
+                "/engine_api.EngineService/RevokeApiToken",
+            );
+            let mut req = request.into_request();
+            req.extensions_mut()
+                .insert(GrpcMethod::new("engine_api.EngineService", "RevokeApiToken"));
+            self.inner.unary(req, path, codec).await
         }
-        /// Limits the maximum size of a decoded message.
-        ///
-        /// Default: `4MB`
+        /// Gets the changes made through the engine and the API containers of its running enclaves, newest first
+        pub async fn get_audit_log(
+            &mut self,
+            request: impl tonic::IntoRequest<super::GetAuditLogArgs>,
+        ) -> std::result::Result<
+            tonic::Response<super::GetAuditLogResponse>,
+            tonic::Status,
+        > {
+            self.inner
+                .ready()
+                .await
+                .map_err(|e| {
+                    tonic::Status::new(
+                        tonic::Code::Unknown,
+                        format!("Service was not ready: {}", e.into()),
+                    )
+                })?;
+            let codec = tonic::codec::ProstCodec::default();
+            let path = http::uri::PathAndQuery::from_static(
+                "/engine_api.EngineService/GetAuditLog",
+            );
+            let mut req = request.into_request();
+            req.extensions_mut()
+                .insert(GrpcMethod::new("engine_api.EngineService", "GetAuditLog"));
+            self.inner.unary(req, path, codec).await
+        }
+        /// Gets a credential proving who the caller is to the API container of an enclave, so that the calls it makes to the API container directly are attributed to it in the audit log
+        pub async fn get_enclave_caller_credential(
+            &mut self,
+            request: impl tonic::IntoRequest<super::GetEnclaveCallerCredentialArgs>,
+        ) -> std::result::Result<
+            tonic::Response<super::GetEnclaveCallerCredentialResponse>,
+            tonic::Status,
+        > {
+            self.inner
+                .ready()
+                .await
+                .map_err(|e| {
+                    tonic::Status::new(
+                        tonic::Code::Unknown,
+                        format!("Service was not ready: {}", e.into()),
+                    )
+                })?;
+            let codec = tonic::codec::ProstCodec::default();
+            let path = http::uri::PathAndQuery::from_static(
+                "/engine_api.EngineService/GetEnclaveCallerCredential",
+            );
+            let mut req = request.into_request();
+            req.extensions_mut()
+                .insert(
+                    GrpcMethod::new(
+                        "engine_api.EngineService",
+                        "GetEnclaveCallerCredential",
+                    ),
+                );
+            self.inner.unary(req, path, codec).await
+        }
+    }
+}
+/// Generated server implementations.
+pub mod engine_service_server {
+    #![allow(unused_variables, dead_code, missing_docs, clippy::let_unit_value)]
+    use tonic::codegen::*;
+    /// Generated trait containing gRPC methods that should be implemented for use with EngineServiceServer.
+    #[async_trait]
+    pub trait EngineService: Send + Sync + 'static {
+        /// Endpoint for getting information about the engine, which is also what we use to verify that the engine has become available
+        async fn get_engine_info(
+            &self,
+            request: tonic::Request<()>,
+        ) -> std::result::Result<
+            tonic::Response<super::GetEngineInfoResponse>,
+            tonic::Status,
+        >;
+        /// ==============================================================================================
+        ///                                   Enclave Management
+        /// ==============================================================================================
+        /// Creates a new Kurtosis Enclave
+        async fn create_enclave(
+            &self,
+            request: tonic::Request<super::CreateEnclaveArgs>,
+        ) -> std::result::Result<
+            tonic::Response<super::CreateEnclaveResponse>,
+            tonic::Status,
+        >;
+        /// Returns information about the enclaves matching the label selector, or all enclaves if none specified.
+        async fn get_enclaves(
+            &self,
+            request: tonic::Request<super::GetEnclavesArgs>,
+        ) -> std::result::Result<
+            tonic::Response<super::GetEnclavesResponse>,
+            tonic::Status,
+        >;
+        /// Returns information about the requested enclaves or all enclaves if none specified.
+        async fn get_enclaves_by_uuids(
+            &self,
+            request: tonic::Request<super::GetEnclavesByUuidsArgs>,
+        ) -> std::result::Result<
+            tonic::Response<super::GetEnclavesResponse>,
+            tonic::Status,
+        >;
+        /// Returns information about all existing & historical enclaves
+        async fn get_existing_and_historical_enclave_identifiers(
+            &self,
+            request: tonic::Request<()>,
+        ) -> std::result::Result<
+            tonic::Response<super::GetExistingAndHistoricalEnclaveIdentifiersResponse>,
+            tonic::Status,
+        >;
+        /// Stops all containers in an enclave
+        async fn stop_enclave(
+            &self,
+            request: tonic::Request<super::StopEnclaveArgs>,
+        ) -> std::result::Result<tonic::Response<()>, tonic::Status>;
+        /// Destroys an enclave, removing all artifacts associated with it
+        async fn destroy_enclave(
+            &self,
+            request: tonic::Request<super::DestroyEnclaveArgs>,
+        ) -> std::result::Result<tonic::Response<()>, tonic::Status>;
+        /// Makes an enclave expire after the given time-to-live, counting from now
+        async fn extend_enclave_ttl(
+            &self,
+            request: tonic::Request<super::ExtendEnclaveTtlArgs>,
+        ) -> std::result::Result<tonic::Response<()>, tonic::Status>;
+        /// Sets and removes labels of an enclave
+        async fn update_enclave_labels(
+            &self,
+            request: tonic::Request<super::UpdateEnclaveLabelsArgs>,
+        ) -> std::result::Result<tonic::Response<()>, tonic::Status>;
+        /// Changes the name of an existing enclave, keeping its UUID
+        async fn rename_enclave(
+            &self,
+            request: tonic::Request<super::RenameEnclaveArgs>,
+        ) -> std::result::Result<tonic::Response<()>, tonic::Status>;
+        /// Creates a new enclave with the files artifacts, persistent directories and services of an existing one
+        async fn clone_enclave(
+            &self,
+            request: tonic::Request<super::CloneEnclaveArgs>,
+        ) -> std::result::Result<
+            tonic::Response<super::CloneEnclaveResponse>,
+            tonic::Status,
+        >;
+        /// Server streaming response type for the ExportEnclave method.
+        type ExportEnclaveStream: futures_core::Stream<
+                Item = std::result::Result<super::EnclaveArchiveChunk, tonic::Status>,
+            >
+            + Send
+            + 'static;
+        /// Streams an archive of an enclave, with its plan, files artifacts, persistent directories and mode, that ImportEnclave recreates it from
+        async fn export_enclave(
+            &self,
+            request: tonic::Request<super::ExportEnclaveArgs>,
+        ) -> std::result::Result<
+            tonic::Response<Self::ExportEnclaveStream>,
+            tonic::Status,
+        >;
+        /// Creates an enclave from an archive streamed by ExportEnclave, possibly on another engine
+        async fn import_enclave(
+            &self,
+            request: tonic::Request<tonic::Streaming<super::ImportEnclaveArgs>>,
+        ) -> std::result::Result<
+            tonic::Response<super::ImportEnclaveResponse>,
+            tonic::Status,
+        >;
+        /// Gets rid of old enclaves
+        async fn clean(
+            &self,
+            request: tonic::Request<super::CleanArgs>,
+        ) -> std::result::Result<tonic::Response<super::CleanResponse>, tonic::Status>;
+        /// Server streaming response type for the GetServiceLogs method.
+        type GetServiceLogsStream: futures_core::Stream<
+                Item = std::result::Result<super::GetServiceLogsResponse, tonic::Status>,
+            >
+            + Send
+            + 'static;
+        /// Get service logs
+        async fn get_service_logs(
+            &self,
+            request: tonic::Request<super::GetServiceLogsArgs>,
+        ) -> std::result::Result<
+            tonic::Response<Self::GetServiceLogsStream>,
+            tonic::Status,
+        >;
+        /// Server streaming response type for the WatchEvents method.
+        type WatchEventsStream: futures_core::Stream<
+                Item = std::result::Result<super::EngineEvent, tonic::Status>,
+            >
+            + Send
+            + 'static;
+        /// Streams what happens to the enclaves of the engine, starting from when the stream is opened
+        async fn watch_events(
+            &self,
+            request: tonic::Request<super::WatchEventsArgs>,
+        ) -> std::result::Result<
+            tonic::Response<Self::WatchEventsStream>,
+            tonic::Status,
+        >;
+        /// Creates an API token, only available when the engine enforces API authentication
+        async fn create_api_token(
+            &self,
+            request: tonic::Request<super::CreateApiTokenArgs>,
+        ) -> std::result::Result<
+            tonic::Response<super::CreateApiTokenResponse>,
+            tonic::Status,
+        >;
+        /// Revokes an API token so that it can't be used anymore
+        async fn revoke_api_token(
+            &self,
+            request: tonic::Request<super::RevokeApiTokenArgs>,
+        ) -> std::result::Result<tonic::Response<()>, tonic::Status>;
+        /// Gets the changes made through the engine and the API containers of its running enclaves, newest first
+        async fn get_audit_log(
+            &self,
+            request: tonic::Request<super::GetAuditLogArgs>,
+        ) -> std::result::Result<
+            tonic::Response<super::GetAuditLogResponse>,
+            tonic::Status,
+        >;
+        /// Gets a credential proving who the caller is to the API container of an enclave, so that the calls it makes to the API container directly are attributed to it in the audit log
+        async fn get_enclave_caller_credential(
+            &self,
+            request: tonic::Request<super::GetEnclaveCallerCredentialArgs>,
+        ) -> std::result::Result<
+            tonic::Response<super::GetEnclaveCallerCredentialResponse>,
+            tonic::Status,
+        >;
+    }
+    #[derive(Debug)]
+    pub struct EngineServiceServer<T: EngineService> {
+        inner: _Inner<T>,
+        accept_compression_encodings: EnabledCompressionEncodings,
+        send_compression_encodings: EnabledCompressionEncodings,
+        max_decoding_message_size: Option<usize>,
+        max_encoding_message_size: Option<usize>,
+    }
+    struct _Inner<T>(Arc<T>);
+    impl<T: EngineService> EngineServiceServer<T> {
+        pub fn new(inner: T) -> Self {
+            Self::from_arc(Arc::new(inner))
+        }
+        pub fn from_arc(inner: Arc<T>) -> Self {
+            let inner = _Inner(inner);
+            Self {
+                inner,
+                accept_compression_encodings: Default::default(),
+                send_compression_encodings: Default::default(),
+                max_decoding_message_size: None,
+                max_encoding_message_size: None,
+            }
+        }
+        pub fn with_interceptor<F>(
+            inner: T,
+            interceptor: F,
+        ) -> InterceptedService<Self, F>
+        where
+            F: tonic::service::Interceptor,
+        {
+            InterceptedService::new(Self::new(inner), interceptor)
+        }
+        /// Enable decompressing requests with the given encoding.
+        #[must_use]
+        pub fn accept_compressed(mut self, encoding: CompressionEncoding) -> Self {
+            self.accept_compression_encodings.enable(encoding);
+            self
+        }
+        /// Compress responses with the given encoding, if the client supports it.
+        #[must_use]
+        pub fn send_compressed(mut self, encoding: CompressionEncoding) -> Self {
+            self.send_compression_encodings.enable(encoding);
+            self
+        }
+        /// Limits the maximum size of a decoded message.
+        ///
+        /// Default: `4MB`
         #[must_use]
         pub fn max_decoding_message_size(mut self, limit: usize) -> Self {
             self.max_decoding_message_size = Some(limit);
@@ -874,18 +1671,389 @@ pub mod engine_service_server {
             match req.uri().path() {
                 "/engine_api.EngineService/GetEngineInfo" => {
                     #[allow(non_camel_case_types)]
-                    struct GetEngineInfoSvc<T: EngineService>(pub Arc<T>);
-                    impl<T: EngineService> tonic::server::UnaryService<()>
-                    for GetEngineInfoSvc<T> {
-                        type Response = super::GetEngineInfoResponse;
+                    struct GetEngineInfoSvc<T: EngineService>(pub Arc<T>);
+                    impl<T: EngineService> tonic::server::UnaryService<()>
+                    for GetEngineInfoSvc<T> {
+                        type Response = super::GetEngineInfoResponse;
+                        type Future = BoxFuture<
+                            tonic::Response<Self::Response>,
+                            tonic::Status,
+                        >;
+                        fn call(&mut self, request: tonic::Request<()>) -> Self::Future {
+                            let inner = Arc::clone(&self.0);
+                            let fut = async move {
+                                (*inner).get_engine_info(request).await
+                            };
+                            Box::pin(fut)
+                        }
+                    }
+                    let accept_compression_encodings = self.accept_compression_encodings;
+                    let send_compression_encodings = self.send_compression_encodings;
+                    let max_decoding_message_size = self.max_decoding_message_size;
+                    let max_encoding_message_size = self.max_encoding_message_size;
+                    let inner = self.inner.clone();
+                    let fut = async move {
+                        let inner = inner.0;
+                        let method = GetEngineInfoSvc(inner);
+                        let codec = tonic::codec::ProstCodec::default();
+                        let mut grpc = tonic::server::Grpc::new(codec)
+                            .apply_compression_config(
+                                accept_compression_encodings,
+                                send_compression_encodings,
+                            )
+                            .apply_max_message_size_config(
+                                max_decoding_message_size,
+                                max_encoding_message_size,
+                            );
+                        let res = grpc.unary(method, req).await;
+                        Ok(res)
+                    };
+                    Box::pin(fut)
+                }
+                "/engine_api.EngineService/CreateEnclave" => {
+                    #[allow(non_camel_case_types)]
+                    struct CreateEnclaveSvc<T: EngineService>(pub Arc<T>);
+                    impl<
+                        T: EngineService,
+                    > tonic::server::UnaryService<super::CreateEnclaveArgs>
+                    for CreateEnclaveSvc<T> {
+                        type Response = super::CreateEnclaveResponse;
+                        type Future = BoxFuture<
+                            tonic::Response<Self::Response>,
+                            tonic::Status,
+                        >;
+                        fn call(
+                            &mut self,
+                            request: tonic::Request<super::CreateEnclaveArgs>,
+                        ) -> Self::Future {
+                            let inner = Arc::clone(&self.0);
+                            let fut = async move {
+                                (*inner).create_enclave(request).await
+                            };
+                            Box::pin(fut)
+                        }
+                    }
+                    let accept_compression_encodings = self.accept_compression_encodings;
+                    let send_compression_encodings = self.send_compression_encodings;
+                    let max_decoding_message_size = self.max_decoding_message_size;
+                    let max_encoding_message_size = self.max_encoding_message_size;
+                    let inner = self.inner.clone();
+                    let fut = async move {
+                        let inner = inner.0;
+                        let method = CreateEnclaveSvc(inner);
+                        let codec = tonic::codec::ProstCodec::default();
+                        let mut grpc = tonic::server::Grpc::new(codec)
+                            .apply_compression_config(
+                                accept_compression_encodings,
+                                send_compression_encodings,
+                            )
+                            .apply_max_message_size_config(
+                                max_decoding_message_size,
+                                max_encoding_message_size,
+                            );
+                        let res = grpc.unary(method, req).await;
+                        Ok(res)
+                    };
+                    Box::pin(fut)
+                }
+                "/engine_api.EngineService/GetEnclaves" => {
+                    #[allow(non_camel_case_types)]
+                    struct GetEnclavesSvc<T: EngineService>(pub Arc<T>);
+                    impl<
+                        T: EngineService,
+                    > tonic::server::UnaryService<super::GetEnclavesArgs>
+                    for GetEnclavesSvc<T> {
+                        type Response = super::GetEnclavesResponse;
+                        type Future = BoxFuture<
+                            tonic::Response<Self::Response>,
+                            tonic::Status,
+                        >;
+                        fn call(
+                            &mut self,
+                            request: tonic::Request<super::GetEnclavesArgs>,
+                        ) -> Self::Future {
+                            let inner = Arc::clone(&self.0);
+                            let fut = async move {
+                                (*inner).get_enclaves(request).await
+                            };
+                            Box::pin(fut)
+                        }
+                    }
+                    let accept_compression_encodings = self.accept_compression_encodings;
+                    let send_compression_encodings = self.send_compression_encodings;
+                    let max_decoding_message_size = self.max_decoding_message_size;
+                    let max_encoding_message_size = self.max_encoding_message_size;
+                    let inner = self.inner.clone();
+                    let fut = async move {
+                        let inner = inner.0;
+                        let method = GetEnclavesSvc(inner);
+                        let codec = tonic::codec::ProstCodec::default();
+                        let mut grpc = tonic::server::Grpc::new(codec)
+                            .apply_compression_config(
+                                accept_compression_encodings,
+                                send_compression_encodings,
+                            )
+                            .apply_max_message_size_config(
+                                max_decoding_message_size,
+                                max_encoding_message_size,
+                            );
+                        let res = grpc.unary(method, req).await;
+                        Ok(res)
+                    };
+                    Box::pin(fut)
+                }
+                "/engine_api.EngineService/GetEnclavesByUuids" => {
+                    #[allow(non_camel_case_types)]
+                    struct GetEnclavesByUuidsSvc<T: EngineService>(pub Arc<T>);
+                    impl<
+                        T: EngineService,
+                    > tonic::server::UnaryService<super::GetEnclavesByUuidsArgs>
+                    for GetEnclavesByUuidsSvc<T> {
+                        type Response = super::GetEnclavesResponse;
+                        type Future = BoxFuture<
+                            tonic::Response<Self::Response>,
+                            tonic::Status,
+                        >;
+                        fn call(
+                            &mut self,
+                            request: tonic::Request<super::GetEnclavesByUuidsArgs>,
+                        ) -> Self::Future {
+                            let inner = Arc::clone(&self.0);
+                            let fut = async move {
+                                (*inner).get_enclaves_by_uuids(request).await
+                            };
+                            Box::pin(fut)
+                        }
+                    }
+                    let accept_compression_encodings = self.accept_compression_encodings;
+                    let send_compression_encodings = self.send_compression_encodings;
+                    let max_decoding_message_size = self.max_decoding_message_size;
+                    let max_encoding_message_size = self.max_encoding_message_size;
+                    let inner = self.inner.clone();
+                    let fut = async move {
+                        let inner = inner.0;
+                        let method = GetEnclavesByUuidsSvc(inner);
+                        let codec = tonic::codec::ProstCodec::default();
+                        let mut grpc = tonic::server::Grpc::new(codec)
+                            .apply_compression_config(
+                                accept_compression_encodings,
+                                send_compression_encodings,
+                            )
+                            .apply_max_message_size_config(
+                                max_decoding_message_size,
+                                max_encoding_message_size,
+                            );
+                        let res = grpc.unary(method, req).await;
+                        Ok(res)
+                    };
+                    Box::pin(fut)
+                }
+                "/engine_api.EngineService/GetExistingAndHistoricalEnclaveIdentifiers" => {
+                    #[allow(non_camel_case_types)]
+                    struct GetExistingAndHistoricalEnclaveIdentifiersSvc<
+                        T: EngineService,
+                    >(
+                        pub Arc<T>,
+                    );
+                    impl<T: EngineService> tonic::server::UnaryService<()>
+                    for GetExistingAndHistoricalEnclaveIdentifiersSvc<T> {
+                        type Response = super::GetExistingAndHistoricalEnclaveIdentifiersResponse;
+                        type Future = BoxFuture<
+                            tonic::Response<Self::Response>,
+                            tonic::Status,
+                        >;
+                        fn call(&mut self, request: tonic::Request<()>) -> Self::Future {
+                            let inner = Arc::clone(&self.0);
+                            let fut = async move {
+                                (*inner)
+                                    .get_existing_and_historical_enclave_identifiers(request)
+                                    .await
+                            };
+                            Box::pin(fut)
+                        }
+                    }
+                    let accept_compression_encodings = self.accept_compression_encodings;
+                    let send_compression_encodings = self.send_compression_encodings;
+                    let max_decoding_message_size = self.max_decoding_message_size;
+                    let max_encoding_message_size = self.max_encoding_message_size;
+                    let inner = self.inner.clone();
+                    let fut = async move {
+                        let inner = inner.0;
+                        let method = GetExistingAndHistoricalEnclaveIdentifiersSvc(
+                            inner,
+                        );
+                        let codec = tonic::codec::ProstCodec::default();
+                        let mut grpc = tonic::server::Grpc::new(codec)
+                            .apply_compression_config(
+                                accept_compression_encodings,
+                                send_compression_encodings,
+                            )
+                            .apply_max_message_size_config(
+                                max_decoding_message_size,
+                                max_encoding_message_size,
+                            );
+                        let res = grpc.unary(method, req).await;
+                        Ok(res)
+                    };
+                    Box::pin(fut)
+                }
+                "/engine_api.EngineService/StopEnclave" => {
+                    #[allow(non_camel_case_types)]
+                    struct StopEnclaveSvc<T: EngineService>(pub Arc<T>);
+                    impl<
+                        T: EngineService,
+                    > tonic::server::UnaryService<super::StopEnclaveArgs>
+                    for StopEnclaveSvc<T> {
+                        type Response = ();
+                        type Future = BoxFuture<
+                            tonic::Response<Self::Response>,
+                            tonic::Status,
+                        >;
+                        fn call(
+                            &mut self,
+                            request: tonic::Request<super::StopEnclaveArgs>,
+                        ) -> Self::Future {
+                            let inner = Arc::clone(&self.0);
+                            let fut = async move {
+                                (*inner).stop_enclave(request).await
+                            };
+                            Box::pin(fut)
+                        }
+                    }
+                    let accept_compression_encodings = self.accept_compression_encodings;
+                    let send_compression_encodings = self.send_compression_encodings;
+                    let max_decoding_message_size = self.max_decoding_message_size;
+                    let max_encoding_message_size = self.max_encoding_message_size;
+                    let inner = self.inner.clone();
+                    let fut = async move {
+                        let inner = inner.0;
+                        let method = StopEnclaveSvc(inner);
+                        let codec = tonic::codec::ProstCodec::default();
+                        let mut grpc = tonic::server::Grpc::new(codec)
+                            .apply_compression_config(
+                                accept_compression_encodings,
+                                send_compression_encodings,
+                            )
+                            .apply_max_message_size_config(
+                                max_decoding_message_size,
+                                max_encoding_message_size,
+                            );
+                        let res = grpc.unary(method, req).await;
+                        Ok(res)
+                    };
+                    Box::pin(fut)
+                }
+                "/engine_api.EngineService/DestroyEnclave" => {
+                    #[allow(non_camel_case_types)]
+                    struct DestroyEnclaveSvc<T: EngineService>(pub Arc<T>);
+                    impl<
+                        T: EngineService,
+                    > tonic::server::UnaryService<super::DestroyEnclaveArgs>
+                    for DestroyEnclaveSvc<T> {
+                        type Response = ();
+                        type Future = BoxFuture<
+                            tonic::Response<Self::Response>,
+                            tonic::Status,
+                        >;
+                        fn call(
+                            &mut self,
+                            request: tonic::Request<super::DestroyEnclaveArgs>,
+                        ) -> Self::Future {
+                            let inner = Arc::clone(&self.0);
+                            let fut = async move {
+                                (*inner).destroy_enclave(request).await
+                            };
+                            Box::pin(fut)
+                        }
+                    }
+                    let accept_compression_encodings = self.accept_compression_encodings;
+                    let send_compression_encodings = self.send_compression_encodings;
+                    let max_decoding_message_size = self.max_decoding_message_size;
+                    let max_encoding_message_size = self.max_encoding_message_size;
+                    let inner = self.inner.clone();
+                    let fut = async move {
+                        let inner = inner.0;
+                        let method = DestroyEnclaveSvc(inner);
+                        let codec = tonic::codec::ProstCodec::default();
+                        let mut grpc = tonic::server::Grpc::new(codec)
+                            .apply_compression_config(
+                                accept_compression_encodings,
+                                send_compression_encodings,
+                            )
+                            .apply_max_message_size_config(
+                                max_decoding_message_size,
+                                max_encoding_message_size,
+                            );
+                        let res = grpc.unary(method, req).await;
+                        Ok(res)
+                    };
+                    Box::pin(fut)
+                }
+                "/engine_api.EngineService/ExtendEnclaveTtl" => {
+                    #[allow(non_camel_case_types)]
+                    struct ExtendEnclaveTtlSvc<T: EngineService>(pub Arc<T>);
+                    impl<
+                        T: EngineService,
+                    > tonic::server::UnaryService<super::ExtendEnclaveTtlArgs>
+                    for ExtendEnclaveTtlSvc<T> {
+                        type Response = ();
+                        type Future = BoxFuture<
+                            tonic::Response<Self::Response>,
+                            tonic::Status,
+                        >;
+                        fn call(
+                            &mut self,
+                            request: tonic::Request<super::ExtendEnclaveTtlArgs>,
+                        ) -> Self::Future {
+                            let inner = Arc::clone(&self.0);
+                            let fut = async move {
+                                (*inner).extend_enclave_ttl(request).await
+                            };
+                            Box::pin(fut)
+                        }
+                    }
+                    let accept_compression_encodings = self.accept_compression_encodings;
+                    let send_compression_encodings = self.send_compression_encodings;
+                    let max_decoding_message_size = self.max_decoding_message_size;
+                    let max_encoding_message_size = self.max_encoding_message_size;
+                    let inner = self.inner.clone();
+                    let fut = async move {
+                        let inner = inner.0;
+                        let method = ExtendEnclaveTtlSvc(inner);
+                        let codec = tonic::codec::ProstCodec::default();
+                        let mut grpc = tonic::server::Grpc::new(codec)
+                            .apply_compression_config(
+                                accept_compression_encodings,
+                                send_compression_encodings,
+                            )
+                            .apply_max_message_size_config(
+                                max_decoding_message_size,
+                                max_encoding_message_size,
+                            );
+                        let res = grpc.unary(method, req).await;
+                        Ok(res)
+                    };
+                    Box::pin(fut)
+                }
+                "/engine_api.EngineService/UpdateEnclaveLabels" => {
+                    #[allow(non_camel_case_types)]
+                    struct UpdateEnclaveLabelsSvc<T: EngineService>(pub Arc<T>);
+                    impl<
+                        T: EngineService,
+                    > tonic::server::UnaryService<super::UpdateEnclaveLabelsArgs>
+                    for UpdateEnclaveLabelsSvc<T> {
+                        type Response = ();
                         type Future = BoxFuture<
                             tonic::Response<Self::Response>,
                             tonic::Status,
                         >;
-                        fn call(&mut self, request: tonic::Request<()>) -> Self::Future {
+                        fn call(
+                            &mut self,
+                            request: tonic::Request<super::UpdateEnclaveLabelsArgs>,
+                        ) -> Self::Future {
                             let inner = Arc::clone(&self.0);
                             let fut = async move {
-                                (*inner).get_engine_info(request).await
+                                (*inner).update_enclave_labels(request).await
                             };
                             Box::pin(fut)
                         }
@@ -897,7 +2065,7 @@ pub mod engine_service_server {
                     let inner = self.inner.clone();
                     let fut = async move {
                         let inner = inner.0;
-                        let method = GetEngineInfoSvc(inner);
+                        let method = UpdateEnclaveLabelsSvc(inner);
                         let codec = tonic::codec::ProstCodec::default();
                         let mut grpc = tonic::server::Grpc::new(codec)
                             .apply_compression_config(
@@ -913,25 +2081,25 @@ pub mod engine_service_server {
                     };
                     Box::pin(fut)
                 }
-                "/engine_api.EngineService/CreateEnclave" => {
+                "/engine_api.EngineService/RenameEnclave" => {
                     #[allow(non_camel_case_types)]
-                    struct CreateEnclaveSvc<T: EngineService>(pub Arc<T>);
+                    struct RenameEnclaveSvc<T: EngineService>(pub Arc<T>);
                     impl<
                         T: EngineService,
-                    > tonic::server::UnaryService<super::CreateEnclaveArgs>
-                    for CreateEnclaveSvc<T> {
-                        type Response = super::CreateEnclaveResponse;
+                    > tonic::server::UnaryService<super::RenameEnclaveArgs>
+                    for RenameEnclaveSvc<T> {
+                        type Response = ();
                         type Future = BoxFuture<
                             tonic::Response<Self::Response>,
                             tonic::Status,
                         >;
                         fn call(
                             &mut self,
-                            request: tonic::Request<super::CreateEnclaveArgs>,
+                            request: tonic::Request<super::RenameEnclaveArgs>,
                         ) -> Self::Future {
                             let inner = Arc::clone(&self.0);
                             let fut = async move {
-                                (*inner).create_enclave(request).await
+                                (*inner).rename_enclave(request).await
                             };
                             Box::pin(fut)
                         }
@@ -943,7 +2111,7 @@ pub mod engine_service_server {
                     let inner = self.inner.clone();
                     let fut = async move {
                         let inner = inner.0;
-                        let method = CreateEnclaveSvc(inner);
+                        let method = RenameEnclaveSvc(inner);
                         let codec = tonic::codec::ProstCodec::default();
                         let mut grpc = tonic::server::Grpc::new(codec)
                             .apply_compression_config(
@@ -959,20 +2127,25 @@ pub mod engine_service_server {
                     };
                     Box::pin(fut)
                 }
-                "/engine_api.EngineService/GetEnclaves" => {
+                "/engine_api.EngineService/CloneEnclave" => {
                     #[allow(non_camel_case_types)]
-                    struct GetEnclavesSvc<T: EngineService>(pub Arc<T>);
-                    impl<T: EngineService> tonic::server::UnaryService<()>
-                    for GetEnclavesSvc<T> {
-                        type Response = super::GetEnclavesResponse;
+                    struct CloneEnclaveSvc<T: EngineService>(pub Arc<T>);
+                    impl<
+                        T: EngineService,
+                    > tonic::server::UnaryService<super::CloneEnclaveArgs>
+                    for CloneEnclaveSvc<T> {
+                        type Response = super::CloneEnclaveResponse;
                         type Future = BoxFuture<
                             tonic::Response<Self::Response>,
                             tonic::Status,
                         >;
-                        fn call(&mut self, request: tonic::Request<()>) -> Self::Future {
+                        fn call(
+                            &mut self,
+                            request: tonic::Request<super::CloneEnclaveArgs>,
+                        ) -> Self::Future {
                             let inner = Arc::clone(&self.0);
                             let fut = async move {
-                                (*inner).get_enclaves(request).await
+                                (*inner).clone_enclave(request).await
                             };
                             Box::pin(fut)
                         }
@@ -984,7 +2157,7 @@ pub mod engine_service_server {
                     let inner = self.inner.clone();
                     let fut = async move {
                         let inner = inner.0;
-                        let method = GetEnclavesSvc(inner);
+                        let method = CloneEnclaveSvc(inner);
                         let codec = tonic::codec::ProstCodec::default();
                         let mut grpc = tonic::server::Grpc::new(codec)
                             .apply_compression_config(
@@ -1000,25 +2173,26 @@ pub mod engine_service_server {
                     };
                     Box::pin(fut)
                 }
-                "/engine_api.EngineService/GetEnclavesByUuids" => {
+                "/engine_api.EngineService/ExportEnclave" => {
                     #[allow(non_camel_case_types)]
-                    struct GetEnclavesByUuidsSvc<T: EngineService>(pub Arc<T>);
+                    struct ExportEnclaveSvc<T: EngineService>(pub Arc<T>);
                     impl<
                         T: EngineService,
-                    > tonic::server::UnaryService<super::GetEnclavesByUuidsArgs>
-                    for GetEnclavesByUuidsSvc<T> {
-                        type Response = super::GetEnclavesResponse;
+                    > tonic::server::ServerStreamingService<super::ExportEnclaveArgs>
+                    for ExportEnclaveSvc<T> {
+                        type Response = super::EnclaveArchiveChunk;
+                        type ResponseStream = T::ExportEnclaveStream;
                         type Future = BoxFuture<
-                            tonic::Response<Self::Response>,
+                            tonic::Response<Self::ResponseStream>,
                             tonic::Status,
                         >;
                         fn call(
                             &mut self,
-                            request: tonic::Request<super::GetEnclavesByUuidsArgs>,
+                            request: tonic::Request<super::ExportEnclaveArgs>,
                         ) -> Self::Future {
                             let inner = Arc::clone(&self.0);
                             let fut = async move {
-                                (*inner).get_enclaves_by_uuids(request).await
+                                (*inner).export_enclave(request).await
                             };
                             Box::pin(fut)
                         }
@@ -1030,7 +2204,7 @@ pub mod engine_service_server {
                     let inner = self.inner.clone();
                     let fut = async move {
                         let inner = inner.0;
-                        let method = GetEnclavesByUuidsSvc(inner);
+                        let method = ExportEnclaveSvc(inner);
                         let codec = tonic::codec::ProstCodec::default();
                         let mut grpc = tonic::server::Grpc::new(codec)
                             .apply_compression_config(
@@ -1041,31 +2215,32 @@ pub mod engine_service_server {
                                 max_decoding_message_size,
                                 max_encoding_message_size,
                             );
-                        let res = grpc.unary(method, req).await;
+                        let res = grpc.server_streaming(method, req).await;
                         Ok(res)
                     };
                     Box::pin(fut)
                 }
-                "/engine_api.EngineService/GetExistingAndHistoricalEnclaveIdentifiers" => {
+                "/engine_api.EngineService/ImportEnclave" => {
                     #[allow(non_camel_case_types)]
-                    struct GetExistingAndHistoricalEnclaveIdentifiersSvc<
+                    struct ImportEnclaveSvc<T: EngineService>(pub Arc<T>);
+                    impl<
                         T: EngineService,
-                    >(
-                        pub Arc<T>,
-                    );
-                    impl<T: EngineService> tonic::server::UnaryService<()>
-                    for GetExistingAndHistoricalEnclaveIdentifiersSvc<T> {
-                        type Response = super::GetExistingAndHistoricalEnclaveIdentifiersResponse;
+                    > tonic::server::ClientStreamingService<super::ImportEnclaveArgs>
+                    for ImportEnclaveSvc<T> {
+                        type Response = super::ImportEnclaveResponse;
                         type Future = BoxFuture<
                             tonic::Response<Self::Response>,
                             tonic::Status,
                         >;
-                        fn call(&mut self, request: tonic::Request<()>) -> Self::Future {
+                        fn call(
+                            &mut self,
+                            request: tonic::Request<
+                                tonic::Streaming<super::ImportEnclaveArgs>,
+                            >,
+                        ) -> Self::Future {
                             let inner = Arc::clone(&self.0);
                             let fut = async move {
-                                (*inner)
-                                    .get_existing_and_historical_enclave_identifiers(request)
-                                    .await
+                                (*inner).import_enclave(request).await
                             };
                             Box::pin(fut)
                         }
@@ -1077,9 +2252,49 @@ pub mod engine_service_server {
                     let inner = self.inner.clone();
                     let fut = async move {
                         let inner = inner.0;
-                        let method = GetExistingAndHistoricalEnclaveIdentifiersSvc(
-                            inner,
-                        );
+                        let method = ImportEnclaveSvc(inner);
+                        let codec = tonic::codec::ProstCodec::default();
+                        let mut grpc = tonic::server::Grpc::new(codec)
+                            .apply_compression_config(
+                                accept_compression_encodings,
+                                send_compression_encodings,
+                            )
+                            .apply_max_message_size_config(
+                                max_decoding_message_size,
+                                max_encoding_message_size,
+                            );
+                        let res = grpc.client_streaming(method, req).await;
+                        Ok(res)
+                    };
+                    Box::pin(fut)
+                }
+                "/engine_api.EngineService/Clean" => {
+                    #[allow(non_camel_case_types)]
+                    struct CleanSvc<T: EngineService>(pub Arc<T>);
+                    impl<T: EngineService> tonic::server::UnaryService<super::CleanArgs>
+                    for CleanSvc<T> {
+                        type Response = super::CleanResponse;
+                        type Future = BoxFuture<
+                            tonic::Response<Self::Response>,
+                            tonic::Status,
+                        >;
+                        fn call(
+                            &mut self,
+                            request: tonic::Request<super::CleanArgs>,
+                        ) -> Self::Future {
+                            let inner = Arc::clone(&self.0);
+                            let fut = async move { (*inner).clean(request).await };
+                            Box::pin(fut)
+                        }
+                    }
+                    let accept_compression_encodings = self.accept_compression_encodings;
+                    let send_compression_encodings = self.send_compression_encodings;
+                    let max_decoding_message_size = self.max_decoding_message_size;
+                    let max_encoding_message_size = self.max_encoding_message_size;
+                    let inner = self.inner.clone();
+                    let fut = async move {
+                        let inner = inner.0;
+                        let method = CleanSvc(inner);
                         let codec = tonic::codec::ProstCodec::default();
                         let mut grpc = tonic::server::Grpc::new(codec)
                             .apply_compression_config(
@@ -1095,25 +2310,119 @@ pub mod engine_service_server {
                     };
                     Box::pin(fut)
                 }
-                "/engine_api.EngineService/StopEnclave" => {
+                "/engine_api.EngineService/GetServiceLogs" => {
                     #[allow(non_camel_case_types)]
-                    struct StopEnclaveSvc<T: EngineService>(pub Arc<T>);
+                    struct GetServiceLogsSvc<T: EngineService>(pub Arc<T>);
                     impl<
                         T: EngineService,
-                    > tonic::server::UnaryService<super::StopEnclaveArgs>
-                    for StopEnclaveSvc<T> {
-                        type Response = ();
+                    > tonic::server::ServerStreamingService<super::GetServiceLogsArgs>
+                    for GetServiceLogsSvc<T> {
+                        type Response = super::GetServiceLogsResponse;
+                        type ResponseStream = T::GetServiceLogsStream;
+                        type Future = BoxFuture<
+                            tonic::Response<Self::ResponseStream>,
+                            tonic::Status,
+                        >;
+                        fn call(
+                            &mut self,
+                            request: tonic::Request<super::GetServiceLogsArgs>,
+                        ) -> Self::Future {
+                            let inner = Arc::clone(&self.0);
+                            let fut = async move {
+                                (*inner).get_service_logs(request).await
+                            };
+                            Box::pin(fut)
+                        }
+                    }
+                    let accept_compression_encodings = self.accept_compression_encodings;
+                    let send_compression_encodings = self.send_compression_encodings;
+                    let max_decoding_message_size = self.max_decoding_message_size;
+                    let max_encoding_message_size = self.max_encoding_message_size;
+                    let inner = self.inner.clone();
+                    let fut = async move {
+                        let inner = inner.0;
+                        let method = GetServiceLogsSvc(inner);
+                        let codec = tonic::codec::ProstCodec::default();
+                        let mut grpc = tonic::server::Grpc::new(codec)
+                            .apply_compression_config(
+                                accept_compression_encodings,
+                                send_compression_encodings,
+                            )
+                            .apply_max_message_size_config(
+                                max_decoding_message_size,
+                                max_encoding_message_size,
+                            );
+                        let res = grpc.server_streaming(method, req).await;
+                        Ok(res)
+                    };
+                    Box::pin(fut)
+                }
+                "/engine_api.EngineService/WatchEvents" => {
+                    #[allow(non_camel_case_types)]
+                    struct WatchEventsSvc<T: EngineService>(pub Arc<T>);
+                    impl<
+                        T: EngineService,
+                    > tonic::server::ServerStreamingService<super::WatchEventsArgs>
+                    for WatchEventsSvc<T> {
+                        type Response = super::EngineEvent;
+                        type ResponseStream = T::WatchEventsStream;
+                        type Future = BoxFuture<
+                            tonic::Response<Self::ResponseStream>,
+                            tonic::Status,
+                        >;
+                        fn call(
+                            &mut self,
+                            request: tonic::Request<super::WatchEventsArgs>,
+                        ) -> Self::Future {
+                            let inner = Arc::clone(&self.0);
+                            let fut = async move {
+                                (*inner).watch_events(request).await
+                            };
+                            Box::pin(fut)
+                        }
+                    }
+                    let accept_compression_encodings = self.accept_compression_encodings;
+                    let send_compression_encodings = self.send_compression_encodings;
+                    let max_decoding_message_size = self.max_decoding_message_size;
+                    let max_encoding_message_size = self.max_encoding_message_size;
+                    let inner = self.inner.clone();
+                    let fut = async move {
+                        let inner = inner.0;
+                        let method = WatchEventsSvc(inner);
+                        let codec = tonic::codec::ProstCodec::default();
+                        let mut grpc = tonic::server::Grpc::new(codec)
+                            .apply_compression_config(
+                                accept_compression_encodings,
+                                send_compression_encodings,
+                            )
+                            .apply_max_message_size_config(
+                                max_decoding_message_size,
+                                max_encoding_message_size,
+                            );
+                        let res = grpc.server_streaming(method, req).await;
+                        Ok(res)
+                    };
+                    Box::pin(fut)
+                }
+                "/engine_api.EngineService/CreateApiToken" => {
+                    #[allow(non_camel_case_types)]
+                    struct CreateApiTokenSvc<T: EngineService>(pub Arc<T>);
+                    impl<
+                        T: EngineService,
+                    > tonic::server::UnaryService<super::CreateApiTokenArgs>
+                    for CreateApiTokenSvc<T> {
+                        type Response = super::CreateApiTokenResponse;
                         type Future = BoxFuture<
                             tonic::Response<Self::Response>,
                             tonic::Status,
                         >;
                         fn call(
                             &mut self,
-                            request: tonic::Request<super::StopEnclaveArgs>,
+                            request: tonic::Request<super::CreateApiTokenArgs>,
                         ) -> Self::Future {
                             let inner = Arc::clone(&self.0);
                             let fut = async move {
-                                (*inner).stop_enclave(request).await
+                                (*inner).create_api_token(request).await
                             };
                             Box::pin(fut)
                         }
@@ -1125,7 +2434,7 @@ pub mod engine_service_server {
                     let inner = self.inner.clone();
                     let fut = async move {
                         let inner = inner.0;
-                        let method = StopEnclaveSvc(inner);
+                        let method = CreateApiTokenSvc(inner);
                         let codec = tonic::codec::ProstCodec::default();
                         let mut grpc = tonic::server::Grpc::new(codec)
                             .apply_compression_config(
@@ -1141,13 +2450,13 @@ pub mod engine_service_server {
                     };
                     Box::pin(fut)
                 }
-                "/engine_api.EngineService/DestroyEnclave" => {
+                "/engine_api.EngineService/RevokeApiToken" => {
                     #[allow(non_camel_case_types)]
-                    struct DestroyEnclaveSvc<T: EngineService>(pub Arc<T>);
+                    struct RevokeApiTokenSvc<T: EngineService>(pub Arc<T>);
                     impl<
                         T: EngineService,
-                    > tonic::server::UnaryService<super::DestroyEnclaveArgs>
-                    for DestroyEnclaveSvc<T> {
+                    > tonic::server::UnaryService<super::RevokeApiTokenArgs>
+                    for RevokeApiTokenSvc<T> {
                         type Response = ();
                         type Future = BoxFuture<
                             tonic::Response<Self::Response>,
@@ -1155,11 +2464,11 @@ pub mod engine_service_server {
                         >;
                         fn call(
                             &mut self,
-                            request: tonic::Request<super::DestroyEnclaveArgs>,
+                            request: tonic::Request<super::RevokeApiTokenArgs>,
                         ) -> Self::Future {
                             let inner = Arc::clone(&self.0);
                             let fut = async move {
-                                (*inner).destroy_enclave(request).await
+                                (*inner).revoke_api_token(request).await
                             };
                             Box::pin(fut)
                         }
@@ -1171,7 +2480,7 @@ pub mod engine_service_server {
                     let inner = self.inner.clone();
                     let fut = async move {
                         let inner = inner.0;
-                        let method = DestroyEnclaveSvc(inner);
+                        let method = RevokeApiTokenSvc(inner);
                         let codec = tonic::codec::ProstCodec::default();
                         let mut grpc = tonic::server::Grpc::new(codec)
                             .apply_compression_config(
@@ -1187,22 +2496,26 @@ pub mod engine_service_server {
                     };
                     Box::pin(fut)
                 }
-                "/engine_api.EngineService/Clean" => {
+                "/engine_api.EngineService/GetAuditLog" => {
                     #[allow(non_camel_case_types)]
-                    struct CleanSvc<T: EngineService>(pub Arc<T>);
-                    impl<T: EngineService> tonic::server::UnaryService<super::CleanArgs>
-                    for CleanSvc<T> {
-                        type Response = super::CleanResponse;
+                    struct GetAuditLogSvc<T: EngineService>(pub Arc<T>);
+                    impl<
+                        T: EngineService,
+                    > tonic::server::UnaryService<super::GetAuditLogArgs>
+                    for GetAuditLogSvc<T> {
+                        type Response = super::GetAuditLogResponse;
                         type Future = BoxFuture<
                             tonic::Response<Self::Response>,
                             tonic::Status,
                         >;
                         fn call(
                             &mut self,
-                            request: tonic::Request<super::CleanArgs>,
+                            request: tonic::Request<super::GetAuditLogArgs>,
                         ) -> Self::Future {
                             let inner = Arc::clone(&self.0);
-                            let fut = async move { (*inner).clean(request).await };
+                            let fut = async move {
+                                (*inner).get_audit_log(request).await
+                            };
                             Box::pin(fut)
                         }
                     }
@@ -1213,7 +2526,7 @@ pub mod engine_service_server {
                     let inner = self.inner.clone();
                     let fut = async move {
                         let inner = inner.0;
-                        let method = CleanSvc(inner);
+                        let method = GetAuditLogSvc(inner);
                         let codec = tonic::codec::ProstCodec::default();
                         let mut grpc = tonic::server::Grpc::new(codec)
                             .apply_compression_config(
@@ -1229,26 +2542,27 @@ pub mod engine_service_server {
                     };
                     Box::pin(fut)
                 }
-                "/engine_api.EngineService/GetServiceLogs" => {
+                "/engine_api.EngineService/GetEnclaveCallerCredential" => {
                     #[allow(non_camel_case_types)]
-                    struct GetServiceLogsSvc<T: EngineService>(pub Arc<T>);
+                    struct GetEnclaveCallerCredentialSvc<T: EngineService>(pub Arc<T>);
                     impl<
                         T: EngineService,
-                    > tonic::server::ServerStreamingService<super::GetServiceLogsArgs>
-                    for GetServiceLogsSvc<T> {
-                        type Response = super::GetServiceLogsResponse;
-                        type ResponseStream = T::GetServiceLogsStream;
+                    > tonic::server::UnaryService<super::GetEnclaveCallerCredentialArgs>
+                    for GetEnclaveCallerCredentialSvc<T> {
+                        type Response = super::GetEnclaveCallerCredentialResponse;
                         type Future = BoxFuture<
-                            tonic::Response<Self::ResponseStream>,
+                            tonic::Response<Self::Response>,
                             tonic::Status,
                         >;
                         fn call(
                             &mut self,
-                            request: tonic::Request<super::GetServiceLogsArgs>,
+                            request: tonic::Request<
+                                super::GetEnclaveCallerCredentialArgs,
+                            >,
                         ) -> Self::Future {
                             let inner = Arc::clone(&self.0);
                             let fut = async move {
-                                (*inner).get_service_logs(request).await
+                                (*inner).get_enclave_caller_credential(request).await
                             };
                             Box::pin(fut)
                         }
@@ -1260,7 +2574,7 @@ pub mod engine_service_server {
                     let inner = self.inner.clone();
                     let fut = async move {
                         let inner = inner.0;
-                        let method = GetServiceLogsSvc(inner);
+                        let method = GetEnclaveCallerCredentialSvc(inner);
                         let codec = tonic::codec::ProstCodec::default();
                         let mut grpc = tonic::server::Grpc::new(codec)
                             .apply_compression_config(
@@ -1271,7 +2585,7 @@ pub mod engine_service_server {
                                 max_decoding_message_size,
                                 max_encoding_message_size,
                             );
-                        let res = grpc.server_streaming(method, req).await;
+                        let res = grpc.unary(method, req).await;
                         Ok(res)
                     };
                     Box::pin(fut)
@@ -26,6 +26,13 @@ interface IApiContainerServiceService extends grpc.ServiceDefinition<grpc.Untype
   getStarlarkRun: grpc.MethodDefinition<google_protobuf_empty_pb.Empty, api_container_service_pb.GetStarlarkRunResponse>;
   getStarlarkScriptPlanYaml: grpc.MethodDefinition<api_container_service_pb.StarlarkScriptPlanYamlArgs, api_container_service_pb.PlanYaml>;
   getStarlarkPackagePlanYaml: grpc.MethodDefinition<api_container_service_pb.StarlarkPackagePlanYamlArgs, api_container_service_pb.PlanYaml>;
+  getLastActivityTime: grpc.MethodDefinition<google_protobuf_empty_pb.Empty, api_container_service_pb.GetLastActivityTimeResponse>;
+  getEnclavePlan: grpc.MethodDefinition<google_protobuf_empty_pb.Empty, api_container_service_pb.GetEnclavePlanResponse>;
+  watchEvents: grpc.MethodDefinition<google_protobuf_empty_pb.Empty, api_container_service_pb.ApiContainerEvent>;
+  getAuditLog: grpc.MethodDefinition<google_protobuf_empty_pb.Empty, api_container_service_pb.GetAuditLogResponse>;
+  renameService: grpc.MethodDefinition<api_container_service_pb.RenameServiceArgs, google_protobuf_empty_pb.Empty>;
+  getServiceStats: grpc.MethodDefinition<api_container_service_pb.GetServiceStatsArgs, api_container_service_pb.GetServiceStatsResponse>;
+  runStarlarkPackageUnitTests: grpc.MethodDefinition<api_container_service_pb.RunStarlarkPackageUnitTestsArgs, api_container_service_pb.RunStarlarkPackageUnitTestsResponse>;
 }
 
 export const ApiContainerServiceService: IApiContainerServiceService;
@@ -49,6 +56,13 @@ export interface IApiContainerServiceServer extends grpc.UntypedServiceImplement
   getStarlarkRun: grpc.handleUnaryCall<google_protobuf_empty_pb.Empty, api_container_service_pb.GetStarlarkRunResponse>;
   getStarlarkScriptPlanYaml: grpc.handleUnaryCall<api_container_service_pb.StarlarkScriptPlanYamlArgs, api_container_service_pb.PlanYaml>;
   getStarlarkPackagePlanYaml: grpc.handleUnaryCall<api_container_service_pb.StarlarkPackagePlanYamlArgs, api_container_service_pb.PlanYaml>;
+  getLastActivityTime: grpc.handleUnaryCall<google_protobuf_empty_pb.Empty, api_container_service_pb.GetLastActivityTimeResponse>;
+  getEnclavePlan: grpc.handleUnaryCall<google_protobuf_empty_pb.Empty, api_container_service_pb.GetEnclavePlanResponse>;
+  watchEvents: grpc.handleServerStreamingCall<google_protobuf_empty_pb.Empty, api_container_service_pb.ApiContainerEvent>;
+  getAuditLog: grpc.handleUnaryCall<google_protobuf_empty_pb.Empty, api_container_service_pb.GetAuditLogResponse>;
+  renameService: grpc.handleUnaryCall<api_container_service_pb.RenameServiceArgs, google_protobuf_empty_pb.Empty>;
+  getServiceStats: grpc.handleServerStreamingCall<api_container_service_pb.GetServiceStatsArgs, api_container_service_pb.GetServiceStatsResponse>;
+  runStarlarkPackageUnitTests: grpc.handleUnaryCall<api_container_service_pb.RunStarlarkPackageUnitTestsArgs, api_container_service_pb.RunStarlarkPackageUnitTestsResponse>;
 }
 
 export class ApiContainerServiceClient extends grpc.Client {
@@ -104,4 +118,23 @@ export class ApiContainerServiceClient extends grpc.Client {
   getStarlarkPackagePlanYaml(argument: api_container_service_pb.StarlarkPackagePlanYamlArgs, callback: grpc.requestCallback<api_container_service_pb.PlanYaml>): grpc.ClientUnaryCall;
   getStarlarkPackagePlanYaml(argument: api_container_service_pb.StarlarkPackagePlanYamlArgs, metadataOrOptions: grpc.Metadata | grpc.CallOptions | null, callback: grpc.requestCallback<api_container_service_pb.PlanYaml>): grpc.ClientUnaryCall;
   getStarlarkPackagePlanYaml(argument: api_container_service_pb.StarlarkPackagePlanYamlArgs, metadata: grpc.Metadata | null, options: grpc.CallOptions | null, callback: grpc.requestCallback<api_container_service_pb.PlanYaml>): grpc.ClientUnaryCall;
+  getLastActivityTime(argument: google_protobuf_empty_pb.Empty, callback: grpc.requestCallback<api_container_service_pb.GetLastActivityTimeResponse>): grpc.ClientUnaryCall;
+  getLastActivityTime(argument: google_protobuf_empty_pb.Empty, metadataOrOptions: grpc.Metadata | grpc.CallOptions | null, callback: grpc.requestCallback<api_container_service_pb.GetLastActivityTimeResponse>): grpc.ClientUnaryCall;
+  getLastActivityTime(argument: google_protobuf_empty_pb.Empty, metadata: grpc.Metadata | null, options: grpc.CallOptions | null, callback: grpc.requestCallback<api_container_service_pb.GetLastActivityTimeResponse>): grpc.ClientUnaryCall;
+  getEnclavePlan(argument: google_protobuf_empty_pb.Empty, callback: grpc.requestCallback<api_container_service_pb.GetEnclavePlanResponse>): grpc.ClientUnaryCall;
+  getEnclavePlan(argument: google_protobuf_empty_pb.Empty, metadataOrOptions: grpc.Metadata | grpc.CallOptions | null, callback: grpc.requestCallback<api_container_service_pb.GetEnclavePlanResponse>): grpc.ClientUnaryCall;
+  getEnclavePlan(argument: google_protobuf_empty_pb.Empty, metadata: grpc.Metadata | null, options: grpc.CallOptions | null, callback: grpc.requestCallback<api_container_service_pb.GetEnclavePlanResponse>): grpc.ClientUnaryCall;
+  watchEvents(argument: google_protobuf_empty_pb.Empty, metadataOrOptions?: grpc.Metadata | grpc.CallOptions | null): grpc.ClientReadableStream<api_container_service_pb.ApiContainerEvent>;
+  watchEvents(argument: google_protobuf_empty_pb.Empty, metadata?: grpc.Metadata | null, options?: grpc.CallOptions | null): grpc.ClientReadableStream<api_container_service_pb.ApiContainerEvent>;
+  getAuditLog(argument: google_protobuf_empty_pb.Empty, callback: grpc.requestCallback<api_container_service_pb.GetAuditLogResponse>): grpc.ClientUnaryCall;
+  getAuditLog(argument: google_protobuf_empty_pb.Empty, metadataOrOptions: grpc.Metadata | grpc.CallOptions | null, callback: grpc.requestCallback<api_container_service_pb.GetAuditLogResponse>): grpc.ClientUnaryCall;
+  getAuditLog(argument: google_protobuf_empty_pb.Empty, metadata: grpc.Metadata | null, options: grpc.CallOptions | null, callback: grpc.requestCallback<api_container_service_pb.GetAuditLogResponse>): grpc.ClientUnaryCall;
+  renameService(argument: api_container_service_pb.RenameServiceArgs, callback: grpc.requestCallback<google_protobuf_empty_pb.Empty>): grpc.ClientUnaryCall;
+  renameService(argument: api_container_service_pb.RenameServiceArgs, metadataOrOptions: grpc.Metadata | grpc.CallOptions | null, callback: grpc.requestCallback<google_protobuf_empty_pb.Empty>): grpc.ClientUnaryCall;
+  renameService(argument: api_container_service_pb.RenameServiceArgs, metadata: grpc.Metadata | null, options: grpc.CallOptions | null, callback: grpc.requestCallback<google_protobuf_empty_pb.Empty>): grpc.ClientUnaryCall;
+  getServiceStats(argument: api_container_service_pb.GetServiceStatsArgs, metadataOrOptions?: grpc.Metadata | grpc.CallOptions | null): grpc.ClientReadableStream<api_container_service_pb.GetServiceStatsResponse>;
+  getServiceStats(argument: api_container_service_pb.GetServiceStatsArgs, metadata?: grpc.Metadata | null, options?: grpc.CallOptions | null): grpc.ClientReadableStream<api_container_service_pb.GetServiceStatsResponse>;
+  runStarlarkPackageUnitTests(argument: api_container_service_pb.RunStarlarkPackageUnitTestsArgs, callback: grpc.requestCallback<api_container_service_pb.RunStarlarkPackageUnitTestsResponse>): grpc.ClientUnaryCall;
+  runStarlarkPackageUnitTests(argument: api_container_service_pb.RunStarlarkPackageUnitTestsArgs, metadataOrOptions: grpc.Metadata | grpc.CallOptions | null, callback: grpc.requestCallback<api_container_service_pb.RunStarlarkPackageUnitTestsResponse>): grpc.ClientUnaryCall;
+  runStarlarkPackageUnitTests(argument: api_container_service_pb.RunStarlarkPackageUnitTestsArgs, metadata: grpc.Metadata | null, options: grpc.CallOptions | null, callback: grpc.requestCallback<api_container_service_pb.RunStarlarkPackageUnitTestsResponse>): grpc.ClientUnaryCall;
 }
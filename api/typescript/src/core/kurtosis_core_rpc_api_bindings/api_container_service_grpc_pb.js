@@ -5,6 +5,18 @@ var grpc = require('@grpc/grpc-js');
 var api_container_service_pb = require('./api_container_service_pb.js');
 var google_protobuf_empty_pb = require('google-protobuf/google/protobuf/empty_pb.js');
 var google_protobuf_duration_pb = require('google-protobuf/google/protobuf/duration_pb.js');
+var google_protobuf_timestamp_pb = require('google-protobuf/google/protobuf/timestamp_pb.js');
+
+function serialize_api_container_api_ApiContainerEvent(arg) {
+  if (!(arg instanceof api_container_service_pb.ApiContainerEvent)) {
+    throw new Error('Expected argument of type api_container_api.ApiContainerEvent');
+  }
+  return Buffer.from(arg.serializeBinary());
+}
+
+function deserialize_api_container_api_ApiContainerEvent(buffer_arg) {
+  return api_container_service_pb.ApiContainerEvent.deserializeBinary(new Uint8Array(buffer_arg));
+}
 
 function serialize_api_container_api_ConnectServicesArgs(arg) {
   if (!(arg instanceof api_container_service_pb.ConnectServicesArgs)) {
@@ -61,6 +73,28 @@ function deserialize_api_container_api_ExecCommandResponse(buffer_arg) {
   return api_container_service_pb.ExecCommandResponse.deserializeBinary(new Uint8Array(buffer_arg));
 }
 
+function serialize_api_container_api_GetAuditLogResponse(arg) {
+  if (!(arg instanceof api_container_service_pb.GetAuditLogResponse)) {
+    throw new Error('Expected argument of type api_container_api.GetAuditLogResponse');
+  }
+  return Buffer.from(arg.serializeBinary());
+}
+
+function deserialize_api_container_api_GetAuditLogResponse(buffer_arg) {
+  return api_container_service_pb.GetAuditLogResponse.deserializeBinary(new Uint8Array(buffer_arg));
+}
+
+function serialize_api_container_api_GetEnclavePlanResponse(arg) {
+  if (!(arg instanceof api_container_service_pb.GetEnclavePlanResponse)) {
+    throw new Error('Expected argument of type api_container_api.GetEnclavePlanResponse');
+  }
+  return Buffer.from(arg.serializeBinary());
+}
+
+function deserialize_api_container_api_GetEnclavePlanResponse(buffer_arg) {
+  return api_container_service_pb.GetEnclavePlanResponse.deserializeBinary(new Uint8Array(buffer_arg));
+}
+
 function serialize_api_container_api_GetExistingAndHistoricalServiceIdentifiersResponse(arg) {
   if (!(arg instanceof api_container_service_pb.GetExistingAndHistoricalServiceIdentifiersResponse)) {
     throw new Error('Expected argument of type api_container_api.GetExistingAndHistoricalServiceIdentifiersResponse');
@@ -72,6 +106,39 @@ function deserialize_api_container_api_GetExistingAndHistoricalServiceIdentifier
   return api_container_service_pb.GetExistingAndHistoricalServiceIdentifiersResponse.deserializeBinary(new Uint8Array(buffer_arg));
 }
 
+function serialize_api_container_api_GetLastActivityTimeResponse(arg) {
+  if (!(arg instanceof api_container_service_pb.GetLastActivityTimeResponse)) {
+    throw new Error('Expected argument of type api_container_api.GetLastActivityTimeResponse');
+  }
+  return Buffer.from(arg.serializeBinary());
+}
+
+function deserialize_api_container_api_GetLastActivityTimeResponse(buffer_arg) {
+  return api_container_service_pb.GetLastActivityTimeResponse.deserializeBinary(new Uint8Array(buffer_arg));
+}
+
+function serialize_api_container_api_GetServiceStatsArgs(arg) {
+  if (!(arg instanceof api_container_service_pb.GetServiceStatsArgs)) {
+    throw new Error('Expected argument of type api_container_api.GetServiceStatsArgs');
+  }
+  return Buffer.from(arg.serializeBinary());
+}
+
+function deserialize_api_container_api_GetServiceStatsArgs(buffer_arg) {
+  return api_container_service_pb.GetServiceStatsArgs.deserializeBinary(new Uint8Array(buffer_arg));
+}
+
+function serialize_api_container_api_GetServiceStatsResponse(arg) {
+  if (!(arg instanceof api_container_service_pb.GetServiceStatsResponse)) {
+    throw new Error('Expected argument of type api_container_api.GetServiceStatsResponse');
+  }
+  return Buffer.from(arg.serializeBinary());
+}
+
+function deserialize_api_container_api_GetServiceStatsResponse(buffer_arg) {
+  return api_container_service_pb.GetServiceStatsResponse.deserializeBinary(new Uint8Array(buffer_arg));
+}
+
 function serialize_api_container_api_GetServicesArgs(arg) {
   if (!(arg instanceof api_container_service_pb.GetServicesArgs)) {
     throw new Error('Expected argument of type api_container_api.GetServicesArgs');
@@ -149,6 +216,17 @@ function deserialize_api_container_api_PlanYaml(buffer_arg) {
   return api_container_service_pb.PlanYaml.deserializeBinary(new Uint8Array(buffer_arg));
 }
 
+function serialize_api_container_api_RenameServiceArgs(arg) {
+  if (!(arg instanceof api_container_service_pb.RenameServiceArgs)) {
+    throw new Error('Expected argument of type api_container_api.RenameServiceArgs');
+  }
+  return Buffer.from(arg.serializeBinary());
+}
+
+function deserialize_api_container_api_RenameServiceArgs(buffer_arg) {
+  return api_container_service_pb.RenameServiceArgs.deserializeBinary(new Uint8Array(buffer_arg));
+}
+
 function serialize_api_container_api_RunStarlarkPackageArgs(arg) {
   if (!(arg instanceof api_container_service_pb.RunStarlarkPackageArgs)) {
     throw new Error('Expected argument of type api_container_api.RunStarlarkPackageArgs');
@@ -160,6 +238,28 @@ function deserialize_api_container_api_RunStarlarkPackageArgs(buffer_arg) {
   return api_container_service_pb.RunStarlarkPackageArgs.deserializeBinary(new Uint8Array(buffer_arg));
 }
 
+function serialize_api_container_api_RunStarlarkPackageUnitTestsArgs(arg) {
+  if (!(arg instanceof api_container_service_pb.RunStarlarkPackageUnitTestsArgs)) {
+    throw new Error('Expected argument of type api_container_api.RunStarlarkPackageUnitTestsArgs');
+  }
+  return Buffer.from(arg.serializeBinary());
+}
+
+function deserialize_api_container_api_RunStarlarkPackageUnitTestsArgs(buffer_arg) {
+  return api_container_service_pb.RunStarlarkPackageUnitTestsArgs.deserializeBinary(new Uint8Array(buffer_arg));
+}
+
+function serialize_api_container_api_RunStarlarkPackageUnitTestsResponse(arg) {
+  if (!(arg instanceof api_container_service_pb.RunStarlarkPackageUnitTestsResponse)) {
+    throw new Error('Expected argument of type api_container_api.RunStarlarkPackageUnitTestsResponse');
+  }
+  return Buffer.from(arg.serializeBinary());
+}
+
+function deserialize_api_container_api_RunStarlarkPackageUnitTestsResponse(buffer_arg) {
+  return api_container_service_pb.RunStarlarkPackageUnitTestsResponse.deserializeBinary(new Uint8Array(buffer_arg));
+}
+
 function serialize_api_container_api_RunStarlarkScriptArgs(arg) {
   if (!(arg instanceof api_container_service_pb.RunStarlarkScriptArgs)) {
     throw new Error('Expected argument of type api_container_api.RunStarlarkScriptArgs');
@@ -519,6 +619,91 @@ getStarlarkPackagePlanYaml: {
     responseSerialize: serialize_api_container_api_PlanYaml,
     responseDeserialize: deserialize_api_container_api_PlanYaml,
   },
+  // Gets when the API container last served a request other than this one, which tells whether the enclave is idle
+getLastActivityTime: {
+    path: '/api_container_api.ApiContainerService/GetLastActivityTime',
+    requestStream: false,
+    responseStream: false,
+    requestType: google_protobuf_empty_pb.Empty,
+    responseType: api_container_service_pb.GetLastActivityTimeResponse,
+    requestSerialize: serialize_google_protobuf_Empty,
+    requestDeserialize: deserialize_google_protobuf_Empty,
+    responseSerialize: serialize_api_container_api_GetLastActivityTimeResponse,
+    responseDeserialize: deserialize_api_container_api_GetLastActivityTimeResponse,
+  },
+  // Gets the instructions that brought the enclave to its current state, as persisted across the Starlark runs
+getEnclavePlan: {
+    path: '/api_container_api.ApiContainerService/GetEnclavePlan',
+    requestStream: false,
+    responseStream: false,
+    requestType: google_protobuf_empty_pb.Empty,
+    responseType: api_container_service_pb.GetEnclavePlanResponse,
+    requestSerialize: serialize_google_protobuf_Empty,
+    requestDeserialize: deserialize_google_protobuf_Empty,
+    responseSerialize: serialize_api_container_api_GetEnclavePlanResponse,
+    responseDeserialize: deserialize_api_container_api_GetEnclavePlanResponse,
+  },
+  // Streams the Starlark runs and service changes happening in the enclave, starting from when the stream is opened
+watchEvents: {
+    path: '/api_container_api.ApiContainerService/WatchEvents',
+    requestStream: false,
+    responseStream: true,
+    requestType: google_protobuf_empty_pb.Empty,
+    responseType: api_container_service_pb.ApiContainerEvent,
+    requestSerialize: serialize_google_protobuf_Empty,
+    requestDeserialize: deserialize_google_protobuf_Empty,
+    responseSerialize: serialize_api_container_api_ApiContainerEvent,
+    responseDeserialize: deserialize_api_container_api_ApiContainerEvent,
+  },
+  // Gets the changes made through the API container, oldest first
+getAuditLog: {
+    path: '/api_container_api.ApiContainerService/GetAuditLog',
+    requestStream: false,
+    responseStream: false,
+    requestType: google_protobuf_empty_pb.Empty,
+    responseType: api_container_service_pb.GetAuditLogResponse,
+    requestSerialize: serialize_google_protobuf_Empty,
+    requestDeserialize: deserialize_google_protobuf_Empty,
+    responseSerialize: serialize_api_container_api_GetAuditLogResponse,
+    responseDeserialize: deserialize_api_container_api_GetAuditLogResponse,
+  },
+  // Gives a running service a new name, keeping its UUID, and updates the enclave plan to match
+renameService: {
+    path: '/api_container_api.ApiContainerService/RenameService',
+    requestStream: false,
+    responseStream: false,
+    requestType: api_container_service_pb.RenameServiceArgs,
+    responseType: google_protobuf_empty_pb.Empty,
+    requestSerialize: serialize_api_container_api_RenameServiceArgs,
+    requestDeserialize: deserialize_api_container_api_RenameServiceArgs,
+    responseSerialize: serialize_google_protobuf_Empty,
+    responseDeserialize: deserialize_google_protobuf_Empty,
+  },
+  // Samples the resources used by the services of the enclave, once or repeatedly until the stream gets closed
+getServiceStats: {
+    path: '/api_container_api.ApiContainerService/GetServiceStats',
+    requestStream: false,
+    responseStream: true,
+    requestType: api_container_service_pb.GetServiceStatsArgs,
+    responseType: api_container_service_pb.GetServiceStatsResponse,
+    requestSerialize: serialize_api_container_api_GetServiceStatsArgs,
+    requestDeserialize: deserialize_api_container_api_GetServiceStatsArgs,
+    responseSerialize: serialize_api_container_api_GetServiceStatsResponse,
+    responseDeserialize: deserialize_api_container_api_GetServiceStatsResponse,
+  },
+  // Runs the unit tests of a package uploaded with UploadStarlarkPackage against a fake enclave, without starting any
+// service
+runStarlarkPackageUnitTests: {
+    path: '/api_container_api.ApiContainerService/RunStarlarkPackageUnitTests',
+    requestStream: false,
+    responseStream: false,
+    requestType: api_container_service_pb.RunStarlarkPackageUnitTestsArgs,
+    responseType: api_container_service_pb.RunStarlarkPackageUnitTestsResponse,
+    requestSerialize: serialize_api_container_api_RunStarlarkPackageUnitTestsArgs,
+    requestDeserialize: deserialize_api_container_api_RunStarlarkPackageUnitTestsArgs,
+    responseSerialize: serialize_api_container_api_RunStarlarkPackageUnitTestsResponse,
+    responseDeserialize: deserialize_api_container_api_RunStarlarkPackageUnitTestsResponse,
+  },
 };
 
 exports.ApiContainerServiceClient = grpc.makeGenericClientConstructor(ApiContainerServiceService);
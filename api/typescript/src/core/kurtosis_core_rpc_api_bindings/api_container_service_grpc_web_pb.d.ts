@@ -115,6 +115,51 @@ export class ApiContainerServiceClient {
                response: api_container_service_pb.PlanYaml) => void
   ): grpcWeb.ClientReadableStream<api_container_service_pb.PlanYaml>;
 
+  getLastActivityTime(
+    request: google_protobuf_empty_pb.Empty,
+    metadata: grpcWeb.Metadata | undefined,
+    callback: (err: grpcWeb.RpcError,
+               response: api_container_service_pb.GetLastActivityTimeResponse) => void
+  ): grpcWeb.ClientReadableStream<api_container_service_pb.GetLastActivityTimeResponse>;
+
+  getEnclavePlan(
+    request: google_protobuf_empty_pb.Empty,
+    metadata: grpcWeb.Metadata | undefined,
+    callback: (err: grpcWeb.RpcError,
+               response: api_container_service_pb.GetEnclavePlanResponse) => void
+  ): grpcWeb.ClientReadableStream<api_container_service_pb.GetEnclavePlanResponse>;
+
+  watchEvents(
+    request: google_protobuf_empty_pb.Empty,
+    metadata?: grpcWeb.Metadata
+  ): grpcWeb.ClientReadableStream<api_container_service_pb.ApiContainerEvent>;
+
+  getAuditLog(
+    request: google_protobuf_empty_pb.Empty,
+    metadata: grpcWeb.Metadata | undefined,
+    callback: (err: grpcWeb.RpcError,
+               response: api_container_service_pb.GetAuditLogResponse) => void
+  ): grpcWeb.ClientReadableStream<api_container_service_pb.GetAuditLogResponse>;
+
+  renameService(
+    request: api_container_service_pb.RenameServiceArgs,
+    metadata: grpcWeb.Metadata | undefined,
+    callback: (err: grpcWeb.RpcError,
+               response: google_protobuf_empty_pb.Empty) => void
+  ): grpcWeb.ClientReadableStream<google_protobuf_empty_pb.Empty>;
+
+  getServiceStats(
+    request: api_container_service_pb.GetServiceStatsArgs,
+    metadata?: grpcWeb.Metadata
+  ): grpcWeb.ClientReadableStream<api_container_service_pb.GetServiceStatsResponse>;
+
+  runStarlarkPackageUnitTests(
+    request: api_container_service_pb.RunStarlarkPackageUnitTestsArgs,
+    metadata: grpcWeb.Metadata | undefined,
+    callback: (err: grpcWeb.RpcError,
+               response: api_container_service_pb.RunStarlarkPackageUnitTestsResponse) => void
+  ): grpcWeb.ClientReadableStream<api_container_service_pb.RunStarlarkPackageUnitTestsResponse>;
+
 }
 
 export class ApiContainerServicePromiseClient {
@@ -202,5 +247,40 @@ export class ApiContainerServicePromiseClient {
     metadata?: grpcWeb.Metadata
   ): Promise<api_container_service_pb.PlanYaml>;
 
+  getLastActivityTime(
+    request: google_protobuf_empty_pb.Empty,
+    metadata?: grpcWeb.Metadata
+  ): Promise<api_container_service_pb.GetLastActivityTimeResponse>;
+
+  getEnclavePlan(
+    request: google_protobuf_empty_pb.Empty,
+    metadata?: grpcWeb.Metadata
+  ): Promise<api_container_service_pb.GetEnclavePlanResponse>;
+
+  watchEvents(
+    request: google_protobuf_empty_pb.Empty,
+    metadata?: grpcWeb.Metadata
+  ): grpcWeb.ClientReadableStream<api_container_service_pb.ApiContainerEvent>;
+
+  getAuditLog(
+    request: google_protobuf_empty_pb.Empty,
+    metadata?: grpcWeb.Metadata
+  ): Promise<api_container_service_pb.GetAuditLogResponse>;
+
+  renameService(
+    request: api_container_service_pb.RenameServiceArgs,
+    metadata?: grpcWeb.Metadata
+  ): Promise<google_protobuf_empty_pb.Empty>;
+
+  getServiceStats(
+    request: api_container_service_pb.GetServiceStatsArgs,
+    metadata?: grpcWeb.Metadata
+  ): grpcWeb.ClientReadableStream<api_container_service_pb.GetServiceStatsResponse>;
+
+  runStarlarkPackageUnitTests(
+    request: api_container_service_pb.RunStarlarkPackageUnitTestsArgs,
+    metadata?: grpcWeb.Metadata
+  ): Promise<api_container_service_pb.RunStarlarkPackageUnitTestsResponse>;
+
 }
 
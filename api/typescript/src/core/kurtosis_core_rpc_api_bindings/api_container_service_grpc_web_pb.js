@@ -23,6 +23,8 @@ grpc.web = require('grpc-web');
 var google_protobuf_empty_pb = require('google-protobuf/google/protobuf/empty_pb.js')
 
 var google_protobuf_duration_pb = require('google-protobuf/google/protobuf/duration_pb.js')
+
+var google_protobuf_timestamp_pb = require('google-protobuf/google/protobuf/timestamp_pb.js')
 const proto = {};
 proto.api_container_api = require('./api_container_service_pb.js');
 
@@ -1039,5 +1041,422 @@ proto.api_container_api.ApiContainerServicePromiseClient.prototype.getStarlarkPa
 };
 
 
+/**
+ * @const
+ * @type {!grpc.web.MethodDescriptor<
+ *   !proto.google.protobuf.Empty,
+ *   !proto.api_container_api.GetLastActivityTimeResponse>}
+ */
+const methodDescriptor_ApiContainerService_GetLastActivityTime = new grpc.web.MethodDescriptor(
+  '/api_container_api.ApiContainerService/GetLastActivityTime',
+  grpc.web.MethodType.UNARY,
+  google_protobuf_empty_pb.Empty,
+  proto.api_container_api.GetLastActivityTimeResponse,
+  /**
+   * @param {!proto.google.protobuf.Empty} request
+   * @return {!Uint8Array}
+   */
+  function(request) {
+    return request.serializeBinary();
+  },
+  proto.api_container_api.GetLastActivityTimeResponse.deserializeBinary
+);
+
+
+/**
+ * @param {!proto.google.protobuf.Empty} request The
+ *     request proto
+ * @param {?Object<string, string>} metadata User defined
+ *     call metadata
+ * @param {function(?grpc.web.RpcError, ?proto.api_container_api.GetLastActivityTimeResponse)}
+ *     callback The callback function(error, response)
+ * @return {!grpc.web.ClientReadableStream<!proto.api_container_api.GetLastActivityTimeResponse>|undefined}
+ *     The XHR Node Readable Stream
+ */
+proto.api_container_api.ApiContainerServiceClient.prototype.getLastActivityTime =
+    function(request, metadata, callback) {
+  return this.client_.rpcCall(this.hostname_ +
+      '/api_container_api.ApiContainerService/GetLastActivityTime',
+      request,
+      metadata || {},
+      methodDescriptor_ApiContainerService_GetLastActivityTime,
+      callback);
+};
+
+
+/**
+ * @param {!proto.google.protobuf.Empty} request The
+ *     request proto
+ * @param {?Object<string, string>=} metadata User defined
+ *     call metadata
+ * @return {!Promise<!proto.api_container_api.GetLastActivityTimeResponse>}
+ *     Promise that resolves to the response
+ */
+proto.api_container_api.ApiContainerServicePromiseClient.prototype.getLastActivityTime =
+    function(request, metadata) {
+  return this.client_.unaryCall(this.hostname_ +
+      '/api_container_api.ApiContainerService/GetLastActivityTime',
+      request,
+      metadata || {},
+      methodDescriptor_ApiContainerService_GetLastActivityTime);
+};
+
+
+/**
+ * @const
+ * @type {!grpc.web.MethodDescriptor<
+ *   !proto.google.protobuf.Empty,
+ *   !proto.api_container_api.GetEnclavePlanResponse>}
+ */
+const methodDescriptor_ApiContainerService_GetEnclavePlan = new grpc.web.MethodDescriptor(
+  '/api_container_api.ApiContainerService/GetEnclavePlan',
+  grpc.web.MethodType.UNARY,
+  google_protobuf_empty_pb.Empty,
+  proto.api_container_api.GetEnclavePlanResponse,
+  /**
+   * @param {!proto.google.protobuf.Empty} request
+   * @return {!Uint8Array}
+   */
+  function(request) {
+    return request.serializeBinary();
+  },
+  proto.api_container_api.GetEnclavePlanResponse.deserializeBinary
+);
+
+
+/**
+ * @param {!proto.google.protobuf.Empty} request The
+ *     request proto
+ * @param {?Object<string, string>} metadata User defined
+ *     call metadata
+ * @param {function(?grpc.web.RpcError, ?proto.api_container_api.GetEnclavePlanResponse)}
+ *     callback The callback function(error, response)
+ * @return {!grpc.web.ClientReadableStream<!proto.api_container_api.GetEnclavePlanResponse>|undefined}
+ *     The XHR Node Readable Stream
+ */
+proto.api_container_api.ApiContainerServiceClient.prototype.getEnclavePlan =
+    function(request, metadata, callback) {
+  return this.client_.rpcCall(this.hostname_ +
+      '/api_container_api.ApiContainerService/GetEnclavePlan',
+      request,
+      metadata || {},
+      methodDescriptor_ApiContainerService_GetEnclavePlan,
+      callback);
+};
+
+
+/**
+ * @param {!proto.google.protobuf.Empty} request The
+ *     request proto
+ * @param {?Object<string, string>=} metadata User defined
+ *     call metadata
+ * @return {!Promise<!proto.api_container_api.GetEnclavePlanResponse>}
+ *     Promise that resolves to the response
+ */
+proto.api_container_api.ApiContainerServicePromiseClient.prototype.getEnclavePlan =
+    function(request, metadata) {
+  return this.client_.unaryCall(this.hostname_ +
+      '/api_container_api.ApiContainerService/GetEnclavePlan',
+      request,
+      metadata || {},
+      methodDescriptor_ApiContainerService_GetEnclavePlan);
+};
+
+
+/**
+ * @const
+ * @type {!grpc.web.MethodDescriptor<
+ *   !proto.google.protobuf.Empty,
+ *   !proto.api_container_api.ApiContainerEvent>}
+ */
+const methodDescriptor_ApiContainerService_WatchEvents = new grpc.web.MethodDescriptor(
+  '/api_container_api.ApiContainerService/WatchEvents',
+  grpc.web.MethodType.SERVER_STREAMING,
+  google_protobuf_empty_pb.Empty,
+  proto.api_container_api.ApiContainerEvent,
+  /**
+   * @param {!proto.google.protobuf.Empty} request
+   * @return {!Uint8Array}
+   */
+  function(request) {
+    return request.serializeBinary();
+  },
+  proto.api_container_api.ApiContainerEvent.deserializeBinary
+);
+
+
+/**
+ * @param {!proto.google.protobuf.Empty} request The request proto
+ * @param {?Object<string, string>=} metadata User defined
+ *     call metadata
+ * @return {!grpc.web.ClientReadableStream<!proto.api_container_api.ApiContainerEvent>}
+ *     The XHR Node Readable Stream
+ */
+proto.api_container_api.ApiContainerServiceClient.prototype.watchEvents =
+    function(request, metadata) {
+  return this.client_.serverStreaming(this.hostname_ +
+      '/api_container_api.ApiContainerService/WatchEvents',
+      request,
+      metadata || {},
+      methodDescriptor_ApiContainerService_WatchEvents);
+};
+
+
+/**
+ * @param {!proto.google.protobuf.Empty} request The request proto
+ * @param {?Object<string, string>=} metadata User defined
+ *     call metadata
+ * @return {!grpc.web.ClientReadableStream<!proto.api_container_api.ApiContainerEvent>}
+ *     The XHR Node Readable Stream
+ */
+proto.api_container_api.ApiContainerServicePromiseClient.prototype.watchEvents =
+    function(request, metadata) {
+  return this.client_.serverStreaming(this.hostname_ +
+      '/api_container_api.ApiContainerService/WatchEvents',
+      request,
+      metadata || {},
+      methodDescriptor_ApiContainerService_WatchEvents);
+};
+
+
+/**
+ * @const
+ * @type {!grpc.web.MethodDescriptor<
+ *   !proto.google.protobuf.Empty,
+ *   !proto.api_container_api.GetAuditLogResponse>}
+ */
+const methodDescriptor_ApiContainerService_GetAuditLog = new grpc.web.MethodDescriptor(
+  '/api_container_api.ApiContainerService/GetAuditLog',
+  grpc.web.MethodType.UNARY,
+  google_protobuf_empty_pb.Empty,
+  proto.api_container_api.GetAuditLogResponse,
+  /**
+   * @param {!proto.google.protobuf.Empty} request
+   * @return {!Uint8Array}
+   */
+  function(request) {
+    return request.serializeBinary();
+  },
+  proto.api_container_api.GetAuditLogResponse.deserializeBinary
+);
+
+
+/**
+ * @param {!proto.google.protobuf.Empty} request The
+ *     request proto
+ * @param {?Object<string, string>} metadata User defined
+ *     call metadata
+ * @param {function(?grpc.web.RpcError, ?proto.api_container_api.GetAuditLogResponse)}
+ *     callback The callback function(error, response)
+ * @return {!grpc.web.ClientReadableStream<!proto.api_container_api.GetAuditLogResponse>|undefined}
+ *     The XHR Node Readable Stream
+ */
+proto.api_container_api.ApiContainerServiceClient.prototype.getAuditLog =
+    function(request, metadata, callback) {
+  return this.client_.rpcCall(this.hostname_ +
+      '/api_container_api.ApiContainerService/GetAuditLog',
+      request,
+      metadata || {},
+      methodDescriptor_ApiContainerService_GetAuditLog,
+      callback);
+};
+
+
+/**
+ * @param {!proto.google.protobuf.Empty} request The
+ *     request proto
+ * @param {?Object<string, string>=} metadata User defined
+ *     call metadata
+ * @return {!Promise<!proto.api_container_api.GetAuditLogResponse>}
+ *     Promise that resolves to the response
+ */
+proto.api_container_api.ApiContainerServicePromiseClient.prototype.getAuditLog =
+    function(request, metadata) {
+  return this.client_.unaryCall(this.hostname_ +
+      '/api_container_api.ApiContainerService/GetAuditLog',
+      request,
+      metadata || {},
+      methodDescriptor_ApiContainerService_GetAuditLog);
+};
+
+
+/**
+ * @const
+ * @type {!grpc.web.MethodDescriptor<
+ *   !proto.api_container_api.RenameServiceArgs,
+ *   !proto.google.protobuf.Empty>}
+ */
+const methodDescriptor_ApiContainerService_RenameService = new grpc.web.MethodDescriptor(
+  '/api_container_api.ApiContainerService/RenameService',
+  grpc.web.MethodType.UNARY,
+  proto.api_container_api.RenameServiceArgs,
+  google_protobuf_empty_pb.Empty,
+  /**
+   * @param {!proto.api_container_api.RenameServiceArgs} request
+   * @return {!Uint8Array}
+   */
+  function(request) {
+    return request.serializeBinary();
+  },
+  google_protobuf_empty_pb.Empty.deserializeBinary
+);
+
+
+/**
+ * @param {!proto.api_container_api.RenameServiceArgs} request The
+ *     request proto
+ * @param {?Object<string, string>} metadata User defined
+ *     call metadata
+ * @param {function(?grpc.web.RpcError, ?proto.google.protobuf.Empty)}
+ *     callback The callback function(error, response)
+ * @return {!grpc.web.ClientReadableStream<!proto.google.protobuf.Empty>|undefined}
+ *     The XHR Node Readable Stream
+ */
+proto.api_container_api.ApiContainerServiceClient.prototype.renameService =
+    function(request, metadata, callback) {
+  return this.client_.rpcCall(this.hostname_ +
+      '/api_container_api.ApiContainerService/RenameService',
+      request,
+      metadata || {},
+      methodDescriptor_ApiContainerService_RenameService,
+      callback);
+};
+
+
+/**
+ * @param {!proto.api_container_api.RenameServiceArgs} request The
+ *     request proto
+ * @param {?Object<string, string>=} metadata User defined
+ *     call metadata
+ * @return {!Promise<!proto.google.protobuf.Empty>}
+ *     Promise that resolves to the response
+ */
+proto.api_container_api.ApiContainerServicePromiseClient.prototype.renameService =
+    function(request, metadata) {
+  return this.client_.unaryCall(this.hostname_ +
+      '/api_container_api.ApiContainerService/RenameService',
+      request,
+      metadata || {},
+      methodDescriptor_ApiContainerService_RenameService);
+};
+
+
+/**
+ * @const
+ * @type {!grpc.web.MethodDescriptor<
+ *   !proto.api_container_api.GetServiceStatsArgs,
+ *   !proto.api_container_api.GetServiceStatsResponse>}
+ */
+const methodDescriptor_ApiContainerService_GetServiceStats = new grpc.web.MethodDescriptor(
+  '/api_container_api.ApiContainerService/GetServiceStats',
+  grpc.web.MethodType.SERVER_STREAMING,
+  proto.api_container_api.GetServiceStatsArgs,
+  proto.api_container_api.GetServiceStatsResponse,
+  /**
+   * @param {!proto.api_container_api.GetServiceStatsArgs} request
+   * @return {!Uint8Array}
+   */
+  function(request) {
+    return request.serializeBinary();
+  },
+  proto.api_container_api.GetServiceStatsResponse.deserializeBinary
+);
+
+
+/**
+ * @param {!proto.api_container_api.GetServiceStatsArgs} request The request proto
+ * @param {?Object<string, string>=} metadata User defined
+ *     call metadata
+ * @return {!grpc.web.ClientReadableStream<!proto.api_container_api.GetServiceStatsResponse>}
+ *     The XHR Node Readable Stream
+ */
+proto.api_container_api.ApiContainerServiceClient.prototype.getServiceStats =
+    function(request, metadata) {
+  return this.client_.serverStreaming(this.hostname_ +
+      '/api_container_api.ApiContainerService/GetServiceStats',
+      request,
+      metadata || {},
+      methodDescriptor_ApiContainerService_GetServiceStats);
+};
+
+
+/**
+ * @param {!proto.api_container_api.GetServiceStatsArgs} request The request proto
+ * @param {?Object<string, string>=} metadata User defined
+ *     call metadata
+ * @return {!grpc.web.ClientReadableStream<!proto.api_container_api.GetServiceStatsResponse>}
+ *     The XHR Node Readable Stream
+ */
+proto.api_container_api.ApiContainerServicePromiseClient.prototype.getServiceStats =
+    function(request, metadata) {
+  return this.client_.serverStreaming(this.hostname_ +
+      '/api_container_api.ApiContainerService/GetServiceStats',
+      request,
+      metadata || {},
+      methodDescriptor_ApiContainerService_GetServiceStats);
+};
+
+
+/**
+ * @const
+ * @type {!grpc.web.MethodDescriptor<
+ *   !proto.api_container_api.RunStarlarkPackageUnitTestsArgs,
+ *   !proto.api_container_api.RunStarlarkPackageUnitTestsResponse>}
+ */
+const methodDescriptor_ApiContainerService_RunStarlarkPackageUnitTests = new grpc.web.MethodDescriptor(
+  '/api_container_api.ApiContainerService/RunStarlarkPackageUnitTests',
+  grpc.web.MethodType.UNARY,
+  proto.api_container_api.RunStarlarkPackageUnitTestsArgs,
+  proto.api_container_api.RunStarlarkPackageUnitTestsResponse,
+  /**
+   * @param {!proto.api_container_api.RunStarlarkPackageUnitTestsArgs} request
+   * @return {!Uint8Array}
+   */
+  function(request) {
+    return request.serializeBinary();
+  },
+  proto.api_container_api.RunStarlarkPackageUnitTestsResponse.deserializeBinary
+);
+
+
+/**
+ * @param {!proto.api_container_api.RunStarlarkPackageUnitTestsArgs} request The
+ *     request proto
+ * @param {?Object<string, string>} metadata User defined
+ *     call metadata
+ * @param {function(?grpc.web.RpcError, ?proto.api_container_api.RunStarlarkPackageUnitTestsResponse)}
+ *     callback The callback function(error, response)
+ * @return {!grpc.web.ClientReadableStream<!proto.api_container_api.RunStarlarkPackageUnitTestsResponse>|undefined}
+ *     The XHR Node Readable Stream
+ */
+proto.api_container_api.ApiContainerServiceClient.prototype.runStarlarkPackageUnitTests =
+    function(request, metadata, callback) {
+  return this.client_.rpcCall(this.hostname_ +
+      '/api_container_api.ApiContainerService/RunStarlarkPackageUnitTests',
+      request,
+      metadata || {},
+      methodDescriptor_ApiContainerService_RunStarlarkPackageUnitTests,
+      callback);
+};
+
+
+/**
+ * @param {!proto.api_container_api.RunStarlarkPackageUnitTestsArgs} request The
+ *     request proto
+ * @param {?Object<string, string>=} metadata User defined
+ *     call metadata
+ * @return {!Promise<!proto.api_container_api.RunStarlarkPackageUnitTestsResponse>}
+ *     Promise that resolves to the response
+ */
+proto.api_container_api.ApiContainerServicePromiseClient.prototype.runStarlarkPackageUnitTests =
+    function(request, metadata) {
+  return this.client_.unaryCall(this.hostname_ +
+      '/api_container_api.ApiContainerService/RunStarlarkPackageUnitTests',
+      request,
+      metadata || {},
+      methodDescriptor_ApiContainerService_RunStarlarkPackageUnitTests);
+};
+
+
 module.exports = proto.api_container_api;
 
@@ -2,6 +2,7 @@ import * as jspb from 'google-protobuf'
 
 import * as google_protobuf_empty_pb from 'google-protobuf/google/protobuf/empty_pb';
 import * as google_protobuf_duration_pb from 'google-protobuf/google/protobuf/duration_pb';
+import * as google_protobuf_timestamp_pb from 'google-protobuf/google/protobuf/timestamp_pb';
 
 
 export class Port extends jspb.Message {
@@ -253,6 +254,9 @@ export class ServiceInfo extends jspb.Message {
   hasTtyEnabled(): boolean;
   clearTtyEnabled(): ServiceInfo;
 
+  getServiceDirPathsToPersistentKeysMap(): jspb.Map<string, string>;
+  clearServiceDirPathsToPersistentKeysMap(): ServiceInfo;
+
   serializeBinary(): Uint8Array;
   toObject(includeInstance?: boolean): ServiceInfo.AsObject;
   static toObject(includeInstance: boolean, msg: ServiceInfo): ServiceInfo.AsObject;
@@ -283,6 +287,7 @@ export namespace ServiceInfo {
     labelsMap: Array<[string, string]>,
     tiniEnabled?: boolean,
     ttyEnabled?: boolean,
+    serviceDirPathsToPersistentKeysMap: Array<[string, string]>,
   }
 
   export enum UserCase { 
@@ -355,6 +360,9 @@ export class RunStarlarkScriptArgs extends jspb.Message {
   hasParallel(): boolean;
   clearParallel(): RunStarlarkScriptArgs;
 
+  getSecretsMap(): jspb.Map<string, string>;
+  clearSecretsMap(): RunStarlarkScriptArgs;
+
   serializeBinary(): Uint8Array;
   toObject(includeInstance?: boolean): RunStarlarkScriptArgs.AsObject;
   static toObject(includeInstance: boolean, msg: RunStarlarkScriptArgs): RunStarlarkScriptArgs.AsObject;
@@ -376,6 +384,7 @@ export namespace RunStarlarkScriptArgs {
     imageDownloadMode?: ImageDownloadMode,
     nonBlockingMode?: boolean,
     parallel?: boolean,
+    secretsMap: Array<[string, string]>,
   }
 
   export enum SerializedParamsCase { 
@@ -501,6 +510,9 @@ export class RunStarlarkPackageArgs extends jspb.Message {
   hasParallel(): boolean;
   clearParallel(): RunStarlarkPackageArgs;
 
+  getSecretsMap(): jspb.Map<string, string>;
+  clearSecretsMap(): RunStarlarkPackageArgs;
+
   getStarlarkPackageContentCase(): RunStarlarkPackageArgs.StarlarkPackageContentCase;
 
   serializeBinary(): Uint8Array;
@@ -529,6 +541,7 @@ export namespace RunStarlarkPackageArgs {
     nonBlockingMode?: boolean,
     githubAuthToken?: string,
     parallel?: boolean,
+    secretsMap: Array<[string, string]>,
   }
 
   export enum StarlarkPackageContentCase { 
@@ -1813,6 +1826,417 @@ export namespace StarlarkPackagePlanYamlArgs {
   }
 }
 
+export class GetLastActivityTimeResponse extends jspb.Message {
+  getLastActivityTime(): google_protobuf_timestamp_pb.Timestamp | undefined;
+  setLastActivityTime(value?: google_protobuf_timestamp_pb.Timestamp): GetLastActivityTimeResponse;
+  hasLastActivityTime(): boolean;
+  clearLastActivityTime(): GetLastActivityTimeResponse;
+
+  serializeBinary(): Uint8Array;
+  toObject(includeInstance?: boolean): GetLastActivityTimeResponse.AsObject;
+  static toObject(includeInstance: boolean, msg: GetLastActivityTimeResponse): GetLastActivityTimeResponse.AsObject;
+  static serializeBinaryToWriter(message: GetLastActivityTimeResponse, writer: jspb.BinaryWriter): void;
+  static deserializeBinary(bytes: Uint8Array): GetLastActivityTimeResponse;
+  static deserializeBinaryFromReader(message: GetLastActivityTimeResponse, reader: jspb.BinaryReader): GetLastActivityTimeResponse;
+}
+
+export namespace GetLastActivityTimeResponse {
+  export type AsObject = {
+    lastActivityTime?: google_protobuf_timestamp_pb.Timestamp.AsObject,
+  }
+}
+
+export class EnclavePlanInstruction extends jspb.Message {
+  getUuid(): string;
+  setUuid(value: string): EnclavePlanInstruction;
+
+  getType(): string;
+  setType(value: string): EnclavePlanInstruction;
+
+  getStarlarkCode(): string;
+  setStarlarkCode(value: string): EnclavePlanInstruction;
+
+  getServiceNamesList(): Array<string>;
+  setServiceNamesList(value: Array<string>): EnclavePlanInstruction;
+  clearServiceNamesList(): EnclavePlanInstruction;
+  addServiceNames(value: string, index?: number): EnclavePlanInstruction;
+
+  getFilesArtifactNamesList(): Array<string>;
+  setFilesArtifactNamesList(value: Array<string>): EnclavePlanInstruction;
+  clearFilesArtifactNamesList(): EnclavePlanInstruction;
+  addFilesArtifactNames(value: string, index?: number): EnclavePlanInstruction;
+
+  serializeBinary(): Uint8Array;
+  toObject(includeInstance?: boolean): EnclavePlanInstruction.AsObject;
+  static toObject(includeInstance: boolean, msg: EnclavePlanInstruction): EnclavePlanInstruction.AsObject;
+  static serializeBinaryToWriter(message: EnclavePlanInstruction, writer: jspb.BinaryWriter): void;
+  static deserializeBinary(bytes: Uint8Array): EnclavePlanInstruction;
+  static deserializeBinaryFromReader(message: EnclavePlanInstruction, reader: jspb.BinaryReader): EnclavePlanInstruction;
+}
+
+export namespace EnclavePlanInstruction {
+  export type AsObject = {
+    uuid: string,
+    type: string,
+    starlarkCode: string,
+    serviceNamesList: Array<string>,
+    filesArtifactNamesList: Array<string>,
+  }
+}
+
+export class GetEnclavePlanResponse extends jspb.Message {
+  getInstructionsList(): Array<EnclavePlanInstruction>;
+  setInstructionsList(value: Array<EnclavePlanInstruction>): GetEnclavePlanResponse;
+  clearInstructionsList(): GetEnclavePlanResponse;
+  addInstructions(value?: EnclavePlanInstruction, index?: number): EnclavePlanInstruction;
+
+  serializeBinary(): Uint8Array;
+  toObject(includeInstance?: boolean): GetEnclavePlanResponse.AsObject;
+  static toObject(includeInstance: boolean, msg: GetEnclavePlanResponse): GetEnclavePlanResponse.AsObject;
+  static serializeBinaryToWriter(message: GetEnclavePlanResponse, writer: jspb.BinaryWriter): void;
+  static deserializeBinary(bytes: Uint8Array): GetEnclavePlanResponse;
+  static deserializeBinaryFromReader(message: GetEnclavePlanResponse, reader: jspb.BinaryReader): GetEnclavePlanResponse;
+}
+
+export namespace GetEnclavePlanResponse {
+  export type AsObject = {
+    instructionsList: Array<EnclavePlanInstruction.AsObject>,
+  }
+}
+
+export class ApiContainerEvent extends jspb.Message {
+  getType(): ApiContainerEventType;
+  setType(value: ApiContainerEventType): ApiContainerEvent;
+
+  getTimestamp(): google_protobuf_timestamp_pb.Timestamp | undefined;
+  setTimestamp(value?: google_protobuf_timestamp_pb.Timestamp): ApiContainerEvent;
+  hasTimestamp(): boolean;
+  clearTimestamp(): ApiContainerEvent;
+
+  getServiceName(): string;
+  setServiceName(value: string): ApiContainerEvent;
+  hasServiceName(): boolean;
+  clearServiceName(): ApiContainerEvent;
+
+  getStarlarkPackageId(): string;
+  setStarlarkPackageId(value: string): ApiContainerEvent;
+  hasStarlarkPackageId(): boolean;
+  clearStarlarkPackageId(): ApiContainerEvent;
+
+  getStarlarkRunSucceeded(): boolean;
+  setStarlarkRunSucceeded(value: boolean): ApiContainerEvent;
+  hasStarlarkRunSucceeded(): boolean;
+  clearStarlarkRunSucceeded(): ApiContainerEvent;
+
+  serializeBinary(): Uint8Array;
+  toObject(includeInstance?: boolean): ApiContainerEvent.AsObject;
+  static toObject(includeInstance: boolean, msg: ApiContainerEvent): ApiContainerEvent.AsObject;
+  static serializeBinaryToWriter(message: ApiContainerEvent, writer: jspb.BinaryWriter): void;
+  static deserializeBinary(bytes: Uint8Array): ApiContainerEvent;
+  static deserializeBinaryFromReader(message: ApiContainerEvent, reader: jspb.BinaryReader): ApiContainerEvent;
+}
+
+export namespace ApiContainerEvent {
+  export type AsObject = {
+    type: ApiContainerEventType,
+    timestamp?: google_protobuf_timestamp_pb.Timestamp.AsObject,
+    serviceName?: string,
+    starlarkPackageId?: string,
+    starlarkRunSucceeded?: boolean,
+  }
+
+  export enum ServiceNameCase { 
+    _SERVICE_NAME_NOT_SET = 0,
+    SERVICE_NAME = 3,
+  }
+
+  export enum StarlarkPackageIdCase { 
+    _STARLARK_PACKAGE_ID_NOT_SET = 0,
+    STARLARK_PACKAGE_ID = 4,
+  }
+
+  export enum StarlarkRunSucceededCase { 
+    _STARLARK_RUN_SUCCEEDED_NOT_SET = 0,
+    STARLARK_RUN_SUCCEEDED = 5,
+  }
+}
+
+export class AuditLogEntry extends jspb.Message {
+  getSequenceNumber(): number;
+  setSequenceNumber(value: number): AuditLogEntry;
+
+  getTimestamp(): google_protobuf_timestamp_pb.Timestamp | undefined;
+  setTimestamp(value?: google_protobuf_timestamp_pb.Timestamp): AuditLogEntry;
+  hasTimestamp(): boolean;
+  clearTimestamp(): AuditLogEntry;
+
+  getCaller(): string;
+  setCaller(value: string): AuditLogEntry;
+
+  getAction(): string;
+  setAction(value: string): AuditLogEntry;
+
+  getArgumentsMap(): jspb.Map<string, string>;
+  clearArgumentsMap(): AuditLogEntry;
+
+  getError(): string;
+  setError(value: string): AuditLogEntry;
+  hasError(): boolean;
+  clearError(): AuditLogEntry;
+
+  serializeBinary(): Uint8Array;
+  toObject(includeInstance?: boolean): AuditLogEntry.AsObject;
+  static toObject(includeInstance: boolean, msg: AuditLogEntry): AuditLogEntry.AsObject;
+  static serializeBinaryToWriter(message: AuditLogEntry, writer: jspb.BinaryWriter): void;
+  static deserializeBinary(bytes: Uint8Array): AuditLogEntry;
+  static deserializeBinaryFromReader(message: AuditLogEntry, reader: jspb.BinaryReader): AuditLogEntry;
+}
+
+export namespace AuditLogEntry {
+  export type AsObject = {
+    sequenceNumber: number,
+    timestamp?: google_protobuf_timestamp_pb.Timestamp.AsObject,
+    caller: string,
+    action: string,
+    argumentsMap: Array<[string, string]>,
+    error?: string,
+  }
+
+  export enum ErrorCase { 
+    _ERROR_NOT_SET = 0,
+    ERROR = 6,
+  }
+}
+
+export class GetAuditLogResponse extends jspb.Message {
+  getEntriesList(): Array<AuditLogEntry>;
+  setEntriesList(value: Array<AuditLogEntry>): GetAuditLogResponse;
+  clearEntriesList(): GetAuditLogResponse;
+  addEntries(value?: AuditLogEntry, index?: number): AuditLogEntry;
+
+  serializeBinary(): Uint8Array;
+  toObject(includeInstance?: boolean): GetAuditLogResponse.AsObject;
+  static toObject(includeInstance: boolean, msg: GetAuditLogResponse): GetAuditLogResponse.AsObject;
+  static serializeBinaryToWriter(message: GetAuditLogResponse, writer: jspb.BinaryWriter): void;
+  static deserializeBinary(bytes: Uint8Array): GetAuditLogResponse;
+  static deserializeBinaryFromReader(message: GetAuditLogResponse, reader: jspb.BinaryReader): GetAuditLogResponse;
+}
+
+export namespace GetAuditLogResponse {
+  export type AsObject = {
+    entriesList: Array<AuditLogEntry.AsObject>,
+  }
+}
+
+export class RenameServiceArgs extends jspb.Message {
+  getServiceIdentifier(): string;
+  setServiceIdentifier(value: string): RenameServiceArgs;
+
+  getNewName(): string;
+  setNewName(value: string): RenameServiceArgs;
+
+  serializeBinary(): Uint8Array;
+  toObject(includeInstance?: boolean): RenameServiceArgs.AsObject;
+  static toObject(includeInstance: boolean, msg: RenameServiceArgs): RenameServiceArgs.AsObject;
+  static serializeBinaryToWriter(message: RenameServiceArgs, writer: jspb.BinaryWriter): void;
+  static deserializeBinary(bytes: Uint8Array): RenameServiceArgs;
+  static deserializeBinaryFromReader(message: RenameServiceArgs, reader: jspb.BinaryReader): RenameServiceArgs;
+}
+
+export namespace RenameServiceArgs {
+  export type AsObject = {
+    serviceIdentifier: string,
+    newName: string,
+  }
+}
+
+export class GetServiceStatsArgs extends jspb.Message {
+  getServiceIdentifiersList(): Array<string>;
+  setServiceIdentifiersList(value: Array<string>): GetServiceStatsArgs;
+  clearServiceIdentifiersList(): GetServiceStatsArgs;
+  addServiceIdentifiers(value: string, index?: number): GetServiceStatsArgs;
+
+  getFollow(): boolean;
+  setFollow(value: boolean): GetServiceStatsArgs;
+
+  getInterval(): google_protobuf_duration_pb.Duration | undefined;
+  setInterval(value?: google_protobuf_duration_pb.Duration): GetServiceStatsArgs;
+  hasInterval(): boolean;
+  clearInterval(): GetServiceStatsArgs;
+
+  serializeBinary(): Uint8Array;
+  toObject(includeInstance?: boolean): GetServiceStatsArgs.AsObject;
+  static toObject(includeInstance: boolean, msg: GetServiceStatsArgs): GetServiceStatsArgs.AsObject;
+  static serializeBinaryToWriter(message: GetServiceStatsArgs, writer: jspb.BinaryWriter): void;
+  static deserializeBinary(bytes: Uint8Array): GetServiceStatsArgs;
+  static deserializeBinaryFromReader(message: GetServiceStatsArgs, reader: jspb.BinaryReader): GetServiceStatsArgs;
+}
+
+export namespace GetServiceStatsArgs {
+  export type AsObject = {
+    serviceIdentifiersList: Array<string>,
+    follow: boolean,
+    interval?: google_protobuf_duration_pb.Duration.AsObject,
+  }
+
+  export enum IntervalCase { 
+    _INTERVAL_NOT_SET = 0,
+    INTERVAL = 3,
+  }
+}
+
+export class ServiceStats extends jspb.Message {
+  getServiceName(): string;
+  setServiceName(value: string): ServiceStats;
+
+  getServiceUuid(): string;
+  setServiceUuid(value: string): ServiceStats;
+
+  getCpuPercent(): number;
+  setCpuPercent(value: number): ServiceStats;
+
+  getMemoryUsageBytes(): number;
+  setMemoryUsageBytes(value: number): ServiceStats;
+
+  getMemoryLimitBytes(): number;
+  setMemoryLimitBytes(value: number): ServiceStats;
+
+  getNetworkReceivedBytes(): number;
+  setNetworkReceivedBytes(value: number): ServiceStats;
+
+  getNetworkSentBytes(): number;
+  setNetworkSentBytes(value: number): ServiceStats;
+
+  getDiskReadBytes(): number;
+  setDiskReadBytes(value: number): ServiceStats;
+
+  getDiskWrittenBytes(): number;
+  setDiskWrittenBytes(value: number): ServiceStats;
+
+  serializeBinary(): Uint8Array;
+  toObject(includeInstance?: boolean): ServiceStats.AsObject;
+  static toObject(includeInstance: boolean, msg: ServiceStats): ServiceStats.AsObject;
+  static serializeBinaryToWriter(message: ServiceStats, writer: jspb.BinaryWriter): void;
+  static deserializeBinary(bytes: Uint8Array): ServiceStats;
+  static deserializeBinaryFromReader(message: ServiceStats, reader: jspb.BinaryReader): ServiceStats;
+}
+
+export namespace ServiceStats {
+  export type AsObject = {
+    serviceName: string,
+    serviceUuid: string,
+    cpuPercent: number,
+    memoryUsageBytes: number,
+    memoryLimitBytes: number,
+    networkReceivedBytes: number,
+    networkSentBytes: number,
+    diskReadBytes: number,
+    diskWrittenBytes: number,
+  }
+}
+
+export class GetServiceStatsResponse extends jspb.Message {
+  getTimestamp(): google_protobuf_timestamp_pb.Timestamp | undefined;
+  setTimestamp(value?: google_protobuf_timestamp_pb.Timestamp): GetServiceStatsResponse;
+  hasTimestamp(): boolean;
+  clearTimestamp(): GetServiceStatsResponse;
+
+  getServiceStatsList(): Array<ServiceStats>;
+  setServiceStatsList(value: Array<ServiceStats>): GetServiceStatsResponse;
+  clearServiceStatsList(): GetServiceStatsResponse;
+  addServiceStats(value?: ServiceStats, index?: number): ServiceStats;
+
+  serializeBinary(): Uint8Array;
+  toObject(includeInstance?: boolean): GetServiceStatsResponse.AsObject;
+  static toObject(includeInstance: boolean, msg: GetServiceStatsResponse): GetServiceStatsResponse.AsObject;
+  static serializeBinaryToWriter(message: GetServiceStatsResponse, writer: jspb.BinaryWriter): void;
+  static deserializeBinary(bytes: Uint8Array): GetServiceStatsResponse;
+  static deserializeBinaryFromReader(message: GetServiceStatsResponse, reader: jspb.BinaryReader): GetServiceStatsResponse;
+}
+
+export namespace GetServiceStatsResponse {
+  export type AsObject = {
+    timestamp?: google_protobuf_timestamp_pb.Timestamp.AsObject,
+    serviceStatsList: Array<ServiceStats.AsObject>,
+  }
+}
+
+export class RunStarlarkPackageUnitTestsArgs extends jspb.Message {
+  getPackageId(): string;
+  setPackageId(value: string): RunStarlarkPackageUnitTestsArgs;
+
+  serializeBinary(): Uint8Array;
+  toObject(includeInstance?: boolean): RunStarlarkPackageUnitTestsArgs.AsObject;
+  static toObject(includeInstance: boolean, msg: RunStarlarkPackageUnitTestsArgs): RunStarlarkPackageUnitTestsArgs.AsObject;
+  static serializeBinaryToWriter(message: RunStarlarkPackageUnitTestsArgs, writer: jspb.BinaryWriter): void;
+  static deserializeBinary(bytes: Uint8Array): RunStarlarkPackageUnitTestsArgs;
+  static deserializeBinaryFromReader(message: RunStarlarkPackageUnitTestsArgs, reader: jspb.BinaryReader): RunStarlarkPackageUnitTestsArgs;
+}
+
+export namespace RunStarlarkPackageUnitTestsArgs {
+  export type AsObject = {
+    packageId: string,
+  }
+}
+
+export class StarlarkUnitTestResult extends jspb.Message {
+  getTestFile(): string;
+  setTestFile(value: string): StarlarkUnitTestResult;
+
+  getTestName(): string;
+  setTestName(value: string): StarlarkUnitTestResult;
+
+  getFailureMessage(): string;
+  setFailureMessage(value: string): StarlarkUnitTestResult;
+
+  getInstructionOutputsList(): Array<string>;
+  setInstructionOutputsList(value: Array<string>): StarlarkUnitTestResult;
+  clearInstructionOutputsList(): StarlarkUnitTestResult;
+  addInstructionOutputs(value: string, index?: number): StarlarkUnitTestResult;
+
+  getDuration(): google_protobuf_duration_pb.Duration | undefined;
+  setDuration(value?: google_protobuf_duration_pb.Duration): StarlarkUnitTestResult;
+  hasDuration(): boolean;
+  clearDuration(): StarlarkUnitTestResult;
+
+  serializeBinary(): Uint8Array;
+  toObject(includeInstance?: boolean): StarlarkUnitTestResult.AsObject;
+  static toObject(includeInstance: boolean, msg: StarlarkUnitTestResult): StarlarkUnitTestResult.AsObject;
+  static serializeBinaryToWriter(message: StarlarkUnitTestResult, writer: jspb.BinaryWriter): void;
+  static deserializeBinary(bytes: Uint8Array): StarlarkUnitTestResult;
+  static deserializeBinaryFromReader(message: StarlarkUnitTestResult, reader: jspb.BinaryReader): StarlarkUnitTestResult;
+}
+
+export namespace StarlarkUnitTestResult {
+  export type AsObject = {
+    testFile: string,
+    testName: string,
+    failureMessage: string,
+    instructionOutputsList: Array<string>,
+    duration?: google_protobuf_duration_pb.Duration.AsObject,
+  }
+}
+
+export class RunStarlarkPackageUnitTestsResponse extends jspb.Message {
+  getResultsList(): Array<StarlarkUnitTestResult>;
+  setResultsList(value: Array<StarlarkUnitTestResult>): RunStarlarkPackageUnitTestsResponse;
+  clearResultsList(): RunStarlarkPackageUnitTestsResponse;
+  addResults(value?: StarlarkUnitTestResult, index?: number): StarlarkUnitTestResult;
+
+  serializeBinary(): Uint8Array;
+  toObject(includeInstance?: boolean): RunStarlarkPackageUnitTestsResponse.AsObject;
+  static toObject(includeInstance: boolean, msg: RunStarlarkPackageUnitTestsResponse): RunStarlarkPackageUnitTestsResponse.AsObject;
+  static serializeBinaryToWriter(message: RunStarlarkPackageUnitTestsResponse, writer: jspb.BinaryWriter): void;
+  static deserializeBinary(bytes: Uint8Array): RunStarlarkPackageUnitTestsResponse;
+  static deserializeBinaryFromReader(message: RunStarlarkPackageUnitTestsResponse, reader: jspb.BinaryReader): RunStarlarkPackageUnitTestsResponse;
+}
+
+export namespace RunStarlarkPackageUnitTestsResponse {
+  export type AsObject = {
+    resultsList: Array<StarlarkUnitTestResult.AsObject>,
+  }
+}
+
 export enum ServiceStatus { 
   STOPPED = 0,
   RUNNING = 1,
@@ -1833,3 +2257,10 @@ export enum RestartPolicy {
   NEVER = 0,
   ALWAYS = 1,
 }
+export enum ApiContainerEventType { 
+  STARLARK_RUN_STARTED = 0,
+  STARLARK_RUN_FINISHED = 1,
+  SERVICE_ADDED = 2,
+  SERVICE_STOPPED = 3,
+  SERVICE_REMOVED = 4,
+}
@@ -3,7 +3,7 @@
 /* eslint-disable */
 // @ts-nocheck
 
-import { ConnectServicesArgs, ConnectServicesResponse, DownloadFilesArtifactArgs, ExecCommandArgs, ExecCommandResponse, GetExistingAndHistoricalServiceIdentifiersResponse, GetServicesArgs, GetServicesResponse, GetStarlarkRunResponse, InspectFilesArtifactContentsRequest, InspectFilesArtifactContentsResponse, ListFilesArtifactNamesAndUuidsResponse, PlanYaml, RunStarlarkPackageArgs, RunStarlarkScriptArgs, StarlarkPackagePlanYamlArgs, StarlarkRunResponseLine, StarlarkScriptPlanYamlArgs, StoreFilesArtifactFromServiceArgs, StoreFilesArtifactFromServiceResponse, StoreWebFilesArtifactArgs, StoreWebFilesArtifactResponse, StreamedDataChunk, UploadFilesArtifactResponse, WaitForHttpGetEndpointAvailabilityArgs, WaitForHttpPostEndpointAvailabilityArgs } from "./api_container_service_pb.js";
+import { ApiContainerEvent, ConnectServicesArgs, ConnectServicesResponse, DownloadFilesArtifactArgs, ExecCommandArgs, ExecCommandResponse, GetAuditLogResponse, GetEnclavePlanResponse, GetExistingAndHistoricalServiceIdentifiersResponse, GetLastActivityTimeResponse, GetServiceStatsArgs, GetServiceStatsResponse, GetServicesArgs, GetServicesResponse, GetStarlarkRunResponse, InspectFilesArtifactContentsRequest, InspectFilesArtifactContentsResponse, ListFilesArtifactNamesAndUuidsResponse, PlanYaml, RenameServiceArgs, RunStarlarkPackageArgs, RunStarlarkPackageUnitTestsArgs, RunStarlarkPackageUnitTestsResponse, RunStarlarkScriptArgs, StarlarkPackagePlanYamlArgs, StarlarkRunResponseLine, StarlarkScriptPlanYamlArgs, StoreFilesArtifactFromServiceArgs, StoreFilesArtifactFromServiceResponse, StoreWebFilesArtifactArgs, StoreWebFilesArtifactResponse, StreamedDataChunk, UploadFilesArtifactResponse, WaitForHttpGetEndpointAvailabilityArgs, WaitForHttpPostEndpointAvailabilityArgs } from "./api_container_service_pb.js";
 import { Empty, MethodKind } from "@bufbuild/protobuf";
 
 /**
@@ -206,6 +206,84 @@ export declare const ApiContainerService: {
       readonly O: typeof PlanYaml,
       readonly kind: MethodKind.Unary,
     },
+    /**
+     * Gets when the API container last served a request other than this one, which tells whether the enclave is idle
+     *
+     * @generated from rpc api_container_api.ApiContainerService.GetLastActivityTime
+     */
+    readonly getLastActivityTime: {
+      readonly name: "GetLastActivityTime",
+      readonly I: typeof Empty,
+      readonly O: typeof GetLastActivityTimeResponse,
+      readonly kind: MethodKind.Unary,
+    },
+    /**
+     * Gets the instructions that brought the enclave to its current state, as persisted across the Starlark runs
+     *
+     * @generated from rpc api_container_api.ApiContainerService.GetEnclavePlan
+     */
+    readonly getEnclavePlan: {
+      readonly name: "GetEnclavePlan",
+      readonly I: typeof Empty,
+      readonly O: typeof GetEnclavePlanResponse,
+      readonly kind: MethodKind.Unary,
+    },
+    /**
+     * Streams the Starlark runs and service changes happening in the enclave, starting from when the stream is opened
+     *
+     * @generated from rpc api_container_api.ApiContainerService.WatchEvents
+     */
+    readonly watchEvents: {
+      readonly name: "WatchEvents",
+      readonly I: typeof Empty,
+      readonly O: typeof ApiContainerEvent,
+      readonly kind: MethodKind.ServerStreaming,
+    },
+    /**
+     * Gets the changes made through the API container, oldest first
+     *
+     * @generated from rpc api_container_api.ApiContainerService.GetAuditLog
+     */
+    readonly getAuditLog: {
+      readonly name: "GetAuditLog",
+      readonly I: typeof Empty,
+      readonly O: typeof GetAuditLogResponse,
+      readonly kind: MethodKind.Unary,
+    },
+    /**
+     * Gives a running service a new name, keeping its UUID, and updates the enclave plan to match
+     *
+     * @generated from rpc api_container_api.ApiContainerService.RenameService
+     */
+    readonly renameService: {
+      readonly name: "RenameService",
+      readonly I: typeof RenameServiceArgs,
+      readonly O: typeof Empty,
+      readonly kind: MethodKind.Unary,
+    },
+    /**
+     * Samples the resources used by the services of the enclave, once or repeatedly until the stream gets closed
+     *
+     * @generated from rpc api_container_api.ApiContainerService.GetServiceStats
+     */
+    readonly getServiceStats: {
+      readonly name: "GetServiceStats",
+      readonly I: typeof GetServiceStatsArgs,
+      readonly O: typeof GetServiceStatsResponse,
+      readonly kind: MethodKind.ServerStreaming,
+    },
+    /**
+     * Runs the unit tests of a package uploaded with UploadStarlarkPackage against a fake enclave, without starting any
+     * service
+     *
+     * @generated from rpc api_container_api.ApiContainerService.RunStarlarkPackageUnitTests
+     */
+    readonly runStarlarkPackageUnitTests: {
+      readonly name: "RunStarlarkPackageUnitTests",
+      readonly I: typeof RunStarlarkPackageUnitTestsArgs,
+      readonly O: typeof RunStarlarkPackageUnitTestsResponse,
+      readonly kind: MethodKind.Unary,
+    },
   }
 };
 
@@ -3,7 +3,7 @@
 /* eslint-disable */
 // @ts-nocheck
 
-import { ConnectServicesArgs, ConnectServicesResponse, DownloadFilesArtifactArgs, ExecCommandArgs, ExecCommandResponse, GetExistingAndHistoricalServiceIdentifiersResponse, GetServicesArgs, GetServicesResponse, GetStarlarkRunResponse, InspectFilesArtifactContentsRequest, InspectFilesArtifactContentsResponse, ListFilesArtifactNamesAndUuidsResponse, PlanYaml, RunStarlarkPackageArgs, RunStarlarkScriptArgs, StarlarkPackagePlanYamlArgs, StarlarkRunResponseLine, StarlarkScriptPlanYamlArgs, StoreFilesArtifactFromServiceArgs, StoreFilesArtifactFromServiceResponse, StoreWebFilesArtifactArgs, StoreWebFilesArtifactResponse, StreamedDataChunk, UploadFilesArtifactResponse, WaitForHttpGetEndpointAvailabilityArgs, WaitForHttpPostEndpointAvailabilityArgs } from "./api_container_service_pb.js";
+import { ApiContainerEvent, ConnectServicesArgs, ConnectServicesResponse, DownloadFilesArtifactArgs, ExecCommandArgs, ExecCommandResponse, GetAuditLogResponse, GetEnclavePlanResponse, GetExistingAndHistoricalServiceIdentifiersResponse, GetLastActivityTimeResponse, GetServiceStatsArgs, GetServiceStatsResponse, GetServicesArgs, GetServicesResponse, GetStarlarkRunResponse, InspectFilesArtifactContentsRequest, InspectFilesArtifactContentsResponse, ListFilesArtifactNamesAndUuidsResponse, PlanYaml, RenameServiceArgs, RunStarlarkPackageArgs, RunStarlarkPackageUnitTestsArgs, RunStarlarkPackageUnitTestsResponse, RunStarlarkScriptArgs, StarlarkPackagePlanYamlArgs, StarlarkRunResponseLine, StarlarkScriptPlanYamlArgs, StoreFilesArtifactFromServiceArgs, StoreFilesArtifactFromServiceResponse, StoreWebFilesArtifactArgs, StoreWebFilesArtifactResponse, StreamedDataChunk, UploadFilesArtifactResponse, WaitForHttpGetEndpointAvailabilityArgs, WaitForHttpPostEndpointAvailabilityArgs } from "./api_container_service_pb.js";
 import { Empty, MethodKind } from "@bufbuild/protobuf";
 
 /**
@@ -206,6 +206,84 @@ export const ApiContainerService = {
       O: PlanYaml,
       kind: MethodKind.Unary,
     },
+    /**
+     * Gets when the API container last served a request other than this one, which tells whether the enclave is idle
+     *
+     * @generated from rpc api_container_api.ApiContainerService.GetLastActivityTime
+     */
+    getLastActivityTime: {
+      name: "GetLastActivityTime",
+      I: Empty,
+      O: GetLastActivityTimeResponse,
+      kind: MethodKind.Unary,
+    },
+    /**
+     * Gets the instructions that brought the enclave to its current state, as persisted across the Starlark runs
+     *
+     * @generated from rpc api_container_api.ApiContainerService.GetEnclavePlan
+     */
+    getEnclavePlan: {
+      name: "GetEnclavePlan",
+      I: Empty,
+      O: GetEnclavePlanResponse,
+      kind: MethodKind.Unary,
+    },
+    /**
+     * Streams the Starlark runs and service changes happening in the enclave, starting from when the stream is opened
+     *
+     * @generated from rpc api_container_api.ApiContainerService.WatchEvents
+     */
+    watchEvents: {
+      name: "WatchEvents",
+      I: Empty,
+      O: ApiContainerEvent,
+      kind: MethodKind.ServerStreaming,
+    },
+    /**
+     * Gets the changes made through the API container, oldest first
+     *
+     * @generated from rpc api_container_api.ApiContainerService.GetAuditLog
+     */
+    getAuditLog: {
+      name: "GetAuditLog",
+      I: Empty,
+      O: GetAuditLogResponse,
+      kind: MethodKind.Unary,
+    },
+    /**
+     * Gives a running service a new name, keeping its UUID, and updates the enclave plan to match
+     *
+     * @generated from rpc api_container_api.ApiContainerService.RenameService
+     */
+    renameService: {
+      name: "RenameService",
+      I: RenameServiceArgs,
+      O: Empty,
+      kind: MethodKind.Unary,
+    },
+    /**
+     * Samples the resources used by the services of the enclave, once or repeatedly until the stream gets closed
+     *
+     * @generated from rpc api_container_api.ApiContainerService.GetServiceStats
+     */
+    getServiceStats: {
+      name: "GetServiceStats",
+      I: GetServiceStatsArgs,
+      O: GetServiceStatsResponse,
+      kind: MethodKind.ServerStreaming,
+    },
+    /**
+     * Runs the unit tests of a package uploaded with UploadStarlarkPackage against a fake enclave, without starting any
+     * service
+     *
+     * @generated from rpc api_container_api.ApiContainerService.RunStarlarkPackageUnitTests
+     */
+    runStarlarkPackageUnitTests: {
+      name: "RunStarlarkPackageUnitTests",
+      I: RunStarlarkPackageUnitTestsArgs,
+      O: RunStarlarkPackageUnitTestsResponse,
+      kind: MethodKind.Unary,
+    },
   }
 };
 
@@ -3,7 +3,7 @@
 /* eslint-disable */
 // @ts-nocheck
 
-import type { BinaryReadOptions, Duration, FieldList, JsonReadOptions, JsonValue, PartialMessage, PlainMessage } from "@bufbuild/protobuf";
+import type { BinaryReadOptions, Duration, FieldList, JsonReadOptions, JsonValue, PartialMessage, PlainMessage, Timestamp } from "@bufbuild/protobuf";
 import { Message, proto3 } from "@bufbuild/protobuf";
 
 /**
@@ -90,6 +90,40 @@ export declare enum RestartPolicy {
   ALWAYS = 1,
 }
 
+/**
+ * ==============================================================================================
+ *                                         Watch Events
+ * ==============================================================================================
+ *
+ * @generated from enum api_container_api.ApiContainerEventType
+ */
+export declare enum ApiContainerEventType {
+  /**
+   * @generated from enum value: STARLARK_RUN_STARTED = 0;
+   */
+  STARLARK_RUN_STARTED = 0,
+
+  /**
+   * @generated from enum value: STARLARK_RUN_FINISHED = 1;
+   */
+  STARLARK_RUN_FINISHED = 1,
+
+  /**
+   * @generated from enum value: SERVICE_ADDED = 2;
+   */
+  SERVICE_ADDED = 2,
+
+  /**
+   * @generated from enum value: SERVICE_STOPPED = 3;
+   */
+  SERVICE_STOPPED = 3,
+
+  /**
+   * @generated from enum value: SERVICE_REMOVED = 4;
+   */
+  SERVICE_REMOVED = 4,
+}
+
 /**
  * ==============================================================================================
  *                           Shared Objects (Used By Multiple Endpoints)
@@ -476,6 +510,13 @@ export declare class ServiceInfo extends Message<ServiceInfo> {
    */
   ttyEnabled?: boolean;
 
+  /**
+   * The persistent key of the persistent directories mounted in the service, keyed by their path in the service
+   *
+   * @generated from field: map<string, string> service_dir_paths_to_persistent_keys = 21;
+   */
+  serviceDirPathsToPersistentKeys: { [key: string]: string };
+
   constructor(data?: PartialMessage<ServiceInfo>);
 
   static readonly runtime: typeof proto3;
@@ -566,6 +607,14 @@ export declare class RunStarlarkScriptArgs extends Message<RunStarlarkScriptArgs
    */
   parallel?: boolean;
 
+  /**
+   * Secret values, by name, that the script can reference with Secret(name). They are only kept in memory by the
+   * APIC and masked in every output of the run
+   *
+   * @generated from field: map<string, string> secrets = 18;
+   */
+  secrets: { [key: string]: string };
+
   constructor(data?: PartialMessage<RunStarlarkScriptArgs>);
 
   static readonly runtime: typeof proto3;
@@ -710,6 +759,14 @@ export declare class RunStarlarkPackageArgs extends Message<RunStarlarkPackageAr
    */
   parallel?: boolean;
 
+  /**
+   * Secret values, by name, that the script can reference with Secret(name). They are only kept in memory by the
+   * APIC and masked in every output of the run
+   *
+   * @generated from field: map<string, string> secrets = 18;
+   */
+  secrets: { [key: string]: string };
+
   constructor(data?: PartialMessage<RunStarlarkPackageArgs>);
 
   static readonly runtime: typeof proto3;
@@ -2159,3 +2216,530 @@ export declare class StarlarkPackagePlanYamlArgs extends Message<StarlarkPackage
   static equals(a: StarlarkPackagePlanYamlArgs | PlainMessage<StarlarkPackagePlanYamlArgs> | undefined, b: StarlarkPackagePlanYamlArgs | PlainMessage<StarlarkPackagePlanYamlArgs> | undefined): boolean;
 }
 
+/**
+ * ==============================================================================================
+ *                               Get Last Activity Time
+ * ==============================================================================================
+ *
+ * @generated from message api_container_api.GetLastActivityTimeResponse
+ */
+export declare class GetLastActivityTimeResponse extends Message<GetLastActivityTimeResponse> {
+  /**
+   * @generated from field: google.protobuf.Timestamp last_activity_time = 1;
+   */
+  lastActivityTime?: Timestamp;
+
+  constructor(data?: PartialMessage<GetLastActivityTimeResponse>);
+
+  static readonly runtime: typeof proto3;
+  static readonly typeName = "api_container_api.GetLastActivityTimeResponse";
+  static readonly fields: FieldList;
+
+  static fromBinary(bytes: Uint8Array, options?: Partial<BinaryReadOptions>): GetLastActivityTimeResponse;
+
+  static fromJson(jsonValue: JsonValue, options?: Partial<JsonReadOptions>): GetLastActivityTimeResponse;
+
+  static fromJsonString(jsonString: string, options?: Partial<JsonReadOptions>): GetLastActivityTimeResponse;
+
+  static equals(a: GetLastActivityTimeResponse | PlainMessage<GetLastActivityTimeResponse> | undefined, b: GetLastActivityTimeResponse | PlainMessage<GetLastActivityTimeResponse> | undefined): boolean;
+}
+
+/**
+ * ==============================================================================================
+ *                                     Get Enclave Plan
+ * ==============================================================================================
+ *
+ * @generated from message api_container_api.EnclavePlanInstruction
+ */
+export declare class EnclavePlanInstruction extends Message<EnclavePlanInstruction> {
+  /**
+   * @generated from field: string uuid = 1;
+   */
+  uuid: string;
+
+  /**
+   * The name of the instruction, e.g. 'add_service'
+   *
+   * @generated from field: string type = 2;
+   */
+  type: string;
+
+  /**
+   * The instruction as it was called, without the 'plan.' prefix
+   *
+   * @generated from field: string starlark_code = 3;
+   */
+  starlarkCode: string;
+
+  /**
+   * The services the instruction created or updated
+   *
+   * @generated from field: repeated string service_names = 4;
+   */
+  serviceNames: string[];
+
+  /**
+   * The files artifacts the instruction created or retrieved
+   *
+   * @generated from field: repeated string files_artifact_names = 5;
+   */
+  filesArtifactNames: string[];
+
+  constructor(data?: PartialMessage<EnclavePlanInstruction>);
+
+  static readonly runtime: typeof proto3;
+  static readonly typeName = "api_container_api.EnclavePlanInstruction";
+  static readonly fields: FieldList;
+
+  static fromBinary(bytes: Uint8Array, options?: Partial<BinaryReadOptions>): EnclavePlanInstruction;
+
+  static fromJson(jsonValue: JsonValue, options?: Partial<JsonReadOptions>): EnclavePlanInstruction;
+
+  static fromJsonString(jsonString: string, options?: Partial<JsonReadOptions>): EnclavePlanInstruction;
+
+  static equals(a: EnclavePlanInstruction | PlainMessage<EnclavePlanInstruction> | undefined, b: EnclavePlanInstruction | PlainMessage<EnclavePlanInstruction> | undefined): boolean;
+}
+
+/**
+ * @generated from message api_container_api.GetEnclavePlanResponse
+ */
+export declare class GetEnclavePlanResponse extends Message<GetEnclavePlanResponse> {
+  /**
+   * @generated from field: repeated api_container_api.EnclavePlanInstruction instructions = 1;
+   */
+  instructions: EnclavePlanInstruction[];
+
+  constructor(data?: PartialMessage<GetEnclavePlanResponse>);
+
+  static readonly runtime: typeof proto3;
+  static readonly typeName = "api_container_api.GetEnclavePlanResponse";
+  static readonly fields: FieldList;
+
+  static fromBinary(bytes: Uint8Array, options?: Partial<BinaryReadOptions>): GetEnclavePlanResponse;
+
+  static fromJson(jsonValue: JsonValue, options?: Partial<JsonReadOptions>): GetEnclavePlanResponse;
+
+  static fromJsonString(jsonString: string, options?: Partial<JsonReadOptions>): GetEnclavePlanResponse;
+
+  static equals(a: GetEnclavePlanResponse | PlainMessage<GetEnclavePlanResponse> | undefined, b: GetEnclavePlanResponse | PlainMessage<GetEnclavePlanResponse> | undefined): boolean;
+}
+
+/**
+ * @generated from message api_container_api.ApiContainerEvent
+ */
+export declare class ApiContainerEvent extends Message<ApiContainerEvent> {
+  /**
+   * @generated from field: api_container_api.ApiContainerEventType type = 1;
+   */
+  type: ApiContainerEventType;
+
+  /**
+   * @generated from field: google.protobuf.Timestamp timestamp = 2;
+   */
+  timestamp?: Timestamp;
+
+  /**
+   * The service the event is about, only set for service events
+   *
+   * @generated from field: optional string service_name = 3;
+   */
+  serviceName?: string;
+
+  /**
+   * The package that was run, only set for Starlark run events of packages
+   *
+   * @generated from field: optional string starlark_package_id = 4;
+   */
+  starlarkPackageId?: string;
+
+  /**
+   * Whether the run succeeded, only set for STARLARK_RUN_FINISHED events
+   *
+   * @generated from field: optional bool starlark_run_succeeded = 5;
+   */
+  starlarkRunSucceeded?: boolean;
+
+  constructor(data?: PartialMessage<ApiContainerEvent>);
+
+  static readonly runtime: typeof proto3;
+  static readonly typeName = "api_container_api.ApiContainerEvent";
+  static readonly fields: FieldList;
+
+  static fromBinary(bytes: Uint8Array, options?: Partial<BinaryReadOptions>): ApiContainerEvent;
+
+  static fromJson(jsonValue: JsonValue, options?: Partial<JsonReadOptions>): ApiContainerEvent;
+
+  static fromJsonString(jsonString: string, options?: Partial<JsonReadOptions>): ApiContainerEvent;
+
+  static equals(a: ApiContainerEvent | PlainMessage<ApiContainerEvent> | undefined, b: ApiContainerEvent | PlainMessage<ApiContainerEvent> | undefined): boolean;
+}
+
+/**
+ * ==============================================================================================
+ *                                         Audit Log
+ * ==============================================================================================
+ *
+ * @generated from message api_container_api.AuditLogEntry
+ */
+export declare class AuditLogEntry extends Message<AuditLogEntry> {
+  /**
+   * Increases with every entry, so that entries logged at the same time keep their order
+   *
+   * @generated from field: uint64 sequence_number = 1;
+   */
+  sequenceNumber: bigint;
+
+  /**
+   * @generated from field: google.protobuf.Timestamp timestamp = 2;
+   */
+  timestamp?: Timestamp;
+
+  /**
+   * Who made the call, as forwarded by the engine or the address the call came from
+   *
+   * @generated from field: string caller = 3;
+   */
+  caller: string;
+
+  /**
+   * The name of the RPC that was called
+   *
+   * @generated from field: string action = 4;
+   */
+  action: string;
+
+  /**
+   * The arguments of the call, with the values of the secrets redacted
+   *
+   * @generated from field: map<string, string> arguments = 5;
+   */
+  arguments: { [key: string]: string };
+
+  /**
+   * Why the call failed, unset if it succeeded
+   *
+   * @generated from field: optional string error = 6;
+   */
+  error?: string;
+
+  constructor(data?: PartialMessage<AuditLogEntry>);
+
+  static readonly runtime: typeof proto3;
+  static readonly typeName = "api_container_api.AuditLogEntry";
+  static readonly fields: FieldList;
+
+  static fromBinary(bytes: Uint8Array, options?: Partial<BinaryReadOptions>): AuditLogEntry;
+
+  static fromJson(jsonValue: JsonValue, options?: Partial<JsonReadOptions>): AuditLogEntry;
+
+  static fromJsonString(jsonString: string, options?: Partial<JsonReadOptions>): AuditLogEntry;
+
+  static equals(a: AuditLogEntry | PlainMessage<AuditLogEntry> | undefined, b: AuditLogEntry | PlainMessage<AuditLogEntry> | undefined): boolean;
+}
+
+/**
+ * @generated from message api_container_api.GetAuditLogResponse
+ */
+export declare class GetAuditLogResponse extends Message<GetAuditLogResponse> {
+  /**
+   * @generated from field: repeated api_container_api.AuditLogEntry entries = 1;
+   */
+  entries: AuditLogEntry[];
+
+  constructor(data?: PartialMessage<GetAuditLogResponse>);
+
+  static readonly runtime: typeof proto3;
+  static readonly typeName = "api_container_api.GetAuditLogResponse";
+  static readonly fields: FieldList;
+
+  static fromBinary(bytes: Uint8Array, options?: Partial<BinaryReadOptions>): GetAuditLogResponse;
+
+  static fromJson(jsonValue: JsonValue, options?: Partial<JsonReadOptions>): GetAuditLogResponse;
+
+  static fromJsonString(jsonString: string, options?: Partial<JsonReadOptions>): GetAuditLogResponse;
+
+  static equals(a: GetAuditLogResponse | PlainMessage<GetAuditLogResponse> | undefined, b: GetAuditLogResponse | PlainMessage<GetAuditLogResponse> | undefined): boolean;
+}
+
+/**
+ * ==============================================================================================
+ *                                       Rename Service
+ * ==============================================================================================
+ *
+ * @generated from message api_container_api.RenameServiceArgs
+ */
+export declare class RenameServiceArgs extends Message<RenameServiceArgs> {
+  /**
+   * The name, UUID or shortened UUID of the service to rename
+   *
+   * @generated from field: string service_identifier = 1;
+   */
+  serviceIdentifier: string;
+
+  /**
+   * @generated from field: string new_name = 2;
+   */
+  newName: string;
+
+  constructor(data?: PartialMessage<RenameServiceArgs>);
+
+  static readonly runtime: typeof proto3;
+  static readonly typeName = "api_container_api.RenameServiceArgs";
+  static readonly fields: FieldList;
+
+  static fromBinary(bytes: Uint8Array, options?: Partial<BinaryReadOptions>): RenameServiceArgs;
+
+  static fromJson(jsonValue: JsonValue, options?: Partial<JsonReadOptions>): RenameServiceArgs;
+
+  static fromJsonString(jsonString: string, options?: Partial<JsonReadOptions>): RenameServiceArgs;
+
+  static equals(a: RenameServiceArgs | PlainMessage<RenameServiceArgs> | undefined, b: RenameServiceArgs | PlainMessage<RenameServiceArgs> | undefined): boolean;
+}
+
+/**
+ * ==============================================================================================
+ *                                       Service Stats
+ * ==============================================================================================
+ *
+ * @generated from message api_container_api.GetServiceStatsArgs
+ */
+export declare class GetServiceStatsArgs extends Message<GetServiceStatsArgs> {
+  /**
+   * The names, UUIDs or shortened UUIDs of the services to sample; all the running services if empty
+   *
+   * @generated from field: repeated string service_identifiers = 1;
+   */
+  serviceIdentifiers: string[];
+
+  /**
+   * Keep sampling until the stream gets closed instead of returning a single sample
+   *
+   * @generated from field: bool follow = 2;
+   */
+  follow: boolean;
+
+  /**
+   * How long to wait between samples when following, defaults to a few seconds
+   *
+   * @generated from field: optional google.protobuf.Duration interval = 3;
+   */
+  interval?: Duration;
+
+  constructor(data?: PartialMessage<GetServiceStatsArgs>);
+
+  static readonly runtime: typeof proto3;
+  static readonly typeName = "api_container_api.GetServiceStatsArgs";
+  static readonly fields: FieldList;
+
+  static fromBinary(bytes: Uint8Array, options?: Partial<BinaryReadOptions>): GetServiceStatsArgs;
+
+  static fromJson(jsonValue: JsonValue, options?: Partial<JsonReadOptions>): GetServiceStatsArgs;
+
+  static fromJsonString(jsonString: string, options?: Partial<JsonReadOptions>): GetServiceStatsArgs;
+
+  static equals(a: GetServiceStatsArgs | PlainMessage<GetServiceStatsArgs> | undefined, b: GetServiceStatsArgs | PlainMessage<GetServiceStatsArgs> | undefined): boolean;
+}
+
+/**
+ * @generated from message api_container_api.ServiceStats
+ */
+export declare class ServiceStats extends Message<ServiceStats> {
+  /**
+   * @generated from field: string service_name = 1;
+   */
+  serviceName: string;
+
+  /**
+   * @generated from field: string service_uuid = 2;
+   */
+  serviceUuid: string;
+
+  /**
+   * Like in `docker stats`, 100 being one CPU fully used
+   *
+   * @generated from field: double cpu_percent = 3;
+   */
+  cpuPercent: number;
+
+  /**
+   * @generated from field: uint64 memory_usage_bytes = 4;
+   */
+  memoryUsageBytes: bigint;
+
+  /**
+   * Zero if the service has no memory limit
+   *
+   * @generated from field: uint64 memory_limit_bytes = 5;
+   */
+  memoryLimitBytes: bigint;
+
+  /**
+   * Network and disk totals since the service started, which stay at zero on Kubernetes
+   *
+   * @generated from field: uint64 network_received_bytes = 6;
+   */
+  networkReceivedBytes: bigint;
+
+  /**
+   * @generated from field: uint64 network_sent_bytes = 7;
+   */
+  networkSentBytes: bigint;
+
+  /**
+   * @generated from field: uint64 disk_read_bytes = 8;
+   */
+  diskReadBytes: bigint;
+
+  /**
+   * @generated from field: uint64 disk_written_bytes = 9;
+   */
+  diskWrittenBytes: bigint;
+
+  constructor(data?: PartialMessage<ServiceStats>);
+
+  static readonly runtime: typeof proto3;
+  static readonly typeName = "api_container_api.ServiceStats";
+  static readonly fields: FieldList;
+
+  static fromBinary(bytes: Uint8Array, options?: Partial<BinaryReadOptions>): ServiceStats;
+
+  static fromJson(jsonValue: JsonValue, options?: Partial<JsonReadOptions>): ServiceStats;
+
+  static fromJsonString(jsonString: string, options?: Partial<JsonReadOptions>): ServiceStats;
+
+  static equals(a: ServiceStats | PlainMessage<ServiceStats> | undefined, b: ServiceStats | PlainMessage<ServiceStats> | undefined): boolean;
+}
+
+/**
+ * @generated from message api_container_api.GetServiceStatsResponse
+ */
+export declare class GetServiceStatsResponse extends Message<GetServiceStatsResponse> {
+  /**
+   * @generated from field: google.protobuf.Timestamp timestamp = 1;
+   */
+  timestamp?: Timestamp;
+
+  /**
+   * @generated from field: repeated api_container_api.ServiceStats service_stats = 2;
+   */
+  serviceStats: ServiceStats[];
+
+  constructor(data?: PartialMessage<GetServiceStatsResponse>);
+
+  static readonly runtime: typeof proto3;
+  static readonly typeName = "api_container_api.GetServiceStatsResponse";
+  static readonly fields: FieldList;
+
+  static fromBinary(bytes: Uint8Array, options?: Partial<BinaryReadOptions>): GetServiceStatsResponse;
+
+  static fromJson(jsonValue: JsonValue, options?: Partial<JsonReadOptions>): GetServiceStatsResponse;
+
+  static fromJsonString(jsonString: string, options?: Partial<JsonReadOptions>): GetServiceStatsResponse;
+
+  static equals(a: GetServiceStatsResponse | PlainMessage<GetServiceStatsResponse> | undefined, b: GetServiceStatsResponse | PlainMessage<GetServiceStatsResponse> | undefined): boolean;
+}
+
+/**
+ * ==============================================================================================
+ *                                   Starlark Package Unit Tests
+ * ==============================================================================================
+ *
+ * @generated from message api_container_api.RunStarlarkPackageUnitTestsArgs
+ */
+export declare class RunStarlarkPackageUnitTestsArgs extends Message<RunStarlarkPackageUnitTestsArgs> {
+  /**
+   * The name of the package, as uploaded with UploadStarlarkPackage
+   *
+   * @generated from field: string package_id = 1;
+   */
+  packageId: string;
+
+  constructor(data?: PartialMessage<RunStarlarkPackageUnitTestsArgs>);
+
+  static readonly runtime: typeof proto3;
+  static readonly typeName = "api_container_api.RunStarlarkPackageUnitTestsArgs";
+  static readonly fields: FieldList;
+
+  static fromBinary(bytes: Uint8Array, options?: Partial<BinaryReadOptions>): RunStarlarkPackageUnitTestsArgs;
+
+  static fromJson(jsonValue: JsonValue, options?: Partial<JsonReadOptions>): RunStarlarkPackageUnitTestsArgs;
+
+  static fromJsonString(jsonString: string, options?: Partial<JsonReadOptions>): RunStarlarkPackageUnitTestsArgs;
+
+  static equals(a: RunStarlarkPackageUnitTestsArgs | PlainMessage<RunStarlarkPackageUnitTestsArgs> | undefined, b: RunStarlarkPackageUnitTestsArgs | PlainMessage<RunStarlarkPackageUnitTestsArgs> | undefined): boolean;
+}
+
+/**
+ * @generated from message api_container_api.StarlarkUnitTestResult
+ */
+export declare class StarlarkUnitTestResult extends Message<StarlarkUnitTestResult> {
+  /**
+   * Path of the test file, relative to the package root
+   *
+   * @generated from field: string test_file = 1;
+   */
+  testFile: string;
+
+  /**
+   * @generated from field: string test_name = 2;
+   */
+  testName: string;
+
+  /**
+   * Empty if the test passed
+   *
+   * @generated from field: string failure_message = 3;
+   */
+  failureMessage: string;
+
+  /**
+   * Output of every instruction executed against the fake enclave, in order
+   *
+   * @generated from field: repeated string instruction_outputs = 4;
+   */
+  instructionOutputs: string[];
+
+  /**
+   * @generated from field: google.protobuf.Duration duration = 5;
+   */
+  duration?: Duration;
+
+  constructor(data?: PartialMessage<StarlarkUnitTestResult>);
+
+  static readonly runtime: typeof proto3;
+  static readonly typeName = "api_container_api.StarlarkUnitTestResult";
+  static readonly fields: FieldList;
+
+  static fromBinary(bytes: Uint8Array, options?: Partial<BinaryReadOptions>): StarlarkUnitTestResult;
+
+  static fromJson(jsonValue: JsonValue, options?: Partial<JsonReadOptions>): StarlarkUnitTestResult;
+
+  static fromJsonString(jsonString: string, options?: Partial<JsonReadOptions>): StarlarkUnitTestResult;
+
+  static equals(a: StarlarkUnitTestResult | PlainMessage<StarlarkUnitTestResult> | undefined, b: StarlarkUnitTestResult | PlainMessage<StarlarkUnitTestResult> | undefined): boolean;
+}
+
+/**
+ * @generated from message api_container_api.RunStarlarkPackageUnitTestsResponse
+ */
+export declare class RunStarlarkPackageUnitTestsResponse extends Message<RunStarlarkPackageUnitTestsResponse> {
+  /**
+   * @generated from field: repeated api_container_api.StarlarkUnitTestResult results = 1;
+   */
+  results: StarlarkUnitTestResult[];
+
+  constructor(data?: PartialMessage<RunStarlarkPackageUnitTestsResponse>);
+
+  static readonly runtime: typeof proto3;
+  static readonly typeName = "api_container_api.RunStarlarkPackageUnitTestsResponse";
+  static readonly fields: FieldList;
+
+  static fromBinary(bytes: Uint8Array, options?: Partial<BinaryReadOptions>): RunStarlarkPackageUnitTestsResponse;
+
+  static fromJson(jsonValue: JsonValue, options?: Partial<JsonReadOptions>): RunStarlarkPackageUnitTestsResponse;
+
+  static fromJsonString(jsonString: string, options?: Partial<JsonReadOptions>): RunStarlarkPackageUnitTestsResponse;
+
+  static equals(a: RunStarlarkPackageUnitTestsResponse | PlainMessage<RunStarlarkPackageUnitTestsResponse> | undefined, b: RunStarlarkPackageUnitTestsResponse | PlainMessage<RunStarlarkPackageUnitTestsResponse> | undefined): boolean;
+}
+
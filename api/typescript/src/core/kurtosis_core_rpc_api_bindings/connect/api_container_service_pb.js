@@ -3,7 +3,7 @@
 /* eslint-disable */
 // @ts-nocheck
 
-import { Duration, proto3 } from "@bufbuild/protobuf";
+import { Duration, proto3, Timestamp } from "@bufbuild/protobuf";
 
 /**
  * @generated from enum api_container_api.ServiceStatus
@@ -62,6 +62,24 @@ export const RestartPolicy = proto3.makeEnum(
   ],
 );
 
+/**
+ * ==============================================================================================
+ *                                         Watch Events
+ * ==============================================================================================
+ *
+ * @generated from enum api_container_api.ApiContainerEventType
+ */
+export const ApiContainerEventType = proto3.makeEnum(
+  "api_container_api.ApiContainerEventType",
+  [
+    {no: 0, name: "STARLARK_RUN_STARTED"},
+    {no: 1, name: "STARLARK_RUN_FINISHED"},
+    {no: 2, name: "SERVICE_ADDED"},
+    {no: 3, name: "SERVICE_STOPPED"},
+    {no: 4, name: "SERVICE_REMOVED"},
+  ],
+);
+
 /**
  * ==============================================================================================
  *                           Shared Objects (Used By Multiple Endpoints)
@@ -184,6 +202,7 @@ export const ServiceInfo = proto3.makeMessageType(
     { no: 18, name: "labels", kind: "map", K: 9 /* ScalarType.STRING */, V: {kind: "scalar", T: 9 /* ScalarType.STRING */} },
     { no: 19, name: "tini_enabled", kind: "scalar", T: 8 /* ScalarType.BOOL */, opt: true },
     { no: 20, name: "tty_enabled", kind: "scalar", T: 8 /* ScalarType.BOOL */, opt: true },
+    { no: 21, name: "service_dir_paths_to_persistent_keys", kind: "map", K: 9 /* ScalarType.STRING */, V: {kind: "scalar", T: 9 /* ScalarType.STRING */} },
   ],
 );
 
@@ -204,6 +223,7 @@ export const RunStarlarkScriptArgs = proto3.makeMessageType(
     { no: 9, name: "image_download_mode", kind: "enum", T: proto3.getEnumType(ImageDownloadMode), opt: true },
     { no: 10, name: "non_blocking_mode", kind: "scalar", T: 8 /* ScalarType.BOOL */, opt: true },
     { no: 17, name: "parallel", kind: "scalar", T: 8 /* ScalarType.BOOL */, opt: true },
+    { no: 18, name: "secrets", kind: "map", K: 9 /* ScalarType.STRING */, V: {kind: "scalar", T: 9 /* ScalarType.STRING */} },
   ],
 );
 
@@ -229,6 +249,7 @@ export const RunStarlarkPackageArgs = proto3.makeMessageType(
     { no: 15, name: "non_blocking_mode", kind: "scalar", T: 8 /* ScalarType.BOOL */, opt: true },
     { no: 16, name: "github_auth_token", kind: "scalar", T: 9 /* ScalarType.STRING */, opt: true },
     { no: 17, name: "parallel", kind: "scalar", T: 8 /* ScalarType.BOOL */, opt: true },
+    { no: 18, name: "secrets", kind: "map", K: 9 /* ScalarType.STRING */, V: {kind: "scalar", T: 9 /* ScalarType.STRING */} },
   ],
 );
 
@@ -733,3 +754,186 @@ export const StarlarkPackagePlanYamlArgs = proto3.makeMessageType(
   ],
 );
 
+/**
+ * ==============================================================================================
+ *                               Get Last Activity Time
+ * ==============================================================================================
+ *
+ * @generated from message api_container_api.GetLastActivityTimeResponse
+ */
+export const GetLastActivityTimeResponse = proto3.makeMessageType(
+  "api_container_api.GetLastActivityTimeResponse",
+  () => [
+    { no: 1, name: "last_activity_time", kind: "message", T: Timestamp },
+  ],
+);
+
+/**
+ * ==============================================================================================
+ *                                     Get Enclave Plan
+ * ==============================================================================================
+ *
+ * @generated from message api_container_api.EnclavePlanInstruction
+ */
+export const EnclavePlanInstruction = proto3.makeMessageType(
+  "api_container_api.EnclavePlanInstruction",
+  () => [
+    { no: 1, name: "uuid", kind: "scalar", T: 9 /* ScalarType.STRING */ },
+    { no: 2, name: "type", kind: "scalar", T: 9 /* ScalarType.STRING */ },
+    { no: 3, name: "starlark_code", kind: "scalar", T: 9 /* ScalarType.STRING */ },
+    { no: 4, name: "service_names", kind: "scalar", T: 9 /* ScalarType.STRING */, repeated: true },
+    { no: 5, name: "files_artifact_names", kind: "scalar", T: 9 /* ScalarType.STRING */, repeated: true },
+  ],
+);
+
+/**
+ * @generated from message api_container_api.GetEnclavePlanResponse
+ */
+export const GetEnclavePlanResponse = proto3.makeMessageType(
+  "api_container_api.GetEnclavePlanResponse",
+  () => [
+    { no: 1, name: "instructions", kind: "message", T: EnclavePlanInstruction, repeated: true },
+  ],
+);
+
+/**
+ * @generated from message api_container_api.ApiContainerEvent
+ */
+export const ApiContainerEvent = proto3.makeMessageType(
+  "api_container_api.ApiContainerEvent",
+  () => [
+    { no: 1, name: "type", kind: "enum", T: proto3.getEnumType(ApiContainerEventType) },
+    { no: 2, name: "timestamp", kind: "message", T: Timestamp },
+    { no: 3, name: "service_name", kind: "scalar", T: 9 /* ScalarType.STRING */, opt: true },
+    { no: 4, name: "starlark_package_id", kind: "scalar", T: 9 /* ScalarType.STRING */, opt: true },
+    { no: 5, name: "starlark_run_succeeded", kind: "scalar", T: 8 /* ScalarType.BOOL */, opt: true },
+  ],
+);
+
+/**
+ * ==============================================================================================
+ *                                         Audit Log
+ * ==============================================================================================
+ *
+ * @generated from message api_container_api.AuditLogEntry
+ */
+export const AuditLogEntry = proto3.makeMessageType(
+  "api_container_api.AuditLogEntry",
+  () => [
+    { no: 1, name: "sequence_number", kind: "scalar", T: 4 /* ScalarType.UINT64 */ },
+    { no: 2, name: "timestamp", kind: "message", T: Timestamp },
+    { no: 3, name: "caller", kind: "scalar", T: 9 /* ScalarType.STRING */ },
+    { no: 4, name: "action", kind: "scalar", T: 9 /* ScalarType.STRING */ },
+    { no: 5, name: "arguments", kind: "map", K: 9 /* ScalarType.STRING */, V: {kind: "scalar", T: 9 /* ScalarType.STRING */} },
+    { no: 6, name: "error", kind: "scalar", T: 9 /* ScalarType.STRING */, opt: true },
+  ],
+);
+
+/**
+ * @generated from message api_container_api.GetAuditLogResponse
+ */
+export const GetAuditLogResponse = proto3.makeMessageType(
+  "api_container_api.GetAuditLogResponse",
+  () => [
+    { no: 1, name: "entries", kind: "message", T: AuditLogEntry, repeated: true },
+  ],
+);
+
+/**
+ * ==============================================================================================
+ *                                       Rename Service
+ * ==============================================================================================
+ *
+ * @generated from message api_container_api.RenameServiceArgs
+ */
+export const RenameServiceArgs = proto3.makeMessageType(
+  "api_container_api.RenameServiceArgs",
+  () => [
+    { no: 1, name: "service_identifier", kind: "scalar", T: 9 /* ScalarType.STRING */ },
+    { no: 2, name: "new_name", kind: "scalar", T: 9 /* ScalarType.STRING */ },
+  ],
+);
+
+/**
+ * ==============================================================================================
+ *                                       Service Stats
+ * ==============================================================================================
+ *
+ * @generated from message api_container_api.GetServiceStatsArgs
+ */
+export const GetServiceStatsArgs = proto3.makeMessageType(
+  "api_container_api.GetServiceStatsArgs",
+  () => [
+    { no: 1, name: "service_identifiers", kind: "scalar", T: 9 /* ScalarType.STRING */, repeated: true },
+    { no: 2, name: "follow", kind: "scalar", T: 8 /* ScalarType.BOOL */ },
+    { no: 3, name: "interval", kind: "message", T: Duration, opt: true },
+  ],
+);
+
+/**
+ * @generated from message api_container_api.ServiceStats
+ */
+export const ServiceStats = proto3.makeMessageType(
+  "api_container_api.ServiceStats",
+  () => [
+    { no: 1, name: "service_name", kind: "scalar", T: 9 /* ScalarType.STRING */ },
+    { no: 2, name: "service_uuid", kind: "scalar", T: 9 /* ScalarType.STRING */ },
+    { no: 3, name: "cpu_percent", kind: "scalar", T: 1 /* ScalarType.DOUBLE */ },
+    { no: 4, name: "memory_usage_bytes", kind: "scalar", T: 4 /* ScalarType.UINT64 */ },
+    { no: 5, name: "memory_limit_bytes", kind: "scalar", T: 4 /* ScalarType.UINT64 */ },
+    { no: 6, name: "network_received_bytes", kind: "scalar", T: 4 /* ScalarType.UINT64 */ },
+    { no: 7, name: "network_sent_bytes", kind: "scalar", T: 4 /* ScalarType.UINT64 */ },
+    { no: 8, name: "disk_read_bytes", kind: "scalar", T: 4 /* ScalarType.UINT64 */ },
+    { no: 9, name: "disk_written_bytes", kind: "scalar", T: 4 /* ScalarType.UINT64 */ },
+  ],
+);
+
+/**
+ * @generated from message api_container_api.GetServiceStatsResponse
+ */
+export const GetServiceStatsResponse = proto3.makeMessageType(
+  "api_container_api.GetServiceStatsResponse",
+  () => [
+    { no: 1, name: "timestamp", kind: "message", T: Timestamp },
+    { no: 2, name: "service_stats", kind: "message", T: ServiceStats, repeated: true },
+  ],
+);
+
+/**
+ * ==============================================================================================
+ *                                   Starlark Package Unit Tests
+ * ==============================================================================================
+ *
+ * @generated from message api_container_api.RunStarlarkPackageUnitTestsArgs
+ */
+export const RunStarlarkPackageUnitTestsArgs = proto3.makeMessageType(
+  "api_container_api.RunStarlarkPackageUnitTestsArgs",
+  () => [
+    { no: 1, name: "package_id", kind: "scalar", T: 9 /* ScalarType.STRING */ },
+  ],
+);
+
+/**
+ * @generated from message api_container_api.StarlarkUnitTestResult
+ */
+export const StarlarkUnitTestResult = proto3.makeMessageType(
+  "api_container_api.StarlarkUnitTestResult",
+  () => [
+    { no: 1, name: "test_file", kind: "scalar", T: 9 /* ScalarType.STRING */ },
+    { no: 2, name: "test_name", kind: "scalar", T: 9 /* ScalarType.STRING */ },
+    { no: 3, name: "failure_message", kind: "scalar", T: 9 /* ScalarType.STRING */ },
+    { no: 4, name: "instruction_outputs", kind: "scalar", T: 9 /* ScalarType.STRING */, repeated: true },
+    { no: 5, name: "duration", kind: "message", T: Duration },
+  ],
+);
+
+/**
+ * @generated from message api_container_api.RunStarlarkPackageUnitTestsResponse
+ */
+export const RunStarlarkPackageUnitTestsResponse = proto3.makeMessageType(
+  "api_container_api.RunStarlarkPackageUnitTestsResponse",
+  () => [
+    { no: 1, name: "results", kind: "message", T: StarlarkUnitTestResult, repeated: true },
+  ],
+);
+
@@ -4,7 +4,7 @@
 // @ts-nocheck
 
 import { Empty, MethodKind } from "@bufbuild/protobuf";
-import { CleanArgs, CleanResponse, CreateEnclaveArgs, CreateEnclaveResponse, DestroyEnclaveArgs, GetEnclavesByUuidsArgs, GetEnclavesResponse, GetEngineInfoResponse, GetExistingAndHistoricalEnclaveIdentifiersResponse, GetServiceLogsArgs, GetServiceLogsResponse, StopEnclaveArgs } from "./engine_service_pb.js";
+import { CleanArgs, CleanResponse, CloneEnclaveArgs, CloneEnclaveResponse, CreateApiTokenArgs, CreateApiTokenResponse, CreateEnclaveArgs, CreateEnclaveResponse, DestroyEnclaveArgs, EnclaveArchiveChunk, EngineEvent, ExportEnclaveArgs, ExtendEnclaveTtlArgs, GetAuditLogArgs, GetAuditLogResponse, GetEnclaveCallerCredentialArgs, GetEnclaveCallerCredentialResponse, GetEnclavesArgs, GetEnclavesByUuidsArgs, GetEnclavesResponse, GetEngineInfoResponse, GetExistingAndHistoricalEnclaveIdentifiersResponse, GetServiceLogsArgs, GetServiceLogsResponse, ImportEnclaveArgs, ImportEnclaveResponse, RenameEnclaveArgs, RevokeApiTokenArgs, StopEnclaveArgs, UpdateEnclaveLabelsArgs, WatchEventsArgs } from "./engine_service_pb.js";
 
 /**
  * @generated from service engine_api.EngineService
@@ -38,13 +38,13 @@ export declare const EngineService: {
       readonly kind: MethodKind.Unary,
     },
     /**
-     * Returns information about the requested enclaves or all enclaves if none specified.
+     * Returns information about the enclaves matching the label selector, or all enclaves if none specified.
      *
      * @generated from rpc engine_api.EngineService.GetEnclaves
      */
     readonly getEnclaves: {
       readonly name: "GetEnclaves",
-      readonly I: typeof Empty,
+      readonly I: typeof GetEnclavesArgs,
       readonly O: typeof GetEnclavesResponse,
       readonly kind: MethodKind.Unary,
     },
@@ -92,6 +92,72 @@ export declare const EngineService: {
       readonly O: typeof Empty,
       readonly kind: MethodKind.Unary,
     },
+    /**
+     * Makes an enclave expire after the given time-to-live, counting from now
+     *
+     * @generated from rpc engine_api.EngineService.ExtendEnclaveTtl
+     */
+    readonly extendEnclaveTtl: {
+      readonly name: "ExtendEnclaveTtl",
+      readonly I: typeof ExtendEnclaveTtlArgs,
+      readonly O: typeof Empty,
+      readonly kind: MethodKind.Unary,
+    },
+    /**
+     * Sets and removes labels of an enclave
+     *
+     * @generated from rpc engine_api.EngineService.UpdateEnclaveLabels
+     */
+    readonly updateEnclaveLabels: {
+      readonly name: "UpdateEnclaveLabels",
+      readonly I: typeof UpdateEnclaveLabelsArgs,
+      readonly O: typeof Empty,
+      readonly kind: MethodKind.Unary,
+    },
+    /**
+     * Changes the name of an existing enclave, keeping its UUID
+     *
+     * @generated from rpc engine_api.EngineService.RenameEnclave
+     */
+    readonly renameEnclave: {
+      readonly name: "RenameEnclave",
+      readonly I: typeof RenameEnclaveArgs,
+      readonly O: typeof Empty,
+      readonly kind: MethodKind.Unary,
+    },
+    /**
+     * Creates a new enclave with the files artifacts, persistent directories and services of an existing one
+     *
+     * @generated from rpc engine_api.EngineService.CloneEnclave
+     */
+    readonly cloneEnclave: {
+      readonly name: "CloneEnclave",
+      readonly I: typeof CloneEnclaveArgs,
+      readonly O: typeof CloneEnclaveResponse,
+      readonly kind: MethodKind.Unary,
+    },
+    /**
+     * Streams an archive of an enclave, with its plan, files artifacts, persistent directories and mode, that ImportEnclave recreates it from
+     *
+     * @generated from rpc engine_api.EngineService.ExportEnclave
+     */
+    readonly exportEnclave: {
+      readonly name: "ExportEnclave",
+      readonly I: typeof ExportEnclaveArgs,
+      readonly O: typeof EnclaveArchiveChunk,
+      readonly kind: MethodKind.ServerStreaming,
+    },
+    /**
+     * Creates an enclave from an archive streamed by ExportEnclave, possibly on another engine
+     *
+     * @generated from rpc engine_api.EngineService.ImportEnclave
+     */
+    readonly importEnclave: {
+      readonly name: "ImportEnclave",
+      readonly I: typeof ImportEnclaveArgs,
+      readonly O: typeof ImportEnclaveResponse,
+      readonly kind: MethodKind.ClientStreaming,
+    },
     /**
      * Gets rid of old enclaves
      *
@@ -114,6 +180,61 @@ export declare const EngineService: {
       readonly O: typeof GetServiceLogsResponse,
       readonly kind: MethodKind.ServerStreaming,
     },
+    /**
+     * Streams what happens to the enclaves of the engine, starting from when the stream is opened
+     *
+     * @generated from rpc engine_api.EngineService.WatchEvents
+     */
+    readonly watchEvents: {
+      readonly name: "WatchEvents",
+      readonly I: typeof WatchEventsArgs,
+      readonly O: typeof EngineEvent,
+      readonly kind: MethodKind.ServerStreaming,
+    },
+    /**
+     * Creates an API token, only available when the engine enforces API authentication
+     *
+     * @generated from rpc engine_api.EngineService.CreateApiToken
+     */
+    readonly createApiToken: {
+      readonly name: "CreateApiToken",
+      readonly I: typeof CreateApiTokenArgs,
+      readonly O: typeof CreateApiTokenResponse,
+      readonly kind: MethodKind.Unary,
+    },
+    /**
+     * Revokes an API token so that it can't be used anymore
+     *
+     * @generated from rpc engine_api.EngineService.RevokeApiToken
+     */
+    readonly revokeApiToken: {
+      readonly name: "RevokeApiToken",
+      readonly I: typeof RevokeApiTokenArgs,
+      readonly O: typeof Empty,
+      readonly kind: MethodKind.Unary,
+    },
+    /**
+     * Gets the changes made through the engine and the API containers of its running enclaves, newest first
+     *
+     * @generated from rpc engine_api.EngineService.GetAuditLog
+     */
+    readonly getAuditLog: {
+      readonly name: "GetAuditLog",
+      readonly I: typeof GetAuditLogArgs,
+      readonly O: typeof GetAuditLogResponse,
+      readonly kind: MethodKind.Unary,
+    },
+    /**
+     * Gets a credential proving who the caller is to the API container of an enclave, so that the calls it makes to the API container directly are attributed to it in the audit log
+     *
+     * @generated from rpc engine_api.EngineService.GetEnclaveCallerCredential
+     */
+    readonly getEnclaveCallerCredential: {
+      readonly name: "GetEnclaveCallerCredential",
+      readonly I: typeof GetEnclaveCallerCredentialArgs,
+      readonly O: typeof GetEnclaveCallerCredentialResponse,
+      readonly kind: MethodKind.Unary,
+    },
   }
 };
 
@@ -4,7 +4,7 @@
 // @ts-nocheck
 
 import { Empty, MethodKind } from "@bufbuild/protobuf";
-import { CleanArgs, CleanResponse, CreateEnclaveArgs, CreateEnclaveResponse, DestroyEnclaveArgs, GetEnclavesByUuidsArgs, GetEnclavesResponse, GetEngineInfoResponse, GetExistingAndHistoricalEnclaveIdentifiersResponse, GetServiceLogsArgs, GetServiceLogsResponse, StopEnclaveArgs } from "./engine_service_pb.js";
+import { CleanArgs, CleanResponse, CloneEnclaveArgs, CloneEnclaveResponse, CreateApiTokenArgs, CreateApiTokenResponse, CreateEnclaveArgs, CreateEnclaveResponse, DestroyEnclaveArgs, EnclaveArchiveChunk, EngineEvent, ExportEnclaveArgs, ExtendEnclaveTtlArgs, GetAuditLogArgs, GetAuditLogResponse, GetEnclaveCallerCredentialArgs, GetEnclaveCallerCredentialResponse, GetEnclavesArgs, GetEnclavesByUuidsArgs, GetEnclavesResponse, GetEngineInfoResponse, GetExistingAndHistoricalEnclaveIdentifiersResponse, GetServiceLogsArgs, GetServiceLogsResponse, ImportEnclaveArgs, ImportEnclaveResponse, RenameEnclaveArgs, RevokeApiTokenArgs, StopEnclaveArgs, UpdateEnclaveLabelsArgs, WatchEventsArgs } from "./engine_service_pb.js";
 
 /**
  * @generated from service engine_api.EngineService
@@ -38,13 +38,13 @@ export const EngineService = {
       kind: MethodKind.Unary,
     },
     /**
-     * Returns information about the requested enclaves or all enclaves if none specified.
+     * Returns information about the enclaves matching the label selector, or all enclaves if none specified.
      *
      * @generated from rpc engine_api.EngineService.GetEnclaves
      */
     getEnclaves: {
       name: "GetEnclaves",
-      I: Empty,
+      I: GetEnclavesArgs,
       O: GetEnclavesResponse,
       kind: MethodKind.Unary,
     },
@@ -92,6 +92,72 @@ export const EngineService = {
       O: Empty,
       kind: MethodKind.Unary,
     },
+    /**
+     * Makes an enclave expire after the given time-to-live, counting from now
+     *
+     * @generated from rpc engine_api.EngineService.ExtendEnclaveTtl
+     */
+    extendEnclaveTtl: {
+      name: "ExtendEnclaveTtl",
+      I: ExtendEnclaveTtlArgs,
+      O: Empty,
+      kind: MethodKind.Unary,
+    },
+    /**
+     * Sets and removes labels of an enclave
+     *
+     * @generated from rpc engine_api.EngineService.UpdateEnclaveLabels
+     */
+    updateEnclaveLabels: {
+      name: "UpdateEnclaveLabels",
+      I: UpdateEnclaveLabelsArgs,
+      O: Empty,
+      kind: MethodKind.Unary,
+    },
+    /**
+     * Changes the name of an existing enclave, keeping its UUID
+     *
+     * @generated from rpc engine_api.EngineService.RenameEnclave
+     */
+    renameEnclave: {
+      name: "RenameEnclave",
+      I: RenameEnclaveArgs,
+      O: Empty,
+      kind: MethodKind.Unary,
+    },
+    /**
+     * Creates a new enclave with the files artifacts, persistent directories and services of an existing one
+     *
+     * @generated from rpc engine_api.EngineService.CloneEnclave
+     */
+    cloneEnclave: {
+      name: "CloneEnclave",
+      I: CloneEnclaveArgs,
+      O: CloneEnclaveResponse,
+      kind: MethodKind.Unary,
+    },
+    /**
+     * Streams an archive of an enclave, with its plan, files artifacts, persistent directories and mode, that ImportEnclave recreates it from
+     *
+     * @generated from rpc engine_api.EngineService.ExportEnclave
+     */
+    exportEnclave: {
+      name: "ExportEnclave",
+      I: ExportEnclaveArgs,
+      O: EnclaveArchiveChunk,
+      kind: MethodKind.ServerStreaming,
+    },
+    /**
+     * Creates an enclave from an archive streamed by ExportEnclave, possibly on another engine
+     *
+     * @generated from rpc engine_api.EngineService.ImportEnclave
+     */
+    importEnclave: {
+      name: "ImportEnclave",
+      I: ImportEnclaveArgs,
+      O: ImportEnclaveResponse,
+      kind: MethodKind.ClientStreaming,
+    },
     /**
      * Gets rid of old enclaves
      *
@@ -114,6 +180,61 @@ export const EngineService = {
       O: GetServiceLogsResponse,
       kind: MethodKind.ServerStreaming,
     },
+    /**
+     * Streams what happens to the enclaves of the engine, starting from when the stream is opened
+     *
+     * @generated from rpc engine_api.EngineService.WatchEvents
+     */
+    watchEvents: {
+      name: "WatchEvents",
+      I: WatchEventsArgs,
+      O: EngineEvent,
+      kind: MethodKind.ServerStreaming,
+    },
+    /**
+     * Creates an API token, only available when the engine enforces API authentication
+     *
+     * @generated from rpc engine_api.EngineService.CreateApiToken
+     */
+    createApiToken: {
+      name: "CreateApiToken",
+      I: CreateApiTokenArgs,
+      O: CreateApiTokenResponse,
+      kind: MethodKind.Unary,
+    },
+    /**
+     * Revokes an API token so that it can't be used anymore
+     *
+     * @generated from rpc engine_api.EngineService.RevokeApiToken
+     */
+    revokeApiToken: {
+      name: "RevokeApiToken",
+      I: RevokeApiTokenArgs,
+      O: Empty,
+      kind: MethodKind.Unary,
+    },
+    /**
+     * Gets the changes made through the engine and the API containers of its running enclaves, newest first
+     *
+     * @generated from rpc engine_api.EngineService.GetAuditLog
+     */
+    getAuditLog: {
+      name: "GetAuditLog",
+      I: GetAuditLogArgs,
+      O: GetAuditLogResponse,
+      kind: MethodKind.Unary,
+    },
+    /**
+     * Gets a credential proving who the caller is to the API container of an enclave, so that the calls it makes to the API container directly are attributed to it in the audit log
+     *
+     * @generated from rpc engine_api.EngineService.GetEnclaveCallerCredential
+     */
+    getEnclaveCallerCredential: {
+      name: "GetEnclaveCallerCredential",
+      I: GetEnclaveCallerCredentialArgs,
+      O: GetEnclaveCallerCredentialResponse,
+      kind: MethodKind.Unary,
+    },
   }
 };
 
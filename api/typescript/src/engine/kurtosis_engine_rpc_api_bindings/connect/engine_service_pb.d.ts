@@ -21,6 +21,23 @@ export declare enum EnclaveMode {
   PRODUCTION = 1,
 }
 
+/**
+ * NOTE: We have to prefix the enum values with the enum name due to the way Protobuf enum value uniqueness works
+ *
+ * @generated from enum engine_api.ExpiredEnclaveAction
+ */
+export declare enum ExpiredEnclaveAction {
+  /**
+   * @generated from enum value: ExpiredEnclaveAction_DESTROY = 0;
+   */
+  ExpiredEnclaveAction_DESTROY = 0,
+
+  /**
+   * @generated from enum value: ExpiredEnclaveAction_STOP = 1;
+   */
+  ExpiredEnclaveAction_STOP = 1,
+}
+
 /**
  * ==============================================================================================
  *                                            Get Enclaves
@@ -112,6 +129,92 @@ export declare enum LogLineOperator {
   LogLineOperator_DOES_NOT_CONTAIN_MATCH_REGEX = 3,
 }
 
+/**
+ * ==============================================================================================
+ *                                         Watch Events
+ * ==============================================================================================
+ * NOTE: We have to prefix the enum values with the enum name due to the way Protobuf enum value uniqueness works
+ *
+ * @generated from enum engine_api.EngineEventType
+ */
+export declare enum EngineEventType {
+  /**
+   * @generated from enum value: EngineEventType_ENCLAVE_CREATED = 0;
+   */
+  EngineEventType_ENCLAVE_CREATED = 0,
+
+  /**
+   * @generated from enum value: EngineEventType_ENCLAVE_STOPPED = 1;
+   */
+  EngineEventType_ENCLAVE_STOPPED = 1,
+
+  /**
+   * @generated from enum value: EngineEventType_ENCLAVE_DESTROYED = 2;
+   */
+  EngineEventType_ENCLAVE_DESTROYED = 2,
+
+  /**
+   * @generated from enum value: EngineEventType_STARLARK_RUN_STARTED = 3;
+   */
+  EngineEventType_STARLARK_RUN_STARTED = 3,
+
+  /**
+   * @generated from enum value: EngineEventType_STARLARK_RUN_FINISHED = 4;
+   */
+  EngineEventType_STARLARK_RUN_FINISHED = 4,
+
+  /**
+   * @generated from enum value: EngineEventType_SERVICE_ADDED = 5;
+   */
+  EngineEventType_SERVICE_ADDED = 5,
+
+  /**
+   * @generated from enum value: EngineEventType_SERVICE_STOPPED = 6;
+   */
+  EngineEventType_SERVICE_STOPPED = 6,
+
+  /**
+   * @generated from enum value: EngineEventType_SERVICE_REMOVED = 7;
+   */
+  EngineEventType_SERVICE_REMOVED = 7,
+
+  /**
+   * @generated from enum value: EngineEventType_API_CONTAINER_RESTARTED = 8;
+   */
+  EngineEventType_API_CONTAINER_RESTARTED = 8,
+}
+
+/**
+ * ==============================================================================================
+ *                                          API Tokens
+ * ==============================================================================================
+ * NOTE: We have to prefix the enum values with the enum name due to the way Protobuf enum value uniqueness works
+ *
+ * @generated from enum engine_api.ApiTokenRole
+ */
+export declare enum ApiTokenRole {
+  /**
+   * Can only call the RPCs that don't change anything
+   *
+   * @generated from enum value: ApiTokenRole_READ_ONLY = 0;
+   */
+  ApiTokenRole_READ_ONLY = 0,
+
+  /**
+   * Can also create, stop and destroy enclaves
+   *
+   * @generated from enum value: ApiTokenRole_OPERATOR = 1;
+   */
+  ApiTokenRole_OPERATOR = 1,
+
+  /**
+   * Can call every RPC, including cleaning the engine and managing the API tokens
+   *
+   * @generated from enum value: ApiTokenRole_ADMIN = 2;
+   */
+  ApiTokenRole_ADMIN = 2,
+}
+
 /**
  * ==============================================================================================
  *                                        Get Engine Info
@@ -127,6 +230,41 @@ export declare class GetEngineInfoResponse extends Message<GetEngineInfoResponse
    */
   engineVersion: string;
 
+  /**
+   * How long the engine keeps the service logs for, as a Go duration
+   *
+   * @generated from field: string log_retention_period = 2;
+   */
+  logRetentionPeriod: string;
+
+  /**
+   * Log level the engine server logs at
+   *
+   * @generated from field: string log_level = 3;
+   */
+  logLevel: string;
+
+  /**
+   * Number of idle enclaves the engine keeps in its pool
+   *
+   * @generated from field: uint32 pool_size = 4;
+   */
+  poolSize: number;
+
+  /**
+   * Whether the engine got started with its debug server
+   *
+   * @generated from field: bool is_debug_mode_enabled = 5;
+   */
+  isDebugModeEnabled: boolean;
+
+  /**
+   * Domain of the enclave manager UI
+   *
+   * @generated from field: string domain = 6;
+   */
+  domain: string;
+
   constructor(data?: PartialMessage<GetEngineInfoResponse>);
 
   static readonly runtime: typeof proto3;
@@ -185,6 +323,51 @@ export declare class CreateEnclaveArgs extends Message<CreateEnclaveArgs> {
    */
   shouldApicRunInDebugMode?: boolean;
 
+  /**
+   * How long the enclave lives for before it expires
+   * If unset, the enclave doesn't expire with age
+   *
+   * @generated from field: optional uint32 ttl_seconds = 6;
+   */
+  ttlSeconds?: number;
+
+  /**
+   * How long the enclave can go without its API container serving any request before it expires
+   * If unset, the enclave doesn't expire for being idle
+   *
+   * @generated from field: optional uint32 idle_timeout_seconds = 7;
+   */
+  idleTimeoutSeconds?: number;
+
+  /**
+   * What happens to the enclave once it expires, the default being to destroy it
+   *
+   * @generated from field: optional engine_api.ExpiredEnclaveAction expired_enclave_action = 8;
+   */
+  expiredEnclaveAction?: ExpiredEnclaveAction;
+
+  /**
+   * Arbitrary key/value labels attached to the enclave, e.g. the team owning it or the commit it was created for
+   *
+   * @generated from field: map<string, string> labels = 9;
+   */
+  labels: { [key: string]: string };
+
+  /**
+   * A package to run in the enclave before returning it, e.g. the base package every test starts by deploying
+   * The enclave is handed out from the engine's warm pool if its enclaves were pre-seeded with the same package and params
+   *
+   * @generated from field: optional string seed_package_locator = 10;
+   */
+  seedPackageLocator?: string;
+
+  /**
+   * The serialized JSON params of the seed package, ignored if there's no seed package
+   *
+   * @generated from field: optional string seed_package_params = 11;
+   */
+  seedPackageParams?: string;
+
   constructor(data?: PartialMessage<CreateEnclaveArgs>);
 
   static readonly runtime: typeof proto3;
@@ -375,6 +558,20 @@ export declare class EnclaveInfo extends Message<EnclaveInfo> {
    */
   mode: EnclaveMode;
 
+  /**
+   * NOTE: Will not be present if the enclave never expires!!
+   *
+   * @generated from field: engine_api.EnclaveExpiration expiration = 10;
+   */
+  expiration?: EnclaveExpiration;
+
+  /**
+   * The key/value labels attached to the enclave
+   *
+   * @generated from field: map<string, string> labels = 11;
+   */
+  labels: { [key: string]: string };
+
   constructor(data?: PartialMessage<EnclaveInfo>);
 
   static readonly runtime: typeof proto3;
@@ -390,6 +587,70 @@ export declare class EnclaveInfo extends Message<EnclaveInfo> {
   static equals(a: EnclaveInfo | PlainMessage<EnclaveInfo> | undefined, b: EnclaveInfo | PlainMessage<EnclaveInfo> | undefined): boolean;
 }
 
+/**
+ * @generated from message engine_api.EnclaveExpiration
+ */
+export declare class EnclaveExpiration extends Message<EnclaveExpiration> {
+  /**
+   * NOTE: Will not be present if the enclave doesn't expire with age
+   *
+   * @generated from field: google.protobuf.Timestamp expiration_time = 1;
+   */
+  expirationTime?: Timestamp;
+
+  /**
+   * NOTE: Will not be present if the enclave doesn't expire for being idle
+   *
+   * @generated from field: optional uint32 idle_timeout_seconds = 2;
+   */
+  idleTimeoutSeconds?: number;
+
+  /**
+   * @generated from field: engine_api.ExpiredEnclaveAction action = 3;
+   */
+  action: ExpiredEnclaveAction;
+
+  constructor(data?: PartialMessage<EnclaveExpiration>);
+
+  static readonly runtime: typeof proto3;
+  static readonly typeName = "engine_api.EnclaveExpiration";
+  static readonly fields: FieldList;
+
+  static fromBinary(bytes: Uint8Array, options?: Partial<BinaryReadOptions>): EnclaveExpiration;
+
+  static fromJson(jsonValue: JsonValue, options?: Partial<JsonReadOptions>): EnclaveExpiration;
+
+  static fromJsonString(jsonString: string, options?: Partial<JsonReadOptions>): EnclaveExpiration;
+
+  static equals(a: EnclaveExpiration | PlainMessage<EnclaveExpiration> | undefined, b: EnclaveExpiration | PlainMessage<EnclaveExpiration> | undefined): boolean;
+}
+
+/**
+ * @generated from message engine_api.GetEnclavesArgs
+ */
+export declare class GetEnclavesArgs extends Message<GetEnclavesArgs> {
+  /**
+   * Only the enclaves having all of these labels are returned; an empty selector matches all enclaves
+   *
+   * @generated from field: map<string, string> label_selector = 1;
+   */
+  labelSelector: { [key: string]: string };
+
+  constructor(data?: PartialMessage<GetEnclavesArgs>);
+
+  static readonly runtime: typeof proto3;
+  static readonly typeName = "engine_api.GetEnclavesArgs";
+  static readonly fields: FieldList;
+
+  static fromBinary(bytes: Uint8Array, options?: Partial<BinaryReadOptions>): GetEnclavesArgs;
+
+  static fromJson(jsonValue: JsonValue, options?: Partial<JsonReadOptions>): GetEnclavesArgs;
+
+  static fromJsonString(jsonString: string, options?: Partial<JsonReadOptions>): GetEnclavesArgs;
+
+  static equals(a: GetEnclavesArgs | PlainMessage<GetEnclavesArgs> | undefined, b: GetEnclavesArgs | PlainMessage<GetEnclavesArgs> | undefined): boolean;
+}
+
 /**
  * @generated from message engine_api.GetEnclavesResponse
  */
@@ -514,159 +775,488 @@ export declare class StopEnclaveArgs extends Message<StopEnclaveArgs> {
 
 /**
  * ==============================================================================================
- *                                       Get Enclaves
+ *                                       Extend Enclave TTL
  * ==============================================================================================
  *
- * @generated from message engine_api.GetEnclavesByUuidsArgs
+ * @generated from message engine_api.ExtendEnclaveTtlArgs
  */
-export declare class GetEnclavesByUuidsArgs extends Message<GetEnclavesByUuidsArgs> {
+export declare class ExtendEnclaveTtlArgs extends Message<ExtendEnclaveTtlArgs> {
   /**
-   * The enclave uuid of the Kurtosis enclaves to get. An empty list of enclave uuids will retrieve all enclaves.
+   * The identifier(uuid, shortened uuid, name) of the Kurtosis enclave to extend the time-to-live of
    *
-   * @generated from field: repeated string enclave_uuids = 1;
+   * @generated from field: string enclave_identifier = 1;
    */
-  enclaveUuids: string[];
+  enclaveIdentifier: string;
 
-  constructor(data?: PartialMessage<GetEnclavesByUuidsArgs>);
+  /**
+   * The new time-to-live of the enclave, counting from now
+   *
+   * @generated from field: uint32 ttl_seconds = 2;
+   */
+  ttlSeconds: number;
+
+  constructor(data?: PartialMessage<ExtendEnclaveTtlArgs>);
 
   static readonly runtime: typeof proto3;
-  static readonly typeName = "engine_api.GetEnclavesByUuidsArgs";
+  static readonly typeName = "engine_api.ExtendEnclaveTtlArgs";
   static readonly fields: FieldList;
 
-  static fromBinary(bytes: Uint8Array, options?: Partial<BinaryReadOptions>): GetEnclavesByUuidsArgs;
+  static fromBinary(bytes: Uint8Array, options?: Partial<BinaryReadOptions>): ExtendEnclaveTtlArgs;
 
-  static fromJson(jsonValue: JsonValue, options?: Partial<JsonReadOptions>): GetEnclavesByUuidsArgs;
+  static fromJson(jsonValue: JsonValue, options?: Partial<JsonReadOptions>): ExtendEnclaveTtlArgs;
 
-  static fromJsonString(jsonString: string, options?: Partial<JsonReadOptions>): GetEnclavesByUuidsArgs;
+  static fromJsonString(jsonString: string, options?: Partial<JsonReadOptions>): ExtendEnclaveTtlArgs;
 
-  static equals(a: GetEnclavesByUuidsArgs | PlainMessage<GetEnclavesByUuidsArgs> | undefined, b: GetEnclavesByUuidsArgs | PlainMessage<GetEnclavesByUuidsArgs> | undefined): boolean;
+  static equals(a: ExtendEnclaveTtlArgs | PlainMessage<ExtendEnclaveTtlArgs> | undefined, b: ExtendEnclaveTtlArgs | PlainMessage<ExtendEnclaveTtlArgs> | undefined): boolean;
 }
 
 /**
  * ==============================================================================================
- *                                       Destroy Enclave
+ *                                       Update Enclave Labels
  * ==============================================================================================
  *
- * @generated from message engine_api.DestroyEnclaveArgs
+ * @generated from message engine_api.UpdateEnclaveLabelsArgs
  */
-export declare class DestroyEnclaveArgs extends Message<DestroyEnclaveArgs> {
+export declare class UpdateEnclaveLabelsArgs extends Message<UpdateEnclaveLabelsArgs> {
   /**
-   * The identifier(uuid, shortened uuid, name) of the Kurtosis enclave to destroy
+   * The identifier(uuid, shortened uuid, name) of the Kurtosis enclave to update the labels of
    *
    * @generated from field: string enclave_identifier = 1;
    */
   enclaveIdentifier: string;
 
-  constructor(data?: PartialMessage<DestroyEnclaveArgs>);
+  /**
+   * Labels to add to the enclave, overwriting the value of the ones it already has
+   *
+   * @generated from field: map<string, string> labels_to_set = 2;
+   */
+  labelsToSet: { [key: string]: string };
+
+  /**
+   * Keys of the labels to remove from the enclave
+   *
+   * @generated from field: repeated string labels_to_remove = 3;
+   */
+  labelsToRemove: string[];
+
+  constructor(data?: PartialMessage<UpdateEnclaveLabelsArgs>);
 
   static readonly runtime: typeof proto3;
-  static readonly typeName = "engine_api.DestroyEnclaveArgs";
+  static readonly typeName = "engine_api.UpdateEnclaveLabelsArgs";
   static readonly fields: FieldList;
 
-  static fromBinary(bytes: Uint8Array, options?: Partial<BinaryReadOptions>): DestroyEnclaveArgs;
+  static fromBinary(bytes: Uint8Array, options?: Partial<BinaryReadOptions>): UpdateEnclaveLabelsArgs;
 
-  static fromJson(jsonValue: JsonValue, options?: Partial<JsonReadOptions>): DestroyEnclaveArgs;
+  static fromJson(jsonValue: JsonValue, options?: Partial<JsonReadOptions>): UpdateEnclaveLabelsArgs;
 
-  static fromJsonString(jsonString: string, options?: Partial<JsonReadOptions>): DestroyEnclaveArgs;
+  static fromJsonString(jsonString: string, options?: Partial<JsonReadOptions>): UpdateEnclaveLabelsArgs;
 
-  static equals(a: DestroyEnclaveArgs | PlainMessage<DestroyEnclaveArgs> | undefined, b: DestroyEnclaveArgs | PlainMessage<DestroyEnclaveArgs> | undefined): boolean;
+  static equals(a: UpdateEnclaveLabelsArgs | PlainMessage<UpdateEnclaveLabelsArgs> | undefined, b: UpdateEnclaveLabelsArgs | PlainMessage<UpdateEnclaveLabelsArgs> | undefined): boolean;
 }
 
 /**
  * ==============================================================================================
- *                                       Create Enclave
+ *                                       Rename Enclave
  * ==============================================================================================
  *
- * @generated from message engine_api.CleanArgs
+ * @generated from message engine_api.RenameEnclaveArgs
  */
-export declare class CleanArgs extends Message<CleanArgs> {
+export declare class RenameEnclaveArgs extends Message<RenameEnclaveArgs> {
   /**
-   * If true, It will clean even the running enclaves
+   * The identifier(uuid, shortened uuid, name) of the Kurtosis enclave to rename
    *
-   * @generated from field: optional bool should_clean_all = 1;
+   * @generated from field: string enclave_identifier = 1;
    */
-  shouldCleanAll?: boolean;
+  enclaveIdentifier: string;
 
-  constructor(data?: PartialMessage<CleanArgs>);
+  /**
+   * The new name of the enclave, which no other existing enclave can have
+   *
+   * @generated from field: string new_name = 2;
+   */
+  newName: string;
+
+  constructor(data?: PartialMessage<RenameEnclaveArgs>);
 
   static readonly runtime: typeof proto3;
-  static readonly typeName = "engine_api.CleanArgs";
+  static readonly typeName = "engine_api.RenameEnclaveArgs";
   static readonly fields: FieldList;
 
-  static fromBinary(bytes: Uint8Array, options?: Partial<BinaryReadOptions>): CleanArgs;
+  static fromBinary(bytes: Uint8Array, options?: Partial<BinaryReadOptions>): RenameEnclaveArgs;
 
-  static fromJson(jsonValue: JsonValue, options?: Partial<JsonReadOptions>): CleanArgs;
+  static fromJson(jsonValue: JsonValue, options?: Partial<JsonReadOptions>): RenameEnclaveArgs;
 
-  static fromJsonString(jsonString: string, options?: Partial<JsonReadOptions>): CleanArgs;
+  static fromJsonString(jsonString: string, options?: Partial<JsonReadOptions>): RenameEnclaveArgs;
 
-  static equals(a: CleanArgs | PlainMessage<CleanArgs> | undefined, b: CleanArgs | PlainMessage<CleanArgs> | undefined): boolean;
+  static equals(a: RenameEnclaveArgs | PlainMessage<RenameEnclaveArgs> | undefined, b: RenameEnclaveArgs | PlainMessage<RenameEnclaveArgs> | undefined): boolean;
 }
 
 /**
- * @generated from message engine_api.EnclaveNameAndUuid
+ * ==============================================================================================
+ *                                       Clone Enclave
+ * ==============================================================================================
+ *
+ * @generated from message engine_api.CloneEnclaveArgs
  */
-export declare class EnclaveNameAndUuid extends Message<EnclaveNameAndUuid> {
+export declare class CloneEnclaveArgs extends Message<CloneEnclaveArgs> {
   /**
-   * @generated from field: string name = 1;
+   * The identifier(uuid, shortened uuid, name) of the Kurtosis enclave to clone
+   *
+   * @generated from field: string enclave_identifier = 1;
    */
-  name: string;
+  enclaveIdentifier: string;
 
   /**
-   * @generated from field: string uuid = 2;
+   * The name of the new enclave, a random one is used if empty
+   *
+   * @generated from field: optional string enclave_name = 2;
    */
-  uuid: string;
+  enclaveName?: string;
 
-  constructor(data?: PartialMessage<EnclaveNameAndUuid>);
+  /**
+   * Whether to still clone the enclave when some instructions of its plan can't be replayed, leaving out the services they'd create
+   *
+   * @generated from field: optional bool allow_partial = 3;
+   */
+  allowPartial?: boolean;
+
+  constructor(data?: PartialMessage<CloneEnclaveArgs>);
 
   static readonly runtime: typeof proto3;
-  static readonly typeName = "engine_api.EnclaveNameAndUuid";
+  static readonly typeName = "engine_api.CloneEnclaveArgs";
   static readonly fields: FieldList;
 
-  static fromBinary(bytes: Uint8Array, options?: Partial<BinaryReadOptions>): EnclaveNameAndUuid;
+  static fromBinary(bytes: Uint8Array, options?: Partial<BinaryReadOptions>): CloneEnclaveArgs;
 
-  static fromJson(jsonValue: JsonValue, options?: Partial<JsonReadOptions>): EnclaveNameAndUuid;
+  static fromJson(jsonValue: JsonValue, options?: Partial<JsonReadOptions>): CloneEnclaveArgs;
 
-  static fromJsonString(jsonString: string, options?: Partial<JsonReadOptions>): EnclaveNameAndUuid;
+  static fromJsonString(jsonString: string, options?: Partial<JsonReadOptions>): CloneEnclaveArgs;
 
-  static equals(a: EnclaveNameAndUuid | PlainMessage<EnclaveNameAndUuid> | undefined, b: EnclaveNameAndUuid | PlainMessage<EnclaveNameAndUuid> | undefined): boolean;
+  static equals(a: CloneEnclaveArgs | PlainMessage<CloneEnclaveArgs> | undefined, b: CloneEnclaveArgs | PlainMessage<CloneEnclaveArgs> | undefined): boolean;
 }
 
 /**
- * @generated from message engine_api.CleanResponse
+ * @generated from message engine_api.CloneEnclaveResponse
  */
-export declare class CleanResponse extends Message<CleanResponse> {
+export declare class CloneEnclaveResponse extends Message<CloneEnclaveResponse> {
   /**
-   * removed enclave name and uuids
+   * The information of the new enclave
    *
-   * @generated from field: repeated engine_api.EnclaveNameAndUuid removed_enclave_name_and_uuids = 1;
+   * @generated from field: engine_api.EnclaveInfo enclave_info = 1;
    */
-  removedEnclaveNameAndUuids: EnclaveNameAndUuid[];
+  enclaveInfo?: EnclaveInfo;
 
-  constructor(data?: PartialMessage<CleanResponse>);
+  /**
+   * What couldn't be copied to the new enclave, e.g. services whose state isn't persisted
+   *
+   * @generated from field: repeated string uncloneable_items = 2;
+   */
+  uncloneableItems: string[];
+
+  /**
+   * What may differ between the new enclave and the original one, e.g. persistent directories copied while their services were running
+   *
+   * @generated from field: repeated string warnings = 3;
+   */
+  warnings: string[];
+
+  constructor(data?: PartialMessage<CloneEnclaveResponse>);
 
   static readonly runtime: typeof proto3;
-  static readonly typeName = "engine_api.CleanResponse";
+  static readonly typeName = "engine_api.CloneEnclaveResponse";
   static readonly fields: FieldList;
 
-  static fromBinary(bytes: Uint8Array, options?: Partial<BinaryReadOptions>): CleanResponse;
+  static fromBinary(bytes: Uint8Array, options?: Partial<BinaryReadOptions>): CloneEnclaveResponse;
 
-  static fromJson(jsonValue: JsonValue, options?: Partial<JsonReadOptions>): CleanResponse;
+  static fromJson(jsonValue: JsonValue, options?: Partial<JsonReadOptions>): CloneEnclaveResponse;
 
-  static fromJsonString(jsonString: string, options?: Partial<JsonReadOptions>): CleanResponse;
+  static fromJsonString(jsonString: string, options?: Partial<JsonReadOptions>): CloneEnclaveResponse;
 
-  static equals(a: CleanResponse | PlainMessage<CleanResponse> | undefined, b: CleanResponse | PlainMessage<CleanResponse> | undefined): boolean;
+  static equals(a: CloneEnclaveResponse | PlainMessage<CloneEnclaveResponse> | undefined, b: CloneEnclaveResponse | PlainMessage<CloneEnclaveResponse> | undefined): boolean;
 }
 
 /**
  * ==============================================================================================
- *                                   Get User Service Logs
+ *                                   Export & Import Enclave
  * ==============================================================================================
  *
- * @generated from message engine_api.GetServiceLogsArgs
+ * @generated from message engine_api.ExportEnclaveArgs
  */
-export declare class GetServiceLogsArgs extends Message<GetServiceLogsArgs> {
+export declare class ExportEnclaveArgs extends Message<ExportEnclaveArgs> {
   /**
-   * The identifier of the user service's Kurtosis Enclave
+   * The identifier(uuid, shortened uuid, name) of the Kurtosis enclave to export
+   *
+   * @generated from field: string enclave_identifier = 1;
+   */
+  enclaveIdentifier: string;
+
+  constructor(data?: PartialMessage<ExportEnclaveArgs>);
+
+  static readonly runtime: typeof proto3;
+  static readonly typeName = "engine_api.ExportEnclaveArgs";
+  static readonly fields: FieldList;
+
+  static fromBinary(bytes: Uint8Array, options?: Partial<BinaryReadOptions>): ExportEnclaveArgs;
+
+  static fromJson(jsonValue: JsonValue, options?: Partial<JsonReadOptions>): ExportEnclaveArgs;
+
+  static fromJsonString(jsonString: string, options?: Partial<JsonReadOptions>): ExportEnclaveArgs;
+
+  static equals(a: ExportEnclaveArgs | PlainMessage<ExportEnclaveArgs> | undefined, b: ExportEnclaveArgs | PlainMessage<ExportEnclaveArgs> | undefined): boolean;
+}
+
+/**
+ * @generated from message engine_api.EnclaveArchiveChunk
+ */
+export declare class EnclaveArchiveChunk extends Message<EnclaveArchiveChunk> {
+  /**
+   * @generated from field: bytes data = 1;
+   */
+  data: Uint8Array;
+
+  constructor(data?: PartialMessage<EnclaveArchiveChunk>);
+
+  static readonly runtime: typeof proto3;
+  static readonly typeName = "engine_api.EnclaveArchiveChunk";
+  static readonly fields: FieldList;
+
+  static fromBinary(bytes: Uint8Array, options?: Partial<BinaryReadOptions>): EnclaveArchiveChunk;
+
+  static fromJson(jsonValue: JsonValue, options?: Partial<JsonReadOptions>): EnclaveArchiveChunk;
+
+  static fromJsonString(jsonString: string, options?: Partial<JsonReadOptions>): EnclaveArchiveChunk;
+
+  static equals(a: EnclaveArchiveChunk | PlainMessage<EnclaveArchiveChunk> | undefined, b: EnclaveArchiveChunk | PlainMessage<EnclaveArchiveChunk> | undefined): boolean;
+}
+
+/**
+ * @generated from message engine_api.ImportEnclaveArgs
+ */
+export declare class ImportEnclaveArgs extends Message<ImportEnclaveArgs> {
+  /**
+   * The name of the new enclave, the one the enclave had when exported is used if empty. Only read from the first message
+   *
+   * @generated from field: optional string enclave_name = 1;
+   */
+  enclaveName?: string;
+
+  /**
+   * The next chunk of the archive
+   *
+   * @generated from field: bytes data = 2;
+   */
+  data: Uint8Array;
+
+  constructor(data?: PartialMessage<ImportEnclaveArgs>);
+
+  static readonly runtime: typeof proto3;
+  static readonly typeName = "engine_api.ImportEnclaveArgs";
+  static readonly fields: FieldList;
+
+  static fromBinary(bytes: Uint8Array, options?: Partial<BinaryReadOptions>): ImportEnclaveArgs;
+
+  static fromJson(jsonValue: JsonValue, options?: Partial<JsonReadOptions>): ImportEnclaveArgs;
+
+  static fromJsonString(jsonString: string, options?: Partial<JsonReadOptions>): ImportEnclaveArgs;
+
+  static equals(a: ImportEnclaveArgs | PlainMessage<ImportEnclaveArgs> | undefined, b: ImportEnclaveArgs | PlainMessage<ImportEnclaveArgs> | undefined): boolean;
+}
+
+/**
+ * @generated from message engine_api.ImportEnclaveResponse
+ */
+export declare class ImportEnclaveResponse extends Message<ImportEnclaveResponse> {
+  /**
+   * The information of the new enclave
+   *
+   * @generated from field: engine_api.EnclaveInfo enclave_info = 1;
+   */
+  enclaveInfo?: EnclaveInfo;
+
+  /**
+   * What couldn't be recreated in the new enclave, e.g. instructions of the plan using values computed at runtime
+   *
+   * @generated from field: repeated string unimportable_items = 2;
+   */
+  unimportableItems: string[];
+
+  constructor(data?: PartialMessage<ImportEnclaveResponse>);
+
+  static readonly runtime: typeof proto3;
+  static readonly typeName = "engine_api.ImportEnclaveResponse";
+  static readonly fields: FieldList;
+
+  static fromBinary(bytes: Uint8Array, options?: Partial<BinaryReadOptions>): ImportEnclaveResponse;
+
+  static fromJson(jsonValue: JsonValue, options?: Partial<JsonReadOptions>): ImportEnclaveResponse;
+
+  static fromJsonString(jsonString: string, options?: Partial<JsonReadOptions>): ImportEnclaveResponse;
+
+  static equals(a: ImportEnclaveResponse | PlainMessage<ImportEnclaveResponse> | undefined, b: ImportEnclaveResponse | PlainMessage<ImportEnclaveResponse> | undefined): boolean;
+}
+
+/**
+ * ==============================================================================================
+ *                                       Get Enclaves
+ * ==============================================================================================
+ *
+ * @generated from message engine_api.GetEnclavesByUuidsArgs
+ */
+export declare class GetEnclavesByUuidsArgs extends Message<GetEnclavesByUuidsArgs> {
+  /**
+   * The enclave uuid of the Kurtosis enclaves to get. An empty list of enclave uuids will retrieve all enclaves.
+   *
+   * @generated from field: repeated string enclave_uuids = 1;
+   */
+  enclaveUuids: string[];
+
+  constructor(data?: PartialMessage<GetEnclavesByUuidsArgs>);
+
+  static readonly runtime: typeof proto3;
+  static readonly typeName = "engine_api.GetEnclavesByUuidsArgs";
+  static readonly fields: FieldList;
+
+  static fromBinary(bytes: Uint8Array, options?: Partial<BinaryReadOptions>): GetEnclavesByUuidsArgs;
+
+  static fromJson(jsonValue: JsonValue, options?: Partial<JsonReadOptions>): GetEnclavesByUuidsArgs;
+
+  static fromJsonString(jsonString: string, options?: Partial<JsonReadOptions>): GetEnclavesByUuidsArgs;
+
+  static equals(a: GetEnclavesByUuidsArgs | PlainMessage<GetEnclavesByUuidsArgs> | undefined, b: GetEnclavesByUuidsArgs | PlainMessage<GetEnclavesByUuidsArgs> | undefined): boolean;
+}
+
+/**
+ * ==============================================================================================
+ *                                       Destroy Enclave
+ * ==============================================================================================
+ *
+ * @generated from message engine_api.DestroyEnclaveArgs
+ */
+export declare class DestroyEnclaveArgs extends Message<DestroyEnclaveArgs> {
+  /**
+   * The identifier(uuid, shortened uuid, name) of the Kurtosis enclave to destroy
+   *
+   * @generated from field: string enclave_identifier = 1;
+   */
+  enclaveIdentifier: string;
+
+  constructor(data?: PartialMessage<DestroyEnclaveArgs>);
+
+  static readonly runtime: typeof proto3;
+  static readonly typeName = "engine_api.DestroyEnclaveArgs";
+  static readonly fields: FieldList;
+
+  static fromBinary(bytes: Uint8Array, options?: Partial<BinaryReadOptions>): DestroyEnclaveArgs;
+
+  static fromJson(jsonValue: JsonValue, options?: Partial<JsonReadOptions>): DestroyEnclaveArgs;
+
+  static fromJsonString(jsonString: string, options?: Partial<JsonReadOptions>): DestroyEnclaveArgs;
+
+  static equals(a: DestroyEnclaveArgs | PlainMessage<DestroyEnclaveArgs> | undefined, b: DestroyEnclaveArgs | PlainMessage<DestroyEnclaveArgs> | undefined): boolean;
+}
+
+/**
+ * ==============================================================================================
+ *                                       Create Enclave
+ * ==============================================================================================
+ *
+ * @generated from message engine_api.CleanArgs
+ */
+export declare class CleanArgs extends Message<CleanArgs> {
+  /**
+   * If true, It will clean even the running enclaves
+   *
+   * @generated from field: optional bool should_clean_all = 1;
+   */
+  shouldCleanAll?: boolean;
+
+  /**
+   * If not empty, only the enclaves having all of these labels get cleaned
+   *
+   * @generated from field: map<string, string> label_selector = 2;
+   */
+  labelSelector: { [key: string]: string };
+
+  constructor(data?: PartialMessage<CleanArgs>);
+
+  static readonly runtime: typeof proto3;
+  static readonly typeName = "engine_api.CleanArgs";
+  static readonly fields: FieldList;
+
+  static fromBinary(bytes: Uint8Array, options?: Partial<BinaryReadOptions>): CleanArgs;
+
+  static fromJson(jsonValue: JsonValue, options?: Partial<JsonReadOptions>): CleanArgs;
+
+  static fromJsonString(jsonString: string, options?: Partial<JsonReadOptions>): CleanArgs;
+
+  static equals(a: CleanArgs | PlainMessage<CleanArgs> | undefined, b: CleanArgs | PlainMessage<CleanArgs> | undefined): boolean;
+}
+
+/**
+ * @generated from message engine_api.EnclaveNameAndUuid
+ */
+export declare class EnclaveNameAndUuid extends Message<EnclaveNameAndUuid> {
+  /**
+   * @generated from field: string name = 1;
+   */
+  name: string;
+
+  /**
+   * @generated from field: string uuid = 2;
+   */
+  uuid: string;
+
+  constructor(data?: PartialMessage<EnclaveNameAndUuid>);
+
+  static readonly runtime: typeof proto3;
+  static readonly typeName = "engine_api.EnclaveNameAndUuid";
+  static readonly fields: FieldList;
+
+  static fromBinary(bytes: Uint8Array, options?: Partial<BinaryReadOptions>): EnclaveNameAndUuid;
+
+  static fromJson(jsonValue: JsonValue, options?: Partial<JsonReadOptions>): EnclaveNameAndUuid;
+
+  static fromJsonString(jsonString: string, options?: Partial<JsonReadOptions>): EnclaveNameAndUuid;
+
+  static equals(a: EnclaveNameAndUuid | PlainMessage<EnclaveNameAndUuid> | undefined, b: EnclaveNameAndUuid | PlainMessage<EnclaveNameAndUuid> | undefined): boolean;
+}
+
+/**
+ * @generated from message engine_api.CleanResponse
+ */
+export declare class CleanResponse extends Message<CleanResponse> {
+  /**
+   * removed enclave name and uuids
+   *
+   * @generated from field: repeated engine_api.EnclaveNameAndUuid removed_enclave_name_and_uuids = 1;
+   */
+  removedEnclaveNameAndUuids: EnclaveNameAndUuid[];
+
+  constructor(data?: PartialMessage<CleanResponse>);
+
+  static readonly runtime: typeof proto3;
+  static readonly typeName = "engine_api.CleanResponse";
+  static readonly fields: FieldList;
+
+  static fromBinary(bytes: Uint8Array, options?: Partial<BinaryReadOptions>): CleanResponse;
+
+  static fromJson(jsonValue: JsonValue, options?: Partial<JsonReadOptions>): CleanResponse;
+
+  static fromJsonString(jsonString: string, options?: Partial<JsonReadOptions>): CleanResponse;
+
+  static equals(a: CleanResponse | PlainMessage<CleanResponse> | undefined, b: CleanResponse | PlainMessage<CleanResponse> | undefined): boolean;
+}
+
+/**
+ * ==============================================================================================
+ *                                   Get User Service Logs
+ * ==============================================================================================
+ *
+ * @generated from message engine_api.GetServiceLogsArgs
+ */
+export declare class GetServiceLogsArgs extends Message<GetServiceLogsArgs> {
+  /**
+   * The identifier of the user service's Kurtosis Enclave
    *
    * @generated from field: string enclave_identifier = 1;
    */
@@ -814,3 +1404,378 @@ export declare class LogLineFilter extends Message<LogLineFilter> {
   static equals(a: LogLineFilter | PlainMessage<LogLineFilter> | undefined, b: LogLineFilter | PlainMessage<LogLineFilter> | undefined): boolean;
 }
 
+/**
+ * @generated from message engine_api.WatchEventsArgs
+ */
+export declare class WatchEventsArgs extends Message<WatchEventsArgs> {
+  /**
+   * The types of events to stream, all of them get streamed if empty
+   *
+   * @generated from field: repeated engine_api.EngineEventType event_types = 1;
+   */
+  eventTypes: EngineEventType[];
+
+  constructor(data?: PartialMessage<WatchEventsArgs>);
+
+  static readonly runtime: typeof proto3;
+  static readonly typeName = "engine_api.WatchEventsArgs";
+  static readonly fields: FieldList;
+
+  static fromBinary(bytes: Uint8Array, options?: Partial<BinaryReadOptions>): WatchEventsArgs;
+
+  static fromJson(jsonValue: JsonValue, options?: Partial<JsonReadOptions>): WatchEventsArgs;
+
+  static fromJsonString(jsonString: string, options?: Partial<JsonReadOptions>): WatchEventsArgs;
+
+  static equals(a: WatchEventsArgs | PlainMessage<WatchEventsArgs> | undefined, b: WatchEventsArgs | PlainMessage<WatchEventsArgs> | undefined): boolean;
+}
+
+/**
+ * @generated from message engine_api.EngineEvent
+ */
+export declare class EngineEvent extends Message<EngineEvent> {
+  /**
+   * @generated from field: engine_api.EngineEventType type = 1;
+   */
+  type: EngineEventType;
+
+  /**
+   * @generated from field: google.protobuf.Timestamp timestamp = 2;
+   */
+  timestamp?: Timestamp;
+
+  /**
+   * @generated from field: string enclave_uuid = 3;
+   */
+  enclaveUuid: string;
+
+  /**
+   * @generated from field: string enclave_name = 4;
+   */
+  enclaveName: string;
+
+  /**
+   * The service the event is about, only set for service events
+   *
+   * @generated from field: optional string service_name = 5;
+   */
+  serviceName?: string;
+
+  /**
+   * The package that was run, only set for Starlark run events of packages
+   *
+   * @generated from field: optional string starlark_package_id = 6;
+   */
+  starlarkPackageId?: string;
+
+  /**
+   * Whether the run succeeded, only set for STARLARK_RUN_FINISHED events
+   *
+   * @generated from field: optional bool starlark_run_succeeded = 7;
+   */
+  starlarkRunSucceeded?: boolean;
+
+  constructor(data?: PartialMessage<EngineEvent>);
+
+  static readonly runtime: typeof proto3;
+  static readonly typeName = "engine_api.EngineEvent";
+  static readonly fields: FieldList;
+
+  static fromBinary(bytes: Uint8Array, options?: Partial<BinaryReadOptions>): EngineEvent;
+
+  static fromJson(jsonValue: JsonValue, options?: Partial<JsonReadOptions>): EngineEvent;
+
+  static fromJsonString(jsonString: string, options?: Partial<JsonReadOptions>): EngineEvent;
+
+  static equals(a: EngineEvent | PlainMessage<EngineEvent> | undefined, b: EngineEvent | PlainMessage<EngineEvent> | undefined): boolean;
+}
+
+/**
+ * @generated from message engine_api.CreateApiTokenArgs
+ */
+export declare class CreateApiTokenArgs extends Message<CreateApiTokenArgs> {
+  /**
+   * Human-readable name of the token, for its owner to recognize it
+   *
+   * @generated from field: string name = 1;
+   */
+  name: string;
+
+  /**
+   * @generated from field: engine_api.ApiTokenRole role = 2;
+   */
+  role: ApiTokenRole;
+
+  /**
+   * The names of the enclaves the token can act on, it can act on every enclave if empty
+   *
+   * @generated from field: repeated string enclave_names = 3;
+   */
+  enclaveNames: string[];
+
+  constructor(data?: PartialMessage<CreateApiTokenArgs>);
+
+  static readonly runtime: typeof proto3;
+  static readonly typeName = "engine_api.CreateApiTokenArgs";
+  static readonly fields: FieldList;
+
+  static fromBinary(bytes: Uint8Array, options?: Partial<BinaryReadOptions>): CreateApiTokenArgs;
+
+  static fromJson(jsonValue: JsonValue, options?: Partial<JsonReadOptions>): CreateApiTokenArgs;
+
+  static fromJsonString(jsonString: string, options?: Partial<JsonReadOptions>): CreateApiTokenArgs;
+
+  static equals(a: CreateApiTokenArgs | PlainMessage<CreateApiTokenArgs> | undefined, b: CreateApiTokenArgs | PlainMessage<CreateApiTokenArgs> | undefined): boolean;
+}
+
+/**
+ * @generated from message engine_api.CreateApiTokenResponse
+ */
+export declare class CreateApiTokenResponse extends Message<CreateApiTokenResponse> {
+  /**
+   * @generated from field: string token_id = 1;
+   */
+  tokenId: string;
+
+  /**
+   * The secret to send as a bearer token, it can't be retrieved later on
+   *
+   * @generated from field: string token = 2;
+   */
+  token: string;
+
+  constructor(data?: PartialMessage<CreateApiTokenResponse>);
+
+  static readonly runtime: typeof proto3;
+  static readonly typeName = "engine_api.CreateApiTokenResponse";
+  static readonly fields: FieldList;
+
+  static fromBinary(bytes: Uint8Array, options?: Partial<BinaryReadOptions>): CreateApiTokenResponse;
+
+  static fromJson(jsonValue: JsonValue, options?: Partial<JsonReadOptions>): CreateApiTokenResponse;
+
+  static fromJsonString(jsonString: string, options?: Partial<JsonReadOptions>): CreateApiTokenResponse;
+
+  static equals(a: CreateApiTokenResponse | PlainMessage<CreateApiTokenResponse> | undefined, b: CreateApiTokenResponse | PlainMessage<CreateApiTokenResponse> | undefined): boolean;
+}
+
+/**
+ * @generated from message engine_api.RevokeApiTokenArgs
+ */
+export declare class RevokeApiTokenArgs extends Message<RevokeApiTokenArgs> {
+  /**
+   * @generated from field: string token_id = 1;
+   */
+  tokenId: string;
+
+  constructor(data?: PartialMessage<RevokeApiTokenArgs>);
+
+  static readonly runtime: typeof proto3;
+  static readonly typeName = "engine_api.RevokeApiTokenArgs";
+  static readonly fields: FieldList;
+
+  static fromBinary(bytes: Uint8Array, options?: Partial<BinaryReadOptions>): RevokeApiTokenArgs;
+
+  static fromJson(jsonValue: JsonValue, options?: Partial<JsonReadOptions>): RevokeApiTokenArgs;
+
+  static fromJsonString(jsonString: string, options?: Partial<JsonReadOptions>): RevokeApiTokenArgs;
+
+  static equals(a: RevokeApiTokenArgs | PlainMessage<RevokeApiTokenArgs> | undefined, b: RevokeApiTokenArgs | PlainMessage<RevokeApiTokenArgs> | undefined): boolean;
+}
+
+/**
+ * ==============================================================================================
+ *                                          Audit Log
+ * ==============================================================================================
+ *
+ * @generated from message engine_api.GetAuditLogArgs
+ */
+export declare class GetAuditLogArgs extends Message<GetAuditLogArgs> {
+  /**
+   * Only the entries about this enclave are returned if set
+   *
+   * @generated from field: optional string enclave_identifier = 1;
+   */
+  enclaveIdentifier?: string;
+
+  /**
+   * The maximum number of entries to return, a default is used if unset
+   *
+   * @generated from field: optional uint32 page_size = 2;
+   */
+  pageSize?: number;
+
+  /**
+   * The next_page_token of the previous page, the newest entries are returned if unset
+   *
+   * @generated from field: optional string page_token = 3;
+   */
+  pageToken?: string;
+
+  constructor(data?: PartialMessage<GetAuditLogArgs>);
+
+  static readonly runtime: typeof proto3;
+  static readonly typeName = "engine_api.GetAuditLogArgs";
+  static readonly fields: FieldList;
+
+  static fromBinary(bytes: Uint8Array, options?: Partial<BinaryReadOptions>): GetAuditLogArgs;
+
+  static fromJson(jsonValue: JsonValue, options?: Partial<JsonReadOptions>): GetAuditLogArgs;
+
+  static fromJsonString(jsonString: string, options?: Partial<JsonReadOptions>): GetAuditLogArgs;
+
+  static equals(a: GetAuditLogArgs | PlainMessage<GetAuditLogArgs> | undefined, b: GetAuditLogArgs | PlainMessage<GetAuditLogArgs> | undefined): boolean;
+}
+
+/**
+ * @generated from message engine_api.AuditLogEntry
+ */
+export declare class AuditLogEntry extends Message<AuditLogEntry> {
+  /**
+   * @generated from field: google.protobuf.Timestamp timestamp = 1;
+   */
+  timestamp?: Timestamp;
+
+  /**
+   * The API token that made the call if the engine enforces API authentication, the address the call came from otherwise
+   *
+   * @generated from field: string caller = 2;
+   */
+  caller: string;
+
+  /**
+   * The name of the RPC that was called
+   *
+   * @generated from field: string action = 3;
+   */
+  action: string;
+
+  /**
+   * The enclave the call acted on, unset for the calls acting on the whole engine
+   *
+   * @generated from field: optional string enclave_uuid = 4;
+   */
+  enclaveUuid?: string;
+
+  /**
+   * @generated from field: optional string enclave_name = 5;
+   */
+  enclaveName?: string;
+
+  /**
+   * The arguments of the call, with the values of the secrets redacted
+   *
+   * @generated from field: map<string, string> arguments = 6;
+   */
+  arguments: { [key: string]: string };
+
+  /**
+   * Why the call failed, unset if it succeeded
+   *
+   * @generated from field: optional string error = 7;
+   */
+  error?: string;
+
+  constructor(data?: PartialMessage<AuditLogEntry>);
+
+  static readonly runtime: typeof proto3;
+  static readonly typeName = "engine_api.AuditLogEntry";
+  static readonly fields: FieldList;
+
+  static fromBinary(bytes: Uint8Array, options?: Partial<BinaryReadOptions>): AuditLogEntry;
+
+  static fromJson(jsonValue: JsonValue, options?: Partial<JsonReadOptions>): AuditLogEntry;
+
+  static fromJsonString(jsonString: string, options?: Partial<JsonReadOptions>): AuditLogEntry;
+
+  static equals(a: AuditLogEntry | PlainMessage<AuditLogEntry> | undefined, b: AuditLogEntry | PlainMessage<AuditLogEntry> | undefined): boolean;
+}
+
+/**
+ * @generated from message engine_api.GetAuditLogResponse
+ */
+export declare class GetAuditLogResponse extends Message<GetAuditLogResponse> {
+  /**
+   * @generated from field: repeated engine_api.AuditLogEntry entries = 1;
+   */
+  entries: AuditLogEntry[];
+
+  /**
+   * To pass to get the next page, empty if this is the last one
+   *
+   * @generated from field: string next_page_token = 2;
+   */
+  nextPageToken: string;
+
+  constructor(data?: PartialMessage<GetAuditLogResponse>);
+
+  static readonly runtime: typeof proto3;
+  static readonly typeName = "engine_api.GetAuditLogResponse";
+  static readonly fields: FieldList;
+
+  static fromBinary(bytes: Uint8Array, options?: Partial<BinaryReadOptions>): GetAuditLogResponse;
+
+  static fromJson(jsonValue: JsonValue, options?: Partial<JsonReadOptions>): GetAuditLogResponse;
+
+  static fromJsonString(jsonString: string, options?: Partial<JsonReadOptions>): GetAuditLogResponse;
+
+  static equals(a: GetAuditLogResponse | PlainMessage<GetAuditLogResponse> | undefined, b: GetAuditLogResponse | PlainMessage<GetAuditLogResponse> | undefined): boolean;
+}
+
+/**
+ * @generated from message engine_api.GetEnclaveCallerCredentialArgs
+ */
+export declare class GetEnclaveCallerCredentialArgs extends Message<GetEnclaveCallerCredentialArgs> {
+  /**
+   * @generated from field: string enclave_identifier = 1;
+   */
+  enclaveIdentifier: string;
+
+  constructor(data?: PartialMessage<GetEnclaveCallerCredentialArgs>);
+
+  static readonly runtime: typeof proto3;
+  static readonly typeName = "engine_api.GetEnclaveCallerCredentialArgs";
+  static readonly fields: FieldList;
+
+  static fromBinary(bytes: Uint8Array, options?: Partial<BinaryReadOptions>): GetEnclaveCallerCredentialArgs;
+
+  static fromJson(jsonValue: JsonValue, options?: Partial<JsonReadOptions>): GetEnclaveCallerCredentialArgs;
+
+  static fromJsonString(jsonString: string, options?: Partial<JsonReadOptions>): GetEnclaveCallerCredentialArgs;
+
+  static equals(a: GetEnclaveCallerCredentialArgs | PlainMessage<GetEnclaveCallerCredentialArgs> | undefined, b: GetEnclaveCallerCredentialArgs | PlainMessage<GetEnclaveCallerCredentialArgs> | undefined): boolean;
+}
+
+/**
+ * @generated from message engine_api.GetEnclaveCallerCredentialResponse
+ */
+export declare class GetEnclaveCallerCredentialResponse extends Message<GetEnclaveCallerCredentialResponse> {
+  /**
+   * To send to the API container in the kurtosis-audit-caller-credential gRPC metadata
+   *
+   * @generated from field: string credential = 1;
+   */
+  credential: string;
+
+  /**
+   * When the API container stops accepting the credential
+   *
+   * @generated from field: google.protobuf.Timestamp expiration_time = 2;
+   */
+  expirationTime?: Timestamp;
+
+  constructor(data?: PartialMessage<GetEnclaveCallerCredentialResponse>);
+
+  static readonly runtime: typeof proto3;
+  static readonly typeName = "engine_api.GetEnclaveCallerCredentialResponse";
+  static readonly fields: FieldList;
+
+  static fromBinary(bytes: Uint8Array, options?: Partial<BinaryReadOptions>): GetEnclaveCallerCredentialResponse;
+
+  static fromJson(jsonValue: JsonValue, options?: Partial<JsonReadOptions>): GetEnclaveCallerCredentialResponse;
+
+  static fromJsonString(jsonString: string, options?: Partial<JsonReadOptions>): GetEnclaveCallerCredentialResponse;
+
+  static equals(a: GetEnclaveCallerCredentialResponse | PlainMessage<GetEnclaveCallerCredentialResponse> | undefined, b: GetEnclaveCallerCredentialResponse | PlainMessage<GetEnclaveCallerCredentialResponse> | undefined): boolean;
+}
+
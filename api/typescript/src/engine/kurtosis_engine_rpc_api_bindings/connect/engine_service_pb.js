@@ -16,6 +16,19 @@ export const EnclaveMode = proto3.makeEnum(
   ],
 );
 
+/**
+ * NOTE: We have to prefix the enum values with the enum name due to the way Protobuf enum value uniqueness works
+ *
+ * @generated from enum engine_api.ExpiredEnclaveAction
+ */
+export const ExpiredEnclaveAction = proto3.makeEnum(
+  "engine_api.ExpiredEnclaveAction",
+  [
+    {no: 0, name: "ExpiredEnclaveAction_DESTROY"},
+    {no: 1, name: "ExpiredEnclaveAction_STOP"},
+  ],
+);
+
 /**
  * ==============================================================================================
  *                                            Get Enclaves
@@ -64,6 +77,46 @@ export const LogLineOperator = proto3.makeEnum(
   ],
 );
 
+/**
+ * ==============================================================================================
+ *                                         Watch Events
+ * ==============================================================================================
+ * NOTE: We have to prefix the enum values with the enum name due to the way Protobuf enum value uniqueness works
+ *
+ * @generated from enum engine_api.EngineEventType
+ */
+export const EngineEventType = proto3.makeEnum(
+  "engine_api.EngineEventType",
+  [
+    {no: 0, name: "EngineEventType_ENCLAVE_CREATED"},
+    {no: 1, name: "EngineEventType_ENCLAVE_STOPPED"},
+    {no: 2, name: "EngineEventType_ENCLAVE_DESTROYED"},
+    {no: 3, name: "EngineEventType_STARLARK_RUN_STARTED"},
+    {no: 4, name: "EngineEventType_STARLARK_RUN_FINISHED"},
+    {no: 5, name: "EngineEventType_SERVICE_ADDED"},
+    {no: 6, name: "EngineEventType_SERVICE_STOPPED"},
+    {no: 7, name: "EngineEventType_SERVICE_REMOVED"},
+    {no: 8, name: "EngineEventType_API_CONTAINER_RESTARTED"},
+  ],
+);
+
+/**
+ * ==============================================================================================
+ *                                          API Tokens
+ * ==============================================================================================
+ * NOTE: We have to prefix the enum values with the enum name due to the way Protobuf enum value uniqueness works
+ *
+ * @generated from enum engine_api.ApiTokenRole
+ */
+export const ApiTokenRole = proto3.makeEnum(
+  "engine_api.ApiTokenRole",
+  [
+    {no: 0, name: "ApiTokenRole_READ_ONLY"},
+    {no: 1, name: "ApiTokenRole_OPERATOR"},
+    {no: 2, name: "ApiTokenRole_ADMIN"},
+  ],
+);
+
 /**
  * ==============================================================================================
  *                                        Get Engine Info
@@ -75,6 +128,11 @@ export const GetEngineInfoResponse = proto3.makeMessageType(
   "engine_api.GetEngineInfoResponse",
   () => [
     { no: 1, name: "engine_version", kind: "scalar", T: 9 /* ScalarType.STRING */ },
+    { no: 2, name: "log_retention_period", kind: "scalar", T: 9 /* ScalarType.STRING */ },
+    { no: 3, name: "log_level", kind: "scalar", T: 9 /* ScalarType.STRING */ },
+    { no: 4, name: "pool_size", kind: "scalar", T: 13 /* ScalarType.UINT32 */ },
+    { no: 5, name: "is_debug_mode_enabled", kind: "scalar", T: 8 /* ScalarType.BOOL */ },
+    { no: 6, name: "domain", kind: "scalar", T: 9 /* ScalarType.STRING */ },
   ],
 );
 
@@ -93,6 +151,12 @@ export const CreateEnclaveArgs = proto3.makeMessageType(
     { no: 3, name: "api_container_log_level", kind: "scalar", T: 9 /* ScalarType.STRING */, opt: true },
     { no: 4, name: "mode", kind: "enum", T: proto3.getEnumType(EnclaveMode), opt: true },
     { no: 5, name: "should_apic_run_in_debug_mode", kind: "scalar", T: 8 /* ScalarType.BOOL */, opt: true },
+    { no: 6, name: "ttl_seconds", kind: "scalar", T: 13 /* ScalarType.UINT32 */, opt: true },
+    { no: 7, name: "idle_timeout_seconds", kind: "scalar", T: 13 /* ScalarType.UINT32 */, opt: true },
+    { no: 8, name: "expired_enclave_action", kind: "enum", T: proto3.getEnumType(ExpiredEnclaveAction), opt: true },
+    { no: 9, name: "labels", kind: "map", K: 9 /* ScalarType.STRING */, V: {kind: "scalar", T: 9 /* ScalarType.STRING */} },
+    { no: 10, name: "seed_package_locator", kind: "scalar", T: 9 /* ScalarType.STRING */, opt: true },
+    { no: 11, name: "seed_package_params", kind: "scalar", T: 9 /* ScalarType.STRING */, opt: true },
   ],
 );
 
@@ -149,6 +213,30 @@ export const EnclaveInfo = proto3.makeMessageType(
     { no: 7, name: "api_container_host_machine_info", kind: "message", T: EnclaveAPIContainerHostMachineInfo },
     { no: 8, name: "creation_time", kind: "message", T: Timestamp },
     { no: 9, name: "mode", kind: "enum", T: proto3.getEnumType(EnclaveMode) },
+    { no: 10, name: "expiration", kind: "message", T: EnclaveExpiration },
+    { no: 11, name: "labels", kind: "map", K: 9 /* ScalarType.STRING */, V: {kind: "scalar", T: 9 /* ScalarType.STRING */} },
+  ],
+);
+
+/**
+ * @generated from message engine_api.EnclaveExpiration
+ */
+export const EnclaveExpiration = proto3.makeMessageType(
+  "engine_api.EnclaveExpiration",
+  () => [
+    { no: 1, name: "expiration_time", kind: "message", T: Timestamp },
+    { no: 2, name: "idle_timeout_seconds", kind: "scalar", T: 13 /* ScalarType.UINT32 */, opt: true },
+    { no: 3, name: "action", kind: "enum", T: proto3.getEnumType(ExpiredEnclaveAction) },
+  ],
+);
+
+/**
+ * @generated from message engine_api.GetEnclavesArgs
+ */
+export const GetEnclavesArgs = proto3.makeMessageType(
+  "engine_api.GetEnclavesArgs",
+  () => [
+    { no: 1, name: "label_selector", kind: "map", K: 9 /* ScalarType.STRING */, V: {kind: "scalar", T: 9 /* ScalarType.STRING */} },
   ],
 );
 
@@ -200,6 +288,126 @@ export const StopEnclaveArgs = proto3.makeMessageType(
   ],
 );
 
+/**
+ * ==============================================================================================
+ *                                       Extend Enclave TTL
+ * ==============================================================================================
+ *
+ * @generated from message engine_api.ExtendEnclaveTtlArgs
+ */
+export const ExtendEnclaveTtlArgs = proto3.makeMessageType(
+  "engine_api.ExtendEnclaveTtlArgs",
+  () => [
+    { no: 1, name: "enclave_identifier", kind: "scalar", T: 9 /* ScalarType.STRING */ },
+    { no: 2, name: "ttl_seconds", kind: "scalar", T: 13 /* ScalarType.UINT32 */ },
+  ],
+);
+
+/**
+ * ==============================================================================================
+ *                                       Update Enclave Labels
+ * ==============================================================================================
+ *
+ * @generated from message engine_api.UpdateEnclaveLabelsArgs
+ */
+export const UpdateEnclaveLabelsArgs = proto3.makeMessageType(
+  "engine_api.UpdateEnclaveLabelsArgs",
+  () => [
+    { no: 1, name: "enclave_identifier", kind: "scalar", T: 9 /* ScalarType.STRING */ },
+    { no: 2, name: "labels_to_set", kind: "map", K: 9 /* ScalarType.STRING */, V: {kind: "scalar", T: 9 /* ScalarType.STRING */} },
+    { no: 3, name: "labels_to_remove", kind: "scalar", T: 9 /* ScalarType.STRING */, repeated: true },
+  ],
+);
+
+/**
+ * ==============================================================================================
+ *                                       Rename Enclave
+ * ==============================================================================================
+ *
+ * @generated from message engine_api.RenameEnclaveArgs
+ */
+export const RenameEnclaveArgs = proto3.makeMessageType(
+  "engine_api.RenameEnclaveArgs",
+  () => [
+    { no: 1, name: "enclave_identifier", kind: "scalar", T: 9 /* ScalarType.STRING */ },
+    { no: 2, name: "new_name", kind: "scalar", T: 9 /* ScalarType.STRING */ },
+  ],
+);
+
+/**
+ * ==============================================================================================
+ *                                       Clone Enclave
+ * ==============================================================================================
+ *
+ * @generated from message engine_api.CloneEnclaveArgs
+ */
+export const CloneEnclaveArgs = proto3.makeMessageType(
+  "engine_api.CloneEnclaveArgs",
+  () => [
+    { no: 1, name: "enclave_identifier", kind: "scalar", T: 9 /* ScalarType.STRING */ },
+    { no: 2, name: "enclave_name", kind: "scalar", T: 9 /* ScalarType.STRING */, opt: true },
+    { no: 3, name: "allow_partial", kind: "scalar", T: 8 /* ScalarType.BOOL */, opt: true },
+  ],
+);
+
+/**
+ * @generated from message engine_api.CloneEnclaveResponse
+ */
+export const CloneEnclaveResponse = proto3.makeMessageType(
+  "engine_api.CloneEnclaveResponse",
+  () => [
+    { no: 1, name: "enclave_info", kind: "message", T: EnclaveInfo },
+    { no: 2, name: "uncloneable_items", kind: "scalar", T: 9 /* ScalarType.STRING */, repeated: true },
+    { no: 3, name: "warnings", kind: "scalar", T: 9 /* ScalarType.STRING */, repeated: true },
+  ],
+);
+
+/**
+ * ==============================================================================================
+ *                                   Export & Import Enclave
+ * ==============================================================================================
+ *
+ * @generated from message engine_api.ExportEnclaveArgs
+ */
+export const ExportEnclaveArgs = proto3.makeMessageType(
+  "engine_api.ExportEnclaveArgs",
+  () => [
+    { no: 1, name: "enclave_identifier", kind: "scalar", T: 9 /* ScalarType.STRING */ },
+  ],
+);
+
+/**
+ * @generated from message engine_api.EnclaveArchiveChunk
+ */
+export const EnclaveArchiveChunk = proto3.makeMessageType(
+  "engine_api.EnclaveArchiveChunk",
+  () => [
+    { no: 1, name: "data", kind: "scalar", T: 12 /* ScalarType.BYTES */ },
+  ],
+);
+
+/**
+ * @generated from message engine_api.ImportEnclaveArgs
+ */
+export const ImportEnclaveArgs = proto3.makeMessageType(
+  "engine_api.ImportEnclaveArgs",
+  () => [
+    { no: 1, name: "enclave_name", kind: "scalar", T: 9 /* ScalarType.STRING */, opt: true },
+    { no: 2, name: "data", kind: "scalar", T: 12 /* ScalarType.BYTES */ },
+  ],
+);
+
+/**
+ * @generated from message engine_api.ImportEnclaveResponse
+ */
+export const ImportEnclaveResponse = proto3.makeMessageType(
+  "engine_api.ImportEnclaveResponse",
+  () => [
+    { no: 1, name: "enclave_info", kind: "message", T: EnclaveInfo },
+    { no: 2, name: "unimportable_items", kind: "scalar", T: 9 /* ScalarType.STRING */, repeated: true },
+  ],
+);
+
 /**
  * ==============================================================================================
  *                                       Get Enclaves
@@ -239,6 +447,7 @@ export const CleanArgs = proto3.makeMessageType(
   "engine_api.CleanArgs",
   () => [
     { no: 1, name: "should_clean_all", kind: "scalar", T: 8 /* ScalarType.BOOL */, opt: true },
+    { no: 2, name: "label_selector", kind: "map", K: 9 /* ScalarType.STRING */, V: {kind: "scalar", T: 9 /* ScalarType.STRING */} },
   ],
 );
 
@@ -315,3 +524,126 @@ export const LogLineFilter = proto3.makeMessageType(
   ],
 );
 
+/**
+ * @generated from message engine_api.WatchEventsArgs
+ */
+export const WatchEventsArgs = proto3.makeMessageType(
+  "engine_api.WatchEventsArgs",
+  () => [
+    { no: 1, name: "event_types", kind: "enum", T: proto3.getEnumType(EngineEventType), repeated: true },
+  ],
+);
+
+/**
+ * @generated from message engine_api.EngineEvent
+ */
+export const EngineEvent = proto3.makeMessageType(
+  "engine_api.EngineEvent",
+  () => [
+    { no: 1, name: "type", kind: "enum", T: proto3.getEnumType(EngineEventType) },
+    { no: 2, name: "timestamp", kind: "message", T: Timestamp },
+    { no: 3, name: "enclave_uuid", kind: "scalar", T: 9 /* ScalarType.STRING */ },
+    { no: 4, name: "enclave_name", kind: "scalar", T: 9 /* ScalarType.STRING */ },
+    { no: 5, name: "service_name", kind: "scalar", T: 9 /* ScalarType.STRING */, opt: true },
+    { no: 6, name: "starlark_package_id", kind: "scalar", T: 9 /* ScalarType.STRING */, opt: true },
+    { no: 7, name: "starlark_run_succeeded", kind: "scalar", T: 8 /* ScalarType.BOOL */, opt: true },
+  ],
+);
+
+/**
+ * @generated from message engine_api.CreateApiTokenArgs
+ */
+export const CreateApiTokenArgs = proto3.makeMessageType(
+  "engine_api.CreateApiTokenArgs",
+  () => [
+    { no: 1, name: "name", kind: "scalar", T: 9 /* ScalarType.STRING */ },
+    { no: 2, name: "role", kind: "enum", T: proto3.getEnumType(ApiTokenRole) },
+    { no: 3, name: "enclave_names", kind: "scalar", T: 9 /* ScalarType.STRING */, repeated: true },
+  ],
+);
+
+/**
+ * @generated from message engine_api.CreateApiTokenResponse
+ */
+export const CreateApiTokenResponse = proto3.makeMessageType(
+  "engine_api.CreateApiTokenResponse",
+  () => [
+    { no: 1, name: "token_id", kind: "scalar", T: 9 /* ScalarType.STRING */ },
+    { no: 2, name: "token", kind: "scalar", T: 9 /* ScalarType.STRING */ },
+  ],
+);
+
+/**
+ * @generated from message engine_api.RevokeApiTokenArgs
+ */
+export const RevokeApiTokenArgs = proto3.makeMessageType(
+  "engine_api.RevokeApiTokenArgs",
+  () => [
+    { no: 1, name: "token_id", kind: "scalar", T: 9 /* ScalarType.STRING */ },
+  ],
+);
+
+/**
+ * ==============================================================================================
+ *                                          Audit Log
+ * ==============================================================================================
+ *
+ * @generated from message engine_api.GetAuditLogArgs
+ */
+export const GetAuditLogArgs = proto3.makeMessageType(
+  "engine_api.GetAuditLogArgs",
+  () => [
+    { no: 1, name: "enclave_identifier", kind: "scalar", T: 9 /* ScalarType.STRING */, opt: true },
+    { no: 2, name: "page_size", kind: "scalar", T: 13 /* ScalarType.UINT32 */, opt: true },
+    { no: 3, name: "page_token", kind: "scalar", T: 9 /* ScalarType.STRING */, opt: true },
+  ],
+);
+
+/**
+ * @generated from message engine_api.AuditLogEntry
+ */
+export const AuditLogEntry = proto3.makeMessageType(
+  "engine_api.AuditLogEntry",
+  () => [
+    { no: 1, name: "timestamp", kind: "message", T: Timestamp },
+    { no: 2, name: "caller", kind: "scalar", T: 9 /* ScalarType.STRING */ },
+    { no: 3, name: "action", kind: "scalar", T: 9 /* ScalarType.STRING */ },
+    { no: 4, name: "enclave_uuid", kind: "scalar", T: 9 /* ScalarType.STRING */, opt: true },
+    { no: 5, name: "enclave_name", kind: "scalar", T: 9 /* ScalarType.STRING */, opt: true },
+    { no: 6, name: "arguments", kind: "map", K: 9 /* ScalarType.STRING */, V: {kind: "scalar", T: 9 /* ScalarType.STRING */} },
+    { no: 7, name: "error", kind: "scalar", T: 9 /* ScalarType.STRING */, opt: true },
+  ],
+);
+
+/**
+ * @generated from message engine_api.GetAuditLogResponse
+ */
+export const GetAuditLogResponse = proto3.makeMessageType(
+  "engine_api.GetAuditLogResponse",
+  () => [
+    { no: 1, name: "entries", kind: "message", T: AuditLogEntry, repeated: true },
+    { no: 2, name: "next_page_token", kind: "scalar", T: 9 /* ScalarType.STRING */ },
+  ],
+);
+
+/**
+ * @generated from message engine_api.GetEnclaveCallerCredentialArgs
+ */
+export const GetEnclaveCallerCredentialArgs = proto3.makeMessageType(
+  "engine_api.GetEnclaveCallerCredentialArgs",
+  () => [
+    { no: 1, name: "enclave_identifier", kind: "scalar", T: 9 /* ScalarType.STRING */ },
+  ],
+);
+
+/**
+ * @generated from message engine_api.GetEnclaveCallerCredentialResponse
+ */
+export const GetEnclaveCallerCredentialResponse = proto3.makeMessageType(
+  "engine_api.GetEnclaveCallerCredentialResponse",
+  () => [
+    { no: 1, name: "credential", kind: "scalar", T: 9 /* ScalarType.STRING */ },
+    { no: 2, name: "expiration_time", kind: "message", T: Timestamp },
+  ],
+);
+
@@ -10,13 +10,24 @@ import * as grpc from "@grpc/grpc-js";
 interface IEngineServiceService extends grpc.ServiceDefinition<grpc.UntypedServiceImplementation> {
   getEngineInfo: grpc.MethodDefinition<google_protobuf_empty_pb.Empty, engine_service_pb.GetEngineInfoResponse>;
   createEnclave: grpc.MethodDefinition<engine_service_pb.CreateEnclaveArgs, engine_service_pb.CreateEnclaveResponse>;
-  getEnclaves: grpc.MethodDefinition<google_protobuf_empty_pb.Empty, engine_service_pb.GetEnclavesResponse>;
+  getEnclaves: grpc.MethodDefinition<engine_service_pb.GetEnclavesArgs, engine_service_pb.GetEnclavesResponse>;
   getEnclavesByUuids: grpc.MethodDefinition<engine_service_pb.GetEnclavesByUuidsArgs, engine_service_pb.GetEnclavesResponse>;
   getExistingAndHistoricalEnclaveIdentifiers: grpc.MethodDefinition<google_protobuf_empty_pb.Empty, engine_service_pb.GetExistingAndHistoricalEnclaveIdentifiersResponse>;
   stopEnclave: grpc.MethodDefinition<engine_service_pb.StopEnclaveArgs, google_protobuf_empty_pb.Empty>;
   destroyEnclave: grpc.MethodDefinition<engine_service_pb.DestroyEnclaveArgs, google_protobuf_empty_pb.Empty>;
+  extendEnclaveTtl: grpc.MethodDefinition<engine_service_pb.ExtendEnclaveTtlArgs, google_protobuf_empty_pb.Empty>;
+  updateEnclaveLabels: grpc.MethodDefinition<engine_service_pb.UpdateEnclaveLabelsArgs, google_protobuf_empty_pb.Empty>;
+  renameEnclave: grpc.MethodDefinition<engine_service_pb.RenameEnclaveArgs, google_protobuf_empty_pb.Empty>;
+  cloneEnclave: grpc.MethodDefinition<engine_service_pb.CloneEnclaveArgs, engine_service_pb.CloneEnclaveResponse>;
+  exportEnclave: grpc.MethodDefinition<engine_service_pb.ExportEnclaveArgs, engine_service_pb.EnclaveArchiveChunk>;
+  importEnclave: grpc.MethodDefinition<engine_service_pb.ImportEnclaveArgs, engine_service_pb.ImportEnclaveResponse>;
   clean: grpc.MethodDefinition<engine_service_pb.CleanArgs, engine_service_pb.CleanResponse>;
   getServiceLogs: grpc.MethodDefinition<engine_service_pb.GetServiceLogsArgs, engine_service_pb.GetServiceLogsResponse>;
+  watchEvents: grpc.MethodDefinition<engine_service_pb.WatchEventsArgs, engine_service_pb.EngineEvent>;
+  createApiToken: grpc.MethodDefinition<engine_service_pb.CreateApiTokenArgs, engine_service_pb.CreateApiTokenResponse>;
+  revokeApiToken: grpc.MethodDefinition<engine_service_pb.RevokeApiTokenArgs, google_protobuf_empty_pb.Empty>;
+  getAuditLog: grpc.MethodDefinition<engine_service_pb.GetAuditLogArgs, engine_service_pb.GetAuditLogResponse>;
+  getEnclaveCallerCredential: grpc.MethodDefinition<engine_service_pb.GetEnclaveCallerCredentialArgs, engine_service_pb.GetEnclaveCallerCredentialResponse>;
 }
 
 export const EngineServiceService: IEngineServiceService;
@@ -24,13 +35,24 @@ export const EngineServiceService: IEngineServiceService;
 export interface IEngineServiceServer extends grpc.UntypedServiceImplementation {
   getEngineInfo: grpc.handleUnaryCall<google_protobuf_empty_pb.Empty, engine_service_pb.GetEngineInfoResponse>;
   createEnclave: grpc.handleUnaryCall<engine_service_pb.CreateEnclaveArgs, engine_service_pb.CreateEnclaveResponse>;
-  getEnclaves: grpc.handleUnaryCall<google_protobuf_empty_pb.Empty, engine_service_pb.GetEnclavesResponse>;
+  getEnclaves: grpc.handleUnaryCall<engine_service_pb.GetEnclavesArgs, engine_service_pb.GetEnclavesResponse>;
   getEnclavesByUuids: grpc.handleUnaryCall<engine_service_pb.GetEnclavesByUuidsArgs, engine_service_pb.GetEnclavesResponse>;
   getExistingAndHistoricalEnclaveIdentifiers: grpc.handleUnaryCall<google_protobuf_empty_pb.Empty, engine_service_pb.GetExistingAndHistoricalEnclaveIdentifiersResponse>;
   stopEnclave: grpc.handleUnaryCall<engine_service_pb.StopEnclaveArgs, google_protobuf_empty_pb.Empty>;
   destroyEnclave: grpc.handleUnaryCall<engine_service_pb.DestroyEnclaveArgs, google_protobuf_empty_pb.Empty>;
+  extendEnclaveTtl: grpc.handleUnaryCall<engine_service_pb.ExtendEnclaveTtlArgs, google_protobuf_empty_pb.Empty>;
+  updateEnclaveLabels: grpc.handleUnaryCall<engine_service_pb.UpdateEnclaveLabelsArgs, google_protobuf_empty_pb.Empty>;
+  renameEnclave: grpc.handleUnaryCall<engine_service_pb.RenameEnclaveArgs, google_protobuf_empty_pb.Empty>;
+  cloneEnclave: grpc.handleUnaryCall<engine_service_pb.CloneEnclaveArgs, engine_service_pb.CloneEnclaveResponse>;
+  exportEnclave: grpc.handleServerStreamingCall<engine_service_pb.ExportEnclaveArgs, engine_service_pb.EnclaveArchiveChunk>;
+  importEnclave: grpc.handleClientStreamingCall<engine_service_pb.ImportEnclaveArgs, engine_service_pb.ImportEnclaveResponse>;
   clean: grpc.handleUnaryCall<engine_service_pb.CleanArgs, engine_service_pb.CleanResponse>;
   getServiceLogs: grpc.handleServerStreamingCall<engine_service_pb.GetServiceLogsArgs, engine_service_pb.GetServiceLogsResponse>;
+  watchEvents: grpc.handleServerStreamingCall<engine_service_pb.WatchEventsArgs, engine_service_pb.EngineEvent>;
+  createApiToken: grpc.handleUnaryCall<engine_service_pb.CreateApiTokenArgs, engine_service_pb.CreateApiTokenResponse>;
+  revokeApiToken: grpc.handleUnaryCall<engine_service_pb.RevokeApiTokenArgs, google_protobuf_empty_pb.Empty>;
+  getAuditLog: grpc.handleUnaryCall<engine_service_pb.GetAuditLogArgs, engine_service_pb.GetAuditLogResponse>;
+  getEnclaveCallerCredential: grpc.handleUnaryCall<engine_service_pb.GetEnclaveCallerCredentialArgs, engine_service_pb.GetEnclaveCallerCredentialResponse>;
 }
 
 export class EngineServiceClient extends grpc.Client {
@@ -41,9 +63,9 @@ export class EngineServiceClient extends grpc.Client {
   createEnclave(argument: engine_service_pb.CreateEnclaveArgs, callback: grpc.requestCallback<engine_service_pb.CreateEnclaveResponse>): grpc.ClientUnaryCall;
   createEnclave(argument: engine_service_pb.CreateEnclaveArgs, metadataOrOptions: grpc.Metadata | grpc.CallOptions | null, callback: grpc.requestCallback<engine_service_pb.CreateEnclaveResponse>): grpc.ClientUnaryCall;
   createEnclave(argument: engine_service_pb.CreateEnclaveArgs, metadata: grpc.Metadata | null, options: grpc.CallOptions | null, callback: grpc.requestCallback<engine_service_pb.CreateEnclaveResponse>): grpc.ClientUnaryCall;
-  getEnclaves(argument: google_protobuf_empty_pb.Empty, callback: grpc.requestCallback<engine_service_pb.GetEnclavesResponse>): grpc.ClientUnaryCall;
-  getEnclaves(argument: google_protobuf_empty_pb.Empty, metadataOrOptions: grpc.Metadata | grpc.CallOptions | null, callback: grpc.requestCallback<engine_service_pb.GetEnclavesResponse>): grpc.ClientUnaryCall;
-  getEnclaves(argument: google_protobuf_empty_pb.Empty, metadata: grpc.Metadata | null, options: grpc.CallOptions | null, callback: grpc.requestCallback<engine_service_pb.GetEnclavesResponse>): grpc.ClientUnaryCall;
+  getEnclaves(argument: engine_service_pb.GetEnclavesArgs, callback: grpc.requestCallback<engine_service_pb.GetEnclavesResponse>): grpc.ClientUnaryCall;
+  getEnclaves(argument: engine_service_pb.GetEnclavesArgs, metadataOrOptions: grpc.Metadata | grpc.CallOptions | null, callback: grpc.requestCallback<engine_service_pb.GetEnclavesResponse>): grpc.ClientUnaryCall;
+  getEnclaves(argument: engine_service_pb.GetEnclavesArgs, metadata: grpc.Metadata | null, options: grpc.CallOptions | null, callback: grpc.requestCallback<engine_service_pb.GetEnclavesResponse>): grpc.ClientUnaryCall;
   getEnclavesByUuids(argument: engine_service_pb.GetEnclavesByUuidsArgs, callback: grpc.requestCallback<engine_service_pb.GetEnclavesResponse>): grpc.ClientUnaryCall;
   getEnclavesByUuids(argument: engine_service_pb.GetEnclavesByUuidsArgs, metadataOrOptions: grpc.Metadata | grpc.CallOptions | null, callback: grpc.requestCallback<engine_service_pb.GetEnclavesResponse>): grpc.ClientUnaryCall;
   getEnclavesByUuids(argument: engine_service_pb.GetEnclavesByUuidsArgs, metadata: grpc.Metadata | null, options: grpc.CallOptions | null, callback: grpc.requestCallback<engine_service_pb.GetEnclavesResponse>): grpc.ClientUnaryCall;
@@ -56,9 +78,40 @@ export class EngineServiceClient extends grpc.Client {
   destroyEnclave(argument: engine_service_pb.DestroyEnclaveArgs, callback: grpc.requestCallback<google_protobuf_empty_pb.Empty>): grpc.ClientUnaryCall;
   destroyEnclave(argument: engine_service_pb.DestroyEnclaveArgs, metadataOrOptions: grpc.Metadata | grpc.CallOptions | null, callback: grpc.requestCallback<google_protobuf_empty_pb.Empty>): grpc.ClientUnaryCall;
   destroyEnclave(argument: engine_service_pb.DestroyEnclaveArgs, metadata: grpc.Metadata | null, options: grpc.CallOptions | null, callback: grpc.requestCallback<google_protobuf_empty_pb.Empty>): grpc.ClientUnaryCall;
+  extendEnclaveTtl(argument: engine_service_pb.ExtendEnclaveTtlArgs, callback: grpc.requestCallback<google_protobuf_empty_pb.Empty>): grpc.ClientUnaryCall;
+  extendEnclaveTtl(argument: engine_service_pb.ExtendEnclaveTtlArgs, metadataOrOptions: grpc.Metadata | grpc.CallOptions | null, callback: grpc.requestCallback<google_protobuf_empty_pb.Empty>): grpc.ClientUnaryCall;
+  extendEnclaveTtl(argument: engine_service_pb.ExtendEnclaveTtlArgs, metadata: grpc.Metadata | null, options: grpc.CallOptions | null, callback: grpc.requestCallback<google_protobuf_empty_pb.Empty>): grpc.ClientUnaryCall;
+  updateEnclaveLabels(argument: engine_service_pb.UpdateEnclaveLabelsArgs, callback: grpc.requestCallback<google_protobuf_empty_pb.Empty>): grpc.ClientUnaryCall;
+  updateEnclaveLabels(argument: engine_service_pb.UpdateEnclaveLabelsArgs, metadataOrOptions: grpc.Metadata | grpc.CallOptions | null, callback: grpc.requestCallback<google_protobuf_empty_pb.Empty>): grpc.ClientUnaryCall;
+  updateEnclaveLabels(argument: engine_service_pb.UpdateEnclaveLabelsArgs, metadata: grpc.Metadata | null, options: grpc.CallOptions | null, callback: grpc.requestCallback<google_protobuf_empty_pb.Empty>): grpc.ClientUnaryCall;
+  renameEnclave(argument: engine_service_pb.RenameEnclaveArgs, callback: grpc.requestCallback<google_protobuf_empty_pb.Empty>): grpc.ClientUnaryCall;
+  renameEnclave(argument: engine_service_pb.RenameEnclaveArgs, metadataOrOptions: grpc.Metadata | grpc.CallOptions | null, callback: grpc.requestCallback<google_protobuf_empty_pb.Empty>): grpc.ClientUnaryCall;
+  renameEnclave(argument: engine_service_pb.RenameEnclaveArgs, metadata: grpc.Metadata | null, options: grpc.CallOptions | null, callback: grpc.requestCallback<google_protobuf_empty_pb.Empty>): grpc.ClientUnaryCall;
+  cloneEnclave(argument: engine_service_pb.CloneEnclaveArgs, callback: grpc.requestCallback<engine_service_pb.CloneEnclaveResponse>): grpc.ClientUnaryCall;
+  cloneEnclave(argument: engine_service_pb.CloneEnclaveArgs, metadataOrOptions: grpc.Metadata | grpc.CallOptions | null, callback: grpc.requestCallback<engine_service_pb.CloneEnclaveResponse>): grpc.ClientUnaryCall;
+  cloneEnclave(argument: engine_service_pb.CloneEnclaveArgs, metadata: grpc.Metadata | null, options: grpc.CallOptions | null, callback: grpc.requestCallback<engine_service_pb.CloneEnclaveResponse>): grpc.ClientUnaryCall;
+  exportEnclave(argument: engine_service_pb.ExportEnclaveArgs, metadataOrOptions?: grpc.Metadata | grpc.CallOptions | null): grpc.ClientReadableStream<engine_service_pb.EnclaveArchiveChunk>;
+  exportEnclave(argument: engine_service_pb.ExportEnclaveArgs, metadata?: grpc.Metadata | null, options?: grpc.CallOptions | null): grpc.ClientReadableStream<engine_service_pb.EnclaveArchiveChunk>;
+  importEnclave(callback: grpc.requestCallback<engine_service_pb.ImportEnclaveResponse>): grpc.ClientWritableStream<engine_service_pb.ImportEnclaveArgs>;
+  importEnclave(metadataOrOptions: grpc.Metadata | grpc.CallOptions | null, callback: grpc.requestCallback<engine_service_pb.ImportEnclaveResponse>): grpc.ClientWritableStream<engine_service_pb.ImportEnclaveArgs>;
+  importEnclave(metadata: grpc.Metadata | null, options: grpc.CallOptions | null, callback: grpc.requestCallback<engine_service_pb.ImportEnclaveResponse>): grpc.ClientWritableStream<engine_service_pb.ImportEnclaveArgs>;
   clean(argument: engine_service_pb.CleanArgs, callback: grpc.requestCallback<engine_service_pb.CleanResponse>): grpc.ClientUnaryCall;
   clean(argument: engine_service_pb.CleanArgs, metadataOrOptions: grpc.Metadata | grpc.CallOptions | null, callback: grpc.requestCallback<engine_service_pb.CleanResponse>): grpc.ClientUnaryCall;
   clean(argument: engine_service_pb.CleanArgs, metadata: grpc.Metadata | null, options: grpc.CallOptions | null, callback: grpc.requestCallback<engine_service_pb.CleanResponse>): grpc.ClientUnaryCall;
   getServiceLogs(argument: engine_service_pb.GetServiceLogsArgs, metadataOrOptions?: grpc.Metadata | grpc.CallOptions | null): grpc.ClientReadableStream<engine_service_pb.GetServiceLogsResponse>;
   getServiceLogs(argument: engine_service_pb.GetServiceLogsArgs, metadata?: grpc.Metadata | null, options?: grpc.CallOptions | null): grpc.ClientReadableStream<engine_service_pb.GetServiceLogsResponse>;
+  watchEvents(argument: engine_service_pb.WatchEventsArgs, metadataOrOptions?: grpc.Metadata | grpc.CallOptions | null): grpc.ClientReadableStream<engine_service_pb.EngineEvent>;
+  watchEvents(argument: engine_service_pb.WatchEventsArgs, metadata?: grpc.Metadata | null, options?: grpc.CallOptions | null): grpc.ClientReadableStream<engine_service_pb.EngineEvent>;
+  createApiToken(argument: engine_service_pb.CreateApiTokenArgs, callback: grpc.requestCallback<engine_service_pb.CreateApiTokenResponse>): grpc.ClientUnaryCall;
+  createApiToken(argument: engine_service_pb.CreateApiTokenArgs, metadataOrOptions: grpc.Metadata | grpc.CallOptions | null, callback: grpc.requestCallback<engine_service_pb.CreateApiTokenResponse>): grpc.ClientUnaryCall;
+  createApiToken(argument: engine_service_pb.CreateApiTokenArgs, metadata: grpc.Metadata | null, options: grpc.CallOptions | null, callback: grpc.requestCallback<engine_service_pb.CreateApiTokenResponse>): grpc.ClientUnaryCall;
+  revokeApiToken(argument: engine_service_pb.RevokeApiTokenArgs, callback: grpc.requestCallback<google_protobuf_empty_pb.Empty>): grpc.ClientUnaryCall;
+  revokeApiToken(argument: engine_service_pb.RevokeApiTokenArgs, metadataOrOptions: grpc.Metadata | grpc.CallOptions | null, callback: grpc.requestCallback<google_protobuf_empty_pb.Empty>): grpc.ClientUnaryCall;
+  revokeApiToken(argument: engine_service_pb.RevokeApiTokenArgs, metadata: grpc.Metadata | null, options: grpc.CallOptions | null, callback: grpc.requestCallback<google_protobuf_empty_pb.Empty>): grpc.ClientUnaryCall;
+  getAuditLog(argument: engine_service_pb.GetAuditLogArgs, callback: grpc.requestCallback<engine_service_pb.GetAuditLogResponse>): grpc.ClientUnaryCall;
+  getAuditLog(argument: engine_service_pb.GetAuditLogArgs, metadataOrOptions: grpc.Metadata | grpc.CallOptions | null, callback: grpc.requestCallback<engine_service_pb.GetAuditLogResponse>): grpc.ClientUnaryCall;
+  getAuditLog(argument: engine_service_pb.GetAuditLogArgs, metadata: grpc.Metadata | null, options: grpc.CallOptions | null, callback: grpc.requestCallback<engine_service_pb.GetAuditLogResponse>): grpc.ClientUnaryCall;
+  getEnclaveCallerCredential(argument: engine_service_pb.GetEnclaveCallerCredentialArgs, callback: grpc.requestCallback<engine_service_pb.GetEnclaveCallerCredentialResponse>): grpc.ClientUnaryCall;
+  getEnclaveCallerCredential(argument: engine_service_pb.GetEnclaveCallerCredentialArgs, metadataOrOptions: grpc.Metadata | grpc.CallOptions | null, callback: grpc.requestCallback<engine_service_pb.GetEnclaveCallerCredentialResponse>): grpc.ClientUnaryCall;
+  getEnclaveCallerCredential(argument: engine_service_pb.GetEnclaveCallerCredentialArgs, metadata: grpc.Metadata | null, options: grpc.CallOptions | null, callback: grpc.requestCallback<engine_service_pb.GetEnclaveCallerCredentialResponse>): grpc.ClientUnaryCall;
 }
@@ -28,6 +28,50 @@ function deserialize_engine_api_CleanResponse(buffer_arg) {
   return engine_service_pb.CleanResponse.deserializeBinary(new Uint8Array(buffer_arg));
 }
 
+function serialize_engine_api_CloneEnclaveArgs(arg) {
+  if (!(arg instanceof engine_service_pb.CloneEnclaveArgs)) {
+    throw new Error('Expected argument of type engine_api.CloneEnclaveArgs');
+  }
+  return Buffer.from(arg.serializeBinary());
+}
+
+function deserialize_engine_api_CloneEnclaveArgs(buffer_arg) {
+  return engine_service_pb.CloneEnclaveArgs.deserializeBinary(new Uint8Array(buffer_arg));
+}
+
+function serialize_engine_api_CloneEnclaveResponse(arg) {
+  if (!(arg instanceof engine_service_pb.CloneEnclaveResponse)) {
+    throw new Error('Expected argument of type engine_api.CloneEnclaveResponse');
+  }
+  return Buffer.from(arg.serializeBinary());
+}
+
+function deserialize_engine_api_CloneEnclaveResponse(buffer_arg) {
+  return engine_service_pb.CloneEnclaveResponse.deserializeBinary(new Uint8Array(buffer_arg));
+}
+
+function serialize_engine_api_CreateApiTokenArgs(arg) {
+  if (!(arg instanceof engine_service_pb.CreateApiTokenArgs)) {
+    throw new Error('Expected argument of type engine_api.CreateApiTokenArgs');
+  }
+  return Buffer.from(arg.serializeBinary());
+}
+
+function deserialize_engine_api_CreateApiTokenArgs(buffer_arg) {
+  return engine_service_pb.CreateApiTokenArgs.deserializeBinary(new Uint8Array(buffer_arg));
+}
+
+function serialize_engine_api_CreateApiTokenResponse(arg) {
+  if (!(arg instanceof engine_service_pb.CreateApiTokenResponse)) {
+    throw new Error('Expected argument of type engine_api.CreateApiTokenResponse');
+  }
+  return Buffer.from(arg.serializeBinary());
+}
+
+function deserialize_engine_api_CreateApiTokenResponse(buffer_arg) {
+  return engine_service_pb.CreateApiTokenResponse.deserializeBinary(new Uint8Array(buffer_arg));
+}
+
 function serialize_engine_api_CreateEnclaveArgs(arg) {
   if (!(arg instanceof engine_service_pb.CreateEnclaveArgs)) {
     throw new Error('Expected argument of type engine_api.CreateEnclaveArgs');
@@ -61,6 +105,105 @@ function deserialize_engine_api_DestroyEnclaveArgs(buffer_arg) {
   return engine_service_pb.DestroyEnclaveArgs.deserializeBinary(new Uint8Array(buffer_arg));
 }
 
+function serialize_engine_api_EnclaveArchiveChunk(arg) {
+  if (!(arg instanceof engine_service_pb.EnclaveArchiveChunk)) {
+    throw new Error('Expected argument of type engine_api.EnclaveArchiveChunk');
+  }
+  return Buffer.from(arg.serializeBinary());
+}
+
+function deserialize_engine_api_EnclaveArchiveChunk(buffer_arg) {
+  return engine_service_pb.EnclaveArchiveChunk.deserializeBinary(new Uint8Array(buffer_arg));
+}
+
+function serialize_engine_api_EngineEvent(arg) {
+  if (!(arg instanceof engine_service_pb.EngineEvent)) {
+    throw new Error('Expected argument of type engine_api.EngineEvent');
+  }
+  return Buffer.from(arg.serializeBinary());
+}
+
+function deserialize_engine_api_EngineEvent(buffer_arg) {
+  return engine_service_pb.EngineEvent.deserializeBinary(new Uint8Array(buffer_arg));
+}
+
+function serialize_engine_api_ExportEnclaveArgs(arg) {
+  if (!(arg instanceof engine_service_pb.ExportEnclaveArgs)) {
+    throw new Error('Expected argument of type engine_api.ExportEnclaveArgs');
+  }
+  return Buffer.from(arg.serializeBinary());
+}
+
+function deserialize_engine_api_ExportEnclaveArgs(buffer_arg) {
+  return engine_service_pb.ExportEnclaveArgs.deserializeBinary(new Uint8Array(buffer_arg));
+}
+
+function serialize_engine_api_ExtendEnclaveTtlArgs(arg) {
+  if (!(arg instanceof engine_service_pb.ExtendEnclaveTtlArgs)) {
+    throw new Error('Expected argument of type engine_api.ExtendEnclaveTtlArgs');
+  }
+  return Buffer.from(arg.serializeBinary());
+}
+
+function deserialize_engine_api_ExtendEnclaveTtlArgs(buffer_arg) {
+  return engine_service_pb.ExtendEnclaveTtlArgs.deserializeBinary(new Uint8Array(buffer_arg));
+}
+
+function serialize_engine_api_GetAuditLogArgs(arg) {
+  if (!(arg instanceof engine_service_pb.GetAuditLogArgs)) {
+    throw new Error('Expected argument of type engine_api.GetAuditLogArgs');
+  }
+  return Buffer.from(arg.serializeBinary());
+}
+
+function deserialize_engine_api_GetAuditLogArgs(buffer_arg) {
+  return engine_service_pb.GetAuditLogArgs.deserializeBinary(new Uint8Array(buffer_arg));
+}
+
+function serialize_engine_api_GetAuditLogResponse(arg) {
+  if (!(arg instanceof engine_service_pb.GetAuditLogResponse)) {
+    throw new Error('Expected argument of type engine_api.GetAuditLogResponse');
+  }
+  return Buffer.from(arg.serializeBinary());
+}
+
+function deserialize_engine_api_GetAuditLogResponse(buffer_arg) {
+  return engine_service_pb.GetAuditLogResponse.deserializeBinary(new Uint8Array(buffer_arg));
+}
+
+function serialize_engine_api_GetEnclaveCallerCredentialArgs(arg) {
+  if (!(arg instanceof engine_service_pb.GetEnclaveCallerCredentialArgs)) {
+    throw new Error('Expected argument of type engine_api.GetEnclaveCallerCredentialArgs');
+  }
+  return Buffer.from(arg.serializeBinary());
+}
+
+function deserialize_engine_api_GetEnclaveCallerCredentialArgs(buffer_arg) {
+  return engine_service_pb.GetEnclaveCallerCredentialArgs.deserializeBinary(new Uint8Array(buffer_arg));
+}
+
+function serialize_engine_api_GetEnclaveCallerCredentialResponse(arg) {
+  if (!(arg instanceof engine_service_pb.GetEnclaveCallerCredentialResponse)) {
+    throw new Error('Expected argument of type engine_api.GetEnclaveCallerCredentialResponse');
+  }
+  return Buffer.from(arg.serializeBinary());
+}
+
+function deserialize_engine_api_GetEnclaveCallerCredentialResponse(buffer_arg) {
+  return engine_service_pb.GetEnclaveCallerCredentialResponse.deserializeBinary(new Uint8Array(buffer_arg));
+}
+
+function serialize_engine_api_GetEnclavesArgs(arg) {
+  if (!(arg instanceof engine_service_pb.GetEnclavesArgs)) {
+    throw new Error('Expected argument of type engine_api.GetEnclavesArgs');
+  }
+  return Buffer.from(arg.serializeBinary());
+}
+
+function deserialize_engine_api_GetEnclavesArgs(buffer_arg) {
+  return engine_service_pb.GetEnclavesArgs.deserializeBinary(new Uint8Array(buffer_arg));
+}
+
 function serialize_engine_api_GetEnclavesByUuidsArgs(arg) {
   if (!(arg instanceof engine_service_pb.GetEnclavesByUuidsArgs)) {
     throw new Error('Expected argument of type engine_api.GetEnclavesByUuidsArgs');
@@ -127,6 +270,50 @@ function deserialize_engine_api_GetServiceLogsResponse(buffer_arg) {
   return engine_service_pb.GetServiceLogsResponse.deserializeBinary(new Uint8Array(buffer_arg));
 }
 
+function serialize_engine_api_ImportEnclaveArgs(arg) {
+  if (!(arg instanceof engine_service_pb.ImportEnclaveArgs)) {
+    throw new Error('Expected argument of type engine_api.ImportEnclaveArgs');
+  }
+  return Buffer.from(arg.serializeBinary());
+}
+
+function deserialize_engine_api_ImportEnclaveArgs(buffer_arg) {
+  return engine_service_pb.ImportEnclaveArgs.deserializeBinary(new Uint8Array(buffer_arg));
+}
+
+function serialize_engine_api_ImportEnclaveResponse(arg) {
+  if (!(arg instanceof engine_service_pb.ImportEnclaveResponse)) {
+    throw new Error('Expected argument of type engine_api.ImportEnclaveResponse');
+  }
+  return Buffer.from(arg.serializeBinary());
+}
+
+function deserialize_engine_api_ImportEnclaveResponse(buffer_arg) {
+  return engine_service_pb.ImportEnclaveResponse.deserializeBinary(new Uint8Array(buffer_arg));
+}
+
+function serialize_engine_api_RenameEnclaveArgs(arg) {
+  if (!(arg instanceof engine_service_pb.RenameEnclaveArgs)) {
+    throw new Error('Expected argument of type engine_api.RenameEnclaveArgs');
+  }
+  return Buffer.from(arg.serializeBinary());
+}
+
+function deserialize_engine_api_RenameEnclaveArgs(buffer_arg) {
+  return engine_service_pb.RenameEnclaveArgs.deserializeBinary(new Uint8Array(buffer_arg));
+}
+
+function serialize_engine_api_RevokeApiTokenArgs(arg) {
+  if (!(arg instanceof engine_service_pb.RevokeApiTokenArgs)) {
+    throw new Error('Expected argument of type engine_api.RevokeApiTokenArgs');
+  }
+  return Buffer.from(arg.serializeBinary());
+}
+
+function deserialize_engine_api_RevokeApiTokenArgs(buffer_arg) {
+  return engine_service_pb.RevokeApiTokenArgs.deserializeBinary(new Uint8Array(buffer_arg));
+}
+
 function serialize_engine_api_StopEnclaveArgs(arg) {
   if (!(arg instanceof engine_service_pb.StopEnclaveArgs)) {
     throw new Error('Expected argument of type engine_api.StopEnclaveArgs');
@@ -138,6 +325,28 @@ function deserialize_engine_api_StopEnclaveArgs(buffer_arg) {
   return engine_service_pb.StopEnclaveArgs.deserializeBinary(new Uint8Array(buffer_arg));
 }
 
+function serialize_engine_api_UpdateEnclaveLabelsArgs(arg) {
+  if (!(arg instanceof engine_service_pb.UpdateEnclaveLabelsArgs)) {
+    throw new Error('Expected argument of type engine_api.UpdateEnclaveLabelsArgs');
+  }
+  return Buffer.from(arg.serializeBinary());
+}
+
+function deserialize_engine_api_UpdateEnclaveLabelsArgs(buffer_arg) {
+  return engine_service_pb.UpdateEnclaveLabelsArgs.deserializeBinary(new Uint8Array(buffer_arg));
+}
+
+function serialize_engine_api_WatchEventsArgs(arg) {
+  if (!(arg instanceof engine_service_pb.WatchEventsArgs)) {
+    throw new Error('Expected argument of type engine_api.WatchEventsArgs');
+  }
+  return Buffer.from(arg.serializeBinary());
+}
+
+function deserialize_engine_api_WatchEventsArgs(buffer_arg) {
+  return engine_service_pb.WatchEventsArgs.deserializeBinary(new Uint8Array(buffer_arg));
+}
+
 function serialize_google_protobuf_Empty(arg) {
   if (!(arg instanceof google_protobuf_empty_pb.Empty)) {
     throw new Error('Expected argument of type google.protobuf.Empty');
@@ -178,15 +387,15 @@ createEnclave: {
     responseSerialize: serialize_engine_api_CreateEnclaveResponse,
     responseDeserialize: deserialize_engine_api_CreateEnclaveResponse,
   },
-  // Returns information about the requested enclaves or all enclaves if none specified.
+  // Returns information about the enclaves matching the label selector, or all enclaves if none specified.
 getEnclaves: {
     path: '/engine_api.EngineService/GetEnclaves',
     requestStream: false,
     responseStream: false,
-    requestType: google_protobuf_empty_pb.Empty,
+    requestType: engine_service_pb.GetEnclavesArgs,
     responseType: engine_service_pb.GetEnclavesResponse,
-    requestSerialize: serialize_google_protobuf_Empty,
-    requestDeserialize: deserialize_google_protobuf_Empty,
+    requestSerialize: serialize_engine_api_GetEnclavesArgs,
+    requestDeserialize: deserialize_engine_api_GetEnclavesArgs,
     responseSerialize: serialize_engine_api_GetEnclavesResponse,
     responseDeserialize: deserialize_engine_api_GetEnclavesResponse,
   },
@@ -238,6 +447,78 @@ destroyEnclave: {
     responseSerialize: serialize_google_protobuf_Empty,
     responseDeserialize: deserialize_google_protobuf_Empty,
   },
+  // Makes an enclave expire after the given time-to-live, counting from now
+extendEnclaveTtl: {
+    path: '/engine_api.EngineService/ExtendEnclaveTtl',
+    requestStream: false,
+    responseStream: false,
+    requestType: engine_service_pb.ExtendEnclaveTtlArgs,
+    responseType: google_protobuf_empty_pb.Empty,
+    requestSerialize: serialize_engine_api_ExtendEnclaveTtlArgs,
+    requestDeserialize: deserialize_engine_api_ExtendEnclaveTtlArgs,
+    responseSerialize: serialize_google_protobuf_Empty,
+    responseDeserialize: deserialize_google_protobuf_Empty,
+  },
+  // Sets and removes labels of an enclave
+updateEnclaveLabels: {
+    path: '/engine_api.EngineService/UpdateEnclaveLabels',
+    requestStream: false,
+    responseStream: false,
+    requestType: engine_service_pb.UpdateEnclaveLabelsArgs,
+    responseType: google_protobuf_empty_pb.Empty,
+    requestSerialize: serialize_engine_api_UpdateEnclaveLabelsArgs,
+    requestDeserialize: deserialize_engine_api_UpdateEnclaveLabelsArgs,
+    responseSerialize: serialize_google_protobuf_Empty,
+    responseDeserialize: deserialize_google_protobuf_Empty,
+  },
+  // Changes the name of an existing enclave, keeping its UUID
+renameEnclave: {
+    path: '/engine_api.EngineService/RenameEnclave',
+    requestStream: false,
+    responseStream: false,
+    requestType: engine_service_pb.RenameEnclaveArgs,
+    responseType: google_protobuf_empty_pb.Empty,
+    requestSerialize: serialize_engine_api_RenameEnclaveArgs,
+    requestDeserialize: deserialize_engine_api_RenameEnclaveArgs,
+    responseSerialize: serialize_google_protobuf_Empty,
+    responseDeserialize: deserialize_google_protobuf_Empty,
+  },
+  // Creates a new enclave with the files artifacts, persistent directories and services of an existing one
+cloneEnclave: {
+    path: '/engine_api.EngineService/CloneEnclave',
+    requestStream: false,
+    responseStream: false,
+    requestType: engine_service_pb.CloneEnclaveArgs,
+    responseType: engine_service_pb.CloneEnclaveResponse,
+    requestSerialize: serialize_engine_api_CloneEnclaveArgs,
+    requestDeserialize: deserialize_engine_api_CloneEnclaveArgs,
+    responseSerialize: serialize_engine_api_CloneEnclaveResponse,
+    responseDeserialize: deserialize_engine_api_CloneEnclaveResponse,
+  },
+  // Streams an archive of an enclave, with its plan, files artifacts, persistent directories and mode, that ImportEnclave recreates it from
+exportEnclave: {
+    path: '/engine_api.EngineService/ExportEnclave',
+    requestStream: false,
+    responseStream: true,
+    requestType: engine_service_pb.ExportEnclaveArgs,
+    responseType: engine_service_pb.EnclaveArchiveChunk,
+    requestSerialize: serialize_engine_api_ExportEnclaveArgs,
+    requestDeserialize: deserialize_engine_api_ExportEnclaveArgs,
+    responseSerialize: serialize_engine_api_EnclaveArchiveChunk,
+    responseDeserialize: deserialize_engine_api_EnclaveArchiveChunk,
+  },
+  // Creates an enclave from an archive streamed by ExportEnclave, possibly on another engine
+importEnclave: {
+    path: '/engine_api.EngineService/ImportEnclave',
+    requestStream: true,
+    responseStream: false,
+    requestType: engine_service_pb.ImportEnclaveArgs,
+    responseType: engine_service_pb.ImportEnclaveResponse,
+    requestSerialize: serialize_engine_api_ImportEnclaveArgs,
+    requestDeserialize: deserialize_engine_api_ImportEnclaveArgs,
+    responseSerialize: serialize_engine_api_ImportEnclaveResponse,
+    responseDeserialize: deserialize_engine_api_ImportEnclaveResponse,
+  },
   // Gets rid of old enclaves
 clean: {
     path: '/engine_api.EngineService/Clean',
@@ -262,6 +543,66 @@ getServiceLogs: {
     responseSerialize: serialize_engine_api_GetServiceLogsResponse,
     responseDeserialize: deserialize_engine_api_GetServiceLogsResponse,
   },
+  // Streams what happens to the enclaves of the engine, starting from when the stream is opened
+watchEvents: {
+    path: '/engine_api.EngineService/WatchEvents',
+    requestStream: false,
+    responseStream: true,
+    requestType: engine_service_pb.WatchEventsArgs,
+    responseType: engine_service_pb.EngineEvent,
+    requestSerialize: serialize_engine_api_WatchEventsArgs,
+    requestDeserialize: deserialize_engine_api_WatchEventsArgs,
+    responseSerialize: serialize_engine_api_EngineEvent,
+    responseDeserialize: deserialize_engine_api_EngineEvent,
+  },
+  // Creates an API token, only available when the engine enforces API authentication
+createApiToken: {
+    path: '/engine_api.EngineService/CreateApiToken',
+    requestStream: false,
+    responseStream: false,
+    requestType: engine_service_pb.CreateApiTokenArgs,
+    responseType: engine_service_pb.CreateApiTokenResponse,
+    requestSerialize: serialize_engine_api_CreateApiTokenArgs,
+    requestDeserialize: deserialize_engine_api_CreateApiTokenArgs,
+    responseSerialize: serialize_engine_api_CreateApiTokenResponse,
+    responseDeserialize: deserialize_engine_api_CreateApiTokenResponse,
+  },
+  // Revokes an API token so that it can't be used anymore
+revokeApiToken: {
+    path: '/engine_api.EngineService/RevokeApiToken',
+    requestStream: false,
+    responseStream: false,
+    requestType: engine_service_pb.RevokeApiTokenArgs,
+    responseType: google_protobuf_empty_pb.Empty,
+    requestSerialize: serialize_engine_api_RevokeApiTokenArgs,
+    requestDeserialize: deserialize_engine_api_RevokeApiTokenArgs,
+    responseSerialize: serialize_google_protobuf_Empty,
+    responseDeserialize: deserialize_google_protobuf_Empty,
+  },
+  // Gets the changes made through the engine and the API containers of its running enclaves, newest first
+getAuditLog: {
+    path: '/engine_api.EngineService/GetAuditLog',
+    requestStream: false,
+    responseStream: false,
+    requestType: engine_service_pb.GetAuditLogArgs,
+    responseType: engine_service_pb.GetAuditLogResponse,
+    requestSerialize: serialize_engine_api_GetAuditLogArgs,
+    requestDeserialize: deserialize_engine_api_GetAuditLogArgs,
+    responseSerialize: serialize_engine_api_GetAuditLogResponse,
+    responseDeserialize: deserialize_engine_api_GetAuditLogResponse,
+  },
+  // Gets a credential proving who the caller is to the API container of an enclave, so that the calls it makes to the API container directly are attributed to it in the audit log
+getEnclaveCallerCredential: {
+    path: '/engine_api.EngineService/GetEnclaveCallerCredential',
+    requestStream: false,
+    responseStream: false,
+    requestType: engine_service_pb.GetEnclaveCallerCredentialArgs,
+    responseType: engine_service_pb.GetEnclaveCallerCredentialResponse,
+    requestSerialize: serialize_engine_api_GetEnclaveCallerCredentialArgs,
+    requestDeserialize: deserialize_engine_api_GetEnclaveCallerCredentialArgs,
+    responseSerialize: serialize_engine_api_GetEnclaveCallerCredentialResponse,
+    responseDeserialize: deserialize_engine_api_GetEnclaveCallerCredentialResponse,
+  },
 };
 
 exports.EngineServiceClient = grpc.makeGenericClientConstructor(EngineServiceService);
@@ -24,7 +24,7 @@ export class EngineServiceClient {
   ): grpcWeb.ClientReadableStream<engine_service_pb.CreateEnclaveResponse>;
 
   getEnclaves(
-    request: google_protobuf_empty_pb.Empty,
+    request: engine_service_pb.GetEnclavesArgs,
     metadata: grpcWeb.Metadata | undefined,
     callback: (err: grpcWeb.RpcError,
                response: engine_service_pb.GetEnclavesResponse) => void
@@ -58,6 +58,39 @@ export class EngineServiceClient {
                response: google_protobuf_empty_pb.Empty) => void
   ): grpcWeb.ClientReadableStream<google_protobuf_empty_pb.Empty>;
 
+  extendEnclaveTtl(
+    request: engine_service_pb.ExtendEnclaveTtlArgs,
+    metadata: grpcWeb.Metadata | undefined,
+    callback: (err: grpcWeb.RpcError,
+               response: google_protobuf_empty_pb.Empty) => void
+  ): grpcWeb.ClientReadableStream<google_protobuf_empty_pb.Empty>;
+
+  updateEnclaveLabels(
+    request: engine_service_pb.UpdateEnclaveLabelsArgs,
+    metadata: grpcWeb.Metadata | undefined,
+    callback: (err: grpcWeb.RpcError,
+               response: google_protobuf_empty_pb.Empty) => void
+  ): grpcWeb.ClientReadableStream<google_protobuf_empty_pb.Empty>;
+
+  renameEnclave(
+    request: engine_service_pb.RenameEnclaveArgs,
+    metadata: grpcWeb.Metadata | undefined,
+    callback: (err: grpcWeb.RpcError,
+               response: google_protobuf_empty_pb.Empty) => void
+  ): grpcWeb.ClientReadableStream<google_protobuf_empty_pb.Empty>;
+
+  cloneEnclave(
+    request: engine_service_pb.CloneEnclaveArgs,
+    metadata: grpcWeb.Metadata | undefined,
+    callback: (err: grpcWeb.RpcError,
+               response: engine_service_pb.CloneEnclaveResponse) => void
+  ): grpcWeb.ClientReadableStream<engine_service_pb.CloneEnclaveResponse>;
+
+  exportEnclave(
+    request: engine_service_pb.ExportEnclaveArgs,
+    metadata?: grpcWeb.Metadata
+  ): grpcWeb.ClientReadableStream<engine_service_pb.EnclaveArchiveChunk>;
+
   clean(
     request: engine_service_pb.CleanArgs,
     metadata: grpcWeb.Metadata | undefined,
@@ -70,6 +103,39 @@ export class EngineServiceClient {
     metadata?: grpcWeb.Metadata
   ): grpcWeb.ClientReadableStream<engine_service_pb.GetServiceLogsResponse>;
 
+  watchEvents(
+    request: engine_service_pb.WatchEventsArgs,
+    metadata?: grpcWeb.Metadata
+  ): grpcWeb.ClientReadableStream<engine_service_pb.EngineEvent>;
+
+  createApiToken(
+    request: engine_service_pb.CreateApiTokenArgs,
+    metadata: grpcWeb.Metadata | undefined,
+    callback: (err: grpcWeb.RpcError,
+               response: engine_service_pb.CreateApiTokenResponse) => void
+  ): grpcWeb.ClientReadableStream<engine_service_pb.CreateApiTokenResponse>;
+
+  revokeApiToken(
+    request: engine_service_pb.RevokeApiTokenArgs,
+    metadata: grpcWeb.Metadata | undefined,
+    callback: (err: grpcWeb.RpcError,
+               response: google_protobuf_empty_pb.Empty) => void
+  ): grpcWeb.ClientReadableStream<google_protobuf_empty_pb.Empty>;
+
+  getAuditLog(
+    request: engine_service_pb.GetAuditLogArgs,
+    metadata: grpcWeb.Metadata | undefined,
+    callback: (err: grpcWeb.RpcError,
+               response: engine_service_pb.GetAuditLogResponse) => void
+  ): grpcWeb.ClientReadableStream<engine_service_pb.GetAuditLogResponse>;
+
+  getEnclaveCallerCredential(
+    request: engine_service_pb.GetEnclaveCallerCredentialArgs,
+    metadata: grpcWeb.Metadata | undefined,
+    callback: (err: grpcWeb.RpcError,
+               response: engine_service_pb.GetEnclaveCallerCredentialResponse) => void
+  ): grpcWeb.ClientReadableStream<engine_service_pb.GetEnclaveCallerCredentialResponse>;
+
 }
 
 export class EngineServicePromiseClient {
@@ -88,7 +154,7 @@ export class EngineServicePromiseClient {
   ): Promise<engine_service_pb.CreateEnclaveResponse>;
 
   getEnclaves(
-    request: google_protobuf_empty_pb.Empty,
+    request: engine_service_pb.GetEnclavesArgs,
     metadata?: grpcWeb.Metadata
   ): Promise<engine_service_pb.GetEnclavesResponse>;
 
@@ -112,6 +178,31 @@ export class EngineServicePromiseClient {
     metadata?: grpcWeb.Metadata
   ): Promise<google_protobuf_empty_pb.Empty>;
 
+  extendEnclaveTtl(
+    request: engine_service_pb.ExtendEnclaveTtlArgs,
+    metadata?: grpcWeb.Metadata
+  ): Promise<google_protobuf_empty_pb.Empty>;
+
+  updateEnclaveLabels(
+    request: engine_service_pb.UpdateEnclaveLabelsArgs,
+    metadata?: grpcWeb.Metadata
+  ): Promise<google_protobuf_empty_pb.Empty>;
+
+  renameEnclave(
+    request: engine_service_pb.RenameEnclaveArgs,
+    metadata?: grpcWeb.Metadata
+  ): Promise<google_protobuf_empty_pb.Empty>;
+
+  cloneEnclave(
+    request: engine_service_pb.CloneEnclaveArgs,
+    metadata?: grpcWeb.Metadata
+  ): Promise<engine_service_pb.CloneEnclaveResponse>;
+
+  exportEnclave(
+    request: engine_service_pb.ExportEnclaveArgs,
+    metadata?: grpcWeb.Metadata
+  ): grpcWeb.ClientReadableStream<engine_service_pb.EnclaveArchiveChunk>;
+
   clean(
     request: engine_service_pb.CleanArgs,
     metadata?: grpcWeb.Metadata
@@ -122,5 +213,30 @@ export class EngineServicePromiseClient {
     metadata?: grpcWeb.Metadata
   ): grpcWeb.ClientReadableStream<engine_service_pb.GetServiceLogsResponse>;
 
+  watchEvents(
+    request: engine_service_pb.WatchEventsArgs,
+    metadata?: grpcWeb.Metadata
+  ): grpcWeb.ClientReadableStream<engine_service_pb.EngineEvent>;
+
+  createApiToken(
+    request: engine_service_pb.CreateApiTokenArgs,
+    metadata?: grpcWeb.Metadata
+  ): Promise<engine_service_pb.CreateApiTokenResponse>;
+
+  revokeApiToken(
+    request: engine_service_pb.RevokeApiTokenArgs,
+    metadata?: grpcWeb.Metadata
+  ): Promise<google_protobuf_empty_pb.Empty>;
+
+  getAuditLog(
+    request: engine_service_pb.GetAuditLogArgs,
+    metadata?: grpcWeb.Metadata
+  ): Promise<engine_service_pb.GetAuditLogResponse>;
+
+  getEnclaveCallerCredential(
+    request: engine_service_pb.GetEnclaveCallerCredentialArgs,
+    metadata?: grpcWeb.Metadata
+  ): Promise<engine_service_pb.GetEnclaveCallerCredentialResponse>;
+
 }
 
@@ -203,16 +203,16 @@ proto.engine_api.EngineServicePromiseClient.prototype.createEnclave =
 /**
  * @const
  * @type {!grpc.web.MethodDescriptor<
- *   !proto.google.protobuf.Empty,
+ *   !proto.engine_api.GetEnclavesArgs,
  *   !proto.engine_api.GetEnclavesResponse>}
  */
 const methodDescriptor_EngineService_GetEnclaves = new grpc.web.MethodDescriptor(
   '/engine_api.EngineService/GetEnclaves',
   grpc.web.MethodType.UNARY,
-  google_protobuf_empty_pb.Empty,
+  proto.engine_api.GetEnclavesArgs,
   proto.engine_api.GetEnclavesResponse,
   /**
-   * @param {!proto.google.protobuf.Empty} request
+   * @param {!proto.engine_api.GetEnclavesArgs} request
    * @return {!Uint8Array}
    */
   function(request) {
@@ -223,7 +223,7 @@ const methodDescriptor_EngineService_GetEnclaves = new grpc.web.MethodDescriptor
 
 
 /**
- * @param {!proto.google.protobuf.Empty} request The
+ * @param {!proto.engine_api.GetEnclavesArgs} request The
  *     request proto
  * @param {?Object<string, string>} metadata User defined
  *     call metadata
@@ -244,7 +244,7 @@ proto.engine_api.EngineServiceClient.prototype.getEnclaves =
 
 
 /**
- * @param {!proto.google.protobuf.Empty} request The
+ * @param {!proto.engine_api.GetEnclavesArgs} request The
  *     request proto
  * @param {?Object<string, string>=} metadata User defined
  *     call metadata
@@ -505,6 +505,306 @@ proto.engine_api.EngineServicePromiseClient.prototype.destroyEnclave =
 };
 
 
+/**
+ * @const
+ * @type {!grpc.web.MethodDescriptor<
+ *   !proto.engine_api.ExtendEnclaveTtlArgs,
+ *   !proto.google.protobuf.Empty>}
+ */
+const methodDescriptor_EngineService_ExtendEnclaveTtl = new grpc.web.MethodDescriptor(
+  '/engine_api.EngineService/ExtendEnclaveTtl',
+  grpc.web.MethodType.UNARY,
+  proto.engine_api.ExtendEnclaveTtlArgs,
+  google_protobuf_empty_pb.Empty,
+  /**
+   * @param {!proto.engine_api.ExtendEnclaveTtlArgs} request
+   * @return {!Uint8Array}
+   */
+  function(request) {
+    return request.serializeBinary();
+  },
+  google_protobuf_empty_pb.Empty.deserializeBinary
+);
+
+
+/**
+ * @param {!proto.engine_api.ExtendEnclaveTtlArgs} request The
+ *     request proto
+ * @param {?Object<string, string>} metadata User defined
+ *     call metadata
+ * @param {function(?grpc.web.RpcError, ?proto.google.protobuf.Empty)}
+ *     callback The callback function(error, response)
+ * @return {!grpc.web.ClientReadableStream<!proto.google.protobuf.Empty>|undefined}
+ *     The XHR Node Readable Stream
+ */
+proto.engine_api.EngineServiceClient.prototype.extendEnclaveTtl =
+    function(request, metadata, callback) {
+  return this.client_.rpcCall(this.hostname_ +
+      '/engine_api.EngineService/ExtendEnclaveTtl',
+      request,
+      metadata || {},
+      methodDescriptor_EngineService_ExtendEnclaveTtl,
+      callback);
+};
+
+
+/**
+ * @param {!proto.engine_api.ExtendEnclaveTtlArgs} request The
+ *     request proto
+ * @param {?Object<string, string>=} metadata User defined
+ *     call metadata
+ * @return {!Promise<!proto.google.protobuf.Empty>}
+ *     Promise that resolves to the response
+ */
+proto.engine_api.EngineServicePromiseClient.prototype.extendEnclaveTtl =
+    function(request, metadata) {
+  return this.client_.unaryCall(this.hostname_ +
+      '/engine_api.EngineService/ExtendEnclaveTtl',
+      request,
+      metadata || {},
+      methodDescriptor_EngineService_ExtendEnclaveTtl);
+};
+
+
+/**
+ * @const
+ * @type {!grpc.web.MethodDescriptor<
+ *   !proto.engine_api.UpdateEnclaveLabelsArgs,
+ *   !proto.google.protobuf.Empty>}
+ */
+const methodDescriptor_EngineService_UpdateEnclaveLabels = new grpc.web.MethodDescriptor(
+  '/engine_api.EngineService/UpdateEnclaveLabels',
+  grpc.web.MethodType.UNARY,
+  proto.engine_api.UpdateEnclaveLabelsArgs,
+  google_protobuf_empty_pb.Empty,
+  /**
+   * @param {!proto.engine_api.UpdateEnclaveLabelsArgs} request
+   * @return {!Uint8Array}
+   */
+  function(request) {
+    return request.serializeBinary();
+  },
+  google_protobuf_empty_pb.Empty.deserializeBinary
+);
+
+
+/**
+ * @param {!proto.engine_api.UpdateEnclaveLabelsArgs} request The
+ *     request proto
+ * @param {?Object<string, string>} metadata User defined
+ *     call metadata
+ * @param {function(?grpc.web.RpcError, ?proto.google.protobuf.Empty)}
+ *     callback The callback function(error, response)
+ * @return {!grpc.web.ClientReadableStream<!proto.google.protobuf.Empty>|undefined}
+ *     The XHR Node Readable Stream
+ */
+proto.engine_api.EngineServiceClient.prototype.updateEnclaveLabels =
+    function(request, metadata, callback) {
+  return this.client_.rpcCall(this.hostname_ +
+      '/engine_api.EngineService/UpdateEnclaveLabels',
+      request,
+      metadata || {},
+      methodDescriptor_EngineService_UpdateEnclaveLabels,
+      callback);
+};
+
+
+/**
+ * @param {!proto.engine_api.UpdateEnclaveLabelsArgs} request The
+ *     request proto
+ * @param {?Object<string, string>=} metadata User defined
+ *     call metadata
+ * @return {!Promise<!proto.google.protobuf.Empty>}
+ *     Promise that resolves to the response
+ */
+proto.engine_api.EngineServicePromiseClient.prototype.updateEnclaveLabels =
+    function(request, metadata) {
+  return this.client_.unaryCall(this.hostname_ +
+      '/engine_api.EngineService/UpdateEnclaveLabels',
+      request,
+      metadata || {},
+      methodDescriptor_EngineService_UpdateEnclaveLabels);
+};
+
+
+/**
+ * @const
+ * @type {!grpc.web.MethodDescriptor<
+ *   !proto.engine_api.RenameEnclaveArgs,
+ *   !proto.google.protobuf.Empty>}
+ */
+const methodDescriptor_EngineService_RenameEnclave = new grpc.web.MethodDescriptor(
+  '/engine_api.EngineService/RenameEnclave',
+  grpc.web.MethodType.UNARY,
+  proto.engine_api.RenameEnclaveArgs,
+  google_protobuf_empty_pb.Empty,
+  /**
+   * @param {!proto.engine_api.RenameEnclaveArgs} request
+   * @return {!Uint8Array}
+   */
+  function(request) {
+    return request.serializeBinary();
+  },
+  google_protobuf_empty_pb.Empty.deserializeBinary
+);
+
+
+/**
+ * @param {!proto.engine_api.RenameEnclaveArgs} request The
+ *     request proto
+ * @param {?Object<string, string>} metadata User defined
+ *     call metadata
+ * @param {function(?grpc.web.RpcError, ?proto.google.protobuf.Empty)}
+ *     callback The callback function(error, response)
+ * @return {!grpc.web.ClientReadableStream<!proto.google.protobuf.Empty>|undefined}
+ *     The XHR Node Readable Stream
+ */
+proto.engine_api.EngineServiceClient.prototype.renameEnclave =
+    function(request, metadata, callback) {
+  return this.client_.rpcCall(this.hostname_ +
+      '/engine_api.EngineService/RenameEnclave',
+      request,
+      metadata || {},
+      methodDescriptor_EngineService_RenameEnclave,
+      callback);
+};
+
+
+/**
+ * @param {!proto.engine_api.RenameEnclaveArgs} request The
+ *     request proto
+ * @param {?Object<string, string>=} metadata User defined
+ *     call metadata
+ * @return {!Promise<!proto.google.protobuf.Empty>}
+ *     Promise that resolves to the response
+ */
+proto.engine_api.EngineServicePromiseClient.prototype.renameEnclave =
+    function(request, metadata) {
+  return this.client_.unaryCall(this.hostname_ +
+      '/engine_api.EngineService/RenameEnclave',
+      request,
+      metadata || {},
+      methodDescriptor_EngineService_RenameEnclave);
+};
+
+
+/**
+ * @const
+ * @type {!grpc.web.MethodDescriptor<
+ *   !proto.engine_api.CloneEnclaveArgs,
+ *   !proto.engine_api.CloneEnclaveResponse>}
+ */
+const methodDescriptor_EngineService_CloneEnclave = new grpc.web.MethodDescriptor(
+  '/engine_api.EngineService/CloneEnclave',
+  grpc.web.MethodType.UNARY,
+  proto.engine_api.CloneEnclaveArgs,
+  proto.engine_api.CloneEnclaveResponse,
+  /**
+   * @param {!proto.engine_api.CloneEnclaveArgs} request
+   * @return {!Uint8Array}
+   */
+  function(request) {
+    return request.serializeBinary();
+  },
+  proto.engine_api.CloneEnclaveResponse.deserializeBinary
+);
+
+
+/**
+ * @param {!proto.engine_api.CloneEnclaveArgs} request The
+ *     request proto
+ * @param {?Object<string, string>} metadata User defined
+ *     call metadata
+ * @param {function(?grpc.web.RpcError, ?proto.engine_api.CloneEnclaveResponse)}
+ *     callback The callback function(error, response)
+ * @return {!grpc.web.ClientReadableStream<!proto.engine_api.CloneEnclaveResponse>|undefined}
+ *     The XHR Node Readable Stream
+ */
+proto.engine_api.EngineServiceClient.prototype.cloneEnclave =
+    function(request, metadata, callback) {
+  return this.client_.rpcCall(this.hostname_ +
+      '/engine_api.EngineService/CloneEnclave',
+      request,
+      metadata || {},
+      methodDescriptor_EngineService_CloneEnclave,
+      callback);
+};
+
+
+/**
+ * @param {!proto.engine_api.CloneEnclaveArgs} request The
+ *     request proto
+ * @param {?Object<string, string>=} metadata User defined
+ *     call metadata
+ * @return {!Promise<!proto.engine_api.CloneEnclaveResponse>}
+ *     Promise that resolves to the response
+ */
+proto.engine_api.EngineServicePromiseClient.prototype.cloneEnclave =
+    function(request, metadata) {
+  return this.client_.unaryCall(this.hostname_ +
+      '/engine_api.EngineService/CloneEnclave',
+      request,
+      metadata || {},
+      methodDescriptor_EngineService_CloneEnclave);
+};
+
+
+/**
+ * @const
+ * @type {!grpc.web.MethodDescriptor<
+ *   !proto.engine_api.ExportEnclaveArgs,
+ *   !proto.engine_api.EnclaveArchiveChunk>}
+ */
+const methodDescriptor_EngineService_ExportEnclave = new grpc.web.MethodDescriptor(
+  '/engine_api.EngineService/ExportEnclave',
+  grpc.web.MethodType.SERVER_STREAMING,
+  proto.engine_api.ExportEnclaveArgs,
+  proto.engine_api.EnclaveArchiveChunk,
+  /**
+   * @param {!proto.engine_api.ExportEnclaveArgs} request
+   * @return {!Uint8Array}
+   */
+  function(request) {
+    return request.serializeBinary();
+  },
+  proto.engine_api.EnclaveArchiveChunk.deserializeBinary
+);
+
+
+/**
+ * @param {!proto.engine_api.ExportEnclaveArgs} request The request proto
+ * @param {?Object<string, string>=} metadata User defined
+ *     call metadata
+ * @return {!grpc.web.ClientReadableStream<!proto.engine_api.EnclaveArchiveChunk>}
+ *     The XHR Node Readable Stream
+ */
+proto.engine_api.EngineServiceClient.prototype.exportEnclave =
+    function(request, metadata) {
+  return this.client_.serverStreaming(this.hostname_ +
+      '/engine_api.EngineService/ExportEnclave',
+      request,
+      metadata || {},
+      methodDescriptor_EngineService_ExportEnclave);
+};
+
+
+/**
+ * @param {!proto.engine_api.ExportEnclaveArgs} request The request proto
+ * @param {?Object<string, string>=} metadata User defined
+ *     call metadata
+ * @return {!grpc.web.ClientReadableStream<!proto.engine_api.EnclaveArchiveChunk>}
+ *     The XHR Node Readable Stream
+ */
+proto.engine_api.EngineServicePromiseClient.prototype.exportEnclave =
+    function(request, metadata) {
+  return this.client_.serverStreaming(this.hostname_ +
+      '/engine_api.EngineService/ExportEnclave',
+      request,
+      metadata || {},
+      methodDescriptor_EngineService_ExportEnclave);
+};
+
+
 /**
  * @const
  * @type {!grpc.web.MethodDescriptor<
@@ -622,5 +922,305 @@ proto.engine_api.EngineServicePromiseClient.prototype.getServiceLogs =
 };
 
 
+/**
+ * @const
+ * @type {!grpc.web.MethodDescriptor<
+ *   !proto.engine_api.WatchEventsArgs,
+ *   !proto.engine_api.EngineEvent>}
+ */
+const methodDescriptor_EngineService_WatchEvents = new grpc.web.MethodDescriptor(
+  '/engine_api.EngineService/WatchEvents',
+  grpc.web.MethodType.SERVER_STREAMING,
+  proto.engine_api.WatchEventsArgs,
+  proto.engine_api.EngineEvent,
+  /**
+   * @param {!proto.engine_api.WatchEventsArgs} request
+   * @return {!Uint8Array}
+   */
+  function(request) {
+    return request.serializeBinary();
+  },
+  proto.engine_api.EngineEvent.deserializeBinary
+);
+
+
+/**
+ * @param {!proto.engine_api.WatchEventsArgs} request The request proto
+ * @param {?Object<string, string>=} metadata User defined
+ *     call metadata
+ * @return {!grpc.web.ClientReadableStream<!proto.engine_api.EngineEvent>}
+ *     The XHR Node Readable Stream
+ */
+proto.engine_api.EngineServiceClient.prototype.watchEvents =
+    function(request, metadata) {
+  return this.client_.serverStreaming(this.hostname_ +
+      '/engine_api.EngineService/WatchEvents',
+      request,
+      metadata || {},
+      methodDescriptor_EngineService_WatchEvents);
+};
+
+
+/**
+ * @param {!proto.engine_api.WatchEventsArgs} request The request proto
+ * @param {?Object<string, string>=} metadata User defined
+ *     call metadata
+ * @return {!grpc.web.ClientReadableStream<!proto.engine_api.EngineEvent>}
+ *     The XHR Node Readable Stream
+ */
+proto.engine_api.EngineServicePromiseClient.prototype.watchEvents =
+    function(request, metadata) {
+  return this.client_.serverStreaming(this.hostname_ +
+      '/engine_api.EngineService/WatchEvents',
+      request,
+      metadata || {},
+      methodDescriptor_EngineService_WatchEvents);
+};
+
+
+/**
+ * @const
+ * @type {!grpc.web.MethodDescriptor<
+ *   !proto.engine_api.CreateApiTokenArgs,
+ *   !proto.engine_api.CreateApiTokenResponse>}
+ */
+const methodDescriptor_EngineService_CreateApiToken = new grpc.web.MethodDescriptor(
+  '/engine_api.EngineService/CreateApiToken',
+  grpc.web.MethodType.UNARY,
+  proto.engine_api.CreateApiTokenArgs,
+  proto.engine_api.CreateApiTokenResponse,
+  /**
+   * @param {!proto.engine_api.CreateApiTokenArgs} request
+   * @return {!Uint8Array}
+   */
+  function(request) {
+    return request.serializeBinary();
+  },
+  proto.engine_api.CreateApiTokenResponse.deserializeBinary
+);
+
+
+/**
+ * @param {!proto.engine_api.CreateApiTokenArgs} request The
+ *     request proto
+ * @param {?Object<string, string>} metadata User defined
+ *     call metadata
+ * @param {function(?grpc.web.RpcError, ?proto.engine_api.CreateApiTokenResponse)}
+ *     callback The callback function(error, response)
+ * @return {!grpc.web.ClientReadableStream<!proto.engine_api.CreateApiTokenResponse>|undefined}
+ *     The XHR Node Readable Stream
+ */
+proto.engine_api.EngineServiceClient.prototype.createApiToken =
+    function(request, metadata, callback) {
+  return this.client_.rpcCall(this.hostname_ +
+      '/engine_api.EngineService/CreateApiToken',
+      request,
+      metadata || {},
+      methodDescriptor_EngineService_CreateApiToken,
+      callback);
+};
+
+
+/**
+ * @param {!proto.engine_api.CreateApiTokenArgs} request The
+ *     request proto
+ * @param {?Object<string, string>=} metadata User defined
+ *     call metadata
+ * @return {!Promise<!proto.engine_api.CreateApiTokenResponse>}
+ *     Promise that resolves to the response
+ */
+proto.engine_api.EngineServicePromiseClient.prototype.createApiToken =
+    function(request, metadata) {
+  return this.client_.unaryCall(this.hostname_ +
+      '/engine_api.EngineService/CreateApiToken',
+      request,
+      metadata || {},
+      methodDescriptor_EngineService_CreateApiToken);
+};
+
+
+/**
+ * @const
+ * @type {!grpc.web.MethodDescriptor<
+ *   !proto.engine_api.RevokeApiTokenArgs,
+ *   !proto.google.protobuf.Empty>}
+ */
+const methodDescriptor_EngineService_RevokeApiToken = new grpc.web.MethodDescriptor(
+  '/engine_api.EngineService/RevokeApiToken',
+  grpc.web.MethodType.UNARY,
+  proto.engine_api.RevokeApiTokenArgs,
+  google_protobuf_empty_pb.Empty,
+  /**
+   * @param {!proto.engine_api.RevokeApiTokenArgs} request
+   * @return {!Uint8Array}
+   */
+  function(request) {
+    return request.serializeBinary();
+  },
+  google_protobuf_empty_pb.Empty.deserializeBinary
+);
+
+
+/**
+ * @param {!proto.engine_api.RevokeApiTokenArgs} request The
+ *     request proto
+ * @param {?Object<string, string>} metadata User defined
+ *     call metadata
+ * @param {function(?grpc.web.RpcError, ?proto.google.protobuf.Empty)}
+ *     callback The callback function(error, response)
+ * @return {!grpc.web.ClientReadableStream<!proto.google.protobuf.Empty>|undefined}
+ *     The XHR Node Readable Stream
+ */
+proto.engine_api.EngineServiceClient.prototype.revokeApiToken =
+    function(request, metadata, callback) {
+  return this.client_.rpcCall(this.hostname_ +
+      '/engine_api.EngineService/RevokeApiToken',
+      request,
+      metadata || {},
+      methodDescriptor_EngineService_RevokeApiToken,
+      callback);
+};
+
+
+/**
+ * @param {!proto.engine_api.RevokeApiTokenArgs} request The
+ *     request proto
+ * @param {?Object<string, string>=} metadata User defined
+ *     call metadata
+ * @return {!Promise<!proto.google.protobuf.Empty>}
+ *     Promise that resolves to the response
+ */
+proto.engine_api.EngineServicePromiseClient.prototype.revokeApiToken =
+    function(request, metadata) {
+  return this.client_.unaryCall(this.hostname_ +
+      '/engine_api.EngineService/RevokeApiToken',
+      request,
+      metadata || {},
+      methodDescriptor_EngineService_RevokeApiToken);
+};
+
+
+/**
+ * @const
+ * @type {!grpc.web.MethodDescriptor<
+ *   !proto.engine_api.GetAuditLogArgs,
+ *   !proto.engine_api.GetAuditLogResponse>}
+ */
+const methodDescriptor_EngineService_GetAuditLog = new grpc.web.MethodDescriptor(
+  '/engine_api.EngineService/GetAuditLog',
+  grpc.web.MethodType.UNARY,
+  proto.engine_api.GetAuditLogArgs,
+  proto.engine_api.GetAuditLogResponse,
+  /**
+   * @param {!proto.engine_api.GetAuditLogArgs} request
+   * @return {!Uint8Array}
+   */
+  function(request) {
+    return request.serializeBinary();
+  },
+  proto.engine_api.GetAuditLogResponse.deserializeBinary
+);
+
+
+/**
+ * @param {!proto.engine_api.GetAuditLogArgs} request The
+ *     request proto
+ * @param {?Object<string, string>} metadata User defined
+ *     call metadata
+ * @param {function(?grpc.web.RpcError, ?proto.engine_api.GetAuditLogResponse)}
+ *     callback The callback function(error, response)
+ * @return {!grpc.web.ClientReadableStream<!proto.engine_api.GetAuditLogResponse>|undefined}
+ *     The XHR Node Readable Stream
+ */
+proto.engine_api.EngineServiceClient.prototype.getAuditLog =
+    function(request, metadata, callback) {
+  return this.client_.rpcCall(this.hostname_ +
+      '/engine_api.EngineService/GetAuditLog',
+      request,
+      metadata || {},
+      methodDescriptor_EngineService_GetAuditLog,
+      callback);
+};
+
+
+/**
+ * @param {!proto.engine_api.GetAuditLogArgs} request The
+ *     request proto
+ * @param {?Object<string, string>=} metadata User defined
+ *     call metadata
+ * @return {!Promise<!proto.engine_api.GetAuditLogResponse>}
+ *     Promise that resolves to the response
+ */
+proto.engine_api.EngineServicePromiseClient.prototype.getAuditLog =
+    function(request, metadata) {
+  return this.client_.unaryCall(this.hostname_ +
+      '/engine_api.EngineService/GetAuditLog',
+      request,
+      metadata || {},
+      methodDescriptor_EngineService_GetAuditLog);
+};
+
+
+/**
+ * @const
+ * @type {!grpc.web.MethodDescriptor<
+ *   !proto.engine_api.GetEnclaveCallerCredentialArgs,
+ *   !proto.engine_api.GetEnclaveCallerCredentialResponse>}
+ */
+const methodDescriptor_EngineService_GetEnclaveCallerCredential = new grpc.web.MethodDescriptor(
+  '/engine_api.EngineService/GetEnclaveCallerCredential',
+  grpc.web.MethodType.UNARY,
+  proto.engine_api.GetEnclaveCallerCredentialArgs,
+  proto.engine_api.GetEnclaveCallerCredentialResponse,
+  /**
+   * @param {!proto.engine_api.GetEnclaveCallerCredentialArgs} request
+   * @return {!Uint8Array}
+   */
+  function(request) {
+    return request.serializeBinary();
+  },
+  proto.engine_api.GetEnclaveCallerCredentialResponse.deserializeBinary
+);
+
+
+/**
+ * @param {!proto.engine_api.GetEnclaveCallerCredentialArgs} request The
+ *     request proto
+ * @param {?Object<string, string>} metadata User defined
+ *     call metadata
+ * @param {function(?grpc.web.RpcError, ?proto.engine_api.GetEnclaveCallerCredentialResponse)}
+ *     callback The callback function(error, response)
+ * @return {!grpc.web.ClientReadableStream<!proto.engine_api.GetEnclaveCallerCredentialResponse>|undefined}
+ *     The XHR Node Readable Stream
+ */
+proto.engine_api.EngineServiceClient.prototype.getEnclaveCallerCredential =
+    function(request, metadata, callback) {
+  return this.client_.rpcCall(this.hostname_ +
+      '/engine_api.EngineService/GetEnclaveCallerCredential',
+      request,
+      metadata || {},
+      methodDescriptor_EngineService_GetEnclaveCallerCredential,
+      callback);
+};
+
+
+/**
+ * @param {!proto.engine_api.GetEnclaveCallerCredentialArgs} request The
+ *     request proto
+ * @param {?Object<string, string>=} metadata User defined
+ *     call metadata
+ * @return {!Promise<!proto.engine_api.GetEnclaveCallerCredentialResponse>}
+ *     Promise that resolves to the response
+ */
+proto.engine_api.EngineServicePromiseClient.prototype.getEnclaveCallerCredential =
+    function(request, metadata) {
+  return this.client_.unaryCall(this.hostname_ +
+      '/engine_api.EngineService/GetEnclaveCallerCredential',
+      request,
+      metadata || {},
+      methodDescriptor_EngineService_GetEnclaveCallerCredential);
+};
+
+
 module.exports = proto.engine_api;
 
@@ -8,6 +8,21 @@ export class GetEngineInfoResponse extends jspb.Message {
   getEngineVersion(): string;
   setEngineVersion(value: string): GetEngineInfoResponse;
 
+  getLogRetentionPeriod(): string;
+  setLogRetentionPeriod(value: string): GetEngineInfoResponse;
+
+  getLogLevel(): string;
+  setLogLevel(value: string): GetEngineInfoResponse;
+
+  getPoolSize(): number;
+  setPoolSize(value: number): GetEngineInfoResponse;
+
+  getIsDebugModeEnabled(): boolean;
+  setIsDebugModeEnabled(value: boolean): GetEngineInfoResponse;
+
+  getDomain(): string;
+  setDomain(value: string): GetEngineInfoResponse;
+
   serializeBinary(): Uint8Array;
   toObject(includeInstance?: boolean): GetEngineInfoResponse.AsObject;
   static toObject(includeInstance: boolean, msg: GetEngineInfoResponse): GetEngineInfoResponse.AsObject;
@@ -19,6 +34,11 @@ export class GetEngineInfoResponse extends jspb.Message {
 export namespace GetEngineInfoResponse {
   export type AsObject = {
     engineVersion: string,
+    logRetentionPeriod: string,
+    logLevel: string,
+    poolSize: number,
+    isDebugModeEnabled: boolean,
+    domain: string,
   }
 }
 
@@ -48,6 +68,34 @@ export class CreateEnclaveArgs extends jspb.Message {
   hasShouldApicRunInDebugMode(): boolean;
   clearShouldApicRunInDebugMode(): CreateEnclaveArgs;
 
+  getTtlSeconds(): number;
+  setTtlSeconds(value: number): CreateEnclaveArgs;
+  hasTtlSeconds(): boolean;
+  clearTtlSeconds(): CreateEnclaveArgs;
+
+  getIdleTimeoutSeconds(): number;
+  setIdleTimeoutSeconds(value: number): CreateEnclaveArgs;
+  hasIdleTimeoutSeconds(): boolean;
+  clearIdleTimeoutSeconds(): CreateEnclaveArgs;
+
+  getExpiredEnclaveAction(): ExpiredEnclaveAction;
+  setExpiredEnclaveAction(value: ExpiredEnclaveAction): CreateEnclaveArgs;
+  hasExpiredEnclaveAction(): boolean;
+  clearExpiredEnclaveAction(): CreateEnclaveArgs;
+
+  getLabelsMap(): jspb.Map<string, string>;
+  clearLabelsMap(): CreateEnclaveArgs;
+
+  getSeedPackageLocator(): string;
+  setSeedPackageLocator(value: string): CreateEnclaveArgs;
+  hasSeedPackageLocator(): boolean;
+  clearSeedPackageLocator(): CreateEnclaveArgs;
+
+  getSeedPackageParams(): string;
+  setSeedPackageParams(value: string): CreateEnclaveArgs;
+  hasSeedPackageParams(): boolean;
+  clearSeedPackageParams(): CreateEnclaveArgs;
+
   serializeBinary(): Uint8Array;
   toObject(includeInstance?: boolean): CreateEnclaveArgs.AsObject;
   static toObject(includeInstance: boolean, msg: CreateEnclaveArgs): CreateEnclaveArgs.AsObject;
@@ -63,6 +111,12 @@ export namespace CreateEnclaveArgs {
     apiContainerLogLevel?: string,
     mode?: EnclaveMode,
     shouldApicRunInDebugMode?: boolean,
+    ttlSeconds?: number,
+    idleTimeoutSeconds?: number,
+    expiredEnclaveAction?: ExpiredEnclaveAction,
+    labelsMap: Array<[string, string]>,
+    seedPackageLocator?: string,
+    seedPackageParams?: string,
   }
 
   export enum EnclaveNameCase { 
@@ -89,6 +143,31 @@ export namespace CreateEnclaveArgs {
     _SHOULD_APIC_RUN_IN_DEBUG_MODE_NOT_SET = 0,
     SHOULD_APIC_RUN_IN_DEBUG_MODE = 5,
   }
+
+  export enum TtlSecondsCase { 
+    _TTL_SECONDS_NOT_SET = 0,
+    TTL_SECONDS = 6,
+  }
+
+  export enum IdleTimeoutSecondsCase { 
+    _IDLE_TIMEOUT_SECONDS_NOT_SET = 0,
+    IDLE_TIMEOUT_SECONDS = 7,
+  }
+
+  export enum ExpiredEnclaveActionCase { 
+    _EXPIRED_ENCLAVE_ACTION_NOT_SET = 0,
+    EXPIRED_ENCLAVE_ACTION = 8,
+  }
+
+  export enum SeedPackageLocatorCase { 
+    _SEED_PACKAGE_LOCATOR_NOT_SET = 0,
+    SEED_PACKAGE_LOCATOR = 10,
+  }
+
+  export enum SeedPackageParamsCase { 
+    _SEED_PACKAGE_PARAMS_NOT_SET = 0,
+    SEED_PACKAGE_PARAMS = 11,
+  }
 }
 
 export class CreateEnclaveResponse extends jspb.Message {
@@ -197,6 +276,14 @@ export class EnclaveInfo extends jspb.Message {
   getMode(): EnclaveMode;
   setMode(value: EnclaveMode): EnclaveInfo;
 
+  getExpiration(): EnclaveExpiration | undefined;
+  setExpiration(value?: EnclaveExpiration): EnclaveInfo;
+  hasExpiration(): boolean;
+  clearExpiration(): EnclaveInfo;
+
+  getLabelsMap(): jspb.Map<string, string>;
+  clearLabelsMap(): EnclaveInfo;
+
   serializeBinary(): Uint8Array;
   toObject(includeInstance?: boolean): EnclaveInfo.AsObject;
   static toObject(includeInstance: boolean, msg: EnclaveInfo): EnclaveInfo.AsObject;
@@ -216,6 +303,61 @@ export namespace EnclaveInfo {
     apiContainerHostMachineInfo?: EnclaveAPIContainerHostMachineInfo.AsObject,
     creationTime?: google_protobuf_timestamp_pb.Timestamp.AsObject,
     mode: EnclaveMode,
+    expiration?: EnclaveExpiration.AsObject,
+    labelsMap: Array<[string, string]>,
+  }
+}
+
+export class EnclaveExpiration extends jspb.Message {
+  getExpirationTime(): google_protobuf_timestamp_pb.Timestamp | undefined;
+  setExpirationTime(value?: google_protobuf_timestamp_pb.Timestamp): EnclaveExpiration;
+  hasExpirationTime(): boolean;
+  clearExpirationTime(): EnclaveExpiration;
+
+  getIdleTimeoutSeconds(): number;
+  setIdleTimeoutSeconds(value: number): EnclaveExpiration;
+  hasIdleTimeoutSeconds(): boolean;
+  clearIdleTimeoutSeconds(): EnclaveExpiration;
+
+  getAction(): ExpiredEnclaveAction;
+  setAction(value: ExpiredEnclaveAction): EnclaveExpiration;
+
+  serializeBinary(): Uint8Array;
+  toObject(includeInstance?: boolean): EnclaveExpiration.AsObject;
+  static toObject(includeInstance: boolean, msg: EnclaveExpiration): EnclaveExpiration.AsObject;
+  static serializeBinaryToWriter(message: EnclaveExpiration, writer: jspb.BinaryWriter): void;
+  static deserializeBinary(bytes: Uint8Array): EnclaveExpiration;
+  static deserializeBinaryFromReader(message: EnclaveExpiration, reader: jspb.BinaryReader): EnclaveExpiration;
+}
+
+export namespace EnclaveExpiration {
+  export type AsObject = {
+    expirationTime?: google_protobuf_timestamp_pb.Timestamp.AsObject,
+    idleTimeoutSeconds?: number,
+    action: ExpiredEnclaveAction,
+  }
+
+  export enum IdleTimeoutSecondsCase { 
+    _IDLE_TIMEOUT_SECONDS_NOT_SET = 0,
+    IDLE_TIMEOUT_SECONDS = 2,
+  }
+}
+
+export class GetEnclavesArgs extends jspb.Message {
+  getLabelSelectorMap(): jspb.Map<string, string>;
+  clearLabelSelectorMap(): GetEnclavesArgs;
+
+  serializeBinary(): Uint8Array;
+  toObject(includeInstance?: boolean): GetEnclavesArgs.AsObject;
+  static toObject(includeInstance: boolean, msg: GetEnclavesArgs): GetEnclavesArgs.AsObject;
+  static serializeBinaryToWriter(message: GetEnclavesArgs, writer: jspb.BinaryWriter): void;
+  static deserializeBinary(bytes: Uint8Array): GetEnclavesArgs;
+  static deserializeBinaryFromReader(message: GetEnclavesArgs, reader: jspb.BinaryReader): GetEnclavesArgs;
+}
+
+export namespace GetEnclavesArgs {
+  export type AsObject = {
+    labelSelectorMap: Array<[string, string]>,
   }
 }
 
@@ -301,6 +443,245 @@ export namespace StopEnclaveArgs {
   }
 }
 
+export class ExtendEnclaveTtlArgs extends jspb.Message {
+  getEnclaveIdentifier(): string;
+  setEnclaveIdentifier(value: string): ExtendEnclaveTtlArgs;
+
+  getTtlSeconds(): number;
+  setTtlSeconds(value: number): ExtendEnclaveTtlArgs;
+
+  serializeBinary(): Uint8Array;
+  toObject(includeInstance?: boolean): ExtendEnclaveTtlArgs.AsObject;
+  static toObject(includeInstance: boolean, msg: ExtendEnclaveTtlArgs): ExtendEnclaveTtlArgs.AsObject;
+  static serializeBinaryToWriter(message: ExtendEnclaveTtlArgs, writer: jspb.BinaryWriter): void;
+  static deserializeBinary(bytes: Uint8Array): ExtendEnclaveTtlArgs;
+  static deserializeBinaryFromReader(message: ExtendEnclaveTtlArgs, reader: jspb.BinaryReader): ExtendEnclaveTtlArgs;
+}
+
+export namespace ExtendEnclaveTtlArgs {
+  export type AsObject = {
+    enclaveIdentifier: string,
+    ttlSeconds: number,
+  }
+}
+
+export class UpdateEnclaveLabelsArgs extends jspb.Message {
+  getEnclaveIdentifier(): string;
+  setEnclaveIdentifier(value: string): UpdateEnclaveLabelsArgs;
+
+  getLabelsToSetMap(): jspb.Map<string, string>;
+  clearLabelsToSetMap(): UpdateEnclaveLabelsArgs;
+
+  getLabelsToRemoveList(): Array<string>;
+  setLabelsToRemoveList(value: Array<string>): UpdateEnclaveLabelsArgs;
+  clearLabelsToRemoveList(): UpdateEnclaveLabelsArgs;
+  addLabelsToRemove(value: string, index?: number): UpdateEnclaveLabelsArgs;
+
+  serializeBinary(): Uint8Array;
+  toObject(includeInstance?: boolean): UpdateEnclaveLabelsArgs.AsObject;
+  static toObject(includeInstance: boolean, msg: UpdateEnclaveLabelsArgs): UpdateEnclaveLabelsArgs.AsObject;
+  static serializeBinaryToWriter(message: UpdateEnclaveLabelsArgs, writer: jspb.BinaryWriter): void;
+  static deserializeBinary(bytes: Uint8Array): UpdateEnclaveLabelsArgs;
+  static deserializeBinaryFromReader(message: UpdateEnclaveLabelsArgs, reader: jspb.BinaryReader): UpdateEnclaveLabelsArgs;
+}
+
+export namespace UpdateEnclaveLabelsArgs {
+  export type AsObject = {
+    enclaveIdentifier: string,
+    labelsToSetMap: Array<[string, string]>,
+    labelsToRemoveList: Array<string>,
+  }
+}
+
+export class RenameEnclaveArgs extends jspb.Message {
+  getEnclaveIdentifier(): string;
+  setEnclaveIdentifier(value: string): RenameEnclaveArgs;
+
+  getNewName(): string;
+  setNewName(value: string): RenameEnclaveArgs;
+
+  serializeBinary(): Uint8Array;
+  toObject(includeInstance?: boolean): RenameEnclaveArgs.AsObject;
+  static toObject(includeInstance: boolean, msg: RenameEnclaveArgs): RenameEnclaveArgs.AsObject;
+  static serializeBinaryToWriter(message: RenameEnclaveArgs, writer: jspb.BinaryWriter): void;
+  static deserializeBinary(bytes: Uint8Array): RenameEnclaveArgs;
+  static deserializeBinaryFromReader(message: RenameEnclaveArgs, reader: jspb.BinaryReader): RenameEnclaveArgs;
+}
+
+export namespace RenameEnclaveArgs {
+  export type AsObject = {
+    enclaveIdentifier: string,
+    newName: string,
+  }
+}
+
+export class CloneEnclaveArgs extends jspb.Message {
+  getEnclaveIdentifier(): string;
+  setEnclaveIdentifier(value: string): CloneEnclaveArgs;
+
+  getEnclaveName(): string;
+  setEnclaveName(value: string): CloneEnclaveArgs;
+  hasEnclaveName(): boolean;
+  clearEnclaveName(): CloneEnclaveArgs;
+
+  getAllowPartial(): boolean;
+  setAllowPartial(value: boolean): CloneEnclaveArgs;
+  hasAllowPartial(): boolean;
+  clearAllowPartial(): CloneEnclaveArgs;
+
+  serializeBinary(): Uint8Array;
+  toObject(includeInstance?: boolean): CloneEnclaveArgs.AsObject;
+  static toObject(includeInstance: boolean, msg: CloneEnclaveArgs): CloneEnclaveArgs.AsObject;
+  static serializeBinaryToWriter(message: CloneEnclaveArgs, writer: jspb.BinaryWriter): void;
+  static deserializeBinary(bytes: Uint8Array): CloneEnclaveArgs;
+  static deserializeBinaryFromReader(message: CloneEnclaveArgs, reader: jspb.BinaryReader): CloneEnclaveArgs;
+}
+
+export namespace CloneEnclaveArgs {
+  export type AsObject = {
+    enclaveIdentifier: string,
+    enclaveName?: string,
+    allowPartial?: boolean,
+  }
+
+  export enum EnclaveNameCase { 
+    _ENCLAVE_NAME_NOT_SET = 0,
+    ENCLAVE_NAME = 2,
+  }
+
+  export enum AllowPartialCase { 
+    _ALLOW_PARTIAL_NOT_SET = 0,
+    ALLOW_PARTIAL = 3,
+  }
+}
+
+export class CloneEnclaveResponse extends jspb.Message {
+  getEnclaveInfo(): EnclaveInfo | undefined;
+  setEnclaveInfo(value?: EnclaveInfo): CloneEnclaveResponse;
+  hasEnclaveInfo(): boolean;
+  clearEnclaveInfo(): CloneEnclaveResponse;
+
+  getUncloneableItemsList(): Array<string>;
+  setUncloneableItemsList(value: Array<string>): CloneEnclaveResponse;
+  clearUncloneableItemsList(): CloneEnclaveResponse;
+  addUncloneableItems(value: string, index?: number): CloneEnclaveResponse;
+
+  getWarningsList(): Array<string>;
+  setWarningsList(value: Array<string>): CloneEnclaveResponse;
+  clearWarningsList(): CloneEnclaveResponse;
+  addWarnings(value: string, index?: number): CloneEnclaveResponse;
+
+  serializeBinary(): Uint8Array;
+  toObject(includeInstance?: boolean): CloneEnclaveResponse.AsObject;
+  static toObject(includeInstance: boolean, msg: CloneEnclaveResponse): CloneEnclaveResponse.AsObject;
+  static serializeBinaryToWriter(message: CloneEnclaveResponse, writer: jspb.BinaryWriter): void;
+  static deserializeBinary(bytes: Uint8Array): CloneEnclaveResponse;
+  static deserializeBinaryFromReader(message: CloneEnclaveResponse, reader: jspb.BinaryReader): CloneEnclaveResponse;
+}
+
+export namespace CloneEnclaveResponse {
+  export type AsObject = {
+    enclaveInfo?: EnclaveInfo.AsObject,
+    uncloneableItemsList: Array<string>,
+    warningsList: Array<string>,
+  }
+}
+
+export class ExportEnclaveArgs extends jspb.Message {
+  getEnclaveIdentifier(): string;
+  setEnclaveIdentifier(value: string): ExportEnclaveArgs;
+
+  serializeBinary(): Uint8Array;
+  toObject(includeInstance?: boolean): ExportEnclaveArgs.AsObject;
+  static toObject(includeInstance: boolean, msg: ExportEnclaveArgs): ExportEnclaveArgs.AsObject;
+  static serializeBinaryToWriter(message: ExportEnclaveArgs, writer: jspb.BinaryWriter): void;
+  static deserializeBinary(bytes: Uint8Array): ExportEnclaveArgs;
+  static deserializeBinaryFromReader(message: ExportEnclaveArgs, reader: jspb.BinaryReader): ExportEnclaveArgs;
+}
+
+export namespace ExportEnclaveArgs {
+  export type AsObject = {
+    enclaveIdentifier: string,
+  }
+}
+
+export class EnclaveArchiveChunk extends jspb.Message {
+  getData(): Uint8Array | string;
+  getData_asU8(): Uint8Array;
+  getData_asB64(): string;
+  setData(value: Uint8Array | string): EnclaveArchiveChunk;
+
+  serializeBinary(): Uint8Array;
+  toObject(includeInstance?: boolean): EnclaveArchiveChunk.AsObject;
+  static toObject(includeInstance: boolean, msg: EnclaveArchiveChunk): EnclaveArchiveChunk.AsObject;
+  static serializeBinaryToWriter(message: EnclaveArchiveChunk, writer: jspb.BinaryWriter): void;
+  static deserializeBinary(bytes: Uint8Array): EnclaveArchiveChunk;
+  static deserializeBinaryFromReader(message: EnclaveArchiveChunk, reader: jspb.BinaryReader): EnclaveArchiveChunk;
+}
+
+export namespace EnclaveArchiveChunk {
+  export type AsObject = {
+    data: Uint8Array | string,
+  }
+}
+
+export class ImportEnclaveArgs extends jspb.Message {
+  getEnclaveName(): string;
+  setEnclaveName(value: string): ImportEnclaveArgs;
+  hasEnclaveName(): boolean;
+  clearEnclaveName(): ImportEnclaveArgs;
+
+  getData(): Uint8Array | string;
+  getData_asU8(): Uint8Array;
+  getData_asB64(): string;
+  setData(value: Uint8Array | string): ImportEnclaveArgs;
+
+  serializeBinary(): Uint8Array;
+  toObject(includeInstance?: boolean): ImportEnclaveArgs.AsObject;
+  static toObject(includeInstance: boolean, msg: ImportEnclaveArgs): ImportEnclaveArgs.AsObject;
+  static serializeBinaryToWriter(message: ImportEnclaveArgs, writer: jspb.BinaryWriter): void;
+  static deserializeBinary(bytes: Uint8Array): ImportEnclaveArgs;
+  static deserializeBinaryFromReader(message: ImportEnclaveArgs, reader: jspb.BinaryReader): ImportEnclaveArgs;
+}
+
+export namespace ImportEnclaveArgs {
+  export type AsObject = {
+    enclaveName?: string,
+    data: Uint8Array | string,
+  }
+
+  export enum EnclaveNameCase { 
+    _ENCLAVE_NAME_NOT_SET = 0,
+    ENCLAVE_NAME = 1,
+  }
+}
+
+export class ImportEnclaveResponse extends jspb.Message {
+  getEnclaveInfo(): EnclaveInfo | undefined;
+  setEnclaveInfo(value?: EnclaveInfo): ImportEnclaveResponse;
+  hasEnclaveInfo(): boolean;
+  clearEnclaveInfo(): ImportEnclaveResponse;
+
+  getUnimportableItemsList(): Array<string>;
+  setUnimportableItemsList(value: Array<string>): ImportEnclaveResponse;
+  clearUnimportableItemsList(): ImportEnclaveResponse;
+  addUnimportableItems(value: string, index?: number): ImportEnclaveResponse;
+
+  serializeBinary(): Uint8Array;
+  toObject(includeInstance?: boolean): ImportEnclaveResponse.AsObject;
+  static toObject(includeInstance: boolean, msg: ImportEnclaveResponse): ImportEnclaveResponse.AsObject;
+  static serializeBinaryToWriter(message: ImportEnclaveResponse, writer: jspb.BinaryWriter): void;
+  static deserializeBinary(bytes: Uint8Array): ImportEnclaveResponse;
+  static deserializeBinaryFromReader(message: ImportEnclaveResponse, reader: jspb.BinaryReader): ImportEnclaveResponse;
+}
+
+export namespace ImportEnclaveResponse {
+  export type AsObject = {
+    enclaveInfo?: EnclaveInfo.AsObject,
+    unimportableItemsList: Array<string>,
+  }
+}
+
 export class GetEnclavesByUuidsArgs extends jspb.Message {
   getEnclaveUuidsList(): Array<string>;
   setEnclaveUuidsList(value: Array<string>): GetEnclavesByUuidsArgs;
@@ -345,6 +726,9 @@ export class CleanArgs extends jspb.Message {
   hasShouldCleanAll(): boolean;
   clearShouldCleanAll(): CleanArgs;
 
+  getLabelSelectorMap(): jspb.Map<string, string>;
+  clearLabelSelectorMap(): CleanArgs;
+
   serializeBinary(): Uint8Array;
   toObject(includeInstance?: boolean): CleanArgs.AsObject;
   static toObject(includeInstance: boolean, msg: CleanArgs): CleanArgs.AsObject;
@@ -356,6 +740,7 @@ export class CleanArgs extends jspb.Message {
 export namespace CleanArgs {
   export type AsObject = {
     shouldCleanAll?: boolean,
+    labelSelectorMap: Array<[string, string]>,
   }
 
   export enum ShouldCleanAllCase { 
@@ -537,10 +922,345 @@ export namespace LogLineFilter {
   }
 }
 
+export class WatchEventsArgs extends jspb.Message {
+  getEventTypesList(): Array<EngineEventType>;
+  setEventTypesList(value: Array<EngineEventType>): WatchEventsArgs;
+  clearEventTypesList(): WatchEventsArgs;
+  addEventTypes(value: EngineEventType, index?: number): WatchEventsArgs;
+
+  serializeBinary(): Uint8Array;
+  toObject(includeInstance?: boolean): WatchEventsArgs.AsObject;
+  static toObject(includeInstance: boolean, msg: WatchEventsArgs): WatchEventsArgs.AsObject;
+  static serializeBinaryToWriter(message: WatchEventsArgs, writer: jspb.BinaryWriter): void;
+  static deserializeBinary(bytes: Uint8Array): WatchEventsArgs;
+  static deserializeBinaryFromReader(message: WatchEventsArgs, reader: jspb.BinaryReader): WatchEventsArgs;
+}
+
+export namespace WatchEventsArgs {
+  export type AsObject = {
+    eventTypesList: Array<EngineEventType>,
+  }
+}
+
+export class EngineEvent extends jspb.Message {
+  getType(): EngineEventType;
+  setType(value: EngineEventType): EngineEvent;
+
+  getTimestamp(): google_protobuf_timestamp_pb.Timestamp | undefined;
+  setTimestamp(value?: google_protobuf_timestamp_pb.Timestamp): EngineEvent;
+  hasTimestamp(): boolean;
+  clearTimestamp(): EngineEvent;
+
+  getEnclaveUuid(): string;
+  setEnclaveUuid(value: string): EngineEvent;
+
+  getEnclaveName(): string;
+  setEnclaveName(value: string): EngineEvent;
+
+  getServiceName(): string;
+  setServiceName(value: string): EngineEvent;
+  hasServiceName(): boolean;
+  clearServiceName(): EngineEvent;
+
+  getStarlarkPackageId(): string;
+  setStarlarkPackageId(value: string): EngineEvent;
+  hasStarlarkPackageId(): boolean;
+  clearStarlarkPackageId(): EngineEvent;
+
+  getStarlarkRunSucceeded(): boolean;
+  setStarlarkRunSucceeded(value: boolean): EngineEvent;
+  hasStarlarkRunSucceeded(): boolean;
+  clearStarlarkRunSucceeded(): EngineEvent;
+
+  serializeBinary(): Uint8Array;
+  toObject(includeInstance?: boolean): EngineEvent.AsObject;
+  static toObject(includeInstance: boolean, msg: EngineEvent): EngineEvent.AsObject;
+  static serializeBinaryToWriter(message: EngineEvent, writer: jspb.BinaryWriter): void;
+  static deserializeBinary(bytes: Uint8Array): EngineEvent;
+  static deserializeBinaryFromReader(message: EngineEvent, reader: jspb.BinaryReader): EngineEvent;
+}
+
+export namespace EngineEvent {
+  export type AsObject = {
+    type: EngineEventType,
+    timestamp?: google_protobuf_timestamp_pb.Timestamp.AsObject,
+    enclaveUuid: string,
+    enclaveName: string,
+    serviceName?: string,
+    starlarkPackageId?: string,
+    starlarkRunSucceeded?: boolean,
+  }
+
+  export enum ServiceNameCase { 
+    _SERVICE_NAME_NOT_SET = 0,
+    SERVICE_NAME = 5,
+  }
+
+  export enum StarlarkPackageIdCase { 
+    _STARLARK_PACKAGE_ID_NOT_SET = 0,
+    STARLARK_PACKAGE_ID = 6,
+  }
+
+  export enum StarlarkRunSucceededCase { 
+    _STARLARK_RUN_SUCCEEDED_NOT_SET = 0,
+    STARLARK_RUN_SUCCEEDED = 7,
+  }
+}
+
+export class CreateApiTokenArgs extends jspb.Message {
+  getName(): string;
+  setName(value: string): CreateApiTokenArgs;
+
+  getRole(): ApiTokenRole;
+  setRole(value: ApiTokenRole): CreateApiTokenArgs;
+
+  getEnclaveNamesList(): Array<string>;
+  setEnclaveNamesList(value: Array<string>): CreateApiTokenArgs;
+  clearEnclaveNamesList(): CreateApiTokenArgs;
+  addEnclaveNames(value: string, index?: number): CreateApiTokenArgs;
+
+  serializeBinary(): Uint8Array;
+  toObject(includeInstance?: boolean): CreateApiTokenArgs.AsObject;
+  static toObject(includeInstance: boolean, msg: CreateApiTokenArgs): CreateApiTokenArgs.AsObject;
+  static serializeBinaryToWriter(message: CreateApiTokenArgs, writer: jspb.BinaryWriter): void;
+  static deserializeBinary(bytes: Uint8Array): CreateApiTokenArgs;
+  static deserializeBinaryFromReader(message: CreateApiTokenArgs, reader: jspb.BinaryReader): CreateApiTokenArgs;
+}
+
+export namespace CreateApiTokenArgs {
+  export type AsObject = {
+    name: string,
+    role: ApiTokenRole,
+    enclaveNamesList: Array<string>,
+  }
+}
+
+export class CreateApiTokenResponse extends jspb.Message {
+  getTokenId(): string;
+  setTokenId(value: string): CreateApiTokenResponse;
+
+  getToken(): string;
+  setToken(value: string): CreateApiTokenResponse;
+
+  serializeBinary(): Uint8Array;
+  toObject(includeInstance?: boolean): CreateApiTokenResponse.AsObject;
+  static toObject(includeInstance: boolean, msg: CreateApiTokenResponse): CreateApiTokenResponse.AsObject;
+  static serializeBinaryToWriter(message: CreateApiTokenResponse, writer: jspb.BinaryWriter): void;
+  static deserializeBinary(bytes: Uint8Array): CreateApiTokenResponse;
+  static deserializeBinaryFromReader(message: CreateApiTokenResponse, reader: jspb.BinaryReader): CreateApiTokenResponse;
+}
+
+export namespace CreateApiTokenResponse {
+  export type AsObject = {
+    tokenId: string,
+    token: string,
+  }
+}
+
+export class RevokeApiTokenArgs extends jspb.Message {
+  getTokenId(): string;
+  setTokenId(value: string): RevokeApiTokenArgs;
+
+  serializeBinary(): Uint8Array;
+  toObject(includeInstance?: boolean): RevokeApiTokenArgs.AsObject;
+  static toObject(includeInstance: boolean, msg: RevokeApiTokenArgs): RevokeApiTokenArgs.AsObject;
+  static serializeBinaryToWriter(message: RevokeApiTokenArgs, writer: jspb.BinaryWriter): void;
+  static deserializeBinary(bytes: Uint8Array): RevokeApiTokenArgs;
+  static deserializeBinaryFromReader(message: RevokeApiTokenArgs, reader: jspb.BinaryReader): RevokeApiTokenArgs;
+}
+
+export namespace RevokeApiTokenArgs {
+  export type AsObject = {
+    tokenId: string,
+  }
+}
+
+export class GetAuditLogArgs extends jspb.Message {
+  getEnclaveIdentifier(): string;
+  setEnclaveIdentifier(value: string): GetAuditLogArgs;
+  hasEnclaveIdentifier(): boolean;
+  clearEnclaveIdentifier(): GetAuditLogArgs;
+
+  getPageSize(): number;
+  setPageSize(value: number): GetAuditLogArgs;
+  hasPageSize(): boolean;
+  clearPageSize(): GetAuditLogArgs;
+
+  getPageToken(): string;
+  setPageToken(value: string): GetAuditLogArgs;
+  hasPageToken(): boolean;
+  clearPageToken(): GetAuditLogArgs;
+
+  serializeBinary(): Uint8Array;
+  toObject(includeInstance?: boolean): GetAuditLogArgs.AsObject;
+  static toObject(includeInstance: boolean, msg: GetAuditLogArgs): GetAuditLogArgs.AsObject;
+  static serializeBinaryToWriter(message: GetAuditLogArgs, writer: jspb.BinaryWriter): void;
+  static deserializeBinary(bytes: Uint8Array): GetAuditLogArgs;
+  static deserializeBinaryFromReader(message: GetAuditLogArgs, reader: jspb.BinaryReader): GetAuditLogArgs;
+}
+
+export namespace GetAuditLogArgs {
+  export type AsObject = {
+    enclaveIdentifier?: string,
+    pageSize?: number,
+    pageToken?: string,
+  }
+
+  export enum EnclaveIdentifierCase { 
+    _ENCLAVE_IDENTIFIER_NOT_SET = 0,
+    ENCLAVE_IDENTIFIER = 1,
+  }
+
+  export enum PageSizeCase { 
+    _PAGE_SIZE_NOT_SET = 0,
+    PAGE_SIZE = 2,
+  }
+
+  export enum PageTokenCase { 
+    _PAGE_TOKEN_NOT_SET = 0,
+    PAGE_TOKEN = 3,
+  }
+}
+
+export class AuditLogEntry extends jspb.Message {
+  getTimestamp(): google_protobuf_timestamp_pb.Timestamp | undefined;
+  setTimestamp(value?: google_protobuf_timestamp_pb.Timestamp): AuditLogEntry;
+  hasTimestamp(): boolean;
+  clearTimestamp(): AuditLogEntry;
+
+  getCaller(): string;
+  setCaller(value: string): AuditLogEntry;
+
+  getAction(): string;
+  setAction(value: string): AuditLogEntry;
+
+  getEnclaveUuid(): string;
+  setEnclaveUuid(value: string): AuditLogEntry;
+  hasEnclaveUuid(): boolean;
+  clearEnclaveUuid(): AuditLogEntry;
+
+  getEnclaveName(): string;
+  setEnclaveName(value: string): AuditLogEntry;
+  hasEnclaveName(): boolean;
+  clearEnclaveName(): AuditLogEntry;
+
+  getArgumentsMap(): jspb.Map<string, string>;
+  clearArgumentsMap(): AuditLogEntry;
+
+  getError(): string;
+  setError(value: string): AuditLogEntry;
+  hasError(): boolean;
+  clearError(): AuditLogEntry;
+
+  serializeBinary(): Uint8Array;
+  toObject(includeInstance?: boolean): AuditLogEntry.AsObject;
+  static toObject(includeInstance: boolean, msg: AuditLogEntry): AuditLogEntry.AsObject;
+  static serializeBinaryToWriter(message: AuditLogEntry, writer: jspb.BinaryWriter): void;
+  static deserializeBinary(bytes: Uint8Array): AuditLogEntry;
+  static deserializeBinaryFromReader(message: AuditLogEntry, reader: jspb.BinaryReader): AuditLogEntry;
+}
+
+export namespace AuditLogEntry {
+  export type AsObject = {
+    timestamp?: google_protobuf_timestamp_pb.Timestamp.AsObject,
+    caller: string,
+    action: string,
+    enclaveUuid?: string,
+    enclaveName?: string,
+    argumentsMap: Array<[string, string]>,
+    error?: string,
+  }
+
+  export enum EnclaveUuidCase { 
+    _ENCLAVE_UUID_NOT_SET = 0,
+    ENCLAVE_UUID = 4,
+  }
+
+  export enum EnclaveNameCase { 
+    _ENCLAVE_NAME_NOT_SET = 0,
+    ENCLAVE_NAME = 5,
+  }
+
+  export enum ErrorCase { 
+    _ERROR_NOT_SET = 0,
+    ERROR = 7,
+  }
+}
+
+export class GetAuditLogResponse extends jspb.Message {
+  getEntriesList(): Array<AuditLogEntry>;
+  setEntriesList(value: Array<AuditLogEntry>): GetAuditLogResponse;
+  clearEntriesList(): GetAuditLogResponse;
+  addEntries(value?: AuditLogEntry, index?: number): AuditLogEntry;
+
+  getNextPageToken(): string;
+  setNextPageToken(value: string): GetAuditLogResponse;
+
+  serializeBinary(): Uint8Array;
+  toObject(includeInstance?: boolean): GetAuditLogResponse.AsObject;
+  static toObject(includeInstance: boolean, msg: GetAuditLogResponse): GetAuditLogResponse.AsObject;
+  static serializeBinaryToWriter(message: GetAuditLogResponse, writer: jspb.BinaryWriter): void;
+  static deserializeBinary(bytes: Uint8Array): GetAuditLogResponse;
+  static deserializeBinaryFromReader(message: GetAuditLogResponse, reader: jspb.BinaryReader): GetAuditLogResponse;
+}
+
+export namespace GetAuditLogResponse {
+  export type AsObject = {
+    entriesList: Array<AuditLogEntry.AsObject>,
+    nextPageToken: string,
+  }
+}
+
+export class GetEnclaveCallerCredentialArgs extends jspb.Message {
+  getEnclaveIdentifier(): string;
+  setEnclaveIdentifier(value: string): GetEnclaveCallerCredentialArgs;
+
+  serializeBinary(): Uint8Array;
+  toObject(includeInstance?: boolean): GetEnclaveCallerCredentialArgs.AsObject;
+  static toObject(includeInstance: boolean, msg: GetEnclaveCallerCredentialArgs): GetEnclaveCallerCredentialArgs.AsObject;
+  static serializeBinaryToWriter(message: GetEnclaveCallerCredentialArgs, writer: jspb.BinaryWriter): void;
+  static deserializeBinary(bytes: Uint8Array): GetEnclaveCallerCredentialArgs;
+  static deserializeBinaryFromReader(message: GetEnclaveCallerCredentialArgs, reader: jspb.BinaryReader): GetEnclaveCallerCredentialArgs;
+}
+
+export namespace GetEnclaveCallerCredentialArgs {
+  export type AsObject = {
+    enclaveIdentifier: string,
+  }
+}
+
+export class GetEnclaveCallerCredentialResponse extends jspb.Message {
+  getCredential(): string;
+  setCredential(value: string): GetEnclaveCallerCredentialResponse;
+
+  getExpirationTime(): google_protobuf_timestamp_pb.Timestamp | undefined;
+  setExpirationTime(value?: google_protobuf_timestamp_pb.Timestamp): GetEnclaveCallerCredentialResponse;
+  hasExpirationTime(): boolean;
+  clearExpirationTime(): GetEnclaveCallerCredentialResponse;
+
+  serializeBinary(): Uint8Array;
+  toObject(includeInstance?: boolean): GetEnclaveCallerCredentialResponse.AsObject;
+  static toObject(includeInstance: boolean, msg: GetEnclaveCallerCredentialResponse): GetEnclaveCallerCredentialResponse.AsObject;
+  static serializeBinaryToWriter(message: GetEnclaveCallerCredentialResponse, writer: jspb.BinaryWriter): void;
+  static deserializeBinary(bytes: Uint8Array): GetEnclaveCallerCredentialResponse;
+  static deserializeBinaryFromReader(message: GetEnclaveCallerCredentialResponse, reader: jspb.BinaryReader): GetEnclaveCallerCredentialResponse;
+}
+
+export namespace GetEnclaveCallerCredentialResponse {
+  export type AsObject = {
+    credential: string,
+    expirationTime?: google_protobuf_timestamp_pb.Timestamp.AsObject,
+  }
+}
+
 export enum EnclaveMode { 
   TEST = 0,
   PRODUCTION = 1,
 }
+export enum ExpiredEnclaveAction { 
+  EXPIREDENCLAVEACTION_DESTROY = 0,
+  EXPIREDENCLAVEACTION_STOP = 1,
+}
 export enum EnclaveContainersStatus { 
   ENCLAVECONTAINERSSTATUS_EMPTY = 0,
   ENCLAVECONTAINERSSTATUS_RUNNING = 1,
@@ -557,3 +1277,19 @@ export enum LogLineOperator {
   LOGLINEOPERATOR_DOES_CONTAIN_MATCH_REGEX = 2,
   LOGLINEOPERATOR_DOES_NOT_CONTAIN_MATCH_REGEX = 3,
 }
+export enum EngineEventType { 
+  ENGINEEVENTTYPE_ENCLAVE_CREATED = 0,
+  ENGINEEVENTTYPE_ENCLAVE_STOPPED = 1,
+  ENGINEEVENTTYPE_ENCLAVE_DESTROYED = 2,
+  ENGINEEVENTTYPE_STARLARK_RUN_STARTED = 3,
+  ENGINEEVENTTYPE_STARLARK_RUN_FINISHED = 4,
+  ENGINEEVENTTYPE_SERVICE_ADDED = 5,
+  ENGINEEVENTTYPE_SERVICE_STOPPED = 6,
+  ENGINEEVENTTYPE_SERVICE_REMOVED = 7,
+  ENGINEEVENTTYPE_API_CONTAINER_RESTARTED = 8,
+}
+export enum ApiTokenRole { 
+  APITOKENROLE_READ_ONLY = 0,
+  APITOKENROLE_OPERATOR = 1,
+  APITOKENROLE_ADMIN = 2,
+}
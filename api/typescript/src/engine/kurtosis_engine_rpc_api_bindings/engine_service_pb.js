@@ -25,29 +25,53 @@ var google_protobuf_empty_pb = require('google-protobuf/google/protobuf/empty_pb
 goog.object.extend(proto, google_protobuf_empty_pb);
 var google_protobuf_timestamp_pb = require('google-protobuf/google/protobuf/timestamp_pb.js');
 goog.object.extend(proto, google_protobuf_timestamp_pb);
+goog.exportSymbol('proto.engine_api.ApiTokenRole', null, global);
+goog.exportSymbol('proto.engine_api.AuditLogEntry', null, global);
 goog.exportSymbol('proto.engine_api.CleanArgs', null, global);
 goog.exportSymbol('proto.engine_api.CleanResponse', null, global);
+goog.exportSymbol('proto.engine_api.CloneEnclaveArgs', null, global);
+goog.exportSymbol('proto.engine_api.CloneEnclaveResponse', null, global);
+goog.exportSymbol('proto.engine_api.CreateApiTokenArgs', null, global);
+goog.exportSymbol('proto.engine_api.CreateApiTokenResponse', null, global);
 goog.exportSymbol('proto.engine_api.CreateEnclaveArgs', null, global);
 goog.exportSymbol('proto.engine_api.CreateEnclaveResponse', null, global);
 goog.exportSymbol('proto.engine_api.DestroyEnclaveArgs', null, global);
 goog.exportSymbol('proto.engine_api.EnclaveAPIContainerHostMachineInfo', null, global);
 goog.exportSymbol('proto.engine_api.EnclaveAPIContainerInfo', null, global);
 goog.exportSymbol('proto.engine_api.EnclaveAPIContainerStatus', null, global);
+goog.exportSymbol('proto.engine_api.EnclaveArchiveChunk', null, global);
 goog.exportSymbol('proto.engine_api.EnclaveContainersStatus', null, global);
+goog.exportSymbol('proto.engine_api.EnclaveExpiration', null, global);
 goog.exportSymbol('proto.engine_api.EnclaveIdentifiers', null, global);
 goog.exportSymbol('proto.engine_api.EnclaveInfo', null, global);
 goog.exportSymbol('proto.engine_api.EnclaveMode', null, global);
 goog.exportSymbol('proto.engine_api.EnclaveNameAndUuid', null, global);
+goog.exportSymbol('proto.engine_api.EngineEvent', null, global);
+goog.exportSymbol('proto.engine_api.EngineEventType', null, global);
+goog.exportSymbol('proto.engine_api.ExpiredEnclaveAction', null, global);
+goog.exportSymbol('proto.engine_api.ExportEnclaveArgs', null, global);
+goog.exportSymbol('proto.engine_api.ExtendEnclaveTtlArgs', null, global);
+goog.exportSymbol('proto.engine_api.GetAuditLogArgs', null, global);
+goog.exportSymbol('proto.engine_api.GetAuditLogResponse', null, global);
+goog.exportSymbol('proto.engine_api.GetEnclaveCallerCredentialArgs', null, global);
+goog.exportSymbol('proto.engine_api.GetEnclaveCallerCredentialResponse', null, global);
+goog.exportSymbol('proto.engine_api.GetEnclavesArgs', null, global);
 goog.exportSymbol('proto.engine_api.GetEnclavesByUuidsArgs', null, global);
 goog.exportSymbol('proto.engine_api.GetEnclavesResponse', null, global);
 goog.exportSymbol('proto.engine_api.GetEngineInfoResponse', null, global);
 goog.exportSymbol('proto.engine_api.GetExistingAndHistoricalEnclaveIdentifiersResponse', null, global);
 goog.exportSymbol('proto.engine_api.GetServiceLogsArgs', null, global);
 goog.exportSymbol('proto.engine_api.GetServiceLogsResponse', null, global);
+goog.exportSymbol('proto.engine_api.ImportEnclaveArgs', null, global);
+goog.exportSymbol('proto.engine_api.ImportEnclaveResponse', null, global);
 goog.exportSymbol('proto.engine_api.LogLine', null, global);
 goog.exportSymbol('proto.engine_api.LogLineFilter', null, global);
 goog.exportSymbol('proto.engine_api.LogLineOperator', null, global);
+goog.exportSymbol('proto.engine_api.RenameEnclaveArgs', null, global);
+goog.exportSymbol('proto.engine_api.RevokeApiTokenArgs', null, global);
 goog.exportSymbol('proto.engine_api.StopEnclaveArgs', null, global);
+goog.exportSymbol('proto.engine_api.UpdateEnclaveLabelsArgs', null, global);
+goog.exportSymbol('proto.engine_api.WatchEventsArgs', null, global);
 /**
  * Generated by JsPbCodeGenerator.
  * @param {Array=} opt_data Optional initial data array, typically from a
@@ -174,6 +198,48 @@ if (goog.DEBUG && !COMPILED) {
    */
   proto.engine_api.EnclaveInfo.displayName = 'proto.engine_api.EnclaveInfo';
 }
+/**
+ * Generated by JsPbCodeGenerator.
+ * @param {Array=} opt_data Optional initial data array, typically from a
+ * server response, or constructed directly in Javascript. The array is used
+ * in place and becomes part of the constructed object. It is not cloned.
+ * If no data is provided, the constructed object will be empty, but still
+ * valid.
+ * @extends {jspb.Message}
+ * @constructor
+ */
+proto.engine_api.EnclaveExpiration = function(opt_data) {
+  jspb.Message.initialize(this, opt_data, 0, -1, null, null);
+};
+goog.inherits(proto.engine_api.EnclaveExpiration, jspb.Message);
+if (goog.DEBUG && !COMPILED) {
+  /**
+   * @public
+   * @override
+   */
+  proto.engine_api.EnclaveExpiration.displayName = 'proto.engine_api.EnclaveExpiration';
+}
+/**
+ * Generated by JsPbCodeGenerator.
+ * @param {Array=} opt_data Optional initial data array, typically from a
+ * server response, or constructed directly in Javascript. The array is used
+ * in place and becomes part of the constructed object. It is not cloned.
+ * If no data is provided, the constructed object will be empty, but still
+ * valid.
+ * @extends {jspb.Message}
+ * @constructor
+ */
+proto.engine_api.GetEnclavesArgs = function(opt_data) {
+  jspb.Message.initialize(this, opt_data, 0, -1, null, null);
+};
+goog.inherits(proto.engine_api.GetEnclavesArgs, jspb.Message);
+if (goog.DEBUG && !COMPILED) {
+  /**
+   * @public
+   * @override
+   */
+  proto.engine_api.GetEnclavesArgs.displayName = 'proto.engine_api.GetEnclavesArgs';
+}
 /**
  * Generated by JsPbCodeGenerator.
  * @param {Array=} opt_data Optional initial data array, typically from a
@@ -258,6 +324,195 @@ if (goog.DEBUG && !COMPILED) {
    */
   proto.engine_api.StopEnclaveArgs.displayName = 'proto.engine_api.StopEnclaveArgs';
 }
+/**
+ * Generated by JsPbCodeGenerator.
+ * @param {Array=} opt_data Optional initial data array, typically from a
+ * server response, or constructed directly in Javascript. The array is used
+ * in place and becomes part of the constructed object. It is not cloned.
+ * If no data is provided, the constructed object will be empty, but still
+ * valid.
+ * @extends {jspb.Message}
+ * @constructor
+ */
+proto.engine_api.ExtendEnclaveTtlArgs = function(opt_data) {
+  jspb.Message.initialize(this, opt_data, 0, -1, null, null);
+};
+goog.inherits(proto.engine_api.ExtendEnclaveTtlArgs, jspb.Message);
+if (goog.DEBUG && !COMPILED) {
+  /**
+   * @public
+   * @override
+   */
+  proto.engine_api.ExtendEnclaveTtlArgs.displayName = 'proto.engine_api.ExtendEnclaveTtlArgs';
+}
+/**
+ * Generated by JsPbCodeGenerator.
+ * @param {Array=} opt_data Optional initial data array, typically from a
+ * server response, or constructed directly in Javascript. The array is used
+ * in place and becomes part of the constructed object. It is not cloned.
+ * If no data is provided, the constructed object will be empty, but still
+ * valid.
+ * @extends {jspb.Message}
+ * @constructor
+ */
+proto.engine_api.UpdateEnclaveLabelsArgs = function(opt_data) {
+  jspb.Message.initialize(this, opt_data, 0, -1, proto.engine_api.UpdateEnclaveLabelsArgs.repeatedFields_, null);
+};
+goog.inherits(proto.engine_api.UpdateEnclaveLabelsArgs, jspb.Message);
+if (goog.DEBUG && !COMPILED) {
+  /**
+   * @public
+   * @override
+   */
+  proto.engine_api.UpdateEnclaveLabelsArgs.displayName = 'proto.engine_api.UpdateEnclaveLabelsArgs';
+}
+/**
+ * Generated by JsPbCodeGenerator.
+ * @param {Array=} opt_data Optional initial data array, typically from a
+ * server response, or constructed directly in Javascript. The array is used
+ * in place and becomes part of the constructed object. It is not cloned.
+ * If no data is provided, the constructed object will be empty, but still
+ * valid.
+ * @extends {jspb.Message}
+ * @constructor
+ */
+proto.engine_api.RenameEnclaveArgs = function(opt_data) {
+  jspb.Message.initialize(this, opt_data, 0, -1, null, null);
+};
+goog.inherits(proto.engine_api.RenameEnclaveArgs, jspb.Message);
+if (goog.DEBUG && !COMPILED) {
+  /**
+   * @public
+   * @override
+   */
+  proto.engine_api.RenameEnclaveArgs.displayName = 'proto.engine_api.RenameEnclaveArgs';
+}
+/**
+ * Generated by JsPbCodeGenerator.
+ * @param {Array=} opt_data Optional initial data array, typically from a
+ * server response, or constructed directly in Javascript. The array is used
+ * in place and becomes part of the constructed object. It is not cloned.
+ * If no data is provided, the constructed object will be empty, but still
+ * valid.
+ * @extends {jspb.Message}
+ * @constructor
+ */
+proto.engine_api.CloneEnclaveArgs = function(opt_data) {
+  jspb.Message.initialize(this, opt_data, 0, -1, null, null);
+};
+goog.inherits(proto.engine_api.CloneEnclaveArgs, jspb.Message);
+if (goog.DEBUG && !COMPILED) {
+  /**
+   * @public
+   * @override
+   */
+  proto.engine_api.CloneEnclaveArgs.displayName = 'proto.engine_api.CloneEnclaveArgs';
+}
+/**
+ * Generated by JsPbCodeGenerator.
+ * @param {Array=} opt_data Optional initial data array, typically from a
+ * server response, or constructed directly in Javascript. The array is used
+ * in place and becomes part of the constructed object. It is not cloned.
+ * If no data is provided, the constructed object will be empty, but still
+ * valid.
+ * @extends {jspb.Message}
+ * @constructor
+ */
+proto.engine_api.CloneEnclaveResponse = function(opt_data) {
+  jspb.Message.initialize(this, opt_data, 0, -1, proto.engine_api.CloneEnclaveResponse.repeatedFields_, null);
+};
+goog.inherits(proto.engine_api.CloneEnclaveResponse, jspb.Message);
+if (goog.DEBUG && !COMPILED) {
+  /**
+   * @public
+   * @override
+   */
+  proto.engine_api.CloneEnclaveResponse.displayName = 'proto.engine_api.CloneEnclaveResponse';
+}
+/**
+ * Generated by JsPbCodeGenerator.
+ * @param {Array=} opt_data Optional initial data array, typically from a
+ * server response, or constructed directly in Javascript. The array is used
+ * in place and becomes part of the constructed object. It is not cloned.
+ * If no data is provided, the constructed object will be empty, but still
+ * valid.
+ * @extends {jspb.Message}
+ * @constructor
+ */
+proto.engine_api.ExportEnclaveArgs = function(opt_data) {
+  jspb.Message.initialize(this, opt_data, 0, -1, null, null);
+};
+goog.inherits(proto.engine_api.ExportEnclaveArgs, jspb.Message);
+if (goog.DEBUG && !COMPILED) {
+  /**
+   * @public
+   * @override
+   */
+  proto.engine_api.ExportEnclaveArgs.displayName = 'proto.engine_api.ExportEnclaveArgs';
+}
+/**
+ * Generated by JsPbCodeGenerator.
+ * @param {Array=} opt_data Optional initial data array, typically from a
+ * server response, or constructed directly in Javascript. The array is used
+ * in place and becomes part of the constructed object. It is not cloned.
+ * If no data is provided, the constructed object will be empty, but still
+ * valid.
+ * @extends {jspb.Message}
+ * @constructor
+ */
+proto.engine_api.EnclaveArchiveChunk = function(opt_data) {
+  jspb.Message.initialize(this, opt_data, 0, -1, null, null);
+};
+goog.inherits(proto.engine_api.EnclaveArchiveChunk, jspb.Message);
+if (goog.DEBUG && !COMPILED) {
+  /**
+   * @public
+   * @override
+   */
+  proto.engine_api.EnclaveArchiveChunk.displayName = 'proto.engine_api.EnclaveArchiveChunk';
+}
+/**
+ * Generated by JsPbCodeGenerator.
+ * @param {Array=} opt_data Optional initial data array, typically from a
+ * server response, or constructed directly in Javascript. The array is used
+ * in place and becomes part of the constructed object. It is not cloned.
+ * If no data is provided, the constructed object will be empty, but still
+ * valid.
+ * @extends {jspb.Message}
+ * @constructor
+ */
+proto.engine_api.ImportEnclaveArgs = function(opt_data) {
+  jspb.Message.initialize(this, opt_data, 0, -1, null, null);
+};
+goog.inherits(proto.engine_api.ImportEnclaveArgs, jspb.Message);
+if (goog.DEBUG && !COMPILED) {
+  /**
+   * @public
+   * @override
+   */
+  proto.engine_api.ImportEnclaveArgs.displayName = 'proto.engine_api.ImportEnclaveArgs';
+}
+/**
+ * Generated by JsPbCodeGenerator.
+ * @param {Array=} opt_data Optional initial data array, typically from a
+ * server response, or constructed directly in Javascript. The array is used
+ * in place and becomes part of the constructed object. It is not cloned.
+ * If no data is provided, the constructed object will be empty, but still
+ * valid.
+ * @extends {jspb.Message}
+ * @constructor
+ */
+proto.engine_api.ImportEnclaveResponse = function(opt_data) {
+  jspb.Message.initialize(this, opt_data, 0, -1, proto.engine_api.ImportEnclaveResponse.repeatedFields_, null);
+};
+goog.inherits(proto.engine_api.ImportEnclaveResponse, jspb.Message);
+if (goog.DEBUG && !COMPILED) {
+  /**
+   * @public
+   * @override
+   */
+  proto.engine_api.ImportEnclaveResponse.displayName = 'proto.engine_api.ImportEnclaveResponse';
+}
 /**
  * Generated by JsPbCodeGenerator.
  * @param {Array=} opt_data Optional initial data array, typically from a
@@ -447,136 +702,216 @@ if (goog.DEBUG && !COMPILED) {
    */
   proto.engine_api.LogLineFilter.displayName = 'proto.engine_api.LogLineFilter';
 }
-
-
-
-if (jspb.Message.GENERATE_TO_OBJECT) {
 /**
- * Creates an object representation of this proto.
- * Field names that are reserved in JavaScript and will be renamed to pb_name.
- * Optional fields that are not set will be set to undefined.
- * To access a reserved field use, foo.pb_<name>, eg, foo.pb_default.
- * For the list of reserved names please see:
- *     net/proto2/compiler/js/internal/generator.cc#kKeyword.
- * @param {boolean=} opt_includeInstance Deprecated. whether to include the
- *     JSPB instance for transitional soy proto support:
- *     http://goto/soy-param-migration
- * @return {!Object}
+ * Generated by JsPbCodeGenerator.
+ * @param {Array=} opt_data Optional initial data array, typically from a
+ * server response, or constructed directly in Javascript. The array is used
+ * in place and becomes part of the constructed object. It is not cloned.
+ * If no data is provided, the constructed object will be empty, but still
+ * valid.
+ * @extends {jspb.Message}
+ * @constructor
  */
-proto.engine_api.GetEngineInfoResponse.prototype.toObject = function(opt_includeInstance) {
-  return proto.engine_api.GetEngineInfoResponse.toObject(opt_includeInstance, this);
+proto.engine_api.WatchEventsArgs = function(opt_data) {
+  jspb.Message.initialize(this, opt_data, 0, -1, proto.engine_api.WatchEventsArgs.repeatedFields_, null);
 };
-
-
+goog.inherits(proto.engine_api.WatchEventsArgs, jspb.Message);
+if (goog.DEBUG && !COMPILED) {
+  /**
+   * @public
+   * @override
+   */
+  proto.engine_api.WatchEventsArgs.displayName = 'proto.engine_api.WatchEventsArgs';
+}
 /**
- * Static version of the {@see toObject} method.
- * @param {boolean|undefined} includeInstance Deprecated. Whether to include
- *     the JSPB instance for transitional soy proto support:
- *     http://goto/soy-param-migration
- * @param {!proto.engine_api.GetEngineInfoResponse} msg The msg instance to transform.
- * @return {!Object}
- * @suppress {unusedLocalVariables} f is only used for nested messages
+ * Generated by JsPbCodeGenerator.
+ * @param {Array=} opt_data Optional initial data array, typically from a
+ * server response, or constructed directly in Javascript. The array is used
+ * in place and becomes part of the constructed object. It is not cloned.
+ * If no data is provided, the constructed object will be empty, but still
+ * valid.
+ * @extends {jspb.Message}
+ * @constructor
  */
-proto.engine_api.GetEngineInfoResponse.toObject = function(includeInstance, msg) {
-  var f, obj = {
-    engineVersion: jspb.Message.getFieldWithDefault(msg, 1, "")
-  };
-
-  if (includeInstance) {
-    obj.$jspbMessageInstance = msg;
-  }
-  return obj;
+proto.engine_api.EngineEvent = function(opt_data) {
+  jspb.Message.initialize(this, opt_data, 0, -1, null, null);
 };
+goog.inherits(proto.engine_api.EngineEvent, jspb.Message);
+if (goog.DEBUG && !COMPILED) {
+  /**
+   * @public
+   * @override
+   */
+  proto.engine_api.EngineEvent.displayName = 'proto.engine_api.EngineEvent';
 }
-
-
 /**
- * Deserializes binary data (in protobuf wire format).
- * @param {jspb.ByteSource} bytes The bytes to deserialize.
- * @return {!proto.engine_api.GetEngineInfoResponse}
+ * Generated by JsPbCodeGenerator.
+ * @param {Array=} opt_data Optional initial data array, typically from a
+ * server response, or constructed directly in Javascript. The array is used
+ * in place and becomes part of the constructed object. It is not cloned.
+ * If no data is provided, the constructed object will be empty, but still
+ * valid.
+ * @extends {jspb.Message}
+ * @constructor
  */
-proto.engine_api.GetEngineInfoResponse.deserializeBinary = function(bytes) {
-  var reader = new jspb.BinaryReader(bytes);
-  var msg = new proto.engine_api.GetEngineInfoResponse;
-  return proto.engine_api.GetEngineInfoResponse.deserializeBinaryFromReader(msg, reader);
+proto.engine_api.CreateApiTokenArgs = function(opt_data) {
+  jspb.Message.initialize(this, opt_data, 0, -1, proto.engine_api.CreateApiTokenArgs.repeatedFields_, null);
 };
-
-
+goog.inherits(proto.engine_api.CreateApiTokenArgs, jspb.Message);
+if (goog.DEBUG && !COMPILED) {
+  /**
+   * @public
+   * @override
+   */
+  proto.engine_api.CreateApiTokenArgs.displayName = 'proto.engine_api.CreateApiTokenArgs';
+}
 /**
- * Deserializes binary data (in protobuf wire format) from the
- * given reader into the given message object.
- * @param {!proto.engine_api.GetEngineInfoResponse} msg The message object to deserialize into.
- * @param {!jspb.BinaryReader} reader The BinaryReader to use.
- * @return {!proto.engine_api.GetEngineInfoResponse}
+ * Generated by JsPbCodeGenerator.
+ * @param {Array=} opt_data Optional initial data array, typically from a
+ * server response, or constructed directly in Javascript. The array is used
+ * in place and becomes part of the constructed object. It is not cloned.
+ * If no data is provided, the constructed object will be empty, but still
+ * valid.
+ * @extends {jspb.Message}
+ * @constructor
  */
-proto.engine_api.GetEngineInfoResponse.deserializeBinaryFromReader = function(msg, reader) {
-  while (reader.nextField()) {
-    if (reader.isEndGroup()) {
-      break;
-    }
-    var field = reader.getFieldNumber();
-    switch (field) {
-    case 1:
-      var value = /** @type {string} */ (reader.readString());
-      msg.setEngineVersion(value);
-      break;
-    default:
-      reader.skipField();
-      break;
-    }
-  }
-  return msg;
+proto.engine_api.CreateApiTokenResponse = function(opt_data) {
+  jspb.Message.initialize(this, opt_data, 0, -1, null, null);
 };
-
-
+goog.inherits(proto.engine_api.CreateApiTokenResponse, jspb.Message);
+if (goog.DEBUG && !COMPILED) {
+  /**
+   * @public
+   * @override
+   */
+  proto.engine_api.CreateApiTokenResponse.displayName = 'proto.engine_api.CreateApiTokenResponse';
+}
 /**
- * Serializes the message to binary data (in protobuf wire format).
- * @return {!Uint8Array}
+ * Generated by JsPbCodeGenerator.
+ * @param {Array=} opt_data Optional initial data array, typically from a
+ * server response, or constructed directly in Javascript. The array is used
+ * in place and becomes part of the constructed object. It is not cloned.
+ * If no data is provided, the constructed object will be empty, but still
+ * valid.
+ * @extends {jspb.Message}
+ * @constructor
  */
-proto.engine_api.GetEngineInfoResponse.prototype.serializeBinary = function() {
-  var writer = new jspb.BinaryWriter();
-  proto.engine_api.GetEngineInfoResponse.serializeBinaryToWriter(this, writer);
-  return writer.getResultBuffer();
+proto.engine_api.RevokeApiTokenArgs = function(opt_data) {
+  jspb.Message.initialize(this, opt_data, 0, -1, null, null);
 };
-
-
+goog.inherits(proto.engine_api.RevokeApiTokenArgs, jspb.Message);
+if (goog.DEBUG && !COMPILED) {
+  /**
+   * @public
+   * @override
+   */
+  proto.engine_api.RevokeApiTokenArgs.displayName = 'proto.engine_api.RevokeApiTokenArgs';
+}
 /**
- * Serializes the given message to binary data (in protobuf wire
- * format), writing to the given BinaryWriter.
- * @param {!proto.engine_api.GetEngineInfoResponse} message
- * @param {!jspb.BinaryWriter} writer
- * @suppress {unusedLocalVariables} f is only used for nested messages
+ * Generated by JsPbCodeGenerator.
+ * @param {Array=} opt_data Optional initial data array, typically from a
+ * server response, or constructed directly in Javascript. The array is used
+ * in place and becomes part of the constructed object. It is not cloned.
+ * If no data is provided, the constructed object will be empty, but still
+ * valid.
+ * @extends {jspb.Message}
+ * @constructor
  */
-proto.engine_api.GetEngineInfoResponse.serializeBinaryToWriter = function(message, writer) {
-  var f = undefined;
-  f = message.getEngineVersion();
-  if (f.length > 0) {
-    writer.writeString(
-      1,
-      f
-    );
-  }
+proto.engine_api.GetAuditLogArgs = function(opt_data) {
+  jspb.Message.initialize(this, opt_data, 0, -1, null, null);
 };
-
-
+goog.inherits(proto.engine_api.GetAuditLogArgs, jspb.Message);
+if (goog.DEBUG && !COMPILED) {
+  /**
+   * @public
+   * @override
+   */
+  proto.engine_api.GetAuditLogArgs.displayName = 'proto.engine_api.GetAuditLogArgs';
+}
 /**
- * optional string engine_version = 1;
- * @return {string}
+ * Generated by JsPbCodeGenerator.
+ * @param {Array=} opt_data Optional initial data array, typically from a
+ * server response, or constructed directly in Javascript. The array is used
+ * in place and becomes part of the constructed object. It is not cloned.
+ * If no data is provided, the constructed object will be empty, but still
+ * valid.
+ * @extends {jspb.Message}
+ * @constructor
  */
-proto.engine_api.GetEngineInfoResponse.prototype.getEngineVersion = function() {
-  return /** @type {string} */ (jspb.Message.getFieldWithDefault(this, 1, ""));
+proto.engine_api.AuditLogEntry = function(opt_data) {
+  jspb.Message.initialize(this, opt_data, 0, -1, null, null);
 };
-
-
+goog.inherits(proto.engine_api.AuditLogEntry, jspb.Message);
+if (goog.DEBUG && !COMPILED) {
+  /**
+   * @public
+   * @override
+   */
+  proto.engine_api.AuditLogEntry.displayName = 'proto.engine_api.AuditLogEntry';
+}
 /**
- * @param {string} value
- * @return {!proto.engine_api.GetEngineInfoResponse} returns this
+ * Generated by JsPbCodeGenerator.
+ * @param {Array=} opt_data Optional initial data array, typically from a
+ * server response, or constructed directly in Javascript. The array is used
+ * in place and becomes part of the constructed object. It is not cloned.
+ * If no data is provided, the constructed object will be empty, but still
+ * valid.
+ * @extends {jspb.Message}
+ * @constructor
  */
-proto.engine_api.GetEngineInfoResponse.prototype.setEngineVersion = function(value) {
-  return jspb.Message.setProto3StringField(this, 1, value);
+proto.engine_api.GetAuditLogResponse = function(opt_data) {
+  jspb.Message.initialize(this, opt_data, 0, -1, proto.engine_api.GetAuditLogResponse.repeatedFields_, null);
 };
-
-
+goog.inherits(proto.engine_api.GetAuditLogResponse, jspb.Message);
+if (goog.DEBUG && !COMPILED) {
+  /**
+   * @public
+   * @override
+   */
+  proto.engine_api.GetAuditLogResponse.displayName = 'proto.engine_api.GetAuditLogResponse';
+}
+/**
+ * Generated by JsPbCodeGenerator.
+ * @param {Array=} opt_data Optional initial data array, typically from a
+ * server response, or constructed directly in Javascript. The array is used
+ * in place and becomes part of the constructed object. It is not cloned.
+ * If no data is provided, the constructed object will be empty, but still
+ * valid.
+ * @extends {jspb.Message}
+ * @constructor
+ */
+proto.engine_api.GetEnclaveCallerCredentialArgs = function(opt_data) {
+  jspb.Message.initialize(this, opt_data, 0, -1, null, null);
+};
+goog.inherits(proto.engine_api.GetEnclaveCallerCredentialArgs, jspb.Message);
+if (goog.DEBUG && !COMPILED) {
+  /**
+   * @public
+   * @override
+   */
+  proto.engine_api.GetEnclaveCallerCredentialArgs.displayName = 'proto.engine_api.GetEnclaveCallerCredentialArgs';
+}
+/**
+ * Generated by JsPbCodeGenerator.
+ * @param {Array=} opt_data Optional initial data array, typically from a
+ * server response, or constructed directly in Javascript. The array is used
+ * in place and becomes part of the constructed object. It is not cloned.
+ * If no data is provided, the constructed object will be empty, but still
+ * valid.
+ * @extends {jspb.Message}
+ * @constructor
+ */
+proto.engine_api.GetEnclaveCallerCredentialResponse = function(opt_data) {
+  jspb.Message.initialize(this, opt_data, 0, -1, null, null);
+};
+goog.inherits(proto.engine_api.GetEnclaveCallerCredentialResponse, jspb.Message);
+if (goog.DEBUG && !COMPILED) {
+  /**
+   * @public
+   * @override
+   */
+  proto.engine_api.GetEnclaveCallerCredentialResponse.displayName = 'proto.engine_api.GetEnclaveCallerCredentialResponse';
+}
 
 
 
@@ -593,8 +928,8 @@ if (jspb.Message.GENERATE_TO_OBJECT) {
  *     http://goto/soy-param-migration
  * @return {!Object}
  */
-proto.engine_api.CreateEnclaveArgs.prototype.toObject = function(opt_includeInstance) {
-  return proto.engine_api.CreateEnclaveArgs.toObject(opt_includeInstance, this);
+proto.engine_api.GetEngineInfoResponse.prototype.toObject = function(opt_includeInstance) {
+  return proto.engine_api.GetEngineInfoResponse.toObject(opt_includeInstance, this);
 };
 
 
@@ -603,17 +938,18 @@ proto.engine_api.CreateEnclaveArgs.prototype.toObject = function(opt_includeInst
  * @param {boolean|undefined} includeInstance Deprecated. Whether to include
  *     the JSPB instance for transitional soy proto support:
  *     http://goto/soy-param-migration
- * @param {!proto.engine_api.CreateEnclaveArgs} msg The msg instance to transform.
+ * @param {!proto.engine_api.GetEngineInfoResponse} msg The msg instance to transform.
  * @return {!Object}
  * @suppress {unusedLocalVariables} f is only used for nested messages
  */
-proto.engine_api.CreateEnclaveArgs.toObject = function(includeInstance, msg) {
+proto.engine_api.GetEngineInfoResponse.toObject = function(includeInstance, msg) {
   var f, obj = {
-    enclaveName: jspb.Message.getFieldWithDefault(msg, 1, ""),
-    apiContainerVersionTag: jspb.Message.getFieldWithDefault(msg, 2, ""),
-    apiContainerLogLevel: jspb.Message.getFieldWithDefault(msg, 3, ""),
-    mode: jspb.Message.getFieldWithDefault(msg, 4, 0),
-    shouldApicRunInDebugMode: jspb.Message.getBooleanFieldWithDefault(msg, 5, false)
+    engineVersion: jspb.Message.getFieldWithDefault(msg, 1, ""),
+    logRetentionPeriod: jspb.Message.getFieldWithDefault(msg, 2, ""),
+    logLevel: jspb.Message.getFieldWithDefault(msg, 3, ""),
+    poolSize: jspb.Message.getFieldWithDefault(msg, 4, 0),
+    isDebugModeEnabled: jspb.Message.getBooleanFieldWithDefault(msg, 5, false),
+    domain: jspb.Message.getFieldWithDefault(msg, 6, "")
   };
 
   if (includeInstance) {
@@ -627,23 +963,23 @@ proto.engine_api.CreateEnclaveArgs.toObject = function(includeInstance, msg) {
 /**
  * Deserializes binary data (in protobuf wire format).
  * @param {jspb.ByteSource} bytes The bytes to deserialize.
- * @return {!proto.engine_api.CreateEnclaveArgs}
+ * @return {!proto.engine_api.GetEngineInfoResponse}
  */
-proto.engine_api.CreateEnclaveArgs.deserializeBinary = function(bytes) {
+proto.engine_api.GetEngineInfoResponse.deserializeBinary = function(bytes) {
   var reader = new jspb.BinaryReader(bytes);
-  var msg = new proto.engine_api.CreateEnclaveArgs;
-  return proto.engine_api.CreateEnclaveArgs.deserializeBinaryFromReader(msg, reader);
+  var msg = new proto.engine_api.GetEngineInfoResponse;
+  return proto.engine_api.GetEngineInfoResponse.deserializeBinaryFromReader(msg, reader);
 };
 
 
 /**
  * Deserializes binary data (in protobuf wire format) from the
  * given reader into the given message object.
- * @param {!proto.engine_api.CreateEnclaveArgs} msg The message object to deserialize into.
+ * @param {!proto.engine_api.GetEngineInfoResponse} msg The message object to deserialize into.
  * @param {!jspb.BinaryReader} reader The BinaryReader to use.
- * @return {!proto.engine_api.CreateEnclaveArgs}
+ * @return {!proto.engine_api.GetEngineInfoResponse}
  */
-proto.engine_api.CreateEnclaveArgs.deserializeBinaryFromReader = function(msg, reader) {
+proto.engine_api.GetEngineInfoResponse.deserializeBinaryFromReader = function(msg, reader) {
   while (reader.nextField()) {
     if (reader.isEndGroup()) {
       break;
@@ -652,23 +988,27 @@ proto.engine_api.CreateEnclaveArgs.deserializeBinaryFromReader = function(msg, r
     switch (field) {
     case 1:
       var value = /** @type {string} */ (reader.readString());
-      msg.setEnclaveName(value);
+      msg.setEngineVersion(value);
       break;
     case 2:
       var value = /** @type {string} */ (reader.readString());
-      msg.setApiContainerVersionTag(value);
+      msg.setLogRetentionPeriod(value);
       break;
     case 3:
       var value = /** @type {string} */ (reader.readString());
-      msg.setApiContainerLogLevel(value);
+      msg.setLogLevel(value);
       break;
     case 4:
-      var value = /** @type {!proto.engine_api.EnclaveMode} */ (reader.readEnum());
-      msg.setMode(value);
+      var value = /** @type {number} */ (reader.readUint32());
+      msg.setPoolSize(value);
       break;
     case 5:
       var value = /** @type {boolean} */ (reader.readBool());
-      msg.setShouldApicRunInDebugMode(value);
+      msg.setIsDebugModeEnabled(value);
+      break;
+    case 6:
+      var value = /** @type {string} */ (reader.readString());
+      msg.setDomain(value);
       break;
     default:
       reader.skipField();
@@ -683,9 +1023,9 @@ proto.engine_api.CreateEnclaveArgs.deserializeBinaryFromReader = function(msg, r
  * Serializes the message to binary data (in protobuf wire format).
  * @return {!Uint8Array}
  */
-proto.engine_api.CreateEnclaveArgs.prototype.serializeBinary = function() {
+proto.engine_api.GetEngineInfoResponse.prototype.serializeBinary = function() {
   var writer = new jspb.BinaryWriter();
-  proto.engine_api.CreateEnclaveArgs.serializeBinaryToWriter(this, writer);
+  proto.engine_api.GetEngineInfoResponse.serializeBinaryToWriter(this, writer);
   return writer.getResultBuffer();
 };
 
@@ -693,227 +1033,162 @@ proto.engine_api.CreateEnclaveArgs.prototype.serializeBinary = function() {
 /**
  * Serializes the given message to binary data (in protobuf wire
  * format), writing to the given BinaryWriter.
- * @param {!proto.engine_api.CreateEnclaveArgs} message
+ * @param {!proto.engine_api.GetEngineInfoResponse} message
  * @param {!jspb.BinaryWriter} writer
  * @suppress {unusedLocalVariables} f is only used for nested messages
  */
-proto.engine_api.CreateEnclaveArgs.serializeBinaryToWriter = function(message, writer) {
+proto.engine_api.GetEngineInfoResponse.serializeBinaryToWriter = function(message, writer) {
   var f = undefined;
-  f = /** @type {string} */ (jspb.Message.getField(message, 1));
-  if (f != null) {
+  f = message.getEngineVersion();
+  if (f.length > 0) {
     writer.writeString(
       1,
       f
     );
   }
-  f = /** @type {string} */ (jspb.Message.getField(message, 2));
-  if (f != null) {
+  f = message.getLogRetentionPeriod();
+  if (f.length > 0) {
     writer.writeString(
       2,
       f
     );
   }
-  f = /** @type {string} */ (jspb.Message.getField(message, 3));
-  if (f != null) {
+  f = message.getLogLevel();
+  if (f.length > 0) {
     writer.writeString(
       3,
       f
     );
   }
-  f = /** @type {!proto.engine_api.EnclaveMode} */ (jspb.Message.getField(message, 4));
-  if (f != null) {
-    writer.writeEnum(
+  f = message.getPoolSize();
+  if (f !== 0) {
+    writer.writeUint32(
       4,
       f
     );
   }
-  f = /** @type {boolean} */ (jspb.Message.getField(message, 5));
-  if (f != null) {
+  f = message.getIsDebugModeEnabled();
+  if (f) {
     writer.writeBool(
       5,
       f
     );
   }
+  f = message.getDomain();
+  if (f.length > 0) {
+    writer.writeString(
+      6,
+      f
+    );
+  }
 };
 
 
 /**
- * optional string enclave_name = 1;
+ * optional string engine_version = 1;
  * @return {string}
  */
-proto.engine_api.CreateEnclaveArgs.prototype.getEnclaveName = function() {
+proto.engine_api.GetEngineInfoResponse.prototype.getEngineVersion = function() {
   return /** @type {string} */ (jspb.Message.getFieldWithDefault(this, 1, ""));
 };
 
 
 /**
  * @param {string} value
- * @return {!proto.engine_api.CreateEnclaveArgs} returns this
- */
-proto.engine_api.CreateEnclaveArgs.prototype.setEnclaveName = function(value) {
-  return jspb.Message.setField(this, 1, value);
-};
-
-
-/**
- * Clears the field making it undefined.
- * @return {!proto.engine_api.CreateEnclaveArgs} returns this
- */
-proto.engine_api.CreateEnclaveArgs.prototype.clearEnclaveName = function() {
-  return jspb.Message.setField(this, 1, undefined);
-};
-
-
-/**
- * Returns whether this field is set.
- * @return {boolean}
+ * @return {!proto.engine_api.GetEngineInfoResponse} returns this
  */
-proto.engine_api.CreateEnclaveArgs.prototype.hasEnclaveName = function() {
-  return jspb.Message.getField(this, 1) != null;
+proto.engine_api.GetEngineInfoResponse.prototype.setEngineVersion = function(value) {
+  return jspb.Message.setProto3StringField(this, 1, value);
 };
 
 
 /**
- * optional string api_container_version_tag = 2;
+ * optional string log_retention_period = 2;
  * @return {string}
  */
-proto.engine_api.CreateEnclaveArgs.prototype.getApiContainerVersionTag = function() {
+proto.engine_api.GetEngineInfoResponse.prototype.getLogRetentionPeriod = function() {
   return /** @type {string} */ (jspb.Message.getFieldWithDefault(this, 2, ""));
 };
 
 
 /**
  * @param {string} value
- * @return {!proto.engine_api.CreateEnclaveArgs} returns this
- */
-proto.engine_api.CreateEnclaveArgs.prototype.setApiContainerVersionTag = function(value) {
-  return jspb.Message.setField(this, 2, value);
-};
-
-
-/**
- * Clears the field making it undefined.
- * @return {!proto.engine_api.CreateEnclaveArgs} returns this
- */
-proto.engine_api.CreateEnclaveArgs.prototype.clearApiContainerVersionTag = function() {
-  return jspb.Message.setField(this, 2, undefined);
-};
-
-
-/**
- * Returns whether this field is set.
- * @return {boolean}
+ * @return {!proto.engine_api.GetEngineInfoResponse} returns this
  */
-proto.engine_api.CreateEnclaveArgs.prototype.hasApiContainerVersionTag = function() {
-  return jspb.Message.getField(this, 2) != null;
+proto.engine_api.GetEngineInfoResponse.prototype.setLogRetentionPeriod = function(value) {
+  return jspb.Message.setProto3StringField(this, 2, value);
 };
 
 
 /**
- * optional string api_container_log_level = 3;
+ * optional string log_level = 3;
  * @return {string}
  */
-proto.engine_api.CreateEnclaveArgs.prototype.getApiContainerLogLevel = function() {
+proto.engine_api.GetEngineInfoResponse.prototype.getLogLevel = function() {
   return /** @type {string} */ (jspb.Message.getFieldWithDefault(this, 3, ""));
 };
 
 
 /**
  * @param {string} value
- * @return {!proto.engine_api.CreateEnclaveArgs} returns this
- */
-proto.engine_api.CreateEnclaveArgs.prototype.setApiContainerLogLevel = function(value) {
-  return jspb.Message.setField(this, 3, value);
-};
-
-
-/**
- * Clears the field making it undefined.
- * @return {!proto.engine_api.CreateEnclaveArgs} returns this
- */
-proto.engine_api.CreateEnclaveArgs.prototype.clearApiContainerLogLevel = function() {
-  return jspb.Message.setField(this, 3, undefined);
-};
-
-
-/**
- * Returns whether this field is set.
- * @return {boolean}
- */
-proto.engine_api.CreateEnclaveArgs.prototype.hasApiContainerLogLevel = function() {
-  return jspb.Message.getField(this, 3) != null;
-};
-
-
-/**
- * optional EnclaveMode mode = 4;
- * @return {!proto.engine_api.EnclaveMode}
- */
-proto.engine_api.CreateEnclaveArgs.prototype.getMode = function() {
-  return /** @type {!proto.engine_api.EnclaveMode} */ (jspb.Message.getFieldWithDefault(this, 4, 0));
-};
-
-
-/**
- * @param {!proto.engine_api.EnclaveMode} value
- * @return {!proto.engine_api.CreateEnclaveArgs} returns this
+ * @return {!proto.engine_api.GetEngineInfoResponse} returns this
  */
-proto.engine_api.CreateEnclaveArgs.prototype.setMode = function(value) {
-  return jspb.Message.setField(this, 4, value);
+proto.engine_api.GetEngineInfoResponse.prototype.setLogLevel = function(value) {
+  return jspb.Message.setProto3StringField(this, 3, value);
 };
 
 
 /**
- * Clears the field making it undefined.
- * @return {!proto.engine_api.CreateEnclaveArgs} returns this
+ * optional uint32 pool_size = 4;
+ * @return {number}
  */
-proto.engine_api.CreateEnclaveArgs.prototype.clearMode = function() {
-  return jspb.Message.setField(this, 4, undefined);
+proto.engine_api.GetEngineInfoResponse.prototype.getPoolSize = function() {
+  return /** @type {number} */ (jspb.Message.getFieldWithDefault(this, 4, 0));
 };
 
 
 /**
- * Returns whether this field is set.
- * @return {boolean}
+ * @param {number} value
+ * @return {!proto.engine_api.GetEngineInfoResponse} returns this
  */
-proto.engine_api.CreateEnclaveArgs.prototype.hasMode = function() {
-  return jspb.Message.getField(this, 4) != null;
+proto.engine_api.GetEngineInfoResponse.prototype.setPoolSize = function(value) {
+  return jspb.Message.setProto3IntField(this, 4, value);
 };
 
 
 /**
- * optional bool should_apic_run_in_debug_mode = 5;
+ * optional bool is_debug_mode_enabled = 5;
  * @return {boolean}
  */
-proto.engine_api.CreateEnclaveArgs.prototype.getShouldApicRunInDebugMode = function() {
+proto.engine_api.GetEngineInfoResponse.prototype.getIsDebugModeEnabled = function() {
   return /** @type {boolean} */ (jspb.Message.getBooleanFieldWithDefault(this, 5, false));
 };
 
 
 /**
  * @param {boolean} value
- * @return {!proto.engine_api.CreateEnclaveArgs} returns this
+ * @return {!proto.engine_api.GetEngineInfoResponse} returns this
  */
-proto.engine_api.CreateEnclaveArgs.prototype.setShouldApicRunInDebugMode = function(value) {
-  return jspb.Message.setField(this, 5, value);
+proto.engine_api.GetEngineInfoResponse.prototype.setIsDebugModeEnabled = function(value) {
+  return jspb.Message.setProto3BooleanField(this, 5, value);
 };
 
 
 /**
- * Clears the field making it undefined.
- * @return {!proto.engine_api.CreateEnclaveArgs} returns this
+ * optional string domain = 6;
+ * @return {string}
  */
-proto.engine_api.CreateEnclaveArgs.prototype.clearShouldApicRunInDebugMode = function() {
-  return jspb.Message.setField(this, 5, undefined);
+proto.engine_api.GetEngineInfoResponse.prototype.getDomain = function() {
+  return /** @type {string} */ (jspb.Message.getFieldWithDefault(this, 6, ""));
 };
 
 
 /**
- * Returns whether this field is set.
- * @return {boolean}
+ * @param {string} value
+ * @return {!proto.engine_api.GetEngineInfoResponse} returns this
  */
-proto.engine_api.CreateEnclaveArgs.prototype.hasShouldApicRunInDebugMode = function() {
-  return jspb.Message.getField(this, 5) != null;
+proto.engine_api.GetEngineInfoResponse.prototype.setDomain = function(value) {
+  return jspb.Message.setProto3StringField(this, 6, value);
 };
 
 
@@ -933,8 +1208,8 @@ if (jspb.Message.GENERATE_TO_OBJECT) {
  *     http://goto/soy-param-migration
  * @return {!Object}
  */
-proto.engine_api.CreateEnclaveResponse.prototype.toObject = function(opt_includeInstance) {
-  return proto.engine_api.CreateEnclaveResponse.toObject(opt_includeInstance, this);
+proto.engine_api.CreateEnclaveArgs.prototype.toObject = function(opt_includeInstance) {
+  return proto.engine_api.CreateEnclaveArgs.toObject(opt_includeInstance, this);
 };
 
 
@@ -943,13 +1218,23 @@ proto.engine_api.CreateEnclaveResponse.prototype.toObject = function(opt_include
  * @param {boolean|undefined} includeInstance Deprecated. Whether to include
  *     the JSPB instance for transitional soy proto support:
  *     http://goto/soy-param-migration
- * @param {!proto.engine_api.CreateEnclaveResponse} msg The msg instance to transform.
+ * @param {!proto.engine_api.CreateEnclaveArgs} msg The msg instance to transform.
  * @return {!Object}
  * @suppress {unusedLocalVariables} f is only used for nested messages
  */
-proto.engine_api.CreateEnclaveResponse.toObject = function(includeInstance, msg) {
+proto.engine_api.CreateEnclaveArgs.toObject = function(includeInstance, msg) {
   var f, obj = {
-    enclaveInfo: (f = msg.getEnclaveInfo()) && proto.engine_api.EnclaveInfo.toObject(includeInstance, f)
+    enclaveName: jspb.Message.getFieldWithDefault(msg, 1, ""),
+    apiContainerVersionTag: jspb.Message.getFieldWithDefault(msg, 2, ""),
+    apiContainerLogLevel: jspb.Message.getFieldWithDefault(msg, 3, ""),
+    mode: jspb.Message.getFieldWithDefault(msg, 4, 0),
+    shouldApicRunInDebugMode: jspb.Message.getBooleanFieldWithDefault(msg, 5, false),
+    ttlSeconds: jspb.Message.getFieldWithDefault(msg, 6, 0),
+    idleTimeoutSeconds: jspb.Message.getFieldWithDefault(msg, 7, 0),
+    expiredEnclaveAction: jspb.Message.getFieldWithDefault(msg, 8, 0),
+    labelsMap: (f = msg.getLabelsMap()) ? f.toObject(includeInstance, undefined) : [],
+    seedPackageLocator: jspb.Message.getFieldWithDefault(msg, 10, ""),
+    seedPackageParams: jspb.Message.getFieldWithDefault(msg, 11, "")
   };
 
   if (includeInstance) {
@@ -963,23 +1248,23 @@ proto.engine_api.CreateEnclaveResponse.toObject = function(includeInstance, msg)
 /**
  * Deserializes binary data (in protobuf wire format).
  * @param {jspb.ByteSource} bytes The bytes to deserialize.
- * @return {!proto.engine_api.CreateEnclaveResponse}
+ * @return {!proto.engine_api.CreateEnclaveArgs}
  */
-proto.engine_api.CreateEnclaveResponse.deserializeBinary = function(bytes) {
+proto.engine_api.CreateEnclaveArgs.deserializeBinary = function(bytes) {
   var reader = new jspb.BinaryReader(bytes);
-  var msg = new proto.engine_api.CreateEnclaveResponse;
-  return proto.engine_api.CreateEnclaveResponse.deserializeBinaryFromReader(msg, reader);
+  var msg = new proto.engine_api.CreateEnclaveArgs;
+  return proto.engine_api.CreateEnclaveArgs.deserializeBinaryFromReader(msg, reader);
 };
 
 
 /**
  * Deserializes binary data (in protobuf wire format) from the
  * given reader into the given message object.
- * @param {!proto.engine_api.CreateEnclaveResponse} msg The message object to deserialize into.
+ * @param {!proto.engine_api.CreateEnclaveArgs} msg The message object to deserialize into.
  * @param {!jspb.BinaryReader} reader The BinaryReader to use.
- * @return {!proto.engine_api.CreateEnclaveResponse}
+ * @return {!proto.engine_api.CreateEnclaveArgs}
  */
-proto.engine_api.CreateEnclaveResponse.deserializeBinaryFromReader = function(msg, reader) {
+proto.engine_api.CreateEnclaveArgs.deserializeBinaryFromReader = function(msg, reader) {
   while (reader.nextField()) {
     if (reader.isEndGroup()) {
       break;
@@ -987,9 +1272,50 @@ proto.engine_api.CreateEnclaveResponse.deserializeBinaryFromReader = function(ms
     var field = reader.getFieldNumber();
     switch (field) {
     case 1:
-      var value = new proto.engine_api.EnclaveInfo;
-      reader.readMessage(value,proto.engine_api.EnclaveInfo.deserializeBinaryFromReader);
-      msg.setEnclaveInfo(value);
+      var value = /** @type {string} */ (reader.readString());
+      msg.setEnclaveName(value);
+      break;
+    case 2:
+      var value = /** @type {string} */ (reader.readString());
+      msg.setApiContainerVersionTag(value);
+      break;
+    case 3:
+      var value = /** @type {string} */ (reader.readString());
+      msg.setApiContainerLogLevel(value);
+      break;
+    case 4:
+      var value = /** @type {!proto.engine_api.EnclaveMode} */ (reader.readEnum());
+      msg.setMode(value);
+      break;
+    case 5:
+      var value = /** @type {boolean} */ (reader.readBool());
+      msg.setShouldApicRunInDebugMode(value);
+      break;
+    case 6:
+      var value = /** @type {number} */ (reader.readUint32());
+      msg.setTtlSeconds(value);
+      break;
+    case 7:
+      var value = /** @type {number} */ (reader.readUint32());
+      msg.setIdleTimeoutSeconds(value);
+      break;
+    case 8:
+      var value = /** @type {!proto.engine_api.ExpiredEnclaveAction} */ (reader.readEnum());
+      msg.setExpiredEnclaveAction(value);
+      break;
+    case 9:
+      var value = msg.getLabelsMap();
+      reader.readMessage(value, function(message, reader) {
+        jspb.Map.deserializeBinary(message, reader, jspb.BinaryReader.prototype.readString, jspb.BinaryReader.prototype.readString, null, "", "");
+         });
+      break;
+    case 10:
+      var value = /** @type {string} */ (reader.readString());
+      msg.setSeedPackageLocator(value);
+      break;
+    case 11:
+      var value = /** @type {string} */ (reader.readString());
+      msg.setSeedPackageParams(value);
       break;
     default:
       reader.skipField();
@@ -1004,9 +1330,9 @@ proto.engine_api.CreateEnclaveResponse.deserializeBinaryFromReader = function(ms
  * Serializes the message to binary data (in protobuf wire format).
  * @return {!Uint8Array}
  */
-proto.engine_api.CreateEnclaveResponse.prototype.serializeBinary = function() {
+proto.engine_api.CreateEnclaveArgs.prototype.serializeBinary = function() {
   var writer = new jspb.BinaryWriter();
-  proto.engine_api.CreateEnclaveResponse.serializeBinaryToWriter(this, writer);
+  proto.engine_api.CreateEnclaveArgs.serializeBinaryToWriter(this, writer);
   return writer.getResultBuffer();
 };
 
@@ -1014,64 +1340,4679 @@ proto.engine_api.CreateEnclaveResponse.prototype.serializeBinary = function() {
 /**
  * Serializes the given message to binary data (in protobuf wire
  * format), writing to the given BinaryWriter.
- * @param {!proto.engine_api.CreateEnclaveResponse} message
+ * @param {!proto.engine_api.CreateEnclaveArgs} message
  * @param {!jspb.BinaryWriter} writer
  * @suppress {unusedLocalVariables} f is only used for nested messages
  */
-proto.engine_api.CreateEnclaveResponse.serializeBinaryToWriter = function(message, writer) {
+proto.engine_api.CreateEnclaveArgs.serializeBinaryToWriter = function(message, writer) {
   var f = undefined;
-  f = message.getEnclaveInfo();
+  f = /** @type {string} */ (jspb.Message.getField(message, 1));
   if (f != null) {
-    writer.writeMessage(
+    writer.writeString(
       1,
-      f,
-      proto.engine_api.EnclaveInfo.serializeBinaryToWriter
+      f
     );
   }
-};
-
-
-/**
- * optional EnclaveInfo enclave_info = 1;
- * @return {?proto.engine_api.EnclaveInfo}
- */
-proto.engine_api.CreateEnclaveResponse.prototype.getEnclaveInfo = function() {
-  return /** @type{?proto.engine_api.EnclaveInfo} */ (
-    jspb.Message.getWrapperField(this, proto.engine_api.EnclaveInfo, 1));
-};
-
-
-/**
- * @param {?proto.engine_api.EnclaveInfo|undefined} value
- * @return {!proto.engine_api.CreateEnclaveResponse} returns this
-*/
-proto.engine_api.CreateEnclaveResponse.prototype.setEnclaveInfo = function(value) {
-  return jspb.Message.setWrapperField(this, 1, value);
-};
-
-
-/**
- * Clears the message field making it undefined.
- * @return {!proto.engine_api.CreateEnclaveResponse} returns this
- */
-proto.engine_api.CreateEnclaveResponse.prototype.clearEnclaveInfo = function() {
-  return this.setEnclaveInfo(undefined);
-};
-
-
-/**
- * Returns whether this field is set.
- * @return {boolean}
- */
-proto.engine_api.CreateEnclaveResponse.prototype.hasEnclaveInfo = function() {
-  return jspb.Message.getField(this, 1) != null;
-};
-
-
-
-
-
-if (jspb.Message.GENERATE_TO_OBJECT) {
+  f = /** @type {string} */ (jspb.Message.getField(message, 2));
+  if (f != null) {
+    writer.writeString(
+      2,
+      f
+    );
+  }
+  f = /** @type {string} */ (jspb.Message.getField(message, 3));
+  if (f != null) {
+    writer.writeString(
+      3,
+      f
+    );
+  }
+  f = /** @type {!proto.engine_api.EnclaveMode} */ (jspb.Message.getField(message, 4));
+  if (f != null) {
+    writer.writeEnum(
+      4,
+      f
+    );
+  }
+  f = /** @type {boolean} */ (jspb.Message.getField(message, 5));
+  if (f != null) {
+    writer.writeBool(
+      5,
+      f
+    );
+  }
+  f = /** @type {number} */ (jspb.Message.getField(message, 6));
+  if (f != null) {
+    writer.writeUint32(
+      6,
+      f
+    );
+  }
+  f = /** @type {number} */ (jspb.Message.getField(message, 7));
+  if (f != null) {
+    writer.writeUint32(
+      7,
+      f
+    );
+  }
+  f = /** @type {!proto.engine_api.ExpiredEnclaveAction} */ (jspb.Message.getField(message, 8));
+  if (f != null) {
+    writer.writeEnum(
+      8,
+      f
+    );
+  }
+  f = message.getLabelsMap(true);
+  if (f && f.getLength() > 0) {
+    f.serializeBinary(9, writer, jspb.BinaryWriter.prototype.writeString, jspb.BinaryWriter.prototype.writeString);
+  }
+  f = /** @type {string} */ (jspb.Message.getField(message, 10));
+  if (f != null) {
+    writer.writeString(
+      10,
+      f
+    );
+  }
+  f = /** @type {string} */ (jspb.Message.getField(message, 11));
+  if (f != null) {
+    writer.writeString(
+      11,
+      f
+    );
+  }
+};
+
+
+/**
+ * optional string enclave_name = 1;
+ * @return {string}
+ */
+proto.engine_api.CreateEnclaveArgs.prototype.getEnclaveName = function() {
+  return /** @type {string} */ (jspb.Message.getFieldWithDefault(this, 1, ""));
+};
+
+
+/**
+ * @param {string} value
+ * @return {!proto.engine_api.CreateEnclaveArgs} returns this
+ */
+proto.engine_api.CreateEnclaveArgs.prototype.setEnclaveName = function(value) {
+  return jspb.Message.setField(this, 1, value);
+};
+
+
+/**
+ * Clears the field making it undefined.
+ * @return {!proto.engine_api.CreateEnclaveArgs} returns this
+ */
+proto.engine_api.CreateEnclaveArgs.prototype.clearEnclaveName = function() {
+  return jspb.Message.setField(this, 1, undefined);
+};
+
+
+/**
+ * Returns whether this field is set.
+ * @return {boolean}
+ */
+proto.engine_api.CreateEnclaveArgs.prototype.hasEnclaveName = function() {
+  return jspb.Message.getField(this, 1) != null;
+};
+
+
+/**
+ * optional string api_container_version_tag = 2;
+ * @return {string}
+ */
+proto.engine_api.CreateEnclaveArgs.prototype.getApiContainerVersionTag = function() {
+  return /** @type {string} */ (jspb.Message.getFieldWithDefault(this, 2, ""));
+};
+
+
+/**
+ * @param {string} value
+ * @return {!proto.engine_api.CreateEnclaveArgs} returns this
+ */
+proto.engine_api.CreateEnclaveArgs.prototype.setApiContainerVersionTag = function(value) {
+  return jspb.Message.setField(this, 2, value);
+};
+
+
+/**
+ * Clears the field making it undefined.
+ * @return {!proto.engine_api.CreateEnclaveArgs} returns this
+ */
+proto.engine_api.CreateEnclaveArgs.prototype.clearApiContainerVersionTag = function() {
+  return jspb.Message.setField(this, 2, undefined);
+};
+
+
+/**
+ * Returns whether this field is set.
+ * @return {boolean}
+ */
+proto.engine_api.CreateEnclaveArgs.prototype.hasApiContainerVersionTag = function() {
+  return jspb.Message.getField(this, 2) != null;
+};
+
+
+/**
+ * optional string api_container_log_level = 3;
+ * @return {string}
+ */
+proto.engine_api.CreateEnclaveArgs.prototype.getApiContainerLogLevel = function() {
+  return /** @type {string} */ (jspb.Message.getFieldWithDefault(this, 3, ""));
+};
+
+
+/**
+ * @param {string} value
+ * @return {!proto.engine_api.CreateEnclaveArgs} returns this
+ */
+proto.engine_api.CreateEnclaveArgs.prototype.setApiContainerLogLevel = function(value) {
+  return jspb.Message.setField(this, 3, value);
+};
+
+
+/**
+ * Clears the field making it undefined.
+ * @return {!proto.engine_api.CreateEnclaveArgs} returns this
+ */
+proto.engine_api.CreateEnclaveArgs.prototype.clearApiContainerLogLevel = function() {
+  return jspb.Message.setField(this, 3, undefined);
+};
+
+
+/**
+ * Returns whether this field is set.
+ * @return {boolean}
+ */
+proto.engine_api.CreateEnclaveArgs.prototype.hasApiContainerLogLevel = function() {
+  return jspb.Message.getField(this, 3) != null;
+};
+
+
+/**
+ * optional EnclaveMode mode = 4;
+ * @return {!proto.engine_api.EnclaveMode}
+ */
+proto.engine_api.CreateEnclaveArgs.prototype.getMode = function() {
+  return /** @type {!proto.engine_api.EnclaveMode} */ (jspb.Message.getFieldWithDefault(this, 4, 0));
+};
+
+
+/**
+ * @param {!proto.engine_api.EnclaveMode} value
+ * @return {!proto.engine_api.CreateEnclaveArgs} returns this
+ */
+proto.engine_api.CreateEnclaveArgs.prototype.setMode = function(value) {
+  return jspb.Message.setField(this, 4, value);
+};
+
+
+/**
+ * Clears the field making it undefined.
+ * @return {!proto.engine_api.CreateEnclaveArgs} returns this
+ */
+proto.engine_api.CreateEnclaveArgs.prototype.clearMode = function() {
+  return jspb.Message.setField(this, 4, undefined);
+};
+
+
+/**
+ * Returns whether this field is set.
+ * @return {boolean}
+ */
+proto.engine_api.CreateEnclaveArgs.prototype.hasMode = function() {
+  return jspb.Message.getField(this, 4) != null;
+};
+
+
+/**
+ * optional bool should_apic_run_in_debug_mode = 5;
+ * @return {boolean}
+ */
+proto.engine_api.CreateEnclaveArgs.prototype.getShouldApicRunInDebugMode = function() {
+  return /** @type {boolean} */ (jspb.Message.getBooleanFieldWithDefault(this, 5, false));
+};
+
+
+/**
+ * @param {boolean} value
+ * @return {!proto.engine_api.CreateEnclaveArgs} returns this
+ */
+proto.engine_api.CreateEnclaveArgs.prototype.setShouldApicRunInDebugMode = function(value) {
+  return jspb.Message.setField(this, 5, value);
+};
+
+
+/**
+ * Clears the field making it undefined.
+ * @return {!proto.engine_api.CreateEnclaveArgs} returns this
+ */
+proto.engine_api.CreateEnclaveArgs.prototype.clearShouldApicRunInDebugMode = function() {
+  return jspb.Message.setField(this, 5, undefined);
+};
+
+
+/**
+ * Returns whether this field is set.
+ * @return {boolean}
+ */
+proto.engine_api.CreateEnclaveArgs.prototype.hasShouldApicRunInDebugMode = function() {
+  return jspb.Message.getField(this, 5) != null;
+};
+
+
+/**
+ * optional uint32 ttl_seconds = 6;
+ * @return {number}
+ */
+proto.engine_api.CreateEnclaveArgs.prototype.getTtlSeconds = function() {
+  return /** @type {number} */ (jspb.Message.getFieldWithDefault(this, 6, 0));
+};
+
+
+/**
+ * @param {number} value
+ * @return {!proto.engine_api.CreateEnclaveArgs} returns this
+ */
+proto.engine_api.CreateEnclaveArgs.prototype.setTtlSeconds = function(value) {
+  return jspb.Message.setField(this, 6, value);
+};
+
+
+/**
+ * Clears the field making it undefined.
+ * @return {!proto.engine_api.CreateEnclaveArgs} returns this
+ */
+proto.engine_api.CreateEnclaveArgs.prototype.clearTtlSeconds = function() {
+  return jspb.Message.setField(this, 6, undefined);
+};
+
+
+/**
+ * Returns whether this field is set.
+ * @return {boolean}
+ */
+proto.engine_api.CreateEnclaveArgs.prototype.hasTtlSeconds = function() {
+  return jspb.Message.getField(this, 6) != null;
+};
+
+
+/**
+ * optional uint32 idle_timeout_seconds = 7;
+ * @return {number}
+ */
+proto.engine_api.CreateEnclaveArgs.prototype.getIdleTimeoutSeconds = function() {
+  return /** @type {number} */ (jspb.Message.getFieldWithDefault(this, 7, 0));
+};
+
+
+/**
+ * @param {number} value
+ * @return {!proto.engine_api.CreateEnclaveArgs} returns this
+ */
+proto.engine_api.CreateEnclaveArgs.prototype.setIdleTimeoutSeconds = function(value) {
+  return jspb.Message.setField(this, 7, value);
+};
+
+
+/**
+ * Clears the field making it undefined.
+ * @return {!proto.engine_api.CreateEnclaveArgs} returns this
+ */
+proto.engine_api.CreateEnclaveArgs.prototype.clearIdleTimeoutSeconds = function() {
+  return jspb.Message.setField(this, 7, undefined);
+};
+
+
+/**
+ * Returns whether this field is set.
+ * @return {boolean}
+ */
+proto.engine_api.CreateEnclaveArgs.prototype.hasIdleTimeoutSeconds = function() {
+  return jspb.Message.getField(this, 7) != null;
+};
+
+
+/**
+ * optional ExpiredEnclaveAction expired_enclave_action = 8;
+ * @return {!proto.engine_api.ExpiredEnclaveAction}
+ */
+proto.engine_api.CreateEnclaveArgs.prototype.getExpiredEnclaveAction = function() {
+  return /** @type {!proto.engine_api.ExpiredEnclaveAction} */ (jspb.Message.getFieldWithDefault(this, 8, 0));
+};
+
+
+/**
+ * @param {!proto.engine_api.ExpiredEnclaveAction} value
+ * @return {!proto.engine_api.CreateEnclaveArgs} returns this
+ */
+proto.engine_api.CreateEnclaveArgs.prototype.setExpiredEnclaveAction = function(value) {
+  return jspb.Message.setField(this, 8, value);
+};
+
+
+/**
+ * Clears the field making it undefined.
+ * @return {!proto.engine_api.CreateEnclaveArgs} returns this
+ */
+proto.engine_api.CreateEnclaveArgs.prototype.clearExpiredEnclaveAction = function() {
+  return jspb.Message.setField(this, 8, undefined);
+};
+
+
+/**
+ * Returns whether this field is set.
+ * @return {boolean}
+ */
+proto.engine_api.CreateEnclaveArgs.prototype.hasExpiredEnclaveAction = function() {
+  return jspb.Message.getField(this, 8) != null;
+};
+
+
+/**
+ * map<string, string> labels = 9;
+ * @param {boolean=} opt_noLazyCreate Do not create the map if
+ * empty, instead returning `undefined`
+ * @return {!jspb.Map<string,string>}
+ */
+proto.engine_api.CreateEnclaveArgs.prototype.getLabelsMap = function(opt_noLazyCreate) {
+  return /** @type {!jspb.Map<string,string>} */ (
+      jspb.Message.getMapField(this, 9, opt_noLazyCreate,
+      null));
+};
+
+
+/**
+ * Clears values from the map. The map will be non-null.
+ * @return {!proto.engine_api.CreateEnclaveArgs} returns this
+ */
+proto.engine_api.CreateEnclaveArgs.prototype.clearLabelsMap = function() {
+  this.getLabelsMap().clear();
+  return this;};
+
+
+/**
+ * optional string seed_package_locator = 10;
+ * @return {string}
+ */
+proto.engine_api.CreateEnclaveArgs.prototype.getSeedPackageLocator = function() {
+  return /** @type {string} */ (jspb.Message.getFieldWithDefault(this, 10, ""));
+};
+
+
+/**
+ * @param {string} value
+ * @return {!proto.engine_api.CreateEnclaveArgs} returns this
+ */
+proto.engine_api.CreateEnclaveArgs.prototype.setSeedPackageLocator = function(value) {
+  return jspb.Message.setField(this, 10, value);
+};
+
+
+/**
+ * Clears the field making it undefined.
+ * @return {!proto.engine_api.CreateEnclaveArgs} returns this
+ */
+proto.engine_api.CreateEnclaveArgs.prototype.clearSeedPackageLocator = function() {
+  return jspb.Message.setField(this, 10, undefined);
+};
+
+
+/**
+ * Returns whether this field is set.
+ * @return {boolean}
+ */
+proto.engine_api.CreateEnclaveArgs.prototype.hasSeedPackageLocator = function() {
+  return jspb.Message.getField(this, 10) != null;
+};
+
+
+/**
+ * optional string seed_package_params = 11;
+ * @return {string}
+ */
+proto.engine_api.CreateEnclaveArgs.prototype.getSeedPackageParams = function() {
+  return /** @type {string} */ (jspb.Message.getFieldWithDefault(this, 11, ""));
+};
+
+
+/**
+ * @param {string} value
+ * @return {!proto.engine_api.CreateEnclaveArgs} returns this
+ */
+proto.engine_api.CreateEnclaveArgs.prototype.setSeedPackageParams = function(value) {
+  return jspb.Message.setField(this, 11, value);
+};
+
+
+/**
+ * Clears the field making it undefined.
+ * @return {!proto.engine_api.CreateEnclaveArgs} returns this
+ */
+proto.engine_api.CreateEnclaveArgs.prototype.clearSeedPackageParams = function() {
+  return jspb.Message.setField(this, 11, undefined);
+};
+
+
+/**
+ * Returns whether this field is set.
+ * @return {boolean}
+ */
+proto.engine_api.CreateEnclaveArgs.prototype.hasSeedPackageParams = function() {
+  return jspb.Message.getField(this, 11) != null;
+};
+
+
+
+
+
+if (jspb.Message.GENERATE_TO_OBJECT) {
+/**
+ * Creates an object representation of this proto.
+ * Field names that are reserved in JavaScript and will be renamed to pb_name.
+ * Optional fields that are not set will be set to undefined.
+ * To access a reserved field use, foo.pb_<name>, eg, foo.pb_default.
+ * For the list of reserved names please see:
+ *     net/proto2/compiler/js/internal/generator.cc#kKeyword.
+ * @param {boolean=} opt_includeInstance Deprecated. whether to include the
+ *     JSPB instance for transitional soy proto support:
+ *     http://goto/soy-param-migration
+ * @return {!Object}
+ */
+proto.engine_api.CreateEnclaveResponse.prototype.toObject = function(opt_includeInstance) {
+  return proto.engine_api.CreateEnclaveResponse.toObject(opt_includeInstance, this);
+};
+
+
+/**
+ * Static version of the {@see toObject} method.
+ * @param {boolean|undefined} includeInstance Deprecated. Whether to include
+ *     the JSPB instance for transitional soy proto support:
+ *     http://goto/soy-param-migration
+ * @param {!proto.engine_api.CreateEnclaveResponse} msg The msg instance to transform.
+ * @return {!Object}
+ * @suppress {unusedLocalVariables} f is only used for nested messages
+ */
+proto.engine_api.CreateEnclaveResponse.toObject = function(includeInstance, msg) {
+  var f, obj = {
+    enclaveInfo: (f = msg.getEnclaveInfo()) && proto.engine_api.EnclaveInfo.toObject(includeInstance, f)
+  };
+
+  if (includeInstance) {
+    obj.$jspbMessageInstance = msg;
+  }
+  return obj;
+};
+}
+
+
+/**
+ * Deserializes binary data (in protobuf wire format).
+ * @param {jspb.ByteSource} bytes The bytes to deserialize.
+ * @return {!proto.engine_api.CreateEnclaveResponse}
+ */
+proto.engine_api.CreateEnclaveResponse.deserializeBinary = function(bytes) {
+  var reader = new jspb.BinaryReader(bytes);
+  var msg = new proto.engine_api.CreateEnclaveResponse;
+  return proto.engine_api.CreateEnclaveResponse.deserializeBinaryFromReader(msg, reader);
+};
+
+
+/**
+ * Deserializes binary data (in protobuf wire format) from the
+ * given reader into the given message object.
+ * @param {!proto.engine_api.CreateEnclaveResponse} msg The message object to deserialize into.
+ * @param {!jspb.BinaryReader} reader The BinaryReader to use.
+ * @return {!proto.engine_api.CreateEnclaveResponse}
+ */
+proto.engine_api.CreateEnclaveResponse.deserializeBinaryFromReader = function(msg, reader) {
+  while (reader.nextField()) {
+    if (reader.isEndGroup()) {
+      break;
+    }
+    var field = reader.getFieldNumber();
+    switch (field) {
+    case 1:
+      var value = new proto.engine_api.EnclaveInfo;
+      reader.readMessage(value,proto.engine_api.EnclaveInfo.deserializeBinaryFromReader);
+      msg.setEnclaveInfo(value);
+      break;
+    default:
+      reader.skipField();
+      break;
+    }
+  }
+  return msg;
+};
+
+
+/**
+ * Serializes the message to binary data (in protobuf wire format).
+ * @return {!Uint8Array}
+ */
+proto.engine_api.CreateEnclaveResponse.prototype.serializeBinary = function() {
+  var writer = new jspb.BinaryWriter();
+  proto.engine_api.CreateEnclaveResponse.serializeBinaryToWriter(this, writer);
+  return writer.getResultBuffer();
+};
+
+
+/**
+ * Serializes the given message to binary data (in protobuf wire
+ * format), writing to the given BinaryWriter.
+ * @param {!proto.engine_api.CreateEnclaveResponse} message
+ * @param {!jspb.BinaryWriter} writer
+ * @suppress {unusedLocalVariables} f is only used for nested messages
+ */
+proto.engine_api.CreateEnclaveResponse.serializeBinaryToWriter = function(message, writer) {
+  var f = undefined;
+  f = message.getEnclaveInfo();
+  if (f != null) {
+    writer.writeMessage(
+      1,
+      f,
+      proto.engine_api.EnclaveInfo.serializeBinaryToWriter
+    );
+  }
+};
+
+
+/**
+ * optional EnclaveInfo enclave_info = 1;
+ * @return {?proto.engine_api.EnclaveInfo}
+ */
+proto.engine_api.CreateEnclaveResponse.prototype.getEnclaveInfo = function() {
+  return /** @type{?proto.engine_api.EnclaveInfo} */ (
+    jspb.Message.getWrapperField(this, proto.engine_api.EnclaveInfo, 1));
+};
+
+
+/**
+ * @param {?proto.engine_api.EnclaveInfo|undefined} value
+ * @return {!proto.engine_api.CreateEnclaveResponse} returns this
+*/
+proto.engine_api.CreateEnclaveResponse.prototype.setEnclaveInfo = function(value) {
+  return jspb.Message.setWrapperField(this, 1, value);
+};
+
+
+/**
+ * Clears the message field making it undefined.
+ * @return {!proto.engine_api.CreateEnclaveResponse} returns this
+ */
+proto.engine_api.CreateEnclaveResponse.prototype.clearEnclaveInfo = function() {
+  return this.setEnclaveInfo(undefined);
+};
+
+
+/**
+ * Returns whether this field is set.
+ * @return {boolean}
+ */
+proto.engine_api.CreateEnclaveResponse.prototype.hasEnclaveInfo = function() {
+  return jspb.Message.getField(this, 1) != null;
+};
+
+
+
+
+
+if (jspb.Message.GENERATE_TO_OBJECT) {
+/**
+ * Creates an object representation of this proto.
+ * Field names that are reserved in JavaScript and will be renamed to pb_name.
+ * Optional fields that are not set will be set to undefined.
+ * To access a reserved field use, foo.pb_<name>, eg, foo.pb_default.
+ * For the list of reserved names please see:
+ *     net/proto2/compiler/js/internal/generator.cc#kKeyword.
+ * @param {boolean=} opt_includeInstance Deprecated. whether to include the
+ *     JSPB instance for transitional soy proto support:
+ *     http://goto/soy-param-migration
+ * @return {!Object}
+ */
+proto.engine_api.EnclaveAPIContainerInfo.prototype.toObject = function(opt_includeInstance) {
+  return proto.engine_api.EnclaveAPIContainerInfo.toObject(opt_includeInstance, this);
+};
+
+
+/**
+ * Static version of the {@see toObject} method.
+ * @param {boolean|undefined} includeInstance Deprecated. Whether to include
+ *     the JSPB instance for transitional soy proto support:
+ *     http://goto/soy-param-migration
+ * @param {!proto.engine_api.EnclaveAPIContainerInfo} msg The msg instance to transform.
+ * @return {!Object}
+ * @suppress {unusedLocalVariables} f is only used for nested messages
+ */
+proto.engine_api.EnclaveAPIContainerInfo.toObject = function(includeInstance, msg) {
+  var f, obj = {
+    containerId: jspb.Message.getFieldWithDefault(msg, 1, ""),
+    ipInsideEnclave: jspb.Message.getFieldWithDefault(msg, 2, ""),
+    grpcPortInsideEnclave: jspb.Message.getFieldWithDefault(msg, 3, 0),
+    bridgeIpAddress: jspb.Message.getFieldWithDefault(msg, 6, "")
+  };
+
+  if (includeInstance) {
+    obj.$jspbMessageInstance = msg;
+  }
+  return obj;
+};
+}
+
+
+/**
+ * Deserializes binary data (in protobuf wire format).
+ * @param {jspb.ByteSource} bytes The bytes to deserialize.
+ * @return {!proto.engine_api.EnclaveAPIContainerInfo}
+ */
+proto.engine_api.EnclaveAPIContainerInfo.deserializeBinary = function(bytes) {
+  var reader = new jspb.BinaryReader(bytes);
+  var msg = new proto.engine_api.EnclaveAPIContainerInfo;
+  return proto.engine_api.EnclaveAPIContainerInfo.deserializeBinaryFromReader(msg, reader);
+};
+
+
+/**
+ * Deserializes binary data (in protobuf wire format) from the
+ * given reader into the given message object.
+ * @param {!proto.engine_api.EnclaveAPIContainerInfo} msg The message object to deserialize into.
+ * @param {!jspb.BinaryReader} reader The BinaryReader to use.
+ * @return {!proto.engine_api.EnclaveAPIContainerInfo}
+ */
+proto.engine_api.EnclaveAPIContainerInfo.deserializeBinaryFromReader = function(msg, reader) {
+  while (reader.nextField()) {
+    if (reader.isEndGroup()) {
+      break;
+    }
+    var field = reader.getFieldNumber();
+    switch (field) {
+    case 1:
+      var value = /** @type {string} */ (reader.readString());
+      msg.setContainerId(value);
+      break;
+    case 2:
+      var value = /** @type {string} */ (reader.readString());
+      msg.setIpInsideEnclave(value);
+      break;
+    case 3:
+      var value = /** @type {number} */ (reader.readUint32());
+      msg.setGrpcPortInsideEnclave(value);
+      break;
+    case 6:
+      var value = /** @type {string} */ (reader.readString());
+      msg.setBridgeIpAddress(value);
+      break;
+    default:
+      reader.skipField();
+      break;
+    }
+  }
+  return msg;
+};
+
+
+/**
+ * Serializes the message to binary data (in protobuf wire format).
+ * @return {!Uint8Array}
+ */
+proto.engine_api.EnclaveAPIContainerInfo.prototype.serializeBinary = function() {
+  var writer = new jspb.BinaryWriter();
+  proto.engine_api.EnclaveAPIContainerInfo.serializeBinaryToWriter(this, writer);
+  return writer.getResultBuffer();
+};
+
+
+/**
+ * Serializes the given message to binary data (in protobuf wire
+ * format), writing to the given BinaryWriter.
+ * @param {!proto.engine_api.EnclaveAPIContainerInfo} message
+ * @param {!jspb.BinaryWriter} writer
+ * @suppress {unusedLocalVariables} f is only used for nested messages
+ */
+proto.engine_api.EnclaveAPIContainerInfo.serializeBinaryToWriter = function(message, writer) {
+  var f = undefined;
+  f = message.getContainerId();
+  if (f.length > 0) {
+    writer.writeString(
+      1,
+      f
+    );
+  }
+  f = message.getIpInsideEnclave();
+  if (f.length > 0) {
+    writer.writeString(
+      2,
+      f
+    );
+  }
+  f = message.getGrpcPortInsideEnclave();
+  if (f !== 0) {
+    writer.writeUint32(
+      3,
+      f
+    );
+  }
+  f = message.getBridgeIpAddress();
+  if (f.length > 0) {
+    writer.writeString(
+      6,
+      f
+    );
+  }
+};
+
+
+/**
+ * optional string container_id = 1;
+ * @return {string}
+ */
+proto.engine_api.EnclaveAPIContainerInfo.prototype.getContainerId = function() {
+  return /** @type {string} */ (jspb.Message.getFieldWithDefault(this, 1, ""));
+};
+
+
+/**
+ * @param {string} value
+ * @return {!proto.engine_api.EnclaveAPIContainerInfo} returns this
+ */
+proto.engine_api.EnclaveAPIContainerInfo.prototype.setContainerId = function(value) {
+  return jspb.Message.setProto3StringField(this, 1, value);
+};
+
+
+/**
+ * optional string ip_inside_enclave = 2;
+ * @return {string}
+ */
+proto.engine_api.EnclaveAPIContainerInfo.prototype.getIpInsideEnclave = function() {
+  return /** @type {string} */ (jspb.Message.getFieldWithDefault(this, 2, ""));
+};
+
+
+/**
+ * @param {string} value
+ * @return {!proto.engine_api.EnclaveAPIContainerInfo} returns this
+ */
+proto.engine_api.EnclaveAPIContainerInfo.prototype.setIpInsideEnclave = function(value) {
+  return jspb.Message.setProto3StringField(this, 2, value);
+};
+
+
+/**
+ * optional uint32 grpc_port_inside_enclave = 3;
+ * @return {number}
+ */
+proto.engine_api.EnclaveAPIContainerInfo.prototype.getGrpcPortInsideEnclave = function() {
+  return /** @type {number} */ (jspb.Message.getFieldWithDefault(this, 3, 0));
+};
+
+
+/**
+ * @param {number} value
+ * @return {!proto.engine_api.EnclaveAPIContainerInfo} returns this
+ */
+proto.engine_api.EnclaveAPIContainerInfo.prototype.setGrpcPortInsideEnclave = function(value) {
+  return jspb.Message.setProto3IntField(this, 3, value);
+};
+
+
+/**
+ * optional string bridge_ip_address = 6;
+ * @return {string}
+ */
+proto.engine_api.EnclaveAPIContainerInfo.prototype.getBridgeIpAddress = function() {
+  return /** @type {string} */ (jspb.Message.getFieldWithDefault(this, 6, ""));
+};
+
+
+/**
+ * @param {string} value
+ * @return {!proto.engine_api.EnclaveAPIContainerInfo} returns this
+ */
+proto.engine_api.EnclaveAPIContainerInfo.prototype.setBridgeIpAddress = function(value) {
+  return jspb.Message.setProto3StringField(this, 6, value);
+};
+
+
+
+
+
+if (jspb.Message.GENERATE_TO_OBJECT) {
+/**
+ * Creates an object representation of this proto.
+ * Field names that are reserved in JavaScript and will be renamed to pb_name.
+ * Optional fields that are not set will be set to undefined.
+ * To access a reserved field use, foo.pb_<name>, eg, foo.pb_default.
+ * For the list of reserved names please see:
+ *     net/proto2/compiler/js/internal/generator.cc#kKeyword.
+ * @param {boolean=} opt_includeInstance Deprecated. whether to include the
+ *     JSPB instance for transitional soy proto support:
+ *     http://goto/soy-param-migration
+ * @return {!Object}
+ */
+proto.engine_api.EnclaveAPIContainerHostMachineInfo.prototype.toObject = function(opt_includeInstance) {
+  return proto.engine_api.EnclaveAPIContainerHostMachineInfo.toObject(opt_includeInstance, this);
+};
+
+
+/**
+ * Static version of the {@see toObject} method.
+ * @param {boolean|undefined} includeInstance Deprecated. Whether to include
+ *     the JSPB instance for transitional soy proto support:
+ *     http://goto/soy-param-migration
+ * @param {!proto.engine_api.EnclaveAPIContainerHostMachineInfo} msg The msg instance to transform.
+ * @return {!Object}
+ * @suppress {unusedLocalVariables} f is only used for nested messages
+ */
+proto.engine_api.EnclaveAPIContainerHostMachineInfo.toObject = function(includeInstance, msg) {
+  var f, obj = {
+    ipOnHostMachine: jspb.Message.getFieldWithDefault(msg, 4, ""),
+    grpcPortOnHostMachine: jspb.Message.getFieldWithDefault(msg, 5, 0)
+  };
+
+  if (includeInstance) {
+    obj.$jspbMessageInstance = msg;
+  }
+  return obj;
+};
+}
+
+
+/**
+ * Deserializes binary data (in protobuf wire format).
+ * @param {jspb.ByteSource} bytes The bytes to deserialize.
+ * @return {!proto.engine_api.EnclaveAPIContainerHostMachineInfo}
+ */
+proto.engine_api.EnclaveAPIContainerHostMachineInfo.deserializeBinary = function(bytes) {
+  var reader = new jspb.BinaryReader(bytes);
+  var msg = new proto.engine_api.EnclaveAPIContainerHostMachineInfo;
+  return proto.engine_api.EnclaveAPIContainerHostMachineInfo.deserializeBinaryFromReader(msg, reader);
+};
+
+
+/**
+ * Deserializes binary data (in protobuf wire format) from the
+ * given reader into the given message object.
+ * @param {!proto.engine_api.EnclaveAPIContainerHostMachineInfo} msg The message object to deserialize into.
+ * @param {!jspb.BinaryReader} reader The BinaryReader to use.
+ * @return {!proto.engine_api.EnclaveAPIContainerHostMachineInfo}
+ */
+proto.engine_api.EnclaveAPIContainerHostMachineInfo.deserializeBinaryFromReader = function(msg, reader) {
+  while (reader.nextField()) {
+    if (reader.isEndGroup()) {
+      break;
+    }
+    var field = reader.getFieldNumber();
+    switch (field) {
+    case 4:
+      var value = /** @type {string} */ (reader.readString());
+      msg.setIpOnHostMachine(value);
+      break;
+    case 5:
+      var value = /** @type {number} */ (reader.readUint32());
+      msg.setGrpcPortOnHostMachine(value);
+      break;
+    default:
+      reader.skipField();
+      break;
+    }
+  }
+  return msg;
+};
+
+
+/**
+ * Serializes the message to binary data (in protobuf wire format).
+ * @return {!Uint8Array}
+ */
+proto.engine_api.EnclaveAPIContainerHostMachineInfo.prototype.serializeBinary = function() {
+  var writer = new jspb.BinaryWriter();
+  proto.engine_api.EnclaveAPIContainerHostMachineInfo.serializeBinaryToWriter(this, writer);
+  return writer.getResultBuffer();
+};
+
+
+/**
+ * Serializes the given message to binary data (in protobuf wire
+ * format), writing to the given BinaryWriter.
+ * @param {!proto.engine_api.EnclaveAPIContainerHostMachineInfo} message
+ * @param {!jspb.BinaryWriter} writer
+ * @suppress {unusedLocalVariables} f is only used for nested messages
+ */
+proto.engine_api.EnclaveAPIContainerHostMachineInfo.serializeBinaryToWriter = function(message, writer) {
+  var f = undefined;
+  f = message.getIpOnHostMachine();
+  if (f.length > 0) {
+    writer.writeString(
+      4,
+      f
+    );
+  }
+  f = message.getGrpcPortOnHostMachine();
+  if (f !== 0) {
+    writer.writeUint32(
+      5,
+      f
+    );
+  }
+};
+
+
+/**
+ * optional string ip_on_host_machine = 4;
+ * @return {string}
+ */
+proto.engine_api.EnclaveAPIContainerHostMachineInfo.prototype.getIpOnHostMachine = function() {
+  return /** @type {string} */ (jspb.Message.getFieldWithDefault(this, 4, ""));
+};
+
+
+/**
+ * @param {string} value
+ * @return {!proto.engine_api.EnclaveAPIContainerHostMachineInfo} returns this
+ */
+proto.engine_api.EnclaveAPIContainerHostMachineInfo.prototype.setIpOnHostMachine = function(value) {
+  return jspb.Message.setProto3StringField(this, 4, value);
+};
+
+
+/**
+ * optional uint32 grpc_port_on_host_machine = 5;
+ * @return {number}
+ */
+proto.engine_api.EnclaveAPIContainerHostMachineInfo.prototype.getGrpcPortOnHostMachine = function() {
+  return /** @type {number} */ (jspb.Message.getFieldWithDefault(this, 5, 0));
+};
+
+
+/**
+ * @param {number} value
+ * @return {!proto.engine_api.EnclaveAPIContainerHostMachineInfo} returns this
+ */
+proto.engine_api.EnclaveAPIContainerHostMachineInfo.prototype.setGrpcPortOnHostMachine = function(value) {
+  return jspb.Message.setProto3IntField(this, 5, value);
+};
+
+
+
+
+
+if (jspb.Message.GENERATE_TO_OBJECT) {
+/**
+ * Creates an object representation of this proto.
+ * Field names that are reserved in JavaScript and will be renamed to pb_name.
+ * Optional fields that are not set will be set to undefined.
+ * To access a reserved field use, foo.pb_<name>, eg, foo.pb_default.
+ * For the list of reserved names please see:
+ *     net/proto2/compiler/js/internal/generator.cc#kKeyword.
+ * @param {boolean=} opt_includeInstance Deprecated. whether to include the
+ *     JSPB instance for transitional soy proto support:
+ *     http://goto/soy-param-migration
+ * @return {!Object}
+ */
+proto.engine_api.EnclaveInfo.prototype.toObject = function(opt_includeInstance) {
+  return proto.engine_api.EnclaveInfo.toObject(opt_includeInstance, this);
+};
+
+
+/**
+ * Static version of the {@see toObject} method.
+ * @param {boolean|undefined} includeInstance Deprecated. Whether to include
+ *     the JSPB instance for transitional soy proto support:
+ *     http://goto/soy-param-migration
+ * @param {!proto.engine_api.EnclaveInfo} msg The msg instance to transform.
+ * @return {!Object}
+ * @suppress {unusedLocalVariables} f is only used for nested messages
+ */
+proto.engine_api.EnclaveInfo.toObject = function(includeInstance, msg) {
+  var f, obj = {
+    enclaveUuid: jspb.Message.getFieldWithDefault(msg, 1, ""),
+    name: jspb.Message.getFieldWithDefault(msg, 2, ""),
+    shortenedUuid: jspb.Message.getFieldWithDefault(msg, 3, ""),
+    containersStatus: jspb.Message.getFieldWithDefault(msg, 4, 0),
+    apiContainerStatus: jspb.Message.getFieldWithDefault(msg, 5, 0),
+    apiContainerInfo: (f = msg.getApiContainerInfo()) && proto.engine_api.EnclaveAPIContainerInfo.toObject(includeInstance, f),
+    apiContainerHostMachineInfo: (f = msg.getApiContainerHostMachineInfo()) && proto.engine_api.EnclaveAPIContainerHostMachineInfo.toObject(includeInstance, f),
+    creationTime: (f = msg.getCreationTime()) && google_protobuf_timestamp_pb.Timestamp.toObject(includeInstance, f),
+    mode: jspb.Message.getFieldWithDefault(msg, 9, 0),
+    expiration: (f = msg.getExpiration()) && proto.engine_api.EnclaveExpiration.toObject(includeInstance, f),
+    labelsMap: (f = msg.getLabelsMap()) ? f.toObject(includeInstance, undefined) : []
+  };
+
+  if (includeInstance) {
+    obj.$jspbMessageInstance = msg;
+  }
+  return obj;
+};
+}
+
+
+/**
+ * Deserializes binary data (in protobuf wire format).
+ * @param {jspb.ByteSource} bytes The bytes to deserialize.
+ * @return {!proto.engine_api.EnclaveInfo}
+ */
+proto.engine_api.EnclaveInfo.deserializeBinary = function(bytes) {
+  var reader = new jspb.BinaryReader(bytes);
+  var msg = new proto.engine_api.EnclaveInfo;
+  return proto.engine_api.EnclaveInfo.deserializeBinaryFromReader(msg, reader);
+};
+
+
+/**
+ * Deserializes binary data (in protobuf wire format) from the
+ * given reader into the given message object.
+ * @param {!proto.engine_api.EnclaveInfo} msg The message object to deserialize into.
+ * @param {!jspb.BinaryReader} reader The BinaryReader to use.
+ * @return {!proto.engine_api.EnclaveInfo}
+ */
+proto.engine_api.EnclaveInfo.deserializeBinaryFromReader = function(msg, reader) {
+  while (reader.nextField()) {
+    if (reader.isEndGroup()) {
+      break;
+    }
+    var field = reader.getFieldNumber();
+    switch (field) {
+    case 1:
+      var value = /** @type {string} */ (reader.readString());
+      msg.setEnclaveUuid(value);
+      break;
+    case 2:
+      var value = /** @type {string} */ (reader.readString());
+      msg.setName(value);
+      break;
+    case 3:
+      var value = /** @type {string} */ (reader.readString());
+      msg.setShortenedUuid(value);
+      break;
+    case 4:
+      var value = /** @type {!proto.engine_api.EnclaveContainersStatus} */ (reader.readEnum());
+      msg.setContainersStatus(value);
+      break;
+    case 5:
+      var value = /** @type {!proto.engine_api.EnclaveAPIContainerStatus} */ (reader.readEnum());
+      msg.setApiContainerStatus(value);
+      break;
+    case 6:
+      var value = new proto.engine_api.EnclaveAPIContainerInfo;
+      reader.readMessage(value,proto.engine_api.EnclaveAPIContainerInfo.deserializeBinaryFromReader);
+      msg.setApiContainerInfo(value);
+      break;
+    case 7:
+      var value = new proto.engine_api.EnclaveAPIContainerHostMachineInfo;
+      reader.readMessage(value,proto.engine_api.EnclaveAPIContainerHostMachineInfo.deserializeBinaryFromReader);
+      msg.setApiContainerHostMachineInfo(value);
+      break;
+    case 8:
+      var value = new google_protobuf_timestamp_pb.Timestamp;
+      reader.readMessage(value,google_protobuf_timestamp_pb.Timestamp.deserializeBinaryFromReader);
+      msg.setCreationTime(value);
+      break;
+    case 9:
+      var value = /** @type {!proto.engine_api.EnclaveMode} */ (reader.readEnum());
+      msg.setMode(value);
+      break;
+    case 10:
+      var value = new proto.engine_api.EnclaveExpiration;
+      reader.readMessage(value,proto.engine_api.EnclaveExpiration.deserializeBinaryFromReader);
+      msg.setExpiration(value);
+      break;
+    case 11:
+      var value = msg.getLabelsMap();
+      reader.readMessage(value, function(message, reader) {
+        jspb.Map.deserializeBinary(message, reader, jspb.BinaryReader.prototype.readString, jspb.BinaryReader.prototype.readString, null, "", "");
+         });
+      break;
+    default:
+      reader.skipField();
+      break;
+    }
+  }
+  return msg;
+};
+
+
+/**
+ * Serializes the message to binary data (in protobuf wire format).
+ * @return {!Uint8Array}
+ */
+proto.engine_api.EnclaveInfo.prototype.serializeBinary = function() {
+  var writer = new jspb.BinaryWriter();
+  proto.engine_api.EnclaveInfo.serializeBinaryToWriter(this, writer);
+  return writer.getResultBuffer();
+};
+
+
+/**
+ * Serializes the given message to binary data (in protobuf wire
+ * format), writing to the given BinaryWriter.
+ * @param {!proto.engine_api.EnclaveInfo} message
+ * @param {!jspb.BinaryWriter} writer
+ * @suppress {unusedLocalVariables} f is only used for nested messages
+ */
+proto.engine_api.EnclaveInfo.serializeBinaryToWriter = function(message, writer) {
+  var f = undefined;
+  f = message.getEnclaveUuid();
+  if (f.length > 0) {
+    writer.writeString(
+      1,
+      f
+    );
+  }
+  f = message.getName();
+  if (f.length > 0) {
+    writer.writeString(
+      2,
+      f
+    );
+  }
+  f = message.getShortenedUuid();
+  if (f.length > 0) {
+    writer.writeString(
+      3,
+      f
+    );
+  }
+  f = message.getContainersStatus();
+  if (f !== 0.0) {
+    writer.writeEnum(
+      4,
+      f
+    );
+  }
+  f = message.getApiContainerStatus();
+  if (f !== 0.0) {
+    writer.writeEnum(
+      5,
+      f
+    );
+  }
+  f = message.getApiContainerInfo();
+  if (f != null) {
+    writer.writeMessage(
+      6,
+      f,
+      proto.engine_api.EnclaveAPIContainerInfo.serializeBinaryToWriter
+    );
+  }
+  f = message.getApiContainerHostMachineInfo();
+  if (f != null) {
+    writer.writeMessage(
+      7,
+      f,
+      proto.engine_api.EnclaveAPIContainerHostMachineInfo.serializeBinaryToWriter
+    );
+  }
+  f = message.getCreationTime();
+  if (f != null) {
+    writer.writeMessage(
+      8,
+      f,
+      google_protobuf_timestamp_pb.Timestamp.serializeBinaryToWriter
+    );
+  }
+  f = message.getMode();
+  if (f !== 0.0) {
+    writer.writeEnum(
+      9,
+      f
+    );
+  }
+  f = message.getExpiration();
+  if (f != null) {
+    writer.writeMessage(
+      10,
+      f,
+      proto.engine_api.EnclaveExpiration.serializeBinaryToWriter
+    );
+  }
+  f = message.getLabelsMap(true);
+  if (f && f.getLength() > 0) {
+    f.serializeBinary(11, writer, jspb.BinaryWriter.prototype.writeString, jspb.BinaryWriter.prototype.writeString);
+  }
+};
+
+
+/**
+ * optional string enclave_uuid = 1;
+ * @return {string}
+ */
+proto.engine_api.EnclaveInfo.prototype.getEnclaveUuid = function() {
+  return /** @type {string} */ (jspb.Message.getFieldWithDefault(this, 1, ""));
+};
+
+
+/**
+ * @param {string} value
+ * @return {!proto.engine_api.EnclaveInfo} returns this
+ */
+proto.engine_api.EnclaveInfo.prototype.setEnclaveUuid = function(value) {
+  return jspb.Message.setProto3StringField(this, 1, value);
+};
+
+
+/**
+ * optional string name = 2;
+ * @return {string}
+ */
+proto.engine_api.EnclaveInfo.prototype.getName = function() {
+  return /** @type {string} */ (jspb.Message.getFieldWithDefault(this, 2, ""));
+};
+
+
+/**
+ * @param {string} value
+ * @return {!proto.engine_api.EnclaveInfo} returns this
+ */
+proto.engine_api.EnclaveInfo.prototype.setName = function(value) {
+  return jspb.Message.setProto3StringField(this, 2, value);
+};
+
+
+/**
+ * optional string shortened_uuid = 3;
+ * @return {string}
+ */
+proto.engine_api.EnclaveInfo.prototype.getShortenedUuid = function() {
+  return /** @type {string} */ (jspb.Message.getFieldWithDefault(this, 3, ""));
+};
+
+
+/**
+ * @param {string} value
+ * @return {!proto.engine_api.EnclaveInfo} returns this
+ */
+proto.engine_api.EnclaveInfo.prototype.setShortenedUuid = function(value) {
+  return jspb.Message.setProto3StringField(this, 3, value);
+};
+
+
+/**
+ * optional EnclaveContainersStatus containers_status = 4;
+ * @return {!proto.engine_api.EnclaveContainersStatus}
+ */
+proto.engine_api.EnclaveInfo.prototype.getContainersStatus = function() {
+  return /** @type {!proto.engine_api.EnclaveContainersStatus} */ (jspb.Message.getFieldWithDefault(this, 4, 0));
+};
+
+
+/**
+ * @param {!proto.engine_api.EnclaveContainersStatus} value
+ * @return {!proto.engine_api.EnclaveInfo} returns this
+ */
+proto.engine_api.EnclaveInfo.prototype.setContainersStatus = function(value) {
+  return jspb.Message.setProto3EnumField(this, 4, value);
+};
+
+
+/**
+ * optional EnclaveAPIContainerStatus api_container_status = 5;
+ * @return {!proto.engine_api.EnclaveAPIContainerStatus}
+ */
+proto.engine_api.EnclaveInfo.prototype.getApiContainerStatus = function() {
+  return /** @type {!proto.engine_api.EnclaveAPIContainerStatus} */ (jspb.Message.getFieldWithDefault(this, 5, 0));
+};
+
+
+/**
+ * @param {!proto.engine_api.EnclaveAPIContainerStatus} value
+ * @return {!proto.engine_api.EnclaveInfo} returns this
+ */
+proto.engine_api.EnclaveInfo.prototype.setApiContainerStatus = function(value) {
+  return jspb.Message.setProto3EnumField(this, 5, value);
+};
+
+
+/**
+ * optional EnclaveAPIContainerInfo api_container_info = 6;
+ * @return {?proto.engine_api.EnclaveAPIContainerInfo}
+ */
+proto.engine_api.EnclaveInfo.prototype.getApiContainerInfo = function() {
+  return /** @type{?proto.engine_api.EnclaveAPIContainerInfo} */ (
+    jspb.Message.getWrapperField(this, proto.engine_api.EnclaveAPIContainerInfo, 6));
+};
+
+
+/**
+ * @param {?proto.engine_api.EnclaveAPIContainerInfo|undefined} value
+ * @return {!proto.engine_api.EnclaveInfo} returns this
+*/
+proto.engine_api.EnclaveInfo.prototype.setApiContainerInfo = function(value) {
+  return jspb.Message.setWrapperField(this, 6, value);
+};
+
+
+/**
+ * Clears the message field making it undefined.
+ * @return {!proto.engine_api.EnclaveInfo} returns this
+ */
+proto.engine_api.EnclaveInfo.prototype.clearApiContainerInfo = function() {
+  return this.setApiContainerInfo(undefined);
+};
+
+
+/**
+ * Returns whether this field is set.
+ * @return {boolean}
+ */
+proto.engine_api.EnclaveInfo.prototype.hasApiContainerInfo = function() {
+  return jspb.Message.getField(this, 6) != null;
+};
+
+
+/**
+ * optional EnclaveAPIContainerHostMachineInfo api_container_host_machine_info = 7;
+ * @return {?proto.engine_api.EnclaveAPIContainerHostMachineInfo}
+ */
+proto.engine_api.EnclaveInfo.prototype.getApiContainerHostMachineInfo = function() {
+  return /** @type{?proto.engine_api.EnclaveAPIContainerHostMachineInfo} */ (
+    jspb.Message.getWrapperField(this, proto.engine_api.EnclaveAPIContainerHostMachineInfo, 7));
+};
+
+
+/**
+ * @param {?proto.engine_api.EnclaveAPIContainerHostMachineInfo|undefined} value
+ * @return {!proto.engine_api.EnclaveInfo} returns this
+*/
+proto.engine_api.EnclaveInfo.prototype.setApiContainerHostMachineInfo = function(value) {
+  return jspb.Message.setWrapperField(this, 7, value);
+};
+
+
+/**
+ * Clears the message field making it undefined.
+ * @return {!proto.engine_api.EnclaveInfo} returns this
+ */
+proto.engine_api.EnclaveInfo.prototype.clearApiContainerHostMachineInfo = function() {
+  return this.setApiContainerHostMachineInfo(undefined);
+};
+
+
+/**
+ * Returns whether this field is set.
+ * @return {boolean}
+ */
+proto.engine_api.EnclaveInfo.prototype.hasApiContainerHostMachineInfo = function() {
+  return jspb.Message.getField(this, 7) != null;
+};
+
+
+/**
+ * optional google.protobuf.Timestamp creation_time = 8;
+ * @return {?proto.google.protobuf.Timestamp}
+ */
+proto.engine_api.EnclaveInfo.prototype.getCreationTime = function() {
+  return /** @type{?proto.google.protobuf.Timestamp} */ (
+    jspb.Message.getWrapperField(this, google_protobuf_timestamp_pb.Timestamp, 8));
+};
+
+
+/**
+ * @param {?proto.google.protobuf.Timestamp|undefined} value
+ * @return {!proto.engine_api.EnclaveInfo} returns this
+*/
+proto.engine_api.EnclaveInfo.prototype.setCreationTime = function(value) {
+  return jspb.Message.setWrapperField(this, 8, value);
+};
+
+
+/**
+ * Clears the message field making it undefined.
+ * @return {!proto.engine_api.EnclaveInfo} returns this
+ */
+proto.engine_api.EnclaveInfo.prototype.clearCreationTime = function() {
+  return this.setCreationTime(undefined);
+};
+
+
+/**
+ * Returns whether this field is set.
+ * @return {boolean}
+ */
+proto.engine_api.EnclaveInfo.prototype.hasCreationTime = function() {
+  return jspb.Message.getField(this, 8) != null;
+};
+
+
+/**
+ * optional EnclaveMode mode = 9;
+ * @return {!proto.engine_api.EnclaveMode}
+ */
+proto.engine_api.EnclaveInfo.prototype.getMode = function() {
+  return /** @type {!proto.engine_api.EnclaveMode} */ (jspb.Message.getFieldWithDefault(this, 9, 0));
+};
+
+
+/**
+ * @param {!proto.engine_api.EnclaveMode} value
+ * @return {!proto.engine_api.EnclaveInfo} returns this
+ */
+proto.engine_api.EnclaveInfo.prototype.setMode = function(value) {
+  return jspb.Message.setProto3EnumField(this, 9, value);
+};
+
+
+/**
+ * optional EnclaveExpiration expiration = 10;
+ * @return {?proto.engine_api.EnclaveExpiration}
+ */
+proto.engine_api.EnclaveInfo.prototype.getExpiration = function() {
+  return /** @type{?proto.engine_api.EnclaveExpiration} */ (
+    jspb.Message.getWrapperField(this, proto.engine_api.EnclaveExpiration, 10));
+};
+
+
+/**
+ * @param {?proto.engine_api.EnclaveExpiration|undefined} value
+ * @return {!proto.engine_api.EnclaveInfo} returns this
+*/
+proto.engine_api.EnclaveInfo.prototype.setExpiration = function(value) {
+  return jspb.Message.setWrapperField(this, 10, value);
+};
+
+
+/**
+ * Clears the message field making it undefined.
+ * @return {!proto.engine_api.EnclaveInfo} returns this
+ */
+proto.engine_api.EnclaveInfo.prototype.clearExpiration = function() {
+  return this.setExpiration(undefined);
+};
+
+
+/**
+ * Returns whether this field is set.
+ * @return {boolean}
+ */
+proto.engine_api.EnclaveInfo.prototype.hasExpiration = function() {
+  return jspb.Message.getField(this, 10) != null;
+};
+
+
+/**
+ * map<string, string> labels = 11;
+ * @param {boolean=} opt_noLazyCreate Do not create the map if
+ * empty, instead returning `undefined`
+ * @return {!jspb.Map<string,string>}
+ */
+proto.engine_api.EnclaveInfo.prototype.getLabelsMap = function(opt_noLazyCreate) {
+  return /** @type {!jspb.Map<string,string>} */ (
+      jspb.Message.getMapField(this, 11, opt_noLazyCreate,
+      null));
+};
+
+
+/**
+ * Clears values from the map. The map will be non-null.
+ * @return {!proto.engine_api.EnclaveInfo} returns this
+ */
+proto.engine_api.EnclaveInfo.prototype.clearLabelsMap = function() {
+  this.getLabelsMap().clear();
+  return this;};
+
+
+
+
+
+if (jspb.Message.GENERATE_TO_OBJECT) {
+/**
+ * Creates an object representation of this proto.
+ * Field names that are reserved in JavaScript and will be renamed to pb_name.
+ * Optional fields that are not set will be set to undefined.
+ * To access a reserved field use, foo.pb_<name>, eg, foo.pb_default.
+ * For the list of reserved names please see:
+ *     net/proto2/compiler/js/internal/generator.cc#kKeyword.
+ * @param {boolean=} opt_includeInstance Deprecated. whether to include the
+ *     JSPB instance for transitional soy proto support:
+ *     http://goto/soy-param-migration
+ * @return {!Object}
+ */
+proto.engine_api.EnclaveExpiration.prototype.toObject = function(opt_includeInstance) {
+  return proto.engine_api.EnclaveExpiration.toObject(opt_includeInstance, this);
+};
+
+
+/**
+ * Static version of the {@see toObject} method.
+ * @param {boolean|undefined} includeInstance Deprecated. Whether to include
+ *     the JSPB instance for transitional soy proto support:
+ *     http://goto/soy-param-migration
+ * @param {!proto.engine_api.EnclaveExpiration} msg The msg instance to transform.
+ * @return {!Object}
+ * @suppress {unusedLocalVariables} f is only used for nested messages
+ */
+proto.engine_api.EnclaveExpiration.toObject = function(includeInstance, msg) {
+  var f, obj = {
+    expirationTime: (f = msg.getExpirationTime()) && google_protobuf_timestamp_pb.Timestamp.toObject(includeInstance, f),
+    idleTimeoutSeconds: jspb.Message.getFieldWithDefault(msg, 2, 0),
+    action: jspb.Message.getFieldWithDefault(msg, 3, 0)
+  };
+
+  if (includeInstance) {
+    obj.$jspbMessageInstance = msg;
+  }
+  return obj;
+};
+}
+
+
+/**
+ * Deserializes binary data (in protobuf wire format).
+ * @param {jspb.ByteSource} bytes The bytes to deserialize.
+ * @return {!proto.engine_api.EnclaveExpiration}
+ */
+proto.engine_api.EnclaveExpiration.deserializeBinary = function(bytes) {
+  var reader = new jspb.BinaryReader(bytes);
+  var msg = new proto.engine_api.EnclaveExpiration;
+  return proto.engine_api.EnclaveExpiration.deserializeBinaryFromReader(msg, reader);
+};
+
+
+/**
+ * Deserializes binary data (in protobuf wire format) from the
+ * given reader into the given message object.
+ * @param {!proto.engine_api.EnclaveExpiration} msg The message object to deserialize into.
+ * @param {!jspb.BinaryReader} reader The BinaryReader to use.
+ * @return {!proto.engine_api.EnclaveExpiration}
+ */
+proto.engine_api.EnclaveExpiration.deserializeBinaryFromReader = function(msg, reader) {
+  while (reader.nextField()) {
+    if (reader.isEndGroup()) {
+      break;
+    }
+    var field = reader.getFieldNumber();
+    switch (field) {
+    case 1:
+      var value = new google_protobuf_timestamp_pb.Timestamp;
+      reader.readMessage(value,google_protobuf_timestamp_pb.Timestamp.deserializeBinaryFromReader);
+      msg.setExpirationTime(value);
+      break;
+    case 2:
+      var value = /** @type {number} */ (reader.readUint32());
+      msg.setIdleTimeoutSeconds(value);
+      break;
+    case 3:
+      var value = /** @type {!proto.engine_api.ExpiredEnclaveAction} */ (reader.readEnum());
+      msg.setAction(value);
+      break;
+    default:
+      reader.skipField();
+      break;
+    }
+  }
+  return msg;
+};
+
+
+/**
+ * Serializes the message to binary data (in protobuf wire format).
+ * @return {!Uint8Array}
+ */
+proto.engine_api.EnclaveExpiration.prototype.serializeBinary = function() {
+  var writer = new jspb.BinaryWriter();
+  proto.engine_api.EnclaveExpiration.serializeBinaryToWriter(this, writer);
+  return writer.getResultBuffer();
+};
+
+
+/**
+ * Serializes the given message to binary data (in protobuf wire
+ * format), writing to the given BinaryWriter.
+ * @param {!proto.engine_api.EnclaveExpiration} message
+ * @param {!jspb.BinaryWriter} writer
+ * @suppress {unusedLocalVariables} f is only used for nested messages
+ */
+proto.engine_api.EnclaveExpiration.serializeBinaryToWriter = function(message, writer) {
+  var f = undefined;
+  f = message.getExpirationTime();
+  if (f != null) {
+    writer.writeMessage(
+      1,
+      f,
+      google_protobuf_timestamp_pb.Timestamp.serializeBinaryToWriter
+    );
+  }
+  f = /** @type {number} */ (jspb.Message.getField(message, 2));
+  if (f != null) {
+    writer.writeUint32(
+      2,
+      f
+    );
+  }
+  f = message.getAction();
+  if (f !== 0.0) {
+    writer.writeEnum(
+      3,
+      f
+    );
+  }
+};
+
+
+/**
+ * optional google.protobuf.Timestamp expiration_time = 1;
+ * @return {?proto.google.protobuf.Timestamp}
+ */
+proto.engine_api.EnclaveExpiration.prototype.getExpirationTime = function() {
+  return /** @type{?proto.google.protobuf.Timestamp} */ (
+    jspb.Message.getWrapperField(this, google_protobuf_timestamp_pb.Timestamp, 1));
+};
+
+
+/**
+ * @param {?proto.google.protobuf.Timestamp|undefined} value
+ * @return {!proto.engine_api.EnclaveExpiration} returns this
+*/
+proto.engine_api.EnclaveExpiration.prototype.setExpirationTime = function(value) {
+  return jspb.Message.setWrapperField(this, 1, value);
+};
+
+
+/**
+ * Clears the message field making it undefined.
+ * @return {!proto.engine_api.EnclaveExpiration} returns this
+ */
+proto.engine_api.EnclaveExpiration.prototype.clearExpirationTime = function() {
+  return this.setExpirationTime(undefined);
+};
+
+
+/**
+ * Returns whether this field is set.
+ * @return {boolean}
+ */
+proto.engine_api.EnclaveExpiration.prototype.hasExpirationTime = function() {
+  return jspb.Message.getField(this, 1) != null;
+};
+
+
+/**
+ * optional uint32 idle_timeout_seconds = 2;
+ * @return {number}
+ */
+proto.engine_api.EnclaveExpiration.prototype.getIdleTimeoutSeconds = function() {
+  return /** @type {number} */ (jspb.Message.getFieldWithDefault(this, 2, 0));
+};
+
+
+/**
+ * @param {number} value
+ * @return {!proto.engine_api.EnclaveExpiration} returns this
+ */
+proto.engine_api.EnclaveExpiration.prototype.setIdleTimeoutSeconds = function(value) {
+  return jspb.Message.setField(this, 2, value);
+};
+
+
+/**
+ * Clears the field making it undefined.
+ * @return {!proto.engine_api.EnclaveExpiration} returns this
+ */
+proto.engine_api.EnclaveExpiration.prototype.clearIdleTimeoutSeconds = function() {
+  return jspb.Message.setField(this, 2, undefined);
+};
+
+
+/**
+ * Returns whether this field is set.
+ * @return {boolean}
+ */
+proto.engine_api.EnclaveExpiration.prototype.hasIdleTimeoutSeconds = function() {
+  return jspb.Message.getField(this, 2) != null;
+};
+
+
+/**
+ * optional ExpiredEnclaveAction action = 3;
+ * @return {!proto.engine_api.ExpiredEnclaveAction}
+ */
+proto.engine_api.EnclaveExpiration.prototype.getAction = function() {
+  return /** @type {!proto.engine_api.ExpiredEnclaveAction} */ (jspb.Message.getFieldWithDefault(this, 3, 0));
+};
+
+
+/**
+ * @param {!proto.engine_api.ExpiredEnclaveAction} value
+ * @return {!proto.engine_api.EnclaveExpiration} returns this
+ */
+proto.engine_api.EnclaveExpiration.prototype.setAction = function(value) {
+  return jspb.Message.setProto3EnumField(this, 3, value);
+};
+
+
+
+
+
+if (jspb.Message.GENERATE_TO_OBJECT) {
+/**
+ * Creates an object representation of this proto.
+ * Field names that are reserved in JavaScript and will be renamed to pb_name.
+ * Optional fields that are not set will be set to undefined.
+ * To access a reserved field use, foo.pb_<name>, eg, foo.pb_default.
+ * For the list of reserved names please see:
+ *     net/proto2/compiler/js/internal/generator.cc#kKeyword.
+ * @param {boolean=} opt_includeInstance Deprecated. whether to include the
+ *     JSPB instance for transitional soy proto support:
+ *     http://goto/soy-param-migration
+ * @return {!Object}
+ */
+proto.engine_api.GetEnclavesArgs.prototype.toObject = function(opt_includeInstance) {
+  return proto.engine_api.GetEnclavesArgs.toObject(opt_includeInstance, this);
+};
+
+
+/**
+ * Static version of the {@see toObject} method.
+ * @param {boolean|undefined} includeInstance Deprecated. Whether to include
+ *     the JSPB instance for transitional soy proto support:
+ *     http://goto/soy-param-migration
+ * @param {!proto.engine_api.GetEnclavesArgs} msg The msg instance to transform.
+ * @return {!Object}
+ * @suppress {unusedLocalVariables} f is only used for nested messages
+ */
+proto.engine_api.GetEnclavesArgs.toObject = function(includeInstance, msg) {
+  var f, obj = {
+    labelSelectorMap: (f = msg.getLabelSelectorMap()) ? f.toObject(includeInstance, undefined) : []
+  };
+
+  if (includeInstance) {
+    obj.$jspbMessageInstance = msg;
+  }
+  return obj;
+};
+}
+
+
+/**
+ * Deserializes binary data (in protobuf wire format).
+ * @param {jspb.ByteSource} bytes The bytes to deserialize.
+ * @return {!proto.engine_api.GetEnclavesArgs}
+ */
+proto.engine_api.GetEnclavesArgs.deserializeBinary = function(bytes) {
+  var reader = new jspb.BinaryReader(bytes);
+  var msg = new proto.engine_api.GetEnclavesArgs;
+  return proto.engine_api.GetEnclavesArgs.deserializeBinaryFromReader(msg, reader);
+};
+
+
+/**
+ * Deserializes binary data (in protobuf wire format) from the
+ * given reader into the given message object.
+ * @param {!proto.engine_api.GetEnclavesArgs} msg The message object to deserialize into.
+ * @param {!jspb.BinaryReader} reader The BinaryReader to use.
+ * @return {!proto.engine_api.GetEnclavesArgs}
+ */
+proto.engine_api.GetEnclavesArgs.deserializeBinaryFromReader = function(msg, reader) {
+  while (reader.nextField()) {
+    if (reader.isEndGroup()) {
+      break;
+    }
+    var field = reader.getFieldNumber();
+    switch (field) {
+    case 1:
+      var value = msg.getLabelSelectorMap();
+      reader.readMessage(value, function(message, reader) {
+        jspb.Map.deserializeBinary(message, reader, jspb.BinaryReader.prototype.readString, jspb.BinaryReader.prototype.readString, null, "", "");
+         });
+      break;
+    default:
+      reader.skipField();
+      break;
+    }
+  }
+  return msg;
+};
+
+
+/**
+ * Serializes the message to binary data (in protobuf wire format).
+ * @return {!Uint8Array}
+ */
+proto.engine_api.GetEnclavesArgs.prototype.serializeBinary = function() {
+  var writer = new jspb.BinaryWriter();
+  proto.engine_api.GetEnclavesArgs.serializeBinaryToWriter(this, writer);
+  return writer.getResultBuffer();
+};
+
+
+/**
+ * Serializes the given message to binary data (in protobuf wire
+ * format), writing to the given BinaryWriter.
+ * @param {!proto.engine_api.GetEnclavesArgs} message
+ * @param {!jspb.BinaryWriter} writer
+ * @suppress {unusedLocalVariables} f is only used for nested messages
+ */
+proto.engine_api.GetEnclavesArgs.serializeBinaryToWriter = function(message, writer) {
+  var f = undefined;
+  f = message.getLabelSelectorMap(true);
+  if (f && f.getLength() > 0) {
+    f.serializeBinary(1, writer, jspb.BinaryWriter.prototype.writeString, jspb.BinaryWriter.prototype.writeString);
+  }
+};
+
+
+/**
+ * map<string, string> label_selector = 1;
+ * @param {boolean=} opt_noLazyCreate Do not create the map if
+ * empty, instead returning `undefined`
+ * @return {!jspb.Map<string,string>}
+ */
+proto.engine_api.GetEnclavesArgs.prototype.getLabelSelectorMap = function(opt_noLazyCreate) {
+  return /** @type {!jspb.Map<string,string>} */ (
+      jspb.Message.getMapField(this, 1, opt_noLazyCreate,
+      null));
+};
+
+
+/**
+ * Clears values from the map. The map will be non-null.
+ * @return {!proto.engine_api.GetEnclavesArgs} returns this
+ */
+proto.engine_api.GetEnclavesArgs.prototype.clearLabelSelectorMap = function() {
+  this.getLabelSelectorMap().clear();
+  return this;};
+
+
+
+
+
+if (jspb.Message.GENERATE_TO_OBJECT) {
+/**
+ * Creates an object representation of this proto.
+ * Field names that are reserved in JavaScript and will be renamed to pb_name.
+ * Optional fields that are not set will be set to undefined.
+ * To access a reserved field use, foo.pb_<name>, eg, foo.pb_default.
+ * For the list of reserved names please see:
+ *     net/proto2/compiler/js/internal/generator.cc#kKeyword.
+ * @param {boolean=} opt_includeInstance Deprecated. whether to include the
+ *     JSPB instance for transitional soy proto support:
+ *     http://goto/soy-param-migration
+ * @return {!Object}
+ */
+proto.engine_api.GetEnclavesResponse.prototype.toObject = function(opt_includeInstance) {
+  return proto.engine_api.GetEnclavesResponse.toObject(opt_includeInstance, this);
+};
+
+
+/**
+ * Static version of the {@see toObject} method.
+ * @param {boolean|undefined} includeInstance Deprecated. Whether to include
+ *     the JSPB instance for transitional soy proto support:
+ *     http://goto/soy-param-migration
+ * @param {!proto.engine_api.GetEnclavesResponse} msg The msg instance to transform.
+ * @return {!Object}
+ * @suppress {unusedLocalVariables} f is only used for nested messages
+ */
+proto.engine_api.GetEnclavesResponse.toObject = function(includeInstance, msg) {
+  var f, obj = {
+    enclaveInfoMap: (f = msg.getEnclaveInfoMap()) ? f.toObject(includeInstance, proto.engine_api.EnclaveInfo.toObject) : []
+  };
+
+  if (includeInstance) {
+    obj.$jspbMessageInstance = msg;
+  }
+  return obj;
+};
+}
+
+
+/**
+ * Deserializes binary data (in protobuf wire format).
+ * @param {jspb.ByteSource} bytes The bytes to deserialize.
+ * @return {!proto.engine_api.GetEnclavesResponse}
+ */
+proto.engine_api.GetEnclavesResponse.deserializeBinary = function(bytes) {
+  var reader = new jspb.BinaryReader(bytes);
+  var msg = new proto.engine_api.GetEnclavesResponse;
+  return proto.engine_api.GetEnclavesResponse.deserializeBinaryFromReader(msg, reader);
+};
+
+
+/**
+ * Deserializes binary data (in protobuf wire format) from the
+ * given reader into the given message object.
+ * @param {!proto.engine_api.GetEnclavesResponse} msg The message object to deserialize into.
+ * @param {!jspb.BinaryReader} reader The BinaryReader to use.
+ * @return {!proto.engine_api.GetEnclavesResponse}
+ */
+proto.engine_api.GetEnclavesResponse.deserializeBinaryFromReader = function(msg, reader) {
+  while (reader.nextField()) {
+    if (reader.isEndGroup()) {
+      break;
+    }
+    var field = reader.getFieldNumber();
+    switch (field) {
+    case 1:
+      var value = msg.getEnclaveInfoMap();
+      reader.readMessage(value, function(message, reader) {
+        jspb.Map.deserializeBinary(message, reader, jspb.BinaryReader.prototype.readString, jspb.BinaryReader.prototype.readMessage, proto.engine_api.EnclaveInfo.deserializeBinaryFromReader, "", new proto.engine_api.EnclaveInfo());
+         });
+      break;
+    default:
+      reader.skipField();
+      break;
+    }
+  }
+  return msg;
+};
+
+
+/**
+ * Serializes the message to binary data (in protobuf wire format).
+ * @return {!Uint8Array}
+ */
+proto.engine_api.GetEnclavesResponse.prototype.serializeBinary = function() {
+  var writer = new jspb.BinaryWriter();
+  proto.engine_api.GetEnclavesResponse.serializeBinaryToWriter(this, writer);
+  return writer.getResultBuffer();
+};
+
+
+/**
+ * Serializes the given message to binary data (in protobuf wire
+ * format), writing to the given BinaryWriter.
+ * @param {!proto.engine_api.GetEnclavesResponse} message
+ * @param {!jspb.BinaryWriter} writer
+ * @suppress {unusedLocalVariables} f is only used for nested messages
+ */
+proto.engine_api.GetEnclavesResponse.serializeBinaryToWriter = function(message, writer) {
+  var f = undefined;
+  f = message.getEnclaveInfoMap(true);
+  if (f && f.getLength() > 0) {
+    f.serializeBinary(1, writer, jspb.BinaryWriter.prototype.writeString, jspb.BinaryWriter.prototype.writeMessage, proto.engine_api.EnclaveInfo.serializeBinaryToWriter);
+  }
+};
+
+
+/**
+ * map<string, EnclaveInfo> enclave_info = 1;
+ * @param {boolean=} opt_noLazyCreate Do not create the map if
+ * empty, instead returning `undefined`
+ * @return {!jspb.Map<string,!proto.engine_api.EnclaveInfo>}
+ */
+proto.engine_api.GetEnclavesResponse.prototype.getEnclaveInfoMap = function(opt_noLazyCreate) {
+  return /** @type {!jspb.Map<string,!proto.engine_api.EnclaveInfo>} */ (
+      jspb.Message.getMapField(this, 1, opt_noLazyCreate,
+      proto.engine_api.EnclaveInfo));
+};
+
+
+/**
+ * Clears values from the map. The map will be non-null.
+ * @return {!proto.engine_api.GetEnclavesResponse} returns this
+ */
+proto.engine_api.GetEnclavesResponse.prototype.clearEnclaveInfoMap = function() {
+  this.getEnclaveInfoMap().clear();
+  return this;};
+
+
+
+
+
+if (jspb.Message.GENERATE_TO_OBJECT) {
+/**
+ * Creates an object representation of this proto.
+ * Field names that are reserved in JavaScript and will be renamed to pb_name.
+ * Optional fields that are not set will be set to undefined.
+ * To access a reserved field use, foo.pb_<name>, eg, foo.pb_default.
+ * For the list of reserved names please see:
+ *     net/proto2/compiler/js/internal/generator.cc#kKeyword.
+ * @param {boolean=} opt_includeInstance Deprecated. whether to include the
+ *     JSPB instance for transitional soy proto support:
+ *     http://goto/soy-param-migration
+ * @return {!Object}
+ */
+proto.engine_api.EnclaveIdentifiers.prototype.toObject = function(opt_includeInstance) {
+  return proto.engine_api.EnclaveIdentifiers.toObject(opt_includeInstance, this);
+};
+
+
+/**
+ * Static version of the {@see toObject} method.
+ * @param {boolean|undefined} includeInstance Deprecated. Whether to include
+ *     the JSPB instance for transitional soy proto support:
+ *     http://goto/soy-param-migration
+ * @param {!proto.engine_api.EnclaveIdentifiers} msg The msg instance to transform.
+ * @return {!Object}
+ * @suppress {unusedLocalVariables} f is only used for nested messages
+ */
+proto.engine_api.EnclaveIdentifiers.toObject = function(includeInstance, msg) {
+  var f, obj = {
+    enclaveUuid: jspb.Message.getFieldWithDefault(msg, 1, ""),
+    name: jspb.Message.getFieldWithDefault(msg, 2, ""),
+    shortenedUuid: jspb.Message.getFieldWithDefault(msg, 3, "")
+  };
+
+  if (includeInstance) {
+    obj.$jspbMessageInstance = msg;
+  }
+  return obj;
+};
+}
+
+
+/**
+ * Deserializes binary data (in protobuf wire format).
+ * @param {jspb.ByteSource} bytes The bytes to deserialize.
+ * @return {!proto.engine_api.EnclaveIdentifiers}
+ */
+proto.engine_api.EnclaveIdentifiers.deserializeBinary = function(bytes) {
+  var reader = new jspb.BinaryReader(bytes);
+  var msg = new proto.engine_api.EnclaveIdentifiers;
+  return proto.engine_api.EnclaveIdentifiers.deserializeBinaryFromReader(msg, reader);
+};
+
+
+/**
+ * Deserializes binary data (in protobuf wire format) from the
+ * given reader into the given message object.
+ * @param {!proto.engine_api.EnclaveIdentifiers} msg The message object to deserialize into.
+ * @param {!jspb.BinaryReader} reader The BinaryReader to use.
+ * @return {!proto.engine_api.EnclaveIdentifiers}
+ */
+proto.engine_api.EnclaveIdentifiers.deserializeBinaryFromReader = function(msg, reader) {
+  while (reader.nextField()) {
+    if (reader.isEndGroup()) {
+      break;
+    }
+    var field = reader.getFieldNumber();
+    switch (field) {
+    case 1:
+      var value = /** @type {string} */ (reader.readString());
+      msg.setEnclaveUuid(value);
+      break;
+    case 2:
+      var value = /** @type {string} */ (reader.readString());
+      msg.setName(value);
+      break;
+    case 3:
+      var value = /** @type {string} */ (reader.readString());
+      msg.setShortenedUuid(value);
+      break;
+    default:
+      reader.skipField();
+      break;
+    }
+  }
+  return msg;
+};
+
+
+/**
+ * Serializes the message to binary data (in protobuf wire format).
+ * @return {!Uint8Array}
+ */
+proto.engine_api.EnclaveIdentifiers.prototype.serializeBinary = function() {
+  var writer = new jspb.BinaryWriter();
+  proto.engine_api.EnclaveIdentifiers.serializeBinaryToWriter(this, writer);
+  return writer.getResultBuffer();
+};
+
+
+/**
+ * Serializes the given message to binary data (in protobuf wire
+ * format), writing to the given BinaryWriter.
+ * @param {!proto.engine_api.EnclaveIdentifiers} message
+ * @param {!jspb.BinaryWriter} writer
+ * @suppress {unusedLocalVariables} f is only used for nested messages
+ */
+proto.engine_api.EnclaveIdentifiers.serializeBinaryToWriter = function(message, writer) {
+  var f = undefined;
+  f = message.getEnclaveUuid();
+  if (f.length > 0) {
+    writer.writeString(
+      1,
+      f
+    );
+  }
+  f = message.getName();
+  if (f.length > 0) {
+    writer.writeString(
+      2,
+      f
+    );
+  }
+  f = message.getShortenedUuid();
+  if (f.length > 0) {
+    writer.writeString(
+      3,
+      f
+    );
+  }
+};
+
+
+/**
+ * optional string enclave_uuid = 1;
+ * @return {string}
+ */
+proto.engine_api.EnclaveIdentifiers.prototype.getEnclaveUuid = function() {
+  return /** @type {string} */ (jspb.Message.getFieldWithDefault(this, 1, ""));
+};
+
+
+/**
+ * @param {string} value
+ * @return {!proto.engine_api.EnclaveIdentifiers} returns this
+ */
+proto.engine_api.EnclaveIdentifiers.prototype.setEnclaveUuid = function(value) {
+  return jspb.Message.setProto3StringField(this, 1, value);
+};
+
+
+/**
+ * optional string name = 2;
+ * @return {string}
+ */
+proto.engine_api.EnclaveIdentifiers.prototype.getName = function() {
+  return /** @type {string} */ (jspb.Message.getFieldWithDefault(this, 2, ""));
+};
+
+
+/**
+ * @param {string} value
+ * @return {!proto.engine_api.EnclaveIdentifiers} returns this
+ */
+proto.engine_api.EnclaveIdentifiers.prototype.setName = function(value) {
+  return jspb.Message.setProto3StringField(this, 2, value);
+};
+
+
+/**
+ * optional string shortened_uuid = 3;
+ * @return {string}
+ */
+proto.engine_api.EnclaveIdentifiers.prototype.getShortenedUuid = function() {
+  return /** @type {string} */ (jspb.Message.getFieldWithDefault(this, 3, ""));
+};
+
+
+/**
+ * @param {string} value
+ * @return {!proto.engine_api.EnclaveIdentifiers} returns this
+ */
+proto.engine_api.EnclaveIdentifiers.prototype.setShortenedUuid = function(value) {
+  return jspb.Message.setProto3StringField(this, 3, value);
+};
+
+
+
+/**
+ * List of repeated fields within this message type.
+ * @private {!Array<number>}
+ * @const
+ */
+proto.engine_api.GetExistingAndHistoricalEnclaveIdentifiersResponse.repeatedFields_ = [1];
+
+
+
+if (jspb.Message.GENERATE_TO_OBJECT) {
+/**
+ * Creates an object representation of this proto.
+ * Field names that are reserved in JavaScript and will be renamed to pb_name.
+ * Optional fields that are not set will be set to undefined.
+ * To access a reserved field use, foo.pb_<name>, eg, foo.pb_default.
+ * For the list of reserved names please see:
+ *     net/proto2/compiler/js/internal/generator.cc#kKeyword.
+ * @param {boolean=} opt_includeInstance Deprecated. whether to include the
+ *     JSPB instance for transitional soy proto support:
+ *     http://goto/soy-param-migration
+ * @return {!Object}
+ */
+proto.engine_api.GetExistingAndHistoricalEnclaveIdentifiersResponse.prototype.toObject = function(opt_includeInstance) {
+  return proto.engine_api.GetExistingAndHistoricalEnclaveIdentifiersResponse.toObject(opt_includeInstance, this);
+};
+
+
+/**
+ * Static version of the {@see toObject} method.
+ * @param {boolean|undefined} includeInstance Deprecated. Whether to include
+ *     the JSPB instance for transitional soy proto support:
+ *     http://goto/soy-param-migration
+ * @param {!proto.engine_api.GetExistingAndHistoricalEnclaveIdentifiersResponse} msg The msg instance to transform.
+ * @return {!Object}
+ * @suppress {unusedLocalVariables} f is only used for nested messages
+ */
+proto.engine_api.GetExistingAndHistoricalEnclaveIdentifiersResponse.toObject = function(includeInstance, msg) {
+  var f, obj = {
+    allidentifiersList: jspb.Message.toObjectList(msg.getAllidentifiersList(),
+    proto.engine_api.EnclaveIdentifiers.toObject, includeInstance)
+  };
+
+  if (includeInstance) {
+    obj.$jspbMessageInstance = msg;
+  }
+  return obj;
+};
+}
+
+
+/**
+ * Deserializes binary data (in protobuf wire format).
+ * @param {jspb.ByteSource} bytes The bytes to deserialize.
+ * @return {!proto.engine_api.GetExistingAndHistoricalEnclaveIdentifiersResponse}
+ */
+proto.engine_api.GetExistingAndHistoricalEnclaveIdentifiersResponse.deserializeBinary = function(bytes) {
+  var reader = new jspb.BinaryReader(bytes);
+  var msg = new proto.engine_api.GetExistingAndHistoricalEnclaveIdentifiersResponse;
+  return proto.engine_api.GetExistingAndHistoricalEnclaveIdentifiersResponse.deserializeBinaryFromReader(msg, reader);
+};
+
+
+/**
+ * Deserializes binary data (in protobuf wire format) from the
+ * given reader into the given message object.
+ * @param {!proto.engine_api.GetExistingAndHistoricalEnclaveIdentifiersResponse} msg The message object to deserialize into.
+ * @param {!jspb.BinaryReader} reader The BinaryReader to use.
+ * @return {!proto.engine_api.GetExistingAndHistoricalEnclaveIdentifiersResponse}
+ */
+proto.engine_api.GetExistingAndHistoricalEnclaveIdentifiersResponse.deserializeBinaryFromReader = function(msg, reader) {
+  while (reader.nextField()) {
+    if (reader.isEndGroup()) {
+      break;
+    }
+    var field = reader.getFieldNumber();
+    switch (field) {
+    case 1:
+      var value = new proto.engine_api.EnclaveIdentifiers;
+      reader.readMessage(value,proto.engine_api.EnclaveIdentifiers.deserializeBinaryFromReader);
+      msg.addAllidentifiers(value);
+      break;
+    default:
+      reader.skipField();
+      break;
+    }
+  }
+  return msg;
+};
+
+
+/**
+ * Serializes the message to binary data (in protobuf wire format).
+ * @return {!Uint8Array}
+ */
+proto.engine_api.GetExistingAndHistoricalEnclaveIdentifiersResponse.prototype.serializeBinary = function() {
+  var writer = new jspb.BinaryWriter();
+  proto.engine_api.GetExistingAndHistoricalEnclaveIdentifiersResponse.serializeBinaryToWriter(this, writer);
+  return writer.getResultBuffer();
+};
+
+
+/**
+ * Serializes the given message to binary data (in protobuf wire
+ * format), writing to the given BinaryWriter.
+ * @param {!proto.engine_api.GetExistingAndHistoricalEnclaveIdentifiersResponse} message
+ * @param {!jspb.BinaryWriter} writer
+ * @suppress {unusedLocalVariables} f is only used for nested messages
+ */
+proto.engine_api.GetExistingAndHistoricalEnclaveIdentifiersResponse.serializeBinaryToWriter = function(message, writer) {
+  var f = undefined;
+  f = message.getAllidentifiersList();
+  if (f.length > 0) {
+    writer.writeRepeatedMessage(
+      1,
+      f,
+      proto.engine_api.EnclaveIdentifiers.serializeBinaryToWriter
+    );
+  }
+};
+
+
+/**
+ * repeated EnclaveIdentifiers allIdentifiers = 1;
+ * @return {!Array<!proto.engine_api.EnclaveIdentifiers>}
+ */
+proto.engine_api.GetExistingAndHistoricalEnclaveIdentifiersResponse.prototype.getAllidentifiersList = function() {
+  return /** @type{!Array<!proto.engine_api.EnclaveIdentifiers>} */ (
+    jspb.Message.getRepeatedWrapperField(this, proto.engine_api.EnclaveIdentifiers, 1));
+};
+
+
+/**
+ * @param {!Array<!proto.engine_api.EnclaveIdentifiers>} value
+ * @return {!proto.engine_api.GetExistingAndHistoricalEnclaveIdentifiersResponse} returns this
+*/
+proto.engine_api.GetExistingAndHistoricalEnclaveIdentifiersResponse.prototype.setAllidentifiersList = function(value) {
+  return jspb.Message.setRepeatedWrapperField(this, 1, value);
+};
+
+
+/**
+ * @param {!proto.engine_api.EnclaveIdentifiers=} opt_value
+ * @param {number=} opt_index
+ * @return {!proto.engine_api.EnclaveIdentifiers}
+ */
+proto.engine_api.GetExistingAndHistoricalEnclaveIdentifiersResponse.prototype.addAllidentifiers = function(opt_value, opt_index) {
+  return jspb.Message.addToRepeatedWrapperField(this, 1, opt_value, proto.engine_api.EnclaveIdentifiers, opt_index);
+};
+
+
+/**
+ * Clears the list making it empty but non-null.
+ * @return {!proto.engine_api.GetExistingAndHistoricalEnclaveIdentifiersResponse} returns this
+ */
+proto.engine_api.GetExistingAndHistoricalEnclaveIdentifiersResponse.prototype.clearAllidentifiersList = function() {
+  return this.setAllidentifiersList([]);
+};
+
+
+
+
+
+if (jspb.Message.GENERATE_TO_OBJECT) {
+/**
+ * Creates an object representation of this proto.
+ * Field names that are reserved in JavaScript and will be renamed to pb_name.
+ * Optional fields that are not set will be set to undefined.
+ * To access a reserved field use, foo.pb_<name>, eg, foo.pb_default.
+ * For the list of reserved names please see:
+ *     net/proto2/compiler/js/internal/generator.cc#kKeyword.
+ * @param {boolean=} opt_includeInstance Deprecated. whether to include the
+ *     JSPB instance for transitional soy proto support:
+ *     http://goto/soy-param-migration
+ * @return {!Object}
+ */
+proto.engine_api.StopEnclaveArgs.prototype.toObject = function(opt_includeInstance) {
+  return proto.engine_api.StopEnclaveArgs.toObject(opt_includeInstance, this);
+};
+
+
+/**
+ * Static version of the {@see toObject} method.
+ * @param {boolean|undefined} includeInstance Deprecated. Whether to include
+ *     the JSPB instance for transitional soy proto support:
+ *     http://goto/soy-param-migration
+ * @param {!proto.engine_api.StopEnclaveArgs} msg The msg instance to transform.
+ * @return {!Object}
+ * @suppress {unusedLocalVariables} f is only used for nested messages
+ */
+proto.engine_api.StopEnclaveArgs.toObject = function(includeInstance, msg) {
+  var f, obj = {
+    enclaveIdentifier: jspb.Message.getFieldWithDefault(msg, 1, "")
+  };
+
+  if (includeInstance) {
+    obj.$jspbMessageInstance = msg;
+  }
+  return obj;
+};
+}
+
+
+/**
+ * Deserializes binary data (in protobuf wire format).
+ * @param {jspb.ByteSource} bytes The bytes to deserialize.
+ * @return {!proto.engine_api.StopEnclaveArgs}
+ */
+proto.engine_api.StopEnclaveArgs.deserializeBinary = function(bytes) {
+  var reader = new jspb.BinaryReader(bytes);
+  var msg = new proto.engine_api.StopEnclaveArgs;
+  return proto.engine_api.StopEnclaveArgs.deserializeBinaryFromReader(msg, reader);
+};
+
+
+/**
+ * Deserializes binary data (in protobuf wire format) from the
+ * given reader into the given message object.
+ * @param {!proto.engine_api.StopEnclaveArgs} msg The message object to deserialize into.
+ * @param {!jspb.BinaryReader} reader The BinaryReader to use.
+ * @return {!proto.engine_api.StopEnclaveArgs}
+ */
+proto.engine_api.StopEnclaveArgs.deserializeBinaryFromReader = function(msg, reader) {
+  while (reader.nextField()) {
+    if (reader.isEndGroup()) {
+      break;
+    }
+    var field = reader.getFieldNumber();
+    switch (field) {
+    case 1:
+      var value = /** @type {string} */ (reader.readString());
+      msg.setEnclaveIdentifier(value);
+      break;
+    default:
+      reader.skipField();
+      break;
+    }
+  }
+  return msg;
+};
+
+
+/**
+ * Serializes the message to binary data (in protobuf wire format).
+ * @return {!Uint8Array}
+ */
+proto.engine_api.StopEnclaveArgs.prototype.serializeBinary = function() {
+  var writer = new jspb.BinaryWriter();
+  proto.engine_api.StopEnclaveArgs.serializeBinaryToWriter(this, writer);
+  return writer.getResultBuffer();
+};
+
+
+/**
+ * Serializes the given message to binary data (in protobuf wire
+ * format), writing to the given BinaryWriter.
+ * @param {!proto.engine_api.StopEnclaveArgs} message
+ * @param {!jspb.BinaryWriter} writer
+ * @suppress {unusedLocalVariables} f is only used for nested messages
+ */
+proto.engine_api.StopEnclaveArgs.serializeBinaryToWriter = function(message, writer) {
+  var f = undefined;
+  f = message.getEnclaveIdentifier();
+  if (f.length > 0) {
+    writer.writeString(
+      1,
+      f
+    );
+  }
+};
+
+
+/**
+ * optional string enclave_identifier = 1;
+ * @return {string}
+ */
+proto.engine_api.StopEnclaveArgs.prototype.getEnclaveIdentifier = function() {
+  return /** @type {string} */ (jspb.Message.getFieldWithDefault(this, 1, ""));
+};
+
+
+/**
+ * @param {string} value
+ * @return {!proto.engine_api.StopEnclaveArgs} returns this
+ */
+proto.engine_api.StopEnclaveArgs.prototype.setEnclaveIdentifier = function(value) {
+  return jspb.Message.setProto3StringField(this, 1, value);
+};
+
+
+
+
+
+if (jspb.Message.GENERATE_TO_OBJECT) {
+/**
+ * Creates an object representation of this proto.
+ * Field names that are reserved in JavaScript and will be renamed to pb_name.
+ * Optional fields that are not set will be set to undefined.
+ * To access a reserved field use, foo.pb_<name>, eg, foo.pb_default.
+ * For the list of reserved names please see:
+ *     net/proto2/compiler/js/internal/generator.cc#kKeyword.
+ * @param {boolean=} opt_includeInstance Deprecated. whether to include the
+ *     JSPB instance for transitional soy proto support:
+ *     http://goto/soy-param-migration
+ * @return {!Object}
+ */
+proto.engine_api.ExtendEnclaveTtlArgs.prototype.toObject = function(opt_includeInstance) {
+  return proto.engine_api.ExtendEnclaveTtlArgs.toObject(opt_includeInstance, this);
+};
+
+
+/**
+ * Static version of the {@see toObject} method.
+ * @param {boolean|undefined} includeInstance Deprecated. Whether to include
+ *     the JSPB instance for transitional soy proto support:
+ *     http://goto/soy-param-migration
+ * @param {!proto.engine_api.ExtendEnclaveTtlArgs} msg The msg instance to transform.
+ * @return {!Object}
+ * @suppress {unusedLocalVariables} f is only used for nested messages
+ */
+proto.engine_api.ExtendEnclaveTtlArgs.toObject = function(includeInstance, msg) {
+  var f, obj = {
+    enclaveIdentifier: jspb.Message.getFieldWithDefault(msg, 1, ""),
+    ttlSeconds: jspb.Message.getFieldWithDefault(msg, 2, 0)
+  };
+
+  if (includeInstance) {
+    obj.$jspbMessageInstance = msg;
+  }
+  return obj;
+};
+}
+
+
+/**
+ * Deserializes binary data (in protobuf wire format).
+ * @param {jspb.ByteSource} bytes The bytes to deserialize.
+ * @return {!proto.engine_api.ExtendEnclaveTtlArgs}
+ */
+proto.engine_api.ExtendEnclaveTtlArgs.deserializeBinary = function(bytes) {
+  var reader = new jspb.BinaryReader(bytes);
+  var msg = new proto.engine_api.ExtendEnclaveTtlArgs;
+  return proto.engine_api.ExtendEnclaveTtlArgs.deserializeBinaryFromReader(msg, reader);
+};
+
+
+/**
+ * Deserializes binary data (in protobuf wire format) from the
+ * given reader into the given message object.
+ * @param {!proto.engine_api.ExtendEnclaveTtlArgs} msg The message object to deserialize into.
+ * @param {!jspb.BinaryReader} reader The BinaryReader to use.
+ * @return {!proto.engine_api.ExtendEnclaveTtlArgs}
+ */
+proto.engine_api.ExtendEnclaveTtlArgs.deserializeBinaryFromReader = function(msg, reader) {
+  while (reader.nextField()) {
+    if (reader.isEndGroup()) {
+      break;
+    }
+    var field = reader.getFieldNumber();
+    switch (field) {
+    case 1:
+      var value = /** @type {string} */ (reader.readString());
+      msg.setEnclaveIdentifier(value);
+      break;
+    case 2:
+      var value = /** @type {number} */ (reader.readUint32());
+      msg.setTtlSeconds(value);
+      break;
+    default:
+      reader.skipField();
+      break;
+    }
+  }
+  return msg;
+};
+
+
+/**
+ * Serializes the message to binary data (in protobuf wire format).
+ * @return {!Uint8Array}
+ */
+proto.engine_api.ExtendEnclaveTtlArgs.prototype.serializeBinary = function() {
+  var writer = new jspb.BinaryWriter();
+  proto.engine_api.ExtendEnclaveTtlArgs.serializeBinaryToWriter(this, writer);
+  return writer.getResultBuffer();
+};
+
+
+/**
+ * Serializes the given message to binary data (in protobuf wire
+ * format), writing to the given BinaryWriter.
+ * @param {!proto.engine_api.ExtendEnclaveTtlArgs} message
+ * @param {!jspb.BinaryWriter} writer
+ * @suppress {unusedLocalVariables} f is only used for nested messages
+ */
+proto.engine_api.ExtendEnclaveTtlArgs.serializeBinaryToWriter = function(message, writer) {
+  var f = undefined;
+  f = message.getEnclaveIdentifier();
+  if (f.length > 0) {
+    writer.writeString(
+      1,
+      f
+    );
+  }
+  f = message.getTtlSeconds();
+  if (f !== 0) {
+    writer.writeUint32(
+      2,
+      f
+    );
+  }
+};
+
+
+/**
+ * optional string enclave_identifier = 1;
+ * @return {string}
+ */
+proto.engine_api.ExtendEnclaveTtlArgs.prototype.getEnclaveIdentifier = function() {
+  return /** @type {string} */ (jspb.Message.getFieldWithDefault(this, 1, ""));
+};
+
+
+/**
+ * @param {string} value
+ * @return {!proto.engine_api.ExtendEnclaveTtlArgs} returns this
+ */
+proto.engine_api.ExtendEnclaveTtlArgs.prototype.setEnclaveIdentifier = function(value) {
+  return jspb.Message.setProto3StringField(this, 1, value);
+};
+
+
+/**
+ * optional uint32 ttl_seconds = 2;
+ * @return {number}
+ */
+proto.engine_api.ExtendEnclaveTtlArgs.prototype.getTtlSeconds = function() {
+  return /** @type {number} */ (jspb.Message.getFieldWithDefault(this, 2, 0));
+};
+
+
+/**
+ * @param {number} value
+ * @return {!proto.engine_api.ExtendEnclaveTtlArgs} returns this
+ */
+proto.engine_api.ExtendEnclaveTtlArgs.prototype.setTtlSeconds = function(value) {
+  return jspb.Message.setProto3IntField(this, 2, value);
+};
+
+
+
+/**
+ * List of repeated fields within this message type.
+ * @private {!Array<number>}
+ * @const
+ */
+proto.engine_api.UpdateEnclaveLabelsArgs.repeatedFields_ = [3];
+
+
+
+if (jspb.Message.GENERATE_TO_OBJECT) {
+/**
+ * Creates an object representation of this proto.
+ * Field names that are reserved in JavaScript and will be renamed to pb_name.
+ * Optional fields that are not set will be set to undefined.
+ * To access a reserved field use, foo.pb_<name>, eg, foo.pb_default.
+ * For the list of reserved names please see:
+ *     net/proto2/compiler/js/internal/generator.cc#kKeyword.
+ * @param {boolean=} opt_includeInstance Deprecated. whether to include the
+ *     JSPB instance for transitional soy proto support:
+ *     http://goto/soy-param-migration
+ * @return {!Object}
+ */
+proto.engine_api.UpdateEnclaveLabelsArgs.prototype.toObject = function(opt_includeInstance) {
+  return proto.engine_api.UpdateEnclaveLabelsArgs.toObject(opt_includeInstance, this);
+};
+
+
+/**
+ * Static version of the {@see toObject} method.
+ * @param {boolean|undefined} includeInstance Deprecated. Whether to include
+ *     the JSPB instance for transitional soy proto support:
+ *     http://goto/soy-param-migration
+ * @param {!proto.engine_api.UpdateEnclaveLabelsArgs} msg The msg instance to transform.
+ * @return {!Object}
+ * @suppress {unusedLocalVariables} f is only used for nested messages
+ */
+proto.engine_api.UpdateEnclaveLabelsArgs.toObject = function(includeInstance, msg) {
+  var f, obj = {
+    enclaveIdentifier: jspb.Message.getFieldWithDefault(msg, 1, ""),
+    labelsToSetMap: (f = msg.getLabelsToSetMap()) ? f.toObject(includeInstance, undefined) : [],
+    labelsToRemoveList: (f = jspb.Message.getRepeatedField(msg, 3)) == null ? undefined : f
+  };
+
+  if (includeInstance) {
+    obj.$jspbMessageInstance = msg;
+  }
+  return obj;
+};
+}
+
+
+/**
+ * Deserializes binary data (in protobuf wire format).
+ * @param {jspb.ByteSource} bytes The bytes to deserialize.
+ * @return {!proto.engine_api.UpdateEnclaveLabelsArgs}
+ */
+proto.engine_api.UpdateEnclaveLabelsArgs.deserializeBinary = function(bytes) {
+  var reader = new jspb.BinaryReader(bytes);
+  var msg = new proto.engine_api.UpdateEnclaveLabelsArgs;
+  return proto.engine_api.UpdateEnclaveLabelsArgs.deserializeBinaryFromReader(msg, reader);
+};
+
+
+/**
+ * Deserializes binary data (in protobuf wire format) from the
+ * given reader into the given message object.
+ * @param {!proto.engine_api.UpdateEnclaveLabelsArgs} msg The message object to deserialize into.
+ * @param {!jspb.BinaryReader} reader The BinaryReader to use.
+ * @return {!proto.engine_api.UpdateEnclaveLabelsArgs}
+ */
+proto.engine_api.UpdateEnclaveLabelsArgs.deserializeBinaryFromReader = function(msg, reader) {
+  while (reader.nextField()) {
+    if (reader.isEndGroup()) {
+      break;
+    }
+    var field = reader.getFieldNumber();
+    switch (field) {
+    case 1:
+      var value = /** @type {string} */ (reader.readString());
+      msg.setEnclaveIdentifier(value);
+      break;
+    case 2:
+      var value = msg.getLabelsToSetMap();
+      reader.readMessage(value, function(message, reader) {
+        jspb.Map.deserializeBinary(message, reader, jspb.BinaryReader.prototype.readString, jspb.BinaryReader.prototype.readString, null, "", "");
+         });
+      break;
+    case 3:
+      var value = /** @type {string} */ (reader.readString());
+      msg.addLabelsToRemove(value);
+      break;
+    default:
+      reader.skipField();
+      break;
+    }
+  }
+  return msg;
+};
+
+
+/**
+ * Serializes the message to binary data (in protobuf wire format).
+ * @return {!Uint8Array}
+ */
+proto.engine_api.UpdateEnclaveLabelsArgs.prototype.serializeBinary = function() {
+  var writer = new jspb.BinaryWriter();
+  proto.engine_api.UpdateEnclaveLabelsArgs.serializeBinaryToWriter(this, writer);
+  return writer.getResultBuffer();
+};
+
+
+/**
+ * Serializes the given message to binary data (in protobuf wire
+ * format), writing to the given BinaryWriter.
+ * @param {!proto.engine_api.UpdateEnclaveLabelsArgs} message
+ * @param {!jspb.BinaryWriter} writer
+ * @suppress {unusedLocalVariables} f is only used for nested messages
+ */
+proto.engine_api.UpdateEnclaveLabelsArgs.serializeBinaryToWriter = function(message, writer) {
+  var f = undefined;
+  f = message.getEnclaveIdentifier();
+  if (f.length > 0) {
+    writer.writeString(
+      1,
+      f
+    );
+  }
+  f = message.getLabelsToSetMap(true);
+  if (f && f.getLength() > 0) {
+    f.serializeBinary(2, writer, jspb.BinaryWriter.prototype.writeString, jspb.BinaryWriter.prototype.writeString);
+  }
+  f = message.getLabelsToRemoveList();
+  if (f.length > 0) {
+    writer.writeRepeatedString(
+      3,
+      f
+    );
+  }
+};
+
+
+/**
+ * optional string enclave_identifier = 1;
+ * @return {string}
+ */
+proto.engine_api.UpdateEnclaveLabelsArgs.prototype.getEnclaveIdentifier = function() {
+  return /** @type {string} */ (jspb.Message.getFieldWithDefault(this, 1, ""));
+};
+
+
+/**
+ * @param {string} value
+ * @return {!proto.engine_api.UpdateEnclaveLabelsArgs} returns this
+ */
+proto.engine_api.UpdateEnclaveLabelsArgs.prototype.setEnclaveIdentifier = function(value) {
+  return jspb.Message.setProto3StringField(this, 1, value);
+};
+
+
+/**
+ * map<string, string> labels_to_set = 2;
+ * @param {boolean=} opt_noLazyCreate Do not create the map if
+ * empty, instead returning `undefined`
+ * @return {!jspb.Map<string,string>}
+ */
+proto.engine_api.UpdateEnclaveLabelsArgs.prototype.getLabelsToSetMap = function(opt_noLazyCreate) {
+  return /** @type {!jspb.Map<string,string>} */ (
+      jspb.Message.getMapField(this, 2, opt_noLazyCreate,
+      null));
+};
+
+
+/**
+ * Clears values from the map. The map will be non-null.
+ * @return {!proto.engine_api.UpdateEnclaveLabelsArgs} returns this
+ */
+proto.engine_api.UpdateEnclaveLabelsArgs.prototype.clearLabelsToSetMap = function() {
+  this.getLabelsToSetMap().clear();
+  return this;};
+
+
+/**
+ * repeated string labels_to_remove = 3;
+ * @return {!Array<string>}
+ */
+proto.engine_api.UpdateEnclaveLabelsArgs.prototype.getLabelsToRemoveList = function() {
+  return /** @type {!Array<string>} */ (jspb.Message.getRepeatedField(this, 3));
+};
+
+
+/**
+ * @param {!Array<string>} value
+ * @return {!proto.engine_api.UpdateEnclaveLabelsArgs} returns this
+ */
+proto.engine_api.UpdateEnclaveLabelsArgs.prototype.setLabelsToRemoveList = function(value) {
+  return jspb.Message.setField(this, 3, value || []);
+};
+
+
+/**
+ * @param {string} value
+ * @param {number=} opt_index
+ * @return {!proto.engine_api.UpdateEnclaveLabelsArgs} returns this
+ */
+proto.engine_api.UpdateEnclaveLabelsArgs.prototype.addLabelsToRemove = function(value, opt_index) {
+  return jspb.Message.addToRepeatedField(this, 3, value, opt_index);
+};
+
+
+/**
+ * Clears the list making it empty but non-null.
+ * @return {!proto.engine_api.UpdateEnclaveLabelsArgs} returns this
+ */
+proto.engine_api.UpdateEnclaveLabelsArgs.prototype.clearLabelsToRemoveList = function() {
+  return this.setLabelsToRemoveList([]);
+};
+
+
+
+
+
+if (jspb.Message.GENERATE_TO_OBJECT) {
+/**
+ * Creates an object representation of this proto.
+ * Field names that are reserved in JavaScript and will be renamed to pb_name.
+ * Optional fields that are not set will be set to undefined.
+ * To access a reserved field use, foo.pb_<name>, eg, foo.pb_default.
+ * For the list of reserved names please see:
+ *     net/proto2/compiler/js/internal/generator.cc#kKeyword.
+ * @param {boolean=} opt_includeInstance Deprecated. whether to include the
+ *     JSPB instance for transitional soy proto support:
+ *     http://goto/soy-param-migration
+ * @return {!Object}
+ */
+proto.engine_api.RenameEnclaveArgs.prototype.toObject = function(opt_includeInstance) {
+  return proto.engine_api.RenameEnclaveArgs.toObject(opt_includeInstance, this);
+};
+
+
+/**
+ * Static version of the {@see toObject} method.
+ * @param {boolean|undefined} includeInstance Deprecated. Whether to include
+ *     the JSPB instance for transitional soy proto support:
+ *     http://goto/soy-param-migration
+ * @param {!proto.engine_api.RenameEnclaveArgs} msg The msg instance to transform.
+ * @return {!Object}
+ * @suppress {unusedLocalVariables} f is only used for nested messages
+ */
+proto.engine_api.RenameEnclaveArgs.toObject = function(includeInstance, msg) {
+  var f, obj = {
+    enclaveIdentifier: jspb.Message.getFieldWithDefault(msg, 1, ""),
+    newName: jspb.Message.getFieldWithDefault(msg, 2, "")
+  };
+
+  if (includeInstance) {
+    obj.$jspbMessageInstance = msg;
+  }
+  return obj;
+};
+}
+
+
+/**
+ * Deserializes binary data (in protobuf wire format).
+ * @param {jspb.ByteSource} bytes The bytes to deserialize.
+ * @return {!proto.engine_api.RenameEnclaveArgs}
+ */
+proto.engine_api.RenameEnclaveArgs.deserializeBinary = function(bytes) {
+  var reader = new jspb.BinaryReader(bytes);
+  var msg = new proto.engine_api.RenameEnclaveArgs;
+  return proto.engine_api.RenameEnclaveArgs.deserializeBinaryFromReader(msg, reader);
+};
+
+
+/**
+ * Deserializes binary data (in protobuf wire format) from the
+ * given reader into the given message object.
+ * @param {!proto.engine_api.RenameEnclaveArgs} msg The message object to deserialize into.
+ * @param {!jspb.BinaryReader} reader The BinaryReader to use.
+ * @return {!proto.engine_api.RenameEnclaveArgs}
+ */
+proto.engine_api.RenameEnclaveArgs.deserializeBinaryFromReader = function(msg, reader) {
+  while (reader.nextField()) {
+    if (reader.isEndGroup()) {
+      break;
+    }
+    var field = reader.getFieldNumber();
+    switch (field) {
+    case 1:
+      var value = /** @type {string} */ (reader.readString());
+      msg.setEnclaveIdentifier(value);
+      break;
+    case 2:
+      var value = /** @type {string} */ (reader.readString());
+      msg.setNewName(value);
+      break;
+    default:
+      reader.skipField();
+      break;
+    }
+  }
+  return msg;
+};
+
+
+/**
+ * Serializes the message to binary data (in protobuf wire format).
+ * @return {!Uint8Array}
+ */
+proto.engine_api.RenameEnclaveArgs.prototype.serializeBinary = function() {
+  var writer = new jspb.BinaryWriter();
+  proto.engine_api.RenameEnclaveArgs.serializeBinaryToWriter(this, writer);
+  return writer.getResultBuffer();
+};
+
+
+/**
+ * Serializes the given message to binary data (in protobuf wire
+ * format), writing to the given BinaryWriter.
+ * @param {!proto.engine_api.RenameEnclaveArgs} message
+ * @param {!jspb.BinaryWriter} writer
+ * @suppress {unusedLocalVariables} f is only used for nested messages
+ */
+proto.engine_api.RenameEnclaveArgs.serializeBinaryToWriter = function(message, writer) {
+  var f = undefined;
+  f = message.getEnclaveIdentifier();
+  if (f.length > 0) {
+    writer.writeString(
+      1,
+      f
+    );
+  }
+  f = message.getNewName();
+  if (f.length > 0) {
+    writer.writeString(
+      2,
+      f
+    );
+  }
+};
+
+
+/**
+ * optional string enclave_identifier = 1;
+ * @return {string}
+ */
+proto.engine_api.RenameEnclaveArgs.prototype.getEnclaveIdentifier = function() {
+  return /** @type {string} */ (jspb.Message.getFieldWithDefault(this, 1, ""));
+};
+
+
+/**
+ * @param {string} value
+ * @return {!proto.engine_api.RenameEnclaveArgs} returns this
+ */
+proto.engine_api.RenameEnclaveArgs.prototype.setEnclaveIdentifier = function(value) {
+  return jspb.Message.setProto3StringField(this, 1, value);
+};
+
+
+/**
+ * optional string new_name = 2;
+ * @return {string}
+ */
+proto.engine_api.RenameEnclaveArgs.prototype.getNewName = function() {
+  return /** @type {string} */ (jspb.Message.getFieldWithDefault(this, 2, ""));
+};
+
+
+/**
+ * @param {string} value
+ * @return {!proto.engine_api.RenameEnclaveArgs} returns this
+ */
+proto.engine_api.RenameEnclaveArgs.prototype.setNewName = function(value) {
+  return jspb.Message.setProto3StringField(this, 2, value);
+};
+
+
+
+
+
+if (jspb.Message.GENERATE_TO_OBJECT) {
+/**
+ * Creates an object representation of this proto.
+ * Field names that are reserved in JavaScript and will be renamed to pb_name.
+ * Optional fields that are not set will be set to undefined.
+ * To access a reserved field use, foo.pb_<name>, eg, foo.pb_default.
+ * For the list of reserved names please see:
+ *     net/proto2/compiler/js/internal/generator.cc#kKeyword.
+ * @param {boolean=} opt_includeInstance Deprecated. whether to include the
+ *     JSPB instance for transitional soy proto support:
+ *     http://goto/soy-param-migration
+ * @return {!Object}
+ */
+proto.engine_api.CloneEnclaveArgs.prototype.toObject = function(opt_includeInstance) {
+  return proto.engine_api.CloneEnclaveArgs.toObject(opt_includeInstance, this);
+};
+
+
+/**
+ * Static version of the {@see toObject} method.
+ * @param {boolean|undefined} includeInstance Deprecated. Whether to include
+ *     the JSPB instance for transitional soy proto support:
+ *     http://goto/soy-param-migration
+ * @param {!proto.engine_api.CloneEnclaveArgs} msg The msg instance to transform.
+ * @return {!Object}
+ * @suppress {unusedLocalVariables} f is only used for nested messages
+ */
+proto.engine_api.CloneEnclaveArgs.toObject = function(includeInstance, msg) {
+  var f, obj = {
+    enclaveIdentifier: jspb.Message.getFieldWithDefault(msg, 1, ""),
+    enclaveName: jspb.Message.getFieldWithDefault(msg, 2, ""),
+    allowPartial: jspb.Message.getBooleanFieldWithDefault(msg, 3, false)
+  };
+
+  if (includeInstance) {
+    obj.$jspbMessageInstance = msg;
+  }
+  return obj;
+};
+}
+
+
+/**
+ * Deserializes binary data (in protobuf wire format).
+ * @param {jspb.ByteSource} bytes The bytes to deserialize.
+ * @return {!proto.engine_api.CloneEnclaveArgs}
+ */
+proto.engine_api.CloneEnclaveArgs.deserializeBinary = function(bytes) {
+  var reader = new jspb.BinaryReader(bytes);
+  var msg = new proto.engine_api.CloneEnclaveArgs;
+  return proto.engine_api.CloneEnclaveArgs.deserializeBinaryFromReader(msg, reader);
+};
+
+
+/**
+ * Deserializes binary data (in protobuf wire format) from the
+ * given reader into the given message object.
+ * @param {!proto.engine_api.CloneEnclaveArgs} msg The message object to deserialize into.
+ * @param {!jspb.BinaryReader} reader The BinaryReader to use.
+ * @return {!proto.engine_api.CloneEnclaveArgs}
+ */
+proto.engine_api.CloneEnclaveArgs.deserializeBinaryFromReader = function(msg, reader) {
+  while (reader.nextField()) {
+    if (reader.isEndGroup()) {
+      break;
+    }
+    var field = reader.getFieldNumber();
+    switch (field) {
+    case 1:
+      var value = /** @type {string} */ (reader.readString());
+      msg.setEnclaveIdentifier(value);
+      break;
+    case 2:
+      var value = /** @type {string} */ (reader.readString());
+      msg.setEnclaveName(value);
+      break;
+    case 3:
+      var value = /** @type {boolean} */ (reader.readBool());
+      msg.setAllowPartial(value);
+      break;
+    default:
+      reader.skipField();
+      break;
+    }
+  }
+  return msg;
+};
+
+
+/**
+ * Serializes the message to binary data (in protobuf wire format).
+ * @return {!Uint8Array}
+ */
+proto.engine_api.CloneEnclaveArgs.prototype.serializeBinary = function() {
+  var writer = new jspb.BinaryWriter();
+  proto.engine_api.CloneEnclaveArgs.serializeBinaryToWriter(this, writer);
+  return writer.getResultBuffer();
+};
+
+
+/**
+ * Serializes the given message to binary data (in protobuf wire
+ * format), writing to the given BinaryWriter.
+ * @param {!proto.engine_api.CloneEnclaveArgs} message
+ * @param {!jspb.BinaryWriter} writer
+ * @suppress {unusedLocalVariables} f is only used for nested messages
+ */
+proto.engine_api.CloneEnclaveArgs.serializeBinaryToWriter = function(message, writer) {
+  var f = undefined;
+  f = message.getEnclaveIdentifier();
+  if (f.length > 0) {
+    writer.writeString(
+      1,
+      f
+    );
+  }
+  f = /** @type {string} */ (jspb.Message.getField(message, 2));
+  if (f != null) {
+    writer.writeString(
+      2,
+      f
+    );
+  }
+  f = /** @type {boolean} */ (jspb.Message.getField(message, 3));
+  if (f != null) {
+    writer.writeBool(
+      3,
+      f
+    );
+  }
+};
+
+
+/**
+ * optional string enclave_identifier = 1;
+ * @return {string}
+ */
+proto.engine_api.CloneEnclaveArgs.prototype.getEnclaveIdentifier = function() {
+  return /** @type {string} */ (jspb.Message.getFieldWithDefault(this, 1, ""));
+};
+
+
+/**
+ * @param {string} value
+ * @return {!proto.engine_api.CloneEnclaveArgs} returns this
+ */
+proto.engine_api.CloneEnclaveArgs.prototype.setEnclaveIdentifier = function(value) {
+  return jspb.Message.setProto3StringField(this, 1, value);
+};
+
+
+/**
+ * optional string enclave_name = 2;
+ * @return {string}
+ */
+proto.engine_api.CloneEnclaveArgs.prototype.getEnclaveName = function() {
+  return /** @type {string} */ (jspb.Message.getFieldWithDefault(this, 2, ""));
+};
+
+
+/**
+ * @param {string} value
+ * @return {!proto.engine_api.CloneEnclaveArgs} returns this
+ */
+proto.engine_api.CloneEnclaveArgs.prototype.setEnclaveName = function(value) {
+  return jspb.Message.setField(this, 2, value);
+};
+
+
+/**
+ * Clears the field making it undefined.
+ * @return {!proto.engine_api.CloneEnclaveArgs} returns this
+ */
+proto.engine_api.CloneEnclaveArgs.prototype.clearEnclaveName = function() {
+  return jspb.Message.setField(this, 2, undefined);
+};
+
+
+/**
+ * Returns whether this field is set.
+ * @return {boolean}
+ */
+proto.engine_api.CloneEnclaveArgs.prototype.hasEnclaveName = function() {
+  return jspb.Message.getField(this, 2) != null;
+};
+
+
+/**
+ * optional bool allow_partial = 3;
+ * @return {boolean}
+ */
+proto.engine_api.CloneEnclaveArgs.prototype.getAllowPartial = function() {
+  return /** @type {boolean} */ (jspb.Message.getBooleanFieldWithDefault(this, 3, false));
+};
+
+
+/**
+ * @param {boolean} value
+ * @return {!proto.engine_api.CloneEnclaveArgs} returns this
+ */
+proto.engine_api.CloneEnclaveArgs.prototype.setAllowPartial = function(value) {
+  return jspb.Message.setField(this, 3, value);
+};
+
+
+/**
+ * Clears the field making it undefined.
+ * @return {!proto.engine_api.CloneEnclaveArgs} returns this
+ */
+proto.engine_api.CloneEnclaveArgs.prototype.clearAllowPartial = function() {
+  return jspb.Message.setField(this, 3, undefined);
+};
+
+
+/**
+ * Returns whether this field is set.
+ * @return {boolean}
+ */
+proto.engine_api.CloneEnclaveArgs.prototype.hasAllowPartial = function() {
+  return jspb.Message.getField(this, 3) != null;
+};
+
+
+
+/**
+ * List of repeated fields within this message type.
+ * @private {!Array<number>}
+ * @const
+ */
+proto.engine_api.CloneEnclaveResponse.repeatedFields_ = [2,3];
+
+
+
+if (jspb.Message.GENERATE_TO_OBJECT) {
+/**
+ * Creates an object representation of this proto.
+ * Field names that are reserved in JavaScript and will be renamed to pb_name.
+ * Optional fields that are not set will be set to undefined.
+ * To access a reserved field use, foo.pb_<name>, eg, foo.pb_default.
+ * For the list of reserved names please see:
+ *     net/proto2/compiler/js/internal/generator.cc#kKeyword.
+ * @param {boolean=} opt_includeInstance Deprecated. whether to include the
+ *     JSPB instance for transitional soy proto support:
+ *     http://goto/soy-param-migration
+ * @return {!Object}
+ */
+proto.engine_api.CloneEnclaveResponse.prototype.toObject = function(opt_includeInstance) {
+  return proto.engine_api.CloneEnclaveResponse.toObject(opt_includeInstance, this);
+};
+
+
+/**
+ * Static version of the {@see toObject} method.
+ * @param {boolean|undefined} includeInstance Deprecated. Whether to include
+ *     the JSPB instance for transitional soy proto support:
+ *     http://goto/soy-param-migration
+ * @param {!proto.engine_api.CloneEnclaveResponse} msg The msg instance to transform.
+ * @return {!Object}
+ * @suppress {unusedLocalVariables} f is only used for nested messages
+ */
+proto.engine_api.CloneEnclaveResponse.toObject = function(includeInstance, msg) {
+  var f, obj = {
+    enclaveInfo: (f = msg.getEnclaveInfo()) && proto.engine_api.EnclaveInfo.toObject(includeInstance, f),
+    uncloneableItemsList: (f = jspb.Message.getRepeatedField(msg, 2)) == null ? undefined : f,
+    warningsList: (f = jspb.Message.getRepeatedField(msg, 3)) == null ? undefined : f
+  };
+
+  if (includeInstance) {
+    obj.$jspbMessageInstance = msg;
+  }
+  return obj;
+};
+}
+
+
+/**
+ * Deserializes binary data (in protobuf wire format).
+ * @param {jspb.ByteSource} bytes The bytes to deserialize.
+ * @return {!proto.engine_api.CloneEnclaveResponse}
+ */
+proto.engine_api.CloneEnclaveResponse.deserializeBinary = function(bytes) {
+  var reader = new jspb.BinaryReader(bytes);
+  var msg = new proto.engine_api.CloneEnclaveResponse;
+  return proto.engine_api.CloneEnclaveResponse.deserializeBinaryFromReader(msg, reader);
+};
+
+
+/**
+ * Deserializes binary data (in protobuf wire format) from the
+ * given reader into the given message object.
+ * @param {!proto.engine_api.CloneEnclaveResponse} msg The message object to deserialize into.
+ * @param {!jspb.BinaryReader} reader The BinaryReader to use.
+ * @return {!proto.engine_api.CloneEnclaveResponse}
+ */
+proto.engine_api.CloneEnclaveResponse.deserializeBinaryFromReader = function(msg, reader) {
+  while (reader.nextField()) {
+    if (reader.isEndGroup()) {
+      break;
+    }
+    var field = reader.getFieldNumber();
+    switch (field) {
+    case 1:
+      var value = new proto.engine_api.EnclaveInfo;
+      reader.readMessage(value,proto.engine_api.EnclaveInfo.deserializeBinaryFromReader);
+      msg.setEnclaveInfo(value);
+      break;
+    case 2:
+      var value = /** @type {string} */ (reader.readString());
+      msg.addUncloneableItems(value);
+      break;
+    case 3:
+      var value = /** @type {string} */ (reader.readString());
+      msg.addWarnings(value);
+      break;
+    default:
+      reader.skipField();
+      break;
+    }
+  }
+  return msg;
+};
+
+
+/**
+ * Serializes the message to binary data (in protobuf wire format).
+ * @return {!Uint8Array}
+ */
+proto.engine_api.CloneEnclaveResponse.prototype.serializeBinary = function() {
+  var writer = new jspb.BinaryWriter();
+  proto.engine_api.CloneEnclaveResponse.serializeBinaryToWriter(this, writer);
+  return writer.getResultBuffer();
+};
+
+
+/**
+ * Serializes the given message to binary data (in protobuf wire
+ * format), writing to the given BinaryWriter.
+ * @param {!proto.engine_api.CloneEnclaveResponse} message
+ * @param {!jspb.BinaryWriter} writer
+ * @suppress {unusedLocalVariables} f is only used for nested messages
+ */
+proto.engine_api.CloneEnclaveResponse.serializeBinaryToWriter = function(message, writer) {
+  var f = undefined;
+  f = message.getEnclaveInfo();
+  if (f != null) {
+    writer.writeMessage(
+      1,
+      f,
+      proto.engine_api.EnclaveInfo.serializeBinaryToWriter
+    );
+  }
+  f = message.getUncloneableItemsList();
+  if (f.length > 0) {
+    writer.writeRepeatedString(
+      2,
+      f
+    );
+  }
+  f = message.getWarningsList();
+  if (f.length > 0) {
+    writer.writeRepeatedString(
+      3,
+      f
+    );
+  }
+};
+
+
+/**
+ * optional EnclaveInfo enclave_info = 1;
+ * @return {?proto.engine_api.EnclaveInfo}
+ */
+proto.engine_api.CloneEnclaveResponse.prototype.getEnclaveInfo = function() {
+  return /** @type{?proto.engine_api.EnclaveInfo} */ (
+    jspb.Message.getWrapperField(this, proto.engine_api.EnclaveInfo, 1));
+};
+
+
+/**
+ * @param {?proto.engine_api.EnclaveInfo|undefined} value
+ * @return {!proto.engine_api.CloneEnclaveResponse} returns this
+*/
+proto.engine_api.CloneEnclaveResponse.prototype.setEnclaveInfo = function(value) {
+  return jspb.Message.setWrapperField(this, 1, value);
+};
+
+
+/**
+ * Clears the message field making it undefined.
+ * @return {!proto.engine_api.CloneEnclaveResponse} returns this
+ */
+proto.engine_api.CloneEnclaveResponse.prototype.clearEnclaveInfo = function() {
+  return this.setEnclaveInfo(undefined);
+};
+
+
+/**
+ * Returns whether this field is set.
+ * @return {boolean}
+ */
+proto.engine_api.CloneEnclaveResponse.prototype.hasEnclaveInfo = function() {
+  return jspb.Message.getField(this, 1) != null;
+};
+
+
+/**
+ * repeated string uncloneable_items = 2;
+ * @return {!Array<string>}
+ */
+proto.engine_api.CloneEnclaveResponse.prototype.getUncloneableItemsList = function() {
+  return /** @type {!Array<string>} */ (jspb.Message.getRepeatedField(this, 2));
+};
+
+
+/**
+ * @param {!Array<string>} value
+ * @return {!proto.engine_api.CloneEnclaveResponse} returns this
+ */
+proto.engine_api.CloneEnclaveResponse.prototype.setUncloneableItemsList = function(value) {
+  return jspb.Message.setField(this, 2, value || []);
+};
+
+
+/**
+ * @param {string} value
+ * @param {number=} opt_index
+ * @return {!proto.engine_api.CloneEnclaveResponse} returns this
+ */
+proto.engine_api.CloneEnclaveResponse.prototype.addUncloneableItems = function(value, opt_index) {
+  return jspb.Message.addToRepeatedField(this, 2, value, opt_index);
+};
+
+
+/**
+ * Clears the list making it empty but non-null.
+ * @return {!proto.engine_api.CloneEnclaveResponse} returns this
+ */
+proto.engine_api.CloneEnclaveResponse.prototype.clearUncloneableItemsList = function() {
+  return this.setUncloneableItemsList([]);
+};
+
+
+/**
+ * repeated string warnings = 3;
+ * @return {!Array<string>}
+ */
+proto.engine_api.CloneEnclaveResponse.prototype.getWarningsList = function() {
+  return /** @type {!Array<string>} */ (jspb.Message.getRepeatedField(this, 3));
+};
+
+
+/**
+ * @param {!Array<string>} value
+ * @return {!proto.engine_api.CloneEnclaveResponse} returns this
+ */
+proto.engine_api.CloneEnclaveResponse.prototype.setWarningsList = function(value) {
+  return jspb.Message.setField(this, 3, value || []);
+};
+
+
+/**
+ * @param {string} value
+ * @param {number=} opt_index
+ * @return {!proto.engine_api.CloneEnclaveResponse} returns this
+ */
+proto.engine_api.CloneEnclaveResponse.prototype.addWarnings = function(value, opt_index) {
+  return jspb.Message.addToRepeatedField(this, 3, value, opt_index);
+};
+
+
+/**
+ * Clears the list making it empty but non-null.
+ * @return {!proto.engine_api.CloneEnclaveResponse} returns this
+ */
+proto.engine_api.CloneEnclaveResponse.prototype.clearWarningsList = function() {
+  return this.setWarningsList([]);
+};
+
+
+
+
+
+if (jspb.Message.GENERATE_TO_OBJECT) {
+/**
+ * Creates an object representation of this proto.
+ * Field names that are reserved in JavaScript and will be renamed to pb_name.
+ * Optional fields that are not set will be set to undefined.
+ * To access a reserved field use, foo.pb_<name>, eg, foo.pb_default.
+ * For the list of reserved names please see:
+ *     net/proto2/compiler/js/internal/generator.cc#kKeyword.
+ * @param {boolean=} opt_includeInstance Deprecated. whether to include the
+ *     JSPB instance for transitional soy proto support:
+ *     http://goto/soy-param-migration
+ * @return {!Object}
+ */
+proto.engine_api.ExportEnclaveArgs.prototype.toObject = function(opt_includeInstance) {
+  return proto.engine_api.ExportEnclaveArgs.toObject(opt_includeInstance, this);
+};
+
+
+/**
+ * Static version of the {@see toObject} method.
+ * @param {boolean|undefined} includeInstance Deprecated. Whether to include
+ *     the JSPB instance for transitional soy proto support:
+ *     http://goto/soy-param-migration
+ * @param {!proto.engine_api.ExportEnclaveArgs} msg The msg instance to transform.
+ * @return {!Object}
+ * @suppress {unusedLocalVariables} f is only used for nested messages
+ */
+proto.engine_api.ExportEnclaveArgs.toObject = function(includeInstance, msg) {
+  var f, obj = {
+    enclaveIdentifier: jspb.Message.getFieldWithDefault(msg, 1, "")
+  };
+
+  if (includeInstance) {
+    obj.$jspbMessageInstance = msg;
+  }
+  return obj;
+};
+}
+
+
+/**
+ * Deserializes binary data (in protobuf wire format).
+ * @param {jspb.ByteSource} bytes The bytes to deserialize.
+ * @return {!proto.engine_api.ExportEnclaveArgs}
+ */
+proto.engine_api.ExportEnclaveArgs.deserializeBinary = function(bytes) {
+  var reader = new jspb.BinaryReader(bytes);
+  var msg = new proto.engine_api.ExportEnclaveArgs;
+  return proto.engine_api.ExportEnclaveArgs.deserializeBinaryFromReader(msg, reader);
+};
+
+
+/**
+ * Deserializes binary data (in protobuf wire format) from the
+ * given reader into the given message object.
+ * @param {!proto.engine_api.ExportEnclaveArgs} msg The message object to deserialize into.
+ * @param {!jspb.BinaryReader} reader The BinaryReader to use.
+ * @return {!proto.engine_api.ExportEnclaveArgs}
+ */
+proto.engine_api.ExportEnclaveArgs.deserializeBinaryFromReader = function(msg, reader) {
+  while (reader.nextField()) {
+    if (reader.isEndGroup()) {
+      break;
+    }
+    var field = reader.getFieldNumber();
+    switch (field) {
+    case 1:
+      var value = /** @type {string} */ (reader.readString());
+      msg.setEnclaveIdentifier(value);
+      break;
+    default:
+      reader.skipField();
+      break;
+    }
+  }
+  return msg;
+};
+
+
+/**
+ * Serializes the message to binary data (in protobuf wire format).
+ * @return {!Uint8Array}
+ */
+proto.engine_api.ExportEnclaveArgs.prototype.serializeBinary = function() {
+  var writer = new jspb.BinaryWriter();
+  proto.engine_api.ExportEnclaveArgs.serializeBinaryToWriter(this, writer);
+  return writer.getResultBuffer();
+};
+
+
+/**
+ * Serializes the given message to binary data (in protobuf wire
+ * format), writing to the given BinaryWriter.
+ * @param {!proto.engine_api.ExportEnclaveArgs} message
+ * @param {!jspb.BinaryWriter} writer
+ * @suppress {unusedLocalVariables} f is only used for nested messages
+ */
+proto.engine_api.ExportEnclaveArgs.serializeBinaryToWriter = function(message, writer) {
+  var f = undefined;
+  f = message.getEnclaveIdentifier();
+  if (f.length > 0) {
+    writer.writeString(
+      1,
+      f
+    );
+  }
+};
+
+
+/**
+ * optional string enclave_identifier = 1;
+ * @return {string}
+ */
+proto.engine_api.ExportEnclaveArgs.prototype.getEnclaveIdentifier = function() {
+  return /** @type {string} */ (jspb.Message.getFieldWithDefault(this, 1, ""));
+};
+
+
+/**
+ * @param {string} value
+ * @return {!proto.engine_api.ExportEnclaveArgs} returns this
+ */
+proto.engine_api.ExportEnclaveArgs.prototype.setEnclaveIdentifier = function(value) {
+  return jspb.Message.setProto3StringField(this, 1, value);
+};
+
+
+
+
+
+if (jspb.Message.GENERATE_TO_OBJECT) {
+/**
+ * Creates an object representation of this proto.
+ * Field names that are reserved in JavaScript and will be renamed to pb_name.
+ * Optional fields that are not set will be set to undefined.
+ * To access a reserved field use, foo.pb_<name>, eg, foo.pb_default.
+ * For the list of reserved names please see:
+ *     net/proto2/compiler/js/internal/generator.cc#kKeyword.
+ * @param {boolean=} opt_includeInstance Deprecated. whether to include the
+ *     JSPB instance for transitional soy proto support:
+ *     http://goto/soy-param-migration
+ * @return {!Object}
+ */
+proto.engine_api.EnclaveArchiveChunk.prototype.toObject = function(opt_includeInstance) {
+  return proto.engine_api.EnclaveArchiveChunk.toObject(opt_includeInstance, this);
+};
+
+
+/**
+ * Static version of the {@see toObject} method.
+ * @param {boolean|undefined} includeInstance Deprecated. Whether to include
+ *     the JSPB instance for transitional soy proto support:
+ *     http://goto/soy-param-migration
+ * @param {!proto.engine_api.EnclaveArchiveChunk} msg The msg instance to transform.
+ * @return {!Object}
+ * @suppress {unusedLocalVariables} f is only used for nested messages
+ */
+proto.engine_api.EnclaveArchiveChunk.toObject = function(includeInstance, msg) {
+  var f, obj = {
+    data: msg.getData_asB64()
+  };
+
+  if (includeInstance) {
+    obj.$jspbMessageInstance = msg;
+  }
+  return obj;
+};
+}
+
+
+/**
+ * Deserializes binary data (in protobuf wire format).
+ * @param {jspb.ByteSource} bytes The bytes to deserialize.
+ * @return {!proto.engine_api.EnclaveArchiveChunk}
+ */
+proto.engine_api.EnclaveArchiveChunk.deserializeBinary = function(bytes) {
+  var reader = new jspb.BinaryReader(bytes);
+  var msg = new proto.engine_api.EnclaveArchiveChunk;
+  return proto.engine_api.EnclaveArchiveChunk.deserializeBinaryFromReader(msg, reader);
+};
+
+
+/**
+ * Deserializes binary data (in protobuf wire format) from the
+ * given reader into the given message object.
+ * @param {!proto.engine_api.EnclaveArchiveChunk} msg The message object to deserialize into.
+ * @param {!jspb.BinaryReader} reader The BinaryReader to use.
+ * @return {!proto.engine_api.EnclaveArchiveChunk}
+ */
+proto.engine_api.EnclaveArchiveChunk.deserializeBinaryFromReader = function(msg, reader) {
+  while (reader.nextField()) {
+    if (reader.isEndGroup()) {
+      break;
+    }
+    var field = reader.getFieldNumber();
+    switch (field) {
+    case 1:
+      var value = /** @type {!Uint8Array} */ (reader.readBytes());
+      msg.setData(value);
+      break;
+    default:
+      reader.skipField();
+      break;
+    }
+  }
+  return msg;
+};
+
+
+/**
+ * Serializes the message to binary data (in protobuf wire format).
+ * @return {!Uint8Array}
+ */
+proto.engine_api.EnclaveArchiveChunk.prototype.serializeBinary = function() {
+  var writer = new jspb.BinaryWriter();
+  proto.engine_api.EnclaveArchiveChunk.serializeBinaryToWriter(this, writer);
+  return writer.getResultBuffer();
+};
+
+
+/**
+ * Serializes the given message to binary data (in protobuf wire
+ * format), writing to the given BinaryWriter.
+ * @param {!proto.engine_api.EnclaveArchiveChunk} message
+ * @param {!jspb.BinaryWriter} writer
+ * @suppress {unusedLocalVariables} f is only used for nested messages
+ */
+proto.engine_api.EnclaveArchiveChunk.serializeBinaryToWriter = function(message, writer) {
+  var f = undefined;
+  f = message.getData_asU8();
+  if (f.length > 0) {
+    writer.writeBytes(
+      1,
+      f
+    );
+  }
+};
+
+
+/**
+ * optional bytes data = 1;
+ * @return {string}
+ */
+proto.engine_api.EnclaveArchiveChunk.prototype.getData = function() {
+  return /** @type {string} */ (jspb.Message.getFieldWithDefault(this, 1, ""));
+};
+
+
+/**
+ * optional bytes data = 1;
+ * This is a type-conversion wrapper around `getData()`
+ * @return {string}
+ */
+proto.engine_api.EnclaveArchiveChunk.prototype.getData_asB64 = function() {
+  return /** @type {string} */ (jspb.Message.bytesAsB64(
+      this.getData()));
+};
+
+
+/**
+ * optional bytes data = 1;
+ * Note that Uint8Array is not supported on all browsers.
+ * @see http://caniuse.com/Uint8Array
+ * This is a type-conversion wrapper around `getData()`
+ * @return {!Uint8Array}
+ */
+proto.engine_api.EnclaveArchiveChunk.prototype.getData_asU8 = function() {
+  return /** @type {!Uint8Array} */ (jspb.Message.bytesAsU8(
+      this.getData()));
+};
+
+
+/**
+ * @param {!(string|Uint8Array)} value
+ * @return {!proto.engine_api.EnclaveArchiveChunk} returns this
+ */
+proto.engine_api.EnclaveArchiveChunk.prototype.setData = function(value) {
+  return jspb.Message.setProto3BytesField(this, 1, value);
+};
+
+
+
+
+
+if (jspb.Message.GENERATE_TO_OBJECT) {
+/**
+ * Creates an object representation of this proto.
+ * Field names that are reserved in JavaScript and will be renamed to pb_name.
+ * Optional fields that are not set will be set to undefined.
+ * To access a reserved field use, foo.pb_<name>, eg, foo.pb_default.
+ * For the list of reserved names please see:
+ *     net/proto2/compiler/js/internal/generator.cc#kKeyword.
+ * @param {boolean=} opt_includeInstance Deprecated. whether to include the
+ *     JSPB instance for transitional soy proto support:
+ *     http://goto/soy-param-migration
+ * @return {!Object}
+ */
+proto.engine_api.ImportEnclaveArgs.prototype.toObject = function(opt_includeInstance) {
+  return proto.engine_api.ImportEnclaveArgs.toObject(opt_includeInstance, this);
+};
+
+
+/**
+ * Static version of the {@see toObject} method.
+ * @param {boolean|undefined} includeInstance Deprecated. Whether to include
+ *     the JSPB instance for transitional soy proto support:
+ *     http://goto/soy-param-migration
+ * @param {!proto.engine_api.ImportEnclaveArgs} msg The msg instance to transform.
+ * @return {!Object}
+ * @suppress {unusedLocalVariables} f is only used for nested messages
+ */
+proto.engine_api.ImportEnclaveArgs.toObject = function(includeInstance, msg) {
+  var f, obj = {
+    enclaveName: jspb.Message.getFieldWithDefault(msg, 1, ""),
+    data: msg.getData_asB64()
+  };
+
+  if (includeInstance) {
+    obj.$jspbMessageInstance = msg;
+  }
+  return obj;
+};
+}
+
+
+/**
+ * Deserializes binary data (in protobuf wire format).
+ * @param {jspb.ByteSource} bytes The bytes to deserialize.
+ * @return {!proto.engine_api.ImportEnclaveArgs}
+ */
+proto.engine_api.ImportEnclaveArgs.deserializeBinary = function(bytes) {
+  var reader = new jspb.BinaryReader(bytes);
+  var msg = new proto.engine_api.ImportEnclaveArgs;
+  return proto.engine_api.ImportEnclaveArgs.deserializeBinaryFromReader(msg, reader);
+};
+
+
+/**
+ * Deserializes binary data (in protobuf wire format) from the
+ * given reader into the given message object.
+ * @param {!proto.engine_api.ImportEnclaveArgs} msg The message object to deserialize into.
+ * @param {!jspb.BinaryReader} reader The BinaryReader to use.
+ * @return {!proto.engine_api.ImportEnclaveArgs}
+ */
+proto.engine_api.ImportEnclaveArgs.deserializeBinaryFromReader = function(msg, reader) {
+  while (reader.nextField()) {
+    if (reader.isEndGroup()) {
+      break;
+    }
+    var field = reader.getFieldNumber();
+    switch (field) {
+    case 1:
+      var value = /** @type {string} */ (reader.readString());
+      msg.setEnclaveName(value);
+      break;
+    case 2:
+      var value = /** @type {!Uint8Array} */ (reader.readBytes());
+      msg.setData(value);
+      break;
+    default:
+      reader.skipField();
+      break;
+    }
+  }
+  return msg;
+};
+
+
+/**
+ * Serializes the message to binary data (in protobuf wire format).
+ * @return {!Uint8Array}
+ */
+proto.engine_api.ImportEnclaveArgs.prototype.serializeBinary = function() {
+  var writer = new jspb.BinaryWriter();
+  proto.engine_api.ImportEnclaveArgs.serializeBinaryToWriter(this, writer);
+  return writer.getResultBuffer();
+};
+
+
+/**
+ * Serializes the given message to binary data (in protobuf wire
+ * format), writing to the given BinaryWriter.
+ * @param {!proto.engine_api.ImportEnclaveArgs} message
+ * @param {!jspb.BinaryWriter} writer
+ * @suppress {unusedLocalVariables} f is only used for nested messages
+ */
+proto.engine_api.ImportEnclaveArgs.serializeBinaryToWriter = function(message, writer) {
+  var f = undefined;
+  f = /** @type {string} */ (jspb.Message.getField(message, 1));
+  if (f != null) {
+    writer.writeString(
+      1,
+      f
+    );
+  }
+  f = message.getData_asU8();
+  if (f.length > 0) {
+    writer.writeBytes(
+      2,
+      f
+    );
+  }
+};
+
+
+/**
+ * optional string enclave_name = 1;
+ * @return {string}
+ */
+proto.engine_api.ImportEnclaveArgs.prototype.getEnclaveName = function() {
+  return /** @type {string} */ (jspb.Message.getFieldWithDefault(this, 1, ""));
+};
+
+
+/**
+ * @param {string} value
+ * @return {!proto.engine_api.ImportEnclaveArgs} returns this
+ */
+proto.engine_api.ImportEnclaveArgs.prototype.setEnclaveName = function(value) {
+  return jspb.Message.setField(this, 1, value);
+};
+
+
+/**
+ * Clears the field making it undefined.
+ * @return {!proto.engine_api.ImportEnclaveArgs} returns this
+ */
+proto.engine_api.ImportEnclaveArgs.prototype.clearEnclaveName = function() {
+  return jspb.Message.setField(this, 1, undefined);
+};
+
+
+/**
+ * Returns whether this field is set.
+ * @return {boolean}
+ */
+proto.engine_api.ImportEnclaveArgs.prototype.hasEnclaveName = function() {
+  return jspb.Message.getField(this, 1) != null;
+};
+
+
+/**
+ * optional bytes data = 2;
+ * @return {string}
+ */
+proto.engine_api.ImportEnclaveArgs.prototype.getData = function() {
+  return /** @type {string} */ (jspb.Message.getFieldWithDefault(this, 2, ""));
+};
+
+
+/**
+ * optional bytes data = 2;
+ * This is a type-conversion wrapper around `getData()`
+ * @return {string}
+ */
+proto.engine_api.ImportEnclaveArgs.prototype.getData_asB64 = function() {
+  return /** @type {string} */ (jspb.Message.bytesAsB64(
+      this.getData()));
+};
+
+
+/**
+ * optional bytes data = 2;
+ * Note that Uint8Array is not supported on all browsers.
+ * @see http://caniuse.com/Uint8Array
+ * This is a type-conversion wrapper around `getData()`
+ * @return {!Uint8Array}
+ */
+proto.engine_api.ImportEnclaveArgs.prototype.getData_asU8 = function() {
+  return /** @type {!Uint8Array} */ (jspb.Message.bytesAsU8(
+      this.getData()));
+};
+
+
+/**
+ * @param {!(string|Uint8Array)} value
+ * @return {!proto.engine_api.ImportEnclaveArgs} returns this
+ */
+proto.engine_api.ImportEnclaveArgs.prototype.setData = function(value) {
+  return jspb.Message.setProto3BytesField(this, 2, value);
+};
+
+
+
+/**
+ * List of repeated fields within this message type.
+ * @private {!Array<number>}
+ * @const
+ */
+proto.engine_api.ImportEnclaveResponse.repeatedFields_ = [2];
+
+
+
+if (jspb.Message.GENERATE_TO_OBJECT) {
+/**
+ * Creates an object representation of this proto.
+ * Field names that are reserved in JavaScript and will be renamed to pb_name.
+ * Optional fields that are not set will be set to undefined.
+ * To access a reserved field use, foo.pb_<name>, eg, foo.pb_default.
+ * For the list of reserved names please see:
+ *     net/proto2/compiler/js/internal/generator.cc#kKeyword.
+ * @param {boolean=} opt_includeInstance Deprecated. whether to include the
+ *     JSPB instance for transitional soy proto support:
+ *     http://goto/soy-param-migration
+ * @return {!Object}
+ */
+proto.engine_api.ImportEnclaveResponse.prototype.toObject = function(opt_includeInstance) {
+  return proto.engine_api.ImportEnclaveResponse.toObject(opt_includeInstance, this);
+};
+
+
+/**
+ * Static version of the {@see toObject} method.
+ * @param {boolean|undefined} includeInstance Deprecated. Whether to include
+ *     the JSPB instance for transitional soy proto support:
+ *     http://goto/soy-param-migration
+ * @param {!proto.engine_api.ImportEnclaveResponse} msg The msg instance to transform.
+ * @return {!Object}
+ * @suppress {unusedLocalVariables} f is only used for nested messages
+ */
+proto.engine_api.ImportEnclaveResponse.toObject = function(includeInstance, msg) {
+  var f, obj = {
+    enclaveInfo: (f = msg.getEnclaveInfo()) && proto.engine_api.EnclaveInfo.toObject(includeInstance, f),
+    unimportableItemsList: (f = jspb.Message.getRepeatedField(msg, 2)) == null ? undefined : f
+  };
+
+  if (includeInstance) {
+    obj.$jspbMessageInstance = msg;
+  }
+  return obj;
+};
+}
+
+
+/**
+ * Deserializes binary data (in protobuf wire format).
+ * @param {jspb.ByteSource} bytes The bytes to deserialize.
+ * @return {!proto.engine_api.ImportEnclaveResponse}
+ */
+proto.engine_api.ImportEnclaveResponse.deserializeBinary = function(bytes) {
+  var reader = new jspb.BinaryReader(bytes);
+  var msg = new proto.engine_api.ImportEnclaveResponse;
+  return proto.engine_api.ImportEnclaveResponse.deserializeBinaryFromReader(msg, reader);
+};
+
+
+/**
+ * Deserializes binary data (in protobuf wire format) from the
+ * given reader into the given message object.
+ * @param {!proto.engine_api.ImportEnclaveResponse} msg The message object to deserialize into.
+ * @param {!jspb.BinaryReader} reader The BinaryReader to use.
+ * @return {!proto.engine_api.ImportEnclaveResponse}
+ */
+proto.engine_api.ImportEnclaveResponse.deserializeBinaryFromReader = function(msg, reader) {
+  while (reader.nextField()) {
+    if (reader.isEndGroup()) {
+      break;
+    }
+    var field = reader.getFieldNumber();
+    switch (field) {
+    case 1:
+      var value = new proto.engine_api.EnclaveInfo;
+      reader.readMessage(value,proto.engine_api.EnclaveInfo.deserializeBinaryFromReader);
+      msg.setEnclaveInfo(value);
+      break;
+    case 2:
+      var value = /** @type {string} */ (reader.readString());
+      msg.addUnimportableItems(value);
+      break;
+    default:
+      reader.skipField();
+      break;
+    }
+  }
+  return msg;
+};
+
+
+/**
+ * Serializes the message to binary data (in protobuf wire format).
+ * @return {!Uint8Array}
+ */
+proto.engine_api.ImportEnclaveResponse.prototype.serializeBinary = function() {
+  var writer = new jspb.BinaryWriter();
+  proto.engine_api.ImportEnclaveResponse.serializeBinaryToWriter(this, writer);
+  return writer.getResultBuffer();
+};
+
+
+/**
+ * Serializes the given message to binary data (in protobuf wire
+ * format), writing to the given BinaryWriter.
+ * @param {!proto.engine_api.ImportEnclaveResponse} message
+ * @param {!jspb.BinaryWriter} writer
+ * @suppress {unusedLocalVariables} f is only used for nested messages
+ */
+proto.engine_api.ImportEnclaveResponse.serializeBinaryToWriter = function(message, writer) {
+  var f = undefined;
+  f = message.getEnclaveInfo();
+  if (f != null) {
+    writer.writeMessage(
+      1,
+      f,
+      proto.engine_api.EnclaveInfo.serializeBinaryToWriter
+    );
+  }
+  f = message.getUnimportableItemsList();
+  if (f.length > 0) {
+    writer.writeRepeatedString(
+      2,
+      f
+    );
+  }
+};
+
+
+/**
+ * optional EnclaveInfo enclave_info = 1;
+ * @return {?proto.engine_api.EnclaveInfo}
+ */
+proto.engine_api.ImportEnclaveResponse.prototype.getEnclaveInfo = function() {
+  return /** @type{?proto.engine_api.EnclaveInfo} */ (
+    jspb.Message.getWrapperField(this, proto.engine_api.EnclaveInfo, 1));
+};
+
+
+/**
+ * @param {?proto.engine_api.EnclaveInfo|undefined} value
+ * @return {!proto.engine_api.ImportEnclaveResponse} returns this
+*/
+proto.engine_api.ImportEnclaveResponse.prototype.setEnclaveInfo = function(value) {
+  return jspb.Message.setWrapperField(this, 1, value);
+};
+
+
+/**
+ * Clears the message field making it undefined.
+ * @return {!proto.engine_api.ImportEnclaveResponse} returns this
+ */
+proto.engine_api.ImportEnclaveResponse.prototype.clearEnclaveInfo = function() {
+  return this.setEnclaveInfo(undefined);
+};
+
+
+/**
+ * Returns whether this field is set.
+ * @return {boolean}
+ */
+proto.engine_api.ImportEnclaveResponse.prototype.hasEnclaveInfo = function() {
+  return jspb.Message.getField(this, 1) != null;
+};
+
+
+/**
+ * repeated string unimportable_items = 2;
+ * @return {!Array<string>}
+ */
+proto.engine_api.ImportEnclaveResponse.prototype.getUnimportableItemsList = function() {
+  return /** @type {!Array<string>} */ (jspb.Message.getRepeatedField(this, 2));
+};
+
+
+/**
+ * @param {!Array<string>} value
+ * @return {!proto.engine_api.ImportEnclaveResponse} returns this
+ */
+proto.engine_api.ImportEnclaveResponse.prototype.setUnimportableItemsList = function(value) {
+  return jspb.Message.setField(this, 2, value || []);
+};
+
+
+/**
+ * @param {string} value
+ * @param {number=} opt_index
+ * @return {!proto.engine_api.ImportEnclaveResponse} returns this
+ */
+proto.engine_api.ImportEnclaveResponse.prototype.addUnimportableItems = function(value, opt_index) {
+  return jspb.Message.addToRepeatedField(this, 2, value, opt_index);
+};
+
+
+/**
+ * Clears the list making it empty but non-null.
+ * @return {!proto.engine_api.ImportEnclaveResponse} returns this
+ */
+proto.engine_api.ImportEnclaveResponse.prototype.clearUnimportableItemsList = function() {
+  return this.setUnimportableItemsList([]);
+};
+
+
+
+/**
+ * List of repeated fields within this message type.
+ * @private {!Array<number>}
+ * @const
+ */
+proto.engine_api.GetEnclavesByUuidsArgs.repeatedFields_ = [1];
+
+
+
+if (jspb.Message.GENERATE_TO_OBJECT) {
+/**
+ * Creates an object representation of this proto.
+ * Field names that are reserved in JavaScript and will be renamed to pb_name.
+ * Optional fields that are not set will be set to undefined.
+ * To access a reserved field use, foo.pb_<name>, eg, foo.pb_default.
+ * For the list of reserved names please see:
+ *     net/proto2/compiler/js/internal/generator.cc#kKeyword.
+ * @param {boolean=} opt_includeInstance Deprecated. whether to include the
+ *     JSPB instance for transitional soy proto support:
+ *     http://goto/soy-param-migration
+ * @return {!Object}
+ */
+proto.engine_api.GetEnclavesByUuidsArgs.prototype.toObject = function(opt_includeInstance) {
+  return proto.engine_api.GetEnclavesByUuidsArgs.toObject(opt_includeInstance, this);
+};
+
+
+/**
+ * Static version of the {@see toObject} method.
+ * @param {boolean|undefined} includeInstance Deprecated. Whether to include
+ *     the JSPB instance for transitional soy proto support:
+ *     http://goto/soy-param-migration
+ * @param {!proto.engine_api.GetEnclavesByUuidsArgs} msg The msg instance to transform.
+ * @return {!Object}
+ * @suppress {unusedLocalVariables} f is only used for nested messages
+ */
+proto.engine_api.GetEnclavesByUuidsArgs.toObject = function(includeInstance, msg) {
+  var f, obj = {
+    enclaveUuidsList: (f = jspb.Message.getRepeatedField(msg, 1)) == null ? undefined : f
+  };
+
+  if (includeInstance) {
+    obj.$jspbMessageInstance = msg;
+  }
+  return obj;
+};
+}
+
+
+/**
+ * Deserializes binary data (in protobuf wire format).
+ * @param {jspb.ByteSource} bytes The bytes to deserialize.
+ * @return {!proto.engine_api.GetEnclavesByUuidsArgs}
+ */
+proto.engine_api.GetEnclavesByUuidsArgs.deserializeBinary = function(bytes) {
+  var reader = new jspb.BinaryReader(bytes);
+  var msg = new proto.engine_api.GetEnclavesByUuidsArgs;
+  return proto.engine_api.GetEnclavesByUuidsArgs.deserializeBinaryFromReader(msg, reader);
+};
+
+
+/**
+ * Deserializes binary data (in protobuf wire format) from the
+ * given reader into the given message object.
+ * @param {!proto.engine_api.GetEnclavesByUuidsArgs} msg The message object to deserialize into.
+ * @param {!jspb.BinaryReader} reader The BinaryReader to use.
+ * @return {!proto.engine_api.GetEnclavesByUuidsArgs}
+ */
+proto.engine_api.GetEnclavesByUuidsArgs.deserializeBinaryFromReader = function(msg, reader) {
+  while (reader.nextField()) {
+    if (reader.isEndGroup()) {
+      break;
+    }
+    var field = reader.getFieldNumber();
+    switch (field) {
+    case 1:
+      var value = /** @type {string} */ (reader.readString());
+      msg.addEnclaveUuids(value);
+      break;
+    default:
+      reader.skipField();
+      break;
+    }
+  }
+  return msg;
+};
+
+
+/**
+ * Serializes the message to binary data (in protobuf wire format).
+ * @return {!Uint8Array}
+ */
+proto.engine_api.GetEnclavesByUuidsArgs.prototype.serializeBinary = function() {
+  var writer = new jspb.BinaryWriter();
+  proto.engine_api.GetEnclavesByUuidsArgs.serializeBinaryToWriter(this, writer);
+  return writer.getResultBuffer();
+};
+
+
+/**
+ * Serializes the given message to binary data (in protobuf wire
+ * format), writing to the given BinaryWriter.
+ * @param {!proto.engine_api.GetEnclavesByUuidsArgs} message
+ * @param {!jspb.BinaryWriter} writer
+ * @suppress {unusedLocalVariables} f is only used for nested messages
+ */
+proto.engine_api.GetEnclavesByUuidsArgs.serializeBinaryToWriter = function(message, writer) {
+  var f = undefined;
+  f = message.getEnclaveUuidsList();
+  if (f.length > 0) {
+    writer.writeRepeatedString(
+      1,
+      f
+    );
+  }
+};
+
+
+/**
+ * repeated string enclave_uuids = 1;
+ * @return {!Array<string>}
+ */
+proto.engine_api.GetEnclavesByUuidsArgs.prototype.getEnclaveUuidsList = function() {
+  return /** @type {!Array<string>} */ (jspb.Message.getRepeatedField(this, 1));
+};
+
+
+/**
+ * @param {!Array<string>} value
+ * @return {!proto.engine_api.GetEnclavesByUuidsArgs} returns this
+ */
+proto.engine_api.GetEnclavesByUuidsArgs.prototype.setEnclaveUuidsList = function(value) {
+  return jspb.Message.setField(this, 1, value || []);
+};
+
+
+/**
+ * @param {string} value
+ * @param {number=} opt_index
+ * @return {!proto.engine_api.GetEnclavesByUuidsArgs} returns this
+ */
+proto.engine_api.GetEnclavesByUuidsArgs.prototype.addEnclaveUuids = function(value, opt_index) {
+  return jspb.Message.addToRepeatedField(this, 1, value, opt_index);
+};
+
+
+/**
+ * Clears the list making it empty but non-null.
+ * @return {!proto.engine_api.GetEnclavesByUuidsArgs} returns this
+ */
+proto.engine_api.GetEnclavesByUuidsArgs.prototype.clearEnclaveUuidsList = function() {
+  return this.setEnclaveUuidsList([]);
+};
+
+
+
+
+
+if (jspb.Message.GENERATE_TO_OBJECT) {
+/**
+ * Creates an object representation of this proto.
+ * Field names that are reserved in JavaScript and will be renamed to pb_name.
+ * Optional fields that are not set will be set to undefined.
+ * To access a reserved field use, foo.pb_<name>, eg, foo.pb_default.
+ * For the list of reserved names please see:
+ *     net/proto2/compiler/js/internal/generator.cc#kKeyword.
+ * @param {boolean=} opt_includeInstance Deprecated. whether to include the
+ *     JSPB instance for transitional soy proto support:
+ *     http://goto/soy-param-migration
+ * @return {!Object}
+ */
+proto.engine_api.DestroyEnclaveArgs.prototype.toObject = function(opt_includeInstance) {
+  return proto.engine_api.DestroyEnclaveArgs.toObject(opt_includeInstance, this);
+};
+
+
+/**
+ * Static version of the {@see toObject} method.
+ * @param {boolean|undefined} includeInstance Deprecated. Whether to include
+ *     the JSPB instance for transitional soy proto support:
+ *     http://goto/soy-param-migration
+ * @param {!proto.engine_api.DestroyEnclaveArgs} msg The msg instance to transform.
+ * @return {!Object}
+ * @suppress {unusedLocalVariables} f is only used for nested messages
+ */
+proto.engine_api.DestroyEnclaveArgs.toObject = function(includeInstance, msg) {
+  var f, obj = {
+    enclaveIdentifier: jspb.Message.getFieldWithDefault(msg, 1, "")
+  };
+
+  if (includeInstance) {
+    obj.$jspbMessageInstance = msg;
+  }
+  return obj;
+};
+}
+
+
+/**
+ * Deserializes binary data (in protobuf wire format).
+ * @param {jspb.ByteSource} bytes The bytes to deserialize.
+ * @return {!proto.engine_api.DestroyEnclaveArgs}
+ */
+proto.engine_api.DestroyEnclaveArgs.deserializeBinary = function(bytes) {
+  var reader = new jspb.BinaryReader(bytes);
+  var msg = new proto.engine_api.DestroyEnclaveArgs;
+  return proto.engine_api.DestroyEnclaveArgs.deserializeBinaryFromReader(msg, reader);
+};
+
+
+/**
+ * Deserializes binary data (in protobuf wire format) from the
+ * given reader into the given message object.
+ * @param {!proto.engine_api.DestroyEnclaveArgs} msg The message object to deserialize into.
+ * @param {!jspb.BinaryReader} reader The BinaryReader to use.
+ * @return {!proto.engine_api.DestroyEnclaveArgs}
+ */
+proto.engine_api.DestroyEnclaveArgs.deserializeBinaryFromReader = function(msg, reader) {
+  while (reader.nextField()) {
+    if (reader.isEndGroup()) {
+      break;
+    }
+    var field = reader.getFieldNumber();
+    switch (field) {
+    case 1:
+      var value = /** @type {string} */ (reader.readString());
+      msg.setEnclaveIdentifier(value);
+      break;
+    default:
+      reader.skipField();
+      break;
+    }
+  }
+  return msg;
+};
+
+
+/**
+ * Serializes the message to binary data (in protobuf wire format).
+ * @return {!Uint8Array}
+ */
+proto.engine_api.DestroyEnclaveArgs.prototype.serializeBinary = function() {
+  var writer = new jspb.BinaryWriter();
+  proto.engine_api.DestroyEnclaveArgs.serializeBinaryToWriter(this, writer);
+  return writer.getResultBuffer();
+};
+
+
+/**
+ * Serializes the given message to binary data (in protobuf wire
+ * format), writing to the given BinaryWriter.
+ * @param {!proto.engine_api.DestroyEnclaveArgs} message
+ * @param {!jspb.BinaryWriter} writer
+ * @suppress {unusedLocalVariables} f is only used for nested messages
+ */
+proto.engine_api.DestroyEnclaveArgs.serializeBinaryToWriter = function(message, writer) {
+  var f = undefined;
+  f = message.getEnclaveIdentifier();
+  if (f.length > 0) {
+    writer.writeString(
+      1,
+      f
+    );
+  }
+};
+
+
+/**
+ * optional string enclave_identifier = 1;
+ * @return {string}
+ */
+proto.engine_api.DestroyEnclaveArgs.prototype.getEnclaveIdentifier = function() {
+  return /** @type {string} */ (jspb.Message.getFieldWithDefault(this, 1, ""));
+};
+
+
+/**
+ * @param {string} value
+ * @return {!proto.engine_api.DestroyEnclaveArgs} returns this
+ */
+proto.engine_api.DestroyEnclaveArgs.prototype.setEnclaveIdentifier = function(value) {
+  return jspb.Message.setProto3StringField(this, 1, value);
+};
+
+
+
+
+
+if (jspb.Message.GENERATE_TO_OBJECT) {
+/**
+ * Creates an object representation of this proto.
+ * Field names that are reserved in JavaScript and will be renamed to pb_name.
+ * Optional fields that are not set will be set to undefined.
+ * To access a reserved field use, foo.pb_<name>, eg, foo.pb_default.
+ * For the list of reserved names please see:
+ *     net/proto2/compiler/js/internal/generator.cc#kKeyword.
+ * @param {boolean=} opt_includeInstance Deprecated. whether to include the
+ *     JSPB instance for transitional soy proto support:
+ *     http://goto/soy-param-migration
+ * @return {!Object}
+ */
+proto.engine_api.CleanArgs.prototype.toObject = function(opt_includeInstance) {
+  return proto.engine_api.CleanArgs.toObject(opt_includeInstance, this);
+};
+
+
+/**
+ * Static version of the {@see toObject} method.
+ * @param {boolean|undefined} includeInstance Deprecated. Whether to include
+ *     the JSPB instance for transitional soy proto support:
+ *     http://goto/soy-param-migration
+ * @param {!proto.engine_api.CleanArgs} msg The msg instance to transform.
+ * @return {!Object}
+ * @suppress {unusedLocalVariables} f is only used for nested messages
+ */
+proto.engine_api.CleanArgs.toObject = function(includeInstance, msg) {
+  var f, obj = {
+    shouldCleanAll: jspb.Message.getBooleanFieldWithDefault(msg, 1, false),
+    labelSelectorMap: (f = msg.getLabelSelectorMap()) ? f.toObject(includeInstance, undefined) : []
+  };
+
+  if (includeInstance) {
+    obj.$jspbMessageInstance = msg;
+  }
+  return obj;
+};
+}
+
+
+/**
+ * Deserializes binary data (in protobuf wire format).
+ * @param {jspb.ByteSource} bytes The bytes to deserialize.
+ * @return {!proto.engine_api.CleanArgs}
+ */
+proto.engine_api.CleanArgs.deserializeBinary = function(bytes) {
+  var reader = new jspb.BinaryReader(bytes);
+  var msg = new proto.engine_api.CleanArgs;
+  return proto.engine_api.CleanArgs.deserializeBinaryFromReader(msg, reader);
+};
+
+
+/**
+ * Deserializes binary data (in protobuf wire format) from the
+ * given reader into the given message object.
+ * @param {!proto.engine_api.CleanArgs} msg The message object to deserialize into.
+ * @param {!jspb.BinaryReader} reader The BinaryReader to use.
+ * @return {!proto.engine_api.CleanArgs}
+ */
+proto.engine_api.CleanArgs.deserializeBinaryFromReader = function(msg, reader) {
+  while (reader.nextField()) {
+    if (reader.isEndGroup()) {
+      break;
+    }
+    var field = reader.getFieldNumber();
+    switch (field) {
+    case 1:
+      var value = /** @type {boolean} */ (reader.readBool());
+      msg.setShouldCleanAll(value);
+      break;
+    case 2:
+      var value = msg.getLabelSelectorMap();
+      reader.readMessage(value, function(message, reader) {
+        jspb.Map.deserializeBinary(message, reader, jspb.BinaryReader.prototype.readString, jspb.BinaryReader.prototype.readString, null, "", "");
+         });
+      break;
+    default:
+      reader.skipField();
+      break;
+    }
+  }
+  return msg;
+};
+
+
+/**
+ * Serializes the message to binary data (in protobuf wire format).
+ * @return {!Uint8Array}
+ */
+proto.engine_api.CleanArgs.prototype.serializeBinary = function() {
+  var writer = new jspb.BinaryWriter();
+  proto.engine_api.CleanArgs.serializeBinaryToWriter(this, writer);
+  return writer.getResultBuffer();
+};
+
+
+/**
+ * Serializes the given message to binary data (in protobuf wire
+ * format), writing to the given BinaryWriter.
+ * @param {!proto.engine_api.CleanArgs} message
+ * @param {!jspb.BinaryWriter} writer
+ * @suppress {unusedLocalVariables} f is only used for nested messages
+ */
+proto.engine_api.CleanArgs.serializeBinaryToWriter = function(message, writer) {
+  var f = undefined;
+  f = /** @type {boolean} */ (jspb.Message.getField(message, 1));
+  if (f != null) {
+    writer.writeBool(
+      1,
+      f
+    );
+  }
+  f = message.getLabelSelectorMap(true);
+  if (f && f.getLength() > 0) {
+    f.serializeBinary(2, writer, jspb.BinaryWriter.prototype.writeString, jspb.BinaryWriter.prototype.writeString);
+  }
+};
+
+
+/**
+ * optional bool should_clean_all = 1;
+ * @return {boolean}
+ */
+proto.engine_api.CleanArgs.prototype.getShouldCleanAll = function() {
+  return /** @type {boolean} */ (jspb.Message.getBooleanFieldWithDefault(this, 1, false));
+};
+
+
+/**
+ * @param {boolean} value
+ * @return {!proto.engine_api.CleanArgs} returns this
+ */
+proto.engine_api.CleanArgs.prototype.setShouldCleanAll = function(value) {
+  return jspb.Message.setField(this, 1, value);
+};
+
+
+/**
+ * Clears the field making it undefined.
+ * @return {!proto.engine_api.CleanArgs} returns this
+ */
+proto.engine_api.CleanArgs.prototype.clearShouldCleanAll = function() {
+  return jspb.Message.setField(this, 1, undefined);
+};
+
+
+/**
+ * Returns whether this field is set.
+ * @return {boolean}
+ */
+proto.engine_api.CleanArgs.prototype.hasShouldCleanAll = function() {
+  return jspb.Message.getField(this, 1) != null;
+};
+
+
+/**
+ * map<string, string> label_selector = 2;
+ * @param {boolean=} opt_noLazyCreate Do not create the map if
+ * empty, instead returning `undefined`
+ * @return {!jspb.Map<string,string>}
+ */
+proto.engine_api.CleanArgs.prototype.getLabelSelectorMap = function(opt_noLazyCreate) {
+  return /** @type {!jspb.Map<string,string>} */ (
+      jspb.Message.getMapField(this, 2, opt_noLazyCreate,
+      null));
+};
+
+
+/**
+ * Clears values from the map. The map will be non-null.
+ * @return {!proto.engine_api.CleanArgs} returns this
+ */
+proto.engine_api.CleanArgs.prototype.clearLabelSelectorMap = function() {
+  this.getLabelSelectorMap().clear();
+  return this;};
+
+
+
+
+
+if (jspb.Message.GENERATE_TO_OBJECT) {
 /**
  * Creates an object representation of this proto.
  * Field names that are reserved in JavaScript and will be renamed to pb_name.
@@ -1084,8 +6025,8 @@ if (jspb.Message.GENERATE_TO_OBJECT) {
  *     http://goto/soy-param-migration
  * @return {!Object}
  */
-proto.engine_api.EnclaveAPIContainerInfo.prototype.toObject = function(opt_includeInstance) {
-  return proto.engine_api.EnclaveAPIContainerInfo.toObject(opt_includeInstance, this);
+proto.engine_api.EnclaveNameAndUuid.prototype.toObject = function(opt_includeInstance) {
+  return proto.engine_api.EnclaveNameAndUuid.toObject(opt_includeInstance, this);
 };
 
 
@@ -1094,16 +6035,14 @@ proto.engine_api.EnclaveAPIContainerInfo.prototype.toObject = function(opt_inclu
  * @param {boolean|undefined} includeInstance Deprecated. Whether to include
  *     the JSPB instance for transitional soy proto support:
  *     http://goto/soy-param-migration
- * @param {!proto.engine_api.EnclaveAPIContainerInfo} msg The msg instance to transform.
+ * @param {!proto.engine_api.EnclaveNameAndUuid} msg The msg instance to transform.
  * @return {!Object}
  * @suppress {unusedLocalVariables} f is only used for nested messages
  */
-proto.engine_api.EnclaveAPIContainerInfo.toObject = function(includeInstance, msg) {
+proto.engine_api.EnclaveNameAndUuid.toObject = function(includeInstance, msg) {
   var f, obj = {
-    containerId: jspb.Message.getFieldWithDefault(msg, 1, ""),
-    ipInsideEnclave: jspb.Message.getFieldWithDefault(msg, 2, ""),
-    grpcPortInsideEnclave: jspb.Message.getFieldWithDefault(msg, 3, 0),
-    bridgeIpAddress: jspb.Message.getFieldWithDefault(msg, 6, "")
+    name: jspb.Message.getFieldWithDefault(msg, 1, ""),
+    uuid: jspb.Message.getFieldWithDefault(msg, 2, "")
   };
 
   if (includeInstance) {
@@ -1117,23 +6056,23 @@ proto.engine_api.EnclaveAPIContainerInfo.toObject = function(includeInstance, ms
 /**
  * Deserializes binary data (in protobuf wire format).
  * @param {jspb.ByteSource} bytes The bytes to deserialize.
- * @return {!proto.engine_api.EnclaveAPIContainerInfo}
+ * @return {!proto.engine_api.EnclaveNameAndUuid}
  */
-proto.engine_api.EnclaveAPIContainerInfo.deserializeBinary = function(bytes) {
+proto.engine_api.EnclaveNameAndUuid.deserializeBinary = function(bytes) {
   var reader = new jspb.BinaryReader(bytes);
-  var msg = new proto.engine_api.EnclaveAPIContainerInfo;
-  return proto.engine_api.EnclaveAPIContainerInfo.deserializeBinaryFromReader(msg, reader);
+  var msg = new proto.engine_api.EnclaveNameAndUuid;
+  return proto.engine_api.EnclaveNameAndUuid.deserializeBinaryFromReader(msg, reader);
 };
 
 
 /**
  * Deserializes binary data (in protobuf wire format) from the
  * given reader into the given message object.
- * @param {!proto.engine_api.EnclaveAPIContainerInfo} msg The message object to deserialize into.
+ * @param {!proto.engine_api.EnclaveNameAndUuid} msg The message object to deserialize into.
  * @param {!jspb.BinaryReader} reader The BinaryReader to use.
- * @return {!proto.engine_api.EnclaveAPIContainerInfo}
+ * @return {!proto.engine_api.EnclaveNameAndUuid}
  */
-proto.engine_api.EnclaveAPIContainerInfo.deserializeBinaryFromReader = function(msg, reader) {
+proto.engine_api.EnclaveNameAndUuid.deserializeBinaryFromReader = function(msg, reader) {
   while (reader.nextField()) {
     if (reader.isEndGroup()) {
       break;
@@ -1142,19 +6081,11 @@ proto.engine_api.EnclaveAPIContainerInfo.deserializeBinaryFromReader = function(
     switch (field) {
     case 1:
       var value = /** @type {string} */ (reader.readString());
-      msg.setContainerId(value);
+      msg.setName(value);
       break;
     case 2:
       var value = /** @type {string} */ (reader.readString());
-      msg.setIpInsideEnclave(value);
-      break;
-    case 3:
-      var value = /** @type {number} */ (reader.readUint32());
-      msg.setGrpcPortInsideEnclave(value);
-      break;
-    case 6:
-      var value = /** @type {string} */ (reader.readString());
-      msg.setBridgeIpAddress(value);
+      msg.setUuid(value);
       break;
     default:
       reader.skipField();
@@ -1169,9 +6100,9 @@ proto.engine_api.EnclaveAPIContainerInfo.deserializeBinaryFromReader = function(
  * Serializes the message to binary data (in protobuf wire format).
  * @return {!Uint8Array}
  */
-proto.engine_api.EnclaveAPIContainerInfo.prototype.serializeBinary = function() {
+proto.engine_api.EnclaveNameAndUuid.prototype.serializeBinary = function() {
   var writer = new jspb.BinaryWriter();
-  proto.engine_api.EnclaveAPIContainerInfo.serializeBinaryToWriter(this, writer);
+  proto.engine_api.EnclaveNameAndUuid.serializeBinaryToWriter(this, writer);
   return writer.getResultBuffer();
 };
 
@@ -1179,116 +6110,73 @@ proto.engine_api.EnclaveAPIContainerInfo.prototype.serializeBinary = function()
 /**
  * Serializes the given message to binary data (in protobuf wire
  * format), writing to the given BinaryWriter.
- * @param {!proto.engine_api.EnclaveAPIContainerInfo} message
+ * @param {!proto.engine_api.EnclaveNameAndUuid} message
  * @param {!jspb.BinaryWriter} writer
  * @suppress {unusedLocalVariables} f is only used for nested messages
  */
-proto.engine_api.EnclaveAPIContainerInfo.serializeBinaryToWriter = function(message, writer) {
+proto.engine_api.EnclaveNameAndUuid.serializeBinaryToWriter = function(message, writer) {
   var f = undefined;
-  f = message.getContainerId();
+  f = message.getName();
   if (f.length > 0) {
     writer.writeString(
       1,
       f
     );
   }
-  f = message.getIpInsideEnclave();
+  f = message.getUuid();
   if (f.length > 0) {
     writer.writeString(
       2,
       f
     );
   }
-  f = message.getGrpcPortInsideEnclave();
-  if (f !== 0) {
-    writer.writeUint32(
-      3,
-      f
-    );
-  }
-  f = message.getBridgeIpAddress();
-  if (f.length > 0) {
-    writer.writeString(
-      6,
-      f
-    );
-  }
 };
 
 
 /**
- * optional string container_id = 1;
+ * optional string name = 1;
  * @return {string}
  */
-proto.engine_api.EnclaveAPIContainerInfo.prototype.getContainerId = function() {
+proto.engine_api.EnclaveNameAndUuid.prototype.getName = function() {
   return /** @type {string} */ (jspb.Message.getFieldWithDefault(this, 1, ""));
 };
 
 
 /**
  * @param {string} value
- * @return {!proto.engine_api.EnclaveAPIContainerInfo} returns this
+ * @return {!proto.engine_api.EnclaveNameAndUuid} returns this
  */
-proto.engine_api.EnclaveAPIContainerInfo.prototype.setContainerId = function(value) {
+proto.engine_api.EnclaveNameAndUuid.prototype.setName = function(value) {
   return jspb.Message.setProto3StringField(this, 1, value);
 };
 
 
 /**
- * optional string ip_inside_enclave = 2;
+ * optional string uuid = 2;
  * @return {string}
  */
-proto.engine_api.EnclaveAPIContainerInfo.prototype.getIpInsideEnclave = function() {
+proto.engine_api.EnclaveNameAndUuid.prototype.getUuid = function() {
   return /** @type {string} */ (jspb.Message.getFieldWithDefault(this, 2, ""));
 };
 
 
 /**
  * @param {string} value
- * @return {!proto.engine_api.EnclaveAPIContainerInfo} returns this
- */
-proto.engine_api.EnclaveAPIContainerInfo.prototype.setIpInsideEnclave = function(value) {
-  return jspb.Message.setProto3StringField(this, 2, value);
-};
-
-
-/**
- * optional uint32 grpc_port_inside_enclave = 3;
- * @return {number}
- */
-proto.engine_api.EnclaveAPIContainerInfo.prototype.getGrpcPortInsideEnclave = function() {
-  return /** @type {number} */ (jspb.Message.getFieldWithDefault(this, 3, 0));
-};
-
-
-/**
- * @param {number} value
- * @return {!proto.engine_api.EnclaveAPIContainerInfo} returns this
- */
-proto.engine_api.EnclaveAPIContainerInfo.prototype.setGrpcPortInsideEnclave = function(value) {
-  return jspb.Message.setProto3IntField(this, 3, value);
-};
-
-
-/**
- * optional string bridge_ip_address = 6;
- * @return {string}
- */
-proto.engine_api.EnclaveAPIContainerInfo.prototype.getBridgeIpAddress = function() {
-  return /** @type {string} */ (jspb.Message.getFieldWithDefault(this, 6, ""));
-};
-
-
-/**
- * @param {string} value
- * @return {!proto.engine_api.EnclaveAPIContainerInfo} returns this
+ * @return {!proto.engine_api.EnclaveNameAndUuid} returns this
  */
-proto.engine_api.EnclaveAPIContainerInfo.prototype.setBridgeIpAddress = function(value) {
-  return jspb.Message.setProto3StringField(this, 6, value);
+proto.engine_api.EnclaveNameAndUuid.prototype.setUuid = function(value) {
+  return jspb.Message.setProto3StringField(this, 2, value);
 };
 
 
 
+/**
+ * List of repeated fields within this message type.
+ * @private {!Array<number>}
+ * @const
+ */
+proto.engine_api.CleanResponse.repeatedFields_ = [1];
+
 
 
 if (jspb.Message.GENERATE_TO_OBJECT) {
@@ -1304,8 +6192,8 @@ if (jspb.Message.GENERATE_TO_OBJECT) {
  *     http://goto/soy-param-migration
  * @return {!Object}
  */
-proto.engine_api.EnclaveAPIContainerHostMachineInfo.prototype.toObject = function(opt_includeInstance) {
-  return proto.engine_api.EnclaveAPIContainerHostMachineInfo.toObject(opt_includeInstance, this);
+proto.engine_api.CleanResponse.prototype.toObject = function(opt_includeInstance) {
+  return proto.engine_api.CleanResponse.toObject(opt_includeInstance, this);
 };
 
 
@@ -1314,14 +6202,14 @@ proto.engine_api.EnclaveAPIContainerHostMachineInfo.prototype.toObject = functio
  * @param {boolean|undefined} includeInstance Deprecated. Whether to include
  *     the JSPB instance for transitional soy proto support:
  *     http://goto/soy-param-migration
- * @param {!proto.engine_api.EnclaveAPIContainerHostMachineInfo} msg The msg instance to transform.
+ * @param {!proto.engine_api.CleanResponse} msg The msg instance to transform.
  * @return {!Object}
  * @suppress {unusedLocalVariables} f is only used for nested messages
  */
-proto.engine_api.EnclaveAPIContainerHostMachineInfo.toObject = function(includeInstance, msg) {
+proto.engine_api.CleanResponse.toObject = function(includeInstance, msg) {
   var f, obj = {
-    ipOnHostMachine: jspb.Message.getFieldWithDefault(msg, 4, ""),
-    grpcPortOnHostMachine: jspb.Message.getFieldWithDefault(msg, 5, 0)
+    removedEnclaveNameAndUuidsList: jspb.Message.toObjectList(msg.getRemovedEnclaveNameAndUuidsList(),
+    proto.engine_api.EnclaveNameAndUuid.toObject, includeInstance)
   };
 
   if (includeInstance) {
@@ -1335,36 +6223,33 @@ proto.engine_api.EnclaveAPIContainerHostMachineInfo.toObject = function(includeI
 /**
  * Deserializes binary data (in protobuf wire format).
  * @param {jspb.ByteSource} bytes The bytes to deserialize.
- * @return {!proto.engine_api.EnclaveAPIContainerHostMachineInfo}
+ * @return {!proto.engine_api.CleanResponse}
  */
-proto.engine_api.EnclaveAPIContainerHostMachineInfo.deserializeBinary = function(bytes) {
+proto.engine_api.CleanResponse.deserializeBinary = function(bytes) {
   var reader = new jspb.BinaryReader(bytes);
-  var msg = new proto.engine_api.EnclaveAPIContainerHostMachineInfo;
-  return proto.engine_api.EnclaveAPIContainerHostMachineInfo.deserializeBinaryFromReader(msg, reader);
+  var msg = new proto.engine_api.CleanResponse;
+  return proto.engine_api.CleanResponse.deserializeBinaryFromReader(msg, reader);
 };
 
 
 /**
  * Deserializes binary data (in protobuf wire format) from the
  * given reader into the given message object.
- * @param {!proto.engine_api.EnclaveAPIContainerHostMachineInfo} msg The message object to deserialize into.
+ * @param {!proto.engine_api.CleanResponse} msg The message object to deserialize into.
  * @param {!jspb.BinaryReader} reader The BinaryReader to use.
- * @return {!proto.engine_api.EnclaveAPIContainerHostMachineInfo}
+ * @return {!proto.engine_api.CleanResponse}
  */
-proto.engine_api.EnclaveAPIContainerHostMachineInfo.deserializeBinaryFromReader = function(msg, reader) {
+proto.engine_api.CleanResponse.deserializeBinaryFromReader = function(msg, reader) {
   while (reader.nextField()) {
     if (reader.isEndGroup()) {
       break;
     }
     var field = reader.getFieldNumber();
     switch (field) {
-    case 4:
-      var value = /** @type {string} */ (reader.readString());
-      msg.setIpOnHostMachine(value);
-      break;
-    case 5:
-      var value = /** @type {number} */ (reader.readUint32());
-      msg.setGrpcPortOnHostMachine(value);
+    case 1:
+      var value = new proto.engine_api.EnclaveNameAndUuid;
+      reader.readMessage(value,proto.engine_api.EnclaveNameAndUuid.deserializeBinaryFromReader);
+      msg.addRemovedEnclaveNameAndUuids(value);
       break;
     default:
       reader.skipField();
@@ -1379,9 +6264,9 @@ proto.engine_api.EnclaveAPIContainerHostMachineInfo.deserializeBinaryFromReader
  * Serializes the message to binary data (in protobuf wire format).
  * @return {!Uint8Array}
  */
-proto.engine_api.EnclaveAPIContainerHostMachineInfo.prototype.serializeBinary = function() {
+proto.engine_api.CleanResponse.prototype.serializeBinary = function() {
   var writer = new jspb.BinaryWriter();
-  proto.engine_api.EnclaveAPIContainerHostMachineInfo.serializeBinaryToWriter(this, writer);
+  proto.engine_api.CleanResponse.serializeBinaryToWriter(this, writer);
   return writer.getResultBuffer();
 };
 
@@ -1389,66 +6274,69 @@ proto.engine_api.EnclaveAPIContainerHostMachineInfo.prototype.serializeBinary =
 /**
  * Serializes the given message to binary data (in protobuf wire
  * format), writing to the given BinaryWriter.
- * @param {!proto.engine_api.EnclaveAPIContainerHostMachineInfo} message
+ * @param {!proto.engine_api.CleanResponse} message
  * @param {!jspb.BinaryWriter} writer
  * @suppress {unusedLocalVariables} f is only used for nested messages
  */
-proto.engine_api.EnclaveAPIContainerHostMachineInfo.serializeBinaryToWriter = function(message, writer) {
+proto.engine_api.CleanResponse.serializeBinaryToWriter = function(message, writer) {
   var f = undefined;
-  f = message.getIpOnHostMachine();
+  f = message.getRemovedEnclaveNameAndUuidsList();
   if (f.length > 0) {
-    writer.writeString(
-      4,
-      f
-    );
-  }
-  f = message.getGrpcPortOnHostMachine();
-  if (f !== 0) {
-    writer.writeUint32(
-      5,
-      f
+    writer.writeRepeatedMessage(
+      1,
+      f,
+      proto.engine_api.EnclaveNameAndUuid.serializeBinaryToWriter
     );
   }
 };
 
 
 /**
- * optional string ip_on_host_machine = 4;
- * @return {string}
+ * repeated EnclaveNameAndUuid removed_enclave_name_and_uuids = 1;
+ * @return {!Array<!proto.engine_api.EnclaveNameAndUuid>}
  */
-proto.engine_api.EnclaveAPIContainerHostMachineInfo.prototype.getIpOnHostMachine = function() {
-  return /** @type {string} */ (jspb.Message.getFieldWithDefault(this, 4, ""));
+proto.engine_api.CleanResponse.prototype.getRemovedEnclaveNameAndUuidsList = function() {
+  return /** @type{!Array<!proto.engine_api.EnclaveNameAndUuid>} */ (
+    jspb.Message.getRepeatedWrapperField(this, proto.engine_api.EnclaveNameAndUuid, 1));
 };
 
 
 /**
- * @param {string} value
- * @return {!proto.engine_api.EnclaveAPIContainerHostMachineInfo} returns this
- */
-proto.engine_api.EnclaveAPIContainerHostMachineInfo.prototype.setIpOnHostMachine = function(value) {
-  return jspb.Message.setProto3StringField(this, 4, value);
+ * @param {!Array<!proto.engine_api.EnclaveNameAndUuid>} value
+ * @return {!proto.engine_api.CleanResponse} returns this
+*/
+proto.engine_api.CleanResponse.prototype.setRemovedEnclaveNameAndUuidsList = function(value) {
+  return jspb.Message.setRepeatedWrapperField(this, 1, value);
 };
 
 
 /**
- * optional uint32 grpc_port_on_host_machine = 5;
- * @return {number}
+ * @param {!proto.engine_api.EnclaveNameAndUuid=} opt_value
+ * @param {number=} opt_index
+ * @return {!proto.engine_api.EnclaveNameAndUuid}
  */
-proto.engine_api.EnclaveAPIContainerHostMachineInfo.prototype.getGrpcPortOnHostMachine = function() {
-  return /** @type {number} */ (jspb.Message.getFieldWithDefault(this, 5, 0));
+proto.engine_api.CleanResponse.prototype.addRemovedEnclaveNameAndUuids = function(opt_value, opt_index) {
+  return jspb.Message.addToRepeatedWrapperField(this, 1, opt_value, proto.engine_api.EnclaveNameAndUuid, opt_index);
 };
 
 
 /**
- * @param {number} value
- * @return {!proto.engine_api.EnclaveAPIContainerHostMachineInfo} returns this
+ * Clears the list making it empty but non-null.
+ * @return {!proto.engine_api.CleanResponse} returns this
  */
-proto.engine_api.EnclaveAPIContainerHostMachineInfo.prototype.setGrpcPortOnHostMachine = function(value) {
-  return jspb.Message.setProto3IntField(this, 5, value);
+proto.engine_api.CleanResponse.prototype.clearRemovedEnclaveNameAndUuidsList = function() {
+  return this.setRemovedEnclaveNameAndUuidsList([]);
 };
 
 
 
+/**
+ * List of repeated fields within this message type.
+ * @private {!Array<number>}
+ * @const
+ */
+proto.engine_api.GetServiceLogsArgs.repeatedFields_ = [4];
+
 
 
 if (jspb.Message.GENERATE_TO_OBJECT) {
@@ -1464,8 +6352,8 @@ if (jspb.Message.GENERATE_TO_OBJECT) {
  *     http://goto/soy-param-migration
  * @return {!Object}
  */
-proto.engine_api.EnclaveInfo.prototype.toObject = function(opt_includeInstance) {
-  return proto.engine_api.EnclaveInfo.toObject(opt_includeInstance, this);
+proto.engine_api.GetServiceLogsArgs.prototype.toObject = function(opt_includeInstance) {
+  return proto.engine_api.GetServiceLogsArgs.toObject(opt_includeInstance, this);
 };
 
 
@@ -1474,21 +6362,19 @@ proto.engine_api.EnclaveInfo.prototype.toObject = function(opt_includeInstance)
  * @param {boolean|undefined} includeInstance Deprecated. Whether to include
  *     the JSPB instance for transitional soy proto support:
  *     http://goto/soy-param-migration
- * @param {!proto.engine_api.EnclaveInfo} msg The msg instance to transform.
+ * @param {!proto.engine_api.GetServiceLogsArgs} msg The msg instance to transform.
  * @return {!Object}
  * @suppress {unusedLocalVariables} f is only used for nested messages
  */
-proto.engine_api.EnclaveInfo.toObject = function(includeInstance, msg) {
+proto.engine_api.GetServiceLogsArgs.toObject = function(includeInstance, msg) {
   var f, obj = {
-    enclaveUuid: jspb.Message.getFieldWithDefault(msg, 1, ""),
-    name: jspb.Message.getFieldWithDefault(msg, 2, ""),
-    shortenedUuid: jspb.Message.getFieldWithDefault(msg, 3, ""),
-    containersStatus: jspb.Message.getFieldWithDefault(msg, 4, 0),
-    apiContainerStatus: jspb.Message.getFieldWithDefault(msg, 5, 0),
-    apiContainerInfo: (f = msg.getApiContainerInfo()) && proto.engine_api.EnclaveAPIContainerInfo.toObject(includeInstance, f),
-    apiContainerHostMachineInfo: (f = msg.getApiContainerHostMachineInfo()) && proto.engine_api.EnclaveAPIContainerHostMachineInfo.toObject(includeInstance, f),
-    creationTime: (f = msg.getCreationTime()) && google_protobuf_timestamp_pb.Timestamp.toObject(includeInstance, f),
-    mode: jspb.Message.getFieldWithDefault(msg, 9, 0)
+    enclaveIdentifier: jspb.Message.getFieldWithDefault(msg, 1, ""),
+    serviceUuidSetMap: (f = msg.getServiceUuidSetMap()) ? f.toObject(includeInstance, undefined) : [],
+    followLogs: jspb.Message.getBooleanFieldWithDefault(msg, 3, false),
+    conjunctiveFiltersList: jspb.Message.toObjectList(msg.getConjunctiveFiltersList(),
+    proto.engine_api.LogLineFilter.toObject, includeInstance),
+    returnAllLogs: jspb.Message.getBooleanFieldWithDefault(msg, 5, false),
+    numLogLines: jspb.Message.getFieldWithDefault(msg, 6, 0)
   };
 
   if (includeInstance) {
@@ -1502,23 +6388,23 @@ proto.engine_api.EnclaveInfo.toObject = function(includeInstance, msg) {
 /**
  * Deserializes binary data (in protobuf wire format).
  * @param {jspb.ByteSource} bytes The bytes to deserialize.
- * @return {!proto.engine_api.EnclaveInfo}
+ * @return {!proto.engine_api.GetServiceLogsArgs}
  */
-proto.engine_api.EnclaveInfo.deserializeBinary = function(bytes) {
+proto.engine_api.GetServiceLogsArgs.deserializeBinary = function(bytes) {
   var reader = new jspb.BinaryReader(bytes);
-  var msg = new proto.engine_api.EnclaveInfo;
-  return proto.engine_api.EnclaveInfo.deserializeBinaryFromReader(msg, reader);
+  var msg = new proto.engine_api.GetServiceLogsArgs;
+  return proto.engine_api.GetServiceLogsArgs.deserializeBinaryFromReader(msg, reader);
 };
 
 
 /**
  * Deserializes binary data (in protobuf wire format) from the
  * given reader into the given message object.
- * @param {!proto.engine_api.EnclaveInfo} msg The message object to deserialize into.
+ * @param {!proto.engine_api.GetServiceLogsArgs} msg The message object to deserialize into.
  * @param {!jspb.BinaryReader} reader The BinaryReader to use.
- * @return {!proto.engine_api.EnclaveInfo}
+ * @return {!proto.engine_api.GetServiceLogsArgs}
  */
-proto.engine_api.EnclaveInfo.deserializeBinaryFromReader = function(msg, reader) {
+proto.engine_api.GetServiceLogsArgs.deserializeBinaryFromReader = function(msg, reader) {
   while (reader.nextField()) {
     if (reader.isEndGroup()) {
       break;
@@ -1527,42 +6413,30 @@ proto.engine_api.EnclaveInfo.deserializeBinaryFromReader = function(msg, reader)
     switch (field) {
     case 1:
       var value = /** @type {string} */ (reader.readString());
-      msg.setEnclaveUuid(value);
+      msg.setEnclaveIdentifier(value);
       break;
     case 2:
-      var value = /** @type {string} */ (reader.readString());
-      msg.setName(value);
+      var value = msg.getServiceUuidSetMap();
+      reader.readMessage(value, function(message, reader) {
+        jspb.Map.deserializeBinary(message, reader, jspb.BinaryReader.prototype.readString, jspb.BinaryReader.prototype.readBool, null, "", false);
+         });
       break;
     case 3:
-      var value = /** @type {string} */ (reader.readString());
-      msg.setShortenedUuid(value);
+      var value = /** @type {boolean} */ (reader.readBool());
+      msg.setFollowLogs(value);
       break;
     case 4:
-      var value = /** @type {!proto.engine_api.EnclaveContainersStatus} */ (reader.readEnum());
-      msg.setContainersStatus(value);
+      var value = new proto.engine_api.LogLineFilter;
+      reader.readMessage(value,proto.engine_api.LogLineFilter.deserializeBinaryFromReader);
+      msg.addConjunctiveFilters(value);
       break;
     case 5:
-      var value = /** @type {!proto.engine_api.EnclaveAPIContainerStatus} */ (reader.readEnum());
-      msg.setApiContainerStatus(value);
+      var value = /** @type {boolean} */ (reader.readBool());
+      msg.setReturnAllLogs(value);
       break;
     case 6:
-      var value = new proto.engine_api.EnclaveAPIContainerInfo;
-      reader.readMessage(value,proto.engine_api.EnclaveAPIContainerInfo.deserializeBinaryFromReader);
-      msg.setApiContainerInfo(value);
-      break;
-    case 7:
-      var value = new proto.engine_api.EnclaveAPIContainerHostMachineInfo;
-      reader.readMessage(value,proto.engine_api.EnclaveAPIContainerHostMachineInfo.deserializeBinaryFromReader);
-      msg.setApiContainerHostMachineInfo(value);
-      break;
-    case 8:
-      var value = new google_protobuf_timestamp_pb.Timestamp;
-      reader.readMessage(value,google_protobuf_timestamp_pb.Timestamp.deserializeBinaryFromReader);
-      msg.setCreationTime(value);
-      break;
-    case 9:
-      var value = /** @type {!proto.engine_api.EnclaveMode} */ (reader.readEnum());
-      msg.setMode(value);
+      var value = /** @type {number} */ (reader.readUint32());
+      msg.setNumLogLines(value);
       break;
     default:
       reader.skipField();
@@ -1577,9 +6451,9 @@ proto.engine_api.EnclaveInfo.deserializeBinaryFromReader = function(msg, reader)
  * Serializes the message to binary data (in protobuf wire format).
  * @return {!Uint8Array}
  */
-proto.engine_api.EnclaveInfo.prototype.serializeBinary = function() {
+proto.engine_api.GetServiceLogsArgs.prototype.serializeBinary = function() {
   var writer = new jspb.BinaryWriter();
-  proto.engine_api.EnclaveInfo.serializeBinaryToWriter(this, writer);
+  proto.engine_api.GetServiceLogsArgs.serializeBinaryToWriter(this, writer);
   return writer.getResultBuffer();
 };
 
@@ -1587,75 +6461,49 @@ proto.engine_api.EnclaveInfo.prototype.serializeBinary = function() {
 /**
  * Serializes the given message to binary data (in protobuf wire
  * format), writing to the given BinaryWriter.
- * @param {!proto.engine_api.EnclaveInfo} message
+ * @param {!proto.engine_api.GetServiceLogsArgs} message
  * @param {!jspb.BinaryWriter} writer
  * @suppress {unusedLocalVariables} f is only used for nested messages
  */
-proto.engine_api.EnclaveInfo.serializeBinaryToWriter = function(message, writer) {
+proto.engine_api.GetServiceLogsArgs.serializeBinaryToWriter = function(message, writer) {
   var f = undefined;
-  f = message.getEnclaveUuid();
+  f = message.getEnclaveIdentifier();
   if (f.length > 0) {
     writer.writeString(
       1,
       f
     );
   }
-  f = message.getName();
-  if (f.length > 0) {
-    writer.writeString(
-      2,
-      f
-    );
+  f = message.getServiceUuidSetMap(true);
+  if (f && f.getLength() > 0) {
+    f.serializeBinary(2, writer, jspb.BinaryWriter.prototype.writeString, jspb.BinaryWriter.prototype.writeBool);
   }
-  f = message.getShortenedUuid();
-  if (f.length > 0) {
-    writer.writeString(
+  f = /** @type {boolean} */ (jspb.Message.getField(message, 3));
+  if (f != null) {
+    writer.writeBool(
       3,
       f
     );
   }
-  f = message.getContainersStatus();
-  if (f !== 0.0) {
-    writer.writeEnum(
+  f = message.getConjunctiveFiltersList();
+  if (f.length > 0) {
+    writer.writeRepeatedMessage(
       4,
-      f
-    );
-  }
-  f = message.getApiContainerStatus();
-  if (f !== 0.0) {
-    writer.writeEnum(
-      5,
-      f
-    );
-  }
-  f = message.getApiContainerInfo();
-  if (f != null) {
-    writer.writeMessage(
-      6,
-      f,
-      proto.engine_api.EnclaveAPIContainerInfo.serializeBinaryToWriter
-    );
-  }
-  f = message.getApiContainerHostMachineInfo();
-  if (f != null) {
-    writer.writeMessage(
-      7,
       f,
-      proto.engine_api.EnclaveAPIContainerHostMachineInfo.serializeBinaryToWriter
+      proto.engine_api.LogLineFilter.serializeBinaryToWriter
     );
   }
-  f = message.getCreationTime();
+  f = /** @type {boolean} */ (jspb.Message.getField(message, 5));
   if (f != null) {
-    writer.writeMessage(
-      8,
-      f,
-      google_protobuf_timestamp_pb.Timestamp.serializeBinaryToWriter
+    writer.writeBool(
+      5,
+      f
     );
   }
-  f = message.getMode();
-  if (f !== 0.0) {
-    writer.writeEnum(
-      9,
+  f = /** @type {number} */ (jspb.Message.getField(message, 6));
+  if (f != null) {
+    writer.writeUint32(
+      6,
       f
     );
   }
@@ -1663,120 +6511,143 @@ proto.engine_api.EnclaveInfo.serializeBinaryToWriter = function(message, writer)
 
 
 /**
- * optional string enclave_uuid = 1;
+ * optional string enclave_identifier = 1;
  * @return {string}
  */
-proto.engine_api.EnclaveInfo.prototype.getEnclaveUuid = function() {
+proto.engine_api.GetServiceLogsArgs.prototype.getEnclaveIdentifier = function() {
   return /** @type {string} */ (jspb.Message.getFieldWithDefault(this, 1, ""));
 };
 
 
 /**
  * @param {string} value
- * @return {!proto.engine_api.EnclaveInfo} returns this
+ * @return {!proto.engine_api.GetServiceLogsArgs} returns this
  */
-proto.engine_api.EnclaveInfo.prototype.setEnclaveUuid = function(value) {
+proto.engine_api.GetServiceLogsArgs.prototype.setEnclaveIdentifier = function(value) {
   return jspb.Message.setProto3StringField(this, 1, value);
 };
 
 
 /**
- * optional string name = 2;
- * @return {string}
+ * map<string, bool> service_uuid_set = 2;
+ * @param {boolean=} opt_noLazyCreate Do not create the map if
+ * empty, instead returning `undefined`
+ * @return {!jspb.Map<string,boolean>}
  */
-proto.engine_api.EnclaveInfo.prototype.getName = function() {
-  return /** @type {string} */ (jspb.Message.getFieldWithDefault(this, 2, ""));
+proto.engine_api.GetServiceLogsArgs.prototype.getServiceUuidSetMap = function(opt_noLazyCreate) {
+  return /** @type {!jspb.Map<string,boolean>} */ (
+      jspb.Message.getMapField(this, 2, opt_noLazyCreate,
+      null));
 };
 
 
 /**
- * @param {string} value
- * @return {!proto.engine_api.EnclaveInfo} returns this
+ * Clears values from the map. The map will be non-null.
+ * @return {!proto.engine_api.GetServiceLogsArgs} returns this
  */
-proto.engine_api.EnclaveInfo.prototype.setName = function(value) {
-  return jspb.Message.setProto3StringField(this, 2, value);
+proto.engine_api.GetServiceLogsArgs.prototype.clearServiceUuidSetMap = function() {
+  this.getServiceUuidSetMap().clear();
+  return this;};
+
+
+/**
+ * optional bool follow_logs = 3;
+ * @return {boolean}
+ */
+proto.engine_api.GetServiceLogsArgs.prototype.getFollowLogs = function() {
+  return /** @type {boolean} */ (jspb.Message.getBooleanFieldWithDefault(this, 3, false));
 };
 
 
 /**
- * optional string shortened_uuid = 3;
- * @return {string}
+ * @param {boolean} value
+ * @return {!proto.engine_api.GetServiceLogsArgs} returns this
  */
-proto.engine_api.EnclaveInfo.prototype.getShortenedUuid = function() {
-  return /** @type {string} */ (jspb.Message.getFieldWithDefault(this, 3, ""));
+proto.engine_api.GetServiceLogsArgs.prototype.setFollowLogs = function(value) {
+  return jspb.Message.setField(this, 3, value);
 };
 
 
 /**
- * @param {string} value
- * @return {!proto.engine_api.EnclaveInfo} returns this
+ * Clears the field making it undefined.
+ * @return {!proto.engine_api.GetServiceLogsArgs} returns this
  */
-proto.engine_api.EnclaveInfo.prototype.setShortenedUuid = function(value) {
-  return jspb.Message.setProto3StringField(this, 3, value);
+proto.engine_api.GetServiceLogsArgs.prototype.clearFollowLogs = function() {
+  return jspb.Message.setField(this, 3, undefined);
 };
 
 
 /**
- * optional EnclaveContainersStatus containers_status = 4;
- * @return {!proto.engine_api.EnclaveContainersStatus}
+ * Returns whether this field is set.
+ * @return {boolean}
  */
-proto.engine_api.EnclaveInfo.prototype.getContainersStatus = function() {
-  return /** @type {!proto.engine_api.EnclaveContainersStatus} */ (jspb.Message.getFieldWithDefault(this, 4, 0));
+proto.engine_api.GetServiceLogsArgs.prototype.hasFollowLogs = function() {
+  return jspb.Message.getField(this, 3) != null;
 };
 
 
 /**
- * @param {!proto.engine_api.EnclaveContainersStatus} value
- * @return {!proto.engine_api.EnclaveInfo} returns this
+ * repeated LogLineFilter conjunctive_filters = 4;
+ * @return {!Array<!proto.engine_api.LogLineFilter>}
  */
-proto.engine_api.EnclaveInfo.prototype.setContainersStatus = function(value) {
-  return jspb.Message.setProto3EnumField(this, 4, value);
+proto.engine_api.GetServiceLogsArgs.prototype.getConjunctiveFiltersList = function() {
+  return /** @type{!Array<!proto.engine_api.LogLineFilter>} */ (
+    jspb.Message.getRepeatedWrapperField(this, proto.engine_api.LogLineFilter, 4));
 };
 
 
 /**
- * optional EnclaveAPIContainerStatus api_container_status = 5;
- * @return {!proto.engine_api.EnclaveAPIContainerStatus}
+ * @param {!Array<!proto.engine_api.LogLineFilter>} value
+ * @return {!proto.engine_api.GetServiceLogsArgs} returns this
+*/
+proto.engine_api.GetServiceLogsArgs.prototype.setConjunctiveFiltersList = function(value) {
+  return jspb.Message.setRepeatedWrapperField(this, 4, value);
+};
+
+
+/**
+ * @param {!proto.engine_api.LogLineFilter=} opt_value
+ * @param {number=} opt_index
+ * @return {!proto.engine_api.LogLineFilter}
  */
-proto.engine_api.EnclaveInfo.prototype.getApiContainerStatus = function() {
-  return /** @type {!proto.engine_api.EnclaveAPIContainerStatus} */ (jspb.Message.getFieldWithDefault(this, 5, 0));
+proto.engine_api.GetServiceLogsArgs.prototype.addConjunctiveFilters = function(opt_value, opt_index) {
+  return jspb.Message.addToRepeatedWrapperField(this, 4, opt_value, proto.engine_api.LogLineFilter, opt_index);
 };
 
 
 /**
- * @param {!proto.engine_api.EnclaveAPIContainerStatus} value
- * @return {!proto.engine_api.EnclaveInfo} returns this
+ * Clears the list making it empty but non-null.
+ * @return {!proto.engine_api.GetServiceLogsArgs} returns this
  */
-proto.engine_api.EnclaveInfo.prototype.setApiContainerStatus = function(value) {
-  return jspb.Message.setProto3EnumField(this, 5, value);
+proto.engine_api.GetServiceLogsArgs.prototype.clearConjunctiveFiltersList = function() {
+  return this.setConjunctiveFiltersList([]);
 };
 
 
 /**
- * optional EnclaveAPIContainerInfo api_container_info = 6;
- * @return {?proto.engine_api.EnclaveAPIContainerInfo}
+ * optional bool return_all_logs = 5;
+ * @return {boolean}
  */
-proto.engine_api.EnclaveInfo.prototype.getApiContainerInfo = function() {
-  return /** @type{?proto.engine_api.EnclaveAPIContainerInfo} */ (
-    jspb.Message.getWrapperField(this, proto.engine_api.EnclaveAPIContainerInfo, 6));
+proto.engine_api.GetServiceLogsArgs.prototype.getReturnAllLogs = function() {
+  return /** @type {boolean} */ (jspb.Message.getBooleanFieldWithDefault(this, 5, false));
 };
 
 
 /**
- * @param {?proto.engine_api.EnclaveAPIContainerInfo|undefined} value
- * @return {!proto.engine_api.EnclaveInfo} returns this
-*/
-proto.engine_api.EnclaveInfo.prototype.setApiContainerInfo = function(value) {
-  return jspb.Message.setWrapperField(this, 6, value);
+ * @param {boolean} value
+ * @return {!proto.engine_api.GetServiceLogsArgs} returns this
+ */
+proto.engine_api.GetServiceLogsArgs.prototype.setReturnAllLogs = function(value) {
+  return jspb.Message.setField(this, 5, value);
 };
 
 
 /**
- * Clears the message field making it undefined.
- * @return {!proto.engine_api.EnclaveInfo} returns this
+ * Clears the field making it undefined.
+ * @return {!proto.engine_api.GetServiceLogsArgs} returns this
  */
-proto.engine_api.EnclaveInfo.prototype.clearApiContainerInfo = function() {
-  return this.setApiContainerInfo(undefined);
+proto.engine_api.GetServiceLogsArgs.prototype.clearReturnAllLogs = function() {
+  return jspb.Message.setField(this, 5, undefined);
 };
 
 
@@ -1784,36 +6655,35 @@ proto.engine_api.EnclaveInfo.prototype.clearApiContainerInfo = function() {
  * Returns whether this field is set.
  * @return {boolean}
  */
-proto.engine_api.EnclaveInfo.prototype.hasApiContainerInfo = function() {
-  return jspb.Message.getField(this, 6) != null;
+proto.engine_api.GetServiceLogsArgs.prototype.hasReturnAllLogs = function() {
+  return jspb.Message.getField(this, 5) != null;
 };
 
 
 /**
- * optional EnclaveAPIContainerHostMachineInfo api_container_host_machine_info = 7;
- * @return {?proto.engine_api.EnclaveAPIContainerHostMachineInfo}
+ * optional uint32 num_log_lines = 6;
+ * @return {number}
  */
-proto.engine_api.EnclaveInfo.prototype.getApiContainerHostMachineInfo = function() {
-  return /** @type{?proto.engine_api.EnclaveAPIContainerHostMachineInfo} */ (
-    jspb.Message.getWrapperField(this, proto.engine_api.EnclaveAPIContainerHostMachineInfo, 7));
+proto.engine_api.GetServiceLogsArgs.prototype.getNumLogLines = function() {
+  return /** @type {number} */ (jspb.Message.getFieldWithDefault(this, 6, 0));
 };
 
 
 /**
- * @param {?proto.engine_api.EnclaveAPIContainerHostMachineInfo|undefined} value
- * @return {!proto.engine_api.EnclaveInfo} returns this
-*/
-proto.engine_api.EnclaveInfo.prototype.setApiContainerHostMachineInfo = function(value) {
-  return jspb.Message.setWrapperField(this, 7, value);
+ * @param {number} value
+ * @return {!proto.engine_api.GetServiceLogsArgs} returns this
+ */
+proto.engine_api.GetServiceLogsArgs.prototype.setNumLogLines = function(value) {
+  return jspb.Message.setField(this, 6, value);
 };
 
 
 /**
- * Clears the message field making it undefined.
- * @return {!proto.engine_api.EnclaveInfo} returns this
+ * Clears the field making it undefined.
+ * @return {!proto.engine_api.GetServiceLogsArgs} returns this
  */
-proto.engine_api.EnclaveInfo.prototype.clearApiContainerHostMachineInfo = function() {
-  return this.setApiContainerHostMachineInfo(undefined);
+proto.engine_api.GetServiceLogsArgs.prototype.clearNumLogLines = function() {
+  return jspb.Message.setField(this, 6, undefined);
 };
 
 
@@ -1821,66 +6691,184 @@ proto.engine_api.EnclaveInfo.prototype.clearApiContainerHostMachineInfo = functi
  * Returns whether this field is set.
  * @return {boolean}
  */
-proto.engine_api.EnclaveInfo.prototype.hasApiContainerHostMachineInfo = function() {
-  return jspb.Message.getField(this, 7) != null;
+proto.engine_api.GetServiceLogsArgs.prototype.hasNumLogLines = function() {
+  return jspb.Message.getField(this, 6) != null;
+};
+
+
+
+
+
+if (jspb.Message.GENERATE_TO_OBJECT) {
+/**
+ * Creates an object representation of this proto.
+ * Field names that are reserved in JavaScript and will be renamed to pb_name.
+ * Optional fields that are not set will be set to undefined.
+ * To access a reserved field use, foo.pb_<name>, eg, foo.pb_default.
+ * For the list of reserved names please see:
+ *     net/proto2/compiler/js/internal/generator.cc#kKeyword.
+ * @param {boolean=} opt_includeInstance Deprecated. whether to include the
+ *     JSPB instance for transitional soy proto support:
+ *     http://goto/soy-param-migration
+ * @return {!Object}
+ */
+proto.engine_api.GetServiceLogsResponse.prototype.toObject = function(opt_includeInstance) {
+  return proto.engine_api.GetServiceLogsResponse.toObject(opt_includeInstance, this);
+};
+
+
+/**
+ * Static version of the {@see toObject} method.
+ * @param {boolean|undefined} includeInstance Deprecated. Whether to include
+ *     the JSPB instance for transitional soy proto support:
+ *     http://goto/soy-param-migration
+ * @param {!proto.engine_api.GetServiceLogsResponse} msg The msg instance to transform.
+ * @return {!Object}
+ * @suppress {unusedLocalVariables} f is only used for nested messages
+ */
+proto.engine_api.GetServiceLogsResponse.toObject = function(includeInstance, msg) {
+  var f, obj = {
+    serviceLogsByServiceUuidMap: (f = msg.getServiceLogsByServiceUuidMap()) ? f.toObject(includeInstance, proto.engine_api.LogLine.toObject) : [],
+    notFoundServiceUuidSetMap: (f = msg.getNotFoundServiceUuidSetMap()) ? f.toObject(includeInstance, undefined) : []
+  };
+
+  if (includeInstance) {
+    obj.$jspbMessageInstance = msg;
+  }
+  return obj;
+};
+}
+
+
+/**
+ * Deserializes binary data (in protobuf wire format).
+ * @param {jspb.ByteSource} bytes The bytes to deserialize.
+ * @return {!proto.engine_api.GetServiceLogsResponse}
+ */
+proto.engine_api.GetServiceLogsResponse.deserializeBinary = function(bytes) {
+  var reader = new jspb.BinaryReader(bytes);
+  var msg = new proto.engine_api.GetServiceLogsResponse;
+  return proto.engine_api.GetServiceLogsResponse.deserializeBinaryFromReader(msg, reader);
+};
+
+
+/**
+ * Deserializes binary data (in protobuf wire format) from the
+ * given reader into the given message object.
+ * @param {!proto.engine_api.GetServiceLogsResponse} msg The message object to deserialize into.
+ * @param {!jspb.BinaryReader} reader The BinaryReader to use.
+ * @return {!proto.engine_api.GetServiceLogsResponse}
+ */
+proto.engine_api.GetServiceLogsResponse.deserializeBinaryFromReader = function(msg, reader) {
+  while (reader.nextField()) {
+    if (reader.isEndGroup()) {
+      break;
+    }
+    var field = reader.getFieldNumber();
+    switch (field) {
+    case 1:
+      var value = msg.getServiceLogsByServiceUuidMap();
+      reader.readMessage(value, function(message, reader) {
+        jspb.Map.deserializeBinary(message, reader, jspb.BinaryReader.prototype.readString, jspb.BinaryReader.prototype.readMessage, proto.engine_api.LogLine.deserializeBinaryFromReader, "", new proto.engine_api.LogLine());
+         });
+      break;
+    case 2:
+      var value = msg.getNotFoundServiceUuidSetMap();
+      reader.readMessage(value, function(message, reader) {
+        jspb.Map.deserializeBinary(message, reader, jspb.BinaryReader.prototype.readString, jspb.BinaryReader.prototype.readBool, null, "", false);
+         });
+      break;
+    default:
+      reader.skipField();
+      break;
+    }
+  }
+  return msg;
 };
 
 
 /**
- * optional google.protobuf.Timestamp creation_time = 8;
- * @return {?proto.google.protobuf.Timestamp}
+ * Serializes the message to binary data (in protobuf wire format).
+ * @return {!Uint8Array}
  */
-proto.engine_api.EnclaveInfo.prototype.getCreationTime = function() {
-  return /** @type{?proto.google.protobuf.Timestamp} */ (
-    jspb.Message.getWrapperField(this, google_protobuf_timestamp_pb.Timestamp, 8));
+proto.engine_api.GetServiceLogsResponse.prototype.serializeBinary = function() {
+  var writer = new jspb.BinaryWriter();
+  proto.engine_api.GetServiceLogsResponse.serializeBinaryToWriter(this, writer);
+  return writer.getResultBuffer();
 };
 
 
 /**
- * @param {?proto.google.protobuf.Timestamp|undefined} value
- * @return {!proto.engine_api.EnclaveInfo} returns this
-*/
-proto.engine_api.EnclaveInfo.prototype.setCreationTime = function(value) {
-  return jspb.Message.setWrapperField(this, 8, value);
+ * Serializes the given message to binary data (in protobuf wire
+ * format), writing to the given BinaryWriter.
+ * @param {!proto.engine_api.GetServiceLogsResponse} message
+ * @param {!jspb.BinaryWriter} writer
+ * @suppress {unusedLocalVariables} f is only used for nested messages
+ */
+proto.engine_api.GetServiceLogsResponse.serializeBinaryToWriter = function(message, writer) {
+  var f = undefined;
+  f = message.getServiceLogsByServiceUuidMap(true);
+  if (f && f.getLength() > 0) {
+    f.serializeBinary(1, writer, jspb.BinaryWriter.prototype.writeString, jspb.BinaryWriter.prototype.writeMessage, proto.engine_api.LogLine.serializeBinaryToWriter);
+  }
+  f = message.getNotFoundServiceUuidSetMap(true);
+  if (f && f.getLength() > 0) {
+    f.serializeBinary(2, writer, jspb.BinaryWriter.prototype.writeString, jspb.BinaryWriter.prototype.writeBool);
+  }
 };
 
 
 /**
- * Clears the message field making it undefined.
- * @return {!proto.engine_api.EnclaveInfo} returns this
+ * map<string, LogLine> service_logs_by_service_uuid = 1;
+ * @param {boolean=} opt_noLazyCreate Do not create the map if
+ * empty, instead returning `undefined`
+ * @return {!jspb.Map<string,!proto.engine_api.LogLine>}
  */
-proto.engine_api.EnclaveInfo.prototype.clearCreationTime = function() {
-  return this.setCreationTime(undefined);
+proto.engine_api.GetServiceLogsResponse.prototype.getServiceLogsByServiceUuidMap = function(opt_noLazyCreate) {
+  return /** @type {!jspb.Map<string,!proto.engine_api.LogLine>} */ (
+      jspb.Message.getMapField(this, 1, opt_noLazyCreate,
+      proto.engine_api.LogLine));
 };
 
 
 /**
- * Returns whether this field is set.
- * @return {boolean}
+ * Clears values from the map. The map will be non-null.
+ * @return {!proto.engine_api.GetServiceLogsResponse} returns this
  */
-proto.engine_api.EnclaveInfo.prototype.hasCreationTime = function() {
-  return jspb.Message.getField(this, 8) != null;
-};
+proto.engine_api.GetServiceLogsResponse.prototype.clearServiceLogsByServiceUuidMap = function() {
+  this.getServiceLogsByServiceUuidMap().clear();
+  return this;};
 
 
 /**
- * optional EnclaveMode mode = 9;
- * @return {!proto.engine_api.EnclaveMode}
+ * map<string, bool> not_found_service_uuid_set = 2;
+ * @param {boolean=} opt_noLazyCreate Do not create the map if
+ * empty, instead returning `undefined`
+ * @return {!jspb.Map<string,boolean>}
  */
-proto.engine_api.EnclaveInfo.prototype.getMode = function() {
-  return /** @type {!proto.engine_api.EnclaveMode} */ (jspb.Message.getFieldWithDefault(this, 9, 0));
+proto.engine_api.GetServiceLogsResponse.prototype.getNotFoundServiceUuidSetMap = function(opt_noLazyCreate) {
+  return /** @type {!jspb.Map<string,boolean>} */ (
+      jspb.Message.getMapField(this, 2, opt_noLazyCreate,
+      null));
 };
 
 
 /**
- * @param {!proto.engine_api.EnclaveMode} value
- * @return {!proto.engine_api.EnclaveInfo} returns this
+ * Clears values from the map. The map will be non-null.
+ * @return {!proto.engine_api.GetServiceLogsResponse} returns this
  */
-proto.engine_api.EnclaveInfo.prototype.setMode = function(value) {
-  return jspb.Message.setProto3EnumField(this, 9, value);
-};
+proto.engine_api.GetServiceLogsResponse.prototype.clearNotFoundServiceUuidSetMap = function() {
+  this.getNotFoundServiceUuidSetMap().clear();
+  return this;};
+
 
 
+/**
+ * List of repeated fields within this message type.
+ * @private {!Array<number>}
+ * @const
+ */
+proto.engine_api.LogLine.repeatedFields_ = [1];
 
 
 
@@ -1897,8 +6885,8 @@ if (jspb.Message.GENERATE_TO_OBJECT) {
  *     http://goto/soy-param-migration
  * @return {!Object}
  */
-proto.engine_api.GetEnclavesResponse.prototype.toObject = function(opt_includeInstance) {
-  return proto.engine_api.GetEnclavesResponse.toObject(opt_includeInstance, this);
+proto.engine_api.LogLine.prototype.toObject = function(opt_includeInstance) {
+  return proto.engine_api.LogLine.toObject(opt_includeInstance, this);
 };
 
 
@@ -1907,13 +6895,14 @@ proto.engine_api.GetEnclavesResponse.prototype.toObject = function(opt_includeIn
  * @param {boolean|undefined} includeInstance Deprecated. Whether to include
  *     the JSPB instance for transitional soy proto support:
  *     http://goto/soy-param-migration
- * @param {!proto.engine_api.GetEnclavesResponse} msg The msg instance to transform.
+ * @param {!proto.engine_api.LogLine} msg The msg instance to transform.
  * @return {!Object}
  * @suppress {unusedLocalVariables} f is only used for nested messages
  */
-proto.engine_api.GetEnclavesResponse.toObject = function(includeInstance, msg) {
+proto.engine_api.LogLine.toObject = function(includeInstance, msg) {
   var f, obj = {
-    enclaveInfoMap: (f = msg.getEnclaveInfoMap()) ? f.toObject(includeInstance, proto.engine_api.EnclaveInfo.toObject) : []
+    lineList: (f = jspb.Message.getRepeatedField(msg, 1)) == null ? undefined : f,
+    timestamp: (f = msg.getTimestamp()) && google_protobuf_timestamp_pb.Timestamp.toObject(includeInstance, f)
   };
 
   if (includeInstance) {
@@ -1927,23 +6916,23 @@ proto.engine_api.GetEnclavesResponse.toObject = function(includeInstance, msg) {
 /**
  * Deserializes binary data (in protobuf wire format).
  * @param {jspb.ByteSource} bytes The bytes to deserialize.
- * @return {!proto.engine_api.GetEnclavesResponse}
+ * @return {!proto.engine_api.LogLine}
  */
-proto.engine_api.GetEnclavesResponse.deserializeBinary = function(bytes) {
+proto.engine_api.LogLine.deserializeBinary = function(bytes) {
   var reader = new jspb.BinaryReader(bytes);
-  var msg = new proto.engine_api.GetEnclavesResponse;
-  return proto.engine_api.GetEnclavesResponse.deserializeBinaryFromReader(msg, reader);
+  var msg = new proto.engine_api.LogLine;
+  return proto.engine_api.LogLine.deserializeBinaryFromReader(msg, reader);
 };
 
 
 /**
  * Deserializes binary data (in protobuf wire format) from the
  * given reader into the given message object.
- * @param {!proto.engine_api.GetEnclavesResponse} msg The message object to deserialize into.
+ * @param {!proto.engine_api.LogLine} msg The message object to deserialize into.
  * @param {!jspb.BinaryReader} reader The BinaryReader to use.
- * @return {!proto.engine_api.GetEnclavesResponse}
+ * @return {!proto.engine_api.LogLine}
  */
-proto.engine_api.GetEnclavesResponse.deserializeBinaryFromReader = function(msg, reader) {
+proto.engine_api.LogLine.deserializeBinaryFromReader = function(msg, reader) {
   while (reader.nextField()) {
     if (reader.isEndGroup()) {
       break;
@@ -1951,10 +6940,13 @@ proto.engine_api.GetEnclavesResponse.deserializeBinaryFromReader = function(msg,
     var field = reader.getFieldNumber();
     switch (field) {
     case 1:
-      var value = msg.getEnclaveInfoMap();
-      reader.readMessage(value, function(message, reader) {
-        jspb.Map.deserializeBinary(message, reader, jspb.BinaryReader.prototype.readString, jspb.BinaryReader.prototype.readMessage, proto.engine_api.EnclaveInfo.deserializeBinaryFromReader, "", new proto.engine_api.EnclaveInfo());
-         });
+      var value = /** @type {string} */ (reader.readString());
+      msg.addLine(value);
+      break;
+    case 2:
+      var value = new google_protobuf_timestamp_pb.Timestamp;
+      reader.readMessage(value,google_protobuf_timestamp_pb.Timestamp.deserializeBinaryFromReader);
+      msg.setTimestamp(value);
       break;
     default:
       reader.skipField();
@@ -1969,9 +6961,9 @@ proto.engine_api.GetEnclavesResponse.deserializeBinaryFromReader = function(msg,
  * Serializes the message to binary data (in protobuf wire format).
  * @return {!Uint8Array}
  */
-proto.engine_api.GetEnclavesResponse.prototype.serializeBinary = function() {
+proto.engine_api.LogLine.prototype.serializeBinary = function() {
   var writer = new jspb.BinaryWriter();
-  proto.engine_api.GetEnclavesResponse.serializeBinaryToWriter(this, writer);
+  proto.engine_api.LogLine.serializeBinaryToWriter(this, writer);
   return writer.getResultBuffer();
 };
 
@@ -1979,39 +6971,102 @@ proto.engine_api.GetEnclavesResponse.prototype.serializeBinary = function() {
 /**
  * Serializes the given message to binary data (in protobuf wire
  * format), writing to the given BinaryWriter.
- * @param {!proto.engine_api.GetEnclavesResponse} message
+ * @param {!proto.engine_api.LogLine} message
  * @param {!jspb.BinaryWriter} writer
  * @suppress {unusedLocalVariables} f is only used for nested messages
  */
-proto.engine_api.GetEnclavesResponse.serializeBinaryToWriter = function(message, writer) {
+proto.engine_api.LogLine.serializeBinaryToWriter = function(message, writer) {
   var f = undefined;
-  f = message.getEnclaveInfoMap(true);
-  if (f && f.getLength() > 0) {
-    f.serializeBinary(1, writer, jspb.BinaryWriter.prototype.writeString, jspb.BinaryWriter.prototype.writeMessage, proto.engine_api.EnclaveInfo.serializeBinaryToWriter);
+  f = message.getLineList();
+  if (f.length > 0) {
+    writer.writeRepeatedString(
+      1,
+      f
+    );
+  }
+  f = message.getTimestamp();
+  if (f != null) {
+    writer.writeMessage(
+      2,
+      f,
+      google_protobuf_timestamp_pb.Timestamp.serializeBinaryToWriter
+    );
   }
 };
 
 
 /**
- * map<string, EnclaveInfo> enclave_info = 1;
- * @param {boolean=} opt_noLazyCreate Do not create the map if
- * empty, instead returning `undefined`
- * @return {!jspb.Map<string,!proto.engine_api.EnclaveInfo>}
+ * repeated string line = 1;
+ * @return {!Array<string>}
  */
-proto.engine_api.GetEnclavesResponse.prototype.getEnclaveInfoMap = function(opt_noLazyCreate) {
-  return /** @type {!jspb.Map<string,!proto.engine_api.EnclaveInfo>} */ (
-      jspb.Message.getMapField(this, 1, opt_noLazyCreate,
-      proto.engine_api.EnclaveInfo));
+proto.engine_api.LogLine.prototype.getLineList = function() {
+  return /** @type {!Array<string>} */ (jspb.Message.getRepeatedField(this, 1));
 };
 
 
 /**
- * Clears values from the map. The map will be non-null.
- * @return {!proto.engine_api.GetEnclavesResponse} returns this
+ * @param {!Array<string>} value
+ * @return {!proto.engine_api.LogLine} returns this
  */
-proto.engine_api.GetEnclavesResponse.prototype.clearEnclaveInfoMap = function() {
-  this.getEnclaveInfoMap().clear();
-  return this;};
+proto.engine_api.LogLine.prototype.setLineList = function(value) {
+  return jspb.Message.setField(this, 1, value || []);
+};
+
+
+/**
+ * @param {string} value
+ * @param {number=} opt_index
+ * @return {!proto.engine_api.LogLine} returns this
+ */
+proto.engine_api.LogLine.prototype.addLine = function(value, opt_index) {
+  return jspb.Message.addToRepeatedField(this, 1, value, opt_index);
+};
+
+
+/**
+ * Clears the list making it empty but non-null.
+ * @return {!proto.engine_api.LogLine} returns this
+ */
+proto.engine_api.LogLine.prototype.clearLineList = function() {
+  return this.setLineList([]);
+};
+
+
+/**
+ * optional google.protobuf.Timestamp timestamp = 2;
+ * @return {?proto.google.protobuf.Timestamp}
+ */
+proto.engine_api.LogLine.prototype.getTimestamp = function() {
+  return /** @type{?proto.google.protobuf.Timestamp} */ (
+    jspb.Message.getWrapperField(this, google_protobuf_timestamp_pb.Timestamp, 2));
+};
+
+
+/**
+ * @param {?proto.google.protobuf.Timestamp|undefined} value
+ * @return {!proto.engine_api.LogLine} returns this
+*/
+proto.engine_api.LogLine.prototype.setTimestamp = function(value) {
+  return jspb.Message.setWrapperField(this, 2, value);
+};
+
+
+/**
+ * Clears the message field making it undefined.
+ * @return {!proto.engine_api.LogLine} returns this
+ */
+proto.engine_api.LogLine.prototype.clearTimestamp = function() {
+  return this.setTimestamp(undefined);
+};
+
+
+/**
+ * Returns whether this field is set.
+ * @return {boolean}
+ */
+proto.engine_api.LogLine.prototype.hasTimestamp = function() {
+  return jspb.Message.getField(this, 2) != null;
+};
 
 
 
@@ -2030,8 +7085,8 @@ if (jspb.Message.GENERATE_TO_OBJECT) {
  *     http://goto/soy-param-migration
  * @return {!Object}
  */
-proto.engine_api.EnclaveIdentifiers.prototype.toObject = function(opt_includeInstance) {
-  return proto.engine_api.EnclaveIdentifiers.toObject(opt_includeInstance, this);
+proto.engine_api.LogLineFilter.prototype.toObject = function(opt_includeInstance) {
+  return proto.engine_api.LogLineFilter.toObject(opt_includeInstance, this);
 };
 
 
@@ -2040,15 +7095,14 @@ proto.engine_api.EnclaveIdentifiers.prototype.toObject = function(opt_includeIns
  * @param {boolean|undefined} includeInstance Deprecated. Whether to include
  *     the JSPB instance for transitional soy proto support:
  *     http://goto/soy-param-migration
- * @param {!proto.engine_api.EnclaveIdentifiers} msg The msg instance to transform.
+ * @param {!proto.engine_api.LogLineFilter} msg The msg instance to transform.
  * @return {!Object}
  * @suppress {unusedLocalVariables} f is only used for nested messages
  */
-proto.engine_api.EnclaveIdentifiers.toObject = function(includeInstance, msg) {
+proto.engine_api.LogLineFilter.toObject = function(includeInstance, msg) {
   var f, obj = {
-    enclaveUuid: jspb.Message.getFieldWithDefault(msg, 1, ""),
-    name: jspb.Message.getFieldWithDefault(msg, 2, ""),
-    shortenedUuid: jspb.Message.getFieldWithDefault(msg, 3, "")
+    operator: jspb.Message.getFieldWithDefault(msg, 1, 0),
+    textPattern: jspb.Message.getFieldWithDefault(msg, 2, "")
   };
 
   if (includeInstance) {
@@ -2062,23 +7116,23 @@ proto.engine_api.EnclaveIdentifiers.toObject = function(includeInstance, msg) {
 /**
  * Deserializes binary data (in protobuf wire format).
  * @param {jspb.ByteSource} bytes The bytes to deserialize.
- * @return {!proto.engine_api.EnclaveIdentifiers}
+ * @return {!proto.engine_api.LogLineFilter}
  */
-proto.engine_api.EnclaveIdentifiers.deserializeBinary = function(bytes) {
+proto.engine_api.LogLineFilter.deserializeBinary = function(bytes) {
   var reader = new jspb.BinaryReader(bytes);
-  var msg = new proto.engine_api.EnclaveIdentifiers;
-  return proto.engine_api.EnclaveIdentifiers.deserializeBinaryFromReader(msg, reader);
+  var msg = new proto.engine_api.LogLineFilter;
+  return proto.engine_api.LogLineFilter.deserializeBinaryFromReader(msg, reader);
 };
 
 
 /**
  * Deserializes binary data (in protobuf wire format) from the
  * given reader into the given message object.
- * @param {!proto.engine_api.EnclaveIdentifiers} msg The message object to deserialize into.
+ * @param {!proto.engine_api.LogLineFilter} msg The message object to deserialize into.
  * @param {!jspb.BinaryReader} reader The BinaryReader to use.
- * @return {!proto.engine_api.EnclaveIdentifiers}
+ * @return {!proto.engine_api.LogLineFilter}
  */
-proto.engine_api.EnclaveIdentifiers.deserializeBinaryFromReader = function(msg, reader) {
+proto.engine_api.LogLineFilter.deserializeBinaryFromReader = function(msg, reader) {
   while (reader.nextField()) {
     if (reader.isEndGroup()) {
       break;
@@ -2086,16 +7140,12 @@ proto.engine_api.EnclaveIdentifiers.deserializeBinaryFromReader = function(msg,
     var field = reader.getFieldNumber();
     switch (field) {
     case 1:
-      var value = /** @type {string} */ (reader.readString());
-      msg.setEnclaveUuid(value);
+      var value = /** @type {!proto.engine_api.LogLineOperator} */ (reader.readEnum());
+      msg.setOperator(value);
       break;
     case 2:
       var value = /** @type {string} */ (reader.readString());
-      msg.setName(value);
-      break;
-    case 3:
-      var value = /** @type {string} */ (reader.readString());
-      msg.setShortenedUuid(value);
+      msg.setTextPattern(value);
       break;
     default:
       reader.skipField();
@@ -2110,9 +7160,9 @@ proto.engine_api.EnclaveIdentifiers.deserializeBinaryFromReader = function(msg,
  * Serializes the message to binary data (in protobuf wire format).
  * @return {!Uint8Array}
  */
-proto.engine_api.EnclaveIdentifiers.prototype.serializeBinary = function() {
+proto.engine_api.LogLineFilter.prototype.serializeBinary = function() {
   var writer = new jspb.BinaryWriter();
-  proto.engine_api.EnclaveIdentifiers.serializeBinaryToWriter(this, writer);
+  proto.engine_api.LogLineFilter.serializeBinaryToWriter(this, writer);
   return writer.getResultBuffer();
 };
 
@@ -2120,97 +7170,72 @@ proto.engine_api.EnclaveIdentifiers.prototype.serializeBinary = function() {
 /**
  * Serializes the given message to binary data (in protobuf wire
  * format), writing to the given BinaryWriter.
- * @param {!proto.engine_api.EnclaveIdentifiers} message
+ * @param {!proto.engine_api.LogLineFilter} message
  * @param {!jspb.BinaryWriter} writer
  * @suppress {unusedLocalVariables} f is only used for nested messages
  */
-proto.engine_api.EnclaveIdentifiers.serializeBinaryToWriter = function(message, writer) {
+proto.engine_api.LogLineFilter.serializeBinaryToWriter = function(message, writer) {
   var f = undefined;
-  f = message.getEnclaveUuid();
-  if (f.length > 0) {
-    writer.writeString(
+  f = message.getOperator();
+  if (f !== 0.0) {
+    writer.writeEnum(
       1,
       f
     );
   }
-  f = message.getName();
+  f = message.getTextPattern();
   if (f.length > 0) {
     writer.writeString(
       2,
       f
     );
   }
-  f = message.getShortenedUuid();
-  if (f.length > 0) {
-    writer.writeString(
-      3,
-      f
-    );
-  }
 };
 
 
 /**
- * optional string enclave_uuid = 1;
- * @return {string}
+ * optional LogLineOperator operator = 1;
+ * @return {!proto.engine_api.LogLineOperator}
  */
-proto.engine_api.EnclaveIdentifiers.prototype.getEnclaveUuid = function() {
-  return /** @type {string} */ (jspb.Message.getFieldWithDefault(this, 1, ""));
+proto.engine_api.LogLineFilter.prototype.getOperator = function() {
+  return /** @type {!proto.engine_api.LogLineOperator} */ (jspb.Message.getFieldWithDefault(this, 1, 0));
 };
 
 
 /**
- * @param {string} value
- * @return {!proto.engine_api.EnclaveIdentifiers} returns this
+ * @param {!proto.engine_api.LogLineOperator} value
+ * @return {!proto.engine_api.LogLineFilter} returns this
  */
-proto.engine_api.EnclaveIdentifiers.prototype.setEnclaveUuid = function(value) {
-  return jspb.Message.setProto3StringField(this, 1, value);
+proto.engine_api.LogLineFilter.prototype.setOperator = function(value) {
+  return jspb.Message.setProto3EnumField(this, 1, value);
 };
 
 
 /**
- * optional string name = 2;
+ * optional string text_pattern = 2;
  * @return {string}
  */
-proto.engine_api.EnclaveIdentifiers.prototype.getName = function() {
+proto.engine_api.LogLineFilter.prototype.getTextPattern = function() {
   return /** @type {string} */ (jspb.Message.getFieldWithDefault(this, 2, ""));
 };
 
 
 /**
  * @param {string} value
- * @return {!proto.engine_api.EnclaveIdentifiers} returns this
+ * @return {!proto.engine_api.LogLineFilter} returns this
  */
-proto.engine_api.EnclaveIdentifiers.prototype.setName = function(value) {
+proto.engine_api.LogLineFilter.prototype.setTextPattern = function(value) {
   return jspb.Message.setProto3StringField(this, 2, value);
 };
 
 
-/**
- * optional string shortened_uuid = 3;
- * @return {string}
- */
-proto.engine_api.EnclaveIdentifiers.prototype.getShortenedUuid = function() {
-  return /** @type {string} */ (jspb.Message.getFieldWithDefault(this, 3, ""));
-};
-
-
-/**
- * @param {string} value
- * @return {!proto.engine_api.EnclaveIdentifiers} returns this
- */
-proto.engine_api.EnclaveIdentifiers.prototype.setShortenedUuid = function(value) {
-  return jspb.Message.setProto3StringField(this, 3, value);
-};
-
-
 
 /**
  * List of repeated fields within this message type.
  * @private {!Array<number>}
  * @const
  */
-proto.engine_api.GetExistingAndHistoricalEnclaveIdentifiersResponse.repeatedFields_ = [1];
+proto.engine_api.WatchEventsArgs.repeatedFields_ = [1];
 
 
 
@@ -2227,8 +7252,8 @@ if (jspb.Message.GENERATE_TO_OBJECT) {
  *     http://goto/soy-param-migration
  * @return {!Object}
  */
-proto.engine_api.GetExistingAndHistoricalEnclaveIdentifiersResponse.prototype.toObject = function(opt_includeInstance) {
-  return proto.engine_api.GetExistingAndHistoricalEnclaveIdentifiersResponse.toObject(opt_includeInstance, this);
+proto.engine_api.WatchEventsArgs.prototype.toObject = function(opt_includeInstance) {
+  return proto.engine_api.WatchEventsArgs.toObject(opt_includeInstance, this);
 };
 
 
@@ -2237,14 +7262,13 @@ proto.engine_api.GetExistingAndHistoricalEnclaveIdentifiersResponse.prototype.to
  * @param {boolean|undefined} includeInstance Deprecated. Whether to include
  *     the JSPB instance for transitional soy proto support:
  *     http://goto/soy-param-migration
- * @param {!proto.engine_api.GetExistingAndHistoricalEnclaveIdentifiersResponse} msg The msg instance to transform.
+ * @param {!proto.engine_api.WatchEventsArgs} msg The msg instance to transform.
  * @return {!Object}
  * @suppress {unusedLocalVariables} f is only used for nested messages
  */
-proto.engine_api.GetExistingAndHistoricalEnclaveIdentifiersResponse.toObject = function(includeInstance, msg) {
+proto.engine_api.WatchEventsArgs.toObject = function(includeInstance, msg) {
   var f, obj = {
-    allidentifiersList: jspb.Message.toObjectList(msg.getAllidentifiersList(),
-    proto.engine_api.EnclaveIdentifiers.toObject, includeInstance)
+    eventTypesList: (f = jspb.Message.getRepeatedField(msg, 1)) == null ? undefined : f
   };
 
   if (includeInstance) {
@@ -2258,23 +7282,23 @@ proto.engine_api.GetExistingAndHistoricalEnclaveIdentifiersResponse.toObject = f
 /**
  * Deserializes binary data (in protobuf wire format).
  * @param {jspb.ByteSource} bytes The bytes to deserialize.
- * @return {!proto.engine_api.GetExistingAndHistoricalEnclaveIdentifiersResponse}
+ * @return {!proto.engine_api.WatchEventsArgs}
  */
-proto.engine_api.GetExistingAndHistoricalEnclaveIdentifiersResponse.deserializeBinary = function(bytes) {
+proto.engine_api.WatchEventsArgs.deserializeBinary = function(bytes) {
   var reader = new jspb.BinaryReader(bytes);
-  var msg = new proto.engine_api.GetExistingAndHistoricalEnclaveIdentifiersResponse;
-  return proto.engine_api.GetExistingAndHistoricalEnclaveIdentifiersResponse.deserializeBinaryFromReader(msg, reader);
+  var msg = new proto.engine_api.WatchEventsArgs;
+  return proto.engine_api.WatchEventsArgs.deserializeBinaryFromReader(msg, reader);
 };
 
 
 /**
  * Deserializes binary data (in protobuf wire format) from the
  * given reader into the given message object.
- * @param {!proto.engine_api.GetExistingAndHistoricalEnclaveIdentifiersResponse} msg The message object to deserialize into.
+ * @param {!proto.engine_api.WatchEventsArgs} msg The message object to deserialize into.
  * @param {!jspb.BinaryReader} reader The BinaryReader to use.
- * @return {!proto.engine_api.GetExistingAndHistoricalEnclaveIdentifiersResponse}
+ * @return {!proto.engine_api.WatchEventsArgs}
  */
-proto.engine_api.GetExistingAndHistoricalEnclaveIdentifiersResponse.deserializeBinaryFromReader = function(msg, reader) {
+proto.engine_api.WatchEventsArgs.deserializeBinaryFromReader = function(msg, reader) {
   while (reader.nextField()) {
     if (reader.isEndGroup()) {
       break;
@@ -2282,9 +7306,10 @@ proto.engine_api.GetExistingAndHistoricalEnclaveIdentifiersResponse.deserializeB
     var field = reader.getFieldNumber();
     switch (field) {
     case 1:
-      var value = new proto.engine_api.EnclaveIdentifiers;
-      reader.readMessage(value,proto.engine_api.EnclaveIdentifiers.deserializeBinaryFromReader);
-      msg.addAllidentifiers(value);
+      var values = /** @type {!Array<!proto.engine_api.EngineEventType>} */ (reader.isDelimited() ? reader.readPackedEnum() : [reader.readEnum()]);
+      for (var i = 0; i < values.length; i++) {
+        msg.addEventTypes(values[i]);
+      }
       break;
     default:
       reader.skipField();
@@ -2299,9 +7324,9 @@ proto.engine_api.GetExistingAndHistoricalEnclaveIdentifiersResponse.deserializeB
  * Serializes the message to binary data (in protobuf wire format).
  * @return {!Uint8Array}
  */
-proto.engine_api.GetExistingAndHistoricalEnclaveIdentifiersResponse.prototype.serializeBinary = function() {
+proto.engine_api.WatchEventsArgs.prototype.serializeBinary = function() {
   var writer = new jspb.BinaryWriter();
-  proto.engine_api.GetExistingAndHistoricalEnclaveIdentifiersResponse.serializeBinaryToWriter(this, writer);
+  proto.engine_api.WatchEventsArgs.serializeBinaryToWriter(this, writer);
   return writer.getResultBuffer();
 };
 
@@ -2309,58 +7334,56 @@ proto.engine_api.GetExistingAndHistoricalEnclaveIdentifiersResponse.prototype.se
 /**
  * Serializes the given message to binary data (in protobuf wire
  * format), writing to the given BinaryWriter.
- * @param {!proto.engine_api.GetExistingAndHistoricalEnclaveIdentifiersResponse} message
+ * @param {!proto.engine_api.WatchEventsArgs} message
  * @param {!jspb.BinaryWriter} writer
  * @suppress {unusedLocalVariables} f is only used for nested messages
  */
-proto.engine_api.GetExistingAndHistoricalEnclaveIdentifiersResponse.serializeBinaryToWriter = function(message, writer) {
+proto.engine_api.WatchEventsArgs.serializeBinaryToWriter = function(message, writer) {
   var f = undefined;
-  f = message.getAllidentifiersList();
+  f = message.getEventTypesList();
   if (f.length > 0) {
-    writer.writeRepeatedMessage(
+    writer.writePackedEnum(
       1,
-      f,
-      proto.engine_api.EnclaveIdentifiers.serializeBinaryToWriter
+      f
     );
   }
 };
 
 
 /**
- * repeated EnclaveIdentifiers allIdentifiers = 1;
- * @return {!Array<!proto.engine_api.EnclaveIdentifiers>}
+ * repeated EngineEventType event_types = 1;
+ * @return {!Array<!proto.engine_api.EngineEventType>}
  */
-proto.engine_api.GetExistingAndHistoricalEnclaveIdentifiersResponse.prototype.getAllidentifiersList = function() {
-  return /** @type{!Array<!proto.engine_api.EnclaveIdentifiers>} */ (
-    jspb.Message.getRepeatedWrapperField(this, proto.engine_api.EnclaveIdentifiers, 1));
+proto.engine_api.WatchEventsArgs.prototype.getEventTypesList = function() {
+  return /** @type {!Array<!proto.engine_api.EngineEventType>} */ (jspb.Message.getRepeatedField(this, 1));
 };
 
 
 /**
- * @param {!Array<!proto.engine_api.EnclaveIdentifiers>} value
- * @return {!proto.engine_api.GetExistingAndHistoricalEnclaveIdentifiersResponse} returns this
-*/
-proto.engine_api.GetExistingAndHistoricalEnclaveIdentifiersResponse.prototype.setAllidentifiersList = function(value) {
-  return jspb.Message.setRepeatedWrapperField(this, 1, value);
+ * @param {!Array<!proto.engine_api.EngineEventType>} value
+ * @return {!proto.engine_api.WatchEventsArgs} returns this
+ */
+proto.engine_api.WatchEventsArgs.prototype.setEventTypesList = function(value) {
+  return jspb.Message.setField(this, 1, value || []);
 };
 
 
 /**
- * @param {!proto.engine_api.EnclaveIdentifiers=} opt_value
+ * @param {!proto.engine_api.EngineEventType} value
  * @param {number=} opt_index
- * @return {!proto.engine_api.EnclaveIdentifiers}
+ * @return {!proto.engine_api.WatchEventsArgs} returns this
  */
-proto.engine_api.GetExistingAndHistoricalEnclaveIdentifiersResponse.prototype.addAllidentifiers = function(opt_value, opt_index) {
-  return jspb.Message.addToRepeatedWrapperField(this, 1, opt_value, proto.engine_api.EnclaveIdentifiers, opt_index);
+proto.engine_api.WatchEventsArgs.prototype.addEventTypes = function(value, opt_index) {
+  return jspb.Message.addToRepeatedField(this, 1, value, opt_index);
 };
 
 
 /**
  * Clears the list making it empty but non-null.
- * @return {!proto.engine_api.GetExistingAndHistoricalEnclaveIdentifiersResponse} returns this
+ * @return {!proto.engine_api.WatchEventsArgs} returns this
  */
-proto.engine_api.GetExistingAndHistoricalEnclaveIdentifiersResponse.prototype.clearAllidentifiersList = function() {
-  return this.setAllidentifiersList([]);
+proto.engine_api.WatchEventsArgs.prototype.clearEventTypesList = function() {
+  return this.setEventTypesList([]);
 };
 
 
@@ -2380,8 +7403,8 @@ if (jspb.Message.GENERATE_TO_OBJECT) {
  *     http://goto/soy-param-migration
  * @return {!Object}
  */
-proto.engine_api.StopEnclaveArgs.prototype.toObject = function(opt_includeInstance) {
-  return proto.engine_api.StopEnclaveArgs.toObject(opt_includeInstance, this);
+proto.engine_api.EngineEvent.prototype.toObject = function(opt_includeInstance) {
+  return proto.engine_api.EngineEvent.toObject(opt_includeInstance, this);
 };
 
 
@@ -2390,13 +7413,19 @@ proto.engine_api.StopEnclaveArgs.prototype.toObject = function(opt_includeInstan
  * @param {boolean|undefined} includeInstance Deprecated. Whether to include
  *     the JSPB instance for transitional soy proto support:
  *     http://goto/soy-param-migration
- * @param {!proto.engine_api.StopEnclaveArgs} msg The msg instance to transform.
+ * @param {!proto.engine_api.EngineEvent} msg The msg instance to transform.
  * @return {!Object}
  * @suppress {unusedLocalVariables} f is only used for nested messages
  */
-proto.engine_api.StopEnclaveArgs.toObject = function(includeInstance, msg) {
+proto.engine_api.EngineEvent.toObject = function(includeInstance, msg) {
   var f, obj = {
-    enclaveIdentifier: jspb.Message.getFieldWithDefault(msg, 1, "")
+    type: jspb.Message.getFieldWithDefault(msg, 1, 0),
+    timestamp: (f = msg.getTimestamp()) && google_protobuf_timestamp_pb.Timestamp.toObject(includeInstance, f),
+    enclaveUuid: jspb.Message.getFieldWithDefault(msg, 3, ""),
+    enclaveName: jspb.Message.getFieldWithDefault(msg, 4, ""),
+    serviceName: jspb.Message.getFieldWithDefault(msg, 5, ""),
+    starlarkPackageId: jspb.Message.getFieldWithDefault(msg, 6, ""),
+    starlarkRunSucceeded: jspb.Message.getBooleanFieldWithDefault(msg, 7, false)
   };
 
   if (includeInstance) {
@@ -2410,23 +7439,23 @@ proto.engine_api.StopEnclaveArgs.toObject = function(includeInstance, msg) {
 /**
  * Deserializes binary data (in protobuf wire format).
  * @param {jspb.ByteSource} bytes The bytes to deserialize.
- * @return {!proto.engine_api.StopEnclaveArgs}
+ * @return {!proto.engine_api.EngineEvent}
  */
-proto.engine_api.StopEnclaveArgs.deserializeBinary = function(bytes) {
+proto.engine_api.EngineEvent.deserializeBinary = function(bytes) {
   var reader = new jspb.BinaryReader(bytes);
-  var msg = new proto.engine_api.StopEnclaveArgs;
-  return proto.engine_api.StopEnclaveArgs.deserializeBinaryFromReader(msg, reader);
+  var msg = new proto.engine_api.EngineEvent;
+  return proto.engine_api.EngineEvent.deserializeBinaryFromReader(msg, reader);
 };
 
 
 /**
  * Deserializes binary data (in protobuf wire format) from the
  * given reader into the given message object.
- * @param {!proto.engine_api.StopEnclaveArgs} msg The message object to deserialize into.
+ * @param {!proto.engine_api.EngineEvent} msg The message object to deserialize into.
  * @param {!jspb.BinaryReader} reader The BinaryReader to use.
- * @return {!proto.engine_api.StopEnclaveArgs}
+ * @return {!proto.engine_api.EngineEvent}
  */
-proto.engine_api.StopEnclaveArgs.deserializeBinaryFromReader = function(msg, reader) {
+proto.engine_api.EngineEvent.deserializeBinaryFromReader = function(msg, reader) {
   while (reader.nextField()) {
     if (reader.isEndGroup()) {
       break;
@@ -2434,8 +7463,33 @@ proto.engine_api.StopEnclaveArgs.deserializeBinaryFromReader = function(msg, rea
     var field = reader.getFieldNumber();
     switch (field) {
     case 1:
+      var value = /** @type {!proto.engine_api.EngineEventType} */ (reader.readEnum());
+      msg.setType(value);
+      break;
+    case 2:
+      var value = new google_protobuf_timestamp_pb.Timestamp;
+      reader.readMessage(value,google_protobuf_timestamp_pb.Timestamp.deserializeBinaryFromReader);
+      msg.setTimestamp(value);
+      break;
+    case 3:
       var value = /** @type {string} */ (reader.readString());
-      msg.setEnclaveIdentifier(value);
+      msg.setEnclaveUuid(value);
+      break;
+    case 4:
+      var value = /** @type {string} */ (reader.readString());
+      msg.setEnclaveName(value);
+      break;
+    case 5:
+      var value = /** @type {string} */ (reader.readString());
+      msg.setServiceName(value);
+      break;
+    case 6:
+      var value = /** @type {string} */ (reader.readString());
+      msg.setStarlarkPackageId(value);
+      break;
+    case 7:
+      var value = /** @type {boolean} */ (reader.readBool());
+      msg.setStarlarkRunSucceeded(value);
       break;
     default:
       reader.skipField();
@@ -2447,210 +7501,285 @@ proto.engine_api.StopEnclaveArgs.deserializeBinaryFromReader = function(msg, rea
 
 
 /**
- * Serializes the message to binary data (in protobuf wire format).
- * @return {!Uint8Array}
+ * Serializes the message to binary data (in protobuf wire format).
+ * @return {!Uint8Array}
+ */
+proto.engine_api.EngineEvent.prototype.serializeBinary = function() {
+  var writer = new jspb.BinaryWriter();
+  proto.engine_api.EngineEvent.serializeBinaryToWriter(this, writer);
+  return writer.getResultBuffer();
+};
+
+
+/**
+ * Serializes the given message to binary data (in protobuf wire
+ * format), writing to the given BinaryWriter.
+ * @param {!proto.engine_api.EngineEvent} message
+ * @param {!jspb.BinaryWriter} writer
+ * @suppress {unusedLocalVariables} f is only used for nested messages
+ */
+proto.engine_api.EngineEvent.serializeBinaryToWriter = function(message, writer) {
+  var f = undefined;
+  f = message.getType();
+  if (f !== 0.0) {
+    writer.writeEnum(
+      1,
+      f
+    );
+  }
+  f = message.getTimestamp();
+  if (f != null) {
+    writer.writeMessage(
+      2,
+      f,
+      google_protobuf_timestamp_pb.Timestamp.serializeBinaryToWriter
+    );
+  }
+  f = message.getEnclaveUuid();
+  if (f.length > 0) {
+    writer.writeString(
+      3,
+      f
+    );
+  }
+  f = message.getEnclaveName();
+  if (f.length > 0) {
+    writer.writeString(
+      4,
+      f
+    );
+  }
+  f = /** @type {string} */ (jspb.Message.getField(message, 5));
+  if (f != null) {
+    writer.writeString(
+      5,
+      f
+    );
+  }
+  f = /** @type {string} */ (jspb.Message.getField(message, 6));
+  if (f != null) {
+    writer.writeString(
+      6,
+      f
+    );
+  }
+  f = /** @type {boolean} */ (jspb.Message.getField(message, 7));
+  if (f != null) {
+    writer.writeBool(
+      7,
+      f
+    );
+  }
+};
+
+
+/**
+ * optional EngineEventType type = 1;
+ * @return {!proto.engine_api.EngineEventType}
+ */
+proto.engine_api.EngineEvent.prototype.getType = function() {
+  return /** @type {!proto.engine_api.EngineEventType} */ (jspb.Message.getFieldWithDefault(this, 1, 0));
+};
+
+
+/**
+ * @param {!proto.engine_api.EngineEventType} value
+ * @return {!proto.engine_api.EngineEvent} returns this
+ */
+proto.engine_api.EngineEvent.prototype.setType = function(value) {
+  return jspb.Message.setProto3EnumField(this, 1, value);
+};
+
+
+/**
+ * optional google.protobuf.Timestamp timestamp = 2;
+ * @return {?proto.google.protobuf.Timestamp}
+ */
+proto.engine_api.EngineEvent.prototype.getTimestamp = function() {
+  return /** @type{?proto.google.protobuf.Timestamp} */ (
+    jspb.Message.getWrapperField(this, google_protobuf_timestamp_pb.Timestamp, 2));
+};
+
+
+/**
+ * @param {?proto.google.protobuf.Timestamp|undefined} value
+ * @return {!proto.engine_api.EngineEvent} returns this
+*/
+proto.engine_api.EngineEvent.prototype.setTimestamp = function(value) {
+  return jspb.Message.setWrapperField(this, 2, value);
+};
+
+
+/**
+ * Clears the message field making it undefined.
+ * @return {!proto.engine_api.EngineEvent} returns this
+ */
+proto.engine_api.EngineEvent.prototype.clearTimestamp = function() {
+  return this.setTimestamp(undefined);
+};
+
+
+/**
+ * Returns whether this field is set.
+ * @return {boolean}
+ */
+proto.engine_api.EngineEvent.prototype.hasTimestamp = function() {
+  return jspb.Message.getField(this, 2) != null;
+};
+
+
+/**
+ * optional string enclave_uuid = 3;
+ * @return {string}
  */
-proto.engine_api.StopEnclaveArgs.prototype.serializeBinary = function() {
-  var writer = new jspb.BinaryWriter();
-  proto.engine_api.StopEnclaveArgs.serializeBinaryToWriter(this, writer);
-  return writer.getResultBuffer();
+proto.engine_api.EngineEvent.prototype.getEnclaveUuid = function() {
+  return /** @type {string} */ (jspb.Message.getFieldWithDefault(this, 3, ""));
 };
 
 
 /**
- * Serializes the given message to binary data (in protobuf wire
- * format), writing to the given BinaryWriter.
- * @param {!proto.engine_api.StopEnclaveArgs} message
- * @param {!jspb.BinaryWriter} writer
- * @suppress {unusedLocalVariables} f is only used for nested messages
+ * @param {string} value
+ * @return {!proto.engine_api.EngineEvent} returns this
  */
-proto.engine_api.StopEnclaveArgs.serializeBinaryToWriter = function(message, writer) {
-  var f = undefined;
-  f = message.getEnclaveIdentifier();
-  if (f.length > 0) {
-    writer.writeString(
-      1,
-      f
-    );
-  }
+proto.engine_api.EngineEvent.prototype.setEnclaveUuid = function(value) {
+  return jspb.Message.setProto3StringField(this, 3, value);
 };
 
 
 /**
- * optional string enclave_identifier = 1;
+ * optional string enclave_name = 4;
  * @return {string}
  */
-proto.engine_api.StopEnclaveArgs.prototype.getEnclaveIdentifier = function() {
-  return /** @type {string} */ (jspb.Message.getFieldWithDefault(this, 1, ""));
+proto.engine_api.EngineEvent.prototype.getEnclaveName = function() {
+  return /** @type {string} */ (jspb.Message.getFieldWithDefault(this, 4, ""));
 };
 
 
 /**
  * @param {string} value
- * @return {!proto.engine_api.StopEnclaveArgs} returns this
+ * @return {!proto.engine_api.EngineEvent} returns this
  */
-proto.engine_api.StopEnclaveArgs.prototype.setEnclaveIdentifier = function(value) {
-  return jspb.Message.setProto3StringField(this, 1, value);
+proto.engine_api.EngineEvent.prototype.setEnclaveName = function(value) {
+  return jspb.Message.setProto3StringField(this, 4, value);
 };
 
 
-
 /**
- * List of repeated fields within this message type.
- * @private {!Array<number>}
- * @const
+ * optional string service_name = 5;
+ * @return {string}
  */
-proto.engine_api.GetEnclavesByUuidsArgs.repeatedFields_ = [1];
-
+proto.engine_api.EngineEvent.prototype.getServiceName = function() {
+  return /** @type {string} */ (jspb.Message.getFieldWithDefault(this, 5, ""));
+};
 
 
-if (jspb.Message.GENERATE_TO_OBJECT) {
 /**
- * Creates an object representation of this proto.
- * Field names that are reserved in JavaScript and will be renamed to pb_name.
- * Optional fields that are not set will be set to undefined.
- * To access a reserved field use, foo.pb_<name>, eg, foo.pb_default.
- * For the list of reserved names please see:
- *     net/proto2/compiler/js/internal/generator.cc#kKeyword.
- * @param {boolean=} opt_includeInstance Deprecated. whether to include the
- *     JSPB instance for transitional soy proto support:
- *     http://goto/soy-param-migration
- * @return {!Object}
+ * @param {string} value
+ * @return {!proto.engine_api.EngineEvent} returns this
  */
-proto.engine_api.GetEnclavesByUuidsArgs.prototype.toObject = function(opt_includeInstance) {
-  return proto.engine_api.GetEnclavesByUuidsArgs.toObject(opt_includeInstance, this);
+proto.engine_api.EngineEvent.prototype.setServiceName = function(value) {
+  return jspb.Message.setField(this, 5, value);
 };
 
 
 /**
- * Static version of the {@see toObject} method.
- * @param {boolean|undefined} includeInstance Deprecated. Whether to include
- *     the JSPB instance for transitional soy proto support:
- *     http://goto/soy-param-migration
- * @param {!proto.engine_api.GetEnclavesByUuidsArgs} msg The msg instance to transform.
- * @return {!Object}
- * @suppress {unusedLocalVariables} f is only used for nested messages
+ * Clears the field making it undefined.
+ * @return {!proto.engine_api.EngineEvent} returns this
  */
-proto.engine_api.GetEnclavesByUuidsArgs.toObject = function(includeInstance, msg) {
-  var f, obj = {
-    enclaveUuidsList: (f = jspb.Message.getRepeatedField(msg, 1)) == null ? undefined : f
-  };
+proto.engine_api.EngineEvent.prototype.clearServiceName = function() {
+  return jspb.Message.setField(this, 5, undefined);
+};
 
-  if (includeInstance) {
-    obj.$jspbMessageInstance = msg;
-  }
-  return obj;
+
+/**
+ * Returns whether this field is set.
+ * @return {boolean}
+ */
+proto.engine_api.EngineEvent.prototype.hasServiceName = function() {
+  return jspb.Message.getField(this, 5) != null;
 };
-}
 
 
 /**
- * Deserializes binary data (in protobuf wire format).
- * @param {jspb.ByteSource} bytes The bytes to deserialize.
- * @return {!proto.engine_api.GetEnclavesByUuidsArgs}
+ * optional string starlark_package_id = 6;
+ * @return {string}
  */
-proto.engine_api.GetEnclavesByUuidsArgs.deserializeBinary = function(bytes) {
-  var reader = new jspb.BinaryReader(bytes);
-  var msg = new proto.engine_api.GetEnclavesByUuidsArgs;
-  return proto.engine_api.GetEnclavesByUuidsArgs.deserializeBinaryFromReader(msg, reader);
+proto.engine_api.EngineEvent.prototype.getStarlarkPackageId = function() {
+  return /** @type {string} */ (jspb.Message.getFieldWithDefault(this, 6, ""));
 };
 
 
 /**
- * Deserializes binary data (in protobuf wire format) from the
- * given reader into the given message object.
- * @param {!proto.engine_api.GetEnclavesByUuidsArgs} msg The message object to deserialize into.
- * @param {!jspb.BinaryReader} reader The BinaryReader to use.
- * @return {!proto.engine_api.GetEnclavesByUuidsArgs}
+ * @param {string} value
+ * @return {!proto.engine_api.EngineEvent} returns this
  */
-proto.engine_api.GetEnclavesByUuidsArgs.deserializeBinaryFromReader = function(msg, reader) {
-  while (reader.nextField()) {
-    if (reader.isEndGroup()) {
-      break;
-    }
-    var field = reader.getFieldNumber();
-    switch (field) {
-    case 1:
-      var value = /** @type {string} */ (reader.readString());
-      msg.addEnclaveUuids(value);
-      break;
-    default:
-      reader.skipField();
-      break;
-    }
-  }
-  return msg;
+proto.engine_api.EngineEvent.prototype.setStarlarkPackageId = function(value) {
+  return jspb.Message.setField(this, 6, value);
 };
 
 
 /**
- * Serializes the message to binary data (in protobuf wire format).
- * @return {!Uint8Array}
+ * Clears the field making it undefined.
+ * @return {!proto.engine_api.EngineEvent} returns this
  */
-proto.engine_api.GetEnclavesByUuidsArgs.prototype.serializeBinary = function() {
-  var writer = new jspb.BinaryWriter();
-  proto.engine_api.GetEnclavesByUuidsArgs.serializeBinaryToWriter(this, writer);
-  return writer.getResultBuffer();
+proto.engine_api.EngineEvent.prototype.clearStarlarkPackageId = function() {
+  return jspb.Message.setField(this, 6, undefined);
 };
 
 
 /**
- * Serializes the given message to binary data (in protobuf wire
- * format), writing to the given BinaryWriter.
- * @param {!proto.engine_api.GetEnclavesByUuidsArgs} message
- * @param {!jspb.BinaryWriter} writer
- * @suppress {unusedLocalVariables} f is only used for nested messages
+ * Returns whether this field is set.
+ * @return {boolean}
  */
-proto.engine_api.GetEnclavesByUuidsArgs.serializeBinaryToWriter = function(message, writer) {
-  var f = undefined;
-  f = message.getEnclaveUuidsList();
-  if (f.length > 0) {
-    writer.writeRepeatedString(
-      1,
-      f
-    );
-  }
+proto.engine_api.EngineEvent.prototype.hasStarlarkPackageId = function() {
+  return jspb.Message.getField(this, 6) != null;
 };
 
 
 /**
- * repeated string enclave_uuids = 1;
- * @return {!Array<string>}
+ * optional bool starlark_run_succeeded = 7;
+ * @return {boolean}
  */
-proto.engine_api.GetEnclavesByUuidsArgs.prototype.getEnclaveUuidsList = function() {
-  return /** @type {!Array<string>} */ (jspb.Message.getRepeatedField(this, 1));
+proto.engine_api.EngineEvent.prototype.getStarlarkRunSucceeded = function() {
+  return /** @type {boolean} */ (jspb.Message.getBooleanFieldWithDefault(this, 7, false));
 };
 
 
 /**
- * @param {!Array<string>} value
- * @return {!proto.engine_api.GetEnclavesByUuidsArgs} returns this
+ * @param {boolean} value
+ * @return {!proto.engine_api.EngineEvent} returns this
  */
-proto.engine_api.GetEnclavesByUuidsArgs.prototype.setEnclaveUuidsList = function(value) {
-  return jspb.Message.setField(this, 1, value || []);
+proto.engine_api.EngineEvent.prototype.setStarlarkRunSucceeded = function(value) {
+  return jspb.Message.setField(this, 7, value);
 };
 
 
 /**
- * @param {string} value
- * @param {number=} opt_index
- * @return {!proto.engine_api.GetEnclavesByUuidsArgs} returns this
+ * Clears the field making it undefined.
+ * @return {!proto.engine_api.EngineEvent} returns this
  */
-proto.engine_api.GetEnclavesByUuidsArgs.prototype.addEnclaveUuids = function(value, opt_index) {
-  return jspb.Message.addToRepeatedField(this, 1, value, opt_index);
+proto.engine_api.EngineEvent.prototype.clearStarlarkRunSucceeded = function() {
+  return jspb.Message.setField(this, 7, undefined);
 };
 
 
 /**
- * Clears the list making it empty but non-null.
- * @return {!proto.engine_api.GetEnclavesByUuidsArgs} returns this
+ * Returns whether this field is set.
+ * @return {boolean}
  */
-proto.engine_api.GetEnclavesByUuidsArgs.prototype.clearEnclaveUuidsList = function() {
-  return this.setEnclaveUuidsList([]);
+proto.engine_api.EngineEvent.prototype.hasStarlarkRunSucceeded = function() {
+  return jspb.Message.getField(this, 7) != null;
 };
 
 
 
+/**
+ * List of repeated fields within this message type.
+ * @private {!Array<number>}
+ * @const
+ */
+proto.engine_api.CreateApiTokenArgs.repeatedFields_ = [3];
+
 
 
 if (jspb.Message.GENERATE_TO_OBJECT) {
@@ -2666,8 +7795,8 @@ if (jspb.Message.GENERATE_TO_OBJECT) {
  *     http://goto/soy-param-migration
  * @return {!Object}
  */
-proto.engine_api.DestroyEnclaveArgs.prototype.toObject = function(opt_includeInstance) {
-  return proto.engine_api.DestroyEnclaveArgs.toObject(opt_includeInstance, this);
+proto.engine_api.CreateApiTokenArgs.prototype.toObject = function(opt_includeInstance) {
+  return proto.engine_api.CreateApiTokenArgs.toObject(opt_includeInstance, this);
 };
 
 
@@ -2676,13 +7805,15 @@ proto.engine_api.DestroyEnclaveArgs.prototype.toObject = function(opt_includeIns
  * @param {boolean|undefined} includeInstance Deprecated. Whether to include
  *     the JSPB instance for transitional soy proto support:
  *     http://goto/soy-param-migration
- * @param {!proto.engine_api.DestroyEnclaveArgs} msg The msg instance to transform.
+ * @param {!proto.engine_api.CreateApiTokenArgs} msg The msg instance to transform.
  * @return {!Object}
  * @suppress {unusedLocalVariables} f is only used for nested messages
  */
-proto.engine_api.DestroyEnclaveArgs.toObject = function(includeInstance, msg) {
+proto.engine_api.CreateApiTokenArgs.toObject = function(includeInstance, msg) {
   var f, obj = {
-    enclaveIdentifier: jspb.Message.getFieldWithDefault(msg, 1, "")
+    name: jspb.Message.getFieldWithDefault(msg, 1, ""),
+    role: jspb.Message.getFieldWithDefault(msg, 2, 0),
+    enclaveNamesList: (f = jspb.Message.getRepeatedField(msg, 3)) == null ? undefined : f
   };
 
   if (includeInstance) {
@@ -2696,23 +7827,23 @@ proto.engine_api.DestroyEnclaveArgs.toObject = function(includeInstance, msg) {
 /**
  * Deserializes binary data (in protobuf wire format).
  * @param {jspb.ByteSource} bytes The bytes to deserialize.
- * @return {!proto.engine_api.DestroyEnclaveArgs}
+ * @return {!proto.engine_api.CreateApiTokenArgs}
  */
-proto.engine_api.DestroyEnclaveArgs.deserializeBinary = function(bytes) {
+proto.engine_api.CreateApiTokenArgs.deserializeBinary = function(bytes) {
   var reader = new jspb.BinaryReader(bytes);
-  var msg = new proto.engine_api.DestroyEnclaveArgs;
-  return proto.engine_api.DestroyEnclaveArgs.deserializeBinaryFromReader(msg, reader);
+  var msg = new proto.engine_api.CreateApiTokenArgs;
+  return proto.engine_api.CreateApiTokenArgs.deserializeBinaryFromReader(msg, reader);
 };
 
 
 /**
  * Deserializes binary data (in protobuf wire format) from the
  * given reader into the given message object.
- * @param {!proto.engine_api.DestroyEnclaveArgs} msg The message object to deserialize into.
+ * @param {!proto.engine_api.CreateApiTokenArgs} msg The message object to deserialize into.
  * @param {!jspb.BinaryReader} reader The BinaryReader to use.
- * @return {!proto.engine_api.DestroyEnclaveArgs}
+ * @return {!proto.engine_api.CreateApiTokenArgs}
  */
-proto.engine_api.DestroyEnclaveArgs.deserializeBinaryFromReader = function(msg, reader) {
+proto.engine_api.CreateApiTokenArgs.deserializeBinaryFromReader = function(msg, reader) {
   while (reader.nextField()) {
     if (reader.isEndGroup()) {
       break;
@@ -2721,7 +7852,15 @@ proto.engine_api.DestroyEnclaveArgs.deserializeBinaryFromReader = function(msg,
     switch (field) {
     case 1:
       var value = /** @type {string} */ (reader.readString());
-      msg.setEnclaveIdentifier(value);
+      msg.setName(value);
+      break;
+    case 2:
+      var value = /** @type {!proto.engine_api.ApiTokenRole} */ (reader.readEnum());
+      msg.setRole(value);
+      break;
+    case 3:
+      var value = /** @type {string} */ (reader.readString());
+      msg.addEnclaveNames(value);
       break;
     default:
       reader.skipField();
@@ -2736,9 +7875,9 @@ proto.engine_api.DestroyEnclaveArgs.deserializeBinaryFromReader = function(msg,
  * Serializes the message to binary data (in protobuf wire format).
  * @return {!Uint8Array}
  */
-proto.engine_api.DestroyEnclaveArgs.prototype.serializeBinary = function() {
+proto.engine_api.CreateApiTokenArgs.prototype.serializeBinary = function() {
   var writer = new jspb.BinaryWriter();
-  proto.engine_api.DestroyEnclaveArgs.serializeBinaryToWriter(this, writer);
+  proto.engine_api.CreateApiTokenArgs.serializeBinaryToWriter(this, writer);
   return writer.getResultBuffer();
 };
 
@@ -2746,40 +7885,109 @@ proto.engine_api.DestroyEnclaveArgs.prototype.serializeBinary = function() {
 /**
  * Serializes the given message to binary data (in protobuf wire
  * format), writing to the given BinaryWriter.
- * @param {!proto.engine_api.DestroyEnclaveArgs} message
+ * @param {!proto.engine_api.CreateApiTokenArgs} message
  * @param {!jspb.BinaryWriter} writer
  * @suppress {unusedLocalVariables} f is only used for nested messages
  */
-proto.engine_api.DestroyEnclaveArgs.serializeBinaryToWriter = function(message, writer) {
+proto.engine_api.CreateApiTokenArgs.serializeBinaryToWriter = function(message, writer) {
   var f = undefined;
-  f = message.getEnclaveIdentifier();
+  f = message.getName();
   if (f.length > 0) {
     writer.writeString(
       1,
       f
     );
   }
+  f = message.getRole();
+  if (f !== 0.0) {
+    writer.writeEnum(
+      2,
+      f
+    );
+  }
+  f = message.getEnclaveNamesList();
+  if (f.length > 0) {
+    writer.writeRepeatedString(
+      3,
+      f
+    );
+  }
 };
 
 
 /**
- * optional string enclave_identifier = 1;
+ * optional string name = 1;
  * @return {string}
  */
-proto.engine_api.DestroyEnclaveArgs.prototype.getEnclaveIdentifier = function() {
+proto.engine_api.CreateApiTokenArgs.prototype.getName = function() {
   return /** @type {string} */ (jspb.Message.getFieldWithDefault(this, 1, ""));
 };
 
 
 /**
  * @param {string} value
- * @return {!proto.engine_api.DestroyEnclaveArgs} returns this
+ * @return {!proto.engine_api.CreateApiTokenArgs} returns this
  */
-proto.engine_api.DestroyEnclaveArgs.prototype.setEnclaveIdentifier = function(value) {
+proto.engine_api.CreateApiTokenArgs.prototype.setName = function(value) {
   return jspb.Message.setProto3StringField(this, 1, value);
 };
 
 
+/**
+ * optional ApiTokenRole role = 2;
+ * @return {!proto.engine_api.ApiTokenRole}
+ */
+proto.engine_api.CreateApiTokenArgs.prototype.getRole = function() {
+  return /** @type {!proto.engine_api.ApiTokenRole} */ (jspb.Message.getFieldWithDefault(this, 2, 0));
+};
+
+
+/**
+ * @param {!proto.engine_api.ApiTokenRole} value
+ * @return {!proto.engine_api.CreateApiTokenArgs} returns this
+ */
+proto.engine_api.CreateApiTokenArgs.prototype.setRole = function(value) {
+  return jspb.Message.setProto3EnumField(this, 2, value);
+};
+
+
+/**
+ * repeated string enclave_names = 3;
+ * @return {!Array<string>}
+ */
+proto.engine_api.CreateApiTokenArgs.prototype.getEnclaveNamesList = function() {
+  return /** @type {!Array<string>} */ (jspb.Message.getRepeatedField(this, 3));
+};
+
+
+/**
+ * @param {!Array<string>} value
+ * @return {!proto.engine_api.CreateApiTokenArgs} returns this
+ */
+proto.engine_api.CreateApiTokenArgs.prototype.setEnclaveNamesList = function(value) {
+  return jspb.Message.setField(this, 3, value || []);
+};
+
+
+/**
+ * @param {string} value
+ * @param {number=} opt_index
+ * @return {!proto.engine_api.CreateApiTokenArgs} returns this
+ */
+proto.engine_api.CreateApiTokenArgs.prototype.addEnclaveNames = function(value, opt_index) {
+  return jspb.Message.addToRepeatedField(this, 3, value, opt_index);
+};
+
+
+/**
+ * Clears the list making it empty but non-null.
+ * @return {!proto.engine_api.CreateApiTokenArgs} returns this
+ */
+proto.engine_api.CreateApiTokenArgs.prototype.clearEnclaveNamesList = function() {
+  return this.setEnclaveNamesList([]);
+};
+
+
 
 
 
@@ -2796,8 +8004,8 @@ if (jspb.Message.GENERATE_TO_OBJECT) {
  *     http://goto/soy-param-migration
  * @return {!Object}
  */
-proto.engine_api.CleanArgs.prototype.toObject = function(opt_includeInstance) {
-  return proto.engine_api.CleanArgs.toObject(opt_includeInstance, this);
+proto.engine_api.CreateApiTokenResponse.prototype.toObject = function(opt_includeInstance) {
+  return proto.engine_api.CreateApiTokenResponse.toObject(opt_includeInstance, this);
 };
 
 
@@ -2806,13 +8014,14 @@ proto.engine_api.CleanArgs.prototype.toObject = function(opt_includeInstance) {
  * @param {boolean|undefined} includeInstance Deprecated. Whether to include
  *     the JSPB instance for transitional soy proto support:
  *     http://goto/soy-param-migration
- * @param {!proto.engine_api.CleanArgs} msg The msg instance to transform.
+ * @param {!proto.engine_api.CreateApiTokenResponse} msg The msg instance to transform.
  * @return {!Object}
  * @suppress {unusedLocalVariables} f is only used for nested messages
  */
-proto.engine_api.CleanArgs.toObject = function(includeInstance, msg) {
+proto.engine_api.CreateApiTokenResponse.toObject = function(includeInstance, msg) {
   var f, obj = {
-    shouldCleanAll: jspb.Message.getBooleanFieldWithDefault(msg, 1, false)
+    tokenId: jspb.Message.getFieldWithDefault(msg, 1, ""),
+    token: jspb.Message.getFieldWithDefault(msg, 2, "")
   };
 
   if (includeInstance) {
@@ -2826,23 +8035,23 @@ proto.engine_api.CleanArgs.toObject = function(includeInstance, msg) {
 /**
  * Deserializes binary data (in protobuf wire format).
  * @param {jspb.ByteSource} bytes The bytes to deserialize.
- * @return {!proto.engine_api.CleanArgs}
+ * @return {!proto.engine_api.CreateApiTokenResponse}
  */
-proto.engine_api.CleanArgs.deserializeBinary = function(bytes) {
+proto.engine_api.CreateApiTokenResponse.deserializeBinary = function(bytes) {
   var reader = new jspb.BinaryReader(bytes);
-  var msg = new proto.engine_api.CleanArgs;
-  return proto.engine_api.CleanArgs.deserializeBinaryFromReader(msg, reader);
+  var msg = new proto.engine_api.CreateApiTokenResponse;
+  return proto.engine_api.CreateApiTokenResponse.deserializeBinaryFromReader(msg, reader);
 };
 
 
 /**
  * Deserializes binary data (in protobuf wire format) from the
  * given reader into the given message object.
- * @param {!proto.engine_api.CleanArgs} msg The message object to deserialize into.
+ * @param {!proto.engine_api.CreateApiTokenResponse} msg The message object to deserialize into.
  * @param {!jspb.BinaryReader} reader The BinaryReader to use.
- * @return {!proto.engine_api.CleanArgs}
+ * @return {!proto.engine_api.CreateApiTokenResponse}
  */
-proto.engine_api.CleanArgs.deserializeBinaryFromReader = function(msg, reader) {
+proto.engine_api.CreateApiTokenResponse.deserializeBinaryFromReader = function(msg, reader) {
   while (reader.nextField()) {
     if (reader.isEndGroup()) {
       break;
@@ -2850,8 +8059,12 @@ proto.engine_api.CleanArgs.deserializeBinaryFromReader = function(msg, reader) {
     var field = reader.getFieldNumber();
     switch (field) {
     case 1:
-      var value = /** @type {boolean} */ (reader.readBool());
-      msg.setShouldCleanAll(value);
+      var value = /** @type {string} */ (reader.readString());
+      msg.setTokenId(value);
+      break;
+    case 2:
+      var value = /** @type {string} */ (reader.readString());
+      msg.setToken(value);
       break;
     default:
       reader.skipField();
@@ -2866,9 +8079,9 @@ proto.engine_api.CleanArgs.deserializeBinaryFromReader = function(msg, reader) {
  * Serializes the message to binary data (in protobuf wire format).
  * @return {!Uint8Array}
  */
-proto.engine_api.CleanArgs.prototype.serializeBinary = function() {
+proto.engine_api.CreateApiTokenResponse.prototype.serializeBinary = function() {
   var writer = new jspb.BinaryWriter();
-  proto.engine_api.CleanArgs.serializeBinaryToWriter(this, writer);
+  proto.engine_api.CreateApiTokenResponse.serializeBinaryToWriter(this, writer);
   return writer.getResultBuffer();
 };
 
@@ -2876,55 +8089,62 @@ proto.engine_api.CleanArgs.prototype.serializeBinary = function() {
 /**
  * Serializes the given message to binary data (in protobuf wire
  * format), writing to the given BinaryWriter.
- * @param {!proto.engine_api.CleanArgs} message
+ * @param {!proto.engine_api.CreateApiTokenResponse} message
  * @param {!jspb.BinaryWriter} writer
  * @suppress {unusedLocalVariables} f is only used for nested messages
  */
-proto.engine_api.CleanArgs.serializeBinaryToWriter = function(message, writer) {
+proto.engine_api.CreateApiTokenResponse.serializeBinaryToWriter = function(message, writer) {
   var f = undefined;
-  f = /** @type {boolean} */ (jspb.Message.getField(message, 1));
-  if (f != null) {
-    writer.writeBool(
+  f = message.getTokenId();
+  if (f.length > 0) {
+    writer.writeString(
       1,
       f
     );
   }
+  f = message.getToken();
+  if (f.length > 0) {
+    writer.writeString(
+      2,
+      f
+    );
+  }
 };
 
 
 /**
- * optional bool should_clean_all = 1;
- * @return {boolean}
+ * optional string token_id = 1;
+ * @return {string}
  */
-proto.engine_api.CleanArgs.prototype.getShouldCleanAll = function() {
-  return /** @type {boolean} */ (jspb.Message.getBooleanFieldWithDefault(this, 1, false));
+proto.engine_api.CreateApiTokenResponse.prototype.getTokenId = function() {
+  return /** @type {string} */ (jspb.Message.getFieldWithDefault(this, 1, ""));
 };
 
 
 /**
- * @param {boolean} value
- * @return {!proto.engine_api.CleanArgs} returns this
+ * @param {string} value
+ * @return {!proto.engine_api.CreateApiTokenResponse} returns this
  */
-proto.engine_api.CleanArgs.prototype.setShouldCleanAll = function(value) {
-  return jspb.Message.setField(this, 1, value);
+proto.engine_api.CreateApiTokenResponse.prototype.setTokenId = function(value) {
+  return jspb.Message.setProto3StringField(this, 1, value);
 };
 
 
 /**
- * Clears the field making it undefined.
- * @return {!proto.engine_api.CleanArgs} returns this
+ * optional string token = 2;
+ * @return {string}
  */
-proto.engine_api.CleanArgs.prototype.clearShouldCleanAll = function() {
-  return jspb.Message.setField(this, 1, undefined);
+proto.engine_api.CreateApiTokenResponse.prototype.getToken = function() {
+  return /** @type {string} */ (jspb.Message.getFieldWithDefault(this, 2, ""));
 };
 
 
 /**
- * Returns whether this field is set.
- * @return {boolean}
+ * @param {string} value
+ * @return {!proto.engine_api.CreateApiTokenResponse} returns this
  */
-proto.engine_api.CleanArgs.prototype.hasShouldCleanAll = function() {
-  return jspb.Message.getField(this, 1) != null;
+proto.engine_api.CreateApiTokenResponse.prototype.setToken = function(value) {
+  return jspb.Message.setProto3StringField(this, 2, value);
 };
 
 
@@ -2944,8 +8164,8 @@ if (jspb.Message.GENERATE_TO_OBJECT) {
  *     http://goto/soy-param-migration
  * @return {!Object}
  */
-proto.engine_api.EnclaveNameAndUuid.prototype.toObject = function(opt_includeInstance) {
-  return proto.engine_api.EnclaveNameAndUuid.toObject(opt_includeInstance, this);
+proto.engine_api.RevokeApiTokenArgs.prototype.toObject = function(opt_includeInstance) {
+  return proto.engine_api.RevokeApiTokenArgs.toObject(opt_includeInstance, this);
 };
 
 
@@ -2954,14 +8174,13 @@ proto.engine_api.EnclaveNameAndUuid.prototype.toObject = function(opt_includeIns
  * @param {boolean|undefined} includeInstance Deprecated. Whether to include
  *     the JSPB instance for transitional soy proto support:
  *     http://goto/soy-param-migration
- * @param {!proto.engine_api.EnclaveNameAndUuid} msg The msg instance to transform.
+ * @param {!proto.engine_api.RevokeApiTokenArgs} msg The msg instance to transform.
  * @return {!Object}
  * @suppress {unusedLocalVariables} f is only used for nested messages
  */
-proto.engine_api.EnclaveNameAndUuid.toObject = function(includeInstance, msg) {
+proto.engine_api.RevokeApiTokenArgs.toObject = function(includeInstance, msg) {
   var f, obj = {
-    name: jspb.Message.getFieldWithDefault(msg, 1, ""),
-    uuid: jspb.Message.getFieldWithDefault(msg, 2, "")
+    tokenId: jspb.Message.getFieldWithDefault(msg, 1, "")
   };
 
   if (includeInstance) {
@@ -2975,23 +8194,23 @@ proto.engine_api.EnclaveNameAndUuid.toObject = function(includeInstance, msg) {
 /**
  * Deserializes binary data (in protobuf wire format).
  * @param {jspb.ByteSource} bytes The bytes to deserialize.
- * @return {!proto.engine_api.EnclaveNameAndUuid}
+ * @return {!proto.engine_api.RevokeApiTokenArgs}
  */
-proto.engine_api.EnclaveNameAndUuid.deserializeBinary = function(bytes) {
+proto.engine_api.RevokeApiTokenArgs.deserializeBinary = function(bytes) {
   var reader = new jspb.BinaryReader(bytes);
-  var msg = new proto.engine_api.EnclaveNameAndUuid;
-  return proto.engine_api.EnclaveNameAndUuid.deserializeBinaryFromReader(msg, reader);
+  var msg = new proto.engine_api.RevokeApiTokenArgs;
+  return proto.engine_api.RevokeApiTokenArgs.deserializeBinaryFromReader(msg, reader);
 };
 
 
 /**
  * Deserializes binary data (in protobuf wire format) from the
  * given reader into the given message object.
- * @param {!proto.engine_api.EnclaveNameAndUuid} msg The message object to deserialize into.
+ * @param {!proto.engine_api.RevokeApiTokenArgs} msg The message object to deserialize into.
  * @param {!jspb.BinaryReader} reader The BinaryReader to use.
- * @return {!proto.engine_api.EnclaveNameAndUuid}
+ * @return {!proto.engine_api.RevokeApiTokenArgs}
  */
-proto.engine_api.EnclaveNameAndUuid.deserializeBinaryFromReader = function(msg, reader) {
+proto.engine_api.RevokeApiTokenArgs.deserializeBinaryFromReader = function(msg, reader) {
   while (reader.nextField()) {
     if (reader.isEndGroup()) {
       break;
@@ -3000,11 +8219,7 @@ proto.engine_api.EnclaveNameAndUuid.deserializeBinaryFromReader = function(msg,
     switch (field) {
     case 1:
       var value = /** @type {string} */ (reader.readString());
-      msg.setName(value);
-      break;
-    case 2:
-      var value = /** @type {string} */ (reader.readString());
-      msg.setUuid(value);
+      msg.setTokenId(value);
       break;
     default:
       reader.skipField();
@@ -3019,9 +8234,9 @@ proto.engine_api.EnclaveNameAndUuid.deserializeBinaryFromReader = function(msg,
  * Serializes the message to binary data (in protobuf wire format).
  * @return {!Uint8Array}
  */
-proto.engine_api.EnclaveNameAndUuid.prototype.serializeBinary = function() {
+proto.engine_api.RevokeApiTokenArgs.prototype.serializeBinary = function() {
   var writer = new jspb.BinaryWriter();
-  proto.engine_api.EnclaveNameAndUuid.serializeBinaryToWriter(this, writer);
+  proto.engine_api.RevokeApiTokenArgs.serializeBinaryToWriter(this, writer);
   return writer.getResultBuffer();
 };
 
@@ -3029,72 +8244,40 @@ proto.engine_api.EnclaveNameAndUuid.prototype.serializeBinary = function() {
 /**
  * Serializes the given message to binary data (in protobuf wire
  * format), writing to the given BinaryWriter.
- * @param {!proto.engine_api.EnclaveNameAndUuid} message
+ * @param {!proto.engine_api.RevokeApiTokenArgs} message
  * @param {!jspb.BinaryWriter} writer
  * @suppress {unusedLocalVariables} f is only used for nested messages
  */
-proto.engine_api.EnclaveNameAndUuid.serializeBinaryToWriter = function(message, writer) {
+proto.engine_api.RevokeApiTokenArgs.serializeBinaryToWriter = function(message, writer) {
   var f = undefined;
-  f = message.getName();
+  f = message.getTokenId();
   if (f.length > 0) {
     writer.writeString(
       1,
       f
     );
   }
-  f = message.getUuid();
-  if (f.length > 0) {
-    writer.writeString(
-      2,
-      f
-    );
-  }
 };
 
 
 /**
- * optional string name = 1;
+ * optional string token_id = 1;
  * @return {string}
  */
-proto.engine_api.EnclaveNameAndUuid.prototype.getName = function() {
+proto.engine_api.RevokeApiTokenArgs.prototype.getTokenId = function() {
   return /** @type {string} */ (jspb.Message.getFieldWithDefault(this, 1, ""));
 };
 
 
 /**
  * @param {string} value
- * @return {!proto.engine_api.EnclaveNameAndUuid} returns this
+ * @return {!proto.engine_api.RevokeApiTokenArgs} returns this
  */
-proto.engine_api.EnclaveNameAndUuid.prototype.setName = function(value) {
+proto.engine_api.RevokeApiTokenArgs.prototype.setTokenId = function(value) {
   return jspb.Message.setProto3StringField(this, 1, value);
 };
 
 
-/**
- * optional string uuid = 2;
- * @return {string}
- */
-proto.engine_api.EnclaveNameAndUuid.prototype.getUuid = function() {
-  return /** @type {string} */ (jspb.Message.getFieldWithDefault(this, 2, ""));
-};
-
-
-/**
- * @param {string} value
- * @return {!proto.engine_api.EnclaveNameAndUuid} returns this
- */
-proto.engine_api.EnclaveNameAndUuid.prototype.setUuid = function(value) {
-  return jspb.Message.setProto3StringField(this, 2, value);
-};
-
-
-
-/**
- * List of repeated fields within this message type.
- * @private {!Array<number>}
- * @const
- */
-proto.engine_api.CleanResponse.repeatedFields_ = [1];
 
 
 
@@ -3111,8 +8294,8 @@ if (jspb.Message.GENERATE_TO_OBJECT) {
  *     http://goto/soy-param-migration
  * @return {!Object}
  */
-proto.engine_api.CleanResponse.prototype.toObject = function(opt_includeInstance) {
-  return proto.engine_api.CleanResponse.toObject(opt_includeInstance, this);
+proto.engine_api.GetAuditLogArgs.prototype.toObject = function(opt_includeInstance) {
+  return proto.engine_api.GetAuditLogArgs.toObject(opt_includeInstance, this);
 };
 
 
@@ -3121,14 +8304,15 @@ proto.engine_api.CleanResponse.prototype.toObject = function(opt_includeInstance
  * @param {boolean|undefined} includeInstance Deprecated. Whether to include
  *     the JSPB instance for transitional soy proto support:
  *     http://goto/soy-param-migration
- * @param {!proto.engine_api.CleanResponse} msg The msg instance to transform.
+ * @param {!proto.engine_api.GetAuditLogArgs} msg The msg instance to transform.
  * @return {!Object}
  * @suppress {unusedLocalVariables} f is only used for nested messages
  */
-proto.engine_api.CleanResponse.toObject = function(includeInstance, msg) {
+proto.engine_api.GetAuditLogArgs.toObject = function(includeInstance, msg) {
   var f, obj = {
-    removedEnclaveNameAndUuidsList: jspb.Message.toObjectList(msg.getRemovedEnclaveNameAndUuidsList(),
-    proto.engine_api.EnclaveNameAndUuid.toObject, includeInstance)
+    enclaveIdentifier: jspb.Message.getFieldWithDefault(msg, 1, ""),
+    pageSize: jspb.Message.getFieldWithDefault(msg, 2, 0),
+    pageToken: jspb.Message.getFieldWithDefault(msg, 3, "")
   };
 
   if (includeInstance) {
@@ -3142,23 +8326,23 @@ proto.engine_api.CleanResponse.toObject = function(includeInstance, msg) {
 /**
  * Deserializes binary data (in protobuf wire format).
  * @param {jspb.ByteSource} bytes The bytes to deserialize.
- * @return {!proto.engine_api.CleanResponse}
+ * @return {!proto.engine_api.GetAuditLogArgs}
  */
-proto.engine_api.CleanResponse.deserializeBinary = function(bytes) {
+proto.engine_api.GetAuditLogArgs.deserializeBinary = function(bytes) {
   var reader = new jspb.BinaryReader(bytes);
-  var msg = new proto.engine_api.CleanResponse;
-  return proto.engine_api.CleanResponse.deserializeBinaryFromReader(msg, reader);
+  var msg = new proto.engine_api.GetAuditLogArgs;
+  return proto.engine_api.GetAuditLogArgs.deserializeBinaryFromReader(msg, reader);
 };
 
 
 /**
  * Deserializes binary data (in protobuf wire format) from the
  * given reader into the given message object.
- * @param {!proto.engine_api.CleanResponse} msg The message object to deserialize into.
+ * @param {!proto.engine_api.GetAuditLogArgs} msg The message object to deserialize into.
  * @param {!jspb.BinaryReader} reader The BinaryReader to use.
- * @return {!proto.engine_api.CleanResponse}
+ * @return {!proto.engine_api.GetAuditLogArgs}
  */
-proto.engine_api.CleanResponse.deserializeBinaryFromReader = function(msg, reader) {
+proto.engine_api.GetAuditLogArgs.deserializeBinaryFromReader = function(msg, reader) {
   while (reader.nextField()) {
     if (reader.isEndGroup()) {
       break;
@@ -3166,9 +8350,16 @@ proto.engine_api.CleanResponse.deserializeBinaryFromReader = function(msg, reade
     var field = reader.getFieldNumber();
     switch (field) {
     case 1:
-      var value = new proto.engine_api.EnclaveNameAndUuid;
-      reader.readMessage(value,proto.engine_api.EnclaveNameAndUuid.deserializeBinaryFromReader);
-      msg.addRemovedEnclaveNameAndUuids(value);
+      var value = /** @type {string} */ (reader.readString());
+      msg.setEnclaveIdentifier(value);
+      break;
+    case 2:
+      var value = /** @type {number} */ (reader.readUint32());
+      msg.setPageSize(value);
+      break;
+    case 3:
+      var value = /** @type {string} */ (reader.readString());
+      msg.setPageToken(value);
       break;
     default:
       reader.skipField();
@@ -3183,9 +8374,9 @@ proto.engine_api.CleanResponse.deserializeBinaryFromReader = function(msg, reade
  * Serializes the message to binary data (in protobuf wire format).
  * @return {!Uint8Array}
  */
-proto.engine_api.CleanResponse.prototype.serializeBinary = function() {
+proto.engine_api.GetAuditLogArgs.prototype.serializeBinary = function() {
   var writer = new jspb.BinaryWriter();
-  proto.engine_api.CleanResponse.serializeBinaryToWriter(this, writer);
+  proto.engine_api.GetAuditLogArgs.serializeBinaryToWriter(this, writer);
   return writer.getResultBuffer();
 };
 
@@ -3193,68 +8384,144 @@ proto.engine_api.CleanResponse.prototype.serializeBinary = function() {
 /**
  * Serializes the given message to binary data (in protobuf wire
  * format), writing to the given BinaryWriter.
- * @param {!proto.engine_api.CleanResponse} message
+ * @param {!proto.engine_api.GetAuditLogArgs} message
  * @param {!jspb.BinaryWriter} writer
  * @suppress {unusedLocalVariables} f is only used for nested messages
  */
-proto.engine_api.CleanResponse.serializeBinaryToWriter = function(message, writer) {
+proto.engine_api.GetAuditLogArgs.serializeBinaryToWriter = function(message, writer) {
   var f = undefined;
-  f = message.getRemovedEnclaveNameAndUuidsList();
-  if (f.length > 0) {
-    writer.writeRepeatedMessage(
+  f = /** @type {string} */ (jspb.Message.getField(message, 1));
+  if (f != null) {
+    writer.writeString(
       1,
-      f,
-      proto.engine_api.EnclaveNameAndUuid.serializeBinaryToWriter
+      f
+    );
+  }
+  f = /** @type {number} */ (jspb.Message.getField(message, 2));
+  if (f != null) {
+    writer.writeUint32(
+      2,
+      f
+    );
+  }
+  f = /** @type {string} */ (jspb.Message.getField(message, 3));
+  if (f != null) {
+    writer.writeString(
+      3,
+      f
     );
   }
 };
 
 
 /**
- * repeated EnclaveNameAndUuid removed_enclave_name_and_uuids = 1;
- * @return {!Array<!proto.engine_api.EnclaveNameAndUuid>}
+ * optional string enclave_identifier = 1;
+ * @return {string}
  */
-proto.engine_api.CleanResponse.prototype.getRemovedEnclaveNameAndUuidsList = function() {
-  return /** @type{!Array<!proto.engine_api.EnclaveNameAndUuid>} */ (
-    jspb.Message.getRepeatedWrapperField(this, proto.engine_api.EnclaveNameAndUuid, 1));
+proto.engine_api.GetAuditLogArgs.prototype.getEnclaveIdentifier = function() {
+  return /** @type {string} */ (jspb.Message.getFieldWithDefault(this, 1, ""));
 };
 
 
 /**
- * @param {!Array<!proto.engine_api.EnclaveNameAndUuid>} value
- * @return {!proto.engine_api.CleanResponse} returns this
-*/
-proto.engine_api.CleanResponse.prototype.setRemovedEnclaveNameAndUuidsList = function(value) {
-  return jspb.Message.setRepeatedWrapperField(this, 1, value);
+ * @param {string} value
+ * @return {!proto.engine_api.GetAuditLogArgs} returns this
+ */
+proto.engine_api.GetAuditLogArgs.prototype.setEnclaveIdentifier = function(value) {
+  return jspb.Message.setField(this, 1, value);
 };
 
 
 /**
- * @param {!proto.engine_api.EnclaveNameAndUuid=} opt_value
- * @param {number=} opt_index
- * @return {!proto.engine_api.EnclaveNameAndUuid}
+ * Clears the field making it undefined.
+ * @return {!proto.engine_api.GetAuditLogArgs} returns this
  */
-proto.engine_api.CleanResponse.prototype.addRemovedEnclaveNameAndUuids = function(opt_value, opt_index) {
-  return jspb.Message.addToRepeatedWrapperField(this, 1, opt_value, proto.engine_api.EnclaveNameAndUuid, opt_index);
+proto.engine_api.GetAuditLogArgs.prototype.clearEnclaveIdentifier = function() {
+  return jspb.Message.setField(this, 1, undefined);
 };
 
 
 /**
- * Clears the list making it empty but non-null.
- * @return {!proto.engine_api.CleanResponse} returns this
+ * Returns whether this field is set.
+ * @return {boolean}
  */
-proto.engine_api.CleanResponse.prototype.clearRemovedEnclaveNameAndUuidsList = function() {
-  return this.setRemovedEnclaveNameAndUuidsList([]);
+proto.engine_api.GetAuditLogArgs.prototype.hasEnclaveIdentifier = function() {
+  return jspb.Message.getField(this, 1) != null;
 };
 
 
+/**
+ * optional uint32 page_size = 2;
+ * @return {number}
+ */
+proto.engine_api.GetAuditLogArgs.prototype.getPageSize = function() {
+  return /** @type {number} */ (jspb.Message.getFieldWithDefault(this, 2, 0));
+};
+
 
 /**
- * List of repeated fields within this message type.
- * @private {!Array<number>}
- * @const
+ * @param {number} value
+ * @return {!proto.engine_api.GetAuditLogArgs} returns this
  */
-proto.engine_api.GetServiceLogsArgs.repeatedFields_ = [4];
+proto.engine_api.GetAuditLogArgs.prototype.setPageSize = function(value) {
+  return jspb.Message.setField(this, 2, value);
+};
+
+
+/**
+ * Clears the field making it undefined.
+ * @return {!proto.engine_api.GetAuditLogArgs} returns this
+ */
+proto.engine_api.GetAuditLogArgs.prototype.clearPageSize = function() {
+  return jspb.Message.setField(this, 2, undefined);
+};
+
+
+/**
+ * Returns whether this field is set.
+ * @return {boolean}
+ */
+proto.engine_api.GetAuditLogArgs.prototype.hasPageSize = function() {
+  return jspb.Message.getField(this, 2) != null;
+};
+
+
+/**
+ * optional string page_token = 3;
+ * @return {string}
+ */
+proto.engine_api.GetAuditLogArgs.prototype.getPageToken = function() {
+  return /** @type {string} */ (jspb.Message.getFieldWithDefault(this, 3, ""));
+};
+
+
+/**
+ * @param {string} value
+ * @return {!proto.engine_api.GetAuditLogArgs} returns this
+ */
+proto.engine_api.GetAuditLogArgs.prototype.setPageToken = function(value) {
+  return jspb.Message.setField(this, 3, value);
+};
+
+
+/**
+ * Clears the field making it undefined.
+ * @return {!proto.engine_api.GetAuditLogArgs} returns this
+ */
+proto.engine_api.GetAuditLogArgs.prototype.clearPageToken = function() {
+  return jspb.Message.setField(this, 3, undefined);
+};
+
+
+/**
+ * Returns whether this field is set.
+ * @return {boolean}
+ */
+proto.engine_api.GetAuditLogArgs.prototype.hasPageToken = function() {
+  return jspb.Message.getField(this, 3) != null;
+};
+
+
 
 
 
@@ -3271,8 +8538,8 @@ if (jspb.Message.GENERATE_TO_OBJECT) {
  *     http://goto/soy-param-migration
  * @return {!Object}
  */
-proto.engine_api.GetServiceLogsArgs.prototype.toObject = function(opt_includeInstance) {
-  return proto.engine_api.GetServiceLogsArgs.toObject(opt_includeInstance, this);
+proto.engine_api.AuditLogEntry.prototype.toObject = function(opt_includeInstance) {
+  return proto.engine_api.AuditLogEntry.toObject(opt_includeInstance, this);
 };
 
 
@@ -3281,19 +8548,19 @@ proto.engine_api.GetServiceLogsArgs.prototype.toObject = function(opt_includeIns
  * @param {boolean|undefined} includeInstance Deprecated. Whether to include
  *     the JSPB instance for transitional soy proto support:
  *     http://goto/soy-param-migration
- * @param {!proto.engine_api.GetServiceLogsArgs} msg The msg instance to transform.
+ * @param {!proto.engine_api.AuditLogEntry} msg The msg instance to transform.
  * @return {!Object}
  * @suppress {unusedLocalVariables} f is only used for nested messages
  */
-proto.engine_api.GetServiceLogsArgs.toObject = function(includeInstance, msg) {
+proto.engine_api.AuditLogEntry.toObject = function(includeInstance, msg) {
   var f, obj = {
-    enclaveIdentifier: jspb.Message.getFieldWithDefault(msg, 1, ""),
-    serviceUuidSetMap: (f = msg.getServiceUuidSetMap()) ? f.toObject(includeInstance, undefined) : [],
-    followLogs: jspb.Message.getBooleanFieldWithDefault(msg, 3, false),
-    conjunctiveFiltersList: jspb.Message.toObjectList(msg.getConjunctiveFiltersList(),
-    proto.engine_api.LogLineFilter.toObject, includeInstance),
-    returnAllLogs: jspb.Message.getBooleanFieldWithDefault(msg, 5, false),
-    numLogLines: jspb.Message.getFieldWithDefault(msg, 6, 0)
+    timestamp: (f = msg.getTimestamp()) && google_protobuf_timestamp_pb.Timestamp.toObject(includeInstance, f),
+    caller: jspb.Message.getFieldWithDefault(msg, 2, ""),
+    action: jspb.Message.getFieldWithDefault(msg, 3, ""),
+    enclaveUuid: jspb.Message.getFieldWithDefault(msg, 4, ""),
+    enclaveName: jspb.Message.getFieldWithDefault(msg, 5, ""),
+    argumentsMap: (f = msg.getArgumentsMap()) ? f.toObject(includeInstance, undefined) : [],
+    error: jspb.Message.getFieldWithDefault(msg, 7, "")
   };
 
   if (includeInstance) {
@@ -3307,23 +8574,23 @@ proto.engine_api.GetServiceLogsArgs.toObject = function(includeInstance, msg) {
 /**
  * Deserializes binary data (in protobuf wire format).
  * @param {jspb.ByteSource} bytes The bytes to deserialize.
- * @return {!proto.engine_api.GetServiceLogsArgs}
+ * @return {!proto.engine_api.AuditLogEntry}
  */
-proto.engine_api.GetServiceLogsArgs.deserializeBinary = function(bytes) {
+proto.engine_api.AuditLogEntry.deserializeBinary = function(bytes) {
   var reader = new jspb.BinaryReader(bytes);
-  var msg = new proto.engine_api.GetServiceLogsArgs;
-  return proto.engine_api.GetServiceLogsArgs.deserializeBinaryFromReader(msg, reader);
+  var msg = new proto.engine_api.AuditLogEntry;
+  return proto.engine_api.AuditLogEntry.deserializeBinaryFromReader(msg, reader);
 };
 
 
 /**
  * Deserializes binary data (in protobuf wire format) from the
  * given reader into the given message object.
- * @param {!proto.engine_api.GetServiceLogsArgs} msg The message object to deserialize into.
+ * @param {!proto.engine_api.AuditLogEntry} msg The message object to deserialize into.
  * @param {!jspb.BinaryReader} reader The BinaryReader to use.
- * @return {!proto.engine_api.GetServiceLogsArgs}
+ * @return {!proto.engine_api.AuditLogEntry}
  */
-proto.engine_api.GetServiceLogsArgs.deserializeBinaryFromReader = function(msg, reader) {
+proto.engine_api.AuditLogEntry.deserializeBinaryFromReader = function(msg, reader) {
   while (reader.nextField()) {
     if (reader.isEndGroup()) {
       break;
@@ -3331,31 +8598,35 @@ proto.engine_api.GetServiceLogsArgs.deserializeBinaryFromReader = function(msg,
     var field = reader.getFieldNumber();
     switch (field) {
     case 1:
-      var value = /** @type {string} */ (reader.readString());
-      msg.setEnclaveIdentifier(value);
+      var value = new google_protobuf_timestamp_pb.Timestamp;
+      reader.readMessage(value,google_protobuf_timestamp_pb.Timestamp.deserializeBinaryFromReader);
+      msg.setTimestamp(value);
       break;
     case 2:
-      var value = msg.getServiceUuidSetMap();
-      reader.readMessage(value, function(message, reader) {
-        jspb.Map.deserializeBinary(message, reader, jspb.BinaryReader.prototype.readString, jspb.BinaryReader.prototype.readBool, null, "", false);
-         });
+      var value = /** @type {string} */ (reader.readString());
+      msg.setCaller(value);
       break;
     case 3:
-      var value = /** @type {boolean} */ (reader.readBool());
-      msg.setFollowLogs(value);
+      var value = /** @type {string} */ (reader.readString());
+      msg.setAction(value);
       break;
     case 4:
-      var value = new proto.engine_api.LogLineFilter;
-      reader.readMessage(value,proto.engine_api.LogLineFilter.deserializeBinaryFromReader);
-      msg.addConjunctiveFilters(value);
+      var value = /** @type {string} */ (reader.readString());
+      msg.setEnclaveUuid(value);
       break;
     case 5:
-      var value = /** @type {boolean} */ (reader.readBool());
-      msg.setReturnAllLogs(value);
+      var value = /** @type {string} */ (reader.readString());
+      msg.setEnclaveName(value);
       break;
     case 6:
-      var value = /** @type {number} */ (reader.readUint32());
-      msg.setNumLogLines(value);
+      var value = msg.getArgumentsMap();
+      reader.readMessage(value, function(message, reader) {
+        jspb.Map.deserializeBinary(message, reader, jspb.BinaryReader.prototype.readString, jspb.BinaryReader.prototype.readString, null, "", "");
+         });
+      break;
+    case 7:
+      var value = /** @type {string} */ (reader.readString());
+      msg.setError(value);
       break;
     default:
       reader.skipField();
@@ -3370,9 +8641,9 @@ proto.engine_api.GetServiceLogsArgs.deserializeBinaryFromReader = function(msg,
  * Serializes the message to binary data (in protobuf wire format).
  * @return {!Uint8Array}
  */
-proto.engine_api.GetServiceLogsArgs.prototype.serializeBinary = function() {
+proto.engine_api.AuditLogEntry.prototype.serializeBinary = function() {
   var writer = new jspb.BinaryWriter();
-  proto.engine_api.GetServiceLogsArgs.serializeBinaryToWriter(this, writer);
+  proto.engine_api.AuditLogEntry.serializeBinaryToWriter(this, writer);
   return writer.getResultBuffer();
 };
 
@@ -3380,49 +8651,56 @@ proto.engine_api.GetServiceLogsArgs.prototype.serializeBinary = function() {
 /**
  * Serializes the given message to binary data (in protobuf wire
  * format), writing to the given BinaryWriter.
- * @param {!proto.engine_api.GetServiceLogsArgs} message
+ * @param {!proto.engine_api.AuditLogEntry} message
  * @param {!jspb.BinaryWriter} writer
  * @suppress {unusedLocalVariables} f is only used for nested messages
  */
-proto.engine_api.GetServiceLogsArgs.serializeBinaryToWriter = function(message, writer) {
+proto.engine_api.AuditLogEntry.serializeBinaryToWriter = function(message, writer) {
   var f = undefined;
-  f = message.getEnclaveIdentifier();
+  f = message.getTimestamp();
+  if (f != null) {
+    writer.writeMessage(
+      1,
+      f,
+      google_protobuf_timestamp_pb.Timestamp.serializeBinaryToWriter
+    );
+  }
+  f = message.getCaller();
   if (f.length > 0) {
     writer.writeString(
-      1,
+      2,
       f
     );
   }
-  f = message.getServiceUuidSetMap(true);
-  if (f && f.getLength() > 0) {
-    f.serializeBinary(2, writer, jspb.BinaryWriter.prototype.writeString, jspb.BinaryWriter.prototype.writeBool);
-  }
-  f = /** @type {boolean} */ (jspb.Message.getField(message, 3));
-  if (f != null) {
-    writer.writeBool(
+  f = message.getAction();
+  if (f.length > 0) {
+    writer.writeString(
       3,
       f
     );
   }
-  f = message.getConjunctiveFiltersList();
-  if (f.length > 0) {
-    writer.writeRepeatedMessage(
+  f = /** @type {string} */ (jspb.Message.getField(message, 4));
+  if (f != null) {
+    writer.writeString(
       4,
-      f,
-      proto.engine_api.LogLineFilter.serializeBinaryToWriter
+      f
     );
   }
-  f = /** @type {boolean} */ (jspb.Message.getField(message, 5));
+  f = /** @type {string} */ (jspb.Message.getField(message, 5));
   if (f != null) {
-    writer.writeBool(
+    writer.writeString(
       5,
       f
     );
   }
-  f = /** @type {number} */ (jspb.Message.getField(message, 6));
+  f = message.getArgumentsMap(true);
+  if (f && f.getLength() > 0) {
+    f.serializeBinary(6, writer, jspb.BinaryWriter.prototype.writeString, jspb.BinaryWriter.prototype.writeString);
+  }
+  f = /** @type {string} */ (jspb.Message.getField(message, 7));
   if (f != null) {
-    writer.writeUint32(
-      6,
+    writer.writeString(
+      7,
       f
     );
   }
@@ -3430,142 +8708,137 @@ proto.engine_api.GetServiceLogsArgs.serializeBinaryToWriter = function(message,
 
 
 /**
- * optional string enclave_identifier = 1;
- * @return {string}
+ * optional google.protobuf.Timestamp timestamp = 1;
+ * @return {?proto.google.protobuf.Timestamp}
  */
-proto.engine_api.GetServiceLogsArgs.prototype.getEnclaveIdentifier = function() {
-  return /** @type {string} */ (jspb.Message.getFieldWithDefault(this, 1, ""));
+proto.engine_api.AuditLogEntry.prototype.getTimestamp = function() {
+  return /** @type{?proto.google.protobuf.Timestamp} */ (
+    jspb.Message.getWrapperField(this, google_protobuf_timestamp_pb.Timestamp, 1));
 };
 
 
 /**
- * @param {string} value
- * @return {!proto.engine_api.GetServiceLogsArgs} returns this
- */
-proto.engine_api.GetServiceLogsArgs.prototype.setEnclaveIdentifier = function(value) {
-  return jspb.Message.setProto3StringField(this, 1, value);
+ * @param {?proto.google.protobuf.Timestamp|undefined} value
+ * @return {!proto.engine_api.AuditLogEntry} returns this
+*/
+proto.engine_api.AuditLogEntry.prototype.setTimestamp = function(value) {
+  return jspb.Message.setWrapperField(this, 1, value);
 };
 
 
 /**
- * map<string, bool> service_uuid_set = 2;
- * @param {boolean=} opt_noLazyCreate Do not create the map if
- * empty, instead returning `undefined`
- * @return {!jspb.Map<string,boolean>}
+ * Clears the message field making it undefined.
+ * @return {!proto.engine_api.AuditLogEntry} returns this
  */
-proto.engine_api.GetServiceLogsArgs.prototype.getServiceUuidSetMap = function(opt_noLazyCreate) {
-  return /** @type {!jspb.Map<string,boolean>} */ (
-      jspb.Message.getMapField(this, 2, opt_noLazyCreate,
-      null));
+proto.engine_api.AuditLogEntry.prototype.clearTimestamp = function() {
+  return this.setTimestamp(undefined);
 };
 
 
 /**
- * Clears values from the map. The map will be non-null.
- * @return {!proto.engine_api.GetServiceLogsArgs} returns this
+ * Returns whether this field is set.
+ * @return {boolean}
  */
-proto.engine_api.GetServiceLogsArgs.prototype.clearServiceUuidSetMap = function() {
-  this.getServiceUuidSetMap().clear();
-  return this;};
+proto.engine_api.AuditLogEntry.prototype.hasTimestamp = function() {
+  return jspb.Message.getField(this, 1) != null;
+};
 
 
 /**
- * optional bool follow_logs = 3;
- * @return {boolean}
+ * optional string caller = 2;
+ * @return {string}
  */
-proto.engine_api.GetServiceLogsArgs.prototype.getFollowLogs = function() {
-  return /** @type {boolean} */ (jspb.Message.getBooleanFieldWithDefault(this, 3, false));
+proto.engine_api.AuditLogEntry.prototype.getCaller = function() {
+  return /** @type {string} */ (jspb.Message.getFieldWithDefault(this, 2, ""));
 };
 
 
 /**
- * @param {boolean} value
- * @return {!proto.engine_api.GetServiceLogsArgs} returns this
+ * @param {string} value
+ * @return {!proto.engine_api.AuditLogEntry} returns this
  */
-proto.engine_api.GetServiceLogsArgs.prototype.setFollowLogs = function(value) {
-  return jspb.Message.setField(this, 3, value);
+proto.engine_api.AuditLogEntry.prototype.setCaller = function(value) {
+  return jspb.Message.setProto3StringField(this, 2, value);
 };
 
 
 /**
- * Clears the field making it undefined.
- * @return {!proto.engine_api.GetServiceLogsArgs} returns this
+ * optional string action = 3;
+ * @return {string}
  */
-proto.engine_api.GetServiceLogsArgs.prototype.clearFollowLogs = function() {
-  return jspb.Message.setField(this, 3, undefined);
+proto.engine_api.AuditLogEntry.prototype.getAction = function() {
+  return /** @type {string} */ (jspb.Message.getFieldWithDefault(this, 3, ""));
 };
 
 
 /**
- * Returns whether this field is set.
- * @return {boolean}
+ * @param {string} value
+ * @return {!proto.engine_api.AuditLogEntry} returns this
  */
-proto.engine_api.GetServiceLogsArgs.prototype.hasFollowLogs = function() {
-  return jspb.Message.getField(this, 3) != null;
+proto.engine_api.AuditLogEntry.prototype.setAction = function(value) {
+  return jspb.Message.setProto3StringField(this, 3, value);
 };
 
 
 /**
- * repeated LogLineFilter conjunctive_filters = 4;
- * @return {!Array<!proto.engine_api.LogLineFilter>}
+ * optional string enclave_uuid = 4;
+ * @return {string}
  */
-proto.engine_api.GetServiceLogsArgs.prototype.getConjunctiveFiltersList = function() {
-  return /** @type{!Array<!proto.engine_api.LogLineFilter>} */ (
-    jspb.Message.getRepeatedWrapperField(this, proto.engine_api.LogLineFilter, 4));
+proto.engine_api.AuditLogEntry.prototype.getEnclaveUuid = function() {
+  return /** @type {string} */ (jspb.Message.getFieldWithDefault(this, 4, ""));
 };
 
 
 /**
- * @param {!Array<!proto.engine_api.LogLineFilter>} value
- * @return {!proto.engine_api.GetServiceLogsArgs} returns this
-*/
-proto.engine_api.GetServiceLogsArgs.prototype.setConjunctiveFiltersList = function(value) {
-  return jspb.Message.setRepeatedWrapperField(this, 4, value);
+ * @param {string} value
+ * @return {!proto.engine_api.AuditLogEntry} returns this
+ */
+proto.engine_api.AuditLogEntry.prototype.setEnclaveUuid = function(value) {
+  return jspb.Message.setField(this, 4, value);
 };
 
 
 /**
- * @param {!proto.engine_api.LogLineFilter=} opt_value
- * @param {number=} opt_index
- * @return {!proto.engine_api.LogLineFilter}
+ * Clears the field making it undefined.
+ * @return {!proto.engine_api.AuditLogEntry} returns this
  */
-proto.engine_api.GetServiceLogsArgs.prototype.addConjunctiveFilters = function(opt_value, opt_index) {
-  return jspb.Message.addToRepeatedWrapperField(this, 4, opt_value, proto.engine_api.LogLineFilter, opt_index);
+proto.engine_api.AuditLogEntry.prototype.clearEnclaveUuid = function() {
+  return jspb.Message.setField(this, 4, undefined);
 };
 
 
 /**
- * Clears the list making it empty but non-null.
- * @return {!proto.engine_api.GetServiceLogsArgs} returns this
+ * Returns whether this field is set.
+ * @return {boolean}
  */
-proto.engine_api.GetServiceLogsArgs.prototype.clearConjunctiveFiltersList = function() {
-  return this.setConjunctiveFiltersList([]);
+proto.engine_api.AuditLogEntry.prototype.hasEnclaveUuid = function() {
+  return jspb.Message.getField(this, 4) != null;
 };
 
 
 /**
- * optional bool return_all_logs = 5;
- * @return {boolean}
+ * optional string enclave_name = 5;
+ * @return {string}
  */
-proto.engine_api.GetServiceLogsArgs.prototype.getReturnAllLogs = function() {
-  return /** @type {boolean} */ (jspb.Message.getBooleanFieldWithDefault(this, 5, false));
+proto.engine_api.AuditLogEntry.prototype.getEnclaveName = function() {
+  return /** @type {string} */ (jspb.Message.getFieldWithDefault(this, 5, ""));
 };
 
 
 /**
- * @param {boolean} value
- * @return {!proto.engine_api.GetServiceLogsArgs} returns this
+ * @param {string} value
+ * @return {!proto.engine_api.AuditLogEntry} returns this
  */
-proto.engine_api.GetServiceLogsArgs.prototype.setReturnAllLogs = function(value) {
+proto.engine_api.AuditLogEntry.prototype.setEnclaveName = function(value) {
   return jspb.Message.setField(this, 5, value);
 };
 
 
 /**
  * Clears the field making it undefined.
- * @return {!proto.engine_api.GetServiceLogsArgs} returns this
+ * @return {!proto.engine_api.AuditLogEntry} returns this
  */
-proto.engine_api.GetServiceLogsArgs.prototype.clearReturnAllLogs = function() {
+proto.engine_api.AuditLogEntry.prototype.clearEnclaveName = function() {
   return jspb.Message.setField(this, 5, undefined);
 };
 
@@ -3574,35 +8847,57 @@ proto.engine_api.GetServiceLogsArgs.prototype.clearReturnAllLogs = function() {
  * Returns whether this field is set.
  * @return {boolean}
  */
-proto.engine_api.GetServiceLogsArgs.prototype.hasReturnAllLogs = function() {
+proto.engine_api.AuditLogEntry.prototype.hasEnclaveName = function() {
   return jspb.Message.getField(this, 5) != null;
 };
 
 
 /**
- * optional uint32 num_log_lines = 6;
- * @return {number}
+ * map<string, string> arguments = 6;
+ * @param {boolean=} opt_noLazyCreate Do not create the map if
+ * empty, instead returning `undefined`
+ * @return {!jspb.Map<string,string>}
  */
-proto.engine_api.GetServiceLogsArgs.prototype.getNumLogLines = function() {
-  return /** @type {number} */ (jspb.Message.getFieldWithDefault(this, 6, 0));
+proto.engine_api.AuditLogEntry.prototype.getArgumentsMap = function(opt_noLazyCreate) {
+  return /** @type {!jspb.Map<string,string>} */ (
+      jspb.Message.getMapField(this, 6, opt_noLazyCreate,
+      null));
 };
 
 
 /**
- * @param {number} value
- * @return {!proto.engine_api.GetServiceLogsArgs} returns this
+ * Clears values from the map. The map will be non-null.
+ * @return {!proto.engine_api.AuditLogEntry} returns this
  */
-proto.engine_api.GetServiceLogsArgs.prototype.setNumLogLines = function(value) {
-  return jspb.Message.setField(this, 6, value);
+proto.engine_api.AuditLogEntry.prototype.clearArgumentsMap = function() {
+  this.getArgumentsMap().clear();
+  return this;};
+
+
+/**
+ * optional string error = 7;
+ * @return {string}
+ */
+proto.engine_api.AuditLogEntry.prototype.getError = function() {
+  return /** @type {string} */ (jspb.Message.getFieldWithDefault(this, 7, ""));
+};
+
+
+/**
+ * @param {string} value
+ * @return {!proto.engine_api.AuditLogEntry} returns this
+ */
+proto.engine_api.AuditLogEntry.prototype.setError = function(value) {
+  return jspb.Message.setField(this, 7, value);
 };
 
 
 /**
  * Clears the field making it undefined.
- * @return {!proto.engine_api.GetServiceLogsArgs} returns this
+ * @return {!proto.engine_api.AuditLogEntry} returns this
  */
-proto.engine_api.GetServiceLogsArgs.prototype.clearNumLogLines = function() {
-  return jspb.Message.setField(this, 6, undefined);
+proto.engine_api.AuditLogEntry.prototype.clearError = function() {
+  return jspb.Message.setField(this, 7, undefined);
 };
 
 
@@ -3610,12 +8905,19 @@ proto.engine_api.GetServiceLogsArgs.prototype.clearNumLogLines = function() {
  * Returns whether this field is set.
  * @return {boolean}
  */
-proto.engine_api.GetServiceLogsArgs.prototype.hasNumLogLines = function() {
-  return jspb.Message.getField(this, 6) != null;
+proto.engine_api.AuditLogEntry.prototype.hasError = function() {
+  return jspb.Message.getField(this, 7) != null;
 };
 
 
 
+/**
+ * List of repeated fields within this message type.
+ * @private {!Array<number>}
+ * @const
+ */
+proto.engine_api.GetAuditLogResponse.repeatedFields_ = [1];
+
 
 
 if (jspb.Message.GENERATE_TO_OBJECT) {
@@ -3631,8 +8933,8 @@ if (jspb.Message.GENERATE_TO_OBJECT) {
  *     http://goto/soy-param-migration
  * @return {!Object}
  */
-proto.engine_api.GetServiceLogsResponse.prototype.toObject = function(opt_includeInstance) {
-  return proto.engine_api.GetServiceLogsResponse.toObject(opt_includeInstance, this);
+proto.engine_api.GetAuditLogResponse.prototype.toObject = function(opt_includeInstance) {
+  return proto.engine_api.GetAuditLogResponse.toObject(opt_includeInstance, this);
 };
 
 
@@ -3641,14 +8943,15 @@ proto.engine_api.GetServiceLogsResponse.prototype.toObject = function(opt_includ
  * @param {boolean|undefined} includeInstance Deprecated. Whether to include
  *     the JSPB instance for transitional soy proto support:
  *     http://goto/soy-param-migration
- * @param {!proto.engine_api.GetServiceLogsResponse} msg The msg instance to transform.
+ * @param {!proto.engine_api.GetAuditLogResponse} msg The msg instance to transform.
  * @return {!Object}
  * @suppress {unusedLocalVariables} f is only used for nested messages
  */
-proto.engine_api.GetServiceLogsResponse.toObject = function(includeInstance, msg) {
+proto.engine_api.GetAuditLogResponse.toObject = function(includeInstance, msg) {
   var f, obj = {
-    serviceLogsByServiceUuidMap: (f = msg.getServiceLogsByServiceUuidMap()) ? f.toObject(includeInstance, proto.engine_api.LogLine.toObject) : [],
-    notFoundServiceUuidSetMap: (f = msg.getNotFoundServiceUuidSetMap()) ? f.toObject(includeInstance, undefined) : []
+    entriesList: jspb.Message.toObjectList(msg.getEntriesList(),
+    proto.engine_api.AuditLogEntry.toObject, includeInstance),
+    nextPageToken: jspb.Message.getFieldWithDefault(msg, 2, "")
   };
 
   if (includeInstance) {
@@ -3662,23 +8965,23 @@ proto.engine_api.GetServiceLogsResponse.toObject = function(includeInstance, msg
 /**
  * Deserializes binary data (in protobuf wire format).
  * @param {jspb.ByteSource} bytes The bytes to deserialize.
- * @return {!proto.engine_api.GetServiceLogsResponse}
+ * @return {!proto.engine_api.GetAuditLogResponse}
  */
-proto.engine_api.GetServiceLogsResponse.deserializeBinary = function(bytes) {
+proto.engine_api.GetAuditLogResponse.deserializeBinary = function(bytes) {
   var reader = new jspb.BinaryReader(bytes);
-  var msg = new proto.engine_api.GetServiceLogsResponse;
-  return proto.engine_api.GetServiceLogsResponse.deserializeBinaryFromReader(msg, reader);
+  var msg = new proto.engine_api.GetAuditLogResponse;
+  return proto.engine_api.GetAuditLogResponse.deserializeBinaryFromReader(msg, reader);
 };
 
 
 /**
  * Deserializes binary data (in protobuf wire format) from the
  * given reader into the given message object.
- * @param {!proto.engine_api.GetServiceLogsResponse} msg The message object to deserialize into.
+ * @param {!proto.engine_api.GetAuditLogResponse} msg The message object to deserialize into.
  * @param {!jspb.BinaryReader} reader The BinaryReader to use.
- * @return {!proto.engine_api.GetServiceLogsResponse}
+ * @return {!proto.engine_api.GetAuditLogResponse}
  */
-proto.engine_api.GetServiceLogsResponse.deserializeBinaryFromReader = function(msg, reader) {
+proto.engine_api.GetAuditLogResponse.deserializeBinaryFromReader = function(msg, reader) {
   while (reader.nextField()) {
     if (reader.isEndGroup()) {
       break;
@@ -3686,16 +8989,13 @@ proto.engine_api.GetServiceLogsResponse.deserializeBinaryFromReader = function(m
     var field = reader.getFieldNumber();
     switch (field) {
     case 1:
-      var value = msg.getServiceLogsByServiceUuidMap();
-      reader.readMessage(value, function(message, reader) {
-        jspb.Map.deserializeBinary(message, reader, jspb.BinaryReader.prototype.readString, jspb.BinaryReader.prototype.readMessage, proto.engine_api.LogLine.deserializeBinaryFromReader, "", new proto.engine_api.LogLine());
-         });
+      var value = new proto.engine_api.AuditLogEntry;
+      reader.readMessage(value,proto.engine_api.AuditLogEntry.deserializeBinaryFromReader);
+      msg.addEntries(value);
       break;
     case 2:
-      var value = msg.getNotFoundServiceUuidSetMap();
-      reader.readMessage(value, function(message, reader) {
-        jspb.Map.deserializeBinary(message, reader, jspb.BinaryReader.prototype.readString, jspb.BinaryReader.prototype.readBool, null, "", false);
-         });
+      var value = /** @type {string} */ (reader.readString());
+      msg.setNextPageToken(value);
       break;
     default:
       reader.skipField();
@@ -3710,84 +9010,96 @@ proto.engine_api.GetServiceLogsResponse.deserializeBinaryFromReader = function(m
  * Serializes the message to binary data (in protobuf wire format).
  * @return {!Uint8Array}
  */
-proto.engine_api.GetServiceLogsResponse.prototype.serializeBinary = function() {
+proto.engine_api.GetAuditLogResponse.prototype.serializeBinary = function() {
   var writer = new jspb.BinaryWriter();
-  proto.engine_api.GetServiceLogsResponse.serializeBinaryToWriter(this, writer);
+  proto.engine_api.GetAuditLogResponse.serializeBinaryToWriter(this, writer);
   return writer.getResultBuffer();
 };
 
 
 /**
- * Serializes the given message to binary data (in protobuf wire
- * format), writing to the given BinaryWriter.
- * @param {!proto.engine_api.GetServiceLogsResponse} message
- * @param {!jspb.BinaryWriter} writer
- * @suppress {unusedLocalVariables} f is only used for nested messages
+ * Serializes the given message to binary data (in protobuf wire
+ * format), writing to the given BinaryWriter.
+ * @param {!proto.engine_api.GetAuditLogResponse} message
+ * @param {!jspb.BinaryWriter} writer
+ * @suppress {unusedLocalVariables} f is only used for nested messages
+ */
+proto.engine_api.GetAuditLogResponse.serializeBinaryToWriter = function(message, writer) {
+  var f = undefined;
+  f = message.getEntriesList();
+  if (f.length > 0) {
+    writer.writeRepeatedMessage(
+      1,
+      f,
+      proto.engine_api.AuditLogEntry.serializeBinaryToWriter
+    );
+  }
+  f = message.getNextPageToken();
+  if (f.length > 0) {
+    writer.writeString(
+      2,
+      f
+    );
+  }
+};
+
+
+/**
+ * repeated AuditLogEntry entries = 1;
+ * @return {!Array<!proto.engine_api.AuditLogEntry>}
  */
-proto.engine_api.GetServiceLogsResponse.serializeBinaryToWriter = function(message, writer) {
-  var f = undefined;
-  f = message.getServiceLogsByServiceUuidMap(true);
-  if (f && f.getLength() > 0) {
-    f.serializeBinary(1, writer, jspb.BinaryWriter.prototype.writeString, jspb.BinaryWriter.prototype.writeMessage, proto.engine_api.LogLine.serializeBinaryToWriter);
-  }
-  f = message.getNotFoundServiceUuidSetMap(true);
-  if (f && f.getLength() > 0) {
-    f.serializeBinary(2, writer, jspb.BinaryWriter.prototype.writeString, jspb.BinaryWriter.prototype.writeBool);
-  }
+proto.engine_api.GetAuditLogResponse.prototype.getEntriesList = function() {
+  return /** @type{!Array<!proto.engine_api.AuditLogEntry>} */ (
+    jspb.Message.getRepeatedWrapperField(this, proto.engine_api.AuditLogEntry, 1));
 };
 
 
 /**
- * map<string, LogLine> service_logs_by_service_uuid = 1;
- * @param {boolean=} opt_noLazyCreate Do not create the map if
- * empty, instead returning `undefined`
- * @return {!jspb.Map<string,!proto.engine_api.LogLine>}
- */
-proto.engine_api.GetServiceLogsResponse.prototype.getServiceLogsByServiceUuidMap = function(opt_noLazyCreate) {
-  return /** @type {!jspb.Map<string,!proto.engine_api.LogLine>} */ (
-      jspb.Message.getMapField(this, 1, opt_noLazyCreate,
-      proto.engine_api.LogLine));
+ * @param {!Array<!proto.engine_api.AuditLogEntry>} value
+ * @return {!proto.engine_api.GetAuditLogResponse} returns this
+*/
+proto.engine_api.GetAuditLogResponse.prototype.setEntriesList = function(value) {
+  return jspb.Message.setRepeatedWrapperField(this, 1, value);
 };
 
 
 /**
- * Clears values from the map. The map will be non-null.
- * @return {!proto.engine_api.GetServiceLogsResponse} returns this
+ * @param {!proto.engine_api.AuditLogEntry=} opt_value
+ * @param {number=} opt_index
+ * @return {!proto.engine_api.AuditLogEntry}
  */
-proto.engine_api.GetServiceLogsResponse.prototype.clearServiceLogsByServiceUuidMap = function() {
-  this.getServiceLogsByServiceUuidMap().clear();
-  return this;};
+proto.engine_api.GetAuditLogResponse.prototype.addEntries = function(opt_value, opt_index) {
+  return jspb.Message.addToRepeatedWrapperField(this, 1, opt_value, proto.engine_api.AuditLogEntry, opt_index);
+};
 
 
 /**
- * map<string, bool> not_found_service_uuid_set = 2;
- * @param {boolean=} opt_noLazyCreate Do not create the map if
- * empty, instead returning `undefined`
- * @return {!jspb.Map<string,boolean>}
+ * Clears the list making it empty but non-null.
+ * @return {!proto.engine_api.GetAuditLogResponse} returns this
  */
-proto.engine_api.GetServiceLogsResponse.prototype.getNotFoundServiceUuidSetMap = function(opt_noLazyCreate) {
-  return /** @type {!jspb.Map<string,boolean>} */ (
-      jspb.Message.getMapField(this, 2, opt_noLazyCreate,
-      null));
+proto.engine_api.GetAuditLogResponse.prototype.clearEntriesList = function() {
+  return this.setEntriesList([]);
 };
 
 
 /**
- * Clears values from the map. The map will be non-null.
- * @return {!proto.engine_api.GetServiceLogsResponse} returns this
+ * optional string next_page_token = 2;
+ * @return {string}
  */
-proto.engine_api.GetServiceLogsResponse.prototype.clearNotFoundServiceUuidSetMap = function() {
-  this.getNotFoundServiceUuidSetMap().clear();
-  return this;};
-
+proto.engine_api.GetAuditLogResponse.prototype.getNextPageToken = function() {
+  return /** @type {string} */ (jspb.Message.getFieldWithDefault(this, 2, ""));
+};
 
 
 /**
- * List of repeated fields within this message type.
- * @private {!Array<number>}
- * @const
+ * @param {string} value
+ * @return {!proto.engine_api.GetAuditLogResponse} returns this
  */
-proto.engine_api.LogLine.repeatedFields_ = [1];
+proto.engine_api.GetAuditLogResponse.prototype.setNextPageToken = function(value) {
+  return jspb.Message.setProto3StringField(this, 2, value);
+};
+
+
 
 
 
@@ -3804,8 +9116,8 @@ if (jspb.Message.GENERATE_TO_OBJECT) {
  *     http://goto/soy-param-migration
  * @return {!Object}
  */
-proto.engine_api.LogLine.prototype.toObject = function(opt_includeInstance) {
-  return proto.engine_api.LogLine.toObject(opt_includeInstance, this);
+proto.engine_api.GetEnclaveCallerCredentialArgs.prototype.toObject = function(opt_includeInstance) {
+  return proto.engine_api.GetEnclaveCallerCredentialArgs.toObject(opt_includeInstance, this);
 };
 
 
@@ -3814,14 +9126,13 @@ proto.engine_api.LogLine.prototype.toObject = function(opt_includeInstance) {
  * @param {boolean|undefined} includeInstance Deprecated. Whether to include
  *     the JSPB instance for transitional soy proto support:
  *     http://goto/soy-param-migration
- * @param {!proto.engine_api.LogLine} msg The msg instance to transform.
+ * @param {!proto.engine_api.GetEnclaveCallerCredentialArgs} msg The msg instance to transform.
  * @return {!Object}
  * @suppress {unusedLocalVariables} f is only used for nested messages
  */
-proto.engine_api.LogLine.toObject = function(includeInstance, msg) {
+proto.engine_api.GetEnclaveCallerCredentialArgs.toObject = function(includeInstance, msg) {
   var f, obj = {
-    lineList: (f = jspb.Message.getRepeatedField(msg, 1)) == null ? undefined : f,
-    timestamp: (f = msg.getTimestamp()) && google_protobuf_timestamp_pb.Timestamp.toObject(includeInstance, f)
+    enclaveIdentifier: jspb.Message.getFieldWithDefault(msg, 1, "")
   };
 
   if (includeInstance) {
@@ -3835,23 +9146,23 @@ proto.engine_api.LogLine.toObject = function(includeInstance, msg) {
 /**
  * Deserializes binary data (in protobuf wire format).
  * @param {jspb.ByteSource} bytes The bytes to deserialize.
- * @return {!proto.engine_api.LogLine}
+ * @return {!proto.engine_api.GetEnclaveCallerCredentialArgs}
  */
-proto.engine_api.LogLine.deserializeBinary = function(bytes) {
+proto.engine_api.GetEnclaveCallerCredentialArgs.deserializeBinary = function(bytes) {
   var reader = new jspb.BinaryReader(bytes);
-  var msg = new proto.engine_api.LogLine;
-  return proto.engine_api.LogLine.deserializeBinaryFromReader(msg, reader);
+  var msg = new proto.engine_api.GetEnclaveCallerCredentialArgs;
+  return proto.engine_api.GetEnclaveCallerCredentialArgs.deserializeBinaryFromReader(msg, reader);
 };
 
 
 /**
  * Deserializes binary data (in protobuf wire format) from the
  * given reader into the given message object.
- * @param {!proto.engine_api.LogLine} msg The message object to deserialize into.
+ * @param {!proto.engine_api.GetEnclaveCallerCredentialArgs} msg The message object to deserialize into.
  * @param {!jspb.BinaryReader} reader The BinaryReader to use.
- * @return {!proto.engine_api.LogLine}
+ * @return {!proto.engine_api.GetEnclaveCallerCredentialArgs}
  */
-proto.engine_api.LogLine.deserializeBinaryFromReader = function(msg, reader) {
+proto.engine_api.GetEnclaveCallerCredentialArgs.deserializeBinaryFromReader = function(msg, reader) {
   while (reader.nextField()) {
     if (reader.isEndGroup()) {
       break;
@@ -3860,12 +9171,7 @@ proto.engine_api.LogLine.deserializeBinaryFromReader = function(msg, reader) {
     switch (field) {
     case 1:
       var value = /** @type {string} */ (reader.readString());
-      msg.addLine(value);
-      break;
-    case 2:
-      var value = new google_protobuf_timestamp_pb.Timestamp;
-      reader.readMessage(value,google_protobuf_timestamp_pb.Timestamp.deserializeBinaryFromReader);
-      msg.setTimestamp(value);
+      msg.setEnclaveIdentifier(value);
       break;
     default:
       reader.skipField();
@@ -3880,9 +9186,9 @@ proto.engine_api.LogLine.deserializeBinaryFromReader = function(msg, reader) {
  * Serializes the message to binary data (in protobuf wire format).
  * @return {!Uint8Array}
  */
-proto.engine_api.LogLine.prototype.serializeBinary = function() {
+proto.engine_api.GetEnclaveCallerCredentialArgs.prototype.serializeBinary = function() {
   var writer = new jspb.BinaryWriter();
-  proto.engine_api.LogLine.serializeBinaryToWriter(this, writer);
+  proto.engine_api.GetEnclaveCallerCredentialArgs.serializeBinaryToWriter(this, writer);
   return writer.getResultBuffer();
 };
 
@@ -3890,101 +9196,37 @@ proto.engine_api.LogLine.prototype.serializeBinary = function() {
 /**
  * Serializes the given message to binary data (in protobuf wire
  * format), writing to the given BinaryWriter.
- * @param {!proto.engine_api.LogLine} message
+ * @param {!proto.engine_api.GetEnclaveCallerCredentialArgs} message
  * @param {!jspb.BinaryWriter} writer
  * @suppress {unusedLocalVariables} f is only used for nested messages
  */
-proto.engine_api.LogLine.serializeBinaryToWriter = function(message, writer) {
+proto.engine_api.GetEnclaveCallerCredentialArgs.serializeBinaryToWriter = function(message, writer) {
   var f = undefined;
-  f = message.getLineList();
+  f = message.getEnclaveIdentifier();
   if (f.length > 0) {
-    writer.writeRepeatedString(
+    writer.writeString(
       1,
       f
     );
   }
-  f = message.getTimestamp();
-  if (f != null) {
-    writer.writeMessage(
-      2,
-      f,
-      google_protobuf_timestamp_pb.Timestamp.serializeBinaryToWriter
-    );
-  }
-};
-
-
-/**
- * repeated string line = 1;
- * @return {!Array<string>}
- */
-proto.engine_api.LogLine.prototype.getLineList = function() {
-  return /** @type {!Array<string>} */ (jspb.Message.getRepeatedField(this, 1));
 };
 
 
 /**
- * @param {!Array<string>} value
- * @return {!proto.engine_api.LogLine} returns this
+ * optional string enclave_identifier = 1;
+ * @return {string}
  */
-proto.engine_api.LogLine.prototype.setLineList = function(value) {
-  return jspb.Message.setField(this, 1, value || []);
+proto.engine_api.GetEnclaveCallerCredentialArgs.prototype.getEnclaveIdentifier = function() {
+  return /** @type {string} */ (jspb.Message.getFieldWithDefault(this, 1, ""));
 };
 
 
 /**
  * @param {string} value
- * @param {number=} opt_index
- * @return {!proto.engine_api.LogLine} returns this
- */
-proto.engine_api.LogLine.prototype.addLine = function(value, opt_index) {
-  return jspb.Message.addToRepeatedField(this, 1, value, opt_index);
-};
-
-
-/**
- * Clears the list making it empty but non-null.
- * @return {!proto.engine_api.LogLine} returns this
- */
-proto.engine_api.LogLine.prototype.clearLineList = function() {
-  return this.setLineList([]);
-};
-
-
-/**
- * optional google.protobuf.Timestamp timestamp = 2;
- * @return {?proto.google.protobuf.Timestamp}
- */
-proto.engine_api.LogLine.prototype.getTimestamp = function() {
-  return /** @type{?proto.google.protobuf.Timestamp} */ (
-    jspb.Message.getWrapperField(this, google_protobuf_timestamp_pb.Timestamp, 2));
-};
-
-
-/**
- * @param {?proto.google.protobuf.Timestamp|undefined} value
- * @return {!proto.engine_api.LogLine} returns this
-*/
-proto.engine_api.LogLine.prototype.setTimestamp = function(value) {
-  return jspb.Message.setWrapperField(this, 2, value);
-};
-
-
-/**
- * Clears the message field making it undefined.
- * @return {!proto.engine_api.LogLine} returns this
- */
-proto.engine_api.LogLine.prototype.clearTimestamp = function() {
-  return this.setTimestamp(undefined);
-};
-
-
-/**
- * Returns whether this field is set.
- * @return {boolean}
+ * @return {!proto.engine_api.GetEnclaveCallerCredentialArgs} returns this
  */
-proto.engine_api.LogLine.prototype.hasTimestamp = function() {
-  return jspb.Message.getField(this, 2) != null;
+proto.engine_api.GetEnclaveCallerCredentialArgs.prototype.setEnclaveIdentifier = function(value) {
+  return jspb.Message.setProto3StringField(this, 1, value);
 };
 
 
@@ -4004,8 +9246,8 @@ if (jspb.Message.GENERATE_TO_OBJECT) {
  *     http://goto/soy-param-migration
  * @return {!Object}
  */
-proto.engine_api.LogLineFilter.prototype.toObject = function(opt_includeInstance) {
-  return proto.engine_api.LogLineFilter.toObject(opt_includeInstance, this);
+proto.engine_api.GetEnclaveCallerCredentialResponse.prototype.toObject = function(opt_includeInstance) {
+  return proto.engine_api.GetEnclaveCallerCredentialResponse.toObject(opt_includeInstance, this);
 };
 
 
@@ -4014,14 +9256,14 @@ proto.engine_api.LogLineFilter.prototype.toObject = function(opt_includeInstance
  * @param {boolean|undefined} includeInstance Deprecated. Whether to include
  *     the JSPB instance for transitional soy proto support:
  *     http://goto/soy-param-migration
- * @param {!proto.engine_api.LogLineFilter} msg The msg instance to transform.
+ * @param {!proto.engine_api.GetEnclaveCallerCredentialResponse} msg The msg instance to transform.
  * @return {!Object}
  * @suppress {unusedLocalVariables} f is only used for nested messages
  */
-proto.engine_api.LogLineFilter.toObject = function(includeInstance, msg) {
+proto.engine_api.GetEnclaveCallerCredentialResponse.toObject = function(includeInstance, msg) {
   var f, obj = {
-    operator: jspb.Message.getFieldWithDefault(msg, 1, 0),
-    textPattern: jspb.Message.getFieldWithDefault(msg, 2, "")
+    credential: jspb.Message.getFieldWithDefault(msg, 1, ""),
+    expirationTime: (f = msg.getExpirationTime()) && google_protobuf_timestamp_pb.Timestamp.toObject(includeInstance, f)
   };
 
   if (includeInstance) {
@@ -4035,23 +9277,23 @@ proto.engine_api.LogLineFilter.toObject = function(includeInstance, msg) {
 /**
  * Deserializes binary data (in protobuf wire format).
  * @param {jspb.ByteSource} bytes The bytes to deserialize.
- * @return {!proto.engine_api.LogLineFilter}
+ * @return {!proto.engine_api.GetEnclaveCallerCredentialResponse}
  */
-proto.engine_api.LogLineFilter.deserializeBinary = function(bytes) {
+proto.engine_api.GetEnclaveCallerCredentialResponse.deserializeBinary = function(bytes) {
   var reader = new jspb.BinaryReader(bytes);
-  var msg = new proto.engine_api.LogLineFilter;
-  return proto.engine_api.LogLineFilter.deserializeBinaryFromReader(msg, reader);
+  var msg = new proto.engine_api.GetEnclaveCallerCredentialResponse;
+  return proto.engine_api.GetEnclaveCallerCredentialResponse.deserializeBinaryFromReader(msg, reader);
 };
 
 
 /**
  * Deserializes binary data (in protobuf wire format) from the
  * given reader into the given message object.
- * @param {!proto.engine_api.LogLineFilter} msg The message object to deserialize into.
+ * @param {!proto.engine_api.GetEnclaveCallerCredentialResponse} msg The message object to deserialize into.
  * @param {!jspb.BinaryReader} reader The BinaryReader to use.
- * @return {!proto.engine_api.LogLineFilter}
+ * @return {!proto.engine_api.GetEnclaveCallerCredentialResponse}
  */
-proto.engine_api.LogLineFilter.deserializeBinaryFromReader = function(msg, reader) {
+proto.engine_api.GetEnclaveCallerCredentialResponse.deserializeBinaryFromReader = function(msg, reader) {
   while (reader.nextField()) {
     if (reader.isEndGroup()) {
       break;
@@ -4059,12 +9301,13 @@ proto.engine_api.LogLineFilter.deserializeBinaryFromReader = function(msg, reade
     var field = reader.getFieldNumber();
     switch (field) {
     case 1:
-      var value = /** @type {!proto.engine_api.LogLineOperator} */ (reader.readEnum());
-      msg.setOperator(value);
+      var value = /** @type {string} */ (reader.readString());
+      msg.setCredential(value);
       break;
     case 2:
-      var value = /** @type {string} */ (reader.readString());
-      msg.setTextPattern(value);
+      var value = new google_protobuf_timestamp_pb.Timestamp;
+      reader.readMessage(value,google_protobuf_timestamp_pb.Timestamp.deserializeBinaryFromReader);
+      msg.setExpirationTime(value);
       break;
     default:
       reader.skipField();
@@ -4079,9 +9322,9 @@ proto.engine_api.LogLineFilter.deserializeBinaryFromReader = function(msg, reade
  * Serializes the message to binary data (in protobuf wire format).
  * @return {!Uint8Array}
  */
-proto.engine_api.LogLineFilter.prototype.serializeBinary = function() {
+proto.engine_api.GetEnclaveCallerCredentialResponse.prototype.serializeBinary = function() {
   var writer = new jspb.BinaryWriter();
-  proto.engine_api.LogLineFilter.serializeBinaryToWriter(this, writer);
+  proto.engine_api.GetEnclaveCallerCredentialResponse.serializeBinaryToWriter(this, writer);
   return writer.getResultBuffer();
 };
 
@@ -4089,62 +9332,82 @@ proto.engine_api.LogLineFilter.prototype.serializeBinary = function() {
 /**
  * Serializes the given message to binary data (in protobuf wire
  * format), writing to the given BinaryWriter.
- * @param {!proto.engine_api.LogLineFilter} message
+ * @param {!proto.engine_api.GetEnclaveCallerCredentialResponse} message
  * @param {!jspb.BinaryWriter} writer
  * @suppress {unusedLocalVariables} f is only used for nested messages
  */
-proto.engine_api.LogLineFilter.serializeBinaryToWriter = function(message, writer) {
+proto.engine_api.GetEnclaveCallerCredentialResponse.serializeBinaryToWriter = function(message, writer) {
   var f = undefined;
-  f = message.getOperator();
-  if (f !== 0.0) {
-    writer.writeEnum(
+  f = message.getCredential();
+  if (f.length > 0) {
+    writer.writeString(
       1,
       f
     );
   }
-  f = message.getTextPattern();
-  if (f.length > 0) {
-    writer.writeString(
+  f = message.getExpirationTime();
+  if (f != null) {
+    writer.writeMessage(
       2,
-      f
+      f,
+      google_protobuf_timestamp_pb.Timestamp.serializeBinaryToWriter
     );
   }
 };
 
 
 /**
- * optional LogLineOperator operator = 1;
- * @return {!proto.engine_api.LogLineOperator}
+ * optional string credential = 1;
+ * @return {string}
  */
-proto.engine_api.LogLineFilter.prototype.getOperator = function() {
-  return /** @type {!proto.engine_api.LogLineOperator} */ (jspb.Message.getFieldWithDefault(this, 1, 0));
+proto.engine_api.GetEnclaveCallerCredentialResponse.prototype.getCredential = function() {
+  return /** @type {string} */ (jspb.Message.getFieldWithDefault(this, 1, ""));
 };
 
 
 /**
- * @param {!proto.engine_api.LogLineOperator} value
- * @return {!proto.engine_api.LogLineFilter} returns this
+ * @param {string} value
+ * @return {!proto.engine_api.GetEnclaveCallerCredentialResponse} returns this
  */
-proto.engine_api.LogLineFilter.prototype.setOperator = function(value) {
-  return jspb.Message.setProto3EnumField(this, 1, value);
+proto.engine_api.GetEnclaveCallerCredentialResponse.prototype.setCredential = function(value) {
+  return jspb.Message.setProto3StringField(this, 1, value);
 };
 
 
 /**
- * optional string text_pattern = 2;
- * @return {string}
+ * optional google.protobuf.Timestamp expiration_time = 2;
+ * @return {?proto.google.protobuf.Timestamp}
  */
-proto.engine_api.LogLineFilter.prototype.getTextPattern = function() {
-  return /** @type {string} */ (jspb.Message.getFieldWithDefault(this, 2, ""));
+proto.engine_api.GetEnclaveCallerCredentialResponse.prototype.getExpirationTime = function() {
+  return /** @type{?proto.google.protobuf.Timestamp} */ (
+    jspb.Message.getWrapperField(this, google_protobuf_timestamp_pb.Timestamp, 2));
 };
 
 
 /**
- * @param {string} value
- * @return {!proto.engine_api.LogLineFilter} returns this
+ * @param {?proto.google.protobuf.Timestamp|undefined} value
+ * @return {!proto.engine_api.GetEnclaveCallerCredentialResponse} returns this
+*/
+proto.engine_api.GetEnclaveCallerCredentialResponse.prototype.setExpirationTime = function(value) {
+  return jspb.Message.setWrapperField(this, 2, value);
+};
+
+
+/**
+ * Clears the message field making it undefined.
+ * @return {!proto.engine_api.GetEnclaveCallerCredentialResponse} returns this
  */
-proto.engine_api.LogLineFilter.prototype.setTextPattern = function(value) {
-  return jspb.Message.setProto3StringField(this, 2, value);
+proto.engine_api.GetEnclaveCallerCredentialResponse.prototype.clearExpirationTime = function() {
+  return this.setExpirationTime(undefined);
+};
+
+
+/**
+ * Returns whether this field is set.
+ * @return {boolean}
+ */
+proto.engine_api.GetEnclaveCallerCredentialResponse.prototype.hasExpirationTime = function() {
+  return jspb.Message.getField(this, 2) != null;
 };
 
 
@@ -4156,6 +9419,14 @@ proto.engine_api.EnclaveMode = {
   PRODUCTION: 1
 };
 
+/**
+ * @enum {number}
+ */
+proto.engine_api.ExpiredEnclaveAction = {
+  EXPIREDENCLAVEACTION_DESTROY: 0,
+  EXPIREDENCLAVEACTION_STOP: 1
+};
+
 /**
  * @enum {number}
  */
@@ -4184,4 +9455,28 @@ proto.engine_api.LogLineOperator = {
   LOGLINEOPERATOR_DOES_NOT_CONTAIN_MATCH_REGEX: 3
 };
 
+/**
+ * @enum {number}
+ */
+proto.engine_api.EngineEventType = {
+  ENGINEEVENTTYPE_ENCLAVE_CREATED: 0,
+  ENGINEEVENTTYPE_ENCLAVE_STOPPED: 1,
+  ENGINEEVENTTYPE_ENCLAVE_DESTROYED: 2,
+  ENGINEEVENTTYPE_STARLARK_RUN_STARTED: 3,
+  ENGINEEVENTTYPE_STARLARK_RUN_FINISHED: 4,
+  ENGINEEVENTTYPE_SERVICE_ADDED: 5,
+  ENGINEEVENTTYPE_SERVICE_STOPPED: 6,
+  ENGINEEVENTTYPE_SERVICE_REMOVED: 7,
+  ENGINEEVENTTYPE_API_CONTAINER_RESTARTED: 8
+};
+
+/**
+ * @enum {number}
+ */
+proto.engine_api.ApiTokenRole = {
+  APITOKENROLE_READ_ONLY: 0,
+  APITOKENROLE_OPERATOR: 1,
+  APITOKENROLE_ADMIN: 2
+};
+
 goog.object.extend(exports, proto.engine_api);
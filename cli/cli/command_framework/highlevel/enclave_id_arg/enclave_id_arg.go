@@ -31,10 +31,16 @@ func NewEnclaveIdentifierArg(
 
 	validate := getValidationFunc(argKey, engineClientCtxKey, isGreedy)
 
+	// Omitted optional greedy args default to no enclaves at all
+	var defaultValue interface{} = ""
+	if isGreedy {
+		defaultValue = []string{}
+	}
+
 	return &args.ArgConfig{
 		Key:                   argKey,
 		IsOptional:            isOptional,
-		DefaultValue:          "",
+		DefaultValue:          defaultValue,
 		IsGreedy:              isGreedy,
 		ValidationFunc:        validate,
 		ArgCompletionProvider: args.NewManualCompletionsProvider(getCompletions),
@@ -0,0 +1,56 @@
+package output_format_flag
+
+import (
+	"fmt"
+
+	"github.com/kurtosis-tech/kurtosis/cli/cli/command_framework/lowlevel/flags"
+	"github.com/kurtosis-tech/kurtosis/cli/cli/helpers/output_printers"
+	"github.com/kurtosis-tech/stacktrace"
+	"github.com/spf13/cobra"
+)
+
+const (
+	OutputFormatFlagKey       = "output"
+	OutputFormatFlagShorthand = "o"
+
+	defaultOutputFormat = string(output_printers.TextOutputFormat)
+)
+
+var outputFormatFlagUsage = fmt.Sprintf(
+	"Format to output the result ('%v' or '%v'). If unset, a human-readable format is used.",
+	output_printers.JsonOutputFormat,
+	output_printers.YamlOutputFormat,
+)
+
+// NewOutputFormatFlag creates the shared '--output'/'-o' flag that switches a command to machine-readable output
+func NewOutputFormatFlag() *flags.FlagConfig {
+	return &flags.FlagConfig{
+		Key:       OutputFormatFlagKey,
+		Usage:     outputFormatFlagUsage,
+		Shorthand: OutputFormatFlagShorthand,
+		Type:      flags.FlagType_String,
+		Default:   defaultOutputFormat,
+	}
+}
+
+// AddOutputFormatFlagToCobraCommand is the equivalent of NewOutputFormatFlag for commands that don't use the
+// Kurtosis command framework
+func AddOutputFormatFlagToCobraCommand(cmd *cobra.Command) {
+	cmd.Flags().StringP(OutputFormatFlagKey, OutputFormatFlagShorthand, defaultOutputFormat, outputFormatFlagUsage)
+}
+
+func GetOutputFormat(parsedFlags *flags.ParsedFlags) (output_printers.OutputFormat, error) {
+	outputFormatStr, err := parsedFlags.GetString(OutputFormatFlagKey)
+	if err != nil {
+		return "", stacktrace.Propagate(err, "Expected a value for the '%v' flag but failed to get it", OutputFormatFlagKey)
+	}
+	outputFormat, err := output_printers.ParseOutputFormat(outputFormatStr)
+	if err != nil {
+		return "", stacktrace.Propagate(err, "An error occurred validating the '%v' flag", OutputFormatFlagKey)
+	}
+	return outputFormat, nil
+}
+
+func GetOutputFormatFromCobraCommand(cmd *cobra.Command) (output_printers.OutputFormat, error) {
+	return GetOutputFormat(flags.NewParsedFlags(cmd.Flags()))
+}
@@ -26,10 +26,16 @@ func NewServiceIdentifierArg(
 
 	validate := getValidationFunc(serviceIdentifierArgKey, isGreedy, enclaveIdentifierArgKey)
 
+	// Optional greedy args must default to a string array
+	var defaultValue interface{} = ""
+	if isGreedy {
+		defaultValue = []string{}
+	}
+
 	return &args.ArgConfig{
 		Key:                   serviceIdentifierArgKey,
 		IsOptional:            isOptional,
-		DefaultValue:          "",
+		DefaultValue:          defaultValue,
 		IsGreedy:              isGreedy,
 		ArgCompletionProvider: args.NewManualCompletionsProvider(getCompletionsOfActiveServices(enclaveIdentifierArgKey)),
 		ValidationFunc:        validate,
@@ -8,4 +8,6 @@ const (
 	FlagType_Uint8
 	FlagType_Uint32
 	FlagType_Bool
+	// Can be passed multiple times; values are not split on commas so that they can contain any character
+	FlagType_StringArray
 )
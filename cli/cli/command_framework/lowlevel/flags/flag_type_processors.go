@@ -4,12 +4,15 @@ import (
 	"github.com/kurtosis-tech/stacktrace"
 	flag "github.com/spf13/pflag"
 	"strconv"
+	"strings"
 )
 
 const (
 	uintBase   = 10
 	uint8Bits  = 8
 	uint32Bits = 32
+
+	stringArrayDefaultValueSeparator = ","
 )
 
 // flagTypeProcessor will take in a user's requested default value for the flag (serialized as a string),
@@ -24,10 +27,11 @@ type flagTypeProcessor func(
 
 // Completeness enforced via unit test
 var AllFlagTypeProcessors = map[FlagType]flagTypeProcessor{
-	FlagType_String: processStringFlag,
-	FlagType_Uint8:  processUint8Flag,
-	FlagType_Uint32: processUint32Flag,
-	FlagType_Bool:   processBoolFlag,
+	FlagType_String:      processStringFlag,
+	FlagType_Uint8:       processUint8Flag,
+	FlagType_Uint32:      processUint32Flag,
+	FlagType_Bool:        processBoolFlag,
+	FlagType_StringArray: processStringArrayFlag,
 }
 
 func processStringFlag(
@@ -125,3 +129,24 @@ func processBoolFlag(
 	)
 	return nil
 }
+
+func processStringArrayFlag(
+	flagKey string,
+	shorthand string,
+	defaultValueStr string,
+	usage string,
+	cobraFlagSet *flag.FlagSet,
+) error {
+	// The default value is the only place where commas act as a separator, values passed by the user are kept as-is
+	defaultValue := []string{}
+	if defaultValueStr != "" {
+		defaultValue = strings.Split(defaultValueStr, stringArrayDefaultValueSeparator)
+	}
+	cobraFlagSet.StringArrayP(
+		flagKey,
+		shorthand,
+		defaultValue,
+		usage,
+	)
+	return nil
+}
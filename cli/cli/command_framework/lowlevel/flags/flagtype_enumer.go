@@ -7,11 +7,11 @@ import (
 	"strings"
 )
 
-const _FlagTypeName = "stringuint8uint32bool"
+const _FlagTypeName = "stringuint8uint32boolstringarray"
 
-var _FlagTypeIndex = [...]uint8{0, 6, 11, 17, 21}
+var _FlagTypeIndex = [...]uint8{0, 6, 11, 17, 21, 32}
 
-const _FlagTypeLowerName = "stringuint8uint32bool"
+const _FlagTypeLowerName = "stringuint8uint32boolstringarray"
 
 func (i FlagType) String() string {
 	if i < 0 || i >= FlagType(len(_FlagTypeIndex)-1) {
@@ -28,9 +28,10 @@ func _FlagTypeNoOp() {
 	_ = x[FlagType_Uint8-(1)]
 	_ = x[FlagType_Uint32-(2)]
 	_ = x[FlagType_Bool-(3)]
+	_ = x[FlagType_StringArray-(4)]
 }
 
-var _FlagTypeValues = []FlagType{FlagType_String, FlagType_Uint8, FlagType_Uint32, FlagType_Bool}
+var _FlagTypeValues = []FlagType{FlagType_String, FlagType_Uint8, FlagType_Uint32, FlagType_Bool, FlagType_StringArray}
 
 var _FlagTypeNameToValueMap = map[string]FlagType{
 	_FlagTypeName[0:6]:        FlagType_String,
@@ -41,6 +42,8 @@ var _FlagTypeNameToValueMap = map[string]FlagType{
 	_FlagTypeLowerName[11:17]: FlagType_Uint32,
 	_FlagTypeName[17:21]:      FlagType_Bool,
 	_FlagTypeLowerName[17:21]: FlagType_Bool,
+	_FlagTypeName[21:32]:      FlagType_StringArray,
+	_FlagTypeLowerName[21:32]: FlagType_StringArray,
 }
 
 var _FlagTypeNames = []string{
@@ -48,6 +51,7 @@ var _FlagTypeNames = []string{
 	_FlagTypeName[6:11],
 	_FlagTypeName[11:17],
 	_FlagTypeName[17:21],
+	_FlagTypeName[21:32],
 }
 
 // FlagTypeString retrieves an enum value from the enum constants string name.
@@ -57,3 +57,14 @@ func (flags *ParsedFlags) GetBool(name string) (bool, error) {
 	}
 	return value, nil
 }
+
+func (flags *ParsedFlags) GetStringArray(name string) ([]string, error) {
+	value, err := flags.cmdFlagsSet.GetStringArray(name)
+	if err != nil {
+		return nil, stacktrace.Propagate(err,
+			"An error occurred getting string array flag '%v'",
+			name,
+		)
+	}
+	return value, nil
+}
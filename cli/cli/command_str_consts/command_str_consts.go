@@ -35,12 +35,28 @@ const (
 	EnclaveRmCmdStr         = "rm"
 	EnclaveDumpCmdStr       = "dump"
 	EnclaveConnectCmdStr    = "connect"
+	EnclaveExtendCmdStr     = "extend"
+	EnclaveLabelCmdStr      = "label"
+	EnclaveCloneCmdStr      = "clone"
+	EnclaveRenameCmdStr     = "rename"
+	EnclaveStatsCmdStr      = "stats"
 	EngineCmdStr            = "engine"
 	EngineLogsCmdStr        = "logs"
 	EngineStartCmdStr       = "start"
 	EngineStatusCmdStr      = "status"
 	EngineStopCmdStr        = "stop"
 	EngineRestartCmdStr     = "restart"
+	EngineRegistryCmdStr    = "registry"
+	EngineTokenCmdStr       = "token"
+	EngineAuditCmdStr       = "audit"
+	EngineExportCmdStr      = "export"
+	EngineImportCmdStr      = "import"
+	TokenCreateCmdStr       = "create"
+	TokenRevokeCmdStr       = "revoke"
+	RegistryStartCmdStr     = "start"
+	RegistryStopCmdStr      = "stop"
+	RegistryLsCmdStr        = "ls"
+	RegistryPruneCmdStr     = "prune"
 	FeedbackCmdStr          = "feedback"
 	FilesCmdStr             = "files"
 	FilesUploadCmdStr       = "upload"
@@ -65,6 +81,7 @@ const (
 	ServiceStopCmdStr       = "stop"
 	ServiceInspectCmdStr    = "inspect"
 	ServiceUpdateCmdStr     = "update"
+	ServiceRenameCmdStr     = "rename"
 	StarlarkRunCmdStr       = "run"
 	TwitterCmdStr           = "twitter"
 	ConfigCmdStr            = "config"
@@ -74,6 +91,7 @@ const (
 	GatewayCmdStr           = "gateway"
 	PackageCmdStr           = "package"
 	InitCmdStr              = "init"
+	PackageTestCmdStr       = "test"
 	PortCmdStr              = "port"
 	PortPrintCmdStr         = "print"
 	WebCmdStr               = "web"
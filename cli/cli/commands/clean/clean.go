@@ -9,6 +9,7 @@ import (
 	"github.com/kurtosis-tech/kurtosis/cli/cli/command_framework/lowlevel/args"
 	"github.com/kurtosis-tech/kurtosis/cli/cli/command_framework/lowlevel/flags"
 	"github.com/kurtosis-tech/kurtosis/cli/cli/command_str_consts"
+	"github.com/kurtosis-tech/kurtosis/cli/cli/helpers/enclave_labels"
 	"github.com/kurtosis-tech/kurtosis/cli/cli/out"
 	"github.com/kurtosis-tech/kurtosis/container-engine-lib/lib/backend_interface"
 	"github.com/kurtosis-tech/kurtosis/container-engine-lib/lib/backend_interface/objects/container"
@@ -42,8 +43,10 @@ var CleanCmd = &engine_consuming_kurtosis_command.EngineConsumingKurtosisCommand
 	CommandStr:       command_str_consts.CleanCmdStr,
 	ShortDescription: "Cleans up Kurtosis leftover artifacts",
 	LongDescription: fmt.Sprintf(
-		"Removes stopped enclaves (and live ones if the '%v' flag is set), as well as stopped engine containers",
+		"Removes stopped enclaves (and live ones if the '%v' flag is set), as well as stopped engine containers. "+
+			"With the '%v' flag, only the enclaves having all the given labels are removed",
 		shouldCleanRunningEnclavesFlagKey,
+		enclave_labels.LabelSelectorFlagKey,
 	),
 	KurtosisBackendContextKey: kurtosisBackendCtxKey,
 	EngineClientContextKey:    engineClientCtxKey,
@@ -55,6 +58,7 @@ var CleanCmd = &engine_consuming_kurtosis_command.EngineConsumingKurtosisCommand
 			Type:      flags.FlagType_Bool,
 			Default:   defaultShouldCleanRunningEnclaves,
 		},
+		enclave_labels.GetLabelSelectorFlagConfig(),
 	},
 	Args:    nil,
 	RunFunc: run,
@@ -73,6 +77,11 @@ func run(
 		return stacktrace.Propagate(err, "Expected a boolean flag with key '%v' but none was found; this is an error in Kurtosis!", shouldCleanAll)
 	}
 
+	labelSelector, err := enclave_labels.GetLabelsFromFlag(flags, enclave_labels.LabelSelectorFlagKey)
+	if err != nil {
+		return stacktrace.Propagate(err, "An error occurred getting the label selector from the flags")
+	}
+
 	// Map of cleaning_phase_title -> (successfully_destroyed_object_id, object_destruction_errors, clean_error)
 	cleaningPhaseFunctions := map[string]func() ([]string, []error, error){
 		oldEngineCleaningPhaseTitle: func() ([]string, []error, error) {
@@ -81,7 +90,7 @@ func run(
 		},
 		enclavesCleaningPhaseTitle: func() ([]string, []error, error) {
 			// Don't use stacktrace b/c the only reason this function exists is to pass in the right args
-			return cleanEnclaves(ctx, engineClient, shouldCleanAll)
+			return cleanEnclaves(ctx, engineClient, shouldCleanAll, labelSelector)
 		},
 		unusedImagesPhaseTitle: func() ([]string, []error, error) {
 			// Don't use stacktrace b/c the only reason this function exists is to pass in the right args
@@ -163,8 +172,8 @@ func cleanStoppedEngineContainers(ctx context.Context, kurtosisBackend backend_i
 	return successfulEngineContainerNames, removeEngineErrors, nil
 }
 
-func cleanEnclaves(ctx context.Context, engineClient kurtosis_engine_rpc_api_bindings.EngineServiceClient, shouldCleanAll bool) ([]string, []error, error) {
-	cleanArgs := &kurtosis_engine_rpc_api_bindings.CleanArgs{ShouldCleanAll: &shouldCleanAll}
+func cleanEnclaves(ctx context.Context, engineClient kurtosis_engine_rpc_api_bindings.EngineServiceClient, shouldCleanAll bool, labelSelector map[string]string) ([]string, []error, error) {
+	cleanArgs := &kurtosis_engine_rpc_api_bindings.CleanArgs{ShouldCleanAll: &shouldCleanAll, LabelSelector: labelSelector}
 	cleanResp, err := engineClient.Clean(ctx, cleanArgs)
 	if err != nil {
 		return nil, nil, stacktrace.Propagate(err, "An error occurred while calling clean")
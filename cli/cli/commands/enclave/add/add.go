@@ -10,6 +10,8 @@ import (
 	"github.com/kurtosis-tech/kurtosis/cli/cli/command_framework/lowlevel/flags"
 	"github.com/kurtosis-tech/kurtosis/cli/cli/command_str_consts"
 	"github.com/kurtosis-tech/kurtosis/cli/cli/defaults"
+	"github.com/kurtosis-tech/kurtosis/cli/cli/helpers/enclave_expiration"
+	"github.com/kurtosis-tech/kurtosis/cli/cli/helpers/enclave_labels"
 	"github.com/kurtosis-tech/kurtosis/cli/cli/helpers/engine_manager"
 	"github.com/kurtosis-tech/kurtosis/cli/cli/helpers/logrus_log_levels"
 	"github.com/kurtosis-tech/kurtosis/cli/cli/helpers/output_printers"
@@ -26,6 +28,7 @@ const (
 	apiContainerLogLevelFlagKey  = "api-container-log-level"
 	enclaveNameFlagKey           = "name"
 	enclaveProductionModeFlagKey = "production"
+	enclaveLabelFlagKey          = "label"
 
 	// Signifies that an enclave name should be auto-generated
 	autogenerateEnclaveNameKeyword = ""
@@ -43,7 +46,7 @@ var EnclaveAddCmd = &engine_consuming_kurtosis_command.EngineConsumingKurtosisCo
 	RunFunc:                   run,
 	KurtosisBackendContextKey: kurtosisBackendCtxKey,
 	EngineClientContextKey:    engineClientCtxKey,
-	Flags: []*flags.FlagConfig{
+	Flags: append([]*flags.FlagConfig{
 		{
 			Key:       apiContainerLogLevelFlagKey,
 			Shorthand: "l",
@@ -77,7 +80,14 @@ var EnclaveAddCmd = &engine_consuming_kurtosis_command.EngineConsumingKurtosisCo
 			Type:      flags.FlagType_Bool,
 			Default:   "false",
 		},
-	},
+		{
+			Key:       enclaveLabelFlagKey,
+			Usage:     "A label to attach to the enclave, in the 'key=value' format, that commands like 'enclave ls' can select it by; can be repeated",
+			Shorthand: "",
+			Type:      flags.FlagType_StringArray,
+			Default:   "",
+		},
+	}, enclave_expiration.GetFlagConfigs()...),
 }
 
 func run(
@@ -143,12 +153,34 @@ func run(
 		mode = kurtosis_engine_rpc_api_bindings.EnclaveMode_PRODUCTION
 	}
 
+	ttl, idleTimeout, expiredEnclaveAction, err := enclave_expiration.GetExpirationFromFlags(flags)
+	if err != nil {
+		return stacktrace.Propagate(err, "An error occurred getting the enclave expiration from the flags")
+	}
+
+	labels, err := enclave_labels.GetLabelsFromFlag(flags, enclaveLabelFlagKey)
+	if err != nil {
+		return stacktrace.Propagate(err, "An error occurred getting the enclave labels from the flags")
+	}
+
 	createEnclaveArgs := &kurtosis_engine_rpc_api_bindings.CreateEnclaveArgs{
 		EnclaveName:              &enclaveName,
 		ApiContainerVersionTag:   &apiContainerVersion,
 		ApiContainerLogLevel:     &kurtosisLogLevelStr,
 		Mode:                     &mode,
 		ShouldApicRunInDebugMode: &shouldApicRunInDebugMode,
+		TtlSeconds:               nil,
+		IdleTimeoutSeconds:       nil,
+		ExpiredEnclaveAction:     &expiredEnclaveAction,
+		Labels:                   labels,
+	}
+	if ttl > 0 {
+		ttlSeconds := uint32(ttl.Seconds())
+		createEnclaveArgs.TtlSeconds = &ttlSeconds
+	}
+	if idleTimeout > 0 {
+		idleTimeoutSeconds := uint32(idleTimeout.Seconds())
+		createEnclaveArgs.IdleTimeoutSeconds = &idleTimeoutSeconds
 	}
 	createdEnclaveResponse, err := engineClient.CreateEnclave(ctx, createEnclaveArgs)
 	if err != nil {
@@ -0,0 +1,119 @@
+package clone
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/kurtosis-tech/kurtosis/api/golang/engine/kurtosis_engine_rpc_api_bindings"
+	enclave_consts "github.com/kurtosis-tech/kurtosis/api/golang/engine/lib/enclave"
+	"github.com/kurtosis-tech/kurtosis/cli/cli/command_framework/highlevel/enclave_id_arg"
+	"github.com/kurtosis-tech/kurtosis/cli/cli/command_framework/highlevel/engine_consuming_kurtosis_command"
+	"github.com/kurtosis-tech/kurtosis/cli/cli/command_framework/lowlevel/args"
+	"github.com/kurtosis-tech/kurtosis/cli/cli/command_framework/lowlevel/flags"
+	"github.com/kurtosis-tech/kurtosis/cli/cli/command_str_consts"
+	"github.com/kurtosis-tech/kurtosis/cli/cli/helpers/output_printers"
+	"github.com/kurtosis-tech/kurtosis/container-engine-lib/lib/backend_interface"
+	"github.com/kurtosis-tech/kurtosis/metrics-library/golang/lib/metrics_client"
+	"github.com/kurtosis-tech/stacktrace"
+	"github.com/sirupsen/logrus"
+)
+
+const (
+	enclaveIdentifierArgKey = "enclave"
+	isEnclaveIdArgOptional  = false
+	isEnclaveIdArgGreedy    = false
+
+	enclaveNameFlagKey = "name"
+
+	allowPartialFlagKey     = "allow-partial"
+	allowPartialFlagDefault = "false"
+
+	autogenerateEnclaveNameKeyword = ""
+
+	kurtosisBackendCtxKey = "kurtosis-backend"
+	engineClientCtxKey    = "engine-client"
+)
+
+var EnclaveCloneCmd = &engine_consuming_kurtosis_command.EngineConsumingKurtosisCommand{
+	CommandStr:       command_str_consts.EnclaveCloneCmdStr,
+	ShortDescription: "Clones an enclave",
+	LongDescription: "Creates a new enclave with the files artifacts, persistent directories and services of a running enclave, " +
+		"recreating the services with the same configuration and names by replaying the plan of the enclave. " +
+		"Whatever couldn't be cloned, like the state of services without persistent directories, is reported. The enclave isn't cloned if some " +
+		"instructions of its plan can't be replayed, unless the '" + allowPartialFlagKey + "' flag is set. The persistent directories are " +
+		"copied while the services of the enclave keep running, and can't be copied on Kubernetes",
+	KurtosisBackendContextKey: kurtosisBackendCtxKey,
+	EngineClientContextKey:    engineClientCtxKey,
+	Flags: []*flags.FlagConfig{
+		{
+			Key:       enclaveNameFlagKey,
+			Shorthand: "n",
+			Default:   autogenerateEnclaveNameKeyword,
+			Usage: fmt.Sprintf(
+				"The enclave name to give the clone, which must match regex '%v' "+
+					"(emptystring will autogenerate an enclave name)",
+				enclave_consts.AllowedEnclaveNameCharsRegexStr,
+			),
+			Type: flags.FlagType_String,
+		},
+		{
+			Key:     allowPartialFlagKey,
+			Usage:   "Clone the enclave even if some instructions of its plan can't be replayed, leaving out the services they'd create",
+			Type:    flags.FlagType_Bool,
+			Default: allowPartialFlagDefault,
+		},
+	},
+	Args: []*args.ArgConfig{
+		enclave_id_arg.NewEnclaveIdentifierArg(
+			enclaveIdentifierArgKey,
+			engineClientCtxKey,
+			isEnclaveIdArgOptional,
+			isEnclaveIdArgGreedy,
+		),
+	},
+	RunFunc: run,
+}
+
+func run(
+	ctx context.Context,
+	_ backend_interface.KurtosisBackend,
+	engineClient kurtosis_engine_rpc_api_bindings.EngineServiceClient,
+	_ metrics_client.MetricsClient,
+	flags *flags.ParsedFlags,
+	args *args.ParsedArgs,
+) error {
+	enclaveIdentifier, err := args.GetNonGreedyArg(enclaveIdentifierArgKey)
+	if err != nil {
+		return stacktrace.Propagate(err, "Expected a value for non-greedy enclave identifier arg '%v' but none was found; this is a bug in the Kurtosis CLI!", enclaveIdentifierArgKey)
+	}
+
+	enclaveName, err := flags.GetString(enclaveNameFlagKey)
+	if err != nil {
+		return stacktrace.Propagate(err, "An error occurred while getting the enclave name using flag with key '%v'; this is a bug in Kurtosis", enclaveNameFlagKey)
+	}
+
+	allowPartial, err := flags.GetBool(allowPartialFlagKey)
+	if err != nil {
+		return stacktrace.Propagate(err, "An error occurred while getting the allow partial setting using flag with key '%v'; this is a bug in Kurtosis", allowPartialFlagKey)
+	}
+
+	logrus.Infof("Cloning enclave '%v'...", enclaveIdentifier)
+	cloneEnclaveArgs := &kurtosis_engine_rpc_api_bindings.CloneEnclaveArgs{
+		EnclaveIdentifier: enclaveIdentifier,
+		EnclaveName:       &enclaveName,
+		AllowPartial:      &allowPartial,
+	}
+	cloneEnclaveResponse, err := engineClient.CloneEnclave(ctx, cloneEnclaveArgs)
+	if err != nil {
+		return stacktrace.Propagate(err, "An error occurred cloning enclave '%v'", enclaveIdentifier)
+	}
+
+	for _, uncloneableItem := range cloneEnclaveResponse.GetUncloneableItems() {
+		logrus.Warnf("Not cloned: %v", uncloneableItem)
+	}
+	for _, warning := range cloneEnclaveResponse.GetWarnings() {
+		logrus.Warnf("Cloned with a caveat: %v", warning)
+	}
+	output_printers.PrintEnclaveName(cloneEnclaveResponse.GetEnclaveInfo().GetName())
+	return nil
+}
@@ -8,11 +8,16 @@ package enclave
 import (
 	"github.com/kurtosis-tech/kurtosis/cli/cli/command_str_consts"
 	"github.com/kurtosis-tech/kurtosis/cli/cli/commands/enclave/add"
+	"github.com/kurtosis-tech/kurtosis/cli/cli/commands/enclave/clone"
 	"github.com/kurtosis-tech/kurtosis/cli/cli/commands/enclave/connect"
 	"github.com/kurtosis-tech/kurtosis/cli/cli/commands/enclave/dump"
+	"github.com/kurtosis-tech/kurtosis/cli/cli/commands/enclave/extend"
 	"github.com/kurtosis-tech/kurtosis/cli/cli/commands/enclave/inspect"
+	"github.com/kurtosis-tech/kurtosis/cli/cli/commands/enclave/label"
 	"github.com/kurtosis-tech/kurtosis/cli/cli/commands/enclave/ls"
+	"github.com/kurtosis-tech/kurtosis/cli/cli/commands/enclave/rename"
 	"github.com/kurtosis-tech/kurtosis/cli/cli/commands/enclave/rm"
+	"github.com/kurtosis-tech/kurtosis/cli/cli/commands/enclave/stats"
 	"github.com/kurtosis-tech/kurtosis/cli/cli/commands/enclave/stop"
 	"github.com/spf13/cobra"
 )
@@ -33,4 +38,9 @@ func init() {
 	EnclaveCmd.AddCommand(rm.EnclaveRmCmd.MustGetCobraCommand())
 	EnclaveCmd.AddCommand(dump.EnclaveDumpCmd.MustGetCobraCommand())
 	EnclaveCmd.AddCommand(connect.EnclaveConnectCmd.MustGetCobraCommand())
+	EnclaveCmd.AddCommand(extend.EnclaveExtendCmd.MustGetCobraCommand())
+	EnclaveCmd.AddCommand(label.EnclaveLabelCmd.MustGetCobraCommand())
+	EnclaveCmd.AddCommand(clone.EnclaveCloneCmd.MustGetCobraCommand())
+	EnclaveCmd.AddCommand(rename.EnclaveRenameCmd.MustGetCobraCommand())
+	EnclaveCmd.AddCommand(stats.EnclaveStatsCmd.MustGetCobraCommand())
 }
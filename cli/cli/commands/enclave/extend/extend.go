@@ -0,0 +1,91 @@
+package extend
+
+import (
+	"context"
+	"github.com/kurtosis-tech/kurtosis/api/golang/engine/kurtosis_engine_rpc_api_bindings"
+	"github.com/kurtosis-tech/kurtosis/cli/cli/command_framework/highlevel/enclave_id_arg"
+	"github.com/kurtosis-tech/kurtosis/cli/cli/command_framework/highlevel/engine_consuming_kurtosis_command"
+	"github.com/kurtosis-tech/kurtosis/cli/cli/command_framework/lowlevel/args"
+	"github.com/kurtosis-tech/kurtosis/cli/cli/command_framework/lowlevel/flags"
+	"github.com/kurtosis-tech/kurtosis/cli/cli/command_str_consts"
+	"github.com/kurtosis-tech/kurtosis/cli/cli/helpers/enclave_expiration"
+	"github.com/kurtosis-tech/kurtosis/container-engine-lib/lib/backend_interface"
+	"github.com/kurtosis-tech/kurtosis/metrics-library/golang/lib/metrics_client"
+	"github.com/kurtosis-tech/stacktrace"
+	"github.com/sirupsen/logrus"
+)
+
+const (
+	enclaveIdentifierArgKey = "enclave"
+	isEnclaveIdArgOptional  = false
+	isEnclaveIdArgGreedy    = false
+
+	ttlFlagKey = "ttl"
+	noTtl      = ""
+
+	kurtosisBackendCtxKey = "kurtosis-backend"
+	engineClientCtxKey    = "engine-client"
+)
+
+var EnclaveExtendCmd = &engine_consuming_kurtosis_command.EngineConsumingKurtosisCommand{
+	CommandStr:                command_str_consts.EnclaveExtendCmdStr,
+	ShortDescription:          "Extends the time-to-live of an enclave",
+	LongDescription:           "Makes the enclave expire once the given time-to-live has elapsed, counting from now, keeping the idle timeout and expiry action it was created with",
+	KurtosisBackendContextKey: kurtosisBackendCtxKey,
+	EngineClientContextKey:    engineClientCtxKey,
+	Flags: []*flags.FlagConfig{
+		{
+			Key:       ttlFlagKey,
+			Usage:     "The new time-to-live of the enclave, as a duration like '30m' or '2h'",
+			Shorthand: "",
+			Type:      flags.FlagType_String,
+			Default:   noTtl,
+		},
+	},
+	Args: []*args.ArgConfig{
+		enclave_id_arg.NewEnclaveIdentifierArg(
+			enclaveIdentifierArgKey,
+			engineClientCtxKey,
+			isEnclaveIdArgOptional,
+			isEnclaveIdArgGreedy,
+		),
+	},
+	RunFunc: run,
+}
+
+func run(
+	ctx context.Context,
+	_ backend_interface.KurtosisBackend,
+	engineClient kurtosis_engine_rpc_api_bindings.EngineServiceClient,
+	_ metrics_client.MetricsClient,
+	flags *flags.ParsedFlags,
+	args *args.ParsedArgs,
+) error {
+	enclaveIdentifier, err := args.GetNonGreedyArg(enclaveIdentifierArgKey)
+	if err != nil {
+		return stacktrace.Propagate(err, "Expected a value for non-greedy enclave identifier arg '%v' but none was found; this is a bug in the Kurtosis CLI!", enclaveIdentifierArgKey)
+	}
+
+	ttlStr, err := flags.GetString(ttlFlagKey)
+	if err != nil {
+		return stacktrace.Propagate(err, "Expected a value for the '%v' flag but failed to get it", ttlFlagKey)
+	}
+	if ttlStr == noTtl {
+		return stacktrace.NewError("The '%v' flag is required to extend the time-to-live of enclave '%v'", ttlFlagKey, enclaveIdentifier)
+	}
+	ttl, err := enclave_expiration.ParseDuration(ttlStr)
+	if err != nil {
+		return stacktrace.Propagate(err, "An error occurred parsing the value of the '%v' flag", ttlFlagKey)
+	}
+
+	extendEnclaveTtlArgs := &kurtosis_engine_rpc_api_bindings.ExtendEnclaveTtlArgs{
+		EnclaveIdentifier: enclaveIdentifier,
+		TtlSeconds:        uint32(ttl.Seconds()),
+	}
+	if _, err := engineClient.ExtendEnclaveTtl(ctx, extendEnclaveTtlArgs); err != nil {
+		return stacktrace.Propagate(err, "An error occurred extending the time-to-live of enclave '%v'", enclaveIdentifier)
+	}
+
+	logrus.Infof("Enclave '%v' will now expire in %v", enclaveIdentifier, ttl)
+	return nil
+}
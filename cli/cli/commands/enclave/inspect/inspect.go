@@ -17,11 +17,15 @@ import (
 	"github.com/kurtosis-tech/kurtosis/api/golang/engine/lib/kurtosis_context"
 	"github.com/kurtosis-tech/kurtosis/cli/cli/command_framework/highlevel/enclave_id_arg"
 	"github.com/kurtosis-tech/kurtosis/cli/cli/command_framework/highlevel/engine_consuming_kurtosis_command"
+	"github.com/kurtosis-tech/kurtosis/cli/cli/command_framework/highlevel/output_format_flag"
 	"github.com/kurtosis-tech/kurtosis/cli/cli/command_framework/lowlevel/args"
 	"github.com/kurtosis-tech/kurtosis/cli/cli/command_framework/lowlevel/flags"
 	"github.com/kurtosis-tech/kurtosis/cli/cli/command_str_consts"
+	"github.com/kurtosis-tech/kurtosis/cli/cli/helpers/enclave_labels"
 	"github.com/kurtosis-tech/kurtosis/cli/cli/helpers/enclave_status_stringifier"
 	"github.com/kurtosis-tech/kurtosis/cli/cli/helpers/output_printers"
+	"github.com/kurtosis-tech/kurtosis/cli/cli/helpers/output_schemas"
+	"github.com/kurtosis-tech/kurtosis/cli/cli/helpers/user_services"
 	"github.com/kurtosis-tech/kurtosis/cli/cli/out"
 	"github.com/kurtosis-tech/kurtosis/container-engine-lib/lib/backend_interface"
 	"github.com/kurtosis-tech/kurtosis/metrics-library/golang/lib/metrics_client"
@@ -38,6 +42,8 @@ const (
 	enclaveNameTitleName         = "Name"
 	enclaveStatusTitleName       = "Status"
 	enclaveCreationTimeTitleName = "Creation Time"
+	enclaveExpirationTitleName   = "Expiration"
+	enclaveLabelsTitleName       = "Labels"
 	flagsTitleName               = "Flags"
 
 	fullUuidsFlagKey       = "full-uuids"
@@ -53,6 +59,8 @@ const (
 	filesArtifactsHeader        = "Files Artifacts"
 
 	productionEnclaveFlagStr = "production"
+
+	expiredEnclaveActionPrefix = "ExpiredEnclaveAction_"
 )
 
 var enclaveObjectPrintingFuncs = map[string]func(ctx context.Context, kurtosisCtx *kurtosis_context.KurtosisContext, enclaveInfo *kurtosis_engine_rpc_api_bindings.EnclaveInfo, showFullUuid bool, isAPIContainerRunning bool) error{
@@ -73,6 +81,7 @@ var EnclaveInspectCmd = &engine_consuming_kurtosis_command.EngineConsumingKurtos
 			Type:    flags.FlagType_Bool,
 			Default: fullUuidFlagKeyDefault,
 		},
+		output_format_flag.NewOutputFormatFlag(),
 	},
 	Args: []*args.ArgConfig{
 		enclave_id_arg.NewEnclaveIdentifierArg(
@@ -103,11 +112,20 @@ func run(
 		return stacktrace.Propagate(err, "Expected a value for the '%v' flag but failed to get it", fullUuidsFlagKey)
 	}
 
+	outputFormat, err := output_format_flag.GetOutputFormat(flags)
+	if err != nil {
+		return stacktrace.Propagate(err, "An error occurred getting the output format")
+	}
+
 	kurtosisCtx, err := kurtosis_context.NewKurtosisContextFromLocalEngine()
 	if err != nil {
 		return stacktrace.Propagate(err, "An error occurred creating Kurtosis Context from local engine")
 	}
 
+	if outputFormat.IsStructured() {
+		return PrintStructuredEnclaveInspect(ctx, kurtosisCtx, enclaveIdentifier, outputFormat)
+	}
+
 	if err = PrintEnclaveInspect(ctx, kurtosisCtx, enclaveIdentifier, showFullUuids); err != nil {
 		// this is already wrapped up
 		return err
@@ -148,6 +166,16 @@ func PrintEnclaveInspect(ctx context.Context, kurtosisCtx *kurtosis_context.Kurt
 	enclaveCreationTimeStr := enclaveCreationTime.AsTime().Local().Format(time.RFC1123)
 	keyValuePrinter.AddPair(enclaveCreationTimeTitleName, enclaveCreationTimeStr)
 
+	// Add expiration row
+	if expiration := enclaveInfo.GetExpiration(); expiration != nil {
+		keyValuePrinter.AddPair(enclaveExpirationTitleName, getEnclaveExpirationStr(expiration))
+	}
+
+	// Add labels row
+	if labels := enclaveInfo.GetLabels(); len(labels) > 0 {
+		keyValuePrinter.AddPair(enclaveLabelsTitleName, enclave_labels.FormatLabels(labels))
+	}
+
 	// Add flags row
 	allEnclaveFlagsStr := getAllEnclaveFlagsStr(enclaveInfo)
 
@@ -198,6 +226,50 @@ func PrintEnclaveInspect(ctx context.Context, kurtosisCtx *kurtosis_context.Kurt
 	return nil
 }
 
+// PrintStructuredEnclaveInspect prints the enclave, its services and its files artifacts in a machine-readable format
+func PrintStructuredEnclaveInspect(ctx context.Context, kurtosisCtx *kurtosis_context.KurtosisContext, enclaveIdentifier string, outputFormat output_printers.OutputFormat) error {
+	enclaveInfo, err := kurtosisCtx.GetEnclave(ctx, enclaveIdentifier)
+	if err != nil {
+		return stacktrace.Propagate(err, "An error occurred getting the enclave for identifier '%v'", enclaveIdentifier)
+	}
+
+	enclaveDetails := &output_schemas.EnclaveDetails{
+		Enclave:        output_schemas.NewEnclave(enclaveInfo),
+		Services:       []*output_schemas.Service{},
+		FilesArtifacts: []*output_schemas.FilesArtifact{},
+	}
+
+	// can't fetch services nor files artifacts information if APIC isn't running
+	isApiContainerRunning := enclaveInfo.GetApiContainerStatus() == kurtosis_engine_rpc_api_bindings.EnclaveAPIContainerStatus_EnclaveAPIContainerStatus_RUNNING
+	if isApiContainerRunning {
+		allServicesMap := map[string]bool{}
+		userServices, err := user_services.GetUserServiceInfoMapFromAPIContainer(ctx, enclaveInfo, allServicesMap)
+		if err != nil {
+			return stacktrace.Propagate(err, "Failed to get service info from API container in enclave '%v'", enclaveInfo.GetEnclaveUuid())
+		}
+		for _, userService := range user_services.GetSortedUserServiceSliceFromUserServiceMap(userServices) {
+			enclaveDetails.Services = append(enclaveDetails.Services, output_schemas.NewService(userService))
+		}
+
+		enclaveContext, err := kurtosisCtx.GetEnclaveContextFromEnclaveInfo(ctx, enclaveInfo)
+		if err != nil {
+			return stacktrace.Propagate(err, "An error occurred while fetching enclave with name '%v'", enclaveInfo.GetName())
+		}
+		filesArtifactsNamesAndUuids, err := enclaveContext.GetAllFilesArtifactNamesAndUuids(ctx)
+		if err != nil {
+			return stacktrace.Propagate(err, "An error occurred while fetching files artifacts name and uuids for enclave '%v'", enclaveContext.GetEnclaveName())
+		}
+		for _, filesArtifactNameAndUuid := range sortFileNamesAndUuids(filesArtifactsNamesAndUuids) {
+			enclaveDetails.FilesArtifacts = append(enclaveDetails.FilesArtifacts, output_schemas.NewFilesArtifact(filesArtifactNameAndUuid))
+		}
+	}
+
+	if err := output_printers.PrintStructured(outputFormat, enclaveDetails); err != nil {
+		return stacktrace.Propagate(err, "An error occurred printing enclave '%v'", enclaveIdentifier)
+	}
+	return nil
+}
+
 func getAllEnclaveFlagsStr(enclaveInfo *kurtosis_engine_rpc_api_bindings.EnclaveInfo) string {
 	allEnclaveFragsStr := ""
 
@@ -211,3 +283,16 @@ func getAllEnclaveFlagsStr(enclaveInfo *kurtosis_engine_rpc_api_bindings.Enclave
 
 	return allEnclaveFragsStr
 }
+
+func getEnclaveExpirationStr(expiration *kurtosis_engine_rpc_api_bindings.EnclaveExpiration) string {
+	expirationConditionStrs := []string{}
+	if expiration.GetExpirationTime() != nil {
+		expirationConditionStrs = append(expirationConditionStrs, fmt.Sprintf("at %v", expiration.GetExpirationTime().AsTime().Local().Format(time.RFC1123)))
+	}
+	if expiration.IdleTimeoutSeconds != nil {
+		idleTimeout := time.Duration(expiration.GetIdleTimeoutSeconds()) * time.Second
+		expirationConditionStrs = append(expirationConditionStrs, fmt.Sprintf("after being idle for %v", idleTimeout))
+	}
+	action := strings.ToLower(strings.TrimPrefix(expiration.GetAction().String(), expiredEnclaveActionPrefix))
+	return fmt.Sprintf("%v %v", action, strings.Join(expirationConditionStrs, " or "))
+}
@@ -0,0 +1,114 @@
+package label
+
+import (
+	"context"
+	"strings"
+
+	"github.com/kurtosis-tech/kurtosis/api/golang/engine/kurtosis_engine_rpc_api_bindings"
+	"github.com/kurtosis-tech/kurtosis/cli/cli/command_framework/highlevel/enclave_id_arg"
+	"github.com/kurtosis-tech/kurtosis/cli/cli/command_framework/highlevel/engine_consuming_kurtosis_command"
+	"github.com/kurtosis-tech/kurtosis/cli/cli/command_framework/lowlevel/args"
+	"github.com/kurtosis-tech/kurtosis/cli/cli/command_framework/lowlevel/flags"
+	"github.com/kurtosis-tech/kurtosis/cli/cli/command_str_consts"
+	"github.com/kurtosis-tech/kurtosis/container-engine-lib/lib/backend_interface"
+	"github.com/kurtosis-tech/kurtosis/container-engine-lib/lib/backend_interface/objects/enclave"
+	"github.com/kurtosis-tech/kurtosis/metrics-library/golang/lib/metrics_client"
+	"github.com/kurtosis-tech/stacktrace"
+	"github.com/sirupsen/logrus"
+)
+
+const (
+	enclaveIdentifierArgKey = "enclave"
+	isEnclaveIdArgOptional  = false
+	isEnclaveIdArgGreedy    = false
+
+	labelsArgKey = "labels"
+
+	// A label argument ending with this suffix, like 'team-', removes the label instead of setting it
+	labelRemovalSuffix = "-"
+
+	kurtosisBackendCtxKey = "kurtosis-backend"
+	engineClientCtxKey    = "engine-client"
+)
+
+var EnclaveLabelCmd = &engine_consuming_kurtosis_command.EngineConsumingKurtosisCommand{
+	CommandStr:                command_str_consts.EnclaveLabelCmdStr,
+	ShortDescription:          "Updates the labels of an enclave",
+	LongDescription:           "Sets the labels given as 'key=value' on the enclave and removes the ones given as 'key-', leaving its other labels untouched",
+	KurtosisBackendContextKey: kurtosisBackendCtxKey,
+	EngineClientContextKey:    engineClientCtxKey,
+	Flags:                     []*flags.FlagConfig{},
+	Args: []*args.ArgConfig{
+		enclave_id_arg.NewEnclaveIdentifierArg(
+			enclaveIdentifierArgKey,
+			engineClientCtxKey,
+			isEnclaveIdArgOptional,
+			isEnclaveIdArgGreedy,
+		),
+		{
+			Key:      labelsArgKey,
+			IsGreedy: true,
+		},
+	},
+	RunFunc: run,
+}
+
+func run(
+	ctx context.Context,
+	_ backend_interface.KurtosisBackend,
+	engineClient kurtosis_engine_rpc_api_bindings.EngineServiceClient,
+	_ metrics_client.MetricsClient,
+	_ *flags.ParsedFlags,
+	args *args.ParsedArgs,
+) error {
+	enclaveIdentifier, err := args.GetNonGreedyArg(enclaveIdentifierArgKey)
+	if err != nil {
+		return stacktrace.Propagate(err, "Expected a value for non-greedy enclave identifier arg '%v' but none was found; this is a bug in the Kurtosis CLI!", enclaveIdentifierArgKey)
+	}
+
+	labelArgs, err := args.GetGreedyArg(labelsArgKey)
+	if err != nil {
+		return stacktrace.Propagate(err, "Expected a value for greedy arg '%v' but none was found; this is a bug in the Kurtosis CLI!", labelsArgKey)
+	}
+
+	labelsToSet, labelsToRemove, err := parseLabelArgs(labelArgs)
+	if err != nil {
+		return stacktrace.Propagate(err, "An error occurred parsing the labels to update")
+	}
+
+	updateEnclaveLabelsArgs := &kurtosis_engine_rpc_api_bindings.UpdateEnclaveLabelsArgs{
+		EnclaveIdentifier: enclaveIdentifier,
+		LabelsToSet:       labelsToSet,
+		LabelsToRemove:    labelsToRemove,
+	}
+	if _, err := engineClient.UpdateEnclaveLabels(ctx, updateEnclaveLabelsArgs); err != nil {
+		return stacktrace.Propagate(err, "An error occurred updating the labels of enclave '%v'", enclaveIdentifier)
+	}
+
+	logrus.Infof("Labels of enclave '%v' successfully updated", enclaveIdentifier)
+	return nil
+}
+
+// Splits the 'key=value' arguments, which set labels, from the 'key-' ones, which remove them
+func parseLabelArgs(labelArgs []string) (map[string]string, []string, error) {
+	keyValuePairsToSet := []string{}
+	labelsToRemove := []string{}
+	for _, labelArg := range labelArgs {
+		if !strings.Contains(labelArg, "=") && strings.HasSuffix(labelArg, labelRemovalSuffix) {
+			labelsToRemove = append(labelsToRemove, strings.TrimSuffix(labelArg, labelRemovalSuffix))
+			continue
+		}
+		keyValuePairsToSet = append(keyValuePairsToSet, labelArg)
+	}
+
+	labelsToSet, err := enclave.ParseEnclaveLabels(keyValuePairsToSet)
+	if err != nil {
+		return nil, nil, stacktrace.Propagate(err, "An error occurred parsing the labels to set")
+	}
+	for _, labelToRemove := range labelsToRemove {
+		if _, found := labelsToSet[labelToRemove]; found {
+			return nil, nil, stacktrace.NewError("Label '%v' can't be both set and removed", labelToRemove)
+		}
+	}
+	return labelsToSet, labelsToRemove, nil
+}
@@ -0,0 +1,19 @@
+package label
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestParseLabelArgs(t *testing.T) {
+	labelsToSet, labelsToRemove, err := parseLabelArgs([]string{"team=infra", "pr-", "commit=abc"})
+	require.NoError(t, err)
+	require.Equal(t, map[string]string{"team": "infra", "commit": "abc"}, labelsToSet)
+	require.Equal(t, []string{"pr"}, labelsToRemove)
+}
+
+func TestParseLabelArgs_SetAndRemoveSameLabel(t *testing.T) {
+	_, _, err := parseLabelArgs([]string{"team=infra", "team-"})
+	require.Error(t, err)
+}
@@ -10,11 +10,14 @@ import (
 	"github.com/kurtosis-tech/kurtosis/api/golang/engine/kurtosis_engine_rpc_api_bindings"
 	"github.com/kurtosis-tech/kurtosis/api/golang/engine/lib/kurtosis_context"
 	"github.com/kurtosis-tech/kurtosis/cli/cli/command_framework/highlevel/engine_consuming_kurtosis_command"
+	"github.com/kurtosis-tech/kurtosis/cli/cli/command_framework/highlevel/output_format_flag"
 	"github.com/kurtosis-tech/kurtosis/cli/cli/command_framework/lowlevel/args"
 	"github.com/kurtosis-tech/kurtosis/cli/cli/command_framework/lowlevel/flags"
 	"github.com/kurtosis-tech/kurtosis/cli/cli/command_str_consts"
+	"github.com/kurtosis-tech/kurtosis/cli/cli/helpers/enclave_labels"
 	"github.com/kurtosis-tech/kurtosis/cli/cli/helpers/enclave_status_stringifier"
 	"github.com/kurtosis-tech/kurtosis/cli/cli/helpers/output_printers"
+	"github.com/kurtosis-tech/kurtosis/cli/cli/helpers/output_schemas"
 	"github.com/kurtosis-tech/kurtosis/container-engine-lib/lib/backend_interface"
 	"github.com/kurtosis-tech/kurtosis/metrics-library/golang/lib/metrics_client"
 	"github.com/kurtosis-tech/stacktrace"
@@ -28,6 +31,7 @@ const (
 	enclaveStatusColumnHeader       = "Status"
 	enclaveNameColumnHeader         = "Name"
 	enclaveCreationTimeColumnHeader = "Creation Time"
+	enclaveLabelsColumnHeader       = "Labels"
 
 	kurtosisBackendCtxKey = "kurtosis-backend"
 	engineClientCtxKey    = "engine-client"
@@ -41,7 +45,7 @@ const (
 var EnclaveLsCmd = &engine_consuming_kurtosis_command.EngineConsumingKurtosisCommand{
 	CommandStr:                command_str_consts.EnclaveLsCmdStr,
 	ShortDescription:          "Lists enclaves",
-	LongDescription:           "Lists the enclaves running in the Kurtosis engine",
+	LongDescription:           "Lists the enclaves running in the Kurtosis engine, only the ones having all the labels given with the '" + enclave_labels.LabelSelectorFlagKey + "' flag if it's set",
 	KurtosisBackendContextKey: kurtosisBackendCtxKey,
 	EngineClientContextKey:    engineClientCtxKey,
 	Flags: []*flags.FlagConfig{
@@ -51,6 +55,8 @@ var EnclaveLsCmd = &engine_consuming_kurtosis_command.EngineConsumingKurtosisCom
 			Type:    flags.FlagType_Bool,
 			Default: fullUuidFlagKeyDefault,
 		},
+		enclave_labels.GetLabelSelectorFlagConfig(),
+		output_format_flag.NewOutputFormatFlag(),
 	},
 	Args:    nil,
 	RunFunc: run,
@@ -69,7 +75,12 @@ func run(
 		return stacktrace.Propagate(err, "An error occurred creating Kurtosis Context from local engine")
 	}
 
-	enclaves, err := kurtosisCtx.GetEnclaves(ctx)
+	labelSelector, err := enclave_labels.GetLabelsFromFlag(flags, enclave_labels.LabelSelectorFlagKey)
+	if err != nil {
+		return stacktrace.Propagate(err, "An error occurred getting the label selector from the flags")
+	}
+
+	enclaves, err := kurtosisCtx.GetEnclavesMatchingLabels(ctx, labelSelector)
 	if err != nil {
 		return stacktrace.Propagate(err, "An error occurred getting enclaves")
 	}
@@ -79,9 +90,29 @@ func run(
 		return stacktrace.Propagate(err, "Expected a value for the '%v' flag but failed to get it", fullUuidsFlagKey)
 	}
 
-	tablePrinter := output_printers.NewTablePrinter(enclaveUuidColumnHeader, enclaveNameColumnHeader, enclaveStatusColumnHeader, enclaveCreationTimeColumnHeader)
+	outputFormat, err := output_format_flag.GetOutputFormat(flags)
+	if err != nil {
+		return stacktrace.Propagate(err, "An error occurred getting the output format")
+	}
+
 	orderedEnclaveInfoMaps, enclaveWithoutCreationTimeInfoMap := getOrderedEnclaveInfoMapAndEnclaveWithoutCreationTimeMap(enclaves.GetEnclavesByUuid())
 
+	if outputFormat.IsStructured() {
+		enclavesOutput := []*output_schemas.Enclave{}
+		for _, enclaveInfo := range enclaveWithoutCreationTimeInfoMap {
+			enclavesOutput = append(enclavesOutput, output_schemas.NewEnclave(enclaveInfo))
+		}
+		for _, enclaveInfo := range orderedEnclaveInfoMaps {
+			enclavesOutput = append(enclavesOutput, output_schemas.NewEnclave(enclaveInfo))
+		}
+		if err := output_printers.PrintStructured(outputFormat, enclavesOutput); err != nil {
+			return stacktrace.Propagate(err, "An error occurred printing the enclaves")
+		}
+		return nil
+	}
+
+	tablePrinter := output_printers.NewTablePrinter(enclaveUuidColumnHeader, enclaveNameColumnHeader, enclaveStatusColumnHeader, enclaveCreationTimeColumnHeader, enclaveLabelsColumnHeader)
+
 	//TODO remove this iteration after 2023-01-01 when we are sure that there is not any old enclave created without the creation time label
 	//This is for retro-compatibility, for those old enclave did not track enclave's creation time
 	for _, enclaveInfo := range enclaveWithoutCreationTimeInfoMap {
@@ -96,7 +127,7 @@ func run(
 			return stacktrace.Propagate(err, "An error occurred when stringify enclave containers status '%v'", enclaveInfo.GetContainersStatus())
 		}
 
-		if err := tablePrinter.AddRow(uuidToPrint, enclaveInfo.Name, enclaveStatus, emptyTimeForOldEnclaves); err != nil {
+		if err := tablePrinter.AddRow(uuidToPrint, enclaveInfo.Name, enclaveStatus, emptyTimeForOldEnclaves, enclave_labels.FormatLabels(enclaveInfo.GetLabels())); err != nil {
 			return stacktrace.NewError("An error occurred adding row for enclave '%v' to the table printer", enclaveUuid)
 		}
 	}
@@ -120,7 +151,7 @@ func run(
 
 		enclaveName := enclaveInfo.GetName()
 
-		if err := tablePrinter.AddRow(uuidToPrint, enclaveName, enclaveStatus, enclaveCreationTime); err != nil {
+		if err := tablePrinter.AddRow(uuidToPrint, enclaveName, enclaveStatus, enclaveCreationTime, enclave_labels.FormatLabels(enclaveInfo.GetLabels())); err != nil {
 			return stacktrace.NewError("An error occurred adding row for enclave '%v' to the table printer", enclaveUuid)
 		}
 	}
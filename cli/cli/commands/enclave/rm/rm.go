@@ -11,6 +11,7 @@ import (
 	"github.com/kurtosis-tech/kurtosis/cli/cli/command_framework/lowlevel/args"
 	"github.com/kurtosis-tech/kurtosis/cli/cli/command_framework/lowlevel/flags"
 	"github.com/kurtosis-tech/kurtosis/cli/cli/command_str_consts"
+	"github.com/kurtosis-tech/kurtosis/cli/cli/helpers/enclave_labels"
 	"github.com/kurtosis-tech/kurtosis/container-engine-lib/lib/backend_interface"
 	"github.com/kurtosis-tech/kurtosis/metrics-library/golang/lib/metrics_client"
 	"github.com/kurtosis-tech/stacktrace"
@@ -21,8 +22,9 @@ import (
 
 const (
 	enclaveIdentifiersArgKey = "enclaves"
-	isEnclaveIdArgOptional   = false
-	isEnclaveIdArgGreedy     = true
+	// The enclaves can be selected by their labels instead
+	isEnclaveIdArgOptional = true
+	isEnclaveIdArgGreedy   = true
 
 	shouldForceRemoveFlagKey = "force"
 	defaultShouldForceRemove = "false"
@@ -34,7 +36,7 @@ const (
 var EnclaveRmCmd = &engine_consuming_kurtosis_command.EngineConsumingKurtosisCommand{
 	CommandStr:                command_str_consts.EnclaveRmCmdStr,
 	ShortDescription:          "Destroys the specified enclaves",
-	LongDescription:           "Destroys the specified enclaves, removing all resources associated with them",
+	LongDescription:           "Destroys the specified enclaves, as well as the ones having all the labels given with the '" + enclave_labels.LabelSelectorFlagKey + "' flag, removing all resources associated with them",
 	KurtosisBackendContextKey: kurtosisBackendCtxKey,
 	EngineClientContextKey:    engineClientCtxKey,
 	Flags: []*flags.FlagConfig{
@@ -45,6 +47,7 @@ var EnclaveRmCmd = &engine_consuming_kurtosis_command.EngineConsumingKurtosisCom
 			Type:      flags.FlagType_Bool,
 			Default:   defaultShouldForceRemove,
 		},
+		enclave_labels.GetLabelSelectorFlagConfig(),
 	},
 	Args: []*args.ArgConfig{
 		enclave_id_arg.NewEnclaveIdentifierArg(
@@ -75,11 +78,33 @@ func run(
 		return stacktrace.Propagate(err, "An error occurred getting the force-removal flag value using key '%v'; this is a bug in Kurtosis!", shouldForceRemoveFlagKey)
 	}
 
+	labelSelector, err := enclave_labels.GetLabelsFromFlag(flags, enclave_labels.LabelSelectorFlagKey)
+	if err != nil {
+		return stacktrace.Propagate(err, "An error occurred getting the label selector from the flags")
+	}
+	if len(enclaveIdentifiers) == 0 && len(labelSelector) == 0 {
+		return stacktrace.NewError("No enclaves to destroy; pass enclave identifiers or select enclaves by labels with the '%v' flag", enclave_labels.LabelSelectorFlagKey)
+	}
+
 	kurtosisCtx, err := kurtosis_context.NewKurtosisContextFromLocalEngine()
 	if err != nil {
 		return stacktrace.Propagate(err, "An error occurred creating Kurtosis Context from local engine")
 	}
 
+	if len(labelSelector) > 0 {
+		matchingEnclaves, err := kurtosisCtx.GetEnclavesMatchingLabels(ctx, labelSelector)
+		if err != nil {
+			return stacktrace.Propagate(err, "An error occurred getting the enclaves matching the label selector")
+		}
+		if len(matchingEnclaves.GetEnclavesByUuid()) == 0 && len(enclaveIdentifiers) == 0 {
+			logrus.Info("No enclaves match the label selector")
+			return nil
+		}
+		for enclaveUuid := range matchingEnclaves.GetEnclavesByUuid() {
+			enclaveIdentifiers = append(enclaveIdentifiers, enclaveUuid)
+		}
+	}
+
 	logrus.Debugf("inputted enclave UUIDs: %+v", enclaveIdentifiers)
 
 	// Condense the enclave UUIDs down into a unique set, so we don't try to double-destroy an enclave
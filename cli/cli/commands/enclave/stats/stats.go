@@ -0,0 +1,213 @@
+package stats
+
+import (
+	"context"
+	"fmt"
+	"io"
+	"time"
+
+	"github.com/kurtosis-tech/kurtosis/api/golang/core/kurtosis_core_rpc_api_bindings"
+	"github.com/kurtosis-tech/kurtosis/api/golang/engine/kurtosis_engine_rpc_api_bindings"
+	"github.com/kurtosis-tech/kurtosis/api/golang/engine/lib/kurtosis_context"
+	"github.com/kurtosis-tech/kurtosis/cli/cli/command_framework/highlevel/enclave_id_arg"
+	"github.com/kurtosis-tech/kurtosis/cli/cli/command_framework/highlevel/engine_consuming_kurtosis_command"
+	"github.com/kurtosis-tech/kurtosis/cli/cli/command_framework/highlevel/output_format_flag"
+	"github.com/kurtosis-tech/kurtosis/cli/cli/command_framework/highlevel/service_identifier_arg"
+	"github.com/kurtosis-tech/kurtosis/cli/cli/command_framework/lowlevel/args"
+	"github.com/kurtosis-tech/kurtosis/cli/cli/command_framework/lowlevel/flags"
+	"github.com/kurtosis-tech/kurtosis/cli/cli/command_str_consts"
+	"github.com/kurtosis-tech/kurtosis/cli/cli/helpers/interactive_terminal_decider"
+	"github.com/kurtosis-tech/kurtosis/cli/cli/helpers/output_printers"
+	"github.com/kurtosis-tech/kurtosis/cli/cli/helpers/output_schemas"
+	"github.com/kurtosis-tech/kurtosis/cli/cli/out"
+	"github.com/kurtosis-tech/kurtosis/container-engine-lib/lib/backend_interface"
+	"github.com/kurtosis-tech/kurtosis/metrics-library/golang/lib/metrics_client"
+	"github.com/kurtosis-tech/stacktrace"
+)
+
+const (
+	enclaveIdentifierArgKey = "enclave"
+	isEnclaveIdArgOptional  = false
+	isEnclaveIdArgGreedy    = false
+
+	serviceIdentifiersArgKey        = "services"
+	isServiceIdentifiersArgOptional = true
+	isServiceIdentifiersArgGreedy   = true
+
+	noStreamFlagKey     = "no-stream"
+	noStreamFlagDefault = "false"
+
+	intervalFlagKey = "interval"
+	// Zero lets the API container pick the interval
+	intervalFlagDefault = "0s"
+
+	nameColumnHeader    = "NAME"
+	cpuColumnHeader     = "CPU %"
+	memoryColumnHeader  = "MEM USAGE / LIMIT"
+	networkColumnHeader = "NET I/O (RX / TX)"
+	diskColumnHeader    = "DISK I/O (READ / WRITE)"
+
+	noMemoryLimitStr = "-"
+
+	// Moves the cursor to the top left corner then clears the screen, so that each sample replaces the previous one
+	clearScreenSequence = "\033[H\033[2J"
+
+	bytesPerUnit = 1024
+
+	kurtosisBackendCtxKey = "kurtosis-backend"
+	engineClientCtxKey    = "engine-client"
+)
+
+var byteUnits = []string{"B", "KiB", "MiB", "GiB", "TiB"}
+
+var EnclaveStatsCmd = &engine_consuming_kurtosis_command.EngineConsumingKurtosisCommand{
+	CommandStr:       command_str_consts.EnclaveStatsCmdStr,
+	ShortDescription: "Shows the resources used by the services of an enclave",
+	LongDescription: "Shows the CPU, memory, network and disk used by the running services of an enclave, or by the given " +
+		"services only, refreshing until interrupted. Network and disk usage are totals since each service started, and aren't " +
+		"reported on Kubernetes",
+	KurtosisBackendContextKey: kurtosisBackendCtxKey,
+	EngineClientContextKey:    engineClientCtxKey,
+	Flags: []*flags.FlagConfig{
+		{
+			Key:     noStreamFlagKey,
+			Usage:   "Print a single sample instead of refreshing until interrupted",
+			Type:    flags.FlagType_Bool,
+			Default: noStreamFlagDefault,
+		},
+		{
+			Key:     intervalFlagKey,
+			Usage:   "How long to wait between samples, as a Go duration (e.g. '5s'). Defaults to the API container's interval",
+			Type:    flags.FlagType_String,
+			Default: intervalFlagDefault,
+		},
+		output_format_flag.NewOutputFormatFlag(),
+	},
+	Args: []*args.ArgConfig{
+		enclave_id_arg.NewEnclaveIdentifierArg(
+			enclaveIdentifierArgKey,
+			engineClientCtxKey,
+			isEnclaveIdArgOptional,
+			isEnclaveIdArgGreedy,
+		),
+		service_identifier_arg.NewServiceIdentifierArg(
+			serviceIdentifiersArgKey,
+			enclaveIdentifierArgKey,
+			isServiceIdentifiersArgOptional,
+			isServiceIdentifiersArgGreedy,
+		),
+	},
+	RunFunc: run,
+}
+
+func run(
+	ctx context.Context,
+	_ backend_interface.KurtosisBackend,
+	_ kurtosis_engine_rpc_api_bindings.EngineServiceClient,
+	_ metrics_client.MetricsClient,
+	flags *flags.ParsedFlags,
+	args *args.ParsedArgs,
+) error {
+	enclaveIdentifier, err := args.GetNonGreedyArg(enclaveIdentifierArgKey)
+	if err != nil {
+		return stacktrace.Propagate(err, "An error occurred getting the enclave identifier value using key '%v'", enclaveIdentifierArgKey)
+	}
+
+	serviceIdentifiers, err := args.GetGreedyArg(serviceIdentifiersArgKey)
+	if err != nil {
+		return stacktrace.Propagate(err, "An error occurred getting the service identifiers using key '%v'", serviceIdentifiersArgKey)
+	}
+
+	noStream, err := flags.GetBool(noStreamFlagKey)
+	if err != nil {
+		return stacktrace.Propagate(err, "Expected a value for the '%v' flag but failed to get it", noStreamFlagKey)
+	}
+
+	intervalStr, err := flags.GetString(intervalFlagKey)
+	if err != nil {
+		return stacktrace.Propagate(err, "Expected a value for the '%v' flag but failed to get it", intervalFlagKey)
+	}
+	interval, err := time.ParseDuration(intervalStr)
+	if err != nil {
+		return stacktrace.Propagate(err, "An error occurred parsing interval '%v' as a Go duration", intervalStr)
+	}
+
+	outputFormat, err := output_format_flag.GetOutputFormat(flags)
+	if err != nil {
+		return stacktrace.Propagate(err, "An error occurred getting the output format")
+	}
+
+	kurtosisCtx, err := kurtosis_context.NewKurtosisContextFromLocalEngine()
+	if err != nil {
+		return stacktrace.Propagate(err, "An error occurred creating Kurtosis Context from local engine")
+	}
+
+	enclaveCtx, err := kurtosisCtx.GetEnclaveContext(ctx, enclaveIdentifier)
+	if err != nil {
+		return stacktrace.Propagate(err, "An error occurred getting an enclave context from enclave info for enclave '%v'", enclaveIdentifier)
+	}
+
+	shouldFollow := !noStream
+	stream, err := enclaveCtx.GetServiceStats(ctx, serviceIdentifiers, shouldFollow, interval)
+	if err != nil {
+		return stacktrace.Propagate(err, "An error occurred getting the stats of the services of enclave '%v'", enclaveIdentifier)
+	}
+
+	shouldClearScreen := shouldFollow && !outputFormat.IsStructured() && interactive_terminal_decider.IsInteractiveTerminal()
+	for {
+		sample, err := stream.Recv()
+		if err == io.EOF {
+			return nil
+		}
+		if err != nil {
+			return stacktrace.Propagate(err, "An error occurred receiving the stats of the services of enclave '%v'", enclaveIdentifier)
+		}
+
+		if outputFormat.IsStructured() {
+			if err := output_printers.PrintStructured(outputFormat, output_schemas.NewServiceStatsSample(sample)); err != nil {
+				return stacktrace.Propagate(err, "An error occurred printing the stats of the services")
+			}
+			continue
+		}
+		if shouldClearScreen {
+			fmt.Fprint(out.GetOut(), clearScreenSequence)
+		}
+		if err := printServiceStatsTable(sample); err != nil {
+			return stacktrace.Propagate(err, "An error occurred printing the stats of the services")
+		}
+	}
+}
+
+func printServiceStatsTable(sample *kurtosis_core_rpc_api_bindings.GetServiceStatsResponse) error {
+	tablePrinter := output_printers.NewTablePrinter(nameColumnHeader, cpuColumnHeader, memoryColumnHeader, networkColumnHeader, diskColumnHeader)
+	for _, serviceStats := range sample.GetServiceStats() {
+		memoryLimitStr := noMemoryLimitStr
+		if serviceStats.GetMemoryLimitBytes() > 0 {
+			memoryLimitStr = formatBytes(serviceStats.GetMemoryLimitBytes())
+		}
+		if err := tablePrinter.AddRow(
+			serviceStats.GetServiceName(),
+			fmt.Sprintf("%.2f%%", serviceStats.GetCpuPercent()),
+			fmt.Sprintf("%s / %s", formatBytes(serviceStats.GetMemoryUsageBytes()), memoryLimitStr),
+			fmt.Sprintf("%s / %s", formatBytes(serviceStats.GetNetworkReceivedBytes()), formatBytes(serviceStats.GetNetworkSentBytes())),
+			fmt.Sprintf("%s / %s", formatBytes(serviceStats.GetDiskReadBytes()), formatBytes(serviceStats.GetDiskWrittenBytes())),
+		); err != nil {
+			return stacktrace.Propagate(err, "An error occurred adding the row of service '%v' to the table printer", serviceStats.GetServiceName())
+		}
+	}
+	tablePrinter.Print()
+	return nil
+}
+
+func formatBytes(numBytes uint64) string {
+	value := float64(numBytes)
+	unitIdx := 0
+	for value >= bytesPerUnit && unitIdx < len(byteUnits)-1 {
+		value /= bytesPerUnit
+		unitIdx++
+	}
+	if unitIdx == 0 {
+		return fmt.Sprintf("%d%s", numBytes, byteUnits[unitIdx])
+	}
+	return fmt.Sprintf("%.2f%s", value, byteUnits[unitIdx])
+}
@@ -0,0 +1,15 @@
+package stats
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestFormatBytes(t *testing.T) {
+	require.Equal(t, "0B", formatBytes(0))
+	require.Equal(t, "1023B", formatBytes(1023))
+	require.Equal(t, "1.00KiB", formatBytes(1024))
+	require.Equal(t, "1.50MiB", formatBytes(1572864))
+	require.Equal(t, "2048.00TiB", formatBytes(2*1024*1024*1024*1024*1024))
+}
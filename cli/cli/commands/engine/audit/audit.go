@@ -0,0 +1,133 @@
+package audit
+
+import (
+	"context"
+	"fmt"
+	"sort"
+	"strings"
+	"time"
+
+	"github.com/kurtosis-tech/kurtosis/api/golang/engine/kurtosis_engine_rpc_api_bindings"
+	"github.com/kurtosis-tech/kurtosis/cli/cli/command_framework/highlevel/engine_consuming_kurtosis_command"
+	"github.com/kurtosis-tech/kurtosis/cli/cli/command_framework/lowlevel/args"
+	"github.com/kurtosis-tech/kurtosis/cli/cli/command_framework/lowlevel/flags"
+	"github.com/kurtosis-tech/kurtosis/cli/cli/command_str_consts"
+	"github.com/kurtosis-tech/kurtosis/cli/cli/helpers/output_printers"
+	"github.com/kurtosis-tech/kurtosis/cli/cli/out"
+	"github.com/kurtosis-tech/kurtosis/container-engine-lib/lib/backend_interface"
+	"github.com/kurtosis-tech/kurtosis/metrics-library/golang/lib/metrics_client"
+	"github.com/kurtosis-tech/stacktrace"
+)
+
+const (
+	timeColumnHeader      = "Time"
+	callerColumnHeader    = "Caller"
+	actionColumnHeader    = "Action"
+	enclaveColumnHeader   = "Enclave"
+	argumentsColumnHeader = "Arguments"
+	errorColumnHeader     = "Error"
+
+	enclaveFlagKey          = "enclave"
+	allEnclavesByDefault    = ""
+	pageSizeFlagKey         = "page-size"
+	engineDefaultPageSize   = "0"
+	pageTokenFlagKey        = "page-token"
+	firstPageTokenByDefault = ""
+
+	argumentsSeparator = ", "
+
+	kurtosisBackendCtxKey = "kurtosis-backend"
+	engineClientCtxKey    = "engine-client"
+)
+
+var EngineAuditCmd = &engine_consuming_kurtosis_command.EngineConsumingKurtosisCommand{
+	CommandStr:       command_str_consts.EngineAuditCmdStr,
+	ShortDescription: "Shows the audit log of the engine",
+	LongDescription: "Shows who created, stopped or destroyed enclaves, ran packages, executed commands in services or uploaded " +
+		"files, newest first. Only admin API tokens can read the audit log when the engine enforces API authentication",
+	KurtosisBackendContextKey: kurtosisBackendCtxKey,
+	EngineClientContextKey:    engineClientCtxKey,
+	Flags: []*flags.FlagConfig{
+		{
+			Key:     enclaveFlagKey,
+			Usage:   "The name, UUID or shortened UUID of the enclave to only show the entries of. Destroyed enclaves can be given too",
+			Type:    flags.FlagType_String,
+			Default: allEnclavesByDefault,
+		},
+		{
+			Key:     pageSizeFlagKey,
+			Usage:   "The maximum number of entries to show. The engine's default is used if it's 0",
+			Type:    flags.FlagType_Uint32,
+			Default: engineDefaultPageSize,
+		},
+		{
+			Key:     pageTokenFlagKey,
+			Usage:   "The token printed with the previous page, to show the entries following it",
+			Type:    flags.FlagType_String,
+			Default: firstPageTokenByDefault,
+		},
+	},
+	Args:    nil,
+	RunFunc: run,
+}
+
+func run(
+	ctx context.Context,
+	_ backend_interface.KurtosisBackend,
+	engineClient kurtosis_engine_rpc_api_bindings.EngineServiceClient,
+	_ metrics_client.MetricsClient,
+	flags *flags.ParsedFlags,
+	_ *args.ParsedArgs,
+) error {
+	enclaveIdentifier, err := flags.GetString(enclaveFlagKey)
+	if err != nil {
+		return stacktrace.Propagate(err, "Expected a value for the '%v' flag but failed to get it", enclaveFlagKey)
+	}
+	pageSize, err := flags.GetUint32(pageSizeFlagKey)
+	if err != nil {
+		return stacktrace.Propagate(err, "Expected a value for the '%v' flag but failed to get it", pageSizeFlagKey)
+	}
+	pageToken, err := flags.GetString(pageTokenFlagKey)
+	if err != nil {
+		return stacktrace.Propagate(err, "Expected a value for the '%v' flag but failed to get it", pageTokenFlagKey)
+	}
+
+	getAuditLogArgs := &kurtosis_engine_rpc_api_bindings.GetAuditLogArgs{
+		EnclaveIdentifier: nil,
+		PageSize:          &pageSize,
+		PageToken:         nil,
+	}
+	if enclaveIdentifier != allEnclavesByDefault {
+		getAuditLogArgs.EnclaveIdentifier = &enclaveIdentifier
+	}
+	if pageToken != firstPageTokenByDefault {
+		getAuditLogArgs.PageToken = &pageToken
+	}
+	response, err := engineClient.GetAuditLog(ctx, getAuditLogArgs)
+	if err != nil {
+		return stacktrace.Propagate(err, "An error occurred getting the audit log")
+	}
+
+	tablePrinter := output_printers.NewTablePrinter(timeColumnHeader, callerColumnHeader, actionColumnHeader, enclaveColumnHeader, argumentsColumnHeader, errorColumnHeader)
+	for _, entry := range response.GetEntries() {
+		entryTime := entry.GetTimestamp().AsTime().Local().Format(time.RFC1123)
+		if err := tablePrinter.AddRow(entryTime, entry.GetCaller(), entry.GetAction(), entry.GetEnclaveName(), formatArguments(entry.GetArguments()), entry.GetError()); err != nil {
+			return stacktrace.NewError("An error occurred adding the '%v' entry of '%v' to the table printer", entry.GetAction(), entryTime)
+		}
+	}
+	tablePrinter.Print()
+
+	if response.GetNextPageToken() != "" {
+		out.PrintOutLn(fmt.Sprintf("More entries can be shown by passing '--%v %v'", pageTokenFlagKey, response.GetNextPageToken()))
+	}
+	return nil
+}
+
+func formatArguments(arguments map[string]string) string {
+	formattedArguments := []string{}
+	for key, value := range arguments {
+		formattedArguments = append(formattedArguments, fmt.Sprintf("%v=%v", key, value))
+	}
+	sort.Strings(formattedArguments)
+	return strings.Join(formattedArguments, argumentsSeparator)
+}
@@ -1,8 +1,11 @@
 package common
 
 import (
+	"context"
+
 	"github.com/kurtosis-tech/kurtosis/cli/cli/helpers/kurtosis_config_getter"
 	"github.com/kurtosis-tech/kurtosis/cli/cli/kurtosis_config/resolved_config"
+	"github.com/kurtosis-tech/kurtosis/container-engine-lib/lib/backend_interface"
 	"github.com/kurtosis-tech/stacktrace"
 )
 
@@ -32,3 +35,17 @@ func isEnclavePoolAvailableForCurrentClusterType() (bool, error) {
 	}
 	return false, nil
 }
+
+// GetKurtosisBackend returns the backend of the current cluster, for the engine commands that manage engine components
+// directly rather than through the engine server
+func GetKurtosisBackend(ctx context.Context) (backend_interface.KurtosisBackend, error) {
+	clusterConfig, err := kurtosis_config_getter.GetKurtosisClusterConfig()
+	if err != nil {
+		return nil, stacktrace.Propagate(err, "An error occurred getting the Kurtosis cluster config")
+	}
+	kurtosisBackend, err := clusterConfig.GetKurtosisBackend(ctx)
+	if err != nil {
+		return nil, stacktrace.Propagate(err, "An error occurred getting a Kurtosis backend connected to the cluster")
+	}
+	return kurtosisBackend, nil
+}
@@ -2,11 +2,16 @@ package engine
 
 import (
 	"github.com/kurtosis-tech/kurtosis/cli/cli/command_str_consts"
+	"github.com/kurtosis-tech/kurtosis/cli/cli/commands/engine/audit"
+	"github.com/kurtosis-tech/kurtosis/cli/cli/commands/engine/export"
+	_import "github.com/kurtosis-tech/kurtosis/cli/cli/commands/engine/import"
 	"github.com/kurtosis-tech/kurtosis/cli/cli/commands/engine/logs"
+	"github.com/kurtosis-tech/kurtosis/cli/cli/commands/engine/registry"
 	"github.com/kurtosis-tech/kurtosis/cli/cli/commands/engine/restart"
 	"github.com/kurtosis-tech/kurtosis/cli/cli/commands/engine/start"
 	"github.com/kurtosis-tech/kurtosis/cli/cli/commands/engine/status"
 	"github.com/kurtosis-tech/kurtosis/cli/cli/commands/engine/stop"
+	"github.com/kurtosis-tech/kurtosis/cli/cli/commands/engine/token"
 	"github.com/spf13/cobra"
 )
 
@@ -24,4 +29,9 @@ func init() {
 	EngineCmd.AddCommand(stop.StopCmd)
 	EngineCmd.AddCommand(restart.RestartCmd.MustGetCobraCommand())
 	EngineCmd.AddCommand(logs.EngineLogsCmd.MustGetCobraCommand())
+	EngineCmd.AddCommand(registry.RegistryCmd)
+	EngineCmd.AddCommand(token.TokenCmd)
+	EngineCmd.AddCommand(audit.EngineAuditCmd.MustGetCobraCommand())
+	EngineCmd.AddCommand(export.EngineExportCmd.MustGetCobraCommand())
+	EngineCmd.AddCommand(_import.EngineImportCmd.MustGetCobraCommand())
 }
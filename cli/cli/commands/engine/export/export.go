@@ -0,0 +1,253 @@
+package export
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"os"
+	"path/filepath"
+	"sort"
+	"strings"
+	"time"
+
+	"github.com/kurtosis-tech/kurtosis/api/golang/engine/kurtosis_engine_rpc_api_bindings"
+	"github.com/kurtosis-tech/kurtosis/api/golang/engine/lib/kurtosis_context"
+	"github.com/kurtosis-tech/kurtosis/cli/cli/command_framework/highlevel/enclave_id_arg"
+	"github.com/kurtosis-tech/kurtosis/cli/cli/command_framework/highlevel/engine_consuming_kurtosis_command"
+	"github.com/kurtosis-tech/kurtosis/cli/cli/command_framework/highlevel/file_system_path_arg"
+	"github.com/kurtosis-tech/kurtosis/cli/cli/command_framework/lowlevel/args"
+	"github.com/kurtosis-tech/kurtosis/cli/cli/command_framework/lowlevel/flags"
+	"github.com/kurtosis-tech/kurtosis/cli/cli/command_str_consts"
+	"github.com/kurtosis-tech/kurtosis/cli/cli/helpers/enclave_labels"
+	"github.com/kurtosis-tech/kurtosis/cli/cli/helpers/engine_archive"
+	"github.com/kurtosis-tech/kurtosis/cli/cli/helpers/kurtosis_config_getter"
+	"github.com/kurtosis-tech/kurtosis/container-engine-lib/lib/backend_interface"
+	"github.com/kurtosis-tech/kurtosis/metrics-library/golang/lib/metrics_client"
+	"github.com/kurtosis-tech/stacktrace"
+	"github.com/sirupsen/logrus"
+	"google.golang.org/protobuf/types/known/emptypb"
+)
+
+const (
+	archiveFilepathArgKey     = "archive-filepath"
+	isArchiveFilepathOptional = false
+	noDefaultArchiveFilepath  = ""
+
+	enclaveIdentifiersArgKey = "enclaves"
+	// All the enclaves get exported if none are selected
+	isEnclaveIdArgOptional = true
+	isEnclaveIdArgGreedy   = true
+
+	// The logs sinks can hold credentials, so they're only exported if asked for
+	includeLogsSinksFlagKey     = "include-logs-sinks"
+	includeLogsSinksFlagDefault = "false"
+
+	enclaveArchivesDirPattern = "kurtosis-engine-export-*"
+	enclaveArchiveFileSuffix  = ".tgz"
+
+	// Only the user can read the archive, as it can hold the credentials of the logs sinks
+	archiveFilePerms = 0600
+
+	kurtosisBackendCtxKey = "kurtosis-backend"
+	engineClientCtxKey    = "engine-client"
+)
+
+var EngineExportCmd = &engine_consuming_kurtosis_command.EngineConsumingKurtosisCommand{
+	CommandStr:       command_str_consts.EngineExportCmdStr,
+	ShortDescription: "Exports the enclaves and config of the engine to an archive",
+	LongDescription: "Exports the given running enclaves, the ones having all the labels given with the '" + enclave_labels.LabelSelectorFlagKey +
+		"' flag, or all of them if none are selected, to an archive that 'kurtosis " + command_str_consts.EngineCmdStr + " " +
+		command_str_consts.EngineImportCmdStr + "' recreates them from on another Docker host or cluster. The archive holds the plans, " +
+		"files artifacts, persistent directories, mode and labels of the enclaves, along with the log retention period of the engine, and its logs sinks " +
+		"if the '" + includeLogsSinksFlagKey + "' flag is set. On Kubernetes, the enclaves having persistent directories can't be exported",
+	KurtosisBackendContextKey: kurtosisBackendCtxKey,
+	EngineClientContextKey:    engineClientCtxKey,
+	Flags: []*flags.FlagConfig{
+		enclave_labels.GetLabelSelectorFlagConfig(),
+		{
+			Key:     includeLogsSinksFlagKey,
+			Usage:   "Also export the logs sinks of the Kurtosis config, as they are, including any credentials they hold",
+			Type:    flags.FlagType_Bool,
+			Default: includeLogsSinksFlagDefault,
+		},
+	},
+	Args: []*args.ArgConfig{
+		file_system_path_arg.NewFilepathArg(
+			archiveFilepathArgKey,
+			isArchiveFilepathOptional,
+			noDefaultArchiveFilepath,
+			file_system_path_arg.BypassDefaultValidationFunc,
+		),
+		enclave_id_arg.NewEnclaveIdentifierArg(
+			enclaveIdentifiersArgKey,
+			engineClientCtxKey,
+			isEnclaveIdArgOptional,
+			isEnclaveIdArgGreedy,
+		),
+	},
+	RunFunc: run,
+}
+
+func run(
+	ctx context.Context,
+	_ backend_interface.KurtosisBackend,
+	engineClient kurtosis_engine_rpc_api_bindings.EngineServiceClient,
+	_ metrics_client.MetricsClient,
+	flags *flags.ParsedFlags,
+	args *args.ParsedArgs,
+) error {
+	archiveFilepath, err := args.GetNonGreedyArg(archiveFilepathArgKey)
+	if err != nil {
+		return stacktrace.Propagate(err, "Expected a value for non-greedy arg '%v' but none was found; this is a bug in the Kurtosis CLI!", archiveFilepathArgKey)
+	}
+	enclaveIdentifiers, err := args.GetGreedyArg(enclaveIdentifiersArgKey)
+	if err != nil {
+		return stacktrace.Propagate(err, "Expected a value for greedy enclave identifier arg '%v' but none was found; this is a bug in the Kurtosis CLI!", enclaveIdentifiersArgKey)
+	}
+	labelSelector, err := enclave_labels.GetLabelsFromFlag(flags, enclave_labels.LabelSelectorFlagKey)
+	if err != nil {
+		return stacktrace.Propagate(err, "An error occurred getting the label selector from the flags")
+	}
+	includeLogsSinks, err := flags.GetBool(includeLogsSinksFlagKey)
+	if err != nil {
+		return stacktrace.Propagate(err, "Expected a value for the '%v' flag but failed to get it", includeLogsSinksFlagKey)
+	}
+
+	kurtosisCtx, err := kurtosis_context.NewKurtosisContextFromLocalEngine()
+	if err != nil {
+		return stacktrace.Propagate(err, "An error occurred creating Kurtosis Context from local engine")
+	}
+	enclavesToExport, err := getEnclavesToExport(ctx, kurtosisCtx, enclaveIdentifiers, labelSelector)
+	if err != nil {
+		return stacktrace.Propagate(err, "An error occurred getting the enclaves to export")
+	}
+
+	engineConfig, engineVersion, err := getEngineConfig(ctx, engineClient, includeLogsSinks)
+	if err != nil {
+		return stacktrace.Propagate(err, "An error occurred getting the config of the engine")
+	}
+
+	// The archive needs the size of each enclave archive upfront, so they're exported to disk first
+	enclaveArchivesDirpath, err := os.MkdirTemp("", enclaveArchivesDirPattern)
+	if err != nil {
+		return stacktrace.Propagate(err, "An error occurred creating a temporary directory to export the enclaves to")
+	}
+	defer func() {
+		if err := os.RemoveAll(enclaveArchivesDirpath); err != nil {
+			logrus.Warnf("An error occurred removing temporary directory '%v'. Error was:\n%v", enclaveArchivesDirpath, err)
+		}
+	}()
+
+	manifest := &engine_archive.Manifest{
+		FormatVersion: engine_archive.FormatVersion,
+		CreatedAt:     time.Now().UTC().Format(time.RFC3339),
+		EngineVersion: engineVersion,
+		EngineConfig:  engineConfig,
+		Enclaves:      []*engine_archive.EnclaveEntry{},
+	}
+	enclaveArchiveFilepaths := map[string]string{}
+	enclaveExportErrorStrs := []string{}
+	for _, enclaveInfo := range enclavesToExport {
+		logrus.Infof("Exporting enclave '%v'...", enclaveInfo.GetName())
+		enclaveArchiveFilepath := filepath.Join(enclaveArchivesDirpath, enclaveInfo.GetEnclaveUuid()+enclaveArchiveFileSuffix)
+		if err := exportEnclave(ctx, kurtosisCtx, enclaveInfo.GetEnclaveUuid(), enclaveArchiveFilepath); err != nil {
+			enclaveExportErrorStrs = append(enclaveExportErrorStrs, fmt.Sprintf(">>>>>>>>>>>>>>>>> %v <<<<<<<<<<<<<<<<<\n%v", enclaveInfo.GetName(), err.Error()))
+			continue
+		}
+		manifest.Enclaves = append(manifest.Enclaves, engine_archive.NewEnclaveEntry(enclaveInfo.GetName(), enclaveInfo.GetEnclaveUuid()))
+		enclaveArchiveFilepaths[enclaveInfo.GetEnclaveUuid()] = enclaveArchiveFilepath
+	}
+
+	archiveFile, err := os.OpenFile(archiveFilepath, os.O_WRONLY|os.O_CREATE|os.O_TRUNC, archiveFilePerms)
+	if err != nil {
+		return stacktrace.Propagate(err, "An error occurred creating archive file '%v'", archiveFilepath)
+	}
+	defer archiveFile.Close()
+	if err := engine_archive.Write(archiveFile, manifest, enclaveArchiveFilepaths); err != nil {
+		return stacktrace.Propagate(err, "An error occurred writing the engine archive to '%v'", archiveFilepath)
+	}
+
+	if len(enclaveExportErrorStrs) > 0 {
+		errorStr := fmt.Sprintf(
+			"The engine got exported to '%v' without the following enclaves, as errors occurred exporting them:\n%v",
+			archiveFilepath,
+			strings.Join(enclaveExportErrorStrs, "\n\n"),
+		)
+		return errors.New(errorStr)
+	}
+	logrus.Infof("Exported %v enclaves and the engine config to '%v'", len(manifest.Enclaves), archiveFilepath)
+	return nil
+}
+
+// Returns the enclaves sorted by name
+func getEnclavesToExport(
+	ctx context.Context,
+	kurtosisCtx *kurtosis_context.KurtosisContext,
+	enclaveIdentifiers []string,
+	labelSelector map[string]string,
+) ([]*kurtosis_engine_rpc_api_bindings.EnclaveInfo, error) {
+	enclavesByUuid := map[string]*kurtosis_engine_rpc_api_bindings.EnclaveInfo{}
+	if len(enclaveIdentifiers) == 0 || len(labelSelector) > 0 {
+		// An empty label selector matches all the enclaves
+		matchingEnclaves, err := kurtosisCtx.GetEnclavesMatchingLabels(ctx, labelSelector)
+		if err != nil {
+			return nil, stacktrace.Propagate(err, "An error occurred getting the enclaves matching the label selector")
+		}
+		for enclaveUuid, enclaveInfo := range matchingEnclaves.GetEnclavesByUuid() {
+			enclavesByUuid[enclaveUuid] = enclaveInfo
+		}
+	}
+	for _, enclaveIdentifier := range enclaveIdentifiers {
+		enclaveInfo, err := kurtosisCtx.GetEnclave(ctx, enclaveIdentifier)
+		if err != nil {
+			return nil, stacktrace.Propagate(err, "An error occurred getting enclave '%v'", enclaveIdentifier)
+		}
+		enclavesByUuid[enclaveInfo.GetEnclaveUuid()] = enclaveInfo
+	}
+
+	enclaves := []*kurtosis_engine_rpc_api_bindings.EnclaveInfo{}
+	for _, enclaveInfo := range enclavesByUuid {
+		enclaves = append(enclaves, enclaveInfo)
+	}
+	sort.Slice(enclaves, func(i, j int) bool {
+		return enclaves[i].GetName() < enclaves[j].GetName()
+	})
+	return enclaves, nil
+}
+
+func getEngineConfig(ctx context.Context, engineClient kurtosis_engine_rpc_api_bindings.EngineServiceClient, includeLogsSinks bool) (*engine_archive.EngineConfig, string, error) {
+	engineInfo, err := engineClient.GetEngineInfo(ctx, &emptypb.Empty{})
+	if err != nil {
+		return nil, "", stacktrace.Propagate(err, "An error occurred getting the engine info")
+	}
+	engineConfig := &engine_archive.EngineConfig{
+		LogRetentionPeriod: engineInfo.GetLogRetentionPeriod(),
+		LogsSinks:          nil,
+	}
+	if !includeLogsSinks {
+		return engineConfig, engineInfo.GetEngineVersion(), nil
+	}
+
+	// The logs sinks aren't known to the engine server, they're passed to the logs aggregator from the Kurtosis config
+	clusterConfig, err := kurtosis_config_getter.GetKurtosisClusterConfig()
+	if err != nil {
+		return nil, "", stacktrace.Propagate(err, "An error occurred getting the Kurtosis cluster config")
+	}
+	engineConfig.LogsSinks = clusterConfig.GetLogsAggregatorConfig().Sinks
+	if len(engineConfig.LogsSinks) > 0 {
+		logrus.Warnf("The archive holds the logs sinks as they are in the Kurtosis config, so it holds any credentials they have too; keep it as safe as them")
+	}
+	return engineConfig, engineInfo.GetEngineVersion(), nil
+}
+
+func exportEnclave(ctx context.Context, kurtosisCtx *kurtosis_context.KurtosisContext, enclaveUuid string, enclaveArchiveFilepath string) error {
+	enclaveArchiveFile, err := os.Create(enclaveArchiveFilepath)
+	if err != nil {
+		return stacktrace.Propagate(err, "An error occurred creating file '%v'", enclaveArchiveFilepath)
+	}
+	defer enclaveArchiveFile.Close()
+	if err := kurtosisCtx.ExportEnclave(ctx, enclaveUuid, enclaveArchiveFile); err != nil {
+		return stacktrace.Propagate(err, "An error occurred exporting enclave '%v'", enclaveUuid)
+	}
+	return nil
+}
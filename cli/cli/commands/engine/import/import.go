@@ -0,0 +1,272 @@
+package _import
+
+import (
+	"context"
+	"errors"
+	"io"
+	"os"
+	"reflect"
+	"time"
+
+	"github.com/kurtosis-tech/kurtosis/api/golang/engine/kurtosis_engine_rpc_api_bindings"
+	"github.com/kurtosis-tech/kurtosis/api/golang/engine/lib/kurtosis_context"
+	"github.com/kurtosis-tech/kurtosis/cli/cli/command_framework/highlevel/engine_consuming_kurtosis_command"
+	"github.com/kurtosis-tech/kurtosis/cli/cli/command_framework/highlevel/file_system_path_arg"
+	"github.com/kurtosis-tech/kurtosis/cli/cli/command_framework/lowlevel/args"
+	"github.com/kurtosis-tech/kurtosis/cli/cli/command_framework/lowlevel/flags"
+	"github.com/kurtosis-tech/kurtosis/cli/cli/command_str_consts"
+	"github.com/kurtosis-tech/kurtosis/cli/cli/defaults"
+	"github.com/kurtosis-tech/kurtosis/cli/cli/helpers/engine_archive"
+	"github.com/kurtosis-tech/kurtosis/cli/cli/helpers/engine_manager"
+	"github.com/kurtosis-tech/kurtosis/cli/cli/helpers/kurtosis_config_getter"
+	"github.com/kurtosis-tech/kurtosis/container-engine-lib/lib/backend_interface"
+	"github.com/kurtosis-tech/kurtosis/container-engine-lib/lib/backend_interface/objects/logs_aggregator"
+	"github.com/kurtosis-tech/kurtosis/metrics-library/golang/lib/metrics_client"
+	"github.com/kurtosis-tech/stacktrace"
+	"github.com/sirupsen/logrus"
+	"google.golang.org/protobuf/types/known/emptypb"
+)
+
+const (
+	archiveFilepathArgKey     = "archive-filepath"
+	isArchiveFilepathOptional = false
+	noDefaultArchiveFilepath  = ""
+
+	// The names the enclaves had when they got exported; all of them get imported if none are given
+	enclaveNamesArgKey        = "enclaves"
+	isEnclaveNamesArgOptional = true
+	isEnclaveNamesArgGreedy   = true
+
+	skipEngineConfigFlagKey     = "skip-engine-config"
+	skipEngineConfigFlagDefault = "false"
+
+	// The enclaves keep the name they were exported with
+	keepExportedEnclaveName = ""
+
+	defaultEngineVersion       = ""
+	restartEngineOnSameVersion = true
+	dontRestartAPIContainers   = false
+
+	kurtosisBackendCtxKey = "kurtosis-backend"
+	engineClientCtxKey    = "engine-client"
+)
+
+var EngineImportCmd = &engine_consuming_kurtosis_command.EngineConsumingKurtosisCommand{
+	CommandStr:       command_str_consts.EngineImportCmdStr,
+	ShortDescription: "Imports the enclaves and config of an engine from an archive",
+	LongDescription: "Recreates the enclaves of an archive written by 'kurtosis " + command_str_consts.EngineCmdStr + " " + command_str_consts.EngineExportCmdStr +
+		"', or only the given ones, with the names they were exported with. Unless the '" + skipEngineConfigFlagKey + "' flag is set, the engine " +
+		"first gets restarted with the log retention period and logs sinks of the archive if they differ from the running engine's. On Kubernetes, " +
+		"the enclaves having persistent directories can't be imported",
+	KurtosisBackendContextKey: kurtosisBackendCtxKey,
+	EngineClientContextKey:    engineClientCtxKey,
+	Flags: []*flags.FlagConfig{
+		{
+			Key:     skipEngineConfigFlagKey,
+			Usage:   "Only import the enclaves, leaving the config of the running engine as is",
+			Type:    flags.FlagType_Bool,
+			Default: skipEngineConfigFlagDefault,
+		},
+	},
+	Args: []*args.ArgConfig{
+		file_system_path_arg.NewFilepathArg(
+			archiveFilepathArgKey,
+			isArchiveFilepathOptional,
+			noDefaultArchiveFilepath,
+			file_system_path_arg.DefaultValidationFunc,
+		),
+		{
+			Key:                   enclaveNamesArgKey,
+			IsOptional:            isEnclaveNamesArgOptional,
+			DefaultValue:          []string{},
+			IsGreedy:              isEnclaveNamesArgGreedy,
+			ArgCompletionProvider: nil,
+			ValidationFunc:        nil,
+		},
+	},
+	RunFunc: run,
+}
+
+func run(
+	ctx context.Context,
+	_ backend_interface.KurtosisBackend,
+	engineClient kurtosis_engine_rpc_api_bindings.EngineServiceClient,
+	_ metrics_client.MetricsClient,
+	flags *flags.ParsedFlags,
+	args *args.ParsedArgs,
+) error {
+	archiveFilepath, err := args.GetNonGreedyArg(archiveFilepathArgKey)
+	if err != nil {
+		return stacktrace.Propagate(err, "Expected a value for non-greedy arg '%v' but none was found; this is a bug in the Kurtosis CLI!", archiveFilepathArgKey)
+	}
+	enclaveNames, err := args.GetGreedyArg(enclaveNamesArgKey)
+	if err != nil {
+		return stacktrace.Propagate(err, "Expected a value for greedy arg '%v' but none was found; this is a bug in the Kurtosis CLI!", enclaveNamesArgKey)
+	}
+	skipEngineConfig, err := flags.GetBool(skipEngineConfigFlagKey)
+	if err != nil {
+		return stacktrace.Propagate(err, "Expected a value for the '%v' flag but failed to get it", skipEngineConfigFlagKey)
+	}
+
+	archiveFile, err := os.Open(archiveFilepath)
+	if err != nil {
+		return stacktrace.Propagate(err, "An error occurred opening archive file '%v'", archiveFilepath)
+	}
+	defer archiveFile.Close()
+	archiveReader, manifest, err := engine_archive.NewReader(archiveFile)
+	if err != nil {
+		return stacktrace.Propagate(err, "An error occurred reading engine archive '%v'", archiveFilepath)
+	}
+
+	enclaveNamesToImport, err := getEnclaveNamesToImport(manifest, enclaveNames)
+	if err != nil {
+		return stacktrace.Propagate(err, "An error occurred selecting the enclaves to import")
+	}
+
+	if !skipEngineConfig && manifest.EngineConfig != nil {
+		if err := applyEngineConfig(ctx, engineClient, manifest.EngineConfig); err != nil {
+			return stacktrace.Propagate(err, "An error occurred applying the engine config of the archive")
+		}
+	}
+
+	kurtosisCtx, err := kurtosis_context.NewKurtosisContextFromLocalEngine()
+	if err != nil {
+		return stacktrace.Propagate(err, "An error occurred creating Kurtosis Context from local engine")
+	}
+	numImportedEnclaves := 0
+	for {
+		enclaveEntry, enclaveArchive, err := archiveReader.Next()
+		if errors.Is(err, io.EOF) {
+			break
+		}
+		if err != nil {
+			return stacktrace.Propagate(err, "An error occurred reading the next enclave of engine archive '%v'", archiveFilepath)
+		}
+		if !enclaveNamesToImport[enclaveEntry.Name] {
+			continue
+		}
+
+		logrus.Infof("Importing enclave '%v'...", enclaveEntry.Name)
+		enclaveCtx, unimportableItems, err := kurtosisCtx.ImportEnclave(ctx, enclaveArchive, keepExportedEnclaveName)
+		if err != nil {
+			return stacktrace.Propagate(err, "An error occurred importing enclave '%v'; the enclaves before it in the archive were imported", enclaveEntry.Name)
+		}
+		for _, unimportableItem := range unimportableItems {
+			logrus.Warnf("Not imported in enclave '%v': %v", enclaveCtx.GetEnclaveName(), unimportableItem)
+		}
+		numImportedEnclaves++
+	}
+	logrus.Infof("Imported %v enclaves from '%v'", numImportedEnclaves, archiveFilepath)
+	return nil
+}
+
+func getEnclaveNamesToImport(manifest *engine_archive.Manifest, enclaveNames []string) (map[string]bool, error) {
+	archivedEnclaveNames := map[string]bool{}
+	for _, enclaveEntry := range manifest.Enclaves {
+		archivedEnclaveNames[enclaveEntry.Name] = true
+	}
+	if len(enclaveNames) == 0 {
+		return archivedEnclaveNames, nil
+	}
+	enclaveNamesToImport := map[string]bool{}
+	for _, enclaveName := range enclaveNames {
+		if !archivedEnclaveNames[enclaveName] {
+			return nil, stacktrace.NewError("Enclave '%v' isn't in the archive", enclaveName)
+		}
+		enclaveNamesToImport[enclaveName] = true
+	}
+	return enclaveNamesToImport, nil
+}
+
+// Restarts the engine with the archived config, unless the running engine already has it. The other settings of the
+// running engine are kept
+func applyEngineConfig(ctx context.Context, engineClient kurtosis_engine_rpc_api_bindings.EngineServiceClient, engineConfig *engine_archive.EngineConfig) error {
+	engineInfo, err := engineClient.GetEngineInfo(ctx, &emptypb.Empty{})
+	if err != nil {
+		return stacktrace.Propagate(err, "An error occurred getting the engine info")
+	}
+	clusterConfig, err := kurtosis_config_getter.GetKurtosisClusterConfig()
+	if err != nil {
+		return stacktrace.Propagate(err, "An error occurred getting the Kurtosis cluster config")
+	}
+	isApplied, err := isEngineConfigApplied(engineInfo.GetLogRetentionPeriod(), clusterConfig.GetLogsAggregatorConfig().Sinks, engineConfig)
+	if err != nil {
+		return stacktrace.Propagate(err, "An error occurred comparing the archived engine config with the running engine's")
+	}
+	if isApplied {
+		return nil
+	}
+
+	logRetentionPeriod := engineConfig.LogRetentionPeriod
+	if logRetentionPeriod == "" {
+		logRetentionPeriod = defaults.DefaultLogRetentionPeriod
+	}
+	logLevel, err := getRunningEngineLogLevel(engineInfo)
+	if err != nil {
+		return stacktrace.Propagate(err, "An error occurred getting the log level of the running engine")
+	}
+	// Engines predating the export don't report their settings, so those get restarted with the default ones
+	poolSize := defaults.DefaultEngineEnclavePoolSize
+	isDebugModeEnabled := defaults.DefaultEnableDebugMode
+	domain := defaults.DefaultDomain
+	if engineInfo.GetLogLevel() != "" {
+		poolSize = uint8(engineInfo.GetPoolSize())
+		isDebugModeEnabled = engineInfo.GetIsDebugModeEnabled()
+		domain = engineInfo.GetDomain()
+	}
+
+	logrus.Infof("Restarting the engine with a log retention period of '%v' and the archived logs sinks...", logRetentionPeriod)
+	engineManager, err := engine_manager.NewEngineManager(ctx)
+	if err != nil {
+		return stacktrace.Propagate(err, "An error occurred creating an engine manager")
+	}
+	// The GitHub auth token isn't reported by the engine, so the restarted engine gets the one the CLI is logged in with
+	_, engineClientCloseFunc, err := engineManager.RestartEngineIdempotently(ctx, logLevel, defaultEngineVersion, restartEngineOnSameVersion, poolSize, isDebugModeEnabled, defaults.DefaultGitHubAuthTokenOverride, dontRestartAPIContainers, domain, logRetentionPeriod, engineConfig.LogsSinks)
+	if err != nil {
+		return stacktrace.Propagate(err, "An error occurred restarting the engine")
+	}
+	if err := engineClientCloseFunc(); err != nil {
+		logrus.Warnf("Error closing the engine client:\n'%v'", err)
+	}
+	return nil
+}
+
+func getRunningEngineLogLevel(engineInfo *kurtosis_engine_rpc_api_bindings.GetEngineInfoResponse) (logrus.Level, error) {
+	if engineInfo.GetLogLevel() == "" {
+		return defaults.DefaultEngineLogLevel, nil
+	}
+	logLevel, err := logrus.ParseLevel(engineInfo.GetLogLevel())
+	if err != nil {
+		return 0, stacktrace.Propagate(err, "An error occurred parsing log level '%v' of the running engine", engineInfo.GetLogLevel())
+	}
+	return logLevel, nil
+}
+
+// The running engine has the archived config if its log retention period is the same, and the Kurtosis config already
+// has all the archived logs sinks
+func isEngineConfigApplied(runningLogRetentionPeriod string, configuredLogsSinks logs_aggregator.Sinks, engineConfig *engine_archive.EngineConfig) (bool, error) {
+	if engineConfig.LogRetentionPeriod != "" {
+		archivedLogRetentionPeriod, err := time.ParseDuration(engineConfig.LogRetentionPeriod)
+		if err != nil {
+			return false, stacktrace.Propagate(err, "An error occurred parsing archived log retention period '%v'", engineConfig.LogRetentionPeriod)
+		}
+		// Engines predating the export don't report their log retention period
+		if runningLogRetentionPeriod == "" {
+			return false, nil
+		}
+		parsedRunningLogRetentionPeriod, err := time.ParseDuration(runningLogRetentionPeriod)
+		if err != nil {
+			return false, stacktrace.Propagate(err, "An error occurred parsing the log retention period '%v' of the running engine", runningLogRetentionPeriod)
+		}
+		if archivedLogRetentionPeriod != parsedRunningLogRetentionPeriod {
+			return false, nil
+		}
+	}
+	for sinkId, sink := range engineConfig.LogsSinks {
+		configuredSink, found := configuredLogsSinks[sinkId]
+		if !found || !reflect.DeepEqual(sink, configuredSink) {
+			return false, nil
+		}
+	}
+	return true, nil
+}
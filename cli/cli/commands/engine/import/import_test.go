@@ -0,0 +1,73 @@
+package _import
+
+import (
+	"testing"
+
+	"github.com/kurtosis-tech/kurtosis/api/golang/engine/kurtosis_engine_rpc_api_bindings"
+	"github.com/kurtosis-tech/kurtosis/cli/cli/defaults"
+	"github.com/kurtosis-tech/kurtosis/cli/cli/helpers/engine_archive"
+	"github.com/kurtosis-tech/kurtosis/container-engine-lib/lib/backend_interface/objects/logs_aggregator"
+	"github.com/sirupsen/logrus"
+	"github.com/stretchr/testify/require"
+)
+
+var testLogsSinks = logs_aggregator.Sinks{
+	"elasticsearch": {"type": "elasticsearch", "endpoints": []interface{}{"http://elasticsearch:9200"}},
+}
+
+func TestIsEngineConfigApplied(t *testing.T) {
+	engineConfig := &engine_archive.EngineConfig{
+		LogRetentionPeriod: "168h",
+		LogsSinks:          testLogsSinks,
+	}
+
+	isApplied, err := isEngineConfigApplied("168h0m0s", testLogsSinks, engineConfig)
+	require.NoError(t, err)
+	require.True(t, isApplied)
+
+	isApplied, err = isEngineConfigApplied("24h", testLogsSinks, engineConfig)
+	require.NoError(t, err)
+	require.False(t, isApplied)
+
+	isApplied, err = isEngineConfigApplied("168h", logs_aggregator.Sinks{}, engineConfig)
+	require.NoError(t, err)
+	require.False(t, isApplied)
+}
+
+func TestGetEnclaveNamesToImport(t *testing.T) {
+	manifest := &engine_archive.Manifest{
+		FormatVersion: engine_archive.FormatVersion,
+		CreatedAt:     "",
+		EngineVersion: "",
+		EngineConfig:  nil,
+		Enclaves: []*engine_archive.EnclaveEntry{
+			engine_archive.NewEnclaveEntry("testnet", "0123456789abcdef0123456789abcdef"),
+			engine_archive.NewEnclaveEntry("devnet", "fedcba9876543210fedcba9876543210"),
+		},
+	}
+
+	enclaveNames, err := getEnclaveNamesToImport(manifest, []string{})
+	require.NoError(t, err)
+	require.Equal(t, map[string]bool{"testnet": true, "devnet": true}, enclaveNames)
+
+	enclaveNames, err = getEnclaveNamesToImport(manifest, []string{"devnet"})
+	require.NoError(t, err)
+	require.Equal(t, map[string]bool{"devnet": true}, enclaveNames)
+
+	_, err = getEnclaveNamesToImport(manifest, []string{"mainnet"})
+	require.Error(t, err)
+}
+
+func TestGetRunningEngineLogLevel(t *testing.T) {
+	logLevel, err := getRunningEngineLogLevel(&kurtosis_engine_rpc_api_bindings.GetEngineInfoResponse{LogLevel: "info"}) // nolint: exhaustruct
+	require.NoError(t, err)
+	require.Equal(t, logrus.InfoLevel, logLevel)
+
+	// Engines predating the export don't report their log level
+	logLevel, err = getRunningEngineLogLevel(&kurtosis_engine_rpc_api_bindings.GetEngineInfoResponse{}) // nolint: exhaustruct
+	require.NoError(t, err)
+	require.Equal(t, defaults.DefaultEngineLogLevel, logLevel)
+
+	_, err = getRunningEngineLogLevel(&kurtosis_engine_rpc_api_bindings.GetEngineInfoResponse{LogLevel: "loud"}) // nolint: exhaustruct
+	require.Error(t, err)
+}
@@ -0,0 +1,57 @@
+package ls
+
+import (
+	"context"
+	"strings"
+
+	"github.com/kurtosis-tech/kurtosis/cli/cli/command_framework/lowlevel"
+	"github.com/kurtosis-tech/kurtosis/cli/cli/command_framework/lowlevel/args"
+	"github.com/kurtosis-tech/kurtosis/cli/cli/command_framework/lowlevel/flags"
+	"github.com/kurtosis-tech/kurtosis/cli/cli/command_str_consts"
+	"github.com/kurtosis-tech/kurtosis/cli/cli/commands/engine/common"
+	"github.com/kurtosis-tech/kurtosis/cli/cli/helpers/output_printers"
+	"github.com/kurtosis-tech/stacktrace"
+)
+
+const (
+	repositoryColumnHeader = "Repository"
+	tagsColumnHeader       = "Tags"
+
+	tagsSeparator = ", "
+)
+
+var RegistryLsCmd = &lowlevel.LowlevelKurtosisCommand{
+	CommandStr:               command_str_consts.RegistryLsCmdStr,
+	ShortDescription:         "Lists the images of the engine image registry",
+	LongDescription:          "Lists the repositories of the engine image registry, along with the tags pushed to each of them",
+	RunFunc:                  run,
+	Flags:                    nil,
+	Args:                     nil,
+	PreValidationAndRunFunc:  nil,
+	PostValidationAndRunFunc: nil,
+}
+
+func run(
+	ctx context.Context,
+	_ *flags.ParsedFlags,
+	_ *args.ParsedArgs,
+) error {
+	kurtosisBackend, err := common.GetKurtosisBackend(ctx)
+	if err != nil {
+		return stacktrace.Propagate(err, "An error occurred getting the Kurtosis backend")
+	}
+
+	repositories, err := kurtosisBackend.ListImageRegistryRepositories(ctx)
+	if err != nil {
+		return stacktrace.Propagate(err, "An error occurred listing the images of the engine image registry")
+	}
+
+	tablePrinter := output_printers.NewTablePrinter(repositoryColumnHeader, tagsColumnHeader)
+	for _, repository := range repositories {
+		if err := tablePrinter.AddRow(repository.GetName(), strings.Join(repository.GetTags(), tagsSeparator)); err != nil {
+			return stacktrace.Propagate(err, "An error occurred adding repository '%v' to the table to be displayed", repository.GetName())
+		}
+	}
+	tablePrinter.Print()
+	return nil
+}
@@ -0,0 +1,59 @@
+package prune
+
+import (
+	"context"
+
+	"github.com/kurtosis-tech/kurtosis/cli/cli/command_framework/lowlevel"
+	"github.com/kurtosis-tech/kurtosis/cli/cli/command_framework/lowlevel/args"
+	"github.com/kurtosis-tech/kurtosis/cli/cli/command_framework/lowlevel/flags"
+	"github.com/kurtosis-tech/kurtosis/cli/cli/command_str_consts"
+	"github.com/kurtosis-tech/kurtosis/cli/cli/commands/engine/common"
+	"github.com/kurtosis-tech/kurtosis/cli/cli/helpers/output_printers"
+	"github.com/kurtosis-tech/kurtosis/cli/cli/out"
+	"github.com/kurtosis-tech/stacktrace"
+)
+
+const (
+	prunedRepositoryColumnHeader = "Pruned Repository"
+)
+
+var RegistryPruneCmd = &lowlevel.LowlevelKurtosisCommand{
+	CommandStr:       command_str_consts.RegistryPruneCmdStr,
+	ShortDescription: "Deletes the unused images of the engine image registry",
+	LongDescription: "Deletes the repositories of the engine image registry that no service of any enclave, running or " +
+		"stopped, runs an image of, and frees the storage only they used",
+	RunFunc:                  run,
+	Flags:                    nil,
+	Args:                     nil,
+	PreValidationAndRunFunc:  nil,
+	PostValidationAndRunFunc: nil,
+}
+
+func run(
+	ctx context.Context,
+	_ *flags.ParsedFlags,
+	_ *args.ParsedArgs,
+) error {
+	kurtosisBackend, err := common.GetKurtosisBackend(ctx)
+	if err != nil {
+		return stacktrace.Propagate(err, "An error occurred getting the Kurtosis backend")
+	}
+
+	prunedRepositories, err := kurtosisBackend.PruneImageRegistry(ctx)
+	if err != nil {
+		return stacktrace.Propagate(err, "An error occurred pruning the engine image registry")
+	}
+
+	if len(prunedRepositories) == 0 {
+		out.PrintOutLn("No unused images to prune")
+		return nil
+	}
+	tablePrinter := output_printers.NewTablePrinter(prunedRepositoryColumnHeader)
+	for _, repository := range prunedRepositories {
+		if err := tablePrinter.AddRow(repository); err != nil {
+			return stacktrace.Propagate(err, "An error occurred adding repository '%v' to the table to be displayed", repository)
+		}
+	}
+	tablePrinter.Print()
+	return nil
+}
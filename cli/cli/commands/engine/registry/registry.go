@@ -0,0 +1,25 @@
+package registry
+
+import (
+	"github.com/kurtosis-tech/kurtosis/cli/cli/command_str_consts"
+	"github.com/kurtosis-tech/kurtosis/cli/cli/commands/engine/registry/ls"
+	"github.com/kurtosis-tech/kurtosis/cli/cli/commands/engine/registry/prune"
+	"github.com/kurtosis-tech/kurtosis/cli/cli/commands/engine/registry/start"
+	"github.com/kurtosis-tech/kurtosis/cli/cli/commands/engine/registry/stop"
+	"github.com/spf13/cobra"
+)
+
+// RegistryCmd Suppressing exhaustruct requirement because this struct has ~40 properties
+// nolint: exhaustruct
+var RegistryCmd = &cobra.Command{
+	Use:   command_str_consts.EngineRegistryCmdStr,
+	Short: "Manage the image registry the images built for services get pushed to",
+	RunE:  nil,
+}
+
+func init() {
+	RegistryCmd.AddCommand(start.RegistryStartCmd.MustGetCobraCommand())
+	RegistryCmd.AddCommand(stop.RegistryStopCmd.MustGetCobraCommand())
+	RegistryCmd.AddCommand(ls.RegistryLsCmd.MustGetCobraCommand())
+	RegistryCmd.AddCommand(prune.RegistryPruneCmd.MustGetCobraCommand())
+}
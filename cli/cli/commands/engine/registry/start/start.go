@@ -0,0 +1,43 @@
+package start
+
+import (
+	"context"
+
+	"github.com/kurtosis-tech/kurtosis/cli/cli/command_framework/lowlevel"
+	"github.com/kurtosis-tech/kurtosis/cli/cli/command_framework/lowlevel/args"
+	"github.com/kurtosis-tech/kurtosis/cli/cli/command_framework/lowlevel/flags"
+	"github.com/kurtosis-tech/kurtosis/cli/cli/command_str_consts"
+	"github.com/kurtosis-tech/kurtosis/cli/cli/commands/engine/common"
+	"github.com/kurtosis-tech/stacktrace"
+	"github.com/sirupsen/logrus"
+)
+
+var RegistryStartCmd = &lowlevel.LowlevelKurtosisCommand{
+	CommandStr:       command_str_consts.RegistryStartCmdStr,
+	ShortDescription: "Starts the engine image registry",
+	LongDescription: "Starts the image registry shared by all the enclaves, if it isn't running already. Once it runs, " +
+		"the images built for services are pushed to it and services run them by digest, so they're built once for every enclave.",
+	RunFunc:                  run,
+	Flags:                    nil,
+	Args:                     nil,
+	PreValidationAndRunFunc:  nil,
+	PostValidationAndRunFunc: nil,
+}
+
+func run(
+	ctx context.Context,
+	_ *flags.ParsedFlags,
+	_ *args.ParsedArgs,
+) error {
+	kurtosisBackend, err := common.GetKurtosisBackend(ctx)
+	if err != nil {
+		return stacktrace.Propagate(err, "An error occurred getting the Kurtosis backend")
+	}
+
+	imageRegistry, err := kurtosisBackend.CreateImageRegistry(ctx)
+	if err != nil {
+		return stacktrace.Propagate(err, "An error occurred starting the engine image registry")
+	}
+	logrus.Infof("Engine image registry is running at '%v'", imageRegistry.GetHost())
+	return nil
+}
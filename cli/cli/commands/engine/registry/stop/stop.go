@@ -0,0 +1,42 @@
+package stop
+
+import (
+	"context"
+
+	"github.com/kurtosis-tech/kurtosis/cli/cli/command_framework/lowlevel"
+	"github.com/kurtosis-tech/kurtosis/cli/cli/command_framework/lowlevel/args"
+	"github.com/kurtosis-tech/kurtosis/cli/cli/command_framework/lowlevel/flags"
+	"github.com/kurtosis-tech/kurtosis/cli/cli/command_str_consts"
+	"github.com/kurtosis-tech/kurtosis/cli/cli/commands/engine/common"
+	"github.com/kurtosis-tech/stacktrace"
+	"github.com/sirupsen/logrus"
+)
+
+var RegistryStopCmd = &lowlevel.LowlevelKurtosisCommand{
+	CommandStr:       command_str_consts.RegistryStopCmdStr,
+	ShortDescription: "Stops the engine image registry",
+	LongDescription: "Stops the image registry shared by all the enclaves. The images built afterwards are only kept " +
+		"where they're built; on Docker, the images already pushed are served again once the registry is restarted.",
+	RunFunc:                  run,
+	Flags:                    nil,
+	Args:                     nil,
+	PreValidationAndRunFunc:  nil,
+	PostValidationAndRunFunc: nil,
+}
+
+func run(
+	ctx context.Context,
+	_ *flags.ParsedFlags,
+	_ *args.ParsedArgs,
+) error {
+	kurtosisBackend, err := common.GetKurtosisBackend(ctx)
+	if err != nil {
+		return stacktrace.Propagate(err, "An error occurred getting the Kurtosis backend")
+	}
+
+	if err := kurtosisBackend.DestroyImageRegistry(ctx); err != nil {
+		return stacktrace.Propagate(err, "An error occurred stopping the engine image registry")
+	}
+	logrus.Info("Engine image registry stopped")
+	return nil
+}
@@ -2,8 +2,11 @@ package status
 
 import (
 	"context"
+	"github.com/kurtosis-tech/kurtosis/cli/cli/command_framework/highlevel/output_format_flag"
 	"github.com/kurtosis-tech/kurtosis/cli/cli/command_str_consts"
 	"github.com/kurtosis-tech/kurtosis/cli/cli/helpers/engine_manager"
+	"github.com/kurtosis-tech/kurtosis/cli/cli/helpers/output_printers"
+	"github.com/kurtosis-tech/kurtosis/cli/cli/helpers/output_schemas"
 	"github.com/kurtosis-tech/stacktrace"
 	"github.com/spf13/cobra"
 )
@@ -16,9 +19,18 @@ var StatusCmd = &cobra.Command{
 	RunE:  run,
 }
 
+func init() {
+	output_format_flag.AddOutputFormatFlagToCobraCommand(StatusCmd)
+}
+
 func run(cmd *cobra.Command, args []string) error {
 	ctx := context.Background()
 
+	outputFormat, err := output_format_flag.GetOutputFormatFromCobraCommand(cmd)
+	if err != nil {
+		return stacktrace.Propagate(err, "An error occurred getting the output format")
+	}
+
 	engineManager, err := engine_manager.NewEngineManager(ctx)
 	if err != nil {
 		return stacktrace.Propagate(err, "An error occurred creating an engine manager")
@@ -28,6 +40,13 @@ func run(cmd *cobra.Command, args []string) error {
 	if err != nil {
 		return stacktrace.Propagate(err, "An error occurred getting the Kurtosis engine status")
 	}
+	if outputFormat.IsStructured() {
+		if err := output_printers.PrintStructured(outputFormat, output_schemas.NewEngineStatus(string(status), maybeApiVersion)); err != nil {
+			return stacktrace.Propagate(err, "An error occurred printing the engine status")
+		}
+		return nil
+	}
+
 	prettyPrintingStatusVisitor := newPrettyPrintingEngineStatusVisitor(maybeApiVersion)
 	if err := status.Accept(prettyPrintingStatusVisitor); err != nil {
 		return stacktrace.Propagate(err, "An error occurred printing the engine status")
@@ -0,0 +1,122 @@
+package create
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/kurtosis-tech/kurtosis/api/golang/engine/kurtosis_engine_rpc_api_bindings"
+	"github.com/kurtosis-tech/kurtosis/api/golang/engine/lib/kurtosis_context"
+	"github.com/kurtosis-tech/kurtosis/cli/cli/command_framework/highlevel/engine_consuming_kurtosis_command"
+	"github.com/kurtosis-tech/kurtosis/cli/cli/command_framework/lowlevel/args"
+	"github.com/kurtosis-tech/kurtosis/cli/cli/command_framework/lowlevel/flags"
+	"github.com/kurtosis-tech/kurtosis/cli/cli/command_str_consts"
+	"github.com/kurtosis-tech/kurtosis/cli/cli/helpers/output_printers"
+	"github.com/kurtosis-tech/kurtosis/cli/cli/out"
+	"github.com/kurtosis-tech/kurtosis/container-engine-lib/lib/backend_interface"
+	"github.com/kurtosis-tech/kurtosis/metrics-library/golang/lib/metrics_client"
+	"github.com/kurtosis-tech/stacktrace"
+)
+
+const (
+	tokenNameArgKey = "name"
+
+	roleFlagKey     = "role"
+	defaultRole     = readOnlyRoleStr
+	readOnlyRoleStr = "read-only"
+	operatorRoleStr = "operator"
+	adminRoleStr    = "admin"
+
+	enclaveFlagKey            = "enclave"
+	noEnclavesPassedByDefault = ""
+
+	kurtosisBackendCtxKey = "kurtosis-backend"
+	engineClientCtxKey    = "engine-client"
+)
+
+var rolesByName = map[string]kurtosis_engine_rpc_api_bindings.ApiTokenRole{
+	readOnlyRoleStr: kurtosis_engine_rpc_api_bindings.ApiTokenRole_ApiTokenRole_READ_ONLY,
+	operatorRoleStr: kurtosis_engine_rpc_api_bindings.ApiTokenRole_ApiTokenRole_OPERATOR,
+	adminRoleStr:    kurtosis_engine_rpc_api_bindings.ApiTokenRole_ApiTokenRole_ADMIN,
+}
+
+var TokenCreateCmd = &engine_consuming_kurtosis_command.EngineConsumingKurtosisCommand{
+	CommandStr:       command_str_consts.TokenCreateCmdStr,
+	ShortDescription: "Creates an engine API token",
+	LongDescription: fmt.Sprintf(
+		"Creates a token to authenticate to the engine API with, either by setting it in the '%v' environment variable "+
+			"or by sending it in an 'Authorization: Bearer' header. The token is only printed once, so store it somewhere safe",
+		kurtosis_context.EngineApiTokenEnvVar,
+	),
+	KurtosisBackendContextKey: kurtosisBackendCtxKey,
+	EngineClientContextKey:    engineClientCtxKey,
+	Flags: []*flags.FlagConfig{
+		{
+			Key: roleFlagKey,
+			Usage: fmt.Sprintf(
+				"The role of the token: '%v' can only read, '%v' can also create, stop and destroy enclaves, and '%v' can also clean the engine and manage tokens",
+				readOnlyRoleStr,
+				operatorRoleStr,
+				adminRoleStr,
+			),
+			Type:    flags.FlagType_String,
+			Default: defaultRole,
+		},
+		{
+			Key:     enclaveFlagKey,
+			Usage:   "The name of an enclave the token is restricted to. Can be repeated; without it, the token can act on all enclaves",
+			Type:    flags.FlagType_StringArray,
+			Default: noEnclavesPassedByDefault,
+		},
+	},
+	Args: []*args.ArgConfig{
+		{
+			Key: tokenNameArgKey,
+		},
+	},
+	RunFunc: run,
+}
+
+func run(
+	ctx context.Context,
+	_ backend_interface.KurtosisBackend,
+	engineClient kurtosis_engine_rpc_api_bindings.EngineServiceClient,
+	_ metrics_client.MetricsClient,
+	flags *flags.ParsedFlags,
+	args *args.ParsedArgs,
+) error {
+	tokenName, err := args.GetNonGreedyArg(tokenNameArgKey)
+	if err != nil {
+		return stacktrace.Propagate(err, "Expected a value for non-greedy arg '%v' but none was found; this is a bug in the Kurtosis CLI!", tokenNameArgKey)
+	}
+
+	roleStr, err := flags.GetString(roleFlagKey)
+	if err != nil {
+		return stacktrace.Propagate(err, "Expected a value for the '%v' flag but failed to get it", roleFlagKey)
+	}
+	role, found := rolesByName[roleStr]
+	if !found {
+		return stacktrace.NewError("Unknown role '%v', expected one of '%v', '%v' or '%v'", roleStr, readOnlyRoleStr, operatorRoleStr, adminRoleStr)
+	}
+
+	enclaveNames, err := flags.GetStringArray(enclaveFlagKey)
+	if err != nil {
+		return stacktrace.Propagate(err, "Expected a value for the '%v' flag but failed to get it", enclaveFlagKey)
+	}
+
+	createApiTokenArgs := &kurtosis_engine_rpc_api_bindings.CreateApiTokenArgs{
+		Name:         tokenName,
+		Role:         role,
+		EnclaveNames: enclaveNames,
+	}
+	response, err := engineClient.CreateApiToken(ctx, createApiTokenArgs)
+	if err != nil {
+		return stacktrace.Propagate(err, "An error occurred creating API token '%v'", tokenName)
+	}
+
+	keyValuePrinter := output_printers.NewKeyValuePrinter()
+	keyValuePrinter.AddPair("Token ID", response.GetTokenId())
+	keyValuePrinter.AddPair("Token", response.GetToken())
+	keyValuePrinter.Print()
+	out.PrintOutLn("This token won't be shown again")
+	return nil
+}
@@ -0,0 +1,61 @@
+package revoke
+
+import (
+	"context"
+
+	"github.com/kurtosis-tech/kurtosis/api/golang/engine/kurtosis_engine_rpc_api_bindings"
+	"github.com/kurtosis-tech/kurtosis/cli/cli/command_framework/highlevel/engine_consuming_kurtosis_command"
+	"github.com/kurtosis-tech/kurtosis/cli/cli/command_framework/lowlevel/args"
+	"github.com/kurtosis-tech/kurtosis/cli/cli/command_framework/lowlevel/flags"
+	"github.com/kurtosis-tech/kurtosis/cli/cli/command_str_consts"
+	"github.com/kurtosis-tech/kurtosis/container-engine-lib/lib/backend_interface"
+	"github.com/kurtosis-tech/kurtosis/metrics-library/golang/lib/metrics_client"
+	"github.com/kurtosis-tech/stacktrace"
+	"github.com/sirupsen/logrus"
+)
+
+const (
+	tokenIdArgKey = "token-id"
+
+	kurtosisBackendCtxKey = "kurtosis-backend"
+	engineClientCtxKey    = "engine-client"
+)
+
+var TokenRevokeCmd = &engine_consuming_kurtosis_command.EngineConsumingKurtosisCommand{
+	CommandStr:                command_str_consts.TokenRevokeCmdStr,
+	ShortDescription:          "Revokes an engine API token",
+	LongDescription:           "Revokes the engine API token with the given ID, rejecting the calls made with it from now on",
+	KurtosisBackendContextKey: kurtosisBackendCtxKey,
+	EngineClientContextKey:    engineClientCtxKey,
+	Flags:                     []*flags.FlagConfig{},
+	Args: []*args.ArgConfig{
+		{
+			Key: tokenIdArgKey,
+		},
+	},
+	RunFunc: run,
+}
+
+func run(
+	ctx context.Context,
+	_ backend_interface.KurtosisBackend,
+	engineClient kurtosis_engine_rpc_api_bindings.EngineServiceClient,
+	_ metrics_client.MetricsClient,
+	_ *flags.ParsedFlags,
+	args *args.ParsedArgs,
+) error {
+	tokenId, err := args.GetNonGreedyArg(tokenIdArgKey)
+	if err != nil {
+		return stacktrace.Propagate(err, "Expected a value for non-greedy arg '%v' but none was found; this is a bug in the Kurtosis CLI!", tokenIdArgKey)
+	}
+
+	revokeApiTokenArgs := &kurtosis_engine_rpc_api_bindings.RevokeApiTokenArgs{
+		TokenId: tokenId,
+	}
+	if _, err := engineClient.RevokeApiToken(ctx, revokeApiTokenArgs); err != nil {
+		return stacktrace.Propagate(err, "An error occurred revoking API token '%v'", tokenId)
+	}
+
+	logrus.Infof("API token '%v' successfully revoked", tokenId)
+	return nil
+}
@@ -0,0 +1,21 @@
+package token
+
+import (
+	"github.com/kurtosis-tech/kurtosis/cli/cli/command_str_consts"
+	"github.com/kurtosis-tech/kurtosis/cli/cli/commands/engine/token/create"
+	"github.com/kurtosis-tech/kurtosis/cli/cli/commands/engine/token/revoke"
+	"github.com/spf13/cobra"
+)
+
+// TokenCmd Suppressing exhaustruct requirement because this struct has ~40 properties
+// nolint: exhaustruct
+var TokenCmd = &cobra.Command{
+	Use:   command_str_consts.EngineTokenCmdStr,
+	Short: "Manage the tokens used to authenticate to the engine API",
+	RunE:  nil,
+}
+
+func init() {
+	TokenCmd.AddCommand(create.TokenCreateCmd.MustGetCobraCommand())
+	TokenCmd.AddCommand(revoke.TokenRevokeCmd.MustGetCobraCommand())
+}
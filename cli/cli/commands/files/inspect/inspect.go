@@ -14,9 +14,12 @@ import (
 	"github.com/kurtosis-tech/kurtosis/cli/cli/command_framework/highlevel/artifact_identifier_arg"
 	"github.com/kurtosis-tech/kurtosis/cli/cli/command_framework/highlevel/enclave_id_arg"
 	"github.com/kurtosis-tech/kurtosis/cli/cli/command_framework/highlevel/engine_consuming_kurtosis_command"
+	"github.com/kurtosis-tech/kurtosis/cli/cli/command_framework/highlevel/output_format_flag"
 	"github.com/kurtosis-tech/kurtosis/cli/cli/command_framework/lowlevel/args"
 	"github.com/kurtosis-tech/kurtosis/cli/cli/command_framework/lowlevel/flags"
 	"github.com/kurtosis-tech/kurtosis/cli/cli/command_str_consts"
+	"github.com/kurtosis-tech/kurtosis/cli/cli/helpers/output_printers"
+	"github.com/kurtosis-tech/kurtosis/cli/cli/helpers/output_schemas"
 	"github.com/kurtosis-tech/kurtosis/cli/cli/out"
 	"github.com/kurtosis-tech/kurtosis/container-engine-lib/lib/backend_interface"
 	"github.com/kurtosis-tech/kurtosis/metrics-library/golang/lib/metrics_client"
@@ -54,7 +57,9 @@ var FilesInspectCmd = &engine_consuming_kurtosis_command.EngineConsumingKurtosis
 	LongDescription:           "Inspect the requested file artifact, returning the file tree, metadata and a preview, if available",
 	KurtosisBackendContextKey: kurtosisBackendCtxKey,
 	EngineClientContextKey:    engineClientCtxKey,
-	Flags:                     []*flags.FlagConfig{},
+	Flags: []*flags.FlagConfig{
+		output_format_flag.NewOutputFormatFlag(),
+	},
 	Args: []*args.ArgConfig{
 		enclave_id_arg.NewEnclaveIdentifierArg(
 			enclaveIdentifierArgKey,
@@ -112,6 +117,11 @@ func run(
 		return stacktrace.Propagate(err, "An error occurred getting the file path")
 	}
 
+	outputFormat, err := output_format_flag.GetOutputFormat(flags)
+	if err != nil {
+		return stacktrace.Propagate(err, "An error occurred getting the output format")
+	}
+
 	filesInspectResponse, err := enclaveCtx.InspectFilesArtifact(ctx, services.FileArtifactName(artifactIdentifierName))
 	if err != nil {
 		return stacktrace.Propagate(err, "An error occurred inspecting files from artifact identifier '%v', enclave '%v'", artifactIdentifierName, enclaveIdentifier)
@@ -119,6 +129,13 @@ func run(
 	fileDescriptions := filesInspectResponse.GetFileDescriptions()
 
 	if filePath == "" {
+		if outputFormat.IsStructured() {
+			filesArtifactContents := output_schemas.NewFilesArtifactContents(artifactIdentifierName, fileDescriptions)
+			if err := output_printers.PrintStructured(outputFormat, filesArtifactContents); err != nil {
+				return stacktrace.Propagate(err, "An error occurred printing the contents of files artifact '%v'", artifactIdentifierName)
+			}
+			return nil
+		}
 		out.PrintErrLn(fmt.Sprintf("Artifact '%v' contents:\n", artifactIdentifierName))
 		out.PrintOutLn(buildTree(fileDescriptions))
 		return nil
@@ -129,6 +146,12 @@ func run(
 	if index == -1 {
 		return stacktrace.NewError("An error finding file '%v' on artifact identifier '%v', from '%v'", filePath, artifactIdentifierName, enclaveIdentifier)
 	}
+	if outputFormat.IsStructured() {
+		if err := output_printers.PrintStructured(outputFormat, output_schemas.NewFileDescription(fileDescriptions[index])); err != nil {
+			return stacktrace.Propagate(err, "An error occurred printing file '%v' of files artifact '%v'", filePath, artifactIdentifierName)
+		}
+		return nil
+	}
 	out.PrintErrLn("File contents:")
 	out.PrintOutLn(fileDescriptions[index].GetTextPreview())
 	return nil
@@ -25,6 +25,11 @@ func init() {
 func run(cmd *cobra.Command, args []string) error {
 	ctx := context.Background()
 
+	kurtosisConfig, err := kurtosis_config_getter.GetKurtosisConfig()
+	if err != nil {
+		return stacktrace.Propagate(err, "An error occurred getting the Kurtosis configuration")
+	}
+
 	clusterConfig, err := kurtosis_config_getter.GetKurtosisClusterConfig()
 	if err != nil {
 		return stacktrace.Propagate(err, "Expected to be able to get Kurtosis cluster configuration, instead a non-nil error was returned")
@@ -48,7 +53,7 @@ func run(cmd *cobra.Command, args []string) error {
 		return stacktrace.Propagate(err, "Expected to be able to instantiate a gateway connection provider, instead a non-nil error was returned")
 	}
 
-	if err := engine_gateway.RunEngineGatewayUntilInterrupted(kurtosisBackend, connectionProvider); err != nil {
+	if err := engine_gateway.RunEngineGatewayUntilInterrupted(kurtosisBackend, connectionProvider, kurtosisConfig.GetIsApiAuthEnabled()); err != nil {
 		return stacktrace.Propagate(err, "An error occurred running the engine gateway server.")
 	}
 	return nil
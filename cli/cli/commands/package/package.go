@@ -3,6 +3,7 @@ package _package
 import (
 	"github.com/kurtosis-tech/kurtosis/cli/cli/command_str_consts"
 	"github.com/kurtosis-tech/kurtosis/cli/cli/commands/package/init_cmd"
+	"github.com/kurtosis-tech/kurtosis/cli/cli/commands/package/test_cmd"
 	"github.com/spf13/cobra"
 )
 
@@ -16,4 +17,5 @@ var PackageCmd = &cobra.Command{
 
 func init() {
 	PackageCmd.AddCommand(init_cmd.InitCmd.MustGetCobraCommand())
+	PackageCmd.AddCommand(test_cmd.TestCmd.MustGetCobraCommand())
 }
@@ -0,0 +1,184 @@
+package test_cmd
+
+import (
+	"context"
+	"fmt"
+	"os"
+	"path"
+	"strings"
+
+	"github.com/kurtosis-tech/kurtosis/api/golang/engine/kurtosis_engine_rpc_api_bindings"
+	"github.com/kurtosis-tech/kurtosis/api/golang/engine/lib/kurtosis_context"
+	"github.com/kurtosis-tech/kurtosis/cli/cli/command_framework/highlevel/engine_consuming_kurtosis_command"
+	"github.com/kurtosis-tech/kurtosis/cli/cli/command_framework/lowlevel/args"
+	"github.com/kurtosis-tech/kurtosis/cli/cli/command_framework/lowlevel/flags"
+	"github.com/kurtosis-tech/kurtosis/cli/cli/command_str_consts"
+	"github.com/kurtosis-tech/kurtosis/cli/cli/out"
+	"github.com/kurtosis-tech/kurtosis/container-engine-lib/lib/backend_interface"
+	"github.com/kurtosis-tech/kurtosis/metrics-library/golang/lib/metrics_client"
+	"github.com/kurtosis-tech/stacktrace"
+	"github.com/sirupsen/logrus"
+)
+
+const (
+	packageDirArgKey          = "package-dir"
+	packageDirArgDefaultValue = "."
+	packageDirArgIsOptional   = true
+	packageDirArgIsGreedy     = false
+
+	unitFlagKey          = "unit"
+	unitFlagDefaultValue = "true"
+
+	verboseFlagKey          = "verbose"
+	verboseFlagShortKey     = "v"
+	verboseFlagDefaultValue = "false"
+
+	kurtosisYamlFilename = "kurtosis.yml"
+
+	// Mirror the names the API container looks the tests up by
+	unitTestFileSuffix     = "_test.star"
+	unitTestFunctionPrefix = "test_"
+	kurtosisTestModuleName = "kurtosis_test"
+
+	// The engine generates a name for the temporary enclave the tests run in
+	autogenerateEnclaveName = ""
+
+	passedTestPrefix = "PASS"
+	failedTestPrefix = "FAIL"
+	indentation      = "    "
+
+	kurtosisBackendCtxKey = "kurtosis-backend"
+	engineClientCtxKey    = "engine-client"
+)
+
+var TestCmd = &engine_consuming_kurtosis_command.EngineConsumingKurtosisCommand{
+	CommandStr:       command_str_consts.PackageTestCmdStr,
+	ShortDescription: "Runs the tests of a Kurtosis package",
+	LongDescription: fmt.Sprintf(
+		"Runs every `%s*` function of the `*%s` files of the package. The package is uploaded to a temporary "+
+			"enclave whose API container interprets it against a fake enclave, where add_service and exec return "+
+			"the values stubbed by the test through the `%s` module; no service is started. The temporary enclave "+
+			"is destroyed once the tests are done.",
+		unitTestFunctionPrefix,
+		unitTestFileSuffix,
+		kurtosisTestModuleName,
+	),
+	KurtosisBackendContextKey: kurtosisBackendCtxKey,
+	EngineClientContextKey:    engineClientCtxKey,
+	Args: []*args.ArgConfig{
+		{
+			Key:                   packageDirArgKey,
+			DefaultValue:          packageDirArgDefaultValue,
+			IsOptional:            packageDirArgIsOptional,
+			IsGreedy:              packageDirArgIsGreedy,
+			ArgCompletionProvider: nil,
+			ValidationFunc:        validatePackageDirArg,
+		},
+	},
+	Flags: []*flags.FlagConfig{
+		{
+			Key:     unitFlagKey,
+			Usage:   "Run the tests against a fake enclave, without starting any service. This is currently the only supported mode, and the default",
+			Type:    flags.FlagType_Bool,
+			Default: unitFlagDefaultValue,
+		},
+		{
+			Key:       verboseFlagKey,
+			Usage:     "Print the output of every instruction executed by each test, not only of the failing ones",
+			Shorthand: verboseFlagShortKey,
+			Type:      flags.FlagType_Bool,
+			Default:   verboseFlagDefaultValue,
+		},
+	},
+	RunFunc: run,
+}
+
+func run(
+	ctx context.Context,
+	_ backend_interface.KurtosisBackend,
+	_ kurtosis_engine_rpc_api_bindings.EngineServiceClient,
+	_ metrics_client.MetricsClient,
+	flags *flags.ParsedFlags,
+	args *args.ParsedArgs,
+) error {
+	packageDir, err := args.GetNonGreedyArg(packageDirArgKey)
+	if err != nil {
+		return stacktrace.Propagate(err, "An error occurred getting the value of argument with key '%v'", packageDirArgKey)
+	}
+	isUnitMode, err := flags.GetBool(unitFlagKey)
+	if err != nil {
+		return stacktrace.Propagate(err, "An error occurred getting the value of flag '%v'", unitFlagKey)
+	}
+	isVerbose, err := flags.GetBool(verboseFlagKey)
+	if err != nil {
+		return stacktrace.Propagate(err, "An error occurred getting the value of flag '%v'", verboseFlagKey)
+	}
+
+	if !isUnitMode {
+		return stacktrace.NewError("Only unit tests are supported for now; re-run the command without '--%s=false' to run the package tests against a fake enclave", unitFlagKey)
+	}
+
+	kurtosisCtx, err := kurtosis_context.NewKurtosisContextFromLocalEngine()
+	if err != nil {
+		return stacktrace.Propagate(err, "An error occurred creating Kurtosis Context from local engine")
+	}
+	logrus.Infof("Creating a temporary enclave to run the unit tests in...")
+	enclaveCtx, err := kurtosisCtx.CreateEnclave(ctx, autogenerateEnclaveName)
+	if err != nil {
+		return stacktrace.Propagate(err, "An error occurred creating the temporary enclave to run the unit tests in")
+	}
+	defer func() {
+		if err := kurtosisCtx.DestroyEnclave(ctx, string(enclaveCtx.GetEnclaveUuid())); err != nil {
+			logrus.Warnf("An error occurred destroying temporary enclave '%v'; it needs to be removed manually with 'kurtosis %v %v %v'. Error was:\n%v", enclaveCtx.GetEnclaveName(), command_str_consts.EnclaveCmdStr, command_str_consts.EnclaveRmCmdStr, enclaveCtx.GetEnclaveName(), err)
+		}
+	}()
+
+	results, err := enclaveCtx.RunStarlarkPackageUnitTests(ctx, packageDir)
+	if err != nil {
+		return stacktrace.Propagate(err, "An error occurred running the unit tests of the package at '%s'", packageDir)
+	}
+	if len(results) == 0 {
+		out.PrintOutLn(fmt.Sprintf("No `%s*` function found in any `*%s` file of the package at '%s'", unitTestFunctionPrefix, unitTestFileSuffix, packageDir))
+		return nil
+	}
+
+	numFailedTests := 0
+	for _, result := range results {
+		isSuccessful := result.GetFailureMessage() == ""
+		prefix := passedTestPrefix
+		if !isSuccessful {
+			prefix = failedTestPrefix
+			numFailedTests += 1
+		}
+		out.PrintOutLn(fmt.Sprintf("%s %s::%s (%s)", prefix, result.GetTestFile(), result.GetTestName(), result.GetDuration().AsDuration()))
+		if isVerbose || !isSuccessful {
+			for _, instructionOutput := range result.GetInstructionOutputs() {
+				out.PrintOutLn(indent(instructionOutput))
+			}
+		}
+		if !isSuccessful {
+			out.PrintOutLn(indent(result.GetFailureMessage()))
+		}
+	}
+
+	if numFailedTests > 0 {
+		return stacktrace.NewError("%d out of %d tests failed", numFailedTests, len(results))
+	}
+	out.PrintOutLn(fmt.Sprintf("All %d tests passed", len(results)))
+	return nil
+}
+
+func validatePackageDirArg(_ context.Context, _ *flags.ParsedFlags, args *args.ParsedArgs) error {
+	packageDir, err := args.GetNonGreedyArg(packageDirArgKey)
+	if err != nil {
+		return stacktrace.Propagate(err, "An error occurred getting the value of argument with key '%v'", packageDirArgKey)
+	}
+	if _, err := os.Stat(path.Join(packageDir, kurtosisYamlFilename)); err != nil {
+		return stacktrace.Propagate(err, "'%s' is not a Kurtosis package; no '%s' could be found at its root", packageDir, kurtosisYamlFilename)
+	}
+	return nil
+}
+
+func indent(text string) string {
+	return indentation + strings.ReplaceAll(text, "\n", "\n"+indentation)
+}
@@ -8,10 +8,13 @@ import (
 	"github.com/kurtosis-tech/kurtosis/api/golang/engine/lib/kurtosis_context"
 	"github.com/kurtosis-tech/kurtosis/cli/cli/command_framework/highlevel/enclave_id_arg"
 	"github.com/kurtosis-tech/kurtosis/cli/cli/command_framework/highlevel/engine_consuming_kurtosis_command"
+	"github.com/kurtosis-tech/kurtosis/cli/cli/command_framework/highlevel/output_format_flag"
 	"github.com/kurtosis-tech/kurtosis/cli/cli/command_framework/highlevel/service_identifier_arg"
 	"github.com/kurtosis-tech/kurtosis/cli/cli/command_framework/lowlevel/args"
 	"github.com/kurtosis-tech/kurtosis/cli/cli/command_framework/lowlevel/flags"
 	"github.com/kurtosis-tech/kurtosis/cli/cli/command_str_consts"
+	"github.com/kurtosis-tech/kurtosis/cli/cli/helpers/output_printers"
+	"github.com/kurtosis-tech/kurtosis/cli/cli/helpers/output_schemas"
 	"github.com/kurtosis-tech/kurtosis/cli/cli/out"
 	"github.com/kurtosis-tech/kurtosis/container-engine-lib/lib/backend_interface"
 	"github.com/kurtosis-tech/kurtosis/metrics-library/golang/lib/metrics_client"
@@ -74,6 +77,7 @@ var PortPrintCmd = &engine_consuming_kurtosis_command.EngineConsumingKurtosisCom
 			Type:    flags.FlagType_String,
 			Default: formatFlagKeyDefault,
 		},
+		output_format_flag.NewOutputFormatFlag(),
 	},
 	Args: []*args.ArgConfig{
 		enclave_id_arg.NewHistoricalEnclaveIdentifiersArgWithValidationDisabled(
@@ -126,6 +130,11 @@ func run(
 		return stacktrace.Propagate(err, "An error occurred getting the output flag key '%v'", formatFlagKey)
 	}
 
+	outputFormat, err := output_format_flag.GetOutputFormat(flags)
+	if err != nil {
+		return stacktrace.Propagate(err, "An error occurred getting the output format")
+	}
+
 	kurtosisCtx, err := kurtosis_context.NewKurtosisContextFromLocalEngine()
 	if err != nil {
 		return stacktrace.Propagate(err, "An error occurred connecting to the local Kurtosis engine")
@@ -150,6 +159,14 @@ func run(
 		)
 	}
 
+	// The structured output always contains every piece of the port, so the format flag is ignored
+	if outputFormat.IsStructured() {
+		if err := output_printers.PrintStructured(outputFormat, output_schemas.NewPublicPort(portIdentifier, ipAddress, publicPort)); err != nil {
+			return stacktrace.Propagate(err, "An error occurred printing port '%v' of service '%v'", portIdentifier, serviceIdentifier)
+		}
+		return nil
+	}
+
 	fullUrl, err := formatPortOutput(format, ipAddress, publicPort)
 	if err != nil {
 		return stacktrace.Propagate(err, "Couldn't format the output according to formatting string '%v'", format)
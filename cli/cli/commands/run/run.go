@@ -40,6 +40,7 @@ import (
 	"github.com/kurtosis-tech/kurtosis/cli/cli/command_framework/lowlevel/flags"
 	"github.com/kurtosis-tech/kurtosis/cli/cli/command_str_consts"
 	"github.com/kurtosis-tech/kurtosis/cli/cli/commands/enclave/inspect"
+	"github.com/kurtosis-tech/kurtosis/cli/cli/helpers/enclave_expiration"
 	"github.com/kurtosis-tech/kurtosis/cli/cli/helpers/graph_viz"
 	"github.com/kurtosis-tech/kurtosis/cli/cli/helpers/output_printers"
 	"github.com/kurtosis-tech/kurtosis/cli/cli/helpers/portal_manager"
@@ -134,6 +135,12 @@ const (
 	withMermaidFlagKey = "with-mermaid"
 	defaultWithMermaid = "false"
 
+	secretFlagKey            = "secret"
+	secretKeyValueSeparator  = "="
+	secretsFileFlagKey       = "secrets-file"
+	secretsFileDefaultValue  = ""
+	noSecretsPassedByDefault = ""
+
 	httpProtocolRegexStr           = "^(http|https)://"
 	shouldCloneNormalRepo          = false
 	packageReplaceKeyInKurtosisYml = "replace:"
@@ -150,7 +157,7 @@ var StarlarkRunCmd = &engine_consuming_kurtosis_command.EngineConsumingKurtosisC
 		") to a remote runnable package on Github. If the '" + enclaveIdentifierFlagKey + "' flag argument " +
 		"is provided, Kurtosis will run the script inside the specified enclave or create it if it doesn't exist. If no '" +
 		enclaveIdentifierFlagKey + "' flag param is provided, Kurtosis will create a new enclave with a random name.",
-	Flags: []*flags.FlagConfig{
+	Flags: append([]*flags.FlagConfig{
 		{
 			Key: dryRunFlagKey,
 			// TODO(gb): link to a doc page mentioning what a "Kurtosis instruction" is
@@ -279,7 +286,21 @@ var StarlarkRunCmd = &engine_consuming_kurtosis_command.EngineConsumingKurtosisC
 			Type:    flags.FlagType_Bool,
 			Default: defaultWithMermaid,
 		},
-	},
+		{
+			Key: secretFlagKey,
+			Usage: fmt.Sprintf("A secret to pass to the run, as 'NAME=VALUE', that Starlark code references with 'Secret(\"NAME\")'. "+
+				"If only 'NAME' is given, the value is read from the environment variable of the same name, which keeps it out of the shell history. "+
+				"Secrets are never persisted in the enclave and are masked in the run output. Can be repeated, and takes precedence over the '--%s' flag.", secretsFileFlagKey),
+			Type:    flags.FlagType_StringArray,
+			Default: noSecretsPassedByDefault,
+		},
+		{
+			Key:     secretsFileFlagKey,
+			Usage:   fmt.Sprintf("Path to a (JSON/YAML) file mapping secret names to their values, see '--%s'", secretFlagKey),
+			Type:    flags.FlagType_String,
+			Default: secretsFileDefaultValue,
+		},
+	}, enclave_expiration.GetFlagConfigs()...),
 	Args: []*args.ArgConfig{
 		// TODO add a `Usage` description here when ArgConfig supports it
 		file_system_path_arg.NewFilepathOrDirpathArg(
@@ -384,6 +405,11 @@ func run(
 		return stacktrace.Propagate(err, "Expected a value for the '%v' flag but failed to get it", enclaveProductionModeFlagKey)
 	}
 
+	ttl, idleTimeout, expiredEnclaveAction, err := enclave_expiration.GetExpirationFromFlags(flags)
+	if err != nil {
+		return stacktrace.Propagate(err, "An error occurred getting the enclave expiration from the flags")
+	}
+
 	experimentalFlags, err := parseExperimentalFlag(flags)
 	if err != nil {
 		return stacktrace.Propagate(err, "Expected a value for the '%v' flag but failed to get it", mainFunctionNameFlagKey)
@@ -419,6 +445,11 @@ func run(
 		return stacktrace.Propagate(err, "Expected a value for the '%v' flag but failed to get it", withMermaidFlagKey)
 	}
 
+	secrets, err := parseSecretFlags(flags)
+	if err != nil {
+		return stacktrace.Propagate(err, "An error occurred parsing the secrets passed with the '%v' and '%v' flags", secretFlagKey, secretsFileFlagKey)
+	}
+
 	if packageArgs == inputArgsAreEmptyBracesByDefault && packageArgsFile != packageArgsFileDefaultValue {
 		logrus.Debugf("'%v' is empty but '%v' is provided so we will go with the '%v' value", inputArgsArgKey, packageArgsFileFlagKey, packageArgsFileFlagKey)
 		packageArgs, err = getArgsFromFilepathOrURL(packageArgsFile)
@@ -439,6 +470,7 @@ func run(
 		starlark_run_config.WithImageDownloadMode(*imageDownload),
 		starlark_run_config.WithNonBlockingMode(nonBlockingMode),
 		starlark_run_config.WithParallel(isParallel),
+		starlark_run_config.WithSecrets(secrets),
 	)
 
 	kurtosisCtx, err := kurtosis_context.NewKurtosisContextFromLocalEngine()
@@ -446,7 +478,7 @@ func run(
 		return stacktrace.Propagate(err, "An error occurred connecting to the local Kurtosis engine")
 	}
 
-	enclaveCtx, isNewEnclave, err := getOrCreateEnclaveContext(ctx, userRequestedEnclaveIdentifier, kurtosisCtx, isProduction)
+	enclaveCtx, isNewEnclave, err := getOrCreateEnclaveContext(ctx, userRequestedEnclaveIdentifier, kurtosisCtx, isProduction, ttl, idleTimeout, expiredEnclaveAction)
 	if err != nil {
 		return stacktrace.Propagate(err, "An error occurred getting the enclave context for enclave '%v'", userRequestedEnclaveIdentifier)
 	}
@@ -752,6 +784,10 @@ func getOrCreateEnclaveContext(
 	enclaveIdentifierOrName string,
 	kurtosisContext *kurtosis_context.KurtosisContext,
 	isProduction bool,
+	// Only applied if a new enclave gets created
+	ttl time.Duration,
+	idleTimeout time.Duration,
+	expiredEnclaveAction kurtosis_engine_rpc_api_bindings.ExpiredEnclaveAction,
 ) (*enclaves.EnclaveContext, bool, error) {
 
 	if enclaveIdentifierOrName != autogenerateEnclaveIdentifierKeyword {
@@ -767,7 +803,9 @@ func getOrCreateEnclaveContext(
 	logrus.Infof("Creating a new enclave for Starlark to run inside...")
 	var enclaveContext *enclaves.EnclaveContext
 	var err error
-	if isProduction {
+	if ttl > 0 || idleTimeout > 0 {
+		enclaveContext, err = kurtosisContext.CreateEnclaveWithExpiration(ctx, enclaveIdentifierOrName, isProduction, ttl, idleTimeout, expiredEnclaveAction)
+	} else if isProduction {
 		enclaveContext, err = kurtosisContext.CreateProductionEnclave(ctx, enclaveIdentifierOrName)
 	} else {
 		enclaveContext, err = kurtosisContext.CreateEnclave(ctx, enclaveIdentifierOrName)
@@ -896,6 +934,55 @@ func parseExperimentalFlag(flags *flags.ParsedFlags) ([]kurtosis_core_rpc_api_bi
 	return parsedExperimentalFeatures, nil
 }
 
+// parseSecretFlags merges the secrets of the secrets file, if any, with the ones passed one by one
+func parseSecretFlags(flags *flags.ParsedFlags) (map[string]string, error) {
+	secrets := map[string]string{}
+
+	secretsFilepath, err := flags.GetString(secretsFileFlagKey)
+	if err != nil {
+		return nil, stacktrace.Propagate(err, "An error occurred getting the secrets file using flag key '%s'", secretsFileFlagKey)
+	}
+	if secretsFilepath != secretsFileDefaultValue {
+		secretsFileContent, err := os.ReadFile(secretsFilepath)
+		if err != nil {
+			return nil, stacktrace.Propagate(err, "An error occurred reading the secrets file '%s'", secretsFilepath)
+		}
+		// YAML being a superset of JSON, this parses both formats
+		if err := yaml.Unmarshal(secretsFileContent, &secrets); err != nil {
+			return nil, stacktrace.Propagate(err, "An error occurred parsing the secrets file '%s'; it should be a mapping of secret names to string values", secretsFilepath)
+		}
+	}
+
+	secretFlagValues, err := flags.GetStringArray(secretFlagKey)
+	if err != nil {
+		return nil, stacktrace.Propagate(err, "An error occurred getting the secrets using flag key '%s'", secretFlagKey)
+	}
+	for _, secretFlagValue := range secretFlagValues {
+		name, value, err := parseSecretFlagValue(secretFlagValue)
+		if err != nil {
+			return nil, err
+		}
+		secrets[name] = value
+	}
+	return secrets, nil
+}
+
+func parseSecretFlagValue(secretFlagValue string) (string, string, error) {
+	name, value, hasValue := strings.Cut(secretFlagValue, secretKeyValueSeparator)
+	if name == "" {
+		// the flag value isn't part of the message as it contains the secret value
+		return "", "", stacktrace.NewError("A secret was passed without a name, it should be passed as '--%s NAME=VALUE' or '--%s NAME'", secretFlagKey, secretFlagKey)
+	}
+	if hasValue {
+		return name, value, nil
+	}
+	value, found := os.LookupEnv(name)
+	if !found {
+		return "", "", stacktrace.NewError("Secret '%s' was passed without a value but no environment variable with this name is set", name)
+	}
+	return name, value, nil
+}
+
 // isStandaloneScript returns true if the fileInfo points to a non `kurtosis.yml` regular file
 func isStandaloneScript(fileInfo os.FileInfo, kurtosisYMLFilePath string) bool {
 	return fileInfo.Mode().IsRegular() && fileInfo.Name() != kurtosisYMLFilePath
@@ -74,3 +74,28 @@ func TestIsHttpUrl_NoValidBecauseIsRelativeFilepath(t *testing.T) {
 
 	require.False(t, isHttpUrlResult)
 }
+
+func TestParseSecretFlagValue_NameAndValue(t *testing.T) {
+	name, value, err := parseSecretFlagValue("DB_PASSWORD=pa=ss,word")
+	require.Nil(t, err)
+	require.Equal(t, "DB_PASSWORD", name)
+	require.Equal(t, "pa=ss,word", value)
+}
+
+func TestParseSecretFlagValue_ValueFromEnvironment(t *testing.T) {
+	t.Setenv("KURTOSIS_TEST_SECRET", "from-env")
+	name, value, err := parseSecretFlagValue("KURTOSIS_TEST_SECRET")
+	require.Nil(t, err)
+	require.Equal(t, "KURTOSIS_TEST_SECRET", name)
+	require.Equal(t, "from-env", value)
+}
+
+func TestParseSecretFlagValue_MissingEnvironmentVariable(t *testing.T) {
+	_, _, err := parseSecretFlagValue("KURTOSIS_TEST_SECRET_THAT_IS_NOT_SET")
+	require.NotNil(t, err)
+}
+
+func TestParseSecretFlagValue_NoName(t *testing.T) {
+	_, _, err := parseSecretFlagValue("=value")
+	require.NotNil(t, err)
+}
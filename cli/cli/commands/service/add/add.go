@@ -8,7 +8,6 @@ import (
 	"os"
 	"strings"
 
-	"github.com/kurtosis-tech/kurtosis/cli/cli/commands/service/inspect"
 	"github.com/kurtosis-tech/kurtosis/cli/cli/commands/service/service_helpers"
 
 	"github.com/kurtosis-tech/kurtosis/api/golang/core/kurtosis_core_rpc_api_bindings"
@@ -17,6 +16,7 @@ import (
 	"github.com/kurtosis-tech/kurtosis/api/golang/engine/lib/kurtosis_context"
 	"github.com/kurtosis-tech/kurtosis/cli/cli/command_framework/highlevel/enclave_id_arg"
 	"github.com/kurtosis-tech/kurtosis/cli/cli/command_framework/highlevel/engine_consuming_kurtosis_command"
+	"github.com/kurtosis-tech/kurtosis/cli/cli/command_framework/highlevel/output_format_flag"
 	"github.com/kurtosis-tech/kurtosis/cli/cli/command_framework/lowlevel/args"
 	"github.com/kurtosis-tech/kurtosis/cli/cli/command_framework/lowlevel/flags"
 	"github.com/kurtosis-tech/kurtosis/cli/cli/command_str_consts"
@@ -185,8 +185,8 @@ var ServiceAddCmd = &engine_consuming_kurtosis_command.EngineConsumingKurtosisCo
 				command_str_consts.KurtosisCmdStr,
 				command_str_consts.ServiceCmdStr,
 				command_str_consts.ServiceInspectCmdStr,
-				inspect.OutputFormatKeyShorthand,
-				inspect.JsonOutputFormat,
+				output_format_flag.OutputFormatFlagShorthand,
+				output_printers.JsonOutputFormat,
 			),
 			Type:    flags.FlagType_String,
 			Default: JsonConfigFlagKeyDefault,
@@ -7,22 +7,22 @@ package inspect
 
 import (
 	"context"
-	"encoding/json"
 	"fmt"
 	"github.com/kurtosis-tech/kurtosis/api/golang/core/lib/services"
 	"github.com/kurtosis-tech/kurtosis/cli/cli/commands/service/service_helpers"
-	"gopkg.in/yaml.v3"
-	"strings"
 
 	"github.com/kurtosis-tech/kurtosis/api/golang/core/kurtosis_core_rpc_api_bindings"
 	"github.com/kurtosis-tech/kurtosis/api/golang/engine/kurtosis_engine_rpc_api_bindings"
 	"github.com/kurtosis-tech/kurtosis/api/golang/engine/lib/kurtosis_context"
 	"github.com/kurtosis-tech/kurtosis/cli/cli/command_framework/highlevel/enclave_id_arg"
 	"github.com/kurtosis-tech/kurtosis/cli/cli/command_framework/highlevel/engine_consuming_kurtosis_command"
+	"github.com/kurtosis-tech/kurtosis/cli/cli/command_framework/highlevel/output_format_flag"
 	"github.com/kurtosis-tech/kurtosis/cli/cli/command_framework/highlevel/service_identifier_arg"
 	"github.com/kurtosis-tech/kurtosis/cli/cli/command_framework/lowlevel/args"
 	"github.com/kurtosis-tech/kurtosis/cli/cli/command_framework/lowlevel/flags"
 	"github.com/kurtosis-tech/kurtosis/cli/cli/command_str_consts"
+	"github.com/kurtosis-tech/kurtosis/cli/cli/helpers/output_printers"
+	"github.com/kurtosis-tech/kurtosis/cli/cli/helpers/output_schemas"
 	"github.com/kurtosis-tech/kurtosis/cli/cli/helpers/service_status_stringifier"
 	"github.com/kurtosis-tech/kurtosis/cli/cli/helpers/user_services"
 	"github.com/kurtosis-tech/kurtosis/cli/cli/out"
@@ -43,12 +43,6 @@ const (
 	fullUuidFlagKey        = "full-uuid"
 	fullUuidFlagKeyDefault = "false"
 
-	outputFormatKey          = "output"
-	OutputFormatKeyShorthand = "o"
-	stdoutOutputFormat       = ""
-	yamlOutputFormat         = "yaml"
-	JsonOutputFormat         = "json"
-
 	ServiceNameTitleName           = "Name"
 	ServiceUUIDTitleName           = "UUID"
 	ServiceStatusTitleName         = "Status"
@@ -75,13 +69,7 @@ var ServiceInspectCmd = &engine_consuming_kurtosis_command.EngineConsumingKurtos
 			Type:    flags.FlagType_Bool,
 			Default: fullUuidFlagKeyDefault,
 		},
-		{
-			Key:       outputFormatKey,
-			Shorthand: OutputFormatKeyShorthand,
-			Usage:     "Format to output the result (yaml or json)",
-			Type:      flags.FlagType_String,
-			Default:   stdoutOutputFormat,
-		},
+		output_format_flag.NewOutputFormatFlag(),
 	},
 	Args: []*args.ArgConfig{
 		enclave_id_arg.NewEnclaveIdentifierArg(
@@ -123,13 +111,9 @@ func run(
 		return stacktrace.Propagate(err, "Expected a value for the '%v' flag but failed to get it", fullUuidFlagKey)
 	}
 
-	outputFormat, err := flags.GetString(outputFormatKey)
+	outputFormat, err := output_format_flag.GetOutputFormat(flags)
 	if err != nil {
-		return stacktrace.Propagate(err, "Expected a value for the '%v' flag but failed to get it", outputFormatKey)
-	}
-	outputFormat = strings.ToLower(strings.TrimSpace(outputFormat))
-	if outputFormat != "" && outputFormat != JsonOutputFormat && outputFormat != yamlOutputFormat {
-		return stacktrace.NewError("Invalid output format '%s'; must be '%v' or '%v'", outputFormat, JsonOutputFormat, yamlOutputFormat)
+		return stacktrace.Propagate(err, "An error occurred getting the output format")
 	}
 
 	kurtosisCtx, err := kurtosis_context.NewKurtosisContextFromLocalEngine()
@@ -149,21 +133,16 @@ func run(
 	return nil
 }
 
-func PrintServiceInspect(userService *kurtosis_core_rpc_api_bindings.ServiceInfo, userServiceConfig *services.ServiceConfig, showFullUuid bool, outputFormat string) error {
+// PrintServiceInspect prints the service info in text format, or the service config in a structured format; the latter
+// is kept as the service config so that it can be fed back into 'service add --json-service-config', with the rest of
+// the service info alongside it
+func PrintServiceInspect(userService *kurtosis_core_rpc_api_bindings.ServiceInfo, userServiceConfig *services.ServiceConfig, showFullUuid bool, outputFormat output_printers.OutputFormat) error {
 	switch outputFormat {
-	case JsonOutputFormat:
-		jsonServiceConfigStr, err := json.MarshalIndent(userServiceConfig, "", "  ")
-		if err != nil {
-			return stacktrace.Propagate(err, "Failed to marshal service info to %s", outputFormat)
-		}
-		out.PrintOutLn(string(jsonServiceConfigStr))
-	case yamlOutputFormat:
-		yamlServiceConfigStr, err := yaml.Marshal(userServiceConfig)
-		if err != nil {
+	case output_printers.JsonOutputFormat, output_printers.YamlOutputFormat:
+		if err := output_printers.PrintStructured(outputFormat, output_schemas.NewInspectedService(userService, userServiceConfig)); err != nil {
 			return stacktrace.Propagate(err, "Failed to marshal service info to %s", outputFormat)
 		}
-		out.PrintOutLn(string(yamlServiceConfigStr))
-	case stdoutOutputFormat:
+	case output_printers.TextOutputFormat:
 		err := printlnServiceInfo(userService, showFullUuid)
 		if err != nil {
 			return stacktrace.Propagate(err, "Failed to print service info to stdout.")
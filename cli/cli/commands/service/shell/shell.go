@@ -31,6 +31,10 @@ const (
 	isServiceGuidArgOptional = false
 	isServiceGuidArgGreedy   = false
 
+	containerUserKey      = "user"
+	containerUserShortKey = "u"
+	containerUserDefault  = "root"
+
 	kurtosisBackendCtxKey = "kurtosis-backend"
 	engineClientCtxKey    = "engine-client"
 )
@@ -41,7 +45,13 @@ var ServiceShellCmd = &engine_consuming_kurtosis_command.EngineConsumingKurtosis
 	LongDescription:           "Starts a shell on the specified service",
 	KurtosisBackendContextKey: kurtosisBackendCtxKey,
 	EngineClientContextKey:    engineClientCtxKey,
-	Flags:                     []*flags.FlagConfig{},
+	Flags: []*flags.FlagConfig{{
+		Key:       containerUserKey,
+		Usage:     "optional service container user for the shell",
+		Shorthand: containerUserShortKey,
+		Type:      flags.FlagType_String,
+		Default:   containerUserDefault,
+	}},
 	Args: []*args.ArgConfig{
 		enclave_id_arg.NewEnclaveIdentifierArg(
 			enclaveIdentifierArgKey,
@@ -95,7 +105,16 @@ func run(
 	}
 	serviceUuid := service.ServiceUUID(serviceCtx.GetServiceUUID())
 
-	if err = kurtosisBackend.GetShellOnUserService(ctx, enclaveUuid, serviceUuid); err != nil {
+	containerUser, err := flags.GetString(containerUserKey)
+	if err != nil {
+		return stacktrace.Propagate(err, "An error occurred getting the shell container user flag '%v'", containerUserKey)
+	}
+	// same as for 'service exec', "root" is implied when empty
+	if containerUser == containerUserDefault {
+		containerUser = ""
+	}
+
+	if err = kurtosisBackend.GetShellOnUserService(ctx, enclaveUuid, serviceUuid, containerUser); err != nil {
 		return stacktrace.Propagate(err, "An error occurred getting shell on user service with UUID '%v' in enclave '%v'", serviceUuid, enclaveIdentifier)
 	}
 
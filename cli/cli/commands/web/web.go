@@ -2,6 +2,9 @@ package web
 
 import (
 	"context"
+	"net/url"
+
+	"github.com/kurtosis-tech/kurtosis/api/golang/engine/lib/kurtosis_context"
 	"github.com/kurtosis-tech/kurtosis/cli/cli/command_framework/lowlevel"
 	"github.com/kurtosis-tech/kurtosis/cli/cli/command_framework/lowlevel/args"
 	"github.com/kurtosis-tech/kurtosis/cli/cli/command_framework/lowlevel/flags"
@@ -25,6 +28,9 @@ var WebCmd = &lowlevel.LowlevelKurtosisCommand{
 
 const (
 	webUILink = "http://localhost:9711/enclaves"
+
+	// The fragment of a URL isn't sent to any server, so the token only reaches the UI
+	engineApiTokenUrlFragmentParam = "api-token"
 )
 
 func run(_ context.Context, _ *flags.ParsedFlags, _ *args.ParsedArgs) error {
@@ -38,7 +44,17 @@ func run(_ context.Context, _ *flags.ParsedFlags, _ *args.ParsedArgs) error {
 		logrus.Warn("Kurtosis Cloud has been deprecated. Switch to a local kurtosis context to use the local Kurtosis web UI instead.")
 	}
 
-	if err := multi_os_command_executor.OpenFile(webUILink); err != nil {
+	// The UI needs the token of the CLI to call the engines enforcing API authentication
+	engineApiToken, err := kurtosis_context.GetEngineApiToken()
+	if err != nil {
+		return stacktrace.Propagate(err, "An error occurred getting the engine API token")
+	}
+	webUIUrl := webUILink
+	if engineApiToken != "" {
+		webUIUrl = webUILink + "#" + url.Values{engineApiTokenUrlFragmentParam: []string{engineApiToken}}.Encode()
+	}
+
+	if err := multi_os_command_executor.OpenFile(webUIUrl); err != nil {
 		return stacktrace.Propagate(err, "An error occurred while opening the Kurtosis Web UI")
 	}
 	return nil
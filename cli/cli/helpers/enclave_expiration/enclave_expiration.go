@@ -0,0 +1,121 @@
+package enclave_expiration
+
+import (
+	"fmt"
+	"strings"
+	"time"
+
+	"github.com/kurtosis-tech/kurtosis/api/golang/engine/kurtosis_engine_rpc_api_bindings"
+	"github.com/kurtosis-tech/kurtosis/cli/cli/command_framework/lowlevel/flags"
+	"github.com/kurtosis-tech/stacktrace"
+)
+
+const (
+	TtlFlagKey         = "ttl"
+	IdleTimeoutFlagKey = "idle-timeout"
+	OnExpiryFlagKey    = "on-expiry"
+
+	// Signifies that the enclave doesn't expire
+	noExpirationFlagValue = ""
+
+	expiredEnclaveActionPrefix = "ExpiredEnclaveAction_"
+	defaultOnExpiry            = "destroy"
+)
+
+// GetFlagConfigs returns the flags that commands creating enclaves use to make them expire
+func GetFlagConfigs() []*flags.FlagConfig {
+	return []*flags.FlagConfig{
+		{
+			Key:       TtlFlagKey,
+			Usage:     "How long the enclave lives for before it expires, as a duration like '30m' or '2h' (emptystring means the enclave doesn't expire with age)",
+			Shorthand: "",
+			Type:      flags.FlagType_String,
+			Default:   noExpirationFlagValue,
+		},
+		{
+			Key:       IdleTimeoutFlagKey,
+			Usage:     "How long the enclave can go without its API container serving any request before it expires, as a duration like '30m' or '2h' (emptystring means the enclave doesn't expire for being idle)",
+			Shorthand: "",
+			Type:      flags.FlagType_String,
+			Default:   noExpirationFlagValue,
+		},
+		{
+			Key: OnExpiryFlagKey,
+			Usage: fmt.Sprintf(
+				"What happens to the enclave once it expires (%v)",
+				strings.Join(getAcceptableOnExpiryStrs(), "|"),
+			),
+			Shorthand: "",
+			Type:      flags.FlagType_String,
+			Default:   defaultOnExpiry,
+		},
+	}
+}
+
+// GetExpirationFromFlags returns the time-to-live, idle timeout and expired enclave action requested with the flags of
+// GetFlagConfigs, a zero duration meaning the enclave doesn't expire for that reason
+func GetExpirationFromFlags(parsedFlags *flags.ParsedFlags) (time.Duration, time.Duration, kurtosis_engine_rpc_api_bindings.ExpiredEnclaveAction, error) {
+	ttlStr, err := parsedFlags.GetString(TtlFlagKey)
+	if err != nil {
+		return 0, 0, 0, stacktrace.Propagate(err, "Expected a value for the '%v' flag but failed to get it", TtlFlagKey)
+	}
+	ttl, err := ParseDuration(ttlStr)
+	if err != nil {
+		return 0, 0, 0, stacktrace.Propagate(err, "An error occurred parsing the value of the '%v' flag", TtlFlagKey)
+	}
+
+	idleTimeoutStr, err := parsedFlags.GetString(IdleTimeoutFlagKey)
+	if err != nil {
+		return 0, 0, 0, stacktrace.Propagate(err, "Expected a value for the '%v' flag but failed to get it", IdleTimeoutFlagKey)
+	}
+	idleTimeout, err := ParseDuration(idleTimeoutStr)
+	if err != nil {
+		return 0, 0, 0, stacktrace.Propagate(err, "An error occurred parsing the value of the '%v' flag", IdleTimeoutFlagKey)
+	}
+
+	onExpiryStr, err := parsedFlags.GetString(OnExpiryFlagKey)
+	if err != nil {
+		return 0, 0, 0, stacktrace.Propagate(err, "Expected a value for the '%v' flag but failed to get it", OnExpiryFlagKey)
+	}
+	expiredEnclaveAction, err := parseExpiredEnclaveAction(onExpiryStr)
+	if err != nil {
+		return 0, 0, 0, stacktrace.Propagate(err, "An error occurred parsing the value of the '%v' flag", OnExpiryFlagKey)
+	}
+
+	return ttl, idleTimeout, expiredEnclaveAction, nil
+}
+
+// ParseDuration parses a strictly positive duration that fits in the seconds the engine API accepts, emptystring meaning zero
+func ParseDuration(durationStr string) (time.Duration, error) {
+	if durationStr == noExpirationFlagValue {
+		return 0, nil
+	}
+	duration, err := time.ParseDuration(durationStr)
+	if err != nil {
+		return 0, stacktrace.Propagate(err, "'%v' isn't a valid duration", durationStr)
+	}
+	if duration < time.Second {
+		return 0, stacktrace.NewError("Duration '%v' must be at least one second", durationStr)
+	}
+	if duration.Seconds() > float64(^uint32(0)) {
+		return 0, stacktrace.NewError("Duration '%v' is too long", durationStr)
+	}
+	return duration, nil
+}
+
+func parseExpiredEnclaveAction(onExpiryStr string) (kurtosis_engine_rpc_api_bindings.ExpiredEnclaveAction, error) {
+	actionValue, found := kurtosis_engine_rpc_api_bindings.ExpiredEnclaveAction_value[expiredEnclaveActionPrefix+strings.ToUpper(onExpiryStr)]
+	if !found {
+		return 0, stacktrace.NewError("Unrecognized expiry action '%v', expected one of '%v'", onExpiryStr, strings.Join(getAcceptableOnExpiryStrs(), "|"))
+	}
+	return kurtosis_engine_rpc_api_bindings.ExpiredEnclaveAction(actionValue), nil
+}
+
+func getAcceptableOnExpiryStrs() []string {
+	result := []string{}
+	for actionValue := 0; actionValue < len(kurtosis_engine_rpc_api_bindings.ExpiredEnclaveAction_name); actionValue++ {
+		actionName := kurtosis_engine_rpc_api_bindings.ExpiredEnclaveAction_name[int32(actionValue)]
+		result = append(result, strings.ToLower(strings.TrimPrefix(actionName, expiredEnclaveActionPrefix)))
+	}
+	return result
+}
@@ -0,0 +1,45 @@
+package enclave_expiration
+
+import (
+	"testing"
+	"time"
+
+	"github.com/kurtosis-tech/kurtosis/api/golang/engine/kurtosis_engine_rpc_api_bindings"
+	"github.com/stretchr/testify/require"
+)
+
+func TestParseDuration(t *testing.T) {
+	duration, err := ParseDuration("")
+	require.NoError(t, err)
+	require.Zero(t, duration)
+
+	duration, err = ParseDuration("1h30m")
+	require.NoError(t, err)
+	require.Equal(t, 90*time.Minute, duration)
+
+	_, err = ParseDuration("500ms")
+	require.Error(t, err)
+
+	_, err = ParseDuration("-1h")
+	require.Error(t, err)
+
+	_, err = ParseDuration("forever")
+	require.Error(t, err)
+}
+
+func TestParseExpiredEnclaveAction(t *testing.T) {
+	action, err := parseExpiredEnclaveAction("stop")
+	require.NoError(t, err)
+	require.Equal(t, kurtosis_engine_rpc_api_bindings.ExpiredEnclaveAction_ExpiredEnclaveAction_STOP, action)
+
+	action, err = parseExpiredEnclaveAction("DESTROY")
+	require.NoError(t, err)
+	require.Equal(t, kurtosis_engine_rpc_api_bindings.ExpiredEnclaveAction_ExpiredEnclaveAction_DESTROY, action)
+
+	_, err = parseExpiredEnclaveAction("pause")
+	require.Error(t, err)
+}
+
+func TestGetAcceptableOnExpiryStrs(t *testing.T) {
+	require.Equal(t, []string{"destroy", "stop"}, getAcceptableOnExpiryStrs())
+}
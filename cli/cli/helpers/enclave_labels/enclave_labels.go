@@ -0,0 +1,55 @@
+package enclave_labels
+
+import (
+	"sort"
+	"strings"
+
+	"github.com/kurtosis-tech/kurtosis/cli/cli/command_framework/lowlevel/flags"
+	"github.com/kurtosis-tech/kurtosis/container-engine-lib/lib/backend_interface/objects/enclave"
+	"github.com/kurtosis-tech/stacktrace"
+)
+
+const (
+	// Name of the flag that commands acting on several enclaves use to only select the ones having given labels
+	LabelSelectorFlagKey = "label"
+
+	labelsSeparator = ","
+	noLabels        = "<none>"
+)
+
+// GetLabelSelectorFlagConfig returns the repeatable 'key=value' flag that commands use to select enclaves by labels
+func GetLabelSelectorFlagConfig() *flags.FlagConfig {
+	return &flags.FlagConfig{
+		Key:       LabelSelectorFlagKey,
+		Usage:     "Only selects the enclaves having this label, in the 'key=value' format; can be repeated to require several labels",
+		Shorthand: "l",
+		Type:      flags.FlagType_StringArray,
+		Default:   "",
+	}
+}
+
+// GetLabelsFromFlag parses the 'key=value' values of a string array flag into a labels map, which is empty if the flag isn't set
+func GetLabelsFromFlag(parsedFlags *flags.ParsedFlags, flagKey string) (map[string]string, error) {
+	keyValuePairs, err := parsedFlags.GetStringArray(flagKey)
+	if err != nil {
+		return nil, stacktrace.Propagate(err, "Expected a value for the '%v' flag but failed to get it", flagKey)
+	}
+	labels, err := enclave.ParseEnclaveLabels(keyValuePairs)
+	if err != nil {
+		return nil, stacktrace.Propagate(err, "An error occurred parsing the value of the '%v' flag", flagKey)
+	}
+	return labels, nil
+}
+
+// FormatLabels renders the labels as 'key=value' pairs sorted by key, so they print the same way every time
+func FormatLabels(labels map[string]string) string {
+	if len(labels) == 0 {
+		return noLabels
+	}
+	keyValuePairs := []string{}
+	for key, value := range labels {
+		keyValuePairs = append(keyValuePairs, key+"="+value)
+	}
+	sort.Strings(keyValuePairs)
+	return strings.Join(keyValuePairs, labelsSeparator)
+}
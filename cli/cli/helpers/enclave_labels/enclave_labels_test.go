@@ -0,0 +1,13 @@
+package enclave_labels
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestFormatLabels(t *testing.T) {
+	require.Equal(t, "pr=1234,team=infra", FormatLabels(map[string]string{"team": "infra", "pr": "1234"}))
+	require.Equal(t, noLabels, FormatLabels(map[string]string{}))
+	require.Equal(t, noLabels, FormatLabels(nil))
+}
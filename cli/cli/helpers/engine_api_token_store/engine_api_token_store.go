@@ -0,0 +1,72 @@
+package engine_api_token_store
+
+import (
+	"errors"
+	"io/fs"
+	"os"
+	"strings"
+
+	"github.com/kurtosis-tech/kurtosis/cli/cli/helpers/host_machine_directories"
+	"github.com/kurtosis-tech/kurtosis/engine/launcher/args"
+	"github.com/kurtosis-tech/stacktrace"
+)
+
+const (
+	cliApiTokenName = "kurtosis-cli"
+
+	// Only meant to be read by the user running the CLI
+	cliApiTokenFilePerms = 0600
+)
+
+// The engine persists the API tokens it creates, so the CLI only keeps the admin token it authenticates with and
+// launches the engine with it.
+
+// GetApiAuthConfig returns the API tokens to launch the engine with, creating the admin token the CLI authenticates
+// with if there's none yet
+func GetApiAuthConfig() (*args.ApiAuthConfig, error) {
+	cliTokenFilepath, err := host_machine_directories.GetEngineApiTokenFilepath()
+	if err != nil {
+		return nil, stacktrace.Propagate(err, "An error occurred getting the engine API token filepath")
+	}
+	return getApiAuthConfig(cliTokenFilepath)
+}
+
+func getApiAuthConfig(cliTokenFilepath string) (*args.ApiAuthConfig, error) {
+	cliBearerToken, err := readCliBearerToken(cliTokenFilepath)
+	if err != nil {
+		return nil, stacktrace.Propagate(err, "An error occurred reading the engine API token of the CLI")
+	}
+	if cliBearerToken != "" {
+		if cliTokenId, cliTokenSecret, err := args.ParseBearerApiToken(cliBearerToken); err == nil {
+			cliToken := &args.ApiToken{
+				Id:           cliTokenId,
+				Name:         cliApiTokenName,
+				Role:         args.ApiTokenRole_Admin,
+				EnclaveNames: nil,
+				SecretHash:   args.HashApiTokenSecret(cliTokenSecret),
+			}
+			return &args.ApiAuthConfig{Tokens: []*args.ApiToken{cliToken}}, nil
+		}
+	}
+
+	cliToken, cliBearerToken, err := args.NewApiToken(cliApiTokenName, args.ApiTokenRole_Admin, nil)
+	if err != nil {
+		return nil, stacktrace.Propagate(err, "An error occurred creating the engine API token of the CLI")
+	}
+	if err := os.WriteFile(cliTokenFilepath, []byte(cliBearerToken), cliApiTokenFilePerms); err != nil {
+		return nil, stacktrace.Propagate(err, "An error occurred writing the engine API token of the CLI to '%v'", cliTokenFilepath)
+	}
+	return &args.ApiAuthConfig{Tokens: []*args.ApiToken{cliToken}}, nil
+}
+
+// Returns an empty string if the CLI has no token yet
+func readCliBearerToken(cliTokenFilepath string) (string, error) {
+	tokenBytes, err := os.ReadFile(cliTokenFilepath)
+	if errors.Is(err, fs.ErrNotExist) {
+		return "", nil
+	}
+	if err != nil {
+		return "", stacktrace.Propagate(err, "An error occurred reading '%v'", cliTokenFilepath)
+	}
+	return strings.TrimSpace(string(tokenBytes)), nil
+}
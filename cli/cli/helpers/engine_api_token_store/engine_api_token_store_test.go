@@ -0,0 +1,46 @@
+package engine_api_token_store
+
+import (
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/kurtosis-tech/kurtosis/engine/launcher/args"
+	"github.com/stretchr/testify/require"
+)
+
+func TestGetApiAuthConfig_CreatesTheCliAdminTokenOnce(t *testing.T) {
+	cliTokenFilepath := filepath.Join(t.TempDir(), "engine-api-token")
+
+	apiAuthConfig, err := getApiAuthConfig(cliTokenFilepath)
+	require.NoError(t, err)
+	require.Len(t, apiAuthConfig.Tokens, 1)
+	cliToken := apiAuthConfig.Tokens[0]
+	require.Equal(t, args.ApiTokenRole_Admin, cliToken.Role)
+
+	cliBearerToken, err := os.ReadFile(cliTokenFilepath)
+	require.NoError(t, err)
+	tokenId, secret, err := args.ParseBearerApiToken(string(cliBearerToken))
+	require.NoError(t, err)
+	require.Equal(t, cliToken.Id, tokenId)
+	require.Equal(t, cliToken.SecretHash, args.HashApiTokenSecret(secret))
+
+	apiAuthConfig, err = getApiAuthConfig(cliTokenFilepath)
+	require.NoError(t, err)
+	require.Equal(t, []*args.ApiToken{cliToken}, apiAuthConfig.Tokens)
+}
+
+func TestGetApiAuthConfig_RecreatesAMalformedCliToken(t *testing.T) {
+	cliTokenFilepath := filepath.Join(t.TempDir(), "engine-api-token")
+	require.NoError(t, os.WriteFile(cliTokenFilepath, []byte("malformed"), cliApiTokenFilePerms))
+
+	apiAuthConfig, err := getApiAuthConfig(cliTokenFilepath)
+	require.NoError(t, err)
+	require.Len(t, apiAuthConfig.Tokens, 1)
+
+	cliBearerToken, err := os.ReadFile(cliTokenFilepath)
+	require.NoError(t, err)
+	tokenId, _, err := args.ParseBearerApiToken(string(cliBearerToken))
+	require.NoError(t, err)
+	require.Equal(t, apiAuthConfig.Tokens[0].Id, tokenId)
+}
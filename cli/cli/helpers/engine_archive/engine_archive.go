@@ -0,0 +1,166 @@
+package engine_archive
+
+import (
+	"archive/tar"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"os"
+
+	"github.com/kurtosis-tech/kurtosis/container-engine-lib/lib/backend_interface/objects/logs_aggregator"
+	"github.com/kurtosis-tech/stacktrace"
+)
+
+const (
+	// FormatVersion must be bumped whenever the layout of the engine archive changes in a way older CLIs can't read; see
+	// docs/docs/cli-reference/engine-export.md
+	FormatVersion = 1
+
+	manifestFilepath             = "manifest.json"
+	enclaveArchiveFilepathFormat = "enclaves/%v.tgz"
+	archiveEntryFileMode         = 0644
+)
+
+// Manifest is the first entry of an engine archive, describing the engine config and the enclaves that follow it
+type Manifest struct {
+	FormatVersion int             `json:"format_version"`
+	CreatedAt     string          `json:"created_at"`
+	EngineVersion string          `json:"engine_version"`
+	EngineConfig  *EngineConfig   `json:"engine_config"`
+	Enclaves      []*EnclaveEntry `json:"enclaves"`
+}
+
+type EngineConfig struct {
+	LogRetentionPeriod string `json:"log_retention_period"`
+	// Only exported if asked for, as they can hold credentials
+	LogsSinks logs_aggregator.Sinks `json:"logs_sinks,omitempty"`
+}
+
+type EnclaveEntry struct {
+	Name string `json:"name"`
+	Uuid string `json:"uuid"`
+	// Where the archive of the enclave, as exported by the engine, is in the engine archive
+	ArchivePath string `json:"archive_path"`
+}
+
+func NewEnclaveEntry(name string, uuid string) *EnclaveEntry {
+	return &EnclaveEntry{
+		Name:        name,
+		Uuid:        uuid,
+		ArchivePath: fmt.Sprintf(enclaveArchiveFilepathFormat, uuid),
+	}
+}
+
+// Write writes an engine archive with the manifest followed by the archives of its enclaves, read from the files that
+// enclaveArchiveFilepaths has for the enclave UUIDs
+func Write(destination io.Writer, manifest *Manifest, enclaveArchiveFilepaths map[string]string) error {
+	tarWriter := tar.NewWriter(destination)
+	serializedManifest, err := json.Marshal(manifest)
+	if err != nil {
+		return stacktrace.Propagate(err, "An error occurred serializing the engine archive manifest")
+	}
+	if err := tarWriter.WriteHeader(newArchiveEntryHeader(manifestFilepath, int64(len(serializedManifest)))); err != nil {
+		return stacktrace.Propagate(err, "An error occurred writing the header of the engine archive manifest")
+	}
+	if _, err := tarWriter.Write(serializedManifest); err != nil {
+		return stacktrace.Propagate(err, "An error occurred writing the engine archive manifest")
+	}
+	for _, enclaveEntry := range manifest.Enclaves {
+		enclaveArchiveFilepath, found := enclaveArchiveFilepaths[enclaveEntry.Uuid]
+		if !found {
+			return stacktrace.NewError("No archive was given for enclave '%v' listed in the manifest", enclaveEntry.Name)
+		}
+		if err := writeFileEntry(tarWriter, enclaveEntry.ArchivePath, enclaveArchiveFilepath); err != nil {
+			return stacktrace.Propagate(err, "An error occurred writing the archive of enclave '%v'", enclaveEntry.Name)
+		}
+	}
+	if err := tarWriter.Close(); err != nil {
+		return stacktrace.Propagate(err, "An error occurred closing the engine archive")
+	}
+	return nil
+}
+
+// Reader goes through the enclaves of an engine archive in the order they were written
+type Reader struct {
+	tarReader *tar.Reader
+
+	enclavesByArchivePath map[string]*EnclaveEntry
+}
+
+// NewReader reads the manifest of the engine archive, rejecting the archives with a format version it doesn't support
+func NewReader(source io.Reader) (*Reader, *Manifest, error) {
+	tarReader := tar.NewReader(source)
+	header, err := tarReader.Next()
+	if err != nil {
+		return nil, nil, stacktrace.Propagate(err, "An error occurred reading the first entry of the engine archive")
+	}
+	if header.Name != manifestFilepath {
+		return nil, nil, stacktrace.NewError("Expected the first entry of the engine archive to be '%v' but was '%v'", manifestFilepath, header.Name)
+	}
+	serializedManifest, err := io.ReadAll(tarReader)
+	if err != nil {
+		return nil, nil, stacktrace.Propagate(err, "An error occurred reading the engine archive manifest")
+	}
+	manifest := &Manifest{} // nolint: exhaustruct
+	if err := json.Unmarshal(serializedManifest, manifest); err != nil {
+		return nil, nil, stacktrace.Propagate(err, "An error occurred deserializing the engine archive manifest")
+	}
+	if manifest.FormatVersion != FormatVersion {
+		return nil, nil, stacktrace.NewError("The engine archive has format version '%v' but this CLI only supports version '%v'; use a CLI with the same version as the one that exported it", manifest.FormatVersion, FormatVersion)
+	}
+
+	enclavesByArchivePath := map[string]*EnclaveEntry{}
+	for _, enclaveEntry := range manifest.Enclaves {
+		enclavesByArchivePath[enclaveEntry.ArchivePath] = enclaveEntry
+	}
+	return &Reader{
+		tarReader:             tarReader,
+		enclavesByArchivePath: enclavesByArchivePath,
+	}, manifest, nil
+}
+
+// Next returns the next enclave of the archive along with a reader of its archive, which is only valid until the
+// following call. It returns io.EOF once all the enclaves were read.
+func (reader *Reader) Next() (*EnclaveEntry, io.Reader, error) {
+	header, err := reader.tarReader.Next()
+	if errors.Is(err, io.EOF) {
+		return nil, nil, io.EOF
+	}
+	if err != nil {
+		return nil, nil, stacktrace.Propagate(err, "An error occurred reading the next entry of the engine archive")
+	}
+	enclaveEntry, found := reader.enclavesByArchivePath[header.Name]
+	if !found {
+		return nil, nil, stacktrace.NewError("The engine archive has an unexpected entry '%v' which isn't listed in its manifest", header.Name)
+	}
+	return enclaveEntry, reader.tarReader, nil
+}
+
+func writeFileEntry(tarWriter *tar.Writer, entryFilepath string, srcFilepath string) error {
+	srcFile, err := os.Open(srcFilepath)
+	if err != nil {
+		return stacktrace.Propagate(err, "An error occurred opening file '%v'", srcFilepath)
+	}
+	defer srcFile.Close()
+	srcFileInfo, err := srcFile.Stat()
+	if err != nil {
+		return stacktrace.Propagate(err, "An error occurred getting the size of file '%v'", srcFilepath)
+	}
+	if err := tarWriter.WriteHeader(newArchiveEntryHeader(entryFilepath, srcFileInfo.Size())); err != nil {
+		return stacktrace.Propagate(err, "An error occurred writing the header of archive entry '%v'", entryFilepath)
+	}
+	if _, err := io.Copy(tarWriter, srcFile); err != nil {
+		return stacktrace.Propagate(err, "An error occurred writing archive entry '%v'", entryFilepath)
+	}
+	return nil
+}
+
+func newArchiveEntryHeader(entryFilepath string, size int64) *tar.Header {
+	return &tar.Header{ // nolint: exhaustruct
+		Typeflag: tar.TypeReg,
+		Name:     entryFilepath,
+		Size:     size,
+		Mode:     archiveEntryFileMode,
+	}
+}
@@ -0,0 +1,74 @@
+package engine_archive
+
+import (
+	"archive/tar"
+	"bytes"
+	"encoding/json"
+	"io"
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/kurtosis-tech/kurtosis/container-engine-lib/lib/backend_interface/objects/logs_aggregator"
+	"github.com/stretchr/testify/require"
+)
+
+func TestWriteAndRead(t *testing.T) {
+	enclaveArchiveFilepath := filepath.Join(t.TempDir(), "testnet.tgz")
+	require.NoError(t, os.WriteFile(enclaveArchiveFilepath, []byte("enclave archive"), 0644))
+
+	manifest := &Manifest{
+		FormatVersion: FormatVersion,
+		CreatedAt:     "2024-01-02T03:04:05Z",
+		EngineVersion: "1.2.3",
+		EngineConfig: &EngineConfig{
+			LogRetentionPeriod: "168h",
+			LogsSinks: logs_aggregator.Sinks{
+				"elasticsearch": {"type": "elasticsearch", "endpoints": []interface{}{"http://elasticsearch:9200"}},
+			},
+		},
+		Enclaves: []*EnclaveEntry{NewEnclaveEntry("testnet", "0123456789abcdef0123456789abcdef")},
+	}
+	archive := &bytes.Buffer{}
+	require.NoError(t, Write(archive, manifest, map[string]string{"0123456789abcdef0123456789abcdef": enclaveArchiveFilepath}))
+
+	reader, readManifest, err := NewReader(archive)
+	require.NoError(t, err)
+	require.Equal(t, manifest, readManifest)
+
+	enclaveEntry, enclaveArchive, err := reader.Next()
+	require.NoError(t, err)
+	require.Equal(t, "testnet", enclaveEntry.Name)
+	require.Equal(t, "enclaves/0123456789abcdef0123456789abcdef.tgz", enclaveEntry.ArchivePath)
+	content, err := io.ReadAll(enclaveArchive)
+	require.NoError(t, err)
+	require.Equal(t, "enclave archive", string(content))
+
+	_, _, err = reader.Next()
+	require.Equal(t, io.EOF, err)
+}
+
+func TestWrite_MissingEnclaveArchive(t *testing.T) {
+	manifest := &Manifest{
+		FormatVersion: FormatVersion,
+		CreatedAt:     "",
+		EngineVersion: "",
+		EngineConfig:  nil,
+		Enclaves:      []*EnclaveEntry{NewEnclaveEntry("testnet", "0123456789abcdef0123456789abcdef")},
+	}
+	require.Error(t, Write(&bytes.Buffer{}, manifest, map[string]string{}))
+}
+
+func TestNewReader_UnsupportedFormatVersion(t *testing.T) {
+	serializedManifest, err := json.Marshal(map[string]int{"format_version": FormatVersion + 1})
+	require.NoError(t, err)
+	archive := &bytes.Buffer{}
+	tarWriter := tar.NewWriter(archive)
+	require.NoError(t, tarWriter.WriteHeader(newArchiveEntryHeader(manifestFilepath, int64(len(serializedManifest)))))
+	_, err = tarWriter.Write(serializedManifest)
+	require.NoError(t, err)
+	require.NoError(t, tarWriter.Close())
+
+	_, _, err = NewReader(archive)
+	require.Error(t, err)
+}
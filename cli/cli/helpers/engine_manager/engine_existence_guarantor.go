@@ -16,6 +16,7 @@ import (
 	"github.com/kurtosis-tech/kurtosis/cli/cli/kurtosis_config/resolved_config"
 	"github.com/kurtosis-tech/kurtosis/container-engine-lib/lib/backend_interface"
 	"github.com/kurtosis-tech/kurtosis/container-engine-lib/lib/user_support_constants"
+	"github.com/kurtosis-tech/kurtosis/engine/launcher/args"
 	"github.com/kurtosis-tech/kurtosis/engine/launcher/engine_server_launcher"
 	"github.com/kurtosis-tech/kurtosis/kurtosis_version"
 	"github.com/kurtosis-tech/kurtosis/metrics-library/golang/lib/metrics_client"
@@ -105,6 +106,18 @@ type engineExistenceGuarantor struct {
 	logsCollectorFilters []logs_collector.Filter
 
 	logsCollectorParsers []logs_collector.Parser
+
+	// OTLP collector the engine and its API containers export traces to, tracing is disabled if empty
+	otlpEndpoint string
+
+	// Webhook the engine POSTs its events to, none is called if nil
+	eventsWebhook *args.EventsWebhookConfig
+
+	// The API tokens the engine accepts, it accepts any caller if nil
+	apiAuth *args.ApiAuthConfig
+
+	// Package pre-run in the idle enclaves of the pool, they're left blank if nil
+	enclavePoolSeedPackage *args.EnclavePoolSeedPackageConfig
 }
 
 func newEngineExistenceGuarantorWithDefaultVersion(
@@ -129,6 +142,10 @@ func newEngineExistenceGuarantorWithDefaultVersion(
 	shouldEnablePersistentVolumeLogsCollection bool,
 	logsCollectorFilters []logs_collector.Filter,
 	logsCollectorParsers []logs_collector.Parser,
+	otlpEndpoint string,
+	eventsWebhook *args.EventsWebhookConfig,
+	apiAuth *args.ApiAuthConfig,
+	enclavePoolSeedPackage *args.EnclavePoolSeedPackageConfig,
 ) *engineExistenceGuarantor {
 	return newEngineExistenceGuarantorWithCustomVersion(
 		ctx,
@@ -153,6 +170,10 @@ func newEngineExistenceGuarantorWithDefaultVersion(
 		shouldEnablePersistentVolumeLogsCollection,
 		logsCollectorFilters,
 		logsCollectorParsers,
+		otlpEndpoint,
+		eventsWebhook,
+		apiAuth,
+		enclavePoolSeedPackage,
 	)
 }
 
@@ -179,6 +200,10 @@ func newEngineExistenceGuarantorWithCustomVersion(
 	shouldEnablePersistentVolumeLogsCollection bool,
 	logsCollectorFilters []logs_collector.Filter,
 	logsCollectorParsers []logs_collector.Parser,
+	otlpEndpoint string,
+	eventsWebhook *args.EventsWebhookConfig,
+	apiAuth *args.ApiAuthConfig,
+	enclavePoolSeedPackage *args.EnclavePoolSeedPackageConfig,
 ) *engineExistenceGuarantor {
 	return &engineExistenceGuarantor{
 		ctx:                                  ctx,
@@ -205,6 +230,10 @@ func newEngineExistenceGuarantorWithCustomVersion(
 		shouldEnablePersistentVolumeLogsCollection: shouldEnablePersistentVolumeLogsCollection,
 		logsCollectorFilters:                       logsCollectorFilters,
 		logsCollectorParsers:                       logsCollectorParsers,
+		otlpEndpoint:                               otlpEndpoint,
+		eventsWebhook:                              eventsWebhook,
+		apiAuth:                                    apiAuth,
+		enclavePoolSeedPackage:                     enclavePoolSeedPackage,
 	}
 }
 
@@ -270,6 +299,10 @@ func (guarantor *engineExistenceGuarantor) VisitStopped() error {
 			guarantor.shouldEnablePersistentVolumeLogsCollection,
 			guarantor.logsCollectorFilters,
 			guarantor.logsCollectorParsers,
+			guarantor.otlpEndpoint,
+			guarantor.eventsWebhook,
+			guarantor.apiAuth,
+			guarantor.enclavePoolSeedPackage,
 		)
 	} else {
 		_, _, engineLaunchErr = guarantor.engineServerLauncher.LaunchWithCustomVersion(
@@ -296,6 +329,10 @@ func (guarantor *engineExistenceGuarantor) VisitStopped() error {
 			guarantor.shouldEnablePersistentVolumeLogsCollection,
 			guarantor.logsCollectorFilters,
 			guarantor.logsCollectorParsers,
+			guarantor.otlpEndpoint,
+			guarantor.eventsWebhook,
+			guarantor.apiAuth,
+			guarantor.enclavePoolSeedPackage,
 		)
 	}
 	if engineLaunchErr != nil {
@@ -2,6 +2,7 @@ package engine_manager
 
 import (
 	"context"
+	"os"
 	"strings"
 	"time"
 
@@ -9,6 +10,7 @@ import (
 	"github.com/kurtosis-tech/kurtosis/api/golang/engine/kurtosis_engine_rpc_api_bindings"
 	"github.com/kurtosis-tech/kurtosis/api/golang/engine/lib/kurtosis_context"
 	"github.com/kurtosis-tech/kurtosis/cli/cli/command_str_consts"
+	"github.com/kurtosis-tech/kurtosis/cli/cli/helpers/engine_api_token_store"
 	"github.com/kurtosis-tech/kurtosis/cli/cli/helpers/grafloki"
 	"github.com/kurtosis-tech/kurtosis/cli/cli/helpers/kurtosis_config_getter"
 	"github.com/kurtosis-tech/kurtosis/cli/cli/helpers/portal_manager"
@@ -20,6 +22,7 @@ import (
 	"github.com/kurtosis-tech/kurtosis/container-engine-lib/lib/backend_interface/objects/engine"
 	"github.com/kurtosis-tech/kurtosis/container-engine-lib/lib/backend_interface/objects/logs_aggregator"
 	"github.com/kurtosis-tech/kurtosis/contexts-config-store/store"
+	"github.com/kurtosis-tech/kurtosis/engine/launcher/args"
 	"github.com/kurtosis-tech/kurtosis/engine/launcher/engine_server_launcher"
 	"github.com/kurtosis-tech/stacktrace"
 	"github.com/sirupsen/logrus"
@@ -37,6 +40,9 @@ const (
 	waitUntilEngineStoppedCoolOff = 5 * time.Second
 
 	doNotStartTheEngineInDebugModeForDefaultVersion = false
+
+	// Standard OpenTelemetry variable, takes precedence over the tracing endpoint set in the Kurtosis config
+	otlpEndpointEnvVar = "OTEL_EXPORTER_OTLP_ENDPOINT"
 )
 
 type EngineManager struct {
@@ -47,6 +53,10 @@ type EngineManager struct {
 	onBastionHost                             bool
 	enclaveEnvVars                            string
 	allowedCORSOrigins                        *[]string
+	otlpEndpoint                              string
+	eventsWebhook                             *args.EventsWebhookConfig
+	isApiAuthEnabled                          bool
+	enclavePoolSeedPackage                    *args.EnclavePoolSeedPackageConfig
 	// Make engine IP, port, and protocol configurable in the future
 }
 
@@ -99,14 +109,24 @@ func NewEngineManager(ctx context.Context) (*EngineManager, error) {
 		}
 	}
 
+	otlpEndpoint := kurtosisConfig.GetOtlpEndpoint()
+	if otlpEndpointFromEnv := os.Getenv(otlpEndpointEnvVar); otlpEndpointFromEnv != "" {
+		otlpEndpoint = otlpEndpointFromEnv
+	}
+	otlpEndpoint = getOtlpEndpointForContainers(otlpEndpoint, clusterConfig.GetClusterType())
+
 	return &EngineManager{
 		kurtosisBackend:   kurtosisBackend,
 		shouldSendMetrics: kurtosisConfig.GetShouldSendMetrics(),
 		engineServerKurtosisBackendConfigSupplier: engineBackendConfigSupplier,
-		clusterConfig:      clusterConfig,
-		onBastionHost:      onBastionHost,
-		enclaveEnvVars:     enclaveEnvVars,
-		allowedCORSOrigins: nil,
+		clusterConfig:          clusterConfig,
+		onBastionHost:          onBastionHost,
+		enclaveEnvVars:         enclaveEnvVars,
+		allowedCORSOrigins:     nil,
+		otlpEndpoint:           otlpEndpoint,
+		eventsWebhook:          kurtosisConfig.GetEventsWebhook(),
+		isApiAuthEnabled:       kurtosisConfig.GetIsApiAuthEnabled(),
+		enclavePoolSeedPackage: kurtosisConfig.GetEnclavePoolSeedPackage(),
 	}, nil
 }
 
@@ -211,6 +231,11 @@ func (manager *EngineManager) StartEngineIdempotentlyWithDefaultVersion(
 	}
 	additionalSinks = combineSinks(additionalSinks, lokiSink)
 
+	apiAuth, err := manager.getApiAuthConfig()
+	if err != nil {
+		return nil, nil, stacktrace.Propagate(err, "An error occurred getting the API authentication config of the engine")
+	}
+
 	engineGuarantor := newEngineExistenceGuarantorWithDefaultVersion(
 		ctx,
 		maybeHostMachinePortBinding,
@@ -233,6 +258,10 @@ func (manager *EngineManager) StartEngineIdempotentlyWithDefaultVersion(
 		manager.clusterConfig.ShouldEnableDefaultLogsSink(),
 		manager.clusterConfig.GetLogsCollectorConfig().Filters,
 		manager.clusterConfig.GetLogsCollectorConfig().Parsers,
+		manager.otlpEndpoint,
+		manager.eventsWebhook,
+		apiAuth,
+		manager.enclavePoolSeedPackage,
 	)
 	// TODO Need to handle the Kubernetes case, where a gateway needs to be started after the engine is started but
 	//  before we can return an EngineClient
@@ -278,6 +307,11 @@ func (manager *EngineManager) StartEngineIdempotentlyWithCustomVersion(ctx conte
 	}
 	additionalSinks = combineSinks(additionalSinks, lokiSink)
 
+	apiAuth, err := manager.getApiAuthConfig()
+	if err != nil {
+		return nil, nil, stacktrace.Propagate(err, "An error occurred getting the API authentication config of the engine")
+	}
+
 	engineGuarantor := newEngineExistenceGuarantorWithCustomVersion(
 		ctx,
 		maybeHostMachinePortBinding,
@@ -301,6 +335,10 @@ func (manager *EngineManager) StartEngineIdempotentlyWithCustomVersion(ctx conte
 		manager.clusterConfig.ShouldEnableDefaultLogsSink(),
 		manager.clusterConfig.GetLogsCollectorConfig().Filters,
 		manager.clusterConfig.GetLogsCollectorConfig().Parsers,
+		manager.otlpEndpoint,
+		manager.eventsWebhook,
+		apiAuth,
+		manager.enclavePoolSeedPackage,
 	)
 	engineClient, engineClientCloseFunc, err := manager.startEngineWithGuarantor(ctx, status, engineGuarantor)
 	if err != nil {
@@ -491,9 +529,25 @@ func (manager *EngineManager) startEngineWithGuarantor(ctx context.Context, curr
 	return engineClient, clientCloseFunc, nil
 }
 
+// Returns nil if the engine shouldn't enforce API authentication
+func (manager *EngineManager) getApiAuthConfig() (*args.ApiAuthConfig, error) {
+	if !manager.isApiAuthEnabled {
+		return nil, nil
+	}
+	apiAuth, err := engine_api_token_store.GetApiAuthConfig()
+	if err != nil {
+		return nil, stacktrace.Propagate(err, "An error occurred getting the API tokens to launch the engine with")
+	}
+	return apiAuth, nil
+}
+
 func getEngineClientFromHostMachineIpAndPort(hostMachineIpAndPort *hostMachineIpAndPort) (kurtosis_engine_rpc_api_bindings.EngineServiceClient, func() error, error) {
+	engineApiToken, err := kurtosis_context.GetEngineApiToken()
+	if err != nil {
+		return nil, nil, stacktrace.Propagate(err, "An error occurred getting the API token to authenticate to the engine with")
+	}
 	url := hostMachineIpAndPort.GetURL()
-	conn, err := grpc.Dial(url, grpc.WithTransportCredentials(insecure.NewCredentials()))
+	conn, err := grpc.Dial(url, grpc.WithTransportCredentials(insecure.NewCredentials()), kurtosis_context.WithEngineApiToken(engineApiToken))
 	if err != nil {
 		return nil, nil, stacktrace.Propagate(err, "An error occurred dialling Kurtosis engine at URL '%v'", url)
 	}
@@ -0,0 +1,64 @@
+package engine_manager
+
+import (
+	"net"
+	"net/url"
+	"strings"
+
+	"github.com/kurtosis-tech/kurtosis/cli/cli/kurtosis_config/resolved_config"
+	"github.com/sirupsen/logrus"
+)
+
+const (
+	endpointSchemeSeparator = "://"
+
+	// The engine and API containers get this host mapped to the Docker host
+	dockerHostDomainInsideContainers = "host.docker.internal"
+)
+
+var loopbackHosts = map[string]bool{
+	"localhost": true,
+	"127.0.0.1": true,
+	"::1":       true,
+}
+
+// The OTLP endpoint is used from inside the engine and API containers, where the loopback address is the container
+// itself. On Docker and Podman, an endpoint on the loopback address is translated to the Docker host, which is where a collector
+// listening on the loopback address of the machine running the CLI is reachable from
+func getOtlpEndpointForContainers(otlpEndpoint string, clusterType resolved_config.KurtosisClusterType) string {
+	if otlpEndpoint == "" {
+		return otlpEndpoint
+	}
+	scheme, host, port, err := splitOtlpEndpoint(otlpEndpoint)
+	if err != nil {
+		// The engine validates the endpoint when it starts, which reports the error
+		return otlpEndpoint
+	}
+	if !loopbackHosts[host] {
+		return otlpEndpoint
+	}
+	if clusterType == resolved_config.KurtosisClusterType_Kubernetes {
+		logrus.Warnf("OTLP endpoint '%v' is on the loopback address, which isn't reachable from inside the engine and API containers; use the address of the collector in the cluster instead", otlpEndpoint)
+		return otlpEndpoint
+	}
+
+	translatedEndpoint := net.JoinHostPort(dockerHostDomainInsideContainers, port)
+	if scheme != "" {
+		translatedEndpoint = scheme + endpointSchemeSeparator + translatedEndpoint
+	}
+	logrus.Debugf("Translated OTLP endpoint '%v' to '%v' so that it's reachable from inside the engine and API containers", otlpEndpoint, translatedEndpoint)
+	return translatedEndpoint
+}
+
+// The endpoint is either 'host:port' or a URL, as the engine and API containers accept it
+func splitOtlpEndpoint(otlpEndpoint string) (string, string, string, error) {
+	if !strings.Contains(otlpEndpoint, endpointSchemeSeparator) {
+		host, port, err := net.SplitHostPort(otlpEndpoint)
+		return "", host, port, err
+	}
+	parsedEndpoint, err := url.Parse(otlpEndpoint)
+	if err != nil {
+		return "", "", "", err
+	}
+	return parsedEndpoint.Scheme, parsedEndpoint.Hostname(), parsedEndpoint.Port(), nil
+}
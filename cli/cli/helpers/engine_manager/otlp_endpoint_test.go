@@ -0,0 +1,19 @@
+package engine_manager
+
+import (
+	"testing"
+
+	"github.com/kurtosis-tech/kurtosis/cli/cli/kurtosis_config/resolved_config"
+	"github.com/stretchr/testify/require"
+)
+
+func TestGetOtlpEndpointForContainers(t *testing.T) {
+	require.Equal(t, "", getOtlpEndpointForContainers("", resolved_config.KurtosisClusterType_Docker))
+	require.Equal(t, "host.docker.internal:4317", getOtlpEndpointForContainers("localhost:4317", resolved_config.KurtosisClusterType_Docker))
+	require.Equal(t, "host.docker.internal:4317", getOtlpEndpointForContainers("[::1]:4317", resolved_config.KurtosisClusterType_Docker))
+	require.Equal(t, "http://host.docker.internal:4317", getOtlpEndpointForContainers("http://127.0.0.1:4317", resolved_config.KurtosisClusterType_Docker))
+	require.Equal(t, "collector:4317", getOtlpEndpointForContainers("collector:4317", resolved_config.KurtosisClusterType_Docker))
+	require.Equal(t, "https://collector.example.com:4317", getOtlpEndpointForContainers("https://collector.example.com:4317", resolved_config.KurtosisClusterType_Docker))
+	require.Equal(t, "host.docker.internal:4317", getOtlpEndpointForContainers("localhost:4317", resolved_config.KurtosisClusterType_Podman))
+	require.Equal(t, "localhost:4317", getOtlpEndpointForContainers("localhost:4317", resolved_config.KurtosisClusterType_Kubernetes))
+}
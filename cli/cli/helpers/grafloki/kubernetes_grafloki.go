@@ -164,6 +164,7 @@ func createGrafanaAndLokiDeployments(ctx context.Context, k8sManager *kubernetes
 			},
 		},
 		[]apiv1.Volume{},
+		"", // default service account
 		&apiv1.Affinity{
 			NodeAffinity:    nil,
 			PodAffinity:     nil,
@@ -379,6 +380,7 @@ func createGrafanaAndLokiDeployments(ctx context.Context, k8sManager *kubernetes
 				Ephemeral:             nil,
 			},
 		}},
+		"", // default service account
 		&apiv1.Affinity{
 			NodeAffinity:    nil,
 			PodAffinity:     nil,
@@ -24,6 +24,9 @@ const (
 	githubUsernameFilename  = "github-username"
 	githubAuthTokenFilename = "github-auth-token"
 
+	// The SDK reads this file too, so its name can't change
+	engineApiTokenFilename = "engine-api-token"
+
 	userSendMetricsElection = "user-send-metrics-election"
 
 	LastPesteredUserAboutOldVersionFilename = "last-pestered-user-about-old-version"
@@ -178,6 +181,16 @@ func GetGitHubAuthTokenFilePath() (string, error) {
 	return githubAuthTokenFilePath, nil
 }
 
+// The bearer token the CLI authenticates to the engine with, when the engine enforces API authentication
+func GetEngineApiTokenFilepath() (string, error) {
+	xdgRelFilepath := getRelativeFilepathForXDG(engineApiTokenFilename)
+	engineApiTokenFilepath, err := xdg.StateFile(xdgRelFilepath)
+	if err != nil {
+		return "", stacktrace.Propagate(err, "An error occurred getting the engine API token filepath from relative path '%v'", xdgRelFilepath)
+	}
+	return engineApiTokenFilepath, nil
+}
+
 // ====================================================================================================
 //
 //	Private Helper Functions
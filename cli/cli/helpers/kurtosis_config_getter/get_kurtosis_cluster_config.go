@@ -31,7 +31,7 @@ func getKurtosisClusterName() (string, error) {
 	return clusterName, nil
 }
 
-func getKurtosisConfig() (*resolved_config.KurtosisConfig, error) {
+func GetKurtosisConfig() (*resolved_config.KurtosisConfig, error) {
 	configStore := kurtosis_config.GetKurtosisConfigStore()
 	configProvider := kurtosis_config.NewKurtosisConfigProvider(configStore)
 	kurtosisConfig, err := configProvider.GetOrInitializeConfig()
@@ -47,7 +47,7 @@ func GetKurtosisClusterConfig() (*resolved_config.KurtosisClusterConfig, error)
 		return nil, stacktrace.Propagate(err, "Expected to be able to get Kurtosis Cluster Name from Kurtosis settings, instead a non-nil error was returned")
 	}
 
-	kurtosisConfig, err := getKurtosisConfig()
+	kurtosisConfig, err := GetKurtosisConfig()
 	if err != nil {
 		return nil, stacktrace.Propagate(err, "An error occurred while getting Kurtosis configuration")
 	}
@@ -0,0 +1,79 @@
+package output_printers
+
+import (
+	"encoding/json"
+	"strings"
+
+	"github.com/kurtosis-tech/kurtosis/cli/cli/out"
+	"github.com/kurtosis-tech/stacktrace"
+	"gopkg.in/yaml.v3"
+)
+
+type OutputFormat string
+
+const (
+	// TextOutputFormat is the default, human-readable output (tables, key-value pairs, trees)
+	TextOutputFormat OutputFormat = ""
+	JsonOutputFormat OutputFormat = "json"
+	YamlOutputFormat OutputFormat = "yaml"
+
+	jsonIndent = "  "
+)
+
+var structuredOutputFormats = []OutputFormat{
+	JsonOutputFormat,
+	YamlOutputFormat,
+}
+
+// ParseOutputFormat normalizes and validates a user-provided output format string
+func ParseOutputFormat(outputFormatStr string) (OutputFormat, error) {
+	outputFormat := OutputFormat(strings.ToLower(strings.TrimSpace(outputFormatStr)))
+	if outputFormat == TextOutputFormat || outputFormat.IsStructured() {
+		return outputFormat, nil
+	}
+	return "", stacktrace.NewError(
+		"Invalid output format '%s'; must be one of '%v'",
+		outputFormatStr,
+		structuredOutputFormats,
+	)
+}
+
+// IsStructured returns true if the output format is machine-readable (JSON or YAML)
+func (outputFormat OutputFormat) IsStructured() bool {
+	for _, structuredOutputFormat := range structuredOutputFormats {
+		if outputFormat == structuredOutputFormat {
+			return true
+		}
+	}
+	return false
+}
+
+// MarshalStructured serializes the object according to the given structured output format
+func MarshalStructured(outputFormat OutputFormat, object interface{}) ([]byte, error) {
+	switch outputFormat {
+	case JsonOutputFormat:
+		result, err := json.MarshalIndent(object, "", jsonIndent)
+		if err != nil {
+			return nil, stacktrace.Propagate(err, "An error occurred marshalling object to '%v'", outputFormat)
+		}
+		return result, nil
+	case YamlOutputFormat:
+		result, err := yaml.Marshal(object)
+		if err != nil {
+			return nil, stacktrace.Propagate(err, "An error occurred marshalling object to '%v'", outputFormat)
+		}
+		return result, nil
+	default:
+		return nil, stacktrace.NewError("Output format '%v' is not a structured output format; this is a bug in Kurtosis", outputFormat)
+	}
+}
+
+// PrintStructured prints the object to stdout serialized with the given structured output format
+func PrintStructured(outputFormat OutputFormat, object interface{}) error {
+	serializedObject, err := MarshalStructured(outputFormat, object)
+	if err != nil {
+		return stacktrace.Propagate(err, "An error occurred serializing the output as '%v'", outputFormat)
+	}
+	out.PrintOutLn(strings.TrimSuffix(string(serializedObject), "\n"))
+	return nil
+}
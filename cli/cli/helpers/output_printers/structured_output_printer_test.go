@@ -0,0 +1,31 @@
+package output_printers
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestParseOutputFormat(t *testing.T) {
+	outputFormat, err := ParseOutputFormat("")
+	require.NoError(t, err)
+	require.Equal(t, TextOutputFormat, outputFormat)
+	require.False(t, outputFormat.IsStructured())
+
+	outputFormat, err = ParseOutputFormat(" JSON ")
+	require.NoError(t, err)
+	require.Equal(t, JsonOutputFormat, outputFormat)
+	require.True(t, outputFormat.IsStructured())
+
+	outputFormat, err = ParseOutputFormat("yaml")
+	require.NoError(t, err)
+	require.Equal(t, YamlOutputFormat, outputFormat)
+
+	_, err = ParseOutputFormat("xml")
+	require.Error(t, err)
+}
+
+func TestMarshalStructured_TextFormatIsRejected(t *testing.T) {
+	_, err := MarshalStructured(TextOutputFormat, map[string]string{})
+	require.Error(t, err)
+}
@@ -0,0 +1,84 @@
+package output_schemas
+
+import (
+	"strings"
+	"time"
+
+	"github.com/kurtosis-tech/kurtosis/api/golang/engine/kurtosis_engine_rpc_api_bindings"
+)
+
+const (
+	enclaveContainersStatusPrefix   = "EnclaveContainersStatus_"
+	enclaveApiContainerStatusPrefix = "EnclaveAPIContainerStatus_"
+	expiredEnclaveActionPrefix      = "ExpiredEnclaveAction_"
+)
+
+// Enclave is the machine-readable representation of an enclave, as printed by 'enclave ls' and 'enclave inspect'
+type Enclave struct {
+	Uuid               string `json:"uuid" yaml:"uuid"`
+	ShortenedUuid      string `json:"shortened_uuid" yaml:"shortened_uuid"`
+	Name               string `json:"name" yaml:"name"`
+	Status             string `json:"status" yaml:"status"`
+	ApiContainerStatus string `json:"api_container_status" yaml:"api_container_status"`
+	Mode               string `json:"mode" yaml:"mode"`
+	// RFC3339 formatted; empty for old enclaves that didn't track their creation time
+	CreationTime string `json:"creation_time" yaml:"creation_time"`
+	// Nil if the enclave never expires
+	Expiration *EnclaveExpiration `json:"expiration,omitempty" yaml:"expiration,omitempty"`
+	Labels     map[string]string  `json:"labels,omitempty" yaml:"labels,omitempty"`
+}
+
+// EnclaveExpiration describes when an enclave expires and what happens to it then
+type EnclaveExpiration struct {
+	// RFC3339 formatted; empty if the enclave doesn't expire with age
+	ExpirationTime string `json:"expiration_time,omitempty" yaml:"expiration_time,omitempty"`
+	// Go duration formatted; empty if the enclave doesn't expire for being idle
+	IdleTimeout string `json:"idle_timeout,omitempty" yaml:"idle_timeout,omitempty"`
+	Action      string `json:"action" yaml:"action"`
+}
+
+// EnclaveDetails is the machine-readable representation of 'enclave inspect'
+type EnclaveDetails struct {
+	Enclave *Enclave `json:"enclave" yaml:"enclave"`
+	// Sorted by name
+	Services []*Service `json:"services" yaml:"services"`
+	// Sorted by name; empty if the API container isn't running
+	FilesArtifacts []*FilesArtifact `json:"files_artifacts" yaml:"files_artifacts"`
+}
+
+func NewEnclave(enclaveInfo *kurtosis_engine_rpc_api_bindings.EnclaveInfo) *Enclave {
+	creationTimeStr := ""
+	if enclaveInfo.GetCreationTime() != nil {
+		creationTimeStr = enclaveInfo.GetCreationTime().AsTime().UTC().Format(time.RFC3339)
+	}
+	return &Enclave{
+		Uuid:               enclaveInfo.GetEnclaveUuid(),
+		ShortenedUuid:      enclaveInfo.GetShortenedUuid(),
+		Name:               enclaveInfo.GetName(),
+		Status:             strings.TrimPrefix(enclaveInfo.GetContainersStatus().String(), enclaveContainersStatusPrefix),
+		ApiContainerStatus: strings.TrimPrefix(enclaveInfo.GetApiContainerStatus().String(), enclaveApiContainerStatusPrefix),
+		Mode:               enclaveInfo.GetMode().String(),
+		CreationTime:       creationTimeStr,
+		Expiration:         newEnclaveExpiration(enclaveInfo.GetExpiration()),
+		Labels:             enclaveInfo.GetLabels(),
+	}
+}
+
+func newEnclaveExpiration(expiration *kurtosis_engine_rpc_api_bindings.EnclaveExpiration) *EnclaveExpiration {
+	if expiration == nil {
+		return nil
+	}
+	expirationTimeStr := ""
+	if expiration.GetExpirationTime() != nil {
+		expirationTimeStr = expiration.GetExpirationTime().AsTime().UTC().Format(time.RFC3339)
+	}
+	idleTimeoutStr := ""
+	if expiration.IdleTimeoutSeconds != nil {
+		idleTimeoutStr = (time.Duration(expiration.GetIdleTimeoutSeconds()) * time.Second).String()
+	}
+	return &EnclaveExpiration{
+		ExpirationTime: expirationTimeStr,
+		IdleTimeout:    idleTimeoutStr,
+		Action:         strings.TrimPrefix(expiration.GetAction().String(), expiredEnclaveActionPrefix),
+	}
+}
@@ -0,0 +1,16 @@
+package output_schemas
+
+// EngineStatus is the machine-readable representation of 'engine status'
+type EngineStatus struct {
+	// One of the engine_manager.EngineStatus values (e.g. 'RUNNING', 'STOPPED')
+	Status string `json:"status" yaml:"status"`
+	// Empty unless the engine is running
+	Version string `json:"version" yaml:"version"`
+}
+
+func NewEngineStatus(status string, maybeVersion string) *EngineStatus {
+	return &EngineStatus{
+		Status:  status,
+		Version: maybeVersion,
+	}
+}
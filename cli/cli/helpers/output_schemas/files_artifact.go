@@ -0,0 +1,51 @@
+package output_schemas
+
+import (
+	"github.com/kurtosis-tech/kurtosis/api/golang/core/kurtosis_core_rpc_api_bindings"
+)
+
+// FilesArtifact is the machine-readable representation of a files artifact in the enclave
+type FilesArtifact struct {
+	Uuid string `json:"uuid" yaml:"uuid"`
+	Name string `json:"name" yaml:"name"`
+}
+
+// FilesArtifactContents is the machine-readable representation of 'files inspect'
+type FilesArtifactContents struct {
+	Name  string             `json:"name" yaml:"name"`
+	Files []*FileDescription `json:"files" yaml:"files"`
+}
+
+type FileDescription struct {
+	Path string `json:"path" yaml:"path"`
+	// In bytes
+	Size uint64 `json:"size" yaml:"size"`
+	// Nil if the file isn't previewable (e.g. binary files)
+	TextPreview *string `json:"text_preview" yaml:"text_preview"`
+}
+
+func NewFilesArtifact(filesArtifactNameAndUuid *kurtosis_core_rpc_api_bindings.FilesArtifactNameAndUuid) *FilesArtifact {
+	return &FilesArtifact{
+		Uuid: filesArtifactNameAndUuid.GetFileUuid(),
+		Name: filesArtifactNameAndUuid.GetFileName(),
+	}
+}
+
+func NewFilesArtifactContents(filesArtifactName string, fileDescriptions []*kurtosis_core_rpc_api_bindings.FileArtifactContentsFileDescription) *FilesArtifactContents {
+	files := []*FileDescription{}
+	for _, fileDescription := range fileDescriptions {
+		files = append(files, NewFileDescription(fileDescription))
+	}
+	return &FilesArtifactContents{
+		Name:  filesArtifactName,
+		Files: files,
+	}
+}
+
+func NewFileDescription(fileDescription *kurtosis_core_rpc_api_bindings.FileArtifactContentsFileDescription) *FileDescription {
+	return &FileDescription{
+		Path:        fileDescription.GetPath(),
+		Size:        fileDescription.GetSize(),
+		TextPreview: fileDescription.TextPreview,
+	}
+}
@@ -0,0 +1,201 @@
+package output_schemas
+
+import (
+	"testing"
+	"time"
+
+	"github.com/kurtosis-tech/kurtosis/api/golang/core/kurtosis_core_rpc_api_bindings"
+	"github.com/kurtosis-tech/kurtosis/api/golang/core/lib/services"
+	"github.com/kurtosis-tech/kurtosis/api/golang/engine/kurtosis_engine_rpc_api_bindings"
+	"github.com/kurtosis-tech/kurtosis/cli/cli/helpers/output_printers"
+	"github.com/stretchr/testify/require"
+	"google.golang.org/protobuf/types/known/timestamppb"
+)
+
+const (
+	expectedEnclaveJson = `{
+  "uuid": "1234567890abcdef",
+  "shortened_uuid": "123456789012",
+  "name": "my-enclave",
+  "status": "RUNNING",
+  "api_container_status": "RUNNING",
+  "mode": "PRODUCTION",
+  "creation_time": "2023-01-02T03:04:05Z"
+}`
+
+	// The fields of the service config come first, as 'service inspect' printed only those before the service info got
+	// added alongside them
+	expectedInspectedServiceJson = `{
+  "image": "nginx:latest",
+  "ports": {
+    "http": {
+      "number": 80,
+      "transport": 0,
+      "maybe_application_protocol": "http"
+    }
+  },
+  "env_vars": {
+    "LOG_LEVEL": "debug"
+  },
+  "uuid": "abcdef",
+  "shortened_uuid": "abc",
+  "name": "my-service",
+  "status": "RUNNING",
+  "private_ip_addr": "10.0.0.2",
+  "public_ip_addr": "127.0.0.1",
+  "port_details": [
+    {
+      "id": "http",
+      "number": 80,
+      "transport_protocol": "TCP",
+      "application_protocol": "http",
+      "public_number": 49152
+    }
+  ],
+  "entrypoint_args": [],
+  "cmd_args": [],
+  "files_artifacts_mountpoints": {}
+}`
+
+	expectedServiceYaml = `uuid: abcdef
+shortened_uuid: abc
+name: my-service
+status: RUNNING
+image: nginx:latest
+private_ip_addr: 10.0.0.2
+public_ip_addr: 127.0.0.1
+ports:
+    - id: http
+      number: 80
+      transport_protocol: TCP
+      application_protocol: http
+      public_number: 49152
+    - id: metrics
+      number: 9090
+      transport_protocol: TCP
+      application_protocol: ""
+      public_number: 0
+entrypoint_args: []
+cmd_args:
+    - --verbose
+env_vars: {}
+files_artifacts_mountpoints:
+    /data:
+        - my-artifact
+`
+)
+
+func TestNewEnclave_StableJsonSchema(t *testing.T) {
+	enclaveInfo := &kurtosis_engine_rpc_api_bindings.EnclaveInfo{
+		EnclaveUuid:                 "1234567890abcdef",
+		Name:                        "my-enclave",
+		ShortenedUuid:               "123456789012",
+		ContainersStatus:            kurtosis_engine_rpc_api_bindings.EnclaveContainersStatus_EnclaveContainersStatus_RUNNING,
+		ApiContainerStatus:          kurtosis_engine_rpc_api_bindings.EnclaveAPIContainerStatus_EnclaveAPIContainerStatus_RUNNING,
+		ApiContainerInfo:            nil,
+		ApiContainerHostMachineInfo: nil,
+		CreationTime:                timestamppb.New(time.Date(2023, 1, 2, 3, 4, 5, 0, time.UTC)),
+		Mode:                        kurtosis_engine_rpc_api_bindings.EnclaveMode_PRODUCTION,
+	}
+
+	serialized, err := output_printers.MarshalStructured(output_printers.JsonOutputFormat, NewEnclave(enclaveInfo))
+	require.NoError(t, err)
+	require.Equal(t, expectedEnclaveJson, string(serialized))
+}
+
+func TestNewEnclave_WithoutCreationTime(t *testing.T) {
+	enclave := NewEnclave(&kurtosis_engine_rpc_api_bindings.EnclaveInfo{Name: "old-enclave"})
+	require.Empty(t, enclave.CreationTime)
+	require.Equal(t, "EMPTY", enclave.Status)
+	require.Equal(t, "NONEXISTENT", enclave.ApiContainerStatus)
+	require.Equal(t, "TEST", enclave.Mode)
+}
+
+func TestNewEnclave_WithExpiration(t *testing.T) {
+	idleTimeoutSeconds := uint32(1800)
+	enclave := NewEnclave(&kurtosis_engine_rpc_api_bindings.EnclaveInfo{
+		Name: "ci-enclave",
+		Expiration: &kurtosis_engine_rpc_api_bindings.EnclaveExpiration{
+			ExpirationTime:     timestamppb.New(time.Date(2023, 1, 2, 3, 4, 5, 0, time.UTC)),
+			IdleTimeoutSeconds: &idleTimeoutSeconds,
+			Action:             kurtosis_engine_rpc_api_bindings.ExpiredEnclaveAction_ExpiredEnclaveAction_STOP,
+		},
+	})
+	require.NotNil(t, enclave.Expiration)
+	require.Equal(t, "2023-01-02T03:04:05Z", enclave.Expiration.ExpirationTime)
+	require.Equal(t, "30m0s", enclave.Expiration.IdleTimeout)
+	require.Equal(t, "STOP", enclave.Expiration.Action)
+}
+
+func TestNewService_StableYamlSchema(t *testing.T) {
+	serviceInfo := &kurtosis_core_rpc_api_bindings.ServiceInfo{
+		ServiceUuid:   "abcdef",
+		PrivateIpAddr: "10.0.0.2",
+		PrivatePorts: map[string]*kurtosis_core_rpc_api_bindings.Port{
+			"metrics": {Number: 9090},
+			"http":    {Number: 80, MaybeApplicationProtocol: "http"},
+		},
+		MaybePublicIpAddr: "127.0.0.1",
+		MaybePublicPorts: map[string]*kurtosis_core_rpc_api_bindings.Port{
+			"http": {Number: 49152, MaybeApplicationProtocol: "http"},
+		},
+		Name:          "my-service",
+		ShortenedUuid: "abc",
+		ServiceStatus: kurtosis_core_rpc_api_bindings.ServiceStatus_RUNNING,
+		Container: &kurtosis_core_rpc_api_bindings.Container{
+			ImageName: "nginx:latest",
+			CmdArgs:   []string{"--verbose"},
+		},
+		ServiceDirPathsToFilesArtifactsList: map[string]*kurtosis_core_rpc_api_bindings.FilesArtifactsList{
+			"/data": {FilesArtifactsIdentifiers: []string{"my-artifact"}},
+		},
+	}
+
+	serialized, err := output_printers.MarshalStructured(output_printers.YamlOutputFormat, NewService(serviceInfo))
+	require.NoError(t, err)
+	require.Equal(t, expectedServiceYaml, string(serialized))
+}
+
+func TestNewInspectedService_StableJsonSchema(t *testing.T) {
+	serviceInfo := &kurtosis_core_rpc_api_bindings.ServiceInfo{ // nolint: exhaustruct
+		ServiceUuid:   "abcdef",
+		PrivateIpAddr: "10.0.0.2",
+		PrivatePorts: map[string]*kurtosis_core_rpc_api_bindings.Port{
+			"http": {Number: 80, MaybeApplicationProtocol: "http"}, // nolint: exhaustruct
+		},
+		MaybePublicIpAddr: "127.0.0.1",
+		MaybePublicPorts: map[string]*kurtosis_core_rpc_api_bindings.Port{
+			"http": {Number: 49152, MaybeApplicationProtocol: "http"}, // nolint: exhaustruct
+		},
+		Name:          "my-service",
+		ShortenedUuid: "abc",
+		ServiceStatus: kurtosis_core_rpc_api_bindings.ServiceStatus_RUNNING,
+		Container: &kurtosis_core_rpc_api_bindings.Container{ // nolint: exhaustruct
+			ImageName: "nginx:latest",
+			EnvVars:   map[string]string{"LOG_LEVEL": "debug"},
+		},
+	}
+	serviceConfig := &services.ServiceConfig{ // nolint: exhaustruct
+		Image: "nginx:latest",
+		PrivatePorts: map[string]services.Port{
+			"http": {Number: 80, Transport: 0, MaybeApplicationProtocol: "http", Wait: ""},
+		},
+		EnvVars: map[string]string{"LOG_LEVEL": "debug"},
+	}
+
+	serialized, err := output_printers.MarshalStructured(output_printers.JsonOutputFormat, NewInspectedService(serviceInfo, serviceConfig))
+	require.NoError(t, err)
+	require.Equal(t, expectedInspectedServiceJson, string(serialized))
+}
+
+func TestNewFilesArtifactContents(t *testing.T) {
+	preview := "hello"
+	contents := NewFilesArtifactContents("my-artifact", []*kurtosis_core_rpc_api_bindings.FileArtifactContentsFileDescription{
+		{Path: "dir/file.txt", Size: 5, TextPreview: &preview},
+		{Path: "binary", Size: 1024, TextPreview: nil},
+	})
+	require.Equal(t, "my-artifact", contents.Name)
+	require.Len(t, contents.Files, 2)
+	require.Equal(t, &preview, contents.Files[0].TextPreview)
+	require.Nil(t, contents.Files[1].TextPreview)
+}
@@ -0,0 +1,60 @@
+package output_schemas
+
+import (
+	"fmt"
+
+	"github.com/kurtosis-tech/kurtosis/api/golang/core/kurtosis_core_rpc_api_bindings"
+	"github.com/kurtosis-tech/kurtosis/api/golang/core/lib/services"
+)
+
+const (
+	emptyApplicationProtocol = ""
+	urlFormat                = "%s://%s:%d"
+)
+
+// Port is the machine-readable representation of a service port
+type Port struct {
+	Id                  string `json:"id" yaml:"id"`
+	Number              uint32 `json:"number" yaml:"number"`
+	TransportProtocol   string `json:"transport_protocol" yaml:"transport_protocol"`
+	ApplicationProtocol string `json:"application_protocol" yaml:"application_protocol"`
+	// Zero if the port isn't published outside the enclave
+	PublicNumber uint32 `json:"public_number" yaml:"public_number"`
+}
+
+// PublicPort is the machine-readable representation of 'port print'
+type PublicPort struct {
+	Id                  string `json:"id" yaml:"id"`
+	IpAddress           string `json:"ip_address" yaml:"ip_address"`
+	Number              uint16 `json:"number" yaml:"number"`
+	TransportProtocol   string `json:"transport_protocol" yaml:"transport_protocol"`
+	ApplicationProtocol string `json:"application_protocol" yaml:"application_protocol"`
+	// Empty if the port has no application protocol
+	Url string `json:"url" yaml:"url"`
+}
+
+func NewPort(portId string, privatePort *kurtosis_core_rpc_api_bindings.Port, maybePublicPort *kurtosis_core_rpc_api_bindings.Port) *Port {
+	return &Port{
+		Id:                  portId,
+		Number:              privatePort.GetNumber(),
+		TransportProtocol:   privatePort.GetTransportProtocol().String(),
+		ApplicationProtocol: privatePort.GetMaybeApplicationProtocol(),
+		PublicNumber:        maybePublicPort.GetNumber(),
+	}
+}
+
+func NewPublicPort(portId string, ipAddress string, publicPortSpec *services.PortSpec) *PublicPort {
+	applicationProtocol := publicPortSpec.GetMaybeApplicationProtocol()
+	url := ""
+	if applicationProtocol != emptyApplicationProtocol {
+		url = fmt.Sprintf(urlFormat, applicationProtocol, ipAddress, publicPortSpec.GetNumber())
+	}
+	return &PublicPort{
+		Id:                  portId,
+		IpAddress:           ipAddress,
+		Number:              publicPortSpec.GetNumber(),
+		TransportProtocol:   kurtosis_core_rpc_api_bindings.Port_TransportProtocol(publicPortSpec.GetTransportProtocol()).String(),
+		ApplicationProtocol: applicationProtocol,
+		Url:                 url,
+	}
+}
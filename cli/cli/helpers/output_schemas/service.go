@@ -0,0 +1,119 @@
+package output_schemas
+
+import (
+	"sort"
+
+	"github.com/kurtosis-tech/kurtosis/api/golang/core/kurtosis_core_rpc_api_bindings"
+	"github.com/kurtosis-tech/kurtosis/api/golang/core/lib/services"
+)
+
+// Service is the machine-readable representation of a service, as exposed by the API container's ServiceInfo
+type Service struct {
+	Uuid          string `json:"uuid" yaml:"uuid"`
+	ShortenedUuid string `json:"shortened_uuid" yaml:"shortened_uuid"`
+	Name          string `json:"name" yaml:"name"`
+	Status        string `json:"status" yaml:"status"`
+	Image         string `json:"image" yaml:"image"`
+	PrivateIpAddr string `json:"private_ip_addr" yaml:"private_ip_addr"`
+	// Empty if the service isn't running or the backend doesn't report public info
+	PublicIpAddr   string            `json:"public_ip_addr" yaml:"public_ip_addr"`
+	Ports          []*Port           `json:"ports" yaml:"ports"`
+	EntrypointArgs []string          `json:"entrypoint_args" yaml:"entrypoint_args"`
+	CmdArgs        []string          `json:"cmd_args" yaml:"cmd_args"`
+	EnvVars        map[string]string `json:"env_vars" yaml:"env_vars"`
+	// Directory path on the service -> names of the files artifacts mounted there
+	FilesArtifactsMountpoints map[string][]string `json:"files_artifacts_mountpoints" yaml:"files_artifacts_mountpoints"`
+}
+
+// InspectedService is the machine-readable representation of 'service inspect'. It's the config of the service, so that
+// it can be fed back into 'service add --json-service-config', with the service info the config doesn't already have
+// alongside it
+type InspectedService struct {
+	services.ServiceConfig `yaml:",inline"`
+
+	Uuid          string `json:"uuid" yaml:"uuid"`
+	ShortenedUuid string `json:"shortened_uuid" yaml:"shortened_uuid"`
+	Name          string `json:"name" yaml:"name"`
+	Status        string `json:"status" yaml:"status"`
+	PrivateIpAddr string `json:"private_ip_addr" yaml:"private_ip_addr"`
+	// Empty if the service isn't running or the backend doesn't report public info
+	PublicIpAddr string `json:"public_ip_addr" yaml:"public_ip_addr"`
+	// The config already has the ports under 'ports', so the ones with their public numbers go under another name
+	PortDetails    []*Port  `json:"port_details" yaml:"port_details"`
+	EntrypointArgs []string `json:"entrypoint_args" yaml:"entrypoint_args"`
+	CmdArgs        []string `json:"cmd_args" yaml:"cmd_args"`
+	// Directory path on the service -> names of the files artifacts mounted there
+	FilesArtifactsMountpoints map[string][]string `json:"files_artifacts_mountpoints" yaml:"files_artifacts_mountpoints"`
+}
+
+func NewService(serviceInfo *kurtosis_core_rpc_api_bindings.ServiceInfo) *Service {
+	portIds := []string{}
+	for portId := range serviceInfo.GetPrivatePorts() {
+		portIds = append(portIds, portId)
+	}
+	sort.Strings(portIds)
+
+	ports := []*Port{}
+	for _, portId := range portIds {
+		privatePort := serviceInfo.GetPrivatePorts()[portId]
+		maybePublicPort := serviceInfo.GetMaybePublicPorts()[portId]
+		ports = append(ports, NewPort(portId, privatePort, maybePublicPort))
+	}
+
+	filesArtifactsMountpoints := map[string][]string{}
+	for dirPath, filesArtifactsList := range serviceInfo.GetServiceDirPathsToFilesArtifactsList() {
+		filesArtifactsMountpoints[dirPath] = filesArtifactsList.GetFilesArtifactsIdentifiers()
+	}
+
+	container := serviceInfo.GetContainer()
+	return &Service{
+		Uuid:                      serviceInfo.GetServiceUuid(),
+		ShortenedUuid:             serviceInfo.GetShortenedUuid(),
+		Name:                      serviceInfo.GetName(),
+		Status:                    serviceInfo.GetServiceStatus().String(),
+		Image:                     container.GetImageName(),
+		PrivateIpAddr:             serviceInfo.GetPrivateIpAddr(),
+		PublicIpAddr:              serviceInfo.GetMaybePublicIpAddr(),
+		Ports:                     ports,
+		EntrypointArgs:            nonNilStringSlice(container.GetEntrypointArgs()),
+		CmdArgs:                   nonNilStringSlice(container.GetCmdArgs()),
+		EnvVars:                   nonNilStringMap(container.GetEnvVars()),
+		FilesArtifactsMountpoints: filesArtifactsMountpoints,
+	}
+}
+
+func NewInspectedService(serviceInfo *kurtosis_core_rpc_api_bindings.ServiceInfo, serviceConfig *services.ServiceConfig) *InspectedService {
+	service := NewService(serviceInfo)
+	inspectedService := &InspectedService{
+		ServiceConfig:             services.ServiceConfig{}, // nolint: exhaustruct
+		Uuid:                      service.Uuid,
+		ShortenedUuid:             service.ShortenedUuid,
+		Name:                      service.Name,
+		Status:                    service.Status,
+		PrivateIpAddr:             service.PrivateIpAddr,
+		PublicIpAddr:              service.PublicIpAddr,
+		PortDetails:               service.Ports,
+		EntrypointArgs:            service.EntrypointArgs,
+		CmdArgs:                   service.CmdArgs,
+		FilesArtifactsMountpoints: service.FilesArtifactsMountpoints,
+	}
+	if serviceConfig != nil {
+		inspectedService.ServiceConfig = *serviceConfig
+	}
+	return inspectedService
+}
+
+// Keeps the schema stable by serializing empty collections as '[]'/'{}' rather than 'null'
+func nonNilStringSlice(slice []string) []string {
+	if slice == nil {
+		return []string{}
+	}
+	return slice
+}
+
+func nonNilStringMap(stringMap map[string]string) map[string]string {
+	if stringMap == nil {
+		return map[string]string{}
+	}
+	return stringMap
+}
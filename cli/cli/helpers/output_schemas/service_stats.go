@@ -0,0 +1,50 @@
+package output_schemas
+
+import (
+	"time"
+
+	"github.com/kurtosis-tech/kurtosis/api/golang/core/kurtosis_core_rpc_api_bindings"
+)
+
+// ServiceStatsSample is the machine-readable representation of one sample of 'enclave stats'
+type ServiceStatsSample struct {
+	// RFC3339 formatted
+	Timestamp string `json:"timestamp" yaml:"timestamp"`
+	// Sorted by name
+	Services []*ServiceStats `json:"services" yaml:"services"`
+}
+
+// ServiceStats is the resources used by a service; the network and disk ones are totals since the service started,
+// which stay at zero on Kubernetes
+type ServiceStats struct {
+	Name                 string  `json:"name" yaml:"name"`
+	Uuid                 string  `json:"uuid" yaml:"uuid"`
+	CpuPercent           float64 `json:"cpu_percent" yaml:"cpu_percent"`
+	MemoryUsageBytes     uint64  `json:"memory_usage_bytes" yaml:"memory_usage_bytes"`
+	MemoryLimitBytes     uint64  `json:"memory_limit_bytes" yaml:"memory_limit_bytes"`
+	NetworkReceivedBytes uint64  `json:"network_received_bytes" yaml:"network_received_bytes"`
+	NetworkSentBytes     uint64  `json:"network_sent_bytes" yaml:"network_sent_bytes"`
+	DiskReadBytes        uint64  `json:"disk_read_bytes" yaml:"disk_read_bytes"`
+	DiskWrittenBytes     uint64  `json:"disk_written_bytes" yaml:"disk_written_bytes"`
+}
+
+func NewServiceStatsSample(sample *kurtosis_core_rpc_api_bindings.GetServiceStatsResponse) *ServiceStatsSample {
+	services := []*ServiceStats{}
+	for _, serviceStats := range sample.GetServiceStats() {
+		services = append(services, &ServiceStats{
+			Name:                 serviceStats.GetServiceName(),
+			Uuid:                 serviceStats.GetServiceUuid(),
+			CpuPercent:           serviceStats.GetCpuPercent(),
+			MemoryUsageBytes:     serviceStats.GetMemoryUsageBytes(),
+			MemoryLimitBytes:     serviceStats.GetMemoryLimitBytes(),
+			NetworkReceivedBytes: serviceStats.GetNetworkReceivedBytes(),
+			NetworkSentBytes:     serviceStats.GetNetworkSentBytes(),
+			DiskReadBytes:        serviceStats.GetDiskReadBytes(),
+			DiskWrittenBytes:     serviceStats.GetDiskWrittenBytes(),
+		})
+	}
+	return &ServiceStatsSample{
+		Timestamp: sample.GetTimestamp().AsTime().UTC().Format(time.RFC3339),
+		Services:  services,
+	}
+}
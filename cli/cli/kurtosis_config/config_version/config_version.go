@@ -12,4 +12,6 @@ const (
 	ConfigVersion_v4 // adds engine-node-name to KubernetesClusterConfig
 	ConfigVersion_v5 // adds GrafanaLokiConfig to KurtosisClusterConfig
 	ConfigVersion_v6 // adds logs collector config
+	ConfigVersion_v7 // adds tracing config
+	ConfigVersion_v8 // adds image-build-registry and enclave-namespace to KubernetesClusterConfig, events-webhook, api-auth and enclave-pool to KurtosisClusterConfig
 )
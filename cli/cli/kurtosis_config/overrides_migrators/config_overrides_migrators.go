@@ -9,6 +9,8 @@ import (
 	v4 "github.com/kurtosis-tech/kurtosis/cli/cli/kurtosis_config/overrides_objects/v4"
 	v5 "github.com/kurtosis-tech/kurtosis/cli/cli/kurtosis_config/overrides_objects/v5"
 	v6 "github.com/kurtosis-tech/kurtosis/cli/cli/kurtosis_config/overrides_objects/v6"
+	v7 "github.com/kurtosis-tech/kurtosis/cli/cli/kurtosis_config/overrides_objects/v7"
+	v8 "github.com/kurtosis-tech/kurtosis/cli/cli/kurtosis_config/overrides_objects/v8"
 	"github.com/kurtosis-tech/stacktrace"
 )
 
@@ -28,6 +30,8 @@ type configOverridesMigrator = func(uncastedOldConfig interface{}) (interface{},
 // to the bottom each time
 // >>>>>>>>>>>>>>>>>>>>>>>>>>>>> INSTRUCTIONS <<<<<<<<<<<<<<<<<<<<<<<<<<<<<<<<<<
 var AllConfigOverridesMigrators = map[config_version.ConfigVersion]configOverridesMigrator{
+	config_version.ConfigVersion_v7: migrateFromV7,
+	config_version.ConfigVersion_v6: migrateFromV6,
 	config_version.ConfigVersion_v5: migrateFromV5,
 	config_version.ConfigVersion_v4: migrateFromV4,
 	config_version.ConfigVersion_v3: migrateFromV3,
@@ -37,6 +41,190 @@ var AllConfigOverridesMigrators = map[config_version.ConfigVersion]configOverrid
 }
 
 // vvvvvvvvvvvvvvvvvvvvvvv REVERSE chronological order so you don't have to scroll forever vvvvvvvvvvvvvvvvvvvv
+func migrateFromV7(uncastedConfig interface{}) (interface{}, error) {
+	// cast "uncastedConfig" to current version we're upgrading from
+	castedOldConfig, ok := uncastedConfig.(*v7.KurtosisConfigV7)
+	if !ok {
+		return nil, stacktrace.NewError(
+			"Failed to cast old configuration '%+v' to expected configuration struct",
+			uncastedConfig,
+		)
+	}
+
+	var newClusters map[string]*v8.KurtosisClusterConfigV8
+	if castedOldConfig.KurtosisClusters != nil {
+		newClusters = map[string]*v8.KurtosisClusterConfigV8{}
+		for oldClusterName, oldClusterConfig := range castedOldConfig.KurtosisClusters {
+			oldKubernetesConfig := oldClusterConfig.Config
+			oldLogsAggregatorConfig := oldClusterConfig.LogsAggregator
+			oldLogsCollectorConfig := oldClusterConfig.LogsCollector
+			oldGraflokiConfig := oldClusterConfig.GrafanaLokiConfig
+
+			var newKubernetesConfig *v8.KubernetesClusterConfigV8
+			if oldKubernetesConfig != nil {
+				newKubernetesConfig = &v8.KubernetesClusterConfigV8{
+					KubernetesClusterName:  oldKubernetesConfig.KubernetesClusterName,
+					StorageClass:           oldKubernetesConfig.StorageClass,
+					EnclaveSizeInMegabytes: oldKubernetesConfig.EnclaveSizeInMegabytes,
+					EngineNodeName:         oldKubernetesConfig.EngineNodeName,
+					ImageBuildRegistry:     nil, // New field, initialize as nil
+					EnclaveNamespace:       nil, // New field, initialize as nil
+				}
+			}
+
+			var newLogsAggregatorConfig *v8.LogsAggregatorConfigV8
+			if oldLogsAggregatorConfig != nil {
+				newLogsAggregatorConfig = &v8.LogsAggregatorConfigV8{
+					Sinks: oldLogsAggregatorConfig.Sinks,
+				}
+			}
+
+			var newLogsCollectorConfig *v8.LogsCollectorConfigV8
+			if oldLogsCollectorConfig != nil {
+				newLogsCollectorConfig = &v8.LogsCollectorConfigV8{
+					Parsers: oldLogsCollectorConfig.Parsers,
+					Filters: oldLogsCollectorConfig.Filters,
+				}
+			}
+
+			var newGraflokiConfig *v8.GrafanaLokiConfigV8
+			if oldGraflokiConfig != nil {
+				newGraflokiConfig = &v8.GrafanaLokiConfigV8{
+					ShouldStartBeforeEngine: oldGraflokiConfig.ShouldStartBeforeEngine,
+					GrafanaImage:            oldGraflokiConfig.GrafanaImage,
+					LokiImage:               oldGraflokiConfig.LokiImage,
+				}
+			}
+
+			newClusterConfig := &v8.KurtosisClusterConfigV8{
+				Type:                        oldClusterConfig.Type,
+				Config:                      newKubernetesConfig,
+				LogsAggregator:              newLogsAggregatorConfig,
+				LogsCollector:               newLogsCollectorConfig,
+				GrafanaLokiConfig:           newGraflokiConfig,
+				ShouldEnableDefaultLogsSink: oldClusterConfig.ShouldEnableDefaultLogsSink,
+			}
+
+			newClusters[oldClusterName] = newClusterConfig
+		}
+	}
+
+	var newCloudConfig *v8.KurtosisCloudConfigV8
+	if castedOldConfig.CloudConfig != nil {
+		newCloudConfig = &v8.KurtosisCloudConfigV8{
+			ApiUrl:           castedOldConfig.CloudConfig.ApiUrl,
+			Port:             castedOldConfig.CloudConfig.Port,
+			CertificateChain: castedOldConfig.CloudConfig.CertificateChain,
+		}
+	}
+
+	var newTracingConfig *v8.TracingConfigV8
+	if castedOldConfig.Tracing != nil {
+		newTracingConfig = &v8.TracingConfigV8{
+			OtlpEndpoint: castedOldConfig.Tracing.OtlpEndpoint,
+		}
+	}
+
+	newConfig := &v8.KurtosisConfigV8{
+		ConfigVersion:     config_version.ConfigVersion_v8,
+		ShouldSendMetrics: castedOldConfig.ShouldSendMetrics,
+		KurtosisClusters:  newClusters,
+		CloudConfig:       newCloudConfig,
+		Tracing:           newTracingConfig,
+		EventsWebhook:     nil,
+		ApiAuth:           nil,
+		EnclavePool:       nil,
+	}
+
+	return newConfig, nil
+}
+
+func migrateFromV6(uncastedConfig interface{}) (interface{}, error) {
+	// cast "uncastedConfig" to current version we're upgrading from
+	castedOldConfig, ok := uncastedConfig.(*v6.KurtosisConfigV6)
+	if !ok {
+		return nil, stacktrace.NewError(
+			"Failed to cast old configuration '%+v' to expected configuration struct",
+			uncastedConfig,
+		)
+	}
+
+	var newClusters map[string]*v7.KurtosisClusterConfigV7
+	if castedOldConfig.KurtosisClusters != nil {
+		newClusters = map[string]*v7.KurtosisClusterConfigV7{}
+		for oldClusterName, oldClusterConfig := range castedOldConfig.KurtosisClusters {
+			oldKubernetesConfig := oldClusterConfig.Config
+			oldLogsAggregatorConfig := oldClusterConfig.LogsAggregator
+			oldLogsCollectorConfig := oldClusterConfig.LogsCollector
+			oldGraflokiConfig := oldClusterConfig.GrafanaLokiConfig
+
+			var newKubernetesConfig *v7.KubernetesClusterConfigV7
+			if oldKubernetesConfig != nil {
+				newKubernetesConfig = &v7.KubernetesClusterConfigV7{
+					KubernetesClusterName:  oldKubernetesConfig.KubernetesClusterName,
+					StorageClass:           oldKubernetesConfig.StorageClass,
+					EnclaveSizeInMegabytes: oldKubernetesConfig.EnclaveSizeInMegabytes,
+					EngineNodeName:         oldKubernetesConfig.EngineNodeName,
+				}
+			}
+
+			var newLogsAggregatorConfig *v7.LogsAggregatorConfigV7
+			if oldLogsAggregatorConfig != nil {
+				newLogsAggregatorConfig = &v7.LogsAggregatorConfigV7{
+					Sinks: oldLogsAggregatorConfig.Sinks,
+				}
+			}
+
+			var newLogsCollectorConfig *v7.LogsCollectorConfigV7
+			if oldLogsCollectorConfig != nil {
+				newLogsCollectorConfig = &v7.LogsCollectorConfigV7{
+					Parsers: oldLogsCollectorConfig.Parsers,
+					Filters: oldLogsCollectorConfig.Filters,
+				}
+			}
+
+			var newGraflokiConfig *v7.GrafanaLokiConfigV7
+			if oldGraflokiConfig != nil {
+				newGraflokiConfig = &v7.GrafanaLokiConfigV7{
+					ShouldStartBeforeEngine: oldGraflokiConfig.ShouldStartBeforeEngine,
+					GrafanaImage:            oldGraflokiConfig.GrafanaImage,
+					LokiImage:               oldGraflokiConfig.LokiImage,
+				}
+			}
+
+			newClusterConfig := &v7.KurtosisClusterConfigV7{
+				Type:                        oldClusterConfig.Type,
+				Config:                      newKubernetesConfig,
+				LogsAggregator:              newLogsAggregatorConfig,
+				LogsCollector:               newLogsCollectorConfig,
+				GrafanaLokiConfig:           newGraflokiConfig,
+				ShouldEnableDefaultLogsSink: oldClusterConfig.ShouldEnableDefaultLogsSink,
+			}
+
+			newClusters[oldClusterName] = newClusterConfig
+		}
+	}
+
+	var newCloudConfig *v7.KurtosisCloudConfigV7
+	if castedOldConfig.CloudConfig != nil {
+		newCloudConfig = &v7.KurtosisCloudConfigV7{
+			ApiUrl:           castedOldConfig.CloudConfig.ApiUrl,
+			Port:             castedOldConfig.CloudConfig.Port,
+			CertificateChain: castedOldConfig.CloudConfig.CertificateChain,
+		}
+	}
+
+	newConfig := &v7.KurtosisConfigV7{
+		ConfigVersion:     config_version.ConfigVersion_v7,
+		ShouldSendMetrics: castedOldConfig.ShouldSendMetrics,
+		KurtosisClusters:  newClusters,
+		CloudConfig:       newCloudConfig,
+		Tracing:           nil, // New field, initialize as nil
+	}
+
+	return newConfig, nil
+}
+
 func migrateFromV5(uncastedConfig interface{}) (interface{}, error) {
 	// cast "uncastedConfig" to current version we're upgrading from
 	castedOldConfig, ok := uncastedConfig.(*v5.KurtosisConfigV5)
@@ -0,0 +1,16 @@
+package v8
+
+/*
+!!!!!!!!!!!!!!!!!!!!!!!!!!!!!!!!!! WARNING !!!!!!!!!!!!!!!!!!!!!!!!!!!!!!!!!!!!!!!!!!!
+                           DO NOT CHANGE THIS FILE!
+  If you change this file, it will break config for users who have instantiated an
+           overrides file with this version of config overrides!
+    Instead, to make changes, you will need to add a new version of the config
+!!!!!!!!!!!!!!!!!!!!!!!!!!!!!!!!!! WARNING !!!!!!!!!!!!!!!!!!!!!!!!!!!!!!!!!!!!!!!!!!!
+*/
+
+// ApiAuthConfigV8 is the configuration of the authentication of the engine APIs.
+type ApiAuthConfigV8 struct {
+	// Enabled makes the engine reject the API calls that don't come with one of its API tokens
+	Enabled *bool `yaml:"enabled,omitempty"`
+}
@@ -0,0 +1,24 @@
+package v8
+
+/*
+!!!!!!!!!!!!!!!!!!!!!!!!!!!!!!!!!! WARNING !!!!!!!!!!!!!!!!!!!!!!!!!!!!!!!!!!!!!!!!!!!
+                           DO NOT CHANGE THIS FILE!
+  If you change this file, it will break config for users who have instantiated an
+           overrides file with this version of config overrides!
+    Instead, to make changes, you will need to add a new version of the config
+!!!!!!!!!!!!!!!!!!!!!!!!!!!!!!!!!! WARNING !!!!!!!!!!!!!!!!!!!!!!!!!!!!!!!!!!!!!!!!!!!
+*/
+
+// EnclavePoolConfigV8 is the configuration of the idle enclaves the engine keeps warm, whose number is set when
+// starting the engine
+type EnclavePoolConfigV8 struct {
+	// SeedPackage is run in every idle enclave, so that they're handed out already deployed
+	SeedPackage *EnclavePoolSeedPackageConfigV8 `yaml:"seed-package,omitempty"`
+}
+
+type EnclavePoolSeedPackageConfigV8 struct {
+	// Locator is the locator of the package, e.g. 'github.com/kurtosis-tech/ethereum-package'
+	Locator *string `yaml:"locator,omitempty"`
+	// Params are the serialized JSON params of the package
+	Params *string `yaml:"params,omitempty"`
+}
@@ -0,0 +1,18 @@
+package v8
+
+/*
+!!!!!!!!!!!!!!!!!!!!!!!!!!!!!!!!!! WARNING !!!!!!!!!!!!!!!!!!!!!!!!!!!!!!!!!!!!!!!!!!!
+                           DO NOT CHANGE THIS FILE!
+  If you change this file, it will break config for users who have instantiated an
+           overrides file with this version of config overrides!
+    Instead, to make changes, you will need to add a new version of the config
+!!!!!!!!!!!!!!!!!!!!!!!!!!!!!!!!!! WARNING !!!!!!!!!!!!!!!!!!!!!!!!!!!!!!!!!!!!!!!!!!!
+*/
+
+// EventsWebhookConfigV8 is the configuration of the outbound webhook the engine POSTs its events to.
+type EventsWebhookConfigV8 struct {
+	// Url is the endpoint the events get POSTed to, as seen from inside the engine container
+	Url *string `yaml:"url,omitempty"`
+	// MaxRetries is how many times the delivery of an event is retried before it gets dropped
+	MaxRetries *uint32 `yaml:"max-retries,omitempty"`
+}
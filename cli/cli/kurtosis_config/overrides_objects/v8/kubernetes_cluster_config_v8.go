@@ -0,0 +1,21 @@
+package v8
+
+/*
+!!!!!!!!!!!!!!!!!!!!!!!!!!!!!!!!!! WARNING !!!!!!!!!!!!!!!!!!!!!!!!!!!!!!!!!!!!!!!!!!!
+                           DO NOT CHANGE THIS FILE!
+  If you change this file, it will break config for users who have instantiated an
+           overrides file with this version of config overrides!
+    Instead, to make changes, you will need to add a new version of the config
+!!!!!!!!!!!!!!!!!!!!!!!!!!!!!!!!!! WARNING !!!!!!!!!!!!!!!!!!!!!!!!!!!!!!!!!!!!!!!!!!!
+*/
+
+import "github.com/kurtosis-tech/kurtosis/container-engine-lib/lib/backend_impls/kubernetes/enclave_namespace_config"
+
+type KubernetesClusterConfigV8 struct {
+	KubernetesClusterName  *string                                          `yaml:"kubernetes-cluster-name,omitempty"`
+	StorageClass           *string                                          `yaml:"storage-class,omitempty"`
+	EnclaveSizeInMegabytes *uint                                            `yaml:"enclave-size-in-megabytes,omitempty"`
+	EngineNodeName         *string                                          `yaml:"engine-node-name,omitempty"`
+	ImageBuildRegistry     *string                                          `yaml:"image-build-registry,omitempty"`
+	EnclaveNamespace       *enclave_namespace_config.EnclaveNamespaceConfig `yaml:"enclave-namespace,omitempty"`
+}
@@ -0,0 +1,17 @@
+package v8
+
+/*
+!!!!!!!!!!!!!!!!!!!!!!!!!!!!!!!!!! WARNING !!!!!!!!!!!!!!!!!!!!!!!!!!!!!!!!!!!!!!!!!!!
+                           DO NOT CHANGE THIS FILE!
+  If you change this file, it will break config for users who have instantiated an
+           overrides file with this version of config overrides!
+    Instead, to make changes, you will need to add a new version of the config
+!!!!!!!!!!!!!!!!!!!!!!!!!!!!!!!!!! WARNING !!!!!!!!!!!!!!!!!!!!!!!!!!!!!!!!!!!!!!!!!!!
+*/
+
+// TracingConfigV8 is the configuration for exporting the engine and API container traces.
+type TracingConfigV8 struct {
+	// OtlpEndpoint is the OTLP gRPC collector the traces get sent to, as seen from inside the engine and API containers.
+	// Either a 'host:port' pair or an 'http(s)://host:port' URL; tracing is disabled if empty
+	OtlpEndpoint *string `yaml:"otlp-endpoint,omitempty"`
+}
@@ -4,7 +4,7 @@ import (
 	"context"
 	"strings"
 
-	v6 "github.com/kurtosis-tech/kurtosis/cli/cli/kurtosis_config/overrides_objects/v6"
+	v8 "github.com/kurtosis-tech/kurtosis/cli/cli/kurtosis_config/overrides_objects/v8"
 	"github.com/kurtosis-tech/kurtosis/contexts-config-store/store"
 
 	"github.com/kurtosis-tech/kurtosis/container-engine-lib/lib/backend_impls/docker/docker_kurtosis_backend/backend_creator"
@@ -21,6 +21,8 @@ const (
 	defaultKubernetesEnclaveDataVolumeSizeInMegabytes = uint(1024)
 	// this will schedule engine on node selected by k8s scheduler
 	defaultEngineNodeName = ""
+	// images built in the cluster will get pushed to a registry started in each enclave
+	defaultImageBuildRegistry = ""
 )
 
 type kurtosisBackendSupplier func(ctx context.Context) (backend_interface.KurtosisBackend, error)
@@ -50,7 +52,7 @@ type GrafanaLokiConfig struct {
 	LokiImage               string
 }
 
-func NewKurtosisClusterConfigFromOverrides(clusterId string, overrides *v6.KurtosisClusterConfigV6) (*KurtosisClusterConfig, error) {
+func NewKurtosisClusterConfigFromOverrides(clusterId string, overrides *v8.KurtosisClusterConfigV8) (*KurtosisClusterConfig, error) {
 	if overrides.Type == nil {
 		return nil, stacktrace.NewError("Kurtosis cluster must have a defined type")
 	}
@@ -161,7 +163,7 @@ func (clusterConfig *KurtosisClusterConfig) ShouldEnableDefaultLogsSink() bool {
 //	Private Helpers
 //
 // ====================================================================================================
-func getSuppliers(clusterId string, clusterType KurtosisClusterType, kubernetesConfig *v6.KubernetesClusterConfigV6) (
+func getSuppliers(clusterId string, clusterType KurtosisClusterType, kubernetesConfig *v8.KubernetesClusterConfigV8) (
 	kurtosisBackendSupplier,
 	engine_server_launcher.KurtosisBackendConfigSupplier,
 	error,
@@ -263,6 +265,17 @@ func getSuppliers(clusterId string, clusterType KurtosisClusterType, kubernetesC
 			engineNodeName = *kubernetesConfig.EngineNodeName
 		}
 
+		imageBuildRegistry := defaultImageBuildRegistry
+		if kubernetesConfig.ImageBuildRegistry != nil {
+			imageBuildRegistry = *kubernetesConfig.ImageBuildRegistry
+		}
+
+		if kubernetesConfig.EnclaveNamespace != nil {
+			if err := kubernetesConfig.EnclaveNamespace.Validate(); err != nil {
+				return nil, nil, stacktrace.Propagate(err, "Cluster '%v' has an invalid enclave namespace config", clusterId)
+			}
+		}
+
 		backendSupplier = func(ctx context.Context) (backend_interface.KurtosisBackend, error) {
 			backend, err := kubernetes_kurtosis_backend.GetCLIBackend(ctx, *kubernetesConfig.StorageClass, engineNodeName)
 			if err != nil {
@@ -277,7 +290,7 @@ func getSuppliers(clusterId string, clusterType KurtosisClusterType, kubernetesC
 			return backend, nil
 		}
 
-		engineConfigSupplier = engine_server_launcher.NewKubernetesKurtosisBackendConfigSupplier(storageClass, enclaveDataVolumeSizeInMb)
+		engineConfigSupplier = engine_server_launcher.NewKubernetesKurtosisBackendConfigSupplier(storageClass, enclaveDataVolumeSizeInMb, imageBuildRegistry, kubernetesConfig.EnclaveNamespace)
 	default:
 		// This should never happen because we enforce this via unit tests
 		return nil, nil, stacktrace.NewError(
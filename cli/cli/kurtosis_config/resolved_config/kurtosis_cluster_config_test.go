@@ -3,15 +3,16 @@ package resolved_config
 import (
 	"testing"
 
-	v6 "github.com/kurtosis-tech/kurtosis/cli/cli/kurtosis_config/overrides_objects/v6"
+	v8 "github.com/kurtosis-tech/kurtosis/cli/cli/kurtosis_config/overrides_objects/v8"
 
+	"github.com/kurtosis-tech/kurtosis/container-engine-lib/lib/backend_impls/kubernetes/enclave_namespace_config"
 	"github.com/kurtosis-tech/kurtosis/container-engine-lib/lib/backend_interface/objects/logs_aggregator"
 	"github.com/kurtosis-tech/kurtosis/container-engine-lib/lib/backend_interface/objects/logs_collector"
 	"github.com/stretchr/testify/require"
 )
 
 func TestNewKurtosisClusterConfigEmptyOverrides(t *testing.T) {
-	kurtosisClusterConfigOverrides := v6.KurtosisClusterConfigV6{
+	kurtosisClusterConfigOverrides := v8.KurtosisClusterConfigV8{
 		Type:                        nil,
 		Config:                      nil,
 		LogsAggregator:              nil,
@@ -25,7 +26,7 @@ func TestNewKurtosisClusterConfigEmptyOverrides(t *testing.T) {
 
 func TestNewKurtosisClusterConfigDockerType(t *testing.T) {
 	dockerType := KurtosisClusterType_Docker.String()
-	kurtosisClusterConfigOverrides := v6.KurtosisClusterConfigV6{
+	kurtosisClusterConfigOverrides := v8.KurtosisClusterConfigV8{
 		Type:                        &dockerType,
 		Config:                      nil,
 		LogsAggregator:              nil,
@@ -37,9 +38,47 @@ func TestNewKurtosisClusterConfigDockerType(t *testing.T) {
 	require.NoError(t, err)
 }
 
+func TestNewKurtosisClusterConfigPodmanType(t *testing.T) {
+	podmanType := KurtosisClusterType_Podman.String()
+	require.Equal(t, "podman", podmanType)
+	kurtosisClusterConfigOverrides := v8.KurtosisClusterConfigV8{
+		Type:                        &podmanType,
+		Config:                      nil,
+		LogsAggregator:              nil,
+		LogsCollector:               nil,
+		GrafanaLokiConfig:           nil,
+		ShouldEnableDefaultLogsSink: nil,
+	}
+	clusterConfig, err := NewKurtosisClusterConfigFromOverrides("test", &kurtosisClusterConfigOverrides)
+	require.NoError(t, err)
+	require.Equal(t, KurtosisClusterType_Podman, clusterConfig.GetClusterType())
+}
+
+func TestNewKurtosisClusterConfigPodmanTypeWithKubernetesConfig(t *testing.T) {
+	podmanType := KurtosisClusterType_Podman.String()
+	kubernetesClusterName := "some-name"
+	kurtosisClusterConfigOverrides := v8.KurtosisClusterConfigV8{
+		Type: &podmanType,
+		Config: &v8.KubernetesClusterConfigV8{
+			KubernetesClusterName:  &kubernetesClusterName,
+			StorageClass:           nil,
+			EnclaveSizeInMegabytes: nil,
+			EngineNodeName:         nil,
+			ImageBuildRegistry:     nil,
+			EnclaveNamespace:       nil,
+		},
+		LogsAggregator:              nil,
+		LogsCollector:               nil,
+		GrafanaLokiConfig:           nil,
+		ShouldEnableDefaultLogsSink: nil,
+	}
+	_, err := NewKurtosisClusterConfigFromOverrides("test", &kurtosisClusterConfigOverrides)
+	require.Error(t, err)
+}
+
 func TestNewKurtosisClusterConfigKubernetesNoConfig(t *testing.T) {
 	kubernetesType := KurtosisClusterType_Kubernetes.String()
-	kurtosisClusterConfigOverrides := v6.KurtosisClusterConfigV6{
+	kurtosisClusterConfigOverrides := v8.KurtosisClusterConfigV8{
 		Type:                        &kubernetesType,
 		Config:                      nil,
 		LogsAggregator:              nil,
@@ -53,7 +92,7 @@ func TestNewKurtosisClusterConfigKubernetesNoConfig(t *testing.T) {
 
 func TestNewKurtosisClusterConfigNonsenseType(t *testing.T) {
 	clusterType := "gdsfgsdfvsf"
-	kurtosisClusterConfigOverrides := v6.KurtosisClusterConfigV6{
+	kurtosisClusterConfigOverrides := v8.KurtosisClusterConfigV8{
 		Type:                        &clusterType,
 		Config:                      nil,
 		LogsAggregator:              nil,
@@ -68,13 +107,15 @@ func TestNewKurtosisClusterConfigNonsenseType(t *testing.T) {
 func TestNewKurtosisClusterConfigKubernetesPartialConfig(t *testing.T) {
 	kubernetesType := KurtosisClusterType_Kubernetes.String()
 	kubernetesClusterName := "some-name"
-	kubernetesPartialConfig := v6.KubernetesClusterConfigV6{
+	kubernetesPartialConfig := v8.KubernetesClusterConfigV8{
 		KubernetesClusterName:  &kubernetesClusterName,
 		StorageClass:           nil,
 		EnclaveSizeInMegabytes: nil,
 		EngineNodeName:         nil,
+		ImageBuildRegistry:     nil,
+		EnclaveNamespace:       nil,
 	}
-	kurtosisClusterConfigOverrides := v6.KurtosisClusterConfigV6{
+	kurtosisClusterConfigOverrides := v8.KurtosisClusterConfigV8{
 		Type:                        &kubernetesType,
 		Config:                      &kubernetesPartialConfig,
 		LogsAggregator:              nil,
@@ -92,13 +133,15 @@ func TestNewKurtosisClusterConfigKubernetesFullConfig(t *testing.T) {
 	kubernetesStorageClass := "some-storage-class"
 	kubernetesEnclaveSizeInMB := uint(5)
 	kubernetesEngineNodeName := "some-node-name"
-	kubernetesFullConfig := v6.KubernetesClusterConfigV6{
+	kubernetesFullConfig := v8.KubernetesClusterConfigV8{
 		KubernetesClusterName:  &kubernetesClusterName,
 		StorageClass:           &kubernetesStorageClass,
 		EnclaveSizeInMegabytes: &kubernetesEnclaveSizeInMB,
 		EngineNodeName:         &kubernetesEngineNodeName,
+		ImageBuildRegistry:     nil,
+		EnclaveNamespace:       nil,
 	}
-	kurtosisClusterConfigOverrides := v6.KurtosisClusterConfigV6{
+	kurtosisClusterConfigOverrides := v8.KurtosisClusterConfigV8{
 		Type:                        &kubernetesType,
 		Config:                      &kubernetesFullConfig,
 		LogsAggregator:              nil,
@@ -110,19 +153,51 @@ func TestNewKurtosisClusterConfigKubernetesFullConfig(t *testing.T) {
 	require.NoError(t, err)
 }
 
+func TestNewKurtosisClusterConfigKubernetesInvalidEnclaveNamespaceConfig(t *testing.T) {
+	kubernetesType := KurtosisClusterType_Kubernetes.String()
+	kubernetesClusterName := "some-name"
+	kubernetesStorageClass := "some-storage-class"
+	kubernetesConfig := v8.KubernetesClusterConfigV8{
+		KubernetesClusterName:  &kubernetesClusterName,
+		StorageClass:           &kubernetesStorageClass,
+		EnclaveSizeInMegabytes: nil,
+		EngineNodeName:         nil,
+		ImageBuildRegistry:     nil,
+		EnclaveNamespace: &enclave_namespace_config.EnclaveNamespaceConfig{
+			Labels:                    map[string]string{"team": "{{.EnclaveName}}"},
+			Annotations:               nil,
+			ResourceQuota:             map[string]string{"requests.cpu": "not-a-quantity"},
+			LimitRange:                nil,
+			ShouldCreateNetworkPolicy: true,
+		},
+	}
+	kurtosisClusterConfigOverrides := v8.KurtosisClusterConfigV8{
+		Type:                        &kubernetesType,
+		Config:                      &kubernetesConfig,
+		LogsAggregator:              nil,
+		LogsCollector:               nil,
+		GrafanaLokiConfig:           nil,
+		ShouldEnableDefaultLogsSink: nil,
+	}
+	_, err := NewKurtosisClusterConfigFromOverrides("test", &kurtosisClusterConfigOverrides)
+	require.Error(t, err)
+}
+
 func TestNewKurtosisClusterConfigLogsAggregatorNoConfig(t *testing.T) {
 	kubernetesType := KurtosisClusterType_Kubernetes.String()
 	kubernetesClusterName := "some-name"
 	kubernetesStorageClass := "some-storage-class"
 	kubernetesEnclaveSizeInMB := uint(5)
 	kubernetesEngineNodeName := "some-node-name"
-	kubernetesFullConfig := v6.KubernetesClusterConfigV6{
+	kubernetesFullConfig := v8.KubernetesClusterConfigV8{
 		KubernetesClusterName:  &kubernetesClusterName,
 		StorageClass:           &kubernetesStorageClass,
 		EnclaveSizeInMegabytes: &kubernetesEnclaveSizeInMB,
 		EngineNodeName:         &kubernetesEngineNodeName,
+		ImageBuildRegistry:     nil,
+		EnclaveNamespace:       nil,
 	}
-	kurtosisClusterConfigOverrides := v6.KurtosisClusterConfigV6{
+	kurtosisClusterConfigOverrides := v8.KurtosisClusterConfigV8{
 		Type:                        &kubernetesType,
 		Config:                      &kubernetesFullConfig,
 		LogsAggregator:              nil,
@@ -140,16 +215,18 @@ func TestNewKurtosisClusterConfigLogsAggregatorReservedSinkId(t *testing.T) {
 	kubernetesStorageClass := "some-storage-class"
 	kubernetesEnclaveSizeInMB := uint(5)
 	kubernetesEngineNodeName := "some-node-name"
-	kubernetesFullConfig := v6.KubernetesClusterConfigV6{
+	kubernetesFullConfig := v8.KubernetesClusterConfigV8{
 		KubernetesClusterName:  &kubernetesClusterName,
 		StorageClass:           &kubernetesStorageClass,
 		EnclaveSizeInMegabytes: &kubernetesEnclaveSizeInMB,
 		EngineNodeName:         &kubernetesEngineNodeName,
+		ImageBuildRegistry:     nil,
+		EnclaveNamespace:       nil,
 	}
-	kurtosisClusterConfigOverrides := v6.KurtosisClusterConfigV6{
+	kurtosisClusterConfigOverrides := v8.KurtosisClusterConfigV8{
 		Type:   &kubernetesType,
 		Config: &kubernetesFullConfig,
-		LogsAggregator: &v6.LogsAggregatorConfigV6{
+		LogsAggregator: &v8.LogsAggregatorConfigV8{
 			Sinks: map[string]map[string]interface{}{
 				logs_aggregator.DefaultSinkId: {
 					"type": "elasticsearch",
@@ -170,16 +247,18 @@ func TestNewKurtosisClusterConfigLogsAggregatorFullConfig(t *testing.T) {
 	kubernetesStorageClass := "some-storage-class"
 	kubernetesEnclaveSizeInMB := uint(5)
 	kubernetesEngineNodeName := "some-node-name"
-	kubernetesFullConfig := v6.KubernetesClusterConfigV6{
+	kubernetesFullConfig := v8.KubernetesClusterConfigV8{
 		KubernetesClusterName:  &kubernetesClusterName,
 		StorageClass:           &kubernetesStorageClass,
 		EnclaveSizeInMegabytes: &kubernetesEnclaveSizeInMB,
 		EngineNodeName:         &kubernetesEngineNodeName,
+		ImageBuildRegistry:     nil,
+		EnclaveNamespace:       nil,
 	}
-	kurtosisClusterConfigOverrides := v6.KurtosisClusterConfigV6{
+	kurtosisClusterConfigOverrides := v8.KurtosisClusterConfigV8{
 		Type:   &kubernetesType,
 		Config: &kubernetesFullConfig,
-		LogsAggregator: &v6.LogsAggregatorConfigV6{
+		LogsAggregator: &v8.LogsAggregatorConfigV8{
 			Sinks: map[string]map[string]interface{}{
 				"elasticsearch": {
 					"type": "elasticsearch",
@@ -200,16 +279,18 @@ func TestNewKurtosisClusterConfigGraflokiNoConfig(t *testing.T) {
 	kubernetesStorageClass := "some-storage-class"
 	kubernetesEnclaveSizeInMB := uint(5)
 	kubernetesEngineNodeName := "some-node-name"
-	kubernetesFullConfig := v6.KubernetesClusterConfigV6{
+	kubernetesFullConfig := v8.KubernetesClusterConfigV8{
 		KubernetesClusterName:  &kubernetesClusterName,
 		StorageClass:           &kubernetesStorageClass,
 		EnclaveSizeInMegabytes: &kubernetesEnclaveSizeInMB,
 		EngineNodeName:         &kubernetesEngineNodeName,
+		ImageBuildRegistry:     nil,
+		EnclaveNamespace:       nil,
 	}
-	kurtosisClusterConfigOverrides := v6.KurtosisClusterConfigV6{
+	kurtosisClusterConfigOverrides := v8.KurtosisClusterConfigV8{
 		Type:   &kubernetesType,
 		Config: &kubernetesFullConfig,
-		LogsAggregator: &v6.LogsAggregatorConfigV6{
+		LogsAggregator: &v8.LogsAggregatorConfigV8{
 			Sinks: map[string]map[string]interface{}{
 				"elasticsearch": {
 					"type": "elasticsearch",
@@ -232,17 +313,19 @@ func TestNewKurtosisClusterConfigGraflokiFullConfig(t *testing.T) {
 	kubernetesEngineNodeName := "some-node-name"
 	grafanaImage := "grafana:1.32"
 	lokiImage := "loki:1.32"
-	kubernetesFullConfig := v6.KubernetesClusterConfigV6{
+	kubernetesFullConfig := v8.KubernetesClusterConfigV8{
 		KubernetesClusterName:  &kubernetesClusterName,
 		StorageClass:           &kubernetesStorageClass,
 		EnclaveSizeInMegabytes: &kubernetesEnclaveSizeInMB,
 		EngineNodeName:         &kubernetesEngineNodeName,
+		ImageBuildRegistry:     nil,
+		EnclaveNamespace:       nil,
 	}
-	kurtosisClusterConfigOverrides := v6.KurtosisClusterConfigV6{
+	kurtosisClusterConfigOverrides := v8.KurtosisClusterConfigV8{
 		Type:           &kubernetesType,
 		Config:         &kubernetesFullConfig,
 		LogsAggregator: nil,
-		GrafanaLokiConfig: &v6.GrafanaLokiConfigV6{
+		GrafanaLokiConfig: &v8.GrafanaLokiConfigV8{
 			ShouldStartBeforeEngine: false,
 			GrafanaImage:            grafanaImage,
 			LokiImage:               lokiImage,
@@ -265,13 +348,15 @@ func TestNewKurtosisClusterConfigShouldEnableDefaultLogsSink(t *testing.T) {
 	kubernetesEnclaveSizeInMB := uint(5)
 	kubernetesEngineNodeName := "some-node-name"
 	ShouldEnableDefaultLogsSink := true
-	kubernetesFullConfig := v6.KubernetesClusterConfigV6{
+	kubernetesFullConfig := v8.KubernetesClusterConfigV8{
 		KubernetesClusterName:  &kubernetesClusterName,
 		StorageClass:           &kubernetesStorageClass,
 		EnclaveSizeInMegabytes: &kubernetesEnclaveSizeInMB,
 		EngineNodeName:         &kubernetesEngineNodeName,
+		ImageBuildRegistry:     nil,
+		EnclaveNamespace:       nil,
 	}
-	kurtosisClusterConfigOverrides := v6.KurtosisClusterConfigV6{
+	kurtosisClusterConfigOverrides := v8.KurtosisClusterConfigV8{
 		Type:                        &kubernetesType,
 		Config:                      &kubernetesFullConfig,
 		LogsAggregator:              nil,
@@ -289,13 +374,15 @@ func TestNewKurtosisClusterConfigLogsCollectorNoConfig(t *testing.T) {
 	kubernetesStorageClass := "some-storage-class"
 	kubernetesEnclaveSizeInMB := uint(5)
 	kubernetesEngineNodeName := "some-node-name"
-	kubernetesFullConfig := v6.KubernetesClusterConfigV6{
+	kubernetesFullConfig := v8.KubernetesClusterConfigV8{
 		KubernetesClusterName:  &kubernetesClusterName,
 		StorageClass:           &kubernetesStorageClass,
 		EnclaveSizeInMegabytes: &kubernetesEnclaveSizeInMB,
 		EngineNodeName:         &kubernetesEngineNodeName,
+		ImageBuildRegistry:     nil,
+		EnclaveNamespace:       nil,
 	}
-	kurtosisClusterConfigOverrides := v6.KurtosisClusterConfigV6{
+	kurtosisClusterConfigOverrides := v8.KurtosisClusterConfigV8{
 		Type:                        &kubernetesType,
 		Config:                      &kubernetesFullConfig,
 		LogsAggregator:              nil,
@@ -315,23 +402,25 @@ func TestNewKurtosisClusterConfigLogsCollectorFullConfig(t *testing.T) {
 	kubernetesStorageClass := "some-storage-class"
 	kubernetesEnclaveSizeInMB := uint(5)
 	kubernetesEngineNodeName := "some-node-name"
-	kubernetesFullConfig := v6.KubernetesClusterConfigV6{
+	kubernetesFullConfig := v8.KubernetesClusterConfigV8{
 		KubernetesClusterName:  &kubernetesClusterName,
 		StorageClass:           &kubernetesStorageClass,
 		EnclaveSizeInMegabytes: &kubernetesEnclaveSizeInMB,
 		EngineNodeName:         &kubernetesEngineNodeName,
+		ImageBuildRegistry:     nil,
+		EnclaveNamespace:       nil,
 	}
-	kurtosisClusterConfigOverrides := v6.KurtosisClusterConfigV6{
+	kurtosisClusterConfigOverrides := v8.KurtosisClusterConfigV8{
 		Type:   &kubernetesType,
 		Config: &kubernetesFullConfig,
-		LogsAggregator: &v6.LogsAggregatorConfigV6{
+		LogsAggregator: &v8.LogsAggregatorConfigV8{
 			Sinks: map[string]map[string]interface{}{
 				"elasticsearch": {
 					"type": "elasticsearch",
 				},
 			},
 		},
-		LogsCollector: &v6.LogsCollectorConfigV6{
+		LogsCollector: &v8.LogsCollectorConfigV8{
 			Filters: []logs_collector.Filter{
 				{
 					Name:  "grep",
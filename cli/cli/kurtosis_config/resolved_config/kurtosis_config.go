@@ -2,7 +2,8 @@ package resolved_config
 
 import (
 	"github.com/kurtosis-tech/kurtosis/cli/cli/kurtosis_config/config_version"
-	v6 "github.com/kurtosis-tech/kurtosis/cli/cli/kurtosis_config/overrides_objects/v6"
+	v8 "github.com/kurtosis-tech/kurtosis/cli/cli/kurtosis_config/overrides_objects/v8"
+	"github.com/kurtosis-tech/kurtosis/engine/launcher/args"
 	"github.com/kurtosis-tech/stacktrace"
 )
 
@@ -12,6 +13,8 @@ const (
 
 	defaultMinikubeClusterName = "minikube"
 
+	defaultPodmanClusterName = "podman"
+
 	defaultMinikubeClusterKubernetesClusterNameStr = "minikube"
 	defaultMinikubeStorageClass                    = "standard"
 	defaultMinikubeEnclaveDataVolumeMB             = uint(10)
@@ -26,6 +29,8 @@ const (
 	// Default log sink is PersistentVolumeLogsDatabase, used by service logs.
 	// Enabled by default for log access, but can be disabled if using a custom or Grafana Loki setup that leverages export logs feature
 	DefaultShouldEnableDefaultLogsSink = true
+
+	DefaultEventsWebhookMaxRetries = uint32(3)
 )
 
 /*
@@ -42,11 +47,19 @@ the overrides on top of the default config.
 */
 type KurtosisConfig struct {
 	// Only necessary to store for when we serialize overrides
-	overrides *v6.KurtosisConfigV6
+	overrides *v8.KurtosisConfigV8
 
 	shouldSendMetrics bool
 	clusters          map[string]*KurtosisClusterConfig
 	cloudConfig       *KurtosisCloudConfig
+	// Empty if the engine and API containers shouldn't export traces
+	otlpEndpoint string
+	// Nil if the engine shouldn't POST its events to a webhook
+	eventsWebhook *args.EventsWebhookConfig
+	// Whether the engine rejects the API calls that don't come with one of its API tokens
+	isApiAuthEnabled bool
+	// Nil if the idle enclaves of the engine pool are left blank
+	enclavePoolSeedPackage *args.EnclavePoolSeedPackageConfig
 }
 
 // NewKurtosisConfigFromOverrides constructs a new KurtosisConfig that uses the given overrides
@@ -59,10 +72,14 @@ func NewKurtosisConfigFromOverrides(uncastedOverrides interface{}) (*KurtosisCon
 	}
 
 	config := &KurtosisConfig{
-		overrides:         overrides,
-		shouldSendMetrics: false,
-		clusters:          nil,
-		cloudConfig:       nil,
+		overrides:              overrides,
+		shouldSendMetrics:      false,
+		clusters:               nil,
+		cloudConfig:            nil,
+		otlpEndpoint:           "",
+		eventsWebhook:          nil,
+		isApiAuthEnabled:       false,
+		enclavePoolSeedPackage: nil,
 	}
 
 	// Get latest config version
@@ -130,21 +147,73 @@ func NewKurtosisConfigFromOverrides(uncastedOverrides interface{}) (*KurtosisCon
 		}
 	}
 
+	otlpEndpoint := ""
+	if overrides.Tracing != nil && overrides.Tracing.OtlpEndpoint != nil {
+		if len(*overrides.Tracing.OtlpEndpoint) < 1 {
+			return nil, stacktrace.NewError("The Tracing OtlpEndpoint must be nonempty")
+		}
+		otlpEndpoint = *overrides.Tracing.OtlpEndpoint
+	}
+
+	var eventsWebhook *args.EventsWebhookConfig
+	if overrides.EventsWebhook != nil {
+		if overrides.EventsWebhook.Url == nil || len(*overrides.EventsWebhook.Url) < 1 {
+			return nil, stacktrace.NewError("The EventsWebhook Url must be nonempty")
+		}
+		maxRetries := DefaultEventsWebhookMaxRetries
+		if overrides.EventsWebhook.MaxRetries != nil {
+			maxRetries = *overrides.EventsWebhook.MaxRetries
+		}
+		eventsWebhook = &args.EventsWebhookConfig{
+			Url:        *overrides.EventsWebhook.Url,
+			MaxRetries: maxRetries,
+		}
+	}
+
+	isApiAuthEnabled := false
+	if overrides.ApiAuth != nil && overrides.ApiAuth.Enabled != nil {
+		isApiAuthEnabled = *overrides.ApiAuth.Enabled
+	}
+
+	var enclavePoolSeedPackage *args.EnclavePoolSeedPackageConfig
+	if overrides.EnclavePool != nil && overrides.EnclavePool.SeedPackage != nil {
+		seedPackageOverrides := overrides.EnclavePool.SeedPackage
+		if seedPackageOverrides.Locator == nil || len(*seedPackageOverrides.Locator) < 1 {
+			return nil, stacktrace.NewError("The EnclavePool SeedPackage Locator must be nonempty")
+		}
+		params := ""
+		if seedPackageOverrides.Params != nil {
+			params = *seedPackageOverrides.Params
+		}
+		enclavePoolSeedPackage = &args.EnclavePoolSeedPackageConfig{
+			PackageLocator: *seedPackageOverrides.Locator,
+			PackageParams:  params,
+		}
+	}
+
 	return &KurtosisConfig{
-		overrides:         overrides,
-		shouldSendMetrics: shouldSendMetrics,
-		clusters:          allClusterConfigs,
-		cloudConfig:       cloudConfig,
+		overrides:              overrides,
+		shouldSendMetrics:      shouldSendMetrics,
+		clusters:               allClusterConfigs,
+		cloudConfig:            cloudConfig,
+		otlpEndpoint:           otlpEndpoint,
+		eventsWebhook:          eventsWebhook,
+		isApiAuthEnabled:       isApiAuthEnabled,
+		enclavePoolSeedPackage: enclavePoolSeedPackage,
 	}, nil
 }
 
 // NOTE: We probably want to remove this function entirely
 func NewKurtosisConfigFromRequiredFields(shouldSendMetrics bool) (*KurtosisConfig, error) {
-	overrides := &v6.KurtosisConfigV6{
+	overrides := &v8.KurtosisConfigV8{
 		ConfigVersion:     0,
 		ShouldSendMetrics: &shouldSendMetrics,
 		KurtosisClusters:  nil,
 		CloudConfig:       nil,
+		Tracing:           nil,
+		EventsWebhook:     nil,
+		ApiAuth:           nil,
+		EnclavePool:       nil,
 	}
 	result, err := NewKurtosisConfigFromOverrides(overrides)
 	if err != nil {
@@ -155,10 +224,14 @@ func NewKurtosisConfigFromRequiredFields(shouldSendMetrics bool) (*KurtosisConfi
 
 func NewKurtosisConfigWithMetricsSetFromExistingConfig(config *KurtosisConfig, shouldSendMetrics bool) *KurtosisConfig {
 	newConfig := &KurtosisConfig{
-		overrides:         config.overrides,
-		shouldSendMetrics: shouldSendMetrics,
-		clusters:          config.clusters,
-		cloudConfig:       config.cloudConfig,
+		overrides:              config.overrides,
+		shouldSendMetrics:      shouldSendMetrics,
+		clusters:               config.clusters,
+		cloudConfig:            config.cloudConfig,
+		otlpEndpoint:           config.otlpEndpoint,
+		eventsWebhook:          config.eventsWebhook,
+		isApiAuthEnabled:       config.isApiAuthEnabled,
+		enclavePoolSeedPackage: config.enclavePoolSeedPackage,
 	}
 	newConfig.overrides.ShouldSendMetrics = &shouldSendMetrics
 	return newConfig
@@ -172,7 +245,7 @@ func (kurtosisConfig *KurtosisConfig) GetKurtosisClusters() map[string]*Kurtosis
 	return kurtosisConfig.clusters
 }
 
-func (kurtosisConfig *KurtosisConfig) GetOverrides() *v6.KurtosisConfigV6 {
+func (kurtosisConfig *KurtosisConfig) GetOverrides() *v8.KurtosisConfigV8 {
 	return kurtosisConfig.overrides
 }
 
@@ -180,22 +253,39 @@ func (kurtosisConfig *KurtosisConfig) GetCloudConfig() *KurtosisCloudConfig {
 	return kurtosisConfig.cloudConfig
 }
 
+func (kurtosisConfig *KurtosisConfig) GetOtlpEndpoint() string {
+	return kurtosisConfig.otlpEndpoint
+}
+
+func (kurtosisConfig *KurtosisConfig) GetEventsWebhook() *args.EventsWebhookConfig {
+	return kurtosisConfig.eventsWebhook
+}
+
+func (kurtosisConfig *KurtosisConfig) GetIsApiAuthEnabled() bool {
+	return kurtosisConfig.isApiAuthEnabled
+}
+
+func (kurtosisConfig *KurtosisConfig) GetEnclavePoolSeedPackage() *args.EnclavePoolSeedPackageConfig {
+	return kurtosisConfig.enclavePoolSeedPackage
+}
+
 // ====================================================================================================
 //
 //	Private Helpers
 //
 // ====================================================================================================
 // This is a separate helper function so that we can use it to ensure that the
-func castUncastedOverrides(uncastedOverrides interface{}) (*v6.KurtosisConfigV6, error) {
-	castedOverrides, ok := uncastedOverrides.(*v6.KurtosisConfigV6)
+func castUncastedOverrides(uncastedOverrides interface{}) (*v8.KurtosisConfigV8, error) {
+	castedOverrides, ok := uncastedOverrides.(*v8.KurtosisConfigV8)
 	if !ok {
 		return nil, stacktrace.NewError("An error occurred casting the uncasted config overrides to the right version")
 	}
 	return castedOverrides, nil
 }
 
-func getDefaultKurtosisClusterConfigOverrides() map[string]*v6.KurtosisClusterConfigV6 {
+func getDefaultKurtosisClusterConfigOverrides() map[string]*v8.KurtosisClusterConfigV8 {
 	dockerClusterType := KurtosisClusterType_Docker.String()
+	podmanClusterType := KurtosisClusterType_Podman.String()
 	minikubeClusterType := KurtosisClusterType_Kubernetes.String()
 	minikubeKubernetesClusterName := defaultMinikubeClusterKubernetesClusterNameStr
 	minikubeStorageClass := defaultMinikubeStorageClass
@@ -203,7 +293,7 @@ func getDefaultKurtosisClusterConfigOverrides() map[string]*v6.KurtosisClusterCo
 	minikubeEngineNodeName := defaultMinikubeEngineNodeName
 	shouldEnableDefaultLogsSink := DefaultShouldEnableDefaultLogsSink
 
-	result := map[string]*v6.KurtosisClusterConfigV6{
+	result := map[string]*v8.KurtosisClusterConfigV8{
 		DefaultDockerClusterName: {
 			Type:              &dockerClusterType,
 			Config:            nil, // Must be nil for Docker
@@ -211,9 +301,16 @@ func getDefaultKurtosisClusterConfigOverrides() map[string]*v6.KurtosisClusterCo
 			LogsCollector:     nil,
 			GrafanaLokiConfig: nil,
 		},
+		defaultPodmanClusterName: {
+			Type:              &podmanClusterType,
+			Config:            nil, // Must be nil for Podman
+			LogsAggregator:    nil,
+			LogsCollector:     nil,
+			GrafanaLokiConfig: nil,
+		},
 		defaultMinikubeClusterName: {
 			Type: &minikubeClusterType,
-			Config: &v6.KubernetesClusterConfigV6{
+			Config: &v8.KubernetesClusterConfigV8{
 				KubernetesClusterName:  &minikubeKubernetesClusterName,
 				StorageClass:           &minikubeStorageClass,
 				EnclaveSizeInMegabytes: &minikubeEnclaveDataVolSizeMB,
@@ -4,7 +4,7 @@ import (
 	"sort"
 	"testing"
 
-	v6 "github.com/kurtosis-tech/kurtosis/cli/cli/kurtosis_config/overrides_objects/v6"
+	v8 "github.com/kurtosis-tech/kurtosis/cli/cli/kurtosis_config/overrides_objects/v8"
 
 	"github.com/kurtosis-tech/kurtosis/cli/cli/kurtosis_config/config_version"
 	"github.com/kurtosis-tech/kurtosis/cli/cli/kurtosis_config/overrides_objects"
@@ -52,24 +52,32 @@ func TestNewKurtosisConfigFromRequiredFields_MetricsElectionIsSent(t *testing.T)
 }
 
 func TestNewKurtosisConfigEmptyOverrides(t *testing.T) {
-	_, err := NewKurtosisConfigFromOverrides(&v6.KurtosisConfigV6{
+	_, err := NewKurtosisConfigFromOverrides(&v8.KurtosisConfigV8{
 		ConfigVersion:     0,
 		ShouldSendMetrics: nil,
 		KurtosisClusters:  nil,
 		CloudConfig:       nil,
+		Tracing:           nil,
+		EventsWebhook:     nil,
+		ApiAuth:           nil,
+		EnclavePool:       nil,
 	})
 	// You can not initialize a Kurtosis config with empty overrides - it needs at least `ShouldSendMetrics`
 	require.Error(t, err)
 }
 
 func TestNewKurtosisConfigJustMetrics(t *testing.T) {
-	version := config_version.ConfigVersion_v6
+	version := config_version.ConfigVersion_v8
 	shouldSendMetrics := true
-	originalOverrides := v6.KurtosisConfigV6{
+	originalOverrides := v8.KurtosisConfigV8{
 		ConfigVersion:     version,
 		ShouldSendMetrics: &shouldSendMetrics,
 		KurtosisClusters:  nil,
 		CloudConfig:       nil,
+		Tracing:           nil,
+		EventsWebhook:     nil,
+		ApiAuth:           nil,
+		EnclavePool:       nil,
 	}
 	config, err := NewKurtosisConfigFromOverrides(&originalOverrides)
 	// You can not initialize a Kurtosis config with empty originalOverrides - it needs at least `ShouldSendMetrics`
@@ -93,18 +101,22 @@ func TestNewKurtosisConfigOverridesAreLatestVersion(t *testing.T) {
 }
 
 func TestCloudConfigOverridesApiUrl(t *testing.T) {
-	version := config_version.ConfigVersion_v6
+	version := config_version.ConfigVersion_v8
 	shouldSendMetrics := true
 	apiUrl := "test.com"
-	originalOverrides := v6.KurtosisConfigV6{
+	originalOverrides := v8.KurtosisConfigV8{
 		ConfigVersion:     version,
 		ShouldSendMetrics: &shouldSendMetrics,
 		KurtosisClusters:  nil,
-		CloudConfig: &v6.KurtosisCloudConfigV6{
+		CloudConfig: &v8.KurtosisCloudConfigV8{
 			ApiUrl:           &apiUrl,
 			Port:             nil,
 			CertificateChain: nil,
 		},
+		Tracing:       nil,
+		EventsWebhook: nil,
+		ApiAuth:       nil,
+		EnclavePool:   nil,
 	}
 	config, err := NewKurtosisConfigFromOverrides(&originalOverrides)
 	require.NoError(t, err)
@@ -121,3 +133,117 @@ func TestCloudConfigOverridesApiUrl(t *testing.T) {
 	require.Equal(t, DefaultCloudConfigPort, config.GetCloudConfig().Port)
 	require.Equal(t, DefaultCertificateChain, config.GetCloudConfig().CertificateChain)
 }
+
+func TestTracingConfigOverridesOtlpEndpoint(t *testing.T) {
+	version := config_version.ConfigVersion_v8
+	shouldSendMetrics := true
+	otlpEndpoint := "host.docker.internal:4317"
+	originalOverrides := v8.KurtosisConfigV8{
+		ConfigVersion:     version,
+		ShouldSendMetrics: &shouldSendMetrics,
+		KurtosisClusters:  nil,
+		CloudConfig:       nil,
+		Tracing: &v8.TracingConfigV8{
+			OtlpEndpoint: &otlpEndpoint,
+		},
+		EventsWebhook: nil,
+		ApiAuth:       nil,
+		EnclavePool:   nil,
+	}
+	config, err := NewKurtosisConfigFromOverrides(&originalOverrides)
+	require.NoError(t, err)
+	require.Equal(t, otlpEndpoint, config.GetOtlpEndpoint())
+}
+
+func TestTracingConfigIsDisabledByDefault(t *testing.T) {
+	config, err := NewKurtosisConfigFromRequiredFields(false)
+	require.NoError(t, err)
+	require.Empty(t, config.GetOtlpEndpoint())
+}
+
+func TestTracingConfigEmptyOtlpEndpointIsRejected(t *testing.T) {
+	shouldSendMetrics := true
+	otlpEndpoint := ""
+	_, err := NewKurtosisConfigFromOverrides(&v8.KurtosisConfigV8{
+		ConfigVersion:     config_version.ConfigVersion_v8,
+		ShouldSendMetrics: &shouldSendMetrics,
+		KurtosisClusters:  nil,
+		CloudConfig:       nil,
+		Tracing: &v8.TracingConfigV8{
+			OtlpEndpoint: &otlpEndpoint,
+		},
+		EventsWebhook: nil,
+		ApiAuth:       nil,
+		EnclavePool:   nil,
+	})
+	require.Error(t, err)
+}
+
+func TestEventsWebhookConfigDefaultsMaxRetries(t *testing.T) {
+	shouldSendMetrics := true
+	url := "http://host.docker.internal:8080/events"
+	config, err := NewKurtosisConfigFromOverrides(&v8.KurtosisConfigV8{
+		ConfigVersion:     config_version.ConfigVersion_v8,
+		ShouldSendMetrics: &shouldSendMetrics,
+		KurtosisClusters:  nil,
+		CloudConfig:       nil,
+		Tracing:           nil,
+		EventsWebhook: &v8.EventsWebhookConfigV8{
+			Url:        &url,
+			MaxRetries: nil,
+		},
+		ApiAuth:     nil,
+		EnclavePool: nil,
+	})
+	require.NoError(t, err)
+	require.Equal(t, url, config.GetEventsWebhook().Url)
+	require.Equal(t, DefaultEventsWebhookMaxRetries, config.GetEventsWebhook().MaxRetries)
+}
+
+func TestEventsWebhookConfigEmptyUrlIsRejected(t *testing.T) {
+	shouldSendMetrics := true
+	url := ""
+	_, err := NewKurtosisConfigFromOverrides(&v8.KurtosisConfigV8{
+		ConfigVersion:     config_version.ConfigVersion_v8,
+		ShouldSendMetrics: &shouldSendMetrics,
+		KurtosisClusters:  nil,
+		CloudConfig:       nil,
+		Tracing:           nil,
+		EventsWebhook: &v8.EventsWebhookConfigV8{
+			Url:        &url,
+			MaxRetries: nil,
+		},
+		ApiAuth:     nil,
+		EnclavePool: nil,
+	})
+	require.Error(t, err)
+}
+
+func TestApiAuthIsDisabledByDefault(t *testing.T) {
+	config, err := NewKurtosisConfigFromRequiredFields(false)
+	require.NoError(t, err)
+	require.False(t, config.GetIsApiAuthEnabled())
+}
+
+func TestEnclavePoolSeedPackageConfig(t *testing.T) {
+	shouldSendMetrics := true
+	locator := "github.com/kurtosis-tech/ethereum-package"
+	config, err := NewKurtosisConfigFromOverrides(&v8.KurtosisConfigV8{
+		ConfigVersion:     config_version.ConfigVersion_v8,
+		ShouldSendMetrics: &shouldSendMetrics,
+		KurtosisClusters:  nil,
+		CloudConfig:       nil,
+		Tracing:           nil,
+		EventsWebhook:     nil,
+		ApiAuth:           nil,
+		EnclavePool: &v8.EnclavePoolConfigV8{
+			SeedPackage: &v8.EnclavePoolSeedPackageConfigV8{
+				Locator: &locator,
+				Params:  nil,
+			},
+		},
+	})
+	require.NoError(t, err)
+	require.Equal(t, locator, config.GetEnclavePoolSeedPackage().PackageLocator)
+	require.Empty(t, config.GetEnclavePoolSeedPackage().PackageParams)
+}
@@ -23,13 +23,14 @@ const (
 	emptyApplicationProtocol      = ""
 	emptyPortUrl                  = ""
 	portForwardTimeBetweenRetries = 5 * time.Second
+	randomLocalPortNumber         = uint16(0)
 )
 
 // GatewayConnectionToKurtosis represents a connection on localhost that can be used by the gateway to communicate with Kurtosis in the cluster
 type GatewayConnectionToKurtosis interface {
 	// GetLocalPorts returns a map keyed with an identifier string describing local ports being forwarded
 	GetLocalPorts() map[string]*port_spec.PortSpec
-	GetGrpcClientConn() (*grpc.ClientConn, error)
+	GetGrpcClientConn(extraDialOptions ...grpc.DialOption) (*grpc.ClientConn, error)
 	Stop()
 }
 
@@ -51,7 +52,8 @@ type gatewayConnectionToKurtosisImpl struct {
 
 // newLocalPortToPodPortConnection binds a random local port to the remote port keyed with an identifier string
 // remotePortSpecs is a map keyed with an identifier string of port specs on the remote pod to forward requests to
-func newLocalPortToPodPortConnection(kubernetesRestConfig *k8s_rest.Config, podProxyEndpointUrl *url.URL, remotePortSpecs map[string]*port_spec.PortSpec) (*gatewayConnectionToKurtosisImpl, error) {
+// If shouldBindSameLocalPort is true, the local port has the same number as the remote port instead of a random one
+func newLocalPortToPodPortConnection(kubernetesRestConfig *k8s_rest.Config, podProxyEndpointUrl *url.URL, remotePortSpecs map[string]*port_spec.PortSpec, shouldBindSameLocalPort bool) (*gatewayConnectionToKurtosisImpl, error) {
 	var portforwardStdOut bytes.Buffer
 	var portforwardStdErr bytes.Buffer
 	portforwardStopChannel := make(chan struct{}, 1)
@@ -70,8 +72,12 @@ func newLocalPortToPodPortConnection(kubernetesRestConfig *k8s_rest.Config, podP
 			logrus.Warnf("The port with id '%v' won't be able to be forwarded from Kubernetes, it uses protocol '%v', but Kubernetes port forwarding only support the '%v' protocol", portspecId, portSpec.GetTransportProtocol(), port_spec.TransportProtocol_TCP)
 			continue
 		}
-		// For our purposes, local-port is set to 0, meaning the host will assign us a random local port
-		portString := fmt.Sprintf("0:%v", portSpec.GetNumber())
+		// Unless the same port is requested, local-port is set to 0, meaning the host will assign us a random local port
+		localPortNumber := randomLocalPortNumber
+		if shouldBindSameLocalPort {
+			localPortNumber = portSpec.GetNumber()
+		}
+		portString := fmt.Sprintf("%v:%v", localPortNumber, portSpec.GetNumber())
 		portStrings = append(portStrings, portString)
 		// Keep track of the portspec ID for the remote ports we connect to
 		remotePortNumberToPortSpecIdMapping[portSpec.GetNumber()] = portspecId
@@ -203,7 +209,7 @@ func (connection *gatewayConnectionToKurtosisImpl) GetLocalPorts() map[string]*p
 
 // GetGrpcClientConn returns a client conn dialed in to the local port
 // It is the caller's responsibility to call resultClientConn.close()
-func (connection *gatewayConnectionToKurtosisImpl) GetGrpcClientConn() (resultClientConn *grpc.ClientConn, resultErr error) {
+func (connection *gatewayConnectionToKurtosisImpl) GetGrpcClientConn(extraDialOptions ...grpc.DialOption) (resultClientConn *grpc.ClientConn, resultErr error) {
 	localPorts := connection.GetLocalPorts()
 	localGrpcPort, isFound := localPorts[grpcPortId]
 	if !isFound {
@@ -211,7 +217,8 @@ func (connection *gatewayConnectionToKurtosisImpl) GetGrpcClientConn() (resultCl
 	}
 	localGrpcPortNum := localPorts[grpcPortId].GetNumber()
 	localGrpcServerAddress := fmt.Sprintf("%v:%v", localHostIpStr, localGrpcPortNum)
-	grpcConnection, err := grpc.Dial(localGrpcServerAddress, grpc.WithTransportCredentials(insecure.NewCredentials()))
+	dialOptions := append([]grpc.DialOption{grpc.WithTransportCredentials(insecure.NewCredentials())}, extraDialOptions...)
+	grpcConnection, err := grpc.Dial(localGrpcServerAddress, dialOptions...)
 	if err != nil {
 		return nil, stacktrace.Propagate(err, "Expected to be able to create a GRPC client connection on address '%v', but a non-nil error was returned", localGrpcServerAddress)
 	}
@@ -7,10 +7,12 @@ import (
 
 	"github.com/kurtosis-tech/kurtosis/api/golang/engine/kurtosis_engine_rpc_api_bindings"
 	"github.com/kurtosis-tech/kurtosis/api/golang/engine/lib/kurtosis_context"
+	"github.com/kurtosis-tech/kurtosis/container-engine-lib/lib/backend_impls/kubernetes/kubernetes_kurtosis_backend/reverse_proxy_functions"
 	"github.com/kurtosis-tech/kurtosis/container-engine-lib/lib/backend_impls/kubernetes/kubernetes_manager"
 	"github.com/kurtosis-tech/kurtosis/container-engine-lib/lib/backend_impls/kubernetes/object_attributes_provider/label_value_consts"
 	"github.com/kurtosis-tech/kurtosis/container-engine-lib/lib/backend_interface/objects/engine"
 	"github.com/kurtosis-tech/kurtosis/container-engine-lib/lib/backend_interface/objects/port_spec"
+	"github.com/kurtosis-tech/kurtosis/container-engine-lib/lib/backend_interface/objects/reverse_proxy"
 	"github.com/kurtosis-tech/stacktrace"
 	v1 "k8s.io/api/core/v1"
 	"k8s.io/apimachinery/pkg/runtime/schema"
@@ -25,6 +27,10 @@ const (
 	// this doesn't have any effect as this is just the gateway
 	emptyStorageClassName = ""
 	emptyUrl              = ""
+
+	reverseProxyHttpPortIdStr = "http"
+	bindRandomLocalPorts      = false
+	bindSameLocalPorts        = true
 )
 
 var noWait *port_spec.Wait = nil
@@ -68,7 +74,7 @@ func (provider *GatewayConnectionProvider) ForEngine(engine *engine.Engine) (Gat
 	if err != nil {
 		return nil, stacktrace.Propagate(err, "Expected to be able to find an api endpoint for Kubernetes portforward to engine '%v', instead a non-nil error was returned", engine.GetGUID())
 	}
-	engineConnection, err := newLocalPortToPodPortConnection(provider.config, podPortforwardEndpoint, enginePorts)
+	engineConnection, err := newLocalPortToPodPortConnection(provider.config, podPortforwardEndpoint, enginePorts, bindRandomLocalPorts)
 	if err != nil {
 		return nil, stacktrace.Propagate(err, "Expected to be able to get a connection to engine '%v', instead a non-nil error was returned", engine.GetGUID())
 	}
@@ -91,7 +97,7 @@ func (provider *GatewayConnectionProvider) ForEnclaveApiContainer(enclaveInfo *k
 	if err != nil {
 		return nil, stacktrace.Propagate(err, "Expected to be able to get an endpoint for portforwarding to the API Container in enclave '%v', instead a non-nil error was returned", enclaveId)
 	}
-	apiContainerConnection, err := newLocalPortToPodPortConnection(provider.config, podPortforwardEndpoint, apiContainerPorts)
+	apiContainerConnection, err := newLocalPortToPodPortConnection(provider.config, podPortforwardEndpoint, apiContainerPorts, bindRandomLocalPorts)
 	if err != nil {
 		return nil, stacktrace.Propagate(err, "Expected to be able to connect to api container in enclave '%v', instead a non-nil error was returned", enclaveId)
 	}
@@ -105,13 +111,34 @@ func (provider *GatewayConnectionProvider) ForUserServiceIfRunning(enclaveId str
 		return nil, stacktrace.Propagate(err, "an error occurred while getting the enclave namespace name")
 	}
 	podPortforwardEndpoint := provider.getUserServicePortForwardEndpoint(enclaveNamespaceName, serviceName)
-	userServiceConnection, err := newLocalPortToPodPortConnection(provider.config, podPortforwardEndpoint, servicePortSpecs)
+	userServiceConnection, err := newLocalPortToPodPortConnection(provider.config, podPortforwardEndpoint, servicePortSpecs, bindRandomLocalPorts)
 	if err != nil {
 		return nil, stacktrace.Propagate(err, "Expected to be able to connect to user service with name '%v', instead a non-nil error was returned", serviceName)
 	}
 	return userServiceConnection, nil
 }
 
+// ForReverseProxy forwards the HTTP port of the reverse proxy to the same port on localhost, so the service URLs it
+// routes are browsable like on Docker
+func (provider *GatewayConnectionProvider) ForReverseProxy(reverseProxy *reverse_proxy.ReverseProxy) (GatewayConnectionToKurtosis, error) {
+	httpPortSpec, err := port_spec.NewPortSpec(reverseProxy.GetHttpPort(), port_spec.TransportProtocol_TCP, httpApplicationProtocol, noWait, emptyUrl)
+	if err != nil {
+		return nil, stacktrace.Propagate(err, "Expected to be able to get a port spec describing the reverse proxy HTTP port '%v', instead a non-nil error was returned", reverseProxy.GetHttpPort())
+	}
+	reverseProxyPorts := map[string]*port_spec.PortSpec{
+		reverseProxyHttpPortIdStr: httpPortSpec,
+	}
+	podPortforwardEndpoint, err := provider.getReverseProxyPodPortforwardEndpoint()
+	if err != nil {
+		return nil, stacktrace.Propagate(err, "Expected to be able to find an api endpoint for Kubernetes portforward to the reverse proxy, instead a non-nil error was returned")
+	}
+	reverseProxyConnection, err := newLocalPortToPodPortConnection(provider.config, podPortforwardEndpoint, reverseProxyPorts, bindSameLocalPorts)
+	if err != nil {
+		return nil, stacktrace.Propagate(err, "Expected to be able to get a connection to the reverse proxy, instead a non-nil error was returned")
+	}
+	return reverseProxyConnection, nil
+}
+
 func (provider *GatewayConnectionProvider) getEnginePodPortforwardEndpoint(engineGuid engine.EngineGUID) (*url.URL, error) {
 	engineLabels := map[string]string{
 		kubernetes_label_key.IDKubernetesLabelKey.GetString():                   string(engineGuid),
@@ -141,6 +168,28 @@ func (provider *GatewayConnectionProvider) getEnginePodPortforwardEndpoint(engin
 	return provider.kubernetesManager.GetPodPortforwardEndpointUrl(engineNamespaceName, enginePodName), nil
 }
 
+func (provider *GatewayConnectionProvider) getReverseProxyPodPortforwardEndpoint() (*url.URL, error) {
+	reverseProxyLabels := reverse_proxy_functions.GetReverseProxyMatchLabels()
+	reverseProxyNamespaceList, err := provider.kubernetesManager.GetNamespacesByLabels(provider.providerContext, reverseProxyLabels)
+	if err != nil {
+		return nil, stacktrace.Propagate(err, "Expected to be able to get reverse proxy namespaces with labels '%+v`, instead a non-nil error was returned", reverseProxyLabels)
+	}
+	if len(reverseProxyNamespaceList.Items) != 1 {
+		return nil, stacktrace.NewError("Expected to find exactly 1 reverse proxy namespace, but instead found '%v'", len(reverseProxyNamespaceList.Items))
+	}
+	reverseProxyNamespaceName := reverseProxyNamespaceList.Items[0].Name
+
+	runningReverseProxyPodNames, err := provider.getRunningPodNamesByLabels(reverseProxyNamespaceName, reverseProxyLabels)
+	if err != nil {
+		return nil, stacktrace.Propagate(err, "Expected to be able to get the names of running reverse proxy pods with labels '%+v', instead a non-nil error was returned", reverseProxyLabels)
+	}
+	if len(runningReverseProxyPodNames) != 1 {
+		return nil, stacktrace.NewError("Expected to find exactly 1 running reverse proxy pod, instead found '%v'", len(runningReverseProxyPodNames))
+	}
+
+	return provider.kubernetesManager.GetPodPortforwardEndpointUrl(reverseProxyNamespaceName, runningReverseProxyPodNames[0]), nil
+}
+
 func (provider *GatewayConnectionProvider) getApiContainerPodPortforwardEndpoint(enclaveId string) (*url.URL, error) {
 	enclaveNamespaceName, err := provider.getEnclaveNamespaceNameForEnclaveId(enclaveId)
 	if err != nil {
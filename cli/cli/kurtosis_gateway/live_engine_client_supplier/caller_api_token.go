@@ -0,0 +1,48 @@
+package live_engine_client_supplier
+
+import (
+	"context"
+
+	"github.com/kurtosis-tech/stacktrace"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/metadata"
+)
+
+const (
+	authorizationMetadataKey = "authorization"
+)
+
+// The gateway is a drop-in replacement for the engine, so its callers authenticate to the engine with their own API
+// token rather than with the one of the CLI running the gateway, which would let anyone reaching the gateway act as an
+// admin
+func newForwardCallerApiTokenUnaryInterceptor(isApiAuthEnabled bool) grpc.UnaryClientInterceptor {
+	return func(ctx context.Context, method string, req, reply interface{}, conn *grpc.ClientConn, invoker grpc.UnaryInvoker, opts ...grpc.CallOption) error {
+		ctxWithCallerApiToken, err := withCallerApiToken(ctx, isApiAuthEnabled)
+		if err != nil {
+			return stacktrace.Propagate(err, "Can't call '%v' on the engine", method)
+		}
+		return invoker(ctxWithCallerApiToken, method, req, reply, conn, opts...)
+	}
+}
+
+func newForwardCallerApiTokenStreamInterceptor(isApiAuthEnabled bool) grpc.StreamClientInterceptor {
+	return func(ctx context.Context, desc *grpc.StreamDesc, conn *grpc.ClientConn, method string, streamer grpc.Streamer, opts ...grpc.CallOption) (grpc.ClientStream, error) {
+		ctxWithCallerApiToken, err := withCallerApiToken(ctx, isApiAuthEnabled)
+		if err != nil {
+			return nil, stacktrace.Propagate(err, "Can't call '%v' on the engine", method)
+		}
+		return streamer(ctxWithCallerApiToken, desc, conn, method, opts...)
+	}
+}
+
+// The context is the one of the call the gateway received, whose API token is passed on to the engine
+func withCallerApiToken(ctx context.Context, isApiAuthEnabled bool) (context.Context, error) {
+	authorizationValues := metadata.ValueFromIncomingContext(ctx, authorizationMetadataKey)
+	if len(authorizationValues) == 0 {
+		if isApiAuthEnabled {
+			return nil, stacktrace.NewError("The engine requires an API token, which must be sent to the gateway as a bearer token in the '%v' metadata", authorizationMetadataKey)
+		}
+		return ctx, nil
+	}
+	return metadata.AppendToOutgoingContext(ctx, authorizationMetadataKey, authorizationValues[0]), nil
+}
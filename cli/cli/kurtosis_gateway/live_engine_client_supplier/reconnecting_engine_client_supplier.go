@@ -36,6 +36,9 @@ type LiveEngineClientSupplier struct {
 
 	connectionProvider *connection.GatewayConnectionProvider
 
+	// Whether the engine enforces API authentication, in which case the calls without an API token are refused
+	isApiAuthEnabled bool
+
 	currentInfo *engineInfo
 
 	stopUpdaterSignalChan chan interface{}
@@ -44,12 +47,14 @@ type LiveEngineClientSupplier struct {
 func NewLiveEngineClientSupplier(
 	kurtosisBackend backend_interface.KurtosisBackend,
 	connectionProvider *connection.GatewayConnectionProvider,
+	isApiAuthEnabled bool,
 ) *LiveEngineClientSupplier {
 	return &LiveEngineClientSupplier{
 		kubernetesBackend:     kurtosisBackend,
 		currentInfo:           nil,
 		stopUpdaterSignalChan: nil,
 		connectionProvider:    connectionProvider,
+		isApiAuthEnabled:      isApiAuthEnabled,
 	}
 }
 
@@ -165,8 +170,11 @@ func (supplier *LiveEngineClientSupplier) replaceCurrentEngineInfo(newEngine *en
 			newProxyConn.Stop()
 		}
 	}()
-	// Create an engine client that sends requests to our new client
-	newGrpcConnection, err := newProxyConn.GetGrpcClientConn()
+	// Create an engine client that sends requests to our new client, authenticating with the API token of each caller
+	newGrpcConnection, err := newProxyConn.GetGrpcClientConn(
+		grpc.WithChainUnaryInterceptor(newForwardCallerApiTokenUnaryInterceptor(supplier.isApiAuthEnabled)),
+		grpc.WithChainStreamInterceptor(newForwardCallerApiTokenStreamInterceptor(supplier.isApiAuthEnabled)),
+	)
 	if err != nil {
 		return stacktrace.Propagate(err, "Expected to be able to get a GRPC client connection to engine '%v', instead a non-nil error was returned", newEngine.GetGUID())
 	}
@@ -1,11 +1,13 @@
 package engine_gateway
 
 import (
+	"context"
 	"github.com/kurtosis-tech/kurtosis/api/golang/engine/kurtosis_engine_rpc_api_bindings"
 	"github.com/kurtosis-tech/kurtosis/cli/cli/kurtosis_gateway/connection"
 	"github.com/kurtosis-tech/kurtosis/cli/cli/kurtosis_gateway/live_engine_client_supplier"
 	"github.com/kurtosis-tech/kurtosis/cli/cli/kurtosis_gateway/server/engine_gateway"
 	"github.com/kurtosis-tech/kurtosis/container-engine-lib/lib/backend_interface"
+	"github.com/kurtosis-tech/kurtosis/container-engine-lib/lib/backend_interface/objects/container"
 	minimal_grpc_server "github.com/kurtosis-tech/minimal-grpc-server/golang/server"
 	"github.com/kurtosis-tech/stacktrace"
 	"github.com/sirupsen/logrus"
@@ -19,13 +21,15 @@ const (
 	grpcServerStopGracePeriod = 5 * time.Second
 )
 
-func RunEngineGatewayUntilInterrupted(kurtosisBackend backend_interface.KurtosisBackend, connectionProvider *connection.GatewayConnectionProvider) error {
-	engineClientSupplier := live_engine_client_supplier.NewLiveEngineClientSupplier(kurtosisBackend, connectionProvider)
+func RunEngineGatewayUntilInterrupted(kurtosisBackend backend_interface.KurtosisBackend, connectionProvider *connection.GatewayConnectionProvider, isApiAuthEnabled bool) error {
+	engineClientSupplier := live_engine_client_supplier.NewLiveEngineClientSupplier(kurtosisBackend, connectionProvider, isApiAuthEnabled)
 	if err := engineClientSupplier.Start(); err != nil {
 		return stacktrace.Propagate(err, "Expected to be able to start supplier for live Kurtosis engine clients, instead a non-nil error was returned")
 	}
 	engineGatewayServer, gatewayCloseFunc := engine_gateway.NewEngineGatewayServiceServer(connectionProvider, engineClientSupplier)
 	defer gatewayCloseFunc()
+	closeReverseProxyConnectionFunc := forwardReverseProxyIfRunning(kurtosisBackend, connectionProvider)
+	defer closeReverseProxyConnectionFunc()
 	engineGatewayServiceRegistrationFunc := func(grpcServer *grpc.Server) {
 		kurtosis_engine_rpc_api_bindings.RegisterEngineServiceServer(grpcServer, engineGatewayServer)
 	}
@@ -49,3 +53,25 @@ func RunEngineGatewayUntilInterrupted(kurtosisBackend backend_interface.Kurtosis
 	return nil
 
 }
+
+// forwardReverseProxyIfRunning makes the service URLs routed by the reverse proxy reachable from this machine
+// The gateway still works without it, so failures are only logged
+func forwardReverseProxyIfRunning(kurtosisBackend backend_interface.KurtosisBackend, connectionProvider *connection.GatewayConnectionProvider) func() {
+	noOpCloseFunc := func() {}
+	reverseProxy, err := kurtosisBackend.GetReverseProxy(context.Background())
+	if err != nil {
+		logrus.Warnf("An error occurred getting the reverse proxy, service URLs won't be reachable through the gateway:\n%v", err)
+		return noOpCloseFunc
+	}
+	if reverseProxy == nil || reverseProxy.GetStatus() != container.ContainerStatus_Running {
+		logrus.Debug("No running reverse proxy found, service URLs won't be reachable through the gateway")
+		return noOpCloseFunc
+	}
+	reverseProxyConnection, err := connectionProvider.ForReverseProxy(reverseProxy)
+	if err != nil {
+		logrus.Warnf("An error occurred forwarding the reverse proxy, service URLs won't be reachable through the gateway:\n%v", err)
+		return noOpCloseFunc
+	}
+	logrus.Infof("Service URLs are reachable through the reverse proxy on '%v:%v'", localHostIpStr, reverseProxy.GetHttpPort())
+	return reverseProxyConnection.Stop
+}
@@ -230,6 +230,68 @@ func (service *ApiContainerGatewayServiceServer) GetStarlarkRun(ctx context.Cont
 	return remoteApiContainerResponse, nil
 }
 
+func (service *ApiContainerGatewayServiceServer) GetLastActivityTime(ctx context.Context, args *emptypb.Empty) (*kurtosis_core_rpc_api_bindings.GetLastActivityTimeResponse, error) {
+	remoteApiContainerResponse, err := service.remoteApiContainerClient.GetLastActivityTime(ctx, args)
+	if err != nil {
+		return nil, stacktrace.Propagate(err, errorCallingRemoteApiContainerFromGateway)
+	}
+	return remoteApiContainerResponse, nil
+}
+
+func (service *ApiContainerGatewayServiceServer) GetEnclavePlan(ctx context.Context, args *emptypb.Empty) (*kurtosis_core_rpc_api_bindings.GetEnclavePlanResponse, error) {
+	remoteApiContainerResponse, err := service.remoteApiContainerClient.GetEnclavePlan(ctx, args)
+	if err != nil {
+		return nil, stacktrace.Propagate(err, errorCallingRemoteApiContainerFromGateway)
+	}
+	return remoteApiContainerResponse, nil
+}
+
+func (service *ApiContainerGatewayServiceServer) GetAuditLog(ctx context.Context, args *emptypb.Empty) (*kurtosis_core_rpc_api_bindings.GetAuditLogResponse, error) {
+	remoteApiContainerResponse, err := service.remoteApiContainerClient.GetAuditLog(ctx, args)
+	if err != nil {
+		return nil, stacktrace.Propagate(err, errorCallingRemoteApiContainerFromGateway)
+	}
+	return remoteApiContainerResponse, nil
+}
+
+func (service *ApiContainerGatewayServiceServer) RenameService(ctx context.Context, args *kurtosis_core_rpc_api_bindings.RenameServiceArgs) (*emptypb.Empty, error) {
+	remoteApiContainerResponse, err := service.remoteApiContainerClient.RenameService(ctx, args)
+	if err != nil {
+		return nil, stacktrace.Propagate(err, errorCallingRemoteApiContainerFromGateway)
+	}
+	return remoteApiContainerResponse, nil
+}
+
+func (service *ApiContainerGatewayServiceServer) RunStarlarkPackageUnitTests(ctx context.Context, args *kurtosis_core_rpc_api_bindings.RunStarlarkPackageUnitTestsArgs) (*kurtosis_core_rpc_api_bindings.RunStarlarkPackageUnitTestsResponse, error) {
+	remoteApiContainerResponse, err := service.remoteApiContainerClient.RunStarlarkPackageUnitTests(ctx, args)
+	if err != nil {
+		return nil, stacktrace.Propagate(err, errorCallingRemoteApiContainerFromGateway)
+	}
+	return remoteApiContainerResponse, nil
+}
+
+func (service *ApiContainerGatewayServiceServer) WatchEvents(args *emptypb.Empty, streamToWriteTo kurtosis_core_rpc_api_bindings.ApiContainerService_WatchEventsServer) error {
+	streamToReadFrom, err := service.remoteApiContainerClient.WatchEvents(streamToWriteTo.Context(), args)
+	if err != nil {
+		return stacktrace.Propagate(err, errorCallingRemoteApiContainerFromGateway)
+	}
+	if err := common.ForwardKurtosisExecutionStream[kurtosis_core_rpc_api_bindings.ApiContainerEvent](streamToReadFrom, streamToWriteTo); err != nil {
+		return stacktrace.Propagate(err, "Error forwarding the event stream from Kurtosis core back to the user")
+	}
+	return nil
+}
+
+func (service *ApiContainerGatewayServiceServer) GetServiceStats(args *kurtosis_core_rpc_api_bindings.GetServiceStatsArgs, streamToWriteTo kurtosis_core_rpc_api_bindings.ApiContainerService_GetServiceStatsServer) error {
+	streamToReadFrom, err := service.remoteApiContainerClient.GetServiceStats(streamToWriteTo.Context(), args)
+	if err != nil {
+		return stacktrace.Propagate(err, errorCallingRemoteApiContainerFromGateway)
+	}
+	if err := common.ForwardKurtosisExecutionStream[kurtosis_core_rpc_api_bindings.GetServiceStatsResponse](streamToReadFrom, streamToWriteTo); err != nil {
+		return stacktrace.Propagate(err, "Error forwarding the service stats stream from Kurtosis core back to the user")
+	}
+	return nil
+}
+
 func (service *ApiContainerGatewayServiceServer) GetStarlarkScriptPlanYaml(ctx context.Context, args *kurtosis_core_rpc_api_bindings.StarlarkScriptPlanYamlArgs) (*kurtosis_core_rpc_api_bindings.PlanYaml, error) {
 	remoteApiContainerResponse, err := service.remoteApiContainerClient.GetStarlarkScriptPlanYaml(ctx, args)
 	if err != nil {
@@ -3,6 +3,7 @@ package engine_gateway
 import (
 	"context"
 	"fmt"
+	"io"
 	"sync"
 	"time"
 
@@ -120,7 +121,7 @@ func (service *EngineGatewayServiceServer) CreateEnclave(ctx context.Context, ar
 	return remoteEngineResponse, nil
 }
 
-func (service *EngineGatewayServiceServer) GetEnclaves(ctx context.Context, in *emptypb.Empty) (*kurtosis_engine_rpc_api_bindings.GetEnclavesResponse, error) {
+func (service *EngineGatewayServiceServer) GetEnclaves(ctx context.Context, in *kurtosis_engine_rpc_api_bindings.GetEnclavesArgs) (*kurtosis_engine_rpc_api_bindings.GetEnclavesResponse, error) {
 	remoteEngineClient, err := service.engineClientSupplier.GetEngineClient()
 	if err != nil {
 		return nil, stacktrace.Propagate(err, "Expected to be able to get a client for a live Kurtosis engine, instead a non nil error was returned")
@@ -212,6 +213,171 @@ func (service *EngineGatewayServiceServer) StopEnclave(ctx context.Context, args
 	return &emptypb.Empty{}, nil
 }
 
+func (service *EngineGatewayServiceServer) ExtendEnclaveTtl(ctx context.Context, args *kurtosis_engine_rpc_api_bindings.ExtendEnclaveTtlArgs) (*emptypb.Empty, error) {
+	remoteEngineClient, err := service.engineClientSupplier.GetEngineClient()
+	if err != nil {
+		return nil, stacktrace.Propagate(err, "Expected to be able to get a client for a live Kurtosis engine, instead a non nil error was returned")
+	}
+	if _, err := remoteEngineClient.ExtendEnclaveTtl(ctx, args); err != nil {
+		return nil, stacktrace.Propagate(err, "An error occurred calling remote engine to extend the time-to-live of enclave '%v'", args.EnclaveIdentifier)
+	}
+
+	return &emptypb.Empty{}, nil
+}
+
+func (service *EngineGatewayServiceServer) UpdateEnclaveLabels(ctx context.Context, args *kurtosis_engine_rpc_api_bindings.UpdateEnclaveLabelsArgs) (*emptypb.Empty, error) {
+	remoteEngineClient, err := service.engineClientSupplier.GetEngineClient()
+	if err != nil {
+		return nil, stacktrace.Propagate(err, "Expected to be able to get a client for a live Kurtosis engine, instead a non nil error was returned")
+	}
+	if _, err := remoteEngineClient.UpdateEnclaveLabels(ctx, args); err != nil {
+		return nil, stacktrace.Propagate(err, "An error occurred calling remote engine to update the labels of enclave '%v'", args.EnclaveIdentifier)
+	}
+
+	return &emptypb.Empty{}, nil
+}
+
+func (service *EngineGatewayServiceServer) RenameEnclave(ctx context.Context, args *kurtosis_engine_rpc_api_bindings.RenameEnclaveArgs) (*emptypb.Empty, error) {
+	remoteEngineClient, err := service.engineClientSupplier.GetEngineClient()
+	if err != nil {
+		return nil, stacktrace.Propagate(err, "Expected to be able to get a client for a live Kurtosis engine, instead a non nil error was returned")
+	}
+	if _, err := remoteEngineClient.RenameEnclave(ctx, args); err != nil {
+		return nil, stacktrace.Propagate(err, "An error occurred calling remote engine to rename enclave '%v' to '%v'", args.EnclaveIdentifier, args.NewName)
+	}
+
+	return &emptypb.Empty{}, nil
+}
+
+func (service *EngineGatewayServiceServer) CloneEnclave(ctx context.Context, args *kurtosis_engine_rpc_api_bindings.CloneEnclaveArgs) (*kurtosis_engine_rpc_api_bindings.CloneEnclaveResponse, error) {
+	remoteEngineClient, err := service.engineClientSupplier.GetEngineClient()
+	if err != nil {
+		return nil, stacktrace.Propagate(err, "Expected to be able to get a client for a live Kurtosis engine, instead a non nil error was returned")
+	}
+	remoteEngineResponse, err := remoteEngineClient.CloneEnclave(ctx, args)
+	if err != nil {
+		return nil, stacktrace.Propagate(err, "An error occurred cloning enclave '%v' through the remote engine", args.GetEnclaveIdentifier())
+	}
+	if remoteEngineResponse.EnclaveInfo == nil {
+		return nil, stacktrace.NewError("Expected the response from the remote engine to have info on the clone of enclave '%v', instead no enclave information was found.", args.GetEnclaveIdentifier())
+	}
+	clonedEnclaveId := remoteEngineResponse.GetEnclaveInfo().GetEnclaveUuid()
+	cleanUpEnclave := true
+	defer func() {
+		if cleanUpEnclave {
+			destroyEnclaveArgs := &kurtosis_engine_rpc_api_bindings.DestroyEnclaveArgs{EnclaveIdentifier: clonedEnclaveId}
+			if _, err := remoteEngineClient.DestroyEnclave(ctx, destroyEnclaveArgs); err != nil {
+				logrus.Errorf("Launching the gateway of the cloned enclave failed, and an error occurred destroying it. ACTION REQUIRED: You'll need to manually destroy enclave '%v'. Error was:\n%v", clonedEnclaveId, err)
+			}
+		}
+	}()
+
+	runningApiContainerGateway, err := service.startRunningGatewayForEnclave(remoteEngineResponse.GetEnclaveInfo())
+	if err != nil {
+		return nil, stacktrace.Propagate(err, "Expected to be able to start a local gateway for enclave '%v', instead a non-nil err was returned", clonedEnclaveId)
+	}
+	remoteEngineResponse.EnclaveInfo.ApiContainerHostMachineInfo = runningApiContainerGateway.hostMachineInfo
+
+	cleanUpEnclave = false
+	return remoteEngineResponse, nil
+}
+
+func (service *EngineGatewayServiceServer) ExportEnclave(
+	args *kurtosis_engine_rpc_api_bindings.ExportEnclaveArgs,
+	streamToWriteTo kurtosis_engine_rpc_api_bindings.EngineService_ExportEnclaveServer,
+) error {
+	remoteEngineClient, err := service.engineClientSupplier.GetEngineClient()
+	if err != nil {
+		return stacktrace.Propagate(err, "Expected to be able to get a client for a live Kurtosis engine, instead a non nil error was returned")
+	}
+	streamToReadFrom, err := remoteEngineClient.ExportEnclave(streamToWriteTo.Context(), args)
+	if err != nil {
+		return stacktrace.Propagate(err, "An error occurred exporting enclave '%v' through the remote engine", args.GetEnclaveIdentifier())
+	}
+	if err := common.ForwardKurtosisExecutionStream[kurtosis_engine_rpc_api_bindings.EnclaveArchiveChunk](streamToReadFrom, streamToWriteTo); err != nil {
+		return stacktrace.Propagate(err, "Error forwarding the enclave archive from Kurtosis engine back to the user")
+	}
+	return nil
+}
+
+func (service *EngineGatewayServiceServer) ImportEnclave(streamToReadFrom kurtosis_engine_rpc_api_bindings.EngineService_ImportEnclaveServer) error {
+	remoteEngineClient, err := service.engineClientSupplier.GetEngineClient()
+	if err != nil {
+		return stacktrace.Propagate(err, "Expected to be able to get a client for a live Kurtosis engine, instead a non nil error was returned")
+	}
+	ctx := streamToReadFrom.Context()
+	streamToWriteTo, err := remoteEngineClient.ImportEnclave(ctx)
+	if err != nil {
+		return stacktrace.Propagate(err, "An error occurred starting to import an enclave through the remote engine")
+	}
+	for {
+		archiveChunk, err := streamToReadFrom.Recv()
+		if err == io.EOF {
+			break
+		}
+		if err != nil {
+			return stacktrace.Propagate(err, "An error occurred receiving the enclave archive from the user")
+		}
+		if err := streamToWriteTo.Send(archiveChunk); err != nil {
+			return stacktrace.Propagate(err, "An error occurred forwarding the enclave archive to the remote engine")
+		}
+	}
+	remoteEngineResponse, err := streamToWriteTo.CloseAndRecv()
+	if err != nil {
+		return stacktrace.Propagate(err, "An error occurred importing the enclave archive through the remote engine")
+	}
+	if remoteEngineResponse.EnclaveInfo == nil {
+		return stacktrace.NewError("Expected the response from the remote engine to have info on the imported enclave, instead no enclave information was found.")
+	}
+	importedEnclaveId := remoteEngineResponse.GetEnclaveInfo().GetEnclaveUuid()
+	cleanUpEnclave := true
+	defer func() {
+		if cleanUpEnclave {
+			destroyEnclaveArgs := &kurtosis_engine_rpc_api_bindings.DestroyEnclaveArgs{EnclaveIdentifier: importedEnclaveId}
+			if _, err := remoteEngineClient.DestroyEnclave(ctx, destroyEnclaveArgs); err != nil {
+				logrus.Errorf("Launching the gateway of the imported enclave failed, and an error occurred destroying it. ACTION REQUIRED: You'll need to manually destroy enclave '%v'. Error was:\n%v", importedEnclaveId, err)
+			}
+		}
+	}()
+
+	runningApiContainerGateway, err := service.startRunningGatewayForEnclave(remoteEngineResponse.GetEnclaveInfo())
+	if err != nil {
+		return stacktrace.Propagate(err, "Expected to be able to start a local gateway for enclave '%v', instead a non-nil err was returned", importedEnclaveId)
+	}
+	remoteEngineResponse.EnclaveInfo.ApiContainerHostMachineInfo = runningApiContainerGateway.hostMachineInfo
+
+	if err := streamToReadFrom.SendAndClose(remoteEngineResponse); err != nil {
+		return stacktrace.Propagate(err, "An error occurred sending the imported enclave back to the user")
+	}
+	cleanUpEnclave = false
+	return nil
+}
+
+func (service *EngineGatewayServiceServer) CreateApiToken(ctx context.Context, args *kurtosis_engine_rpc_api_bindings.CreateApiTokenArgs) (*kurtosis_engine_rpc_api_bindings.CreateApiTokenResponse, error) {
+	remoteEngineClient, err := service.engineClientSupplier.GetEngineClient()
+	if err != nil {
+		return nil, stacktrace.Propagate(err, "Expected to be able to get a client for a live Kurtosis engine, instead a non nil error was returned")
+	}
+	response, err := remoteEngineClient.CreateApiToken(ctx, args)
+	if err != nil {
+		return nil, stacktrace.Propagate(err, "An error occurred calling remote engine to create API token '%v'", args.Name)
+	}
+
+	return response, nil
+}
+
+func (service *EngineGatewayServiceServer) RevokeApiToken(ctx context.Context, args *kurtosis_engine_rpc_api_bindings.RevokeApiTokenArgs) (*emptypb.Empty, error) {
+	remoteEngineClient, err := service.engineClientSupplier.GetEngineClient()
+	if err != nil {
+		return nil, stacktrace.Propagate(err, "Expected to be able to get a client for a live Kurtosis engine, instead a non nil error was returned")
+	}
+	if _, err := remoteEngineClient.RevokeApiToken(ctx, args); err != nil {
+		return nil, stacktrace.Propagate(err, "An error occurred calling remote engine to revoke API token '%v'", args.TokenId)
+	}
+
+	return &emptypb.Empty{}, nil
+}
+
 func (service *EngineGatewayServiceServer) DestroyEnclave(ctx context.Context, args *kurtosis_engine_rpc_api_bindings.DestroyEnclaveArgs) (*emptypb.Empty, error) {
 	remoteEngineClient, err := service.engineClientSupplier.GetEngineClient()
 	if err != nil {
@@ -255,6 +421,30 @@ func (service *EngineGatewayServiceServer) GetEngineInfo(ctx context.Context, em
 	return remoteEngineResponse, nil
 }
 
+func (service *EngineGatewayServiceServer) GetAuditLog(ctx context.Context, args *kurtosis_engine_rpc_api_bindings.GetAuditLogArgs) (*kurtosis_engine_rpc_api_bindings.GetAuditLogResponse, error) {
+	remoteEngineClient, err := service.engineClientSupplier.GetEngineClient()
+	if err != nil {
+		return nil, stacktrace.Propagate(err, "Expected to be able to get a client for a live Kurtosis engine, instead a non nil error was returned")
+	}
+	remoteEngineResponse, err := remoteEngineClient.GetAuditLog(ctx, args)
+	if err != nil {
+		return nil, stacktrace.Propagate(err, "An error occurred getting the audit log through the remote engine")
+	}
+	return remoteEngineResponse, nil
+}
+
+func (service *EngineGatewayServiceServer) GetEnclaveCallerCredential(ctx context.Context, args *kurtosis_engine_rpc_api_bindings.GetEnclaveCallerCredentialArgs) (*kurtosis_engine_rpc_api_bindings.GetEnclaveCallerCredentialResponse, error) {
+	remoteEngineClient, err := service.engineClientSupplier.GetEngineClient()
+	if err != nil {
+		return nil, stacktrace.Propagate(err, "Expected to be able to get a client for a live Kurtosis engine, instead a non nil error was returned")
+	}
+	remoteEngineResponse, err := remoteEngineClient.GetEnclaveCallerCredential(ctx, args)
+	if err != nil {
+		return nil, stacktrace.Propagate(err, "An error occurred getting the caller credential of enclave '%v' through the remote engine", args.GetEnclaveIdentifier())
+	}
+	return remoteEngineResponse, nil
+}
+
 func (service *EngineGatewayServiceServer) GetServiceLogs(
 	args *kurtosis_engine_rpc_api_bindings.GetServiceLogsArgs,
 	streamToWriteTo kurtosis_engine_rpc_api_bindings.EngineService_GetServiceLogsServer,
@@ -273,6 +463,24 @@ func (service *EngineGatewayServiceServer) GetServiceLogs(
 	return nil
 }
 
+func (service *EngineGatewayServiceServer) WatchEvents(
+	args *kurtosis_engine_rpc_api_bindings.WatchEventsArgs,
+	streamToWriteTo kurtosis_engine_rpc_api_bindings.EngineService_WatchEventsServer,
+) error {
+	remoteEngineClient, err := service.engineClientSupplier.GetEngineClient()
+	if err != nil {
+		return stacktrace.Propagate(err, "Expected to be able to get a client for a live Kurtosis engine, instead a non nil error was returned")
+	}
+	streamToReadFrom, err := remoteEngineClient.WatchEvents(streamToWriteTo.Context(), args)
+	if err != nil {
+		return stacktrace.Propagate(err, "An error occurred watching the engine events")
+	}
+	if err := common.ForwardKurtosisExecutionStream[kurtosis_engine_rpc_api_bindings.EngineEvent](streamToReadFrom, streamToWriteTo); err != nil {
+		return stacktrace.Propagate(err, "Error forwarding the event stream from Kurtosis engine back to the user")
+	}
+	return nil
+}
+
 // Private functions for managing our running enclave api container gateways
 func (service *EngineGatewayServiceServer) startRunningGatewayForEnclave(enclaveInfo *kurtosis_engine_rpc_api_bindings.EnclaveInfo) (*runningApiContainerGateway, error) {
 	service.mutex.Lock()
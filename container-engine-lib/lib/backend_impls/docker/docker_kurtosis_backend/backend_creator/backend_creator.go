@@ -62,36 +62,13 @@ func GetDockerKurtosisBackend(
 ) (backend_interface.KurtosisBackend, error) {
 	var kurtosisBackend backend_interface.KurtosisBackend
 	var err error
-	usePodmanMode := false
 	if optionalRemoteBackendConfig != nil {
-		kurtosisBackend, err = getRemoteDockerKurtosisBackend(optionalApiContainerModeArgs, optionalRemoteBackendConfig, usePodmanMode)
+		kurtosisBackend, err = getRemoteDockerKurtosisBackend(optionalApiContainerModeArgs, optionalRemoteBackendConfig)
 		if err != nil {
 			return nil, stacktrace.Propagate(err, "An error occurred creating a remote Docker backend")
 		}
 	} else {
-		kurtosisBackend, err = getLocalDockerKurtosisBackend(optionalApiContainerModeArgs, usePodmanMode)
-		if err != nil {
-			return nil, stacktrace.Propagate(err, "An error occurred creating a local Docker backend")
-		}
-	}
-	return kurtosisBackend, nil
-}
-
-// GetPodmanKurtosisBackend is the same as GetDockerKurtosisBackend, but it will use Podman as the container runtime
-func GetPodmanKurtosisBackend(
-	optionalApiContainerModeArgs *APIContainerModeArgs,
-	optionalRemoteBackendConfig *configs.KurtosisRemoteBackendConfig,
-) (backend_interface.KurtosisBackend, error) {
-	usePodmanMode := true
-	var kurtosisBackend backend_interface.KurtosisBackend
-	var err error
-	if optionalRemoteBackendConfig != nil {
-		kurtosisBackend, err = getRemoteDockerKurtosisBackend(optionalApiContainerModeArgs, optionalRemoteBackendConfig, usePodmanMode)
-		if err != nil {
-			return nil, stacktrace.Propagate(err, "An error occurred creating a remote Docker backend")
-		}
-	} else {
-		kurtosisBackend, err = getLocalDockerKurtosisBackend(optionalApiContainerModeArgs, usePodmanMode)
+		kurtosisBackend, err = getLocalDockerKurtosisBackend(optionalApiContainerModeArgs)
 		if err != nil {
 			return nil, stacktrace.Propagate(err, "An error occurred creating a local Docker backend")
 		}
@@ -102,7 +79,6 @@ func GetPodmanKurtosisBackend(
 // getLocalDockerKurtosisBackend is a Docker backend running locally
 func getLocalDockerKurtosisBackend(
 	optionalApiContainerModeArgs *APIContainerModeArgs,
-	usePodmanMode bool,
 ) (backend_interface.KurtosisBackend, error) {
 	dockerClientOpts := []client.Opt{
 		client.WithAPIVersionNegotiation(),
@@ -134,7 +110,11 @@ func getLocalDockerKurtosisBackend(
 		dockerClientOpts = append(dockerClientOpts, client.FromEnv)
 	}
 
-	localDockerBackend, err := getDockerKurtosisBackend(dockerClientOpts, optionalApiContainerModeArgs, usePodmanMode)
+	dockerManager, err := docker_manager.CreateDockerManager(dockerClientOpts)
+	if err != nil {
+		return nil, stacktrace.Propagate(err, "An error occurred building Docker manager")
+	}
+	localDockerBackend, err := getDockerKurtosisBackend(dockerManager, optionalApiContainerModeArgs)
 	if err != nil {
 		return nil, stacktrace.Propagate(err, "Unable to build local Kurtosis Docker backend")
 	}
@@ -145,14 +125,17 @@ func getLocalDockerKurtosisBackend(
 func getRemoteDockerKurtosisBackend(
 	optionalApiContainerModeArgs *APIContainerModeArgs,
 	remoteBackendConfig *configs.KurtosisRemoteBackendConfig,
-	usePodmanMode bool,
 ) (backend_interface.KurtosisBackend, error) {
 	remoteDockerClientOpts, cleanCertFilesFunc, err := buildRemoteDockerClientOpts(remoteBackendConfig)
 	if err != nil {
 		return nil, stacktrace.Propagate(err, "Error building client configuration for Docker remote backend")
 	}
 	defer cleanCertFilesFunc()
-	kurtosisRemoteBackend, err := getDockerKurtosisBackend(remoteDockerClientOpts, optionalApiContainerModeArgs, usePodmanMode)
+	dockerManager, err := docker_manager.CreateDockerManager(remoteDockerClientOpts)
+	if err != nil {
+		return nil, stacktrace.Propagate(err, "An error occurred building Docker manager")
+	}
+	kurtosisRemoteBackend, err := getDockerKurtosisBackend(dockerManager, optionalApiContainerModeArgs)
 	if err != nil {
 		return nil, stacktrace.Propagate(err, "Error building Kurtosis remote Docker backend")
 	}
@@ -214,25 +197,12 @@ func writeTlsConfigToTempDir(ca []byte, cert []byte, key []byte) (string, func()
 	return tempDirectory, cleanDirectoryFunc, nil
 }
 
+// getDockerKurtosisBackend builds the backend on top of the given manager, which can talk to Docker as well as to the
+// Docker-compatible API of Podman
 func getDockerKurtosisBackend(
-	dockerClientOpts []client.Opt,
+	dockerManager *docker_manager.DockerManager,
 	optionalApiContainerModeArgs *APIContainerModeArgs,
-	usePodmanMode bool,
 ) (backend_interface.KurtosisBackend, error) {
-	var dockerManager *docker_manager.DockerManager
-	var err error
-	if usePodmanMode {
-		dockerManager, err = docker_manager.CreatePodmanManager(dockerClientOpts)
-		if err != nil {
-			return nil, stacktrace.Propagate(err, "An error occurred building Podman manager")
-		}
-	} else {
-		dockerManager, err = docker_manager.CreateDockerManager(dockerClientOpts)
-		if err != nil {
-			return nil, stacktrace.Propagate(err, "An error occurred building Docker manager")
-		}
-	}
-
 	// If running within the API container context, detect the network that the API container is running inside
 	// so, we can create the free IP address trackers
 	enclaveFreeIpAddrTrackers := map[enclave.EnclaveUUID]*free_ip_addr_tracker.FreeIpAddrTracker{}
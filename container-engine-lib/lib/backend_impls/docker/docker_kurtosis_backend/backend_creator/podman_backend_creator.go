@@ -0,0 +1,128 @@
+package backend_creator
+
+import (
+	"fmt"
+	"os"
+	"path"
+
+	"github.com/docker/docker/client"
+	"github.com/kurtosis-tech/kurtosis/container-engine-lib/lib/backend_impls/docker/docker_manager"
+	"github.com/kurtosis-tech/kurtosis/container-engine-lib/lib/backend_interface"
+	"github.com/kurtosis-tech/kurtosis/container-engine-lib/lib/backend_interface/objects/configs"
+	"github.com/kurtosis-tech/stacktrace"
+	"github.com/sirupsen/logrus"
+)
+
+const (
+	// Podman's own equivalent of DOCKER_HOST, which takes precedence over it
+	podmanHostEnvVar = "CONTAINER_HOST"
+
+	xdgRuntimeDirEnvVar = "XDG_RUNTIME_DIR"
+
+	// Relative to the user runtime dir, where 'systemctl --user enable --now podman.socket' creates the socket
+	rootlessPodmanSocketRelativePath = "podman/podman.sock"
+	userRuntimeDirFormat             = "/run/user/%d"
+
+	// Where 'systemctl enable --now podman.socket' creates the socket
+	rootfulPodmanSocket = "/run/podman/podman.sock"
+)
+
+// GetPodmanKurtosisBackend is the same as GetDockerKurtosisBackend, but it will use Podman as the container runtime
+// The local Podman socket is looked up rootless first, then rootful, and the backend adapts to rootless Podman
+func GetPodmanKurtosisBackend(
+	optionalApiContainerModeArgs *APIContainerModeArgs,
+	optionalRemoteBackendConfig *configs.KurtosisRemoteBackendConfig,
+) (backend_interface.KurtosisBackend, error) {
+	var kurtosisBackend backend_interface.KurtosisBackend
+	var err error
+	if optionalRemoteBackendConfig != nil {
+		kurtosisBackend, err = getRemotePodmanKurtosisBackend(optionalApiContainerModeArgs, optionalRemoteBackendConfig)
+		if err != nil {
+			return nil, stacktrace.Propagate(err, "An error occurred creating a remote Podman backend")
+		}
+	} else {
+		kurtosisBackend, err = getLocalPodmanKurtosisBackend(optionalApiContainerModeArgs)
+		if err != nil {
+			return nil, stacktrace.Propagate(err, "An error occurred creating a local Podman backend")
+		}
+	}
+	return kurtosisBackend, nil
+}
+
+// getLocalPodmanKurtosisBackend is a Podman backend running locally
+func getLocalPodmanKurtosisBackend(
+	optionalApiContainerModeArgs *APIContainerModeArgs,
+) (backend_interface.KurtosisBackend, error) {
+	podmanClientOpts := []client.Opt{
+		client.WithAPIVersionNegotiation(),
+	}
+
+	// Podman's and Docker's env variables win over any socket, then the rootless socket of the current user wins over
+	// the rootful one, as rootless is how Podman is meant to be run
+	if podmanHostEnvVarValue := os.Getenv(podmanHostEnvVar); podmanHostEnvVarValue != "" {
+		logrus.Debugf("Connecting to Podman at '%s'", podmanHostEnvVarValue)
+		podmanClientOpts = append(podmanClientOpts, client.WithHost(podmanHostEnvVarValue))
+	} else if dockerHostEnvVar := os.Getenv(client.EnvOverrideHost); dockerHostEnvVar != "" {
+		logrus.Debugf("Connecting to Podman at '%s'", dockerHostEnvVar)
+		podmanClientOpts = append(podmanClientOpts, client.WithHostFromEnv())
+	} else if podmanSocketPath := getLocalPodmanSocketPath(); podmanSocketPath != "" {
+		logrus.Debugf("Connecting to Podman via unix socket '%s'", podmanSocketPath)
+		fullyQualifiedUnixSocket := fmt.Sprintf("%s%s", unixSocketPrefix, podmanSocketPath)
+		podmanClientOpts = append(podmanClientOpts, client.WithHost(fullyQualifiedUnixSocket))
+	} else {
+		logrus.Debugf("Unable to locate the Podman socket and neither '%s' nor '%s' environment variables were set. "+
+			"Falling back to Docker's own way to connect to a locally running daemon. If it fails, make sure the "+
+			"Podman socket is active, e.g. with 'systemctl --user enable --now podman.socket', and try setting '%s'.",
+			podmanHostEnvVar, client.EnvOverrideHost, podmanHostEnvVar)
+		podmanClientOpts = append(podmanClientOpts, client.FromEnv)
+	}
+
+	podmanManager, err := docker_manager.CreatePodmanManager(podmanClientOpts)
+	if err != nil {
+		return nil, stacktrace.Propagate(err, "An error occurred building Podman manager")
+	}
+	localPodmanBackend, err := getDockerKurtosisBackend(podmanManager, optionalApiContainerModeArgs)
+	if err != nil {
+		return nil, stacktrace.Propagate(err, "Unable to build local Kurtosis Podman backend")
+	}
+	return localPodmanBackend, nil
+}
+
+// getRemotePodmanKurtosisBackend is a Podman backend running on a remote host
+func getRemotePodmanKurtosisBackend(
+	optionalApiContainerModeArgs *APIContainerModeArgs,
+	remoteBackendConfig *configs.KurtosisRemoteBackendConfig,
+) (backend_interface.KurtosisBackend, error) {
+	remotePodmanClientOpts, cleanCertFilesFunc, err := buildRemoteDockerClientOpts(remoteBackendConfig)
+	if err != nil {
+		return nil, stacktrace.Propagate(err, "Error building client configuration for Podman remote backend")
+	}
+	defer cleanCertFilesFunc()
+	podmanManager, err := docker_manager.CreatePodmanManager(remotePodmanClientOpts)
+	if err != nil {
+		return nil, stacktrace.Propagate(err, "An error occurred building Podman manager")
+	}
+	kurtosisRemoteBackend, err := getDockerKurtosisBackend(podmanManager, optionalApiContainerModeArgs)
+	if err != nil {
+		return nil, stacktrace.Propagate(err, "Error building Kurtosis remote Podman backend")
+	}
+	return kurtosisRemoteBackend, nil
+}
+
+// getLocalPodmanSocketPath returns the path of the first existing local Podman socket, or an empty string if none exists
+func getLocalPodmanSocketPath() string {
+	userRuntimeDir := os.Getenv(xdgRuntimeDirEnvVar)
+	if userRuntimeDir == "" {
+		userRuntimeDir = fmt.Sprintf(userRuntimeDirFormat, os.Getuid())
+	}
+	candidateSocketPaths := []string{
+		path.Join(userRuntimeDir, rootlessPodmanSocketRelativePath),
+		rootfulPodmanSocket,
+	}
+	for _, candidateSocketPath := range candidateSocketPaths {
+		if _, err := os.Stat(candidateSocketPath); err == nil {
+			return candidateSocketPath
+		}
+	}
+	return ""
+}
@@ -0,0 +1,174 @@
+//go:build podman
+
+package backend_creator
+
+import (
+	"context"
+	"fmt"
+	"net"
+	"os"
+	"testing"
+	"time"
+
+	"github.com/docker/docker/client"
+	"github.com/docker/go-connections/nat"
+	"github.com/kurtosis-tech/kurtosis/container-engine-lib/lib/backend_impls/docker/docker_manager"
+	"github.com/kurtosis-tech/kurtosis/container-engine-lib/lib/backend_interface/objects/service_user"
+	"github.com/stretchr/testify/require"
+)
+
+// These tests run against the Podman socket of CONTAINER_HOST, or else the local one. They're only built with the
+// 'podman' tag, and fail rather than skip when there is no socket: 'go test -tags podman ./...'. Activate the local
+// socket with 'systemctl --user enable --now podman.socket' to run them rootless.
+
+const (
+	compatTestImage         = "alpine:3.17"
+	compatTestLabelKey      = "com.kurtosistech.podman-compat-test"
+	compatTestNetworkSubnet = "10.234.0.0/24"
+	compatTestNetworkGw     = "10.234.0.1"
+	compatTestNonRootUid    = 1000
+	compatTestContainerPort = "8080/tcp"
+	compatTestTimeout       = 2 * time.Minute
+)
+
+func TestPodmanCompatibility_ManagerDetectsPodman(t *testing.T) {
+	podmanManager := getPodmanManager(t)
+	require.True(t, podmanManager.IsPodman())
+	require.Equal(t, docker_manager.NameOfNetworkToStartEngineAndLogServiceContainersInPodman, podmanManager.GetBridgeNetworkName())
+	t.Logf("Podman rootless mode: %v", podmanManager.IsRootlessPodman())
+}
+
+func TestPodmanCompatibility_CreateNetwork(t *testing.T) {
+	podmanManager := getPodmanManager(t)
+	ctx, cancelFunc := context.WithTimeout(context.Background(), compatTestTimeout)
+	defer cancelFunc()
+
+	networkName := getCompatTestObjectName("network")
+	labels := map[string]string{compatTestLabelKey: networkName}
+	networkId, err := podmanManager.CreateNetwork(ctx, networkName, compatTestNetworkSubnet, net.ParseIP(compatTestNetworkGw), labels)
+	require.NoError(t, err)
+	defer func() {
+		require.NoError(t, podmanManager.RemoveNetwork(context.Background(), networkId))
+	}()
+
+	matchingNetworks, err := podmanManager.GetNetworksByLabels(ctx, labels)
+	require.NoError(t, err)
+	require.Len(t, matchingNetworks, 1)
+	require.Equal(t, compatTestNetworkSubnet, matchingNetworks[0].GetIpAndMask().String())
+}
+
+func TestPodmanCompatibility_VolumeOwnedByNonRootContainerUser(t *testing.T) {
+	podmanManager := getPodmanManager(t)
+	ctx, cancelFunc := context.WithTimeout(context.Background(), compatTestTimeout)
+	defer cancelFunc()
+
+	volumeName := getCompatTestObjectName("volume")
+	require.NoError(t, podmanManager.CreateVolume(ctx, volumeName, map[string]string{compatTestLabelKey: volumeName}))
+	// Registered as cleanup rather than deferred so that it runs after the container using the volume is removed
+	t.Cleanup(func() {
+		require.NoError(t, podmanManager.RemoveVolume(context.Background(), volumeName))
+	})
+
+	nonRootUser := service_user.NewServiceUser(compatTestNonRootUid)
+	createAndStartArgs := docker_manager.NewCreateAndStartContainerArgsBuilder(
+		compatTestImage,
+		getCompatTestObjectName("volume-writer"),
+		getBridgeNetworkId(ctx, t, podmanManager),
+	).WithEntrypointArgs(
+		[]string{"/bin/sh", "-c", "touch /data/written-by-non-root-user"},
+	).WithVolumeMounts(
+		map[string]string{volumeName: "/data"},
+	).WithVolumeMountsOwnedByContainerUser().WithUser(nonRootUser).Build()
+	containerId := createAndStartCompatTestContainer(ctx, t, podmanManager, createAndStartArgs)
+
+	exitCode, err := podmanManager.WaitForExit(ctx, containerId)
+	require.NoError(t, err)
+	require.Equal(t, int64(0), exitCode, "The non-root container user couldn't write to the volume mounted as owned by it")
+}
+
+func TestPodmanCompatibility_AutomaticHostPortPublishing(t *testing.T) {
+	podmanManager := getPodmanManager(t)
+	ctx, cancelFunc := context.WithTimeout(context.Background(), compatTestTimeout)
+	defer cancelFunc()
+
+	createAndStartArgs := docker_manager.NewCreateAndStartContainerArgsBuilder(
+		compatTestImage,
+		getCompatTestObjectName("port-publisher"),
+		getBridgeNetworkId(ctx, t, podmanManager),
+	).WithEntrypointArgs(
+		[]string{"/bin/sh", "-c", "sleep 600"},
+	).WithUsedPorts(
+		map[nat.Port]docker_manager.PortPublishSpec{compatTestContainerPort: docker_manager.NewAutomaticPublishingSpec()},
+	).Build()
+	containerId, hostPortBindings, err := podmanManager.CreateAndStartContainer(ctx, createAndStartArgs)
+	require.NoError(t, err)
+	defer removeCompatTestContainer(t, podmanManager, containerId)
+
+	hostPortBinding, found := hostPortBindings[compatTestContainerPort]
+	require.True(t, found, "Expected port '%v' to be published on the host but it wasn't", compatTestContainerPort)
+	require.NotEmpty(t, hostPortBinding.HostPort)
+}
+
+func TestPodmanCompatibility_RootlessRejectsPrivilegedHostPort(t *testing.T) {
+	podmanManager := getPodmanManager(t)
+	if !podmanManager.IsRootlessPodman() {
+		t.Skip("Podman isn't running rootless")
+	}
+	ctx, cancelFunc := context.WithTimeout(context.Background(), compatTestTimeout)
+	defer cancelFunc()
+
+	createAndStartArgs := docker_manager.NewCreateAndStartContainerArgsBuilder(
+		compatTestImage,
+		getCompatTestObjectName("privileged-port-publisher"),
+		getBridgeNetworkId(ctx, t, podmanManager),
+	).WithUsedPorts(
+		map[nat.Port]docker_manager.PortPublishSpec{compatTestContainerPort: docker_manager.NewManualPublishingSpec(80)},
+	).Build()
+	_, _, err := podmanManager.CreateAndStartContainer(ctx, createAndStartArgs)
+	require.Error(t, err)
+}
+
+func getPodmanManager(t *testing.T) *docker_manager.DockerManager {
+	podmanHost := os.Getenv(podmanHostEnvVar)
+	if podmanHost == "" {
+		podmanSocketPath := getLocalPodmanSocketPath()
+		require.NotEmpty(t, podmanSocketPath, "The Podman compatibility tests were requested but no local Podman socket was found and '%v' isn't set", podmanHostEnvVar)
+		podmanHost = unixSocketPrefix + podmanSocketPath
+	}
+	podmanManager, err := docker_manager.CreatePodmanManager([]client.Opt{
+		client.WithHost(podmanHost),
+		client.WithAPIVersionNegotiation(),
+	})
+	require.NoError(t, err)
+	return podmanManager
+}
+
+func getBridgeNetworkId(ctx context.Context, t *testing.T, podmanManager *docker_manager.DockerManager) string {
+	networkId, err := podmanManager.GetNetworkIdByName(ctx, podmanManager.GetBridgeNetworkName())
+	require.NoError(t, err)
+	return networkId
+}
+
+func createAndStartCompatTestContainer(
+	ctx context.Context,
+	t *testing.T,
+	podmanManager *docker_manager.DockerManager,
+	args *docker_manager.CreateAndStartContainerArgs,
+) string {
+	containerId, _, err := podmanManager.CreateAndStartContainer(ctx, args)
+	require.NoError(t, err)
+	t.Cleanup(func() {
+		removeCompatTestContainer(t, podmanManager, containerId)
+	})
+	return containerId
+}
+
+func removeCompatTestContainer(t *testing.T, podmanManager *docker_manager.DockerManager, containerId string) {
+	if err := podmanManager.RemoveContainer(context.Background(), containerId); err != nil {
+		t.Logf("Failed to remove container '%v'; it will need to be removed manually:\n%v", containerId, err)
+	}
+}
+
+func getCompatTestObjectName(objectType string) string {
+	return fmt.Sprintf("kurtosis-podman-compat-%v-%v", objectType, time.Now().UnixNano())
+}
@@ -3,6 +3,8 @@ package docker_kurtosis_backend
 import (
 	"context"
 	"io"
+	"path/filepath"
+	"sort"
 	"sync"
 
 	"github.com/kurtosis-tech/kurtosis/container-engine-lib/lib/backend_interface/objects/image_build_spec"
@@ -12,6 +14,7 @@ import (
 	"github.com/sirupsen/logrus"
 
 	"github.com/kurtosis-tech/kurtosis/container-engine-lib/lib/backend_impls/docker/docker_kurtosis_backend/engine_functions"
+	"github.com/kurtosis-tech/kurtosis/container-engine-lib/lib/backend_impls/docker/docker_kurtosis_backend/image_registry_functions"
 	"github.com/kurtosis-tech/kurtosis/container-engine-lib/lib/backend_impls/docker/docker_kurtosis_backend/logs_aggregator_functions"
 	"github.com/kurtosis-tech/kurtosis/container-engine-lib/lib/backend_impls/docker/docker_kurtosis_backend/logs_aggregator_functions/implementations/vector"
 	"github.com/kurtosis-tech/kurtosis/container-engine-lib/lib/backend_impls/docker/docker_kurtosis_backend/logs_collector_functions"
@@ -31,12 +34,14 @@ import (
 	"github.com/kurtosis-tech/kurtosis/container-engine-lib/lib/backend_interface/objects/engine"
 	"github.com/kurtosis-tech/kurtosis/container-engine-lib/lib/backend_interface/objects/exec_result"
 	"github.com/kurtosis-tech/kurtosis/container-engine-lib/lib/backend_interface/objects/image_download_mode"
+	"github.com/kurtosis-tech/kurtosis/container-engine-lib/lib/backend_interface/objects/image_registry"
 	"github.com/kurtosis-tech/kurtosis/container-engine-lib/lib/backend_interface/objects/logs_aggregator"
 	"github.com/kurtosis-tech/kurtosis/container-engine-lib/lib/backend_interface/objects/logs_collector"
 	"github.com/kurtosis-tech/kurtosis/container-engine-lib/lib/backend_interface/objects/reverse_proxy"
 	"github.com/kurtosis-tech/kurtosis/container-engine-lib/lib/backend_interface/objects/service"
 	"github.com/kurtosis-tech/kurtosis/container-engine-lib/lib/database_accessors/enclave_db/free_ip_addr_tracker"
 	"github.com/kurtosis-tech/kurtosis/container-engine-lib/lib/database_accessors/enclave_db/service_registration"
+	"github.com/kurtosis-tech/kurtosis/container-engine-lib/lib/image_registry_utils"
 	"github.com/kurtosis-tech/stacktrace"
 )
 
@@ -68,6 +73,13 @@ type DockerKurtosisBackend struct {
 
 	// Control concurrent access to serviceRegistrations
 	serviceRegistrationMutex *sync.Mutex
+
+	// Images built by this backend, by name, mapped to the digest reference they were pushed to the engine image registry with
+	builtImageReferences      map[string]string
+	builtImageReferencesMutex *sync.RWMutex
+
+	// Serializes the replacements of the revisioned volumes keeping the enclave attributes that can change
+	enclaveRevisionedVolumesMutex *sync.Mutex
 }
 
 func NewDockerKurtosisBackend(
@@ -85,6 +97,9 @@ func NewDockerKurtosisBackend(
 		serviceRegistrationRepository: serviceRegistrationRepository,
 		productionMode:                productionMode,
 		serviceRegistrationMutex:      &sync.Mutex{},
+		builtImageReferences:          map[string]string{},
+		builtImageReferencesMutex:     &sync.RWMutex{},
+		enclaveRevisionedVolumesMutex: &sync.Mutex{},
 	}
 }
 
@@ -260,7 +275,8 @@ func (backend *DockerKurtosisBackend) StartRegisteredUserServices(ctx context.Co
 		freeIpAddrProviderForEnclave,
 		backend.dockerManager,
 		restartPolicy,
-		shouldTurnOnLogsCollection)
+		shouldTurnOnLogsCollection,
+		backend.getBuiltImageReferences())
 	if err != nil {
 		return nil, nil, stacktrace.Propagate(err, "Unexpected error while starting user service")
 	}
@@ -280,6 +296,14 @@ func (backend *DockerKurtosisBackend) RemoveRegisteredUserServiceProcesses(ctx c
 	return successfullyStartedService, failedService, nil
 }
 
+func (backend *DockerKurtosisBackend) RenameUserService(_ context.Context, enclaveUuid enclave.EnclaveUUID, serviceUuid service.ServiceUUID, newServiceName service.ServiceName) (*service.ServiceRegistration, error) {
+	renamedServiceRegistration, err := user_service_functions.RenameUserService(enclaveUuid, serviceUuid, newServiceName, backend.serviceRegistrationRepository, backend.serviceRegistrationMutex)
+	if err != nil {
+		return nil, stacktrace.Propagate(err, "An error occurred renaming service '%v' of enclave '%v' to '%v'", serviceUuid, enclaveUuid, newServiceName)
+	}
+	return renamedServiceRegistration, nil
+}
+
 func (backend *DockerKurtosisBackend) GetUserServices(
 	ctx context.Context,
 	enclaveUuid enclave.EnclaveUUID,
@@ -304,6 +328,18 @@ func (backend *DockerKurtosisBackend) GetUserServiceLogs(
 	return user_service_functions.GetUserServiceLogs(ctx, enclaveUuid, filters, shouldFollowLogs, backend.dockerManager)
 }
 
+func (backend *DockerKurtosisBackend) GetUserServiceStats(
+	ctx context.Context,
+	enclaveUuid enclave.EnclaveUUID,
+	filters *service.ServiceFilters,
+) (
+	map[service.ServiceUUID]*service.ServiceStats,
+	map[service.ServiceUUID]error,
+	error,
+) {
+	return user_service_functions.GetUserServiceStats(ctx, enclaveUuid, filters, backend.dockerManager)
+}
+
 // NOTE: This function will block while the exec is ongoing; if we need more perf we can make it async
 func (backend *DockerKurtosisBackend) RunUserServiceExecCommands(
 	ctx context.Context,
@@ -327,8 +363,8 @@ func (backend *DockerKurtosisBackend) RunUserServiceExecCommandWithStreamedOutpu
 	return user_service_functions.RunUserServiceExecCommandWithStreamedOutput(ctx, enclaveUuid, serviceUuid, cmd, backend.dockerManager)
 }
 
-func (backend *DockerKurtosisBackend) GetShellOnUserService(ctx context.Context, enclaveUuid enclave.EnclaveUUID, serviceUuid service.ServiceUUID) error {
-	return user_service_functions.GetShellOnUserService(ctx, enclaveUuid, serviceUuid, backend.dockerManager)
+func (backend *DockerKurtosisBackend) GetShellOnUserService(ctx context.Context, enclaveUuid enclave.EnclaveUUID, serviceUuid service.ServiceUUID, containerUser string) error {
+	return user_service_functions.GetShellOnUserService(ctx, enclaveUuid, serviceUuid, containerUser, backend.dockerManager)
 }
 
 // It returns io.ReadCloser which is a tar stream. It's up to the caller to close the reader.
@@ -541,6 +577,45 @@ func (backend *DockerKurtosisBackend) DestroyReverseProxy(ctx context.Context) e
 	return nil
 }
 
+func (backend *DockerKurtosisBackend) CreateImageRegistry(ctx context.Context) (*image_registry.ImageRegistry, error) {
+	imageRegistry, err := image_registry_functions.CreateImageRegistry(ctx, backend.dockerManager, backend.objAttrsProvider)
+	if err != nil {
+		return nil, stacktrace.Propagate(err, "An error occurred creating the image registry")
+	}
+	return imageRegistry, nil
+}
+
+func (backend *DockerKurtosisBackend) GetImageRegistry(ctx context.Context) (*image_registry.ImageRegistry, error) {
+	maybeImageRegistry, err := image_registry_functions.GetImageRegistry(ctx, backend.dockerManager)
+	if err != nil {
+		return nil, stacktrace.Propagate(err, "An error occurred getting the image registry")
+	}
+	return maybeImageRegistry, nil
+}
+
+func (backend *DockerKurtosisBackend) DestroyImageRegistry(ctx context.Context) error {
+	if err := image_registry_functions.DestroyImageRegistry(ctx, backend.dockerManager); err != nil {
+		return stacktrace.Propagate(err, "An error occurred destroying the image registry")
+	}
+	return nil
+}
+
+func (backend *DockerKurtosisBackend) ListImageRegistryRepositories(ctx context.Context) ([]*image_registry.ImageRegistryRepository, error) {
+	repositories, err := image_registry_functions.ListImageRegistryRepositories(ctx, backend.dockerManager)
+	if err != nil {
+		return nil, stacktrace.Propagate(err, "An error occurred listing the repositories of the image registry")
+	}
+	return repositories, nil
+}
+
+func (backend *DockerKurtosisBackend) PruneImageRegistry(ctx context.Context) ([]string, error) {
+	prunedRepositories, err := image_registry_functions.PruneImageRegistry(ctx, backend.dockerManager)
+	if err != nil {
+		return nil, stacktrace.Propagate(err, "An error occurred pruning the image registry")
+	}
+	return prunedRepositories, nil
+}
+
 func (backend *DockerKurtosisBackend) ConnectReverseProxyToNetwork(ctx context.Context, networkId string) error {
 	if err := reverse_proxy_functions.ConnectReverseProxyToNetwork(ctx, backend.dockerManager, networkId); err != nil {
 		return stacktrace.Propagate(err, "An error occurred connecting the reverse proxy to the network with ID '%v'", networkId)
@@ -565,12 +640,75 @@ func (backend *DockerKurtosisBackend) GetAvailableCPUAndMemory(ctx context.Conte
 	return availableMemory, availableCpu, isResourceInformationComplete, nil
 }
 
+// GetAvailableCPUAndMemoryPerNode returns nil as every service runs on the single Docker host, whose resources are
+// already returned by GetAvailableCPUAndMemory
+func (backend *DockerKurtosisBackend) GetAvailableCPUAndMemoryPerNode(ctx context.Context) ([]*compute_resources.NodeResources, error) {
+	return nil, nil
+}
+
 func (backend *DockerKurtosisBackend) BuildImage(ctx context.Context, imageName string, imageBuildSpec *image_build_spec.ImageBuildSpec) (string, error) {
-	return backend.dockerManager.BuildImage(ctx, imageName, imageBuildSpec)
+	maybeImageRegistryHost, err := backend.getRunningImageRegistryHost(ctx)
+	if err != nil {
+		return "", stacktrace.Propagate(err, "An error occurred getting the image registry")
+	}
+	if maybeImageRegistryHost == "" {
+		return backend.dockerManager.BuildImage(ctx, imageName, imageBuildSpec)
+	}
+
+	contentHash, err := image_registry_utils.GetContentHash(imageBuildSpec.GetBuildContextDir(), getImageBuildParameters(imageBuildSpec))
+	if err != nil {
+		return "", stacktrace.Propagate(err, "An error occurred hashing the content image '%v' is built from", imageName)
+	}
+	architecture, found, err := backend.pullBuiltImageFromImageRegistry(ctx, imageName, contentHash)
+	if err != nil {
+		return "", stacktrace.Propagate(err, "An error occurred pulling image '%v' from the image registry", imageName)
+	}
+	if found {
+		return architecture, nil
+	}
+
+	architecture, err = backend.dockerManager.BuildImage(ctx, imageName, imageBuildSpec)
+	if err != nil {
+		return "", stacktrace.Propagate(err, "An error occurred building image '%v'", imageName)
+	}
+	if err := backend.pushBuiltImageToImageRegistry(ctx, maybeImageRegistryHost, imageName, imageName, contentHash); err != nil {
+		return "", stacktrace.Propagate(err, "An error occurred pushing built image '%v' to the image registry", imageName)
+	}
+	return architecture, nil
 }
 
 func (backend *DockerKurtosisBackend) NixBuild(ctx context.Context, nixBuildSpec *nix_build_spec.NixBuildSpec) (string, error) {
-	return backend.dockerManager.NixBuild(ctx, nixBuildSpec)
+	maybeImageRegistryHost, err := backend.getRunningImageRegistryHost(ctx)
+	if err != nil {
+		return "", stacktrace.Propagate(err, "An error occurred getting the image registry")
+	}
+	if maybeImageRegistryHost == "" {
+		return backend.dockerManager.NixBuild(ctx, nixBuildSpec)
+	}
+
+	// The name of the image is only known once the flake is built, so the image is looked up in the registry with
+	// the name it's expected to have
+	expectedImageName := nixBuildSpec.GetImageName()
+	contentHash, err := image_registry_utils.GetContentHash(nixBuildSpec.GetBuildContextDir(), getNixBuildParameters(nixBuildSpec))
+	if err != nil {
+		return "", stacktrace.Propagate(err, "An error occurred hashing the content image '%v' is built from", expectedImageName)
+	}
+	_, found, err := backend.pullBuiltImageFromImageRegistry(ctx, expectedImageName, contentHash)
+	if err != nil {
+		return "", stacktrace.Propagate(err, "An error occurred pulling image '%v' from the image registry", expectedImageName)
+	}
+	if found {
+		return expectedImageName, nil
+	}
+
+	imageName, err := backend.dockerManager.NixBuild(ctx, nixBuildSpec)
+	if err != nil {
+		return "", stacktrace.Propagate(err, "An error occurred building image from Nix flake '%v'", nixBuildSpec.GetFullFlakeReference())
+	}
+	if err := backend.pushBuiltImageToImageRegistry(ctx, maybeImageRegistryHost, imageName, expectedImageName, contentHash); err != nil {
+		return "", stacktrace.Propagate(err, "An error occurred pushing built image '%v' to the image registry", imageName)
+	}
+	return imageName, nil
 }
 
 // ====================================================================================================
@@ -578,6 +716,76 @@ func (backend *DockerKurtosisBackend) NixBuild(ctx context.Context, nixBuildSpec
 //	Private helper functions shared by multiple subfunctions files
 //
 // ====================================================================================================
+// getRunningImageRegistryHost returns the host of the engine image registry, or an empty string if it isn't running in
+// which case built images are only used from the local daemon
+func (backend *DockerKurtosisBackend) getRunningImageRegistryHost(ctx context.Context) (string, error) {
+	maybeImageRegistry, err := image_registry_functions.GetImageRegistry(ctx, backend.dockerManager)
+	if err != nil {
+		return "", stacktrace.Propagate(err, "An error occurred getting the image registry")
+	}
+	if maybeImageRegistry == nil || maybeImageRegistry.GetStatus() != container.ContainerStatus_Running {
+		return "", nil
+	}
+	return maybeImageRegistry.GetHost(), nil
+}
+
+// pullBuiltImageFromImageRegistry pulls the image built from content with the hash if the engine image registry holds
+// it, which saves building it again when another enclave already did, and returns its architecture
+func (backend *DockerKurtosisBackend) pullBuiltImageFromImageRegistry(ctx context.Context, imageName string, contentHash string) (string, bool, error) {
+	builtImageReference, found, err := image_registry_functions.GetBuiltImageReference(ctx, backend.dockerManager, imageName, contentHash)
+	if err != nil {
+		return "", false, stacktrace.Propagate(err, "An error occurred looking for image '%v' in the image registry", imageName)
+	}
+	if !found {
+		return "", false, nil
+	}
+
+	imageReference, architecture, err := backend.dockerManager.PullPushedImage(ctx, builtImageReference, imageName)
+	if err != nil {
+		return "", false, stacktrace.Propagate(err, "An error occurred pulling image '%v' from the image registry", builtImageReference)
+	}
+	logrus.Debugf("Pulled image '%v' from the image registry as '%v' rather than building it again", imageName, imageReference)
+
+	backend.setBuiltImageReference(imageName, imageReference)
+	return architecture, true, nil
+}
+
+// pushBuiltImageToImageRegistry pushes the built image to the engine image registry, tagged with the hash of the
+// content it was built from, so the services started from the image run it by digest
+func (backend *DockerKurtosisBackend) pushBuiltImageToImageRegistry(
+	ctx context.Context,
+	imageRegistryHost string,
+	imageName string,
+	repositoryImageName string,
+	contentHash string,
+) error {
+	targetReference := image_registry_utils.GetContentHashImageReference(imageRegistryHost, repositoryImageName, contentHash)
+	imageReference, err := backend.dockerManager.PushImage(ctx, imageName, targetReference)
+	if err != nil {
+		return stacktrace.Propagate(err, "An error occurred pushing image '%v' to the image registry as '%v'", imageName, targetReference)
+	}
+	logrus.Debugf("Pushed built image '%v' to the image registry as '%v'", imageName, imageReference)
+
+	backend.setBuiltImageReference(imageName, imageReference)
+	return nil
+}
+
+func (backend *DockerKurtosisBackend) setBuiltImageReference(imageName string, imageReference string) {
+	backend.builtImageReferencesMutex.Lock()
+	defer backend.builtImageReferencesMutex.Unlock()
+	backend.builtImageReferences[imageName] = imageReference
+}
+
+func (backend *DockerKurtosisBackend) getBuiltImageReferences() map[string]string {
+	backend.builtImageReferencesMutex.RLock()
+	defer backend.builtImageReferencesMutex.RUnlock()
+	builtImageReferences := make(map[string]string, len(backend.builtImageReferences))
+	for imageName, imageReference := range backend.builtImageReferences {
+		builtImageReferences[imageName] = imageReference
+	}
+	return builtImageReferences
+}
+
 func (backend *DockerKurtosisBackend) getEnclaveNetworkByEnclaveUuid(ctx context.Context, enclaveUuid enclave.EnclaveUUID) (*types.Network, error) {
 	networkSearchLabels := map[string]string{
 		docker_label_key.AppIDDockerLabelKey.GetString():       label_value_consts.AppIDDockerLabelValue.GetString(),
@@ -660,3 +868,28 @@ func (backend *DockerKurtosisBackend) getDockerConfigStorageVolume(ctx context.C
 	volume := foundVolumes[0]
 	return volume.Name, nil
 }
+
+// getImageBuildParameters returns what, besides the build context, changes the image built from the spec
+func getImageBuildParameters(imageBuildSpec *image_build_spec.ImageBuildSpec) []string {
+	buildParameters := []string{imageBuildSpec.GetBuildFile(), imageBuildSpec.GetTargetStage()}
+	buildArgs := imageBuildSpec.GetBuildArgs()
+	buildArgNames := []string{}
+	for buildArgName := range buildArgs {
+		buildArgNames = append(buildArgNames, buildArgName)
+	}
+	sort.Strings(buildArgNames)
+	for _, buildArgName := range buildArgNames {
+		buildParameters = append(buildParameters, buildArgName+"="+buildArgs[buildArgName])
+	}
+	return buildParameters
+}
+
+// getNixBuildParameters returns what, besides the build context, changes the image built from the spec; the flake dir
+// is taken relative to the build context so the same package gets the same hash wherever it's stored
+func getNixBuildParameters(nixBuildSpec *nix_build_spec.NixBuildSpec) []string {
+	flakeDir := nixBuildSpec.GetNixFlakeDir()
+	if relativeFlakeDir, err := filepath.Rel(nixBuildSpec.GetBuildContextDir(), flakeDir); err == nil {
+		flakeDir = relativeFlakeDir
+	}
+	return []string{flakeDir, nixBuildSpec.GetFlakeOutput()}
+}
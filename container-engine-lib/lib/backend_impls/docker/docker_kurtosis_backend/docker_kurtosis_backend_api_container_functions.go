@@ -224,6 +224,9 @@ func (backend *DockerKurtosisBackend) CreateAPIContainer(
 		ipAddr,
 	).WithLabels(
 		labelStrs,
+	).NeedsAccessToDockerHostMachine(
+		// The OTLP collector the API container exports its traces to usually runs on the Docker host
+		true,
 	).WithRestartPolicy(docker_manager.RestartOnFailure)
 
 	if shouldStartInDebugMode {
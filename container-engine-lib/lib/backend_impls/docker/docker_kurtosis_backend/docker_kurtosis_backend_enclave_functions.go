@@ -2,6 +2,7 @@ package docker_kurtosis_backend
 
 import (
 	"context"
+	"io"
 	"strings"
 	"time"
 
@@ -10,12 +11,16 @@ import (
 	"github.com/kurtosis-tech/kurtosis/container-engine-lib/lib/backend_impls/docker/docker_kurtosis_backend/logs_aggregator_functions"
 	"github.com/kurtosis-tech/kurtosis/container-engine-lib/lib/backend_impls/docker/docker_kurtosis_backend/logs_aggregator_functions/implementations/vector"
 	"github.com/kurtosis-tech/kurtosis/container-engine-lib/lib/backend_impls/docker/docker_kurtosis_backend/shared_helpers"
+	user_service_functions "github.com/kurtosis-tech/kurtosis/container-engine-lib/lib/backend_impls/docker/docker_kurtosis_backend/user_services_functions"
 	"github.com/kurtosis-tech/kurtosis/container-engine-lib/lib/backend_impls/docker/docker_manager"
 	"github.com/kurtosis-tech/kurtosis/container-engine-lib/lib/backend_impls/docker/docker_manager/types"
 	"github.com/kurtosis-tech/kurtosis/container-engine-lib/lib/backend_impls/docker/docker_operation_parallelizer"
+	"github.com/kurtosis-tech/kurtosis/container-engine-lib/lib/backend_impls/docker/object_attributes_provider"
 	"github.com/kurtosis-tech/kurtosis/container-engine-lib/lib/backend_impls/docker/object_attributes_provider/docker_label_key"
+	"github.com/kurtosis-tech/kurtosis/container-engine-lib/lib/backend_impls/docker/object_attributes_provider/docker_label_value"
 	"github.com/kurtosis-tech/kurtosis/container-engine-lib/lib/backend_impls/docker/object_attributes_provider/label_value_consts"
 	"github.com/kurtosis-tech/kurtosis/container-engine-lib/lib/backend_interface/objects/enclave"
+	"github.com/kurtosis-tech/kurtosis/container-engine-lib/lib/backend_interface/objects/service_directory"
 	"github.com/kurtosis-tech/stacktrace"
 	"github.com/sirupsen/logrus"
 )
@@ -146,7 +151,7 @@ func (backend *DockerKurtosisBackend) CreateEnclave(ctx context.Context, enclave
 	}()
 
 	// TODO: return production mode for create enclave request as well
-	newEnclave := enclave.NewEnclave(enclaveUuid, enclaveName, enclave.EnclaveStatus_Empty, &creationTime, false)
+	newEnclave := enclave.NewEnclave(enclaveUuid, enclaveName, enclave.EnclaveStatus_Empty, &creationTime, false, nil, map[string]string{})
 
 	if err := backend.ConnectReverseProxyToNetwork(ctx, networkId); err != nil {
 		return nil, stacktrace.Propagate(err, "An error occurred connecting the reverse proxy to the enclave network with ID '%v'", networkId)
@@ -180,6 +185,21 @@ func (backend *DockerKurtosisBackend) GetEnclaves(
 		return nil, stacktrace.Propagate(err, "An error occurred getting enclave networks matching filters '%+v'", filters)
 	}
 
+	enclaveExpirationVolumes, err := getLatestEnclaveRevisionedVolumes(ctx, backend.dockerManager, label_value_consts.EnclaveExpirationVolumeTypeDockerLabelValue, docker_label_key.EnclaveExpirationRevisionDockerLabelKey)
+	if err != nil {
+		return nil, stacktrace.Propagate(err, "An error occurred getting the enclave expiration volumes")
+	}
+
+	enclaveLabelsVolumes, err := getLatestEnclaveRevisionedVolumes(ctx, backend.dockerManager, label_value_consts.EnclaveLabelsVolumeTypeDockerLabelValue, docker_label_key.EnclaveLabelsRevisionDockerLabelKey)
+	if err != nil {
+		return nil, stacktrace.Propagate(err, "An error occurred getting the enclave labels volumes")
+	}
+
+	enclaveNameVolumes, err := getLatestEnclaveRevisionedVolumes(ctx, backend.dockerManager, label_value_consts.EnclaveNameVolumeTypeDockerLabelValue, docker_label_key.EnclaveNameRevisionDockerLabelKey)
+	if err != nil {
+		return nil, stacktrace.Propagate(err, "An error occurred getting the enclave name volumes")
+	}
+
 	result := map[enclave.EnclaveUUID]*enclave.Enclave{}
 	for enclaveUuid, matchingNetworkInfo := range allMatchingNetworkInfo {
 		productionMode := false
@@ -190,6 +210,18 @@ func (backend *DockerKurtosisBackend) GetEnclaves(
 
 		enclaveName := getEnclaveNameFromNetwork(matchingNetworkInfo.dockerNetwork)
 
+		// Renamed enclaves have their current name in the name volume, the network keeps the one they were created with
+		if enclaveNameVolume, found := enclaveNameVolumes[enclaveUuid]; found {
+			renamedEnclaveName, renamedEnclaveCreationTime, err := getEnclaveNameAndCreationTimeFromVolume(enclaveNameVolume)
+			if err != nil {
+				return nil, stacktrace.Propagate(err, "An error occurred getting the name of enclave '%v' from volume '%v'", enclaveUuid, enclaveNameVolume.Name)
+			}
+			enclaveName = renamedEnclaveName
+			if renamedEnclaveCreationTime != nil {
+				creationTime = renamedEnclaveCreationTime
+			}
+		}
+
 		// This method just looks for api-container ( which only can be one) for an enclave
 		// and extracts out whether enclave is running on production mode
 		for _, container := range matchingNetworkInfo.containers {
@@ -208,12 +240,28 @@ func (backend *DockerKurtosisBackend) GetEnclaves(
 			}
 		}
 
+		var enclaveExpiration *enclave.EnclaveExpiration
+		if enclaveExpirationVolume, found := enclaveExpirationVolumes[enclaveUuid]; found {
+			enclaveExpiration, err = getEnclaveExpirationFromVolume(enclaveExpirationVolume)
+			if err != nil {
+				// One enclave with a broken expiration mustn't make all the others unlistable, it just never expires
+				logrus.Warnf("An error occurred getting the expiration of enclave '%v' from volume '%v', it won't expire until it's given a new one. Error was:\n%v", enclaveUuid, enclaveExpirationVolume.Name, err)
+			}
+		}
+
+		enclaveLabels := map[string]string{}
+		if enclaveLabelsVolume, found := enclaveLabelsVolumes[enclaveUuid]; found {
+			enclaveLabels = getEnclaveLabelsFromVolume(enclaveLabelsVolume)
+		}
+
 		result[enclaveUuid] = enclave.NewEnclave(
 			enclaveUuid,
 			enclaveName,
 			matchingNetworkInfo.enclaveStatus,
 			creationTime,
 			productionMode,
+			enclaveExpiration,
+			enclaveLabels,
 		)
 	}
 	return result, nil
@@ -420,7 +468,154 @@ func (backend *DockerKurtosisBackend) UpdateEnclave(
 	newName string,
 	newCreationTime *time.Time,
 ) error {
-	return stacktrace.NewError("UpdateEnclave isn't implemented for Docker yet")
+	enclaveObjAttrsProvider, err := backend.objAttrsProvider.ForEnclave(enclaveUuid)
+	if err != nil {
+		return stacktrace.Propagate(err, "An error occurred while trying to generate an object attributes provider for the enclave with ID '%v'", enclaveUuid)
+	}
+	getNameVolumeAttrs := func(revision int64, previousNameVolume *volume.Volume) (object_attributes_provider.DockerObjectAttributes, error) {
+		creationTime := newCreationTime
+		// A rename without a creation time must keep the creation time a previous update could have set
+		if creationTime == nil && previousNameVolume != nil {
+			_, previousCreationTime, err := getEnclaveNameAndCreationTimeFromVolume(previousNameVolume)
+			if err != nil {
+				return nil, stacktrace.Propagate(err, "An error occurred getting the creation time of enclave '%v' from volume '%v'", enclaveUuid, previousNameVolume.Name)
+			}
+			creationTime = previousCreationTime
+		}
+		nameVolumeAttrs, err := enclaveObjAttrsProvider.ForEnclaveNameVolume(newName, creationTime, revision)
+		if err != nil {
+			return nil, stacktrace.Propagate(err, "An error occurred while trying to get the enclave name volume attributes for the enclave with ID '%v'", enclaveUuid)
+		}
+		return nameVolumeAttrs, nil
+	}
+
+	if err := backend.replaceEnclaveRevisionedVolume(ctx, enclaveUuid, label_value_consts.EnclaveNameVolumeTypeDockerLabelValue, docker_label_key.EnclaveNameRevisionDockerLabelKey, getNameVolumeAttrs); err != nil {
+		return stacktrace.Propagate(err, "An error occurred replacing the name volume of enclave '%v'", enclaveUuid)
+	}
+	return nil
+}
+
+func (backend *DockerKurtosisBackend) UpdateEnclaveExpiration(
+	ctx context.Context,
+	enclaveUuid enclave.EnclaveUUID,
+	expiration *enclave.EnclaveExpiration,
+) error {
+	var getExpirationVolumeAttrs revisionedVolumeAttrsGetter
+	if expiration != nil {
+		enclaveObjAttrsProvider, err := backend.objAttrsProvider.ForEnclave(enclaveUuid)
+		if err != nil {
+			return stacktrace.Propagate(err, "An error occurred while trying to generate an object attributes provider for the enclave with ID '%v'", enclaveUuid)
+		}
+		getExpirationVolumeAttrs = func(revision int64, _ *volume.Volume) (object_attributes_provider.DockerObjectAttributes, error) {
+			expirationVolumeAttrs, err := enclaveObjAttrsProvider.ForEnclaveExpirationVolume(expiration, revision)
+			if err != nil {
+				return nil, stacktrace.Propagate(err, "An error occurred while trying to get the enclave expiration volume attributes for the enclave with ID '%v'", enclaveUuid)
+			}
+			return expirationVolumeAttrs, nil
+		}
+	}
+
+	if err := backend.replaceEnclaveRevisionedVolume(ctx, enclaveUuid, label_value_consts.EnclaveExpirationVolumeTypeDockerLabelValue, docker_label_key.EnclaveExpirationRevisionDockerLabelKey, getExpirationVolumeAttrs); err != nil {
+		return stacktrace.Propagate(err, "An error occurred replacing the expiration volume of enclave '%v'", enclaveUuid)
+	}
+	return nil
+}
+
+func (backend *DockerKurtosisBackend) UpdateEnclaveLabels(
+	ctx context.Context,
+	enclaveUuid enclave.EnclaveUUID,
+	labels map[string]string,
+) error {
+	enclaveObjAttrsProvider, err := backend.objAttrsProvider.ForEnclave(enclaveUuid)
+	if err != nil {
+		return stacktrace.Propagate(err, "An error occurred while trying to generate an object attributes provider for the enclave with ID '%v'", enclaveUuid)
+	}
+	getLabelsVolumeAttrs := func(revision int64, _ *volume.Volume) (object_attributes_provider.DockerObjectAttributes, error) {
+		labelsVolumeAttrs, err := enclaveObjAttrsProvider.ForEnclaveLabelsVolume(labels, revision)
+		if err != nil {
+			return nil, stacktrace.Propagate(err, "An error occurred while trying to get the enclave labels volume attributes for the enclave with ID '%v'", enclaveUuid)
+		}
+		return labelsVolumeAttrs, nil
+	}
+
+	if err := backend.replaceEnclaveRevisionedVolume(ctx, enclaveUuid, label_value_consts.EnclaveLabelsVolumeTypeDockerLabelValue, docker_label_key.EnclaveLabelsRevisionDockerLabelKey, getLabelsVolumeAttrs); err != nil {
+		return stacktrace.Propagate(err, "An error occurred replacing the labels volume of enclave '%v'", enclaveUuid)
+	}
+	return nil
+}
+
+func (backend *DockerKurtosisBackend) CopyEnclavePersistentDirectories(
+	ctx context.Context,
+	sourceEnclaveUuid enclave.EnclaveUUID,
+	destinationEnclaveUuid enclave.EnclaveUUID,
+) ([]service_directory.DirectoryPersistentKey, error) {
+	// Fails if any of the enclaves doesn't exist
+	if _, err := backend.getEnclaveNetworkByEnclaveUuid(ctx, sourceEnclaveUuid); err != nil {
+		return nil, stacktrace.Propagate(err, "An error occurred getting the network of source enclave '%v'", sourceEnclaveUuid)
+	}
+	if _, err := backend.getEnclaveNetworkByEnclaveUuid(ctx, destinationEnclaveUuid); err != nil {
+		return nil, stacktrace.Propagate(err, "An error occurred getting the network of destination enclave '%v'", destinationEnclaveUuid)
+	}
+	sourceObjAttrsProvider, err := backend.objAttrsProvider.ForEnclave(sourceEnclaveUuid)
+	if err != nil {
+		return nil, stacktrace.Propagate(err, "An error occurred while trying to generate an object attributes provider for the enclave with ID '%v'", sourceEnclaveUuid)
+	}
+	destinationObjAttrsProvider, err := backend.objAttrsProvider.ForEnclave(destinationEnclaveUuid)
+	if err != nil {
+		return nil, stacktrace.Propagate(err, "An error occurred while trying to generate an object attributes provider for the enclave with ID '%v'", destinationEnclaveUuid)
+	}
+
+	// The copier containers don't talk to anything, and the IPs of the enclave networks are handed out by the API
+	// containers, so they run in the engine network
+	engineNetwork, err := shared_helpers.GetEngineAndLogsComponentsNetwork(ctx, backend.dockerManager)
+	if err != nil {
+		return nil, stacktrace.Propagate(err, "An error occurred getting the engine network")
+	}
+
+	copiedPersistentKeys, err := user_service_functions.CopyPersistentDirectories(
+		ctx,
+		sourceEnclaveUuid,
+		sourceObjAttrsProvider,
+		destinationObjAttrsProvider,
+		engineNetwork.GetId(),
+		backend.dockerManager,
+	)
+	if err != nil {
+		return nil, stacktrace.Propagate(err, "An error occurred copying the persistent directories of enclave '%v' to enclave '%v'", sourceEnclaveUuid, destinationEnclaveUuid)
+	}
+	return copiedPersistentKeys, nil
+}
+
+func (backend *DockerKurtosisBackend) ExportEnclavePersistentDirectory(
+	ctx context.Context,
+	enclaveUuid enclave.EnclaveUUID,
+	persistentKey service_directory.DirectoryPersistentKey,
+	destination io.Writer,
+) error {
+	objAttrsProvider, transferNetworkId, err := backend.getPersistentDirectoryTransferDependencies(ctx, enclaveUuid)
+	if err != nil {
+		return stacktrace.Propagate(err, "An error occurred preparing to export persistent directory '%v' of enclave '%v'", persistentKey, enclaveUuid)
+	}
+	if err := user_service_functions.ExportPersistentDirectory(ctx, persistentKey, objAttrsProvider, transferNetworkId, backend.dockerManager, destination); err != nil {
+		return stacktrace.Propagate(err, "An error occurred exporting persistent directory '%v' of enclave '%v'", persistentKey, enclaveUuid)
+	}
+	return nil
+}
+
+func (backend *DockerKurtosisBackend) ImportEnclavePersistentDirectory(
+	ctx context.Context,
+	enclaveUuid enclave.EnclaveUUID,
+	persistentKey service_directory.DirectoryPersistentKey,
+	content io.Reader,
+) error {
+	objAttrsProvider, transferNetworkId, err := backend.getPersistentDirectoryTransferDependencies(ctx, enclaveUuid)
+	if err != nil {
+		return stacktrace.Propagate(err, "An error occurred preparing to import persistent directory '%v' into enclave '%v'", persistentKey, enclaveUuid)
+	}
+	if err := user_service_functions.ImportPersistentDirectory(ctx, persistentKey, objAttrsProvider, transferNetworkId, backend.dockerManager, content); err != nil {
+		return stacktrace.Propagate(err, "An error occurred importing persistent directory '%v' into enclave '%v'", persistentKey, enclaveUuid)
+	}
+	return nil
 }
 
 // ====================================================================================================
@@ -856,3 +1051,106 @@ func getEnclaveNameFromNetwork(network *types.Network) string {
 
 	return enclaveNameStr
 }
+
+// Passing a nil volume attributes getter removes the revisioned volume of the enclave altogether
+func (backend *DockerKurtosisBackend) replaceEnclaveRevisionedVolume(
+	ctx context.Context,
+	enclaveUuid enclave.EnclaveUUID,
+	volumeType *docker_label_value.DockerLabelValue,
+	revisionLabelKey *docker_label_key.DockerLabelKey,
+	getNewVolumeAttrs revisionedVolumeAttrsGetter,
+) error {
+	searchNetworkLabels := map[string]string{
+		docker_label_key.AppIDDockerLabelKey.GetString():       label_value_consts.AppIDDockerLabelValue.GetString(),
+		docker_label_key.EnclaveUUIDDockerLabelKey.GetString(): string(enclaveUuid),
+	}
+	networks, err := backend.dockerManager.GetNetworksByLabels(ctx, searchNetworkLabels)
+	if err != nil {
+		return stacktrace.Propagate(err, "An error occurred getting networks using labels '%+v'", searchNetworkLabels)
+	}
+	if len(networks) == 0 {
+		return stacktrace.NewError("Cannot update enclave '%v' because no enclave with that UUID exists", enclaveUuid)
+	}
+
+	if err := replaceEnclaveRevisionedVolume(ctx, backend.dockerManager, backend.enclaveRevisionedVolumesMutex, enclaveUuid, volumeType, revisionLabelKey, getNewVolumeAttrs); err != nil {
+		return stacktrace.Propagate(err, "An error occurred replacing the revisioned volume of type '%v' of enclave '%v'", volumeType.GetString(), enclaveUuid)
+	}
+	return nil
+}
+
+func getEnclaveExpirationFromVolume(expirationVolume *volume.Volume) (*enclave.EnclaveExpiration, error) {
+	labels := expirationVolume.Labels
+
+	expiredEnclaveActionStr := labels[docker_label_key.ExpiredEnclaveActionDockerLabelKey.GetString()]
+	expiredEnclaveAction, err := enclave.ExpiredEnclaveActionString(expiredEnclaveActionStr)
+	if err != nil {
+		return nil, stacktrace.Propagate(err, "An error occurred parsing expired enclave action '%v'", expiredEnclaveActionStr)
+	}
+
+	var expirationTime *time.Time
+	if expirationTimeStr, found := labels[docker_label_key.EnclaveExpirationTimeDockerLabelKey.GetString()]; found {
+		parsedExpirationTime, err := time.Parse(time.RFC3339, expirationTimeStr)
+		if err != nil {
+			return nil, stacktrace.Propagate(err, "An error occurred parsing enclave expiration time '%v' using this format '%v'", expirationTimeStr, time.RFC3339)
+		}
+		expirationTime = &parsedExpirationTime
+	}
+
+	var idleTimeout time.Duration
+	if idleTimeoutStr, found := labels[docker_label_key.EnclaveIdleTimeoutDockerLabelKey.GetString()]; found {
+		idleTimeout, err = time.ParseDuration(idleTimeoutStr)
+		if err != nil {
+			return nil, stacktrace.Propagate(err, "An error occurred parsing enclave idle timeout '%v'", idleTimeoutStr)
+		}
+	}
+
+	return enclave.NewEnclaveExpiration(expirationTime, idleTimeout, expiredEnclaveAction), nil
+}
+
+func getEnclaveLabelsFromVolume(labelsVolume *volume.Volume) map[string]string {
+	enclaveLabels := map[string]string{}
+	for labelKeyStr, labelValueStr := range labelsVolume.Labels {
+		if userLabelKey, isUserLabel := docker_label_key.GetUserCustomLabelKey(labelKeyStr); isUserLabel {
+			enclaveLabels[userLabelKey] = labelValueStr
+		}
+	}
+	return enclaveLabels
+}
+
+// The creation time is nil if the volume doesn't override the one of the enclave network
+func getEnclaveNameAndCreationTimeFromVolume(nameVolume *volume.Volume) (string, *time.Time, error) {
+	labels := nameVolume.Labels
+
+	enclaveName := labels[docker_label_key.EnclaveNameDockerLabelKey.GetString()]
+
+	creationTimeStr, found := labels[docker_label_key.EnclaveCreationTimeLabelKey.GetString()]
+	if !found {
+		return enclaveName, nil, nil
+	}
+	creationTime, err := time.Parse(time.RFC3339, creationTimeStr)
+	if err != nil {
+		return "", nil, stacktrace.Propagate(err, "An error occurred parsing enclave creation time '%v' using this format '%v'", creationTimeStr, time.RFC3339)
+	}
+	return enclaveName, &creationTime, nil
+}
+
+// Returns the object attributes provider of the enclave, failing if the enclave doesn't exist, along with the network the
+// containers transferring the content of its persistent directories run in
+func (backend *DockerKurtosisBackend) getPersistentDirectoryTransferDependencies(
+	ctx context.Context,
+	enclaveUuid enclave.EnclaveUUID,
+) (object_attributes_provider.DockerEnclaveObjectAttributesProvider, string, error) {
+	if _, err := backend.getEnclaveNetworkByEnclaveUuid(ctx, enclaveUuid); err != nil {
+		return nil, "", stacktrace.Propagate(err, "An error occurred getting the network of enclave '%v'", enclaveUuid)
+	}
+	objAttrsProvider, err := backend.objAttrsProvider.ForEnclave(enclaveUuid)
+	if err != nil {
+		return nil, "", stacktrace.Propagate(err, "An error occurred while trying to generate an object attributes provider for the enclave with ID '%v'", enclaveUuid)
+	}
+	// Same as the copier containers, the transfer containers run in the engine network
+	engineNetwork, err := shared_helpers.GetEngineAndLogsComponentsNetwork(ctx, backend.dockerManager)
+	if err != nil {
+		return nil, "", stacktrace.Propagate(err, "An error occurred getting the engine network")
+	}
+	return objAttrsProvider, engineNetwork.GetId(), nil
+}
@@ -0,0 +1,162 @@
+package docker_kurtosis_backend
+
+import (
+	"context"
+	"sort"
+	"strconv"
+	"sync"
+	"time"
+
+	"github.com/docker/docker/api/types/volume"
+	"github.com/kurtosis-tech/kurtosis/container-engine-lib/lib/backend_impls/docker/object_attributes_provider"
+	"github.com/kurtosis-tech/kurtosis/container-engine-lib/lib/backend_impls/docker/object_attributes_provider/docker_label_key"
+	"github.com/kurtosis-tech/kurtosis/container-engine-lib/lib/backend_impls/docker/object_attributes_provider/docker_label_value"
+	"github.com/kurtosis-tech/kurtosis/container-engine-lib/lib/backend_impls/docker/object_attributes_provider/label_value_consts"
+	"github.com/kurtosis-tech/kurtosis/container-engine-lib/lib/backend_interface/objects/enclave"
+	"github.com/kurtosis-tech/stacktrace"
+)
+
+// The attributes of an enclave that can change after its creation, like its user labels, its expiration or its name,
+// can't be Docker labels of the enclave network: Docker has no way to update the labels of a network once it's created,
+// short of recreating the network, which would disconnect every container of the enclave. They're kept in the labels of
+// volumes instead, which are replaced with a volume of a newer revision every time the attributes change, the volume with
+// the highest revision being the one that counts
+
+// The Docker volume operations the revisioned volumes are made of
+type revisionedVolumeStore interface {
+	GetVolumesByLabels(ctx context.Context, labels map[string]string) ([]*volume.Volume, error)
+	CreateVolume(ctx context.Context, volumeName string, labels map[string]string) error
+	RemoveVolume(ctx context.Context, volumeName string) error
+}
+
+// Gets the attributes of the volume of the given revision, from the volume of the previous revision which is nil if
+// there's none
+type revisionedVolumeAttrsGetter func(revision int64, previousVolume *volume.Volume) (object_attributes_provider.DockerObjectAttributes, error)
+
+// Replaces the revisioned volume of the given type of the enclave with a volume of a newer revision
+// A nil volume attributes getter removes the revisioned volume of the enclave altogether
+// The replacements are serialized by the mutex: two of them running at the same time would otherwise both pick the same
+// next revision, which Docker resolves by handing the already created volume to the second one, and both remove the
+// volumes they saw before, so the attributes of one of them would silently get lost or the volume be removed altogether
+func replaceEnclaveRevisionedVolume(
+	ctx context.Context,
+	store revisionedVolumeStore,
+	mutex *sync.Mutex,
+	enclaveUuid enclave.EnclaveUUID,
+	volumeType *docker_label_value.DockerLabelValue,
+	revisionLabelKey *docker_label_key.DockerLabelKey,
+	getNewVolumeAttrs revisionedVolumeAttrsGetter,
+) error {
+	mutex.Lock()
+	defer mutex.Unlock()
+
+	previousVolumeSearchLabels := map[string]string{
+		docker_label_key.AppIDDockerLabelKey.GetString():       label_value_consts.AppIDDockerLabelValue.GetString(),
+		docker_label_key.EnclaveUUIDDockerLabelKey.GetString(): string(enclaveUuid),
+		docker_label_key.VolumeTypeDockerLabelKey.GetString():  volumeType.GetString(),
+	}
+	previousVolumes, err := store.GetVolumesByLabels(ctx, previousVolumeSearchLabels)
+	if err != nil {
+		return stacktrace.Propagate(err, "An error occurred getting enclave volumes matching labels '%+v'", previousVolumeSearchLabels)
+	}
+	latestPreviousVolumes, latestPreviousRevisions, err := getLatestRevisionedVolumesByEnclave(previousVolumes, revisionLabelKey)
+	if err != nil {
+		return stacktrace.Propagate(err, "An error occurred getting the latest previous volume of enclave '%v'", enclaveUuid)
+	}
+
+	newVolumeNameStr := ""
+	if getNewVolumeAttrs != nil {
+		// The clock alone doesn't guarantee that the new revision is higher than the previous one, as it can be coarse or
+		// go backwards
+		newRevision := time.Now().UnixNano()
+		if latestPreviousRevision, found := latestPreviousRevisions[enclaveUuid]; found && newRevision <= latestPreviousRevision {
+			newRevision = latestPreviousRevision + 1
+		}
+		newVolumeAttrs, err := getNewVolumeAttrs(newRevision, latestPreviousVolumes[enclaveUuid])
+		if err != nil {
+			return stacktrace.Propagate(err, "An error occurred getting the attributes of revision '%v' of the volume of enclave '%v'", newRevision, enclaveUuid)
+		}
+		newVolumeNameStr = newVolumeAttrs.GetName().GetString()
+		newVolumeLabelStrs := map[string]string{}
+		for labelKey, labelValue := range newVolumeAttrs.GetLabels() {
+			newVolumeLabelStrs[labelKey.GetString()] = labelValue.GetString()
+		}
+		if err := store.CreateVolume(ctx, newVolumeNameStr, newVolumeLabelStrs); err != nil {
+			return stacktrace.Propagate(
+				err,
+				"An error occurred creating enclave volume with name '%v' and labels '%+v'",
+				newVolumeNameStr,
+				newVolumeLabelStrs,
+			)
+		}
+	}
+
+	// The latest revision is the one that counts, so the previous ones are only removed once it's in place
+	for _, previousVolume := range previousVolumes {
+		if previousVolume.Name == newVolumeNameStr {
+			continue
+		}
+		if err := store.RemoveVolume(ctx, previousVolume.Name); err != nil {
+			return stacktrace.Propagate(err, "An error occurred removing previous volume '%v' of enclave '%v'", previousVolume.Name, enclaveUuid)
+		}
+	}
+
+	return nil
+}
+
+// Gets the volume of the given type with the highest revision of each enclave, keyed by enclave UUID
+func getLatestEnclaveRevisionedVolumes(
+	ctx context.Context,
+	store revisionedVolumeStore,
+	volumeType *docker_label_value.DockerLabelValue,
+	revisionLabelKey *docker_label_key.DockerLabelKey,
+) (map[enclave.EnclaveUUID]*volume.Volume, error) {
+	searchLabels := map[string]string{
+		docker_label_key.AppIDDockerLabelKey.GetString():      label_value_consts.AppIDDockerLabelValue.GetString(),
+		docker_label_key.VolumeTypeDockerLabelKey.GetString(): volumeType.GetString(),
+	}
+	revisionedVolumes, err := store.GetVolumesByLabels(ctx, searchLabels)
+	if err != nil {
+		return nil, stacktrace.Propagate(err, "An error occurred getting enclave volumes matching labels '%+v'", searchLabels)
+	}
+
+	result, _, err := getLatestRevisionedVolumesByEnclave(revisionedVolumes, revisionLabelKey)
+	if err != nil {
+		return nil, stacktrace.Propagate(err, "An error occurred getting the latest revisioned volume of each enclave")
+	}
+	return result, nil
+}
+
+// Volumes of the same revision, which a replacement from an older Kurtosis version could still have created, are told
+// apart by name so that the same one always wins
+func getLatestRevisionedVolumesByEnclave(
+	revisionedVolumes []*volume.Volume,
+	revisionLabelKey *docker_label_key.DockerLabelKey,
+) (map[enclave.EnclaveUUID]*volume.Volume, map[enclave.EnclaveUUID]int64, error) {
+	sortedVolumes := make([]*volume.Volume, len(revisionedVolumes))
+	copy(sortedVolumes, revisionedVolumes)
+	sort.Slice(sortedVolumes, func(i, j int) bool {
+		return sortedVolumes[i].Name < sortedVolumes[j].Name
+	})
+
+	latestVolumes := map[enclave.EnclaveUUID]*volume.Volume{}
+	latestRevisions := map[enclave.EnclaveUUID]int64{}
+	for _, revisionedVolume := range sortedVolumes {
+		enclaveUuidStr, found := revisionedVolume.Labels[docker_label_key.EnclaveUUIDDockerLabelKey.GetString()]
+		if !found {
+			return nil, nil, stacktrace.NewError("Expected to find label '%v' on enclave volume '%v' but none was found", docker_label_key.EnclaveUUIDDockerLabelKey.GetString(), revisionedVolume.Name)
+		}
+		revisionStr := revisionedVolume.Labels[revisionLabelKey.GetString()]
+		revision, err := strconv.ParseInt(revisionStr, 10, 64)
+		if err != nil {
+			return nil, nil, stacktrace.Propagate(err, "An error occurred parsing revision '%v' of enclave volume '%v'", revisionStr, revisionedVolume.Name)
+		}
+		enclaveUuid := enclave.EnclaveUUID(enclaveUuidStr)
+		if latestRevision, found := latestRevisions[enclaveUuid]; found && latestRevision >= revision {
+			continue
+		}
+		latestRevisions[enclaveUuid] = revision
+		latestVolumes[enclaveUuid] = revisionedVolume
+	}
+	return latestVolumes, latestRevisions, nil
+}
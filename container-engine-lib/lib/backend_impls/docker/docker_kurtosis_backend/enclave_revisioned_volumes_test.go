@@ -0,0 +1,139 @@
+package docker_kurtosis_backend
+
+import (
+	"context"
+	"runtime"
+	"strconv"
+	"sync"
+	"testing"
+
+	"github.com/docker/docker/api/types/volume"
+	"github.com/kurtosis-tech/kurtosis/container-engine-lib/lib/backend_impls/docker/object_attributes_provider"
+	"github.com/kurtosis-tech/kurtosis/container-engine-lib/lib/backend_impls/docker/object_attributes_provider/docker_label_key"
+	"github.com/kurtosis-tech/kurtosis/container-engine-lib/lib/backend_impls/docker/object_attributes_provider/label_value_consts"
+	"github.com/kurtosis-tech/kurtosis/container-engine-lib/lib/backend_interface/objects/enclave"
+	"github.com/stretchr/testify/require"
+)
+
+const (
+	testEnclaveUuid = enclave.EnclaveUUID("65d2fb6d673249b8b4a91a2f4ae616de")
+
+	numConcurrentLabelUpdates = 20
+)
+
+func TestReplaceEnclaveRevisionedVolume_ConcurrentLabelUpdatesKeepOneOfThem(t *testing.T) {
+	store := newInMemoryRevisionedVolumeStore()
+	mutex := &sync.Mutex{}
+	enclaveObjAttrsProvider, err := object_attributes_provider.GetDockerObjectAttributesProvider().ForEnclave(testEnclaveUuid)
+	require.NoError(t, err)
+
+	waitGroup := &sync.WaitGroup{}
+	errs := make([]error, numConcurrentLabelUpdates)
+	for i := 0; i < numConcurrentLabelUpdates; i++ {
+		waitGroup.Add(1)
+		go func(i int) {
+			defer waitGroup.Done()
+			getLabelsVolumeAttrs := func(revision int64, _ *volume.Volume) (object_attributes_provider.DockerObjectAttributes, error) {
+				return enclaveObjAttrsProvider.ForEnclaveLabelsVolume(map[string]string{"writer": strconv.Itoa(i)}, revision)
+			}
+			errs[i] = replaceEnclaveRevisionedVolume(context.Background(), store, mutex, testEnclaveUuid, label_value_consts.EnclaveLabelsVolumeTypeDockerLabelValue, docker_label_key.EnclaveLabelsRevisionDockerLabelKey, getLabelsVolumeAttrs)
+		}(i)
+	}
+	waitGroup.Wait()
+	for _, err := range errs {
+		require.NoError(t, err)
+	}
+
+	// Every update replaced the volume of the previous one, so exactly one volume holding the labels of a writer is left
+	require.Len(t, store.volumes, 1)
+	latestVolumes, err := getLatestEnclaveRevisionedVolumes(context.Background(), store, label_value_consts.EnclaveLabelsVolumeTypeDockerLabelValue, docker_label_key.EnclaveLabelsRevisionDockerLabelKey)
+	require.NoError(t, err)
+	labels := getEnclaveLabelsFromVolume(latestVolumes[testEnclaveUuid])
+	require.Len(t, labels, 1)
+	writer, err := strconv.Atoi(labels["writer"])
+	require.NoError(t, err)
+	require.Less(t, writer, numConcurrentLabelUpdates)
+}
+
+func TestReplaceEnclaveRevisionedVolume_NewRevisionIsAlwaysHigher(t *testing.T) {
+	store := newInMemoryRevisionedVolumeStore()
+	enclaveObjAttrsProvider, err := object_attributes_provider.GetDockerObjectAttributesProvider().ForEnclave(testEnclaveUuid)
+	require.NoError(t, err)
+
+	// A revision from the future, like one written before the clock went backwards
+	futureVolumeAttrs, err := enclaveObjAttrsProvider.ForEnclaveLabelsVolume(map[string]string{"writer": "past"}, 1<<62)
+	require.NoError(t, err)
+	store.volumes[futureVolumeAttrs.GetName().GetString()] = newVolume(futureVolumeAttrs)
+
+	getLabelsVolumeAttrs := func(revision int64, previousVolume *volume.Volume) (object_attributes_provider.DockerObjectAttributes, error) {
+		require.Equal(t, futureVolumeAttrs.GetName().GetString(), previousVolume.Name)
+		return enclaveObjAttrsProvider.ForEnclaveLabelsVolume(map[string]string{"writer": "present"}, revision)
+	}
+	require.NoError(t, replaceEnclaveRevisionedVolume(context.Background(), store, &sync.Mutex{}, testEnclaveUuid, label_value_consts.EnclaveLabelsVolumeTypeDockerLabelValue, docker_label_key.EnclaveLabelsRevisionDockerLabelKey, getLabelsVolumeAttrs))
+
+	require.Len(t, store.volumes, 1)
+	latestVolumes, err := getLatestEnclaveRevisionedVolumes(context.Background(), store, label_value_consts.EnclaveLabelsVolumeTypeDockerLabelValue, docker_label_key.EnclaveLabelsRevisionDockerLabelKey)
+	require.NoError(t, err)
+	require.Equal(t, map[string]string{"writer": "present"}, getEnclaveLabelsFromVolume(latestVolumes[testEnclaveUuid]))
+	require.Equal(t, strconv.FormatInt(1<<62+1, 10), latestVolumes[testEnclaveUuid].Labels[docker_label_key.EnclaveLabelsRevisionDockerLabelKey.GetString()])
+}
+
+// Behaves like Docker, where creating a volume that already exists returns the existing one, and yields between the
+// operations to let concurrent replacements interleave
+type inMemoryRevisionedVolumeStore struct {
+	mutex *sync.Mutex
+
+	volumes map[string]*volume.Volume
+}
+
+func newInMemoryRevisionedVolumeStore() *inMemoryRevisionedVolumeStore {
+	return &inMemoryRevisionedVolumeStore{
+		mutex:   &sync.Mutex{},
+		volumes: map[string]*volume.Volume{},
+	}
+}
+
+func (store *inMemoryRevisionedVolumeStore) GetVolumesByLabels(_ context.Context, labels map[string]string) ([]*volume.Volume, error) {
+	runtime.Gosched()
+	store.mutex.Lock()
+	defer store.mutex.Unlock()
+	result := []*volume.Volume{}
+	for _, storedVolume := range store.volumes {
+		isMatching := true
+		for key, value := range labels {
+			if storedVolume.Labels[key] != value {
+				isMatching = false
+			}
+		}
+		if isMatching {
+			result = append(result, storedVolume)
+		}
+	}
+	return result, nil
+}
+
+func (store *inMemoryRevisionedVolumeStore) CreateVolume(_ context.Context, volumeName string, labels map[string]string) error {
+	runtime.Gosched()
+	store.mutex.Lock()
+	defer store.mutex.Unlock()
+	if _, found := store.volumes[volumeName]; !found {
+		store.volumes[volumeName] = &volume.Volume{Name: volumeName, Labels: labels} // nolint: exhaustruct
+	}
+	return nil
+}
+
+func (store *inMemoryRevisionedVolumeStore) RemoveVolume(_ context.Context, volumeName string) error {
+	runtime.Gosched()
+	store.mutex.Lock()
+	defer store.mutex.Unlock()
+	delete(store.volumes, volumeName)
+	return nil
+}
+
+func newVolume(volumeAttrs object_attributes_provider.DockerObjectAttributes) *volume.Volume {
+	labels := map[string]string{}
+	for labelKey, labelValue := range volumeAttrs.GetLabels() {
+		labels[labelKey.GetString()] = labelValue.GetString()
+	}
+	return &volume.Volume{Name: volumeAttrs.GetName().GetString(), Labels: labels} // nolint: exhaustruct
+}
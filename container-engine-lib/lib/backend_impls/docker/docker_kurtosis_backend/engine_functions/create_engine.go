@@ -320,6 +320,9 @@ func CreateEngine(
 		usedPorts,
 	).WithLabels(
 		labelStrs,
+	).NeedsAccessToDockerHostMachine(
+		// The OTLP collector the engine exports its traces to usually runs on the Docker host
+		true,
 	)
 
 	if shouldStartInDebugMode {
@@ -0,0 +1,32 @@
+package image_registry_functions
+
+import (
+	"context"
+
+	"github.com/kurtosis-tech/kurtosis/container-engine-lib/lib/backend_impls/docker/docker_manager"
+	"github.com/kurtosis-tech/kurtosis/container-engine-lib/lib/image_registry_utils"
+	"github.com/kurtosis-tech/stacktrace"
+)
+
+// GetBuiltImageReference returns the reference of the image built from content with the hash, if the running image
+// registry holds it
+func GetBuiltImageReference(
+	ctx context.Context,
+	dockerManager *docker_manager.DockerManager,
+	imageName string,
+	contentHash string,
+) (string, bool, error) {
+	containerId, err := getRunningImageRegistryContainerId(ctx, dockerManager)
+	if err != nil {
+		return "", false, stacktrace.Propagate(err, "An error occurred getting the running image registry container")
+	}
+
+	hasImage, err := image_registry_utils.HasContentHashImage(ctx, newImageRegistryCommandRunner(dockerManager, containerId), imageName, contentHash)
+	if err != nil {
+		return "", false, stacktrace.Propagate(err, "An error occurred looking for image '%v' built from content with hash '%v' in the image registry", imageName, contentHash)
+	}
+	if !hasImage {
+		return "", false, nil
+	}
+	return image_registry_utils.GetContentHashImageReference(imageRegistryHost, imageName, contentHash), true, nil
+}
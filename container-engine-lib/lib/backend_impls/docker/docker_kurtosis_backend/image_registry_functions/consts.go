@@ -0,0 +1,20 @@
+package image_registry_functions
+
+import (
+	"fmt"
+	"time"
+)
+
+const (
+	// The registry is published on the loopback interface of the host so the Docker daemon can push to and pull from
+	// it, which it considers insecure registries on localhost fine to use over plain HTTP, while other machines can't
+	// reach the registry, which doesn't authenticate its clients
+	imageRegistryHostPortNum = uint16(9740)
+
+	stopImageRegistryContainerTimeout = 2 * time.Second
+
+	shouldShowStoppedImageRegistryContainers = true
+	shouldShowStoppedUserServiceContainers   = true
+)
+
+var imageRegistryHost = fmt.Sprintf("localhost:%v", imageRegistryHostPortNum)
@@ -0,0 +1,107 @@
+package image_registry_functions
+
+import (
+	"context"
+
+	"github.com/docker/go-connections/nat"
+	"github.com/kurtosis-tech/kurtosis/container-engine-lib/lib/backend_impls/docker/docker_kurtosis_backend/consts"
+	"github.com/kurtosis-tech/kurtosis/container-engine-lib/lib/backend_impls/docker/docker_kurtosis_backend/shared_helpers"
+	"github.com/kurtosis-tech/kurtosis/container-engine-lib/lib/backend_impls/docker/docker_manager"
+	"github.com/kurtosis-tech/kurtosis/container-engine-lib/lib/backend_impls/docker/object_attributes_provider"
+	"github.com/kurtosis-tech/kurtosis/container-engine-lib/lib/backend_interface/objects/container"
+	"github.com/kurtosis-tech/kurtosis/container-engine-lib/lib/backend_interface/objects/image_registry"
+	"github.com/kurtosis-tech/kurtosis/container-engine-lib/lib/backend_interface/objects/port_spec"
+	"github.com/kurtosis-tech/kurtosis/container-engine-lib/lib/image_registry_utils"
+	"github.com/kurtosis-tech/stacktrace"
+	"github.com/sirupsen/logrus"
+)
+
+// CreateImageRegistry creates the image registry idempotently, if a running image registry is found then it is returned
+func CreateImageRegistry(
+	ctx context.Context,
+	dockerManager *docker_manager.DockerManager,
+	objAttrsProvider object_attributes_provider.DockerObjectAttributesProvider,
+) (*image_registry.ImageRegistry, error) {
+	existingImageRegistryContainer, found, err := getImageRegistryContainer(ctx, dockerManager)
+	if err != nil {
+		return nil, stacktrace.Propagate(err, "An error occurred getting the image registry container")
+	}
+	if found {
+		existingImageRegistry, err := getImageRegistryObjectFromContainerInfo(existingImageRegistryContainer.GetStatus())
+		if err == nil && existingImageRegistry.GetHost() != "" {
+			logrus.Debugf("Found existing running image registry; cannot start a new one.")
+			return existingImageRegistry, nil
+		}
+		logrus.Debugf("Destroying the image registry container with ID '%v' that isn't running to start a new one...", existingImageRegistryContainer.GetId())
+		if err := destroyImageRegistryWithContainerId(ctx, dockerManager, existingImageRegistryContainer.GetId()); err != nil {
+			return nil, stacktrace.Propagate(err, "An error occurred destroying the image registry that isn't running to start a new one")
+		}
+	}
+
+	volumeAttrs, err := objAttrsProvider.ForImageRegistryDataVolume()
+	if err != nil {
+		return nil, stacktrace.Propagate(err, "An error occurred getting the image registry data volume attributes")
+	}
+	volumeName := volumeAttrs.GetName().GetString()
+	volumeLabelStrs := map[string]string{}
+	for labelKey, labelValue := range volumeAttrs.GetLabels() {
+		volumeLabelStrs[labelKey.GetString()] = labelValue.GetString()
+	}
+	// Docker re-uses the volume if it already exists, which is what keeps the pushed images across registries
+	if err := dockerManager.CreateVolume(ctx, volumeName, volumeLabelStrs); err != nil {
+		return nil, stacktrace.Propagate(err, "An error occurred creating the image registry data volume with name '%v'", volumeName)
+	}
+
+	containerAttrs, err := objAttrsProvider.ForImageRegistry()
+	if err != nil {
+		return nil, stacktrace.Propagate(err, "An error occurred getting the image registry container attributes")
+	}
+	containerName := containerAttrs.GetName().GetString()
+	containerLabelStrs := map[string]string{}
+	for labelKey, labelValue := range containerAttrs.GetLabels() {
+		containerLabelStrs[labelKey.GetString()] = labelValue.GetString()
+	}
+
+	imageRegistryNetwork, err := shared_helpers.GetEngineAndLogsComponentsNetwork(ctx, dockerManager)
+	if err != nil {
+		return nil, stacktrace.Propagate(err, "An error occurred getting the image registry network")
+	}
+
+	privatePortSpec, err := port_spec.NewPortSpec(image_registry_utils.ImageRegistryPortNum, port_spec.TransportProtocol_TCP, consts.HttpApplicationProtocol, nil, consts.EmptyApplicationURL)
+	if err != nil {
+		return nil, stacktrace.Propagate(err, "An error occurred creating the image registry private port spec using number '%v'", image_registry_utils.ImageRegistryPortNum)
+	}
+	privateDockerPort, err := shared_helpers.TransformPortSpecToDockerPort(privatePortSpec)
+	if err != nil {
+		return nil, stacktrace.Propagate(err, "An error occurred transforming the image registry private port spec to a Docker port")
+	}
+	usedPorts := map[nat.Port]docker_manager.PortPublishSpec{
+		privateDockerPort: docker_manager.NewLoopbackManualPublishingSpec(imageRegistryHostPortNum),
+	}
+
+	createAndStartArgs := docker_manager.NewCreateAndStartContainerArgsBuilder(
+		image_registry_utils.ImageRegistryImage,
+		containerName,
+		imageRegistryNetwork.GetId(),
+	).WithLabels(
+		containerLabelStrs,
+	).WithUsedPorts(
+		usedPorts,
+	).WithVolumeMounts(
+		map[string]string{
+			volumeName: image_registry_utils.ImageRegistryStorageDirpath,
+		},
+	).WithEntrypointArgs(
+		image_registry_utils.GetImageRegistryCommand(),
+	).WithRestartPolicy(
+		docker_manager.RestartAlways,
+	).Build()
+
+	containerId, _, err := dockerManager.CreateAndStartContainer(ctx, createAndStartArgs)
+	if err != nil {
+		return nil, stacktrace.Propagate(err, "An error occurred starting the image registry container with these args '%+v'", createAndStartArgs)
+	}
+	logrus.Debugf("Started image registry container with ID '%v'", containerId)
+
+	return image_registry.NewImageRegistry(container.ContainerStatus_Running, imageRegistryHost), nil
+}
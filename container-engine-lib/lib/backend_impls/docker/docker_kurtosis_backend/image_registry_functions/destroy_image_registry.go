@@ -0,0 +1,39 @@
+package image_registry_functions
+
+import (
+	"context"
+
+	"github.com/kurtosis-tech/kurtosis/container-engine-lib/lib/backend_impls/docker/docker_manager"
+	"github.com/kurtosis-tech/stacktrace"
+)
+
+// DestroyImageRegistry destroys the image registry container idempotently; its data volume is kept so the pushed images
+// are served again by the next registry
+func DestroyImageRegistry(
+	ctx context.Context,
+	dockerManager *docker_manager.DockerManager,
+) error {
+	imageRegistryContainer, found, err := getImageRegistryContainer(ctx, dockerManager)
+	if err != nil {
+		return stacktrace.Propagate(err, "An error occurred getting the image registry container")
+	}
+	if !found {
+		return nil
+	}
+
+	if err := destroyImageRegistryWithContainerId(ctx, dockerManager, imageRegistryContainer.GetId()); err != nil {
+		return stacktrace.Propagate(err, "An error occurred destroying the image registry container with ID '%v'", imageRegistryContainer.GetId())
+	}
+	return nil
+}
+
+func destroyImageRegistryWithContainerId(ctx context.Context, dockerManager *docker_manager.DockerManager, imageRegistryContainerId string) error {
+	if err := dockerManager.StopContainer(ctx, imageRegistryContainerId, stopImageRegistryContainerTimeout); err != nil {
+		return stacktrace.Propagate(err, "An error occurred stopping the image registry container with ID '%v'", imageRegistryContainerId)
+	}
+
+	if err := dockerManager.RemoveContainer(ctx, imageRegistryContainerId); err != nil {
+		return stacktrace.Propagate(err, "An error occurred removing the image registry container with ID '%v'", imageRegistryContainerId)
+	}
+	return nil
+}
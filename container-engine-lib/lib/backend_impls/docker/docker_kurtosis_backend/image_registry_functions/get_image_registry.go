@@ -0,0 +1,29 @@
+package image_registry_functions
+
+import (
+	"context"
+
+	"github.com/kurtosis-tech/kurtosis/container-engine-lib/lib/backend_impls/docker/docker_manager"
+	"github.com/kurtosis-tech/kurtosis/container-engine-lib/lib/backend_interface/objects/image_registry"
+	"github.com/kurtosis-tech/stacktrace"
+)
+
+// GetImageRegistry returns the image registry, or nil if none was created
+func GetImageRegistry(
+	ctx context.Context,
+	dockerManager *docker_manager.DockerManager,
+) (*image_registry.ImageRegistry, error) {
+	imageRegistryContainer, found, err := getImageRegistryContainer(ctx, dockerManager)
+	if err != nil {
+		return nil, stacktrace.Propagate(err, "An error occurred getting the image registry container")
+	}
+	if !found {
+		return nil, nil
+	}
+
+	imageRegistry, err := getImageRegistryObjectFromContainerInfo(imageRegistryContainer.GetStatus())
+	if err != nil {
+		return nil, stacktrace.Propagate(err, "An error occurred getting the image registry object from container with ID '%v'", imageRegistryContainer.GetId())
+	}
+	return imageRegistry, nil
+}
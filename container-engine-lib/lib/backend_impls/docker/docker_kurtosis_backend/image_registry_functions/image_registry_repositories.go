@@ -0,0 +1,58 @@
+package image_registry_functions
+
+import (
+	"context"
+
+	"github.com/kurtosis-tech/kurtosis/container-engine-lib/lib/backend_impls/docker/docker_manager"
+	"github.com/kurtosis-tech/kurtosis/container-engine-lib/lib/backend_impls/docker/object_attributes_provider/docker_label_key"
+	"github.com/kurtosis-tech/kurtosis/container-engine-lib/lib/backend_impls/docker/object_attributes_provider/label_value_consts"
+	"github.com/kurtosis-tech/kurtosis/container-engine-lib/lib/backend_interface/objects/image_registry"
+	"github.com/kurtosis-tech/kurtosis/container-engine-lib/lib/image_registry_utils"
+	"github.com/kurtosis-tech/stacktrace"
+)
+
+func ListImageRegistryRepositories(
+	ctx context.Context,
+	dockerManager *docker_manager.DockerManager,
+) ([]*image_registry.ImageRegistryRepository, error) {
+	containerId, err := getRunningImageRegistryContainerId(ctx, dockerManager)
+	if err != nil {
+		return nil, stacktrace.Propagate(err, "An error occurred getting the running image registry container")
+	}
+
+	repositories, err := image_registry_utils.ListRepositories(ctx, newImageRegistryCommandRunner(dockerManager, containerId))
+	if err != nil {
+		return nil, stacktrace.Propagate(err, "An error occurred listing the repositories of the image registry")
+	}
+	return repositories, nil
+}
+
+// PruneImageRegistry deletes the repositories no user service container, running or stopped, uses an image of
+func PruneImageRegistry(
+	ctx context.Context,
+	dockerManager *docker_manager.DockerManager,
+) ([]string, error) {
+	containerId, err := getRunningImageRegistryContainerId(ctx, dockerManager)
+	if err != nil {
+		return nil, stacktrace.Propagate(err, "An error occurred getting the running image registry container")
+	}
+
+	userServiceContainerSearchLabels := map[string]string{
+		docker_label_key.AppIDDockerLabelKey.GetString():         label_value_consts.AppIDDockerLabelValue.GetString(),
+		docker_label_key.ContainerTypeDockerLabelKey.GetString(): label_value_consts.UserServiceContainerTypeDockerLabelValue.GetString(),
+	}
+	userServiceContainers, err := dockerManager.GetContainersByLabels(ctx, userServiceContainerSearchLabels, shouldShowStoppedUserServiceContainers)
+	if err != nil {
+		return nil, stacktrace.Propagate(err, "An error occurred fetching the user service containers using labels: %+v", userServiceContainerSearchLabels)
+	}
+	usedImages := []string{}
+	for _, userServiceContainer := range userServiceContainers {
+		usedImages = append(usedImages, userServiceContainer.GetImageName())
+	}
+
+	prunedRepositories, err := image_registry_utils.PruneRepositories(ctx, newImageRegistryCommandRunner(dockerManager, containerId), imageRegistryHost, usedImages)
+	if err != nil {
+		return nil, stacktrace.Propagate(err, "An error occurred pruning the repositories of the image registry")
+	}
+	return prunedRepositories, nil
+}
@@ -0,0 +1,86 @@
+package image_registry_functions
+
+import (
+	"bytes"
+	"context"
+
+	"github.com/kurtosis-tech/kurtosis/container-engine-lib/lib/backend_impls/docker/docker_kurtosis_backend/consts"
+	"github.com/kurtosis-tech/kurtosis/container-engine-lib/lib/backend_impls/docker/docker_manager"
+	"github.com/kurtosis-tech/kurtosis/container-engine-lib/lib/backend_impls/docker/docker_manager/types"
+	"github.com/kurtosis-tech/kurtosis/container-engine-lib/lib/backend_impls/docker/object_attributes_provider/docker_label_key"
+	"github.com/kurtosis-tech/kurtosis/container-engine-lib/lib/backend_impls/docker/object_attributes_provider/label_value_consts"
+	"github.com/kurtosis-tech/kurtosis/container-engine-lib/lib/backend_interface/objects/container"
+	"github.com/kurtosis-tech/kurtosis/container-engine-lib/lib/backend_interface/objects/image_registry"
+	"github.com/kurtosis-tech/kurtosis/container-engine-lib/lib/image_registry_utils"
+	"github.com/kurtosis-tech/stacktrace"
+)
+
+const (
+	// The commands are run as the user of the registry image
+	execCommandUser = ""
+
+	successExitCode = 0
+)
+
+func getImageRegistryContainer(ctx context.Context, dockerManager *docker_manager.DockerManager) (*types.Container, bool, error) {
+	imageRegistryContainerSearchLabels := map[string]string{
+		docker_label_key.AppIDDockerLabelKey.GetString():         label_value_consts.AppIDDockerLabelValue.GetString(),
+		docker_label_key.ContainerTypeDockerLabelKey.GetString(): label_value_consts.ImageRegistryContainerTypeDockerLabelValue.GetString(),
+	}
+
+	matchingImageRegistryContainers, err := dockerManager.GetContainersByLabels(ctx, imageRegistryContainerSearchLabels, shouldShowStoppedImageRegistryContainers)
+	if err != nil {
+		return nil, false, stacktrace.Propagate(err, "An error occurred fetching the image registry container using labels: %+v", imageRegistryContainerSearchLabels)
+	}
+
+	if len(matchingImageRegistryContainers) == 0 {
+		return nil, false, nil
+	}
+	if len(matchingImageRegistryContainers) > 1 {
+		return nil, false, stacktrace.NewError("Found more than one image registry Docker container; this is a bug in Kurtosis")
+	}
+	return matchingImageRegistryContainers[0], true, nil
+}
+
+func getImageRegistryObjectFromContainerInfo(containerStatus types.ContainerStatus) (*image_registry.ImageRegistry, error) {
+	isContainerRunning, found := consts.IsContainerRunningDeterminer[containerStatus]
+	if !found {
+		// This should never happen because we enforce completeness in a unit test
+		return nil, stacktrace.NewError("No is-running designation found for image registry container status '%v'; this is a bug in Kurtosis!", containerStatus.String())
+	}
+
+	if !isContainerRunning {
+		return image_registry.NewImageRegistry(container.ContainerStatus_Stopped, ""), nil
+	}
+	return image_registry.NewImageRegistry(container.ContainerStatus_Running, imageRegistryHost), nil
+}
+
+// getRunningImageRegistryContainerId returns the ID of the image registry container, failing if it isn't running since
+// the registry can't be inspected otherwise
+func getRunningImageRegistryContainerId(ctx context.Context, dockerManager *docker_manager.DockerManager) (string, error) {
+	imageRegistryContainer, found, err := getImageRegistryContainer(ctx, dockerManager)
+	if err != nil {
+		return "", stacktrace.Propagate(err, "An error occurred getting the image registry container")
+	}
+	if !found {
+		return "", stacktrace.NewError("No image registry was found; start one first")
+	}
+	if isContainerRunning := consts.IsContainerRunningDeterminer[imageRegistryContainer.GetStatus()]; !isContainerRunning {
+		return "", stacktrace.NewError("The image registry container with ID '%v' isn't running", imageRegistryContainer.GetId())
+	}
+	return imageRegistryContainer.GetId(), nil
+}
+
+func newImageRegistryCommandRunner(dockerManager *docker_manager.DockerManager, containerId string) image_registry_utils.CommandRunner {
+	return func(ctx context.Context, command []string) (string, error) {
+		output := &bytes.Buffer{}
+		exitCode, err := dockerManager.RunUserServiceExecCommands(ctx, containerId, execCommandUser, command, output)
+		if err != nil {
+			return "", stacktrace.Propagate(err, "An error occurred running command '%v' in the image registry container with ID '%v'", command, containerId)
+		}
+		if exitCode != successExitCode {
+			return "", stacktrace.NewError("Command '%v' in the image registry container with ID '%v' exited with code '%v' and output:\n%v", command, containerId, exitCode, output.String())
+		}
+		return output.String(), nil
+	}
+}
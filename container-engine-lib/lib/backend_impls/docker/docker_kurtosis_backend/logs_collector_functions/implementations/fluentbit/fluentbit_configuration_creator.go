@@ -74,7 +74,7 @@ func (fluent *fluentbitConfigurationCreator) CreateConfiguration(
 		entrypointArgs,
 	).WithVolumeMounts(
 		volumeMounts,
-	).Build()
+	).WithVolumeMountsOwnedByContainerUser().Build()
 
 	containerId, _, err := dockerManager.CreateAndStartContainer(ctx, createAndStartArgs)
 	if err != nil {
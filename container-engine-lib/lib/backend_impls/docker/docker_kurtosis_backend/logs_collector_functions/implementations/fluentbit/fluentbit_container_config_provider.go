@@ -61,7 +61,7 @@ func (fluent *fluentbitContainerConfigProvider) GetContainerArgs(containerName s
 		containerLabels,
 	).WithVolumeMounts(
 		volumeMounts,
-	).Build()
+	).WithVolumeMountsOwnedByContainerUser().Build()
 
 	return createAndStartArgs, nil
 }
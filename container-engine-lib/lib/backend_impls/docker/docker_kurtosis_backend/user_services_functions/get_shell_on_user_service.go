@@ -23,14 +23,14 @@ var commandToRunWhenCreatingUserServiceShell = []string{
 	fi`,
 }
 
-func GetShellOnUserService(ctx context.Context, enclaveId enclave.EnclaveUUID, serviceUuid service.ServiceUUID, dockerManager *docker_manager.DockerManager) error {
+func GetShellOnUserService(ctx context.Context, enclaveId enclave.EnclaveUUID, serviceUuid service.ServiceUUID, containerUser string, dockerManager *docker_manager.DockerManager) error {
 	_, serviceDockerResources, err := getSingleUserServiceObjAndResourcesNoMutex(ctx, enclaveId, serviceUuid, dockerManager)
 	if err != nil {
 		return stacktrace.Propagate(err, "An error occurred getting service object and Docker resources for service '%v' in enclave '%v'", serviceUuid, enclaveId)
 	}
 	container := serviceDockerResources.ServiceContainer
 
-	hijackedResponse, err := dockerManager.CreateContainerExec(ctx, container.GetId(), commandToRunWhenCreatingUserServiceShell)
+	hijackedResponse, err := dockerManager.CreateContainerExec(ctx, container.GetId(), containerUser, commandToRunWhenCreatingUserServiceShell)
 	if err != nil {
 		return stacktrace.Propagate(err, "An error occurred getting a shell on user service with UUID '%v' in enclave '%v'", serviceUuid, enclaveId)
 	}
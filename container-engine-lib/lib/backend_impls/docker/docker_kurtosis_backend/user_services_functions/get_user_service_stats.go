@@ -0,0 +1,127 @@
+package user_service_functions
+
+import (
+	"context"
+	"strings"
+	"sync"
+
+	"github.com/docker/docker/api/types"
+	"github.com/kurtosis-tech/kurtosis/container-engine-lib/lib/backend_impls/docker/docker_kurtosis_backend/consts"
+	"github.com/kurtosis-tech/kurtosis/container-engine-lib/lib/backend_impls/docker/docker_kurtosis_backend/shared_helpers"
+	"github.com/kurtosis-tech/kurtosis/container-engine-lib/lib/backend_impls/docker/docker_manager"
+	"github.com/kurtosis-tech/kurtosis/container-engine-lib/lib/backend_interface/objects/enclave"
+	"github.com/kurtosis-tech/kurtosis/container-engine-lib/lib/backend_interface/objects/service"
+	"github.com/kurtosis-tech/stacktrace"
+)
+
+const (
+	percent = 100
+
+	blkioReadOp  = "read"
+	blkioWriteOp = "write"
+)
+
+// Page cache that the kernel can reclaim, which `docker stats` leaves out of the memory usage. The key is different
+// depending on whether the host runs cgroups v1 or v2
+var reclaimableMemoryStatKeys = []string{
+	"total_inactive_file",
+	"inactive_file",
+}
+
+func GetUserServiceStats(
+	ctx context.Context,
+	enclaveId enclave.EnclaveUUID,
+	filters *service.ServiceFilters,
+	dockerManager *docker_manager.DockerManager,
+) (
+	map[service.ServiceUUID]*service.ServiceStats,
+	map[service.ServiceUUID]error,
+	error,
+) {
+	_, allDockerResources, err := shared_helpers.GetMatchingUserServiceObjsAndDockerResourcesNoMutex(ctx, enclaveId, filters, dockerManager)
+	if err != nil {
+		return nil, nil, stacktrace.Propagate(err, "An error occurred getting user services matching filters '%+v'", filters)
+	}
+
+	successfulUserServiceStats := map[service.ServiceUUID]*service.ServiceStats{}
+	erroredUserServices := map[service.ServiceUUID]error{}
+	resultsMutex := &sync.Mutex{}
+	// Docker takes about a second for each sample, so they're taken in parallel
+	wg := &sync.WaitGroup{}
+	for serviceUuid, resourcesForService := range allDockerResources {
+		container := resourcesForService.ServiceContainer
+		if container == nil {
+			erroredUserServices[serviceUuid] = stacktrace.NewError("Cannot get stats for service '%v' as it has no container", serviceUuid)
+			continue
+		}
+		if !consts.IsContainerRunningDeterminer[container.GetStatus()] {
+			erroredUserServices[serviceUuid] = stacktrace.NewError("Cannot get stats for service '%v' as its container '%v' isn't running", serviceUuid, container.GetName())
+			continue
+		}
+
+		wg.Add(1)
+		go func(serviceUuid service.ServiceUUID, containerId string, containerName string) {
+			defer wg.Done()
+			containerStats, err := dockerManager.GetContainerStats(ctx, containerId)
+			resultsMutex.Lock()
+			defer resultsMutex.Unlock()
+			if err != nil {
+				erroredUserServices[serviceUuid] = stacktrace.Propagate(err, "An error occurred getting stats for container '%v' for user service with UUID '%v'", containerName, serviceUuid)
+				return
+			}
+			successfulUserServiceStats[serviceUuid] = convertContainerStats(containerStats)
+		}(serviceUuid, container.GetId(), container.GetName())
+	}
+	wg.Wait()
+
+	return successfulUserServiceStats, erroredUserServices, nil
+}
+
+// Computes the values the same way `docker stats` does
+func convertContainerStats(containerStats *types.StatsJSON) *service.ServiceStats {
+	cpuPercent := float64(0)
+	cpuDelta := float64(containerStats.CPUStats.CPUUsage.TotalUsage) - float64(containerStats.PreCPUStats.CPUUsage.TotalUsage)
+	systemDelta := float64(containerStats.CPUStats.SystemUsage) - float64(containerStats.PreCPUStats.SystemUsage)
+	onlineCpus := float64(containerStats.CPUStats.OnlineCPUs)
+	if onlineCpus == 0 {
+		onlineCpus = float64(len(containerStats.CPUStats.CPUUsage.PercpuUsage))
+	}
+	if cpuDelta > 0 && systemDelta > 0 {
+		cpuPercent = cpuDelta / systemDelta * onlineCpus * percent
+	}
+
+	memoryUsage := containerStats.MemoryStats.Usage
+	for _, reclaimableMemoryStatKey := range reclaimableMemoryStatKeys {
+		reclaimableMemory, found := containerStats.MemoryStats.Stats[reclaimableMemoryStatKey]
+		if found && reclaimableMemory < memoryUsage {
+			memoryUsage -= reclaimableMemory
+			break
+		}
+	}
+
+	var networkReceivedBytes, networkSentBytes uint64
+	for _, networkStats := range containerStats.Networks {
+		networkReceivedBytes += networkStats.RxBytes
+		networkSentBytes += networkStats.TxBytes
+	}
+
+	var diskReadBytes, diskWrittenBytes uint64
+	for _, blkioEntry := range containerStats.BlkioStats.IoServiceBytesRecursive {
+		switch strings.ToLower(blkioEntry.Op) {
+		case blkioReadOp:
+			diskReadBytes += blkioEntry.Value
+		case blkioWriteOp:
+			diskWrittenBytes += blkioEntry.Value
+		}
+	}
+
+	return service.NewServiceStats(
+		cpuPercent,
+		memoryUsage,
+		containerStats.MemoryStats.Limit,
+		networkReceivedBytes,
+		networkSentBytes,
+		diskReadBytes,
+		diskWrittenBytes,
+	)
+}
@@ -0,0 +1,40 @@
+package user_service_functions
+
+import (
+	"testing"
+
+	"github.com/docker/docker/api/types"
+	"github.com/stretchr/testify/require"
+)
+
+func TestConvertContainerStats(t *testing.T) {
+	containerStats := &types.StatsJSON{} //nolint:exhaustruct
+	containerStats.PreCPUStats.CPUUsage.TotalUsage = 1000
+	containerStats.PreCPUStats.SystemUsage = 10000
+	containerStats.CPUStats.CPUUsage.TotalUsage = 1500
+	containerStats.CPUStats.SystemUsage = 12000
+	containerStats.CPUStats.OnlineCPUs = 4
+	containerStats.MemoryStats.Usage = 300
+	containerStats.MemoryStats.Limit = 1000
+	containerStats.MemoryStats.Stats = map[string]uint64{"inactive_file": 100}
+	containerStats.Networks = map[string]types.NetworkStats{
+		"eth0": {RxBytes: 10, TxBytes: 20},   //nolint:exhaustruct
+		"eth1": {RxBytes: 100, TxBytes: 200}, //nolint:exhaustruct
+	}
+	containerStats.BlkioStats.IoServiceBytesRecursive = []types.BlkioStatEntry{
+		{Major: 8, Minor: 0, Op: "Read", Value: 5},
+		{Major: 8, Minor: 0, Op: "Write", Value: 7},
+		{Major: 8, Minor: 16, Op: "read", Value: 50},
+		{Major: 8, Minor: 16, Op: "write", Value: 70},
+		{Major: 8, Minor: 16, Op: "total", Value: 120},
+	}
+
+	serviceStats := convertContainerStats(containerStats)
+	require.Equal(t, float64(100), serviceStats.GetCpuPercent())
+	require.Equal(t, uint64(200), serviceStats.GetMemoryUsageBytes())
+	require.Equal(t, uint64(1000), serviceStats.GetMemoryLimitBytes())
+	require.Equal(t, uint64(110), serviceStats.GetNetworkReceivedBytes())
+	require.Equal(t, uint64(220), serviceStats.GetNetworkSentBytes())
+	require.Equal(t, uint64(55), serviceStats.GetDiskReadBytes())
+	require.Equal(t, uint64(77), serviceStats.GetDiskWrittenBytes())
+}
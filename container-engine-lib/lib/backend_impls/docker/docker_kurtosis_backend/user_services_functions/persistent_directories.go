@@ -2,14 +2,39 @@ package user_service_functions
 
 import (
 	"context"
+	"fmt"
+	"io"
+
 	"github.com/kurtosis-tech/kurtosis/container-engine-lib/lib/backend_impls/docker/docker_manager"
 	"github.com/kurtosis-tech/kurtosis/container-engine-lib/lib/backend_impls/docker/object_attributes_provider"
+	"github.com/kurtosis-tech/kurtosis/container-engine-lib/lib/backend_impls/docker/object_attributes_provider/docker_label_key"
+	"github.com/kurtosis-tech/kurtosis/container-engine-lib/lib/backend_impls/docker/object_attributes_provider/label_value_consts"
+	"github.com/kurtosis-tech/kurtosis/container-engine-lib/lib/backend_interface/objects/enclave"
 	"github.com/kurtosis-tech/kurtosis/container-engine-lib/lib/backend_interface/objects/service"
 	"github.com/kurtosis-tech/kurtosis/container-engine-lib/lib/backend_interface/objects/service_directory"
 	"github.com/kurtosis-tech/stacktrace"
 	"github.com/sirupsen/logrus"
 )
 
+const (
+	// Already used by other helper containers, so it's likely to be cached
+	persistentDirectoryCopierImage = "alpine:3.17"
+
+	persistentDirectoryCopierSourceDirpath      = "/source"
+	persistentDirectoryCopierDestinationDirpath = "/destination"
+
+	copierShBinaryFilepath = "/bin/sh"
+	copierShCmdFlag        = "-c"
+	// The trailing '/.' copies the content of the directory, hidden files included, rather than the directory itself
+	copyDirectoryContentCmdFormat = "cp -a %v/. %v/"
+
+	persistentDirectoryCopierSuccessExitCode = 0
+
+	// The transfer containers only need to exist for their volume to be read or written through the Docker API
+	persistentDirectoryTransferDirpath = "/persistent-directory"
+	doNothingCmd                       = "true"
+)
+
 func getOrCreatePersistentDirectories(
 	ctx context.Context,
 	serviceUuid service.ServiceUUID,
@@ -80,3 +105,254 @@ func getOrCreatePersistentDirectories(
 	shouldDeleteVolumes = false
 	return persistentDirectories, nil
 }
+
+// CopyPersistentDirectories copies the content of the persistent directory volumes of the source enclave to the volumes
+// with the same persistent keys in the destination enclave, creating them if they don't exist yet. The volumes are
+// copied as they are, so a service still writing to its persistent directory gets copied in an intermediate state
+func CopyPersistentDirectories(
+	ctx context.Context,
+	sourceEnclaveUuid enclave.EnclaveUUID,
+	sourceObjAttrsProvider object_attributes_provider.DockerEnclaveObjectAttributesProvider,
+	destinationObjAttrsProvider object_attributes_provider.DockerEnclaveObjectAttributesProvider,
+	copierNetworkId string,
+	dockerManager *docker_manager.DockerManager,
+) ([]service_directory.DirectoryPersistentKey, error) {
+	volumeSearchLabels := map[string]string{
+		docker_label_key.AppIDDockerLabelKey.GetString():       label_value_consts.AppIDDockerLabelValue.GetString(),
+		docker_label_key.EnclaveUUIDDockerLabelKey.GetString(): string(sourceEnclaveUuid),
+		docker_label_key.VolumeTypeDockerLabelKey.GetString():  label_value_consts.PersistentDirectoryVolumeTypeDockerLabelValue.GetString(),
+	}
+	sourceVolumes, err := dockerManager.GetVolumesByLabels(ctx, volumeSearchLabels)
+	if err != nil {
+		return nil, stacktrace.Propagate(err, "An error occurred getting the persistent directory volumes of enclave '%v'", sourceEnclaveUuid)
+	}
+
+	copiedPersistentKeys := []service_directory.DirectoryPersistentKey{}
+	for _, sourceVolume := range sourceVolumes {
+		persistentKey, err := sourceObjAttrsProvider.GetPersistentKeyFromPersistentDirectoryVolumeName(sourceVolume.Name)
+		if err != nil {
+			return nil, stacktrace.Propagate(err, "An error occurred getting the persistent key of volume '%v'", sourceVolume.Name)
+		}
+		destinationVolumeName, err := getOrCreatePersistentDirectoryVolume(ctx, persistentKey, destinationObjAttrsProvider, dockerManager)
+		if err != nil {
+			return nil, stacktrace.Propagate(err, "An error occurred getting or creating the destination volume of persistent directory '%v'", persistentKey)
+		}
+		if err := copyVolumeContent(ctx, persistentKey, sourceVolume.Name, destinationVolumeName, destinationObjAttrsProvider, copierNetworkId, dockerManager); err != nil {
+			return nil, stacktrace.Propagate(err, "An error occurred copying persistent directory '%v' from volume '%v' to volume '%v'", persistentKey, sourceVolume.Name, destinationVolumeName)
+		}
+		copiedPersistentKeys = append(copiedPersistentKeys, persistentKey)
+	}
+	return copiedPersistentKeys, nil
+}
+
+// ExportPersistentDirectory writes the content of the persistent directory volume with the given key as a TAR archive.
+// Like when copying it, a service still writing to the persistent directory gets it exported in an intermediate state
+func ExportPersistentDirectory(
+	ctx context.Context,
+	persistentKey service_directory.DirectoryPersistentKey,
+	objAttrsProvider object_attributes_provider.DockerEnclaveObjectAttributesProvider,
+	transferNetworkId string,
+	dockerManager *docker_manager.DockerManager,
+	destination io.Writer,
+) error {
+	volumeAttrs, err := objAttrsProvider.ForSinglePersistentDirectoryVolume(persistentKey)
+	if err != nil {
+		return stacktrace.Propagate(err, "Error creating persistent directory labels for '%s'", persistentKey)
+	}
+	volumeName := volumeAttrs.GetName().GetString()
+	existingVolumes, err := dockerManager.GetVolumesByName(ctx, volumeName)
+	if err != nil {
+		return stacktrace.Propagate(err, "An error occurred checking for persistent volume existence")
+	}
+	if len(existingVolumes) == 0 {
+		return stacktrace.NewError("No volume exists for persistent directory '%v'", persistentKey)
+	}
+
+	containerId, removeContainerFunc, err := createPersistentDirectoryTransferContainer(ctx, persistentKey, volumeName, objAttrsProvider, transferNetworkId, dockerManager)
+	if err != nil {
+		return stacktrace.Propagate(err, "An error occurred creating a container to read persistent directory '%v' from", persistentKey)
+	}
+	defer removeContainerFunc()
+
+	tarContent, err := dockerManager.CopyFromContainer(ctx, containerId, persistentDirectoryTransferDirpath)
+	if err != nil {
+		return stacktrace.Propagate(err, "An error occurred reading the content of persistent directory '%v'", persistentKey)
+	}
+	defer tarContent.Close()
+	if _, err := io.Copy(destination, tarContent); err != nil {
+		return stacktrace.Propagate(err, "An error occurred writing the content of persistent directory '%v'", persistentKey)
+	}
+	return nil
+}
+
+// ImportPersistentDirectory extracts a TAR archive into the persistent directory volume with the given key, creating the
+// volume if it doesn't exist yet. Files already in the volume are kept unless the archive overwrites them
+func ImportPersistentDirectory(
+	ctx context.Context,
+	persistentKey service_directory.DirectoryPersistentKey,
+	objAttrsProvider object_attributes_provider.DockerEnclaveObjectAttributesProvider,
+	transferNetworkId string,
+	dockerManager *docker_manager.DockerManager,
+	content io.Reader,
+) error {
+	volumeName, err := getOrCreatePersistentDirectoryVolume(ctx, persistentKey, objAttrsProvider, dockerManager)
+	if err != nil {
+		return stacktrace.Propagate(err, "An error occurred getting or creating the volume of persistent directory '%v'", persistentKey)
+	}
+
+	containerId, removeContainerFunc, err := createPersistentDirectoryTransferContainer(ctx, persistentKey, volumeName, objAttrsProvider, transferNetworkId, dockerManager)
+	if err != nil {
+		return stacktrace.Propagate(err, "An error occurred creating a container to write persistent directory '%v' through", persistentKey)
+	}
+	defer removeContainerFunc()
+
+	if err := dockerManager.CopyToContainer(ctx, containerId, persistentDirectoryTransferDirpath, content); err != nil {
+		return stacktrace.Propagate(err, "An error occurred writing the content of persistent directory '%v'", persistentKey)
+	}
+	return nil
+}
+
+func getOrCreatePersistentDirectoryVolume(
+	ctx context.Context,
+	persistentKey service_directory.DirectoryPersistentKey,
+	objAttrsProvider object_attributes_provider.DockerEnclaveObjectAttributesProvider,
+	dockerManager *docker_manager.DockerManager,
+) (string, error) {
+	volumeAttrs, err := objAttrsProvider.ForSinglePersistentDirectoryVolume(persistentKey)
+	if err != nil {
+		return "", stacktrace.Propagate(err, "Error creating persistent directory labels for '%s'", persistentKey)
+	}
+	volumeName := volumeAttrs.GetName().GetString()
+
+	existingVolumes, err := dockerManager.GetVolumesByName(ctx, volumeName)
+	if err != nil {
+		return "", stacktrace.Propagate(err, "An error occurred checking for persistent volume existence")
+	}
+	if len(existingVolumes) > 0 {
+		return volumeName, nil
+	}
+
+	volumeLabelsStrs := map[string]string{}
+	for key, value := range volumeAttrs.GetLabels() {
+		volumeLabelsStrs[key.GetString()] = value.GetString()
+	}
+	if err := dockerManager.CreateVolume(ctx, volumeName, volumeLabelsStrs); err != nil {
+		return "", stacktrace.Propagate(err, "An error occurred creating persistent directory volume '%s'", persistentKey)
+	}
+	return volumeName, nil
+}
+
+// Runs a container mounting both volumes that copies the files of the source volume over to the destination volume
+func copyVolumeContent(
+	ctx context.Context,
+	persistentKey service_directory.DirectoryPersistentKey,
+	sourceVolumeName string,
+	destinationVolumeName string,
+	objAttrsProvider object_attributes_provider.DockerEnclaveObjectAttributesProvider,
+	copierNetworkId string,
+	dockerManager *docker_manager.DockerManager,
+) error {
+	containerAttrs, err := objAttrsProvider.ForPersistentDirectoryCopierContainer(persistentKey)
+	if err != nil {
+		return stacktrace.Propagate(err, "An error occurred getting the copier container attributes of persistent directory '%v'", persistentKey)
+	}
+	containerName := containerAttrs.GetName().GetString()
+	containerLabels := map[string]string{}
+	for labelKey, labelValue := range containerAttrs.GetLabels() {
+		containerLabels[labelKey.GetString()] = labelValue.GetString()
+	}
+
+	createAndStartArgs := docker_manager.NewCreateAndStartContainerArgsBuilder(
+		persistentDirectoryCopierImage,
+		containerName,
+		copierNetworkId,
+	).WithEntrypointArgs([]string{
+		copierShBinaryFilepath,
+		copierShCmdFlag,
+		fmt.Sprintf(copyDirectoryContentCmdFormat, persistentDirectoryCopierSourceDirpath, persistentDirectoryCopierDestinationDirpath),
+	}).WithVolumeMounts(map[string]string{
+		sourceVolumeName:      persistentDirectoryCopierSourceDirpath,
+		destinationVolumeName: persistentDirectoryCopierDestinationDirpath,
+	}).WithLabels(
+		containerLabels,
+	).WithFetchingLatestImageIfMissing().Build()
+
+	containerId, _, err := dockerManager.CreateAndStartContainer(ctx, createAndStartArgs)
+	if err != nil {
+		return stacktrace.Propagate(err, "An error occurred starting persistent directory copier container '%v'", containerName)
+	}
+	defer func() {
+		// Background context so the container gets removed even if the input context was cancelled
+		if err := dockerManager.RemoveContainer(context.Background(), containerId); err != nil {
+			logrus.Errorf("An error occurred removing persistent directory copier container '%v':\n%v", containerName, err)
+			logrus.Errorf("ACTION REQUIRED: You'll need to remove container '%v' manually", containerName)
+		}
+	}()
+
+	exitCode, err := dockerManager.WaitForExit(ctx, containerId)
+	if err != nil {
+		return stacktrace.Propagate(err, "An error occurred waiting for persistent directory copier container '%v' to exit", containerName)
+	}
+	if exitCode != persistentDirectoryCopierSuccessExitCode {
+		return stacktrace.NewError("Persistent directory copier container '%v' finished with non-%v exit code '%v'", containerName, persistentDirectoryCopierSuccessExitCode, exitCode)
+	}
+	return nil
+}
+
+// Runs a container mounting the volume that exits right away, as the Docker API can read and write the volumes of stopped
+// containers. The returned function removes the container
+func createPersistentDirectoryTransferContainer(
+	ctx context.Context,
+	persistentKey service_directory.DirectoryPersistentKey,
+	volumeName string,
+	objAttrsProvider object_attributes_provider.DockerEnclaveObjectAttributesProvider,
+	transferNetworkId string,
+	dockerManager *docker_manager.DockerManager,
+) (string, func(), error) {
+	containerAttrs, err := objAttrsProvider.ForPersistentDirectoryCopierContainer(persistentKey)
+	if err != nil {
+		return "", nil, stacktrace.Propagate(err, "An error occurred getting the transfer container attributes of persistent directory '%v'", persistentKey)
+	}
+	containerName := containerAttrs.GetName().GetString()
+	containerLabels := map[string]string{}
+	for labelKey, labelValue := range containerAttrs.GetLabels() {
+		containerLabels[labelKey.GetString()] = labelValue.GetString()
+	}
+
+	createAndStartArgs := docker_manager.NewCreateAndStartContainerArgsBuilder(
+		persistentDirectoryCopierImage,
+		containerName,
+		transferNetworkId,
+	).WithEntrypointArgs([]string{
+		copierShBinaryFilepath,
+		copierShCmdFlag,
+		doNothingCmd,
+	}).WithVolumeMounts(map[string]string{
+		volumeName: persistentDirectoryTransferDirpath,
+	}).WithLabels(
+		containerLabels,
+	).WithFetchingLatestImageIfMissing().Build()
+
+	containerId, _, err := dockerManager.CreateAndStartContainer(ctx, createAndStartArgs)
+	if err != nil {
+		return "", nil, stacktrace.Propagate(err, "An error occurred starting persistent directory transfer container '%v'", containerName)
+	}
+	removeContainerFunc := func() {
+		// Background context so the container gets removed even if the input context was cancelled
+		if err := dockerManager.RemoveContainer(context.Background(), containerId); err != nil {
+			logrus.Errorf("An error occurred removing persistent directory transfer container '%v':\n%v", containerName, err)
+			logrus.Errorf("ACTION REQUIRED: You'll need to remove container '%v' manually", containerName)
+		}
+	}
+
+	exitCode, err := dockerManager.WaitForExit(ctx, containerId)
+	if err != nil {
+		removeContainerFunc()
+		return "", nil, stacktrace.Propagate(err, "An error occurred waiting for persistent directory transfer container '%v' to exit", containerName)
+	}
+	if exitCode != persistentDirectoryCopierSuccessExitCode {
+		removeContainerFunc()
+		return "", nil, stacktrace.NewError("Persistent directory transfer container '%v' finished with non-%v exit code '%v'", containerName, persistentDirectoryCopierSuccessExitCode, exitCode)
+	}
+	return containerId, removeContainerFunc, nil
+}
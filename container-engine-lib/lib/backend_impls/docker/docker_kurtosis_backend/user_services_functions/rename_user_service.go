@@ -0,0 +1,42 @@
+package user_service_functions
+
+import (
+	"sync"
+
+	"github.com/kurtosis-tech/kurtosis/container-engine-lib/lib/backend_interface/objects/enclave"
+	"github.com/kurtosis-tech/kurtosis/container-engine-lib/lib/backend_interface/objects/service"
+	"github.com/kurtosis-tech/kurtosis/container-engine-lib/lib/database_accessors/enclave_db/service_registration"
+	"github.com/kurtosis-tech/stacktrace"
+)
+
+// RenameUserService moves the registration of a service to a new name. The name is a label and the network alias of the
+// service container, neither of which can change, so the service can't have a container when it gets renamed
+func RenameUserService(
+	enclaveUuid enclave.EnclaveUUID,
+	serviceUuid service.ServiceUUID,
+	newServiceName service.ServiceName,
+	serviceRegistrationRepository *service_registration.ServiceRegistrationRepository,
+	serviceRegistrationMutex *sync.Mutex,
+) (*service.ServiceRegistration, error) {
+	serviceRegistrationMutex.Lock()
+	defer serviceRegistrationMutex.Unlock()
+
+	enclaveServiceRegistrations, err := serviceRegistrationRepository.GetAllEnclaveServiceRegistrations(enclaveUuid)
+	if err != nil {
+		return nil, stacktrace.Propagate(err, "An error occurred getting all enclave service registrations from the repository for enclave with UUID '%v'", enclaveUuid)
+	}
+	serviceRegistration, found := enclaveServiceRegistrations[serviceUuid]
+	if !found {
+		return nil, stacktrace.NewError("Cannot rename service '%v' because it isn't registered in enclave '%v'", serviceUuid, enclaveUuid)
+	}
+	if serviceRegistration.GetStatus() != service.ServiceStatus_Registered {
+		return nil, stacktrace.NewError("Cannot rename service '%v' because it's '%v', its container must be removed first", serviceRegistration.GetName(), serviceRegistration.GetStatus())
+	}
+
+	// in Docker, hostname = serviceName because we're setting the "alias" of the container to serviceName
+	renamedServiceRegistration, err := serviceRegistrationRepository.Rename(serviceRegistration.GetName(), newServiceName, string(newServiceName))
+	if err != nil {
+		return nil, stacktrace.Propagate(err, "An error occurred renaming the registration of service '%v' to '%v'", serviceRegistration.GetName(), newServiceName)
+	}
+	return renamedServiceRegistration, nil
+}
@@ -133,6 +133,7 @@ func StartRegisteredUserServices(
 	dockerManager *docker_manager.DockerManager,
 	restartPolicy docker_manager.RestartPolicy,
 	shouldTurnOnLogsCollection bool,
+	builtImageReferences map[string]string,
 ) (
 	map[service.ServiceUUID]*service.Service,
 	map[service.ServiceUUID]error,
@@ -235,6 +236,7 @@ func StartRegisteredUserServices(
 		logsCollectorEnclaveAddr,
 		logsCollectorLabels,
 		shouldTurnOnLogsCollection,
+		builtImageReferences,
 	)
 	if err != nil {
 		return nil, nil, stacktrace.Propagate(err, "An error occurred while trying to start services in parallel.")
@@ -471,6 +473,7 @@ func runStartServiceOperationsInParallel(
 	logsCollectorAddress string,
 	logsCollectorLabels []string,
 	shouldTurnOnLogsCollection bool,
+	builtImageReferences map[string]string,
 ) (
 	map[service.ServiceUUID]*service.Service,
 	map[service.ServiceUUID]error,
@@ -499,6 +502,7 @@ func runStartServiceOperationsInParallel(
 			logsCollectorAddress,
 			logsCollectorLabels,
 			shouldTurnOnLogsCollection,
+			builtImageReferences,
 		)
 	}
 
@@ -536,6 +540,7 @@ func createStartServiceOperation(
 	logsCollectorAddress string,
 	logsCollectorLabels []string,
 	shouldTurnOnLogsCollection bool,
+	builtImageReferences map[string]string,
 ) operation_parallelizer.Operation {
 	id := serviceRegistration.GetName()
 	privateIpAddr := serviceRegistration.GetPrivateIP()
@@ -544,6 +549,10 @@ func createStartServiceOperation(
 		filesArtifactsExpansion := serviceConfig.GetFilesArtifactsExpansion()
 		persistentDirectories := serviceConfig.GetPersistentDirectories()
 		containerImageName := serviceConfig.GetContainerImageName()
+		// Images pushed to the engine image registry after being built are run by digest, like everywhere else they're pulled
+		if builtImageReference, found := builtImageReferences[containerImageName]; found {
+			containerImageName = builtImageReference
+		}
 		privatePorts := serviceConfig.GetPrivatePorts()
 		publicPorts := serviceConfig.GetPublicPorts()
 		entrypointArgs := serviceConfig.GetEntrypointArgs()
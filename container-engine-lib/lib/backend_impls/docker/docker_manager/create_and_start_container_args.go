@@ -27,6 +27,7 @@ type CreateAndStartContainerArgs struct {
 	envVariables                             map[string]string
 	bindMounts                               map[string]string
 	volumeMounts                             map[string]string
+	volumeMountsOwnedByContainerUser         bool
 	needsAccessToDockerHostMachine           bool
 	labels                                   map[string]string
 	cpuAllocationMillicpus                   uint64
@@ -58,6 +59,7 @@ type CreateAndStartContainerArgsBuilder struct {
 	envVariables                             map[string]string
 	bindMounts                               map[string]string
 	volumeMounts                             map[string]string
+	volumeMountsOwnedByContainerUser         bool
 	needsAccessToDockerHostMachine           bool
 	labels                                   map[string]string
 	cpuAllocationMillicpus                   uint64
@@ -96,6 +98,7 @@ func NewCreateAndStartContainerArgsBuilder(dockerImage string, name string, netw
 		envVariables:                             map[string]string{},
 		bindMounts:                               map[string]string{},
 		volumeMounts:                             map[string]string{},
+		volumeMountsOwnedByContainerUser:         false,
 		needsAccessToDockerHostMachine:           false,
 		labels:                                   map[string]string{},
 		cpuAllocationMillicpus:                   0,
@@ -134,6 +137,7 @@ func (builder *CreateAndStartContainerArgsBuilder) Build() *CreateAndStartContai
 		envVariables:                             builder.envVariables,
 		bindMounts:                               builder.bindMounts,
 		volumeMounts:                             builder.volumeMounts,
+		volumeMountsOwnedByContainerUser:         builder.volumeMountsOwnedByContainerUser,
 		needsAccessToDockerHostMachine:           builder.needsAccessToDockerHostMachine,
 		cpuAllocationMillicpus:                   builder.cpuAllocationMillicpus,
 		memoryAllocationMegabytes:                builder.memoryAllocationMegabytes,
@@ -223,6 +227,13 @@ func (builder *CreateAndStartContainerArgsBuilder) WithVolumeMounts(volumeMounts
 	return builder
 }
 
+// Has the volume mounts owned by the user of the container; only makes a difference on rootless Podman, where the
+// volumes are otherwise owned by the user running Podman
+func (builder *CreateAndStartContainerArgsBuilder) WithVolumeMountsOwnedByContainerUser() *CreateAndStartContainerArgsBuilder {
+	builder.volumeMountsOwnedByContainerUser = true
+	return builder
+}
+
 // Will provide the container with a magic "host.docker.internal" domain name
 // that it can use to access ports of the machine running Docker itself (useful if, e.g., the container
 // needs to check the host machine's free ports)
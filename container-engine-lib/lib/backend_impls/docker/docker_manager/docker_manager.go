@@ -72,6 +72,11 @@ const (
 	// Character Docker uses to separate the repo from
 	dockerTagSeparatorChar = ":"
 
+	// Character separating the repo from the digest in an image reference pinned to a digest
+	imageDigestSeparatorChar = "@"
+
+	imageReferencePathSeparatorChar = "/"
+
 	// If no tag is specified for an image, this is the tag Docker will use for the image
 	dockerDefaultTag = "latest"
 
@@ -165,6 +170,21 @@ const (
 	dockerContainerStatusExited = "exited"
 	podmanContainerStatusExited = "stopped"
 
+	// Both Docker and Podman report this security option when the daemon runs without root privileges
+	rootlessSecurityOption = "name=rootless"
+
+	networkMtu                = "1440"
+	dockerNetworkMtuOptionKey = "com.docker.network.driver.mtu"
+	// Netavark rejects the Docker driver options it doesn't know about, so Podman networks get its native option
+	podmanNetworkMtuOptionKey = "mtu"
+
+	// Rootless Podman can't bind host ports under this number unless net.ipv4.ip_unprivileged_port_start is lowered
+	rootlessPodmanFirstUnprivilegedPort = 1024
+
+	// Makes Podman chown the volume to the user of the container, which is required for the container to write to it
+	// when Podman runs rootless
+	podmanChownVolumeMountOption = "U"
+
 	defaultContainerStopTimeout = 1 * time.Second
 )
 
@@ -199,6 +219,10 @@ type DockerManager struct {
 	dockerClientNoTimeout *client.Client
 
 	podmanMode bool
+
+	// Only ever true in Podman mode; a rootless Podman can't publish privileged ports and maps volume ownership
+	// through the user namespace
+	podmanRootlessMode bool
 }
 
 /*
@@ -213,12 +237,23 @@ func CreateDockerManager(dockerClientOpts []client.Opt) (*DockerManager, error)
 	return newDockerManager(dockerClientOpts)
 }
 
+/*
+CreatePodmanManager
+Creates a new Docker manager talking to the Docker-compatible API of Podman, detecting whether Podman runs rootless.
+*/
 func CreatePodmanManager(dockerClientOpts []client.Opt) (*DockerManager, error) {
 	dockerManager, err := newDockerManager(dockerClientOpts)
 	if err != nil {
 		return nil, err // already wrapped
 	}
 	dockerManager.podmanMode = true
+
+	info, err := dockerManager.dockerClient.Info(context.Background())
+	if err != nil {
+		return nil, stacktrace.Propagate(err, "An error occurred getting the Podman system info, which is necessary to know whether Podman is running rootless; make sure the Podman socket is active")
+	}
+	dockerManager.podmanRootlessMode = isRootless(info.SecurityOptions)
+	logrus.Debugf("Podman rootless mode: %v", dockerManager.podmanRootlessMode)
 	return dockerManager, nil
 }
 
@@ -240,6 +275,7 @@ func newDockerManager(dockerClientOpts []client.Opt) (*DockerManager, error) {
 		dockerClient:          dockerClient,
 		dockerClientNoTimeout: dockerClientNoTimeout,
 		podmanMode:            false,
+		podmanRootlessMode:    false,
 	}, nil
 }
 
@@ -260,6 +296,11 @@ Returns:
 	id: The Docker-managed ID of the network
 */
 func (manager *DockerManager) CreateNetwork(context context.Context, name string, subnetMask string, gatewayIP net.IP, labels map[string]string) (id string, err error) {
+	networkMtuOptionKey := dockerNetworkMtuOptionKey
+	if manager.podmanMode {
+		networkMtuOptionKey = podmanNetworkMtuOptionKey
+	}
+
 	ipamConfig := []network.IPAMConfig{{
 		Subnet:     subnetMask,
 		IPRange:    "",
@@ -283,7 +324,7 @@ func (manager *DockerManager) CreateNetwork(context context.Context, name string
 		ConfigOnly: false,
 		ConfigFrom: nil,
 		Options: map[string]string{
-			"com.docker.network.driver.mtu": "1440",
+			networkMtuOptionKey: networkMtu,
 		},
 		Labels: labels,
 	})
@@ -396,6 +437,12 @@ func (manager *DockerManager) IsPodman() bool {
 	return manager.podmanMode
 }
 
+// IsRootlessPodman returns true if the DockerManager is using Podman as the container runtime and Podman runs without
+// root privileges
+func (manager *DockerManager) IsRootlessPodman() bool {
+	return manager.podmanMode && manager.podmanRootlessMode
+}
+
 /*
 GetNetworksByLabels
 Gets networks matching the given labels
@@ -599,6 +646,22 @@ func (manager *DockerManager) InspectContainer(ctx context.Context, containerId
 	return result, nil
 }
 
+// GetContainerStats returns a single sample of the resources used by the container, which Docker takes over about a
+// second so that the CPU usage can be computed against the previous sample it returns along
+func (manager *DockerManager) GetContainerStats(ctx context.Context, containerId string) (*types.StatsJSON, error) {
+	statsResponse, err := manager.dockerClient.ContainerStats(ctx, containerId, dontStreamStats)
+	if err != nil {
+		return nil, stacktrace.Propagate(err, "An error occurred getting the stats of container '%v'", containerId)
+	}
+	defer statsResponse.Body.Close()
+
+	containerStats := &types.StatsJSON{} //nolint:exhaustruct
+	if err := json.NewDecoder(statsResponse.Body).Decode(containerStats); err != nil {
+		return nil, stacktrace.Propagate(err, "An error occurred decoding the stats of container '%v'", containerId)
+	}
+	return containerStats, nil
+}
+
 /*
 CreateAndStartContainer
 Creates a Docker container with the given args and starts it.
@@ -677,6 +740,7 @@ func (manager *DockerManager) CreateAndStartContainer(
 		args.networkMode,
 		args.bindMounts,
 		args.volumeMounts,
+		args.volumeMountsOwnedByContainerUser,
 		args.usedPorts,
 		args.needsAccessToDockerHostMachine,
 		args.cpuAllocationMillicpus,
@@ -1590,6 +1654,84 @@ func (manager *DockerManager) BuildImage(ctx context.Context, imageName string,
 	return imageArch, nil
 }
 
+// PushImage tags the local image with the target reference and pushes it, returning the reference of the pushed image
+// pinned to its digest
+func (manager *DockerManager) PushImage(ctx context.Context, imageName string, targetReference string) (string, error) {
+	if err := manager.dockerClient.ImageTag(ctx, imageName, targetReference); err != nil {
+		return "", stacktrace.Propagate(err, "An error occurred tagging image '%v' as '%v'", imageName, targetReference)
+	}
+
+	// The daemon expects an auth header even when pushing to a registry that doesn't require authenticating
+	emptyAuth, err := registry.EncodeAuthConfig(registry.AuthConfig{
+		Username:      "",
+		Password:      "",
+		Auth:          "",
+		Email:         "",
+		ServerAddress: "",
+		IdentityToken: "",
+		RegistryToken: "",
+	})
+	if err != nil {
+		return "", stacktrace.Propagate(err, "An error occurred encoding the auth config to push image '%v'", targetReference)
+	}
+	out, err := manager.dockerClientNoTimeout.ImagePush(ctx, targetReference, types.ImagePushOptions{
+		All:           false,
+		RegistryAuth:  emptyAuth,
+		PrivilegeFunc: nil,
+		Platform:      "",
+	})
+	if err != nil {
+		return "", stacktrace.Propagate(err, "An error occurred pushing image '%v'", targetReference)
+	}
+	defer out.Close()
+	responseDecoder := json.NewDecoder(out)
+	for {
+		jsonMessage := new(jsonmessage.JSONMessage)
+		err = responseDecoder.Decode(&jsonMessage)
+		if err == io.EOF {
+			break
+		}
+		if err != nil {
+			return "", stacktrace.Propagate(err, "Pushing image '%v' failed with an unexpected error", targetReference)
+		}
+		if jsonMessage.Error != nil {
+			return "", stacktrace.NewError("Pushing image '%v' failed with the following error '%v'", targetReference, jsonMessage.Error.Message)
+		}
+	}
+
+	// The daemon records the digest of the pushed image among the repo digests of the local image
+	imageInspect, _, err := manager.dockerClient.ImageInspectWithRaw(ctx, targetReference)
+	if err != nil {
+		return "", stacktrace.Propagate(err, "An error occurred inspecting pushed image '%v'", targetReference)
+	}
+	pushedReference, err := getRepoDigest(imageInspect, targetReference)
+	if err != nil {
+		return "", stacktrace.Propagate(err, "An error occurred getting the digest of pushed image '%v'", targetReference)
+	}
+	return pushedReference, nil
+}
+
+// PullPushedImage pulls the image previously pushed with the source reference and tags it locally with the image name,
+// as if it had just been built, returning the reference of the image pinned to its digest and its architecture
+func (manager *DockerManager) PullPushedImage(ctx context.Context, sourceReference string, imageName string) (string, string, error) {
+	if err := manager.pullImage(ctx, sourceReference, nil); err != nil {
+		return "", "", stacktrace.Propagate(err, "An error occurred pulling image '%v'", sourceReference)
+	}
+	if err := manager.dockerClient.ImageTag(ctx, sourceReference, imageName); err != nil {
+		return "", "", stacktrace.Propagate(err, "An error occurred tagging image '%v' as '%v'", sourceReference, imageName)
+	}
+
+	imageInspect, _, err := manager.dockerClient.ImageInspectWithRaw(ctx, sourceReference)
+	if err != nil {
+		return "", "", stacktrace.Propagate(err, "An error occurred inspecting pulled image '%v'", sourceReference)
+	}
+	pulledReference, err := getRepoDigest(imageInspect, sourceReference)
+	if err != nil {
+		return "", "", stacktrace.Propagate(err, "An error occurred getting the digest of pulled image '%v'", sourceReference)
+	}
+	return pulledReference, imageInspect.Architecture, nil
+}
+
 // returns a reader to a tarball of [contextDirPath]
 func getBuildContextReader(contextDirPath string) (io.Reader, error) {
 	buildContext, _, _, err := path_compression.CompressPath(contextDirPath, false)
@@ -1599,9 +1741,9 @@ func getBuildContextReader(contextDirPath string) (io.Reader, error) {
 	return buildContext, nil
 }
 
-func (manager *DockerManager) CreateContainerExec(context context.Context, containerId string, cmd []string) (*types.HijackedResponse, error) {
+func (manager *DockerManager) CreateContainerExec(context context.Context, containerId string, user string, cmd []string) (*types.HijackedResponse, error) {
 	config := types.ExecConfig{
-		User:         "",
+		User:         user,
 		Privileged:   false,
 		Tty:          shouldAttachStandardStreamsToTtyWhenCreatingContainerExec,
 		ConsoleSize:  nil,
@@ -1668,6 +1810,18 @@ func (manager *DockerManager) CopyFromContainer(ctx context.Context, containerId
 	return tarStreamReadCloser, nil
 }
 
+// CopyToContainer extracts the TAR'd files of content into the directory at dstPath in the container
+func (manager *DockerManager) CopyToContainer(ctx context.Context, containerId string, dstPath string, content io.Reader) error {
+	copyOpts := types.CopyToContainerOptions{
+		AllowOverwriteDirWithFile: false,
+		CopyUIDGID:                true,
+	}
+	if err := manager.dockerClientNoTimeout.CopyToContainer(ctx, containerId, dstPath, content, copyOpts); err != nil {
+		return stacktrace.Propagate(err, "An error occurred copying content to '%v' in container with ID '%v'", dstPath, containerId)
+	}
+	return nil
+}
+
 // GetAvailableCPUAndMemory returns free memory in megabytes, free cpu in millicores, information on whether cpu information is complete
 func (manager *DockerManager) GetAvailableCPUAndMemory(ctx context.Context) (compute_resources.MemoryInMegaBytes, compute_resources.CpuMilliCores, error) {
 	availableMemoryInBytes, availableCpuInMilliCores, err := getFreeMemoryAndCPU(ctx, manager.dockerClient)
@@ -1818,6 +1972,7 @@ func (manager *DockerManager) getContainerHostConfig(
 	networkMode DockerManagerNetworkMode,
 	bindMounts map[string]string,
 	volumeMounts map[string]string,
+	volumeMountsOwnedByContainerUser bool,
 	usedPortsWithPublishSpec map[nat.Port]PortPublishSpec,
 	needsToAccessDockerHostMachine bool,
 	cpuAllocationMillicpus uint64,
@@ -1835,7 +1990,11 @@ func (manager *DockerManager) getContainerHostConfig(
 	for volumeName, containerFilepath := range volumeMounts {
 		// Yes, it's SUPER confusing that "volumes" need to be put into the "binds" section because there's
 		//  a separate thing called a "bind mount".... blame the Docker API
-		bindsList = append(bindsList, volumeName+":"+containerFilepath)
+		volumeBind := volumeName + ":" + containerFilepath
+		if volumeMountsOwnedByContainerUser && manager.IsRootlessPodman() {
+			volumeBind = volumeBind + ":" + podmanChownVolumeMountOption
+		}
+		bindsList = append(bindsList, volumeBind)
 	}
 
 	logrus.Debugf("Binds: %v", bindsList)
@@ -1866,10 +2025,20 @@ func (manager *DockerManager) getContainerHostConfig(
 					publishSpecType,
 				)
 			}
-			hostMachinePortNumStr := fmt.Sprintf("%v", manualSpec.getHostMachinePortNum())
+			hostMachinePortNum := manualSpec.getHostMachinePortNum()
+			if manager.IsRootlessPodman() && hostMachinePortNum < rootlessPodmanFirstUnprivilegedPort {
+				return nil, stacktrace.NewError(
+					"Port '%v' can't be published on host port '%v' because rootless Podman can't bind host ports under '%v'; "+
+						"use a higher host port or lower the 'net.ipv4.ip_unprivileged_port_start' sysctl of the host",
+					containerPort,
+					hostMachinePortNum,
+					rootlessPodmanFirstUnprivilegedPort,
+				)
+			}
+			hostMachinePortNumStr := fmt.Sprintf("%v", hostMachinePortNum)
 			portMap[containerPort] = []nat.PortBinding{
 				{
-					HostIP:   expectedHostIp,
+					HostIP:   manualSpec.getHostMachineIp(),
 					HostPort: hostMachinePortNumStr,
 				},
 			}
@@ -2138,8 +2307,9 @@ func getHostPortBindingsOnExpectedInterface(hostPortBindingsOnAllInterfaces nat.
 				interfaceBinding.HostPort,
 				port,
 			)
-			if interfaceBinding.HostIP == expectedHostIp {
-				logrus.Tracef("Interface binding matched expected host IP '%v'; registering binding", expectedHostIp)
+			// Ports published on the loopback interface only are bound there rather than on the expected interface
+			if interfaceBinding.HostIP == expectedHostIp || interfaceBinding.HostIP == hostPortBindingInterfaceForUserConsumption {
+				logrus.Tracef("Interface binding matched host IP '%v'; registering binding", interfaceBinding.HostIP)
 				result[port] = &nat.PortBinding{
 					HostIP:   hostPortBindingInterfaceForUserConsumption,
 					HostPort: interfaceBinding.HostPort,
@@ -2273,6 +2443,15 @@ func getContainerStatusByDockerContainerState(dockerContainerState string) (dock
 	return containerStatus, nil
 }
 
+func isRootless(securityOptions []string) bool {
+	for _, securityOption := range securityOptions {
+		if securityOption == rootlessSecurityOption {
+			return true
+		}
+	}
+	return false
+}
+
 func getLabelsFilterArgs(searchFilterKey string, labels map[string]string) filters.Args {
 	filtersArgs := []filters.KeyValuePair{}
 	for labelsKey, labelsValue := range labels {
@@ -2552,3 +2731,25 @@ func getFreeMemoryAndCPU(ctx context.Context, dockerClient *client.Client) (comp
 	wg.Wait()
 	return compute_resources.MemoryInMegaBytes((totalFreeMemory - totalUsedMemory) / bytesInMegaBytes), compute_resources.CpuMilliCores(float64(totalCPUs*coresToMilliCores) * (1 - cpuUsageAsFractionOfAvailableCpu)), nil
 }
+
+// getImageRepository strips the tag from the image reference, where only a colon after the last path separator
+// introduces a tag as the registry host may have a port
+// getRepoDigest returns the reference pinned to its digest the daemon recorded for the repository of the image
+// reference, once pushed or pulled
+func getRepoDigest(imageInspect types.ImageInspect, imageReference string) (string, error) {
+	imageRepository := getImageRepository(imageReference)
+	for _, repoDigest := range imageInspect.RepoDigests {
+		if strings.HasPrefix(repoDigest, imageRepository+imageDigestSeparatorChar) {
+			return repoDigest, nil
+		}
+	}
+	return "", stacktrace.NewError("No digest was recorded for repository '%v' of image '%v'; repo digests were '%v'", imageRepository, imageReference, imageInspect.RepoDigests)
+}
+
+func getImageRepository(imageReference string) string {
+	lastPathSeparatorIdx := strings.LastIndex(imageReference, imageReferencePathSeparatorChar)
+	if tagSeparatorIdx := strings.LastIndex(imageReference, dockerTagSeparatorChar); tagSeparatorIdx > lastPathSeparatorIdx {
+		return imageReference[:tagSeparatorIdx]
+	}
+	return imageReference
+}
@@ -182,3 +182,100 @@ func TestBuildImage(t *testing.T) {
 	//_, err = dockerManager.BuildImage(ctx, "foobar", imageBuildSpec)
 	//require.NoError(t, err)
 }
+
+func TestGetContainerHostConfig_RootlessPodmanRejectsPrivilegedHostPorts(t *testing.T) {
+	rootlessPodmanManager := newTestDockerManager(true, true)
+	usedPorts := map[nat.Port]PortPublishSpec{
+		"80/tcp": NewManualPublishingSpec(80),
+	}
+	_, err := getTestContainerHostConfig(rootlessPodmanManager, map[string]string{}, false, usedPorts)
+	require.Error(t, err)
+
+	usedPorts = map[nat.Port]PortPublishSpec{
+		"80/tcp": NewManualPublishingSpec(8080),
+	}
+	hostConfig, err := getTestContainerHostConfig(rootlessPodmanManager, map[string]string{}, false, usedPorts)
+	require.NoError(t, err)
+	require.Equal(t, "8080", hostConfig.PortBindings["80/tcp"][0].HostPort)
+
+	rootfulPodmanManager := newTestDockerManager(true, false)
+	usedPorts = map[nat.Port]PortPublishSpec{
+		"80/tcp": NewManualPublishingSpec(80),
+	}
+	_, err = getTestContainerHostConfig(rootfulPodmanManager, map[string]string{}, false, usedPorts)
+	require.NoError(t, err)
+}
+
+func TestGetContainerHostConfig_LoopbackManualPublishing(t *testing.T) {
+	usedPorts := map[nat.Port]PortPublishSpec{
+		"5000/tcp": NewLoopbackManualPublishingSpec(9740),
+		"80/tcp":   NewManualPublishingSpec(8080),
+	}
+	hostConfig, err := getTestContainerHostConfig(newTestDockerManager(false, false), map[string]string{}, false, usedPorts)
+	require.NoError(t, err)
+	require.Equal(t, "127.0.0.1", hostConfig.PortBindings["5000/tcp"][0].HostIP)
+	require.Equal(t, "9740", hostConfig.PortBindings["5000/tcp"][0].HostPort)
+	require.Equal(t, "0.0.0.0", hostConfig.PortBindings["80/tcp"][0].HostIP)
+
+	hostPortBindings := getHostPortBindingsOnExpectedInterface(hostConfig.PortBindings)
+	require.Len(t, hostPortBindings, 2)
+	require.Equal(t, "9740", hostPortBindings["5000/tcp"].HostPort)
+}
+
+func TestGetContainerHostConfig_VolumeMountsOwnedByContainerUserOnlyOnRootlessPodman(t *testing.T) {
+	volumeMounts := map[string]string{
+		"logs-collector-vol": "/fluent-bit/etc",
+	}
+	noUsedPorts := map[nat.Port]PortPublishSpec{}
+
+	hostConfig, err := getTestContainerHostConfig(newTestDockerManager(true, true), volumeMounts, true, noUsedPorts)
+	require.NoError(t, err)
+	require.Equal(t, []string{"logs-collector-vol:/fluent-bit/etc:U"}, hostConfig.Binds)
+
+	hostConfig, err = getTestContainerHostConfig(newTestDockerManager(true, true), volumeMounts, false, noUsedPorts)
+	require.NoError(t, err)
+	require.Equal(t, []string{"logs-collector-vol:/fluent-bit/etc"}, hostConfig.Binds)
+
+	hostConfig, err = getTestContainerHostConfig(newTestDockerManager(false, false), volumeMounts, true, noUsedPorts)
+	require.NoError(t, err)
+	require.Equal(t, []string{"logs-collector-vol:/fluent-bit/etc"}, hostConfig.Binds)
+}
+
+func TestIsRootless(t *testing.T) {
+	require.True(t, isRootless([]string{"name=seccomp,profile=default", "name=rootless", "name=cgroupns"}))
+	require.False(t, isRootless([]string{"name=seccomp,profile=default", "name=cgroupns"}))
+	require.False(t, isRootless(nil))
+}
+
+func newTestDockerManager(podmanMode bool, podmanRootlessMode bool) *DockerManager {
+	return &DockerManager{
+		dockerClient:          nil,
+		dockerClientNoTimeout: nil,
+		podmanMode:            podmanMode,
+		podmanRootlessMode:    podmanRootlessMode,
+	}
+}
+
+func getTestContainerHostConfig(
+	manager *DockerManager,
+	volumeMounts map[string]string,
+	volumeMountsOwnedByContainerUser bool,
+	usedPorts map[nat.Port]PortPublishSpec,
+) (*container.HostConfig, error) {
+	return manager.getContainerHostConfig(
+		map[ContainerCapability]bool{},
+		map[ContainerSecurityOpt]bool{},
+		DefaultNetworkMode,
+		map[string]string{},
+		volumeMounts,
+		volumeMountsOwnedByContainerUser,
+		usedPorts,
+		false,
+		0,
+		0,
+		nil,
+		false,
+		NoRestart,
+		[]string{},
+	)
+}
@@ -75,12 +75,18 @@ type manuallySpecifiedPortPublishSpec struct {
 	simplePortPublishSpec
 
 	hostMachinePortNum uint16
+
+	hostMachineIp string
 }
 
 func (option *manuallySpecifiedPortPublishSpec) getHostMachinePortNum() uint16 {
 	return option.hostMachinePortNum
 }
 
+func (option *manuallySpecifiedPortPublishSpec) getHostMachineIp() string {
+	return option.hostMachineIp
+}
+
 // Returns a PortPublishSpec indicating that the port should be published to the given port on the host machine
 func NewManualPublishingSpec(hostMachinePortNum uint16) PortPublishSpec {
 	return &manuallySpecifiedPortPublishSpec{
@@ -89,5 +95,19 @@ func NewManualPublishingSpec(hostMachinePortNum uint16) PortPublishSpec {
 			shouldFindAfterContainerStart: true,
 		},
 		hostMachinePortNum: hostMachinePortNum,
+		hostMachineIp:      expectedHostIp,
+	}
+}
+
+// Returns a PortPublishSpec indicating that the port should be published to the given port on the loopback interface
+// of the host machine only, so it can't be reached from other machines
+func NewLoopbackManualPublishingSpec(hostMachinePortNum uint16) PortPublishSpec {
+	return &manuallySpecifiedPortPublishSpec{
+		simplePortPublishSpec: simplePortPublishSpec{
+			publishType:                   manualPublishing,
+			shouldFindAfterContainerStart: true,
+		},
+		hostMachinePortNum: hostMachinePortNum,
+		hostMachineIp:      hostPortBindingInterfaceForUserConsumption,
 	}
 }
@@ -50,6 +50,14 @@ func ValidateUserCustomLabelKey(str string) error {
 	return nil
 }
 
+// GetUserCustomLabelKey returns the key the user gave to a custom user label, and false if the label isn't one
+func GetUserCustomLabelKey(labelKeyStr string) (string, bool) {
+	if !strings.HasPrefix(labelKeyStr, customUserLabelsKeyPrefixStr) {
+		return "", false
+	}
+	return strings.TrimPrefix(labelKeyStr, customUserLabelsKeyPrefixStr), true
+}
+
 // CreateNewDockerUserCustomLabelKey creates a Traefik Docker label with the Traefik label key prefix
 func CreateNewDockerTraefikLabelKey(str string) (*DockerLabelKey, error) {
 	labelKeyStr := traefikLabelKeyPrefixStr + str
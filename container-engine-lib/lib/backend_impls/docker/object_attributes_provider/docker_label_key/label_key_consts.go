@@ -32,6 +32,18 @@ const (
 
 	enclaveCreationTime = labelNamespaceStr + "enclave-creation-time"
 
+	// Set on the enclave expiration volume, as the labels of the enclave network can't be changed once it's created
+	enclaveExpirationTimeLabelKeyStr     = labelNamespaceStr + "enclave-expiration-time"
+	enclaveIdleTimeoutLabelKeyStr        = labelNamespaceStr + "enclave-idle-timeout"
+	expiredEnclaveActionLabelKeyStr      = labelNamespaceStr + "expired-enclave-action"
+	enclaveExpirationRevisionLabelKeyStr = labelNamespaceStr + "enclave-expiration-revision"
+
+	// Set on the enclave labels volume, next to the user labels of the enclave
+	enclaveLabelsRevisionLabelKeyStr = labelNamespaceStr + "enclave-labels-revision"
+
+	// Set on the enclave name volume, next to the enclave name and creation time labels, when the enclave gets renamed
+	enclaveNameRevisionLabelKeyStr = labelNamespaceStr + "enclave-name-revision"
+
 	privateIpAddrLabelKeyStr = labelNamespaceStr + "private-ip"
 
 	// We create a duplicate of the enclave uuid and service uuid label key because:
@@ -64,6 +76,12 @@ var PortSpecsDockerLabelKey = MustCreateNewDockerLabelKey(portSpecsLabelKeyStr)
 var EnclaveUUIDDockerLabelKey = MustCreateNewDockerLabelKey(enclaveIdLabelKeyStr)
 var EnclaveNameDockerLabelKey = MustCreateNewDockerLabelKey(enclaveNameLabelKeyStr)
 var EnclaveCreationTimeLabelKey = MustCreateNewDockerLabelKey(enclaveCreationTime)
+var EnclaveExpirationTimeDockerLabelKey = MustCreateNewDockerLabelKey(enclaveExpirationTimeLabelKeyStr)
+var EnclaveIdleTimeoutDockerLabelKey = MustCreateNewDockerLabelKey(enclaveIdleTimeoutLabelKeyStr)
+var ExpiredEnclaveActionDockerLabelKey = MustCreateNewDockerLabelKey(expiredEnclaveActionLabelKeyStr)
+var EnclaveExpirationRevisionDockerLabelKey = MustCreateNewDockerLabelKey(enclaveExpirationRevisionLabelKeyStr)
+var EnclaveLabelsRevisionDockerLabelKey = MustCreateNewDockerLabelKey(enclaveLabelsRevisionLabelKeyStr)
+var EnclaveNameRevisionDockerLabelKey = MustCreateNewDockerLabelKey(enclaveNameRevisionLabelKeyStr)
 var PrivateIPDockerLabelKey = MustCreateNewDockerLabelKey(privateIpAddrLabelKeyStr)
 var UserServiceGUIDDockerLabelKey = MustCreateNewDockerLabelKey(userServiceGuidDockerLabelKeyStr)
 var LogsEnclaveUUIDDockerLabelKey = MustCreateNewDockerLabelKey(logsOnlyEnclaveUuidLabelKeyStr)
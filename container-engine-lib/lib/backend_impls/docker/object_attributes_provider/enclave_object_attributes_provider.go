@@ -13,6 +13,7 @@ import (
 	"github.com/kurtosis-tech/kurtosis/container-engine-lib/lib/backend_impls/docker/object_attributes_provider/docker_object_name"
 	"github.com/kurtosis-tech/kurtosis/container-engine-lib/lib/backend_impls/docker/object_attributes_provider/docker_port_spec_serializer"
 	"github.com/kurtosis-tech/kurtosis/container-engine-lib/lib/backend_impls/docker/object_attributes_provider/label_value_consts"
+	"github.com/kurtosis-tech/kurtosis/container-engine-lib/lib/backend_interface/objects/enclave"
 	"github.com/kurtosis-tech/kurtosis/container-engine-lib/lib/backend_interface/objects/port_spec"
 	"github.com/kurtosis-tech/kurtosis/container-engine-lib/lib/backend_interface/objects/service"
 	"github.com/kurtosis-tech/kurtosis/container-engine-lib/lib/backend_interface/objects/service_directory"
@@ -26,8 +27,13 @@ const (
 
 	artifactExpansionVolumeNameFragment = "files-artifact-expansion"
 
-	artifactsExpanderContainerNameFragment = "files-artifacts-expander"
-	logsCollectorFragment                  = "kurtosis-logs-collector"
+	enclaveExpirationVolumeNameFragment = "expiration"
+	enclaveLabelsVolumeNameFragment     = "labels"
+	enclaveNameVolumeNameFragment       = "name"
+
+	artifactsExpanderContainerNameFragment         = "files-artifacts-expander"
+	persistentDirectoryCopierContainerNameFragment = "persistent-directory-copier"
+	logsCollectorFragment                          = "kurtosis-logs-collector"
 	// The collector is per enclave so this is a suffix
 	logsCollectorVolumeFragment = logsCollectorFragment + "-vol"
 
@@ -37,6 +43,9 @@ const (
 type DockerEnclaveObjectAttributesProvider interface {
 	ForEnclaveNetwork(enclaveName string, creationTime time.Time) (DockerObjectAttributes, error)
 	ForEnclaveDataVolume() (DockerObjectAttributes, error)
+	ForEnclaveExpirationVolume(expiration *enclave.EnclaveExpiration, revision int64) (DockerObjectAttributes, error)
+	ForEnclaveLabelsVolume(userLabels map[string]string, revision int64) (DockerObjectAttributes, error)
+	ForEnclaveNameVolume(enclaveName string, creationTime *time.Time, revision int64) (DockerObjectAttributes, error)
 	ForApiContainer(
 		ipAddr net.IP,
 		privateGrpcPortId string,
@@ -58,6 +67,10 @@ type DockerEnclaveObjectAttributesProvider interface {
 	ForSinglePersistentDirectoryVolume(
 		persistentKey service_directory.DirectoryPersistentKey,
 	) (DockerObjectAttributes, error)
+	GetPersistentKeyFromPersistentDirectoryVolumeName(volumeName string) (service_directory.DirectoryPersistentKey, error)
+	ForPersistentDirectoryCopierContainer(
+		persistentKey service_directory.DirectoryPersistentKey,
+	) (DockerObjectAttributes, error)
 	ForLogsCollector(tcpPortId string, tcpPortSpec *port_spec.PortSpec, httpPortId string, httpPortSpec *port_spec.PortSpec) (DockerObjectAttributes, error)
 	ForLogsCollectorVolume() (DockerObjectAttributes, error)
 }
@@ -151,6 +164,141 @@ func (provider *dockerEnclaveObjectAttributesProviderImpl) ForEnclaveDataVolume(
 	return objectAttributes, nil
 }
 
+// Docker labels can't be changed once an object is created, so the expiration of an enclave is kept in the labels of
+// a volume that gets replaced with a newer revision every time the expiration changes
+func (provider *dockerEnclaveObjectAttributesProviderImpl) ForEnclaveExpirationVolume(
+	expiration *enclave.EnclaveExpiration,
+	revision int64,
+) (DockerObjectAttributes, error) {
+	revisionStr := strconv.FormatInt(revision, 10)
+	name, err := provider.getNameForEnclaveObject([]string{enclaveExpirationVolumeNameFragment, revisionStr})
+	if err != nil {
+		return nil, stacktrace.Propagate(err, "An error occurred creating the enclave expiration volume Docker name object")
+	}
+
+	labelValueStrs := map[*docker_label_key.DockerLabelKey]string{
+		docker_label_key.EnclaveExpirationRevisionDockerLabelKey: revisionStr,
+		docker_label_key.ExpiredEnclaveActionDockerLabelKey:      expiration.GetAction().String(),
+	}
+	if expirationTime := expiration.GetExpirationTime(); expirationTime != nil {
+		labelValueStrs[docker_label_key.EnclaveExpirationTimeDockerLabelKey] = expirationTime.Format(time.RFC3339)
+	}
+	if idleTimeout := expiration.GetIdleTimeout(); idleTimeout > 0 {
+		labelValueStrs[docker_label_key.EnclaveIdleTimeoutDockerLabelKey] = idleTimeout.String()
+	}
+
+	labels := provider.getLabelsForEnclaveObject()
+	labels[docker_label_key.VolumeTypeDockerLabelKey] = label_value_consts.EnclaveExpirationVolumeTypeDockerLabelValue
+	for labelKey, labelValueStr := range labelValueStrs {
+		labelValue, err := docker_label_value.CreateNewDockerLabelValue(labelValueStr)
+		if err != nil {
+			return nil, stacktrace.Propagate(err, "An error occurred creating a Docker label value object from string '%v'", labelValueStr)
+		}
+		labels[labelKey] = labelValue
+	}
+
+	objectAttributes, err := newDockerObjectAttributesImpl(name, labels)
+	if err != nil {
+		return nil, stacktrace.Propagate(
+			err,
+			"An error occurred while creating the ObjectAttributesImpl with name '%s' and labels '%+v'",
+			name.GetString(),
+			getLabelKeyValuesAsStrings(labels),
+		)
+	}
+
+	return objectAttributes, nil
+}
+
+// The user labels of an enclave can change after it got created, while the labels of its Docker network can't be
+// changed once the network exists, so they're kept as custom user labels of a revisioned volume like the expiration
+func (provider *dockerEnclaveObjectAttributesProviderImpl) ForEnclaveLabelsVolume(
+	userLabels map[string]string,
+	revision int64,
+) (DockerObjectAttributes, error) {
+	revisionStr := strconv.FormatInt(revision, 10)
+	name, err := provider.getNameForEnclaveObject([]string{enclaveLabelsVolumeNameFragment, revisionStr})
+	if err != nil {
+		return nil, stacktrace.Propagate(err, "An error occurred creating the enclave labels volume Docker name object")
+	}
+
+	revisionLabelValue, err := docker_label_value.CreateNewDockerLabelValue(revisionStr)
+	if err != nil {
+		return nil, stacktrace.Propagate(err, "An error occurred creating a Docker label value object from string '%v'", revisionStr)
+	}
+	labels := provider.getLabelsForEnclaveObject()
+	labels[docker_label_key.VolumeTypeDockerLabelKey] = label_value_consts.EnclaveLabelsVolumeTypeDockerLabelValue
+	labels[docker_label_key.EnclaveLabelsRevisionDockerLabelKey] = revisionLabelValue
+	for userLabelKey, userLabelValue := range userLabels {
+		dockerLabelKey, err := docker_label_key.CreateNewDockerUserCustomLabelKey(userLabelKey)
+		if err != nil {
+			return nil, stacktrace.Propagate(err, "An error occurred creating a new user custom Docker label key '%s'", userLabelKey)
+		}
+		dockerLabelValue, err := docker_label_value.CreateNewDockerLabelValue(userLabelValue)
+		if err != nil {
+			return nil, stacktrace.Propagate(err, "An error occurred creating a new user custom Docker label value '%s'", userLabelValue)
+		}
+		labels[dockerLabelKey] = dockerLabelValue
+	}
+
+	objectAttributes, err := newDockerObjectAttributesImpl(name, labels)
+	if err != nil {
+		return nil, stacktrace.Propagate(
+			err,
+			"An error occurred while creating the ObjectAttributesImpl with name '%s' and labels '%+v'",
+			name.GetString(),
+			getLabelKeyValuesAsStrings(labels),
+		)
+	}
+
+	return objectAttributes, nil
+}
+
+// The name and the creation time of an enclave are labels of its network, so renaming the enclave stores the new ones in
+// a revisioned volume that takes precedence over the network labels
+// A nil creation time leaves the one of the enclave network in place
+func (provider *dockerEnclaveObjectAttributesProviderImpl) ForEnclaveNameVolume(
+	enclaveName string,
+	creationTime *time.Time,
+	revision int64,
+) (DockerObjectAttributes, error) {
+	revisionStr := strconv.FormatInt(revision, 10)
+	name, err := provider.getNameForEnclaveObject([]string{enclaveNameVolumeNameFragment, revisionStr})
+	if err != nil {
+		return nil, stacktrace.Propagate(err, "An error occurred creating the enclave name volume Docker name object")
+	}
+
+	labelValueStrs := map[*docker_label_key.DockerLabelKey]string{
+		docker_label_key.EnclaveNameRevisionDockerLabelKey: revisionStr,
+		docker_label_key.EnclaveNameDockerLabelKey:         enclaveName,
+	}
+	if creationTime != nil {
+		labelValueStrs[docker_label_key.EnclaveCreationTimeLabelKey] = creationTime.Format(time.RFC3339)
+	}
+
+	labels := provider.getLabelsForEnclaveObject()
+	labels[docker_label_key.VolumeTypeDockerLabelKey] = label_value_consts.EnclaveNameVolumeTypeDockerLabelValue
+	for labelKey, labelValueStr := range labelValueStrs {
+		labelValue, err := docker_label_value.CreateNewDockerLabelValue(labelValueStr)
+		if err != nil {
+			return nil, stacktrace.Propagate(err, "An error occurred creating a Docker label value object from string '%v'", labelValueStr)
+		}
+		labels[labelKey] = labelValue
+	}
+
+	objectAttributes, err := newDockerObjectAttributesImpl(name, labels)
+	if err != nil {
+		return nil, stacktrace.Propagate(
+			err,
+			"An error occurred while creating the ObjectAttributesImpl with name '%s' and labels '%+v'",
+			name.GetString(),
+			getLabelKeyValuesAsStrings(labels),
+		)
+	}
+
+	return objectAttributes, nil
+}
+
 func (provider *dockerEnclaveObjectAttributesProviderImpl) ForApiContainer(
 	ipAddr net.IP,
 	privateGrpcPortId string,
@@ -363,6 +511,48 @@ func (provider *dockerEnclaveObjectAttributesProviderImpl) ForSinglePersistentDi
 	return objectAttributes, nil
 }
 
+// Persistent directory volumes are named after their persistent key, followed by the enclave UUID
+func (provider *dockerEnclaveObjectAttributesProviderImpl) GetPersistentKeyFromPersistentDirectoryVolumeName(volumeName string) (service_directory.DirectoryPersistentKey, error) {
+	persistentKeyStr, found := strings.CutSuffix(volumeName, objectNameElementSeparator+provider.enclaveId.GetString())
+	if !found || !service_directory.IsPersistentKeyValid(service_directory.DirectoryPersistentKey(persistentKeyStr)) {
+		return "", stacktrace.NewError("Volume '%v' isn't named like a persistent directory volume of enclave '%v'", volumeName, provider.enclaveId.GetString())
+	}
+	return service_directory.DirectoryPersistentKey(persistentKeyStr), nil
+}
+
+func (provider *dockerEnclaveObjectAttributesProviderImpl) ForPersistentDirectoryCopierContainer(
+	persistentKey service_directory.DirectoryPersistentKey,
+) (
+	DockerObjectAttributes,
+	error,
+) {
+	guidStr, err := uuid_generator.GenerateUUIDString()
+	if err != nil {
+		return nil, stacktrace.Propagate(err, "An error occurred generating a UUID for the copier container of persistent directory '%v'", persistentKey)
+	}
+
+	name, err := provider.getNameForEnclaveObject([]string{
+		persistentDirectoryCopierContainerNameFragment,
+		string(persistentKey),
+	})
+	if err != nil {
+		return nil, stacktrace.Propagate(err, "An error occurred creating the copier container name of persistent directory '%v'", persistentKey)
+	}
+
+	labels, err := provider.getLabelsForEnclaveObjectWithGUID(guidStr)
+	if err != nil {
+		return nil, stacktrace.Propagate(err, "An error occurred getting labels for the copier container of persistent directory '%v'", persistentKey)
+	}
+	labels[docker_label_key.ContainerTypeDockerLabelKey] = label_value_consts.PersistentDirectoryCopierContainerTypeDockerLabelValue
+
+	objectAttributes, err := newDockerObjectAttributesImpl(name, labels)
+	if err != nil {
+		return nil, stacktrace.Propagate(err, "An error occurred while creating the ObjectAttributesImpl with the name '%s' and labels '%+v'", name, labels)
+	}
+
+	return objectAttributes, nil
+}
+
 // We'll have at most one files artifact expansion container per service, because the single container will handle
 // all expansion
 func (provider *dockerEnclaveObjectAttributesProviderImpl) ForFilesArtifactsExpanderContainer(
@@ -7,8 +7,10 @@ import (
 
 	"github.com/kurtosis-tech/kurtosis/container-engine-lib/lib/backend_impls/docker/docker_kurtosis_backend/consts"
 	"github.com/kurtosis-tech/kurtosis/container-engine-lib/lib/backend_impls/docker/object_attributes_provider/docker_label_key"
+	"github.com/kurtosis-tech/kurtosis/container-engine-lib/lib/backend_interface/objects/enclave"
 	"github.com/kurtosis-tech/kurtosis/container-engine-lib/lib/backend_interface/objects/port_spec"
 	"github.com/kurtosis-tech/kurtosis/container-engine-lib/lib/backend_interface/objects/service"
+	"github.com/kurtosis-tech/kurtosis/container-engine-lib/lib/backend_interface/objects/service_directory"
 	"github.com/stretchr/testify/require"
 )
 
@@ -78,3 +80,94 @@ func TestForUserServiceContainer(t *testing.T) {
 		}
 	}
 }
+
+func TestForEnclaveExpirationVolume(t *testing.T) {
+	objAttrsProvider := GetDockerObjectAttributesProvider()
+	enclaveObjAttrsProvider, err := objAttrsProvider.ForEnclave(enclaveUuid)
+	require.NoError(t, err, "An unexpected error occurred getting the enclave object attributes provider")
+
+	expirationTime := time.Date(2024, 5, 1, 12, 30, 0, 0, time.UTC)
+	expiration := enclave.NewEnclaveExpiration(&expirationTime, 90*time.Minute, enclave.ExpiredEnclaveAction_Stop)
+	volumeAttrs, err := enclaveObjAttrsProvider.ForEnclaveExpirationVolume(expiration, 1714566600000000000)
+	require.NoError(t, err, "An unexpected error occurred getting the volume attributes")
+	require.Equal(t, "expiration--1714566600000000000--65d2fb6d673249b8b4a91a2f4ae616de", volumeAttrs.GetName().GetString())
+
+	labelStrs := map[string]string{}
+	for labelKey, labelValue := range volumeAttrs.GetLabels() {
+		labelStrs[labelKey.GetString()] = labelValue.GetString()
+	}
+	require.Equal(t, "enclave-expiration", labelStrs[docker_label_key.VolumeTypeDockerLabelKey.GetString()])
+	require.Equal(t, enclaveUuid, labelStrs[docker_label_key.EnclaveUUIDDockerLabelKey.GetString()])
+	require.Equal(t, "2024-05-01T12:30:00Z", labelStrs[docker_label_key.EnclaveExpirationTimeDockerLabelKey.GetString()])
+	require.Equal(t, "1h30m0s", labelStrs[docker_label_key.EnclaveIdleTimeoutDockerLabelKey.GetString()])
+	require.Equal(t, "STOP", labelStrs[docker_label_key.ExpiredEnclaveActionDockerLabelKey.GetString()])
+	require.Equal(t, "1714566600000000000", labelStrs[docker_label_key.EnclaveExpirationRevisionDockerLabelKey.GetString()])
+}
+
+func TestForEnclaveLabelsVolume(t *testing.T) {
+	objAttrsProvider := GetDockerObjectAttributesProvider()
+	enclaveObjAttrsProvider, err := objAttrsProvider.ForEnclave(enclaveUuid)
+	require.NoError(t, err, "An unexpected error occurred getting the enclave object attributes provider")
+
+	userLabels := map[string]string{"team": "infra", "pr": "1234"}
+	volumeAttrs, err := enclaveObjAttrsProvider.ForEnclaveLabelsVolume(userLabels, 1714566600000000000)
+	require.NoError(t, err, "An unexpected error occurred getting the volume attributes")
+	require.Equal(t, "labels--1714566600000000000--65d2fb6d673249b8b4a91a2f4ae616de", volumeAttrs.GetName().GetString())
+
+	foundUserLabels := map[string]string{}
+	labelStrs := map[string]string{}
+	for labelKey, labelValue := range volumeAttrs.GetLabels() {
+		labelStrs[labelKey.GetString()] = labelValue.GetString()
+		if userLabelKey, isUserLabel := docker_label_key.GetUserCustomLabelKey(labelKey.GetString()); isUserLabel {
+			foundUserLabels[userLabelKey] = labelValue.GetString()
+		}
+	}
+	require.Equal(t, "enclave-labels", labelStrs[docker_label_key.VolumeTypeDockerLabelKey.GetString()])
+	require.Equal(t, enclaveUuid, labelStrs[docker_label_key.EnclaveUUIDDockerLabelKey.GetString()])
+	require.Equal(t, "1714566600000000000", labelStrs[docker_label_key.EnclaveLabelsRevisionDockerLabelKey.GetString()])
+	require.Equal(t, userLabels, foundUserLabels)
+}
+
+func TestForEnclaveNameVolume(t *testing.T) {
+	objAttrsProvider := GetDockerObjectAttributesProvider()
+	enclaveObjAttrsProvider, err := objAttrsProvider.ForEnclave(enclaveUuid)
+	require.NoError(t, err, "An unexpected error occurred getting the enclave object attributes provider")
+
+	creationTime := time.Date(2024, 5, 1, 12, 30, 0, 0, time.UTC)
+	volumeAttrs, err := enclaveObjAttrsProvider.ForEnclaveNameVolume("renamed-enclave", &creationTime, 1714566600000000000)
+	require.NoError(t, err, "An unexpected error occurred getting the volume attributes")
+	require.Equal(t, "name--1714566600000000000--65d2fb6d673249b8b4a91a2f4ae616de", volumeAttrs.GetName().GetString())
+
+	labelStrs := map[string]string{}
+	for labelKey, labelValue := range volumeAttrs.GetLabels() {
+		labelStrs[labelKey.GetString()] = labelValue.GetString()
+	}
+	require.Equal(t, "enclave-name", labelStrs[docker_label_key.VolumeTypeDockerLabelKey.GetString()])
+	require.Equal(t, enclaveUuid, labelStrs[docker_label_key.EnclaveUUIDDockerLabelKey.GetString()])
+	require.Equal(t, "renamed-enclave", labelStrs[docker_label_key.EnclaveNameDockerLabelKey.GetString()])
+	require.Equal(t, "2024-05-01T12:30:00Z", labelStrs[docker_label_key.EnclaveCreationTimeLabelKey.GetString()])
+	require.Equal(t, "1714566600000000000", labelStrs[docker_label_key.EnclaveNameRevisionDockerLabelKey.GetString()])
+
+	volumeAttrs, err = enclaveObjAttrsProvider.ForEnclaveNameVolume("renamed-enclave", nil, 1714566600000000000)
+	require.NoError(t, err, "An unexpected error occurred getting the volume attributes")
+	for labelKey := range volumeAttrs.GetLabels() {
+		require.NotEqual(t, docker_label_key.EnclaveCreationTimeLabelKey.GetString(), labelKey.GetString())
+	}
+}
+
+func TestGetPersistentKeyFromPersistentDirectoryVolumeName(t *testing.T) {
+	objAttrsProvider := GetDockerObjectAttributesProvider()
+	enclaveObjAttrsProvider, err := objAttrsProvider.ForEnclave(enclaveUuid)
+	require.NoError(t, err, "An unexpected error occurred getting the enclave object attributes provider")
+
+	volumeAttrs, err := enclaveObjAttrsProvider.ForSinglePersistentDirectoryVolume("geth-data")
+	require.NoError(t, err, "An unexpected error occurred getting the volume attributes")
+	persistentKey, err := enclaveObjAttrsProvider.GetPersistentKeyFromPersistentDirectoryVolumeName(volumeAttrs.GetName().GetString())
+	require.NoError(t, err)
+	require.Equal(t, service_directory.DirectoryPersistentKey("geth-data"), persistentKey)
+
+	otherEnclaveObjAttrsProvider, err := objAttrsProvider.ForEnclave("8f1a2e0c1d4b4f6a9c3e5b7d9f0a1b2c")
+	require.NoError(t, err, "An unexpected error occurred getting the enclave object attributes provider")
+	_, err = otherEnclaveObjAttrsProvider.GetPersistentKeyFromPersistentDirectoryVolumeName(volumeAttrs.GetName().GetString())
+	require.Error(t, err)
+}
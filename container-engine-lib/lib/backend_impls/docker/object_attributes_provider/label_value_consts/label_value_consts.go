@@ -18,11 +18,16 @@ const (
 	reverseProxyContainerTypeLabelValueStr   = "kurtosis-reverse-proxy"
 	// !!!!!!!!!!!!!!!!!!!!!!!!!!!!!!!! DO NOT CHANGE THESE VALUES !!!!!!!!!!!!!!!!!!!!!!!!!!!!!
 
-	apiContainerContainerTypeLabelValueStr           = "api-container"
-	userServiceContainerTypeLabelValueStr            = "user-service"
-	filesArtifactsExpanderContainerTypeLabelValueStr = "files-artifacts-expander"
+	apiContainerContainerTypeLabelValueStr              = "api-container"
+	userServiceContainerTypeLabelValueStr               = "user-service"
+	filesArtifactsExpanderContainerTypeLabelValueStr    = "files-artifacts-expander"
+	imageRegistryContainerTypeLabelValueStr             = "kurtosis-image-registry"
+	persistentDirectoryCopierContainerTypeLabelValueStr = "persistent-directory-copier"
 
 	enclaveDataVolumeTypeLabelValueStr            = "enclave-data"
+	enclaveExpirationVolumeTypeLabelValueStr      = "enclave-expiration"
+	enclaveLabelsVolumeTypeLabelValueStr          = "enclave-labels"
+	enclaveNameVolumeTypeLabelValueStr            = "enclave-name"
 	filesArtifactExpansionVolumeTypeLabelValueStr = "files-artifacts-expansion"
 	persistentDirectoryVolumeTypeLabelValueStr    = "persistent-directory"
 	logsAggregatorDataVolumeTypeLabelValueStr     = "logs-aggregator-data"
@@ -31,6 +36,7 @@ const (
 	logsCollectorVolumeTypeLabelValueStr          = "logs-collector-data"
 	githubAuthStorageVolumeTypeLabelValueStr      = "github-auth-storage"
 	dockerConfigStorageVolumeTypeLabelValueStr    = "docker-config-storage"
+	imageRegistryDataVolumeTypeLabelValueStr      = "image-registry-data"
 )
 
 // !!!!!!!!!!!!!!!!!!!!!!!!!!!!!!!! DO NOT CHANGE THESE VALUES !!!!!!!!!!!!!!!!!!!!!!!!!!!!!
@@ -50,8 +56,13 @@ var ReverseProxyTypeDockerLabelValue = docker_label_value.MustCreateNewDockerLab
 var APIContainerContainerTypeDockerLabelValue = docker_label_value.MustCreateNewDockerLabelValue(apiContainerContainerTypeLabelValueStr)
 var UserServiceContainerTypeDockerLabelValue = docker_label_value.MustCreateNewDockerLabelValue(userServiceContainerTypeLabelValueStr)
 var FilesArtifactExpanderContainerTypeDockerLabelValue = docker_label_value.MustCreateNewDockerLabelValue(filesArtifactsExpanderContainerTypeLabelValueStr)
+var ImageRegistryContainerTypeDockerLabelValue = docker_label_value.MustCreateNewDockerLabelValue(imageRegistryContainerTypeLabelValueStr)
+var PersistentDirectoryCopierContainerTypeDockerLabelValue = docker_label_value.MustCreateNewDockerLabelValue(persistentDirectoryCopierContainerTypeLabelValueStr)
 
 var EnclaveDataVolumeTypeDockerLabelValue = docker_label_value.MustCreateNewDockerLabelValue(enclaveDataVolumeTypeLabelValueStr)
+var EnclaveExpirationVolumeTypeDockerLabelValue = docker_label_value.MustCreateNewDockerLabelValue(enclaveExpirationVolumeTypeLabelValueStr)
+var EnclaveLabelsVolumeTypeDockerLabelValue = docker_label_value.MustCreateNewDockerLabelValue(enclaveLabelsVolumeTypeLabelValueStr)
+var EnclaveNameVolumeTypeDockerLabelValue = docker_label_value.MustCreateNewDockerLabelValue(enclaveNameVolumeTypeLabelValueStr)
 var FilesArtifactExpansionVolumeTypeDockerLabelValue = docker_label_value.MustCreateNewDockerLabelValue(filesArtifactExpansionVolumeTypeLabelValueStr)
 var PersistentDirectoryVolumeTypeDockerLabelValue = docker_label_value.MustCreateNewDockerLabelValue(persistentDirectoryVolumeTypeLabelValueStr)
 var LogsAggregatorDataVolumeTypeDockerLabelValue = docker_label_value.MustCreateNewDockerLabelValue(logsAggregatorDataVolumeTypeLabelValueStr)
@@ -60,3 +71,4 @@ var LogsStorageVolumeTypeDockerLabelValue = docker_label_value.MustCreateNewDock
 var LogsCollectorVolumeTypeDockerLabelValue = docker_label_value.MustCreateNewDockerLabelValue(logsCollectorVolumeTypeLabelValueStr)
 var GitHubAuthStorageVolumeTypeDockerLabelValue = docker_label_value.MustCreateNewDockerLabelValue(githubAuthStorageVolumeTypeLabelValueStr)
 var DockerConfigStorageVolumeTypeDockerLabelValue = docker_label_value.MustCreateNewDockerLabelValue(dockerConfigStorageVolumeTypeLabelValueStr)
+var ImageRegistryDataVolumeTypeDockerLabelValue = docker_label_value.MustCreateNewDockerLabelValue(imageRegistryDataVolumeTypeLabelValueStr)
@@ -26,6 +26,8 @@ const (
 	dockerConfigStorageVolumeName  = "kurtosis-docker-config-storage"
 	engineRESTAPIPortStr           = "engine-rest-api"
 	reverseProxyNamePrefix         = "kurtosis-reverse-proxy"
+	imageRegistryName              = "kurtosis-image-registry"
+	imageRegistryDataVolumeName    = imageRegistryName + "-data"
 )
 
 type DockerObjectAttributesProvider interface {
@@ -44,6 +46,8 @@ type DockerObjectAttributesProvider interface {
 	ForReverseProxy(engineGuid engine.EngineGUID) (DockerObjectAttributes, error)
 	ForGitHubAuthStorageVolume() (DockerObjectAttributes, error)
 	ForDockerConfigStorageVolume() (DockerObjectAttributes, error)
+	ForImageRegistry() (DockerObjectAttributes, error)
+	ForImageRegistryDataVolume() (DockerObjectAttributes, error)
 }
 
 func GetDockerObjectAttributesProvider() DockerObjectAttributesProvider {
@@ -277,6 +281,42 @@ func (provider *dockerObjectAttributesProviderImpl) ForReverseProxy(engineGuid e
 	return objectAttributes, nil
 }
 
+// The image registry is shared by the engines that ever run on the Docker host, so it isn't named after the engine GUID
+// like the reverse proxy
+func (provider *dockerObjectAttributesProviderImpl) ForImageRegistry() (DockerObjectAttributes, error) {
+	name, err := docker_object_name.CreateNewDockerObjectName(imageRegistryName)
+	if err != nil {
+		return nil, stacktrace.Propagate(err, "An error occurred creating a Docker object name object from string '%v'", imageRegistryName)
+	}
+
+	labels := map[*docker_label_key.DockerLabelKey]*docker_label_value.DockerLabelValue{
+		docker_label_key.ContainerTypeDockerLabelKey: label_value_consts.ImageRegistryContainerTypeDockerLabelValue,
+	}
+
+	objectAttributes, err := newDockerObjectAttributesImpl(name, labels)
+	if err != nil {
+		return nil, stacktrace.Propagate(err, "An error occurred while creating the ObjectAttributesImpl with the name '%s' and labels '%+v'", name, labels)
+	}
+	return objectAttributes, nil
+}
+
+func (provider *dockerObjectAttributesProviderImpl) ForImageRegistryDataVolume() (DockerObjectAttributes, error) {
+	name, err := docker_object_name.CreateNewDockerObjectName(imageRegistryDataVolumeName)
+	if err != nil {
+		return nil, stacktrace.Propagate(err, "An error occurred creating a Docker object name object from string '%v'", imageRegistryDataVolumeName)
+	}
+
+	labels := map[*docker_label_key.DockerLabelKey]*docker_label_value.DockerLabelValue{
+		docker_label_key.VolumeTypeDockerLabelKey: label_value_consts.ImageRegistryDataVolumeTypeDockerLabelValue,
+	}
+
+	objectAttributes, err := newDockerObjectAttributesImpl(name, labels)
+	if err != nil {
+		return nil, stacktrace.Propagate(err, "An error occurred while creating the ObjectAttributesImpl with the name '%s' and labels '%+v'", name, labels)
+	}
+	return objectAttributes, nil
+}
+
 // Return Traefik labels
 // Including the labels required to route traffic to the engine rest api port if the Host header is set to "engine".
 //
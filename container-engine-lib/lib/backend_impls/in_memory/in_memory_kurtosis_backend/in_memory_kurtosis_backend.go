@@ -0,0 +1,355 @@
+package in_memory_kurtosis_backend
+
+import (
+	"context"
+	"net"
+	"path"
+	"sync"
+	"time"
+
+	"github.com/kurtosis-tech/kurtosis/container-engine-lib/lib/backend_interface/objects/compute_resources"
+	"github.com/kurtosis-tech/kurtosis/container-engine-lib/lib/backend_interface/objects/container"
+	"github.com/kurtosis-tech/kurtosis/container-engine-lib/lib/backend_interface/objects/enclave"
+	"github.com/kurtosis-tech/kurtosis/container-engine-lib/lib/backend_interface/objects/engine"
+	"github.com/kurtosis-tech/kurtosis/container-engine-lib/lib/backend_interface/objects/exec_result"
+	"github.com/kurtosis-tech/kurtosis/container-engine-lib/lib/backend_interface/objects/logs_aggregator"
+	"github.com/kurtosis-tech/kurtosis/container-engine-lib/lib/backend_interface/objects/logs_collector"
+	"github.com/kurtosis-tech/kurtosis/container-engine-lib/lib/backend_interface/objects/port_spec"
+	"github.com/kurtosis-tech/kurtosis/container-engine-lib/lib/backend_interface/objects/reverse_proxy"
+	"github.com/kurtosis-tech/kurtosis/container-engine-lib/lib/backend_interface/objects/service"
+	"github.com/kurtosis-tech/kurtosis/container-engine-lib/lib/uuid_generator"
+	"github.com/kurtosis-tech/stacktrace"
+)
+
+const (
+	successExitCode = 0
+
+	emptyOutput = ""
+
+	// Every enclave gets its own /24 network carved out of this /8, the same way Docker allocates one network per enclave
+	enclaveNetworkFirstOctet = 10
+
+	// The first addresses of every enclave network are reserved for the gateway and the Kurtosis containers
+	apiContainerHostIndex     = 2
+	logsCollectorHostIndex    = 3
+	firstUserServiceHostIndex = 10
+	lastUserServiceHostIndex  = 254
+
+	maxNumEnclaveNetworks = 256 * 256
+)
+
+var (
+	localhostIpAddr = net.IPv4(127, 0, 0, 1)
+
+	// The default instance is shared by every caller in the process, so that an engine and its API containers running
+	// in the same test binary see the same enclaves and services
+	defaultBackend     *InMemoryKurtosisBackend
+	defaultBackendOnce sync.Once
+)
+
+// ExecCommandHandler simulates running the given command inside the container of a user service
+type ExecCommandHandler func(enclaveUuid enclave.EnclaveUUID, serviceName service.ServiceName, containerUser string, cmd []string) (*exec_result.ExecResult, error)
+
+// ServiceStartHandler is called every time a registered user service gets started; returning an error simulates a
+// service that fails to start
+type ServiceStartHandler func(enclaveUuid enclave.EnclaveUUID, serviceName service.ServiceName, config *service.ServiceConfig) error
+
+// ServiceLogsHandler returns the logs a user service has written so far
+type ServiceLogsHandler func(enclaveUuid enclave.EnclaveUUID, serviceName service.ServiceName) (string, error)
+
+// APIContainerStartHandler is called when the API container of an enclave gets created. It receives the environment
+// the API container would have been started with (including its serialized args), starts an API container server on
+// the backend, typically in-process, and returns the public IP and port it can be reached on along with the function
+// stopping it
+type APIContainerStartHandler func(ctx context.Context, enclaveUuid enclave.EnclaveUUID, grpcPortNum uint16, envVars map[string]string) (net.IP, uint16, APIContainerStopFunc, error)
+
+// APIContainerStopFunc stops the API container server started by an APIContainerStartHandler; it's called once, when
+// the API container or its enclave gets stopped or destroyed
+type APIContainerStopFunc func()
+
+// InMemoryKurtosisBackend is a stateful KurtosisBackend that keeps every object it creates in memory and never talks to
+// a container engine. Enclaves, services, ports and files behave like they would on a real backend while command
+// execution, logs and API container startup are simulated through programmable handlers, so that the engine, the API
+// container and the Starlark engine can be exercised end-to-end without Docker
+type InMemoryKurtosisBackend struct {
+	mutex *sync.RWMutex
+
+	engines map[engine.EngineGUID]*inMemoryEngine
+
+	enclaves map[enclave.EnclaveUUID]*inMemoryEnclave
+
+	// Index of the next enclave network to hand out
+	nextEnclaveNetworkIndex int
+
+	// "Set" of images that were pulled or built, which is what the image pruning works on
+	images map[string]bool
+
+	logsAggregator *logs_aggregator.LogsAggregator
+
+	reverseProxy *reverse_proxy.ReverseProxy
+
+	imageRegistry *inMemoryImageRegistry
+
+	// Built image name -> reference of the image pushed to the image registry
+	builtImageReferences map[string]string
+
+	// Nil until set, in which case the resources are reported as unknown and never validated against
+	availableResources *inMemoryAvailableResources
+
+	execCommandHandler ExecCommandHandler
+
+	serviceStartHandler ServiceStartHandler
+
+	serviceLogsHandler ServiceLogsHandler
+
+	apiContainerStartHandler APIContainerStartHandler
+}
+
+type inMemoryEngine struct {
+	guid     engine.EngineGUID
+	status   container.ContainerStatus
+	grpcPort *port_spec.PortSpec
+}
+
+type inMemoryEnclave struct {
+	uuid                enclave.EnclaveUUID
+	name                string
+	creationTime        *time.Time
+	isProductionEnclave bool
+	networkIndex        int
+	expiration          *enclave.EnclaveExpiration
+	labels              map[string]string
+
+	apiContainer *inMemoryAPIContainer
+
+	logsCollector *logs_collector.LogsCollector
+
+	services map[service.ServiceName]*inMemoryUserService
+}
+
+type inMemoryAPIContainer struct {
+	status         container.ContainerStatus
+	grpcPort       *port_spec.PortSpec
+	publicIpAddr   net.IP
+	publicGrpcPort *port_spec.PortSpec
+
+	// Nil once the API container server was stopped
+	stopFunc APIContainerStopFunc
+}
+
+type inMemoryUserService struct {
+	registration *service.ServiceRegistration
+
+	hostIndex int
+
+	// Nil when the service is registered but was never started, or when its process got removed
+	container *container.Container
+
+	privatePorts map[string]*port_spec.PortSpec
+	publicPorts  map[string]*port_spec.PortSpec
+
+	// Absolute filepath on the service -> file content
+	files map[string][]byte
+}
+
+type inMemoryImageRegistry struct {
+	status container.ContainerStatus
+
+	// Repository name -> "set" of tags
+	repositories map[string]map[string]bool
+}
+
+type inMemoryAvailableResources struct {
+	memory compute_resources.MemoryInMegaBytes
+	cpu    compute_resources.CpuMilliCores
+}
+
+func NewInMemoryKurtosisBackend() *InMemoryKurtosisBackend {
+	return &InMemoryKurtosisBackend{
+		mutex:                    &sync.RWMutex{},
+		engines:                  map[engine.EngineGUID]*inMemoryEngine{},
+		enclaves:                 map[enclave.EnclaveUUID]*inMemoryEnclave{},
+		nextEnclaveNetworkIndex:  0,
+		images:                   map[string]bool{},
+		logsAggregator:           nil,
+		reverseProxy:             nil,
+		imageRegistry:            nil,
+		availableResources:       nil,
+		builtImageReferences:     map[string]string{},
+		execCommandHandler:       defaultExecCommandHandler,
+		serviceStartHandler:      defaultServiceStartHandler,
+		serviceLogsHandler:       defaultServiceLogsHandler,
+		apiContainerStartHandler: defaultAPIContainerStartHandler,
+	}
+}
+
+// GetDefaultInMemoryKurtosisBackend returns the process-wide backend that the engine uses when it's launched with the
+// in-memory backend type, and that the API containers it starts are served on
+func GetDefaultInMemoryKurtosisBackend() *InMemoryKurtosisBackend {
+	defaultBackendOnce.Do(func() {
+		defaultBackend = NewInMemoryKurtosisBackend()
+	})
+	return defaultBackend
+}
+
+// ====================================================================================================
+//
+//	Handlers
+//
+// ====================================================================================================
+func (backend *InMemoryKurtosisBackend) SetExecCommandHandler(handler ExecCommandHandler) {
+	backend.mutex.Lock()
+	defer backend.mutex.Unlock()
+	backend.execCommandHandler = handler
+}
+
+func (backend *InMemoryKurtosisBackend) SetServiceStartHandler(handler ServiceStartHandler) {
+	backend.mutex.Lock()
+	defer backend.mutex.Unlock()
+	backend.serviceStartHandler = handler
+}
+
+func (backend *InMemoryKurtosisBackend) SetServiceLogsHandler(handler ServiceLogsHandler) {
+	backend.mutex.Lock()
+	defer backend.mutex.Unlock()
+	backend.serviceLogsHandler = handler
+}
+
+func (backend *InMemoryKurtosisBackend) SetAPIContainerStartHandler(handler APIContainerStartHandler) {
+	backend.mutex.Lock()
+	defer backend.mutex.Unlock()
+	backend.apiContainerStartHandler = handler
+}
+
+// SetAvailableCPUAndMemory sets the resources reported as available to run services with
+func (backend *InMemoryKurtosisBackend) SetAvailableCPUAndMemory(memory compute_resources.MemoryInMegaBytes, cpu compute_resources.CpuMilliCores) {
+	backend.mutex.Lock()
+	defer backend.mutex.Unlock()
+	backend.availableResources = &inMemoryAvailableResources{
+		memory: memory,
+		cpu:    cpu,
+	}
+}
+
+// WriteFileOnUserService puts a file on a registered user service, so that it can later be copied out of it
+func (backend *InMemoryKurtosisBackend) WriteFileOnUserService(enclaveUuid enclave.EnclaveUUID, serviceUuid service.ServiceUUID, filepathOnService string, content []byte) error {
+	backend.mutex.Lock()
+	defer backend.mutex.Unlock()
+	userService, err := backend.getUserServiceNoLock(enclaveUuid, serviceUuid)
+	if err != nil {
+		return stacktrace.Propagate(err, "An error occurred getting service '%v' in enclave '%v'", serviceUuid, enclaveUuid)
+	}
+	userService.files[path.Clean(filepathOnService)] = content
+	return nil
+}
+
+// ====================================================================================================
+//
+//	Private helpers
+//
+// ====================================================================================================
+func (backend *InMemoryKurtosisBackend) getEnclaveNoLock(enclaveUuid enclave.EnclaveUUID) (*inMemoryEnclave, error) {
+	enclaveObj, found := backend.enclaves[enclaveUuid]
+	if !found {
+		return nil, stacktrace.NewError("No enclave with UUID '%v' exists", enclaveUuid)
+	}
+	return enclaveObj, nil
+}
+
+func (backend *InMemoryKurtosisBackend) getUserServiceNoLock(enclaveUuid enclave.EnclaveUUID, serviceUuid service.ServiceUUID) (*inMemoryUserService, error) {
+	enclaveObj, err := backend.getEnclaveNoLock(enclaveUuid)
+	if err != nil {
+		return nil, err
+	}
+	for _, userService := range enclaveObj.services {
+		if userService.registration.GetUUID() == serviceUuid {
+			return userService, nil
+		}
+	}
+	return nil, stacktrace.NewError("No service with UUID '%v' exists in enclave '%v'", serviceUuid, enclaveUuid)
+}
+
+func generateUuid() (string, error) {
+	uuidStr, err := uuid_generator.GenerateUUIDString()
+	if err != nil {
+		return "", stacktrace.Propagate(err, "An error occurred generating a UUID")
+	}
+	return uuidStr, nil
+}
+
+func (enclaveObj *inMemoryEnclave) getIpAddr(hostIndex int) net.IP {
+	return net.IPv4(enclaveNetworkFirstOctet, byte(enclaveObj.networkIndex/256), byte(enclaveObj.networkIndex%256), byte(hostIndex))
+}
+
+func (enclaveObj *inMemoryEnclave) getFreeHostIndex() (int, error) {
+	usedHostIndices := map[int]bool{}
+	for _, userService := range enclaveObj.services {
+		usedHostIndices[userService.hostIndex] = true
+	}
+	for hostIndex := firstUserServiceHostIndex; hostIndex <= lastUserServiceHostIndex; hostIndex++ {
+		if !usedHostIndices[hostIndex] {
+			return hostIndex, nil
+		}
+	}
+	return 0, stacktrace.NewError("No free IP address is left in the network of enclave '%v'", enclaveObj.uuid)
+}
+
+// getStatus derives the enclave status from its containers, the same way the container-based backends do
+func (enclaveObj *inMemoryEnclave) getStatus() enclave.EnclaveStatus {
+	hasContainers := false
+	if enclaveObj.apiContainer != nil {
+		hasContainers = true
+		if enclaveObj.apiContainer.status == container.ContainerStatus_Running {
+			return enclave.EnclaveStatus_Running
+		}
+	}
+	for _, userService := range enclaveObj.services {
+		if userService.container == nil {
+			continue
+		}
+		hasContainers = true
+		if userService.container.GetStatus() == container.ContainerStatus_Running {
+			return enclave.EnclaveStatus_Running
+		}
+	}
+	if !hasContainers {
+		return enclave.EnclaveStatus_Empty
+	}
+	return enclave.EnclaveStatus_Stopped
+}
+
+func defaultExecCommandHandler(enclaveUuid enclave.EnclaveUUID, serviceName service.ServiceName, containerUser string, cmd []string) (*exec_result.ExecResult, error) {
+	return exec_result.NewExecResult(successExitCode, emptyOutput), nil
+}
+
+func defaultServiceStartHandler(enclaveUuid enclave.EnclaveUUID, serviceName service.ServiceName, config *service.ServiceConfig) error {
+	return nil
+}
+
+func defaultServiceLogsHandler(enclaveUuid enclave.EnclaveUUID, serviceName service.ServiceName) (string, error) {
+	return emptyOutput, nil
+}
+
+// Nothing could answer the engine calls to the API container, so enclaves can't be created until a handler starting a
+// real API container server is set
+func defaultAPIContainerStartHandler(ctx context.Context, enclaveUuid enclave.EnclaveUUID, grpcPortNum uint16, envVars map[string]string) (net.IP, uint16, APIContainerStopFunc, error) {
+	return nil, 0, nil, stacktrace.NewError("No API container start handler was set on the in-memory backend, so the API container of enclave '%v' can't be started", enclaveUuid)
+}
+
+// stop marks the API container as stopped and returns the function stopping its server, if it wasn't stopped yet
+func (apiContainer *inMemoryAPIContainer) stop() APIContainerStopFunc {
+	apiContainer.status = container.ContainerStatus_Stopped
+	stopFunc := apiContainer.stopFunc
+	apiContainer.stopFunc = nil
+	return stopFunc
+}
+
+// runAPIContainerStopFuncs is called once the backend lock is released, as the calls the API container servers are
+// still answering may need it to complete
+func runAPIContainerStopFuncs(stopFuncs []APIContainerStopFunc) {
+	for _, stopFunc := range stopFuncs {
+		if stopFunc != nil {
+			stopFunc()
+		}
+	}
+}
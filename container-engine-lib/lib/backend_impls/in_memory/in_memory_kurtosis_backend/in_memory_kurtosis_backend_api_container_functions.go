@@ -0,0 +1,191 @@
+package in_memory_kurtosis_backend
+
+import (
+	"context"
+	"net"
+
+	"github.com/kurtosis-tech/kurtosis/container-engine-lib/lib/backend_interface/objects/api_container"
+	"github.com/kurtosis-tech/kurtosis/container-engine-lib/lib/backend_interface/objects/container"
+	"github.com/kurtosis-tech/kurtosis/container-engine-lib/lib/backend_interface/objects/enclave"
+	"github.com/kurtosis-tech/kurtosis/container-engine-lib/lib/backend_interface/objects/port_spec"
+	"github.com/kurtosis-tech/stacktrace"
+)
+
+const (
+	// Same env var the engine sets on the API container of production enclaves for the container-based backends
+	isProductionEnclaveEnvVarKey    = "isProductionEnclave"
+	enableProductionModeEnvVarValue = "true"
+)
+
+func (backend *InMemoryKurtosisBackend) CreateAPIContainer(
+	ctx context.Context,
+	image string,
+	enclaveUuid enclave.EnclaveUUID,
+	grpcPortNum uint16,
+	enclaveDataVolumeDirpath string,
+	ownIpAddressEnvVar string,
+	customEnvVars map[string]string,
+	shouldStartInDebugMode bool,
+) (
+	*api_container.APIContainer,
+	error,
+) {
+	backend.mutex.Lock()
+	enclaveObj, err := backend.getEnclaveNoLock(enclaveUuid)
+	if err != nil {
+		backend.mutex.Unlock()
+		return nil, stacktrace.Propagate(err, "An error occurred getting the enclave to create the API container in")
+	}
+	if enclaveObj.apiContainer != nil {
+		backend.mutex.Unlock()
+		return nil, stacktrace.NewError("An API container already exists in enclave '%v'", enclaveUuid)
+	}
+	apiContainerStartHandler := backend.apiContainerStartHandler
+	backend.mutex.Unlock()
+
+	envVars := map[string]string{
+		ownIpAddressEnvVar: enclaveObj.getIpAddr(apiContainerHostIndex).String(),
+	}
+	for key, value := range customEnvVars {
+		envVars[key] = value
+	}
+	// The handler may well call back into the backend (e.g. an API container started in-process), so it's called
+	// without holding the lock
+	publicIpAddr, publicGrpcPortNum, stopFunc, err := apiContainerStartHandler(ctx, enclaveUuid, grpcPortNum, envVars)
+	if err != nil {
+		return nil, stacktrace.Propagate(err, "An error occurred starting the API container of enclave '%v'", enclaveUuid)
+	}
+	shouldStopApiContainer := true
+	defer func() {
+		if shouldStopApiContainer && stopFunc != nil {
+			stopFunc()
+		}
+	}()
+
+	grpcPort, err := port_spec.NewPortSpec(grpcPortNum, port_spec.TransportProtocol_TCP, noApplicationProtocol, noWait, noUrl)
+	if err != nil {
+		return nil, stacktrace.Propagate(err, "An error occurred creating the API container gRPC port spec with number '%v'", grpcPortNum)
+	}
+	publicGrpcPort, err := port_spec.NewPortSpec(publicGrpcPortNum, port_spec.TransportProtocol_TCP, noApplicationProtocol, noWait, noUrl)
+	if err != nil {
+		return nil, stacktrace.Propagate(err, "An error occurred creating the API container public gRPC port spec with number '%v'", publicGrpcPortNum)
+	}
+
+	backend.mutex.Lock()
+	defer backend.mutex.Unlock()
+	enclaveObj, err = backend.getEnclaveNoLock(enclaveUuid)
+	if err != nil {
+		return nil, stacktrace.Propagate(err, "The enclave was destroyed while its API container was starting")
+	}
+	enclaveObj.isProductionEnclave = customEnvVars[isProductionEnclaveEnvVarKey] == enableProductionModeEnvVarValue
+	enclaveObj.apiContainer = &inMemoryAPIContainer{
+		status:         container.ContainerStatus_Running,
+		grpcPort:       grpcPort,
+		publicIpAddr:   publicIpAddr,
+		publicGrpcPort: publicGrpcPort,
+		stopFunc:       stopFunc,
+	}
+	shouldStopApiContainer = false
+	return enclaveObj.toAPIContainerObject(), nil
+}
+
+func (backend *InMemoryKurtosisBackend) GetAPIContainers(
+	ctx context.Context,
+	filters *api_container.APIContainerFilters,
+) (
+	map[enclave.EnclaveUUID]*api_container.APIContainer,
+	error,
+) {
+	backend.mutex.RLock()
+	defer backend.mutex.RUnlock()
+	result := map[enclave.EnclaveUUID]*api_container.APIContainer{}
+	for enclaveUuid, enclaveObj := range backend.getEnclavesWithMatchingAPIContainerNoLock(filters) {
+		result[enclaveUuid] = enclaveObj.toAPIContainerObject()
+	}
+	return result, nil
+}
+
+func (backend *InMemoryKurtosisBackend) StopAPIContainers(
+	ctx context.Context,
+	filters *api_container.APIContainerFilters,
+) (
+	map[enclave.EnclaveUUID]bool,
+	map[enclave.EnclaveUUID]error,
+	error,
+) {
+	stopFuncs := []APIContainerStopFunc{}
+	defer func() { runAPIContainerStopFuncs(stopFuncs) }()
+	backend.mutex.Lock()
+	defer backend.mutex.Unlock()
+	successfulEnclaveUuids := map[enclave.EnclaveUUID]bool{}
+	for enclaveUuid, enclaveObj := range backend.getEnclavesWithMatchingAPIContainerNoLock(filters) {
+		stopFuncs = append(stopFuncs, enclaveObj.apiContainer.stop())
+		successfulEnclaveUuids[enclaveUuid] = true
+	}
+	return successfulEnclaveUuids, map[enclave.EnclaveUUID]error{}, nil
+}
+
+func (backend *InMemoryKurtosisBackend) DestroyAPIContainers(
+	ctx context.Context,
+	filters *api_container.APIContainerFilters,
+) (
+	map[enclave.EnclaveUUID]bool,
+	map[enclave.EnclaveUUID]error,
+	error,
+) {
+	stopFuncs := []APIContainerStopFunc{}
+	defer func() { runAPIContainerStopFuncs(stopFuncs) }()
+	backend.mutex.Lock()
+	defer backend.mutex.Unlock()
+	successfulEnclaveUuids := map[enclave.EnclaveUUID]bool{}
+	for enclaveUuid, enclaveObj := range backend.getEnclavesWithMatchingAPIContainerNoLock(filters) {
+		stopFuncs = append(stopFuncs, enclaveObj.apiContainer.stop())
+		enclaveObj.apiContainer = nil
+		successfulEnclaveUuids[enclaveUuid] = true
+	}
+	return successfulEnclaveUuids, map[enclave.EnclaveUUID]error{}, nil
+}
+
+func (backend *InMemoryKurtosisBackend) getEnclavesWithMatchingAPIContainerNoLock(filters *api_container.APIContainerFilters) map[enclave.EnclaveUUID]*inMemoryEnclave {
+	result := map[enclave.EnclaveUUID]*inMemoryEnclave{}
+	for enclaveUuid, enclaveObj := range backend.enclaves {
+		if enclaveObj.apiContainer == nil {
+			continue
+		}
+		if filters != nil && len(filters.EnclaveIDs) > 0 {
+			if _, found := filters.EnclaveIDs[enclaveUuid]; !found {
+				continue
+			}
+		}
+		if filters != nil && len(filters.Statuses) > 0 {
+			if _, found := filters.Statuses[enclaveObj.apiContainer.status]; !found {
+				continue
+			}
+		}
+		result[enclaveUuid] = enclaveObj
+	}
+	return result
+}
+
+func (enclaveObj *inMemoryEnclave) toAPIContainerObject() *api_container.APIContainer {
+	apiContainer := enclaveObj.apiContainer
+	if apiContainer.status != container.ContainerStatus_Running {
+		return api_container.NewAPIContainer(enclaveObj.uuid, apiContainer.status, enclaveObj.getIpAddr(apiContainerHostIndex), apiContainer.grpcPort, nil, nil, nil, enclaveObj.isProductionEnclave)
+	}
+	// The bridge network address is dialed with the private gRPC port, so it can only be advertised when the API
+	// container listens on that same port
+	var bridgeNetworkIpAddr net.IP
+	if apiContainer.publicGrpcPort.GetNumber() == apiContainer.grpcPort.GetNumber() {
+		bridgeNetworkIpAddr = apiContainer.publicIpAddr
+	}
+	return api_container.NewAPIContainer(
+		enclaveObj.uuid,
+		apiContainer.status,
+		enclaveObj.getIpAddr(apiContainerHostIndex),
+		apiContainer.grpcPort,
+		apiContainer.publicIpAddr,
+		apiContainer.publicGrpcPort,
+		bridgeNetworkIpAddr,
+		enclaveObj.isProductionEnclave,
+	)
+}
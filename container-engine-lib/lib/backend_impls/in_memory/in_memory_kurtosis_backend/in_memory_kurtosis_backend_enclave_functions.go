@@ -0,0 +1,315 @@
+package in_memory_kurtosis_backend
+
+import (
+	"archive/tar"
+	"context"
+	"encoding/json"
+	"fmt"
+	"io"
+	"maps"
+	"os"
+	"path"
+	"slices"
+	"time"
+
+	"github.com/kurtosis-tech/kurtosis/container-engine-lib/lib/backend_interface/objects/enclave"
+	"github.com/kurtosis-tech/kurtosis/container-engine-lib/lib/backend_interface/objects/service"
+	"github.com/kurtosis-tech/kurtosis/container-engine-lib/lib/backend_interface/objects/service_directory"
+	"github.com/kurtosis-tech/stacktrace"
+)
+
+const (
+	serviceDumpDirnameFormat = "%v--%v"
+	serviceSpecFilename      = "spec.json"
+	serviceLogsFilename      = "output.log"
+)
+
+func (backend *InMemoryKurtosisBackend) CreateEnclave(ctx context.Context, enclaveUuid enclave.EnclaveUUID, enclaveName string) (*enclave.Enclave, error) {
+	backend.mutex.Lock()
+	defer backend.mutex.Unlock()
+
+	if _, found := backend.enclaves[enclaveUuid]; found {
+		return nil, stacktrace.NewError("Cannot create enclave with UUID '%v' because an enclave with that UUID already exists", enclaveUuid)
+	}
+	for _, existingEnclave := range backend.enclaves {
+		if existingEnclave.name == enclaveName {
+			return nil, stacktrace.NewError("Cannot create enclave '%v' because an enclave with that name already exists", enclaveName)
+		}
+	}
+	if backend.nextEnclaveNetworkIndex >= maxNumEnclaveNetworks {
+		return nil, stacktrace.NewError("Cannot create enclave '%v' because no free enclave network is left", enclaveName)
+	}
+
+	creationTime := time.Now()
+	newEnclave := &inMemoryEnclave{
+		uuid:                enclaveUuid,
+		name:                enclaveName,
+		creationTime:        &creationTime,
+		isProductionEnclave: false,
+		networkIndex:        backend.nextEnclaveNetworkIndex,
+		expiration:          nil,
+		labels:              map[string]string{},
+		apiContainer:        nil,
+		logsCollector:       nil,
+		services:            map[service.ServiceName]*inMemoryUserService{},
+	}
+	backend.nextEnclaveNetworkIndex++
+	backend.enclaves[enclaveUuid] = newEnclave
+	return newEnclave.toEnclaveObject(), nil
+}
+
+func (backend *InMemoryKurtosisBackend) UpdateEnclave(
+	ctx context.Context,
+	enclaveUuid enclave.EnclaveUUID,
+	newName string,
+	creationTime *time.Time,
+) error {
+	backend.mutex.Lock()
+	defer backend.mutex.Unlock()
+	enclaveObj, err := backend.getEnclaveNoLock(enclaveUuid)
+	if err != nil {
+		return stacktrace.Propagate(err, "An error occurred getting enclave '%v' to update", enclaveUuid)
+	}
+	enclaveObj.name = newName
+	enclaveObj.creationTime = creationTime
+	return nil
+}
+
+func (backend *InMemoryKurtosisBackend) UpdateEnclaveExpiration(
+	ctx context.Context,
+	enclaveUuid enclave.EnclaveUUID,
+	expiration *enclave.EnclaveExpiration,
+) error {
+	backend.mutex.Lock()
+	defer backend.mutex.Unlock()
+	enclaveObj, err := backend.getEnclaveNoLock(enclaveUuid)
+	if err != nil {
+		return stacktrace.Propagate(err, "An error occurred getting enclave '%v' to update the expiration of", enclaveUuid)
+	}
+	enclaveObj.expiration = expiration
+	return nil
+}
+
+func (backend *InMemoryKurtosisBackend) UpdateEnclaveLabels(
+	ctx context.Context,
+	enclaveUuid enclave.EnclaveUUID,
+	labels map[string]string,
+) error {
+	backend.mutex.Lock()
+	defer backend.mutex.Unlock()
+	enclaveObj, err := backend.getEnclaveNoLock(enclaveUuid)
+	if err != nil {
+		return stacktrace.Propagate(err, "An error occurred getting enclave '%v' to update the labels of", enclaveUuid)
+	}
+	enclaveObj.labels = maps.Clone(labels)
+	return nil
+}
+
+// The services of the in-memory backend don't store anything in their persistent directories, so there's nothing to copy
+// besides checking that both enclaves exist
+func (backend *InMemoryKurtosisBackend) CopyEnclavePersistentDirectories(
+	ctx context.Context,
+	sourceEnclaveUuid enclave.EnclaveUUID,
+	destinationEnclaveUuid enclave.EnclaveUUID,
+) ([]service_directory.DirectoryPersistentKey, error) {
+	backend.mutex.RLock()
+	defer backend.mutex.RUnlock()
+	sourceEnclave, err := backend.getEnclaveNoLock(sourceEnclaveUuid)
+	if err != nil {
+		return nil, stacktrace.Propagate(err, "An error occurred getting enclave '%v' to copy the persistent directories of", sourceEnclaveUuid)
+	}
+	if _, err := backend.getEnclaveNoLock(destinationEnclaveUuid); err != nil {
+		return nil, stacktrace.Propagate(err, "An error occurred getting enclave '%v' to copy the persistent directories to", destinationEnclaveUuid)
+	}
+
+	copiedPersistentKeys := map[service_directory.DirectoryPersistentKey]bool{}
+	for _, userService := range sourceEnclave.services {
+		serviceConfig := userService.registration.GetConfig()
+		if serviceConfig == nil || serviceConfig.GetPersistentDirectories() == nil {
+			continue
+		}
+		for _, persistentDirectory := range serviceConfig.GetPersistentDirectories().ServiceDirpathToPersistentDirectory {
+			copiedPersistentKeys[persistentDirectory.PersistentKey] = true
+		}
+	}
+	return slices.Sorted(maps.Keys(copiedPersistentKeys)), nil
+}
+
+// Like when copying them, the persistent directories of the in-memory backend are always empty
+func (backend *InMemoryKurtosisBackend) ExportEnclavePersistentDirectory(
+	ctx context.Context,
+	enclaveUuid enclave.EnclaveUUID,
+	persistentKey service_directory.DirectoryPersistentKey,
+	destination io.Writer,
+) error {
+	backend.mutex.RLock()
+	defer backend.mutex.RUnlock()
+	if _, err := backend.getEnclaveNoLock(enclaveUuid); err != nil {
+		return stacktrace.Propagate(err, "An error occurred getting enclave '%v' to export persistent directory '%v' of", enclaveUuid, persistentKey)
+	}
+	if err := tar.NewWriter(destination).Close(); err != nil {
+		return stacktrace.Propagate(err, "An error occurred writing the empty archive of persistent directory '%v'", persistentKey)
+	}
+	return nil
+}
+
+func (backend *InMemoryKurtosisBackend) ImportEnclavePersistentDirectory(
+	ctx context.Context,
+	enclaveUuid enclave.EnclaveUUID,
+	persistentKey service_directory.DirectoryPersistentKey,
+	content io.Reader,
+) error {
+	backend.mutex.RLock()
+	defer backend.mutex.RUnlock()
+	if _, err := backend.getEnclaveNoLock(enclaveUuid); err != nil {
+		return stacktrace.Propagate(err, "An error occurred getting enclave '%v' to import persistent directory '%v' into", enclaveUuid, persistentKey)
+	}
+	if _, err := io.Copy(io.Discard, content); err != nil {
+		return stacktrace.Propagate(err, "An error occurred reading the archive of persistent directory '%v'", persistentKey)
+	}
+	return nil
+}
+
+func (backend *InMemoryKurtosisBackend) GetEnclaves(
+	ctx context.Context,
+	filters *enclave.EnclaveFilters,
+) (
+	map[enclave.EnclaveUUID]*enclave.Enclave,
+	error,
+) {
+	backend.mutex.RLock()
+	defer backend.mutex.RUnlock()
+	result := map[enclave.EnclaveUUID]*enclave.Enclave{}
+	for enclaveUuid, enclaveObj := range backend.getMatchingEnclavesNoLock(filters) {
+		result[enclaveUuid] = enclaveObj.toEnclaveObject()
+	}
+	return result, nil
+}
+
+func (backend *InMemoryKurtosisBackend) StopEnclaves(
+	ctx context.Context,
+	filters *enclave.EnclaveFilters,
+) (
+	map[enclave.EnclaveUUID]bool,
+	map[enclave.EnclaveUUID]error,
+	error,
+) {
+	stopFuncs := []APIContainerStopFunc{}
+	defer func() { runAPIContainerStopFuncs(stopFuncs) }()
+	backend.mutex.Lock()
+	defer backend.mutex.Unlock()
+	successfulEnclaveUuids := map[enclave.EnclaveUUID]bool{}
+	for enclaveUuid, enclaveObj := range backend.getMatchingEnclavesNoLock(filters) {
+		if enclaveObj.apiContainer != nil {
+			stopFuncs = append(stopFuncs, enclaveObj.apiContainer.stop())
+		}
+		for _, userService := range enclaveObj.services {
+			userService.stop()
+		}
+		successfulEnclaveUuids[enclaveUuid] = true
+	}
+	return successfulEnclaveUuids, map[enclave.EnclaveUUID]error{}, nil
+}
+
+// DumpEnclave writes the registration and the logs of every user service of the enclave, mirroring the layout of the
+// dumps of the container-based backends
+func (backend *InMemoryKurtosisBackend) DumpEnclave(
+	ctx context.Context,
+	enclaveUuid enclave.EnclaveUUID,
+	outputDirpath string,
+) error {
+	backend.mutex.RLock()
+	enclaveObj, err := backend.getEnclaveNoLock(enclaveUuid)
+	if err != nil {
+		backend.mutex.RUnlock()
+		return stacktrace.Propagate(err, "An error occurred getting enclave '%v' to dump", enclaveUuid)
+	}
+	serviceSpecs := map[service.ServiceName][]byte{}
+	serviceDumpDirpaths := map[service.ServiceName]string{}
+	for serviceName, userService := range enclaveObj.services {
+		serviceSpecBytes, err := json.MarshalIndent(userService.registration, "", "  ")
+		if err != nil {
+			backend.mutex.RUnlock()
+			return stacktrace.Propagate(err, "An error occurred serializing the registration of service '%v'", serviceName)
+		}
+		serviceSpecs[serviceName] = serviceSpecBytes
+		serviceDumpDirpaths[serviceName] = path.Join(outputDirpath, fmt.Sprintf(serviceDumpDirnameFormat, serviceName, userService.registration.GetUUID()))
+	}
+	serviceLogsHandler := backend.serviceLogsHandler
+	backend.mutex.RUnlock()
+
+	if _, err := os.Stat(outputDirpath); !os.IsNotExist(err) {
+		return stacktrace.NewError("Cannot dump enclave '%v' to '%v' because the path already exists", enclaveUuid, outputDirpath)
+	}
+	if err := os.MkdirAll(outputDirpath, createdDirPerms); err != nil {
+		return stacktrace.Propagate(err, "An error occurred creating the output directory '%v'", outputDirpath)
+	}
+	for serviceName, serviceDumpDirpath := range serviceDumpDirpaths {
+		if err := os.Mkdir(serviceDumpDirpath, createdDirPerms); err != nil {
+			return stacktrace.Propagate(err, "An error occurred creating the dump directory of service '%v'", serviceName)
+		}
+		if err := os.WriteFile(path.Join(serviceDumpDirpath, serviceSpecFilename), serviceSpecs[serviceName], createdFilePerms); err != nil {
+			return stacktrace.Propagate(err, "An error occurred writing the spec of service '%v'", serviceName)
+		}
+		serviceLogs, err := serviceLogsHandler(enclaveUuid, serviceName)
+		if err != nil {
+			return stacktrace.Propagate(err, "An error occurred getting the logs of service '%v'", serviceName)
+		}
+		if err := os.WriteFile(path.Join(serviceDumpDirpath, serviceLogsFilename), []byte(serviceLogs), createdFilePerms); err != nil {
+			return stacktrace.Propagate(err, "An error occurred writing the logs of service '%v'", serviceName)
+		}
+	}
+	return nil
+}
+
+func (backend *InMemoryKurtosisBackend) DestroyEnclaves(
+	ctx context.Context,
+	filters *enclave.EnclaveFilters,
+) (
+	map[enclave.EnclaveUUID]bool,
+	map[enclave.EnclaveUUID]error,
+	error,
+) {
+	stopFuncs := []APIContainerStopFunc{}
+	defer func() { runAPIContainerStopFuncs(stopFuncs) }()
+	backend.mutex.Lock()
+	defer backend.mutex.Unlock()
+	successfulEnclaveUuids := map[enclave.EnclaveUUID]bool{}
+	for enclaveUuid, enclaveObj := range backend.getMatchingEnclavesNoLock(filters) {
+		if enclaveObj.apiContainer != nil {
+			stopFuncs = append(stopFuncs, enclaveObj.apiContainer.stop())
+		}
+		delete(backend.enclaves, enclaveUuid)
+		successfulEnclaveUuids[enclaveUuid] = true
+	}
+	return successfulEnclaveUuids, map[enclave.EnclaveUUID]error{}, nil
+}
+
+func (backend *InMemoryKurtosisBackend) getMatchingEnclavesNoLock(filters *enclave.EnclaveFilters) map[enclave.EnclaveUUID]*inMemoryEnclave {
+	result := map[enclave.EnclaveUUID]*inMemoryEnclave{}
+	for enclaveUuid, enclaveObj := range backend.enclaves {
+		if filters != nil && len(filters.UUIDs) > 0 {
+			if _, found := filters.UUIDs[enclaveUuid]; !found {
+				continue
+			}
+		}
+		if filters != nil && len(filters.Statuses) > 0 {
+			if _, found := filters.Statuses[enclaveObj.getStatus()]; !found {
+				continue
+			}
+		}
+		result[enclaveUuid] = enclaveObj
+	}
+	return result
+}
+
+func (enclaveObj *inMemoryEnclave) toEnclaveObject() *enclave.Enclave {
+	return enclave.NewEnclave(enclaveObj.uuid, enclaveObj.name, enclaveObj.getStatus(), enclaveObj.creationTime, enclaveObj.isProductionEnclave, enclaveObj.expiration, maps.Clone(enclaveObj.labels))
+}
+
+func getAllEnclavesFilters() *enclave.EnclaveFilters {
+	return &enclave.EnclaveFilters{
+		UUIDs:    nil,
+		Statuses: nil,
+	}
+}
@@ -0,0 +1,172 @@
+package in_memory_kurtosis_backend
+
+import (
+	"context"
+	"fmt"
+	"os"
+	"path"
+
+	"github.com/kurtosis-tech/kurtosis/container-engine-lib/lib/backend_interface/objects/container"
+	"github.com/kurtosis-tech/kurtosis/container-engine-lib/lib/backend_interface/objects/engine"
+	"github.com/kurtosis-tech/kurtosis/container-engine-lib/lib/backend_interface/objects/logs_aggregator"
+	"github.com/kurtosis-tech/kurtosis/container-engine-lib/lib/backend_interface/objects/logs_collector"
+	"github.com/kurtosis-tech/kurtosis/container-engine-lib/lib/backend_interface/objects/port_spec"
+	"github.com/kurtosis-tech/stacktrace"
+)
+
+const (
+	engineDumpDirnameFormat = "kurtosis-engine--%v"
+	engineLogsFilename      = "output.log"
+
+	createdDirPerms  = 0755
+	createdFilePerms = 0644
+
+	noApplicationProtocol = ""
+	noUrl                 = ""
+)
+
+var noWait *port_spec.Wait = nil
+
+func (backend *InMemoryKurtosisBackend) CreateEngine(
+	ctx context.Context,
+	imageOrgAndRepo string,
+	imageVersionTag string,
+	grpcPortNum uint16,
+	envVars map[string]string,
+	shouldStartInDebugMode bool,
+	githubAuthToken string,
+	sinks logs_aggregator.Sinks,
+	shouldEnablePersistentVolumeLogsCollection bool,
+	logsCollectorFilters []logs_collector.Filter,
+	logsCollectorParsers []logs_collector.Parser,
+) (*engine.Engine, error) {
+	backend.mutex.Lock()
+	defer backend.mutex.Unlock()
+
+	grpcPort, err := port_spec.NewPortSpec(grpcPortNum, port_spec.TransportProtocol_TCP, noApplicationProtocol, noWait, noUrl)
+	if err != nil {
+		return nil, stacktrace.Propagate(err, "An error occurred creating the engine gRPC port spec with number '%v'", grpcPortNum)
+	}
+	for _, existingEngine := range backend.engines {
+		if existingEngine.status == container.ContainerStatus_Running && existingEngine.grpcPort.GetNumber() == grpcPortNum {
+			return nil, stacktrace.NewError("Engine '%v' is already running on port '%v'", existingEngine.guid, grpcPortNum)
+		}
+	}
+
+	engineGuidStr, err := generateUuid()
+	if err != nil {
+		return nil, stacktrace.Propagate(err, "An error occurred generating the engine GUID")
+	}
+	newEngine := &inMemoryEngine{
+		guid:     engine.EngineGUID(engineGuidStr),
+		status:   container.ContainerStatus_Running,
+		grpcPort: grpcPort,
+	}
+	backend.engines[newEngine.guid] = newEngine
+	return newEngine.toEngineObject(), nil
+}
+
+func (backend *InMemoryKurtosisBackend) GetEngines(ctx context.Context, filters *engine.EngineFilters) (map[engine.EngineGUID]*engine.Engine, error) {
+	backend.mutex.RLock()
+	defer backend.mutex.RUnlock()
+	result := map[engine.EngineGUID]*engine.Engine{}
+	for engineGuid, engineObj := range backend.getMatchingEnginesNoLock(filters) {
+		result[engineGuid] = engineObj.toEngineObject()
+	}
+	return result, nil
+}
+
+func (backend *InMemoryKurtosisBackend) StopEngines(
+	ctx context.Context,
+	filters *engine.EngineFilters,
+) (
+	map[engine.EngineGUID]bool,
+	map[engine.EngineGUID]error,
+	error,
+) {
+	backend.mutex.Lock()
+	defer backend.mutex.Unlock()
+	successfulEngineGuids := map[engine.EngineGUID]bool{}
+	for engineGuid, engineObj := range backend.getMatchingEnginesNoLock(filters) {
+		engineObj.status = container.ContainerStatus_Stopped
+		successfulEngineGuids[engineGuid] = true
+	}
+	return successfulEngineGuids, map[engine.EngineGUID]error{}, nil
+}
+
+func (backend *InMemoryKurtosisBackend) DestroyEngines(
+	ctx context.Context,
+	filters *engine.EngineFilters,
+) (
+	map[engine.EngineGUID]bool,
+	map[engine.EngineGUID]error,
+	error,
+) {
+	backend.mutex.Lock()
+	defer backend.mutex.Unlock()
+	successfulEngineGuids := map[engine.EngineGUID]bool{}
+	for engineGuid := range backend.getMatchingEnginesNoLock(filters) {
+		delete(backend.engines, engineGuid)
+		successfulEngineGuids[engineGuid] = true
+	}
+	return successfulEngineGuids, map[engine.EngineGUID]error{}, nil
+}
+
+// GetEngineLogs writes an empty logs file per engine as nothing runs outside of the current process
+func (backend *InMemoryKurtosisBackend) GetEngineLogs(ctx context.Context, outputDirpath string) error {
+	backend.mutex.RLock()
+	defer backend.mutex.RUnlock()
+	for engineGuid := range backend.engines {
+		engineDumpDirpath := path.Join(outputDirpath, fmt.Sprintf(engineDumpDirnameFormat, engineGuid))
+		if err := os.MkdirAll(engineDumpDirpath, createdDirPerms); err != nil {
+			return stacktrace.Propagate(err, "An error occurred creating the directory '%v' to write the logs of engine '%v' to", engineDumpDirpath, engineGuid)
+		}
+		engineLogsFilepath := path.Join(engineDumpDirpath, engineLogsFilename)
+		if err := os.WriteFile(engineLogsFilepath, []byte{}, createdFilePerms); err != nil {
+			return stacktrace.Propagate(err, "An error occurred writing the logs of engine '%v' to '%v'", engineGuid, engineLogsFilepath)
+		}
+	}
+	return nil
+}
+
+func (backend *InMemoryKurtosisBackend) DumpKurtosis(ctx context.Context, outputDirpath string) error {
+	if err := backend.GetEngineLogs(ctx, outputDirpath); err != nil {
+		return stacktrace.Propagate(err, "An error occurred dumping the engines to '%v'", outputDirpath)
+	}
+	enclaves, err := backend.GetEnclaves(ctx, getAllEnclavesFilters())
+	if err != nil {
+		return stacktrace.Propagate(err, "An error occurred getting the enclaves to dump")
+	}
+	for enclaveUuid := range enclaves {
+		enclaveDumpDirpath := path.Join(outputDirpath, string(enclaveUuid))
+		if err := backend.DumpEnclave(ctx, enclaveUuid, enclaveDumpDirpath); err != nil {
+			return stacktrace.Propagate(err, "An error occurred dumping enclave '%v' to '%v'", enclaveUuid, enclaveDumpDirpath)
+		}
+	}
+	return nil
+}
+
+func (backend *InMemoryKurtosisBackend) getMatchingEnginesNoLock(filters *engine.EngineFilters) map[engine.EngineGUID]*inMemoryEngine {
+	result := map[engine.EngineGUID]*inMemoryEngine{}
+	for engineGuid, engineObj := range backend.engines {
+		if filters != nil && len(filters.GUIDs) > 0 {
+			if _, found := filters.GUIDs[engineGuid]; !found {
+				continue
+			}
+		}
+		if filters != nil && len(filters.Statuses) > 0 {
+			if _, found := filters.Statuses[engineObj.status]; !found {
+				continue
+			}
+		}
+		result[engineGuid] = engineObj
+	}
+	return result
+}
+
+func (engineObj *inMemoryEngine) toEngineObject() *engine.Engine {
+	if engineObj.status != container.ContainerStatus_Running {
+		return engine.NewEngine(engineObj.guid, engineObj.status, nil, nil)
+	}
+	return engine.NewEngine(engineObj.guid, engineObj.status, localhostIpAddr, engineObj.grpcPort)
+}
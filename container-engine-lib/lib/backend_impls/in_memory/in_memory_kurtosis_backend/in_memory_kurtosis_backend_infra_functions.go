@@ -0,0 +1,363 @@
+package in_memory_kurtosis_backend
+
+import (
+	"context"
+	"net"
+	"runtime"
+	"sort"
+	"strings"
+
+	"github.com/kurtosis-tech/kurtosis/container-engine-lib/lib/backend_interface/objects/compute_resources"
+	"github.com/kurtosis-tech/kurtosis/container-engine-lib/lib/backend_interface/objects/container"
+	"github.com/kurtosis-tech/kurtosis/container-engine-lib/lib/backend_interface/objects/enclave"
+	"github.com/kurtosis-tech/kurtosis/container-engine-lib/lib/backend_interface/objects/engine"
+	"github.com/kurtosis-tech/kurtosis/container-engine-lib/lib/backend_interface/objects/image_build_spec"
+	"github.com/kurtosis-tech/kurtosis/container-engine-lib/lib/backend_interface/objects/image_download_mode"
+	"github.com/kurtosis-tech/kurtosis/container-engine-lib/lib/backend_interface/objects/image_registry"
+	"github.com/kurtosis-tech/kurtosis/container-engine-lib/lib/backend_interface/objects/image_registry_spec"
+	"github.com/kurtosis-tech/kurtosis/container-engine-lib/lib/backend_interface/objects/logs_aggregator"
+	"github.com/kurtosis-tech/kurtosis/container-engine-lib/lib/backend_interface/objects/logs_collector"
+	"github.com/kurtosis-tech/kurtosis/container-engine-lib/lib/backend_interface/objects/nix_build_spec"
+	"github.com/kurtosis-tech/kurtosis/container-engine-lib/lib/backend_interface/objects/port_spec"
+	"github.com/kurtosis-tech/kurtosis/container-engine-lib/lib/backend_interface/objects/reverse_proxy"
+	"github.com/kurtosis-tech/kurtosis/container-engine-lib/lib/image_registry_utils"
+	"github.com/kurtosis-tech/stacktrace"
+)
+
+const (
+	logsAggregatorListeningPortNum = uint16(9000)
+
+	reverseProxyHttpPortNum      = uint16(9730)
+	reverseProxyDashboardPortNum = uint16(9731)
+
+	imageRegistryHost = "localhost:9740"
+
+	imageTagSeparator  = ":"
+	imagePathSeparator = "/"
+	defaultImageTag    = "latest"
+
+	isResourceInformationComplete = true
+)
+
+// ====================================================================================================
+//
+//	Images
+//
+// ====================================================================================================
+func (backend *InMemoryKurtosisBackend) FetchImage(ctx context.Context, image string, registrySpec *image_registry_spec.ImageRegistrySpec, downloadMode image_download_mode.ImageDownloadMode) (bool, string, error) {
+	backend.mutex.Lock()
+	defer backend.mutex.Unlock()
+	_, isImageAvailableLocally := backend.images[image]
+	backend.images[image] = true
+	pulledFromRemote := !isImageAvailableLocally || downloadMode == image_download_mode.ImageDownloadMode_Always
+	return pulledFromRemote, runtime.GOARCH, nil
+}
+
+func (backend *InMemoryKurtosisBackend) PruneUnusedImages(ctx context.Context) ([]string, error) {
+	backend.mutex.Lock()
+	defer backend.mutex.Unlock()
+	imagesInUse := backend.getImagesInUseNoLock()
+	prunedImages := []string{}
+	for image := range backend.images {
+		if _, found := imagesInUse[image]; found {
+			continue
+		}
+		delete(backend.images, image)
+		prunedImages = append(prunedImages, image)
+	}
+	sort.Strings(prunedImages)
+	return prunedImages, nil
+}
+
+func (backend *InMemoryKurtosisBackend) BuildImage(ctx context.Context, imageName string, imageBuildSpec *image_build_spec.ImageBuildSpec) (string, error) {
+	backend.mutex.Lock()
+	defer backend.mutex.Unlock()
+	backend.images[imageName] = true
+	backend.pushBuiltImageToImageRegistryNoLock(imageName)
+	return runtime.GOARCH, nil
+}
+
+func (backend *InMemoryKurtosisBackend) NixBuild(ctx context.Context, nixBuildSpec *nix_build_spec.NixBuildSpec) (string, error) {
+	backend.mutex.Lock()
+	defer backend.mutex.Unlock()
+	imageName := nixBuildSpec.GetImageName()
+	backend.images[imageName] = true
+	backend.pushBuiltImageToImageRegistryNoLock(imageName)
+	return imageName, nil
+}
+
+// ====================================================================================================
+//
+//	Logs aggregator & collectors
+//
+// ====================================================================================================
+func (backend *InMemoryKurtosisBackend) CreateLogsAggregator(
+	ctx context.Context,
+	httpPortNum uint16,
+	sinks logs_aggregator.Sinks,
+) (*logs_aggregator.LogsAggregator, error) {
+	backend.mutex.Lock()
+	defer backend.mutex.Unlock()
+	if backend.logsAggregator != nil {
+		return nil, stacktrace.NewError("A logs aggregator already exists")
+	}
+	httpPort, err := port_spec.NewPortSpec(httpPortNum, port_spec.TransportProtocol_TCP, noApplicationProtocol, noWait, noUrl)
+	if err != nil {
+		return nil, stacktrace.Propagate(err, "An error occurred creating the logs aggregator HTTP port spec with number '%v'", httpPortNum)
+	}
+	backend.logsAggregator = logs_aggregator.NewLogsAggregator(container.ContainerStatus_Running, localhostIpAddr, logsAggregatorListeningPortNum, httpPort)
+	return backend.logsAggregator, nil
+}
+
+// GetLogsAggregator returns nil if no logs aggregator was created, like the container-based backends
+func (backend *InMemoryKurtosisBackend) GetLogsAggregator(ctx context.Context) (*logs_aggregator.LogsAggregator, error) {
+	backend.mutex.RLock()
+	defer backend.mutex.RUnlock()
+	return backend.logsAggregator, nil
+}
+
+func (backend *InMemoryKurtosisBackend) DestroyLogsAggregator(ctx context.Context) error {
+	backend.mutex.Lock()
+	defer backend.mutex.Unlock()
+	backend.logsAggregator = nil
+	return nil
+}
+
+func (backend *InMemoryKurtosisBackend) CreateLogsCollectorForEnclave(
+	ctx context.Context,
+	enclaveUuid enclave.EnclaveUUID,
+	logsCollectorHttpPortNumber uint16,
+	logsCollectorTcpPortNumber uint16,
+	logsCollectorFilters []logs_collector.Filter,
+	logsCollectorParsers []logs_collector.Parser,
+) (
+	*logs_collector.LogsCollector,
+	error,
+) {
+	backend.mutex.Lock()
+	defer backend.mutex.Unlock()
+	enclaveObj, err := backend.getEnclaveNoLock(enclaveUuid)
+	if err != nil {
+		return nil, stacktrace.Propagate(err, "An error occurred getting the enclave to create the logs collector in")
+	}
+	if enclaveObj.logsCollector != nil {
+		return nil, stacktrace.NewError("A logs collector already exists in enclave '%v'", enclaveUuid)
+	}
+	tcpPort, err := port_spec.NewPortSpec(logsCollectorTcpPortNumber, port_spec.TransportProtocol_TCP, noApplicationProtocol, noWait, noUrl)
+	if err != nil {
+		return nil, stacktrace.Propagate(err, "An error occurred creating the logs collector TCP port spec with number '%v'", logsCollectorTcpPortNumber)
+	}
+	httpPort, err := port_spec.NewPortSpec(logsCollectorHttpPortNumber, port_spec.TransportProtocol_TCP, noApplicationProtocol, noWait, noUrl)
+	if err != nil {
+		return nil, stacktrace.Propagate(err, "An error occurred creating the logs collector HTTP port spec with number '%v'", logsCollectorHttpPortNumber)
+	}
+	enclaveObj.logsCollector = logs_collector.NewLogsCollector(
+		container.ContainerStatus_Running,
+		enclaveObj.getIpAddr(logsCollectorHostIndex),
+		localhostIpAddr,
+		tcpPort,
+		httpPort,
+	)
+	return enclaveObj.logsCollector, nil
+}
+
+func (backend *InMemoryKurtosisBackend) GetLogsCollectorForEnclave(ctx context.Context, enclaveUuid enclave.EnclaveUUID) (*logs_collector.LogsCollector, error) {
+	backend.mutex.RLock()
+	defer backend.mutex.RUnlock()
+	enclaveObj, err := backend.getEnclaveNoLock(enclaveUuid)
+	if err != nil {
+		return nil, stacktrace.Propagate(err, "An error occurred getting the enclave to get the logs collector of")
+	}
+	return enclaveObj.logsCollector, nil
+}
+
+func (backend *InMemoryKurtosisBackend) DestroyLogsCollectorForEnclave(ctx context.Context, enclaveUuid enclave.EnclaveUUID) error {
+	backend.mutex.Lock()
+	defer backend.mutex.Unlock()
+	enclaveObj, err := backend.getEnclaveNoLock(enclaveUuid)
+	if err != nil {
+		return stacktrace.Propagate(err, "An error occurred getting the enclave to destroy the logs collector of")
+	}
+	enclaveObj.logsCollector = nil
+	return nil
+}
+
+// ====================================================================================================
+//
+//	Reverse proxy
+//
+// ====================================================================================================
+func (backend *InMemoryKurtosisBackend) CreateReverseProxy(ctx context.Context, engineGuid engine.EngineGUID) (*reverse_proxy.ReverseProxy, error) {
+	backend.mutex.Lock()
+	defer backend.mutex.Unlock()
+	if backend.reverseProxy != nil {
+		return nil, stacktrace.NewError("A reverse proxy already exists")
+	}
+	backend.reverseProxy = reverse_proxy.NewReverseProxy(
+		container.ContainerStatus_Running,
+		localhostIpAddr,
+		map[string]net.IP{},
+		reverseProxyHttpPortNum,
+		reverseProxyDashboardPortNum,
+	)
+	return backend.reverseProxy, nil
+}
+
+// GetReverseProxy returns nil if no reverse proxy was created, like the container-based backends
+func (backend *InMemoryKurtosisBackend) GetReverseProxy(ctx context.Context) (*reverse_proxy.ReverseProxy, error) {
+	backend.mutex.RLock()
+	defer backend.mutex.RUnlock()
+	return backend.reverseProxy, nil
+}
+
+func (backend *InMemoryKurtosisBackend) DestroyReverseProxy(ctx context.Context) error {
+	backend.mutex.Lock()
+	defer backend.mutex.Unlock()
+	backend.reverseProxy = nil
+	return nil
+}
+
+// ====================================================================================================
+//
+//	Image registry
+//
+// ====================================================================================================
+func (backend *InMemoryKurtosisBackend) CreateImageRegistry(ctx context.Context) (*image_registry.ImageRegistry, error) {
+	backend.mutex.Lock()
+	defer backend.mutex.Unlock()
+	if backend.imageRegistry != nil {
+		return nil, stacktrace.NewError("An image registry already exists")
+	}
+	backend.imageRegistry = &inMemoryImageRegistry{
+		status:       container.ContainerStatus_Running,
+		repositories: map[string]map[string]bool{},
+	}
+	return image_registry.NewImageRegistry(backend.imageRegistry.status, imageRegistryHost), nil
+}
+
+// GetImageRegistry returns nil if no image registry was created, like the container-based backends
+func (backend *InMemoryKurtosisBackend) GetImageRegistry(ctx context.Context) (*image_registry.ImageRegistry, error) {
+	backend.mutex.RLock()
+	defer backend.mutex.RUnlock()
+	if backend.imageRegistry == nil {
+		return nil, nil
+	}
+	return image_registry.NewImageRegistry(backend.imageRegistry.status, imageRegistryHost), nil
+}
+
+// DestroyImageRegistry also drops the images that were pushed to the registry, as they were only stored in it
+func (backend *InMemoryKurtosisBackend) DestroyImageRegistry(ctx context.Context) error {
+	backend.mutex.Lock()
+	defer backend.mutex.Unlock()
+	backend.imageRegistry = nil
+	backend.builtImageReferences = map[string]string{}
+	return nil
+}
+
+func (backend *InMemoryKurtosisBackend) ListImageRegistryRepositories(ctx context.Context) ([]*image_registry.ImageRegistryRepository, error) {
+	backend.mutex.RLock()
+	defer backend.mutex.RUnlock()
+	if backend.imageRegistry == nil {
+		return nil, stacktrace.NewError("No image registry exists to list the repositories of")
+	}
+	repositoryNames := []string{}
+	for repositoryName := range backend.imageRegistry.repositories {
+		repositoryNames = append(repositoryNames, repositoryName)
+	}
+	sort.Strings(repositoryNames)
+	repositories := []*image_registry.ImageRegistryRepository{}
+	for _, repositoryName := range repositoryNames {
+		tags := []string{}
+		for tag := range backend.imageRegistry.repositories[repositoryName] {
+			tags = append(tags, tag)
+		}
+		sort.Strings(tags)
+		repositories = append(repositories, image_registry.NewImageRegistryRepository(repositoryName, tags))
+	}
+	return repositories, nil
+}
+
+func (backend *InMemoryKurtosisBackend) PruneImageRegistry(ctx context.Context) ([]string, error) {
+	backend.mutex.Lock()
+	defer backend.mutex.Unlock()
+	if backend.imageRegistry == nil {
+		return nil, stacktrace.NewError("No image registry exists to prune")
+	}
+	usedRepositoryNames := map[string]bool{}
+	for image := range backend.getImagesInUseNoLock() {
+		if repositoryName, found := image_registry_utils.GetRepositoryName(imageRegistryHost, image); found {
+			usedRepositoryNames[repositoryName] = true
+		}
+	}
+	prunedRepositoryNames := []string{}
+	for repositoryName := range backend.imageRegistry.repositories {
+		if _, found := usedRepositoryNames[repositoryName]; found {
+			continue
+		}
+		delete(backend.imageRegistry.repositories, repositoryName)
+		prunedRepositoryNames = append(prunedRepositoryNames, repositoryName)
+	}
+	for imageName, imageReference := range backend.builtImageReferences {
+		if repositoryName, found := image_registry_utils.GetRepositoryName(imageRegistryHost, imageReference); found && !usedRepositoryNames[repositoryName] {
+			delete(backend.builtImageReferences, imageName)
+		}
+	}
+	sort.Strings(prunedRepositoryNames)
+	return prunedRepositoryNames, nil
+}
+
+// ====================================================================================================
+//
+//	Resources
+//
+// ====================================================================================================
+
+// GetAvailableCPUAndMemory reports the resources set with SetAvailableCPUAndMemory, and reports them as incomplete
+// until then so that they aren't validated against
+func (backend *InMemoryKurtosisBackend) GetAvailableCPUAndMemory(ctx context.Context) (compute_resources.MemoryInMegaBytes, compute_resources.CpuMilliCores, bool, error) {
+	backend.mutex.RLock()
+	defer backend.mutex.RUnlock()
+	if backend.availableResources == nil {
+		return 0, 0, !isResourceInformationComplete, nil
+	}
+	return backend.availableResources.memory, backend.availableResources.cpu, isResourceInformationComplete, nil
+}
+
+// GetAvailableCPUAndMemoryPerNode returns nil as everything runs in the current process, whose resources are already
+// returned by GetAvailableCPUAndMemory
+func (backend *InMemoryKurtosisBackend) GetAvailableCPUAndMemoryPerNode(ctx context.Context) ([]*compute_resources.NodeResources, error) {
+	return nil, nil
+}
+
+func (backend *InMemoryKurtosisBackend) pushBuiltImageToImageRegistryNoLock(imageName string) {
+	if backend.imageRegistry == nil || backend.imageRegistry.status != container.ContainerStatus_Running {
+		return
+	}
+	imageReference := image_registry_utils.GetImageReference(imageRegistryHost, imageName)
+	repositoryName, found := image_registry_utils.GetRepositoryName(imageRegistryHost, imageReference)
+	if !found {
+		return
+	}
+	tag := defaultImageTag
+	lastPathSeparatorIdx := strings.LastIndex(imageName, imagePathSeparator)
+	if tagSeparatorIdx := strings.LastIndex(imageName, imageTagSeparator); tagSeparatorIdx > lastPathSeparatorIdx {
+		tag = imageName[tagSeparatorIdx+1:]
+	}
+	if _, found := backend.imageRegistry.repositories[repositoryName]; !found {
+		backend.imageRegistry.repositories[repositoryName] = map[string]bool{}
+	}
+	backend.imageRegistry.repositories[repositoryName][tag] = true
+	backend.builtImageReferences[imageName] = imageReference
+}
+
+func (backend *InMemoryKurtosisBackend) getImagesInUseNoLock() map[string]bool {
+	imagesInUse := map[string]bool{}
+	for _, enclaveObj := range backend.enclaves {
+		for _, userService := range enclaveObj.services {
+			if userService.container != nil {
+				imagesInUse[userService.container.GetImageName()] = true
+			}
+		}
+	}
+	return imagesInUse
+}
@@ -0,0 +1,381 @@
+package in_memory_kurtosis_backend
+
+import (
+	"archive/tar"
+	"bytes"
+	"context"
+	"io"
+	"net"
+	"testing"
+	"time"
+
+	"github.com/kurtosis-tech/kurtosis/container-engine-lib/lib/backend_interface"
+	"github.com/kurtosis-tech/kurtosis/container-engine-lib/lib/backend_interface/objects/api_container"
+	"github.com/kurtosis-tech/kurtosis/container-engine-lib/lib/backend_interface/objects/container"
+	"github.com/kurtosis-tech/kurtosis/container-engine-lib/lib/backend_interface/objects/enclave"
+	"github.com/kurtosis-tech/kurtosis/container-engine-lib/lib/backend_interface/objects/exec_result"
+	"github.com/kurtosis-tech/kurtosis/container-engine-lib/lib/backend_interface/objects/port_spec"
+	"github.com/kurtosis-tech/kurtosis/container-engine-lib/lib/backend_interface/objects/service"
+	"github.com/kurtosis-tech/stacktrace"
+	"github.com/stretchr/testify/require"
+)
+
+const (
+	testEnclaveUuid = enclave.EnclaveUUID("test-enclave-uuid")
+	testEnclaveName = "test-enclave"
+	testServiceName = service.ServiceName("test-service")
+	testImage       = "test-image:1.0"
+	testPortId      = "http"
+	testPortNum     = uint16(8080)
+)
+
+var _ backend_interface.KurtosisBackend = NewInMemoryKurtosisBackend()
+
+func TestEnclaveLifecycle(t *testing.T) {
+	ctx := context.Background()
+	backend := NewInMemoryKurtosisBackend()
+
+	createdEnclave, err := backend.CreateEnclave(ctx, testEnclaveUuid, testEnclaveName)
+	require.NoError(t, err)
+	require.Equal(t, enclave.EnclaveStatus_Empty, createdEnclave.GetStatus())
+	require.NotNil(t, createdEnclave.GetCreationTime())
+
+	_, err = backend.CreateEnclave(ctx, "other-uuid", testEnclaveName)
+	require.Error(t, err)
+
+	_, err = backend.CreateAPIContainer(ctx, "", testEnclaveUuid, 7443, "", "OWN_IP", map[string]string{}, false)
+	require.Error(t, err, "Nothing would be listening on the API container port without a start handler")
+
+	numApiContainerStops := 0
+	backend.SetAPIContainerStartHandler(func(ctx context.Context, enclaveUuid enclave.EnclaveUUID, grpcPortNum uint16, envVars map[string]string) (net.IP, uint16, APIContainerStopFunc, error) {
+		return localhostIpAddr, grpcPortNum, func() { numApiContainerStops++ }, nil
+	})
+	apiContainer, err := backend.CreateAPIContainer(ctx, "", testEnclaveUuid, 7443, "", "OWN_IP", map[string]string{isProductionEnclaveEnvVarKey: enableProductionModeEnvVarValue}, false)
+	require.NoError(t, err)
+	require.Equal(t, localhostIpAddr, apiContainer.GetPublicIPAddress())
+	require.Equal(t, uint16(7443), apiContainer.GetPublicGRPCPort().GetNumber())
+
+	enclaves, err := backend.GetEnclaves(ctx, getAllEnclavesFilters())
+	require.NoError(t, err)
+	require.Len(t, enclaves, 1)
+	require.Equal(t, enclave.EnclaveStatus_Running, enclaves[testEnclaveUuid].GetStatus())
+	require.True(t, enclaves[testEnclaveUuid].IsProductionEnclave())
+
+	_, _, err = backend.StopEnclaves(ctx, getAllEnclavesFilters())
+	require.NoError(t, err)
+	enclaves, err = backend.GetEnclaves(ctx, getAllEnclavesFilters())
+	require.NoError(t, err)
+	require.Equal(t, enclave.EnclaveStatus_Stopped, enclaves[testEnclaveUuid].GetStatus())
+	require.Equal(t, 1, numApiContainerStops)
+
+	destroyed, _, err := backend.DestroyEnclaves(ctx, getAllEnclavesFilters())
+	require.NoError(t, err)
+	require.Len(t, destroyed, 1)
+	require.Equal(t, 1, numApiContainerStops, "The API container server was already stopped")
+	enclaves, err = backend.GetEnclaves(ctx, getAllEnclavesFilters())
+	require.NoError(t, err)
+	require.Empty(t, enclaves)
+}
+
+func TestUpdateEnclaveExpiration(t *testing.T) {
+	ctx := context.Background()
+	backend := NewInMemoryKurtosisBackend()
+
+	createdEnclave, err := backend.CreateEnclave(ctx, testEnclaveUuid, testEnclaveName)
+	require.NoError(t, err)
+	require.Nil(t, createdEnclave.GetExpiration())
+
+	expirationTime := time.Now().Add(time.Hour)
+	expiration := enclave.NewEnclaveExpiration(&expirationTime, 10*time.Minute, enclave.ExpiredEnclaveAction_Stop)
+	require.NoError(t, backend.UpdateEnclaveExpiration(ctx, testEnclaveUuid, expiration))
+	enclaves, err := backend.GetEnclaves(ctx, getAllEnclavesFilters())
+	require.NoError(t, err)
+	require.Equal(t, expiration, enclaves[testEnclaveUuid].GetExpiration())
+
+	require.NoError(t, backend.UpdateEnclaveExpiration(ctx, testEnclaveUuid, nil))
+	enclaves, err = backend.GetEnclaves(ctx, getAllEnclavesFilters())
+	require.NoError(t, err)
+	require.Nil(t, enclaves[testEnclaveUuid].GetExpiration())
+
+	require.Error(t, backend.UpdateEnclaveExpiration(ctx, "unknown-uuid", expiration))
+}
+
+func TestUpdateEnclaveLabels(t *testing.T) {
+	ctx := context.Background()
+	backend := NewInMemoryKurtosisBackend()
+
+	createdEnclave, err := backend.CreateEnclave(ctx, testEnclaveUuid, testEnclaveName)
+	require.NoError(t, err)
+	require.Empty(t, createdEnclave.GetLabels())
+
+	labels := map[string]string{"team": "infra", "pr": "1234"}
+	require.NoError(t, backend.UpdateEnclaveLabels(ctx, testEnclaveUuid, labels))
+	enclaves, err := backend.GetEnclaves(ctx, getAllEnclavesFilters())
+	require.NoError(t, err)
+	require.Equal(t, labels, enclaves[testEnclaveUuid].GetLabels())
+
+	require.NoError(t, backend.UpdateEnclaveLabels(ctx, testEnclaveUuid, map[string]string{"team": "core"}))
+	enclaves, err = backend.GetEnclaves(ctx, getAllEnclavesFilters())
+	require.NoError(t, err)
+	require.Equal(t, map[string]string{"team": "core"}, enclaves[testEnclaveUuid].GetLabels())
+
+	require.Error(t, backend.UpdateEnclaveLabels(ctx, "unknown-uuid", labels))
+}
+
+func TestCopyEnclavePersistentDirectories(t *testing.T) {
+	ctx := context.Background()
+	backend := NewInMemoryKurtosisBackend()
+
+	_, err := backend.CreateEnclave(ctx, testEnclaveUuid, testEnclaveName)
+	require.NoError(t, err)
+	registerAndStartTestService(t, backend)
+	cloneEnclaveUuid := enclave.EnclaveUUID("clone-enclave-uuid")
+	_, err = backend.CreateEnclave(ctx, cloneEnclaveUuid, "clone-enclave")
+	require.NoError(t, err)
+
+	copiedPersistentKeys, err := backend.CopyEnclavePersistentDirectories(ctx, testEnclaveUuid, cloneEnclaveUuid)
+	require.NoError(t, err)
+	require.Empty(t, copiedPersistentKeys)
+
+	_, err = backend.CopyEnclavePersistentDirectories(ctx, testEnclaveUuid, "unknown-uuid")
+	require.Error(t, err)
+}
+
+func TestExportAndImportEnclavePersistentDirectory(t *testing.T) {
+	ctx := context.Background()
+	backend := NewInMemoryKurtosisBackend()
+
+	_, err := backend.CreateEnclave(ctx, testEnclaveUuid, testEnclaveName)
+	require.NoError(t, err)
+
+	exportedContent := &bytes.Buffer{}
+	require.NoError(t, backend.ExportEnclavePersistentDirectory(ctx, testEnclaveUuid, "data", exportedContent))
+	_, err = tar.NewReader(exportedContent).Next()
+	require.ErrorIs(t, err, io.EOF)
+
+	require.NoError(t, backend.ImportEnclavePersistentDirectory(ctx, testEnclaveUuid, "data", exportedContent))
+	require.Error(t, backend.ImportEnclavePersistentDirectory(ctx, "unknown-uuid", "data", exportedContent))
+}
+
+func TestAPIContainerStartHandler(t *testing.T) {
+	ctx := context.Background()
+	backend := NewInMemoryKurtosisBackend()
+	_, err := backend.CreateEnclave(ctx, testEnclaveUuid, testEnclaveName)
+	require.NoError(t, err)
+
+	var receivedOwnIp string
+	isApiContainerStopped := false
+	backend.SetAPIContainerStartHandler(func(ctx context.Context, enclaveUuid enclave.EnclaveUUID, grpcPortNum uint16, envVars map[string]string) (net.IP, uint16, APIContainerStopFunc, error) {
+		receivedOwnIp = envVars["OWN_IP"]
+		// Calling back into the backend must not deadlock
+		_, err := backend.GetEnclaves(ctx, getAllEnclavesFilters())
+		stopFunc := func() {
+			_, err := backend.GetEnclaves(ctx, getAllEnclavesFilters())
+			require.NoError(t, err)
+			isApiContainerStopped = true
+		}
+		return net.IPv4(127, 0, 0, 2), 50000, stopFunc, err
+	})
+	apiContainer, err := backend.CreateAPIContainer(ctx, "", testEnclaveUuid, 7443, "", "OWN_IP", map[string]string{}, false)
+	require.NoError(t, err)
+	require.Equal(t, apiContainer.GetPrivateIPAddress().String(), receivedOwnIp)
+	require.Equal(t, uint16(50000), apiContainer.GetPublicGRPCPort().GetNumber())
+	require.Nil(t, apiContainer.GetBridgeNetworkIPAddress())
+
+	_, _, err = backend.DestroyAPIContainers(ctx, &api_container.APIContainerFilters{EnclaveIDs: nil, Statuses: nil})
+	require.NoError(t, err)
+	require.True(t, isApiContainerStopped)
+}
+
+func TestUserServiceLifecycle(t *testing.T) {
+	ctx := context.Background()
+	backend := NewInMemoryKurtosisBackend()
+	_, err := backend.CreateEnclave(ctx, testEnclaveUuid, testEnclaveName)
+	require.NoError(t, err)
+
+	serviceUuid := registerAndStartTestService(t, backend)
+
+	services, err := backend.GetUserServices(ctx, testEnclaveUuid, &service.ServiceFilters{Names: nil, UUIDs: nil, Statuses: nil})
+	require.NoError(t, err)
+	require.Len(t, services, 1)
+	startedService := services[serviceUuid]
+	require.Equal(t, container.ContainerStatus_Running, startedService.GetContainer().GetStatus())
+	require.Equal(t, testImage, startedService.GetContainer().GetImageName())
+	require.Equal(t, testPortNum, startedService.GetPrivatePorts()[testPortId].GetNumber())
+	require.Equal(t, testPortNum, startedService.GetMaybePublicPorts()[testPortId].GetNumber())
+	require.Equal(t, service.ServiceStatus_Started, startedService.GetRegistration().GetStatus())
+
+	_, _, err = backend.StopUserServices(ctx, testEnclaveUuid, &service.ServiceFilters{Names: nil, UUIDs: map[service.ServiceUUID]bool{serviceUuid: true}, Statuses: nil})
+	require.NoError(t, err)
+	services, err = backend.GetUserServices(ctx, testEnclaveUuid, &service.ServiceFilters{Names: nil, UUIDs: nil, Statuses: map[container.ContainerStatus]bool{container.ContainerStatus_Running: true}})
+	require.NoError(t, err)
+	require.Empty(t, services)
+
+	destroyed, _, err := backend.DestroyUserServices(ctx, testEnclaveUuid, &service.ServiceFilters{Names: nil, UUIDs: nil, Statuses: nil})
+	require.NoError(t, err)
+	require.Len(t, destroyed, 1)
+
+	// The name is free again once the service got destroyed
+	registered, failed, err := backend.RegisterUserServices(ctx, testEnclaveUuid, map[service.ServiceName]bool{testServiceName: true})
+	require.NoError(t, err)
+	require.Empty(t, failed)
+	require.Len(t, registered, 1)
+}
+
+func TestRenameUserService(t *testing.T) {
+	ctx := context.Background()
+	backend := NewInMemoryKurtosisBackend()
+	_, err := backend.CreateEnclave(ctx, testEnclaveUuid, testEnclaveName)
+	require.NoError(t, err)
+
+	serviceUuid := registerAndStartTestService(t, backend)
+	newServiceName := service.ServiceName("renamed-service")
+
+	// The process has to be removed first, as it was started with the previous name
+	_, err = backend.RenameUserService(ctx, testEnclaveUuid, serviceUuid, newServiceName)
+	require.Error(t, err)
+
+	_, _, err = backend.RemoveRegisteredUserServiceProcesses(ctx, testEnclaveUuid, map[service.ServiceUUID]bool{serviceUuid: true})
+	require.NoError(t, err)
+	renamedRegistration, err := backend.RenameUserService(ctx, testEnclaveUuid, serviceUuid, newServiceName)
+	require.NoError(t, err)
+	require.Equal(t, newServiceName, renamedRegistration.GetName())
+	require.Equal(t, string(newServiceName), renamedRegistration.GetHostname())
+	require.Equal(t, serviceUuid, renamedRegistration.GetUUID())
+
+	// The previous name is free again
+	registered, failed, err := backend.RegisterUserServices(ctx, testEnclaveUuid, map[service.ServiceName]bool{testServiceName: true})
+	require.NoError(t, err)
+	require.Empty(t, failed)
+	require.Len(t, registered, 1)
+
+	_, err = backend.RenameUserService(ctx, testEnclaveUuid, serviceUuid, testServiceName)
+	require.Error(t, err)
+}
+
+func TestStartRegisteredUserServices_StartHandlerFailure(t *testing.T) {
+	ctx := context.Background()
+	backend := NewInMemoryKurtosisBackend()
+	_, err := backend.CreateEnclave(ctx, testEnclaveUuid, testEnclaveName)
+	require.NoError(t, err)
+	backend.SetServiceStartHandler(func(enclaveUuid enclave.EnclaveUUID, serviceName service.ServiceName, config *service.ServiceConfig) error {
+		return stacktrace.NewError("Simulated failure")
+	})
+
+	registered, _, err := backend.RegisterUserServices(ctx, testEnclaveUuid, map[service.ServiceName]bool{testServiceName: true})
+	require.NoError(t, err)
+	serviceUuid := registered[testServiceName].GetUUID()
+	started, failed, err := backend.StartRegisteredUserServices(ctx, testEnclaveUuid, map[service.ServiceUUID]*service.ServiceConfig{serviceUuid: getTestServiceConfig(t)})
+	require.NoError(t, err)
+	require.Empty(t, started)
+	require.Contains(t, failed, serviceUuid)
+}
+
+func TestRunUserServiceExecCommands(t *testing.T) {
+	ctx := context.Background()
+	backend := NewInMemoryKurtosisBackend()
+	_, err := backend.CreateEnclave(ctx, testEnclaveUuid, testEnclaveName)
+	require.NoError(t, err)
+	serviceUuid := registerAndStartTestService(t, backend)
+
+	backend.SetExecCommandHandler(func(enclaveUuid enclave.EnclaveUUID, serviceName service.ServiceName, containerUser string, cmd []string) (*exec_result.ExecResult, error) {
+		require.Equal(t, testServiceName, serviceName)
+		return exec_result.NewExecResult(3, "line1\nline2\n"), nil
+	})
+
+	results, failed, err := backend.RunUserServiceExecCommands(ctx, testEnclaveUuid, "", map[service.ServiceUUID][]string{serviceUuid: {"echo"}})
+	require.NoError(t, err)
+	require.Empty(t, failed)
+	require.Equal(t, int32(3), results[serviceUuid].GetExitCode())
+	require.Equal(t, "line1\nline2\n", results[serviceUuid].GetOutput())
+
+	outputChan, finalResultChan, err := backend.RunUserServiceExecCommandWithStreamedOutput(ctx, testEnclaveUuid, serviceUuid, []string{"echo"})
+	require.NoError(t, err)
+	lines := []string{}
+	for line := range outputChan {
+		lines = append(lines, line)
+	}
+	require.Equal(t, []string{"line1\n", "line2\n"}, lines)
+	finalResult := <-finalResultChan
+	require.Equal(t, int32(3), finalResult.GetExitCode())
+}
+
+func TestCopyFilesFromUserService(t *testing.T) {
+	ctx := context.Background()
+	backend := NewInMemoryKurtosisBackend()
+	_, err := backend.CreateEnclave(ctx, testEnclaveUuid, testEnclaveName)
+	require.NoError(t, err)
+	serviceUuid := registerAndStartTestService(t, backend)
+
+	require.NoError(t, backend.WriteFileOnUserService(testEnclaveUuid, serviceUuid, "/data/genesis.json", []byte("{}")))
+	require.NoError(t, backend.WriteFileOnUserService(testEnclaveUuid, serviceUuid, "/data/keys/key1", []byte("secret")))
+	require.NoError(t, backend.WriteFileOnUserService(testEnclaveUuid, serviceUuid, "/other/file", []byte("other")))
+
+	require.Equal(t, map[string]string{"data/genesis.json": "{}", "data/keys/key1": "secret"}, copyFiles(t, backend, serviceUuid, "/data"))
+	require.Equal(t, map[string]string{"genesis.json": "{}", "keys/key1": "secret"}, copyFiles(t, backend, serviceUuid, "/data/."))
+	require.Equal(t, map[string]string{"key1": "secret"}, copyFiles(t, backend, serviceUuid, "/data/keys/key1"))
+
+	err = backend.CopyFilesFromUserService(ctx, testEnclaveUuid, serviceUuid, "/missing", io.Discard)
+	require.Error(t, err)
+}
+
+func TestImageRegistry(t *testing.T) {
+	ctx := context.Background()
+	backend := NewInMemoryKurtosisBackend()
+
+	_, err := backend.CreateImageRegistry(ctx)
+	require.NoError(t, err)
+	_, err = backend.BuildImage(ctx, "my-image:v1", nil)
+	require.NoError(t, err)
+
+	repositories, err := backend.ListImageRegistryRepositories(ctx)
+	require.NoError(t, err)
+	require.Len(t, repositories, 1)
+	require.Equal(t, "my-image", repositories[0].GetName())
+	require.Equal(t, []string{"v1"}, repositories[0].GetTags())
+
+	pruned, err := backend.PruneImageRegistry(ctx)
+	require.NoError(t, err)
+	require.Equal(t, []string{"my-image"}, pruned)
+}
+
+func registerAndStartTestService(t *testing.T, backend *InMemoryKurtosisBackend) service.ServiceUUID {
+	ctx := context.Background()
+	registered, failed, err := backend.RegisterUserServices(ctx, testEnclaveUuid, map[service.ServiceName]bool{testServiceName: true})
+	require.NoError(t, err)
+	require.Empty(t, failed)
+	serviceUuid := registered[testServiceName].GetUUID()
+
+	started, failedToStart, err := backend.StartRegisteredUserServices(ctx, testEnclaveUuid, map[service.ServiceUUID]*service.ServiceConfig{serviceUuid: getTestServiceConfig(t)})
+	require.NoError(t, err)
+	require.Empty(t, failedToStart)
+	require.Len(t, started, 1)
+	return serviceUuid
+}
+
+func getTestServiceConfig(t *testing.T) *service.ServiceConfig {
+	portSpec, err := port_spec.NewPortSpec(testPortNum, port_spec.TransportProtocol_TCP, "", nil, "")
+	require.NoError(t, err)
+	serviceConfig := service.GetEmptyServiceConfig()
+	serviceConfig.SetContainerImageName(testImage)
+	serviceConfig.GetPrivatePorts()[testPortId] = portSpec
+	return serviceConfig
+}
+
+func copyFiles(t *testing.T, backend *InMemoryKurtosisBackend, serviceUuid service.ServiceUUID, srcPath string) map[string]string {
+	output := &bytes.Buffer{}
+	require.NoError(t, backend.CopyFilesFromUserService(context.Background(), testEnclaveUuid, serviceUuid, srcPath, output))
+	files := map[string]string{}
+	tarReader := tar.NewReader(output)
+	for {
+		header, err := tarReader.Next()
+		if err == io.EOF {
+			break
+		}
+		require.NoError(t, err)
+		content, err := io.ReadAll(tarReader)
+		require.NoError(t, err)
+		files[header.Name] = string(content)
+	}
+	return files
+}
@@ -0,0 +1,602 @@
+package in_memory_kurtosis_backend
+
+import (
+	"archive/tar"
+	"context"
+	"io"
+	"path"
+	"sort"
+	"strings"
+
+	"github.com/kurtosis-tech/kurtosis/container-engine-lib/lib/backend_interface/objects/container"
+	"github.com/kurtosis-tech/kurtosis/container-engine-lib/lib/backend_interface/objects/enclave"
+	"github.com/kurtosis-tech/kurtosis/container-engine-lib/lib/backend_interface/objects/exec_result"
+	"github.com/kurtosis-tech/kurtosis/container-engine-lib/lib/backend_interface/objects/service"
+	"github.com/kurtosis-tech/stacktrace"
+)
+
+const (
+	// Same symbol the container-based backends accept to copy the content of a directory without the directory itself
+	doNotIncludeParentDirInArchiveSymbol = "."
+
+	streamOutputDelimiter = "\n"
+
+	archivedFilePerms = 0644
+)
+
+func (backend *InMemoryKurtosisBackend) RegisterUserServices(
+	ctx context.Context,
+	enclaveUuid enclave.EnclaveUUID,
+	services map[service.ServiceName]bool,
+) (
+	map[service.ServiceName]*service.ServiceRegistration,
+	map[service.ServiceName]error,
+	error,
+) {
+	backend.mutex.Lock()
+	defer backend.mutex.Unlock()
+	enclaveObj, err := backend.getEnclaveNoLock(enclaveUuid)
+	if err != nil {
+		return nil, nil, stacktrace.Propagate(err, "An error occurred getting the enclave to register services in")
+	}
+
+	successfulRegistrations := map[service.ServiceName]*service.ServiceRegistration{}
+	failedRegistrations := map[service.ServiceName]error{}
+	for serviceName := range services {
+		if _, found := enclaveObj.services[serviceName]; found {
+			failedRegistrations[serviceName] = stacktrace.NewError("A service with name '%v' is already registered in enclave '%v'", serviceName, enclaveUuid)
+			continue
+		}
+		hostIndex, err := enclaveObj.getFreeHostIndex()
+		if err != nil {
+			failedRegistrations[serviceName] = stacktrace.Propagate(err, "An error occurred getting a free IP address to give to service '%v' in enclave '%v'", serviceName, enclaveUuid)
+			continue
+		}
+		uuidStr, err := generateUuid()
+		if err != nil {
+			failedRegistrations[serviceName] = stacktrace.Propagate(err, "An error occurred generating a UUID to use for the service UUID")
+			continue
+		}
+		registration := service.NewServiceRegistration(
+			serviceName,
+			service.ServiceUUID(uuidStr),
+			enclaveUuid,
+			enclaveObj.getIpAddr(hostIndex),
+			string(serviceName),
+		)
+		enclaveObj.services[serviceName] = &inMemoryUserService{
+			registration: registration,
+			hostIndex:    hostIndex,
+			container:    nil,
+			privatePorts: nil,
+			publicPorts:  nil,
+			files:        map[string][]byte{},
+		}
+		successfulRegistrations[serviceName] = registration
+	}
+	return successfulRegistrations, failedRegistrations, nil
+}
+
+func (backend *InMemoryKurtosisBackend) UnregisterUserServices(
+	ctx context.Context,
+	enclaveUuid enclave.EnclaveUUID,
+	services map[service.ServiceUUID]bool,
+) (
+	map[service.ServiceUUID]bool,
+	map[service.ServiceUUID]error,
+	error,
+) {
+	backend.mutex.Lock()
+	defer backend.mutex.Unlock()
+	enclaveObj, err := backend.getEnclaveNoLock(enclaveUuid)
+	if err != nil {
+		return nil, nil, stacktrace.Propagate(err, "An error occurred getting the enclave to unregister services from")
+	}
+
+	successfulUuids := map[service.ServiceUUID]bool{}
+	erroredUuids := map[service.ServiceUUID]error{}
+	for serviceUuid := range services {
+		userService, err := backend.getUserServiceNoLock(enclaveUuid, serviceUuid)
+		if err != nil {
+			erroredUuids[serviceUuid] = err
+			continue
+		}
+		if userService.container != nil {
+			erroredUuids[serviceUuid] = stacktrace.NewError("Service '%v' cannot be unregistered as it still has a running process", serviceUuid)
+			continue
+		}
+		delete(enclaveObj.services, userService.registration.GetName())
+		successfulUuids[serviceUuid] = true
+	}
+	return successfulUuids, erroredUuids, nil
+}
+
+func (backend *InMemoryKurtosisBackend) StartRegisteredUserServices(
+	ctx context.Context,
+	enclaveUuid enclave.EnclaveUUID,
+	services map[service.ServiceUUID]*service.ServiceConfig,
+) (
+	map[service.ServiceUUID]*service.Service,
+	map[service.ServiceUUID]error,
+	error,
+) {
+	backend.mutex.RLock()
+	if _, err := backend.getEnclaveNoLock(enclaveUuid); err != nil {
+		backend.mutex.RUnlock()
+		return nil, nil, stacktrace.Propagate(err, "An error occurred getting the enclave to start services in")
+	}
+	serviceStartHandler := backend.serviceStartHandler
+	serviceNames := map[service.ServiceUUID]service.ServiceName{}
+	erroredUuids := map[service.ServiceUUID]error{}
+	for serviceUuid := range services {
+		userService, err := backend.getUserServiceNoLock(enclaveUuid, serviceUuid)
+		if err != nil {
+			erroredUuids[serviceUuid] = err
+			continue
+		}
+		serviceNames[serviceUuid] = userService.registration.GetName()
+	}
+	backend.mutex.RUnlock()
+
+	// The handler may well call back into the backend (e.g. to write files on the service), so it's called without
+	// holding the lock
+	startedUuids := map[service.ServiceUUID]bool{}
+	for serviceUuid, serviceName := range serviceNames {
+		if err := serviceStartHandler(enclaveUuid, serviceName, services[serviceUuid]); err != nil {
+			erroredUuids[serviceUuid] = stacktrace.Propagate(err, "An error occurred starting service '%v'", serviceName)
+			continue
+		}
+		startedUuids[serviceUuid] = true
+	}
+
+	backend.mutex.Lock()
+	defer backend.mutex.Unlock()
+	successfulServices := map[service.ServiceUUID]*service.Service{}
+	for serviceUuid := range startedUuids {
+		userService, err := backend.getUserServiceNoLock(enclaveUuid, serviceUuid)
+		if err != nil {
+			erroredUuids[serviceUuid] = stacktrace.Propagate(err, "Service '%v' was removed while it was starting", serviceUuid)
+			continue
+		}
+		serviceConfig := services[serviceUuid]
+		containerImageName := serviceConfig.GetContainerImageName()
+		if imageReference, found := backend.builtImageReferences[containerImageName]; found {
+			containerImageName = imageReference
+		}
+		publicPorts := serviceConfig.GetPublicPorts()
+		if len(publicPorts) == 0 {
+			// Nothing needs to be forwarded for the ports to be reachable from the process, so they are published as is
+			publicPorts = serviceConfig.GetPrivatePorts()
+		}
+		userService.container = container.NewContainer(
+			container.ContainerStatus_Running,
+			containerImageName,
+			serviceConfig.GetEntrypointArgs(),
+			serviceConfig.GetCmdArgs(),
+			serviceConfig.GetEnvVars(),
+		)
+		userService.privatePorts = serviceConfig.GetPrivatePorts()
+		userService.publicPorts = publicPorts
+		userService.registration.SetConfig(serviceConfig)
+		userService.registration.SetStatus(service.ServiceStatus_Started)
+		successfulServices[serviceUuid] = userService.toServiceObject()
+	}
+	return successfulServices, erroredUuids, nil
+}
+
+func (backend *InMemoryKurtosisBackend) RenameUserService(
+	ctx context.Context,
+	enclaveUuid enclave.EnclaveUUID,
+	serviceUuid service.ServiceUUID,
+	newServiceName service.ServiceName,
+) (*service.ServiceRegistration, error) {
+	backend.mutex.Lock()
+	defer backend.mutex.Unlock()
+	enclaveObj, err := backend.getEnclaveNoLock(enclaveUuid)
+	if err != nil {
+		return nil, stacktrace.Propagate(err, "An error occurred getting the enclave to rename the service in")
+	}
+	userService, err := backend.getUserServiceNoLock(enclaveUuid, serviceUuid)
+	if err != nil {
+		return nil, stacktrace.Propagate(err, "An error occurred getting service '%v' to rename", serviceUuid)
+	}
+	if userService.container != nil {
+		return nil, stacktrace.NewError("Service '%v' cannot be renamed as it still has a running process", serviceUuid)
+	}
+	if _, found := enclaveObj.services[newServiceName]; found {
+		return nil, stacktrace.NewError("A service with name '%v' is already registered in enclave '%v'", newServiceName, enclaveUuid)
+	}
+
+	delete(enclaveObj.services, userService.registration.GetName())
+	userService.registration.SetName(newServiceName)
+	userService.registration.SetHostname(string(newServiceName))
+	enclaveObj.services[newServiceName] = userService
+	return userService.registration, nil
+}
+
+func (backend *InMemoryKurtosisBackend) RemoveRegisteredUserServiceProcesses(
+	ctx context.Context,
+	enclaveUuid enclave.EnclaveUUID,
+	services map[service.ServiceUUID]bool,
+) (
+	map[service.ServiceUUID]bool,
+	map[service.ServiceUUID]error,
+	error,
+) {
+	backend.mutex.Lock()
+	defer backend.mutex.Unlock()
+	if _, err := backend.getEnclaveNoLock(enclaveUuid); err != nil {
+		return nil, nil, stacktrace.Propagate(err, "An error occurred getting the enclave to remove service processes from")
+	}
+
+	successfulUuids := map[service.ServiceUUID]bool{}
+	erroredUuids := map[service.ServiceUUID]error{}
+	for serviceUuid := range services {
+		userService, err := backend.getUserServiceNoLock(enclaveUuid, serviceUuid)
+		if err != nil {
+			erroredUuids[serviceUuid] = err
+			continue
+		}
+		userService.container = nil
+		userService.privatePorts = nil
+		userService.publicPorts = nil
+		userService.registration.SetStatus(service.ServiceStatus_Registered)
+		successfulUuids[serviceUuid] = true
+	}
+	return successfulUuids, erroredUuids, nil
+}
+
+func (backend *InMemoryKurtosisBackend) GetUserServices(
+	ctx context.Context,
+	enclaveUuid enclave.EnclaveUUID,
+	filters *service.ServiceFilters,
+) (
+	map[service.ServiceUUID]*service.Service,
+	error,
+) {
+	backend.mutex.RLock()
+	defer backend.mutex.RUnlock()
+	matchingServices, err := backend.getMatchingUserServicesNoLock(enclaveUuid, filters)
+	if err != nil {
+		return nil, stacktrace.Propagate(err, "An error occurred getting user services matching filters '%+v'", filters)
+	}
+	result := map[service.ServiceUUID]*service.Service{}
+	for serviceUuid, userService := range matchingServices {
+		result[serviceUuid] = userService.toServiceObject()
+	}
+	return result, nil
+}
+
+// GetUserServiceLogs returns the logs produced by the logs handler; as they are all known upfront, following them
+// returns the same content
+func (backend *InMemoryKurtosisBackend) GetUserServiceLogs(
+	ctx context.Context,
+	enclaveUuid enclave.EnclaveUUID,
+	filters *service.ServiceFilters,
+	shouldFollowLogs bool,
+) (
+	map[service.ServiceUUID]io.ReadCloser,
+	map[service.ServiceUUID]error,
+	error,
+) {
+	backend.mutex.RLock()
+	matchingServices, err := backend.getMatchingUserServicesNoLock(enclaveUuid, filters)
+	serviceLogsHandler := backend.serviceLogsHandler
+	backend.mutex.RUnlock()
+	if err != nil {
+		return nil, nil, stacktrace.Propagate(err, "An error occurred getting user services matching filters '%+v'", filters)
+	}
+
+	successfulServiceLogs := map[service.ServiceUUID]io.ReadCloser{}
+	erroredServiceUuids := map[service.ServiceUUID]error{}
+	for serviceUuid, userService := range matchingServices {
+		serviceLogs, err := serviceLogsHandler(enclaveUuid, userService.registration.GetName())
+		if err != nil {
+			erroredServiceUuids[serviceUuid] = stacktrace.Propagate(err, "An error occurred getting the logs of service '%v'", serviceUuid)
+			continue
+		}
+		successfulServiceLogs[serviceUuid] = io.NopCloser(strings.NewReader(serviceLogs))
+	}
+	return successfulServiceLogs, erroredServiceUuids, nil
+}
+
+// GetUserServiceStats reports that running services use no resources, as nothing actually runs
+func (backend *InMemoryKurtosisBackend) GetUserServiceStats(
+	ctx context.Context,
+	enclaveUuid enclave.EnclaveUUID,
+	filters *service.ServiceFilters,
+) (
+	map[service.ServiceUUID]*service.ServiceStats,
+	map[service.ServiceUUID]error,
+	error,
+) {
+	backend.mutex.RLock()
+	defer backend.mutex.RUnlock()
+	matchingServices, err := backend.getMatchingUserServicesNoLock(enclaveUuid, filters)
+	if err != nil {
+		return nil, nil, stacktrace.Propagate(err, "An error occurred getting user services matching filters '%+v'", filters)
+	}
+
+	successfulServiceStats := map[service.ServiceUUID]*service.ServiceStats{}
+	erroredServiceUuids := map[service.ServiceUUID]error{}
+	for serviceUuid, userService := range matchingServices {
+		if userService.container == nil || userService.container.GetStatus() != container.ContainerStatus_Running {
+			erroredServiceUuids[serviceUuid] = stacktrace.NewError("Cannot get stats for service '%v' as it isn't running", serviceUuid)
+			continue
+		}
+		successfulServiceStats[serviceUuid] = service.NewServiceStats(0, 0, 0, 0, 0, 0, 0)
+	}
+	return successfulServiceStats, erroredServiceUuids, nil
+}
+
+func (backend *InMemoryKurtosisBackend) RunUserServiceExecCommands(
+	ctx context.Context,
+	enclaveUuid enclave.EnclaveUUID,
+	containerUser string,
+	userServiceCommands map[service.ServiceUUID][]string,
+) (
+	map[service.ServiceUUID]*exec_result.ExecResult,
+	map[service.ServiceUUID]error,
+	error,
+) {
+	backend.mutex.RLock()
+	if _, err := backend.getEnclaveNoLock(enclaveUuid); err != nil {
+		backend.mutex.RUnlock()
+		return nil, nil, stacktrace.Propagate(err, "An error occurred getting the enclave to run exec commands in")
+	}
+	execCommandHandler := backend.execCommandHandler
+	serviceNames := map[service.ServiceUUID]service.ServiceName{}
+	erroredUuids := map[service.ServiceUUID]error{}
+	for serviceUuid := range userServiceCommands {
+		serviceName, err := backend.getRunningUserServiceNameNoLock(enclaveUuid, serviceUuid)
+		if err != nil {
+			erroredUuids[serviceUuid] = err
+			continue
+		}
+		serviceNames[serviceUuid] = serviceName
+	}
+	backend.mutex.RUnlock()
+
+	successfulResults := map[service.ServiceUUID]*exec_result.ExecResult{}
+	for serviceUuid, serviceName := range serviceNames {
+		execResult, err := execCommandHandler(enclaveUuid, serviceName, containerUser, userServiceCommands[serviceUuid])
+		if err != nil {
+			erroredUuids[serviceUuid] = stacktrace.Propagate(err, "An error occurred running command '%+v' on service '%v'", userServiceCommands[serviceUuid], serviceName)
+			continue
+		}
+		successfulResults[serviceUuid] = execResult
+	}
+	return successfulResults, erroredUuids, nil
+}
+
+func (backend *InMemoryKurtosisBackend) RunUserServiceExecCommandWithStreamedOutput(
+	ctx context.Context,
+	enclaveUuid enclave.EnclaveUUID,
+	serviceUuid service.ServiceUUID,
+	cmd []string,
+) (chan string, chan *exec_result.ExecResult, error) {
+	backend.mutex.RLock()
+	serviceName, err := backend.getRunningUserServiceNameNoLock(enclaveUuid, serviceUuid)
+	execCommandHandler := backend.execCommandHandler
+	backend.mutex.RUnlock()
+	if err != nil {
+		return nil, nil, stacktrace.Propagate(err, "An error occurred getting service '%v' to run command '%+v' on", serviceUuid, cmd)
+	}
+
+	execOutputChan := make(chan string)
+	// Buffered so the output channel gets closed even if the caller drains it before reading the final result
+	finalExecResultChan := make(chan *exec_result.ExecResult, 1)
+	go func() {
+		defer func() {
+			close(execOutputChan)
+			close(finalExecResultChan)
+		}()
+		execResult, err := execCommandHandler(enclaveUuid, serviceName, "", cmd)
+		if err != nil {
+			execOutputChan <- err.Error()
+			return
+		}
+		for _, execOutputLine := range strings.SplitAfter(execResult.GetOutput(), streamOutputDelimiter) {
+			if execOutputLine == "" {
+				continue
+			}
+			execOutputChan <- execOutputLine
+		}
+		// Don't send output in final result because it was already streamed
+		finalExecResultChan <- exec_result.NewExecResult(execResult.GetExitCode(), emptyOutput)
+	}()
+	return execOutputChan, finalExecResultChan, nil
+}
+
+func (backend *InMemoryKurtosisBackend) GetShellOnUserService(ctx context.Context, enclaveUuid enclave.EnclaveUUID, serviceUuid service.ServiceUUID, containerUser string) error {
+	return stacktrace.NewError("Getting a shell on service '%v' isn't supported by the in-memory backend as services don't run any process", serviceUuid)
+}
+
+// CopyFilesFromUserService writes a TAR archive of the files under the given path to the output, like the
+// container-based backends do
+func (backend *InMemoryKurtosisBackend) CopyFilesFromUserService(
+	ctx context.Context,
+	enclaveUuid enclave.EnclaveUUID,
+	serviceUuid service.ServiceUUID,
+	srcPathOnService string,
+	output io.Writer,
+) error {
+	backend.mutex.RLock()
+	defer backend.mutex.RUnlock()
+	userService, err := backend.getUserServiceNoLock(enclaveUuid, serviceUuid)
+	if err != nil {
+		return stacktrace.Propagate(err, "An error occurred getting service '%v' to copy files from", serviceUuid)
+	}
+
+	srcPath := path.Clean(srcPathOnService)
+	archiveRootDirname := path.Base(srcPath)
+	if path.Base(srcPathOnService) == doNotIncludeParentDirInArchiveSymbol {
+		archiveRootDirname = ""
+	}
+
+	archivedFilepaths := map[string]string{}
+	for filepathOnService := range userService.files {
+		if filepathOnService == srcPath {
+			archivedFilepaths[path.Base(srcPath)] = filepathOnService
+			continue
+		}
+		if relativeFilepath, found := strings.CutPrefix(filepathOnService, strings.TrimSuffix(srcPath, "/")+"/"); found {
+			archivedFilepaths[path.Join(archiveRootDirname, relativeFilepath)] = filepathOnService
+		}
+	}
+	if len(archivedFilepaths) == 0 {
+		return stacktrace.NewError("No file exists at path '%v' on service '%v'", srcPathOnService, serviceUuid)
+	}
+
+	archivedFilenames := []string{}
+	for archivedFilename := range archivedFilepaths {
+		archivedFilenames = append(archivedFilenames, archivedFilename)
+	}
+	sort.Strings(archivedFilenames)
+
+	tarWriter := tar.NewWriter(output)
+	for _, archivedFilename := range archivedFilenames {
+		content := userService.files[archivedFilepaths[archivedFilename]]
+		header := &tar.Header{ // nolint: exhaustruct
+			Name: archivedFilename,
+			Mode: archivedFilePerms,
+			Size: int64(len(content)),
+		}
+		if err := tarWriter.WriteHeader(header); err != nil {
+			return stacktrace.Propagate(err, "An error occurred writing the TAR header of file '%v'", archivedFilename)
+		}
+		if _, err := tarWriter.Write(content); err != nil {
+			return stacktrace.Propagate(err, "An error occurred writing the content of file '%v' to the TAR archive", archivedFilename)
+		}
+	}
+	if err := tarWriter.Close(); err != nil {
+		return stacktrace.Propagate(err, "An error occurred closing the TAR archive of the files at '%v' on service '%v'", srcPathOnService, serviceUuid)
+	}
+	return nil
+}
+
+func (backend *InMemoryKurtosisBackend) StopUserServices(
+	ctx context.Context,
+	enclaveUuid enclave.EnclaveUUID,
+	filters *service.ServiceFilters,
+) (
+	map[service.ServiceUUID]bool,
+	map[service.ServiceUUID]error,
+	error,
+) {
+	backend.mutex.Lock()
+	defer backend.mutex.Unlock()
+	matchingServices, err := backend.getMatchingUserServicesNoLock(enclaveUuid, filters)
+	if err != nil {
+		return nil, nil, stacktrace.Propagate(err, "An error occurred getting user services matching filters '%+v'", filters)
+	}
+	successfulUuids := map[service.ServiceUUID]bool{}
+	for serviceUuid, userService := range matchingServices {
+		userService.stop()
+		successfulUuids[serviceUuid] = true
+	}
+	return successfulUuids, map[service.ServiceUUID]error{}, nil
+}
+
+func (backend *InMemoryKurtosisBackend) DestroyUserServices(
+	ctx context.Context,
+	enclaveUuid enclave.EnclaveUUID,
+	filters *service.ServiceFilters,
+) (
+	map[service.ServiceUUID]bool,
+	map[service.ServiceUUID]error,
+	error,
+) {
+	backend.mutex.Lock()
+	defer backend.mutex.Unlock()
+	enclaveObj, err := backend.getEnclaveNoLock(enclaveUuid)
+	if err != nil {
+		return nil, nil, stacktrace.Propagate(err, "An error occurred getting the enclave to destroy services in")
+	}
+	successfulUuids := map[service.ServiceUUID]bool{}
+	// Unlike the other functions, destroying also covers the services that are only registered
+	for serviceName, userService := range enclaveObj.services {
+		if !doesUserServiceMatchFilters(userService, filters, true) {
+			continue
+		}
+		delete(enclaveObj.services, serviceName)
+		successfulUuids[userService.registration.GetUUID()] = true
+	}
+	return successfulUuids, map[service.ServiceUUID]error{}, nil
+}
+
+// getMatchingUserServicesNoLock returns the services matching the filters that have a process, registered services
+// being invisible like they are on the container-based backends
+func (backend *InMemoryKurtosisBackend) getMatchingUserServicesNoLock(enclaveUuid enclave.EnclaveUUID, filters *service.ServiceFilters) (map[service.ServiceUUID]*inMemoryUserService, error) {
+	enclaveObj, err := backend.getEnclaveNoLock(enclaveUuid)
+	if err != nil {
+		return nil, err
+	}
+	result := map[service.ServiceUUID]*inMemoryUserService{}
+	for _, userService := range enclaveObj.services {
+		if doesUserServiceMatchFilters(userService, filters, false) {
+			result[userService.registration.GetUUID()] = userService
+		}
+	}
+	return result, nil
+}
+
+func (backend *InMemoryKurtosisBackend) getRunningUserServiceNameNoLock(enclaveUuid enclave.EnclaveUUID, serviceUuid service.ServiceUUID) (service.ServiceName, error) {
+	userService, err := backend.getUserServiceNoLock(enclaveUuid, serviceUuid)
+	if err != nil {
+		return "", err
+	}
+	if userService.container == nil || userService.container.GetStatus() != container.ContainerStatus_Running {
+		return "", stacktrace.NewError("Service '%v' in enclave '%v' isn't running", serviceUuid, enclaveUuid)
+	}
+	return userService.registration.GetName(), nil
+}
+
+func doesUserServiceMatchFilters(userService *inMemoryUserService, filters *service.ServiceFilters, shouldIncludeRegisteredServices bool) bool {
+	if userService.container == nil && !shouldIncludeRegisteredServices {
+		return false
+	}
+	if filters == nil {
+		return true
+	}
+	if len(filters.UUIDs) > 0 {
+		if _, found := filters.UUIDs[userService.registration.GetUUID()]; !found {
+			return false
+		}
+	}
+	if len(filters.Names) > 0 {
+		if _, found := filters.Names[userService.registration.GetName()]; !found {
+			return false
+		}
+	}
+	if len(filters.Statuses) > 0 {
+		if userService.container == nil {
+			return false
+		}
+		if _, found := filters.Statuses[userService.container.GetStatus()]; !found {
+			return false
+		}
+	}
+	return true
+}
+
+func (userService *inMemoryUserService) stop() {
+	if userService.container == nil {
+		return
+	}
+	userService.container = container.NewContainer(
+		container.ContainerStatus_Stopped,
+		userService.container.GetImageName(),
+		userService.container.GetEntrypointArgs(),
+		userService.container.GetCmdArgs(),
+		userService.container.GetEnvVars(),
+	)
+	userService.registration.SetStatus(service.ServiceStatus_Stopped)
+}
+
+func (userService *inMemoryUserService) toServiceObject() *service.Service {
+	if userService.container.GetStatus() != container.ContainerStatus_Running {
+		return service.NewService(userService.registration, userService.privatePorts, nil, nil, userService.container)
+	}
+	return service.NewService(userService.registration, userService.privatePorts, localhostIpAddr, userService.publicPorts, userService.container)
+}
@@ -0,0 +1,170 @@
+package enclave_namespace_config
+
+import (
+	"bytes"
+	"strings"
+	"text/template"
+
+	"github.com/kurtosis-tech/kurtosis/container-engine-lib/lib/backend_interface/objects/enclave"
+	"github.com/kurtosis-tech/kurtosis/container-engine-lib/lib/uuid_generator"
+	"github.com/kurtosis-tech/stacktrace"
+	"k8s.io/apimachinery/pkg/api/resource"
+	"k8s.io/apimachinery/pkg/util/validation"
+)
+
+const (
+	// Kurtosis tracks its resources with labels under this prefix, so users can't set them
+	reservedKeyPrefix = "kurtosistech.com/"
+
+	metadataTemplateName = "enclaveNamespaceMetadata"
+)
+
+// EnclaveNamespaceConfig customizes the namespaces that enclaves get created in on Kubernetes
+// Label and annotation values are Go templates, rendered with the enclave's UUID, shortened UUID and name, e.g.
+// 'kurtosis-{{.EnclaveName}}'
+type EnclaveNamespaceConfig struct {
+	Labels map[string]string `yaml:"labels,omitempty" json:"labels,omitempty"`
+
+	Annotations map[string]string `yaml:"annotations,omitempty" json:"annotations,omitempty"`
+
+	// Hard limits of the ResourceQuota created in the namespace, by resource name, e.g. 'requests.cpu: 4' or 'pods: 50'
+	ResourceQuota map[string]string `yaml:"resource-quota,omitempty" json:"resourceQuota,omitempty"`
+
+	// Container limits of the LimitRange created in the namespace
+	LimitRange *LimitRangeConfig `yaml:"limit-range,omitempty" json:"limitRange,omitempty"`
+
+	// Whether to create network policies denying all traffic into the namespace except from the pods of the enclave
+	// itself, and from the engine to the API container
+	ShouldCreateNetworkPolicy bool `yaml:"network-policy,omitempty" json:"networkPolicy,omitempty"`
+}
+
+// LimitRangeConfig holds the quantities of the LimitRange applied to each container, by resource name
+type LimitRangeConfig struct {
+	Default        map[string]string `yaml:"default,omitempty" json:"default,omitempty"`
+	DefaultRequest map[string]string `yaml:"default-request,omitempty" json:"defaultRequest,omitempty"`
+	Min            map[string]string `yaml:"min,omitempty" json:"min,omitempty"`
+	Max            map[string]string `yaml:"max,omitempty" json:"max,omitempty"`
+}
+
+type metadataTemplateData struct {
+	EnclaveUUID      string
+	EnclaveShortUUID string
+	EnclaveName      string
+}
+
+// Validate checks everything that doesn't depend on the enclave, so a bad config gets rejected before any enclave is
+// created with it
+func (config *EnclaveNamespaceConfig) Validate() error {
+	for labelKey, labelValueTemplate := range config.Labels {
+		if err := validateKey(labelKey); err != nil {
+			return stacktrace.Propagate(err, "Enclave namespace label key '%v' is invalid", labelKey)
+		}
+		if _, err := parseMetadataTemplate(labelValueTemplate); err != nil {
+			return stacktrace.Propagate(err, "Enclave namespace label '%v' has an invalid template value '%v'", labelKey, labelValueTemplate)
+		}
+	}
+	for annotationKey, annotationValueTemplate := range config.Annotations {
+		if err := validateKey(annotationKey); err != nil {
+			return stacktrace.Propagate(err, "Enclave namespace annotation key '%v' is invalid", annotationKey)
+		}
+		if _, err := parseMetadataTemplate(annotationValueTemplate); err != nil {
+			return stacktrace.Propagate(err, "Enclave namespace annotation '%v' has an invalid template value '%v'", annotationKey, annotationValueTemplate)
+		}
+	}
+	if _, err := ParseQuantities(config.ResourceQuota); err != nil {
+		return stacktrace.Propagate(err, "The enclave namespace resource quota is invalid")
+	}
+	if config.LimitRange != nil {
+		limitRangeQuantities := map[string]map[string]string{
+			"default":         config.LimitRange.Default,
+			"default request": config.LimitRange.DefaultRequest,
+			"min":             config.LimitRange.Min,
+			"max":             config.LimitRange.Max,
+		}
+		for limitName, quantities := range limitRangeQuantities {
+			if _, err := ParseQuantities(quantities); err != nil {
+				return stacktrace.Propagate(err, "The '%v' limits of the enclave namespace limit range are invalid", limitName)
+			}
+		}
+	}
+	return nil
+}
+
+// RenderLabels renders the label templates for the given enclave, checking that the results are valid label values
+func (config *EnclaveNamespaceConfig) RenderLabels(enclaveUuid enclave.EnclaveUUID, enclaveName string) (map[string]string, error) {
+	renderedLabels, err := renderMetadata(config.Labels, enclaveUuid, enclaveName)
+	if err != nil {
+		return nil, stacktrace.Propagate(err, "An error occurred rendering the enclave namespace labels")
+	}
+	for labelKey, labelValue := range renderedLabels {
+		if errs := validation.IsValidLabelValue(labelValue); len(errs) > 0 {
+			return nil, stacktrace.NewError("Enclave namespace label '%v' rendered to invalid value '%v': %v", labelKey, labelValue, strings.Join(errs, "; "))
+		}
+	}
+	return renderedLabels, nil
+}
+
+// RenderAnnotations renders the annotation templates for the given enclave
+func (config *EnclaveNamespaceConfig) RenderAnnotations(enclaveUuid enclave.EnclaveUUID, enclaveName string) (map[string]string, error) {
+	renderedAnnotations, err := renderMetadata(config.Annotations, enclaveUuid, enclaveName)
+	if err != nil {
+		return nil, stacktrace.Propagate(err, "An error occurred rendering the enclave namespace annotations")
+	}
+	return renderedAnnotations, nil
+}
+
+// ParseQuantities parses Kubernetes quantities, e.g. '500m' or '2Gi', by resource name
+func ParseQuantities(quantityStrs map[string]string) (map[string]resource.Quantity, error) {
+	quantities := map[string]resource.Quantity{}
+	for resourceName, quantityStr := range quantityStrs {
+		quantity, err := resource.ParseQuantity(quantityStr)
+		if err != nil {
+			return nil, stacktrace.Propagate(err, "Quantity '%v' of resource '%v' isn't a valid Kubernetes quantity", quantityStr, resourceName)
+		}
+		quantities[resourceName] = quantity
+	}
+	return quantities, nil
+}
+
+func validateKey(key string) error {
+	if strings.HasPrefix(key, reservedKeyPrefix) {
+		return stacktrace.NewError("Keys starting with '%v' are reserved for Kurtosis", reservedKeyPrefix)
+	}
+	if errs := validation.IsQualifiedName(key); len(errs) > 0 {
+		return stacktrace.NewError("Key isn't a valid Kubernetes qualified name: %v", strings.Join(errs, "; "))
+	}
+	return nil
+}
+
+func renderMetadata(metadataTemplates map[string]string, enclaveUuid enclave.EnclaveUUID, enclaveName string) (map[string]string, error) {
+	templateData := metadataTemplateData{
+		EnclaveUUID:      string(enclaveUuid),
+		EnclaveShortUUID: uuid_generator.ShortenedUUIDString(string(enclaveUuid)),
+		EnclaveName:      enclaveName,
+	}
+	renderedMetadata := map[string]string{}
+	for key, valueTemplateStr := range metadataTemplates {
+		valueTemplate, err := parseMetadataTemplate(valueTemplateStr)
+		if err != nil {
+			return nil, stacktrace.Propagate(err, "An error occurred parsing the template value '%v' of '%v'", valueTemplateStr, key)
+		}
+		renderedValue := &bytes.Buffer{}
+		if err := valueTemplate.Execute(renderedValue, templateData); err != nil {
+			return nil, stacktrace.Propagate(err, "An error occurred rendering the template value '%v' of '%v'", valueTemplateStr, key)
+		}
+		renderedMetadata[key] = renderedValue.String()
+	}
+	return renderedMetadata, nil
+}
+
+func parseMetadataTemplate(valueTemplateStr string) (*template.Template, error) {
+	// Unknown fields fail at execution rather than rendering '<no value>', so they're caught by Validate
+	valueTemplate, err := template.New(metadataTemplateName).Option("missingkey=error").Parse(valueTemplateStr)
+	if err != nil {
+		return nil, stacktrace.Propagate(err, "An error occurred parsing template '%v'", valueTemplateStr)
+	}
+	if err := valueTemplate.Execute(&bytes.Buffer{}, metadataTemplateData{EnclaveUUID: "", EnclaveShortUUID: "", EnclaveName: ""}); err != nil {
+		return nil, stacktrace.Propagate(err, "Template '%v' references something other than 'EnclaveUUID', 'EnclaveShortUUID' or 'EnclaveName'", valueTemplateStr)
+	}
+	return valueTemplate, nil
+}
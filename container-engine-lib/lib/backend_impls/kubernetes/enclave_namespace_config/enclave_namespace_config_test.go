@@ -0,0 +1,99 @@
+package enclave_namespace_config
+
+import (
+	"testing"
+
+	"github.com/kurtosis-tech/kurtosis/container-engine-lib/lib/backend_interface/objects/enclave"
+	"github.com/stretchr/testify/require"
+)
+
+const (
+	testEnclaveUuid = enclave.EnclaveUUID("65d2fb6d673249b8b4a91a2f4ae616de")
+	testEnclaveName = "my-enclave"
+)
+
+func TestValidate_ValidConfig(t *testing.T) {
+	config := &EnclaveNamespaceConfig{
+		Labels:        map[string]string{"team": "platform", "example.com/enclave": "{{.EnclaveName}}"},
+		Annotations:   map[string]string{"example.com/owner": "enclave {{.EnclaveUUID}}"},
+		ResourceQuota: map[string]string{"requests.cpu": "4", "limits.memory": "8Gi", "pods": "50"},
+		LimitRange: &LimitRangeConfig{
+			Default:        map[string]string{"cpu": "500m", "memory": "512Mi"},
+			DefaultRequest: map[string]string{"cpu": "100m"},
+			Min:            nil,
+			Max:            map[string]string{"memory": "4Gi"},
+		},
+		ShouldCreateNetworkPolicy: true,
+	}
+	require.NoError(t, config.Validate())
+}
+
+func TestValidate_ReservedLabelKeyIsRejected(t *testing.T) {
+	config := &EnclaveNamespaceConfig{ // nolint: exhaustruct
+		Labels: map[string]string{"kurtosistech.com/app-id": "not-kurtosis"},
+	}
+	require.Error(t, config.Validate())
+}
+
+func TestValidate_InvalidAnnotationKeyIsRejected(t *testing.T) {
+	config := &EnclaveNamespaceConfig{ // nolint: exhaustruct
+		Annotations: map[string]string{"not a valid key": "value"},
+	}
+	require.Error(t, config.Validate())
+}
+
+func TestValidate_UnknownTemplateFieldIsRejected(t *testing.T) {
+	config := &EnclaveNamespaceConfig{ // nolint: exhaustruct
+		Labels: map[string]string{"team": "{{.ServiceName}}"},
+	}
+	require.Error(t, config.Validate())
+}
+
+func TestValidate_InvalidQuantityIsRejected(t *testing.T) {
+	quotaConfig := &EnclaveNamespaceConfig{ // nolint: exhaustruct
+		ResourceQuota: map[string]string{"requests.cpu": "four"},
+	}
+	require.Error(t, quotaConfig.Validate())
+
+	limitRangeConfig := &EnclaveNamespaceConfig{ // nolint: exhaustruct
+		LimitRange: &LimitRangeConfig{ // nolint: exhaustruct
+			Max: map[string]string{"memory": "lots"},
+		},
+	}
+	require.Error(t, limitRangeConfig.Validate())
+}
+
+func TestRenderLabels(t *testing.T) {
+	config := &EnclaveNamespaceConfig{ // nolint: exhaustruct
+		Labels: map[string]string{
+			"team":                "platform",
+			"example.com/enclave": "kt-{{.EnclaveName}}",
+			"example.com/uuid":    "{{.EnclaveShortUUID}}",
+		},
+	}
+	renderedLabels, err := config.RenderLabels(testEnclaveUuid, testEnclaveName)
+	require.NoError(t, err)
+	expectedLabels := map[string]string{
+		"team":                "platform",
+		"example.com/enclave": "kt-my-enclave",
+		"example.com/uuid":    "65d2fb6d6732",
+	}
+	require.Equal(t, expectedLabels, renderedLabels)
+}
+
+func TestRenderLabels_InvalidRenderedValueIsRejected(t *testing.T) {
+	config := &EnclaveNamespaceConfig{ // nolint: exhaustruct
+		Labels: map[string]string{"team": "{{.EnclaveName}} enclave"},
+	}
+	_, err := config.RenderLabels(testEnclaveUuid, testEnclaveName)
+	require.Error(t, err)
+}
+
+func TestRenderAnnotations(t *testing.T) {
+	config := &EnclaveNamespaceConfig{ // nolint: exhaustruct
+		Annotations: map[string]string{"example.com/description": "Enclave {{.EnclaveName}} ({{.EnclaveUUID}})"},
+	}
+	renderedAnnotations, err := config.RenderAnnotations(testEnclaveUuid, testEnclaveName)
+	require.NoError(t, err)
+	require.Equal(t, map[string]string{"example.com/description": "Enclave my-enclave (65d2fb6d673249b8b4a91a2f4ae616de)"}, renderedAnnotations)
+}
@@ -0,0 +1,148 @@
+package compute_resources_functions
+
+import (
+	"context"
+	"sort"
+
+	"github.com/kurtosis-tech/kurtosis/container-engine-lib/lib/backend_impls/kubernetes/kubernetes_manager"
+	"github.com/kurtosis-tech/kurtosis/container-engine-lib/lib/backend_interface/objects/compute_resources"
+	"github.com/kurtosis-tech/stacktrace"
+	apiv1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/api/resource"
+)
+
+const (
+	// Matches the factor used to turn the memory allocations of services into Kubernetes memory requests
+	bytesInMegabyte = 1_000_000
+
+	allNamespaces = ""
+)
+
+// GetAvailableCPUAndMemoryPerNode returns, for every node the scheduler can place pods on, the allocatable cpu & memory
+// minus what the pods already placed on the node request. Nodes cordoned or not ready are left out
+func GetAvailableCPUAndMemoryPerNode(ctx context.Context, kubernetesManager *kubernetes_manager.KubernetesManager) ([]*compute_resources.NodeResources, error) {
+	nodes, err := kubernetesManager.GetNodes(ctx)
+	if err != nil {
+		return nil, stacktrace.Propagate(err, "An error occurred getting the nodes of the cluster")
+	}
+
+	pods, err := kubernetesManager.GetPodsByLabels(ctx, allNamespaces, map[string]string{})
+	if err != nil {
+		return nil, stacktrace.Propagate(err, "An error occurred getting the pods of the cluster")
+	}
+	requestedCpuByNodeName := map[string]*resource.Quantity{}
+	requestedMemoryByNodeName := map[string]*resource.Quantity{}
+	for _, pod := range pods.Items {
+		nodeName := pod.Spec.NodeName
+		// Pods not scheduled yet don't hold anything on a node, and pods that terminated released what they requested
+		if nodeName == "" || pod.Status.Phase == apiv1.PodSucceeded || pod.Status.Phase == apiv1.PodFailed {
+			continue
+		}
+		podRequests := getPodRequests(&pod)
+		addRequest(requestedCpuByNodeName, nodeName, podRequests.Cpu())
+		addRequest(requestedMemoryByNodeName, nodeName, podRequests.Memory())
+	}
+
+	nodesResources := []*compute_resources.NodeResources{}
+	for _, node := range nodes.Items {
+		if !isNodeSchedulable(&node) {
+			continue
+		}
+		availableCpu := node.Status.Allocatable.Cpu().DeepCopy()
+		if requestedCpu, found := requestedCpuByNodeName[node.Name]; found {
+			availableCpu.Sub(*requestedCpu)
+		}
+		availableMemory := node.Status.Allocatable.Memory().DeepCopy()
+		if requestedMemory, found := requestedMemoryByNodeName[node.Name]; found {
+			availableMemory.Sub(*requestedMemory)
+		}
+		nodesResources = append(nodesResources, compute_resources.NewNodeResources(
+			node.Name,
+			node.Labels,
+			node.Spec.Taints,
+			compute_resources.CpuMilliCores(nonNegative(availableCpu.MilliValue())),
+			compute_resources.MemoryInMegaBytes(nonNegative(availableMemory.Value())/bytesInMegabyte),
+		))
+	}
+	sort.Slice(nodesResources, func(i, j int) bool {
+		return nodesResources[i].GetName() < nodesResources[j].GetName()
+	})
+	return nodesResources, nil
+}
+
+// GetAvailableCPUAndMemory returns the memory in megabytes and the cpu in millicores left on all the schedulable nodes
+// of the cluster
+func GetAvailableCPUAndMemory(ctx context.Context, kubernetesManager *kubernetes_manager.KubernetesManager) (compute_resources.MemoryInMegaBytes, compute_resources.CpuMilliCores, error) {
+	nodesResources, err := GetAvailableCPUAndMemoryPerNode(ctx, kubernetesManager)
+	if err != nil {
+		return 0, 0, stacktrace.Propagate(err, "An error occurred getting the cpu & memory available on every node")
+	}
+	var availableMemory compute_resources.MemoryInMegaBytes
+	var availableCpu compute_resources.CpuMilliCores
+	for _, nodeResources := range nodesResources {
+		availableMemory += nodeResources.GetAvailableMemoryInMegaBytes()
+		availableCpu += nodeResources.GetAvailableCpuInMilliCores()
+	}
+	return availableMemory, availableCpu, nil
+}
+
+// ====================================================================================================
+//
+//	Private Helper Functions
+//
+// ====================================================================================================
+
+// getPodRequests mirrors how the scheduler sizes a pod: init containers run one after the other before the regular
+// containers, so the pod needs the biggest of the largest init container and the sum of the regular containers, plus
+// the pod overhead
+func getPodRequests(pod *apiv1.Pod) apiv1.ResourceList {
+	requests := apiv1.ResourceList{}
+	for _, podContainer := range pod.Spec.Containers {
+		for resourceName, quantity := range podContainer.Resources.Requests {
+			total := requests[resourceName]
+			total.Add(quantity)
+			requests[resourceName] = total
+		}
+	}
+	for _, initContainer := range pod.Spec.InitContainers {
+		for resourceName, quantity := range initContainer.Resources.Requests {
+			if current, found := requests[resourceName]; !found || quantity.Cmp(current) > 0 {
+				requests[resourceName] = quantity.DeepCopy()
+			}
+		}
+	}
+	for resourceName, quantity := range pod.Spec.Overhead {
+		total := requests[resourceName]
+		total.Add(quantity)
+		requests[resourceName] = total
+	}
+	return requests
+}
+
+func addRequest(requestsByNodeName map[string]*resource.Quantity, nodeName string, quantity *resource.Quantity) {
+	total, found := requestsByNodeName[nodeName]
+	if !found {
+		total = resource.NewQuantity(0, quantity.Format)
+		requestsByNodeName[nodeName] = total
+	}
+	total.Add(*quantity)
+}
+
+func isNodeSchedulable(node *apiv1.Node) bool {
+	if node.Spec.Unschedulable {
+		return false
+	}
+	for _, condition := range node.Status.Conditions {
+		if condition.Type == apiv1.NodeReady {
+			return condition.Status == apiv1.ConditionTrue
+		}
+	}
+	return false
+}
+
+func nonNegative(value int64) int64 {
+	if value < 0 {
+		return 0
+	}
+	return value
+}
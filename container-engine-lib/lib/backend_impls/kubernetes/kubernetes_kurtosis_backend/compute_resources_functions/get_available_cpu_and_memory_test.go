@@ -0,0 +1,95 @@
+package compute_resources_functions
+
+import (
+	"context"
+	"testing"
+
+	"github.com/kurtosis-tech/kurtosis/container-engine-lib/lib/backend_impls/kubernetes/kubernetes_manager"
+	"github.com/kurtosis-tech/kurtosis/container-engine-lib/lib/backend_interface/objects/compute_resources"
+	"github.com/stretchr/testify/require"
+	apiv1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/api/resource"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/client-go/kubernetes/fake"
+)
+
+func TestGetAvailableCPUAndMemoryPerNode(t *testing.T) {
+	cordonedNode := newTestNode("node-c", "4", "8G", true)
+	cordonedNode.Spec.Unschedulable = true
+	notReadyNode := newTestNode("node-d", "4", "8G", false)
+
+	runningPod := newTestPod("running", "node-a", apiv1.PodRunning, "1500m", "3G")
+	runningPod.Spec.InitContainers = []apiv1.Container{newTestContainer("2", "1G")}
+	// nolint: exhaustruct
+	clientSet := fake.NewSimpleClientset(
+		newTestNode("node-b", "2", "4G", true),
+		newTestNode("node-a", "4", "8G", true),
+		cordonedNode,
+		notReadyNode,
+		runningPod,
+		newTestPod("other-namespace", "node-a", apiv1.PodRunning, "500m", "1G"),
+		newTestPod("succeeded", "node-a", apiv1.PodSucceeded, "4", "8G"),
+		newTestPod("pending", "", apiv1.PodPending, "4", "8G"),
+	)
+	kubernetesManager := kubernetes_manager.NewKubernetesManager(clientSet, nil, "")
+
+	nodesResources, err := GetAvailableCPUAndMemoryPerNode(context.Background(), kubernetesManager)
+	require.NoError(t, err)
+	require.Len(t, nodesResources, 2)
+
+	// the init container requests more cpu than the regular container of the running pod
+	require.Equal(t, "node-a", nodesResources[0].GetName())
+	require.Equal(t, compute_resources.CpuMilliCores(1500), nodesResources[0].GetAvailableCpuInMilliCores())
+	require.Equal(t, compute_resources.MemoryInMegaBytes(4000), nodesResources[0].GetAvailableMemoryInMegaBytes())
+
+	require.Equal(t, "node-b", nodesResources[1].GetName())
+	require.Equal(t, compute_resources.CpuMilliCores(2000), nodesResources[1].GetAvailableCpuInMilliCores())
+	require.Equal(t, compute_resources.MemoryInMegaBytes(4000), nodesResources[1].GetAvailableMemoryInMegaBytes())
+
+	availableMemory, availableCpu, err := GetAvailableCPUAndMemory(context.Background(), kubernetesManager)
+	require.NoError(t, err)
+	require.Equal(t, compute_resources.CpuMilliCores(3500), availableCpu)
+	require.Equal(t, compute_resources.MemoryInMegaBytes(8000), availableMemory)
+}
+
+func newTestNode(name string, cpu string, memory string, isReady bool) *apiv1.Node {
+	readyStatus := apiv1.ConditionFalse
+	if isReady {
+		readyStatus = apiv1.ConditionTrue
+	}
+	// nolint: exhaustruct
+	return &apiv1.Node{
+		ObjectMeta: metav1.ObjectMeta{Name: name},
+		Status: apiv1.NodeStatus{
+			Allocatable: apiv1.ResourceList{
+				apiv1.ResourceCPU:    resource.MustParse(cpu),
+				apiv1.ResourceMemory: resource.MustParse(memory),
+			},
+			Conditions: []apiv1.NodeCondition{{Type: apiv1.NodeReady, Status: readyStatus}},
+		},
+	}
+}
+
+func newTestPod(name string, nodeName string, phase apiv1.PodPhase, cpu string, memory string) *apiv1.Pod {
+	// nolint: exhaustruct
+	return &apiv1.Pod{
+		ObjectMeta: metav1.ObjectMeta{Name: name, Namespace: name},
+		Spec: apiv1.PodSpec{
+			NodeName:   nodeName,
+			Containers: []apiv1.Container{newTestContainer(cpu, memory)},
+		},
+		Status: apiv1.PodStatus{Phase: phase},
+	}
+}
+
+func newTestContainer(cpu string, memory string) apiv1.Container {
+	// nolint: exhaustruct
+	return apiv1.Container{
+		Resources: apiv1.ResourceRequirements{
+			Requests: apiv1.ResourceList{
+				apiv1.ResourceCPU:    resource.MustParse(cpu),
+				apiv1.ResourceMemory: resource.MustParse(memory),
+			},
+		},
+	}
+}
@@ -0,0 +1,157 @@
+package kubernetes_kurtosis_backend
+
+import (
+	"context"
+	"encoding/json"
+	"os"
+	"path"
+	"testing"
+
+	"github.com/kurtosis-tech/kurtosis/container-engine-lib/lib/backend_impls/kubernetes/kubernetes_manager"
+	"github.com/kurtosis-tech/kurtosis/container-engine-lib/lib/backend_impls/kubernetes/object_attributes_provider/kubernetes_annotation_key_consts"
+	"github.com/kurtosis-tech/kurtosis/container-engine-lib/lib/backend_impls/kubernetes/object_attributes_provider/kubernetes_label_key"
+	"github.com/kurtosis-tech/kurtosis/container-engine-lib/lib/backend_impls/kubernetes/object_attributes_provider/label_value_consts"
+	"github.com/stretchr/testify/require"
+	appsv1 "k8s.io/api/apps/v1"
+	apiv1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/client-go/kubernetes/fake"
+)
+
+const (
+	testEngineGuid         = "engine-guid"
+	testEngineNamespace    = "kurtosis-engine-engine-guid"
+	testEnclaveUuid        = "3a7e9e9c2f2d4d0bb2f4b7b4e3c4a1f0"
+	testEnclaveName        = "test-enclave"
+	testEnclaveNamespace   = "kt-test-enclave"
+	testAggregatorNs       = "kurtosis-logs-aggregator"
+	testApicPodName        = "kurtosis-api"
+	testUserServicePodName = "service-a"
+	testSidecarName        = "sidecar"
+	testEventName          = "service-a.pull-failed"
+)
+
+func TestDumpKurtosis(t *testing.T) {
+	clientSet := fake.NewSimpleClientset(getTestClusterObjects()...)
+	backend := NewCLIModeKubernetesKurtosisBackend(kubernetes_manager.NewKubernetesManager(clientSet, nil, ""), anyNodeEngineNodeName)
+
+	outputDirpath := path.Join(t.TempDir(), "dump")
+	require.NoError(t, backend.DumpKurtosis(context.Background(), outputDirpath))
+
+	enginePodDirpath := path.Join(outputDirpath, "engines", "kurtosis-engine")
+	require.FileExists(t, path.Join(enginePodDirpath, "spec.json"))
+	require.FileExists(t, path.Join(enginePodDirpath, "pod.json"))
+	require.FileExists(t, path.Join(enginePodDirpath, "kurtosis-engine.log"))
+	require.FileExists(t, path.Join(outputDirpath, "engines", "events.json"))
+
+	require.FileExists(t, path.Join(outputDirpath, "logs-aggregator", "logs-aggregator", "vector.log"))
+	// No logs collector was created in this cluster, so there's nothing to dump
+	require.NoDirExists(t, path.Join(outputDirpath, "logs-collector"))
+
+	enclaveDirpath := path.Join(outputDirpath, "enclaves", testEnclaveName+"--"+testEnclaveUuid)
+	require.FileExists(t, path.Join(enclaveDirpath, testApicPodName, kurtosisApiContainerContainerName+".log"))
+	require.FileExists(t, path.Join(enclaveDirpath, testUserServicePodName, "user-service.log"))
+	require.FileExists(t, path.Join(enclaveDirpath, testUserServicePodName, testSidecarName+".log"))
+	// The API container isn't running so its enclave data volume can't be copied
+	require.NoDirExists(t, path.Join(enclaveDirpath, enclaveDataVolumeDumpDirname))
+
+	podSpecBytes, err := os.ReadFile(path.Join(enclaveDirpath, testUserServicePodName, "spec.json"))
+	require.NoError(t, err)
+	dumpedPodSpec := &apiv1.PodSpec{}
+	require.NoError(t, json.Unmarshal(podSpecBytes, dumpedPodSpec))
+	require.Len(t, dumpedPodSpec.Containers, 2)
+
+	podBytes, err := os.ReadFile(path.Join(enclaveDirpath, testUserServicePodName, "pod.json"))
+	require.NoError(t, err)
+	dumpedPod := &apiv1.Pod{}
+	require.NoError(t, json.Unmarshal(podBytes, dumpedPod))
+	require.Equal(t, testUserServicePodName, dumpedPod.Name)
+	require.Equal(t, apiv1.PodRunning, dumpedPod.Status.Phase)
+
+	eventsBytes, err := os.ReadFile(path.Join(enclaveDirpath, "events.json"))
+	require.NoError(t, err)
+	var dumpedEvents []apiv1.Event
+	require.NoError(t, json.Unmarshal(eventsBytes, &dumpedEvents))
+	require.Len(t, dumpedEvents, 1)
+	require.Equal(t, testEventName, dumpedEvents[0].Name)
+}
+
+func TestDumpKurtosis_FailsIfOutputDirExists(t *testing.T) {
+	clientSet := fake.NewSimpleClientset(getTestClusterObjects()...)
+	backend := NewCLIModeKubernetesKurtosisBackend(kubernetes_manager.NewKubernetesManager(clientSet, nil, ""), anyNodeEngineNodeName)
+
+	require.Error(t, backend.DumpKurtosis(context.Background(), t.TempDir()))
+}
+
+func getTestClusterObjects() []runtime.Object {
+	appIdLabelKey := kubernetes_label_key.AppIDKubernetesLabelKey.GetString()
+	appIdLabelValue := label_value_consts.AppIDKubernetesLabelValue.GetString()
+	resourceTypeLabelKey := kubernetes_label_key.KurtosisResourceTypeKubernetesLabelKey.GetString()
+
+	engineLabels := map[string]string{
+		appIdLabelKey:        appIdLabelValue,
+		resourceTypeLabelKey: label_value_consts.EngineKurtosisResourceTypeKubernetesLabelValue.GetString(),
+		kubernetes_label_key.IDKubernetesLabelKey.GetString(): testEngineGuid,
+	}
+	aggregatorLabels := map[string]string{
+		appIdLabelKey:        appIdLabelValue,
+		resourceTypeLabelKey: label_value_consts.LogsAggregatorKurtosisResourceTypeKubernetesLabelValue.GetString(),
+	}
+	enclaveLabels := map[string]string{
+		appIdLabelKey:        appIdLabelValue,
+		resourceTypeLabelKey: label_value_consts.EnclaveKurtosisResourceTypeKubernetesLabelValue.GetString(),
+		kubernetes_label_key.EnclaveUUIDKubernetesLabelKey.GetString(): testEnclaveUuid,
+	}
+	apiContainerLabels := map[string]string{
+		appIdLabelKey:        appIdLabelValue,
+		resourceTypeLabelKey: label_value_consts.APIContainerKurtosisResourceTypeKubernetesLabelValue.GetString(),
+		kubernetes_label_key.EnclaveUUIDKubernetesLabelKey.GetString(): testEnclaveUuid,
+	}
+	userServiceLabels := map[string]string{
+		appIdLabelKey: appIdLabelValue,
+		kubernetes_label_key.EnclaveUUIDKubernetesLabelKey.GetString(): testEnclaveUuid,
+	}
+
+	// nolint: exhaustruct
+	return []runtime.Object{
+		&apiv1.Namespace{ObjectMeta: metav1.ObjectMeta{Name: testEngineNamespace, Labels: engineLabels}},
+		newTestPod(testEngineNamespace, "kurtosis-engine", engineLabels, apiv1.PodRunning, "kurtosis-engine"),
+
+		&apiv1.Namespace{ObjectMeta: metav1.ObjectMeta{Name: testAggregatorNs, Labels: aggregatorLabels}},
+		&appsv1.Deployment{
+			ObjectMeta: metav1.ObjectMeta{Name: "logs-aggregator", Namespace: testAggregatorNs, Labels: aggregatorLabels},
+			Spec:       appsv1.DeploymentSpec{Selector: &metav1.LabelSelector{MatchLabels: aggregatorLabels}},
+		},
+		newTestPod(testAggregatorNs, "logs-aggregator", aggregatorLabels, apiv1.PodRunning, "vector"),
+
+		&apiv1.Namespace{
+			ObjectMeta: metav1.ObjectMeta{
+				Name:        testEnclaveNamespace,
+				Labels:      enclaveLabels,
+				Annotations: map[string]string{kubernetes_annotation_key_consts.EnclaveNameAnnotationKey.GetString(): testEnclaveName},
+			},
+		},
+		newTestPod(testEnclaveNamespace, testApicPodName, apiContainerLabels, apiv1.PodPending, kurtosisApiContainerContainerName),
+		newTestPod(testEnclaveNamespace, testUserServicePodName, userServiceLabels, apiv1.PodRunning, "user-service", testSidecarName),
+		&apiv1.Event{
+			ObjectMeta:     metav1.ObjectMeta{Name: testEventName, Namespace: testEnclaveNamespace},
+			InvolvedObject: apiv1.ObjectReference{Kind: "Pod", Name: testUserServicePodName, Namespace: testEnclaveNamespace},
+			Reason:         "Failed",
+			Message:        "Failed to pull image",
+		},
+	}
+}
+
+// nolint: exhaustruct
+func newTestPod(namespace string, name string, labels map[string]string, phase apiv1.PodPhase, containerNames ...string) *apiv1.Pod {
+	containers := []apiv1.Container{}
+	for _, containerName := range containerNames {
+		containers = append(containers, apiv1.Container{Name: containerName})
+	}
+	return &apiv1.Pod{
+		ObjectMeta: metav1.ObjectMeta{Name: name, Namespace: namespace, Labels: labels},
+		Spec:       apiv1.PodSpec{Containers: containers},
+		Status:     apiv1.PodStatus{Phase: phase},
+	}
+}
@@ -9,6 +9,7 @@ import (
 	"github.com/kurtosis-tech/kurtosis/container-engine-lib/lib/backend_impls/kubernetes/kubernetes_kurtosis_backend/logs_aggregator_functions/implementations/vector"
 	"github.com/kurtosis-tech/kurtosis/container-engine-lib/lib/backend_impls/kubernetes/kubernetes_kurtosis_backend/logs_collector_functions"
 	"github.com/kurtosis-tech/kurtosis/container-engine-lib/lib/backend_impls/kubernetes/kubernetes_kurtosis_backend/logs_collector_functions/implementations/fluentbit"
+	"github.com/kurtosis-tech/kurtosis/container-engine-lib/lib/backend_impls/kubernetes/kubernetes_kurtosis_backend/reverse_proxy_functions"
 	"github.com/kurtosis-tech/kurtosis/container-engine-lib/lib/backend_interface/objects/logs_aggregator"
 	"github.com/kurtosis-tech/kurtosis/container-engine-lib/lib/backend_interface/objects/logs_collector"
 
@@ -328,6 +329,20 @@ func CreateEngine(
 	}()
 	logrus.Infof("Centralized logs components started.")
 
+	logrus.Infof("Starting the reverse proxy...")
+	_, removeReverseProxyFunc, err := reverse_proxy_functions.CreateReverseProxy(ctx, engineGuid, objAttrsProvider, kubernetesManager)
+	if err != nil {
+		return nil, stacktrace.Propagate(err, "An error occurred creating the reverse proxy")
+	}
+	var shouldRemoveReverseProxy = true
+	defer func() {
+		if shouldRemoveReverseProxy {
+			removeReverseProxyFunc()
+		}
+	}()
+	logrus.Infof("Reverse proxy started.")
+
+	shouldRemoveReverseProxy = false
 	shouldRemoveLogsCollector = false
 	shouldRemoveEngineNodeSelectors = false
 	shouldRemoveLogsAggregator = false
@@ -441,6 +456,9 @@ func createEngineClusterRole(
 				kubernetes_manager_consts.DaemonSetsKubernetesResource,
 				kubernetes_manager_consts.DeploymentsKubernetesResource,
 				kubernetes_manager_consts.DeploymentsScaleKubernetesResource,
+				kubernetes_manager_consts.ResourceQuotasKubernetesResource, // Applied to enclave namespaces when configured
+				kubernetes_manager_consts.LimitRangesKubernetesResource,
+				kubernetes_manager_consts.NetworkPoliciesKubernetesResource,
 			},
 		},
 		{
@@ -0,0 +1,91 @@
+package engine_functions
+
+import (
+	"context"
+	"fmt"
+	"os"
+	"path"
+	"strings"
+
+	"github.com/kurtosis-tech/kurtosis/container-engine-lib/lib/backend_impls/kubernetes/kubernetes_kurtosis_backend/logs_aggregator_functions"
+	"github.com/kurtosis-tech/kurtosis/container-engine-lib/lib/backend_impls/kubernetes/kubernetes_kurtosis_backend/logs_collector_functions"
+	"github.com/kurtosis-tech/kurtosis/container-engine-lib/lib/backend_impls/kubernetes/kubernetes_manager"
+	"github.com/kurtosis-tech/kurtosis/container-engine-lib/lib/backend_interface"
+	"github.com/kurtosis-tech/kurtosis/container-engine-lib/lib/backend_interface/objects/enclave"
+	"github.com/kurtosis-tech/stacktrace"
+)
+
+const (
+	engineLogsSubDirpathFragment     = "engines"
+	logsAggregatorSubDirpathFragment = "logs-aggregator"
+	logsCollectorSubDirpathFragment  = "logs-collector"
+	enclavesSubDirpathFragment       = "enclaves"
+	createdDirPerms                  = 0755
+	enclaveNameUuidSeparator         = "--"
+	errorSeparator                   = "\n\n"
+)
+
+var allEnclavesFilter = &enclave.EnclaveFilters{UUIDs: nil, Statuses: nil}
+
+// DumpKurtosis dumps the engine, the logs aggregator, the logs collector and every enclave of the cluster into the
+// received output dirpath, using the same layout as the Docker backend
+func DumpKurtosis(
+	ctx context.Context,
+	outputDirpath string,
+	backend backend_interface.KurtosisBackend,
+	kubernetesManager *kubernetes_manager.KubernetesManager,
+) error {
+	allEnclaves, err := backend.GetEnclaves(ctx, allEnclavesFilter)
+	if err != nil {
+		return stacktrace.Propagate(err, "An error occurred while getting a list of enclaves registered with the underlying engine")
+	}
+
+	// Note os.IsNotExist doesn't throw if the err is nil
+	if _, err = os.Stat(outputDirpath); !os.IsNotExist(err) {
+		return stacktrace.NewError("Cannot create output directory at '%v'; directory already exists", outputDirpath)
+	}
+	if err = os.Mkdir(outputDirpath, createdDirPerms); err != nil {
+		return stacktrace.Propagate(err, "An error occurred creating output directory at '%v'", outputDirpath)
+	}
+
+	engineOutputDir := path.Join(outputDirpath, engineLogsSubDirpathFragment)
+	if err = backend.GetEngineLogs(ctx, engineOutputDir); err != nil {
+		return stacktrace.Propagate(err, "An error occurred while dumping engine logs to dir '%v'", engineOutputDir)
+	}
+
+	logsAggregatorOutputDir := path.Join(outputDirpath, logsAggregatorSubDirpathFragment)
+	if err = logs_aggregator_functions.DumpLogsAggregator(ctx, logsAggregatorOutputDir, kubernetesManager); err != nil {
+		return stacktrace.Propagate(err, "An error occurred while dumping the logs aggregator to dir '%v'", logsAggregatorOutputDir)
+	}
+
+	logsCollectorOutputDir := path.Join(outputDirpath, logsCollectorSubDirpathFragment)
+	if err = logs_collector_functions.DumpLogsCollector(ctx, logsCollectorOutputDir, kubernetesManager); err != nil {
+		return stacktrace.Propagate(err, "An error occurred while dumping the logs collector to dir '%v'", logsCollectorOutputDir)
+	}
+
+	allEnclavesOutputSubdir := path.Join(outputDirpath, enclavesSubDirpathFragment)
+	if err = os.Mkdir(allEnclavesOutputSubdir, createdDirPerms); err != nil {
+		return stacktrace.Propagate(err, "An error occurred creating output directory for all enclaves at '%v'", allEnclavesOutputSubdir)
+	}
+
+	allEnclaveDumpErrors := map[string]string{}
+	for enclaveUuid, enclave := range allEnclaves {
+		subDirForEnclaveBeingDumped := fmt.Sprintf("%v%v%v", enclave.GetName(), enclaveNameUuidSeparator, string(enclaveUuid))
+		specificEnclaveOutputDir := path.Join(allEnclavesOutputSubdir, subDirForEnclaveBeingDumped)
+		if err = backend.DumpEnclave(ctx, enclaveUuid, specificEnclaveOutputDir); err != nil {
+			allEnclaveDumpErrors[string(enclaveUuid)] = err.Error()
+		}
+	}
+
+	if len(allEnclaveDumpErrors) > 0 {
+		allIndexedEnclaveErrors := []string{}
+		for enclaveUuidStr, errStr := range allEnclaveDumpErrors {
+			indexedEnclaveErrorStr := fmt.Sprintf(">>>>>>>>>>>>>>>>> ERROR dumping enclave with UUID '%v' <<<<<<<<<<<<<<<<<\n%v", enclaveUuidStr, errStr)
+			allIndexedEnclaveErrors = append(allIndexedEnclaveErrors, indexedEnclaveErrorStr)
+		}
+
+		return fmt.Errorf("errors occurred while dumping information for some enclaves :\n'%v'", strings.Join(allIndexedEnclaveErrors, errorSeparator))
+	}
+
+	return nil
+}
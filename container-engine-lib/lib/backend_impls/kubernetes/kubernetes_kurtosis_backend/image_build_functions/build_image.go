@@ -0,0 +1,98 @@
+package image_build_functions
+
+import (
+	"context"
+	"fmt"
+	"path"
+	"sort"
+
+	"github.com/kurtosis-tech/kurtosis/container-engine-lib/lib/backend_impls/kubernetes/kubernetes_kurtosis_backend/shared_helpers"
+	"github.com/kurtosis-tech/kurtosis/container-engine-lib/lib/backend_impls/kubernetes/kubernetes_manager"
+	"github.com/kurtosis-tech/kurtosis/container-engine-lib/lib/backend_interface/objects/image_build_spec"
+	"github.com/kurtosis-tech/stacktrace"
+	"github.com/sirupsen/logrus"
+)
+
+const (
+	// The debug flavour of the Kaniko image is the only one shipping a shell, which the builder pod needs
+	kanikoImage       = "gcr.io/kaniko-project/executor:v1.23.2-debug"
+	kanikoShellBinary = "/busybox/sh"
+	kanikoBuildScript = "exec /kaniko/executor \"$@\""
+
+	// Kaniko writes the digest of the pushed image there, which makes it the builder's termination message
+	kanikoDigestFilepath = "/dev/termination-log"
+)
+
+// BuildImage builds the image described by the spec with Kaniko in a pod of the enclave namespace, pushes it to the
+// image build registry and returns the reference pods must use to pull it
+func BuildImage(
+	ctx context.Context,
+	imageName string,
+	imageBuildSpec *image_build_spec.ImageBuildSpec,
+	apiContainerModeArgs *shared_helpers.ApiContainerModeArgs,
+	kubernetesManager *kubernetes_manager.KubernetesManager,
+) (string, error) {
+	registry, err := getImageRegistry(ctx, apiContainerModeArgs, kubernetesManager)
+	if err != nil {
+		return "", stacktrace.Propagate(err, "An error occurred getting the registry to push image '%v' to", imageName)
+	}
+
+	kanikoArgs, err := getKanikoArgs(imageBuildSpec, registry.getPushReference(imageName), registry.isPlainHttp)
+	if err != nil {
+		return "", stacktrace.Propagate(err, "An error occurred getting the Kaniko arguments to build image '%v'", imageName)
+	}
+
+	logrus.Debugf("Building image '%v' in the cluster with Kaniko args '%v'", imageName, kanikoArgs)
+	digest, err := runBuilderPod(
+		ctx,
+		&builderPodSpec{
+			image:       kanikoImage,
+			shellBinary: kanikoShellBinary,
+			buildScript: kanikoBuildScript,
+			buildArgs:   kanikoArgs,
+		},
+		imageBuildSpec.GetBuildContextDir(),
+		apiContainerModeArgs,
+		kubernetesManager,
+	)
+	if err != nil {
+		return "", stacktrace.Propagate(err, "An error occurred building image '%v' in the cluster", imageName)
+	}
+
+	return registry.getPullReference(imageName, digest), nil
+}
+
+func getKanikoArgs(imageBuildSpec *image_build_spec.ImageBuildSpec, destination string, isDestinationPlainHttp bool) ([]string, error) {
+	containerImageFilepath := path.Join(buildContextDirpath, imageBuildSpec.GetBuildFile())
+	if imageBuildSpec.GetBuildFile() == "" {
+		var err error
+		containerImageFilepath, err = getPathInBuildContext(imageBuildSpec.GetBuildContextDir(), imageBuildSpec.GetContainerImageFilePath())
+		if err != nil {
+			return nil, stacktrace.Propagate(err, "An error occurred locating the container image file in the build context")
+		}
+	}
+
+	kanikoArgs := []string{
+		fmt.Sprintf("--context=dir://%v", buildContextDirpath),
+		fmt.Sprintf("--dockerfile=%v", containerImageFilepath),
+		fmt.Sprintf("--destination=%v", destination),
+		fmt.Sprintf("--digest-file=%v", kanikoDigestFilepath),
+	}
+	if isDestinationPlainHttp {
+		kanikoArgs = append(kanikoArgs, "--insecure", "--skip-tls-verify")
+	}
+	if targetStage := imageBuildSpec.GetTargetStage(); targetStage != "" {
+		kanikoArgs = append(kanikoArgs, fmt.Sprintf("--target=%v", targetStage))
+	}
+
+	buildArgs := imageBuildSpec.GetBuildArgs()
+	buildArgNames := []string{}
+	for buildArgName := range buildArgs {
+		buildArgNames = append(buildArgNames, buildArgName)
+	}
+	sort.Strings(buildArgNames)
+	for _, buildArgName := range buildArgNames {
+		kanikoArgs = append(kanikoArgs, fmt.Sprintf("--build-arg=%v=%v", buildArgName, buildArgs[buildArgName]))
+	}
+	return kanikoArgs, nil
+}
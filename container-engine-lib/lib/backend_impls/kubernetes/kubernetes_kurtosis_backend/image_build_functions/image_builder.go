@@ -0,0 +1,332 @@
+package image_build_functions
+
+import (
+	"archive/tar"
+	"bytes"
+	"context"
+	"fmt"
+	"io"
+	"os"
+	"path/filepath"
+	"strings"
+	"time"
+
+	"github.com/kurtosis-tech/kurtosis/container-engine-lib/lib/backend_impls/kubernetes/kubernetes_kurtosis_backend/shared_helpers"
+	"github.com/kurtosis-tech/kurtosis/container-engine-lib/lib/backend_impls/kubernetes/kubernetes_manager"
+	"github.com/kurtosis-tech/kurtosis/container-engine-lib/lib/backend_impls/kubernetes/object_attributes_provider"
+	"github.com/kurtosis-tech/kurtosis/container-engine-lib/lib/uuid_generator"
+	"github.com/kurtosis-tech/stacktrace"
+	"github.com/sirupsen/logrus"
+	apiv1 "k8s.io/api/core/v1"
+)
+
+const (
+	builderContainerName = "image-builder"
+
+	workspaceVolumeName        = "workspace"
+	workspaceDirpath           = "/workspace"
+	buildContextDirpath        = workspaceDirpath + "/context"
+	buildContextReadyFilepath  = workspaceDirpath + "/.context-ready"
+	isWorkspaceVolumeReadOnly  = false
+	buildContextExecSuccessful = 0
+
+	// The builder container blocks on this until the build context has been streamed into the workspace volume
+	waitForBuildContextScript = "while [ ! -f " + buildContextReadyFilepath + " ]; do sleep 1; done; "
+
+	receiveBuildContextScript = "mkdir -p " + buildContextDirpath +
+		" && tar xf - -C " + buildContextDirpath +
+		" && touch " + buildContextReadyFilepath
+
+	// Builds can legitimately take a long time, e.g. when compiling a whole toolchain
+	builderPodCompletionTimeout          = 1 * time.Hour
+	builderPodCompletionTimeBetweenPolls = 1 * time.Second
+
+	// Only the tail of the logs is surfaced in the error so that a failing build doesn't flood the run output
+	maxBuilderLogLinesInError = 50
+
+	shouldFollowBuilderLogs       = false
+	shouldAddTimestampsToBuildLog = false
+)
+
+// builderPodSpec describes the build that runs inside the builder pod, once the build context has been streamed in
+type builderPodSpec struct {
+	image string
+
+	// The shell binary inside the builder image, used both to wait for the context and to receive it
+	shellBinary string
+
+	// The script run once the context is ready; the build args are available to it as positional params ("$@")
+	buildScript string
+
+	buildArgs []string
+}
+
+// runBuilderPod starts a pod in the API container's namespace running the given builder, streams the build context dir
+// from the API container into it, waits for the build to finish and returns the builder's termination message
+func runBuilderPod(
+	ctx context.Context,
+	spec *builderPodSpec,
+	buildContextDirpathOnDisk string,
+	apiContainerModeArgs *shared_helpers.ApiContainerModeArgs,
+	kubernetesManager *kubernetes_manager.KubernetesManager,
+) (string, error) {
+	namespaceName := apiContainerModeArgs.GetOwnNamespaceName()
+
+	buildUuid, err := uuid_generator.GenerateUUIDString()
+	if err != nil {
+		return "", stacktrace.Propagate(err, "An error occurred generating a UUID for the image build")
+	}
+
+	enclaveObjAttributesProvider := object_attributes_provider.GetKubernetesObjectAttributesProvider().ForEnclave(apiContainerModeArgs.GetOwnEnclaveId())
+	podAttributes, err := enclaveObjAttributesProvider.ForImageBuilderPod(buildUuid)
+	if err != nil {
+		return "", stacktrace.Propagate(err, "An error occurred getting the image builder pod attributes for build '%v'", buildUuid)
+	}
+	podName := podAttributes.GetName().GetString()
+	podLabelStrs := shared_helpers.GetStringMapFromLabelMap(podAttributes.GetLabels())
+	podAnnotationStrs := shared_helpers.GetStringMapFromAnnotationMap(podAttributes.GetAnnotations())
+
+	pod, err := kubernetesManager.CreatePod(
+		ctx,
+		namespaceName,
+		podName,
+		podLabelStrs,
+		podAnnotationStrs,
+		nil,
+		getBuilderPodContainers(spec),
+		getBuilderPodVolumes(),
+		"",
+		apiv1.RestartPolicyNever,
+		nil,
+		nil,
+	)
+	if err != nil {
+		return "", stacktrace.Propagate(err, "An error occurred creating image builder pod '%v' in namespace '%v'", podName, namespaceName)
+	}
+	defer func() {
+		if err := kubernetesManager.RemovePod(context.Background(), pod); err != nil {
+			logrus.Warnf("An error occurred removing image builder pod '%v' in namespace '%v'; you'll need to remove it manually:\n%v", podName, namespaceName, err)
+		}
+	}()
+
+	if err := streamBuildContext(ctx, namespaceName, podName, spec.shellBinary, buildContextDirpathOnDisk, kubernetesManager); err != nil {
+		return "", stacktrace.Propagate(err, "An error occurred streaming build context '%v' to image builder pod '%v'", buildContextDirpathOnDisk, podName)
+	}
+
+	finishedPod, err := waitForBuilderPodCompletion(ctx, namespaceName, podName, kubernetesManager)
+	if err != nil {
+		return "", stacktrace.Propagate(err, "An error occurred waiting for image builder pod '%v' to finish", podName)
+	}
+
+	if finishedPod.Status.Phase == apiv1.PodFailed {
+		return "", stacktrace.NewError(
+			"The image build in pod '%v' failed; the last lines of the builder logs were:\n%v",
+			podName,
+			getBuilderLogsTail(ctx, namespaceName, podName, kubernetesManager),
+		)
+	}
+
+	return getBuilderTerminationMessage(finishedPod), nil
+}
+
+func getBuilderPodContainers(spec *builderPodSpec) []apiv1.Container {
+	command := []string{spec.shellBinary, "-c", waitForBuildContextScript + spec.buildScript, spec.shellBinary}
+	command = append(command, spec.buildArgs...)
+
+	// nolint: exhaustruct
+	return []apiv1.Container{
+		{
+			Name:    builderContainerName,
+			Image:   spec.image,
+			Command: command,
+			VolumeMounts: []apiv1.VolumeMount{
+				{
+					Name:             workspaceVolumeName,
+					ReadOnly:         isWorkspaceVolumeReadOnly,
+					MountPath:        workspaceDirpath,
+					SubPath:          "",
+					MountPropagation: nil,
+					SubPathExpr:      "",
+				},
+			},
+			TerminationMessagePolicy: apiv1.TerminationMessageReadFile,
+			ImagePullPolicy:          apiv1.PullIfNotPresent,
+		},
+	}
+}
+
+func getBuilderPodVolumes() []apiv1.Volume {
+	return []apiv1.Volume{
+		{
+			Name: workspaceVolumeName,
+			// nolint: exhaustruct
+			VolumeSource: apiv1.VolumeSource{
+				EmptyDir: &apiv1.EmptyDirVolumeSource{
+					Medium:    "",
+					SizeLimit: nil,
+				},
+			},
+		},
+	}
+}
+
+func streamBuildContext(
+	ctx context.Context,
+	namespaceName string,
+	podName string,
+	shellBinary string,
+	buildContextDirpathOnDisk string,
+	kubernetesManager *kubernetes_manager.KubernetesManager,
+) error {
+	tarReader, tarWriter := io.Pipe()
+	go func() {
+		tarWriter.CloseWithError(writeBuildContextTar(buildContextDirpathOnDisk, tarWriter))
+	}()
+	defer tarReader.Close()
+
+	outputBuffer := &bytes.Buffer{}
+	exitCode, err := kubernetesManager.RunExecCommandWithStdIn(
+		ctx,
+		namespaceName,
+		podName,
+		builderContainerName,
+		[]string{shellBinary, "-c", receiveBuildContextScript},
+		tarReader,
+		outputBuffer,
+		outputBuffer,
+	)
+	if err != nil {
+		return stacktrace.Propagate(err, "An error occurred running the command receiving the build context in pod '%v'", podName)
+	}
+	if exitCode != buildContextExecSuccessful {
+		return stacktrace.NewError(
+			"Receiving the build context in pod '%v' exited with code '%v' and output:\n%v",
+			podName,
+			exitCode,
+			outputBuffer.String(),
+		)
+	}
+	return nil
+}
+
+// writeBuildContextTar writes the content of the build context dir as an uncompressed tarball, with paths relative
+// to the context dir
+func writeBuildContextTar(buildContextDirpathOnDisk string, writer io.Writer) error {
+	tarWriter := tar.NewWriter(writer)
+	walkErr := filepath.Walk(buildContextDirpathOnDisk, func(filepathOnDisk string, fileInfo os.FileInfo, err error) error {
+		if err != nil {
+			return err
+		}
+		relativeFilepath, err := filepath.Rel(buildContextDirpathOnDisk, filepathOnDisk)
+		if err != nil {
+			return stacktrace.Propagate(err, "An error occurred getting the path of '%v' relative to the build context", filepathOnDisk)
+		}
+		if relativeFilepath == "." {
+			return nil
+		}
+
+		symlinkTarget := ""
+		if fileInfo.Mode()&os.ModeSymlink != 0 {
+			if symlinkTarget, err = os.Readlink(filepathOnDisk); err != nil {
+				return stacktrace.Propagate(err, "An error occurred reading symlink '%v'", filepathOnDisk)
+			}
+		}
+		header, err := tar.FileInfoHeader(fileInfo, symlinkTarget)
+		if err != nil {
+			return stacktrace.Propagate(err, "An error occurred creating the tar header for '%v'", filepathOnDisk)
+		}
+		header.Name = filepath.ToSlash(relativeFilepath)
+		if err := tarWriter.WriteHeader(header); err != nil {
+			return stacktrace.Propagate(err, "An error occurred writing the tar header for '%v'", filepathOnDisk)
+		}
+
+		if !fileInfo.Mode().IsRegular() {
+			return nil
+		}
+		file, err := os.Open(filepathOnDisk)
+		if err != nil {
+			return stacktrace.Propagate(err, "An error occurred opening '%v'", filepathOnDisk)
+		}
+		defer file.Close()
+		if _, err := io.Copy(tarWriter, file); err != nil {
+			return stacktrace.Propagate(err, "An error occurred writing '%v' to the build context tarball", filepathOnDisk)
+		}
+		return nil
+	})
+	if walkErr != nil {
+		return stacktrace.Propagate(walkErr, "An error occurred walking build context '%v'", buildContextDirpathOnDisk)
+	}
+	if err := tarWriter.Close(); err != nil {
+		return stacktrace.Propagate(err, "An error occurred closing the build context tarball")
+	}
+	return nil
+}
+
+func waitForBuilderPodCompletion(
+	ctx context.Context,
+	namespaceName string,
+	podName string,
+	kubernetesManager *kubernetes_manager.KubernetesManager,
+) (*apiv1.Pod, error) {
+	deadline := time.Now().Add(builderPodCompletionTimeout)
+	for time.Now().Before(deadline) {
+		pod, err := kubernetesManager.GetPod(ctx, namespaceName, podName)
+		if err != nil {
+			return nil, stacktrace.Propagate(err, "An error occurred getting image builder pod '%v'", podName)
+		}
+		if pod.Status.Phase == apiv1.PodSucceeded || pod.Status.Phase == apiv1.PodFailed {
+			return pod, nil
+		}
+		select {
+		case <-ctx.Done():
+			return nil, stacktrace.Propagate(ctx.Err(), "The context was cancelled while waiting for image builder pod '%v'", podName)
+		case <-time.After(builderPodCompletionTimeBetweenPolls):
+		}
+	}
+	return nil, stacktrace.NewError("Image builder pod '%v' didn't finish after %v", podName, builderPodCompletionTimeout)
+}
+
+func getBuilderTerminationMessage(pod *apiv1.Pod) string {
+	for _, containerStatus := range pod.Status.ContainerStatuses {
+		if containerStatus.Name != builderContainerName || containerStatus.State.Terminated == nil {
+			continue
+		}
+		return strings.TrimSpace(containerStatus.State.Terminated.Message)
+	}
+	return ""
+}
+
+func getBuilderLogsTail(
+	ctx context.Context,
+	namespaceName string,
+	podName string,
+	kubernetesManager *kubernetes_manager.KubernetesManager,
+) string {
+	logsReader, err := kubernetesManager.GetContainerLogs(ctx, namespaceName, podName, builderContainerName, shouldFollowBuilderLogs, shouldAddTimestampsToBuildLog)
+	if err != nil {
+		return fmt.Sprintf("<the builder logs couldn't be retrieved: %v>", err)
+	}
+	defer logsReader.Close()
+	logsBytes, err := io.ReadAll(logsReader)
+	if err != nil {
+		return fmt.Sprintf("<the builder logs couldn't be read: %v>", err)
+	}
+	logLines := strings.Split(strings.TrimRight(string(logsBytes), "\n"), "\n")
+	if len(logLines) > maxBuilderLogLinesInError {
+		logLines = logLines[len(logLines)-maxBuilderLogLinesInError:]
+	}
+	return strings.Join(logLines, "\n")
+}
+
+// getPathInBuildContext returns the path the given file on the API container's disk will have inside the builder pod,
+// failing if it isn't part of the build context
+func getPathInBuildContext(buildContextDirpathOnDisk string, filepathOnDisk string) (string, error) {
+	relativeFilepath, err := filepath.Rel(buildContextDirpathOnDisk, filepathOnDisk)
+	if err != nil {
+		return "", stacktrace.Propagate(err, "An error occurred getting the path of '%v' relative to build context '%v'", filepathOnDisk, buildContextDirpathOnDisk)
+	}
+	if relativeFilepath == ".." || strings.HasPrefix(relativeFilepath, "../") {
+		return "", stacktrace.NewError("'%v' isn't inside build context '%v'; it can't be sent to the in-cluster image builder", filepathOnDisk, buildContextDirpathOnDisk)
+	}
+	return filepath.ToSlash(filepath.Join(buildContextDirpath, relativeFilepath)), nil
+}
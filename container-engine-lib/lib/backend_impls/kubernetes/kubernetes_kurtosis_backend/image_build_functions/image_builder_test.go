@@ -0,0 +1,101 @@
+package image_build_functions
+
+import (
+	"bytes"
+	"os"
+	"path"
+	"testing"
+
+	"github.com/kurtosis-tech/kurtosis/container-engine-lib/lib/backend_impls/kubernetes/kubernetes_kurtosis_backend/shared_helpers"
+	"github.com/kurtosis-tech/kurtosis/container-engine-lib/lib/backend_interface/objects/image_build_spec"
+	"github.com/kurtosis-tech/kurtosis/container-engine-lib/lib/backend_interface/objects/nix_build_spec"
+	"github.com/stretchr/testify/require"
+)
+
+const (
+	testPackageDirpath = "/kurtosis-data/repositories/github.com/sample/package"
+	testDestination    = "registry.local:5000/my-image:latest"
+
+	isTestDestinationPlainHttp = true
+)
+
+func TestWriteBuildContextTar(t *testing.T) {
+	buildContextDirpath := t.TempDir()
+	require.NoError(t, os.WriteFile(path.Join(buildContextDirpath, "Dockerfile"), []byte("FROM alpine"), 0644))
+	require.NoError(t, os.MkdirAll(path.Join(buildContextDirpath, "src", "nested"), 0755))
+	require.NoError(t, os.WriteFile(path.Join(buildContextDirpath, "src", "nested", "main.go"), []byte("package main"), 0644))
+
+	tarball := &bytes.Buffer{}
+	require.NoError(t, writeBuildContextTar(buildContextDirpath, tarball))
+
+	extractedDirpath := path.Join(t.TempDir(), "context")
+	require.NoError(t, shared_helpers.ExtractTarArchive(tarball, extractedDirpath))
+
+	dockerfileContent, err := os.ReadFile(path.Join(extractedDirpath, "Dockerfile"))
+	require.NoError(t, err)
+	require.Equal(t, "FROM alpine", string(dockerfileContent))
+	mainContent, err := os.ReadFile(path.Join(extractedDirpath, "src", "nested", "main.go"))
+	require.NoError(t, err)
+	require.Equal(t, "package main", string(mainContent))
+}
+
+func TestGetPathInBuildContext(t *testing.T) {
+	pathInContext, err := getPathInBuildContext(testPackageDirpath, path.Join(testPackageDirpath, "server", "Dockerfile"))
+	require.NoError(t, err)
+	require.Equal(t, "/workspace/context/server/Dockerfile", pathInContext)
+
+	_, err = getPathInBuildContext(path.Join(testPackageDirpath, "server"), path.Join(testPackageDirpath, "Dockerfile"))
+	require.Error(t, err)
+}
+
+func TestGetKanikoArgs(t *testing.T) {
+	buildSpec := image_build_spec.NewImageBuildSpec(
+		testPackageDirpath,
+		path.Join(testPackageDirpath, "server", "Dockerfile"),
+		"server",
+		"",
+		map[string]string{"VERSION": "1.0.0", "ARCH": "amd64"},
+	)
+
+	kanikoArgs, err := getKanikoArgs(buildSpec, testDestination, isTestDestinationPlainHttp)
+	require.NoError(t, err)
+	require.Equal(t, []string{
+		"--context=dir:///workspace/context",
+		"--dockerfile=/workspace/context/server/Dockerfile",
+		"--destination=" + testDestination,
+		"--digest-file=/dev/termination-log",
+		"--insecure",
+		"--skip-tls-verify",
+		"--target=server",
+		"--build-arg=ARCH=amd64",
+		"--build-arg=VERSION=1.0.0",
+	}, kanikoArgs)
+}
+
+func TestGetKanikoArgs_UsesBuildFileWhenSet(t *testing.T) {
+	buildSpec := image_build_spec.NewImageBuildSpec(testPackageDirpath, path.Join(testPackageDirpath, "Dockerfile"), "", "Dockerfile.prod", nil)
+
+	kanikoArgs, err := getKanikoArgs(buildSpec, testDestination, isTestDestinationPlainHttp)
+	require.NoError(t, err)
+	require.Contains(t, kanikoArgs, "--dockerfile=/workspace/context/Dockerfile.prod")
+	for _, kanikoArg := range kanikoArgs {
+		require.NotContains(t, kanikoArg, "--target")
+	}
+}
+
+func TestGetKanikoArgs_VerifiesTlsOfConfiguredRegistry(t *testing.T) {
+	buildSpec := image_build_spec.NewImageBuildSpec(testPackageDirpath, path.Join(testPackageDirpath, "Dockerfile"), "", "", nil)
+
+	kanikoArgs, err := getKanikoArgs(buildSpec, testDestination, false)
+	require.NoError(t, err)
+	require.NotContains(t, kanikoArgs, "--insecure")
+	require.NotContains(t, kanikoArgs, "--skip-tls-verify")
+}
+
+func TestGetFlakeReferenceInBuildContext(t *testing.T) {
+	nixBuildSpec := nix_build_spec.NewNixBuildSpec("my-image", testPackageDirpath, path.Join(testPackageDirpath, "nix"), "containerImage")
+
+	flakeReference, err := getFlakeReferenceInBuildContext(nixBuildSpec)
+	require.NoError(t, err)
+	require.Equal(t, "path:/workspace/context/nix#containerImage", flakeReference)
+}
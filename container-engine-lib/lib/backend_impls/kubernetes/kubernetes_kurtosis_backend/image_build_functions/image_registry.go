@@ -0,0 +1,141 @@
+package image_build_functions
+
+import (
+	"context"
+	"fmt"
+	"sync"
+
+	"github.com/kurtosis-tech/kurtosis/container-engine-lib/lib/backend_impls/kubernetes/kubernetes_kurtosis_backend/image_registry_functions"
+	"github.com/kurtosis-tech/kurtosis/container-engine-lib/lib/backend_impls/kubernetes/kubernetes_kurtosis_backend/shared_helpers"
+	"github.com/kurtosis-tech/kurtosis/container-engine-lib/lib/backend_impls/kubernetes/kubernetes_manager"
+	"github.com/kurtosis-tech/kurtosis/container-engine-lib/lib/backend_impls/kubernetes/object_attributes_provider"
+	"github.com/kurtosis-tech/kurtosis/container-engine-lib/lib/image_registry_utils"
+	"github.com/kurtosis-tech/stacktrace"
+	"github.com/sirupsen/logrus"
+	apiv1 "k8s.io/api/core/v1"
+)
+
+// Builds of the same enclave run in parallel, so this keeps them from racing to create the enclave registry
+var enclaveImageRegistryCreationMutex = &sync.Mutex{}
+
+// imageRegistry holds the host builders push images to and the host pods pull them from, which are only different
+// for the registries Kurtosis runs in the cluster
+type imageRegistry struct {
+	pushHost string
+	pullHost string
+
+	// The registries Kurtosis runs in the cluster are served over plain HTTP, so builders must skip TLS to push to
+	// them; a configured registry is always reached over TLS
+	isPlainHttp bool
+}
+
+func (registry *imageRegistry) getPushReference(imageName string) string {
+	return image_registry_utils.GetImageReference(registry.pushHost, imageName)
+}
+
+// getPullReference pins the pulled image to the pushed digest, so a rebuild under the same name can't be mixed up
+// with an older image already cached on the node
+func (registry *imageRegistry) getPullReference(imageName string, digest string) string {
+	if digest == "" {
+		return image_registry_utils.GetImageReference(registry.pullHost, imageName)
+	}
+	return fmt.Sprintf("%v@%v", image_registry_utils.GetImageReference(registry.pullHost, imageName), digest)
+}
+
+// getImageRegistry returns the registry configured for the cluster if there's one, then the engine image registry if
+// it was started, so the images are shared by all the enclaves, and otherwise the enclave-local registry, creating it
+// the first time an image gets built in the enclave
+func getImageRegistry(
+	ctx context.Context,
+	apiContainerModeArgs *shared_helpers.ApiContainerModeArgs,
+	kubernetesManager *kubernetes_manager.KubernetesManager,
+) (*imageRegistry, error) {
+	if configuredRegistry := apiContainerModeArgs.GetImageBuildRegistry(); configuredRegistry != "" {
+		return &imageRegistry{
+			pushHost:    configuredRegistry,
+			pullHost:    configuredRegistry,
+			isPlainHttp: false,
+		}, nil
+	}
+
+	engineRegistryPushHost, engineRegistryPullHost, found, err := image_registry_functions.GetImageRegistryHosts(ctx, kubernetesManager)
+	if err != nil {
+		return nil, stacktrace.Propagate(err, "An error occurred getting the engine image registry")
+	}
+	if found {
+		return &imageRegistry{
+			pushHost:    engineRegistryPushHost,
+			pullHost:    engineRegistryPullHost,
+			isPlainHttp: true,
+		}, nil
+	}
+
+	enclaveImageRegistryCreationMutex.Lock()
+	defer enclaveImageRegistryCreationMutex.Unlock()
+
+	namespaceName := apiContainerModeArgs.GetOwnNamespaceName()
+	enclaveObjAttributesProvider := object_attributes_provider.GetKubernetesObjectAttributesProvider().ForEnclave(apiContainerModeArgs.GetOwnEnclaveId())
+	registryAttributes, err := enclaveObjAttributesProvider.ForImageRegistry()
+	if err != nil {
+		return nil, stacktrace.Propagate(err, "An error occurred getting the enclave image registry attributes")
+	}
+	registryName := registryAttributes.GetName().GetString()
+	registryLabelStrs := shared_helpers.GetStringMapFromLabelMap(registryAttributes.GetLabels())
+	registryAnnotationStrs := shared_helpers.GetStringMapFromAnnotationMap(registryAttributes.GetAnnotations())
+
+	existingServices, err := kubernetesManager.GetServicesByLabels(ctx, namespaceName, registryLabelStrs)
+	if err != nil {
+		return nil, stacktrace.Propagate(err, "An error occurred getting the enclave image registry services in namespace '%v'", namespaceName)
+	}
+	if len(existingServices.Items) > 1 {
+		return nil, stacktrace.NewError("Found more than one enclave image registry service in namespace '%v'; this is a bug in Kurtosis", namespaceName)
+	}
+	if len(existingServices.Items) == 1 {
+		return getEnclaveImageRegistryFromService(&existingServices.Items[0])
+	}
+
+	logrus.Infof("No image registry was configured for the cluster; starting a registry in enclave namespace '%v'", namespaceName)
+	if _, err := kubernetesManager.CreatePod(
+		ctx,
+		namespaceName,
+		registryName,
+		registryLabelStrs,
+		registryAnnotationStrs,
+		nil,
+		image_registry_functions.GetImageRegistryContainers(),
+		image_registry_functions.GetImageRegistryVolumes(),
+		"",
+		apiv1.RestartPolicyAlways,
+		nil,
+		nil,
+	); err != nil {
+		return nil, stacktrace.Propagate(err, "An error occurred creating the enclave image registry pod in namespace '%v'", namespaceName)
+	}
+
+	registryService, err := kubernetesManager.CreateService(
+		ctx,
+		namespaceName,
+		registryName,
+		registryLabelStrs,
+		registryAnnotationStrs,
+		registryLabelStrs,
+		apiv1.ServiceTypeNodePort,
+		image_registry_functions.GetImageRegistryServicePorts(),
+	)
+	if err != nil {
+		return nil, stacktrace.Propagate(err, "An error occurred creating the enclave image registry service in namespace '%v'", namespaceName)
+	}
+	return getEnclaveImageRegistryFromService(registryService)
+}
+
+func getEnclaveImageRegistryFromService(registryService *apiv1.Service) (*imageRegistry, error) {
+	pushHost, pullHost, err := image_registry_functions.GetImageRegistryHostsFromService(registryService)
+	if err != nil {
+		return nil, stacktrace.Propagate(err, "An error occurred getting the hosts of the enclave image registry from service '%v'", registryService.Name)
+	}
+	return &imageRegistry{
+		pushHost:    pushHost,
+		pullHost:    pullHost,
+		isPlainHttp: true,
+	}, nil
+}
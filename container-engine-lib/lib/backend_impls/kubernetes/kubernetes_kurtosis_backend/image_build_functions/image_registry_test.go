@@ -0,0 +1,94 @@
+package image_build_functions
+
+import (
+	"context"
+	"testing"
+
+	"github.com/kurtosis-tech/kurtosis/container-engine-lib/lib/backend_impls/kubernetes/kubernetes_kurtosis_backend/shared_helpers"
+	"github.com/kurtosis-tech/kurtosis/container-engine-lib/lib/backend_impls/kubernetes/kubernetes_manager"
+	"github.com/kurtosis-tech/kurtosis/container-engine-lib/lib/backend_impls/kubernetes/object_attributes_provider"
+	"github.com/kurtosis-tech/kurtosis/container-engine-lib/lib/backend_interface/objects/enclave"
+	"github.com/stretchr/testify/require"
+	apiv1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/client-go/kubernetes/fake"
+)
+
+const (
+	testEnclaveUuid      = enclave.EnclaveUUID("3a7e9e9c2f2d4d0bb2f4b7b4e3c4a1f0")
+	testEnclaveNamespace = "kt-test-enclave"
+	testImageName        = "my-image:latest"
+	testDigest           = "sha256:0123456789abcdef"
+	testNodePort         = 31500
+)
+
+func TestGetImageRegistry_UsesConfiguredRegistry(t *testing.T) {
+	kubernetesManager := kubernetes_manager.NewKubernetesManager(fake.NewSimpleClientset(), nil, "")
+	apiContainerModeArgs := shared_helpers.NewApiContainerModeArgs(testEnclaveUuid, testEnclaveNamespace, "", "registry.example.com:5000")
+
+	registry, err := getImageRegistry(context.Background(), apiContainerModeArgs, kubernetesManager)
+	require.NoError(t, err)
+	require.Equal(t, "registry.example.com:5000/my-image:latest", registry.getPushReference(testImageName))
+	require.Equal(t, "registry.example.com:5000/my-image:latest@"+testDigest, registry.getPullReference(testImageName, testDigest))
+	require.False(t, registry.isPlainHttp)
+}
+
+func TestGetImageRegistry_ReusesEnclaveRegistry(t *testing.T) {
+	registryAttributes, err := object_attributes_provider.GetKubernetesObjectAttributesProvider().ForEnclave(testEnclaveUuid).ForImageRegistry()
+	require.NoError(t, err)
+	// nolint: exhaustruct
+	existingRegistryService := &apiv1.Service{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:      registryAttributes.GetName().GetString(),
+			Namespace: testEnclaveNamespace,
+			Labels:    shared_helpers.GetStringMapFromLabelMap(registryAttributes.GetLabels()),
+		},
+		Spec: apiv1.ServiceSpec{
+			Type: apiv1.ServiceTypeNodePort,
+			Ports: []apiv1.ServicePort{
+				{Name: "registry", Port: 5000, NodePort: testNodePort},
+			},
+		},
+	}
+	kubernetesManager := kubernetes_manager.NewKubernetesManager(fake.NewSimpleClientset(existingRegistryService), nil, "")
+	apiContainerModeArgs := shared_helpers.NewApiContainerModeArgs(testEnclaveUuid, testEnclaveNamespace, "", "")
+
+	registry, err := getImageRegistry(context.Background(), apiContainerModeArgs, kubernetesManager)
+	require.NoError(t, err)
+	require.Equal(t, "kurtosis-image-registry.kt-test-enclave.svc.cluster.local:5000/my-image:latest", registry.getPushReference(testImageName))
+	require.Equal(t, "localhost:31500/my-image:latest@"+testDigest, registry.getPullReference(testImageName, testDigest))
+	require.Equal(t, "localhost:31500/my-image:latest", registry.getPullReference(testImageName, ""))
+	require.True(t, registry.isPlainHttp)
+}
+
+func TestGetImageRegistry_PrefersEngineRegistry(t *testing.T) {
+	engineRegistryAttributes, err := object_attributes_provider.GetKubernetesObjectAttributesProvider().ForEngineImageRegistry().ForEngineImageRegistryService()
+	require.NoError(t, err)
+	// nolint: exhaustruct
+	engineRegistryService := &apiv1.Service{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:      engineRegistryAttributes.GetName().GetString(),
+			Namespace: engineRegistryAttributes.GetName().GetString(),
+			Labels:    shared_helpers.GetStringMapFromLabelMap(engineRegistryAttributes.GetLabels()),
+		},
+		Spec: apiv1.ServiceSpec{
+			Type: apiv1.ServiceTypeNodePort,
+			Ports: []apiv1.ServicePort{
+				{Name: "registry", Port: 5000, NodePort: testNodePort},
+			},
+		},
+	}
+	kubernetesManager := kubernetes_manager.NewKubernetesManager(fake.NewSimpleClientset(engineRegistryService), nil, "")
+	apiContainerModeArgs := shared_helpers.NewApiContainerModeArgs(testEnclaveUuid, testEnclaveNamespace, "", "")
+
+	registry, err := getImageRegistry(context.Background(), apiContainerModeArgs, kubernetesManager)
+	require.NoError(t, err)
+	require.Equal(t, "kurtosis-image-registry.kurtosis-image-registry.svc.cluster.local:5000/my-image:latest", registry.getPushReference(testImageName))
+	require.Equal(t, "localhost:31500/my-image:latest@"+testDigest, registry.getPullReference(testImageName, testDigest))
+	require.True(t, registry.isPlainHttp)
+
+	// the enclave-local registry is never started
+	enclaveRegistryServices, err := kubernetesManager.GetServicesByLabels(context.Background(), testEnclaveNamespace, map[string]string{})
+	require.NoError(t, err)
+	require.Empty(t, enclaveRegistryServices.Items)
+}
@@ -0,0 +1,75 @@
+package image_build_functions
+
+import (
+	"context"
+	"fmt"
+	"strconv"
+
+	"github.com/kurtosis-tech/kurtosis/container-engine-lib/lib/backend_impls/kubernetes/kubernetes_kurtosis_backend/shared_helpers"
+	"github.com/kurtosis-tech/kurtosis/container-engine-lib/lib/backend_impls/kubernetes/kubernetes_manager"
+	"github.com/kurtosis-tech/kurtosis/container-engine-lib/lib/backend_interface/objects/nix_build_spec"
+	"github.com/kurtosis-tech/stacktrace"
+	"github.com/sirupsen/logrus"
+)
+
+const (
+	nixImage       = "nixos/nix:2.24.9"
+	nixShellBinary = "/bin/sh"
+
+	nixOutLinkFilepath = workspaceDirpath + "/result"
+
+	// The flake output is expected to be a Docker image tarball, like the Docker backend expects; skopeo pushes it
+	// as is and records the pushed digest as the builder's termination message
+	nixBuildScript = "nix --extra-experimental-features 'nix-command flakes' build \"$1\" --out-link " + nixOutLinkFilepath +
+		" && nix --extra-experimental-features 'nix-command flakes' run nixpkgs#skopeo --" +
+		" copy --insecure-policy --dest-tls-verify=\"$3\" --digestfile /dev/termination-log" +
+		" docker-archive:" + nixOutLinkFilepath + " \"docker://$2\""
+)
+
+// NixBuild builds the flake output described by the spec in a Nix pod of the enclave namespace, pushes the resulting
+// image to the image build registry and returns the reference pods must use to pull it
+func NixBuild(
+	ctx context.Context,
+	nixBuildSpec *nix_build_spec.NixBuildSpec,
+	apiContainerModeArgs *shared_helpers.ApiContainerModeArgs,
+	kubernetesManager *kubernetes_manager.KubernetesManager,
+) (string, error) {
+	imageName := nixBuildSpec.GetImageName()
+	registry, err := getImageRegistry(ctx, apiContainerModeArgs, kubernetesManager)
+	if err != nil {
+		return "", stacktrace.Propagate(err, "An error occurred getting the registry to push image '%v' to", imageName)
+	}
+
+	flakeReference, err := getFlakeReferenceInBuildContext(nixBuildSpec)
+	if err != nil {
+		return "", stacktrace.Propagate(err, "An error occurred getting the reference of flake '%v' in the build context", nixBuildSpec.GetFullFlakeReference())
+	}
+
+	logrus.Debugf("Building image '%v' in the cluster from Nix flake '%v'", imageName, flakeReference)
+	digest, err := runBuilderPod(
+		ctx,
+		&builderPodSpec{
+			image:       nixImage,
+			shellBinary: nixShellBinary,
+			buildScript: nixBuildScript,
+			buildArgs:   []string{flakeReference, registry.getPushReference(imageName), strconv.FormatBool(!registry.isPlainHttp)},
+		},
+		nixBuildSpec.GetBuildContextDir(),
+		apiContainerModeArgs,
+		kubernetesManager,
+	)
+	if err != nil {
+		return "", stacktrace.Propagate(err, "An error occurred building image '%v' from Nix flake '%v' in the cluster", imageName, flakeReference)
+	}
+
+	return registry.getPullReference(imageName, digest), nil
+}
+
+func getFlakeReferenceInBuildContext(nixBuildSpec *nix_build_spec.NixBuildSpec) (string, error) {
+	flakeDirpath, err := getPathInBuildContext(nixBuildSpec.GetBuildContextDir(), nixBuildSpec.GetNixFlakeDir())
+	if err != nil {
+		return "", stacktrace.Propagate(err, "An error occurred locating the flake dir in the build context")
+	}
+	// The streamed context isn't a git repository, so the flake has to be referenced as a plain path
+	return fmt.Sprintf("path:%v#%v", flakeDirpath, nixBuildSpec.GetFlakeOutput()), nil
+}
@@ -0,0 +1,269 @@
+package image_cache_functions
+
+import (
+	"context"
+	"strings"
+	"time"
+
+	"github.com/kurtosis-tech/kurtosis/container-engine-lib/lib/backend_impls/kubernetes/kubernetes_kurtosis_backend/shared_helpers"
+	"github.com/kurtosis-tech/kurtosis/container-engine-lib/lib/backend_impls/kubernetes/kubernetes_manager"
+	"github.com/kurtosis-tech/kurtosis/container-engine-lib/lib/backend_impls/kubernetes/object_attributes_provider"
+	"github.com/kurtosis-tech/kurtosis/container-engine-lib/lib/backend_interface/objects/image_download_mode"
+	"github.com/kurtosis-tech/kurtosis/container-engine-lib/lib/image_pull_progress"
+	"github.com/kurtosis-tech/kurtosis/container-engine-lib/lib/uuid_generator"
+	"github.com/kurtosis-tech/stacktrace"
+	"github.com/sirupsen/logrus"
+	appsv1 "k8s.io/api/apps/v1"
+	apiv1 "k8s.io/api/core/v1"
+)
+
+const (
+	// The pulled image can't be trusted to ship any binary, so a statically linked busybox gets copied next to it.
+	// Busybox picks the applet to run from the name it's invoked with, which makes the copy behave as 'true'
+	pullProbeImage         = "busybox:1.36.1"
+	pullProbeContainerName = "copy-pull-probe"
+	pullProbeVolumeName    = "pull-probe"
+	pullProbeDirpath       = "/kurtosis-pull-probe"
+	pullProbeFilepath      = pullProbeDirpath + "/true"
+
+	pulledImageContainerName = "pulled-image"
+
+	// Daemon set pods can't complete, so this keeps them running once the image is on the node
+	pauseImage         = "registry.k8s.io/pause:3.9"
+	pauseContainerName = "pause"
+
+	prePullTimeout          = 30 * time.Minute
+	prePullTimeBetweenPolls = 1 * time.Second
+
+	pulledEventReason                = "Pulled"
+	imageAlreadyPresentEventFragment = "already present on machine"
+)
+
+// Kubelets keep retrying pulls failing for these reasons, but they don't recover until the image reference or the
+// registry gets fixed, so waiting for them would only hit the timeout
+var unrecoverableImagePullReasons = map[string]bool{
+	"ImagePullBackOff": true,
+	"InvalidImageName": true,
+}
+
+// PrePullImage pulls the image on every node the enclave pods can be scheduled on with a short-lived daemon set, so
+// the pull latency is paid during validation rather than when the first pod using the image starts on each node.
+// It reports the number of nodes having the image through the reporter of the context, and returns whether the image
+// had to be downloaded on at least one node
+func PrePullImage(
+	ctx context.Context,
+	imageName string,
+	downloadMode image_download_mode.ImageDownloadMode,
+	apiContainerModeArgs *shared_helpers.ApiContainerModeArgs,
+	kubernetesManager *kubernetes_manager.KubernetesManager,
+) (bool, error) {
+	namespaceName := apiContainerModeArgs.GetOwnNamespaceName()
+	pullUuid, err := uuid_generator.GenerateUUIDString()
+	if err != nil {
+		return false, stacktrace.Propagate(err, "An error occurred generating a UUID for the pull of image '%v'", imageName)
+	}
+
+	enclaveObjAttributesProvider := object_attributes_provider.GetKubernetesObjectAttributesProvider().ForEnclave(apiContainerModeArgs.GetOwnEnclaveId())
+	pullerAttributes, err := enclaveObjAttributesProvider.ForImagePullerDaemonSet(pullUuid)
+	if err != nil {
+		return false, stacktrace.Propagate(err, "An error occurred getting the image puller daemon set attributes for pull '%v'", pullUuid)
+	}
+
+	pullerDaemonSet, err := kubernetesManager.CreateDaemonSet(
+		ctx,
+		namespaceName,
+		pullerAttributes.GetName().GetString(),
+		shared_helpers.GetStringMapFromLabelMap(pullerAttributes.GetLabels()),
+		shared_helpers.GetStringMapFromAnnotationMap(pullerAttributes.GetAnnotations()),
+		"",
+		getPullerInitContainers(imageName, downloadMode),
+		getPullerContainers(),
+		getPullerVolumes(),
+	)
+	if err != nil {
+		return false, stacktrace.Propagate(err, "An error occurred creating the daemon set pulling image '%v' in namespace '%v'", imageName, namespaceName)
+	}
+	defer func() {
+		if err := kubernetesManager.RemoveDaemonSet(context.Background(), namespaceName, pullerDaemonSet); err != nil {
+			logrus.Warnf("Attempted to remove daemon set '%v' pulling image '%v' but an error occurred:\n%v", pullerDaemonSet.Name, imageName, err)
+			logrus.Warnf("You may have to remove this daemon set manually.")
+		}
+	}()
+
+	pullerPodNames, err := waitForImageOnAllNodes(ctx, imageName, pullerDaemonSet, kubernetesManager)
+	if err != nil {
+		return false, stacktrace.Propagate(err, "An error occurred waiting for image '%v' to be pulled on the nodes of the cluster", imageName)
+	}
+
+	events, err := kubernetesManager.GetEventsForNamespace(ctx, namespaceName)
+	if err != nil {
+		// The image is on every node at that point, so this only makes the summary less accurate
+		logrus.Debugf("Couldn't get the events of namespace '%v' to find out whether image '%v' was downloaded:\n%v", namespaceName, imageName, err)
+		return false, nil
+	}
+	return isImagePulledFromRemote(events.Items, pullerPodNames), nil
+}
+
+// waitForImageOnAllNodes polls the puller pods until each node the daemon set got scheduled on has the image, and
+// returns the names of these pods
+func waitForImageOnAllNodes(
+	ctx context.Context,
+	imageName string,
+	pullerDaemonSet *appsv1.DaemonSet,
+	kubernetesManager *kubernetes_manager.KubernetesManager,
+) ([]string, error) {
+	numNodesWithImageLastReported := -1
+	deadline := time.Now().Add(prePullTimeout)
+	for time.Now().Before(deadline) {
+		daemonSet, err := kubernetesManager.GetDaemonSet(ctx, pullerDaemonSet.Namespace, pullerDaemonSet.Name)
+		if err != nil {
+			return nil, stacktrace.Propagate(err, "An error occurred getting daemon set '%v'", pullerDaemonSet.Name)
+		}
+		pullerPods, err := kubernetesManager.GetPodsManagedByDaemonSet(ctx, daemonSet)
+		if err != nil {
+			return nil, stacktrace.Propagate(err, "An error occurred getting the pods of daemon set '%v'", pullerDaemonSet.Name)
+		}
+
+		if failedPod, failureMessage, found := getImagePullFailure(pullerPods); found {
+			return nil, stacktrace.NewError("Pulling image '%v' failed on node '%v': %v", imageName, failedPod.Spec.NodeName, failureMessage)
+		}
+
+		numNodesTotal := daemonSet.Status.DesiredNumberScheduled
+		isStatusUpToDate := daemonSet.Status.ObservedGeneration >= daemonSet.Generation
+		if isStatusUpToDate && numNodesTotal == 0 {
+			logrus.Warnf("No node of the cluster can run the pods pulling image '%v'; the image will be pulled when the first pod using it starts", imageName)
+			return nil, nil
+		}
+
+		numNodesWithImage := getNumPodsWithImagePulled(pullerPods)
+		if numNodesWithImage != numNodesWithImageLastReported {
+			image_pull_progress.Report(ctx, imageName, uint32(numNodesWithImage), uint32(numNodesTotal))
+			numNodesWithImageLastReported = numNodesWithImage
+		}
+		if isStatusUpToDate && numNodesWithImage >= int(numNodesTotal) {
+			pullerPodNames := []string{}
+			for _, pod := range pullerPods {
+				pullerPodNames = append(pullerPodNames, pod.Name)
+			}
+			return pullerPodNames, nil
+		}
+
+		select {
+		case <-ctx.Done():
+			return nil, stacktrace.Propagate(ctx.Err(), "The context was cancelled while waiting for image '%v' to be pulled", imageName)
+		case <-time.After(prePullTimeBetweenPolls):
+		}
+	}
+	return nil, stacktrace.NewError("Image '%v' still wasn't pulled on every node after %v", imageName, prePullTimeout)
+}
+
+func getNumPodsWithImagePulled(pullerPods []*apiv1.Pod) int {
+	numPodsWithImagePulled := 0
+	for _, pod := range pullerPods {
+		for _, containerStatus := range pod.Status.InitContainerStatuses {
+			if containerStatus.Name != pulledImageContainerName {
+				continue
+			}
+			// The container can only get started once its image is on the node
+			if containerStatus.State.Running != nil || containerStatus.State.Terminated != nil {
+				numPodsWithImagePulled++
+			}
+		}
+	}
+	return numPodsWithImagePulled
+}
+
+func getImagePullFailure(pullerPods []*apiv1.Pod) (*apiv1.Pod, string, bool) {
+	for _, pod := range pullerPods {
+		for _, containerStatus := range pod.Status.InitContainerStatuses {
+			if containerStatus.Name != pulledImageContainerName || containerStatus.State.Waiting == nil {
+				continue
+			}
+			if unrecoverableImagePullReasons[containerStatus.State.Waiting.Reason] {
+				return pod, containerStatus.State.Waiting.Message, true
+			}
+		}
+	}
+	return nil, "", false
+}
+
+// isImagePulledFromRemote relies on the kubelet events, as they are the only place telling apart an image that was
+// downloaded from one that was already on the node
+func isImagePulledFromRemote(events []apiv1.Event, pullerPodNames []string) bool {
+	pullerPodNamesSet := map[string]bool{}
+	for _, podName := range pullerPodNames {
+		pullerPodNamesSet[podName] = true
+	}
+	for _, event := range events {
+		if event.Reason != pulledEventReason || !pullerPodNamesSet[event.InvolvedObject.Name] {
+			continue
+		}
+		if !strings.Contains(event.InvolvedObject.FieldPath, pulledImageContainerName) {
+			continue
+		}
+		if !strings.Contains(event.Message, imageAlreadyPresentEventFragment) {
+			return true
+		}
+	}
+	return false
+}
+
+func getPullerInitContainers(imageName string, downloadMode image_download_mode.ImageDownloadMode) []apiv1.Container {
+	pullPolicy := apiv1.PullIfNotPresent
+	if downloadMode == image_download_mode.ImageDownloadMode_Always {
+		pullPolicy = apiv1.PullAlways
+	}
+	pullProbeVolumeMounts := []apiv1.VolumeMount{
+		{
+			Name:             pullProbeVolumeName,
+			ReadOnly:         false,
+			MountPath:        pullProbeDirpath,
+			SubPath:          "",
+			MountPropagation: nil,
+			SubPathExpr:      "",
+		},
+	}
+	// nolint: exhaustruct
+	return []apiv1.Container{
+		{
+			Name:            pullProbeContainerName,
+			Image:           pullProbeImage,
+			Command:         []string{"cp", "/bin/busybox", pullProbeFilepath},
+			VolumeMounts:    pullProbeVolumeMounts,
+			ImagePullPolicy: apiv1.PullIfNotPresent,
+		},
+		{
+			Name:            pulledImageContainerName,
+			Image:           imageName,
+			Command:         []string{pullProbeFilepath},
+			VolumeMounts:    pullProbeVolumeMounts,
+			ImagePullPolicy: pullPolicy,
+		},
+	}
+}
+
+func getPullerContainers() []apiv1.Container {
+	// nolint: exhaustruct
+	return []apiv1.Container{
+		{
+			Name:            pauseContainerName,
+			Image:           pauseImage,
+			ImagePullPolicy: apiv1.PullIfNotPresent,
+		},
+	}
+}
+
+func getPullerVolumes() []apiv1.Volume {
+	return []apiv1.Volume{
+		{
+			Name: pullProbeVolumeName,
+			// nolint: exhaustruct
+			VolumeSource: apiv1.VolumeSource{
+				EmptyDir: &apiv1.EmptyDirVolumeSource{
+					Medium:    "",
+					SizeLimit: nil,
+				},
+			},
+		},
+	}
+}
@@ -0,0 +1,110 @@
+package image_cache_functions
+
+import (
+	"context"
+	"testing"
+
+	"github.com/kurtosis-tech/kurtosis/container-engine-lib/lib/backend_impls/kubernetes/kubernetes_kurtosis_backend/shared_helpers"
+	"github.com/kurtosis-tech/kurtosis/container-engine-lib/lib/backend_impls/kubernetes/kubernetes_manager"
+	"github.com/kurtosis-tech/kurtosis/container-engine-lib/lib/backend_interface/objects/enclave"
+	"github.com/kurtosis-tech/kurtosis/container-engine-lib/lib/backend_interface/objects/image_download_mode"
+	"github.com/stretchr/testify/require"
+	apiv1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/client-go/kubernetes/fake"
+)
+
+const (
+	testEnclaveUuid      = enclave.EnclaveUUID("3a7e9e9c2f2d4d0bb2f4b7b4e3c4a1f0")
+	testEnclaveNamespace = "kt-test-enclave"
+	testImageName        = "postgres:16"
+)
+
+func TestGetNumPodsWithImagePulled(t *testing.T) {
+	pullerPods := []*apiv1.Pod{
+		newTestPullerPod("puller-a", "node-a", apiv1.ContainerState{Terminated: &apiv1.ContainerStateTerminated{}}),                    // nolint: exhaustruct
+		newTestPullerPod("puller-b", "node-b", apiv1.ContainerState{Waiting: &apiv1.ContainerStateWaiting{Reason: "PodInitializing"}}), // nolint: exhaustruct
+		newTestPullerPod("puller-c", "node-c", apiv1.ContainerState{Running: &apiv1.ContainerStateRunning{}}),                          // nolint: exhaustruct
+	}
+	require.Equal(t, 2, getNumPodsWithImagePulled(pullerPods))
+
+	_, _, found := getImagePullFailure(pullerPods)
+	require.False(t, found)
+}
+
+func TestGetImagePullFailure(t *testing.T) {
+	pullerPods := []*apiv1.Pod{
+		newTestPullerPod("puller-a", "node-a", apiv1.ContainerState{Terminated: &apiv1.ContainerStateTerminated{}}),                                                  // nolint: exhaustruct
+		newTestPullerPod("puller-b", "node-b", apiv1.ContainerState{Waiting: &apiv1.ContainerStateWaiting{Reason: "ImagePullBackOff", Message: "manifest unknown"}}), // nolint: exhaustruct
+	}
+
+	failedPod, failureMessage, found := getImagePullFailure(pullerPods)
+	require.True(t, found)
+	require.Equal(t, "node-b", failedPod.Spec.NodeName)
+	require.Equal(t, "manifest unknown", failureMessage)
+}
+
+func TestIsImagePulledFromRemote(t *testing.T) {
+	alreadyPresentEvent := newTestPulledEvent("puller-a", "Container image \"postgres:16\" already present on machine")
+	downloadedEvent := newTestPulledEvent("puller-b", "Successfully pulled image \"postgres:16\" in 3.2s")
+	otherPodDownloadedEvent := newTestPulledEvent("service-a", "Successfully pulled image \"postgres:16\" in 3.2s")
+
+	require.False(t, isImagePulledFromRemote([]apiv1.Event{alreadyPresentEvent, otherPodDownloadedEvent}, []string{"puller-a", "puller-b"}))
+	require.True(t, isImagePulledFromRemote([]apiv1.Event{alreadyPresentEvent, downloadedEvent}, []string{"puller-a", "puller-b"}))
+}
+
+func TestGetPullerInitContainers(t *testing.T) {
+	initContainers := getPullerInitContainers(testImageName, image_download_mode.ImageDownloadMode_Always)
+	require.Len(t, initContainers, 2)
+	require.Equal(t, pullProbeImage, initContainers[0].Image)
+	require.Equal(t, testImageName, initContainers[1].Image)
+	require.Equal(t, []string{pullProbeFilepath}, initContainers[1].Command)
+	require.Equal(t, apiv1.PullAlways, initContainers[1].ImagePullPolicy)
+
+	initContainers = getPullerInitContainers(testImageName, image_download_mode.ImageDownloadMode_Missing)
+	require.Equal(t, apiv1.PullIfNotPresent, initContainers[1].ImagePullPolicy)
+}
+
+func TestPrePullImage_NoSchedulableNode(t *testing.T) {
+	clientSet := fake.NewSimpleClientset()
+	kubernetesManager := kubernetes_manager.NewKubernetesManager(clientSet, nil, "")
+	apiContainerModeArgs := shared_helpers.NewApiContainerModeArgs(testEnclaveUuid, testEnclaveNamespace, "", "")
+
+	// There's no daemon set controller behind the fake client set, so the daemon set never gets scheduled anywhere
+	pulledFromRemote, err := PrePullImage(context.Background(), testImageName, image_download_mode.ImageDownloadMode_Missing, apiContainerModeArgs, kubernetesManager)
+	require.NoError(t, err)
+	require.False(t, pulledFromRemote)
+
+	daemonSets, err := clientSet.AppsV1().DaemonSets(testEnclaveNamespace).List(context.Background(), metav1.ListOptions{}) // nolint: exhaustruct
+	require.NoError(t, err)
+	require.Empty(t, daemonSets.Items)
+}
+
+// nolint: exhaustruct
+func newTestPullerPod(name string, nodeName string, pulledImageContainerState apiv1.ContainerState) *apiv1.Pod {
+	return &apiv1.Pod{
+		ObjectMeta: metav1.ObjectMeta{Name: name, Namespace: testEnclaveNamespace},
+		Spec:       apiv1.PodSpec{NodeName: nodeName},
+		Status: apiv1.PodStatus{
+			InitContainerStatuses: []apiv1.ContainerStatus{
+				{Name: pullProbeContainerName, State: apiv1.ContainerState{Terminated: &apiv1.ContainerStateTerminated{}}},
+				{Name: pulledImageContainerName, State: pulledImageContainerState},
+			},
+		},
+	}
+}
+
+// nolint: exhaustruct
+func newTestPulledEvent(podName string, message string) apiv1.Event {
+	return apiv1.Event{
+		ObjectMeta: metav1.ObjectMeta{Name: podName + ".pulled", Namespace: testEnclaveNamespace},
+		InvolvedObject: apiv1.ObjectReference{
+			Kind:      "Pod",
+			Name:      podName,
+			Namespace: testEnclaveNamespace,
+			FieldPath: "spec.initContainers{" + pulledImageContainerName + "}",
+		},
+		Reason:  pulledEventReason,
+		Message: message,
+	}
+}
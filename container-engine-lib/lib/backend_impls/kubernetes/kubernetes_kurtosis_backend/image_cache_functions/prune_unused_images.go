@@ -0,0 +1,182 @@
+package image_cache_functions
+
+import (
+	"context"
+	"regexp"
+	"sort"
+	"strings"
+
+	kurtosis_sdk_version "github.com/kurtosis-tech/kurtosis/api/golang/kurtosis_version"
+	"github.com/kurtosis-tech/kurtosis/container-engine-lib/lib/backend_impls/kubernetes/kubernetes_kurtosis_backend/shared_helpers"
+	"github.com/kurtosis-tech/kurtosis/container-engine-lib/lib/backend_impls/kubernetes/kubernetes_manager"
+	"github.com/kurtosis-tech/kurtosis/container-engine-lib/lib/backend_impls/kubernetes/object_attributes_provider"
+	"github.com/kurtosis-tech/stacktrace"
+	"github.com/sirupsen/logrus"
+	apiv1 "k8s.io/api/core/v1"
+)
+
+const (
+	// Same heuristic as the Docker backend: Kurtosis images are the ones of the Kurtosis org tagged with a release
+	// version, and only the ones of the running version are kept
+	kurtosisImageTagPrefix = "kurtosistech/"
+
+	defaultRegistryPrefix        = "docker.io/"
+	defaultRegistryLibraryPrefix = defaultRegistryPrefix + "library/"
+
+	allNamespaces = ""
+)
+
+var semVerRegex = regexp.MustCompile(`\b(0|[1-9]\d*)\.(0|[1-9]\d*)\.(0|[1-9]\d*)\b`)
+
+// PruneUnusedImages removes the Kurtosis images of other versions from the image cache of every node of the cluster,
+// skipping the ones used by a pod scheduled on the node. It returns the pruned images, even if it fails midway
+func PruneUnusedImages(ctx context.Context, kubernetesManager *kubernetes_manager.KubernetesManager) ([]string, error) {
+	nodes, err := kubernetesManager.GetNodes(ctx)
+	if err != nil {
+		return nil, stacktrace.Propagate(err, "An error occurred getting the nodes of the cluster")
+	}
+	pods, err := kubernetesManager.GetPodsByLabels(ctx, allNamespaces, nil)
+	if err != nil {
+		return nil, stacktrace.Propagate(err, "An error occurred getting the pods of the cluster")
+	}
+
+	imagesToPruneByNode := getUnusedKurtosisImagesByNode(nodes.Items, pods.Items)
+	if len(imagesToPruneByNode) == 0 {
+		logrus.Debugf("No unused Kurtosis image found on the nodes of the cluster")
+		return []string{}, nil
+	}
+
+	prunerNamespace, err := getOrCreateImagePrunerNamespace(ctx, kubernetesManager)
+	if err != nil {
+		return nil, stacktrace.Propagate(err, "An error occurred getting the namespace to run the image pruner pods in")
+	}
+	defer func() {
+		if err := kubernetesManager.RemoveNamespace(context.Background(), prunerNamespace); err != nil {
+			logrus.Warnf("Attempted to remove image pruner namespace '%v' but an error occurred:\n%v", prunerNamespace.Name, err)
+			logrus.Warnf("You may have to remove this namespace manually.")
+		}
+	}()
+
+	nodeNames := []string{}
+	for nodeName := range imagesToPruneByNode {
+		nodeNames = append(nodeNames, nodeName)
+	}
+	sort.Strings(nodeNames)
+
+	prunedImagesSet := map[string]bool{}
+	for _, nodeName := range nodeNames {
+		imagesToPrune := imagesToPruneByNode[nodeName]
+		logrus.Debugf("Pruning images '%v' from node '%v'", imagesToPrune, nodeName)
+		if err := kubernetesManager.RemoveImagesFromNode(ctx, prunerNamespace.Name, nodeName, imagesToPrune); err != nil {
+			return getSortedKeys(prunedImagesSet), stacktrace.Propagate(err, "An error occurred pruning images '%v' from node '%v'", imagesToPrune, nodeName)
+		}
+		for _, image := range imagesToPrune {
+			prunedImagesSet[image] = true
+		}
+	}
+	return getSortedKeys(prunedImagesSet), nil
+}
+
+// getUnusedKurtosisImagesByNode relies on the images nodes report in their status, which kubelets cap to the largest
+// ones; Kurtosis images missing from there are left on the node
+func getUnusedKurtosisImagesByNode(nodes []apiv1.Node, pods []apiv1.Pod) map[string][]string {
+	usedImagesByNode := map[string]map[string]bool{}
+	for _, pod := range pods {
+		nodeName := pod.Spec.NodeName
+		if nodeName == "" {
+			continue
+		}
+		if _, found := usedImagesByNode[nodeName]; !found {
+			usedImagesByNode[nodeName] = map[string]bool{}
+		}
+		for _, container := range append(pod.Spec.InitContainers, pod.Spec.Containers...) {
+			usedImagesByNode[nodeName][normalizeImageName(container.Image)] = true
+		}
+		for _, containerStatus := range append(pod.Status.InitContainerStatuses, pod.Status.ContainerStatuses...) {
+			usedImagesByNode[nodeName][normalizeImageName(containerStatus.Image)] = true
+			usedImagesByNode[nodeName][normalizeImageName(containerStatus.ImageID)] = true
+		}
+	}
+
+	unusedImagesByNode := map[string][]string{}
+	for _, node := range nodes {
+		for _, nodeImage := range node.Status.Images {
+			kurtosisImageName, isKurtosisImage := getKurtosisImageName(nodeImage.Names)
+			if !isKurtosisImage || isImageUsed(nodeImage.Names, usedImagesByNode[node.Name]) {
+				continue
+			}
+			unusedImagesByNode[node.Name] = append(unusedImagesByNode[node.Name], kurtosisImageName)
+		}
+	}
+	return unusedImagesByNode
+}
+
+// getKurtosisImageName returns the tagged name of the image if it's a Kurtosis image of another version
+func getKurtosisImageName(imageNames []string) (string, bool) {
+	for _, imageName := range imageNames {
+		normalizedImageName := normalizeImageName(imageName)
+		if !strings.HasPrefix(normalizedImageName, kurtosisImageTagPrefix) || !semVerRegex.MatchString(normalizedImageName) {
+			continue
+		}
+		if strings.Contains(normalizedImageName, kurtosis_sdk_version.KurtosisVersion) {
+			return "", false
+		}
+		return imageName, true
+	}
+	return "", false
+}
+
+func isImageUsed(imageNames []string, usedImages map[string]bool) bool {
+	for _, imageName := range imageNames {
+		if usedImages[normalizeImageName(imageName)] {
+			return true
+		}
+	}
+	return false
+}
+
+// normalizeImageName drops the default registry nodes add to the names of Docker Hub images, so they can be compared
+// with the image of pod specs
+func normalizeImageName(imageName string) string {
+	if strings.HasPrefix(imageName, defaultRegistryLibraryPrefix) {
+		return strings.TrimPrefix(imageName, defaultRegistryLibraryPrefix)
+	}
+	return strings.TrimPrefix(imageName, defaultRegistryPrefix)
+}
+
+func getOrCreateImagePrunerNamespace(ctx context.Context, kubernetesManager *kubernetes_manager.KubernetesManager) (*apiv1.Namespace, error) {
+	prunerAttributes, err := object_attributes_provider.GetKubernetesObjectAttributesProvider().ForImagePruner().ForImagePrunerNamespace()
+	if err != nil {
+		return nil, stacktrace.Propagate(err, "An error occurred getting the image pruner namespace attributes")
+	}
+	prunerLabelStrs := shared_helpers.GetStringMapFromLabelMap(prunerAttributes.GetLabels())
+
+	// A previous prune may have been interrupted before removing its namespace
+	existingNamespaces, err := kubernetesManager.GetNamespacesByLabels(ctx, prunerLabelStrs)
+	if err != nil {
+		return nil, stacktrace.Propagate(err, "An error occurred getting the image pruner namespaces")
+	}
+	if len(existingNamespaces.Items) > 0 {
+		return &existingNamespaces.Items[0], nil
+	}
+
+	prunerNamespace, err := kubernetesManager.CreateNamespace(
+		ctx,
+		prunerAttributes.GetName().GetString(),
+		prunerLabelStrs,
+		shared_helpers.GetStringMapFromAnnotationMap(prunerAttributes.GetAnnotations()),
+	)
+	if err != nil {
+		return nil, stacktrace.Propagate(err, "An error occurred creating the image pruner namespace")
+	}
+	return prunerNamespace, nil
+}
+
+func getSortedKeys(set map[string]bool) []string {
+	keys := []string{}
+	for key := range set {
+		keys = append(keys, key)
+	}
+	sort.Strings(keys)
+	return keys
+}
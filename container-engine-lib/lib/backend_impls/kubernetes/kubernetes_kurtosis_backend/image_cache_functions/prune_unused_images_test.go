@@ -0,0 +1,76 @@
+package image_cache_functions
+
+import (
+	"context"
+	"testing"
+
+	kurtosis_sdk_version "github.com/kurtosis-tech/kurtosis/api/golang/kurtosis_version"
+	"github.com/kurtosis-tech/kurtosis/container-engine-lib/lib/backend_impls/kubernetes/kubernetes_manager"
+	"github.com/stretchr/testify/require"
+	apiv1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/client-go/kubernetes/fake"
+)
+
+const (
+	testOldEngineImage = "docker.io/kurtosistech/engine:0.90.0"
+	testOldCoreImage   = "docker.io/kurtosistech/core:0.90.0"
+)
+
+var testCurrentEngineImage = "docker.io/kurtosistech/engine:" + kurtosis_sdk_version.KurtosisVersion
+
+func TestGetUnusedKurtosisImagesByNode(t *testing.T) {
+	nodes := []apiv1.Node{
+		newTestNode("node-a", testOldEngineImage, testOldCoreImage, testCurrentEngineImage, "docker.io/library/postgres:16"),
+		newTestNode("node-b", testOldEngineImage),
+	}
+	pods := []apiv1.Pod{
+		newTestPod("node-a", "kurtosistech/core:0.90.0"),
+		newTestPod("node-b", "kurtosistech/engine:0.90.0"),
+		// Pods not scheduled yet don't use any node image
+		newTestPod("", testOldEngineImage),
+	}
+
+	unusedImagesByNode := getUnusedKurtosisImagesByNode(nodes, pods)
+	require.Equal(t, map[string][]string{"node-a": {testOldEngineImage}}, unusedImagesByNode)
+}
+
+func TestPruneUnusedImages_NothingToPrune(t *testing.T) {
+	// nolint: exhaustruct
+	clientSet := fake.NewSimpleClientset([]runtime.Object{
+		&apiv1.NodeList{Items: []apiv1.Node{newTestNode("node-a", testCurrentEngineImage)}},
+	}...)
+	kubernetesManager := kubernetes_manager.NewKubernetesManager(clientSet, nil, "")
+
+	prunedImages, err := PruneUnusedImages(context.Background(), kubernetesManager)
+	require.NoError(t, err)
+	require.Empty(t, prunedImages)
+
+	// No pruner pod had to run, so its namespace was never created
+	namespaces, err := clientSet.CoreV1().Namespaces().List(context.Background(), metav1.ListOptions{}) // nolint: exhaustruct
+	require.NoError(t, err)
+	require.Empty(t, namespaces.Items)
+}
+
+// nolint: exhaustruct
+func newTestNode(name string, imageNames ...string) apiv1.Node {
+	images := []apiv1.ContainerImage{}
+	for _, imageName := range imageNames {
+		images = append(images, apiv1.ContainerImage{Names: []string{imageName}})
+	}
+	return apiv1.Node{
+		ObjectMeta: metav1.ObjectMeta{Name: name},
+		Status:     apiv1.NodeStatus{Images: images},
+	}
+}
+
+// nolint: exhaustruct
+func newTestPod(nodeName string, image string) apiv1.Pod {
+	return apiv1.Pod{
+		Spec: apiv1.PodSpec{
+			NodeName:   nodeName,
+			Containers: []apiv1.Container{{Name: "main", Image: image}},
+		},
+	}
+}
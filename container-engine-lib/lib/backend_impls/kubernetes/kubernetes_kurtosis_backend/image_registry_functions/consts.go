@@ -0,0 +1,23 @@
+package image_registry_functions
+
+import "time"
+
+const (
+	imageRegistryContainerName = "image-registry"
+	imageRegistryPortName      = "registry"
+
+	imageRegistryStorageVolumeName  = "registry-storage"
+	isRegistryStorageVolumeReadOnly = false
+
+	// Kubelets resolve image references on the node rather than inside the cluster network, so while builders push
+	// through the cluster DNS name of the service, kubelets pull through its node port on localhost, which container
+	// runtimes treat as an insecure registry
+	imageRegistryPullHostFormat = "localhost:%v"
+	imageRegistryPushHostFormat = "%v.%v.svc.cluster.local:%v"
+
+	maxRetriesWaitingForImageRegistryPod = 60
+	retryIntervalWaitingForImageRegistry = 1 * time.Second
+
+	// Services of every namespace are searched, as the registry namespace isn't known to the callers
+	allNamespaces = ""
+)
@@ -0,0 +1,55 @@
+package image_registry_functions
+
+import (
+	"context"
+
+	"github.com/kurtosis-tech/kurtosis/container-engine-lib/lib/backend_impls/kubernetes/kubernetes_manager"
+	"github.com/kurtosis-tech/kurtosis/container-engine-lib/lib/backend_impls/kubernetes/object_attributes_provider"
+	"github.com/kurtosis-tech/kurtosis/container-engine-lib/lib/backend_interface/objects/container"
+	"github.com/kurtosis-tech/kurtosis/container-engine-lib/lib/backend_interface/objects/image_registry"
+	"github.com/kurtosis-tech/stacktrace"
+	"github.com/sirupsen/logrus"
+)
+
+// CreateImageRegistry creates the image registry idempotently, if a running image registry is found then it is returned
+func CreateImageRegistry(
+	ctx context.Context,
+	objAttrsProvider object_attributes_provider.KubernetesObjectAttributesProvider,
+	kubernetesManager *kubernetes_manager.KubernetesManager,
+) (*image_registry.ImageRegistry, error) {
+	existingImageRegistry, existingResources, _, err := getImageRegistryObjAndResourcesForCluster(ctx, kubernetesManager)
+	if err != nil {
+		return nil, stacktrace.Propagate(err, "An error occurred getting the image registry object and resources for cluster.")
+	}
+	if existingImageRegistry != nil && existingImageRegistry.GetStatus() == container.ContainerStatus_Running {
+		logrus.Debug("Found existing image registry deployment.")
+		return existingImageRegistry, nil
+	}
+	// Leftovers of an image registry that isn't running are removed so a fresh one can be started
+	if err := destroyImageRegistryKubernetesResources(ctx, existingResources, kubernetesManager); err != nil {
+		return nil, stacktrace.Propagate(err, "An error occurred removing the resources of the image registry that isn't running")
+	}
+
+	resources, removeResourcesFunc, err := createImageRegistryKubernetesResources(ctx, objAttrsProvider.ForEngineImageRegistry(), kubernetesManager)
+	if err != nil {
+		return nil, stacktrace.Propagate(err, "An error occurred creating the image registry Kubernetes resources")
+	}
+	shouldRemoveImageRegistry := true
+	defer func() {
+		if shouldRemoveImageRegistry {
+			removeResourcesFunc()
+		}
+	}()
+
+	if err := kubernetesManager.WaitForPodManagedByDeployment(ctx, resources.deployment, maxRetriesWaitingForImageRegistryPod, retryIntervalWaitingForImageRegistry); err != nil {
+		return nil, stacktrace.Propagate(err, "An error occurred waiting for the pod managed by image registry deployment '%v'", resources.deployment.Name)
+	}
+
+	imageRegistry, _, err := getImageRegistryObjectFromKubernetesResources(ctx, kubernetesManager, resources)
+	if err != nil {
+		return nil, stacktrace.Propagate(err, "An error occurred getting the image registry object from the Kubernetes resources")
+	}
+
+	shouldRemoveImageRegistry = false
+	return imageRegistry, nil
+}
@@ -0,0 +1,56 @@
+package image_registry_functions
+
+import (
+	"context"
+	"errors"
+
+	"github.com/kurtosis-tech/kurtosis/container-engine-lib/lib/backend_impls/kubernetes/kubernetes_manager"
+	"github.com/kurtosis-tech/stacktrace"
+	"github.com/sirupsen/logrus"
+)
+
+// DestroyImageRegistry destroys the image registry and its associated resources idempotently
+func DestroyImageRegistry(ctx context.Context, kubernetesManager *kubernetes_manager.KubernetesManager) error {
+	resources, err := getImageRegistryKubernetesResourcesForCluster(ctx, kubernetesManager)
+	if err != nil {
+		return stacktrace.Propagate(err, "An error occurred retrieving Kubernetes resources for the image registry.")
+	}
+	if err := destroyImageRegistryKubernetesResources(ctx, resources, kubernetesManager); err != nil {
+		return stacktrace.Propagate(err, "An error occurred destroying the image registry.")
+	}
+	return nil
+}
+
+func destroyImageRegistryKubernetesResources(ctx context.Context, resources *imageRegistryKubernetesResources, kubernetesManager *kubernetes_manager.KubernetesManager) error {
+	if resources.namespace == nil {
+		logrus.Debug("No image registry namespace found. Returning without attempting to destroy remaining image registry resources.")
+		return nil
+	}
+
+	var destroyErrs []error
+	namespaceName := resources.namespace.Name
+	if resources.deployment != nil {
+		if err := kubernetesManager.RemoveDeployment(ctx, namespaceName, resources.deployment); err != nil {
+			destroyErrs = append(destroyErrs, stacktrace.Propagate(err, "An error occurred removing image registry deployment."))
+		}
+	}
+
+	if resources.service != nil {
+		if err := kubernetesManager.RemoveService(ctx, resources.service); err != nil {
+			destroyErrs = append(destroyErrs, stacktrace.Propagate(err, "An error occurred removing image registry service."))
+		}
+	}
+
+	if err := kubernetesManager.RemoveNamespace(ctx, resources.namespace); err != nil {
+		destroyErrs = append(destroyErrs, stacktrace.Propagate(err, "An error occurred removing image registry namespace."))
+	}
+
+	if len(destroyErrs) > 0 {
+		errMsg := "Following errors occurred trying to destroy the image registry:\n"
+		for _, destroyErr := range destroyErrs {
+			errMsg += destroyErr.Error() + "\n"
+		}
+		return errors.New(errMsg)
+	}
+	return nil
+}
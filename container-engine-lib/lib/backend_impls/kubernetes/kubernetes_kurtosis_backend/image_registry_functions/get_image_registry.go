@@ -0,0 +1,47 @@
+package image_registry_functions
+
+import (
+	"context"
+
+	"github.com/kurtosis-tech/kurtosis/container-engine-lib/lib/backend_impls/kubernetes/kubernetes_manager"
+	"github.com/kurtosis-tech/kurtosis/container-engine-lib/lib/backend_interface/objects/image_registry"
+	"github.com/kurtosis-tech/stacktrace"
+)
+
+// GetImageRegistry returns the image registry of the cluster, or nil if there is none
+func GetImageRegistry(
+	ctx context.Context,
+	kubernetesManager *kubernetes_manager.KubernetesManager,
+) (*image_registry.ImageRegistry, error) {
+	maybeImageRegistry, _, _, err := getImageRegistryObjAndResourcesForCluster(ctx, kubernetesManager)
+	if err != nil {
+		return nil, stacktrace.Propagate(err, "An error occurred getting the image registry")
+	}
+	return maybeImageRegistry, nil
+}
+
+// GetImageRegistryHosts returns the host images get pushed to from inside the cluster and the host kubelets pull them
+// from, if the cluster has an image registry
+// Only the service of the registry is looked up, so callers that can't read other namespaces entirely, like the API
+// containers, can use it
+func GetImageRegistryHosts(
+	ctx context.Context,
+	kubernetesManager *kubernetes_manager.KubernetesManager,
+) (string, string, bool, error) {
+	services, err := kubernetesManager.GetServicesByLabels(ctx, allNamespaces, getImageRegistryMatchLabels())
+	if err != nil {
+		return "", "", false, stacktrace.Propagate(err, "An error occurred getting the image registry services")
+	}
+	if len(services.Items) == 0 {
+		return "", "", false, nil
+	}
+	if len(services.Items) > 1 {
+		return "", "", false, stacktrace.NewError("Expected at most one image registry service but found '%v'; this is a bug in Kurtosis", len(services.Items))
+	}
+
+	pushHost, pullHost, err := GetImageRegistryHostsFromService(&services.Items[0])
+	if err != nil {
+		return "", "", false, stacktrace.Propagate(err, "An error occurred getting the hosts of the image registry from service '%v'", services.Items[0].Name)
+	}
+	return pushHost, pullHost, true, nil
+}
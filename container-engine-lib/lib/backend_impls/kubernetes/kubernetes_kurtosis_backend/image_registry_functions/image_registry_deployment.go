@@ -0,0 +1,198 @@
+package image_registry_functions
+
+import (
+	"context"
+
+	"github.com/kurtosis-tech/kurtosis/container-engine-lib/lib/backend_impls/kubernetes/kubernetes_kurtosis_backend/shared_helpers"
+	"github.com/kurtosis-tech/kurtosis/container-engine-lib/lib/backend_impls/kubernetes/kubernetes_manager"
+	"github.com/kurtosis-tech/kurtosis/container-engine-lib/lib/backend_impls/kubernetes/object_attributes_provider"
+	"github.com/kurtosis-tech/kurtosis/container-engine-lib/lib/image_registry_utils"
+	"github.com/kurtosis-tech/stacktrace"
+	"github.com/sirupsen/logrus"
+	appsv1 "k8s.io/api/apps/v1"
+	apiv1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/util/intstr"
+)
+
+// createImageRegistryKubernetesResources creates every Kubernetes object of the image registry, removing the ones
+// already created if any of them fails
+func createImageRegistryKubernetesResources(
+	ctx context.Context,
+	objAttrsProvider object_attributes_provider.KubernetesEngineImageRegistryObjectAttributesProvider,
+	kubernetesManager *kubernetes_manager.KubernetesManager,
+) (*imageRegistryKubernetesResources, func(), error) {
+	resources := &imageRegistryKubernetesResources{
+		namespace:  nil,
+		deployment: nil,
+		service:    nil,
+	}
+	removeResourcesFunc := func() {
+		removeCtx := context.Background()
+		if err := destroyImageRegistryKubernetesResources(removeCtx, resources, kubernetesManager); err != nil {
+			logrus.Errorf("Launching the image registry didn't complete successfully so we tried to remove the resources we created, but doing so exited with an error:\n%v", err)
+			logrus.Errorf("ACTION REQUIRED: You'll need to manually remove the Kubernetes objects labelled '%+v'!!!!!!", getImageRegistryMatchLabels())
+		}
+	}
+	shouldRemoveResources := true
+	defer func() {
+		if shouldRemoveResources {
+			removeResourcesFunc()
+		}
+	}()
+
+	var err error
+	if resources.namespace, err = createImageRegistryNamespace(ctx, objAttrsProvider, kubernetesManager); err != nil {
+		return nil, nil, stacktrace.Propagate(err, "An error occurred creating the image registry namespace")
+	}
+	namespaceName := resources.namespace.Name
+
+	if resources.deployment, err = createImageRegistryDeployment(ctx, namespaceName, objAttrsProvider, kubernetesManager); err != nil {
+		return nil, nil, stacktrace.Propagate(err, "An error occurred creating the image registry deployment")
+	}
+
+	if resources.service, err = createImageRegistryService(ctx, namespaceName, resources.deployment.Spec.Selector.MatchLabels, objAttrsProvider, kubernetesManager); err != nil {
+		return nil, nil, stacktrace.Propagate(err, "An error occurred creating the image registry service")
+	}
+
+	shouldRemoveResources = false
+	return resources, removeResourcesFunc, nil
+}
+
+func createImageRegistryNamespace(
+	ctx context.Context,
+	objAttrsProvider object_attributes_provider.KubernetesEngineImageRegistryObjectAttributesProvider,
+	kubernetesManager *kubernetes_manager.KubernetesManager,
+) (*apiv1.Namespace, error) {
+	namespaceAttrs, err := objAttrsProvider.ForEngineImageRegistryNamespace()
+	if err != nil {
+		return nil, stacktrace.Propagate(err, "An error occurred getting the image registry namespace attributes")
+	}
+	namespaceName := namespaceAttrs.GetName().GetString()
+	namespaceLabels := shared_helpers.GetStringMapFromLabelMap(namespaceAttrs.GetLabels())
+	namespaceAnnotations := shared_helpers.GetStringMapFromAnnotationMap(namespaceAttrs.GetAnnotations())
+
+	namespace, err := kubernetesManager.CreateNamespace(ctx, namespaceName, namespaceLabels, namespaceAnnotations)
+	if err != nil {
+		return nil, stacktrace.Propagate(err, "An error occurred creating namespace '%v' for the image registry", namespaceName)
+	}
+	return namespace, nil
+}
+
+func createImageRegistryDeployment(
+	ctx context.Context,
+	namespaceName string,
+	objAttrsProvider object_attributes_provider.KubernetesEngineImageRegistryObjectAttributesProvider,
+	kubernetesManager *kubernetes_manager.KubernetesManager,
+) (*appsv1.Deployment, error) {
+	deploymentAttrs, err := objAttrsProvider.ForEngineImageRegistryDeployment()
+	if err != nil {
+		return nil, stacktrace.Propagate(err, "An error occurred getting the image registry deployment attributes")
+	}
+	deploymentName := deploymentAttrs.GetName().GetString()
+	deploymentLabels := shared_helpers.GetStringMapFromLabelMap(deploymentAttrs.GetLabels())
+	deploymentAnnotations := shared_helpers.GetStringMapFromAnnotationMap(deploymentAttrs.GetAnnotations())
+
+	deployment, err := kubernetesManager.CreateDeployment(
+		ctx,
+		namespaceName,
+		deploymentName,
+		deploymentLabels,
+		deploymentAnnotations,
+		[]apiv1.Container{}, // no need init containers
+		GetImageRegistryContainers(),
+		GetImageRegistryVolumes(),
+		"", // the registry never talks to the Kubernetes API
+		nil,
+	)
+	if err != nil {
+		return nil, stacktrace.Propagate(err, "An error occurred creating deployment '%v' for the image registry", deploymentName)
+	}
+	return deployment, nil
+}
+
+func createImageRegistryService(
+	ctx context.Context,
+	namespaceName string,
+	matchPodLabels map[string]string,
+	objAttrsProvider object_attributes_provider.KubernetesEngineImageRegistryObjectAttributesProvider,
+	kubernetesManager *kubernetes_manager.KubernetesManager,
+) (*apiv1.Service, error) {
+	serviceAttrs, err := objAttrsProvider.ForEngineImageRegistryService()
+	if err != nil {
+		return nil, stacktrace.Propagate(err, "An error occurred getting the image registry service attributes")
+	}
+	serviceName := serviceAttrs.GetName().GetString()
+	serviceLabels := shared_helpers.GetStringMapFromLabelMap(serviceAttrs.GetLabels())
+	serviceAnnotations := shared_helpers.GetStringMapFromAnnotationMap(serviceAttrs.GetAnnotations())
+
+	service, err := kubernetesManager.CreateService(ctx, namespaceName, serviceName, serviceLabels, serviceAnnotations, matchPodLabels, apiv1.ServiceTypeNodePort, GetImageRegistryServicePorts())
+	if err != nil {
+		return nil, stacktrace.Propagate(err, "An error occurred creating service '%v' for the image registry", serviceName)
+	}
+	return service, nil
+}
+
+// GetImageRegistryContainers returns the containers of a registry pod, shared by the engine image registry and the
+// registries started in enclaves
+func GetImageRegistryContainers() []apiv1.Container {
+	// nolint: exhaustruct
+	return []apiv1.Container{
+		{
+			Name:    imageRegistryContainerName,
+			Image:   image_registry_utils.ImageRegistryImage,
+			Command: image_registry_utils.GetImageRegistryCommand(),
+			Ports: []apiv1.ContainerPort{
+				{
+					Name:          imageRegistryPortName,
+					HostPort:      0,
+					ContainerPort: int32(image_registry_utils.ImageRegistryPortNum),
+					Protocol:      apiv1.ProtocolTCP,
+					HostIP:        "",
+				},
+			},
+			VolumeMounts: []apiv1.VolumeMount{
+				{
+					Name:             imageRegistryStorageVolumeName,
+					ReadOnly:         isRegistryStorageVolumeReadOnly,
+					MountPath:        image_registry_utils.ImageRegistryStorageDirpath,
+					SubPath:          "",
+					MountPropagation: nil,
+					SubPathExpr:      "",
+				},
+			},
+			ImagePullPolicy: apiv1.PullIfNotPresent,
+		},
+	}
+}
+
+// GetImageRegistryVolumes returns the volumes of a registry pod; the registry storage lives as long as the pod, the
+// images get built again after the registry is restarted
+func GetImageRegistryVolumes() []apiv1.Volume {
+	return []apiv1.Volume{
+		{
+			Name: imageRegistryStorageVolumeName,
+			// nolint: exhaustruct
+			VolumeSource: apiv1.VolumeSource{
+				EmptyDir: &apiv1.EmptyDirVolumeSource{
+					Medium:    "",
+					SizeLimit: nil,
+				},
+			},
+		},
+	}
+}
+
+// GetImageRegistryServicePorts returns the ports of a registry service, published on a node port so kubelets can pull
+// from the registry
+func GetImageRegistryServicePorts() []apiv1.ServicePort {
+	return []apiv1.ServicePort{
+		{
+			Name:        imageRegistryPortName,
+			Protocol:    apiv1.ProtocolTCP,
+			AppProtocol: nil,
+			Port:        int32(image_registry_utils.ImageRegistryPortNum),
+			TargetPort:  intstr.FromInt(int(image_registry_utils.ImageRegistryPortNum)),
+			NodePort:    0,
+		},
+	}
+}
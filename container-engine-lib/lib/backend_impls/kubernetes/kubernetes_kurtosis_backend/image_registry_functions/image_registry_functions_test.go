@@ -0,0 +1,90 @@
+package image_registry_functions
+
+import (
+	"context"
+	"testing"
+
+	"github.com/kurtosis-tech/kurtosis/container-engine-lib/lib/backend_impls/kubernetes/kubernetes_kurtosis_backend/shared_helpers"
+	"github.com/kurtosis-tech/kurtosis/container-engine-lib/lib/backend_impls/kubernetes/kubernetes_manager"
+	"github.com/kurtosis-tech/kurtosis/container-engine-lib/lib/backend_impls/kubernetes/object_attributes_provider"
+	"github.com/kurtosis-tech/kurtosis/container-engine-lib/lib/backend_interface/objects/container"
+	"github.com/kurtosis-tech/kurtosis/container-engine-lib/lib/image_registry_utils"
+	"github.com/stretchr/testify/require"
+	apiv1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/client-go/kubernetes/fake"
+)
+
+const (
+	testNodePort = 31740
+)
+
+func TestGetImageRegistry_NoImageRegistry(t *testing.T) {
+	kubernetesManager := kubernetes_manager.NewKubernetesManager(fake.NewSimpleClientset(), nil, "")
+
+	imageRegistry, err := GetImageRegistry(context.Background(), kubernetesManager)
+	require.NoError(t, err)
+	require.Nil(t, imageRegistry)
+
+	_, _, found, err := GetImageRegistryHosts(context.Background(), kubernetesManager)
+	require.NoError(t, err)
+	require.False(t, found)
+}
+
+func TestImageRegistryResourcesLifecycle(t *testing.T) {
+	ctx := context.Background()
+	clientSet := fake.NewSimpleClientset()
+	kubernetesManager := kubernetes_manager.NewKubernetesManager(clientSet, nil, "")
+	objAttrsProvider := object_attributes_provider.GetKubernetesObjectAttributesProvider().ForEngineImageRegistry()
+
+	resources, _, err := createImageRegistryKubernetesResources(ctx, objAttrsProvider, kubernetesManager)
+	require.NoError(t, err)
+	registryContainer := resources.deployment.Spec.Template.Spec.Containers[0]
+	require.Equal(t, image_registry_utils.GetImageRegistryCommand(), registryContainer.Command)
+	require.Equal(t, apiv1.ServiceTypeNodePort, resources.service.Spec.Type)
+
+	// The fake clientset doesn't run the deployment controller so the image registry has no pod
+	imageRegistry, err := GetImageRegistry(ctx, kubernetesManager)
+	require.NoError(t, err)
+	require.NotNil(t, imageRegistry)
+	require.Equal(t, container.ContainerStatus_Stopped, imageRegistry.GetStatus())
+	require.Empty(t, imageRegistry.GetHost())
+
+	_, err = ListImageRegistryRepositories(ctx, kubernetesManager)
+	require.Error(t, err)
+
+	require.NoError(t, DestroyImageRegistry(ctx, kubernetesManager))
+
+	imageRegistry, err = GetImageRegistry(ctx, kubernetesManager)
+	require.NoError(t, err)
+	require.Nil(t, imageRegistry)
+
+	// Destroying is idempotent
+	require.NoError(t, DestroyImageRegistry(ctx, kubernetesManager))
+}
+
+func TestGetImageRegistryHosts(t *testing.T) {
+	serviceAttrs, err := object_attributes_provider.GetKubernetesObjectAttributesProvider().ForEngineImageRegistry().ForEngineImageRegistryService()
+	require.NoError(t, err)
+	// nolint: exhaustruct
+	registryService := &apiv1.Service{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:      serviceAttrs.GetName().GetString(),
+			Namespace: serviceAttrs.GetName().GetString(),
+			Labels:    shared_helpers.GetStringMapFromLabelMap(serviceAttrs.GetLabels()),
+		},
+		Spec: apiv1.ServiceSpec{
+			Type: apiv1.ServiceTypeNodePort,
+			Ports: []apiv1.ServicePort{
+				{Name: imageRegistryPortName, Port: int32(image_registry_utils.ImageRegistryPortNum), NodePort: testNodePort},
+			},
+		},
+	}
+	kubernetesManager := kubernetes_manager.NewKubernetesManager(fake.NewSimpleClientset(registryService), nil, "")
+
+	pushHost, pullHost, found, err := GetImageRegistryHosts(context.Background(), kubernetesManager)
+	require.NoError(t, err)
+	require.True(t, found)
+	require.Equal(t, "kurtosis-image-registry.kurtosis-image-registry.svc.cluster.local:5000", pushHost)
+	require.Equal(t, "localhost:31740", pullHost)
+}
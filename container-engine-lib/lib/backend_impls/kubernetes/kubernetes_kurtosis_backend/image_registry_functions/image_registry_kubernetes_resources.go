@@ -0,0 +1,14 @@
+package image_registry_functions
+
+import (
+	appsv1 "k8s.io/api/apps/v1"
+	apiv1 "k8s.io/api/core/v1"
+)
+
+type imageRegistryKubernetesResources struct {
+	namespace *apiv1.Namespace
+
+	deployment *appsv1.Deployment
+
+	service *apiv1.Service
+}
@@ -0,0 +1,61 @@
+package image_registry_functions
+
+import (
+	"context"
+
+	"github.com/kurtosis-tech/kurtosis/container-engine-lib/lib/backend_impls/kubernetes/kubernetes_manager"
+	"github.com/kurtosis-tech/kurtosis/container-engine-lib/lib/backend_impls/kubernetes/object_attributes_provider/kubernetes_label_key"
+	"github.com/kurtosis-tech/kurtosis/container-engine-lib/lib/backend_impls/kubernetes/object_attributes_provider/label_value_consts"
+	"github.com/kurtosis-tech/kurtosis/container-engine-lib/lib/backend_interface/objects/image_registry"
+	"github.com/kurtosis-tech/kurtosis/container-engine-lib/lib/image_registry_utils"
+	"github.com/kurtosis-tech/stacktrace"
+)
+
+func ListImageRegistryRepositories(
+	ctx context.Context,
+	kubernetesManager *kubernetes_manager.KubernetesManager,
+) ([]*image_registry.ImageRegistryRepository, error) {
+	_, pod, err := getRunningImageRegistryPod(ctx, kubernetesManager)
+	if err != nil {
+		return nil, stacktrace.Propagate(err, "An error occurred getting the running image registry pod")
+	}
+
+	repositories, err := image_registry_utils.ListRepositories(ctx, newImageRegistryCommandRunner(kubernetesManager, pod))
+	if err != nil {
+		return nil, stacktrace.Propagate(err, "An error occurred listing the repositories of the image registry")
+	}
+	return repositories, nil
+}
+
+// PruneImageRegistry deletes the repositories no user service pod of any enclave uses an image of
+func PruneImageRegistry(
+	ctx context.Context,
+	kubernetesManager *kubernetes_manager.KubernetesManager,
+) ([]string, error) {
+	imageRegistry, pod, err := getRunningImageRegistryPod(ctx, kubernetesManager)
+	if err != nil {
+		return nil, stacktrace.Propagate(err, "An error occurred getting the running image registry pod")
+	}
+
+	userServicePodSearchLabels := map[string]string{
+		kubernetes_label_key.AppIDKubernetesLabelKey.GetString():                label_value_consts.AppIDKubernetesLabelValue.GetString(),
+		kubernetes_label_key.KurtosisResourceTypeKubernetesLabelKey.GetString(): label_value_consts.UserServiceKurtosisResourceTypeKubernetesLabelValue.GetString(),
+	}
+	userServicePods, err := kubernetesManager.GetPodsByLabels(ctx, allNamespaces, userServicePodSearchLabels)
+	if err != nil {
+		return nil, stacktrace.Propagate(err, "An error occurred getting the user service pods using labels: %+v", userServicePodSearchLabels)
+	}
+	usedImages := []string{}
+	for _, userServicePod := range userServicePods.Items {
+		for _, userServiceContainer := range userServicePod.Spec.Containers {
+			usedImages = append(usedImages, userServiceContainer.Image)
+		}
+	}
+
+	// User service pods reference the images by the host kubelets pull them from
+	prunedRepositories, err := image_registry_utils.PruneRepositories(ctx, newImageRegistryCommandRunner(kubernetesManager, pod), imageRegistry.GetHost(), usedImages)
+	if err != nil {
+		return nil, stacktrace.Propagate(err, "An error occurred pruning the repositories of the image registry")
+	}
+	return prunedRepositories, nil
+}
@@ -0,0 +1,183 @@
+package image_registry_functions
+
+import (
+	"bytes"
+	"context"
+	"fmt"
+
+	"github.com/kurtosis-tech/kurtosis/container-engine-lib/lib/backend_impls/kubernetes/kubernetes_kurtosis_backend/shared_helpers"
+	"github.com/kurtosis-tech/kurtosis/container-engine-lib/lib/backend_impls/kubernetes/kubernetes_manager"
+	"github.com/kurtosis-tech/kurtosis/container-engine-lib/lib/backend_impls/kubernetes/kubernetes_resource_collectors"
+	"github.com/kurtosis-tech/kurtosis/container-engine-lib/lib/backend_impls/kubernetes/object_attributes_provider/kubernetes_label_key"
+	"github.com/kurtosis-tech/kurtosis/container-engine-lib/lib/backend_impls/kubernetes/object_attributes_provider/label_value_consts"
+	"github.com/kurtosis-tech/kurtosis/container-engine-lib/lib/backend_interface/objects/container"
+	"github.com/kurtosis-tech/kurtosis/container-engine-lib/lib/backend_interface/objects/image_registry"
+	"github.com/kurtosis-tech/kurtosis/container-engine-lib/lib/image_registry_utils"
+	"github.com/kurtosis-tech/stacktrace"
+	apiv1 "k8s.io/api/core/v1"
+)
+
+const (
+	successExitCode = 0
+)
+
+func getImageRegistryObjAndResourcesForCluster(ctx context.Context, kubernetesManager *kubernetes_manager.KubernetesManager) (*image_registry.ImageRegistry, *imageRegistryKubernetesResources, *apiv1.Pod, error) {
+	kubernetesResources, err := getImageRegistryKubernetesResourcesForCluster(ctx, kubernetesManager)
+	if err != nil {
+		return nil, nil, nil, stacktrace.Propagate(err, "An error occurred getting Kubernetes resources for the image registry.")
+	}
+
+	obj, maybePod, err := getImageRegistryObjectFromKubernetesResources(ctx, kubernetesManager, kubernetesResources)
+	if err != nil {
+		return nil, nil, nil, stacktrace.Propagate(err, "An error occurred getting the image registry object from Kubernetes resources.")
+	}
+	return obj, kubernetesResources, maybePod, nil
+}
+
+func getImageRegistryKubernetesResourcesForCluster(ctx context.Context, kubernetesManager *kubernetes_manager.KubernetesManager) (*imageRegistryKubernetesResources, error) {
+	resourceTypeLabelKeyStr := kubernetes_label_key.KurtosisResourceTypeKubernetesLabelKey.GetString()
+	imageRegistryResourceTypeLabelValStr := label_value_consts.EngineImageRegistryKurtosisResourceTypeKubernetesLabelValue.GetString()
+	imageRegistrySearchLabels := getImageRegistryMatchLabels()
+	postFilterLabelValues := map[string]bool{
+		imageRegistryResourceTypeLabelValStr: true,
+	}
+
+	resources := &imageRegistryKubernetesResources{
+		namespace:  nil,
+		deployment: nil,
+		service:    nil,
+	}
+
+	namespaces, err := kubernetes_resource_collectors.CollectMatchingNamespaces(ctx, kubernetesManager, imageRegistrySearchLabels, resourceTypeLabelKeyStr, postFilterLabelValues)
+	if err != nil {
+		return nil, stacktrace.Propagate(err, "An error occurred getting the namespace for the image registry.")
+	}
+	if resources.namespace, err = getAtMostOneResource(namespaces[imageRegistryResourceTypeLabelValStr], "namespace"); err != nil {
+		return nil, err // already wrapped with propagate
+	}
+	if resources.namespace == nil {
+		return resources, nil
+	}
+	namespaceName := resources.namespace.Name
+
+	deployments, err := kubernetes_resource_collectors.CollectMatchingDeployments(ctx, kubernetesManager, namespaceName, imageRegistrySearchLabels, resourceTypeLabelKeyStr, postFilterLabelValues)
+	if err != nil {
+		return nil, stacktrace.Propagate(err, "An error occurred getting the deployment for the image registry in namespace '%v'.", namespaceName)
+	}
+	if resources.deployment, err = getAtMostOneResource(deployments[imageRegistryResourceTypeLabelValStr], "deployment"); err != nil {
+		return nil, err // already wrapped with propagate
+	}
+
+	services, err := kubernetes_resource_collectors.CollectMatchingServices(ctx, kubernetesManager, namespaceName, imageRegistrySearchLabels, resourceTypeLabelKeyStr, postFilterLabelValues)
+	if err != nil {
+		return nil, stacktrace.Propagate(err, "An error occurred getting the service for the image registry in namespace '%v'.", namespaceName)
+	}
+	if resources.service, err = getAtMostOneResource(services[imageRegistryResourceTypeLabelValStr], "service"); err != nil {
+		return nil, err // already wrapped with propagate
+	}
+
+	return resources, nil
+}
+
+// getImageRegistryObjectFromKubernetesResources returns an image registry object if and only if the deployment and the
+// service of the image registry exist, along with its pod if it has one
+func getImageRegistryObjectFromKubernetesResources(
+	ctx context.Context,
+	kubernetesManager *kubernetes_manager.KubernetesManager,
+	resources *imageRegistryKubernetesResources,
+) (*image_registry.ImageRegistry, *apiv1.Pod, error) {
+	if resources.namespace == nil || resources.deployment == nil || resources.service == nil {
+		return nil, nil, nil
+	}
+
+	pods, err := kubernetesManager.GetPodsManagedByDeployment(ctx, resources.deployment)
+	if err != nil {
+		return nil, nil, stacktrace.Propagate(err, "An error occurred getting pods managed by image registry deployment '%v'.", resources.deployment.Name)
+	}
+	if len(pods) > 1 {
+		return nil, nil, stacktrace.NewError("Expected at most one pod managed by image registry deployment '%v' but found '%v'; this is a bug in Kurtosis", resources.deployment.Name, len(pods))
+	}
+	if len(pods) == 0 {
+		return image_registry.NewImageRegistry(container.ContainerStatus_Stopped, ""), nil, nil
+	}
+
+	pod := pods[0]
+	status, err := shared_helpers.GetContainerStatusFromPod(pod)
+	if err != nil {
+		return nil, nil, stacktrace.Propagate(err, "An error occurred getting the status of image registry pod '%v'.", pod.Name)
+	}
+	if status != container.ContainerStatus_Running {
+		return image_registry.NewImageRegistry(status, ""), pod, nil
+	}
+
+	_, pullHost, err := GetImageRegistryHostsFromService(resources.service)
+	if err != nil {
+		return nil, nil, stacktrace.Propagate(err, "An error occurred getting the hosts of the image registry from service '%v'.", resources.service.Name)
+	}
+	return image_registry.NewImageRegistry(status, pullHost), pod, nil
+}
+
+// GetImageRegistryHostsFromService returns the host images get pushed to from inside the cluster and the host kubelets
+// pull them from, for a registry service created with the ports of GetImageRegistryServicePorts
+func GetImageRegistryHostsFromService(registryService *apiv1.Service) (string, string, error) {
+	for _, servicePort := range registryService.Spec.Ports {
+		if servicePort.Name != imageRegistryPortName {
+			continue
+		}
+		if servicePort.NodePort == 0 {
+			return "", "", stacktrace.NewError("Image registry service '%v' has no node port allocated", registryService.Name)
+		}
+		pushHost := fmt.Sprintf(imageRegistryPushHostFormat, registryService.Name, registryService.Namespace, servicePort.Port)
+		pullHost := fmt.Sprintf(imageRegistryPullHostFormat, servicePort.NodePort)
+		return pushHost, pullHost, nil
+	}
+	return "", "", stacktrace.NewError("Image registry service '%v' doesn't expose port '%v'", registryService.Name, imageRegistryPortName)
+}
+
+// getRunningImageRegistryPod returns the pod of the image registry, failing if it isn't running since the registry
+// can't be inspected otherwise
+func getRunningImageRegistryPod(ctx context.Context, kubernetesManager *kubernetes_manager.KubernetesManager) (*image_registry.ImageRegistry, *apiv1.Pod, error) {
+	imageRegistry, _, maybePod, err := getImageRegistryObjAndResourcesForCluster(ctx, kubernetesManager)
+	if err != nil {
+		return nil, nil, stacktrace.Propagate(err, "An error occurred getting the image registry")
+	}
+	if imageRegistry == nil {
+		return nil, nil, stacktrace.NewError("No image registry was found; start one first")
+	}
+	if imageRegistry.GetStatus() != container.ContainerStatus_Running || maybePod == nil {
+		return nil, nil, stacktrace.NewError("The image registry isn't running")
+	}
+	return imageRegistry, maybePod, nil
+}
+
+func newImageRegistryCommandRunner(kubernetesManager *kubernetes_manager.KubernetesManager, pod *apiv1.Pod) image_registry_utils.CommandRunner {
+	return func(ctx context.Context, command []string) (string, error) {
+		stdOutOutput := &bytes.Buffer{}
+		stdErrOutput := &bytes.Buffer{}
+		exitCode, err := kubernetesManager.RunExecCommandWithContext(ctx, pod.Namespace, pod.Name, imageRegistryContainerName, command, stdOutOutput, stdErrOutput)
+		if err != nil {
+			return "", stacktrace.Propagate(err, "An error occurred running command '%v' in image registry pod '%v'", command, pod.Name)
+		}
+		if exitCode != successExitCode {
+			return "", stacktrace.NewError("Command '%v' in image registry pod '%v' exited with code '%v' and output:\n%v", command, pod.Name, exitCode, stdErrOutput.String())
+		}
+		return stdOutOutput.String(), nil
+	}
+}
+
+func getImageRegistryMatchLabels() map[string]string {
+	return map[string]string{
+		kubernetes_label_key.AppIDKubernetesLabelKey.GetString():                label_value_consts.AppIDKubernetesLabelValue.GetString(),
+		kubernetes_label_key.KurtosisResourceTypeKubernetesLabelKey.GetString(): label_value_consts.EngineImageRegistryKurtosisResourceTypeKubernetesLabelValue.GetString(),
+	}
+}
+
+func getAtMostOneResource[T any](resources []*T, resourceKind string) (*T, error) {
+	if len(resources) > 1 {
+		return nil, stacktrace.NewError("Expected at most one image registry %v but found '%v'; this is a bug in Kurtosis", resourceKind, len(resources))
+	}
+	if len(resources) == 0 {
+		return nil, nil
+	}
+	return resources[0], nil
+}
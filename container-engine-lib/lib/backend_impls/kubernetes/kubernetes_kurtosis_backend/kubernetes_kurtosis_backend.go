@@ -3,10 +3,12 @@ package kubernetes_kurtosis_backend
 import (
 	"context"
 	"io"
+	"sync"
 
 	"github.com/kurtosis-tech/kurtosis/container-engine-lib/lib/backend_impls/kubernetes/kubernetes_kurtosis_backend/logs_aggregator_functions"
 	"github.com/kurtosis-tech/kurtosis/container-engine-lib/lib/backend_impls/kubernetes/kubernetes_kurtosis_backend/logs_aggregator_functions/implementations/vector"
 	"github.com/kurtosis-tech/kurtosis/container-engine-lib/lib/backend_impls/kubernetes/kubernetes_kurtosis_backend/logs_collector_functions"
+	"github.com/kurtosis-tech/kurtosis/container-engine-lib/lib/backend_impls/kubernetes/kubernetes_kurtosis_backend/reverse_proxy_functions"
 	"github.com/kurtosis-tech/kurtosis/container-engine-lib/lib/backend_interface/objects/container"
 
 	"github.com/kurtosis-tech/kurtosis/container-engine-lib/lib/backend_interface/objects/image_build_spec"
@@ -14,7 +16,12 @@ import (
 	"github.com/kurtosis-tech/kurtosis/container-engine-lib/lib/backend_interface/objects/nix_build_spec"
 	apiv1 "k8s.io/api/core/v1"
 
+	"github.com/kurtosis-tech/kurtosis/container-engine-lib/lib/backend_impls/kubernetes/enclave_namespace_config"
+	"github.com/kurtosis-tech/kurtosis/container-engine-lib/lib/backend_impls/kubernetes/kubernetes_kurtosis_backend/compute_resources_functions"
 	"github.com/kurtosis-tech/kurtosis/container-engine-lib/lib/backend_impls/kubernetes/kubernetes_kurtosis_backend/engine_functions"
+	"github.com/kurtosis-tech/kurtosis/container-engine-lib/lib/backend_impls/kubernetes/kubernetes_kurtosis_backend/image_build_functions"
+	"github.com/kurtosis-tech/kurtosis/container-engine-lib/lib/backend_impls/kubernetes/kubernetes_kurtosis_backend/image_cache_functions"
+	"github.com/kurtosis-tech/kurtosis/container-engine-lib/lib/backend_impls/kubernetes/kubernetes_kurtosis_backend/image_registry_functions"
 	"github.com/kurtosis-tech/kurtosis/container-engine-lib/lib/backend_impls/kubernetes/kubernetes_kurtosis_backend/shared_helpers"
 	"github.com/kurtosis-tech/kurtosis/container-engine-lib/lib/backend_impls/kubernetes/kubernetes_kurtosis_backend/user_services_functions"
 	"github.com/kurtosis-tech/kurtosis/container-engine-lib/lib/backend_impls/kubernetes/kubernetes_manager"
@@ -26,6 +33,7 @@ import (
 	"github.com/kurtosis-tech/kurtosis/container-engine-lib/lib/backend_interface/objects/engine"
 	"github.com/kurtosis-tech/kurtosis/container-engine-lib/lib/backend_interface/objects/exec_result"
 	"github.com/kurtosis-tech/kurtosis/container-engine-lib/lib/backend_interface/objects/image_download_mode"
+	"github.com/kurtosis-tech/kurtosis/container-engine-lib/lib/backend_interface/objects/image_registry"
 	"github.com/kurtosis-tech/kurtosis/container-engine-lib/lib/backend_interface/objects/logs_aggregator"
 	"github.com/kurtosis-tech/kurtosis/container-engine-lib/lib/backend_interface/objects/logs_collector"
 	"github.com/kurtosis-tech/kurtosis/container-engine-lib/lib/backend_interface/objects/reverse_proxy"
@@ -35,7 +43,7 @@ import (
 )
 
 const (
-	isResourceInformationComplete                      = false
+	isResourceInformationComplete                      = true
 	noProductionMode                                   = false
 	anyNodeEngineNodeName                              = "" // engine can be scheduled by k8s on any node
 	defaultShouldTurnOffPersistentVolumeLogsCollection = false
@@ -58,11 +66,17 @@ type KubernetesKurtosisBackend struct {
 
 	// Name of node that engine will get scheduled on via a node selector
 	engineNodeName string
+
+	// Images built in the cluster, by name, mapped to the registry reference user service pods pull them from
+	builtImageReferences      map[string]string
+	builtImageReferencesMutex *sync.RWMutex
 }
 
 func (backend *KubernetesKurtosisBackend) DumpKurtosis(ctx context.Context, outputDirpath string) error {
-	//TODO implement me
-	panic("implement me")
+	if err := engine_functions.DumpKurtosis(ctx, outputDirpath, backend, backend.kubernetesManager); err != nil {
+		return stacktrace.Propagate(err, "An error occurred dumping Kurtosis to '%v'", outputDirpath)
+	}
+	return nil
 }
 
 // Private constructor that the other public constructors will use
@@ -76,13 +90,15 @@ func newKubernetesKurtosisBackend(
 ) *KubernetesKurtosisBackend {
 	objAttrsProvider := object_attributes_provider.GetKubernetesObjectAttributesProvider()
 	return &KubernetesKurtosisBackend{
-		kubernetesManager:    kubernetesManager,
-		objAttrsProvider:     objAttrsProvider,
-		cliModeArgs:          cliModeArgs,
-		engineServerModeArgs: engineServerModeArgs,
-		apiContainerModeArgs: apiContainerModeArgs,
-		productionMode:       productionMoe,
-		engineNodeName:       engineNodeName,
+		kubernetesManager:         kubernetesManager,
+		objAttrsProvider:          objAttrsProvider,
+		cliModeArgs:               cliModeArgs,
+		engineServerModeArgs:      engineServerModeArgs,
+		apiContainerModeArgs:      apiContainerModeArgs,
+		productionMode:            productionMoe,
+		engineNodeName:            engineNodeName,
+		builtImageReferences:      map[string]string{},
+		builtImageReferencesMutex: &sync.RWMutex{},
 	}
 }
 
@@ -91,9 +107,10 @@ func NewAPIContainerKubernetesKurtosisBackend(
 	ownEnclaveUuid enclave.EnclaveUUID,
 	ownNamespaceName string,
 	storageClassName string,
+	imageBuildRegistry string,
 	productionMode bool,
 ) *KubernetesKurtosisBackend {
-	modeArgs := shared_helpers.NewApiContainerModeArgs(ownEnclaveUuid, ownNamespaceName, storageClassName)
+	modeArgs := shared_helpers.NewApiContainerModeArgs(ownEnclaveUuid, ownNamespaceName, storageClassName, imageBuildRegistry)
 	return newKubernetesKurtosisBackend(
 		kubernetesManager,
 		nil,
@@ -106,8 +123,9 @@ func NewAPIContainerKubernetesKurtosisBackend(
 
 func NewEngineServerKubernetesKurtosisBackend(
 	kubernetesManager *kubernetes_manager.KubernetesManager,
+	enclaveNamespaceConfig *enclave_namespace_config.EnclaveNamespaceConfig,
 ) *KubernetesKurtosisBackend {
-	modeArgs := &shared_helpers.EngineServerModeArgs{}
+	modeArgs := shared_helpers.NewEngineServerModeArgs(enclaveNamespaceConfig)
 	return newKubernetesKurtosisBackend(
 		kubernetesManager,
 		nil,
@@ -134,13 +152,28 @@ func NewCLIModeKubernetesKurtosisBackend(
 }
 
 func (backend *KubernetesKurtosisBackend) FetchImage(ctx context.Context, image string, registrySpec *image_registry_spec.ImageRegistrySpec, downloadMode image_download_mode.ImageDownloadMode) (bool, string, error) {
-	logrus.Warnf("FetchImage isn't implemented for Kubernetes yet")
-	return false, "", nil
+	if backend.apiContainerModeArgs == nil {
+		logrus.Debugf("Not pre-pulling image '%v' outside of an enclave; nodes will pull it when the first pod using it starts", image)
+		return false, "", nil
+	}
+	if registrySpec != nil {
+		logrus.Warnf("Registry credentials aren't supported on Kubernetes yet; image '%v' will be pulled without them", image)
+	}
+
+	pulledFromRemote, err := image_cache_functions.PrePullImage(ctx, image, downloadMode, backend.apiContainerModeArgs, backend.kubernetesManager)
+	if err != nil {
+		return false, "", stacktrace.Propagate(err, "An error occurred pre-pulling image '%v' on the nodes of the cluster", image)
+	}
+	// Nodes of a cluster can have different architectures, so there's no single image architecture to return
+	return pulledFromRemote, "", nil
 }
 
 func (backend *KubernetesKurtosisBackend) PruneUnusedImages(ctx context.Context) ([]string, error) {
-	logrus.Warnf("PruneUnusedImages isn't implemented for Kubernetes yet")
-	return nil, nil
+	prunedImages, err := image_cache_functions.PruneUnusedImages(ctx, backend.kubernetesManager)
+	if err != nil {
+		return prunedImages, stacktrace.Propagate(err, "An error occurred pruning the unused Kurtosis images of the cluster")
+	}
+	return prunedImages, nil
 }
 
 func (backend *KubernetesKurtosisBackend) CreateEngine(
@@ -299,7 +332,8 @@ func (backend *KubernetesKurtosisBackend) StartRegisteredUserServices(
 		backend.apiContainerModeArgs,
 		backend.engineServerModeArgs,
 		backend.kubernetesManager,
-		restartPolicy)
+		restartPolicy,
+		backend.getBuiltImageReferences())
 	if err != nil {
 		var serviceUuids []service.ServiceUUID
 		for serviceUuid := range services {
@@ -337,6 +371,17 @@ func (backend *KubernetesKurtosisBackend) RemoveRegisteredUserServiceProcesses(
 	return successfullyStartedServices, failedServices, nil
 }
 
+// The name of a service is kept in the labels of its Kubernetes resources, so moving its registration isn't enough to rename it
+// The API container doesn't remove the process of a service it can't rename on Kubernetes, so this isn't expected to be called
+func (backend *KubernetesKurtosisBackend) RenameUserService(
+	_ context.Context,
+	enclaveUuid enclave.EnclaveUUID,
+	serviceUuid service.ServiceUUID,
+	newServiceName service.ServiceName,
+) (*service.ServiceRegistration, error) {
+	return nil, stacktrace.NewError("Renaming service '%v' of enclave '%v' to '%v' isn't supported on Kubernetes", serviceUuid, enclaveUuid, newServiceName)
+}
+
 func (backend *KubernetesKurtosisBackend) GetUserServices(
 	ctx context.Context,
 	enclaveUuid enclave.EnclaveUUID,
@@ -369,6 +414,21 @@ func (backend *KubernetesKurtosisBackend) GetUserServiceLogs(
 		backend.kubernetesManager)
 }
 
+func (backend *KubernetesKurtosisBackend) GetUserServiceStats(
+	ctx context.Context,
+	enclaveUuid enclave.EnclaveUUID,
+	filters *service.ServiceFilters,
+) (successfulUserServiceStats map[service.ServiceUUID]*service.ServiceStats, erroredUserServiceUuids map[service.ServiceUUID]error, resultError error) {
+	return user_services_functions.GetUserServiceStats(
+		ctx,
+		enclaveUuid,
+		filters,
+		backend.cliModeArgs,
+		backend.apiContainerModeArgs,
+		backend.engineServerModeArgs,
+		backend.kubernetesManager)
+}
+
 func (backend *KubernetesKurtosisBackend) RunUserServiceExecCommands(
 	ctx context.Context,
 	enclaveUuid enclave.EnclaveUUID,
@@ -379,13 +439,10 @@ func (backend *KubernetesKurtosisBackend) RunUserServiceExecCommands(
 	erroredUserServiceUuids map[service.ServiceUUID]error,
 	resultErr error,
 ) {
-	if containerUser != "" {
-		resultErr = stacktrace.NewError("--user not implemented for kurtosis backend")
-		return
-	}
 	return user_services_functions.RunUserServiceExecCommands(
 		ctx,
 		enclaveUuid,
+		containerUser,
 		userServiceCommands,
 		backend.cliModeArgs,
 		backend.apiContainerModeArgs,
@@ -410,13 +467,16 @@ func (backend *KubernetesKurtosisBackend) RunUserServiceExecCommandWithStreamedO
 		backend.kubernetesManager)
 }
 
-func (backend *KubernetesKurtosisBackend) GetShellOnUserService(ctx context.Context, enclaveUuid enclave.EnclaveUUID, serviceUuid service.ServiceUUID) (resultErr error) {
-	objectAndResources, err := shared_helpers.GetSingleUserServiceObjectsAndResources(ctx, enclaveUuid, serviceUuid, backend.cliModeArgs, backend.apiContainerModeArgs, backend.engineServerModeArgs, backend.kubernetesManager)
-	if err != nil {
-		return stacktrace.Propagate(err, "An error occurred getting user service object & Kubernetes resources for service '%v' in enclave '%v'", serviceUuid, enclaveUuid)
-	}
-	pod := objectAndResources.KubernetesResources.Pod
-	return backend.kubernetesManager.GetExecStream(ctx, pod)
+func (backend *KubernetesKurtosisBackend) GetShellOnUserService(ctx context.Context, enclaveUuid enclave.EnclaveUUID, serviceUuid service.ServiceUUID, containerUser string) (resultErr error) {
+	return user_services_functions.GetShellOnUserService(
+		ctx,
+		enclaveUuid,
+		serviceUuid,
+		containerUser,
+		backend.cliModeArgs,
+		backend.apiContainerModeArgs,
+		backend.engineServerModeArgs,
+		backend.kubernetesManager)
 }
 
 func (backend *KubernetesKurtosisBackend) CopyFilesFromUserService(
@@ -461,8 +521,19 @@ func (backend *KubernetesKurtosisBackend) DestroyUserServices(ctx context.Contex
 }
 
 func (backend *KubernetesKurtosisBackend) GetAvailableCPUAndMemory(ctx context.Context) (compute_resources.MemoryInMegaBytes, compute_resources.CpuMilliCores, bool, error) {
-	// TODO - implement resource calculation in kubernetes
-	return 0, 0, isResourceInformationComplete, nil
+	availableMemory, availableCpu, err := compute_resources_functions.GetAvailableCPUAndMemory(ctx, backend.kubernetesManager)
+	if err != nil {
+		return 0, 0, false, stacktrace.Propagate(err, "An error occurred fetching resource information from the Kubernetes cluster")
+	}
+	return availableMemory, availableCpu, isResourceInformationComplete, nil
+}
+
+func (backend *KubernetesKurtosisBackend) GetAvailableCPUAndMemoryPerNode(ctx context.Context) ([]*compute_resources.NodeResources, error) {
+	nodesResources, err := compute_resources_functions.GetAvailableCPUAndMemoryPerNode(ctx, backend.kubernetesManager)
+	if err != nil {
+		return nil, stacktrace.Propagate(err, "An error occurred fetching resource information per node from the Kubernetes cluster")
+	}
+	return nodesResources, nil
 }
 
 func (backend *KubernetesKurtosisBackend) GetLogsAggregator(
@@ -577,28 +648,96 @@ func (backend *KubernetesKurtosisBackend) DestroyLogsCollectorForEnclave(ctx con
 func (backend *KubernetesKurtosisBackend) GetReverseProxy(
 	ctx context.Context,
 ) (*reverse_proxy.ReverseProxy, error) {
-	// TODO IMPLEMENT
-	return nil, stacktrace.NewError("Getting the reverse proxy isn't yet implemented on Kubernetes")
+	maybeReverseProxy, err := reverse_proxy_functions.GetReverseProxy(ctx, backend.kubernetesManager)
+	if err != nil {
+		return nil, stacktrace.Propagate(err, "An error occurred getting the reverse proxy")
+	}
+	return maybeReverseProxy, nil
 }
 
 func (backend *KubernetesKurtosisBackend) CreateReverseProxy(ctx context.Context, engineGuid engine.EngineGUID) (*reverse_proxy.ReverseProxy, error) {
-	// TODO IMPLEMENT
-	return nil, stacktrace.NewError("Creating the reverse proxy isn't yet implemented on Kubernetes")
+	reverseProxy, _, err := reverse_proxy_functions.CreateReverseProxy(
+		ctx,
+		engineGuid,
+		backend.objAttrsProvider,
+		backend.kubernetesManager,
+	)
+	if err != nil {
+		return nil, stacktrace.Propagate(err, "An error occurred creating the reverse proxy")
+	}
+	return reverseProxy, nil
 }
 
 func (backend *KubernetesKurtosisBackend) DestroyReverseProxy(ctx context.Context) error {
-	// TODO IMPLEMENT
-	return stacktrace.NewError("Destroying the reverse proxy isn't yet implemented on Kubernetes")
+	if err := reverse_proxy_functions.DestroyReverseProxy(ctx, backend.kubernetesManager); err != nil {
+		return stacktrace.Propagate(err, "An error occurred destroying the reverse proxy")
+	}
+	return nil
+}
+
+func (backend *KubernetesKurtosisBackend) CreateImageRegistry(ctx context.Context) (*image_registry.ImageRegistry, error) {
+	imageRegistry, err := image_registry_functions.CreateImageRegistry(ctx, backend.objAttrsProvider, backend.kubernetesManager)
+	if err != nil {
+		return nil, stacktrace.Propagate(err, "An error occurred creating the image registry")
+	}
+	return imageRegistry, nil
 }
 
+func (backend *KubernetesKurtosisBackend) GetImageRegistry(ctx context.Context) (*image_registry.ImageRegistry, error) {
+	maybeImageRegistry, err := image_registry_functions.GetImageRegistry(ctx, backend.kubernetesManager)
+	if err != nil {
+		return nil, stacktrace.Propagate(err, "An error occurred getting the image registry")
+	}
+	return maybeImageRegistry, nil
+}
+
+func (backend *KubernetesKurtosisBackend) DestroyImageRegistry(ctx context.Context) error {
+	if err := image_registry_functions.DestroyImageRegistry(ctx, backend.kubernetesManager); err != nil {
+		return stacktrace.Propagate(err, "An error occurred destroying the image registry")
+	}
+	return nil
+}
+
+func (backend *KubernetesKurtosisBackend) ListImageRegistryRepositories(ctx context.Context) ([]*image_registry.ImageRegistryRepository, error) {
+	repositories, err := image_registry_functions.ListImageRegistryRepositories(ctx, backend.kubernetesManager)
+	if err != nil {
+		return nil, stacktrace.Propagate(err, "An error occurred listing the repositories of the image registry")
+	}
+	return repositories, nil
+}
+
+func (backend *KubernetesKurtosisBackend) PruneImageRegistry(ctx context.Context) ([]string, error) {
+	prunedRepositories, err := image_registry_functions.PruneImageRegistry(ctx, backend.kubernetesManager)
+	if err != nil {
+		return nil, stacktrace.Propagate(err, "An error occurred pruning the image registry")
+	}
+	return prunedRepositories, nil
+}
+
+// BuildImage returns an empty architecture as the image is built and stored in the cluster, never on this machine
 func (backend *KubernetesKurtosisBackend) BuildImage(ctx context.Context, imageName string, imageBuildSpec *image_build_spec.ImageBuildSpec) (string, error) {
-	// TODO IMPLEMENT
-	return "", stacktrace.NewError("Building images isn't yet implemented in Kubernetes.")
+	if backend.apiContainerModeArgs == nil {
+		return "", stacktrace.NewError("Images can only be built in the cluster by the API container")
+	}
+	imageReference, err := image_build_functions.BuildImage(ctx, imageName, imageBuildSpec, backend.apiContainerModeArgs, backend.kubernetesManager)
+	if err != nil {
+		return "", stacktrace.Propagate(err, "An error occurred building image '%v' in the cluster", imageName)
+	}
+	backend.setBuiltImageReference(imageName, imageReference)
+	return "", nil
 }
 
 func (backend *KubernetesKurtosisBackend) NixBuild(ctx context.Context, nixBuildSpec *nix_build_spec.NixBuildSpec) (string, error) {
-	// TODO IMPLEMENT
-	return "", stacktrace.NewError("Nix image building isn't yet implemented in Kubernetes.")
+	if backend.apiContainerModeArgs == nil {
+		return "", stacktrace.NewError("Nix images can only be built in the cluster by the API container")
+	}
+	imageName := nixBuildSpec.GetImageName()
+	imageReference, err := image_build_functions.NixBuild(ctx, nixBuildSpec, backend.apiContainerModeArgs, backend.kubernetesManager)
+	if err != nil {
+		return "", stacktrace.Propagate(err, "An error occurred building image '%v' from Nix flake '%v' in the cluster", imageName, nixBuildSpec.GetFullFlakeReference())
+	}
+	backend.setBuiltImageReference(imageName, imageReference)
+	return imageName, nil
 }
 
 // ====================================================================================================
@@ -606,6 +745,22 @@ func (backend *KubernetesKurtosisBackend) NixBuild(ctx context.Context, nixBuild
 //	Private Helper Functions
 //
 // ====================================================================================================
+func (backend *KubernetesKurtosisBackend) setBuiltImageReference(imageName string, imageReference string) {
+	backend.builtImageReferencesMutex.Lock()
+	defer backend.builtImageReferencesMutex.Unlock()
+	backend.builtImageReferences[imageName] = imageReference
+}
+
+func (backend *KubernetesKurtosisBackend) getBuiltImageReferences() map[string]string {
+	backend.builtImageReferencesMutex.RLock()
+	defer backend.builtImageReferencesMutex.RUnlock()
+	builtImageReferences := make(map[string]string, len(backend.builtImageReferences))
+	for imageName, imageReference := range backend.builtImageReferences {
+		builtImageReferences[imageName] = imageReference
+	}
+	return builtImageReferences
+}
+
 func (backend *KubernetesKurtosisBackend) getEnclaveNamespaceName(ctx context.Context, enclaveUuid enclave.EnclaveUUID) (string, error) {
 	// TODO This is a big janky hack that results from *KubernetesKurtosisBackend containing functions for all of API containers, engines, and CLIs
 	//  We want to fix this by splitting the *KubernetesKurtosisBackend into a bunch of different backends, one per user, but we can only
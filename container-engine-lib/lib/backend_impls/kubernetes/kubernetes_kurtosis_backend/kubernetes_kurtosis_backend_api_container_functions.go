@@ -255,9 +255,8 @@ func (backend *KubernetesKurtosisBackend) CreateAPIContainer(
 			},
 		},
 		{
-			// Necessary for the API container to list all nodes
-			// Temporarily adding perms to list nodes here to check for mono-node deployment for persistent volumes.
-			// TODO: remove once we support multi nodes deployments with persistent volumes
+			// Necessary for the API container to list all nodes, to check for mono-node deployment for persistent volumes
+			// and to get the cpu & memory allocatable on every node during validation
 			Verbs: []string{
 				kubernetes_manager_consts.ListKubernetesVerb,
 			},
@@ -268,6 +267,32 @@ func (backend *KubernetesKurtosisBackend) CreateAPIContainer(
 				kubernetes_manager_consts.NodesKubernetesResource,
 			},
 		},
+		{
+			// Necessary for the API container to sum what the pods of every namespace request on each node, so that
+			// validation catches packages asking for more cpu & memory than the cluster has left
+			Verbs: []string{
+				kubernetes_manager_consts.ListKubernetesVerb,
+			},
+			APIGroups: []string{
+				rbacv1.APIGroupAll,
+			},
+			Resources: []string{
+				kubernetes_manager_consts.PodsKubernetesResource,
+			},
+		},
+		{
+			// Necessary for the API container to find the engine image registry, which lives in its own namespace, to
+			// push the images it builds there
+			Verbs: []string{
+				kubernetes_manager_consts.ListKubernetesVerb,
+			},
+			APIGroups: []string{
+				rbacv1.APIGroupAll,
+			},
+			Resources: []string{
+				kubernetes_manager_consts.ServicesKubernetesResource,
+			},
+		},
 	}
 
 	apiContainerClusterRole, err := backend.kubernetesManager.CreateClusterRoles(ctx, clusterRoleName, clusterRolePolicyRules, clusterRoleLabels)
@@ -364,6 +389,9 @@ func (backend *KubernetesKurtosisBackend) CreateAPIContainer(
 				kubernetes_manager_consts.JobsKubernetesResource,
 				kubernetes_manager_consts.PersistentVolumeClaimsKubernetesResource,
 				kubernetes_manager_consts.IngressesKubernetesResource,
+				// Image pre-pulling runs a daemon set and reads the kubelet events of its pods
+				kubernetes_manager_consts.DaemonSetsKubernetesResource,
+				kubernetes_manager_consts.EventsKubernetesResource,
 			},
 		},
 		{
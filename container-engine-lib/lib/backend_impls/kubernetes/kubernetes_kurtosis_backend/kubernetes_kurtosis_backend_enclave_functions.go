@@ -1,30 +1,57 @@
 package kubernetes_kurtosis_backend
 
 import (
+	"bytes"
 	"context"
 	"fmt"
 	"github.com/kurtosis-tech/kurtosis/container-engine-lib/lib/backend_impls/kubernetes/kubernetes_kurtosis_backend/logs_aggregator_functions"
 	"github.com/kurtosis-tech/kurtosis/container-engine-lib/lib/backend_impls/kubernetes/kubernetes_kurtosis_backend/logs_aggregator_functions/implementations/vector"
 	"github.com/kurtosis-tech/kurtosis/container-engine-lib/lib/backend_impls/kubernetes/kubernetes_kurtosis_backend/logs_collector_functions"
 	"github.com/kurtosis-tech/kurtosis/container-engine-lib/lib/backend_impls/kubernetes/kubernetes_kurtosis_backend/logs_collector_functions/implementations/fluentbit"
+	"github.com/kurtosis-tech/kurtosis/container-engine-lib/lib/backend_impls/kubernetes/object_attributes_provider/kubernetes_annotation_key"
 	"github.com/kurtosis-tech/kurtosis/container-engine-lib/lib/backend_impls/kubernetes/object_attributes_provider/kubernetes_label_key"
+	"io"
+	"path"
 	"strings"
 	"time"
 
+	"github.com/kurtosis-tech/kurtosis/container-engine-lib/lib/backend_impls/kubernetes/enclave_namespace_config"
 	"github.com/kurtosis-tech/kurtosis/container-engine-lib/lib/backend_impls/kubernetes/kubernetes_kurtosis_backend/shared_helpers"
 	"github.com/kurtosis-tech/kurtosis/container-engine-lib/lib/backend_impls/kubernetes/kubernetes_resource_collectors"
 	"github.com/kurtosis-tech/kurtosis/container-engine-lib/lib/backend_impls/kubernetes/object_attributes_provider/kubernetes_annotation_key_consts"
 	"github.com/kurtosis-tech/kurtosis/container-engine-lib/lib/backend_impls/kubernetes/object_attributes_provider/label_value_consts"
 	"github.com/kurtosis-tech/kurtosis/container-engine-lib/lib/backend_interface/objects/container"
 	"github.com/kurtosis-tech/kurtosis/container-engine-lib/lib/backend_interface/objects/enclave"
+	"github.com/kurtosis-tech/kurtosis/container-engine-lib/lib/backend_interface/objects/service_directory"
 	"github.com/kurtosis-tech/kurtosis/container-engine-lib/lib/operation_parallelizer"
 	"github.com/kurtosis-tech/stacktrace"
 	"github.com/sirupsen/logrus"
 	apiv1 "k8s.io/api/core/v1"
+	netv1 "k8s.io/api/networking/v1"
 	rbacv1 "k8s.io/api/rbac/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	applyconfigurationsv1 "k8s.io/client-go/applyconfigurations/core/v1"
 )
 
+const (
+	// Pod names can't contain underscores so this can't conflict with the directory of a pod of the enclave
+	enclaveDataVolumeDumpDirname = "enclave_data"
+
+	enclaveDataVolumeTarSuccessExitCode = 0
+
+	// Names of the objects created in the enclave namespace as per the enclave namespace config
+	enclaveResourceQuotaName              = "kurtosis-enclave-quota"
+	enclaveLimitRangeName                 = "kurtosis-enclave-limits"
+	intraEnclaveNetworkPolicyName         = "kurtosis-allow-intra-enclave"
+	engineToApiContainerNetworkPolicyName = "kurtosis-allow-engine-to-api-container"
+)
+
+var enclaveExpirationAnnotationKeys = []*kubernetes_annotation_key.KubernetesAnnotationKey{
+	kubernetes_annotation_key_consts.EnclaveExpirationTimeAnnotationKey,
+	kubernetes_annotation_key_consts.EnclaveIdleTimeoutAnnotationKey,
+	kubernetes_annotation_key_consts.ExpiredEnclaveActionAnnotationKey,
+}
+
 // TODO: MIGRATE THIS FOLDER TO USE STRUCTURE OF USER_SERVICE_FUNCTIONS MODULE
 
 // Any of these values being nil indicates that the resource doesn't exist
@@ -85,6 +112,17 @@ func (backend *KubernetesKurtosisBackend) CreateEnclave(
 	enclaveNamespaceLabels := shared_helpers.GetStringMapFromLabelMap(enclaveNamespaceAttrs.GetLabels())
 	enclaveAnnotationsStrs := shared_helpers.GetStringMapFromAnnotationMap(enclaveNamespaceAttrs.GetAnnotations())
 
+	var enclaveNamespaceConfig *enclave_namespace_config.EnclaveNamespaceConfig
+	if backend.engineServerModeArgs != nil {
+		enclaveNamespaceConfig = backend.engineServerModeArgs.GetEnclaveNamespaceConfig()
+	}
+	if enclaveNamespaceConfig != nil {
+		enclaveNamespaceLabels, enclaveAnnotationsStrs, err = addConfiguredEnclaveNamespaceMetadata(enclaveNamespaceConfig, enclaveUuid, enclaveName, enclaveNamespaceLabels, enclaveAnnotationsStrs)
+		if err != nil {
+			return nil, stacktrace.Propagate(err, "An error occurred adding the configured labels and annotations to the namespace of enclave '%v'", enclaveUuid)
+		}
+	}
+
 	enclaveNamespace, err := backend.kubernetesManager.CreateNamespace(ctx, enclaveNamespaceName, enclaveNamespaceLabels, enclaveAnnotationsStrs)
 	if err != nil {
 		return nil, stacktrace.Propagate(err, "Failed to create namespace with name '%v' for enclave '%v'", enclaveNamespaceName, enclaveUuid)
@@ -99,6 +137,13 @@ func (backend *KubernetesKurtosisBackend) CreateEnclave(
 		}
 	}()
 
+	// The namespace gets deleted on failure, which removes anything created in it
+	if enclaveNamespaceConfig != nil {
+		if err := backend.createConfiguredEnclaveNamespaceObjects(ctx, enclaveNamespaceConfig, enclaveNamespaceName, enclaveUuid); err != nil {
+			return nil, stacktrace.Propagate(err, "An error occurred creating the configured quota and network policy objects in the namespace of enclave '%v'", enclaveUuid)
+		}
+	}
+
 	enclaveResources := &enclaveKubernetesResources{
 		namespace:           enclaveNamespace,
 		pods:                []apiv1.Pod{},
@@ -151,9 +196,8 @@ func (backend *KubernetesKurtosisBackend) UpdateEnclave(
 		return stacktrace.NewError("Cannot rename enclave '%v' because no Kubernetes namespace exists for it", enclaveUuid)
 	}
 
-	updatedAnnotations := map[string]string{
-		kubernetes_annotation_key_consts.EnclaveNameAnnotationKey.GetString(): newName,
-	}
+	updatedAnnotations := getAppliedEnclaveNamespaceAnnotations(namespace)
+	updatedAnnotations[kubernetes_annotation_key_consts.EnclaveNameAnnotationKey.GetString()] = newName
 
 	if newCreationTime != nil {
 		newCreationTimeStr := newCreationTime.Format(time.RFC3339)
@@ -162,6 +206,7 @@ func (backend *KubernetesKurtosisBackend) UpdateEnclave(
 
 	namespaceApplyConfigurator := func(namespaceApplyConfig *applyconfigurationsv1.NamespaceApplyConfiguration) {
 		namespaceApplyConfig.WithAnnotations(updatedAnnotations)
+		namespaceApplyConfig.WithLabels(getAppliedEnclaveNamespaceLabels(namespace))
 	}
 
 	if _, err := backend.kubernetesManager.UpdateNamespace(ctx, namespace.GetName(), namespaceApplyConfigurator); err != nil {
@@ -171,6 +216,79 @@ func (backend *KubernetesKurtosisBackend) UpdateEnclave(
 	return nil
 }
 
+func (backend *KubernetesKurtosisBackend) UpdateEnclaveExpiration(
+	ctx context.Context,
+	enclaveUuid enclave.EnclaveUUID,
+	expiration *enclave.EnclaveExpiration,
+) error {
+	_, kubernetesResources, err := backend.getSingleEnclaveAndKubernetesResources(ctx, enclaveUuid)
+	if err != nil {
+		return stacktrace.Propagate(err, "An error occurred getting enclave object and Kubernetes resources for enclave ID '%v'", enclaveUuid)
+	}
+	namespace := kubernetesResources.namespace
+	if namespace == nil {
+		return stacktrace.NewError("Cannot update the expiration of enclave '%v' because no Kubernetes namespace exists for it", enclaveUuid)
+	}
+
+	updatedAnnotations := getAppliedEnclaveNamespaceAnnotations(namespace)
+	for _, expirationAnnotationKey := range enclaveExpirationAnnotationKeys {
+		delete(updatedAnnotations, expirationAnnotationKey.GetString())
+	}
+	if expiration != nil {
+		if expirationTime := expiration.GetExpirationTime(); expirationTime != nil {
+			updatedAnnotations[kubernetes_annotation_key_consts.EnclaveExpirationTimeAnnotationKey.GetString()] = expirationTime.Format(time.RFC3339)
+		}
+		if idleTimeout := expiration.GetIdleTimeout(); idleTimeout > 0 {
+			updatedAnnotations[kubernetes_annotation_key_consts.EnclaveIdleTimeoutAnnotationKey.GetString()] = idleTimeout.String()
+		}
+		updatedAnnotations[kubernetes_annotation_key_consts.ExpiredEnclaveActionAnnotationKey.GetString()] = expiration.GetAction().String()
+	}
+
+	namespaceApplyConfigurator := func(namespaceApplyConfig *applyconfigurationsv1.NamespaceApplyConfiguration) {
+		namespaceApplyConfig.WithAnnotations(updatedAnnotations)
+		namespaceApplyConfig.WithLabels(getAppliedEnclaveNamespaceLabels(namespace))
+	}
+
+	if _, err := backend.kubernetesManager.UpdateNamespace(ctx, namespace.GetName(), namespaceApplyConfigurator); err != nil {
+		return stacktrace.Propagate(err, "An error occurred updating the expiration of enclave with UUID '%v', it was trying to apply these new annotations '%+v'", enclaveUuid, updatedAnnotations)
+	}
+
+	return nil
+}
+
+func (backend *KubernetesKurtosisBackend) UpdateEnclaveLabels(
+	ctx context.Context,
+	enclaveUuid enclave.EnclaveUUID,
+	labels map[string]string,
+) error {
+	_, kubernetesResources, err := backend.getSingleEnclaveAndKubernetesResources(ctx, enclaveUuid)
+	if err != nil {
+		return stacktrace.Propagate(err, "An error occurred getting enclave object and Kubernetes resources for enclave ID '%v'", enclaveUuid)
+	}
+	namespace := kubernetesResources.namespace
+	if namespace == nil {
+		return stacktrace.NewError("Cannot update the labels of enclave '%v' because no Kubernetes namespace exists for it", enclaveUuid)
+	}
+
+	enclaveObjAttrsProvider := backend.objAttrsProvider.ForEnclave(enclaveUuid)
+	userLabels, err := enclaveObjAttrsProvider.ForEnclaveNamespaceUserLabels(labels)
+	if err != nil {
+		return stacktrace.Propagate(err, "An error occurred getting the namespace labels for the labels '%+v' of enclave '%v'", labels, enclaveUuid)
+	}
+	updatedLabels := shared_helpers.GetStringMapFromLabelMap(userLabels)
+
+	namespaceApplyConfigurator := func(namespaceApplyConfig *applyconfigurationsv1.NamespaceApplyConfiguration) {
+		namespaceApplyConfig.WithAnnotations(getAppliedEnclaveNamespaceAnnotations(namespace))
+		namespaceApplyConfig.WithLabels(updatedLabels)
+	}
+
+	if _, err := backend.kubernetesManager.UpdateNamespace(ctx, namespace.GetName(), namespaceApplyConfigurator); err != nil {
+		return stacktrace.Propagate(err, "An error occurred updating the labels of enclave with UUID '%v', it was trying to apply these new labels '%+v'", enclaveUuid, updatedLabels)
+	}
+
+	return nil
+}
+
 func (backend *KubernetesKurtosisBackend) StopEnclaves(
 	ctx context.Context,
 	filters *enclave.EnclaveFilters,
@@ -248,6 +366,35 @@ func (backend *KubernetesKurtosisBackend) StopEnclaves(
 	return successfulEnclaveIds, erroredEnclaveIds, nil
 }
 
+// Persistent directories are persistent volume claims, which can only be mounted by pods of their own namespace, so no
+// pod can read the ones of the source enclave and write the ones of the destination enclave
+func (backend *KubernetesKurtosisBackend) CopyEnclavePersistentDirectories(
+	ctx context.Context,
+	sourceEnclaveUuid enclave.EnclaveUUID,
+	destinationEnclaveUuid enclave.EnclaveUUID,
+) ([]service_directory.DirectoryPersistentKey, error) {
+	return nil, stacktrace.NewError("Copying the persistent directories of enclave '%v' to enclave '%v' isn't supported on Kubernetes", sourceEnclaveUuid, destinationEnclaveUuid)
+}
+
+// Reading and writing persistent volume claims requires running a pod mounting them, which isn't implemented yet
+func (backend *KubernetesKurtosisBackend) ExportEnclavePersistentDirectory(
+	ctx context.Context,
+	enclaveUuid enclave.EnclaveUUID,
+	persistentKey service_directory.DirectoryPersistentKey,
+	destination io.Writer,
+) error {
+	return stacktrace.NewError("Exporting persistent directory '%v' of enclave '%v' isn't supported on Kubernetes", persistentKey, enclaveUuid)
+}
+
+func (backend *KubernetesKurtosisBackend) ImportEnclavePersistentDirectory(
+	ctx context.Context,
+	enclaveUuid enclave.EnclaveUUID,
+	persistentKey service_directory.DirectoryPersistentKey,
+	content io.Reader,
+) error {
+	return stacktrace.NewError("Importing persistent directory '%v' into enclave '%v' isn't supported on Kubernetes", persistentKey, enclaveUuid)
+}
+
 func (backend *KubernetesKurtosisBackend) DumpEnclave(ctx context.Context, enclaveUuid enclave.EnclaveUUID, outputDirpath string) error {
 	_, kubernetesResources, err := backend.getSingleEnclaveAndKubernetesResources(ctx, enclaveUuid)
 	if err != nil {
@@ -267,6 +414,10 @@ func (backend *KubernetesKurtosisBackend) DumpEnclave(ctx context.Context, encla
 		return stacktrace.Propagate(err, "An error occurred dumping pods '%+v' in namespace '%v'", podsToDump, namespace.GetName())
 	}
 
+	if err = backend.dumpEnclaveDataVolume(ctx, namespace.GetName(), podsToDump, path.Join(outputDirpath, enclaveDataVolumeDumpDirname)); err != nil {
+		return stacktrace.Propagate(err, "An error occurred dumping the enclave data volume of enclave '%v'", enclaveUuid)
+	}
+
 	return nil
 }
 
@@ -575,12 +726,20 @@ func getEnclaveObjectsFromKubernetesResources(
 
 		enclaveName := getEnclaveNameFromEnclaveNamespace(resourcesForEnclaveId.namespace)
 
+		enclaveExpiration, err := getEnclaveExpirationFromEnclaveNamespace(resourcesForEnclaveId.namespace)
+		if err != nil {
+			// One enclave with a broken expiration mustn't make all the others unlistable, it just never expires
+			logrus.Warnf("An error occurred getting the expiration of enclave '%v' from namespace '%v', it won't expire until it's given a new one. Error was:\n%v", enclaveId, resourcesForEnclaveId.namespace.Name, err)
+		}
+
 		enclaveObj := enclave.NewEnclave(
 			enclaveId,
 			enclaveName,
 			enclaveStatus,
 			enclaveCreationTime,
 			false,
+			enclaveExpiration,
+			getEnclaveLabelsFromEnclaveNamespace(resourcesForEnclaveId.namespace),
 		)
 
 		result[enclaveId] = enclaveObj
@@ -638,3 +797,293 @@ func getEnclaveNameFromEnclaveNamespace(namespace *apiv1.Namespace) string {
 
 	return enclaveCreationTimeStr
 }
+
+// Returns nil if the enclave never expires
+func getEnclaveExpirationFromEnclaveNamespace(namespace *apiv1.Namespace) (*enclave.EnclaveExpiration, error) {
+	namespaceAnnotations := namespace.Annotations
+
+	expiredEnclaveActionStr, found := namespaceAnnotations[kubernetes_annotation_key_consts.ExpiredEnclaveActionAnnotationKey.GetString()]
+	if !found {
+		return nil, nil
+	}
+	expiredEnclaveAction, err := enclave.ExpiredEnclaveActionString(expiredEnclaveActionStr)
+	if err != nil {
+		return nil, stacktrace.Propagate(err, "An error occurred parsing expired enclave action '%v'", expiredEnclaveActionStr)
+	}
+
+	var expirationTime *time.Time
+	if expirationTimeStr, found := namespaceAnnotations[kubernetes_annotation_key_consts.EnclaveExpirationTimeAnnotationKey.GetString()]; found {
+		parsedExpirationTime, err := time.Parse(time.RFC3339, expirationTimeStr)
+		if err != nil {
+			return nil, stacktrace.Propagate(err, "An error occurred parsing enclave expiration time '%v' using this format '%v'", expirationTimeStr, time.RFC3339)
+		}
+		expirationTime = &parsedExpirationTime
+	}
+
+	var idleTimeout time.Duration
+	if idleTimeoutStr, found := namespaceAnnotations[kubernetes_annotation_key_consts.EnclaveIdleTimeoutAnnotationKey.GetString()]; found {
+		idleTimeout, err = time.ParseDuration(idleTimeoutStr)
+		if err != nil {
+			return nil, stacktrace.Propagate(err, "An error occurred parsing enclave idle timeout '%v'", idleTimeoutStr)
+		}
+	}
+
+	return enclave.NewEnclaveExpiration(expirationTime, idleTimeout, expiredEnclaveAction), nil
+}
+
+func getEnclaveLabelsFromEnclaveNamespace(namespace *apiv1.Namespace) map[string]string {
+	enclaveLabels := map[string]string{}
+	for labelKeyStr, labelValueStr := range namespace.Labels {
+		if userLabelKey, isUserLabel := kubernetes_label_key.GetUserCustomLabelKey(labelKeyStr); isUserLabel {
+			enclaveLabels[userLabelKey] = labelValueStr
+		}
+	}
+	return enclaveLabels
+}
+
+// Namespace updates are server-side applies, which drop the annotations that a previous apply set but the new one
+// leaves out, so every update has to start from the annotations the previous ones applied
+func getAppliedEnclaveNamespaceAnnotations(namespace *apiv1.Namespace) map[string]string {
+	appliedAnnotationKeys := append(
+		[]*kubernetes_annotation_key.KubernetesAnnotationKey{
+			kubernetes_annotation_key_consts.EnclaveNameAnnotationKey,
+			kubernetes_annotation_key_consts.EnclaveCreationTimeAnnotationKey,
+		},
+		enclaveExpirationAnnotationKeys...,
+	)
+	appliedAnnotations := map[string]string{}
+	for _, annotationKey := range appliedAnnotationKeys {
+		if annotationValue, found := namespace.Annotations[annotationKey.GetString()]; found {
+			appliedAnnotations[annotationKey.GetString()] = annotationValue
+		}
+	}
+	return appliedAnnotations
+}
+
+// The user labels of the enclave are the only namespace labels set through applies, so they're the ones every update
+// has to carry over
+func getAppliedEnclaveNamespaceLabels(namespace *apiv1.Namespace) map[string]string {
+	appliedLabels := map[string]string{}
+	for labelKeyStr, labelValueStr := range namespace.Labels {
+		if _, isUserLabel := kubernetes_label_key.GetUserCustomLabelKey(labelKeyStr); isUserLabel {
+			appliedLabels[labelKeyStr] = labelValueStr
+		}
+	}
+	return appliedLabels
+}
+
+// The enclave data volume is only mounted in the API container, so its content gets copied out of the API container pod
+// It's a no-op when the API container isn't running, as there's nothing to exec into
+func (backend *KubernetesKurtosisBackend) dumpEnclaveDataVolume(
+	ctx context.Context,
+	namespaceName string,
+	enclavePods []apiv1.Pod,
+	outputDirpath string,
+) error {
+	apiContainerResourceTypeLabelValueStr := label_value_consts.APIContainerKurtosisResourceTypeKubernetesLabelValue.GetString()
+	var apiContainerPod *apiv1.Pod
+	for podIdx, pod := range enclavePods {
+		if pod.Labels[kubernetes_label_key.KurtosisResourceTypeKubernetesLabelKey.GetString()] == apiContainerResourceTypeLabelValueStr {
+			apiContainerPod = &enclavePods[podIdx]
+			break
+		}
+	}
+	if apiContainerPod == nil || apiContainerPod.Status.Phase != apiv1.PodRunning {
+		logrus.Debugf("Not dumping the enclave data volume of namespace '%v' as it has no running API container pod", namespaceName)
+		return nil
+	}
+
+	enclaveDataVolumeDirpath := ""
+	for _, podContainer := range apiContainerPod.Spec.Containers {
+		if podContainer.Name != kurtosisApiContainerContainerName {
+			continue
+		}
+		for _, volumeMount := range podContainer.VolumeMounts {
+			if volumeMount.Name == enclaveDataDirVolumeName {
+				enclaveDataVolumeDirpath = volumeMount.MountPath
+			}
+		}
+	}
+	if enclaveDataVolumeDirpath == "" {
+		return stacktrace.NewError("Couldn't find where the enclave data volume is mounted in API container pod '%v'", apiContainerPod.Name)
+	}
+
+	tarCommand := []string{"tar", "cf", "-", "-C", enclaveDataVolumeDirpath, "."}
+	archiveReader, archiveWriter := io.Pipe()
+	defer archiveReader.Close()
+	go func() {
+		stdErrOutput := &bytes.Buffer{}
+		exitCode, err := backend.kubernetesManager.RunExecCommandWithContext(ctx, namespaceName, apiContainerPod.Name, kurtosisApiContainerContainerName, tarCommand, archiveWriter, stdErrOutput)
+		if err == nil && exitCode != enclaveDataVolumeTarSuccessExitCode {
+			err = stacktrace.NewError("Command '%v' exited with non-%v exit code %v and the following STDERR:\n%v", tarCommand, enclaveDataVolumeTarSuccessExitCode, exitCode, stdErrOutput.String())
+		}
+		// A nil error closes the pipe with an EOF, which is what ends the archive extraction
+		archiveWriter.CloseWithError(err)
+	}()
+
+	if err := shared_helpers.ExtractTarArchive(archiveReader, outputDirpath); err != nil {
+		return stacktrace.Propagate(err, "An error occurred copying directory '%v' of API container pod '%v' to '%v'", enclaveDataVolumeDirpath, apiContainerPod.Name, outputDirpath)
+	}
+	return nil
+}
+
+// addConfiguredEnclaveNamespaceMetadata renders the configured labels and annotations of the enclave namespace and adds
+// them to the ones Kurtosis sets, which win on conflict as Kurtosis relies on them to find the enclave
+func addConfiguredEnclaveNamespaceMetadata(
+	enclaveNamespaceConfig *enclave_namespace_config.EnclaveNamespaceConfig,
+	enclaveUuid enclave.EnclaveUUID,
+	enclaveName string,
+	kurtosisLabels map[string]string,
+	kurtosisAnnotations map[string]string,
+) (map[string]string, map[string]string, error) {
+	configuredLabels, err := enclaveNamespaceConfig.RenderLabels(enclaveUuid, enclaveName)
+	if err != nil {
+		return nil, nil, stacktrace.Propagate(err, "An error occurred rendering the configured enclave namespace labels")
+	}
+	configuredAnnotations, err := enclaveNamespaceConfig.RenderAnnotations(enclaveUuid, enclaveName)
+	if err != nil {
+		return nil, nil, stacktrace.Propagate(err, "An error occurred rendering the configured enclave namespace annotations")
+	}
+	for labelKey, labelValue := range kurtosisLabels {
+		configuredLabels[labelKey] = labelValue
+	}
+	for annotationKey, annotationValue := range kurtosisAnnotations {
+		configuredAnnotations[annotationKey] = annotationValue
+	}
+	return configuredLabels, configuredAnnotations, nil
+}
+
+func (backend *KubernetesKurtosisBackend) createConfiguredEnclaveNamespaceObjects(
+	ctx context.Context,
+	enclaveNamespaceConfig *enclave_namespace_config.EnclaveNamespaceConfig,
+	enclaveNamespaceName string,
+	enclaveUuid enclave.EnclaveUUID,
+) error {
+	objectLabels := map[string]string{
+		kubernetes_label_key.AppIDKubernetesLabelKey.GetString():       label_value_consts.AppIDKubernetesLabelValue.GetString(),
+		kubernetes_label_key.EnclaveUUIDKubernetesLabelKey.GetString(): string(enclaveUuid),
+	}
+
+	if len(enclaveNamespaceConfig.ResourceQuota) > 0 {
+		hardLimits, err := getResourceList(enclaveNamespaceConfig.ResourceQuota)
+		if err != nil {
+			return stacktrace.Propagate(err, "An error occurred parsing the hard limits of the enclave resource quota")
+		}
+		if _, err := backend.kubernetesManager.CreateResourceQuota(ctx, enclaveNamespaceName, enclaveResourceQuotaName, objectLabels, hardLimits); err != nil {
+			return stacktrace.Propagate(err, "An error occurred creating the resource quota of the enclave")
+		}
+	}
+
+	if enclaveNamespaceConfig.LimitRange != nil {
+		limitRangeItem, err := getContainerLimitRangeItem(enclaveNamespaceConfig.LimitRange)
+		if err != nil {
+			return stacktrace.Propagate(err, "An error occurred parsing the limits of the enclave limit range")
+		}
+		if _, err := backend.kubernetesManager.CreateLimitRange(ctx, enclaveNamespaceName, enclaveLimitRangeName, objectLabels, []apiv1.LimitRangeItem{*limitRangeItem}); err != nil {
+			return stacktrace.Propagate(err, "An error occurred creating the limit range of the enclave")
+		}
+	}
+
+	if enclaveNamespaceConfig.ShouldCreateNetworkPolicy {
+		if err := backend.createEnclaveNetworkPolicies(ctx, enclaveNamespaceName, objectLabels); err != nil {
+			return stacktrace.Propagate(err, "An error occurred creating the network policies of the enclave")
+		}
+	}
+	return nil
+}
+
+// createEnclaveNetworkPolicies isolates the enclave: any pod of the enclave can be reached from the other pods of the
+// enclave, and the API container can additionally be reached by the engine, which lives in a different namespace
+func (backend *KubernetesKurtosisBackend) createEnclaveNetworkPolicies(ctx context.Context, enclaveNamespaceName string, objectLabels map[string]string) error {
+	allPodsSelector := metav1.LabelSelector{
+		MatchLabels:      nil,
+		MatchExpressions: nil,
+	}
+	intraEnclaveIngressRules := []netv1.NetworkPolicyIngressRule{
+		{
+			Ports: nil,
+			From: []netv1.NetworkPolicyPeer{
+				{
+					// Without a namespace selector, this only matches the pods of the enclave namespace
+					PodSelector:       &allPodsSelector,
+					NamespaceSelector: nil,
+					IPBlock:           nil,
+				},
+			},
+		},
+	}
+	if _, err := backend.kubernetesManager.CreateIngressNetworkPolicy(ctx, enclaveNamespaceName, intraEnclaveNetworkPolicyName, objectLabels, allPodsSelector, intraEnclaveIngressRules); err != nil {
+		return stacktrace.Propagate(err, "An error occurred creating the network policy allowing traffic within the enclave")
+	}
+
+	apiContainerSelector := metav1.LabelSelector{
+		MatchLabels: map[string]string{
+			kubernetes_label_key.AppIDKubernetesLabelKey.GetString():                label_value_consts.AppIDKubernetesLabelValue.GetString(),
+			kubernetes_label_key.KurtosisResourceTypeKubernetesLabelKey.GetString(): label_value_consts.APIContainerKurtosisResourceTypeKubernetesLabelValue.GetString(),
+		},
+		MatchExpressions: nil,
+	}
+	enginePodsSelector := metav1.LabelSelector{
+		MatchLabels: map[string]string{
+			kubernetes_label_key.AppIDKubernetesLabelKey.GetString():                label_value_consts.AppIDKubernetesLabelValue.GetString(),
+			kubernetes_label_key.KurtosisResourceTypeKubernetesLabelKey.GetString(): label_value_consts.EngineKurtosisResourceTypeKubernetesLabelValue.GetString(),
+		},
+		MatchExpressions: nil,
+	}
+	engineToApiContainerIngressRules := []netv1.NetworkPolicyIngressRule{
+		{
+			Ports: nil,
+			From: []netv1.NetworkPolicyPeer{
+				{
+					PodSelector: &enginePodsSelector,
+					// The engine namespace's name isn't known to the engine, so engine pods are matched in any namespace
+					NamespaceSelector: &allPodsSelector,
+					IPBlock:           nil,
+				},
+			},
+		},
+	}
+	if _, err := backend.kubernetesManager.CreateIngressNetworkPolicy(ctx, enclaveNamespaceName, engineToApiContainerNetworkPolicyName, objectLabels, apiContainerSelector, engineToApiContainerIngressRules); err != nil {
+		return stacktrace.Propagate(err, "An error occurred creating the network policy allowing traffic from the engine to the API container")
+	}
+	return nil
+}
+
+func getContainerLimitRangeItem(limitRangeConfig *enclave_namespace_config.LimitRangeConfig) (*apiv1.LimitRangeItem, error) {
+	maxLimits, err := getResourceList(limitRangeConfig.Max)
+	if err != nil {
+		return nil, stacktrace.Propagate(err, "An error occurred parsing the max limits")
+	}
+	minLimits, err := getResourceList(limitRangeConfig.Min)
+	if err != nil {
+		return nil, stacktrace.Propagate(err, "An error occurred parsing the min limits")
+	}
+	defaultLimits, err := getResourceList(limitRangeConfig.Default)
+	if err != nil {
+		return nil, stacktrace.Propagate(err, "An error occurred parsing the default limits")
+	}
+	defaultRequests, err := getResourceList(limitRangeConfig.DefaultRequest)
+	if err != nil {
+		return nil, stacktrace.Propagate(err, "An error occurred parsing the default requests")
+	}
+	return &apiv1.LimitRangeItem{
+		Type:                 apiv1.LimitTypeContainer,
+		Max:                  maxLimits,
+		Min:                  minLimits,
+		Default:              defaultLimits,
+		DefaultRequest:       defaultRequests,
+		MaxLimitRequestRatio: nil,
+	}, nil
+}
+
+func getResourceList(quantityStrs map[string]string) (apiv1.ResourceList, error) {
+	quantities, err := enclave_namespace_config.ParseQuantities(quantityStrs)
+	if err != nil {
+		return nil, stacktrace.Propagate(err, "An error occurred parsing quantities '%+v'", quantityStrs)
+	}
+	resourceList := apiv1.ResourceList{}
+	for resourceName, quantity := range quantities {
+		resourceList[apiv1.ResourceName(resourceName)] = quantity
+	}
+	return resourceList, nil
+}
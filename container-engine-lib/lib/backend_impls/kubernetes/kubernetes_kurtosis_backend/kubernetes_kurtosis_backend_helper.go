@@ -2,6 +2,7 @@ package kubernetes_kurtosis_backend
 
 import (
 	"context"
+	"github.com/kurtosis-tech/kurtosis/container-engine-lib/lib/backend_impls/kubernetes/enclave_namespace_config"
 	"github.com/kurtosis-tech/kurtosis/container-engine-lib/lib/backend_impls/kubernetes/kubernetes_manager"
 	"github.com/kurtosis-tech/kurtosis/container-engine-lib/lib/backend_impls/kubernetes/object_attributes_provider/kubernetes_label_key"
 	"github.com/kurtosis-tech/kurtosis/container-engine-lib/lib/backend_impls/metrics_reporting"
@@ -40,7 +41,7 @@ func GetCLIBackend(ctx context.Context, storageClass string, engineNodeName stri
 }
 
 func GetEngineServerBackend(
-	ctx context.Context, storageClass string,
+	ctx context.Context, storageClass string, enclaveNamespaceConfig *enclave_namespace_config.EnclaveNamespaceConfig,
 ) (backend_interface.KurtosisBackend, error) {
 	kubernetesConfig, err := rest.InClusterConfig()
 	if err != nil {
@@ -50,6 +51,7 @@ func GetEngineServerBackend(
 	backendSupplier := func(_ context.Context, kubernetesManager *kubernetes_manager.KubernetesManager) (*KubernetesKurtosisBackend, error) {
 		return NewEngineServerKubernetesKurtosisBackend(
 			kubernetesManager,
+			enclaveNamespaceConfig,
 		), nil
 	}
 
@@ -69,6 +71,7 @@ func GetEngineServerBackend(
 func GetApiContainerBackend(
 	ctx context.Context,
 	storageClass string,
+	imageBuildRegistry string,
 	productionMode bool,
 ) (backend_interface.KurtosisBackend, error) {
 	kubernetesConfig, err := rest.InClusterConfig()
@@ -103,6 +106,7 @@ func GetApiContainerBackend(
 			enclaveId,
 			namespaceName,
 			storageClass,
+			imageBuildRegistry,
 			productionMode,
 		), nil
 	}
@@ -0,0 +1,40 @@
+package logs_aggregator_functions
+
+import (
+	"context"
+
+	"github.com/kurtosis-tech/kurtosis/container-engine-lib/lib/backend_impls/kubernetes/kubernetes_kurtosis_backend/shared_helpers"
+	"github.com/kurtosis-tech/kurtosis/container-engine-lib/lib/backend_impls/kubernetes/kubernetes_manager"
+	"github.com/kurtosis-tech/stacktrace"
+	"github.com/sirupsen/logrus"
+	apiv1 "k8s.io/api/core/v1"
+)
+
+// DumpLogsAggregator dumps the pods of the logs aggregator deployment into the received output dirpath
+// It's a no-op if the cluster has no logs aggregator
+func DumpLogsAggregator(ctx context.Context, outputDirpath string, kubernetesManager *kubernetes_manager.KubernetesManager) error {
+	logsAggregatorResources, err := getLogsAggregatorKubernetesResourcesForCluster(ctx, kubernetesManager)
+	if err != nil {
+		return stacktrace.Propagate(err, "An error occurred retrieving kubernetes resources for logs aggregator.")
+	}
+	if logsAggregatorResources.namespace == nil {
+		logrus.Debug("No logs aggregator namespace found. Returning without dumping the logs aggregator.")
+		return nil
+	}
+
+	podsToDump := []apiv1.Pod{}
+	if logsAggregatorResources.deployment != nil {
+		logsAggregatorPods, err := kubernetesManager.GetPodsManagedByDeployment(ctx, logsAggregatorResources.deployment)
+		if err != nil {
+			return stacktrace.Propagate(err, "An error occurred getting pods managed by logs aggregator deployment '%v'.", logsAggregatorResources.deployment.Name)
+		}
+		for _, pod := range logsAggregatorPods {
+			podsToDump = append(podsToDump, *pod)
+		}
+	}
+
+	if err := shared_helpers.DumpNamespacePods(ctx, kubernetesManager, logsAggregatorResources.namespace, podsToDump, outputDirpath); err != nil {
+		return stacktrace.Propagate(err, "An error occurred dumping logs aggregator pods in namespace '%v'", logsAggregatorResources.namespace.Name)
+	}
+	return nil
+}
@@ -289,6 +289,7 @@ func createLogsAggregatorDeployment(
 		[]apiv1.Container{}, // no need init containers
 		containers,
 		volumes,
+		"", // default service account
 		affinity,
 	)
 	if err != nil {
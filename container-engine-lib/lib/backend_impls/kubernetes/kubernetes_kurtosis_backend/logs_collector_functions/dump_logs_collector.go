@@ -0,0 +1,40 @@
+package logs_collector_functions
+
+import (
+	"context"
+
+	"github.com/kurtosis-tech/kurtosis/container-engine-lib/lib/backend_impls/kubernetes/kubernetes_kurtosis_backend/shared_helpers"
+	"github.com/kurtosis-tech/kurtosis/container-engine-lib/lib/backend_impls/kubernetes/kubernetes_manager"
+	"github.com/kurtosis-tech/stacktrace"
+	"github.com/sirupsen/logrus"
+	apiv1 "k8s.io/api/core/v1"
+)
+
+// DumpLogsCollector dumps the pods of the logs collector daemon set into the received output dirpath
+// It's a no-op if the cluster has no logs collector
+func DumpLogsCollector(ctx context.Context, outputDirpath string, kubernetesManager *kubernetes_manager.KubernetesManager) error {
+	logsCollectorResources, err := getLogsCollectorKubernetesResourcesForCluster(ctx, kubernetesManager)
+	if err != nil {
+		return stacktrace.Propagate(err, "An error occurred retrieving kubernetes resources for logs collector.")
+	}
+	if logsCollectorResources.namespace == nil {
+		logrus.Debug("No logs collector namespace found. Returning without dumping the logs collector.")
+		return nil
+	}
+
+	podsToDump := []apiv1.Pod{}
+	if logsCollectorResources.daemonSet != nil {
+		logsCollectorPods, err := kubernetesManager.GetPodsManagedByDaemonSet(ctx, logsCollectorResources.daemonSet)
+		if err != nil {
+			return stacktrace.Propagate(err, "An error occurred getting pods managed by logs collector daemon set '%v'.", logsCollectorResources.daemonSet.Name)
+		}
+		for _, pod := range logsCollectorPods {
+			podsToDump = append(podsToDump, *pod)
+		}
+	}
+
+	if err := shared_helpers.DumpNamespacePods(ctx, kubernetesManager, logsCollectorResources.namespace, podsToDump, outputDirpath); err != nil {
+		return stacktrace.Propagate(err, "An error occurred dumping logs collector pods in namespace '%v'", logsCollectorResources.namespace.Name)
+	}
+	return nil
+}
@@ -0,0 +1,21 @@
+package reverse_proxy_functions
+
+import "time"
+
+const (
+	defaultReverseProxyHttpPortNum      = uint16(9730)
+	defaultReverseProxyDashboardPortNum = uint16(9731)
+
+	traefikContainerName = "traefik"
+	traefikImage         = "traefik:2.10.6"
+
+	// Entrypoint names; the user service ingresses are annotated to use the 'web' one
+	httpEntrypointName      = "web"
+	dashboardEntrypointName = "traefik"
+
+	httpPortName      = "http"
+	dashboardPortName = "dashboard"
+
+	maxRetriesWaitingForReverseProxyPod = 30
+	retryIntervalWaitingForReverseProxy = 1 * time.Second
+)
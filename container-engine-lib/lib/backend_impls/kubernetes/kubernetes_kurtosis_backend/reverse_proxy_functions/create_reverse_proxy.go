@@ -0,0 +1,71 @@
+package reverse_proxy_functions
+
+import (
+	"context"
+
+	"github.com/kurtosis-tech/kurtosis/container-engine-lib/lib/backend_impls/kubernetes/kubernetes_manager"
+	"github.com/kurtosis-tech/kurtosis/container-engine-lib/lib/backend_impls/kubernetes/object_attributes_provider"
+	"github.com/kurtosis-tech/kurtosis/container-engine-lib/lib/backend_interface/objects/container"
+	"github.com/kurtosis-tech/kurtosis/container-engine-lib/lib/backend_interface/objects/engine"
+	"github.com/kurtosis-tech/kurtosis/container-engine-lib/lib/backend_interface/objects/reverse_proxy"
+	"github.com/kurtosis-tech/stacktrace"
+	"github.com/sirupsen/logrus"
+)
+
+// CreateReverseProxy creates the reverse proxy idempotently, if a running reverse proxy is found then it is returned
+// The reverse proxy is a Traefik deployment that routes to the user services using the ingresses Kurtosis creates for
+// their HTTP ports
+func CreateReverseProxy(
+	ctx context.Context,
+	engineGuid engine.EngineGUID,
+	objAttrsProvider object_attributes_provider.KubernetesObjectAttributesProvider,
+	kubernetesManager *kubernetes_manager.KubernetesManager,
+) (
+	*reverse_proxy.ReverseProxy,
+	func(),
+	error,
+) {
+	existingReverseProxy, existingResources, err := getReverseProxyObjAndResourcesForCluster(ctx, kubernetesManager)
+	if err != nil {
+		return nil, nil, stacktrace.Propagate(err, "An error occurred getting the reverse proxy object and resources for cluster.")
+	}
+	if existingReverseProxy != nil && existingReverseProxy.GetStatus() == container.ContainerStatus_Running {
+		logrus.Debug("Found existing reverse proxy deployment.")
+		removeReverseProxyFunc := func() {
+			removeCtx := context.Background()
+			if err := destroyReverseProxyKubernetesResources(removeCtx, existingResources, kubernetesManager); err != nil {
+				logrus.Errorf("An error occurred removing the existing reverse proxy. Error was:\n%v", err)
+				logrus.Errorf("ACTION REQUIRED: You'll need to manually remove the reverse proxy namespace '%v'!!!!!!", existingResources.namespace.Name)
+			}
+		}
+		return existingReverseProxy, removeReverseProxyFunc, nil
+	}
+	// Leftovers of a reverse proxy that isn't running are removed so a fresh one can be started
+	if err := destroyReverseProxyKubernetesResources(ctx, existingResources, kubernetesManager); err != nil {
+		return nil, nil, stacktrace.Propagate(err, "An error occurred removing the resources of the reverse proxy that isn't running")
+	}
+
+	reverseProxyAttrsProvider := objAttrsProvider.ForReverseProxy(engineGuid)
+	resources, removeResourcesFunc, err := createReverseProxyKubernetesResources(ctx, reverseProxyAttrsProvider, kubernetesManager)
+	if err != nil {
+		return nil, nil, stacktrace.Propagate(err, "An error occurred creating the reverse proxy Kubernetes resources")
+	}
+	shouldRemoveReverseProxy := true
+	defer func() {
+		if shouldRemoveReverseProxy {
+			removeResourcesFunc()
+		}
+	}()
+
+	if err := kubernetesManager.WaitForPodManagedByDeployment(ctx, resources.deployment, maxRetriesWaitingForReverseProxyPod, retryIntervalWaitingForReverseProxy); err != nil {
+		return nil, nil, stacktrace.Propagate(err, "An error occurred waiting for the pod managed by reverse proxy deployment '%v'", resources.deployment.Name)
+	}
+
+	reverseProxy, err := getReverseProxyObjectFromKubernetesResources(ctx, kubernetesManager, resources)
+	if err != nil {
+		return nil, nil, stacktrace.Propagate(err, "An error occurred getting the reverse proxy object from the Kubernetes resources")
+	}
+
+	shouldRemoveReverseProxy = false
+	return reverseProxy, removeResourcesFunc, nil
+}
@@ -0,0 +1,73 @@
+package reverse_proxy_functions
+
+import (
+	"context"
+	"errors"
+
+	"github.com/kurtosis-tech/kurtosis/container-engine-lib/lib/backend_impls/kubernetes/kubernetes_manager"
+	"github.com/kurtosis-tech/stacktrace"
+	"github.com/sirupsen/logrus"
+)
+
+// DestroyReverseProxy destroys the reverse proxy and its associated resources idempotently
+func DestroyReverseProxy(ctx context.Context, kubernetesManager *kubernetes_manager.KubernetesManager) error {
+	resources, err := getReverseProxyKubernetesResourcesForCluster(ctx, kubernetesManager)
+	if err != nil {
+		return stacktrace.Propagate(err, "An error occurred retrieving Kubernetes resources for the reverse proxy.")
+	}
+	if err := destroyReverseProxyKubernetesResources(ctx, resources, kubernetesManager); err != nil {
+		return stacktrace.Propagate(err, "An error occurred destroying the reverse proxy.")
+	}
+	return nil
+}
+
+func destroyReverseProxyKubernetesResources(ctx context.Context, resources *reverseProxyKubernetesResources, kubernetesManager *kubernetes_manager.KubernetesManager) error {
+	var destroyErrs []error
+	if resources.clusterRoleBinding != nil {
+		if err := kubernetesManager.RemoveClusterRoleBindings(ctx, resources.clusterRoleBinding); err != nil {
+			destroyErrs = append(destroyErrs, stacktrace.Propagate(err, "An error occurred removing reverse proxy cluster role binding '%v'.", resources.clusterRoleBinding.Name))
+		}
+	}
+
+	if resources.clusterRole != nil {
+		if err := kubernetesManager.RemoveClusterRole(ctx, resources.clusterRole); err != nil {
+			destroyErrs = append(destroyErrs, stacktrace.Propagate(err, "An error occurred removing reverse proxy cluster role '%v'.", resources.clusterRole.Name))
+		}
+	}
+
+	if resources.namespace == nil {
+		logrus.Debug("No reverse proxy namespace found. Returning without attempting to destroy remaining reverse proxy resources.")
+	} else {
+		namespaceName := resources.namespace.Name
+		if resources.deployment != nil {
+			if err := kubernetesManager.RemoveDeployment(ctx, namespaceName, resources.deployment); err != nil {
+				destroyErrs = append(destroyErrs, stacktrace.Propagate(err, "An error occurred removing reverse proxy deployment."))
+			}
+		}
+
+		if resources.service != nil {
+			if err := kubernetesManager.RemoveService(ctx, resources.service); err != nil {
+				destroyErrs = append(destroyErrs, stacktrace.Propagate(err, "An error occurred removing reverse proxy service."))
+			}
+		}
+
+		if resources.serviceAccount != nil {
+			if err := kubernetesManager.RemoveServiceAccount(ctx, resources.serviceAccount); err != nil {
+				destroyErrs = append(destroyErrs, stacktrace.Propagate(err, "An error occurred removing reverse proxy service account."))
+			}
+		}
+
+		if err := kubernetesManager.RemoveNamespace(ctx, resources.namespace); err != nil {
+			destroyErrs = append(destroyErrs, stacktrace.Propagate(err, "An error occurred removing reverse proxy namespace."))
+		}
+	}
+
+	if len(destroyErrs) > 0 {
+		errMsg := "Following errors occurred trying to destroy the reverse proxy:\n"
+		for _, destroyErr := range destroyErrs {
+			errMsg += destroyErr.Error() + "\n"
+		}
+		return errors.New(errMsg)
+	}
+	return nil
+}
@@ -0,0 +1,21 @@
+package reverse_proxy_functions
+
+import (
+	"context"
+
+	"github.com/kurtosis-tech/kurtosis/container-engine-lib/lib/backend_impls/kubernetes/kubernetes_manager"
+	"github.com/kurtosis-tech/kurtosis/container-engine-lib/lib/backend_interface/objects/reverse_proxy"
+	"github.com/kurtosis-tech/stacktrace"
+)
+
+// GetReverseProxy returns the reverse proxy of the cluster, or nil if there is none
+func GetReverseProxy(
+	ctx context.Context,
+	kubernetesManager *kubernetes_manager.KubernetesManager,
+) (*reverse_proxy.ReverseProxy, error) {
+	maybeReverseProxyObject, _, err := getReverseProxyObjAndResourcesForCluster(ctx, kubernetesManager)
+	if err != nil {
+		return nil, stacktrace.Propagate(err, "An error occurred getting the reverse proxy")
+	}
+	return maybeReverseProxyObject, nil
+}
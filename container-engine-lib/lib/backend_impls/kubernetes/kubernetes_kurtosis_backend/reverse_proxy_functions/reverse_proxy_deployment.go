@@ -0,0 +1,321 @@
+package reverse_proxy_functions
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/kurtosis-tech/kurtosis/container-engine-lib/lib/backend_impls/kubernetes/kubernetes_kurtosis_backend/shared_helpers"
+	"github.com/kurtosis-tech/kurtosis/container-engine-lib/lib/backend_impls/kubernetes/kubernetes_manager"
+	kubernetes_manager_consts "github.com/kurtosis-tech/kurtosis/container-engine-lib/lib/backend_impls/kubernetes/kubernetes_manager/consts"
+	"github.com/kurtosis-tech/kurtosis/container-engine-lib/lib/backend_impls/kubernetes/object_attributes_provider"
+	"github.com/kurtosis-tech/kurtosis/container-engine-lib/lib/backend_impls/kubernetes/object_attributes_provider/kubernetes_label_key"
+	"github.com/kurtosis-tech/kurtosis/container-engine-lib/lib/backend_impls/kubernetes/object_attributes_provider/label_value_consts"
+	"github.com/kurtosis-tech/stacktrace"
+	"github.com/sirupsen/logrus"
+	appsv1 "k8s.io/api/apps/v1"
+	apiv1 "k8s.io/api/core/v1"
+	rbacv1 "k8s.io/api/rbac/v1"
+	"k8s.io/apimachinery/pkg/util/intstr"
+)
+
+// createReverseProxyKubernetesResources creates every Kubernetes object of the reverse proxy, removing the ones already
+// created if any of them fails
+func createReverseProxyKubernetesResources(
+	ctx context.Context,
+	objAttrsProvider object_attributes_provider.KubernetesReverseProxyObjectAttributesProvider,
+	kubernetesManager *kubernetes_manager.KubernetesManager,
+) (*reverseProxyKubernetesResources, func(), error) {
+	resources := &reverseProxyKubernetesResources{
+		namespace:          nil,
+		serviceAccount:     nil,
+		clusterRole:        nil,
+		clusterRoleBinding: nil,
+		deployment:         nil,
+		service:            nil,
+	}
+	removeResourcesFunc := func() {
+		removeCtx := context.Background()
+		if err := destroyReverseProxyKubernetesResources(removeCtx, resources, kubernetesManager); err != nil {
+			logrus.Errorf("Launching the reverse proxy didn't complete successfully so we tried to remove the resources we created, but doing so exited with an error:\n%v", err)
+			logrus.Errorf("ACTION REQUIRED: You'll need to manually remove the Kubernetes objects labelled '%+v'!!!!!!", GetReverseProxyMatchLabels())
+		}
+	}
+	shouldRemoveResources := true
+	defer func() {
+		if shouldRemoveResources {
+			removeResourcesFunc()
+		}
+	}()
+
+	var err error
+	if resources.namespace, err = createReverseProxyNamespace(ctx, objAttrsProvider, kubernetesManager); err != nil {
+		return nil, nil, stacktrace.Propagate(err, "An error occurred creating the reverse proxy namespace")
+	}
+	namespaceName := resources.namespace.Name
+
+	if resources.serviceAccount, err = createReverseProxyServiceAccount(ctx, namespaceName, objAttrsProvider, kubernetesManager); err != nil {
+		return nil, nil, stacktrace.Propagate(err, "An error occurred creating the reverse proxy service account")
+	}
+
+	if resources.clusterRole, err = createReverseProxyClusterRole(ctx, objAttrsProvider, kubernetesManager); err != nil {
+		return nil, nil, stacktrace.Propagate(err, "An error occurred creating the reverse proxy cluster role")
+	}
+
+	if resources.clusterRoleBinding, err = createReverseProxyClusterRoleBinding(ctx, namespaceName, resources.clusterRole.Name, resources.serviceAccount.Name, objAttrsProvider, kubernetesManager); err != nil {
+		return nil, nil, stacktrace.Propagate(err, "An error occurred creating the reverse proxy cluster role binding")
+	}
+
+	if resources.deployment, err = createReverseProxyDeployment(ctx, namespaceName, resources.serviceAccount.Name, objAttrsProvider, kubernetesManager); err != nil {
+		return nil, nil, stacktrace.Propagate(err, "An error occurred creating the reverse proxy deployment")
+	}
+
+	if resources.service, err = createReverseProxyService(ctx, namespaceName, resources.deployment.Spec.Selector.MatchLabels, objAttrsProvider, kubernetesManager); err != nil {
+		return nil, nil, stacktrace.Propagate(err, "An error occurred creating the reverse proxy service")
+	}
+
+	shouldRemoveResources = false
+	return resources, removeResourcesFunc, nil
+}
+
+func createReverseProxyNamespace(
+	ctx context.Context,
+	objAttrsProvider object_attributes_provider.KubernetesReverseProxyObjectAttributesProvider,
+	kubernetesManager *kubernetes_manager.KubernetesManager,
+) (*apiv1.Namespace, error) {
+	namespaceAttrs, err := objAttrsProvider.ForReverseProxyNamespace()
+	if err != nil {
+		return nil, stacktrace.Propagate(err, "An error occurred getting the reverse proxy namespace attributes")
+	}
+	namespaceName := namespaceAttrs.GetName().GetString()
+	namespaceLabels := shared_helpers.GetStringMapFromLabelMap(namespaceAttrs.GetLabels())
+	namespaceAnnotations := shared_helpers.GetStringMapFromAnnotationMap(namespaceAttrs.GetAnnotations())
+
+	namespace, err := kubernetesManager.CreateNamespace(ctx, namespaceName, namespaceLabels, namespaceAnnotations)
+	if err != nil {
+		return nil, stacktrace.Propagate(err, "An error occurred creating namespace '%v' for the reverse proxy", namespaceName)
+	}
+	return namespace, nil
+}
+
+func createReverseProxyServiceAccount(
+	ctx context.Context,
+	namespaceName string,
+	objAttrsProvider object_attributes_provider.KubernetesReverseProxyObjectAttributesProvider,
+	kubernetesManager *kubernetes_manager.KubernetesManager,
+) (*apiv1.ServiceAccount, error) {
+	serviceAccountAttrs, err := objAttrsProvider.ForReverseProxyServiceAccount()
+	if err != nil {
+		return nil, stacktrace.Propagate(err, "An error occurred getting the reverse proxy service account attributes")
+	}
+	serviceAccountName := serviceAccountAttrs.GetName().GetString()
+	serviceAccountLabels := shared_helpers.GetStringMapFromLabelMap(serviceAccountAttrs.GetLabels())
+
+	serviceAccount, err := kubernetesManager.CreateServiceAccount(ctx, serviceAccountName, namespaceName, serviceAccountLabels, nil)
+	if err != nil {
+		return nil, stacktrace.Propagate(err, "An error occurred creating service account '%v' in namespace '%v' for the reverse proxy", serviceAccountName, namespaceName)
+	}
+	return serviceAccount, nil
+}
+
+// The reverse proxy only needs to watch the ingresses and the services they point to
+func createReverseProxyClusterRole(
+	ctx context.Context,
+	objAttrsProvider object_attributes_provider.KubernetesReverseProxyObjectAttributesProvider,
+	kubernetesManager *kubernetes_manager.KubernetesManager,
+) (*rbacv1.ClusterRole, error) {
+	clusterRoleAttrs, err := objAttrsProvider.ForReverseProxyClusterRole()
+	if err != nil {
+		return nil, stacktrace.Propagate(err, "An error occurred getting the reverse proxy cluster role attributes")
+	}
+	clusterRoleName := clusterRoleAttrs.GetName().GetString()
+	clusterRoleLabels := shared_helpers.GetStringMapFromLabelMap(clusterRoleAttrs.GetLabels())
+	// nolint: exhaustruct
+	clusterRolePolicyRules := []rbacv1.PolicyRule{
+		{
+			Verbs: []string{
+				kubernetes_manager_consts.GetKubernetesVerb,
+				kubernetes_manager_consts.ListKubernetesVerb,
+				kubernetes_manager_consts.WatchKubernetesVerb,
+			},
+			APIGroups: []string{
+				rbacv1.APIGroupAll,
+			},
+			Resources: []string{
+				kubernetes_manager_consts.ServicesKubernetesResource,
+				kubernetes_manager_consts.EndpointsKubernetesResource,
+				kubernetes_manager_consts.SecretsKubernetesResource,
+				kubernetes_manager_consts.IngressesKubernetesResource,
+				kubernetes_manager_consts.IngressClassesKubernetesResource,
+			},
+		},
+		{
+			Verbs: []string{
+				kubernetes_manager_consts.UpdateKubernetesVerb,
+			},
+			APIGroups: []string{
+				rbacv1.APIGroupAll,
+			},
+			Resources: []string{
+				kubernetes_manager_consts.IngressesStatusKubernetesResource,
+			},
+		},
+	}
+	clusterRole, err := kubernetesManager.CreateClusterRoles(ctx, clusterRoleName, clusterRolePolicyRules, clusterRoleLabels)
+	if err != nil {
+		return nil, stacktrace.Propagate(err, "An error occurred creating cluster role '%v' for the reverse proxy", clusterRoleName)
+	}
+	return clusterRole, nil
+}
+
+func createReverseProxyClusterRoleBinding(
+	ctx context.Context,
+	namespaceName string,
+	clusterRoleName string,
+	serviceAccountName string,
+	objAttrsProvider object_attributes_provider.KubernetesReverseProxyObjectAttributesProvider,
+	kubernetesManager *kubernetes_manager.KubernetesManager,
+) (*rbacv1.ClusterRoleBinding, error) {
+	clusterRoleBindingAttrs, err := objAttrsProvider.ForReverseProxyClusterRoleBindings()
+	if err != nil {
+		return nil, stacktrace.Propagate(err, "An error occurred getting the reverse proxy cluster role binding attributes")
+	}
+	clusterRoleBindingName := clusterRoleBindingAttrs.GetName().GetString()
+	clusterRoleBindingLabels := shared_helpers.GetStringMapFromLabelMap(clusterRoleBindingAttrs.GetLabels())
+	// nolint: exhaustruct
+	subjects := []rbacv1.Subject{
+		{
+			Kind:      rbacv1.ServiceAccountKind,
+			Name:      serviceAccountName,
+			Namespace: namespaceName,
+		},
+	}
+	roleRef := rbacv1.RoleRef{
+		APIGroup: kubernetes_manager_consts.RbacAuthorizationApiGroup,
+		Kind:     kubernetes_manager_consts.ClusterRoleKubernetesResourceType,
+		Name:     clusterRoleName,
+	}
+	clusterRoleBinding, err := kubernetesManager.CreateClusterRoleBindings(ctx, clusterRoleBindingName, subjects, roleRef, clusterRoleBindingLabels)
+	if err != nil {
+		return nil, stacktrace.Propagate(err, "An error occurred creating cluster role binding '%v' for the reverse proxy", clusterRoleBindingName)
+	}
+	return clusterRoleBinding, nil
+}
+
+func createReverseProxyDeployment(
+	ctx context.Context,
+	namespaceName string,
+	serviceAccountName string,
+	objAttrsProvider object_attributes_provider.KubernetesReverseProxyObjectAttributesProvider,
+	kubernetesManager *kubernetes_manager.KubernetesManager,
+) (*appsv1.Deployment, error) {
+	deploymentAttrs, err := objAttrsProvider.ForReverseProxyDeployment()
+	if err != nil {
+		return nil, stacktrace.Propagate(err, "An error occurred getting the reverse proxy deployment attributes")
+	}
+	deploymentName := deploymentAttrs.GetName().GetString()
+	deploymentLabels := shared_helpers.GetStringMapFromLabelMap(deploymentAttrs.GetLabels())
+	deploymentAnnotations := shared_helpers.GetStringMapFromAnnotationMap(deploymentAttrs.GetAnnotations())
+
+	// nolint: exhaustruct
+	containers := []apiv1.Container{
+		{
+			Name:  traefikContainerName,
+			Image: traefikImage,
+			Args:  getTraefikArgs(defaultReverseProxyHttpPortNum, defaultReverseProxyDashboardPortNum),
+			Ports: []apiv1.ContainerPort{
+				{
+					Name:          httpPortName,
+					HostPort:      0,
+					ContainerPort: int32(defaultReverseProxyHttpPortNum),
+					Protocol:      apiv1.ProtocolTCP,
+					HostIP:        "",
+				},
+				{
+					Name:          dashboardPortName,
+					HostPort:      0,
+					ContainerPort: int32(defaultReverseProxyDashboardPortNum),
+					Protocol:      apiv1.ProtocolTCP,
+					HostIP:        "",
+				},
+			},
+		},
+	}
+
+	deployment, err := kubernetesManager.CreateDeployment(
+		ctx,
+		namespaceName,
+		deploymentName,
+		deploymentLabels,
+		deploymentAnnotations,
+		[]apiv1.Container{}, // no need init containers
+		containers,
+		[]apiv1.Volume{}, // Traefik is configured through its args
+		serviceAccountName,
+		nil,
+	)
+	if err != nil {
+		return nil, stacktrace.Propagate(err, "An error occurred creating deployment '%v' for the reverse proxy", deploymentName)
+	}
+	return deployment, nil
+}
+
+func createReverseProxyService(
+	ctx context.Context,
+	namespaceName string,
+	matchPodLabels map[string]string,
+	objAttrsProvider object_attributes_provider.KubernetesReverseProxyObjectAttributesProvider,
+	kubernetesManager *kubernetes_manager.KubernetesManager,
+) (*apiv1.Service, error) {
+	serviceAttrs, err := objAttrsProvider.ForReverseProxyService()
+	if err != nil {
+		return nil, stacktrace.Propagate(err, "An error occurred getting the reverse proxy service attributes")
+	}
+	serviceName := serviceAttrs.GetName().GetString()
+	serviceLabels := shared_helpers.GetStringMapFromLabelMap(serviceAttrs.GetLabels())
+	serviceAnnotations := shared_helpers.GetStringMapFromAnnotationMap(serviceAttrs.GetAnnotations())
+
+	ports := []apiv1.ServicePort{
+		getReverseProxyServicePort(httpPortName, defaultReverseProxyHttpPortNum),
+		getReverseProxyServicePort(dashboardPortName, defaultReverseProxyDashboardPortNum),
+	}
+
+	// The reverse proxy is only reachable from within the cluster, the CLI gateway forwards it to the user's machine
+	service, err := kubernetesManager.CreateService(ctx, namespaceName, serviceName, serviceLabels, serviceAnnotations, matchPodLabels, apiv1.ServiceTypeClusterIP, ports)
+	if err != nil {
+		return nil, stacktrace.Propagate(err, "An error occurred creating service '%v' for the reverse proxy", serviceName)
+	}
+	return service, nil
+}
+
+func getReverseProxyServicePort(name string, portNum uint16) apiv1.ServicePort {
+	return apiv1.ServicePort{
+		Name:        name,
+		Protocol:    apiv1.ProtocolTCP,
+		AppProtocol: nil,
+		Port:        int32(portNum),
+		TargetPort: intstr.IntOrString{
+			IntVal: int32(portNum),
+			StrVal: "",
+			Type:   intstr.Int,
+		},
+		NodePort: 0,
+	}
+}
+
+// getTraefikArgs configures Traefik to serve the ingresses Kurtosis creates, which are the only ones carrying the
+// Kurtosis app ID label
+func getTraefikArgs(httpPortNum uint16, dashboardPortNum uint16) []string {
+	return []string{
+		fmt.Sprintf("--entrypoints.%v.address=:%v", httpEntrypointName, httpPortNum),
+		fmt.Sprintf("--entrypoints.%v.address=:%v", dashboardEntrypointName, dashboardPortNum),
+		"--api.dashboard=true",
+		"--api.insecure=true",
+		"--accesslog=true",
+		"--providers.kubernetesingress=true",
+		fmt.Sprintf(
+			"--providers.kubernetesingress.labelselector=%v=%v",
+			kubernetes_label_key.AppIDKubernetesLabelKey.GetString(),
+			label_value_consts.AppIDKubernetesLabelValue.GetString(),
+		),
+	}
+}
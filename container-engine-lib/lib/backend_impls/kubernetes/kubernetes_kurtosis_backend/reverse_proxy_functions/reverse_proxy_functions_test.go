@@ -0,0 +1,68 @@
+package reverse_proxy_functions
+
+import (
+	"context"
+	"testing"
+
+	"github.com/kurtosis-tech/kurtosis/container-engine-lib/lib/backend_impls/kubernetes/kubernetes_manager"
+	"github.com/kurtosis-tech/kurtosis/container-engine-lib/lib/backend_impls/kubernetes/object_attributes_provider"
+	"github.com/kurtosis-tech/kurtosis/container-engine-lib/lib/backend_interface/objects/container"
+	"github.com/kurtosis-tech/kurtosis/container-engine-lib/lib/backend_interface/objects/engine"
+	"github.com/stretchr/testify/require"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/client-go/kubernetes/fake"
+)
+
+const (
+	testEngineGuid = engine.EngineGUID("3771c85af16a40a18201acf4b4b5ad28")
+)
+
+func TestGetReverseProxy_NoReverseProxy(t *testing.T) {
+	kubernetesManager := kubernetes_manager.NewKubernetesManager(fake.NewSimpleClientset(), nil, "")
+
+	reverseProxy, err := GetReverseProxy(context.Background(), kubernetesManager)
+	require.NoError(t, err)
+	require.Nil(t, reverseProxy)
+}
+
+func TestReverseProxyResourcesLifecycle(t *testing.T) {
+	ctx := context.Background()
+	clientSet := fake.NewSimpleClientset()
+	kubernetesManager := kubernetes_manager.NewKubernetesManager(clientSet, nil, "")
+	objAttrsProvider := object_attributes_provider.GetKubernetesObjectAttributesProvider().ForReverseProxy(testEngineGuid)
+
+	resources, _, err := createReverseProxyKubernetesResources(ctx, objAttrsProvider, kubernetesManager)
+	require.NoError(t, err)
+	require.Equal(t, resources.serviceAccount.Name, resources.deployment.Spec.Template.Spec.ServiceAccountName)
+	require.Equal(t, resources.clusterRole.Name, resources.clusterRoleBinding.RoleRef.Name)
+
+	// The fake clientset doesn't run the deployment controller so the reverse proxy has no pod
+	reverseProxy, err := GetReverseProxy(ctx, kubernetesManager)
+	require.NoError(t, err)
+	require.NotNil(t, reverseProxy)
+	require.Equal(t, container.ContainerStatus_Stopped, reverseProxy.GetStatus())
+	require.Equal(t, defaultReverseProxyHttpPortNum, reverseProxy.GetHttpPort())
+	require.Equal(t, defaultReverseProxyDashboardPortNum, reverseProxy.GetDashboardPort())
+
+	require.NoError(t, DestroyReverseProxy(ctx, kubernetesManager))
+
+	reverseProxy, err = GetReverseProxy(ctx, kubernetesManager)
+	require.NoError(t, err)
+	require.Nil(t, reverseProxy)
+	clusterRoles, err := clientSet.RbacV1().ClusterRoles().List(ctx, metav1.ListOptions{}) // nolint: exhaustruct
+	require.NoError(t, err)
+	require.Empty(t, clusterRoles.Items)
+	clusterRoleBindings, err := clientSet.RbacV1().ClusterRoleBindings().List(ctx, metav1.ListOptions{}) // nolint: exhaustruct
+	require.NoError(t, err)
+	require.Empty(t, clusterRoleBindings.Items)
+
+	// Destroying is idempotent
+	require.NoError(t, DestroyReverseProxy(ctx, kubernetesManager))
+}
+
+func TestGetTraefikArgs_OnlyServesKurtosisIngresses(t *testing.T) {
+	args := getTraefikArgs(defaultReverseProxyHttpPortNum, defaultReverseProxyDashboardPortNum)
+	require.Contains(t, args, "--entrypoints.web.address=:9730")
+	require.Contains(t, args, "--entrypoints.traefik.address=:9731")
+	require.Contains(t, args, "--providers.kubernetesingress.labelselector=kurtosistech.com/app-id=kurtosis")
+}
@@ -0,0 +1,21 @@
+package reverse_proxy_functions
+
+import (
+	appsv1 "k8s.io/api/apps/v1"
+	apiv1 "k8s.io/api/core/v1"
+	rbacv1 "k8s.io/api/rbac/v1"
+)
+
+type reverseProxyKubernetesResources struct {
+	namespace *apiv1.Namespace
+
+	serviceAccount *apiv1.ServiceAccount
+
+	clusterRole *rbacv1.ClusterRole
+
+	clusterRoleBinding *rbacv1.ClusterRoleBinding
+
+	deployment *appsv1.Deployment
+
+	service *apiv1.Service
+}
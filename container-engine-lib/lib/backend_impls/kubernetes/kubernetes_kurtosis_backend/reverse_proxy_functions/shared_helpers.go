@@ -0,0 +1,163 @@
+package reverse_proxy_functions
+
+import (
+	"context"
+	"net"
+
+	"github.com/kurtosis-tech/kurtosis/container-engine-lib/lib/backend_impls/kubernetes/kubernetes_kurtosis_backend/shared_helpers"
+	"github.com/kurtosis-tech/kurtosis/container-engine-lib/lib/backend_impls/kubernetes/kubernetes_manager"
+	"github.com/kurtosis-tech/kurtosis/container-engine-lib/lib/backend_impls/kubernetes/kubernetes_resource_collectors"
+	"github.com/kurtosis-tech/kurtosis/container-engine-lib/lib/backend_impls/kubernetes/object_attributes_provider/kubernetes_label_key"
+	"github.com/kurtosis-tech/kurtosis/container-engine-lib/lib/backend_impls/kubernetes/object_attributes_provider/label_value_consts"
+	"github.com/kurtosis-tech/kurtosis/container-engine-lib/lib/backend_interface/objects/container"
+	"github.com/kurtosis-tech/kurtosis/container-engine-lib/lib/backend_interface/objects/reverse_proxy"
+	"github.com/kurtosis-tech/stacktrace"
+)
+
+func getReverseProxyObjAndResourcesForCluster(ctx context.Context, kubernetesManager *kubernetes_manager.KubernetesManager) (*reverse_proxy.ReverseProxy, *reverseProxyKubernetesResources, error) {
+	kubernetesResources, err := getReverseProxyKubernetesResourcesForCluster(ctx, kubernetesManager)
+	if err != nil {
+		return nil, nil, stacktrace.Propagate(err, "An error occurred getting Kubernetes resources for the reverse proxy.")
+	}
+
+	obj, err := getReverseProxyObjectFromKubernetesResources(ctx, kubernetesManager, kubernetesResources)
+	if err != nil {
+		return nil, nil, stacktrace.Propagate(err, "An error occurred getting the reverse proxy object from Kubernetes resources.")
+	}
+	return obj, kubernetesResources, nil
+}
+
+func getReverseProxyKubernetesResourcesForCluster(ctx context.Context, kubernetesManager *kubernetes_manager.KubernetesManager) (*reverseProxyKubernetesResources, error) {
+	resourceTypeLabelKeyStr := kubernetes_label_key.KurtosisResourceTypeKubernetesLabelKey.GetString()
+	reverseProxyResourceTypeLabelValStr := label_value_consts.ReverseProxyKurtosisResourceTypeKubernetesLabelValue.GetString()
+	reverseProxySearchLabels := GetReverseProxyMatchLabels()
+	postFilterLabelValues := map[string]bool{
+		reverseProxyResourceTypeLabelValStr: true,
+	}
+
+	resources := &reverseProxyKubernetesResources{
+		namespace:          nil,
+		serviceAccount:     nil,
+		clusterRole:        nil,
+		clusterRoleBinding: nil,
+		deployment:         nil,
+		service:            nil,
+	}
+
+	// The cluster-scoped objects are collected first, so a half-destroyed reverse proxy without namespace can still be cleaned up
+	clusterRoles, err := kubernetes_resource_collectors.CollectMatchingClusterRoles(ctx, kubernetesManager, reverseProxySearchLabels, resourceTypeLabelKeyStr, postFilterLabelValues)
+	if err != nil {
+		return nil, stacktrace.Propagate(err, "An error occurred getting cluster roles for the reverse proxy.")
+	}
+	if resources.clusterRole, err = getAtMostOneResource(clusterRoles[reverseProxyResourceTypeLabelValStr], "cluster role"); err != nil {
+		return nil, err // already wrapped with propagate
+	}
+
+	clusterRoleBindings, err := kubernetes_resource_collectors.CollectMatchingClusterRoleBindings(ctx, kubernetesManager, reverseProxySearchLabels, resourceTypeLabelKeyStr, postFilterLabelValues)
+	if err != nil {
+		return nil, stacktrace.Propagate(err, "An error occurred getting cluster role bindings for the reverse proxy.")
+	}
+	if resources.clusterRoleBinding, err = getAtMostOneResource(clusterRoleBindings[reverseProxyResourceTypeLabelValStr], "cluster role binding"); err != nil {
+		return nil, err // already wrapped with propagate
+	}
+
+	namespaces, err := kubernetes_resource_collectors.CollectMatchingNamespaces(ctx, kubernetesManager, reverseProxySearchLabels, resourceTypeLabelKeyStr, postFilterLabelValues)
+	if err != nil {
+		return nil, stacktrace.Propagate(err, "An error occurred getting the namespace for the reverse proxy.")
+	}
+	if resources.namespace, err = getAtMostOneResource(namespaces[reverseProxyResourceTypeLabelValStr], "namespace"); err != nil {
+		return nil, err // already wrapped with propagate
+	}
+	if resources.namespace == nil {
+		return resources, nil
+	}
+	namespaceName := resources.namespace.Name
+
+	serviceAccounts, err := kubernetes_resource_collectors.CollectMatchingServiceAccounts(ctx, kubernetesManager, namespaceName, reverseProxySearchLabels, resourceTypeLabelKeyStr, postFilterLabelValues)
+	if err != nil {
+		return nil, stacktrace.Propagate(err, "An error occurred getting the service account for the reverse proxy in namespace '%v'.", namespaceName)
+	}
+	if resources.serviceAccount, err = getAtMostOneResource(serviceAccounts[reverseProxyResourceTypeLabelValStr], "service account"); err != nil {
+		return nil, err // already wrapped with propagate
+	}
+
+	deployments, err := kubernetes_resource_collectors.CollectMatchingDeployments(ctx, kubernetesManager, namespaceName, reverseProxySearchLabels, resourceTypeLabelKeyStr, postFilterLabelValues)
+	if err != nil {
+		return nil, stacktrace.Propagate(err, "An error occurred getting the deployment for the reverse proxy in namespace '%v'.", namespaceName)
+	}
+	if resources.deployment, err = getAtMostOneResource(deployments[reverseProxyResourceTypeLabelValStr], "deployment"); err != nil {
+		return nil, err // already wrapped with propagate
+	}
+
+	services, err := kubernetes_resource_collectors.CollectMatchingServices(ctx, kubernetesManager, namespaceName, reverseProxySearchLabels, resourceTypeLabelKeyStr, postFilterLabelValues)
+	if err != nil {
+		return nil, stacktrace.Propagate(err, "An error occurred getting the service for the reverse proxy in namespace '%v'.", namespaceName)
+	}
+	if resources.service, err = getAtMostOneResource(services[reverseProxyResourceTypeLabelValStr], "service"); err != nil {
+		return nil, err // already wrapped with propagate
+	}
+
+	return resources, nil
+}
+
+// getReverseProxyObjectFromKubernetesResources returns a reverse proxy object if and only if the deployment and the
+// service of the reverse proxy exist, otherwise it returns a nil object
+func getReverseProxyObjectFromKubernetesResources(
+	ctx context.Context,
+	kubernetesManager *kubernetes_manager.KubernetesManager,
+	resources *reverseProxyKubernetesResources,
+) (*reverse_proxy.ReverseProxy, error) {
+	if resources.namespace == nil || resources.deployment == nil || resources.service == nil {
+		return nil, nil
+	}
+
+	pods, err := kubernetesManager.GetPodsManagedByDeployment(ctx, resources.deployment)
+	if err != nil {
+		return nil, stacktrace.Propagate(err, "An error occurred getting pods managed by reverse proxy deployment '%v'.", resources.deployment.Name)
+	}
+	if len(pods) > 1 {
+		return nil, stacktrace.NewError("Expected at most one pod managed by reverse proxy deployment '%v' but found '%v'; this is a bug in Kurtosis", resources.deployment.Name, len(pods))
+	}
+	status := container.ContainerStatus_Stopped
+	if len(pods) == 1 {
+		status, err = shared_helpers.GetContainerStatusFromPod(pods[0])
+		if err != nil {
+			return nil, stacktrace.Propagate(err, "An error occurred getting the status of reverse proxy pod '%v'.", pods[0].Name)
+		}
+	}
+
+	var privateIpAddr net.IP
+	if status == container.ContainerStatus_Running {
+		privateIpAddr = net.ParseIP(resources.service.Spec.ClusterIP)
+		if privateIpAddr == nil {
+			return nil, stacktrace.NewError("Reverse proxy IP address '%v' could not be parsed.", resources.service.Spec.ClusterIP)
+		}
+	}
+
+	// There are no enclave networks on Kubernetes, the reverse proxy reaches every service through its cluster IP
+	return reverse_proxy.NewReverseProxy(
+		status,
+		privateIpAddr,
+		nil,
+		defaultReverseProxyHttpPortNum,
+		defaultReverseProxyDashboardPortNum,
+	), nil
+}
+
+// GetReverseProxyMatchLabels returns the labels carried by every Kubernetes object of the reverse proxy
+func GetReverseProxyMatchLabels() map[string]string {
+	return map[string]string{
+		kubernetes_label_key.AppIDKubernetesLabelKey.GetString():                label_value_consts.AppIDKubernetesLabelValue.GetString(),
+		kubernetes_label_key.KurtosisResourceTypeKubernetesLabelKey.GetString(): label_value_consts.ReverseProxyKurtosisResourceTypeKubernetesLabelValue.GetString(),
+	}
+}
+
+func getAtMostOneResource[T any](resources []*T, resourceKind string) (*T, error) {
+	if len(resources) > 1 {
+		return nil, stacktrace.NewError("Expected at most one reverse proxy %v but found '%v'; this is a bug in Kurtosis", resourceKind, len(resources))
+	}
+	if len(resources) == 0 {
+		return nil, nil
+	}
+	return resources[0], nil
+}
@@ -1,6 +1,7 @@
 package shared_helpers
 
 import (
+	"archive/tar"
 	"bytes"
 	"context"
 	"encoding/json"
@@ -10,10 +11,12 @@ import (
 	"net"
 	"os"
 	"path"
+	"path/filepath"
 	"strings"
 	"time"
 
 	"github.com/gammazero/workerpool"
+	"github.com/kurtosis-tech/kurtosis/container-engine-lib/lib/backend_impls/kubernetes/enclave_namespace_config"
 	"github.com/kurtosis-tech/kurtosis/container-engine-lib/lib/backend_impls/kubernetes/kubernetes_kurtosis_backend/consts"
 	"github.com/kurtosis-tech/kurtosis/container-engine-lib/lib/backend_impls/kubernetes/kubernetes_manager"
 	"github.com/kurtosis-tech/kurtosis/container-engine-lib/lib/backend_impls/kubernetes/kubernetes_resource_collectors"
@@ -51,6 +54,10 @@ const (
 	// Name to give the file that we'll write for storing specs of pods, containers, etc.
 	podSpecFilename             = "spec.json"
 	containerLogsFilenameSuffix = ".log"
+	// Holds the whole pod, status included, which is the equivalent of what 'docker inspect' returns for the
+	// containers of a Docker dump
+	podFilename             = "pod.json"
+	namespaceEventsFilename = "events.json"
 
 	// Permissions for the files & directories we create as a result of the dump
 	createdDirPerms  os.FileMode = 0755
@@ -94,6 +101,9 @@ type ApiContainerModeArgs struct {
 
 	// TODO make this more dynamic - maybe guess based on the files artifact size?
 	filesArtifactExpansionVolumeSizeInMegabytes uint
+
+	// Registry that images built in the cluster get pushed to; empty means an enclave-local registry is used
+	imageBuildRegistry string
 }
 
 type dumpPodResult struct {
@@ -103,12 +113,13 @@ type dumpPodResult struct {
 
 func NewApiContainerModeArgs(
 	ownEnclaveId enclave.EnclaveUUID,
-	ownNamespaceName string, storageClassName string) *ApiContainerModeArgs {
+	ownNamespaceName string, storageClassName string, imageBuildRegistry string) *ApiContainerModeArgs {
 	return &ApiContainerModeArgs{
 		ownEnclaveId:     ownEnclaveId,
 		ownNamespaceName: ownNamespaceName,
 		storageClassName: storageClassName,
 		filesArtifactExpansionVolumeSizeInMegabytes: 0,
+		imageBuildRegistry:                          imageBuildRegistry,
 	}
 }
 
@@ -120,8 +131,24 @@ func (apiContainerModeArgs *ApiContainerModeArgs) GetOwnNamespaceName() string {
 	return apiContainerModeArgs.ownNamespaceName
 }
 
-// EngineServerModeArgs TODO(victor.colombo): Can we remove this?
-type EngineServerModeArgs struct{}
+func (apiContainerModeArgs *ApiContainerModeArgs) GetImageBuildRegistry() string {
+	return apiContainerModeArgs.imageBuildRegistry
+}
+
+type EngineServerModeArgs struct {
+	// Customizations applied to the namespace of each enclave the engine creates; nil if there are none
+	enclaveNamespaceConfig *enclave_namespace_config.EnclaveNamespaceConfig
+}
+
+func NewEngineServerModeArgs(enclaveNamespaceConfig *enclave_namespace_config.EnclaveNamespaceConfig) *EngineServerModeArgs {
+	return &EngineServerModeArgs{
+		enclaveNamespaceConfig: enclaveNamespaceConfig,
+	}
+}
+
+func (engineServerModeArgs *EngineServerModeArgs) GetEnclaveNamespaceConfig() *enclave_namespace_config.EnclaveNamespaceConfig {
+	return engineServerModeArgs.enclaveNamespaceConfig
+}
 
 type UserServiceObjectsAndKubernetesResources struct {
 	// Should never be nil because 1 Kubernetes service = 1 Kurtosis service registration
@@ -769,6 +796,10 @@ func DumpNamespacePods(
 		return stacktrace.Propagate(err, "An error occurred creating output directory at '%v'", outputDirpath)
 	}
 
+	if err := dumpNamespaceEvents(ctx, kubernetesManager, namespace.Name, outputDirpath); err != nil {
+		return stacktrace.Propagate(err, "An error occurred dumping the events of namespace '%v'", namespace.Name)
+	}
+
 	workerPool := workerpool.New(numPodsToDumpAtOnce)
 	resultErrsChan := make(chan dumpPodResult, len(podsToDump))
 	for _, pod := range podsToDump {
@@ -842,6 +873,47 @@ func BuildCombinedError(errorsById map[string]error, titleStr string) error {
 	return nil
 }
 
+// ExtractTarArchive writes the content of the tar archive read from tarReader into the (non-existing) destDirpath
+// This is what a 'tar cf -' exec'd in a pod produces, which is the only way to get files out of a pod
+func ExtractTarArchive(tarReader io.Reader, destDirpath string) error {
+	if err := os.Mkdir(destDirpath, createdDirPerms); err != nil {
+		return stacktrace.Propagate(err, "An error occurred creating directory '%v' to extract the archive into", destDirpath)
+	}
+	archiveReader := tar.NewReader(tarReader)
+	for {
+		header, err := archiveReader.Next()
+		if err == io.EOF {
+			return nil
+		}
+		if err != nil {
+			return stacktrace.Propagate(err, "An error occurred reading the next entry of the archive being extracted to '%v'", destDirpath)
+		}
+
+		// Guard against entries escaping the destination directory, e.g. '../../etc/passwd'
+		entryFilepath := filepath.Join(destDirpath, header.Name)
+		if entryFilepath != filepath.Clean(destDirpath) && !strings.HasPrefix(entryFilepath, filepath.Clean(destDirpath)+string(os.PathSeparator)) {
+			return stacktrace.NewError("Archive entry '%v' would be extracted outside of '%v'", header.Name, destDirpath)
+		}
+
+		switch header.Typeflag {
+		case tar.TypeDir:
+			if err := os.MkdirAll(entryFilepath, createdDirPerms); err != nil {
+				return stacktrace.Propagate(err, "An error occurred creating directory '%v'", entryFilepath)
+			}
+		case tar.TypeReg:
+			if err := os.MkdirAll(filepath.Dir(entryFilepath), createdDirPerms); err != nil {
+				return stacktrace.Propagate(err, "An error occurred creating the parent directory of '%v'", entryFilepath)
+			}
+			if err := extractTarFileEntry(archiveReader, entryFilepath); err != nil {
+				return stacktrace.Propagate(err, "An error occurred extracting archive entry '%v'", header.Name)
+			}
+		default:
+			// Symlinks, devices, etc. don't carry any content worth keeping
+			logrus.Debugf("Skipping archive entry '%v' of type '%v'", header.Name, string(header.Typeflag))
+		}
+	}
+}
+
 // ====================================================================================================
 //
 //	Private Helper Methods
@@ -855,6 +927,18 @@ func getEnclaveMatchLabels() map[string]string {
 	return matchLabels
 }
 
+func extractTarFileEntry(archiveReader *tar.Reader, entryFilepath string) error {
+	entryFp, err := os.OpenFile(entryFilepath, os.O_CREATE|os.O_WRONLY|os.O_TRUNC, createdFilePerms)
+	if err != nil {
+		return stacktrace.Propagate(err, "An error occurred creating file '%v'", entryFilepath)
+	}
+	defer entryFp.Close()
+	if _, err := io.Copy(entryFp, archiveReader); err != nil {
+		return stacktrace.Propagate(err, "An error occurred writing file '%v'", entryFilepath)
+	}
+	return nil
+}
+
 func createDumpPodJob(
 	ctx context.Context,
 	kubernetesManager *kubernetes_manager.KubernetesManager,
@@ -908,52 +992,106 @@ func dumpPodInfo(
 		)
 	}
 
-	for _, container := range pod.Spec.Containers {
-		containerName := container.Name
+	jsonSerializedPodBytes, err := json.MarshalIndent(pod, enclaveDumpJsonSerializationPrefix, enclaveDumpJsonSerializationIndent)
+	if err != nil {
+		return stacktrace.Propagate(err, "An error occurred serializing pod '%v' to JSON", podName)
+	}
+	podOutputFilepath := path.Join(podOutputDirpath, podFilename)
+	if err := os.WriteFile(podOutputFilepath, jsonSerializedPodBytes, createdFilePerms); err != nil {
+		return stacktrace.Propagate(
+			err,
+			"An error occurred writing pod '%v' to file '%v'",
+			podName,
+			podOutputFilepath,
+		)
+	}
 
-		// Make container output directory
-		containerLogsFilepath := path.Join(podOutputDirpath, containerName+containerLogsFilenameSuffix)
-		containerLogsOutputFp, err := os.Create(containerLogsFilepath)
-		if err != nil {
-			return stacktrace.Propagate(
-				err,
-				"An error occurred creating file '%v' to hold the logs of container with name '%v' in pod '%v'",
-				containerLogsFilepath,
-				containerName,
-				podName,
-			)
+	for _, container := range pod.Spec.Containers {
+		containerLogsFilepath := path.Join(podOutputDirpath, container.Name+containerLogsFilenameSuffix)
+		if err := dumpContainerLogs(ctx, kubernetesManager, namespaceName, podName, container.Name, containerLogsFilepath); err != nil {
+			return stacktrace.Propagate(err, "An error occurred dumping the logs of container '%v' in pod '%v'", container.Name, podName)
 		}
-		defer containerLogsOutputFp.Close()
+	}
+	for _, initContainer := range pod.Spec.InitContainers {
+		containerLogsFilepath := path.Join(podOutputDirpath, initContainer.Name+containerLogsFilenameSuffix)
+		if err := dumpContainerLogs(ctx, kubernetesManager, namespaceName, podName, initContainer.Name, containerLogsFilepath); err != nil {
+			return stacktrace.Propagate(err, "An error occurred dumping the logs of init container '%v' in pod '%v'", initContainer.Name, podName)
+		}
+	}
 
-		containerLogReadCloser, err := kubernetesManager.GetContainerLogs(
-			ctx,
-			namespaceName,
+	return nil
+}
+
+func dumpContainerLogs(
+	ctx context.Context,
+	kubernetesManager *kubernetes_manager.KubernetesManager,
+	namespaceName string,
+	podName string,
+	containerName string,
+	containerLogsFilepath string,
+) error {
+	containerLogsOutputFp, err := os.Create(containerLogsFilepath)
+	if err != nil {
+		return stacktrace.Propagate(
+			err,
+			"An error occurred creating file '%v' to hold the logs of container with name '%v' in pod '%v'",
+			containerLogsFilepath,
+			containerName,
 			podName,
+		)
+	}
+	defer containerLogsOutputFp.Close()
+
+	containerLogReadCloser, err := kubernetesManager.GetContainerLogs(
+		ctx,
+		namespaceName,
+		podName,
+		containerName,
+		shouldFollowPodLogsWhenDumping,
+		shouldAddTimestampsWhenDumpingPodLogs,
+	)
+	if err != nil {
+		return stacktrace.Propagate(
+			err,
+			"An error occurred getting logs of container '%v' in pod '%v' in namespace '%v'",
 			containerName,
-			shouldFollowPodLogsWhenDumping,
-			shouldAddTimestampsWhenDumpingPodLogs,
+			podName,
+			namespaceName,
 		)
-		if err != nil {
-			return stacktrace.Propagate(
-				err,
-				"An error occurred getting logs of container '%v' in pod '%v' in namespace '%v'",
-				containerName,
-				podName,
-				namespaceName,
-			)
-		}
-		defer containerLogReadCloser.Close()
+	}
+	defer containerLogReadCloser.Close()
 
-		if _, err := io.Copy(containerLogsOutputFp, containerLogReadCloser); err != nil {
-			return stacktrace.Propagate(
-				err,
-				"An error occurred writing logs of container '%v' in pod '%v' to file '%v'",
-				containerName,
-				podName,
-				containerLogsFilepath,
-			)
-		}
+	if _, err := io.Copy(containerLogsOutputFp, containerLogReadCloser); err != nil {
+		return stacktrace.Propagate(
+			err,
+			"An error occurred writing logs of container '%v' in pod '%v' to file '%v'",
+			containerName,
+			podName,
+			containerLogsFilepath,
+		)
 	}
+	return nil
+}
 
+// Events are what explains most of the pods that never started (image pull failures, unschedulable pods, failing
+// probes...), so we dump them next to the pods
+func dumpNamespaceEvents(
+	ctx context.Context,
+	kubernetesManager *kubernetes_manager.KubernetesManager,
+	namespaceName string,
+	outputDirpath string,
+) error {
+	events, err := kubernetesManager.GetEventsForNamespace(ctx, namespaceName)
+	if err != nil {
+		return stacktrace.Propagate(err, "An error occurred getting the events of namespace '%v'", namespaceName)
+	}
+	jsonSerializedEventsBytes, err := json.MarshalIndent(events.Items, enclaveDumpJsonSerializationPrefix, enclaveDumpJsonSerializationIndent)
+	if err != nil {
+		return stacktrace.Propagate(err, "An error occurred serializing the events of namespace '%v' to JSON", namespaceName)
+	}
+	eventsOutputFilepath := path.Join(outputDirpath, namespaceEventsFilename)
+	if err := os.WriteFile(eventsOutputFilepath, jsonSerializedEventsBytes, createdFilePerms); err != nil {
+		return stacktrace.Propagate(err, "An error occurred writing the events of namespace '%v' to file '%v'", namespaceName, eventsOutputFilepath)
+	}
 	return nil
 }
@@ -1,9 +1,14 @@
 package shared_helpers
 
 import (
+	"archive/tar"
+	"bytes"
+	"os"
+	"path"
+	"testing"
+
 	"github.com/kurtosis-tech/kurtosis/container-engine-lib/lib/backend_interface/objects/port_spec"
 	"github.com/stretchr/testify/require"
-	"testing"
 )
 
 func TestGetServicePortsFromPortSpecs(t *testing.T) {
@@ -45,3 +50,51 @@ func TestGetContainerPortsFromPortSpecs(t *testing.T) {
 	})
 	require.NoError(t, err)
 }
+
+func TestExtractTarArchive(t *testing.T) {
+	archive := buildTestTarArchive(t, map[string]string{
+		"./":                          "",
+		"./enclave.db":                "db content",
+		"./artifacts/":                "",
+		"./artifacts/genesis/data.gz": "genesis content",
+	})
+	destDirpath := path.Join(t.TempDir(), "enclave_data")
+
+	require.NoError(t, ExtractTarArchive(archive, destDirpath))
+
+	dbContent, err := os.ReadFile(path.Join(destDirpath, "enclave.db"))
+	require.NoError(t, err)
+	require.Equal(t, "db content", string(dbContent))
+	genesisContent, err := os.ReadFile(path.Join(destDirpath, "artifacts", "genesis", "data.gz"))
+	require.NoError(t, err)
+	require.Equal(t, "genesis content", string(genesisContent))
+}
+
+func TestExtractTarArchive_RejectsEntriesOutsideOfDestination(t *testing.T) {
+	archive := buildTestTarArchive(t, map[string]string{
+		"../escaped": "content",
+	})
+	destDirpath := path.Join(t.TempDir(), "enclave_data")
+
+	require.Error(t, ExtractTarArchive(archive, destDirpath))
+	require.NoFileExists(t, path.Join(destDirpath, "..", "escaped"))
+}
+
+// Entries ending with a '/' are directories
+func buildTestTarArchive(t *testing.T, entries map[string]string) *bytes.Buffer {
+	archive := &bytes.Buffer{}
+	archiveWriter := tar.NewWriter(archive)
+	for name, content := range entries {
+		// nolint: exhaustruct
+		header := &tar.Header{Name: name, Mode: 0644, Size: int64(len(content)), Typeflag: tar.TypeReg}
+		if name[len(name)-1] == '/' {
+			header.Typeflag = tar.TypeDir
+			header.Mode = 0755
+		}
+		require.NoError(t, archiveWriter.WriteHeader(header))
+		_, err := archiveWriter.Write([]byte(content))
+		require.NoError(t, err)
+	}
+	require.NoError(t, archiveWriter.Close())
+	return archive
+}
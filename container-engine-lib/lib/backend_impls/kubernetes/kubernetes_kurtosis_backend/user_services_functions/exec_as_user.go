@@ -0,0 +1,69 @@
+package user_services_functions
+
+import (
+	"fmt"
+	"strings"
+
+	"github.com/kurtosis-tech/stacktrace"
+)
+
+const (
+	containerUserGroupSeparator = ":"
+
+	// Name the shim shows up as in the process list of the container, passed as $0 of the shim script
+	execAsUserShimName = "kurtosis-exec-as-user"
+
+	// Picked outside the exit codes shells give a meaning to, so a command failing on its own is never mistaken for the
+	// shim failing to switch user
+	userSwitchUnsupportedExitCode = 213
+	userSwitchUnsupportedMarker   = "KURTOSIS_USER_SWITCH_UNSUPPORTED"
+)
+
+// Pod exec requests can't pick the user the command runs as, unlike Docker execs. The shim runs as the container user,
+// which has to be root, and switches to the requested user with whichever tool the image ships
+var execAsUserShimScript = fmt.Sprintf(`user="$1"; group="$2"; shift 2
+unsupported() {
+	echo "%[1]s: $1" >&2
+	exit %[2]d
+}
+if [ "$(id -u)" != "0" ]; then
+	unsupported "the container runs as uid $(id -u) instead of root so it can't switch to another user"
+fi
+if [ -z "$group" ]; then
+	group="$(id -g "$user" 2>/dev/null || echo 0)"
+fi
+if command -v setpriv > /dev/null 2>&1; then
+	exec setpriv --reuid="$user" --regid="$group" --clear-groups -- "$@"
+fi
+if chroot --help 2>&1 | grep -q -- '--userspec'; then
+	exec chroot --userspec="$user:$group" / "$@"
+fi
+unsupported "the image ships neither 'setpriv' nor a 'chroot' supporting '--userspec' to switch user with"`,
+	userSwitchUnsupportedMarker,
+	userSwitchUnsupportedExitCode,
+)
+
+// wrapCommandToRunAsUser wraps the command with the shim switching to the container user, which is formatted like the
+// Docker exec one: 'user', 'uid', 'user:group' or 'uid:gid'
+func wrapCommandToRunAsUser(containerUser string, command []string) ([]string, error) {
+	user, group, _ := strings.Cut(containerUser, containerUserGroupSeparator)
+	if user == "" {
+		return nil, stacktrace.NewError("Container user '%v' is invalid as it doesn't specify a user", containerUser)
+	}
+	wrappedCommand := []string{"sh", "-c", execAsUserShimScript, execAsUserShimName, user, group}
+	return append(wrappedCommand, command...), nil
+}
+
+// getUserSwitchUnsupportedReason returns the reason the shim gave for not being able to switch user, if the exec failed
+// because of that
+func getUserSwitchUnsupportedReason(exitCode int32, output string) (string, bool) {
+	if exitCode != userSwitchUnsupportedExitCode {
+		return "", false
+	}
+	for _, line := range strings.Split(output, "\n") {
+		if reason, found := strings.CutPrefix(line, userSwitchUnsupportedMarker+": "); found {
+			return reason, true
+		}
+	}
+	return "", false
+}
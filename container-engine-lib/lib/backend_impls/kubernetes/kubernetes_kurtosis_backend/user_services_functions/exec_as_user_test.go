@@ -0,0 +1,38 @@
+package user_services_functions
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestWrapCommandToRunAsUser(t *testing.T) {
+	command := []string{"sh", "-c", "whoami"}
+
+	wrappedCommand, err := wrapCommandToRunAsUser("1000:2000", command)
+	require.NoError(t, err)
+	require.Equal(t, []string{"sh", "-c", execAsUserShimScript, execAsUserShimName, "1000", "2000", "sh", "-c", "whoami"}, wrappedCommand)
+
+	// the group is resolved by the shim when it's left out
+	wrappedCommand, err = wrapCommandToRunAsUser("postgres", command)
+	require.NoError(t, err)
+	require.Equal(t, []string{"postgres", ""}, wrappedCommand[4:6])
+
+	_, err = wrapCommandToRunAsUser(":2000", command)
+	require.Error(t, err)
+}
+
+func TestGetUserSwitchUnsupportedReason(t *testing.T) {
+	output := "some output\n" + userSwitchUnsupportedMarker + ": the image ships nothing\n"
+
+	reason, isUserSwitchUnsupported := getUserSwitchUnsupportedReason(userSwitchUnsupportedExitCode, output)
+	require.True(t, isUserSwitchUnsupported)
+	require.Equal(t, "the image ships nothing", reason)
+
+	// the command itself failed, the marker can't be trusted
+	_, isUserSwitchUnsupported = getUserSwitchUnsupportedReason(1, output)
+	require.False(t, isUserSwitchUnsupported)
+
+	_, isUserSwitchUnsupported = getUserSwitchUnsupportedReason(userSwitchUnsupportedExitCode, "exited on its own")
+	require.False(t, isUserSwitchUnsupported)
+}
@@ -0,0 +1,52 @@
+package user_services_functions
+
+import (
+	"context"
+
+	"github.com/kurtosis-tech/kurtosis/container-engine-lib/lib/backend_impls/kubernetes/kubernetes_kurtosis_backend/shared_helpers"
+	"github.com/kurtosis-tech/kurtosis/container-engine-lib/lib/backend_impls/kubernetes/kubernetes_manager"
+	"github.com/kurtosis-tech/kurtosis/container-engine-lib/lib/backend_interface/objects/enclave"
+	"github.com/kurtosis-tech/kurtosis/container-engine-lib/lib/backend_interface/objects/service"
+	"github.com/kurtosis-tech/stacktrace"
+)
+
+// We'll try to use the nicer-to-use shells first before we drop down to the lower shells
+var commandToRunWhenCreatingUserServiceShell = []string{
+	"sh",
+	"-c",
+	`if command -v 'bash' > /dev/null; then
+		echo "Found bash on container; creating bash shell..."; bash; 
+       else 
+		echo "No bash found on container; dropping down to sh shell..."; sh; 
+	fi`,
+}
+
+func GetShellOnUserService(
+	ctx context.Context,
+	enclaveUuid enclave.EnclaveUUID,
+	serviceUuid service.ServiceUUID,
+	containerUser string,
+	cliModeArgs *shared_helpers.CliModeArgs,
+	apiContainerModeArgs *shared_helpers.ApiContainerModeArgs,
+	engineServerModeArgs *shared_helpers.EngineServerModeArgs,
+	kubernetesManager *kubernetes_manager.KubernetesManager,
+) error {
+	objectAndResources, err := shared_helpers.GetSingleUserServiceObjectsAndResources(ctx, enclaveUuid, serviceUuid, cliModeArgs, apiContainerModeArgs, engineServerModeArgs, kubernetesManager)
+	if err != nil {
+		return stacktrace.Propagate(err, "An error occurred getting user service object & Kubernetes resources for service '%v' in enclave '%v'", serviceUuid, enclaveUuid)
+	}
+	pod := objectAndResources.KubernetesResources.Pod
+
+	shellCommand := commandToRunWhenCreatingUserServiceShell
+	if containerUser != "" {
+		// The shim prints why it can't switch user on the terminal before exiting
+		shellCommand, err = wrapCommandToRunAsUser(containerUser, commandToRunWhenCreatingUserServiceShell)
+		if err != nil {
+			return stacktrace.Propagate(err, "An error occurred wrapping the shell command to run as user '%v'", containerUser)
+		}
+	}
+	if err := kubernetesManager.GetExecStream(ctx, pod, shellCommand); err != nil {
+		return stacktrace.Propagate(err, "An error occurred running a shell on user service '%v' in enclave '%v'", serviceUuid, enclaveUuid)
+	}
+	return nil
+}
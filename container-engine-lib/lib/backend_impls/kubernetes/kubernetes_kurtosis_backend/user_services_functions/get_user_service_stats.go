@@ -0,0 +1,78 @@
+package user_services_functions
+
+import (
+	"context"
+
+	"github.com/kurtosis-tech/kurtosis/container-engine-lib/lib/backend_impls/kubernetes/kubernetes_kurtosis_backend/shared_helpers"
+	"github.com/kurtosis-tech/kurtosis/container-engine-lib/lib/backend_impls/kubernetes/kubernetes_manager"
+	"github.com/kurtosis-tech/kurtosis/container-engine-lib/lib/backend_interface/objects/enclave"
+	"github.com/kurtosis-tech/kurtosis/container-engine-lib/lib/backend_interface/objects/service"
+	"github.com/kurtosis-tech/stacktrace"
+	apiv1 "k8s.io/api/core/v1"
+)
+
+const (
+	milliCoresToPercent = 10
+
+	// The metrics API doesn't report network and disk usage
+	unmeasuredNetworkReceivedBytes = 0
+	unmeasuredNetworkSentBytes     = 0
+	unmeasuredDiskReadBytes        = 0
+	unmeasuredDiskWrittenBytes     = 0
+)
+
+func GetUserServiceStats(
+	ctx context.Context,
+	enclaveId enclave.EnclaveUUID,
+	filters *service.ServiceFilters,
+	cliModeArgs *shared_helpers.CliModeArgs,
+	apiContainerModeArgs *shared_helpers.ApiContainerModeArgs,
+	engineServerModeArgs *shared_helpers.EngineServerModeArgs,
+	kubernetesManager *kubernetes_manager.KubernetesManager,
+) (successfulUserServiceStats map[service.ServiceUUID]*service.ServiceStats, erroredUserServiceUuids map[service.ServiceUUID]error, resultError error) {
+	serviceObjectsAndResources, err := shared_helpers.GetMatchingUserServiceObjectsAndKubernetesResources(ctx, enclaveId, filters, cliModeArgs, apiContainerModeArgs, engineServerModeArgs, kubernetesManager)
+	if err != nil {
+		return nil, nil, stacktrace.Propagate(err, "Expected to be able to get user services and Kubernetes resources, instead a non nil error was returned")
+	}
+	userServiceStats := map[service.ServiceUUID]*service.ServiceStats{}
+	erredServiceStats := map[service.ServiceUUID]error{}
+	for _, serviceObjectAndResource := range serviceObjectsAndResources {
+		serviceUuid := serviceObjectAndResource.Service.GetRegistration().GetUUID()
+		servicePod := serviceObjectAndResource.KubernetesResources.Pod
+		if servicePod == nil {
+			erredServiceStats[serviceUuid] = stacktrace.NewError("Expected to find a pod for Kurtosis service with UUID '%v', instead no pod was found", serviceUuid)
+			continue
+		}
+		usageByContainerName, err := kubernetesManager.GetPodContainersUsage(ctx, servicePod.GetNamespace(), servicePod.GetName())
+		if err != nil {
+			erredServiceStats[serviceUuid] = stacktrace.Propagate(err, "An error occurred getting the resources used by the pod of service with UUID '%v'", serviceUuid)
+			continue
+		}
+		usage, found := usageByContainerName[userServiceContainerName]
+		if !found {
+			erredServiceStats[serviceUuid] = stacktrace.NewError("No metrics were reported for container '%v' of the pod of service with UUID '%v'", userServiceContainerName, serviceUuid)
+			continue
+		}
+
+		var memoryLimitBytes uint64
+		for _, container := range servicePod.Spec.Containers {
+			if container.Name != userServiceContainerName {
+				continue
+			}
+			if memoryLimit, found := container.Resources.Limits[apiv1.ResourceMemory]; found {
+				memoryLimitBytes = uint64(memoryLimit.Value())
+			}
+		}
+
+		userServiceStats[serviceUuid] = service.NewServiceStats(
+			float64(usage.Cpu().MilliValue())/milliCoresToPercent,
+			uint64(usage.Memory().Value()),
+			memoryLimitBytes,
+			unmeasuredNetworkReceivedBytes,
+			unmeasuredNetworkSentBytes,
+			unmeasuredDiskReadBytes,
+			unmeasuredDiskWrittenBytes,
+		)
+	}
+	return userServiceStats, erredServiceStats, nil
+}
@@ -21,6 +21,7 @@ import (
 func RunUserServiceExecCommands(
 	ctx context.Context,
 	enclaveId enclave.EnclaveUUID,
+	containerUser string,
 	userServiceCommands map[service.ServiceUUID][]string,
 	cliModeArgs *shared_helpers.CliModeArgs,
 	apiContainerModeArgs *shared_helpers.ApiContainerModeArgs,
@@ -50,14 +51,14 @@ func RunUserServiceExecCommands(
 		return nil, nil, stacktrace.Propagate(err, "An error occurred getting user services matching the requested UUIDs: %+v", requestedGuids)
 	}
 
-	successfulExecs, failedExecs, err := runExecOperationsInParallel(namespaceName, userServiceCommands, matchingObjectsAndResources, kubernetesManager, ctx)
+	successfulExecs, failedExecs, err := runExecOperationsInParallel(namespaceName, containerUser, userServiceCommands, matchingObjectsAndResources, kubernetesManager, ctx)
 	if err != nil {
 		return nil, nil, stacktrace.Propagate(err, "An unexpected error occurred running the exec commands in parallel")
 	}
 	return successfulExecs, failedExecs, nil
 }
 
-func runExecOperationsInParallel(namespaceName string, commandArgs map[service.ServiceUUID][]string, userServiceKubernetesResources map[service.ServiceUUID]*shared_helpers.UserServiceObjectsAndKubernetesResources, kubernetesManager *kubernetes_manager.KubernetesManager, ctx context.Context) (map[service.ServiceUUID]*exec_result.ExecResult, map[service.ServiceUUID]error, error) {
+func runExecOperationsInParallel(namespaceName string, containerUser string, commandArgs map[service.ServiceUUID][]string, userServiceKubernetesResources map[service.ServiceUUID]*shared_helpers.UserServiceObjectsAndKubernetesResources, kubernetesManager *kubernetes_manager.KubernetesManager, ctx context.Context) (map[service.ServiceUUID]*exec_result.ExecResult, map[service.ServiceUUID]error, error) {
 	successfulExecs := map[service.ServiceUUID]*exec_result.ExecResult{}
 	failedExecs := map[service.ServiceUUID]error{}
 
@@ -95,7 +96,7 @@ func runExecOperationsInParallel(namespaceName string, commandArgs map[service.S
 		userServiceKubernetesPod := userServiceKubernetesResource.KubernetesResources.Pod
 
 		execOperationId := operation_parallelizer.OperationID(serviceUuid)
-		execOperation := createExecOperation(namespaceName, serviceUuid, userServiceKubernetesPod, commandArg, kubernetesManager, ctx)
+		execOperation := createExecOperation(namespaceName, serviceUuid, userServiceKubernetesPod, containerUser, commandArg, kubernetesManager, ctx)
 		execOperations[execOperationId] = execOperation
 	}
 
@@ -117,8 +118,16 @@ func runExecOperationsInParallel(namespaceName string, commandArgs map[service.S
 	return successfulExecs, failedExecs, nil
 }
 
-func createExecOperation(namespaceName string, serviceUuid service.ServiceUUID, servicePod *v1.Pod, commandArg []string, kubernetesManager *kubernetes_manager.KubernetesManager, ctx context.Context) operation_parallelizer.Operation {
+func createExecOperation(namespaceName string, serviceUuid service.ServiceUUID, servicePod *v1.Pod, containerUser string, commandArg []string, kubernetesManager *kubernetes_manager.KubernetesManager, ctx context.Context) operation_parallelizer.Operation {
 	return func() (interface{}, error) {
+		commandToRun := commandArg
+		if containerUser != "" {
+			wrappedCommand, err := wrapCommandToRunAsUser(containerUser, commandArg)
+			if err != nil {
+				return nil, stacktrace.Propagate(err, "An error occurred wrapping command '%+v' to run as user '%v'", commandArg, containerUser)
+			}
+			commandToRun = wrappedCommand
+		}
 		outputBuffer := &bytes.Buffer{}
 		concurrentBuffer := concurrent_writer.NewConcurrentWriter(outputBuffer)
 		exitCode, err := kubernetesManager.RunExecCommandWithContext(
@@ -126,7 +135,7 @@ func createExecOperation(namespaceName string, serviceUuid service.ServiceUUID,
 			namespaceName,
 			servicePod.Name,
 			userServiceContainerName,
-			commandArg,
+			commandToRun,
 			concurrentBuffer,
 			concurrentBuffer,
 		)
@@ -140,6 +149,11 @@ func createExecOperation(namespaceName string, serviceUuid service.ServiceUUID,
 				serviceUuid,
 			)
 		}
+		if containerUser != "" {
+			if reason, isUserSwitchUnsupported := getUserSwitchUnsupportedReason(exitCode, outputBuffer.String()); isUserSwitchUnsupported {
+				return nil, stacktrace.NewError("Command '%+v' can't run as user '%v' in service '%v' because %v", commandArg, containerUser, serviceUuid, reason)
+			}
+		}
 		return exec_result.NewExecResult(exitCode, outputBuffer.String()), nil
 	}
 }
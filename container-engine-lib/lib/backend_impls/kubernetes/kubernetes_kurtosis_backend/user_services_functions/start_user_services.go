@@ -47,6 +47,9 @@ const (
 	unboundPortNumber = 1
 
 	unlimitedReplacements = -1
+
+	// Browsers resolve every '*.localhost' hostname to the loopback address, where the gateway forwards the reverse proxy
+	localhostIngressHostSuffix = ".localhost"
 )
 
 // Completeness enforced via unit test
@@ -124,6 +127,7 @@ func StartRegisteredUserServices(
 	engineServerModeArgs *shared_helpers.EngineServerModeArgs,
 	kubernetesManager *kubernetes_manager.KubernetesManager,
 	restartPolicy apiv1.RestartPolicy,
+	builtImageReferences map[string]string,
 ) (
 	map[service.ServiceUUID]*service.Service,
 	map[service.ServiceUUID]error,
@@ -184,7 +188,8 @@ func StartRegisteredUserServices(
 		serviceRegisteredThatCanBeStarted,
 		existingObjectsAndResources,
 		kubernetesManager,
-		restartPolicy)
+		restartPolicy,
+		builtImageReferences)
 	if err != nil {
 		return nil, nil, stacktrace.Propagate(err, "An error occurred while trying to start services in parallel.")
 	}
@@ -247,6 +252,7 @@ func runStartServiceOperationsInParallel(
 	servicesObjectsAndResources map[service.ServiceUUID]*shared_helpers.UserServiceObjectsAndKubernetesResources,
 	kubernetesManager *kubernetes_manager.KubernetesManager,
 	restartPolicy apiv1.RestartPolicy,
+	builtImageReferences map[string]string,
 ) (
 	map[service.ServiceUUID]*service.Service,
 	map[service.ServiceUUID]error,
@@ -261,7 +267,8 @@ func runStartServiceOperationsInParallel(
 			servicesObjectsAndResources,
 			enclaveUUID,
 			kubernetesManager,
-			restartPolicy)
+			restartPolicy,
+			builtImageReferences)
 	}
 
 	successfulServiceObjs, failedOperations := operation_parallelizer.RunOperationsInParallel(startServiceOperations)
@@ -295,12 +302,17 @@ func createStartServiceOperation(
 	servicesObjectsAndResources map[service.ServiceUUID]*shared_helpers.UserServiceObjectsAndKubernetesResources,
 	enclaveUuid enclave.EnclaveUUID,
 	kubernetesManager *kubernetes_manager.KubernetesManager,
-	restartPolicy apiv1.RestartPolicy) operation_parallelizer.Operation {
+	restartPolicy apiv1.RestartPolicy,
+	builtImageReferences map[string]string) operation_parallelizer.Operation {
 
 	return func() (interface{}, error) {
 		filesArtifactsExpansion := serviceConfig.GetFilesArtifactsExpansion()
 		persistentDirectories := serviceConfig.GetPersistentDirectories()
 		containerImageName := serviceConfig.GetContainerImageName()
+		// Images built in the cluster only exist in the image build registry, so pods have to pull them from there
+		if builtImageReference, found := builtImageReferences[containerImageName]; found {
+			containerImageName = builtImageReference
+		}
 		privatePorts := serviceConfig.GetPrivatePorts()
 		entrypointArgs := serviceConfig.GetEntrypointArgs()
 		cmdArgs := serviceConfig.GetCmdArgs()
@@ -1028,30 +1040,33 @@ func getUserServiceIngressRules(
 		}
 		if maybeApplicationProtocol == consts.HttpApplicationProtocol {
 			host := fmt.Sprintf("%d-%s-%s", portSpec.GetNumber(), serviceShortUuid, enclaveShortUuid)
-			ingressRule := netv1.IngressRule{
-				Host: host,
-				IngressRuleValue: netv1.IngressRuleValue{
-					HTTP: &netv1.HTTPIngressRuleValue{
-						Paths: []netv1.HTTPIngressPath{
-							{
-								Path:     consts.IngressRulePathAllPaths,
-								PathType: &consts.IngressRulePathTypePrefix,
-								Backend: netv1.IngressBackend{
-									Service: &netv1.IngressServiceBackend{
-										Name: string(serviceRegistration.GetName()),
-										Port: netv1.ServiceBackendPort{
-											Name:   "",
-											Number: int32(portSpec.GetNumber()),
+			// The same port is also routed under a '.localhost' host so its URL can be browsed through the reverse proxy
+			for _, ruleHost := range []string{host, host + localhostIngressHostSuffix} {
+				ingressRule := netv1.IngressRule{
+					Host: ruleHost,
+					IngressRuleValue: netv1.IngressRuleValue{
+						HTTP: &netv1.HTTPIngressRuleValue{
+							Paths: []netv1.HTTPIngressPath{
+								{
+									Path:     consts.IngressRulePathAllPaths,
+									PathType: &consts.IngressRulePathTypePrefix,
+									Backend: netv1.IngressBackend{
+										Service: &netv1.IngressServiceBackend{
+											Name: string(serviceRegistration.GetName()),
+											Port: netv1.ServiceBackendPort{
+												Name:   "",
+												Number: int32(portSpec.GetNumber()),
+											},
 										},
+										Resource: nil,
 									},
-									Resource: nil,
 								},
 							},
 						},
 					},
-				},
+				}
+				ingressRules = append(ingressRules, ingressRule)
 			}
-			ingressRules = append(ingressRules, ingressRule)
 		}
 	}
 	return ingressRules, nil
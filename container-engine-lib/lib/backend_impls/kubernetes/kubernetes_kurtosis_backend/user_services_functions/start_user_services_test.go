@@ -1,7 +1,11 @@
 package user_services_functions
 
 import (
+	"github.com/kurtosis-tech/kurtosis/container-engine-lib/lib/backend_interface/objects/enclave"
+	"github.com/kurtosis-tech/kurtosis/container-engine-lib/lib/backend_interface/objects/port_spec"
+	"github.com/kurtosis-tech/kurtosis/container-engine-lib/lib/backend_interface/objects/service"
 	"github.com/stretchr/testify/require"
+	"net"
 	"testing"
 )
 
@@ -11,3 +15,33 @@ func TestConvertMemoryAllocationToBytesReturnsCorrectValue(t *testing.T) {
 	memoryAllocationBytes := convertMegabytesToBytes(memoryAllocationMegabytes)
 	require.Equal(t, uint64(400000000), memoryAllocationBytes)
 }
+
+func TestGetUserServiceIngressRulesRoutesHttpPortsOnly(t *testing.T) {
+	serviceRegistration := service.NewServiceRegistration(
+		"my-service",
+		"3771c85af16a40a18201acf4b4b5ad28",
+		enclave.EnclaveUUID("65d2fb6d673249b8b4a91a2f4ae616de"),
+		net.ParseIP("10.0.0.1"),
+		"my-service",
+	)
+	httpPortSpec, err := port_spec.NewPortSpec(8080, port_spec.TransportProtocol_TCP, "http", nil, "")
+	require.NoError(t, err)
+	grpcPortSpec, err := port_spec.NewPortSpec(9090, port_spec.TransportProtocol_TCP, "grpc", nil, "")
+	require.NoError(t, err)
+
+	ingressRules, err := getUserServiceIngressRules(serviceRegistration, map[string]*port_spec.PortSpec{
+		"http": httpPortSpec,
+		"grpc": grpcPortSpec,
+	})
+	require.NoError(t, err)
+
+	require.Len(t, ingressRules, 2)
+	require.Equal(t, "8080-3771c85af16a-65d2fb6d6732", ingressRules[0].Host)
+	require.Equal(t, "8080-3771c85af16a-65d2fb6d6732.localhost", ingressRules[1].Host)
+	for _, ingressRule := range ingressRules {
+		paths := ingressRule.IngressRuleValue.HTTP.Paths
+		require.Len(t, paths, 1)
+		require.Equal(t, "my-service", paths[0].Backend.Service.Name)
+		require.Equal(t, int32(8080), paths[0].Backend.Service.Port.Number)
+	}
+}
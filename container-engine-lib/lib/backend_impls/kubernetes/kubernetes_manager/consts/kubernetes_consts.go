@@ -28,6 +28,14 @@ const (
 	DaemonSetsKubernetesResource             = "daemonsets"
 	DeploymentsKubernetesResource            = "deployments"
 	DeploymentsScaleKubernetesResource       = "deployments/scale"
+	EventsKubernetesResource                 = "events"
+	EndpointsKubernetesResource              = "endpoints"
+	SecretsKubernetesResource                = "secrets"
+	IngressClassesKubernetesResource         = "ingressclasses"
+	IngressesStatusKubernetesResource        = "ingresses/status"
+	ResourceQuotasKubernetesResource         = "resourcequotas"
+	LimitRangesKubernetesResource            = "limitranges"
+	NetworkPoliciesKubernetesResource        = "networkpolicies"
 
 	ClusterRoleKubernetesResourceType = "ClusterRole"
 	RoleKubernetesResourceType        = "Role"
@@ -79,18 +79,9 @@ const (
 	listOptionsTimeoutSeconds      int64 = 10
 	contextDeadlineExceeded              = "context deadline exceeded"
 	expectedStatusMessageSliceSize       = 6
-)
 
-// We'll try to use the nicer-to-use shells first before we drop down to the lower shells
-var commandToRunWhenCreatingUserServiceShell = []string{
-	"sh",
-	"-c",
-	`if command -v 'bash' > /dev/null; then
-		echo "Found bash on container; creating bash shell..."; bash; 
-       else 
-		echo "No bash found on container; dropping down to sh shell..."; sh; 
-	fi`,
-}
+	podMetricsAbsPathFormat = "/apis/metrics.k8s.io/v1beta1/namespaces/%s/pods/%s"
+)
 
 var (
 	globalDeletePolicy  = metav1.DeletePropagationForeground
@@ -140,9 +131,17 @@ var (
 	}
 )
 
+// The subset of the metrics.k8s.io PodMetrics resource that we use, so that we don't need its whole client
+type podMetrics struct {
+	Containers []struct {
+		Name  string             `json:"name"`
+		Usage apiv1.ResourceList `json:"usage"`
+	} `json:"containers"`
+}
+
 type KubernetesManager struct {
 	// The underlying K8s client that will be used to modify the K8s environment
-	kubernetesClientSet *kubernetes.Clientset
+	kubernetesClientSet kubernetes.Interface
 
 	// Underlying restClient configuration
 	kuberneteRestConfig *rest.Config
@@ -152,7 +151,7 @@ type KubernetesManager struct {
 
 func int64Ptr(i int64) *int64 { return &i }
 
-func NewKubernetesManager(kubernetesClientSet *kubernetes.Clientset, kuberneteRestConfig *rest.Config, storageClass string) *KubernetesManager {
+func NewKubernetesManager(kubernetesClientSet kubernetes.Interface, kuberneteRestConfig *rest.Config, storageClass string) *KubernetesManager {
 	return &KubernetesManager{
 		kubernetesClientSet: kubernetesClientSet,
 		kuberneteRestConfig: kuberneteRestConfig,
@@ -602,6 +601,146 @@ func (manager *KubernetesManager) GetNamespacesByLabels(ctx context.Context, nam
 	return &namespacesNotMarkedForDeletionnamespaceList, nil
 }
 
+// ---------------------------resource quotas, limit ranges and network policies------------------------------------------
+
+func (manager *KubernetesManager) CreateResourceQuota(ctx context.Context, namespace string, name string, labels map[string]string, hardLimits apiv1.ResourceList) (*apiv1.ResourceQuota, error) {
+	client := manager.kubernetesClientSet.CoreV1().ResourceQuotas(namespace)
+
+	resourceQuota := &apiv1.ResourceQuota{
+		TypeMeta: metav1.TypeMeta{
+			Kind:       "",
+			APIVersion: "",
+		},
+		ObjectMeta: metav1.ObjectMeta{
+			Name:            name,
+			GenerateName:    "",
+			Namespace:       "",
+			SelfLink:        "",
+			UID:             "",
+			ResourceVersion: "",
+			Generation:      0,
+			CreationTimestamp: metav1.Time{
+				Time: time.Time{},
+			},
+			DeletionTimestamp:          nil,
+			DeletionGracePeriodSeconds: nil,
+			Labels:                     labels,
+			Annotations:                nil,
+			OwnerReferences:            nil,
+			Finalizers:                 nil,
+			ManagedFields:              nil,
+		},
+		Spec: apiv1.ResourceQuotaSpec{
+			Hard:          hardLimits,
+			Scopes:        nil,
+			ScopeSelector: nil,
+		},
+		Status: apiv1.ResourceQuotaStatus{
+			Hard: nil,
+			Used: nil,
+		},
+	}
+
+	resourceQuotaResult, err := client.Create(ctx, resourceQuota, globalCreateOptions)
+	if err != nil {
+		return nil, stacktrace.Propagate(err, "Failed to create resource quota with name '%s' in namespace '%v' and hard limits '%+v'", name, namespace, hardLimits)
+	}
+	return resourceQuotaResult, nil
+}
+
+func (manager *KubernetesManager) CreateLimitRange(ctx context.Context, namespace string, name string, labels map[string]string, limits []apiv1.LimitRangeItem) (*apiv1.LimitRange, error) {
+	client := manager.kubernetesClientSet.CoreV1().LimitRanges(namespace)
+
+	limitRange := &apiv1.LimitRange{
+		TypeMeta: metav1.TypeMeta{
+			Kind:       "",
+			APIVersion: "",
+		},
+		ObjectMeta: metav1.ObjectMeta{
+			Name:            name,
+			GenerateName:    "",
+			Namespace:       "",
+			SelfLink:        "",
+			UID:             "",
+			ResourceVersion: "",
+			Generation:      0,
+			CreationTimestamp: metav1.Time{
+				Time: time.Time{},
+			},
+			DeletionTimestamp:          nil,
+			DeletionGracePeriodSeconds: nil,
+			Labels:                     labels,
+			Annotations:                nil,
+			OwnerReferences:            nil,
+			Finalizers:                 nil,
+			ManagedFields:              nil,
+		},
+		Spec: apiv1.LimitRangeSpec{
+			Limits: limits,
+		},
+	}
+
+	limitRangeResult, err := client.Create(ctx, limitRange, globalCreateOptions)
+	if err != nil {
+		return nil, stacktrace.Propagate(err, "Failed to create limit range with name '%s' in namespace '%v' and limits '%+v'", name, namespace, limits)
+	}
+	return limitRangeResult, nil
+}
+
+// CreateIngressNetworkPolicy creates a network policy denying all ingress traffic to the pods matching the selector,
+// except for the traffic allowed by the rules
+func (manager *KubernetesManager) CreateIngressNetworkPolicy(
+	ctx context.Context,
+	namespace string,
+	name string,
+	labels map[string]string,
+	podSelector metav1.LabelSelector,
+	ingressRules []netv1.NetworkPolicyIngressRule,
+) (*netv1.NetworkPolicy, error) {
+	client := manager.kubernetesClientSet.NetworkingV1().NetworkPolicies(namespace)
+
+	networkPolicy := &netv1.NetworkPolicy{
+		TypeMeta: metav1.TypeMeta{
+			Kind:       "",
+			APIVersion: "",
+		},
+		ObjectMeta: metav1.ObjectMeta{
+			Name:            name,
+			GenerateName:    "",
+			Namespace:       "",
+			SelfLink:        "",
+			UID:             "",
+			ResourceVersion: "",
+			Generation:      0,
+			CreationTimestamp: metav1.Time{
+				Time: time.Time{},
+			},
+			DeletionTimestamp:          nil,
+			DeletionGracePeriodSeconds: nil,
+			Labels:                     labels,
+			Annotations:                nil,
+			OwnerReferences:            nil,
+			Finalizers:                 nil,
+			ManagedFields:              nil,
+		},
+		Spec: netv1.NetworkPolicySpec{
+			PodSelector: podSelector,
+			Ingress:     ingressRules,
+			Egress:      nil,
+			PolicyTypes: []netv1.PolicyType{netv1.PolicyTypeIngress},
+		},
+		Status: netv1.NetworkPolicyStatus{
+			Conditions: nil,
+		},
+	}
+
+	networkPolicyResult, err := client.Create(ctx, networkPolicy, globalCreateOptions)
+	if err != nil {
+		return nil, stacktrace.Propagate(err, "Failed to create network policy with name '%s' in namespace '%v'", name, namespace)
+	}
+	return networkPolicyResult, nil
+}
+
 // ---------------------------service accounts------------------------------------------------------------------------------
 
 func (manager *KubernetesManager) CreateServiceAccount(ctx context.Context, name string, namespace string, labels map[string]string, imagePullSecrets []apiv1.LocalObjectReference) (*apiv1.ServiceAccount, error) {
@@ -1209,6 +1348,18 @@ func (manager *KubernetesManager) GetPod(ctx context.Context, namespace string,
 	return pod, nil
 }
 
+// GetEventsForNamespace returns all the events that Kubernetes recorded for the objects of the given namespace
+func (manager *KubernetesManager) GetEventsForNamespace(ctx context.Context, namespace string) (*apiv1.EventList, error) {
+	eventsClient := manager.kubernetesClientSet.CoreV1().Events(namespace)
+
+	events, err := eventsClient.List(ctx, globalListOptions)
+	if err != nil {
+		return nil, stacktrace.Propagate(err, "Failed to get events in namespace '%s'", namespace)
+	}
+
+	return events, nil
+}
+
 // ---------------------------daemon sets---------------------------------------------------------------------------------------
 func (manager *KubernetesManager) RemoveDaemonSet(ctx context.Context, namespace string, daemonSet *v1.DaemonSet) error {
 	client := manager.kubernetesClientSet.AppsV1().DaemonSets(namespace)
@@ -1473,6 +1624,7 @@ func (manager *KubernetesManager) CreateDeployment(
 	initContainers []apiv1.Container,
 	containers []apiv1.Container,
 	volumes []apiv1.Volume,
+	serviceAccountName string,
 	affinity *apiv1.Affinity,
 ) (*v1.Deployment, error) {
 	deploymentClient := manager.kubernetesClientSet.AppsV1().Deployments(namespaceName)
@@ -1523,7 +1675,7 @@ func (manager *KubernetesManager) CreateDeployment(
 				ActiveDeadlineSeconds:         nil,
 				DNSPolicy:                     "",
 				NodeSelector:                  nil,
-				ServiceAccountName:            "",
+				ServiceAccountName:            serviceAccountName,
 				DeprecatedServiceAccount:      "",
 				AutomountServiceAccountToken:  nil,
 				NodeName:                      "",
@@ -1879,6 +2031,32 @@ func (manager *KubernetesManager) GetContainerLogs(
 	return result, nil
 }
 
+// GetPodContainersUsage returns the resources each container of the pod currently uses, as reported by the metrics
+// API. The cluster needs a metrics server for it to be served
+func (manager *KubernetesManager) GetPodContainersUsage(
+	ctx context.Context,
+	namespaceName string,
+	podName string,
+) (
+	map[string]apiv1.ResourceList,
+	error,
+) {
+	podMetricsPath := fmt.Sprintf(podMetricsAbsPathFormat, namespaceName, podName)
+	rawPodMetrics, err := manager.kubernetesClientSet.CoreV1().RESTClient().Get().AbsPath(podMetricsPath).DoRaw(ctx)
+	if err != nil {
+		return nil, stacktrace.Propagate(err, "An error occurred getting the metrics of pod '%v' in namespace '%v'; is a metrics server running in the cluster?", podName, namespaceName)
+	}
+	podMetrics := &podMetrics{Containers: nil}
+	if err := json.Unmarshal(rawPodMetrics, podMetrics); err != nil {
+		return nil, stacktrace.Propagate(err, "An error occurred deserializing the metrics of pod '%v' in namespace '%v'", podName, namespaceName)
+	}
+	usageByContainerName := map[string]apiv1.ResourceList{}
+	for _, containerMetrics := range podMetrics.Containers {
+		usageByContainerName[containerMetrics.Name] = containerMetrics.Usage
+	}
+	return usageByContainerName, nil
+}
+
 // RunExecCommandWithContext This runs the exec to kubernetes with context, therefore
 // when context timeouts it stops the process.
 // TODO: merge RunExecCommand and this to one method
@@ -1897,13 +2075,47 @@ func (manager *KubernetesManager) RunExecCommandWithContext(
 ) (
 	resultExitCode int32,
 	resultErr error,
+) {
+	return manager.runExecCommandWithContext(ctx, namespaceName, podName, containerName, command, nil, stdOutOutput, stdErrOutput)
+}
+
+// RunExecCommandWithStdIn runs the command in the container the same way RunExecCommandWithContext does, but also
+// streams the received reader to the command's stdin until the reader is exhausted
+func (manager *KubernetesManager) RunExecCommandWithStdIn(
+	ctx context.Context,
+	namespaceName string,
+	podName string,
+	containerName string,
+	command []string,
+	stdInInput io.Reader,
+	stdOutOutput io.Writer,
+	stdErrOutput io.Writer,
+) (
+	resultExitCode int32,
+	resultErr error,
+) {
+	return manager.runExecCommandWithContext(ctx, namespaceName, podName, containerName, command, stdInInput, stdOutOutput, stdErrOutput)
+}
+
+func (manager *KubernetesManager) runExecCommandWithContext(
+	ctx context.Context,
+	namespaceName string,
+	podName string,
+	containerName string,
+	command []string,
+	maybeStdInInput io.Reader,
+	stdOutOutput io.Writer,
+	stdErrOutput io.Writer,
+) (
+	resultExitCode int32,
+	resultErr error,
 ) {
 	execOptions := &apiv1.PodExecOptions{
 		TypeMeta: metav1.TypeMeta{
 			Kind:       "",
 			APIVersion: "",
 		},
-		Stdin:     shouldAllocateStdinOnPodExec,
+		Stdin:     maybeStdInInput != nil,
 		Stdout:    shouldAllocatedStdoutOnPodExec,
 		Stderr:    shouldAllocatedStderrOnPodExec,
 		TTY:       shouldAllocateTtyOnPodExec,
@@ -1937,7 +2149,7 @@ func (manager *KubernetesManager) RunExecCommandWithContext(
 	}
 
 	if err = exec.StreamWithContext(ctx, remotecommand.StreamOptions{
-		Stdin:             nil,
+		Stdin:             maybeStdInInput,
 		Stdout:            stdOutOutput,
 		Stderr:            stdErrOutput,
 		Tty:               false,
@@ -2248,6 +2460,132 @@ func (manager *KubernetesManager) RemoveDirPathFromNode(ctx context.Context, nam
 	return nil
 }
 
+// RemoveImagesFromNode removes [imagesToRemove] from the image cache of [nodeName] by creating a pod in [namespace] with privileged access to the node
+// The host filesystem is mounted onto the pod and crictl is run from it, as the node's container runtime is the only one that knows about these images
+func (manager *KubernetesManager) RemoveImagesFromNode(ctx context.Context, namespace string, nodeName string, imagesToRemove []string) error {
+	removeContainerName := "remove-images-container"
+	// pod needs to be privileged to chroot into the host filesystem
+	isPrivileged := true
+	removeImagesPodUUID, err := uuid_generator.GenerateUUIDString()
+	if err != nil {
+		return stacktrace.Propagate(err, "An error occurred generating uuid for remove images pod.")
+	}
+	removeImagesPodName := fmt.Sprintf("remove-images-pod-%v", removeImagesPodUUID)
+	hostVolumeName := "remove-images-vol"
+	hostRootDirPath := "/"
+	mountPath := "/host"
+	nodeSelectorsToSchedulePodOnNode := map[string]string{
+		apiv1.LabelHostname: nodeName,
+	}
+	removeImagesPod, err := manager.CreatePod(
+		ctx,
+		namespace,
+		removeImagesPodName,
+		nil,
+		nil,
+		nil,
+		[]apiv1.Container{
+			{
+				Name:  removeContainerName,
+				Image: "busybox",
+				Command: []string{
+					"sh",
+					"-c",
+					"sleep 10000000s",
+				},
+				Args:       nil,
+				WorkingDir: "",
+				Ports:      nil,
+				EnvFrom:    nil,
+				Env:        nil,
+				Resources: apiv1.ResourceRequirements{
+					Limits:   nil,
+					Requests: nil,
+					Claims:   nil,
+				},
+				ResizePolicy: nil,
+				VolumeMounts: []apiv1.VolumeMount{
+					{
+						Name:             hostVolumeName,
+						ReadOnly:         false,
+						MountPath:        mountPath,
+						SubPath:          "",
+						MountPropagation: nil,
+						SubPathExpr:      "",
+					},
+				},
+				VolumeDevices:            nil,
+				LivenessProbe:            nil,
+				ReadinessProbe:           nil,
+				StartupProbe:             nil,
+				Lifecycle:                nil,
+				TerminationMessagePath:   "",
+				TerminationMessagePolicy: "",
+				ImagePullPolicy:          "",
+				SecurityContext: &apiv1.SecurityContext{
+					Privileged:               &isPrivileged,
+					Capabilities:             nil,
+					SeccompProfile:           nil,
+					ProcMount:                nil,
+					ReadOnlyRootFilesystem:   nil,
+					AllowPrivilegeEscalation: nil,
+					RunAsNonRoot:             nil,
+					RunAsGroup:               nil,
+					RunAsUser:                nil,
+					SELinuxOptions:           nil,
+					WindowsOptions:           nil,
+				},
+				Stdin:     false,
+				StdinOnce: false,
+				TTY:       false,
+			},
+		}, []apiv1.Volume{
+			{
+				Name:         hostVolumeName,
+				VolumeSource: manager.GetVolumeSourceForHostPath(hostRootDirPath),
+			},
+		}, "", "", nil, nodeSelectorsToSchedulePodOnNode)
+	defer func() {
+		// Don't block on removing this remove images pod because this can take a while sometimes in k8s
+		go func() {
+			removeCtx := context.Background()
+			if removeImagesPod != nil {
+				err := manager.RemovePod(removeCtx, removeImagesPod)
+				if err != nil {
+					logrus.Warnf("Attempted to remove pod '%v' in namespace '%v' but an error occurred:\n%v", removeImagesPod.Name, namespace, err.Error())
+					logrus.Warn("You may have to remove this pod manually.")
+				}
+			}
+		}()
+	}()
+	if err != nil {
+		return stacktrace.Propagate(err, "An error occurred creating pod '%v' in namespace '%v'.", removeImagesPodName, namespace)
+	}
+
+	removeImagesSuccessExitCode := int32(0)
+	removeImagesCmd := append([]string{"chroot", mountPath, "crictl", "rmi"}, imagesToRemove...)
+	output := &bytes.Buffer{}
+	concurrentWriter := concurrent_writer.NewConcurrentWriter(output)
+	resultExitCode, err := manager.RunExecCommand(
+		removeImagesPod.Namespace,
+		removeImagesPod.Name,
+		removeContainerName,
+		removeImagesCmd,
+		concurrentWriter,
+		concurrentWriter,
+	)
+	logrus.Debugf("Output of remove images '%v': %v, exit code: %v", removeImagesCmd, output.String(), resultExitCode)
+	if err != nil {
+		return stacktrace.Propagate(err, "An error occurred running exec command '%v' on pod '%v' in namespace '%v' with output '%v'.", removeImagesCmd, removeImagesPod.Name, removeImagesPod.Namespace, output.String())
+	}
+	if resultExitCode != removeImagesSuccessExitCode {
+		return stacktrace.NewError("Running exec command '%v' on pod '%v' in namespace '%v' returned a non-%v exit code: '%v' and output '%v'.", removeImagesCmd, removeImagesPod.Name, removeImagesPod.Namespace, removeImagesSuccessExitCode, resultExitCode, output.String())
+	}
+
+	logrus.Debugf("Successfully removed images '%v' from node '%v'.", imagesToRemove, nodeName)
+	return nil
+}
+
 func (manager *KubernetesManager) GetAllEnclaveResourcesByLabels(ctx context.Context, namespace string, labels map[string]string) (*apiv1.PodList, *apiv1.ServiceList, *rbacv1.ClusterRoleList, *rbacv1.ClusterRoleBindingList, error) {
 
 	var (
@@ -2385,13 +2723,13 @@ func (manager *KubernetesManager) GetPodPortforwardEndpointUrl(namespace string,
 	return manager.kubernetesClientSet.CoreV1().RESTClient().Post().Resource("pods").Namespace(namespace).Name(podName).SubResource("portforward").URL()
 }
 
-func (manager *KubernetesManager) GetExecStream(ctx context.Context, pod *apiv1.Pod) error {
+func (manager *KubernetesManager) GetExecStream(ctx context.Context, pod *apiv1.Pod, command []string) error {
 	containerName := pod.Spec.Containers[0].Name
 	request := manager.kubernetesClientSet.CoreV1().RESTClient().Post().Resource("pods").Name(pod.Name).Namespace(pod.Namespace).SubResource("exec")
 	// lifted from https://github.com/kubernetes/client-go/issues/912 - the terminal magic is still magical
 	request.VersionedParams(&apiv1.PodExecOptions{
 		Container: containerName,
-		Command:   commandToRunWhenCreatingUserServiceShell,
+		Command:   command,
 		Stdin:     true,
 		Stdout:    true,
 		Stderr:    true,
@@ -2438,6 +2776,14 @@ func (manager *KubernetesManager) HasComputeNodes(ctx context.Context) (bool, er
 	return len(nodes.Items) != 0, nil
 }
 
+func (manager *KubernetesManager) GetNodes(ctx context.Context) (*apiv1.NodeList, error) {
+	nodes, err := manager.kubernetesClientSet.CoreV1().Nodes().List(ctx, globalListOptions)
+	if err != nil {
+		return nil, stacktrace.Propagate(err, "An error occurred while listing the nodes of the Kubernetes cluster")
+	}
+	return nodes, nil
+}
+
 // AddLabelsToNode will add kurtosis related [labels] from [nodeName] - non Kurtosis labels will not be allowed for addition
 func (manager *KubernetesManager) AddLabelsToNode(ctx context.Context, nodeName string, labels map[string]string) error {
 	for k := range labels {
@@ -25,11 +25,16 @@ const (
 
 	enclaveDataDirFragment = "enclave-data-dir"
 
+	imageBuilderFragment  = "kurtosis-image-builder"
+	imageRegistryFragment = "kurtosis-image-registry"
+	imagePullerFragment   = "kurtosis-image-puller"
+
 	traefikIngressRouterEntrypointsValue = "web"
 )
 
 type KubernetesEnclaveObjectAttributesProvider interface {
 	ForEnclaveNamespace(creationTime time.Time, enclaveName string) (KubernetesObjectAttributes, error)
+	ForEnclaveNamespaceUserLabels(userLabels map[string]string) (map[*kubernetes_label_key.KubernetesLabelKey]*kubernetes_label_value.KubernetesLabelValue, error)
 	ForApiContainer() KubernetesApiContainerObjectAttributesProvider
 	ForEnclaveDataDirVolume() (KubernetesObjectAttributes, error)
 	ForUserServiceService(
@@ -50,6 +55,9 @@ type KubernetesEnclaveObjectAttributesProvider interface {
 		id service.ServiceName,
 		privatePorts map[string]*port_spec.PortSpec,
 	) (KubernetesObjectAttributes, error)
+	ForImageBuilderPod(buildUuid string) (KubernetesObjectAttributes, error)
+	ForImageRegistry() (KubernetesObjectAttributes, error)
+	ForImagePullerDaemonSet(pullUuid string) (KubernetesObjectAttributes, error)
 }
 
 // Private so it can't be instantiated
@@ -119,6 +127,25 @@ func (provider *kubernetesEnclaveObjectAttributesProviderImpl) ForEnclaveNamespa
 	return objectAttributes, nil
 }
 
+// The user labels of an enclave get applied to its namespace after it's created, as they can change during its lifetime
+func (provider *kubernetesEnclaveObjectAttributesProviderImpl) ForEnclaveNamespaceUserLabels(
+	userLabels map[string]string,
+) (map[*kubernetes_label_key.KubernetesLabelKey]*kubernetes_label_value.KubernetesLabelValue, error) {
+	labels := map[*kubernetes_label_key.KubernetesLabelKey]*kubernetes_label_value.KubernetesLabelValue{}
+	for userLabelKey, userLabelValue := range userLabels {
+		kubernetesLabelKey, err := kubernetes_label_key.CreateNewKubernetesUserCustomLabelKey(userLabelKey)
+		if err != nil {
+			return nil, stacktrace.Propagate(err, "An error occurred creating a new user custom Kubernetes label key '%s'", userLabelKey)
+		}
+		kubernetesLabelValue, err := kubernetes_label_value.CreateNewKubernetesLabelValue(userLabelValue)
+		if err != nil {
+			return nil, stacktrace.Propagate(err, "An error occurred creating a new user custom Kubernetes label value '%s'", userLabelValue)
+		}
+		labels[kubernetesLabelKey] = kubernetesLabelValue
+	}
+	return labels, nil
+}
+
 func (provider *kubernetesEnclaveObjectAttributesProviderImpl) ForApiContainer() KubernetesApiContainerObjectAttributesProvider {
 	enclaveId := enclave.EnclaveUUID(provider.enclaveId)
 	return GetKubernetesApiContainerObjectAttributesProvider(enclaveId)
@@ -321,6 +348,80 @@ func (provider *kubernetesEnclaveObjectAttributesProviderImpl) ForUserServiceIng
 	return objectAttributes, nil
 }
 
+func (provider *kubernetesEnclaveObjectAttributesProviderImpl) ForImageBuilderPod(buildUuid string) (KubernetesObjectAttributes, error) {
+	name, err := getCompositeKubernetesObjectName([]string{
+		imageBuilderFragment,
+		buildUuid,
+	})
+	if err != nil {
+		return nil, stacktrace.Propagate(err, "An error occurred creating the image builder pod name for build '%v'", buildUuid)
+	}
+
+	labels, err := provider.getLabelsForEnclaveObjectWithIDAndGUID(imageBuilderFragment, buildUuid)
+	if err != nil {
+		return nil, stacktrace.Propagate(err, "Failed to get labels for image builder pod of build '%v'", buildUuid)
+	}
+	labels[kubernetes_label_key.KurtosisResourceTypeKubernetesLabelKey] = label_value_consts.ImageBuilderKurtosisResourceTypeKubernetesLabelValue
+
+	annotations := map[*kubernetes_annotation_key.KubernetesAnnotationKey]*kubernetes_annotation_value.KubernetesAnnotationValue{}
+
+	objectAttributes, err := newKubernetesObjectAttributesImpl(name, labels, annotations)
+	if err != nil {
+		return nil, stacktrace.Propagate(err, "Failed to create image builder pod object attributes")
+	}
+
+	return objectAttributes, nil
+}
+
+func (provider *kubernetesEnclaveObjectAttributesProviderImpl) ForImageRegistry() (KubernetesObjectAttributes, error) {
+	name, err := getCompositeKubernetesObjectName([]string{
+		imageRegistryFragment,
+	})
+	if err != nil {
+		return nil, stacktrace.Propagate(err, "An error occurred creating the enclave image registry name")
+	}
+
+	labels, err := provider.getLabelsForEnclaveObjectWithIDAndGUID(imageRegistryFragment, provider.enclaveId)
+	if err != nil {
+		return nil, stacktrace.Propagate(err, "Failed to get labels for the image registry of enclave '%v'", provider.enclaveId)
+	}
+	labels[kubernetes_label_key.KurtosisResourceTypeKubernetesLabelKey] = label_value_consts.ImageRegistryKurtosisResourceTypeKubernetesLabelValue
+
+	annotations := map[*kubernetes_annotation_key.KubernetesAnnotationKey]*kubernetes_annotation_value.KubernetesAnnotationValue{}
+
+	objectAttributes, err := newKubernetesObjectAttributesImpl(name, labels, annotations)
+	if err != nil {
+		return nil, stacktrace.Propagate(err, "Failed to create image registry object attributes")
+	}
+
+	return objectAttributes, nil
+}
+
+func (provider *kubernetesEnclaveObjectAttributesProviderImpl) ForImagePullerDaemonSet(pullUuid string) (KubernetesObjectAttributes, error) {
+	name, err := getCompositeKubernetesObjectName([]string{
+		imagePullerFragment,
+		pullUuid,
+	})
+	if err != nil {
+		return nil, stacktrace.Propagate(err, "An error occurred creating the image puller daemon set name for pull '%v'", pullUuid)
+	}
+
+	labels, err := provider.getLabelsForEnclaveObjectWithIDAndGUID(imagePullerFragment, pullUuid)
+	if err != nil {
+		return nil, stacktrace.Propagate(err, "Failed to get labels for image puller daemon set of pull '%v'", pullUuid)
+	}
+	labels[kubernetes_label_key.KurtosisResourceTypeKubernetesLabelKey] = label_value_consts.ImagePullerKurtosisResourceTypeKubernetesLabelValue
+
+	annotations := map[*kubernetes_annotation_key.KubernetesAnnotationKey]*kubernetes_annotation_value.KubernetesAnnotationValue{}
+
+	objectAttributes, err := newKubernetesObjectAttributesImpl(name, labels, annotations)
+	if err != nil {
+		return nil, stacktrace.Propagate(err, "Failed to create image puller daemon set object attributes")
+	}
+
+	return objectAttributes, nil
+}
+
 // ====================================================================================================
 //
 //	Private Helper Functions
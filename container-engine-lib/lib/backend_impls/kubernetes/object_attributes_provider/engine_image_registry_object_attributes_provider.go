@@ -0,0 +1,65 @@
+package object_attributes_provider
+
+import (
+	"github.com/kurtosis-tech/kurtosis/container-engine-lib/lib/backend_impls/kubernetes/object_attributes_provider/kubernetes_annotation_key"
+	"github.com/kurtosis-tech/kurtosis/container-engine-lib/lib/backend_impls/kubernetes/object_attributes_provider/kubernetes_annotation_value"
+	"github.com/kurtosis-tech/kurtosis/container-engine-lib/lib/backend_impls/kubernetes/object_attributes_provider/kubernetes_label_key"
+	"github.com/kurtosis-tech/kurtosis/container-engine-lib/lib/backend_impls/kubernetes/object_attributes_provider/kubernetes_label_value"
+	"github.com/kurtosis-tech/kurtosis/container-engine-lib/lib/backend_impls/kubernetes/object_attributes_provider/label_value_consts"
+	"github.com/kurtosis-tech/stacktrace"
+)
+
+const (
+	engineImageRegistryNamePrefix = "kurtosis-image-registry"
+)
+
+type KubernetesEngineImageRegistryObjectAttributesProvider interface {
+	ForEngineImageRegistryNamespace() (KubernetesObjectAttributes, error)
+
+	ForEngineImageRegistryDeployment() (KubernetesObjectAttributes, error)
+
+	ForEngineImageRegistryService() (KubernetesObjectAttributes, error)
+}
+
+func GetKubernetesEngineImageRegistryObjectAttributesProvider() KubernetesEngineImageRegistryObjectAttributesProvider {
+	return newKubernetesEngineImageRegistryObjectAttributesProvider()
+}
+
+// The engine image registry outlives the engines, so unlike the reverse proxy its objects aren't labelled with an engine GUID
+type kubernetesEngineImageRegistryObjectAttributesProviderImpl struct{}
+
+func newKubernetesEngineImageRegistryObjectAttributesProvider() *kubernetesEngineImageRegistryObjectAttributesProviderImpl {
+	return &kubernetesEngineImageRegistryObjectAttributesProviderImpl{}
+}
+
+func (provider *kubernetesEngineImageRegistryObjectAttributesProviderImpl) ForEngineImageRegistryNamespace() (KubernetesObjectAttributes, error) {
+	return provider.getEngineImageRegistryObjectAttributes()
+}
+
+func (provider *kubernetesEngineImageRegistryObjectAttributesProviderImpl) ForEngineImageRegistryDeployment() (KubernetesObjectAttributes, error) {
+	return provider.getEngineImageRegistryObjectAttributes()
+}
+
+func (provider *kubernetesEngineImageRegistryObjectAttributesProviderImpl) ForEngineImageRegistryService() (KubernetesObjectAttributes, error) {
+	return provider.getEngineImageRegistryObjectAttributes()
+}
+
+func (provider *kubernetesEngineImageRegistryObjectAttributesProviderImpl) getEngineImageRegistryObjectAttributes() (KubernetesObjectAttributes, error) {
+	name, err := getCompositeKubernetesObjectName([]string{engineImageRegistryNamePrefix})
+	if err != nil {
+		return nil, stacktrace.Propagate(err, "An error occurred while creating a Kubernetes object name with prefix '%v'.", engineImageRegistryNamePrefix)
+	}
+
+	labels := map[*kubernetes_label_key.KubernetesLabelKey]*kubernetes_label_value.KubernetesLabelValue{
+		kubernetes_label_key.KurtosisResourceTypeKubernetesLabelKey: label_value_consts.EngineImageRegistryKurtosisResourceTypeKubernetesLabelValue,
+	}
+
+	annotations := map[*kubernetes_annotation_key.KubernetesAnnotationKey]*kubernetes_annotation_value.KubernetesAnnotationValue{}
+
+	objectAttributes, err := newKubernetesObjectAttributesImpl(name, labels, annotations)
+	if err != nil {
+		return nil, stacktrace.Propagate(err, "An error occurred while creating the Kubernetes object attributes with the name "+
+			"'%s' and labels '%+v', and annotations '%+v'", name.GetString(), labels, annotations)
+	}
+	return objectAttributes, nil
+}
@@ -0,0 +1,48 @@
+package object_attributes_provider
+
+import (
+	"github.com/kurtosis-tech/kurtosis/container-engine-lib/lib/backend_impls/kubernetes/object_attributes_provider/kubernetes_annotation_key"
+	"github.com/kurtosis-tech/kurtosis/container-engine-lib/lib/backend_impls/kubernetes/object_attributes_provider/kubernetes_annotation_value"
+	"github.com/kurtosis-tech/kurtosis/container-engine-lib/lib/backend_impls/kubernetes/object_attributes_provider/kubernetes_label_key"
+	"github.com/kurtosis-tech/kurtosis/container-engine-lib/lib/backend_impls/kubernetes/object_attributes_provider/kubernetes_label_value"
+	"github.com/kurtosis-tech/kurtosis/container-engine-lib/lib/backend_impls/kubernetes/object_attributes_provider/label_value_consts"
+	"github.com/kurtosis-tech/stacktrace"
+)
+
+const (
+	imagePrunerNamePrefix = "kurtosis-image-pruner"
+)
+
+type KubernetesImagePrunerObjectAttributesProvider interface {
+	ForImagePrunerNamespace() (KubernetesObjectAttributes, error)
+}
+
+func GetKubernetesImagePrunerObjectAttributesProvider() KubernetesImagePrunerObjectAttributesProvider {
+	return newKubernetesImagePrunerObjectAttributesProvider()
+}
+
+type kubernetesImagePrunerObjectAttributesProviderImpl struct{}
+
+func newKubernetesImagePrunerObjectAttributesProvider() *kubernetesImagePrunerObjectAttributesProviderImpl {
+	return &kubernetesImagePrunerObjectAttributesProviderImpl{}
+}
+
+func (provider *kubernetesImagePrunerObjectAttributesProviderImpl) ForImagePrunerNamespace() (KubernetesObjectAttributes, error) {
+	name, err := getCompositeKubernetesObjectName([]string{imagePrunerNamePrefix})
+	if err != nil {
+		return nil, stacktrace.Propagate(err, "An error occurred while creating a Kubernetes object name with prefix '%v'.", imagePrunerNamePrefix)
+	}
+
+	labels := map[*kubernetes_label_key.KubernetesLabelKey]*kubernetes_label_value.KubernetesLabelValue{
+		kubernetes_label_key.KurtosisResourceTypeKubernetesLabelKey: label_value_consts.ImagePrunerKurtosisResourceTypeKubernetesLabelValue,
+	}
+
+	annotations := make(map[*kubernetes_annotation_key.KubernetesAnnotationKey]*kubernetes_annotation_value.KubernetesAnnotationValue)
+
+	objectAttributes, err := newKubernetesObjectAttributesImpl(name, labels, annotations)
+	if err != nil {
+		return nil, stacktrace.Propagate(err, "An error occurred while creating the Kubernetes object attributes with the name "+
+			"'%s' and labels '%+v', and annotations '%+v'", name.GetString(), labels, annotations)
+	}
+	return objectAttributes, nil
+}
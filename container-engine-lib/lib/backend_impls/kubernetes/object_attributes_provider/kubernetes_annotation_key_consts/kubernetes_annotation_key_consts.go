@@ -16,6 +16,12 @@ const (
 
 	enclaveNameKeyStr = labelKeyPrefixStr + "enclave-name"
 
+	enclaveExpirationTimeKeyStr = labelKeyPrefixStr + "enclave-expiration-time"
+
+	enclaveIdleTimeoutKeyStr = labelKeyPrefixStr + "enclave-idle-timeout"
+
+	expiredEnclaveActionKeyStr = labelKeyPrefixStr + "expired-enclave-action"
+
 	// Traefik ingress router
 	traefikKeyIngressRouterPrefixStr = "traefik.ingress.kubernetes.io/router."
 	traefikKeyEntrypointsStr         = traefikKeyIngressRouterPrefixStr + "entrypoints"
@@ -27,4 +33,7 @@ const (
 var PortSpecsKubernetesAnnotationKey = kubernetes_annotation_key.MustCreateNewKubernetesAnnotationKey(portSpecsAnnotationKeyStr)
 var EnclaveCreationTimeAnnotationKey = kubernetes_annotation_key.MustCreateNewKubernetesAnnotationKey(enclaveCreationTimeKeyStr)
 var EnclaveNameAnnotationKey = kubernetes_annotation_key.MustCreateNewKubernetesAnnotationKey(enclaveNameKeyStr)
+var EnclaveExpirationTimeAnnotationKey = kubernetes_annotation_key.MustCreateNewKubernetesAnnotationKey(enclaveExpirationTimeKeyStr)
+var EnclaveIdleTimeoutAnnotationKey = kubernetes_annotation_key.MustCreateNewKubernetesAnnotationKey(enclaveIdleTimeoutKeyStr)
+var ExpiredEnclaveActionAnnotationKey = kubernetes_annotation_key.MustCreateNewKubernetesAnnotationKey(expiredEnclaveActionKeyStr)
 var TraefikIngressRouterEntrypointsAnnotationKey = kubernetes_annotation_key.MustCreateNewKubernetesAnnotationKey(traefikKeyEntrypointsStr)
@@ -8,17 +8,23 @@ import (
 )
 
 var labelKeyStrsToEnsure = map[string]string{
-	labelKeyPrefixStr:         "kurtosistech.com/",
-	portSpecsAnnotationKeyStr: "kurtosistech.com/ports",
-	enclaveCreationTimeKeyStr: "kurtosistech.com/enclave-creation-time",
-	enclaveNameKeyStr:         "kurtosistech.com/enclave-name",
-	traefikKeyEntrypointsStr:  "traefik.ingress.kubernetes.io/router.entrypoints",
+	labelKeyPrefixStr:           "kurtosistech.com/",
+	portSpecsAnnotationKeyStr:   "kurtosistech.com/ports",
+	enclaveCreationTimeKeyStr:   "kurtosistech.com/enclave-creation-time",
+	enclaveNameKeyStr:           "kurtosistech.com/enclave-name",
+	enclaveExpirationTimeKeyStr: "kurtosistech.com/enclave-expiration-time",
+	enclaveIdleTimeoutKeyStr:    "kurtosistech.com/enclave-idle-timeout",
+	expiredEnclaveActionKeyStr:  "kurtosistech.com/expired-enclave-action",
+	traefikKeyEntrypointsStr:    "traefik.ingress.kubernetes.io/router.entrypoints",
 }
 
 var labelKeysToEnsure = map[*kubernetes_annotation_key.KubernetesAnnotationKey]string{
 	PortSpecsKubernetesAnnotationKey:             "kurtosistech.com/ports",
 	EnclaveCreationTimeAnnotationKey:             "kurtosistech.com/enclave-creation-time",
 	EnclaveNameAnnotationKey:                     "kurtosistech.com/enclave-name",
+	EnclaveExpirationTimeAnnotationKey:           "kurtosistech.com/enclave-expiration-time",
+	EnclaveIdleTimeoutAnnotationKey:              "kurtosistech.com/enclave-idle-timeout",
+	ExpiredEnclaveActionAnnotationKey:            "kurtosistech.com/expired-enclave-action",
 	TraefikIngressRouterEntrypointsAnnotationKey: "traefik.ingress.kubernetes.io/router.entrypoints",
 }
 
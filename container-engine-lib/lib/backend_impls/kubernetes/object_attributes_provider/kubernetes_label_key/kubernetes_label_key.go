@@ -41,6 +41,14 @@ func ValidateUserCustomLabelKey(str string) error {
 	return nil
 }
 
+// GetUserCustomLabelKey returns the key the user gave to a custom user label, and false if the label isn't one
+func GetUserCustomLabelKey(labelKeyStr string) (string, bool) {
+	if !strings.HasPrefix(labelKeyStr, customUserLabelsKeyPrefixStr) {
+		return "", false
+	}
+	return strings.TrimPrefix(labelKeyStr, customUserLabelsKeyPrefixStr), true
+}
+
 func createNewKubernetesLabelKey(str string) (*KubernetesLabelKey, error) {
 	if err := validate(str); err != nil {
 		return nil, stacktrace.Propagate(err, "Label value string '%v' doesn't pass validation of being a Kubernetes label key", str)
@@ -17,9 +17,16 @@ const (
 	logsAggregatorResourceTypeLabelValueStr = "kurtosis-logs-aggregator"
 	// !!!!!!!!!!!!!!!!!!!!!!!!!!!!!!!! DO NOT CHANGE THESE VALUES !!!!!!!!!!!!!!!!!!!!!!!!!!!!!
 
-	enclaveKurtosisResourceTypeLabelValueStr      = "enclave"
-	apiContainerKurtosisResourceTypeLabelValueStr = "api-container"
-	userServiceKurtosisResourceTypeLabelValueStr  = "user-service"
+	enclaveKurtosisResourceTypeLabelValueStr       = "enclave"
+	apiContainerKurtosisResourceTypeLabelValueStr  = "api-container"
+	userServiceKurtosisResourceTypeLabelValueStr   = "user-service"
+	imageBuilderKurtosisResourceTypeLabelValueStr  = "image-builder"
+	imageRegistryKurtosisResourceTypeLabelValueStr = "image-registry"
+	imagePullerKurtosisResourceTypeLabelValueStr   = "image-puller"
+	imagePrunerKurtosisResourceTypeLabelValueStr   = "kurtosis-image-pruner"
+	reverseProxyKurtosisResourceTypeLabelValueStr  = "kurtosis-reverse-proxy"
+	// Unlike the image registries started in enclaves, the engine image registry is shared by all of them
+	engineImageRegistryKurtosisResourceTypeLabelValueStr = "kurtosis-image-registry"
 
 	enclaveDataVolumeTypeLabelValueStr             = "enclave-data"
 	filesArtifactsExpansionVolumeTypeLabelValueStr = "files-artifacts-expansion"
@@ -38,7 +45,13 @@ var EngineKurtosisResourceTypeKubernetesLabelValue = kubernetes_label_value.Must
 var EnclaveKurtosisResourceTypeKubernetesLabelValue = kubernetes_label_value.MustCreateNewKubernetesLabelValue(enclaveKurtosisResourceTypeLabelValueStr)
 var APIContainerKurtosisResourceTypeKubernetesLabelValue = kubernetes_label_value.MustCreateNewKubernetesLabelValue(apiContainerKurtosisResourceTypeLabelValueStr)
 var UserServiceKurtosisResourceTypeKubernetesLabelValue = kubernetes_label_value.MustCreateNewKubernetesLabelValue(userServiceKurtosisResourceTypeLabelValueStr)
+var ImageBuilderKurtosisResourceTypeKubernetesLabelValue = kubernetes_label_value.MustCreateNewKubernetesLabelValue(imageBuilderKurtosisResourceTypeLabelValueStr)
+var ImageRegistryKurtosisResourceTypeKubernetesLabelValue = kubernetes_label_value.MustCreateNewKubernetesLabelValue(imageRegistryKurtosisResourceTypeLabelValueStr)
+var ImagePullerKurtosisResourceTypeKubernetesLabelValue = kubernetes_label_value.MustCreateNewKubernetesLabelValue(imagePullerKurtosisResourceTypeLabelValueStr)
 var EnclaveDataVolumeTypeKubernetesLabelValue = kubernetes_label_value.MustCreateNewKubernetesLabelValue(enclaveDataVolumeTypeLabelValueStr)
 var FilesArtifactsExpansionVolumeTypeKubernetesLabelValue = kubernetes_label_value.MustCreateNewKubernetesLabelValue(filesArtifactsExpansionVolumeTypeLabelValueStr)
 var LogsCollectorKurtosisResourceTypeKubernetesLabelValue = kubernetes_label_value.MustCreateNewKubernetesLabelValue(logsCollectorResourceTypeLabelValueStr)
 var LogsAggregatorKurtosisResourceTypeKubernetesLabelValue = kubernetes_label_value.MustCreateNewKubernetesLabelValue(logsAggregatorResourceTypeLabelValueStr)
+var ImagePrunerKurtosisResourceTypeKubernetesLabelValue = kubernetes_label_value.MustCreateNewKubernetesLabelValue(imagePrunerKurtosisResourceTypeLabelValueStr)
+var ReverseProxyKurtosisResourceTypeKubernetesLabelValue = kubernetes_label_value.MustCreateNewKubernetesLabelValue(reverseProxyKurtosisResourceTypeLabelValueStr)
+var EngineImageRegistryKurtosisResourceTypeKubernetesLabelValue = kubernetes_label_value.MustCreateNewKubernetesLabelValue(engineImageRegistryKurtosisResourceTypeLabelValueStr)
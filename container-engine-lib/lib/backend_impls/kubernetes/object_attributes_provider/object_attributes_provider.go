@@ -15,6 +15,9 @@ type KubernetesObjectAttributesProvider interface {
 	ForEnclave(enclaveId enclave.EnclaveUUID) KubernetesEnclaveObjectAttributesProvider
 	ForLogsCollector(guid logs_collector.LogsCollectorGuid) KubernetesLogsCollectorObjectAttributesProvider
 	ForLogsAggregator(guid logs_aggregator.LogsAggregatorGuid) KubernetesLogsAggregatorObjectAttributesProvider
+	ForImagePruner() KubernetesImagePrunerObjectAttributesProvider
+	ForReverseProxy(engineGuid engine.EngineGUID) KubernetesReverseProxyObjectAttributesProvider
+	ForEngineImageRegistry() KubernetesEngineImageRegistryObjectAttributesProvider
 }
 
 func GetKubernetesObjectAttributesProvider() KubernetesObjectAttributesProvider {
@@ -44,6 +47,18 @@ func (provider *kubernetesObjectAttributesProviderImpl) ForLogsAggregator(logsAg
 	return GetKubernetesLogsAggregatorObjectAttributesProvider(logsAggregatorGuid)
 }
 
+func (provider *kubernetesObjectAttributesProviderImpl) ForImagePruner() KubernetesImagePrunerObjectAttributesProvider {
+	return GetKubernetesImagePrunerObjectAttributesProvider()
+}
+
+func (provider *kubernetesObjectAttributesProviderImpl) ForReverseProxy(engineGuid engine.EngineGUID) KubernetesReverseProxyObjectAttributesProvider {
+	return GetKubernetesReverseProxyObjectAttributesProvider(engineGuid)
+}
+
+func (provider *kubernetesObjectAttributesProviderImpl) ForEngineImageRegistry() KubernetesEngineImageRegistryObjectAttributesProvider {
+	return GetKubernetesEngineImageRegistryObjectAttributesProvider()
+}
+
 // Gets the name for an enclave object, making sure to put the enclave ID first and join using the standardized separator
 func getCompositeKubernetesObjectName(elems []string) (*kubernetes_object_name.KubernetesObjectName, error) {
 	nameStr := strings.Join(
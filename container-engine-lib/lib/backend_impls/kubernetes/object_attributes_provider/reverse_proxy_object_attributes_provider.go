@@ -0,0 +1,116 @@
+package object_attributes_provider
+
+import (
+	"github.com/kurtosis-tech/kurtosis/container-engine-lib/lib/backend_impls/kubernetes/object_attributes_provider/kubernetes_annotation_key"
+	"github.com/kurtosis-tech/kurtosis/container-engine-lib/lib/backend_impls/kubernetes/object_attributes_provider/kubernetes_annotation_value"
+	"github.com/kurtosis-tech/kurtosis/container-engine-lib/lib/backend_impls/kubernetes/object_attributes_provider/kubernetes_label_key"
+	"github.com/kurtosis-tech/kurtosis/container-engine-lib/lib/backend_impls/kubernetes/object_attributes_provider/kubernetes_label_value"
+	"github.com/kurtosis-tech/kurtosis/container-engine-lib/lib/backend_impls/kubernetes/object_attributes_provider/kubernetes_object_name"
+	"github.com/kurtosis-tech/kurtosis/container-engine-lib/lib/backend_impls/kubernetes/object_attributes_provider/label_value_consts"
+	"github.com/kurtosis-tech/kurtosis/container-engine-lib/lib/backend_interface/objects/engine"
+	"github.com/kurtosis-tech/stacktrace"
+)
+
+const (
+	reverseProxyNamePrefix = "kurtosis-reverse-proxy"
+)
+
+type KubernetesReverseProxyObjectAttributesProvider interface {
+	ForReverseProxyNamespace() (KubernetesObjectAttributes, error)
+
+	ForReverseProxyServiceAccount() (KubernetesObjectAttributes, error)
+
+	ForReverseProxyClusterRole() (KubernetesObjectAttributes, error)
+
+	ForReverseProxyClusterRoleBindings() (KubernetesObjectAttributes, error)
+
+	ForReverseProxyDeployment() (KubernetesObjectAttributes, error)
+
+	ForReverseProxyService() (KubernetesObjectAttributes, error)
+}
+
+func GetKubernetesReverseProxyObjectAttributesProvider(engineGuid engine.EngineGUID) KubernetesReverseProxyObjectAttributesProvider {
+	return newKubernetesReverseProxyObjectAttributesProvider(engineGuid)
+}
+
+// Private so it can't be instantiated
+type kubernetesReverseProxyObjectAttributesProviderImpl struct {
+	engineGuid engine.EngineGUID
+}
+
+func newKubernetesReverseProxyObjectAttributesProvider(engineGuid engine.EngineGUID) *kubernetesReverseProxyObjectAttributesProviderImpl {
+	return &kubernetesReverseProxyObjectAttributesProviderImpl{
+		engineGuid: engineGuid,
+	}
+}
+
+func (provider *kubernetesReverseProxyObjectAttributesProviderImpl) ForReverseProxyNamespace() (KubernetesObjectAttributes, error) {
+	return provider.getReverseProxyObjectAttributes()
+}
+
+func (provider *kubernetesReverseProxyObjectAttributesProviderImpl) ForReverseProxyServiceAccount() (KubernetesObjectAttributes, error) {
+	return provider.getReverseProxyObjectAttributes()
+}
+
+func (provider *kubernetesReverseProxyObjectAttributesProviderImpl) ForReverseProxyClusterRole() (KubernetesObjectAttributes, error) {
+	return provider.getReverseProxyObjectAttributes()
+}
+
+func (provider *kubernetesReverseProxyObjectAttributesProviderImpl) ForReverseProxyClusterRoleBindings() (KubernetesObjectAttributes, error) {
+	return provider.getReverseProxyObjectAttributes()
+}
+
+func (provider *kubernetesReverseProxyObjectAttributesProviderImpl) ForReverseProxyDeployment() (KubernetesObjectAttributes, error) {
+	return provider.getReverseProxyObjectAttributes()
+}
+
+func (provider *kubernetesReverseProxyObjectAttributesProviderImpl) ForReverseProxyService() (KubernetesObjectAttributes, error) {
+	return provider.getReverseProxyObjectAttributes()
+}
+
+// All the reverse proxy objects share the same name and labels; they live in their own namespace or are cluster-scoped
+// objects of different kinds, so the names never collide
+func (provider *kubernetesReverseProxyObjectAttributesProviderImpl) getReverseProxyObjectAttributes() (KubernetesObjectAttributes, error) {
+	name, err := provider.getReverseProxyObjectName()
+	if err != nil {
+		return nil, stacktrace.Propagate(err, "An error occurred creating a Kubernetes object name for the reverse proxy")
+	}
+
+	labels, err := provider.getReverseProxyObjectLabels()
+	if err != nil {
+		return nil, stacktrace.Propagate(err, "An error occurred getting Kubernetes labels for the reverse proxy")
+	}
+
+	annotations := map[*kubernetes_annotation_key.KubernetesAnnotationKey]*kubernetes_annotation_value.KubernetesAnnotationValue{}
+
+	objectAttributes, err := newKubernetesObjectAttributesImpl(name, labels, annotations)
+	if err != nil {
+		return nil, stacktrace.Propagate(err, "An error occurred while creating the Kubernetes object attributes with the name "+
+			"'%s' and labels '%+v', and annotations '%+v'", name.GetString(), labels, annotations)
+	}
+	return objectAttributes, nil
+}
+
+func (provider *kubernetesReverseProxyObjectAttributesProviderImpl) getReverseProxyObjectLabels() (map[*kubernetes_label_key.KubernetesLabelKey]*kubernetes_label_value.KubernetesLabelValue, error) {
+	guidLabelValue, err := kubernetes_label_value.CreateNewKubernetesLabelValue(string(provider.engineGuid))
+	if err != nil {
+		return nil, stacktrace.Propagate(err, "An error occurred parsing engine GUID '%v' into a Kubernetes label value", provider.engineGuid)
+	}
+
+	labels := map[*kubernetes_label_key.KubernetesLabelKey]*kubernetes_label_value.KubernetesLabelValue{
+		kubernetes_label_key.KurtosisResourceTypeKubernetesLabelKey: label_value_consts.ReverseProxyKurtosisResourceTypeKubernetesLabelValue,
+		kubernetes_label_key.GUIDKubernetesLabelKey:                 guidLabelValue,
+	}
+	return labels, nil
+}
+
+func (provider *kubernetesReverseProxyObjectAttributesProviderImpl) getReverseProxyObjectName() (*kubernetes_object_name.KubernetesObjectName, error) {
+	result, err := getCompositeKubernetesObjectName([]string{
+		reverseProxyNamePrefix,
+		string(provider.engineGuid),
+	})
+	if err != nil {
+		return nil, stacktrace.Propagate(err, "An error occurred getting a Kubernetes object name for the reverse proxy of engine GUID '%v'", provider.engineGuid)
+	}
+	return result, nil
+}
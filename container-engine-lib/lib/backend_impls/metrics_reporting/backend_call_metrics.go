@@ -0,0 +1,38 @@
+package metrics_reporting
+
+import (
+	"time"
+
+	"github.com/prometheus/client_golang/prometheus"
+	"github.com/prometheus/client_golang/prometheus/promauto"
+)
+
+const (
+	metricsNamespace       = "kurtosis"
+	backendMetricSubsystem = "backend"
+
+	backendMethodLabel = "method"
+)
+
+var (
+	// Image pulls and enclave teardowns can take minutes, hence the long tail
+	backendCallDurationBuckets = []float64{0.01, 0.05, 0.1, 0.5, 1, 5, 10, 30, 60, 300}
+
+	backendCallDurationSeconds = promauto.NewHistogramVec(
+		prometheus.HistogramOpts{
+			Namespace:   metricsNamespace,
+			Subsystem:   backendMetricSubsystem,
+			Name:        "call_duration_seconds",
+			Help:        "Latency of the calls made to the container engine backend (Docker, Kubernetes...), by backend method.",
+			ConstLabels: nil,
+			Buckets:     backendCallDurationBuckets,
+		},
+		[]string{backendMethodLabel},
+	)
+)
+
+// observeBackendCallDuration is meant to be deferred at the top of every backend method, with the start time
+// evaluated at the moment the call is deferred
+func observeBackendCallDuration(method string, startTime time.Time) {
+	backendCallDurationSeconds.WithLabelValues(method).Observe(time.Since(startTime).Seconds())
+}
@@ -16,10 +16,12 @@ import (
 	"github.com/kurtosis-tech/kurtosis/container-engine-lib/lib/backend_interface/objects/engine"
 	"github.com/kurtosis-tech/kurtosis/container-engine-lib/lib/backend_interface/objects/exec_result"
 	"github.com/kurtosis-tech/kurtosis/container-engine-lib/lib/backend_interface/objects/image_download_mode"
+	"github.com/kurtosis-tech/kurtosis/container-engine-lib/lib/backend_interface/objects/image_registry"
 	"github.com/kurtosis-tech/kurtosis/container-engine-lib/lib/backend_interface/objects/logs_aggregator"
 	"github.com/kurtosis-tech/kurtosis/container-engine-lib/lib/backend_interface/objects/logs_collector"
 	"github.com/kurtosis-tech/kurtosis/container-engine-lib/lib/backend_interface/objects/reverse_proxy"
 	"github.com/kurtosis-tech/kurtosis/container-engine-lib/lib/backend_interface/objects/service"
+	"github.com/kurtosis-tech/kurtosis/container-engine-lib/lib/backend_interface/objects/service_directory"
 	"github.com/kurtosis-tech/stacktrace"
 )
 
@@ -33,6 +35,7 @@ func NewMetricsReportingKurtosisBackend(underlying backend_interface.KurtosisBac
 }
 
 func (backend *MetricsReportingKurtosisBackend) FetchImage(ctx context.Context, image string, registrySpec *image_registry_spec.ImageRegistrySpec, downloadMode image_download_mode.ImageDownloadMode) (bool, string, error) {
+	defer observeBackendCallDuration("FetchImage", time.Now())
 	pulledFromRemote, architecture, err := backend.underlying.FetchImage(ctx, image, registrySpec, downloadMode)
 	if err != nil {
 		return false, "", stacktrace.Propagate(err, "An error occurred pulling image '%v'", image)
@@ -41,6 +44,7 @@ func (backend *MetricsReportingKurtosisBackend) FetchImage(ctx context.Context,
 }
 
 func (backend *MetricsReportingKurtosisBackend) PruneUnusedImages(ctx context.Context) ([]string, error) {
+	defer observeBackendCallDuration("PruneUnusedImages", time.Now())
 	prunedImages, err := backend.underlying.PruneUnusedImages(ctx)
 	if err != nil {
 		return prunedImages, stacktrace.Propagate(err, "An error occurred pruning unused images")
@@ -61,6 +65,7 @@ func (backend *MetricsReportingKurtosisBackend) CreateEngine(
 	logsCollectorFilters []logs_collector.Filter,
 	logsCollectorParsers []logs_collector.Parser,
 ) (*engine.Engine, error) {
+	defer observeBackendCallDuration("CreateEngine", time.Now())
 	result, err := backend.underlying.CreateEngine(
 		ctx,
 		imageOrgAndRepo,
@@ -82,6 +87,7 @@ func (backend *MetricsReportingKurtosisBackend) CreateEngine(
 
 // Gets point-in-time data about engines matching the given filters
 func (backend *MetricsReportingKurtosisBackend) GetEngines(ctx context.Context, filters *engine.EngineFilters) (map[engine.EngineGUID]*engine.Engine, error) {
+	defer observeBackendCallDuration("GetEngines", time.Now())
 	engines, err := backend.underlying.GetEngines(ctx, filters)
 	if err != nil {
 		return nil, stacktrace.Propagate(err, "An error occurred getting engines using filters: %+v", filters)
@@ -94,6 +100,7 @@ func (backend *MetricsReportingKurtosisBackend) StopEngines(ctx context.Context,
 	failedIds map[engine.EngineGUID]error,
 	resultErr error,
 ) {
+	defer observeBackendCallDuration("StopEngines", time.Now())
 	successes, failures, err := backend.underlying.StopEngines(ctx, filters)
 	if err != nil {
 		return nil, nil, stacktrace.Propagate(err, "An error occurred stopping engines using filters: %+v", filters)
@@ -106,6 +113,7 @@ func (backend *MetricsReportingKurtosisBackend) DestroyEngines(ctx context.Conte
 	failedIds map[engine.EngineGUID]error,
 	resultErr error,
 ) {
+	defer observeBackendCallDuration("DestroyEngines", time.Now())
 	successes, failures, err := backend.underlying.DestroyEngines(ctx, filters)
 	if err != nil {
 		return nil, nil, stacktrace.Propagate(err, "An error occurred destroying engines using filters: %+v", filters)
@@ -114,6 +122,7 @@ func (backend *MetricsReportingKurtosisBackend) DestroyEngines(ctx context.Conte
 }
 
 func (backend *MetricsReportingKurtosisBackend) GetEngineLogs(ctx context.Context, outputDirpath string) error {
+	defer observeBackendCallDuration("GetEngineLogs", time.Now())
 	if err := backend.underlying.GetEngineLogs(ctx, outputDirpath); err != nil {
 		return stacktrace.Propagate(err, "An error occurred while dumping engine logs to dir '%v'", outputDirpath)
 	}
@@ -121,6 +130,7 @@ func (backend *MetricsReportingKurtosisBackend) GetEngineLogs(ctx context.Contex
 }
 
 func (backend *MetricsReportingKurtosisBackend) DumpKurtosis(ctx context.Context, outputDirpath string) error {
+	defer observeBackendCallDuration("DumpKurtosis", time.Now())
 	if err := backend.underlying.DumpKurtosis(ctx, outputDirpath); err != nil {
 		return stacktrace.Propagate(err, "An error occurred while dumping the state of Kurtosis to dir '%v'", outputDirpath)
 	}
@@ -128,6 +138,7 @@ func (backend *MetricsReportingKurtosisBackend) DumpKurtosis(ctx context.Context
 }
 
 func (backend *MetricsReportingKurtosisBackend) CreateEnclave(ctx context.Context, enclaveUuid enclave.EnclaveUUID, enclaveName string) (*enclave.Enclave, error) {
+	defer observeBackendCallDuration("CreateEnclave", time.Now())
 	result, err := backend.underlying.CreateEnclave(ctx, enclaveUuid, enclaveName)
 	if err != nil {
 		return nil, stacktrace.Propagate(err, "An error occurred creating enclave with UUID '%v'", enclaveUuid)
@@ -142,6 +153,7 @@ func (backend *MetricsReportingKurtosisBackend) GetEnclaves(
 	map[enclave.EnclaveUUID]*enclave.Enclave,
 	error,
 ) {
+	defer observeBackendCallDuration("GetEnclaves", time.Now())
 	results, err := backend.underlying.GetEnclaves(ctx, filters)
 	if err != nil {
 		return nil, stacktrace.Propagate(err, "An error occurred getting enclaves using filters: %+v", filters)
@@ -155,6 +167,7 @@ func (backend *MetricsReportingKurtosisBackend) UpdateEnclave(
 	newName string,
 	newCreationTime *time.Time,
 ) error {
+	defer observeBackendCallDuration("UpdateEnclave", time.Now())
 
 	if err := backend.underlying.UpdateEnclave(ctx, enclaveUuid, newName, newCreationTime); err != nil {
 		return stacktrace.Propagate(err, "An error occurred updating enclave with UUID '%v', updating name to '%s' and creation time to '%v'", enclaveUuid, newName, newCreationTime)
@@ -163,6 +176,79 @@ func (backend *MetricsReportingKurtosisBackend) UpdateEnclave(
 	return nil
 }
 
+func (backend *MetricsReportingKurtosisBackend) UpdateEnclaveExpiration(
+	ctx context.Context,
+	enclaveUuid enclave.EnclaveUUID,
+	expiration *enclave.EnclaveExpiration,
+) error {
+	defer observeBackendCallDuration("UpdateEnclaveExpiration", time.Now())
+
+	if err := backend.underlying.UpdateEnclaveExpiration(ctx, enclaveUuid, expiration); err != nil {
+		return stacktrace.Propagate(err, "An error occurred updating the expiration of enclave with UUID '%v' to '%+v'", enclaveUuid, expiration)
+	}
+
+	return nil
+}
+
+func (backend *MetricsReportingKurtosisBackend) UpdateEnclaveLabels(
+	ctx context.Context,
+	enclaveUuid enclave.EnclaveUUID,
+	labels map[string]string,
+) error {
+	defer observeBackendCallDuration("UpdateEnclaveLabels", time.Now())
+
+	if err := backend.underlying.UpdateEnclaveLabels(ctx, enclaveUuid, labels); err != nil {
+		return stacktrace.Propagate(err, "An error occurred updating the labels of enclave with UUID '%v' to '%+v'", enclaveUuid, labels)
+	}
+
+	return nil
+}
+
+func (backend *MetricsReportingKurtosisBackend) CopyEnclavePersistentDirectories(
+	ctx context.Context,
+	sourceEnclaveUuid enclave.EnclaveUUID,
+	destinationEnclaveUuid enclave.EnclaveUUID,
+) ([]service_directory.DirectoryPersistentKey, error) {
+	defer observeBackendCallDuration("CopyEnclavePersistentDirectories", time.Now())
+
+	copiedPersistentKeys, err := backend.underlying.CopyEnclavePersistentDirectories(ctx, sourceEnclaveUuid, destinationEnclaveUuid)
+	if err != nil {
+		return nil, stacktrace.Propagate(err, "An error occurred copying the persistent directories of enclave with UUID '%v' to enclave with UUID '%v'", sourceEnclaveUuid, destinationEnclaveUuid)
+	}
+
+	return copiedPersistentKeys, nil
+}
+
+func (backend *MetricsReportingKurtosisBackend) ExportEnclavePersistentDirectory(
+	ctx context.Context,
+	enclaveUuid enclave.EnclaveUUID,
+	persistentKey service_directory.DirectoryPersistentKey,
+	destination io.Writer,
+) error {
+	defer observeBackendCallDuration("ExportEnclavePersistentDirectory", time.Now())
+
+	if err := backend.underlying.ExportEnclavePersistentDirectory(ctx, enclaveUuid, persistentKey, destination); err != nil {
+		return stacktrace.Propagate(err, "An error occurred exporting persistent directory '%v' of enclave with UUID '%v'", persistentKey, enclaveUuid)
+	}
+
+	return nil
+}
+
+func (backend *MetricsReportingKurtosisBackend) ImportEnclavePersistentDirectory(
+	ctx context.Context,
+	enclaveUuid enclave.EnclaveUUID,
+	persistentKey service_directory.DirectoryPersistentKey,
+	content io.Reader,
+) error {
+	defer observeBackendCallDuration("ImportEnclavePersistentDirectory", time.Now())
+
+	if err := backend.underlying.ImportEnclavePersistentDirectory(ctx, enclaveUuid, persistentKey, content); err != nil {
+		return stacktrace.Propagate(err, "An error occurred importing persistent directory '%v' into enclave with UUID '%v'", persistentKey, enclaveUuid)
+	}
+
+	return nil
+}
+
 func (backend *MetricsReportingKurtosisBackend) StopEnclaves(
 	ctx context.Context,
 	filters *enclave.EnclaveFilters,
@@ -171,6 +257,7 @@ func (backend *MetricsReportingKurtosisBackend) StopEnclaves(
 	erroredEnclaveIds map[enclave.EnclaveUUID]error,
 	resultErr error,
 ) {
+	defer observeBackendCallDuration("StopEnclaves", time.Now())
 	successes, failures, err := backend.underlying.StopEnclaves(ctx, filters)
 	if err != nil {
 		return nil, nil, stacktrace.Propagate(err, "An error occurred stopping enclaves using filters: %+v", filters)
@@ -183,6 +270,7 @@ func (backend *MetricsReportingKurtosisBackend) DumpEnclave(
 	enclaveUuid enclave.EnclaveUUID,
 	outputDirpath string,
 ) error {
+	defer observeBackendCallDuration("DumpEnclave", time.Now())
 	if err := backend.underlying.DumpEnclave(ctx, enclaveUuid, outputDirpath); err != nil {
 		return stacktrace.Propagate(err, "An error occurred dumping enclave '%v' to path '%v'", enclaveUuid, outputDirpath)
 	}
@@ -197,6 +285,7 @@ func (backend *MetricsReportingKurtosisBackend) DestroyEnclaves(
 	erroredEnclaveIds map[enclave.EnclaveUUID]error,
 	resultErr error,
 ) {
+	defer observeBackendCallDuration("DestroyEnclaves", time.Now())
 	successes, failures, err := backend.underlying.DestroyEnclaves(ctx, filters)
 	if err != nil {
 		return nil, nil, stacktrace.Propagate(err, "An error occurred destroying enclaves using filters: %+v", filters)
@@ -214,6 +303,7 @@ func (backend *MetricsReportingKurtosisBackend) CreateAPIContainer(
 	customEnvVars map[string]string,
 	shouldStartInDebugMode bool,
 ) (*api_container.APIContainer, error) {
+	defer observeBackendCallDuration("CreateAPIContainer", time.Now())
 	if _, found := customEnvVars[ownIpEnvVar]; found {
 		return nil, stacktrace.NewError("Requested own IP environment variable '%v' conflicts with custom environment variable", ownIpEnvVar)
 	}
@@ -240,6 +330,7 @@ func (backend *MetricsReportingKurtosisBackend) CreateAPIContainer(
 }
 
 func (backend *MetricsReportingKurtosisBackend) GetAPIContainers(ctx context.Context, filters *api_container.APIContainerFilters) (map[enclave.EnclaveUUID]*api_container.APIContainer, error) {
+	defer observeBackendCallDuration("GetAPIContainers", time.Now())
 	results, err := backend.underlying.GetAPIContainers(ctx, filters)
 	if err != nil {
 		return nil, stacktrace.Propagate(err, "An error occurred getting API containers matching filters: %+v", filters)
@@ -248,6 +339,7 @@ func (backend *MetricsReportingKurtosisBackend) GetAPIContainers(ctx context.Con
 }
 
 func (backend *MetricsReportingKurtosisBackend) StopAPIContainers(ctx context.Context, filters *api_container.APIContainerFilters) (successfulApiContainerIds map[enclave.EnclaveUUID]bool, erroredApiContainerIds map[enclave.EnclaveUUID]error, resultErr error) {
+	defer observeBackendCallDuration("StopAPIContainers", time.Now())
 	successes, failures, err := backend.underlying.StopAPIContainers(ctx, filters)
 	if err != nil {
 		return nil, nil, stacktrace.Propagate(err, "An error occurred stopping API containers using filters: %+v", filters)
@@ -256,6 +348,7 @@ func (backend *MetricsReportingKurtosisBackend) StopAPIContainers(ctx context.Co
 }
 
 func (backend *MetricsReportingKurtosisBackend) DestroyAPIContainers(ctx context.Context, filters *api_container.APIContainerFilters) (successfulApiContainerIds map[enclave.EnclaveUUID]bool, erroredApiContainerIds map[enclave.EnclaveUUID]error, resultErr error) {
+	defer observeBackendCallDuration("DestroyAPIContainers", time.Now())
 	successes, failures, err := backend.underlying.DestroyAPIContainers(ctx, filters)
 	if err != nil {
 		return nil, nil, stacktrace.Propagate(err, "An error occurred destroying API containers using filters: %+v", filters)
@@ -264,6 +357,7 @@ func (backend *MetricsReportingKurtosisBackend) DestroyAPIContainers(ctx context
 }
 
 func (backend *MetricsReportingKurtosisBackend) RegisterUserServices(ctx context.Context, enclaveUuid enclave.EnclaveUUID, services map[service.ServiceName]bool) (map[service.ServiceName]*service.ServiceRegistration, map[service.ServiceName]error, error) {
+	defer observeBackendCallDuration("RegisterUserServices", time.Now())
 	successes, failures, err := backend.underlying.RegisterUserServices(ctx, enclaveUuid, services)
 	if err != nil {
 		return nil, nil, stacktrace.Propagate(err, "An error occurred registering services to enclave '%v' with the following service ids: %+v", enclaveUuid, services)
@@ -272,6 +366,7 @@ func (backend *MetricsReportingKurtosisBackend) RegisterUserServices(ctx context
 }
 
 func (backend *MetricsReportingKurtosisBackend) UnregisterUserServices(ctx context.Context, enclaveUuid enclave.EnclaveUUID, services map[service.ServiceUUID]bool) (map[service.ServiceUUID]bool, map[service.ServiceUUID]error, error) {
+	defer observeBackendCallDuration("UnregisterUserServices", time.Now())
 	successes, failures, err := backend.underlying.UnregisterUserServices(ctx, enclaveUuid, services)
 	if err != nil {
 		return nil, nil, stacktrace.Propagate(err, "An error occurred unregistering services from enclave '%v' with the following service uuids: %+v", enclaveUuid, services)
@@ -280,6 +375,7 @@ func (backend *MetricsReportingKurtosisBackend) UnregisterUserServices(ctx conte
 }
 
 func (backend *MetricsReportingKurtosisBackend) StartRegisteredUserServices(ctx context.Context, enclaveUuid enclave.EnclaveUUID, services map[service.ServiceUUID]*service.ServiceConfig) (map[service.ServiceUUID]*service.Service, map[service.ServiceUUID]error, error) {
+	defer observeBackendCallDuration("StartRegisteredUserServices", time.Now())
 	successes, failures, err := backend.underlying.StartRegisteredUserServices(ctx, enclaveUuid, services)
 	if err != nil {
 		return nil, nil, stacktrace.Propagate(err, "An error occurred starting services in enclave '%v' with the following service ids: %+v", enclaveUuid, services)
@@ -288,6 +384,7 @@ func (backend *MetricsReportingKurtosisBackend) StartRegisteredUserServices(ctx
 }
 
 func (backend *MetricsReportingKurtosisBackend) RemoveRegisteredUserServiceProcesses(ctx context.Context, enclaveUuid enclave.EnclaveUUID, services map[service.ServiceUUID]bool) (map[service.ServiceUUID]bool, map[service.ServiceUUID]error, error) {
+	defer observeBackendCallDuration("RemoveRegisteredUserServiceProcesses", time.Now())
 	successes, failures, err := backend.underlying.RemoveRegisteredUserServiceProcesses(ctx, enclaveUuid, services)
 	if err != nil {
 		return nil, nil, stacktrace.Propagate(err, "An error occurred removing service processes in enclave '%v' with the following service ids: %+v", enclaveUuid, services)
@@ -295,6 +392,15 @@ func (backend *MetricsReportingKurtosisBackend) RemoveRegisteredUserServiceProce
 	return successes, failures, nil
 }
 
+func (backend *MetricsReportingKurtosisBackend) RenameUserService(ctx context.Context, enclaveUuid enclave.EnclaveUUID, serviceUuid service.ServiceUUID, newServiceName service.ServiceName) (*service.ServiceRegistration, error) {
+	defer observeBackendCallDuration("RenameUserService", time.Now())
+	renamedServiceRegistration, err := backend.underlying.RenameUserService(ctx, enclaveUuid, serviceUuid, newServiceName)
+	if err != nil {
+		return nil, stacktrace.Propagate(err, "An error occurred renaming service '%v' in enclave '%v' to '%v'", serviceUuid, enclaveUuid, newServiceName)
+	}
+	return renamedServiceRegistration, nil
+}
+
 func (backend *MetricsReportingKurtosisBackend) GetUserServices(
 	ctx context.Context,
 	enclaveUuid enclave.EnclaveUUID,
@@ -303,6 +409,7 @@ func (backend *MetricsReportingKurtosisBackend) GetUserServices(
 	map[service.ServiceUUID]*service.Service,
 	error,
 ) {
+	defer observeBackendCallDuration("GetUserServices", time.Now())
 	services, err := backend.underlying.GetUserServices(ctx, enclaveUuid, filters)
 	if err != nil {
 		return nil, stacktrace.Propagate(err, "An error occurred getting user services in enclave '%v' using filters '%+v'", enclaveUuid, filters)
@@ -320,6 +427,7 @@ func (backend *MetricsReportingKurtosisBackend) GetUserServiceLogs(
 	map[service.ServiceUUID]error,
 	error,
 ) {
+	defer observeBackendCallDuration("GetUserServiceLogs", time.Now())
 	userServiceLogs, erroredUserServices, err := backend.underlying.GetUserServiceLogs(ctx, enclaveUuid, filters, shouldFollowLogs)
 	if err != nil {
 		return nil, nil, stacktrace.Propagate(err, "An error occurred getting user service logs in enclave '%v' using filters '%+v'", enclaveUuid, filters)
@@ -327,6 +435,23 @@ func (backend *MetricsReportingKurtosisBackend) GetUserServiceLogs(
 	return userServiceLogs, erroredUserServices, nil
 }
 
+func (backend *MetricsReportingKurtosisBackend) GetUserServiceStats(
+	ctx context.Context,
+	enclaveUuid enclave.EnclaveUUID,
+	filters *service.ServiceFilters,
+) (
+	map[service.ServiceUUID]*service.ServiceStats,
+	map[service.ServiceUUID]error,
+	error,
+) {
+	defer observeBackendCallDuration("GetUserServiceStats", time.Now())
+	userServiceStats, erroredUserServices, err := backend.underlying.GetUserServiceStats(ctx, enclaveUuid, filters)
+	if err != nil {
+		return nil, nil, stacktrace.Propagate(err, "An error occurred getting user service stats in enclave '%v' using filters '%+v'", enclaveUuid, filters)
+	}
+	return userServiceStats, erroredUserServices, nil
+}
+
 func (backend *MetricsReportingKurtosisBackend) RunUserServiceExecCommands(
 	ctx context.Context,
 	enclaveUuid enclave.EnclaveUUID,
@@ -337,6 +462,7 @@ func (backend *MetricsReportingKurtosisBackend) RunUserServiceExecCommands(
 	erroredUserServiceUuids map[service.ServiceUUID]error,
 	resultErr error,
 ) {
+	defer observeBackendCallDuration("RunUserServiceExecCommands", time.Now())
 	successfulUserServiceExecResults, erroredUserServiceUuids, err := backend.underlying.RunUserServiceExecCommands(ctx, enclaveUuid, containerUser, userServiceCommands)
 	if err != nil {
 		return nil, nil, stacktrace.Propagate(
@@ -355,11 +481,13 @@ func (backend *MetricsReportingKurtosisBackend) RunUserServiceExecCommandWithStr
 	serviceUuid service.ServiceUUID,
 	cmd []string,
 ) (chan string, chan *exec_result.ExecResult, error) {
+	defer observeBackendCallDuration("RunUserServiceExecCommandWithStreamedOutput", time.Now())
 	return backend.underlying.RunUserServiceExecCommandWithStreamedOutput(ctx, enclaveUuid, serviceUuid, cmd)
 }
 
-func (backend *MetricsReportingKurtosisBackend) GetShellOnUserService(ctx context.Context, enclaveUuid enclave.EnclaveUUID, serviceUuid service.ServiceUUID) (resultErr error) {
-	err := backend.underlying.GetShellOnUserService(ctx, enclaveUuid, serviceUuid)
+func (backend *MetricsReportingKurtosisBackend) GetShellOnUserService(ctx context.Context, enclaveUuid enclave.EnclaveUUID, serviceUuid service.ServiceUUID, containerUser string) (resultErr error) {
+	defer observeBackendCallDuration("GetShellOnUserService", time.Now())
+	err := backend.underlying.GetShellOnUserService(ctx, enclaveUuid, serviceUuid, containerUser)
 	if err != nil {
 		return stacktrace.Propagate(err, "An error occurred getting connection with user service with UUID '%v'", serviceUuid)
 	}
@@ -373,6 +501,7 @@ func (backend *MetricsReportingKurtosisBackend) CopyFilesFromUserService(
 	srcPath string,
 	output io.Writer,
 ) error {
+	defer observeBackendCallDuration("CopyFilesFromUserService", time.Now())
 	if err := backend.underlying.CopyFilesFromUserService(ctx, enclaveUuid, serviceUuid, srcPath, output); err != nil {
 		return stacktrace.Propagate(
 			err,
@@ -394,6 +523,7 @@ func (backend *MetricsReportingKurtosisBackend) StopUserServices(
 	erroredUserServiceUuids map[service.ServiceUUID]error,
 	resultErr error,
 ) {
+	defer observeBackendCallDuration("StopUserServices", time.Now())
 	successes, failures, err := backend.underlying.StopUserServices(ctx, enclaveUuid, filters)
 	if err != nil {
 		return nil, nil, stacktrace.Propagate(err, "An error occurred stopping user services in enclave '%v' using filters: %+v", enclaveUuid, filters)
@@ -410,6 +540,7 @@ func (backend *MetricsReportingKurtosisBackend) DestroyUserServices(
 	erroredUserServiceUuids map[service.ServiceUUID]error,
 	resultErr error,
 ) {
+	defer observeBackendCallDuration("DestroyUserServices", time.Now())
 	successes, failures, err := backend.underlying.DestroyUserServices(ctx, enclaveUuid, filters)
 	if err != nil {
 		return nil, nil, stacktrace.Propagate(err, "An error occurred destroying user services using filters: %+v", filters)
@@ -418,14 +549,17 @@ func (backend *MetricsReportingKurtosisBackend) DestroyUserServices(
 }
 
 func (backend *MetricsReportingKurtosisBackend) CreateLogsAggregator(ctx context.Context, httpPortNum uint16, sinks logs_aggregator.Sinks) (*logs_aggregator.LogsAggregator, error) {
+	defer observeBackendCallDuration("CreateLogsAggregator", time.Now())
 	return backend.underlying.CreateLogsAggregator(ctx, httpPortNum, sinks)
 }
 
 func (backend *MetricsReportingKurtosisBackend) GetLogsAggregator(ctx context.Context) (*logs_aggregator.LogsAggregator, error) {
+	defer observeBackendCallDuration("GetLogsAggregator", time.Now())
 	return backend.underlying.GetLogsAggregator(ctx)
 }
 
 func (backend *MetricsReportingKurtosisBackend) DestroyLogsAggregator(ctx context.Context) error {
+	defer observeBackendCallDuration("DestroyLogsAggregator", time.Now())
 	return backend.underlying.DestroyLogsAggregator(ctx)
 }
 
@@ -440,6 +574,7 @@ func (backend *MetricsReportingKurtosisBackend) CreateLogsCollectorForEnclave(
 	*logs_collector.LogsCollector,
 	error,
 ) {
+	defer observeBackendCallDuration("CreateLogsCollectorForEnclave", time.Now())
 	logsCollector, err := backend.underlying.CreateLogsCollectorForEnclave(ctx, enclaveUuid, logsCollectorHttpPortNumber, logsCollectorTcpPortNumber, logsCollectorFilters, logsCollectorParsers)
 	if err != nil {
 		return nil, stacktrace.Propagate(err, "An error occurred creating the logs collector with TCP port number '%v' and HTTP port number '%v'", logsCollectorTcpPortNumber, logsCollectorHttpPortNumber)
@@ -450,6 +585,7 @@ func (backend *MetricsReportingKurtosisBackend) CreateLogsCollectorForEnclave(
 
 // if nothing is found returns nil
 func (backend *MetricsReportingKurtosisBackend) GetLogsCollectorForEnclave(ctx context.Context, enclaveUuid enclave.EnclaveUUID) (resultMaybeLogsCollector *logs_collector.LogsCollector, resultErr error) {
+	defer observeBackendCallDuration("GetLogsCollectorForEnclave", time.Now())
 	maybeLogsCollector, err := backend.underlying.GetLogsCollectorForEnclave(ctx, enclaveUuid)
 	if err != nil {
 		return nil, stacktrace.Propagate(err, "An error occurred getting the logs collector")
@@ -459,6 +595,7 @@ func (backend *MetricsReportingKurtosisBackend) GetLogsCollectorForEnclave(ctx c
 }
 
 func (backend *MetricsReportingKurtosisBackend) DestroyLogsCollectorForEnclave(ctx context.Context, enclaveUuid enclave.EnclaveUUID) error {
+	defer observeBackendCallDuration("DestroyLogsCollectorForEnclave", time.Now())
 
 	if err := backend.underlying.DestroyLogsCollectorForEnclave(ctx, enclaveUuid); err != nil {
 		return stacktrace.Propagate(err, "An error occurred destroying the logs collector")
@@ -468,18 +605,47 @@ func (backend *MetricsReportingKurtosisBackend) DestroyLogsCollectorForEnclave(c
 }
 
 func (backend *MetricsReportingKurtosisBackend) CreateReverseProxy(ctx context.Context, engineGuid engine.EngineGUID) (*reverse_proxy.ReverseProxy, error) {
+	defer observeBackendCallDuration("CreateReverseProxy", time.Now())
 	return backend.underlying.CreateReverseProxy(ctx, engineGuid)
 }
 
 func (backend *MetricsReportingKurtosisBackend) GetReverseProxy(ctx context.Context) (*reverse_proxy.ReverseProxy, error) {
+	defer observeBackendCallDuration("GetReverseProxy", time.Now())
 	return backend.underlying.GetReverseProxy(ctx)
 }
 
 func (backend *MetricsReportingKurtosisBackend) DestroyReverseProxy(ctx context.Context) error {
+	defer observeBackendCallDuration("DestroyReverseProxy", time.Now())
 	return backend.underlying.DestroyReverseProxy(ctx)
 }
 
+func (backend *MetricsReportingKurtosisBackend) CreateImageRegistry(ctx context.Context) (*image_registry.ImageRegistry, error) {
+	defer observeBackendCallDuration("CreateImageRegistry", time.Now())
+	return backend.underlying.CreateImageRegistry(ctx)
+}
+
+func (backend *MetricsReportingKurtosisBackend) GetImageRegistry(ctx context.Context) (*image_registry.ImageRegistry, error) {
+	defer observeBackendCallDuration("GetImageRegistry", time.Now())
+	return backend.underlying.GetImageRegistry(ctx)
+}
+
+func (backend *MetricsReportingKurtosisBackend) DestroyImageRegistry(ctx context.Context) error {
+	defer observeBackendCallDuration("DestroyImageRegistry", time.Now())
+	return backend.underlying.DestroyImageRegistry(ctx)
+}
+
+func (backend *MetricsReportingKurtosisBackend) ListImageRegistryRepositories(ctx context.Context) ([]*image_registry.ImageRegistryRepository, error) {
+	defer observeBackendCallDuration("ListImageRegistryRepositories", time.Now())
+	return backend.underlying.ListImageRegistryRepositories(ctx)
+}
+
+func (backend *MetricsReportingKurtosisBackend) PruneImageRegistry(ctx context.Context) ([]string, error) {
+	defer observeBackendCallDuration("PruneImageRegistry", time.Now())
+	return backend.underlying.PruneImageRegistry(ctx)
+}
+
 func (backend *MetricsReportingKurtosisBackend) GetAvailableCPUAndMemory(ctx context.Context) (compute_resources.MemoryInMegaBytes, compute_resources.CpuMilliCores, bool, error) {
+	defer observeBackendCallDuration("GetAvailableCPUAndMemory", time.Now())
 	availableMemory, availableCpu, isResourceInformationComplete, err := backend.underlying.GetAvailableCPUAndMemory(ctx)
 	if err != nil {
 		return 0, 0, false, stacktrace.Propagate(err, "An error occurred while fetching cpu & memory information from the underlying backend")
@@ -487,10 +653,21 @@ func (backend *MetricsReportingKurtosisBackend) GetAvailableCPUAndMemory(ctx con
 	return availableMemory, availableCpu, isResourceInformationComplete, nil
 }
 
+func (backend *MetricsReportingKurtosisBackend) GetAvailableCPUAndMemoryPerNode(ctx context.Context) ([]*compute_resources.NodeResources, error) {
+	defer observeBackendCallDuration("GetAvailableCPUAndMemoryPerNode", time.Now())
+	nodesResources, err := backend.underlying.GetAvailableCPUAndMemoryPerNode(ctx)
+	if err != nil {
+		return nil, stacktrace.Propagate(err, "An error occurred while fetching cpu & memory information per node from the underlying backend")
+	}
+	return nodesResources, nil
+}
+
 func (backend *MetricsReportingKurtosisBackend) BuildImage(ctx context.Context, imageName string, imageBuildSpec *image_build_spec.ImageBuildSpec) (string, error) {
+	defer observeBackendCallDuration("BuildImage", time.Now())
 	return backend.underlying.BuildImage(ctx, imageName, imageBuildSpec)
 }
 
 func (backend *MetricsReportingKurtosisBackend) NixBuild(ctx context.Context, nixBuildSpec *nix_build_spec.NixBuildSpec) (string, error) {
+	defer observeBackendCallDuration("NixBuild", time.Now())
 	return backend.underlying.NixBuild(ctx, nixBuildSpec)
 }
@@ -0,0 +1,41 @@
+package metrics_reporting
+
+import (
+	"context"
+	"errors"
+	"testing"
+
+	"github.com/kurtosis-tech/kurtosis/container-engine-lib/lib/backend_interface"
+	"github.com/prometheus/client_golang/prometheus"
+	dto "github.com/prometheus/client_model/go"
+	"github.com/stretchr/testify/require"
+)
+
+const (
+	pruneUnusedImagesMethodName = "PruneUnusedImages"
+)
+
+func TestMetricsReportingKurtosisBackend_ObservesCallDurationOnSuccessAndFailure(t *testing.T) {
+	ctx := context.Background()
+	underlying := backend_interface.NewMockKurtosisBackend(t)
+	underlying.EXPECT().PruneUnusedImages(ctx).Return([]string{"image"}, nil).Once()
+	underlying.EXPECT().PruneUnusedImages(ctx).Return(nil, errors.New("prune failed")).Once()
+
+	backend := NewMetricsReportingKurtosisBackend(underlying)
+	numObservationsBefore := getNumObservedCalls(t, pruneUnusedImagesMethodName)
+
+	_, err := backend.PruneUnusedImages(ctx)
+	require.NoError(t, err)
+	_, err = backend.PruneUnusedImages(ctx)
+	require.Error(t, err)
+
+	require.Equal(t, numObservationsBefore+2, getNumObservedCalls(t, pruneUnusedImagesMethodName))
+}
+
+func getNumObservedCalls(t *testing.T, method string) uint64 {
+	histogram, ok := backendCallDurationSeconds.WithLabelValues(method).(prometheus.Metric)
+	require.True(t, ok)
+	metric := &dto.Metric{}
+	require.NoError(t, histogram.Write(metric))
+	return metric.GetHistogram().GetSampleCount()
+}
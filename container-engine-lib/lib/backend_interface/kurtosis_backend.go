@@ -15,10 +15,12 @@ import (
 	"github.com/kurtosis-tech/kurtosis/container-engine-lib/lib/backend_interface/objects/engine"
 	"github.com/kurtosis-tech/kurtosis/container-engine-lib/lib/backend_interface/objects/exec_result"
 	"github.com/kurtosis-tech/kurtosis/container-engine-lib/lib/backend_interface/objects/image_download_mode"
+	"github.com/kurtosis-tech/kurtosis/container-engine-lib/lib/backend_interface/objects/image_registry"
 	"github.com/kurtosis-tech/kurtosis/container-engine-lib/lib/backend_interface/objects/logs_aggregator"
 	"github.com/kurtosis-tech/kurtosis/container-engine-lib/lib/backend_interface/objects/logs_collector"
 	"github.com/kurtosis-tech/kurtosis/container-engine-lib/lib/backend_interface/objects/reverse_proxy"
 	"github.com/kurtosis-tech/kurtosis/container-engine-lib/lib/backend_interface/objects/service"
+	"github.com/kurtosis-tech/kurtosis/container-engine-lib/lib/backend_interface/objects/service_directory"
 )
 
 // TODO This mega-backend should really have its individual functionalities split up into
@@ -102,6 +104,46 @@ type KurtosisBackend interface {
 		creationTime *time.Time,
 	) error
 
+	// Sets when the enclave expires and what happens to it then, replacing any previous expiration
+	// A nil expiration means the enclave never expires
+	UpdateEnclaveExpiration(
+		ctx context.Context,
+		enclaveUuid enclave.EnclaveUUID,
+		expiration *enclave.EnclaveExpiration,
+	) error
+
+	// Sets the key/value labels of the enclave, replacing all its previous labels
+	UpdateEnclaveLabels(
+		ctx context.Context,
+		enclaveUuid enclave.EnclaveUUID,
+		labels map[string]string,
+	) error
+
+	// Copies the content of the persistent directories of the source enclave to the persistent directories with the same
+	// keys in the destination enclave, creating them if needed, and returns the keys of the copied persistent directories
+	CopyEnclavePersistentDirectories(
+		ctx context.Context,
+		sourceEnclaveUuid enclave.EnclaveUUID,
+		destinationEnclaveUuid enclave.EnclaveUUID,
+	) ([]service_directory.DirectoryPersistentKey, error)
+
+	// Writes the content of the persistent directory of the enclave with the given key to the destination, as a TAR archive
+	ExportEnclavePersistentDirectory(
+		ctx context.Context,
+		enclaveUuid enclave.EnclaveUUID,
+		persistentKey service_directory.DirectoryPersistentKey,
+		destination io.Writer,
+	) error
+
+	// Extracts a TAR archive, as written by ExportEnclavePersistentDirectory, into the persistent directory of the enclave
+	// with the given key, creating the persistent directory if it doesn't exist yet
+	ImportEnclavePersistentDirectory(
+		ctx context.Context,
+		enclaveUuid enclave.EnclaveUUID,
+		persistentKey service_directory.DirectoryPersistentKey,
+		content io.Reader,
+	) error
+
 	// Gets enclaves matching the given filters
 	GetEnclaves(
 		ctx context.Context,
@@ -257,6 +299,18 @@ type KurtosisBackend interface {
 		error, // represents an error with the function itself, rather than the user services
 	)
 
+	// RenameUserService gives a new name, and with it a new hostname, to a registered service whose process got removed
+	// The service gets the new name once it's started again
+	RenameUserService(
+		ctx context.Context,
+		enclaveUuid enclave.EnclaveUUID,
+		serviceUuid service.ServiceUUID,
+		newServiceName service.ServiceName,
+	) (
+		*service.ServiceRegistration,
+		error,
+	)
+
 	// Gets user services using the given filters, returning a map of matched user services identified by their UUID
 	GetUserServices(
 		ctx context.Context,
@@ -280,6 +334,18 @@ type KurtosisBackend interface {
 		resultError error,
 	)
 
+	// Samples the resources used by the running user services matching the filters, returning a map of matched user
+	// services identified by their UUID
+	GetUserServiceStats(
+		ctx context.Context,
+		enclaveUuid enclave.EnclaveUUID,
+		filters *service.ServiceFilters,
+	) (
+		successfulUserServiceStats map[service.ServiceUUID]*service.ServiceStats,
+		erroredUserServiceUuids map[service.ServiceUUID]error,
+		resultError error,
+	)
+
 	// Executes a shell command inside an user service instance indenfified by its ID
 	RunUserServiceExecCommands(
 		ctx context.Context,
@@ -300,8 +366,8 @@ type KurtosisBackend interface {
 		cmd []string,
 	) (execOutputChan chan string, finalExecResultChan chan *exec_result.ExecResult, resultErr error)
 
-	// Get a connection with user service to execute commands in
-	GetShellOnUserService(ctx context.Context, enclaveUuid enclave.EnclaveUUID, serviceUuid service.ServiceUUID) (resultErr error)
+	// Get a connection with user service to execute commands in, as the container user if it's not empty
+	GetShellOnUserService(ctx context.Context, enclaveUuid enclave.EnclaveUUID, serviceUuid service.ServiceUUID, containerUser string) (resultErr error)
 
 	// Copy files, packaged as a TAR, from the given user service and writes the bytes to the given output writer
 	CopyFilesFromUserService(
@@ -372,9 +438,31 @@ type KurtosisBackend interface {
 
 	DestroyReverseProxy(ctx context.Context) error
 
+	// CreateImageRegistry starts the image registry shared by all the enclaves, idempotently, which the images built
+	// for services get pushed to from then on
+	CreateImageRegistry(ctx context.Context) (*image_registry.ImageRegistry, error)
+
+	// Returns nil if image registry was not found
+	GetImageRegistry(ctx context.Context) (*image_registry.ImageRegistry, error)
+
+	// DestroyImageRegistry removes the image registry idempotently; on Docker the pushed images are kept for the next
+	// image registry to serve
+	DestroyImageRegistry(ctx context.Context) error
+
+	// ListImageRegistryRepositories lists the repositories of the image registry along with their tags
+	ListImageRegistryRepositories(ctx context.Context) ([]*image_registry.ImageRegistryRepository, error)
+
+	// PruneImageRegistry deletes the repositories of the image registry no user service runs an image of, returning
+	// their names
+	PruneImageRegistry(ctx context.Context) ([]string, error)
+
 	// GetAvailableCPUAndMemory - gets available memory in megabytes and cpu in millicores, the boolean indicates whether the information is complete
 	GetAvailableCPUAndMemory(ctx context.Context) (compute_resources.MemoryInMegaBytes, compute_resources.CpuMilliCores, bool, error)
 
+	// GetAvailableCPUAndMemoryPerNode - gets the memory and cpu left on every node services can be scheduled on, along with
+	// the node labels and taints; returns nil if the backend doesn't schedule services across several nodes
+	GetAvailableCPUAndMemoryPerNode(ctx context.Context) ([]*compute_resources.NodeResources, error)
+
 	// BuildImage builds a container image based on the [imageBuildSpec] with [imageName]
 	// Returns image architecture and if error occurred
 	BuildImage(ctx context.Context, imageName string, imageBuildSpec *image_build_spec.ImageBuildSpec) (string, error)
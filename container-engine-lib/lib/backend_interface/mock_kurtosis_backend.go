@@ -18,6 +18,8 @@ import (
 
 	image_download_mode "github.com/kurtosis-tech/kurtosis/container-engine-lib/lib/backend_interface/objects/image_download_mode"
 
+	image_registry "github.com/kurtosis-tech/kurtosis/container-engine-lib/lib/backend_interface/objects/image_registry"
+
 	image_registry_spec "github.com/kurtosis-tech/kurtosis/container-engine-lib/lib/backend_interface/objects/image_registry_spec"
 
 	io "io"
@@ -34,6 +36,8 @@ import (
 
 	service "github.com/kurtosis-tech/kurtosis/container-engine-lib/lib/backend_interface/objects/service"
 
+	service_directory "github.com/kurtosis-tech/kurtosis/container-engine-lib/lib/backend_interface/objects/service_directory"
+
 	time "time"
 )
 
@@ -104,6 +108,62 @@ func (_c *MockKurtosisBackend_BuildImage_Call) RunAndReturn(run func(context.Con
 	return _c
 }
 
+// CopyEnclavePersistentDirectories provides a mock function with given fields: ctx, sourceEnclaveUuid, destinationEnclaveUuid
+func (_m *MockKurtosisBackend) CopyEnclavePersistentDirectories(ctx context.Context, sourceEnclaveUuid enclave.EnclaveUUID, destinationEnclaveUuid enclave.EnclaveUUID) ([]service_directory.DirectoryPersistentKey, error) {
+	ret := _m.Called(ctx, sourceEnclaveUuid, destinationEnclaveUuid)
+
+	var r0 []service_directory.DirectoryPersistentKey
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, enclave.EnclaveUUID, enclave.EnclaveUUID) ([]service_directory.DirectoryPersistentKey, error)); ok {
+		return rf(ctx, sourceEnclaveUuid, destinationEnclaveUuid)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, enclave.EnclaveUUID, enclave.EnclaveUUID) []service_directory.DirectoryPersistentKey); ok {
+		r0 = rf(ctx, sourceEnclaveUuid, destinationEnclaveUuid)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]service_directory.DirectoryPersistentKey)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, enclave.EnclaveUUID, enclave.EnclaveUUID) error); ok {
+		r1 = rf(ctx, sourceEnclaveUuid, destinationEnclaveUuid)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// MockKurtosisBackend_CopyEnclavePersistentDirectories_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'CopyEnclavePersistentDirectories'
+type MockKurtosisBackend_CopyEnclavePersistentDirectories_Call struct {
+	*mock.Call
+}
+
+// CopyEnclavePersistentDirectories is a helper method to define mock.On call
+//   - ctx context.Context
+//   - sourceEnclaveUuid enclave.EnclaveUUID
+//   - destinationEnclaveUuid enclave.EnclaveUUID
+func (_e *MockKurtosisBackend_Expecter) CopyEnclavePersistentDirectories(ctx interface{}, sourceEnclaveUuid interface{}, destinationEnclaveUuid interface{}) *MockKurtosisBackend_CopyEnclavePersistentDirectories_Call {
+	return &MockKurtosisBackend_CopyEnclavePersistentDirectories_Call{Call: _e.mock.On("CopyEnclavePersistentDirectories", ctx, sourceEnclaveUuid, destinationEnclaveUuid)}
+}
+
+func (_c *MockKurtosisBackend_CopyEnclavePersistentDirectories_Call) Run(run func(ctx context.Context, sourceEnclaveUuid enclave.EnclaveUUID, destinationEnclaveUuid enclave.EnclaveUUID)) *MockKurtosisBackend_CopyEnclavePersistentDirectories_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(enclave.EnclaveUUID), args[2].(enclave.EnclaveUUID))
+	})
+	return _c
+}
+
+func (_c *MockKurtosisBackend_CopyEnclavePersistentDirectories_Call) Return(_a0 []service_directory.DirectoryPersistentKey, _a1 error) *MockKurtosisBackend_CopyEnclavePersistentDirectories_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *MockKurtosisBackend_CopyEnclavePersistentDirectories_Call) RunAndReturn(run func(context.Context, enclave.EnclaveUUID, enclave.EnclaveUUID) ([]service_directory.DirectoryPersistentKey, error)) *MockKurtosisBackend_CopyEnclavePersistentDirectories_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
 // CopyFilesFromUserService provides a mock function with given fields: ctx, enclaveUuid, serviceUuid, srcPathOnService, output
 func (_m *MockKurtosisBackend) CopyFilesFromUserService(ctx context.Context, enclaveUuid enclave.EnclaveUUID, serviceUuid service.ServiceUUID, srcPathOnService string, output io.Writer) error {
 	ret := _m.Called(ctx, enclaveUuid, serviceUuid, srcPathOnService, output)
@@ -331,6 +391,60 @@ func (_c *MockKurtosisBackend_CreateEngine_Call) RunAndReturn(run func(context.C
 	return _c
 }
 
+// CreateImageRegistry provides a mock function with given fields: ctx
+func (_m *MockKurtosisBackend) CreateImageRegistry(ctx context.Context) (*image_registry.ImageRegistry, error) {
+	ret := _m.Called(ctx)
+
+	var r0 *image_registry.ImageRegistry
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context) (*image_registry.ImageRegistry, error)); ok {
+		return rf(ctx)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context) *image_registry.ImageRegistry); ok {
+		r0 = rf(ctx)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*image_registry.ImageRegistry)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context) error); ok {
+		r1 = rf(ctx)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// MockKurtosisBackend_CreateImageRegistry_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'CreateImageRegistry'
+type MockKurtosisBackend_CreateImageRegistry_Call struct {
+	*mock.Call
+}
+
+// CreateImageRegistry is a helper method to define mock.On call
+//   - ctx context.Context
+func (_e *MockKurtosisBackend_Expecter) CreateImageRegistry(ctx interface{}) *MockKurtosisBackend_CreateImageRegistry_Call {
+	return &MockKurtosisBackend_CreateImageRegistry_Call{Call: _e.mock.On("CreateImageRegistry", ctx)}
+}
+
+func (_c *MockKurtosisBackend_CreateImageRegistry_Call) Run(run func(ctx context.Context)) *MockKurtosisBackend_CreateImageRegistry_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context))
+	})
+	return _c
+}
+
+func (_c *MockKurtosisBackend_CreateImageRegistry_Call) Return(_a0 *image_registry.ImageRegistry, _a1 error) *MockKurtosisBackend_CreateImageRegistry_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *MockKurtosisBackend_CreateImageRegistry_Call) RunAndReturn(run func(context.Context) (*image_registry.ImageRegistry, error)) *MockKurtosisBackend_CreateImageRegistry_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
 // CreateLogsAggregator provides a mock function with given fields: ctx, httpPortNum, sinks
 func (_m *MockKurtosisBackend) CreateLogsAggregator(ctx context.Context, httpPortNum uint16, sinks logs_aggregator.Sinks) (*logs_aggregator.LogsAggregator, error) {
 	ret := _m.Called(ctx, httpPortNum, sinks)
@@ -693,6 +807,48 @@ func (_c *MockKurtosisBackend_DestroyEngines_Call) RunAndReturn(run func(context
 	return _c
 }
 
+// DestroyImageRegistry provides a mock function with given fields: ctx
+func (_m *MockKurtosisBackend) DestroyImageRegistry(ctx context.Context) error {
+	ret := _m.Called(ctx)
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(context.Context) error); ok {
+		r0 = rf(ctx)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// MockKurtosisBackend_DestroyImageRegistry_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'DestroyImageRegistry'
+type MockKurtosisBackend_DestroyImageRegistry_Call struct {
+	*mock.Call
+}
+
+// DestroyImageRegistry is a helper method to define mock.On call
+//   - ctx context.Context
+func (_e *MockKurtosisBackend_Expecter) DestroyImageRegistry(ctx interface{}) *MockKurtosisBackend_DestroyImageRegistry_Call {
+	return &MockKurtosisBackend_DestroyImageRegistry_Call{Call: _e.mock.On("DestroyImageRegistry", ctx)}
+}
+
+func (_c *MockKurtosisBackend_DestroyImageRegistry_Call) Run(run func(ctx context.Context)) *MockKurtosisBackend_DestroyImageRegistry_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context))
+	})
+	return _c
+}
+
+func (_c *MockKurtosisBackend_DestroyImageRegistry_Call) Return(_a0 error) *MockKurtosisBackend_DestroyImageRegistry_Call {
+	_c.Call.Return(_a0)
+	return _c
+}
+
+func (_c *MockKurtosisBackend_DestroyImageRegistry_Call) RunAndReturn(run func(context.Context) error) *MockKurtosisBackend_DestroyImageRegistry_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
 // DestroyLogsAggregator provides a mock function with given fields: ctx
 func (_m *MockKurtosisBackend) DestroyLogsAggregator(ctx context.Context) error {
 	ret := _m.Called(ctx)
@@ -972,6 +1128,51 @@ func (_c *MockKurtosisBackend_DumpKurtosis_Call) RunAndReturn(run func(context.C
 	return _c
 }
 
+// ExportEnclavePersistentDirectory provides a mock function with given fields: ctx, enclaveUuid, persistentKey, destination
+func (_m *MockKurtosisBackend) ExportEnclavePersistentDirectory(ctx context.Context, enclaveUuid enclave.EnclaveUUID, persistentKey service_directory.DirectoryPersistentKey, destination io.Writer) error {
+	ret := _m.Called(ctx, enclaveUuid, persistentKey, destination)
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(context.Context, enclave.EnclaveUUID, service_directory.DirectoryPersistentKey, io.Writer) error); ok {
+		r0 = rf(ctx, enclaveUuid, persistentKey, destination)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// MockKurtosisBackend_ExportEnclavePersistentDirectory_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'ExportEnclavePersistentDirectory'
+type MockKurtosisBackend_ExportEnclavePersistentDirectory_Call struct {
+	*mock.Call
+}
+
+// ExportEnclavePersistentDirectory is a helper method to define mock.On call
+//   - ctx context.Context
+//   - enclaveUuid enclave.EnclaveUUID
+//   - persistentKey service_directory.DirectoryPersistentKey
+//   - destination io.Writer
+func (_e *MockKurtosisBackend_Expecter) ExportEnclavePersistentDirectory(ctx interface{}, enclaveUuid interface{}, persistentKey interface{}, destination interface{}) *MockKurtosisBackend_ExportEnclavePersistentDirectory_Call {
+	return &MockKurtosisBackend_ExportEnclavePersistentDirectory_Call{Call: _e.mock.On("ExportEnclavePersistentDirectory", ctx, enclaveUuid, persistentKey, destination)}
+}
+
+func (_c *MockKurtosisBackend_ExportEnclavePersistentDirectory_Call) Run(run func(ctx context.Context, enclaveUuid enclave.EnclaveUUID, persistentKey service_directory.DirectoryPersistentKey, destination io.Writer)) *MockKurtosisBackend_ExportEnclavePersistentDirectory_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(enclave.EnclaveUUID), args[2].(service_directory.DirectoryPersistentKey), args[3].(io.Writer))
+	})
+	return _c
+}
+
+func (_c *MockKurtosisBackend_ExportEnclavePersistentDirectory_Call) Return(_a0 error) *MockKurtosisBackend_ExportEnclavePersistentDirectory_Call {
+	_c.Call.Return(_a0)
+	return _c
+}
+
+func (_c *MockKurtosisBackend_ExportEnclavePersistentDirectory_Call) RunAndReturn(run func(context.Context, enclave.EnclaveUUID, service_directory.DirectoryPersistentKey, io.Writer) error) *MockKurtosisBackend_ExportEnclavePersistentDirectory_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
 // FetchImage provides a mock function with given fields: ctx, image, registrySpec, downloadMode
 func (_m *MockKurtosisBackend) FetchImage(ctx context.Context, image string, registrySpec *image_registry_spec.ImageRegistrySpec, downloadMode image_download_mode.ImageDownloadMode) (bool, string, error) {
 	ret := _m.Called(ctx, image, registrySpec, downloadMode)
@@ -1155,6 +1356,60 @@ func (_c *MockKurtosisBackend_GetAvailableCPUAndMemory_Call) RunAndReturn(run fu
 	return _c
 }
 
+// GetAvailableCPUAndMemoryPerNode provides a mock function with given fields: ctx
+func (_m *MockKurtosisBackend) GetAvailableCPUAndMemoryPerNode(ctx context.Context) ([]*compute_resources.NodeResources, error) {
+	ret := _m.Called(ctx)
+
+	var r0 []*compute_resources.NodeResources
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context) ([]*compute_resources.NodeResources, error)); ok {
+		return rf(ctx)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context) []*compute_resources.NodeResources); ok {
+		r0 = rf(ctx)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]*compute_resources.NodeResources)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context) error); ok {
+		r1 = rf(ctx)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// MockKurtosisBackend_GetAvailableCPUAndMemoryPerNode_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'GetAvailableCPUAndMemoryPerNode'
+type MockKurtosisBackend_GetAvailableCPUAndMemoryPerNode_Call struct {
+	*mock.Call
+}
+
+// GetAvailableCPUAndMemoryPerNode is a helper method to define mock.On call
+//   - ctx context.Context
+func (_e *MockKurtosisBackend_Expecter) GetAvailableCPUAndMemoryPerNode(ctx interface{}) *MockKurtosisBackend_GetAvailableCPUAndMemoryPerNode_Call {
+	return &MockKurtosisBackend_GetAvailableCPUAndMemoryPerNode_Call{Call: _e.mock.On("GetAvailableCPUAndMemoryPerNode", ctx)}
+}
+
+func (_c *MockKurtosisBackend_GetAvailableCPUAndMemoryPerNode_Call) Run(run func(ctx context.Context)) *MockKurtosisBackend_GetAvailableCPUAndMemoryPerNode_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context))
+	})
+	return _c
+}
+
+func (_c *MockKurtosisBackend_GetAvailableCPUAndMemoryPerNode_Call) Return(_a0 []*compute_resources.NodeResources, _a1 error) *MockKurtosisBackend_GetAvailableCPUAndMemoryPerNode_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *MockKurtosisBackend_GetAvailableCPUAndMemoryPerNode_Call) RunAndReturn(run func(context.Context) ([]*compute_resources.NodeResources, error)) *MockKurtosisBackend_GetAvailableCPUAndMemoryPerNode_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
 // GetEnclaves provides a mock function with given fields: ctx, filters
 func (_m *MockKurtosisBackend) GetEnclaves(ctx context.Context, filters *enclave.EnclaveFilters) (map[enclave.EnclaveUUID]*enclave.Enclave, error) {
 	ret := _m.Called(ctx, filters)
@@ -1308,6 +1563,60 @@ func (_c *MockKurtosisBackend_GetEngines_Call) RunAndReturn(run func(context.Con
 	return _c
 }
 
+// GetImageRegistry provides a mock function with given fields: ctx
+func (_m *MockKurtosisBackend) GetImageRegistry(ctx context.Context) (*image_registry.ImageRegistry, error) {
+	ret := _m.Called(ctx)
+
+	var r0 *image_registry.ImageRegistry
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context) (*image_registry.ImageRegistry, error)); ok {
+		return rf(ctx)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context) *image_registry.ImageRegistry); ok {
+		r0 = rf(ctx)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*image_registry.ImageRegistry)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context) error); ok {
+		r1 = rf(ctx)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// MockKurtosisBackend_GetImageRegistry_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'GetImageRegistry'
+type MockKurtosisBackend_GetImageRegistry_Call struct {
+	*mock.Call
+}
+
+// GetImageRegistry is a helper method to define mock.On call
+//   - ctx context.Context
+func (_e *MockKurtosisBackend_Expecter) GetImageRegistry(ctx interface{}) *MockKurtosisBackend_GetImageRegistry_Call {
+	return &MockKurtosisBackend_GetImageRegistry_Call{Call: _e.mock.On("GetImageRegistry", ctx)}
+}
+
+func (_c *MockKurtosisBackend_GetImageRegistry_Call) Run(run func(ctx context.Context)) *MockKurtosisBackend_GetImageRegistry_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context))
+	})
+	return _c
+}
+
+func (_c *MockKurtosisBackend_GetImageRegistry_Call) Return(_a0 *image_registry.ImageRegistry, _a1 error) *MockKurtosisBackend_GetImageRegistry_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *MockKurtosisBackend_GetImageRegistry_Call) RunAndReturn(run func(context.Context) (*image_registry.ImageRegistry, error)) *MockKurtosisBackend_GetImageRegistry_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
 // GetLogsAggregator provides a mock function with given fields: ctx
 func (_m *MockKurtosisBackend) GetLogsAggregator(ctx context.Context) (*logs_aggregator.LogsAggregator, error) {
 	ret := _m.Called(ctx)
@@ -1471,13 +1780,13 @@ func (_c *MockKurtosisBackend_GetReverseProxy_Call) RunAndReturn(run func(contex
 	return _c
 }
 
-// GetShellOnUserService provides a mock function with given fields: ctx, enclaveUuid, serviceUuid
-func (_m *MockKurtosisBackend) GetShellOnUserService(ctx context.Context, enclaveUuid enclave.EnclaveUUID, serviceUuid service.ServiceUUID) error {
-	ret := _m.Called(ctx, enclaveUuid, serviceUuid)
+// GetShellOnUserService provides a mock function with given fields: ctx, enclaveUuid, serviceUuid, containerUser
+func (_m *MockKurtosisBackend) GetShellOnUserService(ctx context.Context, enclaveUuid enclave.EnclaveUUID, serviceUuid service.ServiceUUID, containerUser string) error {
+	ret := _m.Called(ctx, enclaveUuid, serviceUuid, containerUser)
 
 	var r0 error
-	if rf, ok := ret.Get(0).(func(context.Context, enclave.EnclaveUUID, service.ServiceUUID) error); ok {
-		r0 = rf(ctx, enclaveUuid, serviceUuid)
+	if rf, ok := ret.Get(0).(func(context.Context, enclave.EnclaveUUID, service.ServiceUUID, string) error); ok {
+		r0 = rf(ctx, enclaveUuid, serviceUuid, containerUser)
 	} else {
 		r0 = ret.Error(0)
 	}
@@ -1494,13 +1803,14 @@ type MockKurtosisBackend_GetShellOnUserService_Call struct {
 //   - ctx context.Context
 //   - enclaveUuid enclave.EnclaveUUID
 //   - serviceUuid service.ServiceUUID
-func (_e *MockKurtosisBackend_Expecter) GetShellOnUserService(ctx interface{}, enclaveUuid interface{}, serviceUuid interface{}) *MockKurtosisBackend_GetShellOnUserService_Call {
-	return &MockKurtosisBackend_GetShellOnUserService_Call{Call: _e.mock.On("GetShellOnUserService", ctx, enclaveUuid, serviceUuid)}
+//   - containerUser string
+func (_e *MockKurtosisBackend_Expecter) GetShellOnUserService(ctx interface{}, enclaveUuid interface{}, serviceUuid interface{}, containerUser interface{}) *MockKurtosisBackend_GetShellOnUserService_Call {
+	return &MockKurtosisBackend_GetShellOnUserService_Call{Call: _e.mock.On("GetShellOnUserService", ctx, enclaveUuid, serviceUuid, containerUser)}
 }
 
-func (_c *MockKurtosisBackend_GetShellOnUserService_Call) Run(run func(ctx context.Context, enclaveUuid enclave.EnclaveUUID, serviceUuid service.ServiceUUID)) *MockKurtosisBackend_GetShellOnUserService_Call {
+func (_c *MockKurtosisBackend_GetShellOnUserService_Call) Run(run func(ctx context.Context, enclaveUuid enclave.EnclaveUUID, serviceUuid service.ServiceUUID, containerUser string)) *MockKurtosisBackend_GetShellOnUserService_Call {
 	_c.Call.Run(func(args mock.Arguments) {
-		run(args[0].(context.Context), args[1].(enclave.EnclaveUUID), args[2].(service.ServiceUUID))
+		run(args[0].(context.Context), args[1].(enclave.EnclaveUUID), args[2].(service.ServiceUUID), args[3].(string))
 	})
 	return _c
 }
@@ -1510,7 +1820,7 @@ func (_c *MockKurtosisBackend_GetShellOnUserService_Call) Return(resultErr error
 	return _c
 }
 
-func (_c *MockKurtosisBackend_GetShellOnUserService_Call) RunAndReturn(run func(context.Context, enclave.EnclaveUUID, service.ServiceUUID) error) *MockKurtosisBackend_GetShellOnUserService_Call {
+func (_c *MockKurtosisBackend_GetShellOnUserService_Call) RunAndReturn(run func(context.Context, enclave.EnclaveUUID, service.ServiceUUID, string) error) *MockKurtosisBackend_GetShellOnUserService_Call {
 	_c.Call.Return(run)
 	return _c
 }
@@ -1581,6 +1891,71 @@ func (_c *MockKurtosisBackend_GetUserServiceLogs_Call) RunAndReturn(run func(con
 	return _c
 }
 
+// GetUserServiceStats provides a mock function with given fields: ctx, enclaveUuid, filters
+func (_m *MockKurtosisBackend) GetUserServiceStats(ctx context.Context, enclaveUuid enclave.EnclaveUUID, filters *service.ServiceFilters) (map[service.ServiceUUID]*service.ServiceStats, map[service.ServiceUUID]error, error) {
+	ret := _m.Called(ctx, enclaveUuid, filters)
+
+	var r0 map[service.ServiceUUID]*service.ServiceStats
+	var r1 map[service.ServiceUUID]error
+	var r2 error
+	if rf, ok := ret.Get(0).(func(context.Context, enclave.EnclaveUUID, *service.ServiceFilters) (map[service.ServiceUUID]*service.ServiceStats, map[service.ServiceUUID]error, error)); ok {
+		return rf(ctx, enclaveUuid, filters)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, enclave.EnclaveUUID, *service.ServiceFilters) map[service.ServiceUUID]*service.ServiceStats); ok {
+		r0 = rf(ctx, enclaveUuid, filters)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(map[service.ServiceUUID]*service.ServiceStats)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, enclave.EnclaveUUID, *service.ServiceFilters) map[service.ServiceUUID]error); ok {
+		r1 = rf(ctx, enclaveUuid, filters)
+	} else {
+		if ret.Get(1) != nil {
+			r1 = ret.Get(1).(map[service.ServiceUUID]error)
+		}
+	}
+
+	if rf, ok := ret.Get(2).(func(context.Context, enclave.EnclaveUUID, *service.ServiceFilters) error); ok {
+		r2 = rf(ctx, enclaveUuid, filters)
+	} else {
+		r2 = ret.Error(2)
+	}
+
+	return r0, r1, r2
+}
+
+// MockKurtosisBackend_GetUserServiceStats_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'GetUserServiceStats'
+type MockKurtosisBackend_GetUserServiceStats_Call struct {
+	*mock.Call
+}
+
+// GetUserServiceStats is a helper method to define mock.On call
+//   - ctx context.Context
+//   - enclaveUuid enclave.EnclaveUUID
+//   - filters *service.ServiceFilters
+func (_e *MockKurtosisBackend_Expecter) GetUserServiceStats(ctx interface{}, enclaveUuid interface{}, filters interface{}) *MockKurtosisBackend_GetUserServiceStats_Call {
+	return &MockKurtosisBackend_GetUserServiceStats_Call{Call: _e.mock.On("GetUserServiceStats", ctx, enclaveUuid, filters)}
+}
+
+func (_c *MockKurtosisBackend_GetUserServiceStats_Call) Run(run func(ctx context.Context, enclaveUuid enclave.EnclaveUUID, filters *service.ServiceFilters)) *MockKurtosisBackend_GetUserServiceStats_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(enclave.EnclaveUUID), args[2].(*service.ServiceFilters))
+	})
+	return _c
+}
+
+func (_c *MockKurtosisBackend_GetUserServiceStats_Call) Return(successfulUserServiceStats map[service.ServiceUUID]*service.ServiceStats, erroredUserServiceUuids map[service.ServiceUUID]error, resultError error) *MockKurtosisBackend_GetUserServiceStats_Call {
+	_c.Call.Return(successfulUserServiceStats, erroredUserServiceUuids, resultError)
+	return _c
+}
+
+func (_c *MockKurtosisBackend_GetUserServiceStats_Call) RunAndReturn(run func(context.Context, enclave.EnclaveUUID, *service.ServiceFilters) (map[service.ServiceUUID]*service.ServiceStats, map[service.ServiceUUID]error, error)) *MockKurtosisBackend_GetUserServiceStats_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
 // GetUserServices provides a mock function with given fields: ctx, enclaveUuid, filters
 func (_m *MockKurtosisBackend) GetUserServices(ctx context.Context, enclaveUuid enclave.EnclaveUUID, filters *service.ServiceFilters) (map[service.ServiceUUID]*service.Service, error) {
 	ret := _m.Called(ctx, enclaveUuid, filters)
@@ -1637,6 +2012,105 @@ func (_c *MockKurtosisBackend_GetUserServices_Call) RunAndReturn(run func(contex
 	return _c
 }
 
+// ImportEnclavePersistentDirectory provides a mock function with given fields: ctx, enclaveUuid, persistentKey, content
+func (_m *MockKurtosisBackend) ImportEnclavePersistentDirectory(ctx context.Context, enclaveUuid enclave.EnclaveUUID, persistentKey service_directory.DirectoryPersistentKey, content io.Reader) error {
+	ret := _m.Called(ctx, enclaveUuid, persistentKey, content)
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(context.Context, enclave.EnclaveUUID, service_directory.DirectoryPersistentKey, io.Reader) error); ok {
+		r0 = rf(ctx, enclaveUuid, persistentKey, content)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// MockKurtosisBackend_ImportEnclavePersistentDirectory_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'ImportEnclavePersistentDirectory'
+type MockKurtosisBackend_ImportEnclavePersistentDirectory_Call struct {
+	*mock.Call
+}
+
+// ImportEnclavePersistentDirectory is a helper method to define mock.On call
+//   - ctx context.Context
+//   - enclaveUuid enclave.EnclaveUUID
+//   - persistentKey service_directory.DirectoryPersistentKey
+//   - content io.Reader
+func (_e *MockKurtosisBackend_Expecter) ImportEnclavePersistentDirectory(ctx interface{}, enclaveUuid interface{}, persistentKey interface{}, content interface{}) *MockKurtosisBackend_ImportEnclavePersistentDirectory_Call {
+	return &MockKurtosisBackend_ImportEnclavePersistentDirectory_Call{Call: _e.mock.On("ImportEnclavePersistentDirectory", ctx, enclaveUuid, persistentKey, content)}
+}
+
+func (_c *MockKurtosisBackend_ImportEnclavePersistentDirectory_Call) Run(run func(ctx context.Context, enclaveUuid enclave.EnclaveUUID, persistentKey service_directory.DirectoryPersistentKey, content io.Reader)) *MockKurtosisBackend_ImportEnclavePersistentDirectory_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(enclave.EnclaveUUID), args[2].(service_directory.DirectoryPersistentKey), args[3].(io.Reader))
+	})
+	return _c
+}
+
+func (_c *MockKurtosisBackend_ImportEnclavePersistentDirectory_Call) Return(_a0 error) *MockKurtosisBackend_ImportEnclavePersistentDirectory_Call {
+	_c.Call.Return(_a0)
+	return _c
+}
+
+func (_c *MockKurtosisBackend_ImportEnclavePersistentDirectory_Call) RunAndReturn(run func(context.Context, enclave.EnclaveUUID, service_directory.DirectoryPersistentKey, io.Reader) error) *MockKurtosisBackend_ImportEnclavePersistentDirectory_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// ListImageRegistryRepositories provides a mock function with given fields: ctx
+func (_m *MockKurtosisBackend) ListImageRegistryRepositories(ctx context.Context) ([]*image_registry.ImageRegistryRepository, error) {
+	ret := _m.Called(ctx)
+
+	var r0 []*image_registry.ImageRegistryRepository
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context) ([]*image_registry.ImageRegistryRepository, error)); ok {
+		return rf(ctx)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context) []*image_registry.ImageRegistryRepository); ok {
+		r0 = rf(ctx)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]*image_registry.ImageRegistryRepository)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context) error); ok {
+		r1 = rf(ctx)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// MockKurtosisBackend_ListImageRegistryRepositories_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'ListImageRegistryRepositories'
+type MockKurtosisBackend_ListImageRegistryRepositories_Call struct {
+	*mock.Call
+}
+
+// ListImageRegistryRepositories is a helper method to define mock.On call
+//   - ctx context.Context
+func (_e *MockKurtosisBackend_Expecter) ListImageRegistryRepositories(ctx interface{}) *MockKurtosisBackend_ListImageRegistryRepositories_Call {
+	return &MockKurtosisBackend_ListImageRegistryRepositories_Call{Call: _e.mock.On("ListImageRegistryRepositories", ctx)}
+}
+
+func (_c *MockKurtosisBackend_ListImageRegistryRepositories_Call) Run(run func(ctx context.Context)) *MockKurtosisBackend_ListImageRegistryRepositories_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context))
+	})
+	return _c
+}
+
+func (_c *MockKurtosisBackend_ListImageRegistryRepositories_Call) Return(_a0 []*image_registry.ImageRegistryRepository, _a1 error) *MockKurtosisBackend_ListImageRegistryRepositories_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *MockKurtosisBackend_ListImageRegistryRepositories_Call) RunAndReturn(run func(context.Context) ([]*image_registry.ImageRegistryRepository, error)) *MockKurtosisBackend_ListImageRegistryRepositories_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
 // NixBuild provides a mock function with given fields: ctx, nixBuildSpec
 func (_m *MockKurtosisBackend) NixBuild(ctx context.Context, nixBuildSpec *nix_build_spec.NixBuildSpec) (string, error) {
 	ret := _m.Called(ctx, nixBuildSpec)
@@ -1690,6 +2164,60 @@ func (_c *MockKurtosisBackend_NixBuild_Call) RunAndReturn(run func(context.Conte
 	return _c
 }
 
+// PruneImageRegistry provides a mock function with given fields: ctx
+func (_m *MockKurtosisBackend) PruneImageRegistry(ctx context.Context) ([]string, error) {
+	ret := _m.Called(ctx)
+
+	var r0 []string
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context) ([]string, error)); ok {
+		return rf(ctx)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context) []string); ok {
+		r0 = rf(ctx)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]string)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context) error); ok {
+		r1 = rf(ctx)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// MockKurtosisBackend_PruneImageRegistry_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'PruneImageRegistry'
+type MockKurtosisBackend_PruneImageRegistry_Call struct {
+	*mock.Call
+}
+
+// PruneImageRegistry is a helper method to define mock.On call
+//   - ctx context.Context
+func (_e *MockKurtosisBackend_Expecter) PruneImageRegistry(ctx interface{}) *MockKurtosisBackend_PruneImageRegistry_Call {
+	return &MockKurtosisBackend_PruneImageRegistry_Call{Call: _e.mock.On("PruneImageRegistry", ctx)}
+}
+
+func (_c *MockKurtosisBackend_PruneImageRegistry_Call) Run(run func(ctx context.Context)) *MockKurtosisBackend_PruneImageRegistry_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context))
+	})
+	return _c
+}
+
+func (_c *MockKurtosisBackend_PruneImageRegistry_Call) Return(_a0 []string, _a1 error) *MockKurtosisBackend_PruneImageRegistry_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *MockKurtosisBackend_PruneImageRegistry_Call) RunAndReturn(run func(context.Context) ([]string, error)) *MockKurtosisBackend_PruneImageRegistry_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
 // PruneUnusedImages provides a mock function with given fields: ctx
 func (_m *MockKurtosisBackend) PruneUnusedImages(ctx context.Context) ([]string, error) {
 	ret := _m.Called(ctx)
@@ -1874,6 +2402,63 @@ func (_c *MockKurtosisBackend_RemoveRegisteredUserServiceProcesses_Call) RunAndR
 	return _c
 }
 
+// RenameUserService provides a mock function with given fields: ctx, enclaveUuid, serviceUuid, newServiceName
+func (_m *MockKurtosisBackend) RenameUserService(ctx context.Context, enclaveUuid enclave.EnclaveUUID, serviceUuid service.ServiceUUID, newServiceName service.ServiceName) (*service.ServiceRegistration, error) {
+	ret := _m.Called(ctx, enclaveUuid, serviceUuid, newServiceName)
+
+	var r0 *service.ServiceRegistration
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, enclave.EnclaveUUID, service.ServiceUUID, service.ServiceName) (*service.ServiceRegistration, error)); ok {
+		return rf(ctx, enclaveUuid, serviceUuid, newServiceName)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, enclave.EnclaveUUID, service.ServiceUUID, service.ServiceName) *service.ServiceRegistration); ok {
+		r0 = rf(ctx, enclaveUuid, serviceUuid, newServiceName)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*service.ServiceRegistration)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, enclave.EnclaveUUID, service.ServiceUUID, service.ServiceName) error); ok {
+		r1 = rf(ctx, enclaveUuid, serviceUuid, newServiceName)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// MockKurtosisBackend_RenameUserService_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'RenameUserService'
+type MockKurtosisBackend_RenameUserService_Call struct {
+	*mock.Call
+}
+
+// RenameUserService is a helper method to define mock.On call
+//   - ctx context.Context
+//   - enclaveUuid enclave.EnclaveUUID
+//   - serviceUuid service.ServiceUUID
+//   - newServiceName service.ServiceName
+func (_e *MockKurtosisBackend_Expecter) RenameUserService(ctx interface{}, enclaveUuid interface{}, serviceUuid interface{}, newServiceName interface{}) *MockKurtosisBackend_RenameUserService_Call {
+	return &MockKurtosisBackend_RenameUserService_Call{Call: _e.mock.On("RenameUserService", ctx, enclaveUuid, serviceUuid, newServiceName)}
+}
+
+func (_c *MockKurtosisBackend_RenameUserService_Call) Run(run func(ctx context.Context, enclaveUuid enclave.EnclaveUUID, serviceUuid service.ServiceUUID, newServiceName service.ServiceName)) *MockKurtosisBackend_RenameUserService_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(enclave.EnclaveUUID), args[2].(service.ServiceUUID), args[3].(service.ServiceName))
+	})
+	return _c
+}
+
+func (_c *MockKurtosisBackend_RenameUserService_Call) Return(_a0 *service.ServiceRegistration, _a1 error) *MockKurtosisBackend_RenameUserService_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *MockKurtosisBackend_RenameUserService_Call) RunAndReturn(run func(context.Context, enclave.EnclaveUUID, service.ServiceUUID, service.ServiceName) (*service.ServiceRegistration, error)) *MockKurtosisBackend_RenameUserService_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
 // RunUserServiceExecCommandWithStreamedOutput provides a mock function with given fields: ctx, enclaveUuid, serviceUuid, cmd
 func (_m *MockKurtosisBackend) RunUserServiceExecCommandWithStreamedOutput(ctx context.Context, enclaveUuid enclave.EnclaveUUID, serviceUuid service.ServiceUUID, cmd []string) (chan string, chan *exec_result.ExecResult, error) {
 	ret := _m.Called(ctx, enclaveUuid, serviceUuid, cmd)
@@ -2438,6 +3023,94 @@ func (_c *MockKurtosisBackend_UpdateEnclave_Call) RunAndReturn(run func(context.
 	return _c
 }
 
+// UpdateEnclaveExpiration provides a mock function with given fields: ctx, enclaveUuid, expiration
+func (_m *MockKurtosisBackend) UpdateEnclaveExpiration(ctx context.Context, enclaveUuid enclave.EnclaveUUID, expiration *enclave.EnclaveExpiration) error {
+	ret := _m.Called(ctx, enclaveUuid, expiration)
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(context.Context, enclave.EnclaveUUID, *enclave.EnclaveExpiration) error); ok {
+		r0 = rf(ctx, enclaveUuid, expiration)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// MockKurtosisBackend_UpdateEnclaveExpiration_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'UpdateEnclaveExpiration'
+type MockKurtosisBackend_UpdateEnclaveExpiration_Call struct {
+	*mock.Call
+}
+
+// UpdateEnclaveExpiration is a helper method to define mock.On call
+//   - ctx context.Context
+//   - enclaveUuid enclave.EnclaveUUID
+//   - expiration *enclave.EnclaveExpiration
+func (_e *MockKurtosisBackend_Expecter) UpdateEnclaveExpiration(ctx interface{}, enclaveUuid interface{}, expiration interface{}) *MockKurtosisBackend_UpdateEnclaveExpiration_Call {
+	return &MockKurtosisBackend_UpdateEnclaveExpiration_Call{Call: _e.mock.On("UpdateEnclaveExpiration", ctx, enclaveUuid, expiration)}
+}
+
+func (_c *MockKurtosisBackend_UpdateEnclaveExpiration_Call) Run(run func(ctx context.Context, enclaveUuid enclave.EnclaveUUID, expiration *enclave.EnclaveExpiration)) *MockKurtosisBackend_UpdateEnclaveExpiration_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(enclave.EnclaveUUID), args[2].(*enclave.EnclaveExpiration))
+	})
+	return _c
+}
+
+func (_c *MockKurtosisBackend_UpdateEnclaveExpiration_Call) Return(_a0 error) *MockKurtosisBackend_UpdateEnclaveExpiration_Call {
+	_c.Call.Return(_a0)
+	return _c
+}
+
+func (_c *MockKurtosisBackend_UpdateEnclaveExpiration_Call) RunAndReturn(run func(context.Context, enclave.EnclaveUUID, *enclave.EnclaveExpiration) error) *MockKurtosisBackend_UpdateEnclaveExpiration_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// UpdateEnclaveLabels provides a mock function with given fields: ctx, enclaveUuid, labels
+func (_m *MockKurtosisBackend) UpdateEnclaveLabels(ctx context.Context, enclaveUuid enclave.EnclaveUUID, labels map[string]string) error {
+	ret := _m.Called(ctx, enclaveUuid, labels)
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(context.Context, enclave.EnclaveUUID, map[string]string) error); ok {
+		r0 = rf(ctx, enclaveUuid, labels)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// MockKurtosisBackend_UpdateEnclaveLabels_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'UpdateEnclaveLabels'
+type MockKurtosisBackend_UpdateEnclaveLabels_Call struct {
+	*mock.Call
+}
+
+// UpdateEnclaveLabels is a helper method to define mock.On call
+//   - ctx context.Context
+//   - enclaveUuid enclave.EnclaveUUID
+//   - labels map[string]string
+func (_e *MockKurtosisBackend_Expecter) UpdateEnclaveLabels(ctx interface{}, enclaveUuid interface{}, labels interface{}) *MockKurtosisBackend_UpdateEnclaveLabels_Call {
+	return &MockKurtosisBackend_UpdateEnclaveLabels_Call{Call: _e.mock.On("UpdateEnclaveLabels", ctx, enclaveUuid, labels)}
+}
+
+func (_c *MockKurtosisBackend_UpdateEnclaveLabels_Call) Run(run func(ctx context.Context, enclaveUuid enclave.EnclaveUUID, labels map[string]string)) *MockKurtosisBackend_UpdateEnclaveLabels_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(enclave.EnclaveUUID), args[2].(map[string]string))
+	})
+	return _c
+}
+
+func (_c *MockKurtosisBackend_UpdateEnclaveLabels_Call) Return(_a0 error) *MockKurtosisBackend_UpdateEnclaveLabels_Call {
+	_c.Call.Return(_a0)
+	return _c
+}
+
+func (_c *MockKurtosisBackend_UpdateEnclaveLabels_Call) RunAndReturn(run func(context.Context, enclave.EnclaveUUID, map[string]string) error) *MockKurtosisBackend_UpdateEnclaveLabels_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
 type mockConstructorTestingTNewMockKurtosisBackend interface {
 	mock.TestingT
 	Cleanup(func())
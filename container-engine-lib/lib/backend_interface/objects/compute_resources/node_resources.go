@@ -0,0 +1,85 @@
+package compute_resources
+
+import (
+	v1 "k8s.io/api/core/v1"
+)
+
+// NodeResources is the cpu & memory left to schedule workloads on a single node of a cluster, along with the node
+// labels and taints deciding which workloads can be scheduled on it
+type NodeResources struct {
+	name string
+
+	labels map[string]string
+
+	taints []v1.Taint
+
+	availableCpuInMilliCores CpuMilliCores
+
+	availableMemoryInMegaBytes MemoryInMegaBytes
+}
+
+func NewNodeResources(
+	name string,
+	labels map[string]string,
+	taints []v1.Taint,
+	availableCpuInMilliCores CpuMilliCores,
+	availableMemoryInMegaBytes MemoryInMegaBytes,
+) *NodeResources {
+	return &NodeResources{
+		name:                       name,
+		labels:                     labels,
+		taints:                     taints,
+		availableCpuInMilliCores:   availableCpuInMilliCores,
+		availableMemoryInMegaBytes: availableMemoryInMegaBytes,
+	}
+}
+
+func (resources *NodeResources) GetName() string {
+	return resources.name
+}
+
+func (resources *NodeResources) GetLabels() map[string]string {
+	return resources.labels
+}
+
+func (resources *NodeResources) GetTaints() []v1.Taint {
+	return resources.taints
+}
+
+func (resources *NodeResources) GetAvailableCpuInMilliCores() CpuMilliCores {
+	return resources.availableCpuInMilliCores
+}
+
+func (resources *NodeResources) GetAvailableMemoryInMegaBytes() MemoryInMegaBytes {
+	return resources.availableMemoryInMegaBytes
+}
+
+// CanSchedule returns true if a workload with the given node selectors and tolerations can be scheduled on the node,
+// regardless of the cpu & memory left on it
+func (resources *NodeResources) CanSchedule(nodeSelectors map[string]string, tolerations []v1.Toleration) bool {
+	for selectorKey, selectorValue := range nodeSelectors {
+		if labelValue, found := resources.labels[selectorKey]; !found || labelValue != selectorValue {
+			return false
+		}
+	}
+	for taintIdx := range resources.taints {
+		taint := resources.taints[taintIdx]
+		// PreferNoSchedule taints are only a hint to the scheduler, they never prevent a workload from landing on the node
+		if taint.Effect == v1.TaintEffectPreferNoSchedule {
+			continue
+		}
+		if !isTaintTolerated(&taint, tolerations) {
+			return false
+		}
+	}
+	return true
+}
+
+func isTaintTolerated(taint *v1.Taint, tolerations []v1.Toleration) bool {
+	for tolerationIdx := range tolerations {
+		if tolerations[tolerationIdx].ToleratesTaint(taint) {
+			return true
+		}
+	}
+	return false
+}
@@ -10,10 +10,16 @@ type Enclave struct {
 	status              EnclaveStatus
 	creationTime        *time.Time
 	isProductionEnclave bool
+
+	// Nil if the enclave never expires
+	expiration *EnclaveExpiration
+
+	// The key/value labels the user attached to the enclave
+	labels map[string]string
 }
 
-func NewEnclave(id EnclaveUUID, name string, status EnclaveStatus, creationTime *time.Time, productionMode bool) *Enclave {
-	return &Enclave{uuid: id, name: name, status: status, creationTime: creationTime, isProductionEnclave: productionMode}
+func NewEnclave(id EnclaveUUID, name string, status EnclaveStatus, creationTime *time.Time, productionMode bool, expiration *EnclaveExpiration, labels map[string]string) *Enclave {
+	return &Enclave{uuid: id, name: name, status: status, creationTime: creationTime, isProductionEnclave: productionMode, expiration: expiration, labels: labels}
 }
 
 func (enclave *Enclave) GetUUID() EnclaveUUID {
@@ -35,3 +41,11 @@ func (enclave *Enclave) GetName() string {
 func (enclave *Enclave) IsProductionEnclave() bool {
 	return enclave.isProductionEnclave
 }
+
+func (enclave *Enclave) GetExpiration() *EnclaveExpiration {
+	return enclave.expiration
+}
+
+func (enclave *Enclave) GetLabels() map[string]string {
+	return enclave.labels
+}
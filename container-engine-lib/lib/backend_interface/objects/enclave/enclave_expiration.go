@@ -0,0 +1,31 @@
+package enclave
+
+import "time"
+
+// EnclaveExpiration describes when an enclave expires and what happens to it then
+// An enclave expires once its expiration time has passed, or once it has been idle for longer than its idle timeout
+type EnclaveExpiration struct {
+	// Nil if the enclave has no time-to-live
+	expirationTime *time.Time
+
+	// Zero if the enclave never expires for being idle
+	idleTimeout time.Duration
+
+	action ExpiredEnclaveAction
+}
+
+func NewEnclaveExpiration(expirationTime *time.Time, idleTimeout time.Duration, action ExpiredEnclaveAction) *EnclaveExpiration {
+	return &EnclaveExpiration{expirationTime: expirationTime, idleTimeout: idleTimeout, action: action}
+}
+
+func (expiration *EnclaveExpiration) GetExpirationTime() *time.Time {
+	return expiration.expirationTime
+}
+
+func (expiration *EnclaveExpiration) GetIdleTimeout() time.Duration {
+	return expiration.idleTimeout
+}
+
+func (expiration *EnclaveExpiration) GetAction() ExpiredEnclaveAction {
+	return expiration.action
+}
@@ -0,0 +1,68 @@
+package enclave
+
+import (
+	"regexp"
+	"strings"
+
+	"github.com/kurtosis-tech/stacktrace"
+)
+
+const (
+	// Enclave labels end up as Docker labels and Kubernetes namespace labels, so they're restricted to what both accept:
+	// keys are lowercase because Docker label keys are, and both keys and values follow the Kubernetes label syntax
+	enclaveLabelKeyRegexStr   = "^[a-z0-9]([-a-z0-9_.]*[a-z0-9])?$"
+	enclaveLabelValueRegexStr = "^([A-Za-z0-9]([-A-Za-z0-9_.]*[A-Za-z0-9])?)?$"
+
+	maxEnclaveLabelKeyLength   = 63
+	maxEnclaveLabelValueLength = 63
+
+	enclaveLabelKeyValueSeparator = "="
+)
+
+var (
+	enclaveLabelKeyRegex   = regexp.MustCompile(enclaveLabelKeyRegexStr)
+	enclaveLabelValueRegex = regexp.MustCompile(enclaveLabelValueRegexStr)
+)
+
+// ValidateEnclaveLabels checks that the user-provided labels of an enclave can be stored on every backend
+func ValidateEnclaveLabels(labels map[string]string) error {
+	for key, value := range labels {
+		if len(key) > maxEnclaveLabelKeyLength || !enclaveLabelKeyRegex.MatchString(key) {
+			return stacktrace.NewError(
+				"Enclave label key '%v' is invalid; it must be at most %v characters long and match regex '%v'",
+				key,
+				maxEnclaveLabelKeyLength,
+				enclaveLabelKeyRegexStr,
+			)
+		}
+		if len(value) > maxEnclaveLabelValueLength || !enclaveLabelValueRegex.MatchString(value) {
+			return stacktrace.NewError(
+				"Value '%v' of enclave label '%v' is invalid; it must be at most %v characters long and match regex '%v'",
+				value,
+				key,
+				maxEnclaveLabelValueLength,
+				enclaveLabelValueRegexStr,
+			)
+		}
+	}
+	return nil
+}
+
+// ParseEnclaveLabels turns 'key=value' strings, as users write them on the CLI or in label selectors, into a labels map
+func ParseEnclaveLabels(keyValuePairs []string) (map[string]string, error) {
+	labels := map[string]string{}
+	for _, keyValuePair := range keyValuePairs {
+		key, value, found := strings.Cut(strings.TrimSpace(keyValuePair), enclaveLabelKeyValueSeparator)
+		if !found {
+			return nil, stacktrace.NewError("Enclave label '%v' isn't in the 'key%svalue' format", keyValuePair, enclaveLabelKeyValueSeparator)
+		}
+		if existingValue, found := labels[key]; found && existingValue != value {
+			return nil, stacktrace.NewError("Enclave label '%v' was given twice, with values '%v' and '%v'", key, existingValue, value)
+		}
+		labels[key] = value
+	}
+	if err := ValidateEnclaveLabels(labels); err != nil {
+		return nil, stacktrace.Propagate(err, "An error occurred validating the parsed enclave labels")
+	}
+	return labels, nil
+}
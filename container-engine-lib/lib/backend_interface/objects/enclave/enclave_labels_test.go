@@ -0,0 +1,44 @@
+package enclave
+
+import (
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestValidateEnclaveLabels_ValidLabels(t *testing.T) {
+	labels := map[string]string{
+		"team":       "infra",
+		"pr-number":  "1234",
+		"commit":     "2f4e6a8c0b1d3f5e7a9c",
+		"owner.name": "Jane_Doe",
+		"empty":      "",
+	}
+	require.NoError(t, ValidateEnclaveLabels(labels))
+}
+
+func TestValidateEnclaveLabels_InvalidKeys(t *testing.T) {
+	for _, key := range []string{"", "Team", "team=", "-team", "team-", "with/slash", strings.Repeat("a", 64)} {
+		require.Error(t, ValidateEnclaveLabels(map[string]string{key: "value"}), "Expected key '%v' to be invalid", key)
+	}
+}
+
+func TestValidateEnclaveLabels_InvalidValues(t *testing.T) {
+	for _, value := range []string{"with space", "-infra", "infra.", "a,b", strings.Repeat("a", 64)} {
+		require.Error(t, ValidateEnclaveLabels(map[string]string{"team": value}), "Expected value '%v' to be invalid", value)
+	}
+}
+
+func TestParseEnclaveLabels(t *testing.T) {
+	labels, err := ParseEnclaveLabels([]string{"team=infra", "pr-number=1234", "empty="})
+	require.NoError(t, err)
+	require.Equal(t, map[string]string{"team": "infra", "pr-number": "1234", "empty": ""}, labels)
+}
+
+func TestParseEnclaveLabels_InvalidPairs(t *testing.T) {
+	for _, keyValuePairs := range [][]string{{"team"}, {"Team=infra"}, {"team=infra", "team=core"}} {
+		_, err := ParseEnclaveLabels(keyValuePairs)
+		require.Error(t, err, "Expected '%v' to be invalid", keyValuePairs)
+	}
+}
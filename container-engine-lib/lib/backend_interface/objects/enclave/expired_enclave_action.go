@@ -0,0 +1,9 @@
+package enclave
+
+//go:generate go run github.com/dmarkham/enumer -trimprefix=ExpiredEnclaveAction_ -transform=snake-upper -type=ExpiredEnclaveAction
+type ExpiredEnclaveAction int
+
+const (
+	ExpiredEnclaveAction_Destroy ExpiredEnclaveAction = iota // The enclave gets destroyed, along with its logs
+	ExpiredEnclaveAction_Stop                                // The enclave gets stopped, so it can still be inspected or destroyed later
+)
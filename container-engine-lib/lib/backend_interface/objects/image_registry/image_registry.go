@@ -0,0 +1,30 @@
+package image_registry
+
+import (
+	"github.com/kurtosis-tech/kurtosis/container-engine-lib/lib/backend_interface/objects/container"
+)
+
+// ImageRegistry is the registry shared by all the enclaves of an engine, which the images built for services get pushed
+// to so they're only built once and can be pulled from anywhere the registry is reachable
+type ImageRegistry struct {
+	status container.ContainerStatus
+
+	// Host user service images are pulled from, e.g. 'localhost:9740'
+	// This will be empty if the registry is not running
+	maybeHost string
+}
+
+func NewImageRegistry(status container.ContainerStatus, maybeHost string) *ImageRegistry {
+	return &ImageRegistry{
+		status:    status,
+		maybeHost: maybeHost,
+	}
+}
+
+func (registry *ImageRegistry) GetStatus() container.ContainerStatus {
+	return registry.status
+}
+
+func (registry *ImageRegistry) GetHost() string {
+	return registry.maybeHost
+}
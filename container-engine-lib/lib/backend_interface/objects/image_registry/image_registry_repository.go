@@ -0,0 +1,23 @@
+package image_registry
+
+// ImageRegistryRepository is a repository of the image registry, along with the tags pushed to it
+type ImageRegistryRepository struct {
+	name string
+
+	tags []string
+}
+
+func NewImageRegistryRepository(name string, tags []string) *ImageRegistryRepository {
+	return &ImageRegistryRepository{
+		name: name,
+		tags: tags,
+	}
+}
+
+func (repository *ImageRegistryRepository) GetName() string {
+	return repository.name
+}
+
+func (repository *ImageRegistryRepository) GetTags() []string {
+	return repository.tags
+}
@@ -60,6 +60,10 @@ func (registration *ServiceRegistration) GetName() ServiceName {
 	return registration.privateServiceRegistration.Name
 }
 
+func (registration *ServiceRegistration) SetName(name ServiceName) {
+	registration.privateServiceRegistration.Name = name
+}
+
 func (registration *ServiceRegistration) GetUUID() ServiceUUID {
 	return registration.privateServiceRegistration.Uuid
 }
@@ -76,6 +80,10 @@ func (registration *ServiceRegistration) GetHostname() string {
 	return registration.privateServiceRegistration.Hostname
 }
 
+func (registration *ServiceRegistration) SetHostname(hostname string) {
+	registration.privateServiceRegistration.Hostname = hostname
+}
+
 func (registration *ServiceRegistration) GetStatus() ServiceStatus {
 	return registration.privateServiceRegistration.Status
 }
@@ -0,0 +1,69 @@
+package service
+
+// ServiceStats is a sample of the resources used by the container of a service, the network and disk ones being
+// totals since the container started. Backends that can't measure a resource leave it at zero
+type ServiceStats struct {
+	// Like in `docker stats`, 100 being one CPU fully used
+	cpuPercent float64
+
+	memoryUsageBytes uint64
+
+	// Zero if the container has no memory limit
+	memoryLimitBytes uint64
+
+	networkReceivedBytes uint64
+
+	networkSentBytes uint64
+
+	diskReadBytes uint64
+
+	diskWrittenBytes uint64
+}
+
+func NewServiceStats(
+	cpuPercent float64,
+	memoryUsageBytes uint64,
+	memoryLimitBytes uint64,
+	networkReceivedBytes uint64,
+	networkSentBytes uint64,
+	diskReadBytes uint64,
+	diskWrittenBytes uint64,
+) *ServiceStats {
+	return &ServiceStats{
+		cpuPercent:           cpuPercent,
+		memoryUsageBytes:     memoryUsageBytes,
+		memoryLimitBytes:     memoryLimitBytes,
+		networkReceivedBytes: networkReceivedBytes,
+		networkSentBytes:     networkSentBytes,
+		diskReadBytes:        diskReadBytes,
+		diskWrittenBytes:     diskWrittenBytes,
+	}
+}
+
+func (stats *ServiceStats) GetCpuPercent() float64 {
+	return stats.cpuPercent
+}
+
+func (stats *ServiceStats) GetMemoryUsageBytes() uint64 {
+	return stats.memoryUsageBytes
+}
+
+func (stats *ServiceStats) GetMemoryLimitBytes() uint64 {
+	return stats.memoryLimitBytes
+}
+
+func (stats *ServiceStats) GetNetworkReceivedBytes() uint64 {
+	return stats.networkReceivedBytes
+}
+
+func (stats *ServiceStats) GetNetworkSentBytes() uint64 {
+	return stats.networkSentBytes
+}
+
+func (stats *ServiceStats) GetDiskReadBytes() uint64 {
+	return stats.diskReadBytes
+}
+
+func (stats *ServiceStats) GetDiskWrittenBytes() uint64 {
+	return stats.diskWrittenBytes
+}
@@ -35,21 +35,7 @@ func GetOrCreateEnclaveDatabase(enclaveDatabaseDirpath string) (*EnclaveDB, erro
 	}
 
 	openDatabaseOnce.Do(func() {
-		enclaveDatabaseFilepath := path.Join(enclaveDatabaseDirpath, enclaveDbFileName)
-		databaseInstance, databaseOpenError = bolt.Open(enclaveDatabaseFilepath, readWritePermissionToDatabase, &bolt.Options{
-			Timeout:         timeOut, //to fail if any other process is locking the file
-			NoGrowSync:      false,
-			NoFreelistSync:  false,
-			FreelistType:    "",
-			ReadOnly:        false,
-			MmapFlags:       0,
-			InitialMmapSize: 0,
-			PageSize:        0,
-			NoSync:          false,
-			OpenFile:        nil,
-			Mlock:           false,
-			PreLoadFreelist: false,
-		})
+		databaseInstance, databaseOpenError = openDatabase(enclaveDatabaseDirpath)
 	})
 	if databaseOpenError != nil {
 		return nil, stacktrace.Propagate(databaseOpenError, "An error occurred while opening the enclave database")
@@ -58,6 +44,16 @@ func GetOrCreateEnclaveDatabase(enclaveDatabaseDirpath string) (*EnclaveDB, erro
 	return &EnclaveDB{databaseInstance}, nil
 }
 
+// OpenEnclaveDatabase opens a database that, unlike the process-wide one of GetOrCreateEnclaveDatabase, belongs to the
+// caller, who closes it; this lets a single process serve several enclaves
+func OpenEnclaveDatabase(enclaveDatabaseDirpath string) (*EnclaveDB, error) {
+	db, err := openDatabase(enclaveDatabaseDirpath)
+	if err != nil {
+		return nil, stacktrace.Propagate(err, "An error occurred while opening the enclave database in '%s'", enclaveDatabaseDirpath)
+	}
+	return &EnclaveDB{db}, nil
+}
+
 func EraseDatabase() error {
 	path := databaseInstance.Path()
 	err := databaseInstance.Close()
@@ -70,3 +66,21 @@ func EraseDatabase() error {
 	}
 	return nil
 }
+
+func openDatabase(enclaveDatabaseDirpath string) (*bolt.DB, error) {
+	enclaveDatabaseFilepath := path.Join(enclaveDatabaseDirpath, enclaveDbFileName)
+	return bolt.Open(enclaveDatabaseFilepath, readWritePermissionToDatabase, &bolt.Options{
+		Timeout:         timeOut, //to fail if any other process is locking the file
+		NoGrowSync:      false,
+		NoFreelistSync:  false,
+		FreelistType:    "",
+		ReadOnly:        false,
+		MmapFlags:       0,
+		InitialMmapSize: 0,
+		PageSize:        0,
+		NoSync:          false,
+		OpenFile:        nil,
+		Mlock:           false,
+		PreLoadFreelist: false,
+	})
+}
@@ -104,17 +104,23 @@ func (fileArtifactDb *FileArtifactPersisted) DeleteContentMd5(artifactName strin
 }
 
 func GetOrCreateNewFileArtifactsDb() (*FileArtifactPersisted, error) {
-	data := fileArtifactData{
-		map[string]string{},
-		map[string][]string{},
-		map[string][]byte{},
-	}
 	// using the noEnclaveDatabaseDirpath because at this point we know that the enclave database has been created, so we are getting it from this call
 	noEnclaveDatabaseDirpath := ""
 	db, err := enclave_db.GetOrCreateEnclaveDatabase(noEnclaveDatabaseDirpath)
 	if err != nil {
 		return nil, stacktrace.Propagate(err, "Failed to get enclave database")
 	}
+	return GetOrCreateNewFileArtifactsDbFromEnclaveDb(db)
+}
+
+// GetOrCreateNewFileArtifactsDbFromEnclaveDb is GetOrCreateNewFileArtifactsDb for an enclave database that isn't the
+// process-wide one
+func GetOrCreateNewFileArtifactsDbFromEnclaveDb(db *enclave_db.EnclaveDB) (*FileArtifactPersisted, error) {
+	data := fileArtifactData{
+		map[string]string{},
+		map[string][]string{},
+		map[string][]byte{},
+	}
 	fileArtifactPersisted, err := getFileArtifactsDbFromEnclaveDb(db, &data)
 	if err != nil {
 		return nil, stacktrace.Propagate(err, "Failed to hydrate pre-existing file artifacts")
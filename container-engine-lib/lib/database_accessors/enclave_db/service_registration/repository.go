@@ -272,6 +272,45 @@ func (repository *ServiceRegistrationRepository) UpdateStatusAndConfig(
 	return nil
 }
 
+// Rename moves the service registration to the new service name, along with its new hostname, returning the renamed registration
+// It fails if a service with the new name is already registered, leaving both registrations untouched
+func (repository *ServiceRegistrationRepository) Rename(
+	serviceName service.ServiceName,
+	newServiceName service.ServiceName,
+	newHostname string,
+) (*service.ServiceRegistration, error) {
+	var renamedServiceRegistration *service.ServiceRegistration
+
+	if err := repository.enclaveDb.Update(func(tx *bolt.Tx) error {
+		bucket := tx.Bucket(serviceRegistrationBucketName)
+
+		serviceRegistration, err := getServiceRegistrationFromBucket(bucket, serviceName)
+		if err != nil {
+			return stacktrace.Propagate(err, "An error occurred getting service registration for service '%s' from bucket with name '%s'", serviceName, serviceRegistrationBucketName)
+		}
+
+		if bucket.Get(getServiceNameKey(newServiceName)) != nil {
+			return stacktrace.NewError("Cannot rename service '%s' to '%s' because a service with that name is already registered", serviceName, newServiceName)
+		}
+
+		serviceRegistration.SetName(newServiceName)
+		serviceRegistration.SetHostname(newHostname)
+
+		if err := saveServiceRegistrationIntoTheBucket(bucket, newServiceName, serviceRegistration); err != nil {
+			return stacktrace.Propagate(err, "An error occurred saving service registration '%+v' for service '%s' in the service registration bucket", serviceRegistration, newServiceName)
+		}
+		if err := bucket.Delete(getServiceNameKey(serviceName)); err != nil {
+			return stacktrace.Propagate(err, "An error occurred deleting the service registration of service '%s' from the service registration bucket", serviceName)
+		}
+
+		renamedServiceRegistration = serviceRegistration
+		return nil
+	}); err != nil {
+		return nil, stacktrace.Propagate(err, "An error occurred while renaming service '%s' to '%s' in the service registration repository", serviceName, newServiceName)
+	}
+	return renamedServiceRegistration, nil
+}
+
 func (repository *ServiceRegistrationRepository) Delete(serviceName service.ServiceName) error {
 	if err := repository.enclaveDb.Update(func(tx *bolt.Tx) error {
 		bucket := tx.Bucket(serviceRegistrationBucketName)
@@ -187,6 +187,51 @@ func TestDelete_Success(t *testing.T) {
 	require.False(t, exist)
 }
 
+func TestRename_Success(t *testing.T) {
+	repository := getRepositoryForTest(t)
+
+	originalServiceRegistration := saveAndGetOneServiceRegistrationForTest(t, repository)
+	originalServiceName := originalServiceRegistration.GetName()
+
+	newServiceName := service.ServiceName("renamed-service")
+	renamedServiceRegistration, err := repository.Rename(originalServiceName, newServiceName, string(newServiceName))
+	require.NoError(t, err)
+	require.Equal(t, newServiceName, renamedServiceRegistration.GetName())
+	require.Equal(t, string(newServiceName), renamedServiceRegistration.GetHostname())
+
+	exist, err := repository.Exist(originalServiceName)
+	require.NoError(t, err)
+	require.False(t, exist)
+
+	serviceRegistrationFromRepository, err := repository.Get(newServiceName)
+	require.NoError(t, err)
+	require.Equal(t, renamedServiceRegistration, serviceRegistrationFromRepository)
+	require.Equal(t, originalServiceRegistration.GetUUID(), serviceRegistrationFromRepository.GetUUID())
+	require.Equal(t, originalServiceRegistration.GetPrivateIP(), serviceRegistrationFromRepository.GetPrivateIP())
+	require.Equal(t, originalServiceRegistration.GetConfig(), serviceRegistrationFromRepository.GetConfig())
+}
+
+func TestRename_FailsIfNewNameIsTaken(t *testing.T) {
+	repository := getRepositoryForTest(t)
+
+	serviceRegistrations, _ := getServiceRegistrationsForTest(t, 2)
+	for _, serviceRegistration := range serviceRegistrations {
+		require.NoError(t, repository.Save(serviceRegistration))
+	}
+	firstServiceName := service.ServiceName(fmt.Sprintf(serviceNameFormat, 0))
+	secondServiceName := service.ServiceName(fmt.Sprintf(serviceNameFormat, 1))
+
+	_, err := repository.Rename(firstServiceName, secondServiceName, string(secondServiceName))
+	require.Error(t, err)
+
+	firstServiceRegistration, err := repository.Get(firstServiceName)
+	require.NoError(t, err)
+	require.Equal(t, firstServiceName, firstServiceRegistration.GetName())
+	secondServiceRegistration, err := repository.Get(secondServiceName)
+	require.NoError(t, err)
+	require.Equal(t, secondServiceName, secondServiceRegistration.GetName())
+}
+
 func saveAndGetOneServiceRegistrationForTest(
 	t *testing.T,
 	repository *ServiceRegistrationRepository,
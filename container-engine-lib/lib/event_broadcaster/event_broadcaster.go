@@ -0,0 +1,61 @@
+package event_broadcaster
+
+import (
+	"sync"
+
+	"github.com/sirupsen/logrus"
+)
+
+const (
+	// How many events a subscriber can lag behind before it starts missing some
+	subscriberBufferSize = 100
+)
+
+// EventBroadcaster fans out the events it gets published to all its subscribers
+// Publishing never blocks: subscribers that don't keep up miss events instead of slowing the publisher down
+type EventBroadcaster[T any] struct {
+	mutex *sync.Mutex
+
+	subscribers map[chan T]bool
+}
+
+func NewEventBroadcaster[T any]() *EventBroadcaster[T] {
+	return &EventBroadcaster[T]{
+		mutex:       &sync.Mutex{},
+		subscribers: map[chan T]bool{},
+	}
+}
+
+// Subscribe returns a channel receiving the events published from now on, along with a function that unsubscribes and
+// closes the channel, which must be called once the subscriber is done
+func (broadcaster *EventBroadcaster[T]) Subscribe() (<-chan T, func()) {
+	broadcaster.mutex.Lock()
+	defer broadcaster.mutex.Unlock()
+
+	subscriber := make(chan T, subscriberBufferSize)
+	broadcaster.subscribers[subscriber] = true
+
+	unsubscribeOnce := &sync.Once{}
+	unsubscribe := func() {
+		unsubscribeOnce.Do(func() {
+			broadcaster.mutex.Lock()
+			defer broadcaster.mutex.Unlock()
+			delete(broadcaster.subscribers, subscriber)
+			close(subscriber)
+		})
+	}
+	return subscriber, unsubscribe
+}
+
+func (broadcaster *EventBroadcaster[T]) Publish(event T) {
+	broadcaster.mutex.Lock()
+	defer broadcaster.mutex.Unlock()
+
+	for subscriber := range broadcaster.subscribers {
+		select {
+		case subscriber <- event:
+		default:
+			logrus.Warnf("A subscriber isn't keeping up with the events, dropped event '%+v'", event)
+		}
+	}
+}
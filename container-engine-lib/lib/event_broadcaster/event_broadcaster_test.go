@@ -0,0 +1,46 @@
+package event_broadcaster
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestPublish_AllSubscribersGetTheEvent(t *testing.T) {
+	broadcaster := NewEventBroadcaster[string]()
+	firstSubscriber, unsubscribeFirst := broadcaster.Subscribe()
+	defer unsubscribeFirst()
+	secondSubscriber, unsubscribeSecond := broadcaster.Subscribe()
+	defer unsubscribeSecond()
+
+	broadcaster.Publish("event")
+
+	require.Equal(t, "event", <-firstSubscriber)
+	require.Equal(t, "event", <-secondSubscriber)
+}
+
+func TestPublish_UnsubscribedSubscriberDoesNotGetTheEvent(t *testing.T) {
+	broadcaster := NewEventBroadcaster[string]()
+	subscriber, unsubscribe := broadcaster.Subscribe()
+	unsubscribe()
+	// Unsubscribing twice is a no-op
+	unsubscribe()
+
+	broadcaster.Publish("event")
+
+	_, isChanOpen := <-subscriber
+	require.False(t, isChanOpen)
+}
+
+func TestPublish_DoesNotBlockOnSlowSubscriber(t *testing.T) {
+	broadcaster := NewEventBroadcaster[int]()
+	subscriber, unsubscribe := broadcaster.Subscribe()
+	defer unsubscribe()
+
+	for i := 0; i < subscriberBufferSize+10; i++ {
+		broadcaster.Publish(i)
+	}
+
+	require.Len(t, subscriber, subscriberBufferSize)
+	require.Equal(t, 0, <-subscriber)
+}
@@ -0,0 +1,23 @@
+package image_pull_progress
+
+import "context"
+
+// ReporterFunc receives the progress of an image pull spanning several nodes, every time one more node has the image
+type ReporterFunc func(imageName string, numNodesWithImage uint32, numNodesTotal uint32)
+
+type reporterContextKey struct{}
+
+// WithReporter returns a copy of the context carrying the reporter. Backends pulling an image on several nodes report
+// to it, which lets the caller surface the progress without the backend interface knowing about it
+func WithReporter(ctx context.Context, reporter ReporterFunc) context.Context {
+	return context.WithValue(ctx, reporterContextKey{}, reporter)
+}
+
+// Report forwards the progress to the reporter of the context, if any
+func Report(ctx context.Context, imageName string, numNodesWithImage uint32, numNodesTotal uint32) {
+	reporter, found := ctx.Value(reporterContextKey{}).(ReporterFunc)
+	if !found || reporter == nil {
+		return
+	}
+	reporter(imageName, numNodesWithImage, numNodesTotal)
+}
@@ -0,0 +1,29 @@
+package image_pull_progress
+
+import (
+	"context"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestReport(t *testing.T) {
+	var reportedImage string
+	var reportedNumNodesWithImage, reportedNumNodesTotal uint32
+	ctx := WithReporter(context.Background(), func(imageName string, numNodesWithImage uint32, numNodesTotal uint32) {
+		reportedImage = imageName
+		reportedNumNodesWithImage = numNodesWithImage
+		reportedNumNodesTotal = numNodesTotal
+	})
+
+	Report(ctx, "postgres:16", 2, 3)
+	require.Equal(t, "postgres:16", reportedImage)
+	require.Equal(t, uint32(2), reportedNumNodesWithImage)
+	require.Equal(t, uint32(3), reportedNumNodesTotal)
+}
+
+func TestReport_NoReporterIsNoOp(t *testing.T) {
+	require.NotPanics(t, func() {
+		Report(context.Background(), "postgres:16", 2, 3)
+	})
+}
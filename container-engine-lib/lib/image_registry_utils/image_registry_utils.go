@@ -0,0 +1,291 @@
+package image_registry_utils
+
+import (
+	"context"
+	"crypto/sha256"
+	"encoding/json"
+	"fmt"
+	"io"
+	"io/fs"
+	"os"
+	"path/filepath"
+	"sort"
+	"strings"
+
+	"github.com/kurtosis-tech/kurtosis/container-engine-lib/lib/backend_interface/objects/image_registry"
+	"github.com/kurtosis-tech/stacktrace"
+)
+
+const (
+	// The engine image registry runs the same registry image as the registries started in enclaves on Kubernetes
+	ImageRegistryImage          = "registry:2.8.3"
+	ImageRegistryPortNum        = uint16(5000)
+	ImageRegistryStorageDirpath = "/var/lib/registry"
+
+	configDirpath  = "/etc/kurtosis-registry"
+	configFilepath = configDirpath + "/config.yml"
+	// Unlike the default configuration of the image, this one leaves the blob descriptor cache out so the repositories
+	// deleted from the storage when pruning are never served again from the cache
+	configFileContent = `version: 0.1
+log:
+  level: info
+storage:
+  filesystem:
+    rootdirectory: ` + ImageRegistryStorageDirpath + `
+  delete:
+    enabled: true
+http:
+  addr: :5000
+`
+	repositoriesDirpath = ImageRegistryStorageDirpath + "/docker/registry/v2/repositories"
+
+	catalogUrl        = "http://localhost:5000/v2/_catalog?n=10000"
+	tagsListUrlFormat = "http://localhost:5000/v2/%v/tags/list"
+
+	shellCmd     = "sh"
+	shellCmdFlag = "-c"
+	// Names the scripts show up as in the process list of the registry container, passed as $0 of the scripts
+	registryScriptName = "kurtosis-image-registry"
+	pruneScriptName    = "kurtosis-image-registry-prune"
+
+	digestSeparator        = "@"
+	tagSeparator           = ":"
+	referencePathSeparator = "/"
+
+	// Built images are pushed with a tag derived from what they were built from, so a build whose inputs didn't change
+	// finds the image in the registry rather than building it again
+	contentHashTagFormat = "content-%x"
+	// Separates the inputs of the hash so that moving bytes from one to the next changes the hash
+	contentHashFieldSeparator = "\x00"
+)
+
+// CommandRunner runs the command in the image registry container, returning its output and an error if the command
+// didn't exit successfully
+type CommandRunner func(ctx context.Context, command []string) (string, error)
+
+type catalogResponse struct {
+	Repositories []string `json:"repositories"`
+}
+
+type tagsListResponse struct {
+	Name string   `json:"name"`
+	Tags []string `json:"tags"`
+}
+
+// GetImageRegistryCommand returns the command to run the image registry container with, replacing the entrypoint of the
+// image so the registry is started with the Kurtosis configuration
+func GetImageRegistryCommand() []string {
+	script := fmt.Sprintf(`mkdir -p '%v' && printf '%%s' "$1" > '%v' && exec registry serve '%v'`, configDirpath, configFilepath, configFilepath)
+	return []string{shellCmd, shellCmdFlag, script, registryScriptName, configFileContent}
+}
+
+// GetImageReference returns the reference of the image once pushed to the registry
+func GetImageReference(registryHost string, imageName string) string {
+	return registryHost + referencePathSeparator + imageName
+}
+
+// GetRepositoryName returns the repository of the registry the image reference points to, if it points to the registry
+func GetRepositoryName(registryHost string, imageReference string) (string, bool) {
+	imagePath, found := strings.CutPrefix(imageReference, registryHost+referencePathSeparator)
+	if !found {
+		return "", false
+	}
+	repositoryName := getUntaggedImagePath(imagePath)
+	return repositoryName, repositoryName != ""
+}
+
+// GetContentHash returns the hash of the files of the build context, along with the parameters of the build that aren't
+// part of the build context, like the build arguments
+func GetContentHash(buildContextDirpath string, buildParameters []string) (string, error) {
+	hasher := sha256.New()
+	for _, buildParameter := range buildParameters {
+		hasher.Write([]byte(buildParameter + contentHashFieldSeparator))
+	}
+
+	// The files are walked in lexical order, so the same build context always hashes the same way
+	err := filepath.WalkDir(buildContextDirpath, func(walkedFilepath string, entry fs.DirEntry, err error) error {
+		if err != nil {
+			return err
+		}
+		relativeFilepath, err := getRelativeFilepath(buildContextDirpath, walkedFilepath)
+		if err != nil {
+			return err
+		}
+		info, err := entry.Info()
+		if err != nil {
+			return stacktrace.Propagate(err, "An error occurred getting the info of file '%v'", walkedFilepath)
+		}
+		hasher.Write([]byte(relativeFilepath + contentHashFieldSeparator + info.Mode().String() + contentHashFieldSeparator))
+
+		switch {
+		case info.Mode()&fs.ModeSymlink != 0:
+			linkTarget, err := os.Readlink(walkedFilepath)
+			if err != nil {
+				return stacktrace.Propagate(err, "An error occurred reading the target of symlink '%v'", walkedFilepath)
+			}
+			hasher.Write([]byte(linkTarget + contentHashFieldSeparator))
+		case info.Mode().IsRegular():
+			if err := hashFileContent(hasher, walkedFilepath); err != nil {
+				return stacktrace.Propagate(err, "An error occurred hashing the content of file '%v'", walkedFilepath)
+			}
+		}
+		return nil
+	})
+	if err != nil {
+		return "", stacktrace.Propagate(err, "An error occurred hashing build context '%v'", buildContextDirpath)
+	}
+	return fmt.Sprintf(contentHashTagFormat, hasher.Sum(nil)), nil
+}
+
+// GetContentHashImageReference returns the reference the built image gets pushed to the registry with, in the
+// repository of the image but tagged with the content hash of what it was built from
+func GetContentHashImageReference(registryHost string, imageName string, contentHash string) string {
+	return GetImageReference(registryHost, getUntaggedImagePath(imageName)) + tagSeparator + contentHash
+}
+
+// HasContentHashImage returns whether the registry holds the image built from content with the hash, pushed to the
+// reference GetContentHashImageReference returns
+func HasContentHashImage(ctx context.Context, runCommand CommandRunner, imageName string, contentHash string) (bool, error) {
+	repositoryName := getUntaggedImagePath(imageName)
+	// The registry answers with an error status when the repository doesn't exist, which is no different from a
+	// repository without the tag
+	output, err := runCommand(ctx, getFetchUrlIfFoundCommand(fmt.Sprintf(tagsListUrlFormat, repositoryName)))
+	if err != nil {
+		return false, stacktrace.Propagate(err, "An error occurred getting the tags of repository '%v' of the image registry", repositoryName)
+	}
+	if output == "" {
+		return false, nil
+	}
+	tagsList := new(tagsListResponse)
+	if err := json.Unmarshal([]byte(output), tagsList); err != nil {
+		return false, stacktrace.Propagate(err, "An error occurred parsing the tags of repository '%v' of the image registry from '%v'", repositoryName, output)
+	}
+	for _, tag := range tagsList.Tags {
+		if tag == contentHash {
+			return true, nil
+		}
+	}
+	return false, nil
+}
+
+// ListRepositories returns the repositories of the registry, sorted by name
+func ListRepositories(ctx context.Context, runCommand CommandRunner) ([]*image_registry.ImageRegistryRepository, error) {
+	repositoryNames, err := getRepositoryNames(ctx, runCommand)
+	if err != nil {
+		return nil, stacktrace.Propagate(err, "An error occurred getting the repositories of the image registry")
+	}
+
+	repositories := []*image_registry.ImageRegistryRepository{}
+	for _, repositoryName := range repositoryNames {
+		output, err := runCommand(ctx, getFetchUrlCommand(fmt.Sprintf(tagsListUrlFormat, repositoryName)))
+		if err != nil {
+			return nil, stacktrace.Propagate(err, "An error occurred getting the tags of repository '%v' of the image registry", repositoryName)
+		}
+		tagsList := new(tagsListResponse)
+		if err := json.Unmarshal([]byte(output), tagsList); err != nil {
+			return nil, stacktrace.Propagate(err, "An error occurred parsing the tags of repository '%v' of the image registry from '%v'", repositoryName, output)
+		}
+		sort.Strings(tagsList.Tags)
+		repositories = append(repositories, image_registry.NewImageRegistryRepository(repositoryName, tagsList.Tags))
+	}
+	return repositories, nil
+}
+
+// PruneRepositories deletes the repositories of the registry none of the used images come from, along with the blobs
+// only they referenced, and returns their names
+func PruneRepositories(ctx context.Context, runCommand CommandRunner, registryHost string, usedImages []string) ([]string, error) {
+	repositoryNames, err := getRepositoryNames(ctx, runCommand)
+	if err != nil {
+		return nil, stacktrace.Propagate(err, "An error occurred getting the repositories of the image registry")
+	}
+
+	unusedRepositoryNames := getUnusedRepositoryNames(repositoryNames, registryHost, usedImages)
+	if len(unusedRepositoryNames) == 0 {
+		return unusedRepositoryNames, nil
+	}
+	if _, err := runCommand(ctx, getDeleteRepositoriesCommand(unusedRepositoryNames)); err != nil {
+		return nil, stacktrace.Propagate(err, "An error occurred deleting repositories '%v' from the image registry", unusedRepositoryNames)
+	}
+	return unusedRepositoryNames, nil
+}
+
+// ====================================================================================================
+//
+//	Private Helper Functions
+//
+// ====================================================================================================
+
+func getRepositoryNames(ctx context.Context, runCommand CommandRunner) ([]string, error) {
+	output, err := runCommand(ctx, getFetchUrlCommand(catalogUrl))
+	if err != nil {
+		return nil, stacktrace.Propagate(err, "An error occurred getting the catalog of the image registry")
+	}
+	catalog := new(catalogResponse)
+	if err := json.Unmarshal([]byte(output), catalog); err != nil {
+		return nil, stacktrace.Propagate(err, "An error occurred parsing the catalog of the image registry from '%v'", output)
+	}
+	sort.Strings(catalog.Repositories)
+	return catalog.Repositories, nil
+}
+
+func getUnusedRepositoryNames(repositoryNames []string, registryHost string, usedImages []string) []string {
+	usedRepositoryNames := map[string]bool{}
+	for _, usedImage := range usedImages {
+		if repositoryName, found := GetRepositoryName(registryHost, usedImage); found {
+			usedRepositoryNames[repositoryName] = true
+		}
+	}
+	unusedRepositoryNames := []string{}
+	for _, repositoryName := range repositoryNames {
+		if !usedRepositoryNames[repositoryName] {
+			unusedRepositoryNames = append(unusedRepositoryNames, repositoryName)
+		}
+	}
+	return unusedRepositoryNames
+}
+
+// The registry image is Alpine based, so it ships the BusyBox wget rather than curl
+func getFetchUrlCommand(url string) []string {
+	return []string{"wget", "-q", "-O", "-", url}
+}
+
+// Same as getFetchUrlCommand, but outputs nothing rather than failing when the registry answers with an error status
+func getFetchUrlIfFoundCommand(url string) []string {
+	return []string{shellCmd, shellCmdFlag, `wget -q -O - "$1" || true`, registryScriptName, url}
+}
+
+func getUntaggedImagePath(imagePath string) string {
+	imagePath, _, _ = strings.Cut(imagePath, digestSeparator)
+	// Only a colon after the last path separator introduces a tag
+	lastPathSeparatorIdx := strings.LastIndex(imagePath, referencePathSeparator)
+	if tagSeparatorIdx := strings.LastIndex(imagePath, tagSeparator); tagSeparatorIdx > lastPathSeparatorIdx {
+		imagePath = imagePath[:tagSeparatorIdx]
+	}
+	return imagePath
+}
+
+func getRelativeFilepath(basepath string, filepathToRelativize string) (string, error) {
+	relativeFilepath, err := filepath.Rel(basepath, filepathToRelativize)
+	if err != nil {
+		return "", stacktrace.Propagate(err, "An error occurred getting the path of file '%v' relative to '%v'", filepathToRelativize, basepath)
+	}
+	return filepath.ToSlash(relativeFilepath), nil
+}
+
+func hashFileContent(hasher io.Writer, fileToHashFilepath string) error {
+	file, err := os.Open(fileToHashFilepath)
+	if err != nil {
+		return stacktrace.Propagate(err, "An error occurred opening file '%v'", fileToHashFilepath)
+	}
+	defer file.Close()
+	if _, err := io.Copy(hasher, file); err != nil {
+		return stacktrace.Propagate(err, "An error occurred reading file '%v'", fileToHashFilepath)
+	}
+	return nil
+}
+
+// The repositories are passed as arguments of the script rather than formatted into it so their names never need quoting
+func getDeleteRepositoriesCommand(repositoryNames []string) []string {
+	script := fmt.Sprintf(`for repository in "$@"; do rm -rf "%v/$repository"; done && registry garbage-collect '%v'`, repositoriesDirpath, configFilepath)
+	return append([]string{shellCmd, shellCmdFlag, script, pruneScriptName}, repositoryNames...)
+}
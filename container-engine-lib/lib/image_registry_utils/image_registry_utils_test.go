@@ -0,0 +1,142 @@
+package image_registry_utils
+
+import (
+	"context"
+	"os"
+	"path"
+	"strings"
+	"testing"
+
+	"github.com/kurtosis-tech/stacktrace"
+	"github.com/stretchr/testify/require"
+)
+
+const (
+	testRegistryHost = "localhost:9740"
+)
+
+func TestGetRepositoryName(t *testing.T) {
+	repositoryName, found := GetRepositoryName(testRegistryHost, "localhost:9740/my-image@sha256:1234")
+	require.True(t, found)
+	require.Equal(t, "my-image", repositoryName)
+
+	repositoryName, found = GetRepositoryName(testRegistryHost, "localhost:9740/org/my-image:1.0.0")
+	require.True(t, found)
+	require.Equal(t, "org/my-image", repositoryName)
+
+	_, found = GetRepositoryName(testRegistryHost, "org/my-image:1.0.0")
+	require.False(t, found)
+
+	// another registry listening on a port starting the same way
+	_, found = GetRepositoryName(testRegistryHost, "localhost:97401/my-image")
+	require.False(t, found)
+}
+
+func TestListRepositories(t *testing.T) {
+	runCommand := newTestCommandRunner(map[string]string{
+		catalogUrl:                 "{\"repositories\":[\"org/my-image\",\"another-image\"]}",
+		"/another-image/tags/list": "{\"name\":\"another-image\",\"tags\":[\"latest\"]}",
+		"/org/my-image/tags/list":  "{\"name\":\"org/my-image\",\"tags\":[\"2.0.0\",\"1.0.0\"]}",
+	}, nil)
+
+	repositories, err := ListRepositories(context.Background(), runCommand)
+	require.NoError(t, err)
+	require.Len(t, repositories, 2)
+	require.Equal(t, "another-image", repositories[0].GetName())
+	require.Equal(t, []string{"latest"}, repositories[0].GetTags())
+	require.Equal(t, "org/my-image", repositories[1].GetName())
+	require.Equal(t, []string{"1.0.0", "2.0.0"}, repositories[1].GetTags())
+}
+
+func TestPruneRepositories(t *testing.T) {
+	var deleteCommand []string
+	runCommand := newTestCommandRunner(map[string]string{
+		catalogUrl: "{\"repositories\":[\"used-image\",\"unused-image\",\"org/unused-image\"]}",
+	}, &deleteCommand)
+
+	usedImages := []string{
+		"localhost:9740/used-image@sha256:1234",
+		"postgres:16",
+	}
+	prunedRepositories, err := PruneRepositories(context.Background(), runCommand, testRegistryHost, usedImages)
+	require.NoError(t, err)
+	require.Equal(t, []string{"org/unused-image", "unused-image"}, prunedRepositories)
+	require.Equal(t, []string{"org/unused-image", "unused-image"}, deleteCommand[4:])
+}
+
+func TestPruneRepositories_NothingToPrune(t *testing.T) {
+	var deleteCommand []string
+	runCommand := newTestCommandRunner(map[string]string{
+		catalogUrl: "{\"repositories\":[\"used-image\"]}",
+	}, &deleteCommand)
+
+	prunedRepositories, err := PruneRepositories(context.Background(), runCommand, testRegistryHost, []string{"localhost:9740/used-image:latest"})
+	require.NoError(t, err)
+	require.Empty(t, prunedRepositories)
+	require.Nil(t, deleteCommand)
+}
+
+func TestGetContentHash(t *testing.T) {
+	buildContextDirpath := t.TempDir()
+	require.NoError(t, os.WriteFile(path.Join(buildContextDirpath, "Dockerfile"), []byte("FROM alpine"), 0644))
+	require.NoError(t, os.Mkdir(path.Join(buildContextDirpath, "src"), 0755))
+	require.NoError(t, os.WriteFile(path.Join(buildContextDirpath, "src", "main.go"), []byte("package main"), 0644))
+
+	contentHash, err := GetContentHash(buildContextDirpath, []string{"Dockerfile", "ARG=1"})
+	require.NoError(t, err)
+	require.True(t, strings.HasPrefix(contentHash, "content-"))
+
+	sameContentHash, err := GetContentHash(buildContextDirpath, []string{"Dockerfile", "ARG=1"})
+	require.NoError(t, err)
+	require.Equal(t, contentHash, sameContentHash)
+
+	otherParametersContentHash, err := GetContentHash(buildContextDirpath, []string{"Dockerfile", "ARG=2"})
+	require.NoError(t, err)
+	require.NotEqual(t, contentHash, otherParametersContentHash)
+
+	require.NoError(t, os.WriteFile(path.Join(buildContextDirpath, "src", "main.go"), []byte("package other"), 0644))
+	otherContentContentHash, err := GetContentHash(buildContextDirpath, []string{"Dockerfile", "ARG=1"})
+	require.NoError(t, err)
+	require.NotEqual(t, contentHash, otherContentContentHash)
+}
+
+func TestGetContentHashImageReference(t *testing.T) {
+	require.Equal(t, "localhost:9740/my-image:content-1234", GetContentHashImageReference(testRegistryHost, "my-image:latest", "content-1234"))
+	require.Equal(t, "localhost:9740/org/my-image:content-1234", GetContentHashImageReference(testRegistryHost, "org/my-image", "content-1234"))
+}
+
+func TestHasContentHashImage(t *testing.T) {
+	runCommand := newTestCommandRunner(map[string]string{
+		"/my-image/tags/list":      "{\"name\":\"my-image\",\"tags\":[\"latest\",\"content-1234\"]}",
+		"/missing-image/tags/list": "",
+	}, nil)
+
+	found, err := HasContentHashImage(context.Background(), runCommand, "my-image:latest", "content-1234")
+	require.NoError(t, err)
+	require.True(t, found)
+
+	found, err = HasContentHashImage(context.Background(), runCommand, "my-image:latest", "content-5678")
+	require.NoError(t, err)
+	require.False(t, found)
+
+	found, err = HasContentHashImage(context.Background(), runCommand, "missing-image", "content-1234")
+	require.NoError(t, err)
+	require.False(t, found)
+}
+
+// newTestCommandRunner answers the fetches of the URLs ending with the given suffixes, and records the delete command
+func newTestCommandRunner(outputsByUrlSuffix map[string]string, deleteCommand *[]string) CommandRunner {
+	return func(ctx context.Context, command []string) (string, error) {
+		if command[0] == shellCmd && command[3] == pruneScriptName {
+			*deleteCommand = command
+			return "", nil
+		}
+		url := command[len(command)-1]
+		for urlSuffix, output := range outputsByUrlSuffix {
+			if strings.HasSuffix(url, urlSuffix) {
+				return output, nil
+			}
+		}
+		return "", stacktrace.NewError("Unexpected URL '%v'", url)
+	}
+}
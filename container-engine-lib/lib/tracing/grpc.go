@@ -0,0 +1,79 @@
+package tracing
+
+import (
+	"context"
+	"fmt"
+
+	"go.opentelemetry.io/contrib/instrumentation/google.golang.org/grpc/otelgrpc"
+	"google.golang.org/grpc"
+)
+
+const (
+	grpcFullMethodNameFormat = "/%s/%s"
+)
+
+type methodHandler = func(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error)
+
+// UnaryClientInterceptor propagates the span of the context to the gRPC server being called, so that the spans it
+// creates belong to the same trace
+func UnaryClientInterceptor() grpc.UnaryClientInterceptor {
+	return otelgrpc.UnaryClientInterceptor()
+}
+
+// StreamClientInterceptor is the streaming counterpart of UnaryClientInterceptor
+func StreamClientInterceptor() grpc.StreamClientInterceptor {
+	return otelgrpc.StreamClientInterceptor()
+}
+
+// WrapServiceDesc returns a copy of the service description whose handlers create a server span per call, child of
+// the span the caller propagated if any
+func WrapServiceDesc(serviceDesc *grpc.ServiceDesc) *grpc.ServiceDesc {
+	wrappedServiceDesc := *serviceDesc
+
+	unaryServerInterceptor := otelgrpc.UnaryServerInterceptor()
+	wrappedServiceDesc.Methods = []grpc.MethodDesc{}
+	for _, methodDesc := range serviceDesc.Methods {
+		methodDesc.Handler = wrapMethodHandler(methodDesc.Handler, unaryServerInterceptor)
+		wrappedServiceDesc.Methods = append(wrappedServiceDesc.Methods, methodDesc)
+	}
+
+	streamServerInterceptor := otelgrpc.StreamServerInterceptor()
+	wrappedServiceDesc.Streams = []grpc.StreamDesc{}
+	for _, streamDesc := range serviceDesc.Streams {
+		streamServerInfo := &grpc.StreamServerInfo{
+			FullMethod:     getGrpcFullMethodName(serviceDesc.ServiceName, streamDesc.StreamName),
+			IsClientStream: streamDesc.ClientStreams,
+			IsServerStream: streamDesc.ServerStreams,
+		}
+		streamDesc.Handler = wrapStreamHandler(streamDesc.Handler, streamServerInfo, streamServerInterceptor)
+		wrappedServiceDesc.Streams = append(wrappedServiceDesc.Streams, streamDesc)
+	}
+
+	return &wrappedServiceDesc
+}
+
+// The generated handlers only hand the method info over through the interceptor, so the tracing interceptor is
+// chained in front of the one the server was given, if any
+func wrapMethodHandler(handler methodHandler, tracingInterceptor grpc.UnaryServerInterceptor) methodHandler {
+	return func(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+		chainedInterceptor := func(ctx context.Context, req interface{}, info *grpc.UnaryServerInfo, next grpc.UnaryHandler) (interface{}, error) {
+			if interceptor == nil {
+				return tracingInterceptor(ctx, req, info, next)
+			}
+			return tracingInterceptor(ctx, req, info, func(ctx context.Context, req interface{}) (interface{}, error) {
+				return interceptor(ctx, req, info, next)
+			})
+		}
+		return handler(srv, ctx, dec, chainedInterceptor)
+	}
+}
+
+func wrapStreamHandler(handler grpc.StreamHandler, info *grpc.StreamServerInfo, tracingInterceptor grpc.StreamServerInterceptor) grpc.StreamHandler {
+	return func(srv interface{}, stream grpc.ServerStream) error {
+		return tracingInterceptor(srv, stream, info, handler)
+	}
+}
+
+func getGrpcFullMethodName(serviceName string, methodName string) string {
+	return fmt.Sprintf(grpcFullMethodNameFormat, serviceName, methodName)
+}
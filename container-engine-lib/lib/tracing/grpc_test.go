@@ -0,0 +1,63 @@
+package tracing
+
+import (
+	"context"
+	"net"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+	"go.opentelemetry.io/otel"
+	"go.opentelemetry.io/otel/trace"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/credentials/insecure"
+	"google.golang.org/grpc/health"
+	"google.golang.org/grpc/health/grpc_health_v1"
+)
+
+const (
+	healthCheckSpanName = "grpc.health.v1.Health/Check"
+)
+
+func TestWrapServiceDesc_ContinuesTraceOfCaller(t *testing.T) {
+	collector, collectorAddress := startInProcessCollector(t)
+	ctx := context.Background()
+
+	shutdownFunc, err := InitTracerProvider(ctx, collectorAddress, testServiceName, testServiceVersion)
+	require.NoError(t, err)
+	defer otel.SetTracerProvider(trace.NewNoopTracerProvider())
+
+	listener, err := net.Listen("tcp", localhostListenAddress)
+	require.NoError(t, err)
+	grpcServer := grpc.NewServer()
+	grpcServer.RegisterService(WrapServiceDesc(&grpc_health_v1.Health_ServiceDesc), health.NewServer())
+	go func() {
+		_ = grpcServer.Serve(listener)
+	}()
+	defer grpcServer.Stop()
+
+	conn, err := grpc.Dial(
+		listener.Addr().String(),
+		grpc.WithTransportCredentials(insecure.NewCredentials()),
+		grpc.WithUnaryInterceptor(UnaryClientInterceptor()),
+	)
+	require.NoError(t, err)
+	defer conn.Close()
+
+	callerCtx, callerSpan := StartSpan(ctx, "caller")
+	_, err = grpc_health_v1.NewHealthClient(conn).Check(callerCtx, &grpc_health_v1.HealthCheckRequest{Service: ""})
+	require.NoError(t, err)
+	EndSpan(callerSpan, nil)
+	require.NoError(t, shutdownFunc(ctx))
+
+	var checkSpanTraceIds [][]byte
+	for _, span := range collector.spans {
+		if span.GetName() == healthCheckSpanName {
+			checkSpanTraceIds = append(checkSpanTraceIds, span.GetTraceId())
+		}
+	}
+	// one span for the client side of the call, and one for the server side
+	require.Len(t, checkSpanTraceIds, 2)
+	for _, traceId := range checkSpanTraceIds {
+		require.Equal(t, collector.getSpansByName()["caller"].GetTraceId(), traceId)
+	}
+}
@@ -0,0 +1,95 @@
+package tracing
+
+import (
+	"context"
+	"net/url"
+	"strings"
+
+	"github.com/kurtosis-tech/stacktrace"
+	"go.opentelemetry.io/otel"
+	"go.opentelemetry.io/otel/attribute"
+	"go.opentelemetry.io/otel/codes"
+	"go.opentelemetry.io/otel/exporters/otlp/otlptrace/otlptracegrpc"
+	"go.opentelemetry.io/otel/propagation"
+	"go.opentelemetry.io/otel/sdk/resource"
+	sdktrace "go.opentelemetry.io/otel/sdk/trace"
+	semconv "go.opentelemetry.io/otel/semconv/v1.17.0"
+	"go.opentelemetry.io/otel/trace"
+)
+
+const (
+	tracerName = "github.com/kurtosis-tech/kurtosis"
+
+	endpointSchemeSeparator = "://"
+	secureEndpointScheme    = "https"
+)
+
+// ShutdownFunc flushes the spans that haven't been exported yet and stops the exporter
+type ShutdownFunc func(ctx context.Context) error
+
+// InitTracerProvider registers the global tracer provider exporting the spans to the OTLP gRPC endpoint. The endpoint
+// is either 'host:port' or a URL, in which case TLS is only used for the 'https' scheme.
+// If the endpoint is empty, tracing stays disabled and spans are no-ops
+func InitTracerProvider(ctx context.Context, otlpEndpoint string, serviceName string, serviceVersion string) (ShutdownFunc, error) {
+	if otlpEndpoint == "" {
+		return func(ctx context.Context) error { return nil }, nil
+	}
+
+	exporterOpts, err := getExporterOptions(otlpEndpoint)
+	if err != nil {
+		return nil, stacktrace.Propagate(err, "An error occurred parsing OTLP endpoint '%s'", otlpEndpoint)
+	}
+	exporter, err := otlptracegrpc.New(ctx, exporterOpts...)
+	if err != nil {
+		return nil, stacktrace.Propagate(err, "An error occurred creating the OTLP trace exporter for endpoint '%s'", otlpEndpoint)
+	}
+
+	tracerProvider := sdktrace.NewTracerProvider(
+		sdktrace.WithBatcher(exporter),
+		sdktrace.WithResource(resource.NewWithAttributes(
+			semconv.SchemaURL,
+			semconv.ServiceName(serviceName),
+			semconv.ServiceVersion(serviceVersion),
+		)),
+	)
+	otel.SetTracerProvider(tracerProvider)
+	otel.SetTextMapPropagator(propagation.TraceContext{})
+	return tracerProvider.Shutdown, nil
+}
+
+// StartSpan starts a span, child of the span in the context if any. The span is a no-op if tracing is disabled
+func StartSpan(ctx context.Context, spanName string, attributes ...attribute.KeyValue) (context.Context, trace.Span) {
+	return otel.Tracer(tracerName).Start(ctx, spanName, trace.WithAttributes(attributes...))
+}
+
+// EndSpan marks the span as failed if the error is not nil, and ends it
+func EndSpan(span trace.Span, err error) {
+	if err != nil {
+		span.RecordError(err)
+		span.SetStatus(codes.Error, err.Error())
+	}
+	span.End()
+}
+
+func getExporterOptions(otlpEndpoint string) ([]otlptracegrpc.Option, error) {
+	if !strings.Contains(otlpEndpoint, endpointSchemeSeparator) {
+		return []otlptracegrpc.Option{
+			otlptracegrpc.WithEndpoint(otlpEndpoint),
+			otlptracegrpc.WithInsecure(),
+		}, nil
+	}
+	parsedEndpoint, err := url.Parse(otlpEndpoint)
+	if err != nil {
+		return nil, stacktrace.Propagate(err, "An error occurred parsing the endpoint as a URL")
+	}
+	if parsedEndpoint.Host == "" {
+		return nil, stacktrace.NewError("The endpoint URL has no host")
+	}
+	opts := []otlptracegrpc.Option{
+		otlptracegrpc.WithEndpoint(parsedEndpoint.Host),
+	}
+	if parsedEndpoint.Scheme != secureEndpointScheme {
+		opts = append(opts, otlptracegrpc.WithInsecure())
+	}
+	return opts, nil
+}
@@ -0,0 +1,123 @@
+package tracing
+
+import (
+	"context"
+	"errors"
+	"net"
+	"sync"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+	"go.opentelemetry.io/otel"
+	"go.opentelemetry.io/otel/attribute"
+	"go.opentelemetry.io/otel/trace"
+	collectortrace "go.opentelemetry.io/proto/otlp/collector/trace/v1"
+	tracev1 "go.opentelemetry.io/proto/otlp/trace/v1"
+	"google.golang.org/grpc"
+)
+
+const (
+	localhostListenAddress = "127.0.0.1:0"
+
+	testServiceName    = "test-service"
+	testServiceVersion = "0.0.0"
+)
+
+// inProcessCollector is a stand-in for an OTLP collector, keeping the spans it receives in memory
+type inProcessCollector struct {
+	collectortrace.UnimplementedTraceServiceServer
+
+	mutex *sync.Mutex
+	spans []*tracev1.Span
+}
+
+func (collector *inProcessCollector) Export(_ context.Context, request *collectortrace.ExportTraceServiceRequest) (*collectortrace.ExportTraceServiceResponse, error) {
+	collector.mutex.Lock()
+	defer collector.mutex.Unlock()
+	for _, resourceSpans := range request.GetResourceSpans() {
+		for _, scopeSpans := range resourceSpans.GetScopeSpans() {
+			collector.spans = append(collector.spans, scopeSpans.GetSpans()...)
+		}
+	}
+	return &collectortrace.ExportTraceServiceResponse{PartialSuccess: nil}, nil
+}
+
+func (collector *inProcessCollector) getSpansByName() map[string]*tracev1.Span {
+	collector.mutex.Lock()
+	defer collector.mutex.Unlock()
+	spansByName := map[string]*tracev1.Span{}
+	for _, span := range collector.spans {
+		spansByName[span.GetName()] = span
+	}
+	return spansByName
+}
+
+func startInProcessCollector(t *testing.T) (*inProcessCollector, string) {
+	listener, err := net.Listen("tcp", localhostListenAddress)
+	require.NoError(t, err)
+	collector := &inProcessCollector{
+		UnimplementedTraceServiceServer: collectortrace.UnimplementedTraceServiceServer{},
+		mutex:                           &sync.Mutex{},
+		spans:                           nil,
+	}
+	grpcServer := grpc.NewServer()
+	collectortrace.RegisterTraceServiceServer(grpcServer, collector)
+	go func() {
+		_ = grpcServer.Serve(listener)
+	}()
+	t.Cleanup(grpcServer.Stop)
+	return collector, listener.Addr().String()
+}
+
+func TestInitTracerProvider_ExportsSpansToCollector(t *testing.T) {
+	collector, collectorAddress := startInProcessCollector(t)
+	ctx := context.Background()
+
+	shutdownFunc, err := InitTracerProvider(ctx, collectorAddress, testServiceName, testServiceVersion)
+	require.NoError(t, err)
+	defer otel.SetTracerProvider(trace.NewNoopTracerProvider())
+
+	runCtx, runSpan := StartSpan(ctx, "run", attribute.String("package_id", "github.com/sample/package"))
+	_, instructionSpan := StartSpan(runCtx, "instruction")
+	EndSpan(instructionSpan, errors.New("instruction failed"))
+	EndSpan(runSpan, nil)
+
+	// shutting down flushes the spans still in the batch
+	require.NoError(t, shutdownFunc(ctx))
+
+	spansByName := collector.getSpansByName()
+	require.Len(t, spansByName, 2)
+	require.Contains(t, spansByName, "run")
+	require.Contains(t, spansByName, "instruction")
+	require.Equal(t, spansByName["run"].GetSpanId(), spansByName["instruction"].GetParentSpanId())
+	require.Equal(t, tracev1.Status_STATUS_CODE_ERROR, spansByName["instruction"].GetStatus().GetCode())
+	require.Equal(t, tracev1.Status_STATUS_CODE_UNSET, spansByName["run"].GetStatus().GetCode())
+}
+
+func TestInitTracerProvider_EmptyEndpointDisablesTracing(t *testing.T) {
+	ctx := context.Background()
+	shutdownFunc, err := InitTracerProvider(ctx, "", testServiceName, testServiceVersion)
+	require.NoError(t, err)
+
+	_, span := StartSpan(ctx, "run")
+	require.False(t, span.SpanContext().IsValid())
+	EndSpan(span, nil)
+	require.NoError(t, shutdownFunc(ctx))
+}
+
+func TestGetExporterOptions(t *testing.T) {
+	opts, err := getExporterOptions("collector:4317")
+	require.NoError(t, err)
+	require.Len(t, opts, 2)
+
+	opts, err = getExporterOptions("http://collector:4317")
+	require.NoError(t, err)
+	require.Len(t, opts, 2)
+
+	opts, err = getExporterOptions("https://collector:4317")
+	require.NoError(t, err)
+	require.Len(t, opts, 1)
+
+	_, err = getExporterOptions("https://")
+	require.Error(t, err)
+}
@@ -46,6 +46,8 @@ func (launcher ApiContainerLauncher) LaunchWithDefaultVersion(
 	cloudUserID metrics_client.CloudUserID,
 	cloudInstanceID metrics_client.CloudInstanceID,
 	shouldStartInDebugMode bool,
+	otlpEndpoint string,
+	engineCallerKey string,
 ) (
 	resultApiContainer *api_container.APIContainer,
 	resultErr error,
@@ -65,6 +67,8 @@ func (launcher ApiContainerLauncher) LaunchWithDefaultVersion(
 		cloudUserID,
 		cloudInstanceID,
 		shouldStartInDebugMode,
+		otlpEndpoint,
+		engineCallerKey,
 	)
 	if err != nil {
 		return nil, stacktrace.Propagate(err, "An error occurred launching the API container with default version tag '%v'", kurtosis_version.KurtosisVersion)
@@ -87,6 +91,8 @@ func (launcher ApiContainerLauncher) LaunchWithCustomVersion(
 	cloudUserID metrics_client.CloudUserID,
 	cloudInstanceID metrics_client.CloudInstanceID,
 	shouldStartInDebugMode bool,
+	otlpEndpoint string,
+	engineCallerKey string,
 ) (
 	resultApiContainer *api_container.APIContainer,
 	resultErr error,
@@ -107,6 +113,8 @@ func (launcher ApiContainerLauncher) LaunchWithCustomVersion(
 		isCI,
 		cloudUserID,
 		cloudInstanceID,
+		otlpEndpoint,
+		engineCallerKey,
 	)
 	if err != nil {
 		return nil, stacktrace.Propagate(err, "An error occurred creating the API container args")
@@ -0,0 +1,23 @@
+/*
+ * Copyright (c) 2022 - present Kurtosis Technologies Inc.
+ * All Rights Reserved.
+ */
+
+package api_container_launcher
+
+import (
+	"github.com/kurtosis-tech/kurtosis/core/launcher/args"
+	"github.com/kurtosis-tech/kurtosis/core/launcher/args/kurtosis_backend_config"
+)
+
+type InMemoryBackendConfigSupplier struct {
+}
+
+func NewInMemoryKurtosisBackendConfigSupplier() InMemoryBackendConfigSupplier {
+	return InMemoryBackendConfigSupplier{}
+}
+
+func (backendConfigSupplier InMemoryBackendConfigSupplier) getKurtosisBackendConfig() (args.KurtosisBackendType, interface{}) {
+	inMemoryBackendConfig := kurtosis_backend_config.InMemoryBackendConfig{}
+	return args.KurtosisBackendType_InMemory, inMemoryBackendConfig
+}
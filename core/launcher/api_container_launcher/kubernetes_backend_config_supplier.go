@@ -11,15 +11,20 @@ import (
 )
 
 type KubernetesBackendConfigSupplier struct {
-	storageClass string
+	storageClass       string
+	imageBuildRegistry string
 }
 
-func NewKubernetesKurtosisBackendConfigSupplier(storageClass string) KubernetesBackendConfigSupplier {
+func NewKubernetesKurtosisBackendConfigSupplier(storageClass string, imageBuildRegistry string) KubernetesBackendConfigSupplier {
 	return KubernetesBackendConfigSupplier{
-		storageClass: storageClass,
+		storageClass:       storageClass,
+		imageBuildRegistry: imageBuildRegistry,
 	}
 }
 
 func (backendConfigSupplier KubernetesBackendConfigSupplier) getKurtosisBackendConfig() (args.KurtosisBackendType, interface{}) {
-	return args.KurtosisBackendType_Kubernetes, kurtosis_backend_config.KubernetesBackendConfig{StorageClass: backendConfigSupplier.storageClass}
+	return args.KurtosisBackendType_Kubernetes, kurtosis_backend_config.KubernetesBackendConfig{
+		StorageClass:       backendConfigSupplier.storageClass,
+		ImageBuildRegistry: backendConfigSupplier.imageBuildRegistry,
+	}
 }
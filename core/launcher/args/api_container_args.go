@@ -55,11 +55,20 @@ type APIContainerArgs struct {
 
 	// The Cloud Instance ID of the current user if available
 	CloudInstanceID metrics_client.CloudInstanceID `json:"cloud_instance_id"`
+
+	// The OTLP gRPC endpoint traces are exported to. Tracing is disabled if empty
+	OtlpEndpoint string `json:"otlpEndpoint"`
+
+	// The key the engine sends along with the callers it forwards, so that the audit log can tell them apart from the
+	// callers reporting themselves. The engines predating it don't send any
+	EngineCallerKey string `json:"engineCallerKey"`
 }
 
 var skipValidation = map[string]bool{
 	"cloud_instance_id": true,
 	"cloud_user_id":     true,
+	"otlpEndpoint":      true,
+	"engineCallerKey":   true,
 }
 
 func (args *APIContainerArgs) UnmarshalJSON(data []byte) error {
@@ -93,6 +102,12 @@ func (args *APIContainerArgs) UnmarshalJSON(data []byte) error {
 			return stacktrace.Propagate(err, "Failed to unmarshal backend config '%+v' with type '%v'", apiContainerArgsMirror.KurtosisBackendConfig, apiContainerArgsMirror.KurtosisBackendType.String())
 		}
 		apiContainerArgsMirror.KurtosisBackendConfig = kubernetesConfig
+	case KurtosisBackendType_InMemory:
+		var inMemoryConfig kurtosis_backend_config.InMemoryBackendConfig
+		if err := json.Unmarshal(byteArray, &inMemoryConfig); err != nil {
+			return stacktrace.Propagate(err, "Failed to unmarshal backend config '%+v' with type '%v'", apiContainerArgsMirror.KurtosisBackendConfig, apiContainerArgsMirror.KurtosisBackendType.String())
+		}
+		apiContainerArgsMirror.KurtosisBackendConfig = inMemoryConfig
 	default:
 		return stacktrace.NewError("Unmarshalled an unrecognized Kurtosis backend type: '%v'", apiContainerArgsMirror.KurtosisBackendType.String())
 	}
@@ -118,6 +133,8 @@ func NewAPIContainerArgs(
 	isCI bool,
 	cloudUserID metrics_client.CloudUserID,
 	cloudInstanceID metrics_client.CloudInstanceID,
+	otlpEndpoint string,
+	engineCallerKey string,
 ) (*APIContainerArgs, error) {
 	result := &APIContainerArgs{
 		Version:                     version,
@@ -134,6 +151,8 @@ func NewAPIContainerArgs(
 		IsCI:                        isCI,
 		CloudUserID:                 cloudUserID,
 		CloudInstanceID:             cloudInstanceID,
+		OtlpEndpoint:                otlpEndpoint,
+		EngineCallerKey:             engineCallerKey,
 	}
 
 	if err := result.validate(); err != nil {
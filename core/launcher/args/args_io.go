@@ -39,7 +39,20 @@ func GetEnvFromArgs(args *APIContainerArgs) (
 
 // Intended to be used in the container main.go function - gets args + own IP from the environment variables
 func GetArgsFromEnv() (*APIContainerArgs, net.IP, error) {
-	serializedParamsStr, found := os.LookupEnv(serializedArgsEnvVar)
+	return getArgsFromEnvLookup(os.LookupEnv)
+}
+
+// Intended to be used when the API container runs in-process - gets args + own IP from the environment variables the
+// container would have been started with, as returned by GetEnvFromArgs
+func GetArgsFromEnvVars(envVars map[string]string) (*APIContainerArgs, net.IP, error) {
+	return getArgsFromEnvLookup(func(key string) (string, bool) {
+		value, found := envVars[key]
+		return value, found
+	})
+}
+
+func getArgsFromEnvLookup(lookupEnv func(key string) (string, bool)) (*APIContainerArgs, net.IP, error) {
+	serializedParamsStr, found := lookupEnv(serializedArgsEnvVar)
 	if !found {
 		return nil, nil, stacktrace.NewError("No serialized args environment variable '%v' defined", serializedArgsEnvVar)
 	}
@@ -52,7 +65,7 @@ func GetArgsFromEnv() (*APIContainerArgs, net.IP, error) {
 		return nil, nil, stacktrace.Propagate(err, "An error occurred deserializing the args JSON '%v'", serializedParamsStr)
 	}
 
-	ownIpAddrStr, found := os.LookupEnv(ownIpAddressEnvVar)
+	ownIpAddrStr, found := lookupEnv(ownIpAddressEnvVar)
 	if !found {
 		return nil, nil, stacktrace.NewError("No own IP address environment variable '%v' defined", ownIpAddressEnvVar)
 	}
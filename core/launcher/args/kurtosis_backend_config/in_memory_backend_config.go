@@ -0,0 +1,10 @@
+/*
+ * Copyright (c) 2022 - present Kurtosis Technologies Inc.
+ * All Rights Reserved.
+ */
+
+package kurtosis_backend_config
+
+// InMemoryBackendConfig configures the in-memory backend, which simulates enclaves and services without any container
+// engine and is meant for tests
+type InMemoryBackendConfig struct{}
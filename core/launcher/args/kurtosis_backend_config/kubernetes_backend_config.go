@@ -7,4 +7,7 @@ package kurtosis_backend_config
 
 type KubernetesBackendConfig struct {
 	StorageClass string
+
+	// Registry that images built in the cluster get pushed to; when empty, each enclave runs its own registry
+	ImageBuildRegistry string
 }
@@ -8,11 +8,11 @@ import (
 	"strings"
 )
 
-const _KurtosisBackendTypeName = "dockerkubernetespodman"
+const _KurtosisBackendTypeName = "dockerkubernetespodmaninmemory"
 
-var _KurtosisBackendTypeIndex = [...]uint8{0, 6, 16, 22}
+var _KurtosisBackendTypeIndex = [...]uint8{0, 6, 16, 22, 30}
 
-const _KurtosisBackendTypeLowerName = "dockerkubernetespodman"
+const _KurtosisBackendTypeLowerName = "dockerkubernetespodmaninmemory"
 
 func (i KurtosisBackendType) String() string {
 	if i >= KurtosisBackendType(len(_KurtosisBackendTypeIndex)-1) {
@@ -28,9 +28,10 @@ func _KurtosisBackendTypeNoOp() {
 	_ = x[KurtosisBackendType_Docker-(0)]
 	_ = x[KurtosisBackendType_Kubernetes-(1)]
 	_ = x[KurtosisBackendType_Podman-(2)]
+	_ = x[KurtosisBackendType_InMemory-(3)]
 }
 
-var _KurtosisBackendTypeValues = []KurtosisBackendType{KurtosisBackendType_Docker, KurtosisBackendType_Kubernetes, KurtosisBackendType_Podman}
+var _KurtosisBackendTypeValues = []KurtosisBackendType{KurtosisBackendType_Docker, KurtosisBackendType_Kubernetes, KurtosisBackendType_Podman, KurtosisBackendType_InMemory}
 
 var _KurtosisBackendTypeNameToValueMap = map[string]KurtosisBackendType{
 	_KurtosisBackendTypeName[0:6]:        KurtosisBackendType_Docker,
@@ -39,12 +40,15 @@ var _KurtosisBackendTypeNameToValueMap = map[string]KurtosisBackendType{
 	_KurtosisBackendTypeLowerName[6:16]:  KurtosisBackendType_Kubernetes,
 	_KurtosisBackendTypeName[16:22]:      KurtosisBackendType_Podman,
 	_KurtosisBackendTypeLowerName[16:22]: KurtosisBackendType_Podman,
+	_KurtosisBackendTypeName[22:30]:      KurtosisBackendType_InMemory,
+	_KurtosisBackendTypeLowerName[22:30]: KurtosisBackendType_InMemory,
 }
 
 var _KurtosisBackendTypeNames = []string{
 	_KurtosisBackendTypeName[0:6],
 	_KurtosisBackendTypeName[6:16],
 	_KurtosisBackendTypeName[16:22],
+	_KurtosisBackendTypeName[22:30],
 }
 
 // KurtosisBackendTypeString retrieves an enum value from the enum constants string name.
@@ -0,0 +1,260 @@
+package api_container_creator
+
+import (
+	"net"
+
+	"github.com/kurtosis-tech/kurtosis/api/golang/core/kurtosis_core_rpc_api_bindings"
+	"github.com/kurtosis-tech/kurtosis/container-engine-lib/lib/backend_interface"
+	"github.com/kurtosis-tech/kurtosis/container-engine-lib/lib/backend_interface/objects/enclave"
+	"github.com/kurtosis-tech/kurtosis/container-engine-lib/lib/database_accessors/enclave_db"
+	"github.com/kurtosis-tech/kurtosis/container-engine-lib/lib/tracing"
+	"github.com/kurtosis-tech/kurtosis/core/launcher/args"
+	"github.com/kurtosis-tech/kurtosis/core/server/api_container/server"
+	"github.com/kurtosis-tech/kurtosis/core/server/api_container/server/activity_tracker"
+	"github.com/kurtosis-tech/kurtosis/core/server/api_container/server/audit_log"
+	"github.com/kurtosis-tech/kurtosis/core/server/api_container/server/secret_store"
+	"github.com/kurtosis-tech/kurtosis/core/server/api_container/server/service_network"
+	"github.com/kurtosis-tech/kurtosis/core/server/api_container/server/startosis_engine"
+	"github.com/kurtosis-tech/kurtosis/core/server/api_container/server/startosis_engine/enclave_plan_persistence"
+	"github.com/kurtosis-tech/kurtosis/core/server/api_container/server/startosis_engine/interpretation_time_value_store"
+	"github.com/kurtosis-tech/kurtosis/core/server/api_container/server/startosis_engine/kurtosis_types"
+	"github.com/kurtosis-tech/kurtosis/core/server/api_container/server/startosis_engine/runtime_value_store"
+	"github.com/kurtosis-tech/kurtosis/core/server/api_container/server/startosis_engine/starlark_run"
+	"github.com/kurtosis-tech/kurtosis/core/server/api_container/server/startosis_engine/startosis_packages/git_package_content_provider"
+	"github.com/kurtosis-tech/kurtosis/core/server/commons/enclave_data_directory"
+	"github.com/kurtosis-tech/kurtosis/metrics-library/golang/lib/analytics_logger"
+	"github.com/kurtosis-tech/kurtosis/metrics-library/golang/lib/metrics_client"
+	"github.com/kurtosis-tech/kurtosis/metrics-library/golang/lib/source"
+	"github.com/kurtosis-tech/stacktrace"
+	"github.com/sirupsen/logrus"
+	"go.starlark.net/starlark"
+	"google.golang.org/grpc"
+)
+
+const (
+	shouldFlushMetricsClientQueueOnEachEvent = false
+
+	// The engine polls it to know whether the enclave is idle, so it mustn't count as activity itself
+	getLastActivityTimeMethodName = "GetLastActivityTime"
+	// The engine keeps a stream open on it for as long as the enclave runs, which would otherwise make it never idle
+	watchEventsMethodName = "WatchEvents"
+	// The engine reads it from every running enclave when its own audit log is requested
+	getAuditLogMethodName = "GetAuditLog"
+)
+
+// The methods changing the enclave, whose calls are recorded in the audit log
+var auditedMethodNames = map[string]bool{
+	"RunStarlarkScript":             true,
+	"UploadStarlarkPackage":         true,
+	"RunStarlarkPackage":            true,
+	"ExecCommand":                   true,
+	"UploadFilesArtifact":           true,
+	"StoreWebFilesArtifact":         true,
+	"StoreFilesArtifactFromService": true,
+	"ConnectServices":               true,
+	"RenameService":                 true,
+}
+
+// ApiContainer is the API container service of an enclave, whether it runs in its own container or in the process of
+// the engine
+type ApiContainer struct {
+	service         *server.ApiContainerService
+	activityTracker *activity_tracker.ActivityTracker
+	auditLog        *audit_log.AuditLog
+
+	closeMetricsClientFunc func() error
+}
+
+// CreateApiContainer creates the API container service of the enclave the args are for, managing it through the given
+// backend and persisting its state to the given data directory and database
+func CreateApiContainer(
+	serverArgs *args.APIContainerArgs,
+	ownIpAddress net.IP,
+	enclaveDataDir *enclave_data_directory.EnclaveDataDirectory,
+	enclaveDb *enclave_db.EnclaveDB,
+	kurtosisBackend backend_interface.KurtosisBackend,
+) (*ApiContainer, error) {
+	repositoriesDirPath, tempDirectoriesDirPath, githubAuthDirPath, _, err := enclaveDataDir.GetEnclaveDataDirectoryPaths()
+	if err != nil {
+		return nil, stacktrace.Propagate(err, "An error occurred getting directory paths of the enclave data directory.")
+	}
+
+	filesArtifactStore, err := enclaveDataDir.GetFilesArtifactStore()
+	if err != nil {
+		return nil, stacktrace.Propagate(err, "An error occurred getting the files artifact store")
+	}
+
+	githubAuthProvider := git_package_content_provider.NewGitHubPackageAuthProvider(githubAuthDirPath)
+	gitPackageContentProvider := git_package_content_provider.NewGitPackageContentProvider(repositoriesDirPath, tempDirectoriesDirPath, githubAuthProvider, enclaveDb)
+
+	// Secrets are kept in memory only, they must never end up in the enclave DB
+	secretStore := secret_store.NewSecretStore()
+
+	starlarkValueSerde := createStarlarkValueSerde()
+	runtimeValueStore, err := runtime_value_store.CreateRuntimeValueStore(starlarkValueSerde, enclaveDb, secretStore)
+	if err != nil {
+		return nil, stacktrace.Propagate(err, "An error occurred creating the runtime value store")
+	}
+
+	interpretationTimeValueStore, err := interpretation_time_value_store.CreateInterpretationTimeValueStore(enclaveDb, starlarkValueSerde)
+	if err != nil {
+		return nil, stacktrace.Propagate(err, "An error occurred while creating the interpretation time value store")
+	}
+
+	serviceNetwork, err := createServiceNetwork(kurtosisBackend, enclaveDataDir, serverArgs, ownIpAddress, enclaveDb, secretStore)
+	if err != nil {
+		return nil, stacktrace.Propagate(err, "An error occurred creating the service network")
+	}
+
+	logger := logrus.StandardLogger()
+	metricsClient, closeClientFunc, err := metrics_client.CreateMetricsClient(
+		metrics_client.NewMetricsClientCreatorOption(
+			source.KurtosisCoreSource,
+			serverArgs.Version,
+			serverArgs.MetricsUserID,
+			serverArgs.KurtosisBackendType.String(),
+			serverArgs.DidUserAcceptSendingMetrics,
+			shouldFlushMetricsClientQueueOnEachEvent,
+			metrics_client.DoNothingMetricsClientCallback{},
+			analytics_logger.ConvertLogrusLoggerToAnalyticsLogger(logger),
+			serverArgs.IsCI,
+			serverArgs.CloudUserID,
+			serverArgs.CloudInstanceID,
+		),
+	)
+	if err != nil {
+		return nil, stacktrace.Propagate(err, "An error occurred creating the metrics client")
+	}
+	shouldCloseMetricsClient := true
+	defer func() {
+		if shouldCloseMetricsClient {
+			if err := closeClientFunc(); err != nil {
+				logrus.Warnf("We tried to close the metrics client, but doing so threw an error:\n%v", err)
+			}
+		}
+	}()
+
+	// Load the current enclave plan, in case the enclave is being restarted
+	enclavePlan, err := enclave_plan_persistence.Load(enclaveDb)
+	if err != nil {
+		return nil, stacktrace.Propagate(err, "An error occurred loading stored enclave plan")
+	}
+
+	// TODO: Consolidate Interpreter, Validator and Executor into a single interface
+	startosisInterpreter := startosis_engine.NewStartosisInterpreter(serviceNetwork, gitPackageContentProvider, runtimeValueStore, starlarkValueSerde, serverArgs.EnclaveEnvVars, interpretationTimeValueStore, serverArgs.KurtosisBackendType)
+	startosisRunner := startosis_engine.NewStartosisRunner(
+		startosisInterpreter,
+		startosis_engine.NewStartosisValidator(&kurtosisBackend, serviceNetwork, filesArtifactStore, secretStore),
+		startosis_engine.NewStartosisExecutor(starlarkValueSerde, runtimeValueStore, enclavePlan, enclaveDb),
+		secretStore)
+
+	starlarkRunRepository, err := starlark_run.GetOrCreateNewStarlarkRunRepository(enclaveDb)
+	if err != nil {
+		return nil, stacktrace.Propagate(err, "An error occurred creating the starlark run repository")
+	}
+
+	//Creation of ApiContainerService
+	restartPolicy := kurtosis_core_rpc_api_bindings.RestartPolicy_NEVER
+	if serverArgs.IsProductionEnclave {
+		restartPolicy = kurtosis_core_rpc_api_bindings.RestartPolicy_ALWAYS
+	}
+	activityTracker := activity_tracker.NewActivityTracker()
+	auditLog, err := audit_log.GetOrCreateAuditLog(enclaveDb, secretStore, serverArgs.EngineCallerKey)
+	if err != nil {
+		return nil, stacktrace.Propagate(err, "An error occurred creating the audit log")
+	}
+	apiContainerService, err := server.NewApiContainerService(
+		filesArtifactStore,
+		serviceNetwork,
+		startosisRunner,
+		startosisInterpreter,
+		gitPackageContentProvider,
+		restartPolicy,
+		metricsClient,
+		githubAuthProvider,
+		starlarkRunRepository,
+		interpretationTimeValueStore,
+		secretStore,
+		activityTracker,
+		auditLog,
+	)
+	if err != nil {
+		return nil, stacktrace.Propagate(err, "An error occurred creating the API container service")
+	}
+
+	shouldCloseMetricsClient = false
+	return &ApiContainer{
+		service:                apiContainerService,
+		activityTracker:        activityTracker,
+		auditLog:               auditLog,
+		closeMetricsClientFunc: closeClientFunc,
+	}, nil
+}
+
+// RegisterService registers the API container service on the gRPC server, behind its audit log, activity tracking and
+// tracing
+func (apiContainer *ApiContainer) RegisterService(grpcServer *grpc.Server) {
+	auditedServiceDesc := apiContainer.auditLog.WrapServiceDesc(&kurtosis_core_rpc_api_bindings.ApiContainerService_ServiceDesc, auditedMethodNames)
+	activityTrackingServiceDesc := apiContainer.activityTracker.WrapServiceDesc(
+		auditedServiceDesc,
+		map[string]bool{getLastActivityTimeMethodName: true, watchEventsMethodName: true, getAuditLogMethodName: true},
+	)
+	// The tracing wraps the other wrappers so that their work is part of the span of the call
+	grpcServer.RegisterService(tracing.WrapServiceDesc(activityTrackingServiceDesc), apiContainer.service)
+}
+
+// Close flushes the metrics the API container still holds
+func (apiContainer *ApiContainer) Close() {
+	if err := apiContainer.closeMetricsClientFunc(); err != nil {
+		logrus.Warnf("We tried to close the metrics client, but doing so threw an error:\n%v", err)
+	}
+}
+
+func createServiceNetwork(
+	kurtosisBackend backend_interface.KurtosisBackend,
+	enclaveDataDir *enclave_data_directory.EnclaveDataDirectory,
+	args *args.APIContainerArgs,
+	ownIpAddress net.IP,
+	enclaveDb *enclave_db.EnclaveDB,
+	secretStore *secret_store.SecretStore,
+) (service_network.ServiceNetwork, error) {
+	enclaveIdStr := args.EnclaveUUID
+	enclaveUuid := enclave.EnclaveUUID(enclaveIdStr)
+
+	apiContainerInfo := service_network.NewApiContainerInfo(
+		ownIpAddress,
+		args.GrpcListenPortNum,
+		args.Version,
+	)
+
+	serviceNetwork, err := service_network.NewDefaultServiceNetwork(
+		enclaveUuid,
+		apiContainerInfo,
+		kurtosisBackend,
+		enclaveDataDir,
+		enclaveDb,
+		secretStore,
+		args.KurtosisBackendType,
+	)
+
+	if err != nil {
+		return nil, stacktrace.Propagate(err, "An error occurred while creating the default service network")
+	}
+	return serviceNetwork, nil
+}
+
+func createStarlarkValueSerde() *kurtosis_types.StarlarkValueSerde {
+	starlarkThread := &starlark.Thread{
+		Name:       "starlark-serde-thread",
+		Print:      nil,
+		Load:       nil,
+		OnMaxSteps: nil,
+		Steps:      0,
+	}
+	starlarkEnv := startosis_engine.Predeclared()
+	builtins := startosis_engine.KurtosisTypeConstructors()
+	for _, builtin := range builtins {
+		starlarkEnv[builtin.Name()] = builtin
+	}
+	return kurtosis_types.NewStarlarkValueSerde(starlarkThread, starlarkEnv)
+}
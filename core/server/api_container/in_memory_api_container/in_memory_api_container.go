@@ -0,0 +1,117 @@
+package in_memory_api_container
+
+import (
+	"context"
+	"net"
+	"os"
+
+	"github.com/kurtosis-tech/kurtosis/container-engine-lib/lib/backend_impls/in_memory/in_memory_kurtosis_backend"
+	"github.com/kurtosis-tech/kurtosis/container-engine-lib/lib/backend_interface/objects/enclave"
+	"github.com/kurtosis-tech/kurtosis/container-engine-lib/lib/database_accessors/enclave_db"
+	"github.com/kurtosis-tech/kurtosis/core/launcher/args"
+	"github.com/kurtosis-tech/kurtosis/core/server/api_container/api_container_creator"
+	"github.com/kurtosis-tech/kurtosis/core/server/commons/enclave_data_directory"
+	"github.com/kurtosis-tech/stacktrace"
+	"github.com/sirupsen/logrus"
+	"google.golang.org/grpc"
+)
+
+const (
+	enclaveDataDirPattern = "kurtosis-enclave-data-"
+
+	tcpNetwork = "tcp"
+	// Several enclaves can be served at once, so each API container gets a port of its own
+	anyFreePort = "0"
+)
+
+var localhostIpAddr = net.IPv4(127, 0, 0, 1)
+
+// NewAPIContainerStartHandler returns the handler the in-memory backend starts API containers with: each of them is
+// served from the current process, on the same backend as the engine so that both see the same enclaves and services
+func NewAPIContainerStartHandler(kurtosisBackend *in_memory_kurtosis_backend.InMemoryKurtosisBackend) in_memory_kurtosis_backend.APIContainerStartHandler {
+	return func(ctx context.Context, enclaveUuid enclave.EnclaveUUID, grpcPortNum uint16, envVars map[string]string) (net.IP, uint16, in_memory_kurtosis_backend.APIContainerStopFunc, error) {
+		serverArgs, ownIpAddress, err := args.GetArgsFromEnvVars(envVars)
+		if err != nil {
+			return nil, 0, nil, stacktrace.Propagate(err, "An error occurred getting the args of the API container of enclave '%v'", enclaveUuid)
+		}
+
+		// The data volume of a containerized API container is its own, whereas in-process ones share the filesystem
+		enclaveDataDirpath, err := os.MkdirTemp("", enclaveDataDirPattern)
+		if err != nil {
+			return nil, 0, nil, stacktrace.Propagate(err, "An error occurred creating the data directory of enclave '%v'", enclaveUuid)
+		}
+		shouldRemoveEnclaveDataDir := true
+		defer func() {
+			if shouldRemoveEnclaveDataDir {
+				removeEnclaveDataDir(enclaveDataDirpath)
+			}
+		}()
+
+		enclaveDataDir := enclave_data_directory.NewEnclaveDataDirectory(enclaveDataDirpath)
+		_, _, _, enclaveDatabaseDirpath, err := enclaveDataDir.GetEnclaveDataDirectoryPaths()
+		if err != nil {
+			return nil, 0, nil, stacktrace.Propagate(err, "An error occurred getting directory paths of the enclave data directory.")
+		}
+		enclaveDb, err := enclave_db.OpenEnclaveDatabase(enclaveDatabaseDirpath)
+		if err != nil {
+			return nil, 0, nil, stacktrace.Propagate(err, "An error occurred opening the database of enclave '%v'", enclaveUuid)
+		}
+		shouldCloseEnclaveDb := true
+		defer func() {
+			if shouldCloseEnclaveDb {
+				closeEnclaveDb(enclaveDb)
+			}
+		}()
+		enclaveDataDir, err = enclaveDataDir.WithOwnDatabase(enclaveDb)
+		if err != nil {
+			return nil, 0, nil, stacktrace.Propagate(err, "An error occurred setting up the data directory of enclave '%v'", enclaveUuid)
+		}
+
+		apiContainer, err := api_container_creator.CreateApiContainer(serverArgs, ownIpAddress, enclaveDataDir, enclaveDb, kurtosisBackend)
+		if err != nil {
+			return nil, 0, nil, stacktrace.Propagate(err, "An error occurred creating the API container of enclave '%v'", enclaveUuid)
+		}
+		shouldCloseApiContainer := true
+		defer func() {
+			if shouldCloseApiContainer {
+				apiContainer.Close()
+			}
+		}()
+
+		listener, err := net.Listen(tcpNetwork, net.JoinHostPort(localhostIpAddr.String(), anyFreePort))
+		if err != nil {
+			return nil, 0, nil, stacktrace.Propagate(err, "An error occurred listening for the API container of enclave '%v'", enclaveUuid)
+		}
+		grpcServer := grpc.NewServer()
+		apiContainer.RegisterService(grpcServer)
+		go func() {
+			if err := grpcServer.Serve(listener); err != nil {
+				logrus.Warnf("The API container server of enclave '%v' stopped. Error was:\n%v", enclaveUuid, err)
+			}
+		}()
+
+		// Stopping rather than draining the server, as the engine keeps streams open on it for as long as the enclave runs
+		stopFunc := func() {
+			grpcServer.Stop()
+			apiContainer.Close()
+			closeEnclaveDb(enclaveDb)
+			removeEnclaveDataDir(enclaveDataDirpath)
+		}
+		shouldRemoveEnclaveDataDir = false
+		shouldCloseEnclaveDb = false
+		shouldCloseApiContainer = false
+		return localhostIpAddr, uint16(listener.Addr().(*net.TCPAddr).Port), stopFunc, nil
+	}
+}
+
+func closeEnclaveDb(enclaveDb *enclave_db.EnclaveDB) {
+	if err := enclaveDb.Close(); err != nil {
+		logrus.Warnf("An error occurred closing enclave database '%v'. Error was:\n%v", enclaveDb.Path(), err)
+	}
+}
+
+func removeEnclaveDataDir(enclaveDataDirpath string) {
+	if err := os.RemoveAll(enclaveDataDirpath); err != nil {
+		logrus.Warnf("An error occurred removing enclave data directory '%v', it'll have to be removed manually. Error was:\n%v", enclaveDataDirpath, err)
+	}
+}
@@ -0,0 +1,105 @@
+package in_memory_api_container
+
+import (
+	"context"
+	"fmt"
+	"io"
+	"net"
+	"strconv"
+	"testing"
+
+	"github.com/kurtosis-tech/kurtosis/api/golang/core/kurtosis_core_rpc_api_bindings"
+	"github.com/kurtosis-tech/kurtosis/container-engine-lib/lib/backend_impls/in_memory/in_memory_kurtosis_backend"
+	"github.com/kurtosis-tech/kurtosis/container-engine-lib/lib/backend_interface/objects/container"
+	"github.com/kurtosis-tech/kurtosis/container-engine-lib/lib/backend_interface/objects/enclave"
+	"github.com/kurtosis-tech/kurtosis/container-engine-lib/lib/backend_interface/objects/service"
+	"github.com/kurtosis-tech/kurtosis/core/launcher/api_container_launcher"
+	"github.com/sirupsen/logrus"
+	"github.com/stretchr/testify/require"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/credentials/insecure"
+)
+
+const (
+	testEnclaveUuid = enclave.EnclaveUUID("5ff5d5b2a5a34cf6a5d2f3b3b6fcd1a0")
+	testEnclaveName = "test-enclave"
+
+	testGrpcPortNum    = uint16(7443)
+	testEnclaveEnvVars = "{}"
+	testMetricsUserId  = "test-user"
+
+	testServiceName = "test-service"
+
+	noParams = "{}"
+
+	script = `
+def run(plan):
+    service = plan.add_service(name = "` + testServiceName + `", config = ServiceConfig(image = "test-image:1.0"))
+    plan.print("Started " + service.name)
+`
+)
+
+func TestRunStarlarkScriptThroughInMemoryApiContainer(t *testing.T) {
+	ctx := context.Background()
+	backend := in_memory_kurtosis_backend.NewInMemoryKurtosisBackend()
+	backend.SetAPIContainerStartHandler(NewAPIContainerStartHandler(backend))
+
+	_, err := backend.CreateEnclave(ctx, testEnclaveUuid, testEnclaveName)
+	require.NoError(t, err)
+	defer func() {
+		_, _, err := backend.DestroyEnclaves(ctx, &enclave.EnclaveFilters{UUIDs: nil, Statuses: nil})
+		require.NoError(t, err)
+	}()
+
+	// Launching the API container the way the engine does
+	apiContainer, err := api_container_launcher.NewApiContainerLauncher(backend).LaunchWithDefaultVersion(
+		ctx,
+		logrus.InfoLevel,
+		testEnclaveUuid,
+		testGrpcPortNum,
+		api_container_launcher.NewInMemoryKurtosisBackendConfigSupplier(),
+		testEnclaveEnvVars,
+		false,
+		testMetricsUserId,
+		false,
+		false,
+		"",
+		"",
+		false,
+		"",
+		"",
+	)
+	require.NoError(t, err)
+
+	grpcUrl := net.JoinHostPort(apiContainer.GetPublicIPAddress().String(), strconv.Itoa(int(apiContainer.GetPublicGRPCPort().GetNumber())))
+	conn, err := grpc.Dial(grpcUrl, grpc.WithTransportCredentials(insecure.NewCredentials()))
+	require.NoError(t, err)
+	defer conn.Close()
+	client := kurtosis_core_rpc_api_bindings.NewApiContainerServiceClient(conn)
+
+	serializedParams := noParams
+	stream, err := client.RunStarlarkScript(ctx, &kurtosis_core_rpc_api_bindings.RunStarlarkScriptArgs{SerializedScript: script, SerializedParams: &serializedParams}) // nolint: exhaustruct
+	require.NoError(t, err)
+	output := ""
+	for {
+		responseLine, err := stream.Recv()
+		if err == io.EOF {
+			break
+		}
+		require.NoError(t, err)
+		require.Nil(t, responseLine.GetError(), "The run failed: %v", responseLine.GetError())
+		if instructionResult := responseLine.GetInstructionResult(); instructionResult != nil {
+			output += instructionResult.GetSerializedInstructionResult()
+		}
+	}
+	require.Contains(t, output, fmt.Sprintf("Started %v", testServiceName))
+
+	// The service was added through the API container, so seeing it on the backend proves they share their state
+	services, err := backend.GetUserServices(ctx, testEnclaveUuid, &service.ServiceFilters{Names: nil, UUIDs: nil, Statuses: nil})
+	require.NoError(t, err)
+	require.Len(t, services, 1)
+	for _, userService := range services {
+		require.Equal(t, service.ServiceName(testServiceName), userService.GetRegistration().GetName())
+		require.Equal(t, container.ContainerStatus_Running, userService.GetContainer().GetStatus())
+	}
+}
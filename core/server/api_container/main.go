@@ -8,40 +8,28 @@ package main
 import (
 	"context"
 	"fmt"
-	"net"
+	"net/http"
 	"os"
 	"path"
 	"runtime"
 	"strings"
 	"time"
 
-	"github.com/kurtosis-tech/kurtosis/core/server/api_container/server/startosis_engine/interpretation_time_value_store"
-	"github.com/kurtosis-tech/kurtosis/core/server/api_container/server/startosis_engine/starlark_run"
-	"github.com/kurtosis-tech/kurtosis/core/server/api_container/server/startosis_engine/startosis_packages/git_package_content_provider"
-
-	"github.com/kurtosis-tech/kurtosis/api/golang/core/kurtosis_core_rpc_api_bindings"
 	"github.com/kurtosis-tech/kurtosis/container-engine-lib/lib/backend_impls/docker/docker_kurtosis_backend/backend_creator"
 	"github.com/kurtosis-tech/kurtosis/container-engine-lib/lib/backend_impls/kubernetes/kubernetes_kurtosis_backend"
 	"github.com/kurtosis-tech/kurtosis/container-engine-lib/lib/backend_interface"
 	"github.com/kurtosis-tech/kurtosis/container-engine-lib/lib/backend_interface/objects/configs"
 	"github.com/kurtosis-tech/kurtosis/container-engine-lib/lib/backend_interface/objects/enclave"
 	"github.com/kurtosis-tech/kurtosis/container-engine-lib/lib/database_accessors/enclave_db"
+	"github.com/kurtosis-tech/kurtosis/container-engine-lib/lib/tracing"
 	"github.com/kurtosis-tech/kurtosis/core/launcher/args"
 	"github.com/kurtosis-tech/kurtosis/core/launcher/args/kurtosis_backend_config"
-	"github.com/kurtosis-tech/kurtosis/core/server/api_container/server"
-	"github.com/kurtosis-tech/kurtosis/core/server/api_container/server/service_network"
-	"github.com/kurtosis-tech/kurtosis/core/server/api_container/server/startosis_engine"
-	"github.com/kurtosis-tech/kurtosis/core/server/api_container/server/startosis_engine/enclave_plan_persistence"
-	"github.com/kurtosis-tech/kurtosis/core/server/api_container/server/startosis_engine/kurtosis_types"
-	"github.com/kurtosis-tech/kurtosis/core/server/api_container/server/startosis_engine/runtime_value_store"
+	"github.com/kurtosis-tech/kurtosis/core/server/api_container/api_container_creator"
 	"github.com/kurtosis-tech/kurtosis/core/server/commons/enclave_data_directory"
-	"github.com/kurtosis-tech/kurtosis/metrics-library/golang/lib/analytics_logger"
-	"github.com/kurtosis-tech/kurtosis/metrics-library/golang/lib/metrics_client"
-	"github.com/kurtosis-tech/kurtosis/metrics-library/golang/lib/source"
 	minimal_grpc_server "github.com/kurtosis-tech/minimal-grpc-server/golang/server"
 	"github.com/kurtosis-tech/stacktrace"
+	"github.com/prometheus/client_golang/prometheus/promhttp"
 	"github.com/sirupsen/logrus"
-	"go.starlark.net/starlark"
 	"google.golang.org/grpc"
 )
 
@@ -58,7 +46,11 @@ const (
 	functionPathSeparator     = "."
 	emptyFunctionName         = ""
 
-	shouldFlushMetricsClientQueueOnEachEvent = false
+	tracingServiceName = "kurtosis-api-container"
+
+	// Only reachable from inside the enclave network, as the API container doesn't publish it
+	prometheusMetricsPortAddr = ":7444"
+	prometheusMetricsPath     = "/metrics"
 )
 
 func main() {
@@ -112,6 +104,17 @@ func runMain() error {
 	}
 	logrus.SetLevel(logLevel)
 
+	shutdownTracerProviderFunc, err := tracing.InitTracerProvider(ctx, serverArgs.OtlpEndpoint, tracingServiceName, serverArgs.Version)
+	if err != nil {
+		return stacktrace.Propagate(err, "An error occurred initializing the tracer provider")
+	}
+	defer func() {
+		// flushes the spans of the last run, which would otherwise be lost when the API container stops
+		if err := shutdownTracerProviderFunc(ctx); err != nil {
+			logrus.Warnf("An error occurred shutting down the tracer provider, some traces might not have been exported. Error was:\n%v", err)
+		}
+	}()
+
 	enclaveDataDir := enclave_data_directory.NewEnclaveDataDirectory(serverArgs.EnclaveDataVolumeDirpath)
 
 	clusterConfig := serverArgs.KurtosisBackendConfig
@@ -119,7 +122,7 @@ func runMain() error {
 		return stacktrace.NewError("Kurtosis backend type is '%v' but cluster configuration parameters are null.", args.KurtosisBackendType_Kubernetes.String())
 	}
 
-	repositoriesDirPath, tempDirectoriesDirPath, githubAuthDirPath, enclaveDatabaseDirpath, err := enclaveDataDir.GetEnclaveDataDirectoryPaths()
+	_, _, _, enclaveDatabaseDirpath, err := enclaveDataDir.GetEnclaveDataDirectoryPaths()
 	if err != nil {
 		return stacktrace.Propagate(err, "An error occurred getting directory paths of the enclave data directory.")
 	}
@@ -129,14 +132,6 @@ func runMain() error {
 		return stacktrace.Propagate(err, "An error occurred while getting the enclave db")
 	}
 
-	filesArtifactStore, err := enclaveDataDir.GetFilesArtifactStore()
-	if err != nil {
-		return stacktrace.Propagate(err, "An error occurred getting the files artifact store")
-	}
-
-	githubAuthProvider := git_package_content_provider.NewGitHubPackageAuthProvider(githubAuthDirPath)
-	gitPackageContentProvider := git_package_content_provider.NewGitPackageContentProvider(repositoriesDirPath, tempDirectoriesDirPath, githubAuthProvider, enclaveDb)
-
 	// TODO Extract into own function
 	dockerApiContainerModeArgs := &backend_creator.APIContainerModeArgs{
 		Context:        ctx,
@@ -169,105 +164,39 @@ func runMain() error {
 			)
 		}
 		// TODO wrap up APIContainerModeArgs if the parameter list keeps on going up (currently just IsProductionEnclave)
-		kurtosisBackend, err = kubernetes_kurtosis_backend.GetApiContainerBackend(ctx, clusterConfigK8s.StorageClass, serverArgs.IsProductionEnclave)
+		kurtosisBackend, err = kubernetes_kurtosis_backend.GetApiContainerBackend(ctx, clusterConfigK8s.StorageClass, clusterConfigK8s.ImageBuildRegistry, serverArgs.IsProductionEnclave)
 		if err != nil {
 			return stacktrace.Propagate(
 				err,
 				"An error occurred getting Kurtosis Kubernetes backend for APIC",
 			)
 		}
+	case args.KurtosisBackendType_InMemory:
+		// The in-memory backend only lives in the engine process, which runs its API containers itself
+		return stacktrace.NewError("The API container can't run in its own process with the '%v' backend, the engine runs it in-process instead", serverArgs.KurtosisBackendType.String())
 	default:
 		return stacktrace.NewError("Backend type '%v' was not recognized by API container.", serverArgs.KurtosisBackendType.String())
 	}
 
-	starlarkValueSerde := createStarlarkValueSerde()
-	runtimeValueStore, err := runtime_value_store.CreateRuntimeValueStore(starlarkValueSerde, enclaveDb)
+	apiContainer, err := api_container_creator.CreateApiContainer(serverArgs, ownIpAddress, enclaveDataDir, enclaveDb, kurtosisBackend)
 	if err != nil {
-		return stacktrace.Propagate(err, "An error occurred creating the runtime value store")
+		return stacktrace.Propagate(err, "An error occurred creating the API container")
 	}
+	defer apiContainer.Close()
 
-	interpretationTimeValueStore, err := interpretation_time_value_store.CreateInterpretationTimeValueStore(enclaveDb, starlarkValueSerde)
-	if err != nil {
-		return stacktrace.Propagate(err, "An error occurred while creating the interpretation time value store")
-	}
-
-	serviceNetwork, err := createServiceNetwork(kurtosisBackend, enclaveDataDir, serverArgs, ownIpAddress, enclaveDb)
-	if err != nil {
-		return stacktrace.Propagate(err, "An error occurred creating the service network")
-	}
-
-	logger := logrus.StandardLogger()
-	metricsClient, closeClientFunc, err := metrics_client.CreateMetricsClient(
-		metrics_client.NewMetricsClientCreatorOption(
-			source.KurtosisCoreSource,
-			serverArgs.Version,
-			serverArgs.MetricsUserID,
-			serverArgs.KurtosisBackendType.String(),
-			serverArgs.DidUserAcceptSendingMetrics,
-			shouldFlushMetricsClientQueueOnEachEvent,
-			metrics_client.DoNothingMetricsClientCallback{},
-			analytics_logger.ConvertLogrusLoggerToAnalyticsLogger(logger),
-			serverArgs.IsCI,
-			serverArgs.CloudUserID,
-			serverArgs.CloudInstanceID,
-		),
-	)
-	if err != nil {
-		return stacktrace.Propagate(err, "An error occurred creating the metrics client")
-	}
-	defer func() {
-		if err := closeClientFunc(); err != nil {
-			logrus.Warnf("We tried to close the metrics client, but doing so threw an error:\n%v", err)
+	go func() {
+		handler := http.NewServeMux()
+		handler.Handle(prometheusMetricsPath, promhttp.Handler())
+		if err := http.ListenAndServe(prometheusMetricsPortAddr, handler); err != nil {
+			logrus.Warnf("The Prometheus metrics server stopped, the API container metrics won't be available. Error was:\n%v", err)
 		}
 	}()
 
-	// Load the current enclave plan, in case the enclave is being restarted
-	enclavePlan, err := enclave_plan_persistence.Load(enclaveDb)
-	if err != nil {
-		return stacktrace.Propagate(err, "An error occurred loading stored enclave plan")
-	}
-
-	// TODO: Consolidate Interpreter, Validator and Executor into a single interface
-	startosisInterpreter := startosis_engine.NewStartosisInterpreter(serviceNetwork, gitPackageContentProvider, runtimeValueStore, starlarkValueSerde, serverArgs.EnclaveEnvVars, interpretationTimeValueStore, serverArgs.KurtosisBackendType)
-	startosisRunner := startosis_engine.NewStartosisRunner(
-		startosisInterpreter,
-		startosis_engine.NewStartosisValidator(&kurtosisBackend, serviceNetwork, filesArtifactStore),
-		startosis_engine.NewStartosisExecutor(starlarkValueSerde, runtimeValueStore, enclavePlan, enclaveDb))
-
-	starlarkRunRepository, err := starlark_run.GetOrCreateNewStarlarkRunRepository(enclaveDb)
-	if err != nil {
-		return stacktrace.Propagate(err, "An error occurred creating the starlark run repository")
-	}
-
-	//Creation of ApiContainerService
-	restartPolicy := kurtosis_core_rpc_api_bindings.RestartPolicy_NEVER
-	if serverArgs.IsProductionEnclave {
-		restartPolicy = kurtosis_core_rpc_api_bindings.RestartPolicy_ALWAYS
-	}
-	apiContainerService, err := server.NewApiContainerService(
-		filesArtifactStore,
-		serviceNetwork,
-		startosisRunner,
-		startosisInterpreter,
-		gitPackageContentProvider,
-		restartPolicy,
-		metricsClient,
-		githubAuthProvider,
-		starlarkRunRepository,
-		interpretationTimeValueStore,
-	)
-	if err != nil {
-		return stacktrace.Propagate(err, "An error occurred creating the API container service")
-	}
-
-	apiContainerServiceRegistrationFunc := func(grpcServer *grpc.Server) {
-		kurtosis_core_rpc_api_bindings.RegisterApiContainerServiceServer(grpcServer, apiContainerService)
-	}
 	apiContainerServer := minimal_grpc_server.NewMinimalGRPCServer(
 		serverArgs.GrpcListenPortNum,
 		grpcServerStopGracePeriod,
 		[]func(*grpc.Server){
-			apiContainerServiceRegistrationFunc,
+			apiContainer.RegisterService,
 		},
 	)
 
@@ -279,52 +208,6 @@ func runMain() error {
 	return nil
 }
 
-func createServiceNetwork(
-	kurtosisBackend backend_interface.KurtosisBackend,
-	enclaveDataDir *enclave_data_directory.EnclaveDataDirectory,
-	args *args.APIContainerArgs,
-	ownIpAddress net.IP,
-	enclaveDb *enclave_db.EnclaveDB,
-) (service_network.ServiceNetwork, error) {
-	enclaveIdStr := args.EnclaveUUID
-	enclaveUuid := enclave.EnclaveUUID(enclaveIdStr)
-
-	apiContainerInfo := service_network.NewApiContainerInfo(
-		ownIpAddress,
-		args.GrpcListenPortNum,
-		args.Version,
-	)
-
-	serviceNetwork, err := service_network.NewDefaultServiceNetwork(
-		enclaveUuid,
-		apiContainerInfo,
-		kurtosisBackend,
-		enclaveDataDir,
-		enclaveDb,
-	)
-
-	if err != nil {
-		return nil, stacktrace.Propagate(err, "An error occurred while creating the default service network")
-	}
-	return serviceNetwork, nil
-}
-
-func createStarlarkValueSerde() *kurtosis_types.StarlarkValueSerde {
-	starlarkThread := &starlark.Thread{
-		Name:       "starlark-serde-thread",
-		Print:      nil,
-		Load:       nil,
-		OnMaxSteps: nil,
-		Steps:      0,
-	}
-	starlarkEnv := startosis_engine.Predeclared()
-	builtins := startosis_engine.KurtosisTypeConstructors()
-	for _, builtin := range builtins {
-		starlarkEnv[builtin.Name()] = builtin
-	}
-	return kurtosis_types.NewStarlarkValueSerde(starlarkThread, starlarkEnv)
-}
-
 func formatFilenameFunctionForLogs(filename string, functionName string) string {
 	var output strings.Builder
 	output.WriteString("[")
@@ -0,0 +1,93 @@
+package activity_tracker
+
+import (
+	"context"
+	"sync"
+	"time"
+
+	"google.golang.org/grpc"
+)
+
+// The signature of grpc.MethodDesc handlers, which gRPC doesn't export
+type methodHandler = func(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error)
+
+// ActivityTracker records when the API container last served a request, so the engine can tell how long the enclave
+// has been idle for. Requests still being served, e.g. a long Starlark run, keep the enclave active until they end
+type ActivityTracker struct {
+	mutex *sync.RWMutex
+
+	lastActivityTime time.Time
+
+	numRequestsInFlight int
+}
+
+func NewActivityTracker() *ActivityTracker {
+	return &ActivityTracker{
+		mutex:               &sync.RWMutex{},
+		lastActivityTime:    time.Now(),
+		numRequestsInFlight: 0,
+	}
+}
+
+func (tracker *ActivityTracker) GetLastActivityTime() time.Time {
+	tracker.mutex.RLock()
+	defer tracker.mutex.RUnlock()
+	if tracker.numRequestsInFlight > 0 {
+		return time.Now()
+	}
+	return tracker.lastActivityTime
+}
+
+// WrapServiceDesc returns a copy of the service description whose handlers record their requests as activity, except
+// for the methods that are ignored, e.g. the one the engine polls to get the last activity time
+func (tracker *ActivityTracker) WrapServiceDesc(serviceDesc *grpc.ServiceDesc, ignoredMethodNames map[string]bool) *grpc.ServiceDesc {
+	wrappedServiceDesc := *serviceDesc
+
+	wrappedServiceDesc.Methods = []grpc.MethodDesc{}
+	for _, methodDesc := range serviceDesc.Methods {
+		if !ignoredMethodNames[methodDesc.MethodName] {
+			methodDesc.Handler = tracker.wrapMethodHandler(methodDesc.Handler)
+		}
+		wrappedServiceDesc.Methods = append(wrappedServiceDesc.Methods, methodDesc)
+	}
+
+	wrappedServiceDesc.Streams = []grpc.StreamDesc{}
+	for _, streamDesc := range serviceDesc.Streams {
+		if !ignoredMethodNames[streamDesc.StreamName] {
+			streamDesc.Handler = tracker.wrapStreamHandler(streamDesc.Handler)
+		}
+		wrappedServiceDesc.Streams = append(wrappedServiceDesc.Streams, streamDesc)
+	}
+
+	return &wrappedServiceDesc
+}
+
+func (tracker *ActivityTracker) wrapMethodHandler(handler methodHandler) methodHandler {
+	return func(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+		tracker.startRequest()
+		defer tracker.endRequest()
+		return handler(srv, ctx, dec, interceptor)
+	}
+}
+
+func (tracker *ActivityTracker) wrapStreamHandler(handler grpc.StreamHandler) grpc.StreamHandler {
+	return func(srv interface{}, stream grpc.ServerStream) error {
+		tracker.startRequest()
+		defer tracker.endRequest()
+		return handler(srv, stream)
+	}
+}
+
+func (tracker *ActivityTracker) startRequest() {
+	tracker.mutex.Lock()
+	defer tracker.mutex.Unlock()
+	tracker.numRequestsInFlight++
+	tracker.lastActivityTime = time.Now()
+}
+
+func (tracker *ActivityTracker) endRequest() {
+	tracker.mutex.Lock()
+	defer tracker.mutex.Unlock()
+	tracker.numRequestsInFlight--
+	tracker.lastActivityTime = time.Now()
+}
@@ -0,0 +1,66 @@
+package activity_tracker
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+	"google.golang.org/grpc"
+)
+
+const (
+	trackedMethodName = "Tracked"
+	ignoredMethodName = "Ignored"
+	trackedStreamName = "TrackedStream"
+)
+
+func TestWrapServiceDesc(t *testing.T) {
+	tracker := NewActivityTracker()
+	initialActivityTime := tracker.GetLastActivityTime()
+
+	var activityTimeDuringStream time.Time
+	serviceDesc := &grpc.ServiceDesc{
+		ServiceName: "TestService",
+		HandlerType: nil,
+		Methods: []grpc.MethodDesc{
+			{MethodName: trackedMethodName, Handler: noOpMethodHandler},
+			{MethodName: ignoredMethodName, Handler: noOpMethodHandler},
+		},
+		Streams: []grpc.StreamDesc{
+			{
+				StreamName: trackedStreamName,
+				Handler: func(srv interface{}, stream grpc.ServerStream) error {
+					time.Sleep(time.Millisecond)
+					activityTimeDuringStream = tracker.GetLastActivityTime()
+					return nil
+				},
+				ServerStreams: true,
+				ClientStreams: false,
+			},
+		},
+		Metadata: "",
+	}
+	wrappedServiceDesc := tracker.WrapServiceDesc(serviceDesc, map[string]bool{ignoredMethodName: true})
+	require.Len(t, wrappedServiceDesc.Methods, 2)
+	require.Len(t, wrappedServiceDesc.Streams, 1)
+
+	time.Sleep(time.Millisecond)
+	_, err := wrappedServiceDesc.Methods[1].Handler(nil, context.Background(), nil, nil)
+	require.NoError(t, err)
+	require.Equal(t, initialActivityTime, tracker.GetLastActivityTime())
+
+	_, err = wrappedServiceDesc.Methods[0].Handler(nil, context.Background(), nil, nil)
+	require.NoError(t, err)
+	activityTimeAfterMethod := tracker.GetLastActivityTime()
+	require.True(t, activityTimeAfterMethod.After(initialActivityTime))
+
+	require.NoError(t, wrappedServiceDesc.Streams[0].Handler(nil, nil))
+	// The enclave counts as active for as long as the stream is being served
+	require.True(t, activityTimeDuringStream.After(activityTimeAfterMethod))
+	require.False(t, tracker.GetLastActivityTime().Before(activityTimeDuringStream))
+}
+
+func noOpMethodHandler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	return nil, nil
+}
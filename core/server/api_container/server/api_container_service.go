@@ -17,6 +17,7 @@ import (
 	"net/http"
 	"os"
 	"path"
+	"sort"
 	"strings"
 	"time"
 	"unicode"
@@ -24,6 +25,7 @@ import (
 	"github.com/kurtosis-tech/kurtosis/container-engine-lib/lib/backend_interface/objects/service_user"
 	"github.com/kurtosis-tech/kurtosis/core/server/api_container/server/startosis_engine"
 	"github.com/kurtosis-tech/kurtosis/core/server/api_container/server/startosis_engine/docker_compose_transpiler"
+	"github.com/kurtosis-tech/kurtosis/core/server/api_container/server/startosis_engine/enclave_plan_persistence"
 	"github.com/kurtosis-tech/kurtosis/core/server/api_container/server/startosis_engine/enclave_structure"
 	"github.com/kurtosis-tech/kurtosis/core/server/api_container/server/startosis_engine/instructions_plan"
 	"github.com/kurtosis-tech/kurtosis/core/server/api_container/server/startosis_engine/instructions_plan/resolver"
@@ -43,16 +45,23 @@ import (
 	"github.com/kurtosis-tech/kurtosis/container-engine-lib/lib/backend_interface/objects/image_download_mode"
 	"github.com/kurtosis-tech/kurtosis/container-engine-lib/lib/backend_interface/objects/port_spec"
 	"github.com/kurtosis-tech/kurtosis/container-engine-lib/lib/backend_interface/objects/service"
+	"github.com/kurtosis-tech/kurtosis/container-engine-lib/lib/event_broadcaster"
+	"github.com/kurtosis-tech/kurtosis/core/server/api_container/server/activity_tracker"
+	"github.com/kurtosis-tech/kurtosis/core/server/api_container/server/audit_log"
+	"github.com/kurtosis-tech/kurtosis/core/server/api_container/server/secret_store"
 	"github.com/kurtosis-tech/kurtosis/core/server/api_container/server/service_network"
 	"github.com/kurtosis-tech/kurtosis/core/server/api_container/server/startosis_engine/startosis_constants"
 	"github.com/kurtosis-tech/kurtosis/core/server/api_container/server/startosis_engine/startosis_errors"
 	"github.com/kurtosis-tech/kurtosis/core/server/api_container/server/startosis_engine/startosis_packages"
+	"github.com/kurtosis-tech/kurtosis/core/server/api_container/server/startosis_engine/unit_test_runner"
 	"github.com/kurtosis-tech/kurtosis/core/server/commons/enclave_data_directory"
 	"github.com/kurtosis-tech/kurtosis/grpc-file-transfer/golang/grpc_file_streaming"
 	"github.com/kurtosis-tech/stacktrace"
 	"github.com/sirupsen/logrus"
 	"google.golang.org/grpc"
+	"google.golang.org/protobuf/types/known/durationpb"
 	"google.golang.org/protobuf/types/known/emptypb"
+	"google.golang.org/protobuf/types/known/timestamppb"
 )
 
 const (
@@ -80,6 +89,9 @@ const (
 	isNotScript              = false
 	isNotRemote              = false
 	defaultParallelism       = 4
+
+	// Docker itself takes about a second to sample each service
+	defaultServiceStatsInterval = 2 * time.Second
 )
 
 // Guaranteed (by a unit test) to be a 1:1 mapping between API port protos and port spec protos
@@ -112,6 +124,14 @@ type ApiContainerService struct {
 	// TODO: Either mutex protect the interpretationTimeValueStore OR compose the interpretationTimeValueStore of a separate mutex protected `serviceConfigRepository` object
 	// and allow both ApiContainerService and interpretationTimeValueStore to have access to that
 	interpretationTimeValueStore *interpretation_time_value_store.InterpretationTimeValueStore
+
+	secretStore *secret_store.SecretStore
+
+	activityTracker *activity_tracker.ActivityTracker
+
+	auditLog *audit_log.AuditLog
+
+	eventBroadcaster *event_broadcaster.EventBroadcaster[*kurtosis_core_rpc_api_bindings.ApiContainerEvent]
 }
 
 func NewApiContainerService(
@@ -125,6 +145,9 @@ func NewApiContainerService(
 	githubAuthProvider *git_package_content_provider.GitHubPackageAuthProvider,
 	starlarkRunRepository *starlark_run.StarlarkRunRepository,
 	interpretationTimeValueStore *interpretation_time_value_store.InterpretationTimeValueStore,
+	secretStore *secret_store.SecretStore,
+	activityTracker *activity_tracker.ActivityTracker,
+	auditLog *audit_log.AuditLog,
 ) (*ApiContainerService, error) {
 
 	if err := initStarlarkRun(starlarkRunRepository, restartPolicy); err != nil {
@@ -141,6 +164,10 @@ func NewApiContainerService(
 		metricsClient:                metricsClient,
 		githubAuthProvider:           githubAuthProvider,
 		interpretationTimeValueStore: interpretationTimeValueStore,
+		secretStore:                  secretStore,
+		activityTracker:              activityTracker,
+		auditLog:                     auditLog,
+		eventBroadcaster:             event_broadcaster.NewEventBroadcaster[*kurtosis_core_rpc_api_bindings.ApiContainerEvent](),
 	}
 
 	return service, nil
@@ -180,6 +207,7 @@ func (apicService *ApiContainerService) RunStarlarkScript(args *kurtosis_core_rp
 		nonBlockingMode,
 		shouldExecuteInParallel,
 		experimentalFeatures,
+		args.GetSecrets(),
 		stream,
 	)
 
@@ -378,6 +406,7 @@ func (apicService *ApiContainerService) RunStarlarkPackage(args *kurtosis_core_r
 		nonBlockingMode,
 		shouldExecuteInParallel,
 		args.ExperimentalFeatures,
+		args.GetSecrets(),
 		stream)
 
 	return nil
@@ -765,11 +794,142 @@ func (apicService *ApiContainerService) GetStarlarkScriptPlanYaml(ctx context.Co
 	return &kurtosis_core_rpc_api_bindings.PlanYaml{PlanYaml: planYamlStr}, nil
 }
 
+func (apicService *ApiContainerService) GetLastActivityTime(_ context.Context, _ *emptypb.Empty) (*kurtosis_core_rpc_api_bindings.GetLastActivityTimeResponse, error) {
+	return &kurtosis_core_rpc_api_bindings.GetLastActivityTimeResponse{
+		LastActivityTime: timestamppb.New(apicService.activityTracker.GetLastActivityTime()),
+	}, nil
+}
+
+func (apicService *ApiContainerService) GetEnclavePlan(_ context.Context, _ *emptypb.Empty) (*kurtosis_core_rpc_api_bindings.GetEnclavePlanResponse, error) {
+	enclavePlan, err := apicService.startosisRunner.GetEnclavePlan()
+	if err != nil {
+		return nil, stacktrace.Propagate(err, "An error occurred getting the enclave plan")
+	}
+	instructions := []*kurtosis_core_rpc_api_bindings.EnclavePlanInstruction{}
+	for _, enclavePlanInstruction := range enclavePlan.GeneratePlan() {
+		instructions = append(instructions, transformEnclavePlanInstructionToApi(enclavePlanInstruction))
+	}
+	return &kurtosis_core_rpc_api_bindings.GetEnclavePlanResponse{
+		Instructions: instructions,
+	}, nil
+}
+
+func (apicService *ApiContainerService) GetAuditLog(_ context.Context, _ *emptypb.Empty) (*kurtosis_core_rpc_api_bindings.GetAuditLogResponse, error) {
+	entries, err := apicService.auditLog.GetEntries()
+	if err != nil {
+		return nil, stacktrace.Propagate(err, "An error occurred getting the entries of the audit log")
+	}
+	return &kurtosis_core_rpc_api_bindings.GetAuditLogResponse{
+		Entries: entries,
+	}, nil
+}
+
+func (apicService *ApiContainerService) RenameService(ctx context.Context, args *kurtosis_core_rpc_api_bindings.RenameServiceArgs) (*emptypb.Empty, error) {
+	serviceIdentifier := args.GetServiceIdentifier()
+	newServiceName := service.ServiceName(args.GetNewName())
+	serviceToRename, err := apicService.serviceNetwork.GetService(ctx, serviceIdentifier)
+	if err != nil {
+		return nil, stacktrace.Propagate(err, "An error occurred getting service '%s'", serviceIdentifier)
+	}
+	serviceName := serviceToRename.GetRegistration().GetName()
+
+	renamedService, err := apicService.serviceNetwork.RenameService(ctx, serviceIdentifier, newServiceName)
+	if err != nil {
+		return nil, stacktrace.Propagate(err, "An error occurred renaming service '%s' to '%s'", serviceName, newServiceName)
+	}
+	apicService.eventBroadcaster.Publish(newServiceEvent(kurtosis_core_rpc_api_bindings.ApiContainerEventType_SERVICE_REMOVED, serviceName))
+	apicService.eventBroadcaster.Publish(newServiceEvent(kurtosis_core_rpc_api_bindings.ApiContainerEventType_SERVICE_ADDED, newServiceName))
+
+	if err := apicService.startosisRunner.RenameService(serviceName, newServiceName, renamedService.GetRegistration().GetHostname()); err != nil {
+		return nil, stacktrace.Propagate(err, "Service '%s' was renamed to '%s' but an error occurred updating the enclave plan, so the next Starlark run may not match the services of the enclave", serviceName, newServiceName)
+	}
+	return &emptypb.Empty{}, nil
+}
+
+func (apicService *ApiContainerService) WatchEvents(_ *emptypb.Empty, stream kurtosis_core_rpc_api_bindings.ApiContainerService_WatchEventsServer) error {
+	events, unsubscribe := apicService.eventBroadcaster.Subscribe()
+	defer unsubscribe()
+
+	for {
+		select {
+		case <-stream.Context().Done():
+			return nil
+		case event := <-events:
+			if err := stream.Send(event); err != nil {
+				return stacktrace.Propagate(err, "An error occurred sending event '%v' through the stream", event.GetType())
+			}
+		}
+	}
+}
+
+func (apicService *ApiContainerService) GetServiceStats(args *kurtosis_core_rpc_api_bindings.GetServiceStatsArgs, stream kurtosis_core_rpc_api_bindings.ApiContainerService_GetServiceStatsServer) error {
+	interval := defaultServiceStatsInterval
+	if args.Interval != nil {
+		interval = args.GetInterval().AsDuration()
+	}
+	for {
+		serviceStatsSample, err := apicService.getServiceStatsSample(stream.Context(), args.GetServiceIdentifiers())
+		if err != nil {
+			return stacktrace.Propagate(err, "An error occurred sampling the stats of services '%v'", args.GetServiceIdentifiers())
+		}
+		if err := stream.Send(serviceStatsSample); err != nil {
+			return stacktrace.Propagate(err, "An error occurred sending the stats of services through the stream")
+		}
+		if !args.GetFollow() {
+			return nil
+		}
+		select {
+		case <-stream.Context().Done():
+			return nil
+		case <-time.After(interval):
+		}
+	}
+}
+
+// RunStarlarkPackageUnitTests runs the tests against a fake enclave of their own, so the services of this enclave are
+// neither seen nor changed by them
+func (apicService *ApiContainerService) RunStarlarkPackageUnitTests(ctx context.Context, args *kurtosis_core_rpc_api_bindings.RunStarlarkPackageUnitTestsArgs) (*kurtosis_core_rpc_api_bindings.RunStarlarkPackageUnitTestsResponse, error) {
+	packageRootDirpath, interpretationErr := apicService.packageContentProvider.GetOnDiskAbsolutePackagePath(args.GetPackageId())
+	if interpretationErr != nil {
+		return nil, stacktrace.Propagate(interpretationErr, "An error occurred getting the path of package '%v', it needs to be uploaded first", args.GetPackageId())
+	}
+	results, err := unit_test_runner.RunPackageUnitTests(ctx, packageRootDirpath)
+	if err != nil {
+		return nil, stacktrace.Propagate(err, "An error occurred running the unit tests of package '%v'", args.GetPackageId())
+	}
+	apiResults := []*kurtosis_core_rpc_api_bindings.StarlarkUnitTestResult{}
+	for _, result := range results {
+		apiResults = append(apiResults, &kurtosis_core_rpc_api_bindings.StarlarkUnitTestResult{
+			TestFile:           result.TestFile,
+			TestName:           result.TestName,
+			FailureMessage:     result.FailureMessage,
+			InstructionOutputs: result.InstructionOutputs,
+			Duration:           durationpb.New(result.Duration),
+		})
+	}
+	return &kurtosis_core_rpc_api_bindings.RunStarlarkPackageUnitTestsResponse{Results: apiResults}, nil
+}
+
 // ====================================================================================================
 //
 //	Private helper methods
 //
 // ====================================================================================================
+func transformEnclavePlanInstructionToApi(enclavePlanInstruction *enclave_plan_persistence.EnclavePlanInstruction) *kurtosis_core_rpc_api_bindings.EnclavePlanInstruction {
+	filesArtifactNames := []string{}
+	for filesArtifactName := range enclavePlanInstruction.FilesArtifacts {
+		filesArtifactNames = append(filesArtifactNames, filesArtifactName)
+	}
+	sort.Strings(filesArtifactNames)
+	return &kurtosis_core_rpc_api_bindings.EnclavePlanInstruction{
+		Uuid:               enclavePlanInstruction.Uuid,
+		Type:               enclavePlanInstruction.Type,
+		StarlarkCode:       enclavePlanInstruction.StarlarkCode,
+		ServiceNames:       enclavePlanInstruction.ServiceNames,
+		FilesArtifactNames: filesArtifactNames,
+	}
+}
+
 func transformPortSpecToApiPort(port *port_spec.PortSpec) (*kurtosis_core_rpc_api_bindings.Port, error) {
 	portNumUint16 := port.GetNumber()
 	portSpecProto := port.GetTransportProtocol()
@@ -945,6 +1105,8 @@ func (apicService *ApiContainerService) getServiceInfoForIdentifier(ctx context.
 	if err != nil {
 		return nil, stacktrace.Propagate(err, "A error occurred while converting service obj for service with id '%v' to service info", serviceIdentifier)
 	}
+	// the container runs with the secret values, they are masked as for any other output of the APIC
+	apicService.secretStore.MaskValuesInProtoMessage(serviceInfo.ProtoReflect())
 	return serviceInfo, nil
 }
 
@@ -1040,9 +1202,14 @@ func (apicService *ApiContainerService) runStarlark(
 	nonBlockingMode bool,
 	shouldExecuteInParallel bool,
 	experimentalFeatures []kurtosis_core_rpc_api_bindings.KurtosisFeatureFlag,
+	secrets map[string]string,
 	stream grpc.ServerStream,
 ) {
-	responseLineStream := apicService.startosisRunner.Run(stream.Context(), dryRun, parallelism, packageId, packageReplaceOptions, mainFunctionName, relativePathToMainFile, serializedStarlark, serializedParams, imageDownloadMode, nonBlockingMode, shouldExecuteInParallel, experimentalFeatures)
+	// Service changes are found by comparing the services before and after the run, as Starlark is what changes them
+	serviceStatusesBeforeRun := apicService.getServiceStatuses(stream.Context())
+	apicService.eventBroadcaster.Publish(newStarlarkRunEvent(kurtosis_core_rpc_api_bindings.ApiContainerEventType_STARLARK_RUN_STARTED, packageId, nil))
+
+	responseLineStream := apicService.startosisRunner.Run(stream.Context(), dryRun, parallelism, packageId, packageReplaceOptions, mainFunctionName, relativePathToMainFile, serializedStarlark, serializedParams, imageDownloadMode, nonBlockingMode, shouldExecuteInParallel, experimentalFeatures, secrets)
 	for {
 		select {
 		case <-stream.Context().Done():
@@ -1080,6 +1247,15 @@ func (apicService *ApiContainerService) runStarlark(
 				if err := apicService.metricsClient.TrackKurtosisRunFinishedEvent(packageId, numberOfServicesAfterRunFinished, isSuccessful, serializedParams); err != nil {
 					logrus.Warn("An error occurred tracking the run-finished event")
 				}
+
+				if serviceStatusesBeforeRun != nil {
+					if serviceStatusesAfterRun := apicService.getServiceStatuses(stream.Context()); serviceStatusesAfterRun != nil {
+						for _, serviceEvent := range getServiceChangeEvents(serviceStatusesBeforeRun, serviceStatusesAfterRun) {
+							apicService.eventBroadcaster.Publish(serviceEvent)
+						}
+					}
+				}
+				apicService.eventBroadcaster.Publish(newStarlarkRunEvent(kurtosis_core_rpc_api_bindings.ApiContainerEventType_STARLARK_RUN_FINISHED, packageId, &isSuccessful))
 			}
 			// in addition to send the msg to the RPC stream, we also print the lines to the APIC logs at debug level
 			logrus.Debugf("Received response line from Starlark runner: '%v'", responseLine)
@@ -1106,6 +1282,7 @@ func (apicService *ApiContainerService) getServiceInfosFromServiceObjs(services
 		if err != nil {
 			return nil, stacktrace.Propagate(err, "There was an error converting the service obj for service with uuid '%v' and name '%v' to service info", uuid, serviceObj.GetRegistration().GetName())
 		}
+		apicService.secretStore.MaskValuesInProtoMessage(serviceInfo.ProtoReflect())
 		serviceInfos[serviceInfo.Name] = serviceInfo
 	}
 	return serviceInfos, nil
@@ -1162,6 +1339,13 @@ func getServiceInfoFromServiceObj(serviceObj *service.Service, serviceConfig *se
 		serviceDirPathsToFilesArtifactsList = transformServiceDirPathsToFileArtifactsToApiPortsFilesArtifactsList(serviceConfig.GetFilesArtifactsExpansion().ServiceDirpathsToArtifactIdentifiers)
 	}
 
+	serviceDirPathsToPersistentKeys := map[string]string{}
+	if serviceConfig.GetPersistentDirectories() != nil {
+		for serviceDirPath, persistentDirectory := range serviceConfig.GetPersistentDirectories().ServiceDirpathToPersistentDirectory {
+			serviceDirPathsToPersistentKeys[serviceDirPath] = string(persistentDirectory.PersistentKey)
+		}
+	}
+
 	var user *service_user.ServiceUser
 	var apiUser *kurtosis_core_rpc_api_bindings.User
 	if serviceConfig.GetUser() != nil {
@@ -1210,6 +1394,7 @@ func getServiceInfoFromServiceObj(serviceObj *service.Service, serviceConfig *se
 		serviceConfig.GetLabels(),
 		serviceConfig.GetTiniEnabled(),
 		serviceConfig.GetTtyEnabled(),
+		serviceDirPathsToPersistentKeys,
 	)
 
 	return serviceInfoResponse, nil
@@ -1343,3 +1528,100 @@ func initStarlarkRun(
 
 	return nil
 }
+
+// Returns nil if the services couldn't be retrieved, in which case no service events get published for the run
+func (apicService *ApiContainerService) getServiceStatuses(ctx context.Context) map[service.ServiceName]service.ServiceStatus {
+	services, err := apicService.serviceNetwork.GetServices(ctx)
+	if err != nil {
+		logrus.Warnf("An error occurred getting the services, the service events of the Starlark run won't be published. Error was:\n%v", err)
+		return nil
+	}
+	serviceStatuses := map[service.ServiceName]service.ServiceStatus{}
+	for _, serviceObj := range services {
+		serviceStatuses[serviceObj.GetRegistration().GetName()] = serviceObj.GetRegistration().GetStatus()
+	}
+	return serviceStatuses
+}
+
+func getServiceChangeEvents(serviceStatusesBefore map[service.ServiceName]service.ServiceStatus, serviceStatusesAfter map[service.ServiceName]service.ServiceStatus) []*kurtosis_core_rpc_api_bindings.ApiContainerEvent {
+	serviceChangeEvents := []*kurtosis_core_rpc_api_bindings.ApiContainerEvent{}
+	for serviceName, statusAfter := range serviceStatusesAfter {
+		statusBefore, existedBefore := serviceStatusesBefore[serviceName]
+		if !existedBefore {
+			serviceChangeEvents = append(serviceChangeEvents, newServiceEvent(kurtosis_core_rpc_api_bindings.ApiContainerEventType_SERVICE_ADDED, serviceName))
+		} else if statusAfter == service.ServiceStatus_Stopped && statusBefore != service.ServiceStatus_Stopped {
+			serviceChangeEvents = append(serviceChangeEvents, newServiceEvent(kurtosis_core_rpc_api_bindings.ApiContainerEventType_SERVICE_STOPPED, serviceName))
+		}
+	}
+	for serviceName := range serviceStatusesBefore {
+		if _, existsAfter := serviceStatusesAfter[serviceName]; !existsAfter {
+			serviceChangeEvents = append(serviceChangeEvents, newServiceEvent(kurtosis_core_rpc_api_bindings.ApiContainerEventType_SERVICE_REMOVED, serviceName))
+		}
+	}
+	return serviceChangeEvents
+}
+
+func newServiceEvent(eventType kurtosis_core_rpc_api_bindings.ApiContainerEventType, serviceName service.ServiceName) *kurtosis_core_rpc_api_bindings.ApiContainerEvent {
+	serviceNameStr := string(serviceName)
+	return &kurtosis_core_rpc_api_bindings.ApiContainerEvent{
+		Type:                 eventType,
+		Timestamp:            timestamppb.Now(),
+		ServiceName:          &serviceNameStr,
+		StarlarkPackageId:    nil,
+		StarlarkRunSucceeded: nil,
+	}
+}
+
+// The package ID is left unset for standalone scripts
+func newStarlarkRunEvent(eventType kurtosis_core_rpc_api_bindings.ApiContainerEventType, packageId string, isSuccessful *bool) *kurtosis_core_rpc_api_bindings.ApiContainerEvent {
+	var maybePackageId *string
+	if packageId != startosis_constants.PackageIdPlaceholderForStandaloneScript {
+		maybePackageId = &packageId
+	}
+	return &kurtosis_core_rpc_api_bindings.ApiContainerEvent{
+		Type:                 eventType,
+		Timestamp:            timestamppb.Now(),
+		ServiceName:          nil,
+		StarlarkPackageId:    maybePackageId,
+		StarlarkRunSucceeded: isSuccessful,
+	}
+}
+
+func (apicService *ApiContainerService) getServiceStatsSample(ctx context.Context, serviceIdentifiers []string) (*kurtosis_core_rpc_api_bindings.GetServiceStatsResponse, error) {
+	timestamp := time.Now()
+	serviceStatsByUuid, err := apicService.serviceNetwork.GetServiceStats(ctx, serviceIdentifiers)
+	if err != nil {
+		return nil, stacktrace.Propagate(err, "An error occurred getting the stats of services '%v'", serviceIdentifiers)
+	}
+	services, err := apicService.serviceNetwork.GetServices(ctx)
+	if err != nil {
+		return nil, stacktrace.Propagate(err, "An error occurred getting the services to name their stats")
+	}
+
+	apiServiceStats := []*kurtosis_core_rpc_api_bindings.ServiceStats{}
+	for serviceUuid, serviceStats := range serviceStatsByUuid {
+		serviceObj, found := services[serviceUuid]
+		if !found {
+			// The service got removed while it was being sampled
+			continue
+		}
+		apiServiceStats = append(apiServiceStats, &kurtosis_core_rpc_api_bindings.ServiceStats{
+			ServiceName:          string(serviceObj.GetRegistration().GetName()),
+			ServiceUuid:          string(serviceUuid),
+			CpuPercent:           serviceStats.GetCpuPercent(),
+			MemoryUsageBytes:     serviceStats.GetMemoryUsageBytes(),
+			MemoryLimitBytes:     serviceStats.GetMemoryLimitBytes(),
+			NetworkReceivedBytes: serviceStats.GetNetworkReceivedBytes(),
+			NetworkSentBytes:     serviceStats.GetNetworkSentBytes(),
+			DiskReadBytes:        serviceStats.GetDiskReadBytes(),
+			DiskWrittenBytes:     serviceStats.GetDiskWrittenBytes(),
+		})
+	}
+	sort.Slice(apiServiceStats, func(i, j int) bool {
+		return apiServiceStats[i].GetServiceName() < apiServiceStats[j].GetServiceName()
+	})
+	return &kurtosis_core_rpc_api_bindings.GetServiceStatsResponse{
+		Timestamp:    timestamppb.New(timestamp),
+		ServiceStats: apiServiceStats,
+	}, nil
+}
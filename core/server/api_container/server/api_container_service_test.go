@@ -6,8 +6,11 @@
 package server
 
 import (
+	"context"
 	"github.com/kurtosis-tech/kurtosis/api/golang/core/kurtosis_core_rpc_api_bindings"
 	"github.com/kurtosis-tech/kurtosis/container-engine-lib/lib/backend_interface/objects/port_spec"
+	"github.com/kurtosis-tech/kurtosis/container-engine-lib/lib/backend_interface/objects/service"
+	"github.com/kurtosis-tech/kurtosis/core/server/api_container/server/service_network"
 	"github.com/stretchr/testify/require"
 	"strings"
 	"testing"
@@ -50,3 +53,57 @@ line
 	require.NotNil(t, output)
 	require.Equal(t, expectedOutput, *output)
 }
+
+func TestGetServiceChangeEvents(t *testing.T) {
+	serviceStatusesBefore := map[service.ServiceName]service.ServiceStatus{
+		"unchanged": service.ServiceStatus_Started,
+		"stopped":   service.ServiceStatus_Started,
+		"removed":   service.ServiceStatus_Started,
+		"restarted": service.ServiceStatus_Stopped,
+	}
+	serviceStatusesAfter := map[service.ServiceName]service.ServiceStatus{
+		"unchanged": service.ServiceStatus_Started,
+		"stopped":   service.ServiceStatus_Stopped,
+		"restarted": service.ServiceStatus_Started,
+		"added":     service.ServiceStatus_Started,
+	}
+
+	eventTypesByServiceName := map[string]kurtosis_core_rpc_api_bindings.ApiContainerEventType{}
+	for _, event := range getServiceChangeEvents(serviceStatusesBefore, serviceStatusesAfter) {
+		eventTypesByServiceName[event.GetServiceName()] = event.GetType()
+	}
+	require.Equal(t, map[string]kurtosis_core_rpc_api_bindings.ApiContainerEventType{
+		"added":   kurtosis_core_rpc_api_bindings.ApiContainerEventType_SERVICE_ADDED,
+		"stopped": kurtosis_core_rpc_api_bindings.ApiContainerEventType_SERVICE_STOPPED,
+		"removed": kurtosis_core_rpc_api_bindings.ApiContainerEventType_SERVICE_REMOVED,
+	}, eventTypesByServiceName)
+}
+
+func TestGetServiceStatsSample(t *testing.T) {
+	ctx := context.Background()
+	serviceNetwork := service_network.NewMockServiceNetwork(t)
+	serviceNetwork.EXPECT().GetServiceStats(ctx, []string{}).Return(map[service.ServiceUUID]*service.ServiceStats{
+		"uuid-node":    service.NewServiceStats(50, 100, 1000, 1, 2, 3, 4),
+		"uuid-db":      service.NewServiceStats(10, 200, 0, 5, 6, 7, 8),
+		"uuid-removed": service.NewServiceStats(0, 0, 0, 0, 0, 0, 0),
+	}, nil)
+	serviceNetwork.EXPECT().GetServices(ctx).Return(map[service.ServiceUUID]*service.Service{
+		"uuid-node": service.NewService(service.NewServiceRegistration("node", "uuid-node", "enclave", nil, "node"), nil, nil, nil, nil),
+		"uuid-db":   service.NewService(service.NewServiceRegistration("db", "uuid-db", "enclave", nil, "db"), nil, nil, nil, nil),
+	}, nil)
+	apicService := &ApiContainerService{serviceNetwork: serviceNetwork} //nolint:exhaustruct
+
+	serviceStatsSample, err := apicService.getServiceStatsSample(ctx, []string{})
+	require.NoError(t, err)
+	require.NotNil(t, serviceStatsSample.GetTimestamp())
+	// sorted by name, leaving out the service that got removed while it was being sampled
+	require.Len(t, serviceStatsSample.GetServiceStats(), 2)
+	dbStats := serviceStatsSample.GetServiceStats()[0]
+	require.Equal(t, "db", dbStats.GetServiceName())
+	require.Equal(t, "uuid-db", dbStats.GetServiceUuid())
+	require.Equal(t, float64(10), dbStats.GetCpuPercent())
+	require.Equal(t, uint64(200), dbStats.GetMemoryUsageBytes())
+	require.Equal(t, uint64(8), dbStats.GetDiskWrittenBytes())
+	require.Equal(t, "node", serviceStatsSample.GetServiceStats()[1].GetServiceName())
+	require.Equal(t, uint64(1000), serviceStatsSample.GetServiceStats()[1].GetMemoryLimitBytes())
+}
@@ -0,0 +1,254 @@
+package audit_log
+
+import (
+	"context"
+	"crypto/subtle"
+	"encoding/binary"
+	"encoding/json"
+	"fmt"
+	"time"
+
+	"github.com/kurtosis-tech/kurtosis/api/golang/core/kurtosis_core_rpc_api_bindings"
+	"github.com/kurtosis-tech/kurtosis/api/golang/core/lib/shared_utils"
+	"github.com/kurtosis-tech/kurtosis/container-engine-lib/lib/database_accessors/enclave_db"
+	"github.com/kurtosis-tech/kurtosis/core/server/api_container/server/secret_store"
+	"github.com/kurtosis-tech/stacktrace"
+	"github.com/sirupsen/logrus"
+	bolt "go.etcd.io/bbolt"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/metadata"
+	"google.golang.org/grpc/peer"
+	"google.golang.org/protobuf/proto"
+	"google.golang.org/protobuf/types/known/timestamppb"
+)
+
+const (
+	unknownCaller = "unknown"
+	// The address of the caller, then who it claims to be
+	unverifiedCallerFormat = "%v (unverified, claims to be '%v')"
+
+	sequenceNumberKeyLength = 8
+)
+
+var (
+	auditLogBucketName = []byte("audit-log")
+)
+
+// The signature of grpc.MethodDesc handlers, which gRPC doesn't export
+type methodHandler = func(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error)
+
+// AuditLog records who called the API container methods changing the enclave, with which arguments, in the enclave DB.
+// Entries are only ever appended, keyed by their sequence number
+type AuditLog struct {
+	enclaveDb *enclave_db.EnclaveDB
+
+	secretStore *secret_store.SecretStore
+
+	// Only the callers sent along with it are trusted, empty if the API container was launched by an engine predating it
+	engineCallerKey string
+}
+
+type auditLogEntry struct {
+	SequenceNumber uint64            `json:"sequenceNumber"`
+	Timestamp      time.Time         `json:"timestamp"`
+	Caller         string            `json:"caller"`
+	Action         string            `json:"action"`
+	Arguments      map[string]string `json:"arguments"`
+	Error          string            `json:"error,omitempty"`
+}
+
+func GetOrCreateAuditLog(enclaveDb *enclave_db.EnclaveDB, secretStore *secret_store.SecretStore, engineCallerKey string) (*AuditLog, error) {
+	if err := enclaveDb.Update(func(tx *bolt.Tx) error {
+		if _, err := tx.CreateBucketIfNotExists(auditLogBucketName); err != nil {
+			return stacktrace.Propagate(err, "An error occurred while creating the audit log database bucket")
+		}
+		return nil
+	}); err != nil {
+		return nil, stacktrace.Propagate(err, "An error occurred while building the audit log")
+	}
+
+	return &AuditLog{
+		enclaveDb:       enclaveDb,
+		secretStore:     secretStore,
+		engineCallerKey: engineCallerKey,
+	}, nil
+}
+
+// GetEntries returns all the entries of the audit log, oldest first
+func (auditLog *AuditLog) GetEntries() ([]*kurtosis_core_rpc_api_bindings.AuditLogEntry, error) {
+	entries := []*kurtosis_core_rpc_api_bindings.AuditLogEntry{}
+	if err := auditLog.enclaveDb.View(func(tx *bolt.Tx) error {
+		return tx.Bucket(auditLogBucketName).ForEach(func(key []byte, value []byte) error {
+			entry := &auditLogEntry{} // nolint: exhaustruct
+			if err := json.Unmarshal(value, entry); err != nil {
+				return stacktrace.Propagate(err, "An error occurred unmarshalling audit log entry '%v'", binary.BigEndian.Uint64(key))
+			}
+			entries = append(entries, toApiAuditLogEntry(entry))
+			return nil
+		})
+	}); err != nil {
+		return nil, stacktrace.Propagate(err, "An error occurred while getting the audit log entries from the enclave db")
+	}
+	return entries, nil
+}
+
+// WrapServiceDesc returns a copy of the service description whose audited methods get recorded in the audit log once
+// they've been served
+func (auditLog *AuditLog) WrapServiceDesc(serviceDesc *grpc.ServiceDesc, auditedMethodNames map[string]bool) *grpc.ServiceDesc {
+	wrappedServiceDesc := *serviceDesc
+
+	wrappedServiceDesc.Methods = []grpc.MethodDesc{}
+	for _, methodDesc := range serviceDesc.Methods {
+		if auditedMethodNames[methodDesc.MethodName] {
+			methodDesc.Handler = auditLog.wrapMethodHandler(methodDesc.MethodName, methodDesc.Handler)
+		}
+		wrappedServiceDesc.Methods = append(wrappedServiceDesc.Methods, methodDesc)
+	}
+
+	wrappedServiceDesc.Streams = []grpc.StreamDesc{}
+	for _, streamDesc := range serviceDesc.Streams {
+		if auditedMethodNames[streamDesc.StreamName] {
+			streamDesc.Handler = auditLog.wrapStreamHandler(streamDesc.StreamName, streamDesc.Handler)
+		}
+		wrappedServiceDesc.Streams = append(wrappedServiceDesc.Streams, streamDesc)
+	}
+
+	return &wrappedServiceDesc
+}
+
+func (auditLog *AuditLog) wrapMethodHandler(methodName string, handler methodHandler) methodHandler {
+	return func(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+		var args interface{}
+		recordingDec := func(in interface{}) error {
+			args = in
+			return dec(in)
+		}
+		response, err := handler(srv, ctx, recordingDec, interceptor)
+		auditLog.record(ctx, methodName, args, getErrorMessage(err))
+		return response, err
+	}
+}
+
+func (auditLog *AuditLog) wrapStreamHandler(streamName string, handler grpc.StreamHandler) grpc.StreamHandler {
+	return func(srv interface{}, stream grpc.ServerStream) error {
+		auditedStream := newAuditedServerStream(stream)
+		err := handler(srv, auditedStream)
+		errorMessage := getErrorMessage(err)
+		if errorMessage == "" {
+			errorMessage = auditedStream.starlarkRunErrorMessage
+		}
+		auditLog.record(stream.Context(), streamName, auditedStream.getArgs(), errorMessage)
+		return err
+	}
+}
+
+// Failing to record an entry doesn't fail the call, it's only logged
+func (auditLog *AuditLog) record(ctx context.Context, action string, args interface{}, errorMessage string) {
+	arguments := map[string]string{}
+	if argsMessage, ok := args.(proto.Message); ok {
+		var err error
+		if arguments, err = getRedactedArguments(argsMessage, auditLog.secretStore); err != nil {
+			logrus.Errorf("An error occurred getting the arguments of the call to '%v' for the audit log, it's recorded without them. Error was:\n%v", action, err)
+		}
+	}
+	entry := &auditLogEntry{
+		SequenceNumber: 0,
+		Timestamp:      time.Now(),
+		Caller:         getCaller(ctx, auditLog.engineCallerKey),
+		Action:         action,
+		Arguments:      arguments,
+		Error:          auditLog.secretStore.MaskValues(errorMessage),
+	}
+
+	if err := auditLog.enclaveDb.Update(func(tx *bolt.Tx) error {
+		bucket := tx.Bucket(auditLogBucketName)
+		sequenceNumber, err := bucket.NextSequence()
+		if err != nil {
+			return stacktrace.Propagate(err, "An error occurred getting the next sequence number of the audit log")
+		}
+		entry.SequenceNumber = sequenceNumber
+		entryBytes, err := json.Marshal(entry)
+		if err != nil {
+			return stacktrace.Propagate(err, "An error occurred marshalling audit log entry '%+v'", entry)
+		}
+		key := make([]byte, sequenceNumberKeyLength)
+		binary.BigEndian.PutUint64(key, sequenceNumber)
+		return bucket.Put(key, entryBytes)
+	}); err != nil {
+		logrus.Errorf("An error occurred recording the call to '%v' in the audit log. Error was:\n%v", action, err)
+	}
+}
+
+// The engine forwards who it's calling on behalf of, along with the key proving it's the engine, and the callers calling
+// directly send the credential the engine issued to them. Other callers are only known by their address, the caller
+// they report themselves as being recorded as unverified
+func getCaller(ctx context.Context, engineCallerKey string) string {
+	reportedCaller := ""
+	if incomingMetadata, found := metadata.FromIncomingContext(ctx); found {
+		if callers := incomingMetadata.Get(shared_utils.AuditLogCallerMetadataKey); len(callers) > 0 {
+			reportedCaller = callers[0]
+		}
+		if reportedCaller != "" && isForwardedByEngine(incomingMetadata, engineCallerKey) {
+			return reportedCaller
+		}
+		if credentialCaller, found := getCredentialCaller(incomingMetadata, engineCallerKey); found {
+			return credentialCaller
+		}
+	}
+
+	caller := unknownCaller
+	if callerPeer, found := peer.FromContext(ctx); found && callerPeer.Addr != nil {
+		caller = callerPeer.Addr.String()
+	}
+	if reportedCaller != "" {
+		return fmt.Sprintf(unverifiedCallerFormat, caller, reportedCaller)
+	}
+	return caller
+}
+
+func isForwardedByEngine(incomingMetadata metadata.MD, engineCallerKey string) bool {
+	if engineCallerKey == "" {
+		return false
+	}
+	keys := incomingMetadata.Get(shared_utils.AuditLogEngineCallerKeyMetadataKey)
+	return len(keys) > 0 && subtle.ConstantTimeCompare([]byte(keys[0]), []byte(engineCallerKey)) == 1
+}
+
+// Returns the caller the credential sent along with the call vouches for, if it's valid
+func getCredentialCaller(incomingMetadata metadata.MD, engineCallerKey string) (string, bool) {
+	if engineCallerKey == "" {
+		return "", false
+	}
+	credentials := incomingMetadata.Get(shared_utils.AuditLogCallerCredentialMetadataKey)
+	if len(credentials) == 0 {
+		return "", false
+	}
+	caller, err := shared_utils.VerifyAuditLogCallerCredential(engineCallerKey, credentials[0], time.Now())
+	if err != nil {
+		logrus.Debugf("Ignored the caller credential of a call as it isn't valid. Error was:\n%v", err)
+		return "", false
+	}
+	return caller, true
+}
+
+func getErrorMessage(err error) string {
+	if err == nil {
+		return ""
+	}
+	return err.Error()
+}
+
+func toApiAuditLogEntry(entry *auditLogEntry) *kurtosis_core_rpc_api_bindings.AuditLogEntry {
+	apiEntry := &kurtosis_core_rpc_api_bindings.AuditLogEntry{
+		SequenceNumber: entry.SequenceNumber,
+		Timestamp:      timestamppb.New(entry.Timestamp),
+		Caller:         entry.Caller,
+		Action:         entry.Action,
+		Arguments:      entry.Arguments,
+		Error:          nil,
+	}
+	if entry.Error != "" {
+		apiEntry.Error = &entry.Error
+	}
+	return apiEntry
+}
@@ -0,0 +1,239 @@
+package audit_log
+
+import (
+	"context"
+	"os"
+	"testing"
+	"time"
+
+	"github.com/kurtosis-tech/kurtosis/api/golang/core/kurtosis_core_rpc_api_bindings"
+	"github.com/kurtosis-tech/kurtosis/api/golang/core/lib/shared_utils"
+	"github.com/kurtosis-tech/kurtosis/container-engine-lib/lib/database_accessors/enclave_db"
+	"github.com/kurtosis-tech/kurtosis/core/server/api_container/server/secret_store"
+	"github.com/kurtosis-tech/stacktrace"
+	"github.com/stretchr/testify/require"
+	bolt "go.etcd.io/bbolt"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/metadata"
+	"google.golang.org/protobuf/proto"
+)
+
+const (
+	auditedMethodName    = "ExecCommand"
+	notAuditedMethodName = "GetServices"
+	auditedStreamName    = "RunStarlarkScript"
+
+	testCaller          = "token:ci"
+	testEngineCallerKey = "engine-caller-key"
+	testSecretName      = "api_key"
+	testSecretValue     = "s3cr3t"
+)
+
+func TestWrapServiceDesc_RecordsAuditedMethods(t *testing.T) {
+	secretStore := secret_store.NewSecretStore()
+	require.NoError(t, secretStore.SetRunSecrets(map[string]string{testSecretName: testSecretValue}))
+	auditLog := getAuditLogForTest(t, secretStore)
+
+	execCommandArgs := &kurtosis_core_rpc_api_bindings.ExecCommandArgs{
+		ServiceIdentifier: "geth",
+		CommandArgs:       []string{"curl", "-H", "Authorization: " + testSecretValue},
+	}
+	serviceDesc := &grpc.ServiceDesc{
+		ServiceName: "TestService",
+		HandlerType: nil,
+		Methods: []grpc.MethodDesc{
+			{MethodName: auditedMethodName, Handler: newDecodingMethodHandler(&kurtosis_core_rpc_api_bindings.ExecCommandArgs{}, stacktrace.NewError("Exec failed"))}, // nolint: exhaustruct
+			{MethodName: notAuditedMethodName, Handler: newDecodingMethodHandler(&kurtosis_core_rpc_api_bindings.GetServicesArgs{}, nil)},                             // nolint: exhaustruct
+		},
+		Streams:  []grpc.StreamDesc{},
+		Metadata: "",
+	}
+	wrappedServiceDesc := auditLog.WrapServiceDesc(serviceDesc, map[string]bool{auditedMethodName: true})
+
+	ctx := metadata.NewIncomingContext(context.Background(), metadata.Pairs(
+		shared_utils.AuditLogCallerMetadataKey, testCaller,
+		shared_utils.AuditLogEngineCallerKeyMetadataKey, testEngineCallerKey,
+	))
+	_, err := wrappedServiceDesc.Methods[0].Handler(nil, ctx, newDecoder(execCommandArgs), nil)
+	require.Error(t, err)
+	_, err = wrappedServiceDesc.Methods[1].Handler(nil, ctx, newDecoder(&kurtosis_core_rpc_api_bindings.GetServicesArgs{}), nil) // nolint: exhaustruct
+	require.NoError(t, err)
+
+	entries, err := auditLog.GetEntries()
+	require.NoError(t, err)
+	require.Len(t, entries, 1)
+	require.Equal(t, uint64(1), entries[0].GetSequenceNumber())
+	require.Equal(t, testCaller, entries[0].GetCaller())
+	require.Equal(t, auditedMethodName, entries[0].GetAction())
+	require.Equal(t, "geth", entries[0].GetArguments()["service_identifier"])
+	require.Equal(t, `["curl","-H","Authorization: {{kurtosis:secret:api_key}}"]`, entries[0].GetArguments()["command_args"])
+	require.Contains(t, entries[0].GetError(), "Exec failed")
+}
+
+func TestWrapServiceDesc_RecordsSelfReportedCallersAsUnverified(t *testing.T) {
+	auditLog := getAuditLogForTest(t, secret_store.NewSecretStore())
+
+	serviceDesc := &grpc.ServiceDesc{
+		ServiceName: "TestService",
+		HandlerType: nil,
+		Methods: []grpc.MethodDesc{
+			{MethodName: auditedMethodName, Handler: newDecodingMethodHandler(&kurtosis_core_rpc_api_bindings.ExecCommandArgs{}, nil)}, // nolint: exhaustruct
+		},
+		Streams:  []grpc.StreamDesc{},
+		Metadata: "",
+	}
+	wrappedServiceDesc := auditLog.WrapServiceDesc(serviceDesc, map[string]bool{auditedMethodName: true})
+
+	withoutKeyCtx := metadata.NewIncomingContext(context.Background(), metadata.Pairs(shared_utils.AuditLogCallerMetadataKey, testCaller))
+	_, err := wrappedServiceDesc.Methods[0].Handler(nil, withoutKeyCtx, newDecoder(&kurtosis_core_rpc_api_bindings.ExecCommandArgs{}), nil) // nolint: exhaustruct
+	require.NoError(t, err)
+	withWrongKeyCtx := metadata.NewIncomingContext(context.Background(), metadata.Pairs(
+		shared_utils.AuditLogCallerMetadataKey, testCaller,
+		shared_utils.AuditLogEngineCallerKeyMetadataKey, "not-the-engine-caller-key",
+	))
+	_, err = wrappedServiceDesc.Methods[0].Handler(nil, withWrongKeyCtx, newDecoder(&kurtosis_core_rpc_api_bindings.ExecCommandArgs{}), nil) // nolint: exhaustruct
+	require.NoError(t, err)
+
+	entries, err := auditLog.GetEntries()
+	require.NoError(t, err)
+	require.Len(t, entries, 2)
+	for _, entry := range entries {
+		require.Equal(t, "unknown (unverified, claims to be 'token:ci')", entry.GetCaller())
+	}
+}
+
+func TestWrapServiceDesc_RecordsCallersVouchedForByEngineCredentials(t *testing.T) {
+	auditLog := getAuditLogForTest(t, secret_store.NewSecretStore())
+
+	serviceDesc := &grpc.ServiceDesc{
+		ServiceName: "TestService",
+		HandlerType: nil,
+		Methods: []grpc.MethodDesc{
+			{MethodName: auditedMethodName, Handler: newDecodingMethodHandler(&kurtosis_core_rpc_api_bindings.ExecCommandArgs{}, nil)}, // nolint: exhaustruct
+		},
+		Streams:  []grpc.StreamDesc{},
+		Metadata: "",
+	}
+	wrappedServiceDesc := auditLog.WrapServiceDesc(serviceDesc, map[string]bool{auditedMethodName: true})
+
+	validCredential, err := shared_utils.NewAuditLogCallerCredential(testEngineCallerKey, testCaller, time.Now().Add(time.Hour))
+	require.NoError(t, err)
+	expiredCredential, err := shared_utils.NewAuditLogCallerCredential(testEngineCallerKey, testCaller, time.Now().Add(-time.Minute))
+	require.NoError(t, err)
+	otherEnclaveCredential, err := shared_utils.NewAuditLogCallerCredential("other-engine-caller-key", testCaller, time.Now().Add(time.Hour))
+	require.NoError(t, err)
+	for _, credential := range []string{validCredential, expiredCredential, otherEnclaveCredential} {
+		ctx := metadata.NewIncomingContext(context.Background(), metadata.Pairs(shared_utils.AuditLogCallerCredentialMetadataKey, credential))
+		_, err := wrappedServiceDesc.Methods[0].Handler(nil, ctx, newDecoder(&kurtosis_core_rpc_api_bindings.ExecCommandArgs{}), nil) // nolint: exhaustruct
+		require.NoError(t, err)
+	}
+
+	entries, err := auditLog.GetEntries()
+	require.NoError(t, err)
+	require.Len(t, entries, 3)
+	require.Equal(t, testCaller, entries[0].GetCaller())
+	require.Equal(t, unknownCaller, entries[1].GetCaller())
+	require.Equal(t, unknownCaller, entries[2].GetCaller())
+}
+
+func TestWrapServiceDesc_RecordsFailedStarlarkRuns(t *testing.T) {
+	auditLog := getAuditLogForTest(t, secret_store.NewSecretStore())
+
+	runArgs := &kurtosis_core_rpc_api_bindings.RunStarlarkScriptArgs{ // nolint: exhaustruct
+		SerializedScript: "def run(plan): pass",
+		Secrets:          map[string]string{testSecretName: testSecretValue},
+	}
+	serviceDesc := &grpc.ServiceDesc{
+		ServiceName: "TestService",
+		HandlerType: nil,
+		Methods:     []grpc.MethodDesc{},
+		Streams: []grpc.StreamDesc{
+			{
+				StreamName: auditedStreamName,
+				Handler: func(srv interface{}, stream grpc.ServerStream) error {
+					receivedArgs := &kurtosis_core_rpc_api_bindings.RunStarlarkScriptArgs{} // nolint: exhaustruct
+					if err := stream.RecvMsg(receivedArgs); err != nil {
+						return err
+					}
+					runFinishedEvent := &kurtosis_core_rpc_api_bindings.StarlarkRunResponseLine{ // nolint: exhaustruct
+						RunResponseLine: &kurtosis_core_rpc_api_bindings.StarlarkRunResponseLine_RunFinishedEvent{
+							RunFinishedEvent: &kurtosis_core_rpc_api_bindings.StarlarkRunFinishedEvent{ // nolint: exhaustruct
+								IsRunSuccessful: false,
+							},
+						},
+					}
+					return stream.SendMsg(runFinishedEvent)
+				},
+				ServerStreams: true,
+				ClientStreams: false,
+			},
+		},
+		Metadata: "",
+	}
+	wrappedServiceDesc := auditLog.WrapServiceDesc(serviceDesc, map[string]bool{auditedStreamName: true})
+
+	require.NoError(t, wrappedServiceDesc.Streams[0].Handler(nil, &testServerStream{ServerStream: nil, args: runArgs}))
+
+	entries, err := auditLog.GetEntries()
+	require.NoError(t, err)
+	require.Len(t, entries, 1)
+	require.Equal(t, unknownCaller, entries[0].GetCaller())
+	require.Equal(t, "def run(plan): pass", entries[0].GetArguments()["serialized_script"])
+	require.Equal(t, `{"api_key":"<redacted>"}`, entries[0].GetArguments()["secrets"])
+	require.Equal(t, unsuccessfulStarlarkRunErrorMessage, entries[0].GetError())
+}
+
+func getAuditLogForTest(t *testing.T, secretStore *secret_store.SecretStore) *AuditLog {
+	file, err := os.CreateTemp("/tmp", "*.db")
+	require.NoError(t, err)
+	t.Cleanup(func() {
+		require.NoError(t, os.Remove(file.Name()))
+	})
+	db, err := bolt.Open(file.Name(), 0666, nil)
+	require.NoError(t, err)
+	t.Cleanup(func() {
+		require.NoError(t, db.Close())
+	})
+
+	auditLog, err := GetOrCreateAuditLog(&enclave_db.EnclaveDB{DB: db}, secretStore, testEngineCallerKey)
+	require.NoError(t, err)
+	return auditLog
+}
+
+// Decodes arguments of the same type as the given ones, like the handlers generated by gRPC
+func newDecodingMethodHandler(argsType proto.Message, err error) methodHandler {
+	return func(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+		decodedArgs := argsType.ProtoReflect().New().Interface()
+		if decodingErr := dec(decodedArgs); decodingErr != nil {
+			return nil, decodingErr
+		}
+		return nil, err
+	}
+}
+
+func newDecoder(args proto.Message) func(interface{}) error {
+	return func(message interface{}) error {
+		proto.Merge(message.(proto.Message), args)
+		return nil
+	}
+}
+
+// A stream receiving the given arguments, and ignoring what gets sent
+type testServerStream struct {
+	grpc.ServerStream
+
+	args proto.Message
+}
+
+func (stream *testServerStream) Context() context.Context {
+	return context.Background()
+}
+
+func (stream *testServerStream) RecvMsg(message interface{}) error {
+	proto.Merge(message.(proto.Message), stream.args)
+	return nil
+}
+
+func (stream *testServerStream) SendMsg(_ interface{}) error {
+	return nil
+}
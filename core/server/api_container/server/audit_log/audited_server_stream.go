@@ -0,0 +1,70 @@
+package audit_log
+
+import (
+	"github.com/kurtosis-tech/kurtosis/api/golang/core/kurtosis_core_rpc_api_bindings"
+	"google.golang.org/grpc"
+	"google.golang.org/protobuf/proto"
+)
+
+const (
+	unsuccessfulStarlarkRunErrorMessage = "The Starlark run didn't succeed"
+)
+
+// auditedServerStream keeps what the audit log needs from a stream: the arguments the caller sent and whether the
+// Starlark run it streams failed, as a failed run doesn't make the method return an error
+type auditedServerStream struct {
+	grpc.ServerStream
+
+	firstReceivedMessage proto.Message
+
+	starlarkRunErrorMessage string
+}
+
+func newAuditedServerStream(stream grpc.ServerStream) *auditedServerStream {
+	return &auditedServerStream{
+		ServerStream:            stream,
+		firstReceivedMessage:    nil,
+		starlarkRunErrorMessage: "",
+	}
+}
+
+func (stream *auditedServerStream) RecvMsg(message interface{}) error {
+	if err := stream.ServerStream.RecvMsg(message); err != nil {
+		return err
+	}
+	if receivedMessage, ok := message.(proto.Message); ok && stream.firstReceivedMessage == nil {
+		stream.firstReceivedMessage = receivedMessage
+	}
+	return nil
+}
+
+func (stream *auditedServerStream) SendMsg(message interface{}) error {
+	if responseLine, ok := message.(*kurtosis_core_rpc_api_bindings.StarlarkRunResponseLine); ok && stream.starlarkRunErrorMessage == "" {
+		stream.starlarkRunErrorMessage = getStarlarkRunErrorMessage(responseLine)
+	}
+	return stream.ServerStream.SendMsg(message)
+}
+
+// The content of the uploads isn't worth recording, only its metadata is
+func (stream *auditedServerStream) getArgs() proto.Message {
+	if dataChunk, ok := stream.firstReceivedMessage.(*kurtosis_core_rpc_api_bindings.StreamedDataChunk); ok {
+		return dataChunk.GetMetadata()
+	}
+	return stream.firstReceivedMessage
+}
+
+func getStarlarkRunErrorMessage(responseLine *kurtosis_core_rpc_api_bindings.StarlarkRunResponseLine) string {
+	runError := responseLine.GetError()
+	switch {
+	case runError.GetInterpretationError() != nil:
+		return runError.GetInterpretationError().GetErrorMessage()
+	case runError.GetValidationError() != nil:
+		return runError.GetValidationError().GetErrorMessage()
+	case runError.GetExecutionError() != nil:
+		return runError.GetExecutionError().GetErrorMessage()
+	case responseLine.GetRunFinishedEvent() != nil && !responseLine.GetRunFinishedEvent().GetIsRunSuccessful():
+		return unsuccessfulStarlarkRunErrorMessage
+	default:
+		return ""
+	}
+}
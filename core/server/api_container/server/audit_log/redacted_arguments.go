@@ -0,0 +1,68 @@
+package audit_log
+
+import (
+	"bytes"
+	"encoding/json"
+
+	"github.com/kurtosis-tech/kurtosis/core/server/api_container/server/secret_store"
+	"github.com/kurtosis-tech/stacktrace"
+	"google.golang.org/protobuf/encoding/protojson"
+	"google.golang.org/protobuf/proto"
+	"google.golang.org/protobuf/reflect/protoreflect"
+)
+
+const (
+	redactedSecretValue = "<redacted>"
+)
+
+// The argument fields holding secrets keyed by their names, only the names end up in the audit log
+var secretsFieldNames = map[protoreflect.Name]bool{
+	"secrets": true,
+}
+
+// getRedactedArguments flattens the top-level fields of the arguments into strings, the nested ones being rendered as
+// JSON. The secrets passed along are redacted, and so are the values of the secrets already known to the enclave
+func getRedactedArguments(args proto.Message, secretStore *secret_store.SecretStore) (map[string]string, error) {
+	redactedArgs := proto.Clone(args)
+	redactSecretsFields(redactedArgs.ProtoReflect())
+	secretStore.MaskValuesInProtoMessage(redactedArgs.ProtoReflect())
+
+	argsJson, err := protojson.MarshalOptions{UseProtoNames: true}.Marshal(redactedArgs) // nolint: exhaustruct
+	if err != nil {
+		return nil, stacktrace.Propagate(err, "An error occurred serializing the arguments to JSON")
+	}
+	fields := map[string]json.RawMessage{}
+	if err := json.Unmarshal(argsJson, &fields); err != nil {
+		return nil, stacktrace.Propagate(err, "An error occurred deserializing the JSON arguments '%v'", string(argsJson))
+	}
+
+	arguments := map[string]string{}
+	for name, value := range fields {
+		var stringValue string
+		if err := json.Unmarshal(value, &stringValue); err == nil {
+			arguments[name] = stringValue
+			continue
+		}
+		// The JSON serialization of protobuf messages doesn't have a stable whitespace
+		compactedValue := &bytes.Buffer{}
+		if err := json.Compact(compactedValue, value); err != nil {
+			return nil, stacktrace.Propagate(err, "An error occurred compacting the value of argument '%v'", name)
+		}
+		arguments[name] = compactedValue.String()
+	}
+	return arguments, nil
+}
+
+func redactSecretsFields(message protoreflect.Message) {
+	message.Range(func(field protoreflect.FieldDescriptor, value protoreflect.Value) bool {
+		if !secretsFieldNames[field.Name()] || !field.IsMap() || field.MapValue().Kind() != protoreflect.StringKind {
+			return true
+		}
+		secrets := value.Map()
+		secrets.Range(func(key protoreflect.MapKey, _ protoreflect.Value) bool {
+			secrets.Set(key, protoreflect.ValueOfString(redactedSecretValue))
+			return true
+		})
+		return true
+	})
+}
@@ -0,0 +1,74 @@
+package operational_metrics
+
+import (
+	"time"
+
+	"github.com/prometheus/client_golang/prometheus"
+	"github.com/prometheus/client_golang/prometheus/promauto"
+)
+
+// These are the API container's Prometheus metrics, served on its own port inside the enclave network. They're meant
+// for operating Kurtosis and are unrelated to the product analytics sent through the metrics library
+
+const (
+	metricsNamespace        = "kurtosis"
+	starlarkMetricSubsystem = "starlark"
+
+	runOutcomeLabel      = "outcome"
+	instructionNameLabel = "instruction"
+
+	successfulRunOutcome = "success"
+	failedRunOutcome     = "failure"
+)
+
+var (
+	// Runs go from a few milliseconds for a dry run to the better part of an hour for big networks
+	starlarkRunDurationBuckets = []float64{0.1, 0.5, 1, 5, 10, 30, 60, 120, 300, 600, 1200, 3600}
+
+	starlarkRunsTotal = promauto.NewCounterVec(
+		prometheus.CounterOpts{
+			Namespace:   metricsNamespace,
+			Subsystem:   starlarkMetricSubsystem,
+			Name:        "runs_total",
+			Help:        "Number of Starlark runs, by outcome.",
+			ConstLabels: nil,
+		},
+		[]string{runOutcomeLabel},
+	)
+
+	starlarkRunDurationSeconds = promauto.NewHistogramVec(
+		prometheus.HistogramOpts{
+			Namespace:   metricsNamespace,
+			Subsystem:   starlarkMetricSubsystem,
+			Name:        "run_duration_seconds",
+			Help:        "Duration of the Starlark runs, from the start of interpretation to the end of execution, by outcome.",
+			ConstLabels: nil,
+			Buckets:     starlarkRunDurationBuckets,
+		},
+		[]string{runOutcomeLabel},
+	)
+
+	instructionFailuresTotal = promauto.NewCounterVec(
+		prometheus.CounterOpts{
+			Namespace:   metricsNamespace,
+			Subsystem:   starlarkMetricSubsystem,
+			Name:        "instruction_failures_total",
+			Help:        "Number of instructions that failed during execution, by instruction name (add_service, exec...).",
+			ConstLabels: nil,
+		},
+		[]string{instructionNameLabel},
+	)
+)
+
+func RecordStarlarkRun(isSuccessful bool, duration time.Duration) {
+	outcome := failedRunOutcome
+	if isSuccessful {
+		outcome = successfulRunOutcome
+	}
+	starlarkRunsTotal.WithLabelValues(outcome).Inc()
+	starlarkRunDurationSeconds.WithLabelValues(outcome).Observe(duration.Seconds())
+}
+
+func RecordInstructionFailure(instructionName string) {
+	instructionFailuresTotal.WithLabelValues(instructionName).Inc()
+}
@@ -0,0 +1,33 @@
+package operational_metrics
+
+import (
+	"testing"
+	"time"
+
+	"github.com/prometheus/client_golang/prometheus/testutil"
+	"github.com/stretchr/testify/require"
+)
+
+const (
+	failingInstructionName = "add_service"
+)
+
+func TestRecordStarlarkRun_CountsRunsByOutcome(t *testing.T) {
+	initialNumSuccessfulRuns := testutil.ToFloat64(starlarkRunsTotal.WithLabelValues(successfulRunOutcome))
+	initialNumFailedRuns := testutil.ToFloat64(starlarkRunsTotal.WithLabelValues(failedRunOutcome))
+
+	RecordStarlarkRun(true, time.Second)
+	RecordStarlarkRun(true, time.Minute)
+	RecordStarlarkRun(false, time.Millisecond)
+
+	require.Equal(t, initialNumSuccessfulRuns+2, testutil.ToFloat64(starlarkRunsTotal.WithLabelValues(successfulRunOutcome)))
+	require.Equal(t, initialNumFailedRuns+1, testutil.ToFloat64(starlarkRunsTotal.WithLabelValues(failedRunOutcome)))
+}
+
+func TestRecordInstructionFailure_CountsFailuresByInstructionName(t *testing.T) {
+	initialNumFailures := testutil.ToFloat64(instructionFailuresTotal.WithLabelValues(failingInstructionName))
+
+	RecordInstructionFailure(failingInstructionName)
+
+	require.Equal(t, initialNumFailures+1, testutil.ToFloat64(instructionFailuresTotal.WithLabelValues(failingInstructionName)))
+}
@@ -0,0 +1,290 @@
+package secret_store
+
+import (
+	"encoding/json"
+	"fmt"
+	"regexp"
+	"sort"
+	"strings"
+	"sync"
+
+	"github.com/kurtosis-tech/stacktrace"
+	"google.golang.org/protobuf/reflect/protoreflect"
+)
+
+const (
+	kurtosisNamespace = "kurtosis"
+	secretNamespace   = "secret"
+
+	secretNameSubgroupName = "name"
+	secretNameRegexp       = "[a-zA-Z0-9_\\-\\.]+"
+
+	// Masking replaces every occurrence of a value in the output, so a shorter value would garble unrelated text
+	minSecretValueLength = 4
+
+	secretPlaceholderRegex = "\\{\\{" + kurtosisNamespace + ":" + secretNamespace + ":(?P<" + secretNameSubgroupName + ">" + secretNameRegexp + ")\\}\\}"
+	// SecretPlaceholderFormat is what the Starlark Secret builtin returns in place of the value. It is the only form
+	// under which a secret ever leaves this store: in the plan, in instruction descriptions and in the enclave DB
+	SecretPlaceholderFormat = "{{" + kurtosisNamespace + ":" + secretNamespace + ":%s}}"
+
+	missingSecretErrorMsg = "Secret '%s' is referenced but its value isn't available in the enclave. Secrets are not persisted, it needs to be passed again to the run"
+)
+
+type namedSecret struct {
+	name  string
+	value string
+}
+
+var (
+	compiledSecretPlaceholderRegex = regexp.MustCompile(secretPlaceholderRegex)
+	compiledSecretNameRegex        = regexp.MustCompile("^" + secretNameRegexp + "$")
+)
+
+// SecretStore keeps the secrets passed to the latest Starlark run of this enclave. Values only live in memory and are
+// replaced by every run, so each run referencing a secret needs to pass it again. The values the services got started
+// with are kept for as long as the services exist though, so that they can be restarted after later runs
+type SecretStore struct {
+	mutex *sync.RWMutex
+
+	secrets map[string]string
+
+	// Service UUID -> the secrets referenced by the config of the service, with the values it got started with
+	serviceSecrets map[string]map[string]string
+}
+
+func NewSecretStore() *SecretStore {
+	return &SecretStore{
+		mutex:          &sync.RWMutex{},
+		secrets:        map[string]string{},
+		serviceSecrets: map[string]map[string]string{},
+	}
+}
+
+// SetRunSecrets replaces the stored secrets by the ones passed to a new run; the secrets of the previous runs are
+// dropped. Non-empty values shorter than minSecretValueLength are rejected as they can't be masked safely
+func (store *SecretStore) SetRunSecrets(secrets map[string]string) error {
+	store.mutex.Lock()
+	defer store.mutex.Unlock()
+	for name, value := range secrets {
+		if !IsValidSecretName(name) {
+			return stacktrace.NewError("Secret name '%s' is invalid, it should match '%s'", name, secretNameRegexp)
+		}
+		if value != "" && len(value) < minSecretValueLength {
+			return stacktrace.NewError("The value of secret '%s' is too short, it should be at least %d characters long", name, minSecretValueLength)
+		}
+	}
+	runSecrets := map[string]string{}
+	for name, value := range secrets {
+		runSecrets[name] = value
+	}
+	store.secrets = runSecrets
+	return nil
+}
+
+func (store *SecretStore) HasSecret(name string) bool {
+	store.mutex.RLock()
+	defer store.mutex.RUnlock()
+	_, found := store.secrets[name]
+	return found
+}
+
+// ResolvePlaceholders replaces all the secret placeholders in the string by their values
+func (store *SecretStore) ResolvePlaceholders(originalString string) (string, error) {
+	store.mutex.RLock()
+	defer store.mutex.RUnlock()
+	var missingSecretName string
+	resolvedString := compiledSecretPlaceholderRegex.ReplaceAllStringFunc(originalString, func(placeholder string) string {
+		name := compiledSecretPlaceholderRegex.FindStringSubmatch(placeholder)[compiledSecretPlaceholderRegex.SubexpIndex(secretNameSubgroupName)]
+		value, found := store.secrets[name]
+		if !found {
+			missingSecretName = name
+			return placeholder
+		}
+		return value
+	})
+	if missingSecretName != "" {
+		return "", stacktrace.NewError(missingSecretErrorMsg, missingSecretName)
+	}
+	return resolvedString, nil
+}
+
+// ResolveServicePlaceholdersInJson is like ResolvePlaceholdersInJson for the config of a service. The secrets missing
+// from the latest run get the values the service got started with, and the values the config resolves to are kept for
+// the next time the service gets started, until ForgetServiceSecrets is called
+func (store *SecretStore) ResolveServicePlaceholdersInJson(serviceUuid string, serviceConfigJson []byte) ([]byte, error) {
+	store.mutex.Lock()
+	defer store.mutex.Unlock()
+	referencedSecrets := map[string]string{}
+	var resolutionErr error
+	resolvedJson := compiledSecretPlaceholderRegex.ReplaceAllStringFunc(string(serviceConfigJson), func(placeholder string) string {
+		name := compiledSecretPlaceholderRegex.FindStringSubmatch(placeholder)[compiledSecretPlaceholderRegex.SubexpIndex(secretNameSubgroupName)]
+		value, found := store.secrets[name]
+		if !found {
+			value, found = store.serviceSecrets[serviceUuid][name]
+		}
+		if !found {
+			resolutionErr = stacktrace.NewError(missingSecretErrorMsg, name)
+			return placeholder
+		}
+		escapedValue, err := escapeJsonString(value)
+		if err != nil {
+			resolutionErr = stacktrace.Propagate(err, "An error occurred escaping the value of secret '%s'", name)
+			return placeholder
+		}
+		referencedSecrets[name] = value
+		return escapedValue
+	})
+	if resolutionErr != nil {
+		return nil, resolutionErr
+	}
+	if len(referencedSecrets) == 0 {
+		delete(store.serviceSecrets, serviceUuid)
+	} else {
+		store.serviceSecrets[serviceUuid] = referencedSecrets
+	}
+	return []byte(resolvedJson), nil
+}
+
+// ForgetServiceSecrets drops the values the service got started with, once it's removed
+func (store *SecretStore) ForgetServiceSecrets(serviceUuid string) {
+	store.mutex.Lock()
+	defer store.mutex.Unlock()
+	delete(store.serviceSecrets, serviceUuid)
+}
+
+// MaskValues replaces every secret value found in the string by the placeholder of the secret. This is the reverse
+// operation of ResolvePlaceholders, which means a masked string can be safely persisted and resolved later on
+func (store *SecretStore) MaskValues(originalString string) string {
+	store.mutex.RLock()
+	defer store.mutex.RUnlock()
+	maskedString := originalString
+	for _, secret := range store.getNonEmptySecretsLongestFirst() {
+		maskedString = strings.ReplaceAll(maskedString, secret.value, NewSecretPlaceholder(secret.name))
+	}
+	return maskedString
+}
+
+// MaskValuesInProtoMessage masks the secret values in all the string fields of the message, recursively
+func (store *SecretStore) MaskValuesInProtoMessage(message protoreflect.Message) {
+	populatedFields := map[protoreflect.FieldDescriptor]protoreflect.Value{}
+	message.Range(func(field protoreflect.FieldDescriptor, value protoreflect.Value) bool {
+		populatedFields[field] = value
+		return true
+	})
+	for field, value := range populatedFields {
+		switch {
+		case field.IsList():
+			list := value.List()
+			for idx := 0; idx < list.Len(); idx++ {
+				if field.Kind() == protoreflect.StringKind {
+					list.Set(idx, protoreflect.ValueOfString(store.MaskValues(list.Get(idx).String())))
+				} else if field.Message() != nil {
+					store.MaskValuesInProtoMessage(list.Get(idx).Message())
+				}
+			}
+		case field.IsMap():
+			mapValue := value.Map()
+			mapValue.Range(func(key protoreflect.MapKey, entryValue protoreflect.Value) bool {
+				if field.MapValue().Kind() == protoreflect.StringKind {
+					mapValue.Set(key, protoreflect.ValueOfString(store.MaskValues(entryValue.String())))
+				} else if field.MapValue().Message() != nil {
+					store.MaskValuesInProtoMessage(entryValue.Message())
+				}
+				return true
+			})
+		case field.Kind() == protoreflect.StringKind:
+			message.Set(field, protoreflect.ValueOfString(store.MaskValues(value.String())))
+		case field.Message() != nil:
+			store.MaskValuesInProtoMessage(value.Message())
+		}
+	}
+}
+
+// MaskValuesInJson is the JSON-aware version of MaskValues: secret values are looked up in their JSON-escaped form
+// so that the output stays valid JSON
+func (store *SecretStore) MaskValuesInJson(originalJson []byte) ([]byte, error) {
+	store.mutex.RLock()
+	defer store.mutex.RUnlock()
+	maskedJson := string(originalJson)
+	for _, secret := range store.getNonEmptySecretsLongestFirst() {
+		escapedValue, err := escapeJsonString(secret.value)
+		if err != nil {
+			return nil, stacktrace.Propagate(err, "An error occurred escaping the value of secret '%s'", secret.name)
+		}
+		maskedJson = strings.ReplaceAll(maskedJson, escapedValue, NewSecretPlaceholder(secret.name))
+	}
+	return []byte(maskedJson), nil
+}
+
+// ResolvePlaceholdersInJson is the JSON-aware version of ResolvePlaceholders
+func (store *SecretStore) ResolvePlaceholdersInJson(originalJson []byte) ([]byte, error) {
+	store.mutex.RLock()
+	defer store.mutex.RUnlock()
+	var resolutionErr error
+	resolvedJson := compiledSecretPlaceholderRegex.ReplaceAllStringFunc(string(originalJson), func(placeholder string) string {
+		name := compiledSecretPlaceholderRegex.FindStringSubmatch(placeholder)[compiledSecretPlaceholderRegex.SubexpIndex(secretNameSubgroupName)]
+		value, found := store.secrets[name]
+		if !found {
+			resolutionErr = stacktrace.NewError(missingSecretErrorMsg, name)
+			return placeholder
+		}
+		escapedValue, err := escapeJsonString(value)
+		if err != nil {
+			resolutionErr = stacktrace.Propagate(err, "An error occurred escaping the value of secret '%s'", name)
+			return placeholder
+		}
+		return escapedValue
+	})
+	if resolutionErr != nil {
+		return nil, resolutionErr
+	}
+	return []byte(resolvedJson), nil
+}
+
+// getNonEmptySecretsLongestFirst returns the secrets of the latest run and of the services ordered by decreasing value
+// length, so that a secret containing another one is masked as a whole. The caller is expected to hold the lock
+func (store *SecretStore) getNonEmptySecretsLongestFirst() []namedSecret {
+	uniqueSecrets := map[namedSecret]bool{}
+	for name, value := range store.secrets {
+		uniqueSecrets[namedSecret{name: name, value: value}] = true
+	}
+	for _, serviceSecrets := range store.serviceSecrets {
+		for name, value := range serviceSecrets {
+			uniqueSecrets[namedSecret{name: name, value: value}] = true
+		}
+	}
+	secrets := []namedSecret{}
+	for secret := range uniqueSecrets {
+		if secret.value == "" {
+			continue
+		}
+		secrets = append(secrets, secret)
+	}
+	sort.Slice(secrets, func(i, j int) bool {
+		if len(secrets[i].value) != len(secrets[j].value) {
+			return len(secrets[i].value) > len(secrets[j].value)
+		}
+		if secrets[i].name != secrets[j].name {
+			return secrets[i].name < secrets[j].name
+		}
+		return secrets[i].value < secrets[j].value
+	})
+	return secrets
+}
+
+func escapeJsonString(value string) (string, error) {
+	jsonValue, err := json.Marshal(value)
+	if err != nil {
+		return "", stacktrace.Propagate(err, "An error occurred marshalling the string to JSON")
+	}
+	// strips the surrounding quotes
+	return string(jsonValue[1 : len(jsonValue)-1]), nil
+}
+
+func NewSecretPlaceholder(name string) string {
+	return fmt.Sprintf(SecretPlaceholderFormat, name)
+}
+
+func IsValidSecretName(name string) bool {
+	return compiledSecretNameRegex.MatchString(name)
+}
@@ -0,0 +1,167 @@
+package secret_store
+
+import (
+	"encoding/json"
+	"testing"
+
+	"github.com/kurtosis-tech/kurtosis/api/golang/core/kurtosis_core_rpc_api_bindings"
+	"github.com/kurtosis-tech/kurtosis/api/golang/core/lib/binding_constructors"
+	"github.com/stretchr/testify/require"
+)
+
+const (
+	apiKeyName  = "API_KEY"
+	apiKeyValue = "abc123"
+
+	passwordName  = "PASSWORD"
+	passwordValue = "p@ss\"word\\"
+
+	testServiceUuid = "service-uuid"
+)
+
+func newTestSecretStore(t *testing.T) *SecretStore {
+	store := NewSecretStore()
+	require.NoError(t, store.SetRunSecrets(map[string]string{
+		apiKeyName:   apiKeyValue,
+		passwordName: passwordValue,
+	}))
+	return store
+}
+
+func TestSetRunSecrets_InvalidName(t *testing.T) {
+	store := NewSecretStore()
+	err := store.SetRunSecrets(map[string]string{"not a valid name": "value"})
+	require.Error(t, err)
+	require.False(t, store.HasSecret("not a valid name"))
+}
+
+func TestSetRunSecrets_ValueTooShort(t *testing.T) {
+	store := newTestSecretStore(t)
+	err := store.SetRunSecrets(map[string]string{"PIN": "a1"})
+	require.Error(t, err)
+	require.False(t, store.HasSecret("PIN"))
+	require.True(t, store.HasSecret(apiKeyName))
+}
+
+func TestSetRunSecrets_DropsSecretsOfPreviousRun(t *testing.T) {
+	store := newTestSecretStore(t)
+	require.NoError(t, store.SetRunSecrets(map[string]string{apiKeyName: "new-value"}))
+
+	resolved, err := store.ResolvePlaceholders(NewSecretPlaceholder(apiKeyName))
+	require.NoError(t, err)
+	require.Equal(t, "new-value", resolved)
+	require.False(t, store.HasSecret(passwordName))
+	require.Equal(t, "PASSWORD="+passwordValue, store.MaskValues("PASSWORD="+passwordValue))
+}
+
+func TestResolvePlaceholders(t *testing.T) {
+	store := newTestSecretStore(t)
+
+	resolved, err := store.ResolvePlaceholders("curl -H 'Authorization: " + NewSecretPlaceholder(apiKeyName) + "' {{kurtosis:abc:field.runtime_value}}")
+	require.NoError(t, err)
+	require.Equal(t, "curl -H 'Authorization: abc123' {{kurtosis:abc:field.runtime_value}}", resolved)
+}
+
+func TestResolvePlaceholders_MissingSecret(t *testing.T) {
+	store := NewSecretStore()
+
+	_, err := store.ResolvePlaceholders("token=" + NewSecretPlaceholder(apiKeyName))
+	require.Error(t, err)
+	require.Contains(t, err.Error(), "Secret 'API_KEY' is referenced but its value isn't available in the enclave")
+}
+
+func TestMaskValues(t *testing.T) {
+	store := newTestSecretStore(t)
+
+	masked := store.MaskValues("API_KEY=abc123 PASSWORD=" + passwordValue)
+	require.Equal(t, "API_KEY={{kurtosis:secret:API_KEY}} PASSWORD={{kurtosis:secret:PASSWORD}}", masked)
+}
+
+func TestMaskValues_LongestValueFirst(t *testing.T) {
+	store := NewSecretStore()
+	require.NoError(t, store.SetRunSecrets(map[string]string{
+		"SHORT": "abcd",
+		"LONG":  "abcdefgh",
+		"EMPTY": "",
+	}))
+
+	require.Equal(t, "{{kurtosis:secret:LONG}} {{kurtosis:secret:SHORT}}", store.MaskValues("abcdefgh abcd"))
+}
+
+func TestMaskValuesInJsonAndResolveBack(t *testing.T) {
+	store := newTestSecretStore(t)
+
+	originalJson, err := json.Marshal(map[string]string{"password": passwordValue, "api_key": apiKeyValue})
+	require.NoError(t, err)
+
+	maskedJson, err := store.MaskValuesInJson(originalJson)
+	require.NoError(t, err)
+	require.NotContains(t, string(maskedJson), apiKeyValue)
+	require.NotContains(t, string(maskedJson), "p@ss")
+	require.True(t, json.Valid(maskedJson))
+
+	resolvedJson, err := store.ResolvePlaceholdersInJson(maskedJson)
+	require.NoError(t, err)
+	require.JSONEq(t, string(originalJson), string(resolvedJson))
+}
+
+func TestMaskValuesInProtoMessage(t *testing.T) {
+	store := newTestSecretStore(t)
+
+	responseLine := binding_constructors.NewStarlarkRunResponseLineFromInstructionResult("Command returned with exit code '0' and the following output: abc123", 0)
+	store.MaskValuesInProtoMessage(responseLine.ProtoReflect())
+	require.Equal(t, "Command returned with exit code '0' and the following output: {{kurtosis:secret:API_KEY}}", responseLine.GetInstructionResult().GetSerializedInstructionResult())
+
+	serviceInfo := &kurtosis_core_rpc_api_bindings.ServiceInfo{
+		Container: &kurtosis_core_rpc_api_bindings.Container{
+			CmdArgs: []string{"--password", passwordValue},
+			EnvVars: map[string]string{apiKeyName: apiKeyValue},
+		},
+	}
+	store.MaskValuesInProtoMessage(serviceInfo.ProtoReflect())
+	require.Equal(t, []string{"--password", "{{kurtosis:secret:PASSWORD}}"}, serviceInfo.GetContainer().GetCmdArgs())
+	require.Equal(t, map[string]string{apiKeyName: "{{kurtosis:secret:API_KEY}}"}, serviceInfo.GetContainer().GetEnvVars())
+}
+
+func TestResolveServicePlaceholdersInJson_KeepsTheValuesTheServiceStartedWith(t *testing.T) {
+	store := newTestSecretStore(t)
+	serviceConfigJson := []byte(`{"env_vars":{"KEY":"` + NewSecretPlaceholder(apiKeyName) + `"}}`)
+	expectedResolvedJson := `{"env_vars":{"KEY":"` + apiKeyValue + `"}}`
+
+	resolvedJson, err := store.ResolveServicePlaceholdersInJson(testServiceUuid, serviceConfigJson)
+	require.NoError(t, err)
+	require.Equal(t, expectedResolvedJson, string(resolvedJson))
+
+	// A later run without the secret doesn't prevent the service from being started again
+	require.NoError(t, store.SetRunSecrets(map[string]string{}))
+	require.False(t, store.HasSecret(apiKeyName))
+	resolvedJson, err = store.ResolveServicePlaceholdersInJson(testServiceUuid, serviceConfigJson)
+	require.NoError(t, err)
+	require.Equal(t, expectedResolvedJson, string(resolvedJson))
+	require.Equal(t, NewSecretPlaceholder(apiKeyName), store.MaskValues(apiKeyValue))
+
+	// Only the service it got started with has the value
+	_, err = store.ResolveServicePlaceholdersInJson("other-service", serviceConfigJson)
+	require.Error(t, err)
+
+	store.ForgetServiceSecrets(testServiceUuid)
+	_, err = store.ResolveServicePlaceholdersInJson(testServiceUuid, serviceConfigJson)
+	require.Error(t, err)
+	require.Equal(t, apiKeyValue, store.MaskValues(apiKeyValue))
+}
+
+func TestResolveServicePlaceholdersInJson_PrefersTheLatestRunValues(t *testing.T) {
+	store := newTestSecretStore(t)
+	serviceConfigJson := []byte(`{"cmd":["` + NewSecretPlaceholder(apiKeyName) + `"]}`)
+	_, err := store.ResolveServicePlaceholdersInJson(testServiceUuid, serviceConfigJson)
+	require.NoError(t, err)
+
+	require.NoError(t, store.SetRunSecrets(map[string]string{apiKeyName: "new-value"}))
+	resolvedJson, err := store.ResolveServicePlaceholdersInJson(testServiceUuid, serviceConfigJson)
+	require.NoError(t, err)
+	require.Equal(t, `{"cmd":["new-value"]}`, string(resolvedJson))
+
+	// The old value isn't referenced by the service anymore, so it isn't kept
+	require.NoError(t, store.SetRunSecrets(map[string]string{}))
+	require.Equal(t, apiKeyValue, store.MaskValues(apiKeyValue))
+}
@@ -9,6 +9,7 @@ import (
 	"bytes"
 	"compress/gzip"
 	"context"
+	"encoding/json"
 	"fmt"
 	"io"
 	"net"
@@ -22,6 +23,7 @@ import (
 	"github.com/kurtosis-tech/kurtosis/container-engine-lib/lib/backend_interface/objects/exec_result"
 	"github.com/kurtosis-tech/kurtosis/container-engine-lib/lib/database_accessors/enclave_db"
 	"github.com/kurtosis-tech/kurtosis/container-engine-lib/lib/database_accessors/enclave_db/service_registration"
+	"github.com/kurtosis-tech/kurtosis/core/server/api_container/server/secret_store"
 	"github.com/kurtosis-tech/kurtosis/core/server/api_container/server/service_network/render_templates"
 	"github.com/kurtosis-tech/kurtosis/core/server/api_container/server/service_network/service_identifiers"
 	path_compression "github.com/kurtosis-tech/kurtosis/path-compression"
@@ -31,6 +33,7 @@ import (
 	"github.com/kurtosis-tech/kurtosis/container-engine-lib/lib/backend_interface/objects/port_spec"
 	"github.com/kurtosis-tech/kurtosis/container-engine-lib/lib/backend_interface/objects/service"
 	"github.com/kurtosis-tech/kurtosis/container-engine-lib/lib/uuid_generator"
+	"github.com/kurtosis-tech/kurtosis/core/launcher/args"
 	"github.com/kurtosis-tech/kurtosis/core/server/commons/enclave_data_directory"
 	"github.com/kurtosis-tech/stacktrace"
 	"github.com/sirupsen/logrus"
@@ -85,6 +88,12 @@ type DefaultServiceNetwork struct {
 	// This contains all service identifiers ever successfully created
 	serviceIdentifiersRepository *service_identifiers.ServiceIdentifiersRepository
 	serviceIdentifiersMutex      *sync.Mutex // VERY IMPORTANT TO CHECK AT THE START OF EVERY METHOD!
+
+	// Secret values are resolved right before the services are started and masked before their config gets persisted
+	secretStore *secret_store.SecretStore
+
+	// Not every backend supports every operation, like renaming services
+	kurtosisBackendType args.KurtosisBackendType
 }
 
 func NewDefaultServiceNetwork(
@@ -93,6 +102,8 @@ func NewDefaultServiceNetwork(
 	kurtosisBackend backend_interface.KurtosisBackend,
 	enclaveDataDir *enclave_data_directory.EnclaveDataDirectory,
 	enclaveDb *enclave_db.EnclaveDB,
+	secretStore *secret_store.SecretStore,
+	kurtosisBackendType args.KurtosisBackendType,
 ) (*DefaultServiceNetwork, error) {
 	serviceIdentifiersRepository, err := service_identifiers.GetOrCreateNewServiceIdentifiersRepository(enclaveDb)
 	if err != nil {
@@ -115,6 +126,8 @@ func NewDefaultServiceNetwork(
 		serviceRegistrationMutex:      &sync.Mutex{},
 		serviceIdentifiersRepository:  serviceIdentifiersRepository,
 		serviceIdentifiersMutex:       &sync.Mutex{},
+		secretStore:                   secretStore,
+		kurtosisBackendType:           kurtosisBackendType,
 	}, nil
 }
 
@@ -397,6 +410,102 @@ func (network *DefaultServiceNetwork) UpdateServices(ctx context.Context, update
 	return successfullyUpdatedService, failedServicesPool, nil
 }
 
+// RenameService gives a running service a new name, keeping its UUID. The service container is re-created from the
+// updated registration so the new name is also the one other services reach it by. If the service can't be renamed
+// once its container is removed, it's restarted under its old name. Like UpdateServices, this does not roll back if the
+// renamed service fails to come back up.
+// The hostname of the service that other services got passed, for instance in their environment variables, isn't
+// rewritten, so they keep referring to the old name until they're updated
+func (network *DefaultServiceNetwork) RenameService(ctx context.Context, serviceIdentifier string, newServiceName service.ServiceName) (*service.Service, error) {
+	if isValidServiceName := service.IsServiceNameValid(newServiceName); !isValidServiceName {
+		return nil, stacktrace.NewError("Service name '%v' is invalid as it contains disallowed characters. Service names can only contain characters 'a-z', 'A-Z', '0-9', '-' & '_'", newServiceName)
+	}
+	// On Kubernetes, the name of a service is the name of its Kubernetes service, which can't be renamed without giving
+	// it a new IP address, so the service is left running rather than being stopped for nothing
+	if network.kurtosisBackendType == args.KurtosisBackendType_Kubernetes {
+		return nil, stacktrace.NewError("Renaming services isn't supported on Kubernetes")
+	}
+
+	network.mutex.Lock()
+	defer network.mutex.Unlock()
+
+	serviceRegistration, err := network.getServiceRegistrationForIdentifierLocked(serviceIdentifier)
+	if err != nil {
+		return nil, stacktrace.Propagate(err, "An error occurred getting the service registration for service identifier '%v'", serviceIdentifier)
+	}
+	serviceName := serviceRegistration.GetName()
+	serviceUuid := serviceRegistration.GetUUID()
+	if serviceName == newServiceName {
+		return nil, stacktrace.NewError("Service '%v' is already named '%v'", serviceIdentifier, newServiceName)
+	}
+	if serviceRegistration.GetStatus() != service.ServiceStatus_Started {
+		return nil, stacktrace.NewError("Only running services can be renamed but service '%v' is '%v'", serviceName, serviceRegistration.GetStatus())
+	}
+	newServiceNameExists, err := network.serviceRegistrationRepository.Exist(newServiceName)
+	if err != nil {
+		return nil, stacktrace.Propagate(err, "An error occurred checking if service name '%v' is already in use", newServiceName)
+	}
+	if newServiceNameExists {
+		return nil, stacktrace.NewError("Cannot rename service '%v' to '%v' because another service already has that name", serviceName, newServiceName)
+	}
+	serviceConfig := serviceRegistration.GetConfig()
+	// The service gets started again with the same config, so it's checked upfront that its secrets can still be
+	// resolved rather than finding out once its container is removed
+	if _, err := network.resolveSecretsInServiceConfig(serviceUuid, serviceConfig); err != nil {
+		return nil, stacktrace.Propagate(err, "Service '%v' can't be renamed as it couldn't be started again", serviceName)
+	}
+
+	serviceUuidsToRemove := map[service.ServiceUUID]bool{
+		serviceUuid: true,
+	}
+	_, failedRemovedServices, err := network.kurtosisBackend.RemoveRegisteredUserServiceProcesses(ctx, network.enclaveUuid, serviceUuidsToRemove)
+	if err != nil {
+		return nil, stacktrace.Propagate(err, "An error occurred removing the container of service '%v'", serviceName)
+	}
+	if removeErr, found := failedRemovedServices[serviceUuid]; found {
+		return nil, stacktrace.Propagate(removeErr, "An error occurred removing the container of service '%v'", serviceName)
+	}
+	if err := network.serviceRegistrationRepository.UpdateStatusAndConfig(serviceName, service.ServiceStatus_Registered, nil); err != nil {
+		return nil, stacktrace.Propagate(err, "An error occurred updating the status of service '%v' to '%v' after its container was removed", serviceName, service.ServiceStatus_Registered)
+	}
+
+	if _, err := network.kurtosisBackend.RenameUserService(ctx, network.enclaveUuid, serviceUuid, newServiceName); err != nil {
+		renameErr := stacktrace.Propagate(err, "An error occurred renaming service '%v' to '%v'", serviceName, newServiceName)
+		return nil, network.restartServiceUnderOldName(ctx, serviceUuid, serviceName, serviceConfig, renameErr)
+	}
+	network.serviceIdentifiersMutex.Lock()
+	err = network.serviceIdentifiersRepository.RenameServiceIdentifier(serviceUuid, newServiceName)
+	network.serviceIdentifiersMutex.Unlock()
+	if err != nil {
+		renameErr := stacktrace.Propagate(err, "An error occurred renaming the identifiers of service '%v' to '%v'", serviceName, newServiceName)
+		if _, err := network.kurtosisBackend.RenameUserService(ctx, network.enclaveUuid, serviceUuid, serviceName); err != nil {
+			return nil, stacktrace.Propagate(renameErr, "Service '%v' couldn't be renamed back to its old name after the error below, so it's left stopped. Error renaming it back was:\n%v", serviceName, err)
+		}
+		return nil, network.restartServiceUnderOldName(ctx, serviceUuid, serviceName, serviceConfig, renameErr)
+	}
+
+	renamedService, err := network.startRegisteredService(ctx, serviceUuid, serviceConfig)
+	if err != nil {
+		return nil, stacktrace.Propagate(err, "Service '%v' was renamed to '%v' but an error occurred starting it again", serviceName, newServiceName)
+	}
+	if err := network.serviceRegistrationRepository.UpdateStatus(newServiceName, service.ServiceStatus_Started); err != nil {
+		return nil, stacktrace.Propagate(err, "An error occurred while updating service status to '%s' in service registration for service '%s' after the service was renamed", service.ServiceStatus_Started, newServiceName)
+	}
+	return renamedService, nil
+}
+
+// Restarts a service whose container got removed to rename it, returning the error that prevented renaming it along
+// with whether it got restarted
+func (network *DefaultServiceNetwork) restartServiceUnderOldName(ctx context.Context, serviceUuid service.ServiceUUID, serviceName service.ServiceName, serviceConfig *service.ServiceConfig, renameErr error) error {
+	if _, err := network.startRegisteredService(ctx, serviceUuid, serviceConfig); err != nil {
+		return stacktrace.Propagate(renameErr, "Service '%v' couldn't be restarted under its old name after the error below, so it's left stopped. Error restarting it was:\n%v", serviceName, err)
+	}
+	if err := network.serviceRegistrationRepository.UpdateStatus(serviceName, service.ServiceStatus_Started); err != nil {
+		return stacktrace.Propagate(renameErr, "Service '%v' was restarted under its old name after the error below, but its status couldn't be updated to '%v'. Error updating it was:\n%v", serviceName, service.ServiceStatus_Started, err)
+	}
+	return stacktrace.Propagate(renameErr, "Service '%v' couldn't be renamed so it was restarted under its old name", serviceName)
+}
+
 func (network *DefaultServiceNetwork) RemoveService(
 	ctx context.Context,
 	serviceIdentifier string,
@@ -436,6 +545,7 @@ func (network *DefaultServiceNetwork) RemoveService(
 		return "", stacktrace.Propagate(err, "An error occurred deleting the service registration for service '%v' from the repository", serviceName)
 	}
 	network.serviceRegistrationMutex.Unlock()
+	network.secretStore.ForgetServiceSecrets(string(serviceUuid))
 
 	return serviceUuid, nil
 }
@@ -482,7 +592,15 @@ func (network *DefaultServiceNetwork) StartServices(
 	}
 
 	for serviceUuid, serviceRegistration := range serviceRegistrations {
-		serviceConfigs[serviceUuid] = serviceRegistration.GetConfig()
+		serviceConfigWithSecrets, err := network.resolveSecretsInServiceConfig(serviceUuid, serviceRegistration.GetConfig())
+		if err != nil {
+			erroredUuids[serviceUuid] = stacktrace.Propagate(err, "An error occurred resolving the secrets referenced in the config of service '%v'", serviceRegistration.GetName())
+			continue
+		}
+		serviceConfigs[serviceUuid] = serviceConfigWithSecrets
+	}
+	if len(serviceConfigs) == 0 {
+		return successfulUuids, erroredUuids, nil
 	}
 
 	successfulServices, failedServices, err := network.kurtosisBackend.StartRegisteredUserServices(ctx, network.enclaveUuid, serviceConfigs)
@@ -742,6 +860,47 @@ func (network *DefaultServiceNetwork) GetService(ctx context.Context, serviceIde
 	return serviceObj, nil
 }
 
+// GetServiceStats samples the resources used by the given services, or by all the started services if none are given
+func (network *DefaultServiceNetwork) GetServiceStats(ctx context.Context, serviceIdentifiers []string) (map[service.ServiceUUID]*service.ServiceStats, error) {
+	serviceUuids := map[service.ServiceUUID]bool{}
+	if len(serviceIdentifiers) == 0 {
+		registeredServices, err := network.serviceRegistrationRepository.GetAll()
+		if err != nil {
+			return nil, stacktrace.Propagate(err, "An error occurred getting registered services from the repository")
+		}
+		for _, registration := range registeredServices {
+			if registration.GetStatus() == service.ServiceStatus_Started {
+				serviceUuids[registration.GetUUID()] = true
+			}
+		}
+	}
+	for _, serviceIdentifier := range serviceIdentifiers {
+		serviceRegistration, err := network.getServiceRegistrationForIdentifierLocked(serviceIdentifier)
+		if err != nil {
+			return nil, stacktrace.Propagate(err, "An error occurred while fetching registration for service identifier '%v'", serviceIdentifier)
+		}
+		serviceUuids[serviceRegistration.GetUUID()] = true
+	}
+	// Filters without UUIDs would match all the services
+	if len(serviceUuids) == 0 {
+		return map[service.ServiceUUID]*service.ServiceStats{}, nil
+	}
+
+	serviceStatsFilters := &service.ServiceFilters{
+		Names:    nil,
+		UUIDs:    serviceUuids,
+		Statuses: nil,
+	}
+	successfulServiceStats, erroredServiceUuids, err := network.kurtosisBackend.GetUserServiceStats(ctx, network.enclaveUuid, serviceStatsFilters)
+	if err != nil {
+		return nil, stacktrace.Propagate(err, "An error occurred getting the stats of services '%v'", serviceUuids)
+	}
+	for serviceUuid, serviceErr := range erroredServiceUuids {
+		return nil, stacktrace.Propagate(serviceErr, "An error occurred getting the stats of service '%v'", serviceUuid)
+	}
+	return successfulServiceStats, nil
+}
+
 func (network *DefaultServiceNetwork) GetServiceNames() (map[service.ServiceName]bool, error) {
 
 	serviceNames, err := network.serviceRegistrationRepository.GetAllServiceNames()
@@ -936,6 +1095,7 @@ func (network *DefaultServiceNetwork) unregisterService(ctx context.Context, ser
 		return stacktrace.Propagate(err, "An error occurred deleting the service registration for service '%v' from the repository", serviceName)
 	}
 	serviceUuid := serviceRegistration.GetUUID()
+	network.secretStore.ForgetServiceSecrets(string(serviceUuid))
 	serviceToUnregister := map[service.ServiceUUID]bool{
 		serviceUuid: true,
 	}
@@ -969,9 +1129,14 @@ func (network *DefaultServiceNetwork) startRegisteredService(
 		return nil, stacktrace.NewError("Memory allocation, `%d`, is too low. Kurtosis requires the memory limit to be at least `%d` megabytes for service with UUID '%v'.", serviceConfig.GetMemoryAllocationMegabytes(), minMemoryLimit, serviceUuid)
 	}
 
+	serviceConfigWithSecrets, err := network.resolveSecretsInServiceConfig(serviceUuid, serviceConfig)
+	if err != nil {
+		return nil, stacktrace.Propagate(err, "An error occurred resolving the secrets referenced in the config of service with UUID '%v'", serviceUuid)
+	}
+
 	// TODO(gb): make the backend also handle starting service sequentially to simplify the logic there as well
 	serviceConfigMap := map[service.ServiceUUID]*service.ServiceConfig{
-		serviceUuid: serviceConfig,
+		serviceUuid: serviceConfigWithSecrets,
 	}
 	successfulServices, failedServices, err := network.kurtosisBackend.StartRegisteredUserServices(ctx, network.enclaveUuid, serviceConfigMap)
 	if err != nil {
@@ -1027,15 +1192,48 @@ func (network *DefaultServiceNetwork) startRegisteredService(
 		)
 	}
 
-	serviceStartedSuccessfully = true
 	serviceName := startedService.GetRegistration().GetName()
-	if err := network.serviceRegistrationRepository.UpdateConfig(serviceName, serviceConfig); err != nil {
-		return nil, stacktrace.Propagate(err, "An error occurred while updating service config to '%+v' in service registration for service '%s' after the service was started", serviceConfig, serviceName)
+	serviceConfigWithMaskedSecrets, err := network.transformServiceConfigJson(serviceConfig, network.secretStore.MaskValuesInJson)
+	if err != nil {
+		return nil, stacktrace.Propagate(err, "An error occurred masking the secrets in the config of service '%s'", serviceName)
+	}
+	serviceStartedSuccessfully = true
+	if err := network.serviceRegistrationRepository.UpdateConfig(serviceName, serviceConfigWithMaskedSecrets); err != nil {
+		return nil, stacktrace.Propagate(err, "An error occurred while updating service config to '%+v' in service registration for service '%s' after the service was started", serviceConfigWithMaskedSecrets, serviceName)
 	}
 
 	return startedService, nil
 }
 
+// resolveSecretsInServiceConfig resolves the secret placeholders of the config as persisted in the enclave DB, with the
+// values the service got started with if the latest run didn't pass them
+func (network *DefaultServiceNetwork) resolveSecretsInServiceConfig(serviceUuid service.ServiceUUID, serviceConfig *service.ServiceConfig) (*service.ServiceConfig, error) {
+	return network.transformServiceConfigJson(serviceConfig, func(serviceConfigJson []byte) ([]byte, error) {
+		return network.secretStore.ResolveServicePlaceholdersInJson(string(serviceUuid), serviceConfigJson)
+	})
+}
+
+// transformServiceConfigJson applies the transformation to the JSON representation of the service config, which is
+// what gets persisted in the enclave DB, and returns the resulting config
+func (network *DefaultServiceNetwork) transformServiceConfigJson(
+	serviceConfig *service.ServiceConfig,
+	transformation func(serviceConfigJson []byte) ([]byte, error),
+) (*service.ServiceConfig, error) {
+	serviceConfigJson, err := json.Marshal(serviceConfig)
+	if err != nil {
+		return nil, stacktrace.Propagate(err, "An error occurred marshalling the service config")
+	}
+	transformedServiceConfigJson, err := transformation(serviceConfigJson)
+	if err != nil {
+		return nil, stacktrace.Propagate(err, "An error occurred transforming the service config JSON")
+	}
+	transformedServiceConfig := service.GetEmptyServiceConfig()
+	if err := json.Unmarshal(transformedServiceConfigJson, transformedServiceConfig); err != nil {
+		return nil, stacktrace.Propagate(err, "An error occurred unmarshalling the transformed service config")
+	}
+	return transformedServiceConfig, nil
+}
+
 // destroyService is the opposite of startRegisteredService. It removes a started service from the enclave. Note that it does not
 // take care of unregistering the service. For this, unregisterService should be called
 // Similar to unregisterService, it is expected that the service passed to destroyService has been properly started.
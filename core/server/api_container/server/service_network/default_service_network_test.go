@@ -23,6 +23,8 @@ import (
 	"github.com/kurtosis-tech/kurtosis/container-engine-lib/lib/backend_interface/objects/enclave"
 	"github.com/kurtosis-tech/kurtosis/container-engine-lib/lib/backend_interface/objects/port_spec"
 	"github.com/kurtosis-tech/kurtosis/container-engine-lib/lib/backend_interface/objects/service"
+	"github.com/kurtosis-tech/kurtosis/core/launcher/args"
+	"github.com/kurtosis-tech/kurtosis/core/server/api_container/server/secret_store"
 	"github.com/kurtosis-tech/kurtosis/core/server/commons/enclave_data_directory"
 	"github.com/kurtosis-tech/stacktrace"
 	"github.com/pkg/errors"
@@ -45,6 +47,10 @@ const (
 
 	tcpPortId = "tcp"
 	udpPortId = "udp"
+
+	testSecretName   = "API_KEY"
+	testSecretValue  = "super-secret-value"
+	testSecretEnvVar = "API_KEY"
 )
 
 var (
@@ -84,6 +90,8 @@ func TestAddService_Successful(t *testing.T) {
 		backend,
 		unusedEnclaveDataDir,
 		enclaveDb,
+		secret_store.NewSecretStore(),
+		args.KurtosisBackendType_Docker,
 	)
 	require.Nil(t, err)
 
@@ -165,6 +173,8 @@ func TestAddService_FailedToStart(t *testing.T) {
 		backend,
 		unusedEnclaveDataDir,
 		enclaveDb,
+		secret_store.NewSecretStore(),
+		args.KurtosisBackendType_Docker,
 	)
 	require.Nil(t, err)
 
@@ -259,6 +269,8 @@ func TestAddServices_Success(t *testing.T) {
 		backend,
 		unusedEnclaveDataDir,
 		enclaveDb,
+		secret_store.NewSecretStore(),
+		args.KurtosisBackendType_Docker,
 	)
 	require.Nil(t, err)
 
@@ -352,6 +364,8 @@ func TestAddServices_FailureRollsBackTheEntireBatch(t *testing.T) {
 		backend,
 		unusedEnclaveDataDir,
 		enclaveDb,
+		secret_store.NewSecretStore(),
+		args.KurtosisBackendType_Docker,
 	)
 	require.Nil(t, err)
 
@@ -503,6 +517,8 @@ func TestAddServices_FailedToRegisterService(t *testing.T) {
 		backend,
 		unusedEnclaveDataDir,
 		enclaveDb,
+		secret_store.NewSecretStore(),
+		args.KurtosisBackendType_Docker,
 	)
 	require.Nil(t, err)
 
@@ -557,6 +573,8 @@ func TestStopService_Successful(t *testing.T) {
 		backend,
 		unusedEnclaveDataDir,
 		enclaveDb,
+		secret_store.NewSecretStore(),
+		args.KurtosisBackendType_Docker,
 	)
 	require.Nil(t, err)
 
@@ -615,6 +633,8 @@ func TestStopService_StopUserServicesFailed(t *testing.T) {
 		backend,
 		unusedEnclaveDataDir,
 		enclaveDb,
+		secret_store.NewSecretStore(),
+		args.KurtosisBackendType_Docker,
 	)
 	require.Nil(t, err)
 	err = network.serviceRegistrationRepository.Save(serviceRegistration)
@@ -670,6 +690,8 @@ func TestStopService_ServiceAlreadyStopped(t *testing.T) {
 		backend,
 		unusedEnclaveDataDir,
 		enclaveDb,
+		secret_store.NewSecretStore(),
+		args.KurtosisBackendType_Docker,
 	)
 	require.Nil(t, err)
 	err = network.serviceRegistrationRepository.Save(serviceRegistration)
@@ -729,6 +751,8 @@ func TestStartService_Successful(t *testing.T) {
 		backend,
 		unusedEnclaveDataDir,
 		enclaveDb,
+		secret_store.NewSecretStore(),
+		args.KurtosisBackendType_Docker,
 	)
 	require.Nil(t, err)
 	err = network.serviceRegistrationRepository.Save(serviceRegistration)
@@ -783,6 +807,8 @@ func TestStartService_StartRegisteredUserServicesFailed(t *testing.T) {
 		backend,
 		unusedEnclaveDataDir,
 		enclaveDb,
+		secret_store.NewSecretStore(),
+		args.KurtosisBackendType_Docker,
 	)
 	require.Nil(t, err)
 	err = network.serviceRegistrationRepository.Save(serviceRegistration)
@@ -837,6 +863,8 @@ func TestStartService_ServiceAlreadyStarted(t *testing.T) {
 		backend,
 		unusedEnclaveDataDir,
 		enclaveDb,
+		secret_store.NewSecretStore(),
+		args.KurtosisBackendType_Docker,
 	)
 	require.Nil(t, err)
 	err = network.serviceRegistrationRepository.Save(serviceRegistration)
@@ -864,6 +892,341 @@ func TestStartService_ServiceAlreadyStarted(t *testing.T) {
 	require.Equal(t, serviceRegistrationAfterBeingStarted.GetStatus(), service.ServiceStatus_Started)
 }
 
+func TestStartService_ResolvesSecretsPassedToAnEarlierRun(t *testing.T) {
+	ctx := context.Background()
+	backend := backend_interface.NewMockKurtosisBackend(t)
+
+	serviceInternalTestId := 1
+	serviceName := testServiceNameFromInt(serviceInternalTestId)
+	serviceUuid := testServiceUuidFromInt(serviceInternalTestId)
+	successfulServiceIp := testIpFromInt(serviceInternalTestId)
+	serviceRegistration := service.NewServiceRegistration(serviceName, serviceUuid, enclaveName, successfulServiceIp, string(serviceName))
+	serviceRegistration.SetStatus(service.ServiceStatus_Stopped)
+	// Like the configs persisted in the enclave DB, the config only has the placeholder of the secret
+	serviceRegistration.SetConfig(testServiceConfigWithEnvVars(t, map[string]string{testSecretEnvVar: secret_store.NewSecretPlaceholder(testSecretName)}))
+	serviceObj := service.NewService(serviceRegistration, map[string]*port_spec.PortSpec{}, successfulServiceIp, map[string]*port_spec.PortSpec{}, container.NewContainer(container.ContainerStatus_Running, testContainerImageName, nil, nil, nil))
+
+	file, err := os.CreateTemp("/tmp", "*.db")
+	defer os.Remove(file.Name())
+	require.Nil(t, err)
+	db, err := bolt.Open(file.Name(), 0666, nil)
+	require.Nil(t, err)
+	defer db.Close()
+	enclaveDb := &enclave_db.EnclaveDB{DB: db}
+
+	secretStore := secret_store.NewSecretStore()
+	require.NoError(t, secretStore.SetRunSecrets(map[string]string{testSecretName: testSecretValue}))
+	network, err := NewDefaultServiceNetwork(
+		enclaveName,
+		apiContainerInfo,
+		backend,
+		unusedEnclaveDataDir,
+		enclaveDb,
+		secretStore,
+		args.KurtosisBackendType_Docker,
+	)
+	require.Nil(t, err)
+	err = network.serviceRegistrationRepository.Save(serviceRegistration)
+	require.NoError(t, err)
+
+	// Started once while the run secrets have the secret, then again after a run without it
+	backend.EXPECT().StopUserServices(ctx, enclaveName, mock.Anything).Times(1).Return(
+		map[service.ServiceUUID]bool{serviceUuid: true},
+		map[service.ServiceUUID]error{},
+		nil,
+	)
+	backend.EXPECT().StartRegisteredUserServices(
+		ctx,
+		enclaveName,
+		mock.MatchedBy(func(serviceConfigs map[service.ServiceUUID]*service.ServiceConfig) bool {
+			return serviceConfigs[serviceUuid].GetEnvVars()[testSecretEnvVar] == testSecretValue
+		}),
+	).Times(2).Return(
+		map[service.ServiceUUID]*service.Service{
+			serviceUuid: serviceObj,
+		},
+		map[service.ServiceUUID]error{},
+		nil,
+	)
+
+	require.NoError(t, network.StartService(ctx, string(serviceName)))
+	require.NoError(t, secretStore.SetRunSecrets(map[string]string{}))
+	require.NoError(t, network.StopService(ctx, string(serviceName)))
+	require.NoError(t, network.StartService(ctx, string(serviceName)))
+	serviceRegistrationAfterBeingStarted, err := network.serviceRegistrationRepository.Get(serviceName)
+	require.NoError(t, err)
+	require.Equal(t, service.ServiceStatus_Started, serviceRegistrationAfterBeingStarted.GetStatus())
+	// The persisted config still only has the placeholder
+	require.Equal(t, secret_store.NewSecretPlaceholder(testSecretName), serviceRegistrationAfterBeingStarted.GetConfig().GetEnvVars()[testSecretEnvVar])
+}
+
+func TestStartService_MissingSecret(t *testing.T) {
+	ctx := context.Background()
+	backend := backend_interface.NewMockKurtosisBackend(t)
+
+	serviceInternalTestId := 1
+	serviceName := testServiceNameFromInt(serviceInternalTestId)
+	serviceUuid := testServiceUuidFromInt(serviceInternalTestId)
+	serviceRegistration := service.NewServiceRegistration(serviceName, serviceUuid, enclaveName, testIpFromInt(serviceInternalTestId), string(serviceName))
+	serviceRegistration.SetStatus(service.ServiceStatus_Stopped)
+	serviceRegistration.SetConfig(testServiceConfigWithEnvVars(t, map[string]string{testSecretEnvVar: secret_store.NewSecretPlaceholder(testSecretName)}))
+
+	file, err := os.CreateTemp("/tmp", "*.db")
+	defer os.Remove(file.Name())
+	require.Nil(t, err)
+	db, err := bolt.Open(file.Name(), 0666, nil)
+	require.Nil(t, err)
+	defer db.Close()
+	enclaveDb := &enclave_db.EnclaveDB{DB: db}
+
+	network, err := NewDefaultServiceNetwork(
+		enclaveName,
+		apiContainerInfo,
+		backend,
+		unusedEnclaveDataDir,
+		enclaveDb,
+		secret_store.NewSecretStore(),
+		args.KurtosisBackendType_Docker,
+	)
+	require.Nil(t, err)
+	err = network.serviceRegistrationRepository.Save(serviceRegistration)
+	require.NoError(t, err)
+
+	// The backend isn't called, so the container never gets the placeholder
+	err = network.StartService(ctx, string(serviceName))
+	require.Error(t, err)
+	require.Contains(t, err.Error(), testSecretName)
+	serviceRegistrationAfterFailedStart, err := network.serviceRegistrationRepository.Get(serviceName)
+	require.NoError(t, err)
+	require.Equal(t, service.ServiceStatus_Stopped, serviceRegistrationAfterFailedStart.GetStatus())
+}
+
+func TestRenameService_RestartsUnderOldNameWhenRenamingFails(t *testing.T) {
+	ctx := context.Background()
+	backend := backend_interface.NewMockKurtosisBackend(t)
+
+	serviceInternalTestId := 1
+	serviceName := testServiceNameFromInt(serviceInternalTestId)
+	serviceUuid := testServiceUuidFromInt(serviceInternalTestId)
+	newServiceName := testServiceNameFromInt(serviceInternalTestId + 1)
+	successfulServiceIp := testIpFromInt(serviceInternalTestId)
+	serviceRegistration := service.NewServiceRegistration(serviceName, serviceUuid, enclaveName, successfulServiceIp, string(serviceName))
+	serviceRegistration.SetStatus(service.ServiceStatus_Started)
+	serviceConfig := testServiceConfig(t, testContainerImageName)
+	serviceRegistration.SetConfig(serviceConfig)
+	serviceObj := service.NewService(serviceRegistration, map[string]*port_spec.PortSpec{}, successfulServiceIp, map[string]*port_spec.PortSpec{}, container.NewContainer(container.ContainerStatus_Running, testContainerImageName, nil, nil, nil))
+
+	file, err := os.CreateTemp("/tmp", "*.db")
+	defer os.Remove(file.Name())
+	require.Nil(t, err)
+	db, err := bolt.Open(file.Name(), 0666, nil)
+	require.Nil(t, err)
+	defer db.Close()
+	enclaveDb := &enclave_db.EnclaveDB{DB: db}
+
+	network, err := NewDefaultServiceNetwork(
+		enclaveName,
+		apiContainerInfo,
+		backend,
+		unusedEnclaveDataDir,
+		enclaveDb,
+		secret_store.NewSecretStore(),
+		args.KurtosisBackendType_Docker,
+	)
+	require.Nil(t, err)
+	err = network.serviceRegistrationRepository.Save(serviceRegistration)
+	require.NoError(t, err)
+
+	backend.EXPECT().RemoveRegisteredUserServiceProcesses(
+		ctx,
+		enclaveName,
+		map[service.ServiceUUID]bool{serviceUuid: true},
+	).Times(1).Return(
+		map[service.ServiceUUID]bool{serviceUuid: true},
+		map[service.ServiceUUID]error{},
+		nil,
+	)
+	// Like on Kubernetes, which doesn't support renaming services
+	backend.EXPECT().RenameUserService(ctx, enclaveName, serviceUuid, newServiceName).Times(1).Return(nil, stacktrace.NewError("Renaming services isn't supported"))
+	backend.EXPECT().StartRegisteredUserServices(
+		ctx,
+		enclaveName,
+		map[service.ServiceUUID]*service.ServiceConfig{
+			serviceUuid: serviceConfig,
+		},
+	).Times(1).Return(
+		map[service.ServiceUUID]*service.Service{
+			serviceUuid: serviceObj,
+		},
+		map[service.ServiceUUID]error{},
+		nil,
+	)
+
+	_, err = network.RenameService(ctx, string(serviceName), newServiceName)
+	require.Error(t, err)
+	require.Contains(t, err.Error(), "Renaming services isn't supported")
+	require.Contains(t, err.Error(), "restarted under its old name")
+	serviceRegistrationAfterFailedRename, err := network.serviceRegistrationRepository.Get(serviceName)
+	require.NoError(t, err)
+	require.Equal(t, service.ServiceStatus_Started, serviceRegistrationAfterFailedRename.GetStatus())
+}
+
+func TestRenameService_ResolvesSecretsPassedToAnEarlierRun(t *testing.T) {
+	ctx := context.Background()
+	backend := backend_interface.NewMockKurtosisBackend(t)
+
+	serviceInternalTestId := 1
+	serviceName := testServiceNameFromInt(serviceInternalTestId)
+	serviceUuid := testServiceUuidFromInt(serviceInternalTestId)
+	newServiceName := testServiceNameFromInt(serviceInternalTestId + 1)
+	successfulServiceIp := testIpFromInt(serviceInternalTestId)
+	serviceRegistration := service.NewServiceRegistration(serviceName, serviceUuid, enclaveName, successfulServiceIp, string(serviceName))
+	serviceRegistration.SetStatus(service.ServiceStatus_Stopped)
+	serviceRegistration.SetConfig(testServiceConfigWithEnvVars(t, map[string]string{testSecretEnvVar: secret_store.NewSecretPlaceholder(testSecretName)}))
+	serviceObj := service.NewService(serviceRegistration, map[string]*port_spec.PortSpec{}, successfulServiceIp, map[string]*port_spec.PortSpec{}, container.NewContainer(container.ContainerStatus_Running, testContainerImageName, nil, nil, nil))
+
+	file, err := os.CreateTemp("/tmp", "*.db")
+	defer os.Remove(file.Name())
+	require.Nil(t, err)
+	db, err := bolt.Open(file.Name(), 0666, nil)
+	require.Nil(t, err)
+	defer db.Close()
+	enclaveDb := &enclave_db.EnclaveDB{DB: db}
+
+	secretStore := secret_store.NewSecretStore()
+	require.NoError(t, secretStore.SetRunSecrets(map[string]string{testSecretName: testSecretValue}))
+	network, err := NewDefaultServiceNetwork(
+		enclaveName,
+		apiContainerInfo,
+		backend,
+		unusedEnclaveDataDir,
+		enclaveDb,
+		secretStore,
+		args.KurtosisBackendType_Docker,
+	)
+	require.Nil(t, err)
+	err = network.serviceRegistrationRepository.Save(serviceRegistration)
+	require.NoError(t, err)
+
+	backend.EXPECT().StartRegisteredUserServices(
+		ctx,
+		enclaveName,
+		mock.MatchedBy(func(serviceConfigs map[service.ServiceUUID]*service.ServiceConfig) bool {
+			return serviceConfigs[serviceUuid].GetEnvVars()[testSecretEnvVar] == testSecretValue
+		}),
+	).Times(2).Return(
+		map[service.ServiceUUID]*service.Service{
+			serviceUuid: serviceObj,
+		},
+		map[service.ServiceUUID]error{},
+		nil,
+	)
+	backend.EXPECT().RemoveRegisteredUserServiceProcesses(
+		ctx,
+		enclaveName,
+		map[service.ServiceUUID]bool{serviceUuid: true},
+	).Times(1).Return(
+		map[service.ServiceUUID]bool{serviceUuid: true},
+		map[service.ServiceUUID]error{},
+		nil,
+	)
+	backend.EXPECT().RenameUserService(ctx, enclaveName, serviceUuid, newServiceName).Times(1).Return(nil, stacktrace.NewError("Renaming services isn't supported"))
+
+	require.NoError(t, network.StartService(ctx, string(serviceName)))
+	require.NoError(t, secretStore.SetRunSecrets(map[string]string{}))
+	// The service gets restarted under its old name with the secret it got started with
+	_, err = network.RenameService(ctx, string(serviceName), newServiceName)
+	require.Error(t, err)
+	require.Contains(t, err.Error(), "restarted under its old name")
+	serviceRegistrationAfterFailedRename, err := network.serviceRegistrationRepository.Get(serviceName)
+	require.NoError(t, err)
+	require.Equal(t, service.ServiceStatus_Started, serviceRegistrationAfterFailedRename.GetStatus())
+}
+
+func TestRenameService_MissingSecretLeavesTheServiceRunning(t *testing.T) {
+	ctx := context.Background()
+	backend := backend_interface.NewMockKurtosisBackend(t)
+
+	serviceInternalTestId := 1
+	serviceName := testServiceNameFromInt(serviceInternalTestId)
+	serviceUuid := testServiceUuidFromInt(serviceInternalTestId)
+	newServiceName := testServiceNameFromInt(serviceInternalTestId + 1)
+	serviceRegistration := service.NewServiceRegistration(serviceName, serviceUuid, enclaveName, testIpFromInt(serviceInternalTestId), string(serviceName))
+	serviceRegistration.SetStatus(service.ServiceStatus_Started)
+	serviceRegistration.SetConfig(testServiceConfigWithEnvVars(t, map[string]string{testSecretEnvVar: secret_store.NewSecretPlaceholder(testSecretName)}))
+
+	file, err := os.CreateTemp("/tmp", "*.db")
+	defer os.Remove(file.Name())
+	require.Nil(t, err)
+	db, err := bolt.Open(file.Name(), 0666, nil)
+	require.Nil(t, err)
+	defer db.Close()
+	enclaveDb := &enclave_db.EnclaveDB{DB: db}
+
+	network, err := NewDefaultServiceNetwork(
+		enclaveName,
+		apiContainerInfo,
+		backend,
+		unusedEnclaveDataDir,
+		enclaveDb,
+		secret_store.NewSecretStore(),
+		args.KurtosisBackendType_Docker,
+	)
+	require.Nil(t, err)
+	err = network.serviceRegistrationRepository.Save(serviceRegistration)
+	require.NoError(t, err)
+
+	// The backend isn't called, so the container of the service doesn't get removed
+	_, err = network.RenameService(ctx, string(serviceName), newServiceName)
+	require.Error(t, err)
+	require.Contains(t, err.Error(), testSecretName)
+	serviceRegistrationAfterFailedRename, err := network.serviceRegistrationRepository.Get(serviceName)
+	require.NoError(t, err)
+	require.Equal(t, service.ServiceStatus_Started, serviceRegistrationAfterFailedRename.GetStatus())
+}
+
+func TestRenameService_UnsupportedOnKubernetesLeavesTheServiceRunning(t *testing.T) {
+	ctx := context.Background()
+	backend := backend_interface.NewMockKurtosisBackend(t)
+
+	serviceInternalTestId := 1
+	serviceName := testServiceNameFromInt(serviceInternalTestId)
+	serviceUuid := testServiceUuidFromInt(serviceInternalTestId)
+	newServiceName := testServiceNameFromInt(serviceInternalTestId + 1)
+	serviceRegistration := service.NewServiceRegistration(serviceName, serviceUuid, enclaveName, testIpFromInt(serviceInternalTestId), string(serviceName))
+	serviceRegistration.SetStatus(service.ServiceStatus_Started)
+	serviceRegistration.SetConfig(testServiceConfig(t, testContainerImageName))
+
+	file, err := os.CreateTemp("/tmp", "*.db")
+	defer os.Remove(file.Name())
+	require.Nil(t, err)
+	db, err := bolt.Open(file.Name(), 0666, nil)
+	require.Nil(t, err)
+	defer db.Close()
+	enclaveDb := &enclave_db.EnclaveDB{DB: db}
+
+	network, err := NewDefaultServiceNetwork(
+		enclaveName,
+		apiContainerInfo,
+		backend,
+		unusedEnclaveDataDir,
+		enclaveDb,
+		secret_store.NewSecretStore(),
+		args.KurtosisBackendType_Kubernetes,
+	)
+	require.Nil(t, err)
+	err = network.serviceRegistrationRepository.Save(serviceRegistration)
+	require.NoError(t, err)
+
+	// The backend isn't called, so the container of the service doesn't get removed
+	_, err = network.RenameService(ctx, string(serviceName), newServiceName)
+	require.Error(t, err)
+	require.Contains(t, err.Error(), "isn't supported on Kubernetes")
+	serviceRegistrationAfterFailedRename, err := network.serviceRegistrationRepository.Get(serviceName)
+	require.NoError(t, err)
+	require.Equal(t, service.ServiceStatus_Started, serviceRegistrationAfterFailedRename.GetStatus())
+}
+
 func TestUpdateService(t *testing.T) {
 	ctx := context.Background()
 	backend := backend_interface.NewMockKurtosisBackend(t)
@@ -882,6 +1245,8 @@ func TestUpdateService(t *testing.T) {
 		backend,
 		unusedEnclaveDataDir,
 		enclaveDb,
+		secret_store.NewSecretStore(),
+		args.KurtosisBackendType_Docker,
 	)
 	require.Nil(t, err)
 
@@ -1210,6 +1575,12 @@ func testServiceConfig(t *testing.T, imageName string) *service.ServiceConfig {
 	return serviceConfig
 }
 
+func testServiceConfigWithEnvVars(t *testing.T, envVars map[string]string) *service.ServiceConfig {
+	serviceConfig, err := service.CreateServiceConfig(testContainerImageName, nil, nil, nil, nil, nil, nil, nil, envVars, nil, nil, 0, 0, "", 0, 0, map[string]string{}, nil, nil, map[string]string{}, image_download_mode.ImageDownloadMode_Missing, true, false, []string{})
+	require.NoError(t, err)
+	return serviceConfig
+}
+
 func testIpFromInt(i int) net.IP {
 	return []byte{1, 1, 1, byte(i)}
 }
@@ -577,6 +577,61 @@ func (_c *MockServiceNetwork_GetServiceNames_Call) RunAndReturn(run func() (map[
 	return _c
 }
 
+// GetServiceStats provides a mock function with given fields: ctx, serviceIdentifiers
+func (_m *MockServiceNetwork) GetServiceStats(ctx context.Context, serviceIdentifiers []string) (map[service.ServiceUUID]*service.ServiceStats, error) {
+	ret := _m.Called(ctx, serviceIdentifiers)
+
+	var r0 map[service.ServiceUUID]*service.ServiceStats
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, []string) (map[service.ServiceUUID]*service.ServiceStats, error)); ok {
+		return rf(ctx, serviceIdentifiers)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, []string) map[service.ServiceUUID]*service.ServiceStats); ok {
+		r0 = rf(ctx, serviceIdentifiers)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(map[service.ServiceUUID]*service.ServiceStats)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, []string) error); ok {
+		r1 = rf(ctx, serviceIdentifiers)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// MockServiceNetwork_GetServiceStats_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'GetServiceStats'
+type MockServiceNetwork_GetServiceStats_Call struct {
+	*mock.Call
+}
+
+// GetServiceStats is a helper method to define mock.On call
+//   - ctx context.Context
+//   - serviceIdentifiers []string
+func (_e *MockServiceNetwork_Expecter) GetServiceStats(ctx interface{}, serviceIdentifiers interface{}) *MockServiceNetwork_GetServiceStats_Call {
+	return &MockServiceNetwork_GetServiceStats_Call{Call: _e.mock.On("GetServiceStats", ctx, serviceIdentifiers)}
+}
+
+func (_c *MockServiceNetwork_GetServiceStats_Call) Run(run func(ctx context.Context, serviceIdentifiers []string)) *MockServiceNetwork_GetServiceStats_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].([]string))
+	})
+	return _c
+}
+
+func (_c *MockServiceNetwork_GetServiceStats_Call) Return(_a0 map[service.ServiceUUID]*service.ServiceStats, _a1 error) *MockServiceNetwork_GetServiceStats_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *MockServiceNetwork_GetServiceStats_Call) RunAndReturn(run func(context.Context, []string) (map[service.ServiceUUID]*service.ServiceStats, error)) *MockServiceNetwork_GetServiceStats_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
 // GetServices provides a mock function with given fields: ctx
 func (_m *MockServiceNetwork) GetServices(ctx context.Context) (map[service.ServiceUUID]*service.Service, error) {
 	ret := _m.Called(ctx)
@@ -796,6 +851,62 @@ func (_c *MockServiceNetwork_RemoveService_Call) RunAndReturn(run func(context.C
 	return _c
 }
 
+// RenameService provides a mock function with given fields: ctx, serviceIdentifier, newServiceName
+func (_m *MockServiceNetwork) RenameService(ctx context.Context, serviceIdentifier string, newServiceName service.ServiceName) (*service.Service, error) {
+	ret := _m.Called(ctx, serviceIdentifier, newServiceName)
+
+	var r0 *service.Service
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, string, service.ServiceName) (*service.Service, error)); ok {
+		return rf(ctx, serviceIdentifier, newServiceName)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, string, service.ServiceName) *service.Service); ok {
+		r0 = rf(ctx, serviceIdentifier, newServiceName)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*service.Service)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, string, service.ServiceName) error); ok {
+		r1 = rf(ctx, serviceIdentifier, newServiceName)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// MockServiceNetwork_RenameService_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'RenameService'
+type MockServiceNetwork_RenameService_Call struct {
+	*mock.Call
+}
+
+// RenameService is a helper method to define mock.On call
+//   - ctx context.Context
+//   - serviceIdentifier string
+//   - newServiceName service.ServiceName
+func (_e *MockServiceNetwork_Expecter) RenameService(ctx interface{}, serviceIdentifier interface{}, newServiceName interface{}) *MockServiceNetwork_RenameService_Call {
+	return &MockServiceNetwork_RenameService_Call{Call: _e.mock.On("RenameService", ctx, serviceIdentifier, newServiceName)}
+}
+
+func (_c *MockServiceNetwork_RenameService_Call) Run(run func(ctx context.Context, serviceIdentifier string, newServiceName service.ServiceName)) *MockServiceNetwork_RenameService_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(string), args[2].(service.ServiceName))
+	})
+	return _c
+}
+
+func (_c *MockServiceNetwork_RenameService_Call) Return(_a0 *service.Service, _a1 error) *MockServiceNetwork_RenameService_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *MockServiceNetwork_RenameService_Call) RunAndReturn(run func(context.Context, string, service.ServiceName) (*service.Service, error)) *MockServiceNetwork_RenameService_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
 // RenderTemplates provides a mock function with given fields: templatesAndDataByDestinationRelFilepath, artifactName
 func (_m *MockServiceNetwork) RenderTemplates(templatesAndDataByDestinationRelFilepath map[string]*render_templates.TemplateData, artifactName string) (enclave_data_directory.FilesArtifactUUID, error) {
 	ret := _m.Called(templatesAndDataByDestinationRelFilepath, artifactName)
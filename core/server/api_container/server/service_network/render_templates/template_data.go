@@ -46,7 +46,12 @@ func (templateData *TemplateData) GetDataAsSerializedJson() string {
 }
 
 func (templateData *TemplateData) ReplaceRuntimeValues(runtimeValueStore *runtime_value_store.RuntimeValueStore) error {
-	dataAsSerializedJsonWithRuntimeValues, err := magic_string_helper.ReplaceRuntimeValueInString(templateData.dataAsSerializedJson, runtimeValueStore)
+	// secrets are resolved first, JSON-escaped, as their values can contain characters that would break the JSON data
+	dataAsSerializedJsonWithSecrets, err := runtimeValueStore.GetSecretStore().ResolvePlaceholdersInJson([]byte(templateData.dataAsSerializedJson))
+	if err != nil {
+		return stacktrace.Propagate(err, "An error occurred while resolving the secrets referenced in the JSON data")
+	}
+	dataAsSerializedJsonWithRuntimeValues, err := magic_string_helper.ReplaceRuntimeValueInString(string(dataAsSerializedJsonWithSecrets), runtimeValueStore)
 	if err != nil {
 		return stacktrace.Propagate(
 			err,
@@ -2,6 +2,7 @@ package service_identifiers
 
 import (
 	"encoding/json"
+	"github.com/kurtosis-tech/kurtosis/container-engine-lib/lib/backend_interface/objects/service"
 	"github.com/kurtosis-tech/kurtosis/container-engine-lib/lib/database_accessors/consts"
 	"github.com/kurtosis-tech/kurtosis/container-engine-lib/lib/database_accessors/enclave_db"
 	"github.com/kurtosis-tech/stacktrace"
@@ -70,6 +71,42 @@ func (repository *ServiceIdentifiersRepository) AddServiceIdentifier(
 	return nil
 }
 
+// RenameServiceIdentifier changes the name of every identifier of the service with the given UUID, so the old name
+// stops resolving to it
+func (repository *ServiceIdentifiersRepository) RenameServiceIdentifier(
+	serviceUuid service.ServiceUUID,
+	newServiceName service.ServiceName,
+) error {
+
+	if err := repository.enclaveDb.Update(func(tx *bolt.Tx) error {
+		bucket := tx.Bucket(serviceIdentifiersBucketName)
+
+		serviceIdentifiers, err := getServiceIdentifiersFromBucket(bucket)
+		if err != nil {
+			return stacktrace.Propagate(err, "An error occurred getting service identifiers from bucket with name '%s'", serviceIdentifiersBucketName)
+		}
+
+		for _, serviceIdentifierObj := range serviceIdentifiers {
+			if serviceIdentifierObj.GetUuid() == serviceUuid {
+				serviceIdentifierObj.name = newServiceName
+			}
+		}
+		serviceIdentifiersPtr := &serviceIdentifiers
+		jsonBytes, err := json.Marshal(serviceIdentifiersPtr)
+		if err != nil {
+			return stacktrace.Propagate(err, "An error occurred marshalling service identifiers '%+v'", serviceIdentifiers)
+		}
+
+		if err := bucket.Put(serviceIdentifiersSliceKey, jsonBytes); err != nil {
+			return stacktrace.Propagate(err, "An error occurred while saving service identifiers '%+v' into the enclave db", serviceIdentifiers)
+		}
+		return nil
+	}); err != nil {
+		return stacktrace.Propagate(err, "An error occurred while renaming service identifier with UUID '%v' to '%v' in the enclave db", serviceUuid, newServiceName)
+	}
+	return nil
+}
+
 func (repository *ServiceIdentifiersRepository) GetServiceIdentifiers() (ServiceIdentifiers, error) {
 	var (
 		serviceIdentifiers = []*serviceIdentifier{}
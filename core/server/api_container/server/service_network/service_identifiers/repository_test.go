@@ -31,6 +31,28 @@ func TestAddServiceIdentifier_Success(t *testing.T) {
 	}
 }
 
+func TestRenameServiceIdentifier_Success(t *testing.T) {
+	repository := getRepositoryForTest(t)
+
+	originalServiceIdentifiers := getServiceIdentifiersForTest()
+	for _, serviceIdentifierObj := range originalServiceIdentifiers {
+		err := repository.AddServiceIdentifier(serviceIdentifierObj)
+		require.NoError(t, err)
+	}
+
+	renamedServiceUuid := originalServiceIdentifiers[0].GetUuid()
+	err := repository.RenameServiceIdentifier(renamedServiceUuid, "renamed-service-for-test")
+	require.NoError(t, err)
+
+	serviceIdentifiersResult, err := repository.GetServiceIdentifiers()
+	require.NoError(t, err)
+	require.Len(t, serviceIdentifiersResult, len(originalServiceIdentifiers))
+	require.Equal(t, renamedServiceUuid, serviceIdentifiersResult[0].GetUuid())
+	require.Equal(t, originalServiceIdentifiers[0].GetShortenedUUIDStr(), serviceIdentifiersResult[0].GetShortenedUUIDStr())
+	require.EqualValues(t, "renamed-service-for-test", serviceIdentifiersResult[0].GetName())
+	require.EqualValues(t, originalServiceIdentifiers[1], serviceIdentifiersResult[1])
+}
+
 func getRepositoryForTest(t *testing.T) *ServiceIdentifiersRepository {
 	file, err := os.CreateTemp("/tmp", "*.db")
 	defer func() {
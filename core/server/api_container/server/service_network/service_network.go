@@ -58,6 +58,8 @@ type ServiceNetwork interface {
 
 	RemoveService(ctx context.Context, serviceIdentifier string) (service.ServiceUUID, error)
 
+	RenameService(ctx context.Context, serviceIdentifier string, newServiceName service.ServiceName) (*service.Service, error)
+
 	StartService(ctx context.Context, serviceIdentifier string) error
 
 	StartServices(
@@ -97,6 +99,8 @@ type ServiceNetwork interface {
 
 	GetServices(ctx context.Context) (map[service.ServiceUUID]*service.Service, error)
 
+	GetServiceStats(ctx context.Context, serviceIdentifiers []string) (map[service.ServiceUUID]*service.ServiceStats, error)
+
 	CopyFilesFromService(ctx context.Context, serviceIdentifier string, srcPath string, artifactName string) (enclave_data_directory.FilesArtifactUUID, error)
 
 	GetServiceNames() (map[service.ServiceName]bool, error)
@@ -0,0 +1,60 @@
+package secret
+
+import (
+	"github.com/kurtosis-tech/kurtosis/core/server/api_container/server/secret_store"
+	"github.com/kurtosis-tech/kurtosis/core/server/api_container/server/startosis_engine/kurtosis_starlark_framework"
+	"github.com/kurtosis-tech/kurtosis/core/server/api_container/server/startosis_engine/kurtosis_starlark_framework/builtin_argument"
+	"github.com/kurtosis-tech/kurtosis/core/server/api_container/server/startosis_engine/kurtosis_starlark_framework/kurtosis_helper"
+	"github.com/kurtosis-tech/kurtosis/core/server/api_container/server/startosis_engine/startosis_errors"
+	"go.starlark.net/starlark"
+)
+
+const (
+	SecretBuiltinName = "Secret"
+
+	NameArgName = "name"
+)
+
+// NewSecretHelper returns the builtin referencing a secret passed to the run. It evaluates to a placeholder that is only
+// replaced by the secret value at execution time, right before it's handed over to a container, so the value never
+// shows up in the plan, in the instruction descriptions or in the enclave DB
+func NewSecretHelper(secretStore *secret_store.SecretStore) *kurtosis_helper.KurtosisHelper {
+	return &kurtosis_helper.KurtosisHelper{
+		KurtosisBaseBuiltin: &kurtosis_starlark_framework.KurtosisBaseBuiltin{
+			Name: SecretBuiltinName,
+			Arguments: []*builtin_argument.BuiltinArgument{
+				{
+					Name:              NameArgName,
+					IsOptional:        false,
+					ZeroValueProvider: builtin_argument.ZeroValueProvider[starlark.String],
+					Validator: func(value starlark.Value) *startosis_errors.InterpretationError {
+						return builtin_argument.NonEmptyString(value, NameArgName)
+					},
+				},
+			},
+		},
+
+		Capabilities: &secretCapabilities{
+			secretStore: secretStore,
+		},
+	}
+}
+
+type secretCapabilities struct {
+	secretStore *secret_store.SecretStore
+}
+
+func (builtin *secretCapabilities) Interpret(_ string, arguments *builtin_argument.ArgumentValuesSet) (starlark.Value, *startosis_errors.InterpretationError) {
+	nameValue, err := builtin_argument.ExtractArgumentValue[starlark.String](arguments, NameArgName)
+	if err != nil {
+		return nil, startosis_errors.WrapWithInterpretationError(err, "Unable to extract value for arg '%s'", NameArgName)
+	}
+	secretName := nameValue.GoString()
+	if !secret_store.IsValidSecretName(secretName) {
+		return nil, startosis_errors.NewInterpretationError("Secret name '%s' is invalid, it can only contain alphanumeric characters, '_', '-' and '.'", secretName)
+	}
+	if !builtin.secretStore.HasSecret(secretName) {
+		return nil, startosis_errors.NewInterpretationError("Secret '%s' was not passed to this run. Secrets are passed with 'kurtosis run --secret %s=<value>' or through a secrets file", secretName, secretName)
+	}
+	return starlark.String(secret_store.NewSecretPlaceholder(secretName)), nil
+}
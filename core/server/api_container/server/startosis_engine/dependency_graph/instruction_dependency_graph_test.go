@@ -6,6 +6,7 @@ import (
 	"testing"
 
 	"github.com/kurtosis-tech/kurtosis/container-engine-lib/lib/database_accessors/enclave_db"
+	"github.com/kurtosis-tech/kurtosis/core/server/api_container/server/secret_store"
 	"github.com/kurtosis-tech/kurtosis/core/server/api_container/server/startosis_engine/kurtosis_instruction/shared_helpers/magic_string_helper"
 	"github.com/kurtosis-tech/kurtosis/core/server/api_container/server/startosis_engine/kurtosis_types"
 	"github.com/kurtosis-tech/kurtosis/core/server/api_container/server/startosis_engine/runtime_value_store"
@@ -225,7 +226,7 @@ func getTestRuntimeValue(t *testing.T) string {
 	enclaveDb := getEnclaveDBForTest(t)
 
 	dummySerde := newDummyStarlarkValueSerDeForTest()
-	runtimeValueStore, err := runtime_value_store.CreateRuntimeValueStore(dummySerde, enclaveDb)
+	runtimeValueStore, err := runtime_value_store.CreateRuntimeValueStore(dummySerde, enclaveDb, secret_store.NewSecretStore())
 	require.NoError(t, err)
 
 	stringValueUuid, err := runtimeValueStore.CreateValue()
@@ -0,0 +1,211 @@
+package startosis_engine
+
+import (
+	"strings"
+
+	"github.com/kurtosis-tech/kurtosis/container-engine-lib/lib/backend_interface/objects/service"
+	"github.com/kurtosis-tech/kurtosis/core/server/api_container/server/startosis_engine/enclave_plan_persistence"
+	"github.com/kurtosis-tech/kurtosis/core/server/api_container/server/startosis_engine/kurtosis_instruction/add_service"
+	"github.com/kurtosis-tech/kurtosis/core/server/api_container/server/startosis_engine/kurtosis_instruction/exec"
+	"github.com/kurtosis-tech/kurtosis/core/server/api_container/server/startosis_engine/kurtosis_instruction/get_service"
+	"github.com/kurtosis-tech/kurtosis/core/server/api_container/server/startosis_engine/kurtosis_instruction/remove_service"
+	"github.com/kurtosis-tech/kurtosis/core/server/api_container/server/startosis_engine/kurtosis_instruction/request"
+	"github.com/kurtosis-tech/kurtosis/core/server/api_container/server/startosis_engine/kurtosis_instruction/set_service"
+	"github.com/kurtosis-tech/kurtosis/core/server/api_container/server/startosis_engine/kurtosis_instruction/start_service"
+	"github.com/kurtosis-tech/kurtosis/core/server/api_container/server/startosis_engine/kurtosis_instruction/stop_service"
+	"github.com/kurtosis-tech/kurtosis/core/server/api_container/server/startosis_engine/kurtosis_instruction/store_service_files"
+	"github.com/kurtosis-tech/kurtosis/core/server/api_container/server/startosis_engine/kurtosis_instruction/wait"
+	"github.com/kurtosis-tech/kurtosis/core/server/api_container/server/startosis_engine/kurtosis_types"
+	"github.com/kurtosis-tech/stacktrace"
+	"go.starlark.net/starlark"
+	"go.starlark.net/syntax"
+)
+
+// The argument holding the name of the service each instruction acts on. add_services is handled on its own as the
+// names are the keys of its configs dict
+var serviceNameArgNameByInstructionType = map[string]string{
+	add_service.AddServiceBuiltinName:                add_service.ServiceNameArgName,
+	exec.ExecBuiltinName:                             exec.ServiceNameArgName,
+	get_service.GetServiceBuiltinName:                get_service.ServiceNameArgName,
+	remove_service.RemoveServiceBuiltinName:          remove_service.ServiceNameArgName,
+	request.RequestBuiltinName:                       request.ServiceNameArgName,
+	set_service.SetServiceBuiltinName:                set_service.ServiceNameArgName,
+	start_service.StartServiceBuiltinName:            start_service.ServiceNameArgName,
+	stop_service.StopServiceBuiltinName:              stop_service.ServiceNameArgName,
+	store_service_files.StoreServiceFilesBuiltinName: store_service_files.ServiceNameArgName,
+	wait.WaitBuiltinName:                             wait.ServiceNameArgName,
+}
+
+// renameServiceInEnclavePlan rewrites the instructions of the plan as if they had been written with the new service
+// name, so that a package updated to use the new name resolves against the plan instead of re-creating the service
+func renameServiceInEnclavePlan(enclavePlan *enclave_plan_persistence.EnclavePlan, serviceName service.ServiceName, newServiceName service.ServiceName, newHostname string) error {
+	for _, instruction := range enclavePlan.GeneratePlan() {
+		renamedStarlarkCode, err := renameServiceInStarlarkCode(instruction.Type, instruction.StarlarkCode, serviceName, newServiceName)
+		if err != nil {
+			return stacktrace.Propagate(err, "An error occurred renaming service '%s' in instruction '%s'", serviceName, instruction.StarlarkCode)
+		}
+		renamedReturnedValue, err := renameServiceInReturnedValue(instruction.ReturnedValue, serviceName, newServiceName, newHostname)
+		if err != nil {
+			return stacktrace.Propagate(err, "An error occurred renaming service '%s' in the value returned by instruction '%s'", serviceName, instruction.StarlarkCode)
+		}
+		instruction.StarlarkCode = renamedStarlarkCode
+		instruction.ReturnedValue = renamedReturnedValue
+		for idx, instructionServiceName := range instruction.ServiceNames {
+			if instructionServiceName == string(serviceName) {
+				instruction.ServiceNames[idx] = string(newServiceName)
+			}
+		}
+	}
+	return nil
+}
+
+func renameServiceInStarlarkCode(instructionType string, starlarkCode string, serviceName service.ServiceName, newServiceName service.ServiceName) (string, error) {
+	expr, err := syntax.ParseExpr("", starlarkCode, syntax.RetainComments)
+	if err != nil {
+		return "", stacktrace.Propagate(err, "An error occurred parsing instruction '%s'", starlarkCode)
+	}
+	call, ok := expr.(*syntax.CallExpr)
+	if !ok {
+		return starlarkCode, nil
+	}
+	serviceNameArgName, found := serviceNameArgNameByInstructionType[instructionType]
+	var serviceNameExprs []syntax.Expr
+	for _, arg := range call.Args {
+		argName, argValue, isKeywordArg := getKeywordArg(arg)
+		if !isKeywordArg {
+			continue
+		}
+		if found && argName == serviceNameArgName {
+			serviceNameExprs = append(serviceNameExprs, argValue)
+		}
+		if instructionType == add_service.AddServicesBuiltinName && argName == add_service.ConfigsArgName {
+			if configs, isDict := argValue.(*syntax.DictExpr); isDict {
+				for _, entry := range configs.List {
+					serviceNameExprs = append(serviceNameExprs, entry.(*syntax.DictEntry).Key)
+				}
+			}
+		}
+	}
+	newValueByLiteral := map[*syntax.Literal]string{}
+	for _, serviceNameExpr := range serviceNameExprs {
+		if isStringLiteral(serviceNameExpr, string(serviceName)) {
+			newValueByLiteral[serviceNameExpr.(*syntax.Literal)] = string(newServiceName)
+		}
+	}
+	return replaceLiterals(starlarkCode, newValueByLiteral), nil
+}
+
+// The returned value of add_service(s) and get_service(s) holds Service objects, on their own or in a dict keyed by
+// service name
+func renameServiceInReturnedValue(returnedValue string, serviceName service.ServiceName, newServiceName service.ServiceName, newHostname string) (string, error) {
+	if returnedValue == "" {
+		return returnedValue, nil
+	}
+	expr, err := syntax.ParseExpr("", returnedValue, syntax.RetainComments)
+	if err != nil {
+		return "", stacktrace.Propagate(err, "An error occurred parsing returned value '%s'", returnedValue)
+	}
+	newValueByLiteral := map[*syntax.Literal]string{}
+	syntax.Walk(expr, func(node syntax.Node) bool {
+		switch typedNode := node.(type) {
+		case *syntax.CallExpr:
+			nameExpr, hostnameExpr, isService := getServiceNameAndHostnameArgs(typedNode)
+			if !isService || !isStringLiteral(nameExpr, string(serviceName)) {
+				break
+			}
+			newValueByLiteral[nameExpr.(*syntax.Literal)] = string(newServiceName)
+			if hostnameLiteral, isLiteral := hostnameExpr.(*syntax.Literal); isLiteral && hostnameLiteral.Token == syntax.STRING {
+				newValueByLiteral[hostnameLiteral] = newHostname
+			}
+		case *syntax.DictEntry:
+			valueCall, isCall := typedNode.Value.(*syntax.CallExpr)
+			if !isCall {
+				break
+			}
+			nameExpr, _, isService := getServiceNameAndHostnameArgs(valueCall)
+			if isService && isStringLiteral(nameExpr, string(serviceName)) && isStringLiteral(typedNode.Key, string(serviceName)) {
+				newValueByLiteral[typedNode.Key.(*syntax.Literal)] = string(newServiceName)
+			}
+		}
+		return true
+	})
+	return replaceLiterals(returnedValue, newValueByLiteral), nil
+}
+
+func getServiceNameAndHostnameArgs(call *syntax.CallExpr) (syntax.Expr, syntax.Expr, bool) {
+	fn, isIdent := call.Fn.(*syntax.Ident)
+	if !isIdent || fn.Name != kurtosis_types.ServiceTypeName {
+		return nil, nil, false
+	}
+	var nameExpr, hostnameExpr syntax.Expr
+	for _, arg := range call.Args {
+		argName, argValue, isKeywordArg := getKeywordArg(arg)
+		if !isKeywordArg {
+			continue
+		}
+		switch argName {
+		case kurtosis_types.ServiceNameAttr:
+			nameExpr = argValue
+		case kurtosis_types.HostnameAttr:
+			hostnameExpr = argValue
+		}
+	}
+	return nameExpr, hostnameExpr, nameExpr != nil
+}
+
+func getKeywordArg(arg syntax.Expr) (string, syntax.Expr, bool) {
+	binaryExpr, isBinaryExpr := arg.(*syntax.BinaryExpr)
+	if !isBinaryExpr || binaryExpr.Op != syntax.EQ {
+		return "", nil, false
+	}
+	argName, isIdent := binaryExpr.X.(*syntax.Ident)
+	if !isIdent {
+		return "", nil, false
+	}
+	return argName.Name, binaryExpr.Y, true
+}
+
+func isStringLiteral(expr syntax.Expr, value string) bool {
+	literal, isLiteral := expr.(*syntax.Literal)
+	return isLiteral && literal.Token == syntax.STRING && literal.Value == value
+}
+
+// replaceLiterals swaps the given string literals for new ones, leaving the rest of the code untouched so it still
+// compares equal to what the instruction would serialize to
+func replaceLiterals(code string, newValueByLiteral map[*syntax.Literal]string) string {
+	if len(newValueByLiteral) == 0 {
+		return code
+	}
+	literalsByOffset := map[int]*syntax.Literal{}
+	for literal := range newValueByLiteral {
+		literalsByOffset[getByteOffset(code, literal.TokenPos)] = literal
+	}
+	var renamedCode strings.Builder
+	for offset := 0; offset < len(code); {
+		if literal, found := literalsByOffset[offset]; found {
+			renamedCode.WriteString(starlark.String(newValueByLiteral[literal]).String())
+			offset += len(literal.Raw)
+			continue
+		}
+		renamedCode.WriteByte(code[offset])
+		offset++
+	}
+	return renamedCode.String()
+}
+
+// The parser reports positions as 1-based line and rune column
+func getByteOffset(code string, position syntax.Position) int {
+	line, col := int32(1), int32(1)
+	for offset, char := range code {
+		if line == position.Line && col == position.Col {
+			return offset
+		}
+		if char == '\n' {
+			line++
+			col = 1
+		} else {
+			col++
+		}
+	}
+	return len(code)
+}
@@ -0,0 +1,62 @@
+package startosis_engine
+
+import (
+	"testing"
+
+	"github.com/kurtosis-tech/kurtosis/core/server/api_container/server/startosis_engine/enclave_plan_persistence"
+	"github.com/stretchr/testify/require"
+)
+
+func TestRenameServiceInEnclavePlan(t *testing.T) {
+	enclavePlan := enclave_plan_persistence.NewEnclavePlan()
+	enclavePlan.AppendInstruction(&enclave_plan_persistence.EnclavePlanInstruction{
+		Uuid:           "1",
+		Type:           "add_service",
+		StarlarkCode:   `add_service(name="databse", config=ServiceConfig(image="postgres", env_vars={"NAME": "databse"}))`,
+		ReturnedValue:  `Service(name="databse", hostname="databse", ip_address="10.0.0.2", ports={})`,
+		ServiceNames:   []string{"databse"},
+		FilesArtifacts: map[string][]byte{},
+	})
+	enclavePlan.AppendInstruction(&enclave_plan_persistence.EnclavePlanInstruction{
+		Uuid:           "2",
+		Type:           "add_services",
+		StarlarkCode:   `add_services(configs={"databse-replica": ServiceConfig(image="postgres"), "databse": ServiceConfig(image="postgres")})`,
+		ReturnedValue:  `{"databse-replica": Service(name="databse-replica", hostname="databse-replica", ip_address="10.0.0.3", ports={}), "databse": Service(name="databse", hostname="databse", ip_address="10.0.0.2", ports={})}`,
+		ServiceNames:   []string{"databse-replica", "databse"},
+		FilesArtifacts: map[string][]byte{},
+	})
+	enclavePlan.AppendInstruction(&enclave_plan_persistence.EnclavePlanInstruction{
+		Uuid:           "3",
+		Type:           "store_service_files",
+		StarlarkCode:   `store_service_files(service_name="databse", src="/data", name="databse")`,
+		ReturnedValue:  `"databse"`,
+		ServiceNames:   []string{},
+		FilesArtifacts: map[string][]byte{},
+	})
+	enclavePlan.AppendInstruction(&enclave_plan_persistence.EnclavePlanInstruction{
+		Uuid:           "4",
+		Type:           "print",
+		StarlarkCode:   `print(msg="databse")`,
+		ReturnedValue:  `None`,
+		ServiceNames:   []string{},
+		FilesArtifacts: map[string][]byte{},
+	})
+
+	err := renameServiceInEnclavePlan(enclavePlan, "databse", "database", "database")
+	require.NoError(t, err)
+
+	instructions := enclavePlan.GeneratePlan()
+	// only the service name is renamed, values that happen to be equal to it are left alone
+	require.Equal(t, `add_service(name="database", config=ServiceConfig(image="postgres", env_vars={"NAME": "databse"}))`, instructions[0].StarlarkCode)
+	require.Equal(t, `Service(name="database", hostname="database", ip_address="10.0.0.2", ports={})`, instructions[0].ReturnedValue)
+	require.Equal(t, []string{"database"}, instructions[0].ServiceNames)
+
+	require.Equal(t, `add_services(configs={"databse-replica": ServiceConfig(image="postgres"), "database": ServiceConfig(image="postgres")})`, instructions[1].StarlarkCode)
+	require.Equal(t, `{"databse-replica": Service(name="databse-replica", hostname="databse-replica", ip_address="10.0.0.3", ports={}), "database": Service(name="database", hostname="database", ip_address="10.0.0.2", ports={})}`, instructions[1].ReturnedValue)
+	require.Equal(t, []string{"databse-replica", "database"}, instructions[1].ServiceNames)
+
+	require.Equal(t, `store_service_files(service_name="database", src="/data", name="databse")`, instructions[2].StarlarkCode)
+	require.Equal(t, `"databse"`, instructions[2].ReturnedValue)
+
+	require.Equal(t, `print(msg="databse")`, instructions[3].StarlarkCode)
+}
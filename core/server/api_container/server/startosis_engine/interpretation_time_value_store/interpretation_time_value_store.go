@@ -57,6 +57,22 @@ func (itvs *InterpretationTimeValueStore) RemoveService(name service.ServiceName
 	return nil
 }
 
+// RenameService moves everything stored for the service to its new name
+func (itvs *InterpretationTimeValueStore) RenameService(name service.ServiceName, newName service.ServiceName, newHostname string) error {
+	if err := itvs.serviceValues.RenameService(name, newName, newHostname); err != nil {
+		return stacktrace.Propagate(err, "An error occurred renaming interpretation time service object for service '%v' to '%v'", name, newName)
+	}
+	if serviceConfig, found := itvs.serviceConfigValues[name]; found {
+		delete(itvs.serviceConfigValues, name)
+		itvs.serviceConfigValues[newName] = serviceConfig
+	}
+	if newServiceConfig, found := itvs.setServiceConfigValues[name]; found {
+		delete(itvs.setServiceConfigValues, name)
+		itvs.setServiceConfigValues[newName] = newServiceConfig
+	}
+	return nil
+}
+
 func (itvs *InterpretationTimeValueStore) PutServiceConfig(name service.ServiceName, serviceConfig *service.ServiceConfig) {
 	itvs.serviceConfigValues[name] = serviceConfig
 }
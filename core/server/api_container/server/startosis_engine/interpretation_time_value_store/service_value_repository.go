@@ -7,6 +7,7 @@ import (
 	"github.com/kurtosis-tech/stacktrace"
 	"github.com/sirupsen/logrus"
 	bolt "go.etcd.io/bbolt"
+	"go.starlark.net/starlark"
 )
 
 var (
@@ -150,6 +151,53 @@ func (repository *serviceInterpretationValueRepository) RemoveService(name servi
 	return nil
 }
 
+// RenameService moves the value of the service to its new name, pointing it at its new hostname. Services that
+// weren't added by Starlark have no value, in which case it's a no-op
+func (repository *serviceInterpretationValueRepository) RenameService(name service.ServiceName, newName service.ServiceName, newHostname string) error {
+	logrus.Debugf("Renaming service value for '%v' to '%v' in service value repository...", name, newName)
+	if err := repository.enclaveDb.Update(func(tx *bolt.Tx) error {
+		bucket := tx.Bucket(serviceInterpretationValueBucketName)
+
+		serviceSerializedValue := bucket.Get(getKey(name))
+		if serviceSerializedValue == nil {
+			return nil
+		}
+		if bucket.Get(getKey(newName)) != nil {
+			return stacktrace.NewError("A value for service '%v' already exists in the repository", newName)
+		}
+
+		deserializedValue, interpretationErr := repository.starlarkValueSerde.Deserialize(string(serviceSerializedValue))
+		if interpretationErr != nil {
+			return stacktrace.Propagate(interpretationErr, "an error occurred while deserializing object associated with service '%v' in repository", name)
+		}
+		serviceValue, ok := deserializedValue.(*kurtosis_types.Service)
+		if !ok {
+			return stacktrace.NewError("An error occurred converting the deserialized value '%v' into required internal type", deserializedValue)
+		}
+		ipAddress, interpretationErr := serviceValue.GetIpAddress()
+		if interpretationErr != nil {
+			return stacktrace.Propagate(interpretationErr, "An error occurred getting the IP address of service '%v'", name)
+		}
+		ports, interpretationErr := serviceValue.GetPorts()
+		if interpretationErr != nil {
+			return stacktrace.Propagate(interpretationErr, "An error occurred getting the ports of service '%v'", name)
+		}
+		renamedServiceValue, interpretationErr := kurtosis_types.CreateService(starlark.String(newName), starlark.String(newHostname), starlark.String(ipAddress), ports)
+		if interpretationErr != nil {
+			return stacktrace.Propagate(interpretationErr, "An error occurred creating the value of renamed service '%v'", newName)
+		}
+
+		if err := bucket.Put(getKey(newName), []byte(repository.starlarkValueSerde.Serialize(renamedServiceValue))); err != nil {
+			return stacktrace.Propagate(err, "An error occurred while saving service interpretation time value '%v' for service '%v'", renamedServiceValue, newName)
+		}
+		return bucket.Delete(getKey(name))
+	}); err != nil {
+		return stacktrace.Propagate(err, "An error occurred while renaming service '%v' to '%v' in service value repository", name, newName)
+	}
+	logrus.Debugf("Successfully renamed service value for '%v' to '%v'", name, newName)
+	return nil
+}
+
 func getKey(name service.ServiceName) []byte {
 	return []byte(name)
 }
@@ -141,6 +141,41 @@ func TestRemoveService(t *testing.T) {
 	require.Error(t, err)
 }
 
+func TestRenameService(t *testing.T) {
+	repository := getServiceInterpretationTimeValueRepository(t)
+	require.NotNil(t, repository)
+
+	serviceOneName := fmt.Sprintf("%v-%v", serviceName, "1")
+	serviceOne, interpretationErr := kurtosis_types.CreateService(starlark.String(serviceOneName), starlark.String(serviceOneName), ipAddress, starlark.NewDict(0))
+	require.Nil(t, interpretationErr)
+
+	err := repository.PutService(service.ServiceName(serviceOneName), serviceOne)
+	require.Nil(t, err)
+
+	renamedServiceName := fmt.Sprintf("%v-%v", serviceName, "renamed")
+	err = repository.RenameService(service.ServiceName(serviceOneName), service.ServiceName(renamedServiceName), renamedServiceName)
+	require.Nil(t, err)
+
+	_, err = repository.GetService(service.ServiceName(serviceOneName))
+	require.Error(t, err)
+
+	renamedService, err := repository.GetService(service.ServiceName(renamedServiceName))
+	require.Nil(t, err)
+	actualName, interpretationErr := renamedService.GetName()
+	require.Nil(t, interpretationErr)
+	require.Equal(t, service.ServiceName(renamedServiceName), actualName)
+	actualHostname, interpretationErr := renamedService.GetHostname()
+	require.Nil(t, interpretationErr)
+	require.Equal(t, renamedServiceName, actualHostname)
+	actualIpAddress, interpretationErr := renamedService.GetIpAddress()
+	require.Nil(t, interpretationErr)
+	require.Equal(t, ipAddress.GoString(), actualIpAddress)
+
+	// services that weren't added by Starlark have nothing to rename
+	err = repository.RenameService("not-added-by-starlark", "still-not-added-by-starlark", "still-not-added-by-starlark")
+	require.Nil(t, err)
+}
+
 func getServiceInterpretationTimeValueRepository(t *testing.T) *serviceInterpretationValueRepository {
 	file, err := os.CreateTemp("/tmp", "*.db")
 	defer func() {
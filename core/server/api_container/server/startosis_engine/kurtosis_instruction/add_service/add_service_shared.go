@@ -7,6 +7,7 @@ import (
 
 	"github.com/kurtosis-tech/kurtosis/container-engine-lib/lib/backend_interface/objects/service"
 	"github.com/kurtosis-tech/kurtosis/container-engine-lib/lib/backend_interface/objects/service_directory"
+	"github.com/kurtosis-tech/kurtosis/container-engine-lib/lib/tracing"
 	"github.com/kurtosis-tech/kurtosis/core/server/api_container/server/service_network"
 	"github.com/kurtosis-tech/kurtosis/core/server/api_container/server/startosis_engine/dependency_graph"
 	"github.com/kurtosis-tech/kurtosis/core/server/api_container/server/startosis_engine/kurtosis_instruction/shared_helpers"
@@ -21,12 +22,18 @@ import (
 	"github.com/kurtosis-tech/kurtosis/core/server/api_container/server/startosis_engine/types"
 	"github.com/kurtosis-tech/stacktrace"
 	"github.com/sirupsen/logrus"
+	"go.opentelemetry.io/otel/attribute"
 	"go.starlark.net/starlark"
 )
 
 const (
 	ipAddressRuntimeValue = "ip_address"
 	hostnameRuntimeValue  = "hostname"
+
+	readinessCheckSpanName = "readiness_check"
+
+	serviceNameSpanAttributeKey         = "kurtosis.service.name"
+	readinessCheckTriesSpanAttributeKey = "kurtosis.readiness_check.tries"
 )
 
 func fillAddServiceReturnValueWithRuntimeValues(service *service.Service, resultUuid string, runtimeValueStore *runtime_value_store.RuntimeValueStore) error {
@@ -106,6 +113,10 @@ func validateSingleService(validatorEnvironment *startosis_validator.ValidatorEn
 		return validationErr
 	}
 
+	if validationErr := validatorEnvironment.HasNodeWithEnoughResources(serviceConfig.GetMinCPUAllocationMillicpus(), serviceConfig.GetMinMemoryAllocationMegabytes(), serviceConfig.GetNodeSelectors(), serviceConfig.GetTolerations(), serviceName); validationErr != nil {
+		return validationErr
+	}
+
 	validatorEnvironment.AddServiceName(serviceName)
 
 	if serviceConfig.GetImageBuildSpec() != nil {
@@ -128,6 +139,7 @@ func validateSingleService(validatorEnvironment *startosis_validator.ValidatorEn
 	validatorEnvironment.AddPrivatePortIDForService(portIds, serviceName)
 	validatorEnvironment.ConsumeMemory(serviceConfig.GetMinMemoryAllocationMegabytes(), serviceName)
 	validatorEnvironment.ConsumeCPU(serviceConfig.GetMinCPUAllocationMillicpus(), serviceName)
+	validatorEnvironment.ConsumeNodeResources(serviceConfig.GetMinCPUAllocationMillicpus(), serviceConfig.GetMinMemoryAllocationMegabytes(), serviceConfig.GetNodeSelectors(), serviceConfig.GetTolerations(), serviceName)
 	return nil
 }
 
@@ -264,8 +276,9 @@ func runServiceReadinessCheck(
 
 		startTime := time.Now()
 		logrus.Infof("Checking service readiness for '%s' at '%v'", serviceName, startTime) //TODO change to debug
+		readinessCheckCtx, readinessCheckSpan := tracing.StartSpan(ctx, readinessCheckSpanName, attribute.String(serviceNameSpanAttributeKey, string(serviceName)))
 		lastResult, tries, err := shared_helpers.ExecuteServiceAssertionWithRecipe(
-			ctx,
+			readinessCheckCtx,
 			serviceNetwork,
 			runtimeValueStore,
 			serviceName,
@@ -277,6 +290,8 @@ func runServiceReadinessCheck(
 			interval,
 			timeout,
 		)
+		readinessCheckSpan.SetAttributes(attribute.Int(readinessCheckTriesSpanAttributeKey, tries))
+		tracing.EndSpan(readinessCheckSpan, err)
 		if err != nil {
 			return stacktrace.Propagate(
 				err,
@@ -52,6 +52,10 @@ func ReplaceRuntimeValueInString(originalString string, recipeEngine *runtime_va
 			replacedString = strings.Replace(replacedString, allMatch, value.String(), singleMatch)
 		}
 	}
+	replacedString, err := recipeEngine.GetSecretStore().ResolvePlaceholders(replacedString)
+	if err != nil {
+		return "", stacktrace.Propagate(err, "An error happened resolving the secrets referenced in '%v'", originalString)
+	}
 	return replacedString, nil
 }
 
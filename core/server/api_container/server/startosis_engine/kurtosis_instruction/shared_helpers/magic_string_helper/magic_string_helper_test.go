@@ -3,6 +3,7 @@ package magic_string_helper
 import (
 	"fmt"
 	"github.com/kurtosis-tech/kurtosis/container-engine-lib/lib/database_accessors/enclave_db"
+	"github.com/kurtosis-tech/kurtosis/core/server/api_container/server/secret_store"
 	"github.com/kurtosis-tech/kurtosis/core/server/api_container/server/startosis_engine/kurtosis_types"
 	"github.com/kurtosis-tech/kurtosis/core/server/api_container/server/startosis_engine/runtime_value_store"
 	"github.com/stretchr/testify/require"
@@ -26,7 +27,7 @@ func TestGetOrReplaceRuntimeValueFromString_BasicFetch(t *testing.T) {
 
 	dummySerde := newDummyStarlarkValueSerDeForTest()
 
-	runtimeValueStore, err := runtime_value_store.CreateRuntimeValueStore(dummySerde, enclaveDb)
+	runtimeValueStore, err := runtime_value_store.CreateRuntimeValueStore(dummySerde, enclaveDb, secret_store.NewSecretStore())
 	require.NoError(t, err)
 	stringValueUuid, err := runtimeValueStore.CreateValue()
 	require.Nil(t, err)
@@ -49,7 +50,7 @@ func TestGetOrReplaceRuntimeValueFromString_Interpolated(t *testing.T) {
 
 	dummySerde := newDummyStarlarkValueSerDeForTest()
 
-	runtimeValueStore, err := runtime_value_store.CreateRuntimeValueStore(dummySerde, enclaveDb)
+	runtimeValueStore, err := runtime_value_store.CreateRuntimeValueStore(dummySerde, enclaveDb, secret_store.NewSecretStore())
 	require.NoError(t, err)
 	stringValueUuid, err := runtimeValueStore.CreateValue()
 	require.Nil(t, err)
@@ -72,7 +73,7 @@ func TestReplaceRuntimeValueFromString(t *testing.T) {
 
 	dummySerde := newDummyStarlarkValueSerDeForTest()
 
-	runtimeValueStore, err := runtime_value_store.CreateRuntimeValueStore(dummySerde, enclaveDb)
+	runtimeValueStore, err := runtime_value_store.CreateRuntimeValueStore(dummySerde, enclaveDb, secret_store.NewSecretStore())
 	require.NoError(t, err)
 	stringValueUuid, err := runtimeValueStore.CreateValue()
 	require.Nil(t, err)
@@ -90,6 +91,29 @@ func TestReplaceRuntimeValueFromString(t *testing.T) {
 	require.Equal(t, resolvedInterpolatedString, testExpectedInterpolatedString.GoString())
 }
 
+func TestReplaceRuntimeValueFromString_ResolvesSecrets(t *testing.T) {
+	enclaveDb := getEnclaveDBForTest(t)
+
+	dummySerde := newDummyStarlarkValueSerDeForTest()
+
+	secretStore := secret_store.NewSecretStore()
+	require.NoError(t, secretStore.SetRunSecrets(map[string]string{"TOKEN": "s3cr3t"}))
+	runtimeValueStore, err := runtime_value_store.CreateRuntimeValueStore(dummySerde, enclaveDb, secretStore)
+	require.NoError(t, err)
+	stringValueUuid, err := runtimeValueStore.CreateValue()
+	require.Nil(t, err)
+	err = runtimeValueStore.SetValue(stringValueUuid, map[string]starlark.Comparable{testRuntimeValueField: testStringRuntimeValue})
+	require.NoError(t, err)
+	stringRuntimeValue := fmt.Sprintf(RuntimeValueReplacementPlaceholderFormat, stringValueUuid, testRuntimeValueField)
+	interpolatedString := fmt.Sprintf("%v uses %v", stringRuntimeValue, secret_store.NewSecretPlaceholder("TOKEN"))
+	resolvedInterpolatedString, err := ReplaceRuntimeValueInString(interpolatedString, runtimeValueStore)
+	require.Nil(t, err)
+	require.Equal(t, "test_string uses s3cr3t", resolvedInterpolatedString)
+
+	_, err = ReplaceRuntimeValueInString(secret_store.NewSecretPlaceholder("UNKNOWN"), runtimeValueStore)
+	require.Error(t, err)
+}
+
 func getEnclaveDBForTest(t *testing.T) *enclave_db.EnclaveDB {
 	file, err := os.CreateTemp("/tmp", "*.db")
 	defer func() {
@@ -0,0 +1,56 @@
+package test_engine
+
+import (
+	"fmt"
+	"github.com/kurtosis-tech/kurtosis/core/server/api_container/server/secret_store"
+	"github.com/kurtosis-tech/kurtosis/core/server/api_container/server/startosis_engine/builtins/secret"
+	"github.com/kurtosis-tech/kurtosis/core/server/api_container/server/startosis_engine/kurtosis_starlark_framework/kurtosis_helper"
+	"github.com/stretchr/testify/require"
+	"go.starlark.net/starlark"
+	"testing"
+)
+
+const (
+	testSecretName  = "API_KEY"
+	testSecretValue = "super-secret-value"
+)
+
+type secretTestCase struct {
+	*testing.T
+
+	secretStore *secret_store.SecretStore
+}
+
+func (suite *KurtosisHelperTestSuite) TestSecret() {
+	secretStore := secret_store.NewSecretStore()
+	suite.Require().Nil(secretStore.SetRunSecrets(map[string]string{testSecretName: testSecretValue}))
+
+	suite.run(&secretTestCase{
+		T:           suite.T(),
+		secretStore: secretStore,
+	})
+}
+
+func (suite *KurtosisHelperTestSuite) TestSecret_NotPassedToTheRun() {
+	suite.runShouldFail(
+		testModuleMainFileLocator,
+		&secretTestCase{
+			T:           suite.T(),
+			secretStore: secret_store.NewSecretStore(),
+		},
+		fmt.Sprintf("Secret '%s' was not passed to this run. Secrets are passed with 'kurtosis run --secret %s=<value>' or through a secrets file", testSecretName, testSecretName),
+	)
+}
+
+func (t *secretTestCase) GetHelper() *kurtosis_helper.KurtosisHelper {
+	return secret.NewSecretHelper(t.secretStore)
+}
+
+func (t *secretTestCase) GetStarlarkCode() string {
+	return fmt.Sprintf(`%s(%s=%q)`, secret.SecretBuiltinName, secret.NameArgName, testSecretName)
+}
+
+func (t *secretTestCase) Assert(result starlark.Value) {
+	// the value itself never leaves the store at interpretation time
+	require.Equal(t, starlark.String("{{kurtosis:secret:"+testSecretName+"}}"), result)
+}
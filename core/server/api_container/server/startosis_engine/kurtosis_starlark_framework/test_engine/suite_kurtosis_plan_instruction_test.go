@@ -2,6 +2,7 @@ package test_engine
 
 import (
 	"context"
+	"github.com/kurtosis-tech/kurtosis/core/server/api_container/server/secret_store"
 	"github.com/kurtosis-tech/kurtosis/core/server/api_container/server/service_network"
 	"github.com/kurtosis-tech/kurtosis/core/server/api_container/server/startosis_engine/enclave_structure"
 	"github.com/kurtosis-tech/kurtosis/core/server/api_container/server/startosis_engine/instructions_plan"
@@ -45,7 +46,7 @@ func (suite *KurtosisPlanInstructionTestSuite) SetupTest() {
 
 	enclaveDb := getEnclaveDBForTest(suite.T())
 	serde := kurtosis_types.NewStarlarkValueSerde(suite.starlarkThread, suite.starlarkEnv)
-	runtimeValueStoreForTest, err := runtime_value_store.CreateRuntimeValueStore(serde, enclaveDb)
+	runtimeValueStoreForTest, err := runtime_value_store.CreateRuntimeValueStore(serde, enclaveDb, secret_store.NewSecretStore())
 	suite.Require().NoError(err)
 	suite.runtimeValueStore = runtimeValueStoreForTest
 
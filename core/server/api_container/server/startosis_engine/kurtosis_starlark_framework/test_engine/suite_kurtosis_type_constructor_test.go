@@ -1,6 +1,7 @@
 package test_engine
 
 import (
+	"github.com/kurtosis-tech/kurtosis/core/server/api_container/server/secret_store"
 	"github.com/kurtosis-tech/kurtosis/core/server/api_container/server/service_network"
 	"github.com/kurtosis-tech/kurtosis/core/server/api_container/server/startosis_engine/kurtosis_starlark_framework/builtin_argument"
 	"github.com/kurtosis-tech/kurtosis/core/server/api_container/server/startosis_engine/kurtosis_types"
@@ -40,7 +41,7 @@ func (suite *KurtosisTypeConstructorTestSuite) SetupTest() {
 
 	enclaveDb := getEnclaveDBForTest(suite.T())
 	serde := kurtosis_types.NewStarlarkValueSerde(suite.starlarkThread, suite.starlarkEnv)
-	runtimeValueStoreForTest, err := runtime_value_store.CreateRuntimeValueStore(serde, enclaveDb)
+	runtimeValueStoreForTest, err := runtime_value_store.CreateRuntimeValueStore(serde, enclaveDb, secret_store.NewSecretStore())
 	suite.Require().NoError(err)
 	suite.runtimeValueStore = runtimeValueStoreForTest
 
@@ -4,6 +4,7 @@ import (
 	"github.com/kurtosis-tech/kurtosis/container-engine-lib/lib/backend_interface/objects/service"
 	"github.com/kurtosis-tech/kurtosis/container-engine-lib/lib/database_accessors/enclave_db"
 	"github.com/kurtosis-tech/kurtosis/container-engine-lib/lib/uuid_generator"
+	"github.com/kurtosis-tech/kurtosis/core/server/api_container/server/secret_store"
 	"github.com/kurtosis-tech/kurtosis/core/server/api_container/server/startosis_engine/kurtosis_types"
 	"github.com/kurtosis-tech/stacktrace"
 	"go.starlark.net/starlark"
@@ -13,9 +14,11 @@ type RuntimeValueStore struct {
 	starlarkValueSerde                *kurtosis_types.StarlarkValueSerde
 	recipeResultRepository            *recipeResultRepository
 	serviceAssociatedValuesRepository *serviceAssociatedValuesRepository
+	// secrets are resolved along with the runtime values but, unlike them, are never persisted
+	secretStore *secret_store.SecretStore
 }
 
-func CreateRuntimeValueStore(starlarkValueSerde *kurtosis_types.StarlarkValueSerde, enclaveDb *enclave_db.EnclaveDB) (*RuntimeValueStore, error) {
+func CreateRuntimeValueStore(starlarkValueSerde *kurtosis_types.StarlarkValueSerde, enclaveDb *enclave_db.EnclaveDB, secretStore *secret_store.SecretStore) (*RuntimeValueStore, error) {
 	associatedValuesRepository, err := getOrCreateNewServiceAssociatedValuesRepository(enclaveDb)
 	if err != nil {
 		return nil, stacktrace.Propagate(err, "An error occurred getting or creating the service associated values repository")
@@ -30,11 +33,16 @@ func CreateRuntimeValueStore(starlarkValueSerde *kurtosis_types.StarlarkValueSer
 		starlarkValueSerde:                starlarkValueSerde,
 		recipeResultRepository:            recipeResultRepositoryObj,
 		serviceAssociatedValuesRepository: associatedValuesRepository,
+		secretStore:                       secretStore,
 	}
 
 	return runtimeValueStore, nil
 }
 
+func (re *RuntimeValueStore) GetSecretStore() *secret_store.SecretStore {
+	return re.secretStore
+}
+
 func (re *RuntimeValueStore) CreateValue() (string, error) {
 	uuid, err := uuid_generator.GenerateUUIDString()
 	if err != nil {
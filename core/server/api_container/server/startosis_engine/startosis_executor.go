@@ -9,6 +9,8 @@ import (
 	"github.com/kurtosis-tech/kurtosis/api/golang/core/kurtosis_core_rpc_api_bindings"
 	"github.com/kurtosis-tech/kurtosis/api/golang/core/lib/binding_constructors"
 	"github.com/kurtosis-tech/kurtosis/container-engine-lib/lib/database_accessors/enclave_db"
+	"github.com/kurtosis-tech/kurtosis/container-engine-lib/lib/tracing"
+	"github.com/kurtosis-tech/kurtosis/core/server/api_container/server/operational_metrics"
 	"github.com/kurtosis-tech/kurtosis/core/server/api_container/server/startosis_engine/enclave_plan_persistence"
 	"github.com/kurtosis-tech/kurtosis/core/server/api_container/server/startosis_engine/instructions_plan"
 	"github.com/kurtosis-tech/kurtosis/core/server/api_container/server/startosis_engine/kurtosis_instruction/shared_helpers/magic_string_helper"
@@ -18,6 +20,8 @@ import (
 	"github.com/kurtosis-tech/kurtosis/core/server/api_container/server/startosis_engine/types"
 	"github.com/kurtosis-tech/stacktrace"
 	"github.com/sirupsen/logrus"
+	"go.opentelemetry.io/otel/attribute"
+	"go.opentelemetry.io/otel/trace"
 )
 
 const (
@@ -26,6 +30,10 @@ const (
 	outputSizeLimit          = 64 * 1024
 	outputLimitReachedSuffix = "..."
 	progressMsg              = "Execution in progress"
+
+	instructionUuidSpanAttributeKey     = "kurtosis.instruction.uuid"
+	instructionPositionSpanAttributeKey = "kurtosis.instruction.position"
+	instructionSkippedSpanAttributeKey  = "kurtosis.instruction.skipped"
 )
 
 var (
@@ -101,23 +109,27 @@ func (executor *StartosisExecutor) Execute(ctx context.Context, dryRun bool, par
 			starlarkRunResponseLineStream <- progress
 
 			instruction := scheduledInstruction.GetInstruction()
-			canonicalInstruction := binding_constructors.NewStarlarkRunResponseLineFromInstruction(instruction.GetCanonicalInstruction(scheduledInstruction.IsExecuted()))
+			starlarkInstruction := instruction.GetCanonicalInstruction(scheduledInstruction.IsExecuted())
+			canonicalInstruction := binding_constructors.NewStarlarkRunResponseLineFromInstruction(starlarkInstruction)
 			starlarkRunResponseLineStream <- canonicalInstruction
 
 			if !dryRun {
 				var err error
 				var instructionOutput *string
 				var duration time.Duration
+				instructionCtx, instructionSpan := startInstructionSpan(ctxWithParallelism, scheduledInstruction, starlarkInstruction)
 				if scheduledInstruction.IsExecuted() {
 					// instruction already executed within this enclave. Do not run it
 					instructionOutput = &skippedInstructionOutput
 				} else {
 					startTime := time.Now()
-					instructionOutput, err = instruction.Execute(ctxWithParallelism)
+					instructionOutput, err = instruction.Execute(instructionCtx)
 					duration = time.Since(startTime)
 					totalExecutionDuration += duration
 				}
+				tracing.EndSpan(instructionSpan, err)
 				if err != nil {
+					operational_metrics.RecordInstructionFailure(starlarkInstruction.GetInstructionName())
 					sendErrorAndFail(starlarkRunResponseLineStream, totalExecutionDuration, err, "An error occurred executing instruction (number %d) at %v:\n%v", instructionNumber, instruction.GetPositionInOriginalScript().String(), instruction.String())
 					return
 				}
@@ -245,23 +257,27 @@ func (executor *StartosisExecutor) ExecuteInParallel(ctx context.Context, dryRun
 				starlarkRunResponseLineStream <- progress
 
 				instruction := scheduledInstruction.GetInstruction()
-				canonicalInstruction := binding_constructors.NewStarlarkRunResponseLineFromInstructionWithInstructionId(instruction.GetCanonicalInstruction(scheduledInstruction.IsExecuted()), instructionUuidStr)
+				starlarkInstruction := instruction.GetCanonicalInstruction(scheduledInstruction.IsExecuted())
+				canonicalInstruction := binding_constructors.NewStarlarkRunResponseLineFromInstructionWithInstructionId(starlarkInstruction, instructionUuidStr)
 				starlarkRunResponseLineStream <- canonicalInstruction
 
 				if !dryRun {
 					var err error
 					var instructionOutput *string
 					var duration time.Duration
+					instructionCtx, instructionSpan := startInstructionSpan(ctxWithParallelismAndCancel, scheduledInstruction, starlarkInstruction)
 					if scheduledInstruction.IsExecuted() {
 						// instruction already executed within this enclave. Do not run it
 						instructionOutput = &skippedInstructionOutput
 					} else {
 						startTime := time.Now()
-						instructionOutput, err = instruction.Execute(ctxWithParallelismAndCancel)
+						instructionOutput, err = instruction.Execute(instructionCtx)
 						duration = time.Since(startTime)
 						instructionDurations.Store(instructionUuid, duration)
 					}
+					tracing.EndSpan(instructionSpan, err)
 					if err != nil {
+						operational_metrics.RecordInstructionFailure(starlarkInstruction.GetInstructionName())
 						errorMu.Lock()
 						if !errorFound {
 							errorFound = true
@@ -311,6 +327,22 @@ func (executor *StartosisExecutor) GetCurrentEnclavePLan() *enclave_plan_persist
 	return executor.enclavePlan
 }
 
+// startInstructionSpan starts the span of a scheduled instruction, named after the instruction (e.g. 'add_service').
+// Instructions get the returned context so that what they trace (image pulls, readiness checks...) is nested under it
+func startInstructionSpan(
+	ctx context.Context,
+	scheduledInstruction *instructions_plan.ScheduledInstruction,
+	starlarkInstruction *kurtosis_core_rpc_api_bindings.StarlarkInstruction,
+) (context.Context, trace.Span) {
+	return tracing.StartSpan(
+		ctx,
+		starlarkInstruction.GetInstructionName(),
+		attribute.String(instructionUuidSpanAttributeKey, string(scheduledInstruction.GetUuid())),
+		attribute.String(instructionPositionSpanAttributeKey, scheduledInstruction.GetInstruction().GetPositionInOriginalScript().String()),
+		attribute.Bool(instructionSkippedSpanAttributeKey, scheduledInstruction.IsExecuted()),
+	)
+}
+
 // how should I ensure this terminates all running goroutines?
 func sendErrorAndFail(starlarkRunResponseLineStream chan<- *kurtosis_core_rpc_api_bindings.StarlarkRunResponseLine, totalExecutionDuration time.Duration, err error, msg string, msgArgs ...interface{}) {
 	propagatedErr := stacktrace.Propagate(err, msg, msgArgs...)
@@ -400,7 +400,7 @@ func (interpreter *StartosisInterpreter) buildBindings(
 	predeclared[builtins.KurtosisModuleName] = kurtosisModule
 
 	// Add all Kurtosis helpers
-	for _, kurtosisHelper := range KurtosisHelpers(packageId, recursiveInterpretForModuleLoading, interpreter.packageContentProvider, moduleGlobalCache, packageReplaceOptions) {
+	for _, kurtosisHelper := range KurtosisHelpers(packageId, recursiveInterpretForModuleLoading, interpreter.packageContentProvider, moduleGlobalCache, packageReplaceOptions, interpreter.recipeExecutor.GetSecretStore()) {
 		predeclared[kurtosisHelper.Name()] = kurtosisHelper
 	}
 
@@ -4,15 +4,24 @@ import (
 	"context"
 	"strings"
 	"sync"
+	"time"
 
 	"github.com/kurtosis-tech/kurtosis/api/golang/core/kurtosis_core_rpc_api_bindings"
 	"github.com/kurtosis-tech/kurtosis/api/golang/core/lib/binding_constructors"
 	"github.com/kurtosis-tech/kurtosis/container-engine-lib/lib/backend_interface/objects/image_download_mode"
+	"github.com/kurtosis-tech/kurtosis/container-engine-lib/lib/backend_interface/objects/service"
+	"github.com/kurtosis-tech/kurtosis/container-engine-lib/lib/tracing"
+	"github.com/kurtosis-tech/kurtosis/core/server/api_container/server/operational_metrics"
+	"github.com/kurtosis-tech/kurtosis/core/server/api_container/server/secret_store"
+	"github.com/kurtosis-tech/kurtosis/core/server/api_container/server/startosis_engine/enclave_plan_persistence"
 	"github.com/kurtosis-tech/kurtosis/core/server/api_container/server/startosis_engine/enclave_structure"
 	"github.com/kurtosis-tech/kurtosis/core/server/api_container/server/startosis_engine/instructions_plan"
 	"github.com/kurtosis-tech/kurtosis/core/server/api_container/server/startosis_engine/instructions_plan/resolver"
 	"github.com/kurtosis-tech/kurtosis/core/server/api_container/server/startosis_engine/starlark_warning"
+	"github.com/kurtosis-tech/kurtosis/core/server/api_container/server/startosis_engine/startosis_errors"
+	"github.com/kurtosis-tech/stacktrace"
 	"github.com/sirupsen/logrus"
+	"go.opentelemetry.io/otel/attribute"
 )
 
 type StartosisRunner struct {
@@ -22,6 +31,8 @@ type StartosisRunner struct {
 
 	startosisExecutor *StartosisExecutor
 
+	secretStore *secret_store.SecretStore
+
 	mutex *sync.Mutex
 }
 
@@ -35,13 +46,22 @@ const (
 	InterpretationInstructionId = "interpretation"
 	ValidationInstructionId     = "validation"
 	ExecutionInstructionId      = "execution"
+
+	starlarkRunSpanName = "starlark_run"
+
+	packageIdSpanAttributeKey            = "kurtosis.package_id"
+	mainFunctionNameSpanAttributeKey     = "kurtosis.main_function_name"
+	dryRunSpanAttributeKey               = "kurtosis.dry_run"
+	parallelExecutionSpanAttributeKey    = "kurtosis.parallel_execution"
+	numberOfInstructionsSpanAttributeKey = "kurtosis.number_of_instructions"
 )
 
-func NewStartosisRunner(interpreter *StartosisInterpreter, validator *StartosisValidator, executor *StartosisExecutor) *StartosisRunner {
+func NewStartosisRunner(interpreter *StartosisInterpreter, validator *StartosisValidator, executor *StartosisExecutor, secretStore *secret_store.SecretStore) *StartosisRunner {
 	return &StartosisRunner{
 		startosisInterpreter: interpreter,
 		startosisValidator:   validator,
 		startosisExecutor:    executor,
+		secretStore:          secretStore,
 
 		// we only expect one starlark package to run at a time against an enclave
 		// this lock ensures that only warning set is accessed by one starlark run method
@@ -63,14 +83,28 @@ func (runner *StartosisRunner) Run(
 	nonBlockingMode bool,
 	shouldExecuteInParallel bool,
 	experimentalFeatures []kurtosis_core_rpc_api_bindings.KurtosisFeatureFlag,
+	secrets map[string]string,
 ) <-chan *kurtosis_core_rpc_api_bindings.StarlarkRunResponseLine {
 	runner.mutex.Lock()
 	starlark_warning.Clear()
 	defer runner.mutex.Unlock()
 
-	// TODO(gb): add metric tracking maybe?
 	starlarkRunResponseLines := make(chan *kurtosis_core_rpc_api_bindings.StarlarkRunResponseLine)
 	go func() {
+		// The spans of the run phases are children of this one, and the instructions ones are children of the execution span
+		ctx, runSpan := tracing.StartSpan(ctx, starlarkRunSpanName,
+			attribute.String(packageIdSpanAttributeKey, packageId),
+			attribute.String(mainFunctionNameSpanAttributeKey, mainFunctionName),
+			attribute.Bool(dryRunSpanAttributeKey, dryRun),
+			attribute.Bool(parallelExecutionSpanAttributeKey, shouldExecuteInParallel),
+		)
+		runStartTime := time.Now()
+		var runErr error
+		defer func() {
+			tracing.EndSpan(runSpan, runErr)
+			operational_metrics.RecordStarlarkRun(runErr == nil, time.Since(runStartTime))
+		}()
+
 		defer func() {
 			warnings := starlark_warning.GetContentFromWarningSet()
 
@@ -97,11 +131,21 @@ func (runner *StartosisRunner) Run(
 			mainFunctionName,
 			serializedParams)
 
+		// Secrets need to be known before interpretation as the Secret builtin checks they were passed to this run
+		if err := runner.secretStore.SetRunSecrets(secrets); err != nil {
+			interpretationErr := startosis_errors.WrapWithInterpretationError(err, "An error occurred storing the secrets passed to the run")
+			runErr = interpretationErr
+			starlarkRunResponseLines <- binding_constructors.NewStarlarkRunResponseLineFromInterpretationError(interpretationErr.ToAPIType())
+			starlarkRunResponseLines <- binding_constructors.NewStarlarkRunResponseLineFromRunFailureEvent()
+			return
+		}
+
 		// Interpretation starts > send progress info (this line will be invisible as interpretation is super quick)
 		progressInfo := binding_constructors.NewStarlarkRunResponseLineFromSinglelineProgressInfoWithInstructionId(
 			startingInterpretationMsg, defaultCurrentStepNumber, defaultTotalStepsNumber, InterpretationInstructionId)
 		starlarkRunResponseLines <- progressInfo
 
+		interpretationCtx, interpretationSpan := tracing.StartSpan(ctx, InterpretationInstructionId)
 		// TODO: once we have feature flags, add a switch here to call InterpretAndOptimizePlan if the feature flag is
 		//  turned on
 		var serializedScriptOutput string
@@ -109,7 +153,7 @@ func (runner *StartosisRunner) Run(
 		var interpretationError *kurtosis_core_rpc_api_bindings.StarlarkInterpretationError
 		if doesFeatureFlagsContain(experimentalFeatures, kurtosis_core_rpc_api_bindings.KurtosisFeatureFlag_NO_INSTRUCTIONS_CACHING) {
 			serializedScriptOutput, instructionsPlan, interpretationError = runner.startosisInterpreter.Interpret(
-				ctx,
+				interpretationCtx,
 				packageId,
 				mainFunctionName,
 				packageReplaceOptions,
@@ -124,7 +168,7 @@ func (runner *StartosisRunner) Run(
 			)
 		} else {
 			serializedScriptOutput, instructionsPlan, interpretationError = runner.startosisInterpreter.InterpretAndOptimizePlan(
-				ctx,
+				interpretationCtx,
 				packageId,
 				packageReplaceOptions,
 				mainFunctionName,
@@ -138,6 +182,8 @@ func (runner *StartosisRunner) Run(
 		}
 
 		if interpretationError != nil {
+			runErr = stacktrace.NewError("An error occurred interpreting the Starlark code: %s", interpretationError.GetErrorMessage())
+			tracing.EndSpan(interpretationSpan, runErr)
 			starlarkRunResponseLines <- binding_constructors.NewStarlarkRunResponseLineFromInterpretationError(interpretationError)
 			starlarkRunResponseLines <- binding_constructors.NewStarlarkRunResponseLineFromRunFailureEvent()
 			return
@@ -148,6 +194,8 @@ func (runner *StartosisRunner) Run(
 
 		instructionsSequence, interpretationErr := instructionsPlan.GeneratePlan()
 		if interpretationErr != nil {
+			runErr = interpretationErr
+			tracing.EndSpan(interpretationSpan, runErr)
 			starlarkRunResponseLines <- binding_constructors.NewStarlarkRunResponseLineFromInterpretationError(interpretationErr.ToAPIType())
 			starlarkRunResponseLines <- binding_constructors.NewStarlarkRunResponseLineFromRunFailureEvent()
 			return
@@ -155,23 +203,32 @@ func (runner *StartosisRunner) Run(
 
 		instructionDependencyGraph, interpretationErr := instructionsPlan.GenerateInstructionsDependencyGraph()
 		if interpretationErr != nil {
+			runErr = interpretationErr
+			tracing.EndSpan(interpretationSpan, runErr)
 			starlarkRunResponseLines <- binding_constructors.NewStarlarkRunResponseLineFromInterpretationError(interpretationErr.ToAPIType())
 			starlarkRunResponseLines <- binding_constructors.NewStarlarkRunResponseLineFromRunFailureEvent()
 			return
 		}
 
+		runSpan.SetAttributes(attribute.Int(numberOfInstructionsSpanAttributeKey, len(instructionsSequence)))
+		tracing.EndSpan(interpretationSpan, nil)
+
 		// Validation starts > send progress info
 		progressInfo = binding_constructors.NewStarlarkRunResponseLineFromSinglelineProgressInfoWithInstructionId(
 			startingValidationMsg, defaultCurrentStepNumber, totalNumberOfInstructions, ValidationInstructionId)
 		starlarkRunResponseLines <- progressInfo
 
-		validationErrorsChan := runner.startosisValidator.Validate(ctx, instructionsSequence, imageDownloadMode)
+		validationCtx, validationSpan := tracing.StartSpan(ctx, ValidationInstructionId)
+		validationErrorsChan := runner.startosisValidator.Validate(validationCtx, instructionsSequence, imageDownloadMode)
 		if isRunFinished, isRunSuccessful := forwardKurtosisResponseLineChannelUntilSourceIsClosed(validationErrorsChan, starlarkRunResponseLines); isRunFinished {
 			if !isRunSuccessful {
+				runErr = stacktrace.NewError("An error occurred validating the sequence of Kurtosis instructions")
 				logrus.Warnf("An error occurred validating the sequence of Kurtosis instructions. See logs above for more details")
 			}
+			tracing.EndSpan(validationSpan, runErr)
 			return
 		}
+		tracing.EndSpan(validationSpan, nil)
 		logrus.Debugf("Successfully validated Starlark script")
 
 		// Execution starts > send progress info. This will soon be overridden byt the first instruction execution
@@ -179,23 +236,70 @@ func (runner *StartosisRunner) Run(
 			startingExecutionMsg, defaultCurrentStepNumber, totalNumberOfInstructions, ExecutionInstructionId)
 		starlarkRunResponseLines <- progressInfo
 
+		executionCtx, executionSpan := tracing.StartSpan(ctx, ExecutionInstructionId)
 		var executionResponseLinesChan <-chan *kurtosis_core_rpc_api_bindings.StarlarkRunResponseLine
 		if shouldExecuteInParallel {
 			logrus.Infof("Executing Kurtosis instructions in parallel with parallelism: %d", parallelism)
-			executionResponseLinesChan = runner.startosisExecutor.ExecuteInParallel(ctx, dryRun, parallelism, instructionsPlan.GetIndexOfFirstInstruction(), instructionsSequence, serializedScriptOutput, instructionDependencyGraph)
+			executionResponseLinesChan = runner.startosisExecutor.ExecuteInParallel(executionCtx, dryRun, parallelism, instructionsPlan.GetIndexOfFirstInstruction(), instructionsSequence, serializedScriptOutput, instructionDependencyGraph)
 		} else {
 			logrus.Infof("Executing Kurtosis instructions in serial")
-			executionResponseLinesChan = runner.startosisExecutor.Execute(ctx, dryRun, parallelism, instructionsPlan.GetIndexOfFirstInstruction(), instructionsSequence, serializedScriptOutput)
+			executionResponseLinesChan = runner.startosisExecutor.Execute(executionCtx, dryRun, parallelism, instructionsPlan.GetIndexOfFirstInstruction(), instructionsSequence, serializedScriptOutput)
 		}
 		if isRunFinished, isRunSuccessful := forwardKurtosisResponseLineChannelUntilSourceIsClosed(executionResponseLinesChan, starlarkRunResponseLines); !isRunFinished {
 			logrus.Warnf("Execution finished but no 'RunFinishedEvent' was received through the stream. This is unexpected as every execution should be terminal.")
 		} else if !isRunSuccessful {
+			runErr = stacktrace.NewError("An error occurred executing the sequence of Kurtosis instructions")
 			logrus.Warnf("An error occurred executing the sequence of Kurtosis instructions. See logs above for more details")
 		} else {
 			logrus.Debugf("Successfully executed Kurtosis plan composed of %d Kurtosis instructions", totalNumberOfInstructions)
 		}
+		tracing.EndSpan(executionSpan, runErr)
+	}()
+	return runner.maskSecretsInResponseLines(starlarkRunResponseLines)
+}
+
+// GetEnclavePlan returns the enclave plan as persisted at the end of the last successful run, so a run in progress
+// isn't reflected in it
+func (runner *StartosisRunner) GetEnclavePlan() (*enclave_plan_persistence.EnclavePlan, error) {
+	enclavePlan, err := enclave_plan_persistence.Load(runner.startosisExecutor.enclaveDb)
+	if err != nil {
+		return nil, stacktrace.Propagate(err, "An error occurred loading the enclave plan from the enclave database")
+	}
+	return enclavePlan, nil
+}
+
+// RenameService updates the enclave plan and the interpretation time values after a service got renamed, so the
+// next run of a package using the new name matches what's already in the enclave
+func (runner *StartosisRunner) RenameService(serviceName service.ServiceName, newServiceName service.ServiceName, newHostname string) error {
+	runner.mutex.Lock()
+	defer runner.mutex.Unlock()
+
+	enclavePlan := runner.startosisExecutor.enclavePlan
+	if err := renameServiceInEnclavePlan(enclavePlan, serviceName, newServiceName, newHostname); err != nil {
+		return stacktrace.Propagate(err, "An error occurred renaming service '%s' to '%s' in the enclave plan", serviceName, newServiceName)
+	}
+	if err := enclavePlan.Persist(runner.startosisExecutor.enclaveDb); err != nil {
+		return stacktrace.Propagate(err, "An error occurred persisting the enclave plan after renaming service '%s' to '%s'", serviceName, newServiceName)
+	}
+	if err := runner.startosisInterpreter.interpretationTimeValueStore.RenameService(serviceName, newServiceName, newHostname); err != nil {
+		return stacktrace.Propagate(err, "An error occurred renaming service '%s' to '%s' in the interpretation time value store", serviceName, newServiceName)
+	}
+	return nil
+}
+
+// maskSecretsInResponseLines forwards the response lines, replacing every secret value they contain by the secret
+// placeholder. Secrets can end up in the output of many instructions (run_sh, exec, print, errors...), so this
+// happens on the way out rather than in each of them
+func (runner *StartosisRunner) maskSecretsInResponseLines(sourceChan <-chan *kurtosis_core_rpc_api_bindings.StarlarkRunResponseLine) <-chan *kurtosis_core_rpc_api_bindings.StarlarkRunResponseLine {
+	maskedResponseLines := make(chan *kurtosis_core_rpc_api_bindings.StarlarkRunResponseLine)
+	go func() {
+		defer close(maskedResponseLines)
+		for responseLine := range sourceChan {
+			runner.secretStore.MaskValuesInProtoMessage(responseLine.ProtoReflect())
+			maskedResponseLines <- responseLine
+		}
 	}()
-	return starlarkRunResponseLines
+	return maskedResponseLines
 }
 
 func forwardKurtosisResponseLineChannelUntilSourceIsClosed(sourceChan <-chan *kurtosis_core_rpc_api_bindings.StarlarkRunResponseLine, destChan chan<- *kurtosis_core_rpc_api_bindings.StarlarkRunResponseLine) (bool, bool) {
@@ -11,6 +11,7 @@ import (
 	"github.com/kurtosis-tech/kurtosis/container-engine-lib/lib/backend_interface"
 	"github.com/kurtosis-tech/kurtosis/container-engine-lib/lib/backend_interface/objects/image_download_mode"
 	"github.com/kurtosis-tech/kurtosis/container-engine-lib/lib/backend_interface/objects/service"
+	"github.com/kurtosis-tech/kurtosis/core/server/api_container/server/secret_store"
 	"github.com/kurtosis-tech/kurtosis/core/server/api_container/server/service_network"
 	"github.com/kurtosis-tech/kurtosis/core/server/api_container/server/startosis_engine/instructions_plan"
 	"github.com/kurtosis-tech/kurtosis/core/server/api_container/server/startosis_engine/startosis_errors"
@@ -29,6 +30,9 @@ const (
 	containerImageValidationBuilt         = "locally built"
 	containerImageValidationMsgLineFormat = "> %s - %s"
 
+	imageValidationInProgressLineFormat  = "Validating %s"
+	imagePullOnNodesInProgressLineFormat = "Validating %s - pulled on %d/%d nodes"
+
 	containerImageArchWarningHeaderFormat   = "WARNING: Container images with different architecture than expected(%s):"
 	containerImageArchitectureMsgLineFormat = "> %s - %s"
 
@@ -46,8 +50,8 @@ type StartosisValidator struct {
 	backend *backend_interface.KurtosisBackend
 }
 
-func NewStartosisValidator(kurtosisBackend *backend_interface.KurtosisBackend, serviceNetwork service_network.ServiceNetwork, fileArtifactStore *enclave_data_directory.FilesArtifactStore) *StartosisValidator {
-	imagesValidator := startosis_validator.NewImagesValidator(kurtosisBackend)
+func NewStartosisValidator(kurtosisBackend *backend_interface.KurtosisBackend, serviceNetwork service_network.ServiceNetwork, fileArtifactStore *enclave_data_directory.FilesArtifactStore, secretStore *secret_store.SecretStore) *StartosisValidator {
+	imagesValidator := startosis_validator.NewImagesValidator(kurtosisBackend, secretStore)
 	return &StartosisValidator{
 		imagesValidator,
 		serviceNetwork,
@@ -89,12 +93,21 @@ func (validator *StartosisValidator) Validate(ctx context.Context, instructionsS
 			return
 		}
 
+		nodesResources, err := (*validator.backend).GetAvailableCPUAndMemoryPerNode(ctx)
+		if err != nil {
+			wrappedValidationError := startosis_errors.WrapWithValidationError(err, "Couldn't create validator environment as we ran into errors fetching information about available cpu & memory per node")
+			starlarkRunResponseLineStream <- binding_constructors.NewStarlarkRunResponseLineFromValidationError(wrappedValidationError.ToAPIType())
+			starlarkRunResponseLineStream <- binding_constructors.NewStarlarkRunResponseLineFromRunFailureEvent()
+			return
+		}
+
 		environment := startosis_validator.NewValidatorEnvironment(
 			serviceNames,
 			validator.fileArtifactStore.ListFiles(),
 			serviceNamePortIdMapping,
 			availableCpuInMilliCores,
 			availableMemoryInMegaBytes,
+			nodesResources,
 			isResourceInformationComplete,
 			imageDownloadMode)
 
@@ -141,8 +154,9 @@ func (validator *StartosisValidator) validateImagesAccountingForProgress(ctx con
 
 	errors := make(chan error)
 	imageValidationStarted := make(chan string)
+	imageValidationProgress := make(chan *startosis_validator.ImageValidationProgress)
 	imageValidationFinished := make(chan *startosis_validator.ValidatedImage)
-	go validator.imagesValidator.Validate(ctx, environment, imageValidationStarted, imageValidationFinished, errors)
+	go validator.imagesValidator.Validate(ctx, environment, imageValidationStarted, imageValidationProgress, imageValidationFinished, errors)
 
 	numberOfImageValidated := uint32(0)
 	totalImageNumberToValidate := environment.GetNumberOfContainerImagesToProcess()
@@ -152,8 +166,9 @@ func (validator *StartosisValidator) validateImagesAccountingForProgress(ctx con
 
 	go func() {
 		var imageCurrentlyBeingValidated []string
+		imageValidationProgressByName := map[string]*startosis_validator.ImageValidationProgress{}
 		imageSuccessfullyValidated := map[string]*startosis_validator.ValidatedImage{}
-		// we read the three channels to update imageCurrentlyBeingValidated and return progress info back to the CLI
+		// we read the four channels to update imageCurrentlyBeingValidated and return progress info back to the CLI
 		// it returns when the error channel is closed. The error channel is the reference here as we don't want to
 		// hide an error from the user. I.e. we don't want this function to return before the error channel is closed
 		for {
@@ -165,7 +180,15 @@ func (validator *StartosisValidator) validateImagesAccountingForProgress(ctx con
 				}
 				logrus.Debugf("Received image validation started event: '%s'", image)
 				imageCurrentlyBeingValidated = append(imageCurrentlyBeingValidated, image)
-				updateProgressWithDownloadInfo(starlarkRunResponseLineStream, imageCurrentlyBeingValidated, numberOfImageValidated, totalImageNumberToValidate)
+				updateProgressWithDownloadInfo(starlarkRunResponseLineStream, imageCurrentlyBeingValidated, imageValidationProgressByName, numberOfImageValidated, totalImageNumberToValidate)
+			case progress, isChanOpen := <-imageValidationProgress:
+				if !isChanOpen {
+					// the subroutine returns when the error channel is closed
+					continue
+				}
+				logrus.Debugf("Received image validation progress event: '%s' on %d/%d nodes", progress.GetName(), progress.GetNumNodesWithImage(), progress.GetNumNodesTotal())
+				imageValidationProgressByName[progress.GetName()] = progress
+				updateProgressWithDownloadInfo(starlarkRunResponseLineStream, imageCurrentlyBeingValidated, imageValidationProgressByName, numberOfImageValidated, totalImageNumberToValidate)
 			case validatedImage, isChanOpen := <-imageValidationFinished:
 				if !isChanOpen {
 					// the subroutine returns when the error channel is closed
@@ -177,10 +200,11 @@ func (validator *StartosisValidator) validateImagesAccountingForProgress(ctx con
 				logrus.Debugf("Received image validation finished event: '%s'", imageName)
 
 				imageCurrentlyBeingValidated = removeIfPresent(imageCurrentlyBeingValidated, imageName)
+				delete(imageValidationProgressByName, imageName)
 
 				imageSuccessfullyValidated[imageName] = validatedImage
 
-				updateProgressWithDownloadInfo(starlarkRunResponseLineStream, imageCurrentlyBeingValidated, numberOfImageValidated, totalImageNumberToValidate)
+				updateProgressWithDownloadInfo(starlarkRunResponseLineStream, imageCurrentlyBeingValidated, imageValidationProgressByName, numberOfImageValidated, totalImageNumberToValidate)
 			case err, isChanOpen := <-errors:
 				if !isChanOpen {
 					sendContainerImageSummaryInfoMsg(imageSuccessfullyValidated, starlarkRunResponseLineStream)
@@ -257,10 +281,15 @@ func sendContainerImageSummaryInfoMsg(
 	}
 }
 
-func updateProgressWithDownloadInfo(starlarkRunResponseLineStream chan<- *kurtosis_core_rpc_api_bindings.StarlarkRunResponseLine, imageCurrentlyInProgress []string, numberOfImageValidated uint32, totalNumberOfImagesToValidate uint32) {
+func updateProgressWithDownloadInfo(starlarkRunResponseLineStream chan<- *kurtosis_core_rpc_api_bindings.StarlarkRunResponseLine, imageCurrentlyInProgress []string, imageValidationProgressByName map[string]*startosis_validator.ImageValidationProgress, numberOfImageValidated uint32, totalNumberOfImagesToValidate uint32) {
 	msgLines := []string{validationInProgressMsg}
 	for _, imageName := range imageCurrentlyInProgress {
-		msgLines = append(msgLines, fmt.Sprintf("Validating %s", imageName))
+		progress, found := imageValidationProgressByName[imageName]
+		if !found {
+			msgLines = append(msgLines, fmt.Sprintf(imageValidationInProgressLineFormat, imageName))
+			continue
+		}
+		msgLines = append(msgLines, fmt.Sprintf(imagePullOnNodesInProgressLineFormat, imageName, progress.GetNumNodesWithImage(), progress.GetNumNodesTotal()))
 	}
 	starlarkRunResponseLineStream <- binding_constructors.NewStarlarkRunResponseLineFromMultilineProgressInfo(
 		msgLines, numberOfImageValidated, totalNumberOfImagesToValidate)
@@ -0,0 +1,29 @@
+package startosis_validator
+
+// ImageValidationProgress is the progress of an image pulled on several nodes, which backends running on a cluster
+// report while validating the image
+type ImageValidationProgress struct {
+	name              string
+	numNodesWithImage uint32
+	numNodesTotal     uint32
+}
+
+func NewImageValidationProgress(name string, numNodesWithImage uint32, numNodesTotal uint32) *ImageValidationProgress {
+	return &ImageValidationProgress{
+		name:              name,
+		numNodesWithImage: numNodesWithImage,
+		numNodesTotal:     numNodesTotal,
+	}
+}
+
+func (p *ImageValidationProgress) GetName() string {
+	return p.name
+}
+
+func (p *ImageValidationProgress) GetNumNodesWithImage() uint32 {
+	return p.numNodesWithImage
+}
+
+func (p *ImageValidationProgress) GetNumNodesTotal() uint32 {
+	return p.numNodesTotal
+}
@@ -10,27 +10,43 @@ import (
 
 	"github.com/kurtosis-tech/kurtosis/container-engine-lib/lib/backend_interface"
 	"github.com/kurtosis-tech/kurtosis/container-engine-lib/lib/backend_interface/objects/image_download_mode"
+	"github.com/kurtosis-tech/kurtosis/container-engine-lib/lib/image_pull_progress"
+	"github.com/kurtosis-tech/kurtosis/container-engine-lib/lib/tracing"
+	"github.com/kurtosis-tech/kurtosis/core/server/api_container/server/secret_store"
 	"github.com/kurtosis-tech/kurtosis/core/server/api_container/server/startosis_engine/startosis_errors"
 	"github.com/sirupsen/logrus"
+	"go.opentelemetry.io/otel/attribute"
 )
 
-const maxNumberOfConcurrentDownloads = int64(4)
+const (
+	maxNumberOfConcurrentDownloads = int64(4)
+
+	imagePullSpanName  = "image_pull"
+	imageBuildSpanName = "image_build"
+
+	imageNameSpanAttributeKey             = "kurtosis.image.name"
+	imagePulledFromRemoteSpanAttributeKey = "kurtosis.image.pulled_from_remote"
+)
 
 type ImagesValidator struct {
 	kurtosisBackend *backend_interface.KurtosisBackend
+
+	secretStore *secret_store.SecretStore
 }
 
-func NewImagesValidator(kurtosisBackend *backend_interface.KurtosisBackend) *ImagesValidator {
+func NewImagesValidator(kurtosisBackend *backend_interface.KurtosisBackend, secretStore *secret_store.SecretStore) *ImagesValidator {
 	return &ImagesValidator{
 		kurtosisBackend,
+		secretStore,
 	}
 }
 
 // Validate validates all container images by downloading them. It is an async function, and it takes as input a
 // WaitGroup that will unblock once the function is complete (as opposed to when the function returns). It allows the
 // consumer to run this function synchronously by calling it and then waiting for wait group to resolve.
-// In addition to the total number of container images to validate, it returns three channels:
+// In addition to the total number of container images to validate, it returns four channels:
 // - One that receives an image name when this image validation starts
+// - One that receives the per-node progress of image pulls, for backends pulling images on several nodes
 // - One that receives an image name when an image validation finishes
 // - An error channel that receives all errors happening during validation
 // Note that since it is an async function, the channels are not closed by this function, consumers need to take
@@ -39,6 +55,7 @@ func (validator *ImagesValidator) Validate(
 	ctx context.Context,
 	environment *ValidatorEnvironment,
 	imageValidationStarted chan<- string,
+	imageValidationProgress chan<- *ImageValidationProgress,
 	imageValidationFinished chan<- *ValidatedImage,
 	imageValidationErrors chan<- error) {
 	// We use a buffered channel to control concurrency. We push a bool to this channel when a download starts, and
@@ -46,6 +63,7 @@ func (validator *ImagesValidator) Validate(
 	imageCurrentlyValidating := make(chan bool, maxNumberOfConcurrentDownloads)
 	defer func() {
 		close(imageValidationStarted)
+		close(imageValidationProgress)
 		close(imageValidationFinished)
 		close(imageValidationErrors)
 		close(imageCurrentlyValidating)
@@ -53,8 +71,13 @@ func (validator *ImagesValidator) Validate(
 
 	wg := &sync.WaitGroup{}
 	for imageName, maybeImageRegistrySpec := range environment.imagesToPull {
+		maybeImageRegistrySpec, err := validator.resolveSecretsInImageRegistrySpec(maybeImageRegistrySpec)
+		if err != nil {
+			imageValidationErrors <- startosis_errors.WrapWithValidationError(err, "An error occurred resolving the registry credentials of image '%s'", imageName)
+			continue
+		}
 		wg.Add(1)
-		go fetchImageFromBackend(ctx, wg, imageCurrentlyValidating, validator.kurtosisBackend, imageName, maybeImageRegistrySpec, environment.imageDownloadMode, imageValidationErrors, imageValidationStarted, imageValidationFinished)
+		go fetchImageFromBackend(ctx, wg, imageCurrentlyValidating, validator.kurtosisBackend, imageName, maybeImageRegistrySpec, environment.imageDownloadMode, imageValidationErrors, imageValidationStarted, imageValidationProgress, imageValidationFinished)
 	}
 	for imageName, imageBuildSpec := range environment.imagesToBuild {
 		wg.Add(1)
@@ -69,7 +92,24 @@ func (validator *ImagesValidator) Validate(
 	logrus.Debug("All image validation submitted, currently in progress.")
 }
 
-func fetchImageFromBackend(ctx context.Context, wg *sync.WaitGroup, imageCurrentlyDownloading chan bool, backend *backend_interface.KurtosisBackend, imageName string, registrySpec *image_registry_spec.ImageRegistrySpec, imageDownloadMode image_download_mode.ImageDownloadMode, pullErrors chan<- error, imageDownloadStarted chan<- string, imageDownloadFinished chan<- *ValidatedImage) {
+// resolveSecretsInImageRegistrySpec returns a copy of the spec with the credentials resolved, since they are commonly
+// passed as secrets
+func (validator *ImagesValidator) resolveSecretsInImageRegistrySpec(maybeImageRegistrySpec *image_registry_spec.ImageRegistrySpec) (*image_registry_spec.ImageRegistrySpec, error) {
+	if maybeImageRegistrySpec == nil {
+		return nil, nil
+	}
+	username, err := validator.secretStore.ResolvePlaceholders(maybeImageRegistrySpec.GetUsername())
+	if err != nil {
+		return nil, err
+	}
+	password, err := validator.secretStore.ResolvePlaceholders(maybeImageRegistrySpec.GetPassword())
+	if err != nil {
+		return nil, err
+	}
+	return image_registry_spec.NewImageRegistrySpec(maybeImageRegistrySpec.GetImageName(), username, password, maybeImageRegistrySpec.GetRegistryAddr()), nil
+}
+
+func fetchImageFromBackend(ctx context.Context, wg *sync.WaitGroup, imageCurrentlyDownloading chan bool, backend *backend_interface.KurtosisBackend, imageName string, registrySpec *image_registry_spec.ImageRegistrySpec, imageDownloadMode image_download_mode.ImageDownloadMode, pullErrors chan<- error, imageDownloadStarted chan<- string, imageDownloadProgress chan<- *ImageValidationProgress, imageDownloadFinished chan<- *ValidatedImage) {
 	logrus.Debugf("Requesting the download of image: '%s'", imageName)
 	var imagePulledFromRemote bool
 	var imageArch string
@@ -83,7 +123,13 @@ func fetchImageFromBackend(ctx context.Context, wg *sync.WaitGroup, imageCurrent
 	}()
 
 	logrus.Debugf("Starting the download of image: '%s'", imageName)
-	imagePulledFromRemote, imageArch, err := (*backend).FetchImage(ctx, imageName, registrySpec, imageDownloadMode)
+	pullCtx, pullSpan := tracing.StartSpan(ctx, imagePullSpanName, attribute.String(imageNameSpanAttributeKey, imageName))
+	pullCtx = image_pull_progress.WithReporter(pullCtx, func(_ string, numNodesWithImage uint32, numNodesTotal uint32) {
+		imageDownloadProgress <- NewImageValidationProgress(imageName, numNodesWithImage, numNodesTotal)
+	})
+	imagePulledFromRemote, imageArch, err := (*backend).FetchImage(pullCtx, imageName, registrySpec, imageDownloadMode)
+	pullSpan.SetAttributes(attribute.Bool(imagePulledFromRemoteSpanAttributeKey, imagePulledFromRemote))
+	tracing.EndSpan(pullSpan, err)
 	if err != nil {
 		logrus.Warnf("Container image '%s' download failed. Error was: '%s'", imageName, err.Error())
 		pullErrors <- startosis_errors.WrapWithValidationError(err, "Failed fetching the required image '%v'.", imageName)
@@ -115,7 +161,9 @@ func (validator *ImagesValidator) buildImageUsingBackend(
 	}()
 
 	logrus.Debugf("Starting the build of image: '%s'", imageName)
-	imageArch, err := (*backend).BuildImage(ctx, imageName, imageBuildSpec)
+	buildCtx, buildSpan := tracing.StartSpan(ctx, imageBuildSpanName, attribute.String(imageNameSpanAttributeKey, imageName))
+	imageArch, err := (*backend).BuildImage(buildCtx, imageName, imageBuildSpec)
+	tracing.EndSpan(buildSpan, err)
 	if err != nil {
 		logrus.Warnf("Container image '%s' build failed. Error was: '%s'", imageName, err.Error())
 		buildErrors <- startosis_errors.WrapWithValidationError(err, "Failed to build the required image '%v'.", imageName)
@@ -148,7 +196,9 @@ func (validator *ImagesValidator) nixBuildUsingBackend(
 	}()
 
 	logrus.Debugf("Starting the build of image: '%s'", imageRef)
-	imageName, err := (*backend).NixBuild(ctx, nixBuildSpec)
+	buildCtx, buildSpan := tracing.StartSpan(ctx, imageBuildSpanName, attribute.String(imageNameSpanAttributeKey, imageRef))
+	imageName, err := (*backend).NixBuild(buildCtx, nixBuildSpec)
+	tracing.EndSpan(buildSpan, err)
 	if err != nil {
 		logrus.Warnf("Container image '%s' build failed. Error was: '%s'", imageRef, err.Error())
 		buildErrors <- startosis_errors.WrapWithValidationError(err, "Failed to build the required image '%v'.", imageRef)
@@ -1,6 +1,10 @@
 package startosis_validator
 
 import (
+	"fmt"
+	"sort"
+	"strings"
+
 	"github.com/kurtosis-tech/kurtosis/container-engine-lib/lib/backend_interface/objects/compute_resources"
 	"github.com/kurtosis-tech/kurtosis/container-engine-lib/lib/backend_interface/objects/image_build_spec"
 	"github.com/kurtosis-tech/kurtosis/container-engine-lib/lib/backend_interface/objects/image_download_mode"
@@ -10,6 +14,7 @@ import (
 	"github.com/kurtosis-tech/kurtosis/container-engine-lib/lib/backend_interface/objects/service_directory"
 	"github.com/kurtosis-tech/kurtosis/core/server/api_container/server/startosis_engine/startosis_errors"
 	"github.com/sirupsen/logrus"
+	v1 "k8s.io/api/core/v1"
 )
 
 // ValidatorEnvironment fields are not exported so that only validators can access its fields
@@ -26,10 +31,20 @@ type ValidatorEnvironment struct {
 	isResourceInformationComplete bool
 	minCPUByServiceName           map[service.ServiceName]compute_resources.CpuMilliCores
 	minMemoryByServiceName        map[service.ServiceName]compute_resources.MemoryInMegaBytes
-	imageDownloadMode             image_download_mode.ImageDownloadMode
+	// nil when the backend doesn't schedule services across several nodes, in which case only the totals above are checked
+	nodes                 []*nodeAvailableResources
+	nodeNameByServiceName map[service.ServiceName]string
+	imageDownloadMode     image_download_mode.ImageDownloadMode
+}
+
+// nodeAvailableResources keeps track of the cpu & memory left on a node as services get placed on it during validation
+type nodeAvailableResources struct {
+	node                       *compute_resources.NodeResources
+	availableCpuInMilliCores   compute_resources.CpuMilliCores
+	availableMemoryInMegaBytes compute_resources.MemoryInMegaBytes
 }
 
-func NewValidatorEnvironment(serviceNames map[service.ServiceName]bool, artifactNames map[string]bool, serviceNameToPrivatePortIds map[service.ServiceName][]string, availableCpuInMilliCores compute_resources.CpuMilliCores, availableMemoryInMegaBytes compute_resources.MemoryInMegaBytes, isResourceInformationComplete bool, imageDownloadMode image_download_mode.ImageDownloadMode) *ValidatorEnvironment {
+func NewValidatorEnvironment(serviceNames map[service.ServiceName]bool, artifactNames map[string]bool, serviceNameToPrivatePortIds map[service.ServiceName][]string, availableCpuInMilliCores compute_resources.CpuMilliCores, availableMemoryInMegaBytes compute_resources.MemoryInMegaBytes, nodesResources []*compute_resources.NodeResources, isResourceInformationComplete bool, imageDownloadMode image_download_mode.ImageDownloadMode) *ValidatorEnvironment {
 	serviceNamesWithComponentExistence := map[service.ServiceName]ComponentExistence{}
 	for serviceName := range serviceNames {
 		serviceNamesWithComponentExistence[serviceName] = ComponentExistedBeforePackageRun
@@ -38,6 +53,17 @@ func NewValidatorEnvironment(serviceNames map[service.ServiceName]bool, artifact
 	for artifactName := range artifactNames {
 		artifactNamesWithComponentExistence[artifactName] = ComponentExistedBeforePackageRun
 	}
+	var nodes []*nodeAvailableResources
+	if nodesResources != nil {
+		nodes = []*nodeAvailableResources{}
+		for _, nodeResources := range nodesResources {
+			nodes = append(nodes, &nodeAvailableResources{
+				node:                       nodeResources,
+				availableCpuInMilliCores:   nodeResources.GetAvailableCpuInMilliCores(),
+				availableMemoryInMegaBytes: nodeResources.GetAvailableMemoryInMegaBytes(),
+			})
+		}
+	}
 	return &ValidatorEnvironment{
 		imagesToPull:                  map[string]*image_registry_spec.ImageRegistrySpec{},
 		imagesToBuild:                 map[string]*image_build_spec.ImageBuildSpec{},
@@ -52,6 +78,8 @@ func NewValidatorEnvironment(serviceNames map[service.ServiceName]bool, artifact
 		persistentKeys:         map[service_directory.DirectoryPersistentKey]ComponentExistence{},
 		minMemoryByServiceName: map[service.ServiceName]compute_resources.MemoryInMegaBytes{},
 		minCPUByServiceName:    map[service.ServiceName]compute_resources.CpuMilliCores{},
+		nodes:                  nodes,
+		nodeNameByServiceName:  map[service.ServiceName]string{},
 		imageDownloadMode:      imageDownloadMode,
 	}
 }
@@ -177,6 +205,115 @@ func (environment *ValidatorEnvironment) HasEnoughMemory(memoryToConsume uint64,
 	return startosis_errors.NewValidationError("service '%v' requires '%v' megabytes of memory but based on our calculation we will only have '%v' megabytes available at the time we start the service", serviceNameForLogging, memoryToConsume, environment.availableMemoryInMegaBytes)
 }
 
+// HasNodeWithEnoughResources checks that at least one node matching the node selectors and tolerating the taints
+// will have enough cpu & memory left to host the service, as a pod has to fit on a single node
+func (environment *ValidatorEnvironment) HasNodeWithEnoughResources(cpuToConsume uint64, memoryToConsume uint64, nodeSelectors map[string]string, tolerations []v1.Toleration, serviceNameForLogging service.ServiceName) *startosis_errors.ValidationError {
+	if !environment.isResourceInformationComplete || environment.nodes == nil {
+		return nil
+	}
+	eligibleNodes := environment.getEligibleNodes(nodeSelectors, tolerations)
+	if len(eligibleNodes) == 0 {
+		return startosis_errors.NewValidationError("service '%v' can't be scheduled as none of the '%v' schedulable nodes of the cluster is part of %v", serviceNameForLogging, len(environment.nodes), describeNodePool(nodeSelectors, tolerations))
+	}
+	if getNodeWithMostResourcesLeft(eligibleNodes, cpuToConsume, memoryToConsume) != nil {
+		return nil
+	}
+	nodeDescriptions := []string{}
+	for _, eligibleNode := range eligibleNodes {
+		nodeDescriptions = append(nodeDescriptions, fmt.Sprintf("node '%v' with '%v' millicores and '%v' megabytes", eligibleNode.node.GetName(), eligibleNode.availableCpuInMilliCores, eligibleNode.availableMemoryInMegaBytes))
+	}
+	return startosis_errors.NewValidationError("service '%v' requires '%v' millicores of cpu and '%v' megabytes of memory on a single node but based on our calculation %v will be short at the time we start the service, as its nodes will only have the following available: %v", serviceNameForLogging, cpuToConsume, memoryToConsume, describeNodePool(nodeSelectors, tolerations), strings.Join(nodeDescriptions, ", "))
+}
+
+// ConsumeNodeResources places the service on the eligible node with the most resources left, the same way the default
+// Kubernetes scheduler spreads pods across nodes
+func (environment *ValidatorEnvironment) ConsumeNodeResources(cpuConsumed uint64, memoryConsumed uint64, nodeSelectors map[string]string, tolerations []v1.Toleration, serviceName service.ServiceName) {
+	if environment.nodes == nil {
+		return
+	}
+	selectedNode := getNodeWithMostResourcesLeft(environment.getEligibleNodes(nodeSelectors, tolerations), cpuConsumed, memoryConsumed)
+	if selectedNode == nil {
+		logrus.Warnf("tried to run 'ConsumeNodeResources' for service '%v' but no node has enough resources left for it", serviceName)
+		return
+	}
+	selectedNode.availableCpuInMilliCores -= compute_resources.CpuMilliCores(cpuConsumed)
+	selectedNode.availableMemoryInMegaBytes -= compute_resources.MemoryInMegaBytes(memoryConsumed)
+	environment.nodeNameByServiceName[serviceName] = selectedNode.node.GetName()
+}
+
+func (environment *ValidatorEnvironment) FreeNodeResources(serviceName service.ServiceName) {
+	if environment.nodes == nil {
+		return
+	}
+	nodeName, found := environment.nodeNameByServiceName[serviceName]
+	if !found {
+		logrus.Warnf("tried to run 'FreeNodeResources' for service '%v' that wasn't placed on any node by the validator", serviceName)
+		return
+	}
+	delete(environment.nodeNameByServiceName, serviceName)
+	for _, node := range environment.nodes {
+		if node.node.GetName() == nodeName {
+			node.availableCpuInMilliCores += environment.minCPUByServiceName[serviceName]
+			node.availableMemoryInMegaBytes += environment.minMemoryByServiceName[serviceName]
+			return
+		}
+	}
+}
+
 func (environment *ValidatorEnvironment) AddPersistentKey(persistentKey service_directory.DirectoryPersistentKey) {
 	environment.persistentKeys[persistentKey] = ComponentCreatedOrUpdatedDuringPackageRun
 }
+
+func (environment *ValidatorEnvironment) getEligibleNodes(nodeSelectors map[string]string, tolerations []v1.Toleration) []*nodeAvailableResources {
+	eligibleNodes := []*nodeAvailableResources{}
+	for _, node := range environment.nodes {
+		if node.node.CanSchedule(nodeSelectors, tolerations) {
+			eligibleNodes = append(eligibleNodes, node)
+		}
+	}
+	return eligibleNodes
+}
+
+// getNodeWithMostResourcesLeft returns nil if none of the nodes has enough cpu & memory left
+func getNodeWithMostResourcesLeft(nodes []*nodeAvailableResources, cpuToConsume uint64, memoryToConsume uint64) *nodeAvailableResources {
+	var selectedNode *nodeAvailableResources
+	for _, node := range nodes {
+		if node.availableCpuInMilliCores < compute_resources.CpuMilliCores(cpuToConsume) || node.availableMemoryInMegaBytes < compute_resources.MemoryInMegaBytes(memoryToConsume) {
+			continue
+		}
+		if selectedNode == nil ||
+			node.availableCpuInMilliCores > selectedNode.availableCpuInMilliCores ||
+			(node.availableCpuInMilliCores == selectedNode.availableCpuInMilliCores && node.availableMemoryInMegaBytes > selectedNode.availableMemoryInMegaBytes) {
+			selectedNode = node
+		}
+	}
+	return selectedNode
+}
+
+func describeNodePool(nodeSelectors map[string]string, tolerations []v1.Toleration) string {
+	if len(nodeSelectors) == 0 && len(tolerations) == 0 {
+		return "the pool of untainted nodes"
+	}
+	poolDescriptions := []string{}
+	if len(nodeSelectors) > 0 {
+		selectorStrs := []string{}
+		for key, value := range nodeSelectors {
+			selectorStrs = append(selectorStrs, fmt.Sprintf("%v=%v", key, value))
+		}
+		sort.Strings(selectorStrs)
+		poolDescriptions = append(poolDescriptions, fmt.Sprintf("labeled '%v'", strings.Join(selectorStrs, ",")))
+	}
+	if len(tolerations) > 0 {
+		tolerationStrs := []string{}
+		for _, toleration := range tolerations {
+			// Same syntax as 'kubectl taint', the value is left out when the toleration matches any value of the key
+			tolerationStr := toleration.Key
+			if toleration.Operator != v1.TolerationOpExists {
+				tolerationStr += "=" + toleration.Value
+			}
+			tolerationStrs = append(tolerationStrs, fmt.Sprintf("%v:%v", tolerationStr, toleration.Effect))
+		}
+		poolDescriptions = append(poolDescriptions, fmt.Sprintf("with taints tolerated by '%v'", strings.Join(tolerationStrs, ",")))
+	}
+	return fmt.Sprintf("the pool of nodes %v", strings.Join(poolDescriptions, " and "))
+}
@@ -3,9 +3,11 @@ package startosis_validator
 import (
 	"testing"
 
+	"github.com/kurtosis-tech/kurtosis/container-engine-lib/lib/backend_interface/objects/compute_resources"
 	"github.com/kurtosis-tech/kurtosis/container-engine-lib/lib/backend_interface/objects/image_download_mode"
 	"github.com/kurtosis-tech/kurtosis/container-engine-lib/lib/backend_interface/objects/service"
 	"github.com/stretchr/testify/require"
+	v1 "k8s.io/api/core/v1"
 )
 
 const (
@@ -18,11 +20,20 @@ const (
 	isResourceInformationComplete = true
 	tooMuchMemory                 = 120000
 	tooMuchCpu                    = 5000
+
+	testFooService   = service.ServiceName("foo")
+	defaultPoolNode  = "default-pool-node"
+	gpuPoolNode      = "gpu-pool-node"
+	nodePoolLabelKey = "pool"
+	gpuPoolLabelVal  = "gpu"
+	gpuTaintKey      = "nvidia.com/gpu"
 )
 
+var noNodesResources []*compute_resources.NodeResources
+
 func TestMultiplePortIdsForValidation(t *testing.T) {
 	emptyInitialMapping := map[service.ServiceName][]string{}
-	validatorEnvironment := NewValidatorEnvironment(nil, nil, emptyInitialMapping, availableCpuInMilliCores, availableMemoryInBytes, isResourceInformationComplete, image_download_mode.ImageDownloadMode_Missing)
+	validatorEnvironment := NewValidatorEnvironment(nil, nil, emptyInitialMapping, availableCpuInMilliCores, availableMemoryInBytes, noNodesResources, isResourceInformationComplete, image_download_mode.ImageDownloadMode_Missing)
 	portIds := []string{
 		fooPortId,
 		fizzPortId,
@@ -37,3 +48,48 @@ func TestMultiplePortIdsForValidation(t *testing.T) {
 	require.Error(t, validatorEnvironment.HasEnoughCPU(tooMuchCpu, testBarService))
 	require.Error(t, validatorEnvironment.HasEnoughMemory(tooMuchMemory, testBarService))
 }
+
+func TestNodeResourcesAccounting(t *testing.T) {
+	gpuTaint := v1.Taint{Key: gpuTaintKey, Value: "", Effect: v1.TaintEffectNoSchedule, TimeAdded: nil}
+	nodesResources := []*compute_resources.NodeResources{
+		compute_resources.NewNodeResources(defaultPoolNode, map[string]string{}, nil, 1000, 2000),
+		compute_resources.NewNodeResources(gpuPoolNode, map[string]string{nodePoolLabelKey: gpuPoolLabelVal}, []v1.Taint{gpuTaint}, 4000, 8000),
+	}
+	validatorEnvironment := NewValidatorEnvironment(nil, nil, map[service.ServiceName][]string{}, 5000, 10000, nodesResources, isResourceInformationComplete, image_download_mode.ImageDownloadMode_Missing)
+
+	gpuNodeSelectors := map[string]string{nodePoolLabelKey: gpuPoolLabelVal}
+	// nolint: exhaustruct
+	gpuTolerations := []v1.Toleration{{Key: gpuTaintKey, Operator: v1.TolerationOpExists, Effect: v1.TaintEffectNoSchedule}}
+
+	// enough cpu across the cluster, but not on the only untainted node
+	require.Nil(t, validatorEnvironment.HasEnoughCPU(2000, testBarService))
+	validationErr := validatorEnvironment.HasNodeWithEnoughResources(2000, 0, nil, nil, testBarService)
+	require.NotNil(t, validationErr)
+	require.Contains(t, validationErr.Error(), "the pool of untainted nodes")
+	require.Contains(t, validationErr.Error(), defaultPoolNode)
+	require.NotContains(t, validationErr.Error(), gpuPoolNode)
+
+	// the gpu node is selected but its taint isn't tolerated
+	validationErr = validatorEnvironment.HasNodeWithEnoughResources(0, 0, gpuNodeSelectors, nil, testBarService)
+	require.NotNil(t, validationErr)
+	require.Contains(t, validationErr.Error(), "can't be scheduled")
+
+	require.Nil(t, validatorEnvironment.HasNodeWithEnoughResources(3000, 6000, gpuNodeSelectors, gpuTolerations, testBarService))
+	validatorEnvironment.ConsumeNodeResources(3000, 6000, gpuNodeSelectors, gpuTolerations, testBarService)
+	validatorEnvironment.ConsumeCPU(3000, testBarService)
+	validatorEnvironment.ConsumeMemory(6000, testBarService)
+
+	validationErr = validatorEnvironment.HasNodeWithEnoughResources(3000, 0, gpuNodeSelectors, gpuTolerations, testFooService)
+	require.NotNil(t, validationErr)
+	require.Contains(t, validationErr.Error(), "labeled 'pool=gpu'")
+	require.Contains(t, validationErr.Error(), "tolerated by 'nvidia.com/gpu:NoSchedule'")
+	require.Contains(t, validationErr.Error(), "node 'gpu-pool-node' with '1000' millicores and '2000' megabytes")
+
+	validatorEnvironment.FreeNodeResources(testBarService)
+	require.Nil(t, validatorEnvironment.HasNodeWithEnoughResources(3000, 0, gpuNodeSelectors, gpuTolerations, testFooService))
+}
+
+func TestNodeResourcesAccountingSkippedWithoutNodes(t *testing.T) {
+	validatorEnvironment := NewValidatorEnvironment(nil, nil, map[service.ServiceName][]string{}, availableCpuInMilliCores, availableMemoryInBytes, noNodesResources, isResourceInformationComplete, image_download_mode.ImageDownloadMode_Missing)
+	require.Nil(t, validatorEnvironment.HasNodeWithEnoughResources(tooMuchCpu, tooMuchMemory, map[string]string{nodePoolLabelKey: gpuPoolLabelVal}, nil, testBarService))
+}
@@ -1,8 +1,11 @@
 package startosis_engine
 
 import (
-	"github.com/stretchr/testify/require"
 	"testing"
+
+	"github.com/kurtosis-tech/kurtosis/api/golang/core/kurtosis_core_rpc_api_bindings"
+	"github.com/kurtosis-tech/kurtosis/core/server/api_container/server/startosis_engine/startosis_validator"
+	"github.com/stretchr/testify/require"
 )
 
 func TestRemoveIfPresent(t *testing.T) {
@@ -29,3 +32,21 @@ func TestRemoveIfPresent_NotPresent(t *testing.T) {
 	}
 	require.Equal(t, expectedImageCurrentlyBeingDownloaded, result)
 }
+
+func TestUpdateProgressWithDownloadInfo_IncludesPerNodeProgress(t *testing.T) {
+	starlarkRunResponseLineStream := make(chan *kurtosis_core_rpc_api_bindings.StarlarkRunResponseLine, 1)
+	imageValidationProgressByName := map[string]*startosis_validator.ImageValidationProgress{
+		"kurtosistech/image_2": startosis_validator.NewImageValidationProgress("kurtosistech/image_2", 1, 3),
+	}
+
+	updateProgressWithDownloadInfo(starlarkRunResponseLineStream, []string{"kurtosistech/image_1", "kurtosistech/image_2"}, imageValidationProgressByName, 0, 2)
+
+	progressInfo := (<-starlarkRunResponseLineStream).GetProgressInfo()
+	expectedCurrentStepInfo := []string{
+		validationInProgressMsg,
+		"Validating kurtosistech/image_1",
+		"Validating kurtosistech/image_2 - pulled on 1/3 nodes",
+	}
+	require.Equal(t, expectedCurrentStepInfo, progressInfo.GetCurrentStepInfo())
+	require.Equal(t, uint32(2), progressInfo.GetTotalSteps())
+}
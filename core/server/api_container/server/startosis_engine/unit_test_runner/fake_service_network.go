@@ -0,0 +1,404 @@
+package unit_test_runner
+
+import (
+	"bytes"
+	"context"
+	"fmt"
+	"io"
+	"net"
+	"net/http"
+	"strings"
+	"sync"
+
+	"github.com/kurtosis-tech/kurtosis/container-engine-lib/lib/backend_interface/objects/container"
+	"github.com/kurtosis-tech/kurtosis/container-engine-lib/lib/backend_interface/objects/enclave"
+	"github.com/kurtosis-tech/kurtosis/container-engine-lib/lib/backend_interface/objects/exec_result"
+	"github.com/kurtosis-tech/kurtosis/container-engine-lib/lib/backend_interface/objects/service"
+	"github.com/kurtosis-tech/kurtosis/core/server/api_container/server/service_network"
+	"github.com/kurtosis-tech/kurtosis/core/server/api_container/server/service_network/render_templates"
+	"github.com/kurtosis-tech/kurtosis/core/server/api_container/server/service_network/service_identifiers"
+	"github.com/kurtosis-tech/kurtosis/core/server/commons/enclave_data_directory"
+	"github.com/kurtosis-tech/stacktrace"
+)
+
+const (
+	fakeEnclaveUuid = "unit-test-enclave"
+
+	fakeApicGrpcPortNum = 7443
+	fakeApicVersion     = "unit-test"
+
+	// Fake services get addresses from this /24 in the order they're added, unless the test stubs one
+	fakeServiceIpPrefix = "10.0.0."
+	firstFakeServiceIp  = 2
+
+	// Service UUIDs are deterministic so tests can assert on instruction outputs
+	fakeServiceUuidFmt = "%032x"
+
+	fakeFilesArtifactNamePrefix = "unit-test-artifact-"
+
+	defaultExecExitCode = 0
+	defaultExecOutput   = ""
+
+	fakeHttpResponseStatus = "200 OK"
+)
+
+// serviceStub holds what the test declared a service should look like once it's been added
+type serviceStub struct {
+	ipAddress string
+	hostname  string
+}
+
+// execStub holds what the test declared an exec against a service should return. An empty command matches any
+// command run against the service
+type execStub struct {
+	command  []string
+	exitCode int32
+	output   string
+}
+
+// fakeServiceNetwork is a ServiceNetwork that never touches a backend. Services are only kept in memory, and the
+// values returned by add_service and exec come from the stubs the test declared through the kurtosis_test module
+type fakeServiceNetwork struct {
+	mutex *sync.Mutex
+
+	serviceStubs map[service.ServiceName]*serviceStub
+	execStubs    map[service.ServiceName][]*execStub
+
+	services           map[service.ServiceName]*service.Service
+	historicalServices service_identifiers.ServiceIdentifiers
+
+	filesArtifacts map[string]enclave_data_directory.FilesArtifactUUID
+	nextServiceIp  int
+}
+
+func newFakeServiceNetwork() *fakeServiceNetwork {
+	return &fakeServiceNetwork{
+		mutex:              &sync.Mutex{},
+		serviceStubs:       map[service.ServiceName]*serviceStub{},
+		execStubs:          map[service.ServiceName][]*execStub{},
+		services:           map[service.ServiceName]*service.Service{},
+		historicalServices: service_identifiers.ServiceIdentifiers{},
+		filesArtifacts:     map[string]enclave_data_directory.FilesArtifactUUID{},
+		nextServiceIp:      firstFakeServiceIp,
+	}
+}
+
+func (network *fakeServiceNetwork) stubService(serviceName service.ServiceName, ipAddress string, hostname string) {
+	network.mutex.Lock()
+	defer network.mutex.Unlock()
+	network.serviceStubs[serviceName] = &serviceStub{
+		ipAddress: ipAddress,
+		hostname:  hostname,
+	}
+}
+
+func (network *fakeServiceNetwork) stubExec(serviceName service.ServiceName, command []string, exitCode int32, output string) {
+	network.mutex.Lock()
+	defer network.mutex.Unlock()
+	network.execStubs[serviceName] = append(network.execStubs[serviceName], &execStub{
+		command:  command,
+		exitCode: exitCode,
+		output:   output,
+	})
+}
+
+func (network *fakeServiceNetwork) AddService(ctx context.Context, serviceName service.ServiceName, serviceConfig *service.ServiceConfig) (*service.Service, error) {
+	network.mutex.Lock()
+	defer network.mutex.Unlock()
+	return network.addServiceWithoutLocking(serviceName, serviceConfig)
+}
+
+func (network *fakeServiceNetwork) AddServices(ctx context.Context, serviceConfigs map[service.ServiceName]*service.ServiceConfig, _ int) (map[service.ServiceName]*service.Service, map[service.ServiceName]error, error) {
+	network.mutex.Lock()
+	defer network.mutex.Unlock()
+	startedServices := map[service.ServiceName]*service.Service{}
+	failedServices := map[service.ServiceName]error{}
+	for serviceName, serviceConfig := range serviceConfigs {
+		startedService, err := network.addServiceWithoutLocking(serviceName, serviceConfig)
+		if err != nil {
+			failedServices[serviceName] = err
+			continue
+		}
+		startedServices[serviceName] = startedService
+	}
+	return startedServices, failedServices, nil
+}
+
+func (network *fakeServiceNetwork) UpdateService(ctx context.Context, serviceName service.ServiceName, updateServiceConfig *service.ServiceConfig) (*service.Service, error) {
+	network.mutex.Lock()
+	defer network.mutex.Unlock()
+	existingService, found := network.services[serviceName]
+	if !found {
+		return nil, stacktrace.NewError("Service '%s' can't be updated because it doesn't exist in the unit test enclave", serviceName)
+	}
+	updatedService := newFakeService(existingService.GetRegistration(), updateServiceConfig)
+	network.services[serviceName] = updatedService
+	return updatedService, nil
+}
+
+func (network *fakeServiceNetwork) UpdateServices(ctx context.Context, updateServiceConfigs map[service.ServiceName]*service.ServiceConfig, _ int) (map[service.ServiceName]*service.Service, map[service.ServiceName]error, error) {
+	updatedServices := map[service.ServiceName]*service.Service{}
+	failedServices := map[service.ServiceName]error{}
+	for serviceName, serviceConfig := range updateServiceConfigs {
+		updatedService, err := network.UpdateService(ctx, serviceName, serviceConfig)
+		if err != nil {
+			failedServices[serviceName] = err
+			continue
+		}
+		updatedServices[serviceName] = updatedService
+	}
+	return updatedServices, failedServices, nil
+}
+
+func (network *fakeServiceNetwork) RemoveService(ctx context.Context, serviceIdentifier string) (service.ServiceUUID, error) {
+	network.mutex.Lock()
+	defer network.mutex.Unlock()
+	existingService, err := network.getServiceWithoutLocking(serviceIdentifier)
+	if err != nil {
+		return "", err
+	}
+	delete(network.services, existingService.GetRegistration().GetName())
+	return existingService.GetRegistration().GetUUID(), nil
+}
+
+// Starlark has no instruction to rename a service, so a unit test can never get here
+func (network *fakeServiceNetwork) RenameService(ctx context.Context, serviceIdentifier string, newServiceName service.ServiceName) (*service.Service, error) {
+	return nil, stacktrace.NewError("Renaming service '%s' to '%s' isn't supported in the unit test enclave", serviceIdentifier, newServiceName)
+}
+
+func (network *fakeServiceNetwork) StartService(ctx context.Context, serviceIdentifier string) error {
+	return network.setServiceStatus(serviceIdentifier, service.ServiceStatus_Started)
+}
+
+func (network *fakeServiceNetwork) StartServices(ctx context.Context, serviceIdentifiers []string) (map[service.ServiceUUID]bool, map[service.ServiceUUID]error, error) {
+	return network.setServicesStatus(serviceIdentifiers, service.ServiceStatus_Started)
+}
+
+func (network *fakeServiceNetwork) StopService(ctx context.Context, serviceIdentifier string) error {
+	return network.setServiceStatus(serviceIdentifier, service.ServiceStatus_Stopped)
+}
+
+func (network *fakeServiceNetwork) StopServices(ctx context.Context, serviceIdentifiers []string) (map[service.ServiceUUID]bool, map[service.ServiceUUID]error, error) {
+	return network.setServicesStatus(serviceIdentifiers, service.ServiceStatus_Stopped)
+}
+
+func (network *fakeServiceNetwork) RunExec(ctx context.Context, serviceIdentifier string, userServiceCommand []string) (*exec_result.ExecResult, error) {
+	network.mutex.Lock()
+	defer network.mutex.Unlock()
+	existingService, err := network.getServiceWithoutLocking(serviceIdentifier)
+	if err != nil {
+		return nil, err
+	}
+	var fallbackStub *execStub
+	for _, stub := range network.execStubs[existingService.GetRegistration().GetName()] {
+		if len(stub.command) == 0 {
+			fallbackStub = stub
+			continue
+		}
+		if strings.Join(stub.command, " ") == strings.Join(userServiceCommand, " ") {
+			return exec_result.NewExecResult(stub.exitCode, stub.output), nil
+		}
+	}
+	if fallbackStub != nil {
+		return exec_result.NewExecResult(fallbackStub.exitCode, fallbackStub.output), nil
+	}
+	return exec_result.NewExecResult(defaultExecExitCode, defaultExecOutput), nil
+}
+
+func (network *fakeServiceNetwork) RunExecs(ctx context.Context, userServiceCommands map[string][]string) (map[service.ServiceUUID]*exec_result.ExecResult, map[service.ServiceUUID]error, error) {
+	successfulExecs := map[service.ServiceUUID]*exec_result.ExecResult{}
+	failedExecs := map[service.ServiceUUID]error{}
+	for serviceIdentifier, command := range userServiceCommands {
+		existingService, err := network.GetService(ctx, serviceIdentifier)
+		if err != nil {
+			return nil, nil, err
+		}
+		serviceUuid := existingService.GetRegistration().GetUUID()
+		result, err := network.RunExec(ctx, serviceIdentifier, command)
+		if err != nil {
+			failedExecs[serviceUuid] = err
+			continue
+		}
+		successfulExecs[serviceUuid] = result
+	}
+	return successfulExecs, failedExecs, nil
+}
+
+// HttpRequestService answers every request with an empty 200 so that HTTP ready conditions and wait instructions pass
+func (network *fakeServiceNetwork) HttpRequestService(ctx context.Context, service *service.Service, portId string, method string, contentType string, endpoint string, body string, headers map[string]string) (*http.Response, error) {
+	return &http.Response{ // nolint: exhaustruct
+		Status:     fakeHttpResponseStatus,
+		StatusCode: http.StatusOK,
+		Header:     http.Header{},
+		Body:       io.NopCloser(bytes.NewReader(nil)),
+	}, nil
+}
+
+func (network *fakeServiceNetwork) GetService(ctx context.Context, serviceIdentifier string) (*service.Service, error) {
+	network.mutex.Lock()
+	defer network.mutex.Unlock()
+	return network.getServiceWithoutLocking(serviceIdentifier)
+}
+
+func (network *fakeServiceNetwork) GetServices(ctx context.Context) (map[service.ServiceUUID]*service.Service, error) {
+	network.mutex.Lock()
+	defer network.mutex.Unlock()
+	servicesByUuid := map[service.ServiceUUID]*service.Service{}
+	for _, existingService := range network.services {
+		servicesByUuid[existingService.GetRegistration().GetUUID()] = existingService
+	}
+	return servicesByUuid, nil
+}
+
+func (network *fakeServiceNetwork) GetServiceStats(ctx context.Context, serviceIdentifiers []string) (map[service.ServiceUUID]*service.ServiceStats, error) {
+	return nil, stacktrace.NewError("Getting the stats of services '%v' isn't supported in the unit test enclave", serviceIdentifiers)
+}
+
+func (network *fakeServiceNetwork) CopyFilesFromService(ctx context.Context, serviceIdentifier string, srcPath string, artifactName string) (enclave_data_directory.FilesArtifactUUID, error) {
+	if _, err := network.GetService(ctx, serviceIdentifier); err != nil {
+		return "", err
+	}
+	return network.registerFilesArtifact(artifactName), nil
+}
+
+func (network *fakeServiceNetwork) GetServiceNames() (map[service.ServiceName]bool, error) {
+	network.mutex.Lock()
+	defer network.mutex.Unlock()
+	serviceNames := map[service.ServiceName]bool{}
+	for serviceName := range network.services {
+		serviceNames[serviceName] = true
+	}
+	return serviceNames, nil
+}
+
+func (network *fakeServiceNetwork) GetExistingAndHistoricalServiceIdentifiers() (service_identifiers.ServiceIdentifiers, error) {
+	network.mutex.Lock()
+	defer network.mutex.Unlock()
+	return network.historicalServices, nil
+}
+
+func (network *fakeServiceNetwork) ExistServiceRegistration(serviceName service.ServiceName) (bool, error) {
+	network.mutex.Lock()
+	defer network.mutex.Unlock()
+	_, found := network.services[serviceName]
+	return found, nil
+}
+
+func (network *fakeServiceNetwork) RenderTemplates(_ map[string]*render_templates.TemplateData, artifactName string) (enclave_data_directory.FilesArtifactUUID, error) {
+	return network.registerFilesArtifact(artifactName), nil
+}
+
+func (network *fakeServiceNetwork) UploadFilesArtifact(_ io.Reader, _ []byte, artifactName string) (enclave_data_directory.FilesArtifactUUID, error) {
+	return network.registerFilesArtifact(artifactName), nil
+}
+
+func (network *fakeServiceNetwork) GetFilesArtifactMd5(artifactName string) (enclave_data_directory.FilesArtifactUUID, []byte, bool, error) {
+	network.mutex.Lock()
+	defer network.mutex.Unlock()
+	filesArtifactUuid, found := network.filesArtifacts[artifactName]
+	return filesArtifactUuid, nil, found, nil
+}
+
+func (network *fakeServiceNetwork) UpdateFilesArtifact(_ enclave_data_directory.FilesArtifactUUID, _ io.Reader, _ []byte) error {
+	return nil
+}
+
+func (network *fakeServiceNetwork) GetUniqueNameForFileArtifact() (string, error) {
+	network.mutex.Lock()
+	defer network.mutex.Unlock()
+	return fmt.Sprintf("%s%d", fakeFilesArtifactNamePrefix, len(network.filesArtifacts)+1), nil
+}
+
+func (network *fakeServiceNetwork) GetApiContainerInfo() *service_network.ApiContainerInfo {
+	return service_network.NewApiContainerInfo(net.IPv4zero, fakeApicGrpcPortNum, fakeApicVersion)
+}
+
+func (network *fakeServiceNetwork) GetEnclaveUuid() enclave.EnclaveUUID {
+	return fakeEnclaveUuid
+}
+
+func (network *fakeServiceNetwork) addServiceWithoutLocking(serviceName service.ServiceName, serviceConfig *service.ServiceConfig) (*service.Service, error) {
+	if _, found := network.services[serviceName]; found {
+		return nil, stacktrace.NewError("Service '%s' already exists in the unit test enclave", serviceName)
+	}
+
+	ipAddress := fmt.Sprintf("%s%d", fakeServiceIpPrefix, network.nextServiceIp)
+	network.nextServiceIp += 1
+	hostname := string(serviceName)
+	if stub, found := network.serviceStubs[serviceName]; found {
+		if stub.ipAddress != "" {
+			ipAddress = stub.ipAddress
+		}
+		if stub.hostname != "" {
+			hostname = stub.hostname
+		}
+	}
+	parsedIpAddress := net.ParseIP(ipAddress)
+	if parsedIpAddress == nil {
+		return nil, stacktrace.NewError("IP address '%s' stubbed for service '%s' is not a valid IP address", ipAddress, serviceName)
+	}
+
+	serviceUuid := service.ServiceUUID(fmt.Sprintf(fakeServiceUuidFmt, len(network.historicalServices)+1))
+	registration := service.NewServiceRegistration(serviceName, serviceUuid, fakeEnclaveUuid, parsedIpAddress, hostname)
+	registration.SetStatus(service.ServiceStatus_Started)
+	registration.SetConfig(serviceConfig)
+
+	startedService := newFakeService(registration, serviceConfig)
+	network.services[serviceName] = startedService
+	network.historicalServices = append(network.historicalServices, service_identifiers.NewServiceIdentifier(serviceUuid, serviceName))
+	return startedService, nil
+}
+
+func (network *fakeServiceNetwork) getServiceWithoutLocking(serviceIdentifier string) (*service.Service, error) {
+	for serviceName, existingService := range network.services {
+		if string(serviceName) == serviceIdentifier || string(existingService.GetRegistration().GetUUID()) == serviceIdentifier {
+			return existingService, nil
+		}
+	}
+	return nil, stacktrace.NewError("Service '%s' doesn't exist in the unit test enclave", serviceIdentifier)
+}
+
+func (network *fakeServiceNetwork) setServiceStatus(serviceIdentifier string, status service.ServiceStatus) error {
+	network.mutex.Lock()
+	defer network.mutex.Unlock()
+	existingService, err := network.getServiceWithoutLocking(serviceIdentifier)
+	if err != nil {
+		return err
+	}
+	existingService.GetRegistration().SetStatus(status)
+	return nil
+}
+
+func (network *fakeServiceNetwork) setServicesStatus(serviceIdentifiers []string, status service.ServiceStatus) (map[service.ServiceUUID]bool, map[service.ServiceUUID]error, error) {
+	network.mutex.Lock()
+	defer network.mutex.Unlock()
+	successfulServices := map[service.ServiceUUID]bool{}
+	for _, serviceIdentifier := range serviceIdentifiers {
+		existingService, err := network.getServiceWithoutLocking(serviceIdentifier)
+		if err != nil {
+			return nil, nil, err
+		}
+		existingService.GetRegistration().SetStatus(status)
+		successfulServices[existingService.GetRegistration().GetUUID()] = true
+	}
+	return successfulServices, map[service.ServiceUUID]error{}, nil
+}
+
+func (network *fakeServiceNetwork) registerFilesArtifact(artifactName string) enclave_data_directory.FilesArtifactUUID {
+	network.mutex.Lock()
+	defer network.mutex.Unlock()
+	filesArtifactUuid := enclave_data_directory.FilesArtifactUUID(fmt.Sprintf("%s%d", fakeFilesArtifactNamePrefix, len(network.filesArtifacts)+1))
+	network.filesArtifacts[artifactName] = filesArtifactUuid
+	return filesArtifactUuid
+}
+
+func newFakeService(registration *service.ServiceRegistration, serviceConfig *service.ServiceConfig) *service.Service {
+	fakeContainer := container.NewContainer(
+		container.ContainerStatus_Running,
+		serviceConfig.GetContainerImageName(),
+		serviceConfig.GetEntrypointArgs(),
+		serviceConfig.GetCmdArgs(),
+		serviceConfig.GetEnvVars(),
+	)
+	return service.NewService(registration, serviceConfig.GetPrivatePorts(), nil, nil, fakeContainer)
+}
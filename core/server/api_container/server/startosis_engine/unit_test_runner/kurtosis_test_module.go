@@ -0,0 +1,166 @@
+package unit_test_runner
+
+import (
+	"github.com/kurtosis-tech/kurtosis/container-engine-lib/lib/backend_interface/objects/service"
+	"github.com/kurtosis-tech/kurtosis/core/server/api_container/server/startosis_engine/instructions_plan"
+	"github.com/kurtosis-tech/kurtosis/core/server/api_container/server/startosis_engine/plan_yaml"
+	"github.com/kurtosis-tech/kurtosis/core/server/api_container/server/startosis_engine/startosis_errors"
+	"go.starlark.net/starlark"
+	"go.starlark.net/starlarkstruct"
+)
+
+const (
+	KurtosisTestModuleName = "kurtosis_test"
+
+	mockServiceBuiltinName     = "mock_service"
+	mockExecBuiltinName        = "mock_exec"
+	getInstructionsBuiltinName = "get_instructions"
+	getPlanYamlBuiltinName     = "get_plan_yaml"
+	assertEqBuiltinName        = "assert_eq"
+	assertTrueBuiltinName      = "assert_true"
+
+	nameArgName        = "name"
+	ipAddressArgName   = "ip_address"
+	hostnameArgName    = "hostname"
+	serviceNameArgName = "service_name"
+	commandArgName     = "command"
+	codeArgName        = "code"
+	outputArgName      = "output"
+	actualArgName      = "actual"
+	expectedArgName    = "expected"
+	conditionArgName   = "condition"
+	messageArgName     = "message"
+
+	optionalArgSuffix = "?"
+)
+
+// newKurtosisTestModule builds the kurtosis_test module that is only available to unit tests. It lets a test stub
+// what the fake enclave returns, and inspect the plan generated so far by the code under test
+func newKurtosisTestModule(packageId string, serviceNetwork *fakeServiceNetwork, instructionsPlan *instructions_plan.InstructionsPlan) *starlarkstruct.Module {
+	return &starlarkstruct.Module{
+		Name: KurtosisTestModuleName,
+		Members: starlark.StringDict{
+			mockServiceBuiltinName:     starlark.NewBuiltin(mockServiceBuiltinName, mockService(serviceNetwork)),
+			mockExecBuiltinName:        starlark.NewBuiltin(mockExecBuiltinName, mockExec(serviceNetwork)),
+			getInstructionsBuiltinName: starlark.NewBuiltin(getInstructionsBuiltinName, getInstructions(instructionsPlan)),
+			getPlanYamlBuiltinName:     starlark.NewBuiltin(getPlanYamlBuiltinName, getPlanYaml(packageId, instructionsPlan)),
+			assertEqBuiltinName:        starlark.NewBuiltin(assertEqBuiltinName, assertEq),
+			assertTrueBuiltinName:      starlark.NewBuiltin(assertTrueBuiltinName, assertTrue),
+		},
+	}
+}
+
+func mockService(serviceNetwork *fakeServiceNetwork) func(*starlark.Thread, *starlark.Builtin, starlark.Tuple, []starlark.Tuple) (starlark.Value, error) {
+	return func(_ *starlark.Thread, builtin *starlark.Builtin, args starlark.Tuple, kwargs []starlark.Tuple) (starlark.Value, error) {
+		var serviceName, ipAddress, hostname string
+		if err := starlark.UnpackArgs(builtin.Name(), args, kwargs,
+			nameArgName, &serviceName,
+			ipAddressArgName+optionalArgSuffix, &ipAddress,
+			hostnameArgName+optionalArgSuffix, &hostname,
+		); err != nil {
+			return nil, err
+		}
+		serviceNetwork.stubService(service.ServiceName(serviceName), ipAddress, hostname)
+		return starlark.None, nil
+	}
+}
+
+func mockExec(serviceNetwork *fakeServiceNetwork) func(*starlark.Thread, *starlark.Builtin, starlark.Tuple, []starlark.Tuple) (starlark.Value, error) {
+	return func(_ *starlark.Thread, builtin *starlark.Builtin, args starlark.Tuple, kwargs []starlark.Tuple) (starlark.Value, error) {
+		var serviceName, output string
+		var code int
+		var rawCommand *starlark.List
+		if err := starlark.UnpackArgs(builtin.Name(), args, kwargs,
+			serviceNameArgName, &serviceName,
+			outputArgName+optionalArgSuffix, &output,
+			codeArgName+optionalArgSuffix, &code,
+			commandArgName+optionalArgSuffix, &rawCommand,
+		); err != nil {
+			return nil, err
+		}
+		var command []string
+		if rawCommand != nil {
+			for idx := 0; idx < rawCommand.Len(); idx++ {
+				commandFragment, ok := starlark.AsString(rawCommand.Index(idx))
+				if !ok {
+					return nil, startosis_errors.NewInterpretationError("'%s' argument of '%s' should be a list of strings but contained '%s'", commandArgName, builtin.Name(), rawCommand.Index(idx))
+				}
+				command = append(command, commandFragment)
+			}
+		}
+		serviceNetwork.stubExec(service.ServiceName(serviceName), command, int32(code), output)
+		return starlark.None, nil
+	}
+}
+
+func getInstructions(instructionsPlan *instructions_plan.InstructionsPlan) func(*starlark.Thread, *starlark.Builtin, starlark.Tuple, []starlark.Tuple) (starlark.Value, error) {
+	return func(_ *starlark.Thread, builtin *starlark.Builtin, args starlark.Tuple, kwargs []starlark.Tuple) (starlark.Value, error) {
+		if err := starlark.UnpackArgs(builtin.Name(), args, kwargs); err != nil {
+			return nil, err
+		}
+		scheduledInstructions, interpretationErr := instructionsPlan.GeneratePlan()
+		if interpretationErr != nil {
+			return nil, interpretationErr
+		}
+		var instructions []starlark.Value
+		for _, scheduledInstruction := range scheduledInstructions {
+			instructions = append(instructions, starlark.String(scheduledInstruction.GetInstruction().String()))
+		}
+		return starlark.NewList(instructions), nil
+	}
+}
+
+func getPlanYaml(packageId string, instructionsPlan *instructions_plan.InstructionsPlan) func(*starlark.Thread, *starlark.Builtin, starlark.Tuple, []starlark.Tuple) (starlark.Value, error) {
+	return func(_ *starlark.Thread, builtin *starlark.Builtin, args starlark.Tuple, kwargs []starlark.Tuple) (starlark.Value, error) {
+		if err := starlark.UnpackArgs(builtin.Name(), args, kwargs); err != nil {
+			return nil, err
+		}
+		planYaml, err := instructionsPlan.GenerateYaml(plan_yaml.CreateEmptyPlan(packageId))
+		if err != nil {
+			return nil, startosis_errors.WrapWithInterpretationError(err, "An error occurred generating the plan YAML of the code under test")
+		}
+		return starlark.String(planYaml), nil
+	}
+}
+
+func assertEq(_ *starlark.Thread, builtin *starlark.Builtin, args starlark.Tuple, kwargs []starlark.Tuple) (starlark.Value, error) {
+	var actual, expected starlark.Value
+	var message string
+	if err := starlark.UnpackArgs(builtin.Name(), args, kwargs,
+		actualArgName, &actual,
+		expectedArgName, &expected,
+		messageArgName+optionalArgSuffix, &message,
+	); err != nil {
+		return nil, err
+	}
+	areEqual, err := starlark.Equal(actual, expected)
+	if err != nil {
+		return nil, startosis_errors.WrapWithInterpretationError(err, "Unable to compare '%s' with '%s'", actual, expected)
+	}
+	if !areEqual {
+		return nil, startosis_errors.NewInterpretationError("%s failed: expected %s but got %s%s", builtin.Name(), expected, actual, formatAssertionMessage(message))
+	}
+	return starlark.None, nil
+}
+
+func assertTrue(_ *starlark.Thread, builtin *starlark.Builtin, args starlark.Tuple, kwargs []starlark.Tuple) (starlark.Value, error) {
+	var condition starlark.Value
+	var message string
+	if err := starlark.UnpackArgs(builtin.Name(), args, kwargs,
+		conditionArgName, &condition,
+		messageArgName+optionalArgSuffix, &message,
+	); err != nil {
+		return nil, err
+	}
+	if !condition.Truth() {
+		return nil, startosis_errors.NewInterpretationError("%s failed: %s is not truthy%s", builtin.Name(), condition, formatAssertionMessage(message))
+	}
+	return starlark.None, nil
+}
+
+func formatAssertionMessage(message string) string {
+	if message == "" {
+		return ""
+	}
+	return " (" + message + ")"
+}
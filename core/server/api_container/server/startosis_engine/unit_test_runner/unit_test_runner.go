@@ -0,0 +1,314 @@
+package unit_test_runner
+
+import (
+	"context"
+	"io/fs"
+	"os"
+	"path"
+	"path/filepath"
+	"sort"
+	"strings"
+	"time"
+
+	"github.com/kurtosis-tech/kurtosis/container-engine-lib/lib/backend_interface/objects/image_download_mode"
+	"github.com/kurtosis-tech/kurtosis/container-engine-lib/lib/database_accessors/enclave_db"
+	"github.com/kurtosis-tech/kurtosis/core/launcher/args"
+	"github.com/kurtosis-tech/kurtosis/core/server/api_container/server/secret_store"
+	"github.com/kurtosis-tech/kurtosis/core/server/api_container/server/startosis_engine"
+	"github.com/kurtosis-tech/kurtosis/core/server/api_container/server/startosis_engine/enclave_plan_persistence"
+	"github.com/kurtosis-tech/kurtosis/core/server/api_container/server/startosis_engine/enclave_structure"
+	"github.com/kurtosis-tech/kurtosis/core/server/api_container/server/startosis_engine/instructions_plan"
+	"github.com/kurtosis-tech/kurtosis/core/server/api_container/server/startosis_engine/instructions_plan/resolver"
+	"github.com/kurtosis-tech/kurtosis/core/server/api_container/server/startosis_engine/interpretation_time_value_store"
+	"github.com/kurtosis-tech/kurtosis/core/server/api_container/server/startosis_engine/kurtosis_types"
+	"github.com/kurtosis-tech/kurtosis/core/server/api_container/server/startosis_engine/runtime_value_store"
+	"github.com/kurtosis-tech/kurtosis/core/server/api_container/server/startosis_engine/startosis_constants"
+	"github.com/kurtosis-tech/kurtosis/core/server/api_container/server/startosis_engine/startosis_packages/git_package_content_provider"
+	"github.com/kurtosis-tech/kurtosis/core/server/commons/yaml_parser"
+	"github.com/kurtosis-tech/kurtosis/path-compression"
+	"github.com/kurtosis-tech/stacktrace"
+	"github.com/sirupsen/logrus"
+	bolt "go.etcd.io/bbolt"
+	"go.starlark.net/starlark"
+	"go.starlark.net/syntax"
+)
+
+const (
+	// UnitTestFileSuffix is the suffix of the Starlark files that contain unit tests
+	UnitTestFileSuffix = "_test.star"
+	// UnitTestFunctionPrefix is the prefix of the functions inside unit test files that are run as tests
+	UnitTestFunctionPrefix = "test_"
+
+	workDirPattern         = "kurtosis-package-unit-tests-*"
+	repositoriesDirName    = "repositories"
+	tmpRepositoriesDirName = "tmp-repositories"
+	githubAuthDirName      = "github-auth"
+	enclaveDbFileSuffix    = ".db"
+	packagesDbFileName     = "packages"
+
+	dirPermission       = 0755
+	enclaveDbPermission = 0666
+
+	noEnclaveEnvVars        = ""
+	noInputArgs             = "{}"
+	isNonBlockingMode       = false
+	isDryRun                = false
+	executionParallelism    = 1
+	firstInstructionIndex   = 0
+	doNotEnforceMaxSize     = false
+	doOverwriteExisting     = true
+	serdeStarlarkThreadName = "unit-test-serde-thread"
+)
+
+// UnitTestResult is the outcome of a single test function
+type UnitTestResult struct {
+	// Path of the test file, relative to the package root
+	TestFile string
+	TestName string
+
+	// Empty if the test passed
+	FailureMessage string
+
+	// Output of every instruction executed against the fake enclave, in order
+	InstructionOutputs []string
+
+	Duration time.Duration
+}
+
+func (result *UnitTestResult) IsSuccessful() bool {
+	return result.FailureMessage == ""
+}
+
+// RunPackageUnitTests interprets every `test_*` function found in the `*_test.star` files of the package located at
+// packageRootDirpath, and executes the resulting plan against a fake enclave. Nothing is started on any backend; the
+// values returned by add_service and exec are the ones stubbed by the test through the kurtosis_test module
+func RunPackageUnitTests(ctx context.Context, packageRootDirpath string) ([]*UnitTestResult, error) {
+	kurtosisYaml, err := yaml_parser.ParseKurtosisYaml(path.Join(packageRootDirpath, startosis_constants.KurtosisYamlName))
+	if err != nil {
+		return nil, stacktrace.Propagate(err, "An error occurred parsing the '%s' at the root of '%s'", startosis_constants.KurtosisYamlName, packageRootDirpath)
+	}
+	packageId := kurtosisYaml.GetPackageName()
+
+	workDirpath, err := os.MkdirTemp("", workDirPattern)
+	if err != nil {
+		return nil, stacktrace.Propagate(err, "An error occurred creating the working directory for the unit tests")
+	}
+	defer func() {
+		if err := os.RemoveAll(workDirpath); err != nil {
+			logrus.Warnf("An error occurred removing the unit tests working directory '%s'. It will need to be removed manually. Error was:\n%v", workDirpath, err)
+		}
+	}()
+
+	packageContentProvider, packagesDb, err := createPackageContentProvider(workDirpath)
+	if err != nil {
+		return nil, stacktrace.Propagate(err, "An error occurred creating the package content provider for the unit tests")
+	}
+	defer packagesDb.Close()
+
+	compressedPackage, _, _, err := path_compression.CompressPath(packageRootDirpath, doNotEnforceMaxSize)
+	if err != nil {
+		return nil, stacktrace.Propagate(err, "An error occurred compressing package '%s'", packageRootDirpath)
+	}
+	defer compressedPackage.Close()
+	if _, interpretationErr := packageContentProvider.StorePackageContents(packageId, compressedPackage, doOverwriteExisting); interpretationErr != nil {
+		return nil, stacktrace.Propagate(interpretationErr, "An error occurred storing package '%s' for the unit tests", packageId)
+	}
+
+	testFileRelativePaths, err := findUnitTestFiles(packageRootDirpath)
+	if err != nil {
+		return nil, stacktrace.Propagate(err, "An error occurred looking for unit test files in '%s'", packageRootDirpath)
+	}
+
+	var results []*UnitTestResult
+	for _, testFileRelativePath := range testFileRelativePaths {
+		testFileContent, err := os.ReadFile(path.Join(packageRootDirpath, testFileRelativePath))
+		if err != nil {
+			return nil, stacktrace.Propagate(err, "An error occurred reading unit test file '%s'", testFileRelativePath)
+		}
+		testNames, err := findUnitTestFunctions(testFileRelativePath, testFileContent)
+		if err != nil {
+			return nil, stacktrace.Propagate(err, "An error occurred parsing unit test file '%s'", testFileRelativePath)
+		}
+		for _, testName := range testNames {
+			result, err := runUnitTest(ctx, workDirpath, packageId, kurtosisYaml.PackageReplaceOptions, packageContentProvider, testFileRelativePath, string(testFileContent), testName)
+			if err != nil {
+				return nil, stacktrace.Propagate(err, "An error occurred running unit test '%s' from '%s'", testName, testFileRelativePath)
+			}
+			results = append(results, result)
+		}
+	}
+	return results, nil
+}
+
+// runUnitTest runs a single test function inside its own fake enclave, so that tests can't see each other's services
+// or runtime values
+func runUnitTest(
+	ctx context.Context,
+	workDirpath string,
+	packageId string,
+	packageReplaceOptions map[string]string,
+	packageContentProvider *git_package_content_provider.GitPackageContentProvider,
+	testFileRelativePath string,
+	testFileContent string,
+	testName string,
+) (*UnitTestResult, error) {
+	result := &UnitTestResult{
+		TestFile:           testFileRelativePath,
+		TestName:           testName,
+		FailureMessage:     "",
+		InstructionOutputs: []string{},
+		Duration:           0,
+	}
+	startTime := time.Now()
+	defer func() {
+		result.Duration = time.Since(startTime)
+	}()
+
+	enclaveDb, err := openEnclaveDb(workDirpath, strings.ReplaceAll(testFileRelativePath, "/", "_")+"_"+testName)
+	if err != nil {
+		return nil, stacktrace.Propagate(err, "An error occurred opening the database of the fake enclave")
+	}
+	defer enclaveDb.Close()
+
+	starlarkValueSerde := createStarlarkValueSerde()
+	runtimeValueStore, err := runtime_value_store.CreateRuntimeValueStore(starlarkValueSerde, enclaveDb, secret_store.NewSecretStore())
+	if err != nil {
+		return nil, stacktrace.Propagate(err, "An error occurred creating the runtime value store of the fake enclave")
+	}
+	interpretationTimeValueStore, err := interpretation_time_value_store.CreateInterpretationTimeValueStore(enclaveDb, starlarkValueSerde)
+	if err != nil {
+		return nil, stacktrace.Propagate(err, "An error occurred creating the interpretation time value store of the fake enclave")
+	}
+
+	serviceNetwork := newFakeServiceNetwork()
+	instructionsPlan := instructions_plan.NewInstructionsPlan()
+	addKurtosisTestModule := func(_ *starlark.Thread, predeclared starlark.StringDict) starlark.StringDict {
+		predeclared[KurtosisTestModuleName] = newKurtosisTestModule(packageId, serviceNetwork, instructionsPlan)
+		return predeclared
+	}
+	interpreter := startosis_engine.NewStartosisInterpreterWithBuiltinsProcessor(serviceNetwork, packageContentProvider, runtimeValueStore, starlarkValueSerde, noEnclaveEnvVars, interpretationTimeValueStore, addKurtosisTestModule, args.KurtosisBackendType_Docker)
+
+	serializedOutput, _, interpretationErr := interpreter.Interpret(
+		ctx,
+		packageId,
+		testName,
+		packageReplaceOptions,
+		testFileRelativePath,
+		testFileContent,
+		noInputArgs,
+		isNonBlockingMode,
+		enclave_structure.NewEnclaveComponents(),
+		resolver.NewInstructionsPlanMask(0),
+		image_download_mode.ImageDownloadMode_Missing,
+		instructionsPlan,
+	)
+	if interpretationErr != nil {
+		result.FailureMessage = interpretationErr.GetErrorMessage()
+		return result, nil
+	}
+
+	scheduledInstructions, planGenerationErr := instructionsPlan.GeneratePlan()
+	if planGenerationErr != nil {
+		result.FailureMessage = planGenerationErr.Error()
+		return result, nil
+	}
+
+	executor := startosis_engine.NewStartosisExecutor(starlarkValueSerde, runtimeValueStore, enclave_plan_persistence.NewEnclavePlan(), enclaveDb)
+	for responseLine := range executor.Execute(ctx, isDryRun, executionParallelism, firstInstructionIndex, scheduledInstructions, serializedOutput) {
+		if instructionResult := responseLine.GetInstructionResult(); instructionResult != nil {
+			result.InstructionOutputs = append(result.InstructionOutputs, instructionResult.GetSerializedInstructionResult())
+		}
+		if executionErr := responseLine.GetError().GetExecutionError(); executionErr != nil {
+			result.FailureMessage = executionErr.GetErrorMessage()
+		}
+	}
+	return result, nil
+}
+
+func createPackageContentProvider(workDirpath string) (*git_package_content_provider.GitPackageContentProvider, *enclave_db.EnclaveDB, error) {
+	repositoriesDirpath := path.Join(workDirpath, repositoriesDirName)
+	tmpRepositoriesDirpath := path.Join(workDirpath, tmpRepositoriesDirName)
+	githubAuthDirpath := path.Join(workDirpath, githubAuthDirName)
+	for _, dirpath := range []string{repositoriesDirpath, tmpRepositoriesDirpath, githubAuthDirpath} {
+		if err := os.MkdirAll(dirpath, dirPermission); err != nil {
+			return nil, nil, stacktrace.Propagate(err, "An error occurred creating directory '%s'", dirpath)
+		}
+	}
+	packagesDb, err := openEnclaveDb(workDirpath, packagesDbFileName)
+	if err != nil {
+		return nil, nil, stacktrace.Propagate(err, "An error occurred opening the packages database")
+	}
+	githubAuthProvider := git_package_content_provider.NewGitHubPackageAuthProvider(githubAuthDirpath)
+	return git_package_content_provider.NewGitPackageContentProvider(repositoriesDirpath, tmpRepositoriesDirpath, githubAuthProvider, packagesDb), packagesDb, nil
+}
+
+// openEnclaveDb opens a standalone database rather than going through enclave_db.GetOrCreateEnclaveDatabase, which
+// only ever hands out a single process-wide instance
+func openEnclaveDb(workDirpath string, name string) (*enclave_db.EnclaveDB, error) {
+	dbFilepath := path.Join(workDirpath, name+enclaveDbFileSuffix)
+	db, err := bolt.Open(dbFilepath, enclaveDbPermission, nil)
+	if err != nil {
+		return nil, stacktrace.Propagate(err, "An error occurred opening database file '%s'", dbFilepath)
+	}
+	return &enclave_db.EnclaveDB{
+		DB: db,
+	}, nil
+}
+
+func createStarlarkValueSerde() *kurtosis_types.StarlarkValueSerde {
+	starlarkThread := &starlark.Thread{
+		Name:       serdeStarlarkThreadName,
+		Print:      nil,
+		Load:       nil,
+		OnMaxSteps: nil,
+		Steps:      0,
+	}
+	starlarkEnv := startosis_engine.Predeclared()
+	for _, builtin := range startosis_engine.KurtosisTypeConstructors() {
+		starlarkEnv[builtin.Name()] = builtin
+	}
+	return kurtosis_types.NewStarlarkValueSerde(starlarkThread, starlarkEnv)
+}
+
+// findUnitTestFiles returns the path, relative to the package root, of every unit test file in the package
+func findUnitTestFiles(packageRootDirpath string) ([]string, error) {
+	var testFileRelativePaths []string
+	err := filepath.WalkDir(packageRootDirpath, func(currentPath string, entry fs.DirEntry, err error) error {
+		if err != nil {
+			return err
+		}
+		if entry.IsDir() || !strings.HasSuffix(entry.Name(), UnitTestFileSuffix) {
+			return nil
+		}
+		relativePath, err := filepath.Rel(packageRootDirpath, currentPath)
+		if err != nil {
+			return stacktrace.Propagate(err, "An error occurred computing the path of '%s' relative to '%s'", currentPath, packageRootDirpath)
+		}
+		testFileRelativePaths = append(testFileRelativePaths, filepath.ToSlash(relativePath))
+		return nil
+	})
+	if err != nil {
+		return nil, stacktrace.Propagate(err, "An error occurred walking package directory '%s'", packageRootDirpath)
+	}
+	sort.Strings(testFileRelativePaths)
+	return testFileRelativePaths, nil
+}
+
+// findUnitTestFunctions returns the name of the top level `test_*` functions of a unit test file, in declaration order
+func findUnitTestFunctions(testFileRelativePath string, testFileContent []byte) ([]string, error) {
+	parsedFile, err := syntax.Parse(testFileRelativePath, testFileContent, syntax.RetainComments)
+	if err != nil {
+		return nil, stacktrace.Propagate(err, "An error occurred parsing '%s'", testFileRelativePath)
+	}
+	var testNames []string
+	for _, statement := range parsedFile.Stmts {
+		functionDefinition, ok := statement.(*syntax.DefStmt)
+		if !ok {
+			continue
+		}
+		if strings.HasPrefix(functionDefinition.Name.Name, UnitTestFunctionPrefix) {
+			testNames = append(testNames, functionDefinition.Name.Name)
+		}
+	}
+	return testNames, nil
+}
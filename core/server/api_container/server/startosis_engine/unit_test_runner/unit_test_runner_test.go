@@ -0,0 +1,86 @@
+package unit_test_runner
+
+import (
+	"context"
+	"os"
+	"path"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+const (
+	testPackageKurtosisYaml = `name: github.com/test-author/test-package
+`
+
+	testPackageMainFile = `def run(plan, node_count = 1):
+    nodes = []
+    for i in range(node_count):
+        nodes.append(plan.add_service(
+            name = "node-%d" % i,
+            config = ServiceConfig(image = "node:latest", ports = {"rpc": PortSpec(number = 8545)}),
+        ))
+    genesis = plan.exec(service_name = "node-0", recipe = ExecRecipe(command = ["cat", "/genesis"]))
+    plan.add_service(
+        name = "explorer",
+        config = ServiceConfig(image = "explorer:latest", env_vars = {"NODE_IP": nodes[0].ip_address}),
+    )
+    return struct(node_ip = nodes[0].ip_address, genesis = genesis["output"])
+`
+
+	testPackageTestFile = `main = import_module("../main.star")
+
+def test_stubbed_values_flow_through_the_plan(plan):
+    kurtosis_test.mock_service(name = "node-0", ip_address = "10.10.0.1")
+    kurtosis_test.mock_exec(service_name = "node-0", command = ["cat", "/genesis"], output = "0xgenesis")
+    output = main.run(plan, node_count = 2)
+    plan.verify(value = output.node_ip, assertion = "==", target_value = "10.10.0.1")
+    plan.verify(value = output.genesis, assertion = "==", target_value = "0xgenesis")
+
+def test_plan_contents(plan):
+    main.run(plan, node_count = 2)
+    instructions = kurtosis_test.get_instructions()
+    kurtosis_test.assert_eq(len(instructions), 4)
+    kurtosis_test.assert_true(instructions[3].startswith("add_service(name=\"explorer\""))
+    kurtosis_test.assert_true("name: explorer" in kurtosis_test.get_plan_yaml())
+
+def test_failing_assertion(plan):
+    main.run(plan)
+    kurtosis_test.assert_eq(len(kurtosis_test.get_instructions()), 1, "only the node should be added")
+
+def test_failing_exec(plan):
+    kurtosis_test.mock_exec(service_name = "node-0", code = 1, output = "no genesis")
+    main.run(plan)
+
+def helper_that_is_not_a_test(plan):
+    fail("should never be called")
+`
+)
+
+func TestRunPackageUnitTests(t *testing.T) {
+	packageRootDirpath := t.TempDir()
+	require.NoError(t, os.WriteFile(path.Join(packageRootDirpath, "kurtosis.yml"), []byte(testPackageKurtosisYaml), 0644))
+	require.NoError(t, os.WriteFile(path.Join(packageRootDirpath, "main.star"), []byte(testPackageMainFile), 0644))
+	require.NoError(t, os.Mkdir(path.Join(packageRootDirpath, "tests"), 0755))
+	require.NoError(t, os.WriteFile(path.Join(packageRootDirpath, "tests", "main_test.star"), []byte(testPackageTestFile), 0644))
+
+	results, err := RunPackageUnitTests(context.Background(), packageRootDirpath)
+	require.NoError(t, err)
+	require.Len(t, results, 4)
+
+	require.Equal(t, "tests/main_test.star", results[0].TestFile)
+	require.Equal(t, "test_stubbed_values_flow_through_the_plan", results[0].TestName)
+	require.True(t, results[0].IsSuccessful(), results[0].FailureMessage)
+	require.Contains(t, results[0].InstructionOutputs, "Service 'node-0' added with service UUID '00000000000000000000000000000001'")
+
+	require.Equal(t, "test_plan_contents", results[1].TestName)
+	require.True(t, results[1].IsSuccessful(), results[1].FailureMessage)
+
+	require.Equal(t, "test_failing_assertion", results[2].TestName)
+	require.False(t, results[2].IsSuccessful())
+	require.Contains(t, results[2].FailureMessage, "assert_eq failed: expected 1 but got 3 (only the node should be added)")
+
+	require.Equal(t, "test_failing_exec", results[3].TestName)
+	require.False(t, results[3].IsSuccessful())
+	require.Contains(t, results[3].FailureMessage, "no genesis")
+}
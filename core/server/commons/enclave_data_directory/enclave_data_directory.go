@@ -6,6 +6,7 @@
 package enclave_data_directory
 
 import (
+	"github.com/kurtosis-tech/kurtosis/container-engine-lib/lib/database_accessors/enclave_db"
 	"github.com/kurtosis-tech/kurtosis/container-engine-lib/lib/database_accessors/enclave_db/file_artifacts_db"
 	"github.com/kurtosis-tech/stacktrace"
 	"path"
@@ -36,6 +37,9 @@ const (
 // An enclave is created either per-test (in the testing framework) or per interactive instance (with Kurtosis Interactive)
 type EnclaveDataDirectory struct {
 	absMountDirpath string
+
+	// Only set when the directory has its own enclave database, otherwise the process-wide store is used
+	filesArtifactStore *FilesArtifactStore
 }
 
 var (
@@ -45,10 +49,32 @@ var (
 )
 
 func NewEnclaveDataDirectory(absMountDirpath string) *EnclaveDataDirectory {
-	return &EnclaveDataDirectory{absMountDirpath: absMountDirpath}
+	return &EnclaveDataDirectory{absMountDirpath: absMountDirpath, filesArtifactStore: nil}
+}
+
+// WithOwnDatabase returns a copy of the directory whose files artifact store persists to the given enclave database
+// instead of the process-wide one, for the API containers the engine runs in-process
+func (dir EnclaveDataDirectory) WithOwnDatabase(enclaveDb *enclave_db.EnclaveDB) (*EnclaveDataDirectory, error) {
+	relativeDirpath := artifactStoreDirname
+	absoluteDirpath := path.Join(dir.absMountDirpath, relativeDirpath)
+	if err := ensureDirpathExists(absoluteDirpath); err != nil {
+		return nil, stacktrace.Propagate(err, "An error occurred ensuring the files artifact store dirpath '%v' exists.", absoluteDirpath)
+	}
+	db, err := file_artifacts_db.GetOrCreateNewFileArtifactsDbFromEnclaveDb(enclaveDb)
+	if err != nil {
+		return nil, stacktrace.Propagate(err, "Failed to get file artifacts db")
+	}
+	return &EnclaveDataDirectory{
+		absMountDirpath:    dir.absMountDirpath,
+		filesArtifactStore: newFilesArtifactStoreFromDb(absoluteDirpath, relativeDirpath, db),
+	}, nil
 }
 
 func (dir EnclaveDataDirectory) GetFilesArtifactStore() (*FilesArtifactStore, error) {
+	if dir.filesArtifactStore != nil {
+		return dir.filesArtifactStore, nil
+	}
+
 	relativeDirpath := artifactStoreDirname
 	absoluteDirpath := path.Join(dir.absMountDirpath, relativeDirpath)
 	if err := ensureDirpathExists(absoluteDirpath); err != nil {
@@ -7,9 +7,11 @@ import (
 
 const (
 	enforceAuth = false
+	// The engine API token is still passed on to the engine when the UI sends one
+	isEngineApiAuthEnabled = false
 )
 
 func main() {
 	logrus.Info("Running the enclave manager from the enclave manager main package.")
-	server.RunEnclaveManagerApiServer(enforceAuth)
+	server.RunEnclaveManagerApiServer(enforceAuth, isEngineApiAuthEnabled)
 }
@@ -0,0 +1,122 @@
+package server
+
+import (
+	"context"
+	"net/http"
+	"strings"
+
+	"connectrpc.com/connect"
+	"github.com/kurtosis-tech/kurtosis/api/golang/engine/kurtosis_engine_rpc_api_bindings/kurtosis_engine_rpc_api_bindingsconnect"
+	"github.com/kurtosis-tech/kurtosis/enclave-manager/api/golang/kurtosis_enclave_manager_api_bindings/kurtosis_enclave_manager_api_bindingsconnect"
+	"github.com/kurtosis-tech/stacktrace"
+	"google.golang.org/protobuf/types/known/emptypb"
+)
+
+const (
+	// The 'Authorization' header already holds the Kurtosis Cloud token when the UI is authenticated to Kurtosis Cloud,
+	// so the engine API token is sent in a header of its own
+	engineApiTokenHeader = "X-Kurtosis-Api-Token"
+
+	engineAuthorizationHeader = "Authorization"
+	bearerPrefix              = "Bearer "
+)
+
+type engineApiTokenContextKey struct{}
+
+// engineApiTokenInterceptor makes sure the callers of the enclave manager API have a valid engine API token when the
+// engine enforces API authentication, and keeps it in the context for the engine calls made on their behalf
+type engineApiTokenInterceptor struct {
+	isEngineApiAuthEnabled bool
+
+	// The token is checked against the engine itself, which is the one holding the API tokens
+	engineServiceClient kurtosis_engine_rpc_api_bindingsconnect.EngineServiceClient
+}
+
+func newEngineApiTokenInterceptor(isEngineApiAuthEnabled bool, engineServiceClient kurtosis_engine_rpc_api_bindingsconnect.EngineServiceClient) *engineApiTokenInterceptor {
+	return &engineApiTokenInterceptor{
+		isEngineApiAuthEnabled: isEngineApiAuthEnabled,
+		engineServiceClient:    engineServiceClient,
+	}
+}
+
+func (interceptor *engineApiTokenInterceptor) WrapUnary(next connect.UnaryFunc) connect.UnaryFunc {
+	return func(ctx context.Context, request connect.AnyRequest) (connect.AnyResponse, error) {
+		ctxWithEngineApiToken, err := interceptor.authenticate(ctx, request.Spec().Procedure, request.Header())
+		if err != nil {
+			return nil, err
+		}
+		return next(ctxWithEngineApiToken, request)
+	}
+}
+
+// WrapStreamingClient is a no-op, the enclave manager calls the engine through forwardEngineApiTokenInterceptor
+func (interceptor *engineApiTokenInterceptor) WrapStreamingClient(next connect.StreamingClientFunc) connect.StreamingClientFunc {
+	return next
+}
+
+func (interceptor *engineApiTokenInterceptor) WrapStreamingHandler(next connect.StreamingHandlerFunc) connect.StreamingHandlerFunc {
+	return func(ctx context.Context, conn connect.StreamingHandlerConn) error {
+		ctxWithEngineApiToken, err := interceptor.authenticate(ctx, conn.Spec().Procedure, conn.RequestHeader())
+		if err != nil {
+			return err
+		}
+		return next(ctxWithEngineApiToken, conn)
+	}
+}
+
+func (interceptor *engineApiTokenInterceptor) authenticate(ctx context.Context, procedure string, header http.Header) (context.Context, error) {
+	// The UI checks whether the enclave manager is reachable before it has a token
+	if procedure == kurtosis_enclave_manager_api_bindingsconnect.KurtosisEnclaveManagerServerCheckProcedure {
+		return ctx, nil
+	}
+	engineApiToken := strings.TrimSpace(header.Get(engineApiTokenHeader))
+	if engineApiToken == "" {
+		if interceptor.isEngineApiAuthEnabled {
+			return nil, connect.NewError(connect.CodeUnauthenticated, stacktrace.NewError("The engine requires an API token, which must be sent in the '%v' header", engineApiTokenHeader))
+		}
+		return ctx, nil
+	}
+	ctxWithEngineApiToken := context.WithValue(ctx, engineApiTokenContextKey{}, engineApiToken)
+	if !interceptor.isEngineApiAuthEnabled {
+		return ctxWithEngineApiToken, nil
+	}
+
+	// Some calls go to the API containers rather than to the engine, so they wouldn't get the token checked otherwise
+	if _, err := interceptor.engineServiceClient.GetEngineInfo(ctxWithEngineApiToken, connect.NewRequest(&emptypb.Empty{})); err != nil {
+		return nil, connect.NewError(connect.CodeOf(err), stacktrace.Propagate(err, "An error occurred checking the engine API token with the engine"))
+	}
+	return ctxWithEngineApiToken, nil
+}
+
+// forwardEngineApiTokenInterceptor authenticates the engine calls with the API token of the caller the enclave
+// manager makes them on behalf of
+type forwardEngineApiTokenInterceptor struct{}
+
+func (interceptor forwardEngineApiTokenInterceptor) WrapUnary(next connect.UnaryFunc) connect.UnaryFunc {
+	return func(ctx context.Context, request connect.AnyRequest) (connect.AnyResponse, error) {
+		setEngineAuthorization(ctx, request.Header())
+		return next(ctx, request)
+	}
+}
+
+func (interceptor forwardEngineApiTokenInterceptor) WrapStreamingClient(next connect.StreamingClientFunc) connect.StreamingClientFunc {
+	return func(ctx context.Context, spec connect.Spec) connect.StreamingClientConn {
+		conn := next(ctx, spec)
+		setEngineAuthorization(ctx, conn.RequestHeader())
+		return conn
+	}
+}
+
+// WrapStreamingHandler is a no-op, the engine calls are the only ones going through this interceptor
+func (interceptor forwardEngineApiTokenInterceptor) WrapStreamingHandler(next connect.StreamingHandlerFunc) connect.StreamingHandlerFunc {
+	return next
+}
+
+// Does nothing if the caller didn't send any token, which is fine for the engines that don't enforce API authentication
+func setEngineAuthorization(ctx context.Context, header http.Header) {
+	engineApiToken, found := ctx.Value(engineApiTokenContextKey{}).(string)
+	if !found {
+		return
+	}
+	header.Set(engineAuthorizationHeader, bearerPrefix+engineApiToken)
+}
@@ -60,6 +60,7 @@ func NewWebserver(enforceAuth bool) (*WebServer, error) {
 	engineServiceClient := kurtosis_engine_rpc_api_bindingsconnect.NewEngineServiceClient(
 		http.DefaultClient,
 		engineHostUrl,
+		connect.WithInterceptors(forwardEngineApiTokenInterceptor{}),
 	)
 	githubAuthToken := github_auth_storage_creator.GetGitHubAuthToken()
 	return &WebServer{
@@ -287,7 +288,11 @@ func (c *WebServer) GetEnclaves(ctx context.Context, req *connect.Request[emptyp
 	if !isValidRequest {
 		return nil, stacktrace.Propagate(err, "User not authorized")
 	}
-	enclaves, err := (*c.engineServiceClient).GetEnclaves(ctx, req)
+	enclaves, err := (*c.engineServiceClient).GetEnclaves(ctx, &connect.Request[kurtosis_engine_rpc_api_bindings.GetEnclavesArgs]{
+		Msg: &kurtosis_engine_rpc_api_bindings.GetEnclavesArgs{
+			LabelSelector: nil,
+		},
+	})
 	if err != nil {
 		return nil, err
 	}
@@ -993,13 +998,18 @@ func extractJwtToken(header http.Header) (string, error) {
 	return reqToken, nil
 }
 
-func RunEnclaveManagerApiServer(enforceAuth bool) error {
+// RunEnclaveManagerApiServer runs the enclave manager API, requiring its callers to have an engine API token if
+// isEngineApiAuthEnabled is set
+func RunEnclaveManagerApiServer(enforceAuth bool, isEngineApiAuthEnabled bool) error {
 	srv, err := NewWebserver(enforceAuth)
 	if err != nil {
 		logrus.Fatal("an error occurred while processing the auth settings, exiting!", err)
 		return err
 	}
-	apiPath, handler := kurtosis_enclave_manager_api_bindingsconnect.NewKurtosisEnclaveManagerServerHandler(srv)
+	apiPath, handler := kurtosis_enclave_manager_api_bindingsconnect.NewKurtosisEnclaveManagerServerHandler(
+		srv,
+		connect.WithInterceptors(newEngineApiTokenInterceptor(isEngineApiAuthEnabled, *srv.engineServiceClient)),
+	)
 
 	logrus.Infof("Web server running and listening on port %d", listenPort)
 	apiServer := connect_server.NewConnectServer(
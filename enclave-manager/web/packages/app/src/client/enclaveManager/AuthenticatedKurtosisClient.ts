@@ -3,6 +3,7 @@ import { createConnectTransport } from "@connectrpc/connect-web";
 import { KurtosisEnclaveManagerServer } from "enclave-manager-sdk/build/kurtosis_enclave_manager_api_connect";
 import { DateTime } from "luxon";
 import { KURTOSIS_CLOUD_EM_URL, KURTOSIS_CLOUD_UI_URL, KURTOSIS_DEFAULT_EM_API_PORT } from "../constants";
+import { getEngineApiToken, setEngineApiTokenHeader } from "./engineApiToken";
 import { KurtosisClient } from "./KurtosisClient";
 
 function constructGatewayURL(remoteHost: string): string {
@@ -12,6 +13,7 @@ function constructGatewayURL(remoteHost: string): string {
 export class AuthenticatedKurtosisClient extends KurtosisClient {
   private readonly token: string;
   private readonly tokenExpiry: DateTime;
+  private readonly engineApiToken: string | null;
 
   constructor(gatewayHost: string, token: string, parentUrl: URL, childUrl: URL) {
     super(
@@ -25,6 +27,7 @@ export class AuthenticatedKurtosisClient extends KurtosisClient {
     this.token = token;
     const parsedToken = JSON.parse(atob(this.token.split(".")[1]));
     this.tokenExpiry = DateTime.fromSeconds(parsedToken["exp"]);
+    this.engineApiToken = getEngineApiToken();
   }
 
   validateTokenStillFresh() {
@@ -38,6 +41,7 @@ export class AuthenticatedKurtosisClient extends KurtosisClient {
     this.validateTokenStillFresh();
     const headers = new Headers();
     headers.set("Authorization", `Bearer ${this.token}`);
+    setEngineApiTokenHeader(headers, this.engineApiToken);
     return { headers: headers };
   }
   isRunningInCloud(): boolean {
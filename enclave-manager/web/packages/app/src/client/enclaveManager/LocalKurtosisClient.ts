@@ -2,9 +2,12 @@ import { createPromiseClient } from "@connectrpc/connect";
 import { createConnectTransport } from "@connectrpc/connect-web";
 import { KurtosisEnclaveManagerServer } from "enclave-manager-sdk/build/kurtosis_enclave_manager_api_connect";
 import { KURTOSIS_EM_API_DEFAULT_URL } from "../constants";
+import { getEngineApiToken, setEngineApiTokenHeader } from "./engineApiToken";
 import { KurtosisClient } from "./KurtosisClient";
 
 export class LocalKurtosisClient extends KurtosisClient {
+  private readonly engineApiToken: string | null;
+
   constructor() {
     const defaultUrl = new URL(`${window.location.protocol}//${window.location.host}`);
     var baseUrl = KURTOSIS_EM_API_DEFAULT_URL;
@@ -16,10 +19,13 @@ export class LocalKurtosisClient extends KurtosisClient {
       defaultUrl,
       defaultUrl,
     );
+    this.engineApiToken = getEngineApiToken();
   }
 
-  getHeaderOptions() {
-    return {};
+  getHeaderOptions(): { headers?: Headers } {
+    const headers = new Headers();
+    setEngineApiTokenHeader(headers, this.engineApiToken);
+    return { headers: headers };
   }
 
   isRunningInCloud(): boolean {
@@ -0,0 +1,31 @@
+const ENGINE_API_TOKEN_HEADER = "X-Kurtosis-Api-Token";
+const ENGINE_API_TOKEN_LOCAL_STORAGE_KEY = "kurtosis-engine-api-token";
+const ENGINE_API_TOKEN_URL_FRAGMENT_PARAM = "api-token";
+
+/* `kurtosis web` passes the engine API token in the fragment of the URL, which browsers never send to any server.
+ * The token is kept so that reloading the page doesn't lose it, and removed from the URL so that it doesn't end up in
+ * the browser history.
+ * */
+export function getEngineApiToken(): string | null {
+  const fragmentParams = new URLSearchParams(window.location.hash.substring(1));
+  const tokenFromUrl = fragmentParams.get(ENGINE_API_TOKEN_URL_FRAGMENT_PARAM);
+  if (tokenFromUrl !== null && tokenFromUrl !== "") {
+    localStorage.setItem(ENGINE_API_TOKEN_LOCAL_STORAGE_KEY, tokenFromUrl);
+    fragmentParams.delete(ENGINE_API_TOKEN_URL_FRAGMENT_PARAM);
+    const remainingFragment = fragmentParams.toString();
+    window.history.replaceState(
+      window.history.state,
+      "",
+      `${window.location.pathname}${window.location.search}${remainingFragment !== "" ? `#${remainingFragment}` : ""}`,
+    );
+    return tokenFromUrl;
+  }
+  return localStorage.getItem(ENGINE_API_TOKEN_LOCAL_STORAGE_KEY);
+}
+
+// The engines enforcing API authentication reject the calls made without a token
+export function setEngineApiTokenHeader(headers: Headers, engineApiToken: string | null) {
+  if (engineApiToken !== null && engineApiToken !== "") {
+    headers.set(ENGINE_API_TOKEN_HEADER, engineApiToken);
+  }
+}
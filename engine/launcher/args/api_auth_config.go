@@ -0,0 +1,89 @@
+package args
+
+import (
+	"crypto/rand"
+	"crypto/sha256"
+	"encoding/hex"
+	"strings"
+
+	"github.com/kurtosis-tech/stacktrace"
+)
+
+const (
+	apiTokenIdNumBytes     = 8
+	apiTokenSecretNumBytes = 32
+
+	// The bearer token is the ID of the token followed by its secret, so that the token can be looked up without
+	// hashing the secret against every known token
+	apiTokenIdAndSecretSeparator = "."
+)
+
+type ApiTokenRole string
+
+const (
+	ApiTokenRole_ReadOnly ApiTokenRole = "READ_ONLY"
+	ApiTokenRole_Operator ApiTokenRole = "OPERATOR"
+	ApiTokenRole_Admin    ApiTokenRole = "ADMIN"
+)
+
+// ApiAuthConfig makes the engine reject the API calls that don't come with one of its tokens
+type ApiAuthConfig struct {
+	Tokens []*ApiToken `json:"tokens"`
+}
+
+// ApiToken is a token accepted by the engine. Only the hash of its secret is kept, the secret itself is only known by
+// whoever created the token
+type ApiToken struct {
+	Id string `json:"id"`
+
+	Name string `json:"name"`
+
+	Role ApiTokenRole `json:"role"`
+
+	// The names of the enclaves the token can act on, it can act on every enclave if empty
+	EnclaveNames []string `json:"enclaveNames"`
+
+	SecretHash string `json:"secretHash"`
+}
+
+// NewApiToken generates a token along with the bearer token to authenticate with it
+func NewApiToken(name string, role ApiTokenRole, enclaveNames []string) (*ApiToken, string, error) {
+	id, err := getRandomHexString(apiTokenIdNumBytes)
+	if err != nil {
+		return nil, "", stacktrace.Propagate(err, "An error occurred generating the ID of the API token")
+	}
+	secret, err := getRandomHexString(apiTokenSecretNumBytes)
+	if err != nil {
+		return nil, "", stacktrace.Propagate(err, "An error occurred generating the secret of the API token")
+	}
+	token := &ApiToken{
+		Id:           id,
+		Name:         name,
+		Role:         role,
+		EnclaveNames: enclaveNames,
+		SecretHash:   HashApiTokenSecret(secret),
+	}
+	return token, id + apiTokenIdAndSecretSeparator + secret, nil
+}
+
+// ParseBearerApiToken splits a bearer token into the ID of the API token and its secret
+func ParseBearerApiToken(bearerToken string) (string, string, error) {
+	id, secret, found := strings.Cut(bearerToken, apiTokenIdAndSecretSeparator)
+	if !found || id == "" || secret == "" {
+		return "", "", stacktrace.NewError("The API token isn't of the form '<id>%v<secret>'", apiTokenIdAndSecretSeparator)
+	}
+	return id, secret, nil
+}
+
+func HashApiTokenSecret(secret string) string {
+	hash := sha256.Sum256([]byte(secret))
+	return hex.EncodeToString(hash[:])
+}
+
+func getRandomHexString(numBytes int) (string, error) {
+	randomBytes := make([]byte, numBytes)
+	if _, err := rand.Read(randomBytes); err != nil {
+		return "", stacktrace.Propagate(err, "An error occurred reading random bytes")
+	}
+	return hex.EncodeToString(randomBytes), nil
+}
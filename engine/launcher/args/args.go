@@ -74,12 +74,28 @@ type EngineServerArgs struct {
 	LogsCollectorFilters []logs_collector.Filter `json:"logsCollectorFilters"`
 
 	LogsCollectorParsers []logs_collector.Parser `json:"logsCollectorParsers"`
+
+	// The OTLP gRPC endpoint the engine and the API containers export their traces to. Tracing is disabled if empty
+	OtlpEndpoint string `json:"otlpEndpoint"`
+
+	// The webhook every engine event gets POSTed to. No webhook gets called if nil
+	EventsWebhook *EventsWebhookConfig `json:"eventsWebhook,omitempty"`
+
+	// The tokens the API callers must authenticate with. The APIs accept any caller if nil
+	ApiAuth *ApiAuthConfig `json:"apiAuth,omitempty"`
+
+	// The package pre-run in the idle enclaves of the pool. They're left blank if nil
+	EnclavePoolSeedPackage *EnclavePoolSeedPackageConfig `json:"enclavePoolSeedPackage,omitempty"`
+
+	// So that the engine server knows whether it got started with its debug server
+	IsDebugModeEnabled bool `json:"isDebugModeEnabled"`
 }
 
 var skipValidation = map[string]bool{
 	"cloud_instance_id": true,
 	"cloud_user_id":     true,
 	"domain":            true,
+	"otlpEndpoint":      true,
 }
 
 func (args *EngineServerArgs) UnmarshalJSON(data []byte) error {
@@ -112,6 +128,12 @@ func (args *EngineServerArgs) UnmarshalJSON(data []byte) error {
 			return stacktrace.Propagate(err, "Failed to unmarshal backend config '%+v' with type '%v'", engineServerArgsMirror.KurtosisLocalBackendConfig, engineServerArgsMirror.KurtosisBackendType.String())
 		}
 		engineServerArgsMirror.KurtosisLocalBackendConfig = kubernetesConfig
+	case KurtosisBackendType_InMemory:
+		var inMemoryConfig kurtosis_backend_config.InMemoryBackendConfig
+		if err := json.Unmarshal(byteArray, &inMemoryConfig); err != nil {
+			return stacktrace.Propagate(err, "Failed to unmarshal backend config '%+v' with type '%v'", engineServerArgsMirror.KurtosisLocalBackendConfig, engineServerArgsMirror.KurtosisBackendType.String())
+		}
+		engineServerArgsMirror.KurtosisLocalBackendConfig = inMemoryConfig
 	default:
 		return stacktrace.NewError("Unmarshalled an unrecognized Kurtosis backend type: '%v'", engineServerArgsMirror.KurtosisBackendType.String())
 	}
@@ -141,6 +163,11 @@ func NewEngineServerArgs(
 	logRetentionPeriod string,
 	logsCollectorFilters []logs_collector.Filter,
 	logsCollectorParsers []logs_collector.Parser,
+	otlpEndpoint string,
+	eventsWebhook *EventsWebhookConfig,
+	apiAuth *ApiAuthConfig,
+	enclavePoolSeedPackage *EnclavePoolSeedPackageConfig,
+	isDebugModeEnabled bool,
 ) (*EngineServerArgs, error) {
 	if enclaveEnvVars == "" {
 		enclaveEnvVars = emptyJsonField
@@ -165,6 +192,11 @@ func NewEngineServerArgs(
 		LogRetentionPeriod:          logRetentionPeriod,
 		LogsCollectorFilters:        logsCollectorFilters,
 		LogsCollectorParsers:        logsCollectorParsers,
+		OtlpEndpoint:                otlpEndpoint,
+		EventsWebhook:               eventsWebhook,
+		ApiAuth:                     apiAuth,
+		EnclavePoolSeedPackage:      enclavePoolSeedPackage,
+		IsDebugModeEnabled:          isDebugModeEnabled,
 	}
 	if err := result.validate(); err != nil {
 		return nil, stacktrace.Propagate(err, "An error occurred validating engine server args")
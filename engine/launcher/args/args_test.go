@@ -2,13 +2,16 @@ package args
 
 import (
 	"encoding/json"
+	"github.com/kurtosis-tech/kurtosis/engine/launcher/args/kurtosis_backend_config"
 	"github.com/stretchr/testify/require"
 	"testing"
 )
 
 const (
-	kubernetesArgsJson = `{"grpcListenPortNum":9710,"logLevelStr":"debug","imageVersionTag":"X.X.X","metricsUserId":"5e9d668ad9b004ba16def3ee14c271f5134e1df57a4d4996924e6544e6b0e9be","didUserAcceptSendingMetrics":true,"kurtosisBackendType":"kubernetes","kurtosisBackendConfig":{}}`
-	dockerArgsJson     = `{"grpcListenPortNum":9710,"logLevelStr":"debug","imageVersionTag":"X.X.X","metricsUserId":"5e9d668ad9b004ba16def3ee14c271f5134e1df57a4d4996924e6544e6b0e9be","didUserAcceptSendingMetrics":true,"kurtosisBackendType":"docker","kurtosisBackendConfig":{}}`
+	kubernetesArgsJson                     = `{"grpcListenPortNum":9710,"logLevelStr":"debug","imageVersionTag":"X.X.X","metricsUserId":"5e9d668ad9b004ba16def3ee14c271f5134e1df57a4d4996924e6544e6b0e9be","didUserAcceptSendingMetrics":true,"kurtosisBackendType":"kubernetes","kurtosisBackendConfig":{}}`
+	dockerArgsJson                         = `{"grpcListenPortNum":9710,"logLevelStr":"debug","imageVersionTag":"X.X.X","metricsUserId":"5e9d668ad9b004ba16def3ee14c271f5134e1df57a4d4996924e6544e6b0e9be","didUserAcceptSendingMetrics":true,"kurtosisBackendType":"docker","kurtosisBackendConfig":{}}`
+	kubernetesWithEnclaveNamespaceArgsJson = `{"grpcListenPortNum":9710,"logLevelStr":"debug","imageVersionTag":"X.X.X","metricsUserId":"5e9d668ad9b004ba16def3ee14c271f5134e1df57a4d4996924e6544e6b0e9be","didUserAcceptSendingMetrics":true,"kurtosisBackendType":"kubernetes","kurtosisBackendConfig":{"StorageClass":"standard","EnclaveNamespace":{"labels":{"team":"{{.EnclaveName}}"},"resourceQuota":{"pods":"50"},"networkPolicy":true}}}`
+	inMemoryArgsJson                       = `{"grpcListenPortNum":9710,"logLevelStr":"debug","imageVersionTag":"X.X.X","metricsUserId":"5e9d668ad9b004ba16def3ee14c271f5134e1df57a4d4996924e6544e6b0e9be","didUserAcceptSendingMetrics":true,"kurtosisBackendType":"inmemory","kurtosisBackendConfig":{}}`
 )
 
 func TestArgsUnmarshalKubernetes(t *testing.T) {
@@ -18,9 +21,47 @@ func TestArgsUnmarshalKubernetes(t *testing.T) {
 	require.NoError(t, err)
 }
 
+func TestArgsUnmarshalKubernetesWithEnclaveNamespace(t *testing.T) {
+	paramsJsonBytes := []byte(kubernetesWithEnclaveNamespaceArgsJson)
+	var args EngineServerArgs
+	err := json.Unmarshal(paramsJsonBytes, &args)
+	require.NoError(t, err)
+	kubernetesConfig, ok := args.KurtosisLocalBackendConfig.(kurtosis_backend_config.KubernetesBackendConfig)
+	require.True(t, ok)
+	require.NotNil(t, kubernetesConfig.EnclaveNamespace)
+	require.Equal(t, map[string]string{"team": "{{.EnclaveName}}"}, kubernetesConfig.EnclaveNamespace.Labels)
+	require.Equal(t, map[string]string{"pods": "50"}, kubernetesConfig.EnclaveNamespace.ResourceQuota)
+	require.True(t, kubernetesConfig.EnclaveNamespace.ShouldCreateNetworkPolicy)
+}
+
 func TestArgsUnmarshalDocker(t *testing.T) {
 	paramsJsonBytes := []byte(dockerArgsJson)
 	var args EngineServerArgs
 	err := json.Unmarshal(paramsJsonBytes, &args)
 	require.NoError(t, err)
 }
+
+func TestArgsUnmarshalInMemory(t *testing.T) {
+	paramsJsonBytes := []byte(inMemoryArgsJson)
+	var args EngineServerArgs
+	err := json.Unmarshal(paramsJsonBytes, &args)
+	require.NoError(t, err)
+	require.Equal(t, KurtosisBackendType_InMemory, args.KurtosisBackendType)
+}
+
+func TestNewApiToken_BearerTokenMatchesTheSecretHash(t *testing.T) {
+	token, bearerToken, err := NewApiToken("ci", ApiTokenRole_Operator, []string{"my-enclave"})
+	require.NoError(t, err)
+
+	id, secret, err := ParseBearerApiToken(bearerToken)
+	require.NoError(t, err)
+	require.Equal(t, token.Id, id)
+	require.Equal(t, token.SecretHash, HashApiTokenSecret(secret))
+}
+
+func TestParseBearerApiToken_RejectsMalformedTokens(t *testing.T) {
+	for _, bearerToken := range []string{"", "no-separator", ".secret", "id."} {
+		_, _, err := ParseBearerApiToken(bearerToken)
+		require.Error(t, err, "Bearer token '%v' should have been rejected", bearerToken)
+	}
+}
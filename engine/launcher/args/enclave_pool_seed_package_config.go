@@ -0,0 +1,10 @@
+package args
+
+// EnclavePoolSeedPackageConfig is the package the engine pre-runs in the idle enclaves of its pool, so that the callers
+// deploying this package first get an enclave where it already ran
+type EnclavePoolSeedPackageConfig struct {
+	PackageLocator string `json:"packageLocator"`
+
+	// The serialized JSON params of the package
+	PackageParams string `json:"packageParams"`
+}
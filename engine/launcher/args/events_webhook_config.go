@@ -0,0 +1,9 @@
+package args
+
+// EventsWebhookConfig is the outbound webhook the engine POSTs each of its events to
+type EventsWebhookConfig struct {
+	Url string `json:"url"`
+
+	// How many times the delivery of a single event is retried before it gets dropped
+	MaxRetries uint32 `json:"maxRetries"`
+}
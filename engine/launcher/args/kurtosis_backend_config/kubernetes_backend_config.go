@@ -5,6 +5,14 @@
 
 package kurtosis_backend_config
 
+import "github.com/kurtosis-tech/kurtosis/container-engine-lib/lib/backend_impls/kubernetes/enclave_namespace_config"
+
 type KubernetesBackendConfig struct {
 	StorageClass string
+
+	// Registry that images built in the cluster get pushed to; when empty, each enclave runs its own registry
+	ImageBuildRegistry string
+
+	// Customizations of the namespaces enclaves get created in; nil when there are none
+	EnclaveNamespace *enclave_namespace_config.EnclaveNamespaceConfig
 }
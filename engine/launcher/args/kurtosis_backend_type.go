@@ -13,4 +13,5 @@ const (
 	KurtosisBackendType_Docker KurtosisBackendType = iota
 	KurtosisBackendType_Kubernetes
 	KurtosisBackendType_Podman
+	KurtosisBackendType_InMemory
 )
@@ -56,6 +56,10 @@ func (launcher *EngineServerLauncher) LaunchWithDefaultVersion(
 	shouldEnablePersistentVolumeLogsCollection bool,
 	logsCollectorFilters []logs_collector.Filter,
 	logsCollectorParsers []logs_collector.Parser,
+	otlpEndpoint string,
+	eventsWebhook *args.EventsWebhookConfig,
+	apiAuth *args.ApiAuthConfig,
+	enclavePoolSeedPackage *args.EnclavePoolSeedPackageConfig,
 ) (
 	resultPublicIpAddr net.IP,
 	resultPublicGrpcPortSpec *port_spec.PortSpec,
@@ -85,6 +89,10 @@ func (launcher *EngineServerLauncher) LaunchWithDefaultVersion(
 		shouldEnablePersistentVolumeLogsCollection,
 		logsCollectorFilters,
 		logsCollectorParsers,
+		otlpEndpoint,
+		eventsWebhook,
+		apiAuth,
+		enclavePoolSeedPackage,
 	)
 	if err != nil {
 		return nil, nil, stacktrace.Propagate(err, "An error occurred launching the engine server container with default version tag '%v'", kurtosis_version.KurtosisVersion)
@@ -116,6 +124,10 @@ func (launcher *EngineServerLauncher) LaunchWithCustomVersion(
 	shouldEnablePersistentVolumeLogsCollection bool,
 	logsCollectorFilters []logs_collector.Filter,
 	logsCollectorParsers []logs_collector.Parser,
+	otlpEndpoint string,
+	eventsWebhook *args.EventsWebhookConfig,
+	apiAuth *args.ApiAuthConfig,
+	enclavePoolSeedPackage *args.EnclavePoolSeedPackageConfig,
 ) (
 	resultPublicIpAddr net.IP,
 	resultPublicGrpcPortSpec *port_spec.PortSpec,
@@ -143,6 +155,11 @@ func (launcher *EngineServerLauncher) LaunchWithCustomVersion(
 		logRetentionPeriod,
 		logsCollectorFilters,
 		logsCollectorParsers,
+		otlpEndpoint,
+		eventsWebhook,
+		apiAuth,
+		enclavePoolSeedPackage,
+		shouldStartInDebugMode,
 	)
 	if err != nil {
 		return nil, nil, stacktrace.Propagate(err, "An error occurred creating the engine server args")
@@ -6,6 +6,7 @@
 package engine_server_launcher
 
 import (
+	"github.com/kurtosis-tech/kurtosis/container-engine-lib/lib/backend_impls/kubernetes/enclave_namespace_config"
 	"github.com/kurtosis-tech/kurtosis/engine/launcher/args"
 	"github.com/kurtosis-tech/kurtosis/engine/launcher/args/kurtosis_backend_config"
 )
@@ -13,15 +14,28 @@ import (
 type KubernetesBackendConfigSupplier struct {
 	storageClass           string
 	enclaveSizeInMegabytes uint
+	imageBuildRegistry     string
+	enclaveNamespaceConfig *enclave_namespace_config.EnclaveNamespaceConfig
 }
 
-func NewKubernetesKurtosisBackendConfigSupplier(storageClass string, enclaveSizeInMegabytes uint) KubernetesBackendConfigSupplier {
+func NewKubernetesKurtosisBackendConfigSupplier(
+	storageClass string,
+	enclaveSizeInMegabytes uint,
+	imageBuildRegistry string,
+	enclaveNamespaceConfig *enclave_namespace_config.EnclaveNamespaceConfig,
+) KubernetesBackendConfigSupplier {
 	return KubernetesBackendConfigSupplier{
 		storageClass:           storageClass,
 		enclaveSizeInMegabytes: enclaveSizeInMegabytes,
+		imageBuildRegistry:     imageBuildRegistry,
+		enclaveNamespaceConfig: enclaveNamespaceConfig,
 	}
 }
 
 func (backendConfigSupplier KubernetesBackendConfigSupplier) getKurtosisBackendConfig() (args.KurtosisBackendType, interface{}) {
-	return args.KurtosisBackendType_Kubernetes, kurtosis_backend_config.KubernetesBackendConfig{StorageClass: backendConfigSupplier.storageClass}
+	return args.KurtosisBackendType_Kubernetes, kurtosis_backend_config.KubernetesBackendConfig{
+		StorageClass:       backendConfigSupplier.storageClass,
+		ImageBuildRegistry: backendConfigSupplier.imageBuildRegistry,
+		EnclaveNamespace:   backendConfigSupplier.enclaveNamespaceConfig,
+	}
 }
@@ -0,0 +1,176 @@
+package api_auth
+
+import (
+	"crypto/subtle"
+	"encoding/json"
+	"os"
+	"path/filepath"
+	"sync"
+	"time"
+
+	"github.com/kurtosis-tech/kurtosis/engine/launcher/args"
+	"github.com/kurtosis-tech/stacktrace"
+	bolt "go.etcd.io/bbolt"
+)
+
+const (
+	databaseDirPerms  = 0755
+	databaseFilePerms = 0600
+	// Fails rather than hangs if another engine still holds the database
+	databaseOpenTimeout = 10 * time.Second
+)
+
+var apiTokensBucketName = []byte("api-tokens")
+
+// ApiTokenStore holds the API tokens the engine accepts. They're persisted in the engine database, which only holds the
+// hash of their secret, so that they survive engine restarts. The tokens the engine gets launched with, like the one
+// of the CLI, are added to the persisted ones
+type ApiTokenStore struct {
+	mutex *sync.RWMutex
+
+	// Nil if the tokens are only kept in memory
+	db *bolt.DB
+
+	tokensById map[string]*args.ApiToken
+}
+
+// GetOrCreateApiTokenStore loads the tokens persisted in the database file, creating it if it doesn't exist, and adds
+// the tokens of the config to them. The tokens are only kept in memory if the filepath is empty
+func GetOrCreateApiTokenStore(databaseFilepath string, apiAuthConfig *args.ApiAuthConfig) (*ApiTokenStore, error) {
+	store := &ApiTokenStore{
+		mutex:      &sync.RWMutex{},
+		db:         nil,
+		tokensById: map[string]*args.ApiToken{},
+	}
+
+	if databaseFilepath != "" {
+		if err := os.MkdirAll(filepath.Dir(databaseFilepath), databaseDirPerms); err != nil {
+			return nil, stacktrace.Propagate(err, "An error occurred creating the directory of API tokens database '%v'", databaseFilepath)
+		}
+		db, err := bolt.Open(databaseFilepath, databaseFilePerms, &bolt.Options{Timeout: databaseOpenTimeout}) // nolint: exhaustruct
+		if err != nil {
+			return nil, stacktrace.Propagate(err, "An error occurred opening API tokens database '%v'", databaseFilepath)
+		}
+		store.db = db
+		if err := store.loadPersistedTokens(); err != nil {
+			store.closeDatabase()
+			return nil, stacktrace.Propagate(err, "An error occurred loading the API tokens persisted in '%v'", databaseFilepath)
+		}
+	}
+
+	for _, token := range apiAuthConfig.Tokens {
+		if err := store.addToken(token); err != nil {
+			store.closeDatabase()
+			return nil, stacktrace.Propagate(err, "An error occurred adding API token '%v' the engine was launched with", token.Id)
+		}
+	}
+	return store, nil
+}
+
+// CreateToken returns the created token along with the bearer token to authenticate with it
+func (store *ApiTokenStore) CreateToken(name string, role args.ApiTokenRole, enclaveNames []string) (*args.ApiToken, string, error) {
+	if _, found := roleRanks[role]; !found {
+		return nil, "", stacktrace.NewError("Unknown API token role '%v'", role)
+	}
+	token, bearerToken, err := args.NewApiToken(name, role, enclaveNames)
+	if err != nil {
+		return nil, "", stacktrace.Propagate(err, "An error occurred generating API token '%v'", name)
+	}
+	if err := store.addToken(token); err != nil {
+		return nil, "", stacktrace.Propagate(err, "An error occurred storing API token '%v'", name)
+	}
+	return token, bearerToken, nil
+}
+
+func (store *ApiTokenStore) RevokeToken(tokenId string) error {
+	store.mutex.Lock()
+	defer store.mutex.Unlock()
+
+	if _, found := store.tokensById[tokenId]; !found {
+		return stacktrace.NewError("No API token with ID '%v' exists", tokenId)
+	}
+	if store.db != nil {
+		err := store.db.Update(func(tx *bolt.Tx) error {
+			return tx.Bucket(apiTokensBucketName).Delete([]byte(tokenId))
+		})
+		if err != nil {
+			return stacktrace.Propagate(err, "An error occurred deleting API token '%v' from the database", tokenId)
+		}
+	}
+	delete(store.tokensById, tokenId)
+	return nil
+}
+
+// Authenticate returns the token matching the bearer token, or an error if there's none
+func (store *ApiTokenStore) Authenticate(bearerToken string) (*args.ApiToken, error) {
+	tokenId, secret, err := args.ParseBearerApiToken(bearerToken)
+	if err != nil {
+		return nil, stacktrace.Propagate(err, "The API token is malformed")
+	}
+
+	store.mutex.RLock()
+	token, found := store.tokensById[tokenId]
+	store.mutex.RUnlock()
+	if !found {
+		return nil, stacktrace.NewError("The API token doesn't exist or got revoked")
+	}
+	if subtle.ConstantTimeCompare([]byte(token.SecretHash), []byte(args.HashApiTokenSecret(secret))) != 1 {
+		return nil, stacktrace.NewError("The API token doesn't exist or got revoked")
+	}
+	return token, nil
+}
+
+func (store *ApiTokenStore) Close() error {
+	if store.db == nil {
+		return nil
+	}
+	if err := store.db.Close(); err != nil {
+		return stacktrace.Propagate(err, "An error occurred closing the API tokens database")
+	}
+	return nil
+}
+
+// Adds the token, or replaces the one having the same ID
+func (store *ApiTokenStore) addToken(token *args.ApiToken) error {
+	store.mutex.Lock()
+	defer store.mutex.Unlock()
+
+	if store.db != nil {
+		tokenBytes, err := json.Marshal(token)
+		if err != nil {
+			return stacktrace.Propagate(err, "An error occurred serializing API token '%v'", token.Id)
+		}
+		err = store.db.Update(func(tx *bolt.Tx) error {
+			return tx.Bucket(apiTokensBucketName).Put([]byte(token.Id), tokenBytes)
+		})
+		if err != nil {
+			return stacktrace.Propagate(err, "An error occurred persisting API token '%v'", token.Id)
+		}
+	}
+	store.tokensById[token.Id] = token
+	return nil
+}
+
+func (store *ApiTokenStore) loadPersistedTokens() error {
+	return store.db.Update(func(tx *bolt.Tx) error {
+		bucket, err := tx.CreateBucketIfNotExists(apiTokensBucketName)
+		if err != nil {
+			return stacktrace.Propagate(err, "An error occurred creating the API tokens bucket")
+		}
+		return bucket.ForEach(func(tokenId []byte, tokenBytes []byte) error {
+			token := &args.ApiToken{} // nolint: exhaustruct
+			if err := json.Unmarshal(tokenBytes, token); err != nil {
+				return stacktrace.Propagate(err, "An error occurred deserializing API token '%v'", string(tokenId))
+			}
+			store.tokensById[token.Id] = token
+			return nil
+		})
+	})
+}
+
+// Only used when the store couldn't be created, which the error it fails with already reports
+func (store *ApiTokenStore) closeDatabase() {
+	if store.db != nil {
+		_ = store.db.Close()
+	}
+}
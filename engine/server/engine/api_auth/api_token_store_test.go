@@ -0,0 +1,78 @@
+package api_auth
+
+import (
+	"path/filepath"
+	"testing"
+
+	"github.com/kurtosis-tech/kurtosis/engine/launcher/args"
+	"github.com/stretchr/testify/require"
+)
+
+func TestApiTokenStore_AuthenticatesTheTokensItWasCreatedWith(t *testing.T) {
+	token, bearerToken, err := args.NewApiToken("admin", args.ApiTokenRole_Admin, nil)
+	require.NoError(t, err)
+	store, err := GetOrCreateApiTokenStore("", &args.ApiAuthConfig{Tokens: []*args.ApiToken{token}})
+	require.NoError(t, err)
+
+	authenticatedToken, err := store.Authenticate(bearerToken)
+	require.NoError(t, err)
+	require.Equal(t, token, authenticatedToken)
+}
+
+func TestApiTokenStore_RevokedTokensAreRejected(t *testing.T) {
+	store, err := GetOrCreateApiTokenStore("", &args.ApiAuthConfig{Tokens: nil})
+	require.NoError(t, err)
+	token, bearerToken, err := store.CreateToken("ci", args.ApiTokenRole_Operator, nil)
+	require.NoError(t, err)
+	_, err = store.Authenticate(bearerToken)
+	require.NoError(t, err)
+
+	require.NoError(t, store.RevokeToken(token.Id))
+	_, err = store.Authenticate(bearerToken)
+	require.Error(t, err)
+	require.Error(t, store.RevokeToken(token.Id))
+}
+
+func TestApiTokenStore_WrongSecretIsRejected(t *testing.T) {
+	store, err := GetOrCreateApiTokenStore("", &args.ApiAuthConfig{Tokens: nil})
+	require.NoError(t, err)
+	token, _, err := store.CreateToken("ci", args.ApiTokenRole_Operator, nil)
+	require.NoError(t, err)
+
+	_, err = store.Authenticate(token.Id + ".wrong-secret")
+	require.Error(t, err)
+}
+
+func TestApiTokenStore_UnknownRoleIsRejected(t *testing.T) {
+	store, err := GetOrCreateApiTokenStore("", &args.ApiAuthConfig{Tokens: nil})
+	require.NoError(t, err)
+	_, _, err = store.CreateToken("ci", args.ApiTokenRole("SUPERUSER"), nil)
+	require.Error(t, err)
+}
+
+func TestApiTokenStore_TokensSurviveRestarts(t *testing.T) {
+	databaseFilepath := filepath.Join(t.TempDir(), "api-auth", "api_tokens.db")
+	launchToken, launchBearerToken, err := args.NewApiToken("admin", args.ApiTokenRole_Admin, nil)
+	require.NoError(t, err)
+	store, err := GetOrCreateApiTokenStore(databaseFilepath, &args.ApiAuthConfig{Tokens: []*args.ApiToken{launchToken}})
+	require.NoError(t, err)
+	createdToken, createdBearerToken, err := store.CreateToken("ci", args.ApiTokenRole_Operator, []string{"test-enclave"})
+	require.NoError(t, err)
+	revokedToken, revokedBearerToken, err := store.CreateToken("revoked", args.ApiTokenRole_ReadOnly, nil)
+	require.NoError(t, err)
+	require.NoError(t, store.RevokeToken(revokedToken.Id))
+	require.NoError(t, store.Close())
+
+	restartedStore, err := GetOrCreateApiTokenStore(databaseFilepath, &args.ApiAuthConfig{Tokens: nil})
+	require.NoError(t, err)
+	defer func() {
+		require.NoError(t, restartedStore.Close())
+	}()
+	authenticatedToken, err := restartedStore.Authenticate(createdBearerToken)
+	require.NoError(t, err)
+	require.Equal(t, createdToken, authenticatedToken)
+	_, err = restartedStore.Authenticate(launchBearerToken)
+	require.NoError(t, err)
+	_, err = restartedStore.Authenticate(revokedBearerToken)
+	require.Error(t, err)
+}
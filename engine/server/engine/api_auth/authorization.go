@@ -0,0 +1,120 @@
+package api_auth
+
+import (
+	"context"
+	"fmt"
+	"slices"
+	"strings"
+
+	"github.com/kurtosis-tech/kurtosis/api/golang/engine/kurtosis_engine_rpc_api_bindings/kurtosis_engine_rpc_api_bindingsconnect"
+	"github.com/kurtosis-tech/kurtosis/engine/launcher/args"
+	"github.com/kurtosis-tech/stacktrace"
+)
+
+const (
+	authorizationHeader = "Authorization"
+	bearerPrefix        = "Bearer "
+)
+
+type apiTokenContextKey struct{}
+
+// EnclaveNameResolver gets the name of the enclave an identifier (name, UUID or shortened UUID) refers to
+type EnclaveNameResolver func(ctx context.Context, enclaveIdentifier string) (string, error)
+
+// A role can do everything the roles with a lower rank can do
+var roleRanks = map[args.ApiTokenRole]int{
+	args.ApiTokenRole_ReadOnly: 0,
+	args.ApiTokenRole_Operator: 1,
+	args.ApiTokenRole_Admin:    2,
+}
+
+// The procedures that aren't listed here, like the ones that got added to the API later on, require the admin role
+var procedureRequiredRoles = map[string]args.ApiTokenRole{
+	kurtosis_engine_rpc_api_bindingsconnect.EngineServiceGetEngineInfoProcedure:                              args.ApiTokenRole_ReadOnly,
+	kurtosis_engine_rpc_api_bindingsconnect.EngineServiceGetEnclavesProcedure:                                args.ApiTokenRole_ReadOnly,
+	kurtosis_engine_rpc_api_bindingsconnect.EngineServiceGetEnclavesByUuidsProcedure:                         args.ApiTokenRole_ReadOnly,
+	kurtosis_engine_rpc_api_bindingsconnect.EngineServiceGetExistingAndHistoricalEnclaveIdentifiersProcedure: args.ApiTokenRole_ReadOnly,
+	kurtosis_engine_rpc_api_bindingsconnect.EngineServiceGetServiceLogsProcedure:                             args.ApiTokenRole_ReadOnly,
+	kurtosis_engine_rpc_api_bindingsconnect.EngineServiceWatchEventsProcedure:                                args.ApiTokenRole_ReadOnly,
+	kurtosis_engine_rpc_api_bindingsconnect.EngineServiceGetEnclaveCallerCredentialProcedure:                 args.ApiTokenRole_ReadOnly,
+	kurtosis_engine_rpc_api_bindingsconnect.EngineServiceCreateEnclaveProcedure:                              args.ApiTokenRole_Operator,
+	kurtosis_engine_rpc_api_bindingsconnect.EngineServiceStopEnclaveProcedure:                                args.ApiTokenRole_Operator,
+	kurtosis_engine_rpc_api_bindingsconnect.EngineServiceDestroyEnclaveProcedure:                             args.ApiTokenRole_Operator,
+	kurtosis_engine_rpc_api_bindingsconnect.EngineServiceExtendEnclaveTtlProcedure:                           args.ApiTokenRole_Operator,
+	kurtosis_engine_rpc_api_bindingsconnect.EngineServiceUpdateEnclaveLabelsProcedure:                        args.ApiTokenRole_Operator,
+	kurtosis_engine_rpc_api_bindingsconnect.EngineServiceRenameEnclaveProcedure:                              args.ApiTokenRole_Operator,
+	kurtosis_engine_rpc_api_bindingsconnect.EngineServiceCloneEnclaveProcedure:                               args.ApiTokenRole_Operator,
+	kurtosis_engine_rpc_api_bindingsconnect.EngineServiceExportEnclaveProcedure:                              args.ApiTokenRole_Operator,
+	kurtosis_engine_rpc_api_bindingsconnect.EngineServiceImportEnclaveProcedure:                              args.ApiTokenRole_Operator,
+	kurtosis_engine_rpc_api_bindingsconnect.EngineServiceCleanProcedure:                                      args.ApiTokenRole_Admin,
+	kurtosis_engine_rpc_api_bindingsconnect.EngineServiceCreateApiTokenProcedure:                             args.ApiTokenRole_Admin,
+	kurtosis_engine_rpc_api_bindingsconnect.EngineServiceRevokeApiTokenProcedure:                             args.ApiTokenRole_Admin,
+	kurtosis_engine_rpc_api_bindingsconnect.EngineServiceGetAuditLogProcedure:                                args.ApiTokenRole_Admin,
+}
+
+func getRequiredRoleForProcedure(procedure string) args.ApiTokenRole {
+	requiredRole, found := procedureRequiredRoles[procedure]
+	if !found {
+		return args.ApiTokenRole_Admin
+	}
+	return requiredRole
+}
+
+func hasRole(token *args.ApiToken, requiredRole args.ApiTokenRole) bool {
+	tokenRank, found := roleRanks[token.Role]
+	if !found {
+		return false
+	}
+	return tokenRank >= roleRanks[requiredRole]
+}
+
+// The callers are identified by their API token in the audit log, the ID telling apart the tokens having the same name
+func getAuditLogCaller(token *args.ApiToken) string {
+	return fmt.Sprintf("API token '%v' (%v)", token.Name, token.Id)
+}
+
+func isScopedToEnclaves(token *args.ApiToken) bool {
+	return len(token.EnclaveNames) > 0
+}
+
+func canActOnEnclave(token *args.ApiToken, enclaveName string) bool {
+	return !isScopedToEnclaves(token) || slices.Contains(token.EnclaveNames, enclaveName)
+}
+
+func contextWithApiToken(ctx context.Context, token *args.ApiToken) context.Context {
+	return context.WithValue(ctx, apiTokenContextKey{}, token)
+}
+
+// CanSeeEnclave returns whether the caller the context belongs to may see the enclave in the enclaves it lists. Every
+// enclave is visible when the API authentication isn't enforced, or to the tokens that aren't scoped to some enclaves
+func CanSeeEnclave(ctx context.Context, enclaveName string) bool {
+	token, found := ctx.Value(apiTokenContextKey{}).(*args.ApiToken)
+	if !found {
+		return true
+	}
+	return canActOnEnclave(token, enclaveName)
+}
+
+// Returns an error if the enclave the identifier refers to isn't one of the enclaves the token is scoped to
+func checkCanActOnEnclaveIdentifier(ctx context.Context, token *args.ApiToken, enclaveIdentifier string, enclaveNameResolver EnclaveNameResolver) error {
+	if !isScopedToEnclaves(token) {
+		return nil
+	}
+	enclaveName, err := enclaveNameResolver(ctx, enclaveIdentifier)
+	if err != nil {
+		return stacktrace.Propagate(err, "An error occurred getting the name of enclave '%v' to check whether the API token can act on it", enclaveIdentifier)
+	}
+	if !canActOnEnclave(token, enclaveName) {
+		return stacktrace.NewError("API token '%v' can't act on enclave '%v'", token.Name, enclaveName)
+	}
+	return nil
+}
+
+// Returns an empty string if the header isn't a bearer token
+func getBearerToken(authorizationHeaderValue string) string {
+	bearerToken, found := strings.CutPrefix(authorizationHeaderValue, bearerPrefix)
+	if !found {
+		return ""
+	}
+	return strings.TrimSpace(bearerToken)
+}
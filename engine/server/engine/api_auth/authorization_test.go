@@ -0,0 +1,174 @@
+package api_auth
+
+import (
+	"context"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"connectrpc.com/connect"
+	"github.com/kurtosis-tech/kurtosis/api/golang/engine/kurtosis_engine_rpc_api_bindings"
+	"github.com/kurtosis-tech/kurtosis/api/golang/engine/kurtosis_engine_rpc_api_bindings/kurtosis_engine_rpc_api_bindingsconnect"
+	"github.com/kurtosis-tech/kurtosis/engine/launcher/args"
+	"github.com/labstack/echo/v4"
+	"github.com/stretchr/testify/require"
+)
+
+const (
+	scopedEnclaveName = "allowed-enclave"
+	otherEnclaveName  = "other-enclave"
+)
+
+// Identifiers are names in these tests
+func resolveEnclaveNameForTest(_ context.Context, enclaveIdentifier string) (string, error) {
+	return enclaveIdentifier, nil
+}
+
+func TestHasRole(t *testing.T) {
+	operator := &args.ApiToken{Id: "id", Name: "operator", Role: args.ApiTokenRole_Operator, EnclaveNames: nil, SecretHash: ""}
+	require.True(t, hasRole(operator, args.ApiTokenRole_ReadOnly))
+	require.True(t, hasRole(operator, args.ApiTokenRole_Operator))
+	require.False(t, hasRole(operator, args.ApiTokenRole_Admin))
+}
+
+func TestGetRequiredRoleForProcedure_UnknownProceduresRequireAdmin(t *testing.T) {
+	require.Equal(t, args.ApiTokenRole_Admin, getRequiredRoleForProcedure("/engine_api.EngineService/SomeFutureRpc"))
+}
+
+func TestConnectInterceptor(t *testing.T) {
+	store, err := GetOrCreateApiTokenStore("", &args.ApiAuthConfig{Tokens: nil})
+	require.NoError(t, err)
+	_, readOnlyToken, err := store.CreateToken("read-only", args.ApiTokenRole_ReadOnly, nil)
+	require.NoError(t, err)
+	_, scopedOperatorToken, err := store.CreateToken("scoped-operator", args.ApiTokenRole_Operator, []string{scopedEnclaveName})
+	require.NoError(t, err)
+
+	// The unimplemented handler answers the calls that got through the interceptor
+	path, handler := kurtosis_engine_rpc_api_bindingsconnect.NewEngineServiceHandler(
+		kurtosis_engine_rpc_api_bindingsconnect.UnimplementedEngineServiceHandler{},
+		connect.WithInterceptors(NewConnectInterceptor(store, resolveEnclaveNameForTest)),
+	)
+	mux := http.NewServeMux()
+	mux.Handle(path, handler)
+	server := httptest.NewServer(mux)
+	defer server.Close()
+	client := kurtosis_engine_rpc_api_bindingsconnect.NewEngineServiceClient(server.Client(), server.URL)
+
+	destroyEnclave := func(bearerToken string, enclaveIdentifier string) connect.Code {
+		request := connect.NewRequest(&kurtosis_engine_rpc_api_bindings.DestroyEnclaveArgs{EnclaveIdentifier: enclaveIdentifier})
+		if bearerToken != "" {
+			request.Header().Set(authorizationHeader, bearerPrefix+bearerToken)
+		}
+		_, err := client.DestroyEnclave(context.Background(), request)
+		return connect.CodeOf(err)
+	}
+
+	require.Equal(t, connect.CodeUnauthenticated, destroyEnclave("", scopedEnclaveName))
+	require.Equal(t, connect.CodeUnauthenticated, destroyEnclave("unknown.token", scopedEnclaveName))
+	require.Equal(t, connect.CodePermissionDenied, destroyEnclave(readOnlyToken, scopedEnclaveName))
+	require.Equal(t, connect.CodePermissionDenied, destroyEnclave(scopedOperatorToken, otherEnclaveName))
+	require.Equal(t, connect.CodeUnimplemented, destroyEnclave(scopedOperatorToken, scopedEnclaveName))
+
+	// Enclave-scoped tokens can't move an enclave out of their scope by renaming it
+	renameEnclave := func(enclaveIdentifier string, newName string) connect.Code {
+		request := connect.NewRequest(&kurtosis_engine_rpc_api_bindings.RenameEnclaveArgs{EnclaveIdentifier: enclaveIdentifier, NewName: newName})
+		request.Header().Set(authorizationHeader, bearerPrefix+scopedOperatorToken)
+		_, err := client.RenameEnclave(context.Background(), request)
+		return connect.CodeOf(err)
+	}
+
+	require.Equal(t, connect.CodePermissionDenied, renameEnclave(otherEnclaveName, scopedEnclaveName))
+	require.Equal(t, connect.CodePermissionDenied, renameEnclave(scopedEnclaveName, otherEnclaveName))
+	require.Equal(t, connect.CodeUnimplemented, renameEnclave(scopedEnclaveName, scopedEnclaveName))
+
+	// The arguments of streaming RPCs are only checked once received
+	getServiceLogs := func(bearerToken string, enclaveIdentifier string) connect.Code {
+		request := connect.NewRequest(&kurtosis_engine_rpc_api_bindings.GetServiceLogsArgs{
+			EnclaveIdentifier:  enclaveIdentifier,
+			ServiceUuidSet:     nil,
+			FollowLogs:         nil,
+			ConjunctiveFilters: nil,
+			ReturnAllLogs:      nil,
+			NumLogLines:        nil,
+		})
+		request.Header().Set(authorizationHeader, bearerPrefix+bearerToken)
+		stream, err := client.GetServiceLogs(context.Background(), request)
+		require.NoError(t, err)
+		defer stream.Close()
+		require.False(t, stream.Receive())
+		return connect.CodeOf(stream.Err())
+	}
+
+	require.Equal(t, connect.CodePermissionDenied, getServiceLogs(scopedOperatorToken, otherEnclaveName))
+	require.Equal(t, connect.CodeUnimplemented, getServiceLogs(scopedOperatorToken, scopedEnclaveName))
+}
+
+func TestEchoMiddleware(t *testing.T) {
+	store, err := GetOrCreateApiTokenStore("", &args.ApiAuthConfig{Tokens: nil})
+	require.NoError(t, err)
+	_, readOnlyToken, err := store.CreateToken("read-only", args.ApiTokenRole_ReadOnly, nil)
+	require.NoError(t, err)
+	_, scopedOperatorToken, err := store.CreateToken("scoped-operator", args.ApiTokenRole_Operator, []string{scopedEnclaveName})
+	require.NoError(t, err)
+
+	router := echo.New()
+	router.Use(NewEchoMiddleware(store, resolveEnclaveNameForTest))
+	okHandler := func(ctx echo.Context) error {
+		return ctx.NoContent(http.StatusOK)
+	}
+	router.GET("/enclaves", okHandler)
+	router.DELETE("/enclaves", okHandler)
+	router.DELETE("/enclaves/:enclave_identifier", okHandler)
+
+	sendRequest := func(method string, path string, bearerToken string) int {
+		request := httptest.NewRequest(method, path, nil)
+		if bearerToken != "" {
+			request.Header.Set(authorizationHeader, bearerPrefix+bearerToken)
+		}
+		recorder := httptest.NewRecorder()
+		router.ServeHTTP(recorder, request)
+		return recorder.Code
+	}
+
+	require.Equal(t, http.StatusUnauthorized, sendRequest(http.MethodGet, "/enclaves", ""))
+	require.Equal(t, http.StatusOK, sendRequest(http.MethodGet, "/enclaves", readOnlyToken))
+	require.Equal(t, http.StatusForbidden, sendRequest(http.MethodDelete, "/enclaves/"+scopedEnclaveName, readOnlyToken))
+	require.Equal(t, http.StatusOK, sendRequest(http.MethodDelete, "/enclaves/"+scopedEnclaveName, scopedOperatorToken))
+	require.Equal(t, http.StatusForbidden, sendRequest(http.MethodDelete, "/enclaves/"+otherEnclaveName, scopedOperatorToken))
+	require.Equal(t, http.StatusForbidden, sendRequest(http.MethodDelete, "/enclaves", scopedOperatorToken))
+}
+
+func TestEchoMiddleware_EnclaveScopedTokensOnlySeeTheirEnclaves(t *testing.T) {
+	store, err := GetOrCreateApiTokenStore("", &args.ApiAuthConfig{Tokens: nil})
+	require.NoError(t, err)
+	_, readOnlyToken, err := store.CreateToken("read-only", args.ApiTokenRole_ReadOnly, nil)
+	require.NoError(t, err)
+	_, scopedReadOnlyToken, err := store.CreateToken("scoped-read-only", args.ApiTokenRole_ReadOnly, []string{scopedEnclaveName})
+	require.NoError(t, err)
+
+	router := echo.New()
+	router.Use(NewEchoMiddleware(store, resolveEnclaveNameForTest))
+	router.GET("/enclaves", func(ctx echo.Context) error {
+		visibleEnclaveNames := []string{}
+		for _, enclaveName := range []string{scopedEnclaveName, otherEnclaveName} {
+			if CanSeeEnclave(ctx.Request().Context(), enclaveName) {
+				visibleEnclaveNames = append(visibleEnclaveNames, enclaveName)
+			}
+		}
+		return ctx.JSON(http.StatusOK, visibleEnclaveNames)
+	})
+
+	listEnclaves := func(bearerToken string) string {
+		request := httptest.NewRequest(http.MethodGet, "/enclaves", nil)
+		request.Header.Set(authorizationHeader, bearerPrefix+bearerToken)
+		recorder := httptest.NewRecorder()
+		router.ServeHTTP(recorder, request)
+		require.Equal(t, http.StatusOK, recorder.Code)
+		return recorder.Body.String()
+	}
+
+	require.JSONEq(t, `["`+scopedEnclaveName+`","`+otherEnclaveName+`"]`, listEnclaves(readOnlyToken))
+	require.JSONEq(t, `["`+scopedEnclaveName+`"]`, listEnclaves(scopedReadOnlyToken))
+	// Without API authentication, every enclave is visible
+	require.True(t, CanSeeEnclave(context.Background(), otherEnclaveName))
+}
@@ -0,0 +1,147 @@
+package api_auth
+
+import (
+	"context"
+	"net/http"
+
+	"connectrpc.com/connect"
+	"github.com/kurtosis-tech/kurtosis/api/golang/engine/kurtosis_engine_rpc_api_bindings"
+	"github.com/kurtosis-tech/kurtosis/api/golang/engine/kurtosis_engine_rpc_api_bindings/kurtosis_engine_rpc_api_bindingsconnect"
+	"github.com/kurtosis-tech/kurtosis/engine/launcher/args"
+	"github.com/kurtosis-tech/kurtosis/engine/server/engine/audit_log"
+	"github.com/kurtosis-tech/stacktrace"
+	"github.com/sirupsen/logrus"
+)
+
+// These procedures act on every enclave, or on enclaves whose name isn't known upfront, so the tokens that are scoped to
+// some enclaves can't call them
+var proceduresForbiddenToEnclaveScopedTokens = map[string]bool{
+	kurtosis_engine_rpc_api_bindingsconnect.EngineServiceCleanProcedure:          true,
+	kurtosis_engine_rpc_api_bindingsconnect.EngineServiceCreateApiTokenProcedure: true,
+	kurtosis_engine_rpc_api_bindingsconnect.EngineServiceRevokeApiTokenProcedure: true,
+	kurtosis_engine_rpc_api_bindingsconnect.EngineServiceGetAuditLogProcedure:    true,
+	kurtosis_engine_rpc_api_bindingsconnect.EngineServiceImportEnclaveProcedure:  true,
+}
+
+// Implemented by the arguments of the RPCs acting on an existing enclave
+type enclaveIdentifierArgs interface {
+	GetEnclaveIdentifier() string
+}
+
+// ConnectInterceptor rejects the engine RPCs whose caller doesn't have an API token allowing them
+type ConnectInterceptor struct {
+	tokenStore *ApiTokenStore
+
+	enclaveNameResolver EnclaveNameResolver
+}
+
+func NewConnectInterceptor(tokenStore *ApiTokenStore, enclaveNameResolver EnclaveNameResolver) *ConnectInterceptor {
+	return &ConnectInterceptor{
+		tokenStore:          tokenStore,
+		enclaveNameResolver: enclaveNameResolver,
+	}
+}
+
+func (interceptor *ConnectInterceptor) WrapUnary(next connect.UnaryFunc) connect.UnaryFunc {
+	return func(ctx context.Context, request connect.AnyRequest) (connect.AnyResponse, error) {
+		token, err := interceptor.authorizeProcedure(request.Spec().Procedure, request.Header())
+		if err != nil {
+			return nil, err
+		}
+		if err := interceptor.authorizeArgs(ctx, token, request.Spec().Procedure, request.Any()); err != nil {
+			return nil, err
+		}
+		return next(contextWithApiToken(audit_log.ContextWithCaller(ctx, getAuditLogCaller(token)), token), request)
+	}
+}
+
+// WrapStreamingClient is a no-op, the engine doesn't call other services through this interceptor
+func (interceptor *ConnectInterceptor) WrapStreamingClient(next connect.StreamingClientFunc) connect.StreamingClientFunc {
+	return next
+}
+
+func (interceptor *ConnectInterceptor) WrapStreamingHandler(next connect.StreamingHandlerFunc) connect.StreamingHandlerFunc {
+	return func(ctx context.Context, conn connect.StreamingHandlerConn) error {
+		token, err := interceptor.authorizeProcedure(conn.Spec().Procedure, conn.RequestHeader())
+		if err != nil {
+			return err
+		}
+		ctx = contextWithApiToken(audit_log.ContextWithCaller(ctx, getAuditLogCaller(token)), token)
+		return next(ctx, &authorizingStreamingHandlerConn{
+			StreamingHandlerConn: conn,
+			ctx:                  ctx,
+			interceptor:          interceptor,
+			token:                token,
+		})
+	}
+}
+
+func (interceptor *ConnectInterceptor) authorizeProcedure(procedure string, header http.Header) (*args.ApiToken, error) {
+	bearerToken := getBearerToken(header.Get(authorizationHeader))
+	if bearerToken == "" {
+		return nil, connect.NewError(connect.CodeUnauthenticated, stacktrace.NewError("This engine requires an API token, which must be sent as a bearer token in the 'Authorization' header"))
+	}
+	token, err := interceptor.tokenStore.Authenticate(bearerToken)
+	if err != nil {
+		logrus.Debugf("Rejected a call to '%v' with an invalid API token. Error was:\n%v", procedure, err)
+		return nil, connect.NewError(connect.CodeUnauthenticated, stacktrace.NewError("The API token is invalid or got revoked"))
+	}
+
+	requiredRole := getRequiredRoleForProcedure(procedure)
+	if !hasRole(token, requiredRole) {
+		return nil, connect.NewError(connect.CodePermissionDenied, stacktrace.NewError("API token '%v' has role '%v' but '%v' requires role '%v'", token.Name, token.Role, procedure, requiredRole))
+	}
+	if isScopedToEnclaves(token) && proceduresForbiddenToEnclaveScopedTokens[procedure] {
+		return nil, connect.NewError(connect.CodePermissionDenied, stacktrace.NewError("API token '%v' is scoped to some enclaves so it can't call '%v'", token.Name, procedure))
+	}
+	return token, nil
+}
+
+func (interceptor *ConnectInterceptor) authorizeArgs(ctx context.Context, token *args.ApiToken, procedure string, rpcArgs any) error {
+	if !isScopedToEnclaves(token) {
+		return nil
+	}
+	switch castedArgs := rpcArgs.(type) {
+	case *kurtosis_engine_rpc_api_bindings.CreateEnclaveArgs:
+		if !canActOnEnclave(token, castedArgs.GetEnclaveName()) {
+			return connect.NewError(connect.CodePermissionDenied, stacktrace.NewError("API token '%v' can't create enclave '%v', an enclave-scoped token can only create the enclaves it's scoped to and must give their name", token.Name, castedArgs.GetEnclaveName()))
+		}
+	case *kurtosis_engine_rpc_api_bindings.CloneEnclaveArgs:
+		if err := checkCanActOnEnclaveIdentifier(ctx, token, castedArgs.GetEnclaveIdentifier(), interceptor.enclaveNameResolver); err != nil {
+			return connect.NewError(connect.CodePermissionDenied, stacktrace.Propagate(err, "API token '%v' isn't allowed to clone enclave '%v'", token.Name, castedArgs.GetEnclaveIdentifier()))
+		}
+		if !canActOnEnclave(token, castedArgs.GetEnclaveName()) {
+			return connect.NewError(connect.CodePermissionDenied, stacktrace.NewError("API token '%v' can't create enclave '%v', an enclave-scoped token can only clone into the enclaves it's scoped to and must give their name", token.Name, castedArgs.GetEnclaveName()))
+		}
+	case *kurtosis_engine_rpc_api_bindings.RenameEnclaveArgs:
+		if err := checkCanActOnEnclaveIdentifier(ctx, token, castedArgs.GetEnclaveIdentifier(), interceptor.enclaveNameResolver); err != nil {
+			return connect.NewError(connect.CodePermissionDenied, stacktrace.Propagate(err, "API token '%v' isn't allowed to rename enclave '%v'", token.Name, castedArgs.GetEnclaveIdentifier()))
+		}
+		if !canActOnEnclave(token, castedArgs.GetNewName()) {
+			return connect.NewError(connect.CodePermissionDenied, stacktrace.NewError("API token '%v' can't rename an enclave to '%v', an enclave-scoped token can only give the enclaves the names it's scoped to", token.Name, castedArgs.GetNewName()))
+		}
+	case enclaveIdentifierArgs:
+		if err := checkCanActOnEnclaveIdentifier(ctx, token, castedArgs.GetEnclaveIdentifier(), interceptor.enclaveNameResolver); err != nil {
+			return connect.NewError(connect.CodePermissionDenied, stacktrace.Propagate(err, "API token '%v' isn't allowed to call '%v'", token.Name, procedure))
+		}
+	}
+	return nil
+}
+
+// Checks the arguments of the streaming RPCs once they're received, as they aren't known when the stream gets opened
+type authorizingStreamingHandlerConn struct {
+	connect.StreamingHandlerConn
+
+	ctx context.Context
+
+	interceptor *ConnectInterceptor
+
+	token *args.ApiToken
+}
+
+func (conn *authorizingStreamingHandlerConn) Receive(message any) error {
+	if err := conn.StreamingHandlerConn.Receive(message); err != nil {
+		return err
+	}
+	return conn.interceptor.authorizeArgs(conn.ctx, conn.token, conn.Spec().Procedure, message)
+}
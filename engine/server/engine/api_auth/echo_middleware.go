@@ -0,0 +1,71 @@
+package api_auth
+
+import (
+	"net/http"
+	"strings"
+
+	"github.com/kurtosis-tech/kurtosis/engine/launcher/args"
+	"github.com/kurtosis-tech/kurtosis/engine/server/engine/audit_log"
+	"github.com/labstack/echo/v4"
+	"github.com/sirupsen/logrus"
+)
+
+const (
+	enclaveIdentifierPathParam = "enclave_identifier"
+
+	// Cleaning the engine and reading its audit log are the only REST endpoints reserved to admins
+	enclavesRouteSuffix = "/enclaves"
+	auditLogRoutePath   = "/engine/audit"
+)
+
+// NewEchoMiddleware rejects the REST API requests whose caller doesn't have an API token allowing them. Reading is
+// allowed to every role, while changing anything requires the operator role
+func NewEchoMiddleware(tokenStore *ApiTokenStore, enclaveNameResolver EnclaveNameResolver) echo.MiddlewareFunc {
+	return func(next echo.HandlerFunc) echo.HandlerFunc {
+		return func(ctx echo.Context) error {
+			request := ctx.Request()
+			bearerToken := getBearerToken(request.Header.Get(authorizationHeader))
+			if bearerToken == "" {
+				return echo.NewHTTPError(http.StatusUnauthorized, "This engine requires an API token, which must be sent as a bearer token in the 'Authorization' header")
+			}
+			token, err := tokenStore.Authenticate(bearerToken)
+			if err != nil {
+				logrus.Debugf("Rejected a '%v %v' request with an invalid API token. Error was:\n%v", request.Method, request.URL.Path, err)
+				return echo.NewHTTPError(http.StatusUnauthorized, "The API token is invalid or got revoked")
+			}
+
+			requiredRole := getRequiredRoleForRequest(request.Method, ctx.Path())
+			if !hasRole(token, requiredRole) {
+				return echo.NewHTTPError(http.StatusForbidden, "The API token doesn't have the '"+string(requiredRole)+"' role this request requires")
+			}
+			ctx.SetRequest(request.WithContext(contextWithApiToken(audit_log.ContextWithCaller(request.Context(), getAuditLogCaller(token)), token)))
+
+			enclaveIdentifier := ctx.Param(enclaveIdentifierPathParam)
+			if enclaveIdentifier == "" {
+				// Only reading is possible outside of a given enclave for the enclave-scoped tokens
+				if isScopedToEnclaves(token) && requiredRole != args.ApiTokenRole_ReadOnly {
+					return echo.NewHTTPError(http.StatusForbidden, "The API token is scoped to some enclaves so it can't make this request")
+				}
+				return next(ctx)
+			}
+			if err := checkCanActOnEnclaveIdentifier(request.Context(), token, enclaveIdentifier, enclaveNameResolver); err != nil {
+				logrus.Debugf("Rejected a '%v %v' request outside of the enclaves of the API token. Error was:\n%v", request.Method, request.URL.Path, err)
+				return echo.NewHTTPError(http.StatusForbidden, "The API token can't act on enclave '"+enclaveIdentifier+"'")
+			}
+			return next(ctx)
+		}
+	}
+}
+
+func getRequiredRoleForRequest(method string, routePath string) args.ApiTokenRole {
+	switch {
+	case strings.HasSuffix(routePath, auditLogRoutePath):
+		return args.ApiTokenRole_Admin
+	case method == http.MethodGet || method == http.MethodHead || method == http.MethodOptions:
+		return args.ApiTokenRole_ReadOnly
+	case method == http.MethodDelete && strings.HasSuffix(routePath, enclavesRouteSuffix):
+		return args.ApiTokenRole_Admin
+	default:
+		return args.ApiTokenRole_Operator
+	}
+}
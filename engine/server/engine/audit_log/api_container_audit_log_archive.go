@@ -0,0 +1,82 @@
+package audit_log
+
+import (
+	"sync"
+
+	"github.com/kurtosis-tech/stacktrace"
+)
+
+// ApiContainerAuditLogArchive holds copies of the entries of the API container audit logs, which the engine keeps so
+// that they outlive the enclaves. Like the engine audit log, entries are only ever appended to the file it's backed by
+type ApiContainerAuditLogArchive struct {
+	mutex *sync.RWMutex
+
+	// Empty if the entries are only kept in memory
+	filepath string
+
+	entries []*Entry
+
+	// The API containers number their entries in order, so the ones up to this number are already archived
+	lastSequenceNumbersByEnclaveUuid map[string]uint64
+}
+
+// GetOrCreateApiContainerAuditLogArchive loads the entries already archived in the file, creating it if it doesn't
+// exist. The entries are only kept in memory if the filepath is empty
+func GetOrCreateApiContainerAuditLogArchive(archiveFilepath string) (*ApiContainerAuditLogArchive, error) {
+	archive := &ApiContainerAuditLogArchive{
+		mutex:                            &sync.RWMutex{},
+		filepath:                         archiveFilepath,
+		entries:                          []*Entry{},
+		lastSequenceNumbersByEnclaveUuid: map[string]uint64{},
+	}
+	if archiveFilepath == "" {
+		return archive, nil
+	}
+
+	entries, err := readEntriesFile(archiveFilepath)
+	if err != nil {
+		return nil, stacktrace.Propagate(err, "An error occurred reading the entries of API container audit log archive '%v'", archiveFilepath)
+	}
+	archive.entries = entries
+	for _, entry := range entries {
+		archive.lastSequenceNumbersByEnclaveUuid[entry.EnclaveUuid] = max(archive.lastSequenceNumbersByEnclaveUuid[entry.EnclaveUuid], entry.SequenceNumber)
+	}
+	return archive, nil
+}
+
+// Archive adds the entries of the audit log of the API container of the enclave that aren't archived yet
+func (archive *ApiContainerAuditLogArchive) Archive(enclaveUuid string, entries []*Entry) error {
+	archive.mutex.Lock()
+	defer archive.mutex.Unlock()
+
+	lastSequenceNumber := archive.lastSequenceNumbersByEnclaveUuid[enclaveUuid]
+	newEntries := []*Entry{}
+	for _, entry := range entries {
+		if entry.SequenceNumber <= lastSequenceNumber {
+			continue
+		}
+		newEntries = append(newEntries, entry)
+		lastSequenceNumber = max(lastSequenceNumber, entry.SequenceNumber)
+	}
+	if len(newEntries) == 0 {
+		return nil
+	}
+
+	// Persisted first so that entries failing to be persisted get archived again the next time
+	if err := appendToEntriesFile(archive.filepath, newEntries); err != nil {
+		return stacktrace.Propagate(err, "An error occurred persisting the audit log entries of the API container of enclave '%v'", enclaveUuid)
+	}
+	archive.entries = keepLatestEntries(append(archive.entries, newEntries...))
+	archive.lastSequenceNumbersByEnclaveUuid[enclaveUuid] = lastSequenceNumber
+	return nil
+}
+
+// GetEntries returns the latest archived entries, oldest archived first
+func (archive *ApiContainerAuditLogArchive) GetEntries() []*Entry {
+	archive.mutex.RLock()
+	defer archive.mutex.RUnlock()
+
+	entries := make([]*Entry, len(archive.entries))
+	copy(entries, archive.entries)
+	return entries
+}
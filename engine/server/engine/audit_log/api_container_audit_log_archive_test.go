@@ -0,0 +1,44 @@
+package audit_log
+
+import (
+	"path/filepath"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+const (
+	otherTestEnclaveUuid = "11112222333344445555666677778888"
+)
+
+func TestArchive_OnlyAddsTheEntriesNotArchivedYet(t *testing.T) {
+	archiveFilepath := filepath.Join(t.TempDir(), "audit", "api_container_audit_log.jsonl")
+	archive, err := GetOrCreateApiContainerAuditLogArchive(archiveFilepath)
+	require.NoError(t, err)
+
+	require.NoError(t, archive.Archive(testEnclaveUuid, []*Entry{newApiContainerEntryForTest(testEnclaveUuid, 1), newApiContainerEntryForTest(testEnclaveUuid, 2)}))
+	// The API container audit log is archived whole each time, so the entries already archived come again
+	require.NoError(t, archive.Archive(testEnclaveUuid, []*Entry{newApiContainerEntryForTest(testEnclaveUuid, 1), newApiContainerEntryForTest(testEnclaveUuid, 2), newApiContainerEntryForTest(testEnclaveUuid, 3)}))
+	require.NoError(t, archive.Archive(otherTestEnclaveUuid, []*Entry{newApiContainerEntryForTest(otherTestEnclaveUuid, 1)}))
+	require.Len(t, archive.GetEntries(), 4)
+
+	// The archived entries outlive the engine, and the ones archived before a restart aren't archived again
+	reloadedArchive, err := GetOrCreateApiContainerAuditLogArchive(archiveFilepath)
+	require.NoError(t, err)
+	require.NoError(t, reloadedArchive.Archive(testEnclaveUuid, []*Entry{newApiContainerEntryForTest(testEnclaveUuid, 3), newApiContainerEntryForTest(testEnclaveUuid, 4)}))
+	entries := reloadedArchive.GetEntries()
+	require.Len(t, entries, 5)
+	require.Equal(t, testEnclaveUuid, entries[4].EnclaveUuid)
+	require.Equal(t, uint64(4), entries[4].SequenceNumber)
+}
+
+func newApiContainerEntryForTest(enclaveUuid string, sequenceNumber uint64) *Entry {
+	return &Entry{ // nolint: exhaustruct
+		SequenceNumber: sequenceNumber,
+		Caller:         testCaller,
+		Action:         "RunStarlarkPackage",
+		EnclaveUuid:    enclaveUuid,
+		EnclaveName:    testEnclaveName,
+		Arguments:      map[string]string{},
+	}
+}
@@ -0,0 +1,206 @@
+package audit_log
+
+import (
+	"bufio"
+	"context"
+	"encoding/json"
+	"fmt"
+	"os"
+	"path/filepath"
+	"sync"
+	"time"
+
+	"github.com/kurtosis-tech/stacktrace"
+	"github.com/sirupsen/logrus"
+)
+
+const (
+	auditLogDirPerms  = 0755
+	auditLogFilePerms = 0644
+
+	auditLogFileOpenFlags = os.O_APPEND | os.O_CREATE | os.O_WRONLY
+
+	// Only the latest entries are kept in memory, and returned, so that the engine memory doesn't grow with its age. The
+	// older ones are still in the file
+	maxInMemoryEntries = 100000
+	// Dropping the oldest entries copies the latest ones, so it's only done once that many more have been recorded
+	inMemoryEntriesTrimSlack = maxInMemoryEntries / 10
+)
+
+// Entry is a change made through the engine, or through the API container of an enclave
+type Entry struct {
+	// Increases with each entry of the same audit log, the engine and each API container having their own
+	SequenceNumber uint64 `json:"sequenceNumber"`
+
+	Timestamp time.Time `json:"timestamp"`
+
+	// The API token, or the address when the engine doesn't require API tokens, the change was made by
+	Caller string `json:"caller"`
+
+	Action string `json:"action"`
+
+	// Empty if the change isn't about a single enclave
+	EnclaveUuid string `json:"enclaveUuid,omitempty"`
+	EnclaveName string `json:"enclaveName,omitempty"`
+
+	// Never holds secrets, the callers recording entries leave them out
+	Arguments map[string]string `json:"arguments"`
+
+	// Empty if the change succeeded
+	Error string `json:"error,omitempty"`
+}
+
+// AuditLog records who changed what through the engine. Entries are only ever appended, one JSON object per line, to
+// the file the audit log is backed by so that they outlive the engine
+type AuditLog struct {
+	mutex *sync.RWMutex
+
+	// Empty if the entries are only kept in memory
+	filepath string
+
+	entries []*Entry
+}
+
+// GetOrCreateAuditLog loads the entries already recorded in the file, creating it if it doesn't exist. The entries
+// are only kept in memory if the filepath is empty
+func GetOrCreateAuditLog(auditLogFilepath string) (*AuditLog, error) {
+	auditLog := &AuditLog{
+		mutex:    &sync.RWMutex{},
+		filepath: auditLogFilepath,
+		entries:  []*Entry{},
+	}
+	if auditLogFilepath == "" {
+		return auditLog, nil
+	}
+
+	entries, err := readEntriesFile(auditLogFilepath)
+	if err != nil {
+		return nil, stacktrace.Propagate(err, "An error occurred reading the entries of audit log file '%v'", auditLogFilepath)
+	}
+	auditLog.entries = keepLatestEntries(entries)
+	return auditLog, nil
+}
+
+// Record appends an entry, made by the caller the context holds, to the audit log. Failing to persist it doesn't fail
+// the change it's about, it's only logged
+func (auditLog *AuditLog) Record(ctx context.Context, action string, enclaveUuid string, enclaveName string, arguments map[string]string, err error) {
+	if arguments == nil {
+		arguments = map[string]string{}
+	}
+	entry := &Entry{
+		SequenceNumber: 0,
+		Timestamp:      time.Now(),
+		Caller:         GetCaller(ctx),
+		Action:         action,
+		EnclaveUuid:    enclaveUuid,
+		EnclaveName:    enclaveName,
+		Arguments:      arguments,
+		Error:          "",
+	}
+	if err != nil {
+		entry.Error = err.Error()
+	}
+
+	auditLog.mutex.Lock()
+	defer auditLog.mutex.Unlock()
+
+	entry.SequenceNumber = 1
+	if len(auditLog.entries) > 0 {
+		entry.SequenceNumber = auditLog.entries[len(auditLog.entries)-1].SequenceNumber + 1
+	}
+	auditLog.entries = keepLatestEntries(append(auditLog.entries, entry))
+	if err := appendToEntriesFile(auditLog.filepath, []*Entry{entry}); err != nil {
+		logrus.Errorf("An error occurred persisting the '%v' entry of the audit log, it's only kept in memory. Error was:\n%v", action, err)
+	}
+}
+
+// GetEntries returns the latest entries of the audit log, oldest first
+func (auditLog *AuditLog) GetEntries() []*Entry {
+	auditLog.mutex.RLock()
+	defer auditLog.mutex.RUnlock()
+
+	entries := make([]*Entry, len(auditLog.entries))
+	copy(entries, auditLog.entries)
+	return entries
+}
+
+// Reads the entries of the file, creating it if it doesn't exist
+func readEntriesFile(entriesFilepath string) ([]*Entry, error) {
+	if err := os.MkdirAll(filepath.Dir(entriesFilepath), auditLogDirPerms); err != nil {
+		return nil, stacktrace.Propagate(err, "An error occurred creating the directory of audit log file '%v'", entriesFilepath)
+	}
+	file, err := os.OpenFile(entriesFilepath, os.O_CREATE|os.O_RDONLY, auditLogFilePerms)
+	if err != nil {
+		return nil, stacktrace.Propagate(err, "An error occurred opening audit log file '%v'", entriesFilepath)
+	}
+	defer file.Close()
+
+	entries := []*Entry{}
+	scanner := bufio.NewScanner(file)
+	// Some arguments, like Starlark scripts, make for long lines
+	scanner.Buffer(nil, bufio.MaxScanTokenSize*64)
+	for scanner.Scan() {
+		if len(scanner.Bytes()) == 0 {
+			continue
+		}
+		entry := &Entry{} // nolint: exhaustruct
+		if err := json.Unmarshal(scanner.Bytes(), entry); err != nil {
+			// A line can only be partially written if the engine went down while recording it, so it's skipped
+			logrus.Warnf("Skipping an entry of audit log file '%v' that couldn't be read. Error was:\n%v", entriesFilepath, err)
+			continue
+		}
+		// The file is read once, on startup, so only the latest entries are held while reading it
+		entries = keepLatestEntries(append(entries, entry))
+	}
+	if err := scanner.Err(); err != nil {
+		return nil, stacktrace.Propagate(err, "An error occurred reading audit log file '%v'", entriesFilepath)
+	}
+	return entries, nil
+}
+
+// Does nothing if the filepath is empty, the entries then only being kept in memory
+func appendToEntriesFile(entriesFilepath string, entries []*Entry) error {
+	if entriesFilepath == "" || len(entries) == 0 {
+		return nil
+	}
+	entriesBytes := []byte{}
+	for _, entry := range entries {
+		entryBytes, err := json.Marshal(entry)
+		if err != nil {
+			return stacktrace.Propagate(err, "An error occurred marshalling audit log entry '%+v'", entry)
+		}
+		entriesBytes = append(append(entriesBytes, entryBytes...), '\n')
+	}
+	file, err := os.OpenFile(entriesFilepath, auditLogFileOpenFlags, auditLogFilePerms)
+	if err != nil {
+		return stacktrace.Propagate(err, "An error occurred opening audit log file '%v'", entriesFilepath)
+	}
+	defer file.Close()
+	if _, err := file.Write(entriesBytes); err != nil {
+		return stacktrace.Propagate(err, "An error occurred writing to audit log file '%v'", entriesFilepath)
+	}
+	return nil
+}
+
+// Drops the oldest entries once there are more than the in-memory limit, along with its slack
+func keepLatestEntries(entries []*Entry) []*Entry {
+	if len(entries) <= maxInMemoryEntries+inMemoryEntriesTrimSlack {
+		return entries
+	}
+	latestEntries := make([]*Entry, maxInMemoryEntries)
+	copy(latestEntries, entries[len(entries)-maxInMemoryEntries:])
+	return latestEntries
+}
+
+// FormatArgument formats an argument of a change for its entry, the strings being kept as they are and the other values
+// being formatted as JSON
+func FormatArgument(value interface{}) string {
+	if stringValue, ok := value.(string); ok {
+		return stringValue
+	}
+	valueBytes, err := json.Marshal(value)
+	if err != nil {
+		return fmt.Sprintf("%v", value)
+	}
+	return string(valueBytes)
+}
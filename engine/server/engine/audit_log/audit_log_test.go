@@ -0,0 +1,89 @@
+package audit_log
+
+import (
+	"context"
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/kurtosis-tech/stacktrace"
+	"github.com/stretchr/testify/require"
+)
+
+const (
+	testAuditLogFilename = "audit_log.jsonl"
+
+	testCaller      = "API token 'ci' (ci-token-id)"
+	testEnclaveUuid = "aaaabbbbccccddddeeeeffff00001111"
+	testEnclaveName = "test-enclave"
+)
+
+func TestRecord_EntriesAreReloaded(t *testing.T) {
+	auditLogFilepath := filepath.Join(t.TempDir(), "audit", testAuditLogFilename)
+	auditLog, err := GetOrCreateAuditLog(auditLogFilepath)
+	require.NoError(t, err)
+
+	ctx := ContextWithCaller(context.Background(), testCaller)
+	auditLog.Record(ctx, "CreateEnclave", testEnclaveUuid, testEnclaveName, map[string]string{"enclave_name": testEnclaveName}, nil)
+	auditLog.Record(context.Background(), "DestroyEnclave", testEnclaveUuid, testEnclaveName, nil, stacktrace.NewError("Destroying failed"))
+
+	reloadedAuditLog, err := GetOrCreateAuditLog(auditLogFilepath)
+	require.NoError(t, err)
+	entries := reloadedAuditLog.GetEntries()
+	require.Len(t, entries, 2)
+
+	require.Equal(t, uint64(1), entries[0].SequenceNumber)
+	require.Equal(t, testCaller, entries[0].Caller)
+	require.Equal(t, "CreateEnclave", entries[0].Action)
+	require.Equal(t, map[string]string{"enclave_name": testEnclaveName}, entries[0].Arguments)
+	require.Empty(t, entries[0].Error)
+
+	require.Equal(t, uint64(2), entries[1].SequenceNumber)
+	require.Equal(t, unknownCaller, entries[1].Caller)
+	require.Equal(t, map[string]string{}, entries[1].Arguments)
+	require.Contains(t, entries[1].Error, "Destroying failed")
+	require.True(t, entries[1].Timestamp.Equal(auditLog.GetEntries()[1].Timestamp))
+
+	// The sequence numbers carry on from the reloaded entries
+	reloadedAuditLog.Record(ctx, "Clean", "", "", nil, nil)
+	require.Equal(t, uint64(3), reloadedAuditLog.GetEntries()[2].SequenceNumber)
+}
+
+func TestGetOrCreateAuditLog_SkipsPartiallyWrittenEntries(t *testing.T) {
+	auditLogFilepath := filepath.Join(t.TempDir(), testAuditLogFilename)
+	auditLog, err := GetOrCreateAuditLog(auditLogFilepath)
+	require.NoError(t, err)
+	auditLog.Record(context.Background(), "StopEnclave", testEnclaveUuid, testEnclaveName, nil, nil)
+
+	file, err := os.OpenFile(auditLogFilepath, auditLogFileOpenFlags, auditLogFilePerms)
+	require.NoError(t, err)
+	_, err = file.WriteString(`{"sequenceNumber":2,"action":"Destr`)
+	require.NoError(t, err)
+	require.NoError(t, file.Close())
+
+	reloadedAuditLog, err := GetOrCreateAuditLog(auditLogFilepath)
+	require.NoError(t, err)
+	entries := reloadedAuditLog.GetEntries()
+	require.Len(t, entries, 1)
+	require.Equal(t, "StopEnclave", entries[0].Action)
+}
+
+func TestKeepLatestEntries_DropsTheOldestEntriesPastTheLimit(t *testing.T) {
+	entries := []*Entry{}
+	for sequenceNumber := uint64(1); sequenceNumber <= maxInMemoryEntries+inMemoryEntriesTrimSlack; sequenceNumber++ {
+		entries = append(entries, &Entry{SequenceNumber: sequenceNumber}) // nolint: exhaustruct
+	}
+	require.Len(t, keepLatestEntries(entries), maxInMemoryEntries+inMemoryEntriesTrimSlack)
+
+	entries = keepLatestEntries(append(entries, &Entry{SequenceNumber: maxInMemoryEntries + inMemoryEntriesTrimSlack + 1})) // nolint: exhaustruct
+	require.Len(t, entries, maxInMemoryEntries)
+	require.Equal(t, uint64(inMemoryEntriesTrimSlack+2), entries[0].SequenceNumber)
+	require.Equal(t, uint64(maxInMemoryEntries+inMemoryEntriesTrimSlack+1), entries[len(entries)-1].SequenceNumber)
+}
+
+func TestFormatArgument(t *testing.T) {
+	require.Equal(t, "my-enclave", FormatArgument("my-enclave"))
+	require.Equal(t, "true", FormatArgument(true))
+	require.Equal(t, `["dev","prod"]`, FormatArgument([]string{"dev", "prod"}))
+	require.Equal(t, `{"env":"dev"}`, FormatArgument(map[string]string{"env": "dev"}))
+}
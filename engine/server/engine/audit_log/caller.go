@@ -0,0 +1,64 @@
+package audit_log
+
+import (
+	"context"
+
+	"connectrpc.com/connect"
+	"github.com/labstack/echo/v4"
+)
+
+const (
+	unknownCaller = "unknown"
+)
+
+type callerContextKey struct{}
+
+// ContextWithCaller returns a copy of the context holding who's making the call, for the entries recorded during it
+func ContextWithCaller(ctx context.Context, caller string) context.Context {
+	return context.WithValue(ctx, callerContextKey{}, caller)
+}
+
+// GetCaller returns who's making the call the context belongs to
+func GetCaller(ctx context.Context) string {
+	if caller, found := ctx.Value(callerContextKey{}).(string); found && caller != "" {
+		return caller
+	}
+	return unknownCaller
+}
+
+// NewEchoMiddleware identifies the callers of the REST API by their address. The API authentication, when enforced,
+// identifies them more precisely by their API token afterward
+func NewEchoMiddleware() echo.MiddlewareFunc {
+	return func(next echo.HandlerFunc) echo.HandlerFunc {
+		return func(ctx echo.Context) error {
+			request := ctx.Request()
+			ctx.SetRequest(request.WithContext(ContextWithCaller(request.Context(), ctx.RealIP())))
+			return next(ctx)
+		}
+	}
+}
+
+// ConnectInterceptor identifies the callers of the engine RPCs by their address, like the echo middleware does for the
+// REST API
+type ConnectInterceptor struct{}
+
+func NewConnectInterceptor() *ConnectInterceptor {
+	return &ConnectInterceptor{}
+}
+
+func (interceptor *ConnectInterceptor) WrapUnary(next connect.UnaryFunc) connect.UnaryFunc {
+	return func(ctx context.Context, request connect.AnyRequest) (connect.AnyResponse, error) {
+		return next(ContextWithCaller(ctx, request.Peer().Addr), request)
+	}
+}
+
+// WrapStreamingClient is a no-op, the engine doesn't call other services through this interceptor
+func (interceptor *ConnectInterceptor) WrapStreamingClient(next connect.StreamingClientFunc) connect.StreamingClientFunc {
+	return next
+}
+
+func (interceptor *ConnectInterceptor) WrapStreamingHandler(next connect.StreamingHandlerFunc) connect.StreamingHandlerFunc {
+	return func(ctx context.Context, conn connect.StreamingHandlerConn) error {
+		return next(ContextWithCaller(ctx, conn.Peer().Addr), conn)
+	}
+}
@@ -0,0 +1,112 @@
+package audit_log
+
+import (
+	"context"
+	"crypto/hmac"
+	"crypto/rand"
+	"crypto/sha256"
+	"encoding/hex"
+	"errors"
+	"io/fs"
+	"os"
+	"path/filepath"
+	"strings"
+	"time"
+
+	"github.com/kurtosis-tech/kurtosis/api/golang/core/lib/shared_utils"
+	"github.com/kurtosis-tech/stacktrace"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/metadata"
+)
+
+const (
+	callerKeyLength = 32
+
+	callerKeyDirPerms = 0755
+	// Holds the secret every enclave caller key is derived from
+	callerKeyFilePerms = 0600
+)
+
+// CallerKey proves to the API containers that the callers they get forwarded come from the engine. Each API container
+// gets a key of its own, derived from the enclave it runs, so that it can't pass itself off as the engine to the others
+type CallerKey struct {
+	secret []byte
+}
+
+// GetOrCreateCallerKey loads the secret the enclave caller keys are derived from, creating it if it doesn't exist. It's
+// persisted so that the API containers launched before an engine restart keep accepting the callers it forwards. The
+// secret is only kept in memory if the filepath is empty
+func GetOrCreateCallerKey(callerKeyFilepath string) (*CallerKey, error) {
+	if callerKeyFilepath != "" {
+		secretHex, err := os.ReadFile(callerKeyFilepath)
+		if err == nil {
+			secret, err := hex.DecodeString(strings.TrimSpace(string(secretHex)))
+			if err == nil && len(secret) == callerKeyLength {
+				return &CallerKey{secret: secret}, nil
+			}
+		} else if !errors.Is(err, fs.ErrNotExist) {
+			return nil, stacktrace.Propagate(err, "An error occurred reading caller key file '%v'", callerKeyFilepath)
+		}
+	}
+
+	secret := make([]byte, callerKeyLength)
+	if _, err := rand.Read(secret); err != nil {
+		return nil, stacktrace.Propagate(err, "An error occurred generating the caller key")
+	}
+	if callerKeyFilepath != "" {
+		if err := os.MkdirAll(filepath.Dir(callerKeyFilepath), callerKeyDirPerms); err != nil {
+			return nil, stacktrace.Propagate(err, "An error occurred creating the directory of caller key file '%v'", callerKeyFilepath)
+		}
+		if err := os.WriteFile(callerKeyFilepath, []byte(hex.EncodeToString(secret)), callerKeyFilePerms); err != nil {
+			return nil, stacktrace.Propagate(err, "An error occurred writing caller key file '%v'", callerKeyFilepath)
+		}
+	}
+	return &CallerKey{secret: secret}, nil
+}
+
+// GetEnclaveCallerKey returns the key the API container of the enclave gets launched with
+func (callerKey *CallerKey) GetEnclaveCallerKey(enclaveUuid string) string {
+	mac := hmac.New(sha256.New, callerKey.secret)
+	mac.Write([]byte(enclaveUuid))
+	return hex.EncodeToString(mac.Sum(nil))
+}
+
+// NewCallerCredential returns the credential proving to the API container of the enclave that the calls sent along with
+// it come from the caller, for the callers calling it directly rather than through the engine
+func (callerKey *CallerKey) NewCallerCredential(enclaveUuid string, caller string, expirationTime time.Time) (string, error) {
+	credential, err := shared_utils.NewAuditLogCallerCredential(callerKey.GetEnclaveCallerKey(enclaveUuid), caller, expirationTime)
+	if err != nil {
+		return "", stacktrace.Propagate(err, "An error occurred creating the credential of caller '%v' for enclave '%v'", caller, enclaveUuid)
+	}
+	return credential, nil
+}
+
+// NewForwardCallerUnaryClientInterceptor passes the caller on to the API container of the enclave the engine calls on
+// their behalf, so that its audit log knows who made the changes
+func (callerKey *CallerKey) NewForwardCallerUnaryClientInterceptor(enclaveUuid string) grpc.UnaryClientInterceptor {
+	enclaveCallerKey := callerKey.GetEnclaveCallerKey(enclaveUuid)
+	return func(ctx context.Context, method string, req, reply interface{}, conn *grpc.ClientConn, invoker grpc.UnaryInvoker, opts ...grpc.CallOption) error {
+		return invoker(withOutgoingCaller(ctx, enclaveCallerKey), method, req, reply, conn, opts...)
+	}
+}
+
+// NewForwardCallerStreamClientInterceptor is the streaming counterpart of NewForwardCallerUnaryClientInterceptor
+func (callerKey *CallerKey) NewForwardCallerStreamClientInterceptor(enclaveUuid string) grpc.StreamClientInterceptor {
+	enclaveCallerKey := callerKey.GetEnclaveCallerKey(enclaveUuid)
+	return func(ctx context.Context, desc *grpc.StreamDesc, conn *grpc.ClientConn, method string, streamer grpc.Streamer, opts ...grpc.CallOption) (grpc.ClientStream, error) {
+		return streamer(withOutgoingCaller(ctx, enclaveCallerKey), desc, conn, method, opts...)
+	}
+}
+
+// The API container identifies the callers by their address when the engine doesn't know who they are
+func withOutgoingCaller(ctx context.Context, enclaveCallerKey string) context.Context {
+	caller := GetCaller(ctx)
+	if caller == unknownCaller {
+		return ctx
+	}
+	return metadata.AppendToOutgoingContext(
+		ctx,
+		shared_utils.AuditLogCallerMetadataKey, caller,
+		shared_utils.AuditLogEngineCallerKeyMetadataKey, enclaveCallerKey,
+	)
+}
@@ -0,0 +1,38 @@
+package audit_log
+
+import (
+	"path/filepath"
+	"testing"
+	"time"
+
+	"github.com/kurtosis-tech/kurtosis/api/golang/core/lib/shared_utils"
+	"github.com/stretchr/testify/require"
+)
+
+func TestGetOrCreateCallerKey_KeepsTheEnclaveKeysAcrossRestarts(t *testing.T) {
+	callerKeyFilepath := filepath.Join(t.TempDir(), "audit", "engine_caller.key")
+
+	callerKey, err := GetOrCreateCallerKey(callerKeyFilepath)
+	require.NoError(t, err)
+	reloadedCallerKey, err := GetOrCreateCallerKey(callerKeyFilepath)
+	require.NoError(t, err)
+
+	require.Equal(t, callerKey.GetEnclaveCallerKey("enclave-1"), reloadedCallerKey.GetEnclaveCallerKey("enclave-1"))
+	require.NotEqual(t, callerKey.GetEnclaveCallerKey("enclave-1"), callerKey.GetEnclaveCallerKey("enclave-2"))
+}
+
+func TestNewCallerCredential_OnlyAcceptedByTheApiContainerOfTheEnclave(t *testing.T) {
+	callerKey, err := GetOrCreateCallerKey("")
+	require.NoError(t, err)
+
+	now := time.Now()
+	credential, err := callerKey.NewCallerCredential("enclave-1", "API token 'ci' (0123456789abcdef)", now.Add(time.Hour))
+	require.NoError(t, err)
+
+	caller, err := shared_utils.VerifyAuditLogCallerCredential(callerKey.GetEnclaveCallerKey("enclave-1"), credential, now)
+	require.NoError(t, err)
+	require.Equal(t, "API token 'ci' (0123456789abcdef)", caller)
+
+	_, err = shared_utils.VerifyAuditLogCallerCredential(callerKey.GetEnclaveCallerKey("enclave-2"), credential, now)
+	require.Error(t, err)
+}
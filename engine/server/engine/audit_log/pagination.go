@@ -0,0 +1,121 @@
+package audit_log
+
+import (
+	"encoding/base64"
+	"encoding/json"
+	"sort"
+	"time"
+
+	"github.com/kurtosis-tech/kurtosis/container-engine-lib/lib/uuid_generator"
+	"github.com/kurtosis-tech/stacktrace"
+)
+
+const (
+	defaultPageSize = 100
+	maxPageSize     = 1000
+)
+
+// Where a page ends, the next page starting right after this entry. Entries are only ever added with a later
+// timestamp, so the pages already read don't change when new entries get recorded
+type pageCursor struct {
+	Timestamp time.Time `json:"timestamp"`
+
+	EnclaveUuid string `json:"enclaveUuid"`
+
+	SequenceNumber uint64 `json:"sequenceNumber"`
+}
+
+// GetPage sorts the entries, coming from the engine and the API containers, newest first and returns the page starting
+// after the given page token, or the first page if it's empty. The returned page token is empty on the last page
+func GetPage(entries []*Entry, pageSize uint32, pageToken string) ([]*Entry, string, error) {
+	if pageSize == 0 {
+		pageSize = defaultPageSize
+	}
+	if pageSize > maxPageSize {
+		return nil, "", stacktrace.NewError("The page size must be at most %d but was %d", maxPageSize, pageSize)
+	}
+
+	sortedEntries := make([]*Entry, len(entries))
+	copy(sortedEntries, entries)
+	sort.SliceStable(sortedEntries, func(i, j int) bool {
+		return isBefore(newPageCursor(sortedEntries[i]), newPageCursor(sortedEntries[j]))
+	})
+
+	pageStart := 0
+	if pageToken != "" {
+		cursor, err := decodePageToken(pageToken)
+		if err != nil {
+			return nil, "", stacktrace.Propagate(err, "Page token '%v' is invalid", pageToken)
+		}
+		pageStart = sort.Search(len(sortedEntries), func(i int) bool {
+			return isBefore(cursor, newPageCursor(sortedEntries[i]))
+		})
+	}
+	pageEnd := min(pageStart+int(pageSize), len(sortedEntries))
+	page := sortedEntries[pageStart:pageEnd]
+
+	if pageEnd == len(sortedEntries) {
+		return page, "", nil
+	}
+	nextPageToken, err := encodePageToken(newPageCursor(page[len(page)-1]))
+	if err != nil {
+		return nil, "", stacktrace.Propagate(err, "An error occurred creating the token of the next page")
+	}
+	return page, nextPageToken, nil
+}
+
+func newPageCursor(entry *Entry) pageCursor {
+	return pageCursor{
+		Timestamp:      entry.Timestamp,
+		EnclaveUuid:    entry.EnclaveUuid,
+		SequenceNumber: entry.SequenceNumber,
+	}
+}
+
+// The order of the pages: newest first, the entries recorded at the same time being ordered by the log they come from
+func isBefore(cursor pageCursor, otherCursor pageCursor) bool {
+	if !cursor.Timestamp.Equal(otherCursor.Timestamp) {
+		return cursor.Timestamp.After(otherCursor.Timestamp)
+	}
+	if cursor.EnclaveUuid != otherCursor.EnclaveUuid {
+		return cursor.EnclaveUuid < otherCursor.EnclaveUuid
+	}
+	return cursor.SequenceNumber > otherCursor.SequenceNumber
+}
+
+func encodePageToken(cursor pageCursor) (string, error) {
+	cursorBytes, err := json.Marshal(cursor)
+	if err != nil {
+		return "", stacktrace.Propagate(err, "An error occurred marshalling page cursor '%+v'", cursor)
+	}
+	return base64.RawURLEncoding.EncodeToString(cursorBytes), nil
+}
+
+func decodePageToken(pageToken string) (pageCursor, error) {
+	cursor := pageCursor{} // nolint: exhaustruct
+	cursorBytes, err := base64.RawURLEncoding.DecodeString(pageToken)
+	if err != nil {
+		return cursor, stacktrace.Propagate(err, "An error occurred decoding the page token")
+	}
+	if err := json.Unmarshal(cursorBytes, &cursor); err != nil {
+		return cursor, stacktrace.Propagate(err, "An error occurred unmarshalling the page cursor")
+	}
+	return cursor, nil
+}
+
+// FilterByEnclave keeps the entries about the enclave the identifier (name, UUID or shortened UUID) refers to. The
+// entries of destroyed enclaves are matched too, as the engine doesn't know them anymore
+func FilterByEnclave(entries []*Entry, enclaveIdentifier string) []*Entry {
+	filteredEntries := []*Entry{}
+	for _, entry := range entries {
+		if entry.EnclaveUuid == "" {
+			continue
+		}
+		if entry.EnclaveName == enclaveIdentifier ||
+			entry.EnclaveUuid == enclaveIdentifier ||
+			uuid_generator.ShortenedUUIDString(entry.EnclaveUuid) == enclaveIdentifier {
+			filteredEntries = append(filteredEntries, entry)
+		}
+	}
+	return filteredEntries
+}
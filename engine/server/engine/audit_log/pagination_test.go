@@ -0,0 +1,90 @@
+package audit_log
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+)
+
+const (
+	otherEnclaveUuid = "11112222333344445555666677778888"
+	otherEnclaveName = "other-enclave"
+)
+
+func TestGetPage_PagesThroughAllEntriesNewestFirst(t *testing.T) {
+	start := time.Date(2024, 3, 1, 12, 0, 0, 0, time.UTC)
+	entries := []*Entry{
+		newTestEntry(1, start, "", ""),
+		newTestEntry(2, start.Add(time.Minute), "", ""),
+		// Recorded at the same time by the engine and an API container
+		newTestEntry(1, start.Add(2*time.Minute), testEnclaveUuid, testEnclaveName),
+		newTestEntry(3, start.Add(2*time.Minute), "", ""),
+		newTestEntry(2, start.Add(3*time.Minute), testEnclaveUuid, testEnclaveName),
+	}
+
+	firstPage, pageToken, err := GetPage(entries, 2, "")
+	require.NoError(t, err)
+	require.Equal(t, []*Entry{entries[4], entries[3]}, firstPage)
+	require.NotEmpty(t, pageToken)
+
+	// An entry recorded in between doesn't shift the following pages
+	entries = append(entries, newTestEntry(4, start.Add(4*time.Minute), "", ""))
+
+	secondPage, pageToken, err := GetPage(entries, 2, pageToken)
+	require.NoError(t, err)
+	require.Equal(t, []*Entry{entries[2], entries[1]}, secondPage)
+	require.NotEmpty(t, pageToken)
+
+	lastPage, pageToken, err := GetPage(entries, 2, pageToken)
+	require.NoError(t, err)
+	require.Equal(t, []*Entry{entries[0]}, lastPage)
+	require.Empty(t, pageToken)
+}
+
+func TestGetPage_DefaultPageSize(t *testing.T) {
+	entries := []*Entry{}
+	start := time.Date(2024, 3, 1, 12, 0, 0, 0, time.UTC)
+	for i := 0; i < defaultPageSize+1; i++ {
+		entries = append(entries, newTestEntry(uint64(i+1), start.Add(time.Duration(i)*time.Second), "", ""))
+	}
+
+	page, pageToken, err := GetPage(entries, 0, "")
+	require.NoError(t, err)
+	require.Len(t, page, defaultPageSize)
+	require.NotEmpty(t, pageToken)
+}
+
+func TestGetPage_InvalidArgs(t *testing.T) {
+	_, _, err := GetPage([]*Entry{}, maxPageSize+1, "")
+	require.Error(t, err)
+
+	_, _, err = GetPage([]*Entry{}, 10, "not a page token")
+	require.Error(t, err)
+}
+
+func TestFilterByEnclave(t *testing.T) {
+	start := time.Date(2024, 3, 1, 12, 0, 0, 0, time.UTC)
+	engineEntry := newTestEntry(1, start, "", "")
+	enclaveEntry := newTestEntry(2, start, testEnclaveUuid, testEnclaveName)
+	otherEnclaveEntry := newTestEntry(3, start, otherEnclaveUuid, otherEnclaveName)
+	entries := []*Entry{engineEntry, enclaveEntry, otherEnclaveEntry}
+
+	require.Equal(t, []*Entry{enclaveEntry}, FilterByEnclave(entries, testEnclaveName))
+	require.Equal(t, []*Entry{enclaveEntry}, FilterByEnclave(entries, testEnclaveUuid))
+	require.Equal(t, []*Entry{enclaveEntry}, FilterByEnclave(entries, testEnclaveUuid[:12]))
+	require.Empty(t, FilterByEnclave(entries, "unknown-enclave"))
+}
+
+func newTestEntry(sequenceNumber uint64, timestamp time.Time, enclaveUuid string, enclaveName string) *Entry {
+	return &Entry{
+		SequenceNumber: sequenceNumber,
+		Timestamp:      timestamp,
+		Caller:         testCaller,
+		Action:         "CreateEnclave",
+		EnclaveUuid:    enclaveUuid,
+		EnclaveName:    enclaveName,
+		Arguments:      map[string]string{},
+		Error:          "",
+	}
+}
@@ -170,7 +170,7 @@ func getMockedKurtosisBackendWithEnclavesAndServices(ctx context.Context, t *tes
 	enclaveStatus := enclave.EnclaveStatus_Running
 	enclaveCreationTime := mockTime.Now() // time doesn't matter
 	enclaveMap := map[enclave.EnclaveUUID]*enclave.Enclave{
-		enclaveUuid: enclave.NewEnclave(enclaveUuid, testEnclaveUuid, enclaveStatus, &enclaveCreationTime, false),
+		enclaveUuid: enclave.NewEnclave(enclaveUuid, testEnclaveUuid, enclaveStatus, &enclaveCreationTime, false, nil, nil),
 	}
 
 	mockKurtosisBackend.
@@ -0,0 +1,476 @@
+package enclave_manager
+
+import (
+	"archive/tar"
+	"compress/gzip"
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"maps"
+	"os"
+	"path/filepath"
+	"sort"
+	"strings"
+
+	rpc_api "github.com/kurtosis-tech/kurtosis/api/golang/core/kurtosis_core_rpc_api_bindings"
+	"github.com/kurtosis-tech/kurtosis/container-engine-lib/lib/backend_interface/objects/enclave"
+	"github.com/kurtosis-tech/kurtosis/container-engine-lib/lib/backend_interface/objects/service_directory"
+	"github.com/kurtosis-tech/kurtosis/container-engine-lib/lib/tracing"
+	"github.com/kurtosis-tech/kurtosis/engine/launcher/args"
+	"github.com/kurtosis-tech/kurtosis/engine/server/engine/types"
+	"github.com/kurtosis-tech/stacktrace"
+	"github.com/sirupsen/logrus"
+	"go.opentelemetry.io/otel/attribute"
+	"google.golang.org/protobuf/encoding/protojson"
+	"google.golang.org/protobuf/types/known/emptypb"
+)
+
+const (
+	exportEnclaveSpanName = "export_enclave"
+	importEnclaveSpanName = "import_enclave"
+
+	// EnclaveArchiveFormatVersion must be bumped whenever the layout of the enclave archive changes in a way older
+	// engines can't read; see docs/docs/cli-reference/engine-export.md
+	EnclaveArchiveFormatVersion = 1
+
+	enclaveArchiveManifestFilepath                  = "enclave.json"
+	enclaveArchivePlanFilepath                      = "plan.json"
+	enclaveArchiveFilesArtifactsDirpath             = "files_artifacts"
+	enclaveArchiveFilesArtifactFilepathFormat       = enclaveArchiveFilesArtifactsDirpath + "/%d.tgz"
+	enclaveArchivePersistentDirectoriesDirpath      = "persistent_directories"
+	enclaveArchivePersistentDirectoryFilepathFormat = enclaveArchivePersistentDirectoriesDirpath + "/%d.tar"
+	enclaveArchiveEntryFileMode                     = 0644
+
+	// The entries are buffered in the temporary directory at the same paths they have in the archive
+	enclaveExportDirPattern = "kurtosis-enclave-export-*"
+	enclaveExportDirPerms   = 0755
+
+	shouldImportedEnclaveApiContainerRunInDebugMode = false
+)
+
+// enclaveArchiveManifest is the first entry of an enclave archive, describing the other entries
+type enclaveArchiveManifest struct {
+	FormatVersion         int                   `json:"format_version"`
+	Name                  string                `json:"name"`
+	Mode                  types.EnclaveMode     `json:"mode"`
+	Labels                map[string]string     `json:"labels"`
+	FilesArtifacts        []enclaveArchiveEntry `json:"files_artifacts"`
+	PersistentDirectories []enclaveArchiveEntry `json:"persistent_directories"`
+	// Everything of the enclave that isn't in the archive, so that the import can report it
+	UnexportedItems []string `json:"unexported_items"`
+}
+
+type enclaveArchiveEntry struct {
+	// The files artifact name or the persistent key
+	Name     string `json:"name"`
+	Filepath string `json:"filepath"`
+}
+
+// ExportEnclave writes a gzipped tar archive of a running enclave to destination, with its plan, files artifacts and
+// persistent directories, which ImportEnclave can recreate the enclave from on another engine
+func (manager *EnclaveManager) ExportEnclave(ctx context.Context, enclaveIdentifier string, destination io.Writer) (resultErr error) {
+	ctx, span := tracing.StartSpan(ctx, exportEnclaveSpanName, attribute.String(enclaveIdentifierSpanAttributeKey, enclaveIdentifier))
+	defer func() {
+		tracing.EndSpan(span, resultErr)
+	}()
+
+	enclaveUuid, err := manager.GetEnclaveUuidForEnclaveIdentifier(ctx, enclaveIdentifier)
+	if err != nil {
+		return stacktrace.Propagate(err, "An error occurred getting the UUID of enclave '%v'", enclaveIdentifier)
+	}
+	enclaves, err := manager.GetEnclavesByUuid(ctx, []enclave.EnclaveUUID{enclaveUuid})
+	if err != nil {
+		return stacktrace.Propagate(err, "An error occurred getting enclave '%v'", enclaveUuid)
+	}
+	enclaveInfo, found := enclaves[string(enclaveUuid)]
+	if !found {
+		return stacktrace.NewError("Enclave '%v' wasn't found", enclaveUuid)
+	}
+
+	apiContainerClient, closeClientFunc, err := manager.getApiContainerClient(ctx, enclaveUuid)
+	if err != nil {
+		return stacktrace.Propagate(err, "An error occurred getting a client to the API container of enclave '%v'", enclaveIdentifier)
+	}
+	if apiContainerClient == nil {
+		return stacktrace.NewError("Enclave '%v' has no running API container; only running enclaves can be exported", enclaveIdentifier)
+	}
+	defer closeClientFunc()
+
+	enclavePlan, err := apiContainerClient.GetEnclavePlan(ctx, &emptypb.Empty{})
+	if err != nil {
+		return stacktrace.Propagate(err, "An error occurred getting the plan of enclave '%v'", enclaveIdentifier)
+	}
+	serializedPlan, err := protojson.Marshal(enclavePlan)
+	if err != nil {
+		return stacktrace.Propagate(err, "An error occurred serializing the plan of enclave '%v'", enclaveIdentifier)
+	}
+	filesArtifacts, err := apiContainerClient.ListFilesArtifactNamesAndUuids(ctx, &emptypb.Empty{})
+	if err != nil {
+		return stacktrace.Propagate(err, "An error occurred listing the files artifacts of enclave '%v'", enclaveIdentifier)
+	}
+	services, err := apiContainerClient.GetServices(ctx, &rpc_api.GetServicesArgs{ServiceIdentifiers: map[string]bool{}})
+	if err != nil {
+		return stacktrace.Propagate(err, "An error occurred getting the services of enclave '%v'", enclaveIdentifier)
+	}
+
+	persistentKeys := getSortedPersistentKeys(services.GetServiceInfo())
+	if len(persistentKeys) > 0 && !canTransferPersistentDirectories(manager.kurtosisBackendType) {
+		return stacktrace.NewError("Enclave '%v' has persistent directories '%v', which can't be exported on Kubernetes", enclaveIdentifier, strings.Join(persistentKeys, "', '"))
+	}
+
+	manifest := &enclaveArchiveManifest{
+		FormatVersion:         EnclaveArchiveFormatVersion,
+		Name:                  enclaveInfo.Name,
+		Mode:                  enclaveInfo.Mode,
+		Labels:                maps.Clone(enclaveInfo.Labels),
+		FilesArtifacts:        []enclaveArchiveEntry{},
+		PersistentDirectories: []enclaveArchiveEntry{},
+		UnexportedItems:       []string{},
+	}
+	for _, serviceName := range getSortedServiceNames(services.GetServiceInfo()) {
+		if len(services.GetServiceInfo()[serviceName].GetServiceDirPathsToPersistentKeys()) == 0 {
+			manifest.UnexportedItems = append(manifest.UnexportedItems, fmt.Sprintf("service '%v': it has no persistent directory, so its runtime state isn't exported and it starts fresh", serviceName))
+		}
+	}
+
+	// Tar entries need their size upfront, so files artifacts and persistent directories are buffered on disk before
+	// writing the archive
+	exportDirpath, err := os.MkdirTemp("", enclaveExportDirPattern)
+	if err != nil {
+		return stacktrace.Propagate(err, "An error occurred creating a temporary directory to export the files artifacts and persistent directories of enclave '%v' to", enclaveIdentifier)
+	}
+	defer func() {
+		if err := os.RemoveAll(exportDirpath); err != nil {
+			logrus.Warnf("An error occurred removing temporary directory '%v'. Error was:\n%v", exportDirpath, err)
+		}
+	}()
+	for _, entriesDirpath := range []string{enclaveArchiveFilesArtifactsDirpath, enclaveArchivePersistentDirectoriesDirpath} {
+		if err := os.Mkdir(filepath.Join(exportDirpath, entriesDirpath), enclaveExportDirPerms); err != nil {
+			return stacktrace.Propagate(err, "An error occurred creating directory '%v' in temporary directory '%v'", entriesDirpath, exportDirpath)
+		}
+	}
+	for idx, persistentKey := range persistentKeys {
+		entryFilepath := fmt.Sprintf(enclaveArchivePersistentDirectoryFilepathFormat, idx)
+		if err := manager.exportPersistentDirectoryToFile(ctx, enclaveUuid, persistentKey, filepath.Join(exportDirpath, entryFilepath)); err != nil {
+			logrus.Warnf("An error occurred exporting persistent directory '%v' of enclave '%v', it won't be in the archive. Error was:\n%v", persistentKey, enclaveUuid, err)
+			manifest.UnexportedItems = append(manifest.UnexportedItems, fmt.Sprintf("persistent directory '%v': it couldn't be exported, services using it start with an empty directory", persistentKey))
+			continue
+		}
+		manifest.PersistentDirectories = append(manifest.PersistentDirectories, enclaveArchiveEntry{Name: persistentKey, Filepath: entryFilepath})
+	}
+	for idx, filesArtifact := range filesArtifacts.GetFileNamesAndUuids() {
+		entryFilepath := fmt.Sprintf(enclaveArchiveFilesArtifactFilepathFormat, idx)
+		if err := exportFilesArtifactToFile(ctx, apiContainerClient, filesArtifact.GetFileName(), filepath.Join(exportDirpath, entryFilepath)); err != nil {
+			return stacktrace.Propagate(err, "An error occurred downloading files artifact '%v' of enclave '%v'", filesArtifact.GetFileName(), enclaveIdentifier)
+		}
+		manifest.FilesArtifacts = append(manifest.FilesArtifacts, enclaveArchiveEntry{
+			Name:     filesArtifact.GetFileName(),
+			Filepath: entryFilepath,
+		})
+	}
+
+	gzipWriter := gzip.NewWriter(destination)
+	tarWriter := tar.NewWriter(gzipWriter)
+	if err := writeEnclaveArchiveManifest(tarWriter, manifest); err != nil {
+		return stacktrace.Propagate(err, "An error occurred writing the manifest of the archive of enclave '%v'", enclaveIdentifier)
+	}
+	if err := writeEnclaveArchiveEntry(tarWriter, enclaveArchivePlanFilepath, serializedPlan); err != nil {
+		return stacktrace.Propagate(err, "An error occurred writing the plan of enclave '%v' to the archive", enclaveIdentifier)
+	}
+	for _, filesArtifact := range manifest.FilesArtifacts {
+		if err := writeEnclaveArchiveFileEntry(tarWriter, filesArtifact.Filepath, filepath.Join(exportDirpath, filesArtifact.Filepath)); err != nil {
+			return stacktrace.Propagate(err, "An error occurred writing files artifact '%v' to the archive", filesArtifact.Name)
+		}
+	}
+	for _, persistentDirectory := range manifest.PersistentDirectories {
+		if err := writeEnclaveArchiveFileEntry(tarWriter, persistentDirectory.Filepath, filepath.Join(exportDirpath, persistentDirectory.Filepath)); err != nil {
+			return stacktrace.Propagate(err, "An error occurred writing persistent directory '%v' to the archive", persistentDirectory.Name)
+		}
+	}
+	if err := tarWriter.Close(); err != nil {
+		return stacktrace.Propagate(err, "An error occurred closing the archive of enclave '%v'", enclaveIdentifier)
+	}
+	if err := gzipWriter.Close(); err != nil {
+		return stacktrace.Propagate(err, "An error occurred closing the compressed archive of enclave '%v'", enclaveIdentifier)
+	}
+	return nil
+}
+
+// ImportEnclave creates a new enclave from an archive written by ExportEnclave. Besides the new enclave, it returns a
+// description of everything that couldn't be exported or imported.
+func (manager *EnclaveManager) ImportEnclave(
+	ctx context.Context,
+	// If blank, will use the default
+	engineVersion string,
+	// If blank, will use the name of the exported enclave
+	newEnclaveName string,
+	archive io.Reader,
+) (resultEnclaveInfo *types.EnclaveInfo, resultUnimportableItems []string, resultErr error) {
+	ctx, span := tracing.StartSpan(ctx, importEnclaveSpanName, attribute.String(enclaveNameSpanAttributeKey, newEnclaveName))
+	defer func() {
+		tracing.EndSpan(span, resultErr)
+	}()
+
+	gzipReader, err := gzip.NewReader(archive)
+	if err != nil {
+		return nil, nil, stacktrace.Propagate(err, "An error occurred decompressing the enclave archive")
+	}
+	defer gzipReader.Close()
+	tarReader := tar.NewReader(gzipReader)
+
+	manifest, err := readEnclaveArchiveManifest(tarReader)
+	if err != nil {
+		return nil, nil, stacktrace.Propagate(err, "An error occurred reading the manifest of the enclave archive")
+	}
+	if newEnclaveName == "" {
+		newEnclaveName = manifest.Name
+	}
+	// Checked before creating the enclave, so that it doesn't get imported without the content of its persistent directories
+	if len(manifest.PersistentDirectories) > 0 && !canTransferPersistentDirectories(manager.kurtosisBackendType) {
+		return nil, nil, stacktrace.NewError("The archive of enclave '%v' has persistent directories, which can't be imported on Kubernetes", manifest.Name)
+	}
+
+	importedEnclaveInfo, err := manager.CreateEnclave(
+		ctx,
+		engineVersion,
+		"",
+		defaultApiContainerLogLevel,
+		newEnclaveName,
+		manifest.Mode == types.EnclaveMode_PRODUCTION,
+		shouldImportedEnclaveApiContainerRunInDebugMode,
+		0,
+		0,
+		enclave.ExpiredEnclaveAction_Destroy,
+		manifest.Labels,
+		nil,
+	)
+	if err != nil {
+		return nil, nil, stacktrace.Propagate(err, "An error occurred creating enclave '%v' to import the archive into", newEnclaveName)
+	}
+	shouldDestroyImportedEnclave := true
+	defer func() {
+		if shouldDestroyImportedEnclave {
+			if err := manager.DestroyEnclave(context.Background(), importedEnclaveInfo.EnclaveUuid); err != nil {
+				logrus.Errorf("Importing enclave '%v' failed, but destroying the partially imported enclave '%v' failed too. Error was:\n%v", newEnclaveName, importedEnclaveInfo.EnclaveUuid, err)
+				logrus.Errorf("ACTION REQUIRED: You'll need to manually destroy enclave '%v'!!!!!!!", importedEnclaveInfo.EnclaveUuid)
+			}
+		}
+	}()
+
+	importedEnclaveUuid := enclave.EnclaveUUID(importedEnclaveInfo.EnclaveUuid)
+	apiContainerClient, closeClientFunc, err := manager.getApiContainerClient(ctx, importedEnclaveUuid)
+	if err != nil {
+		return nil, nil, stacktrace.Propagate(err, "An error occurred getting a client to the API container of imported enclave '%v'", importedEnclaveUuid)
+	}
+	if apiContainerClient == nil {
+		return nil, nil, stacktrace.NewError("Imported enclave '%v' has no API container", importedEnclaveUuid)
+	}
+	defer closeClientFunc()
+
+	filesArtifactNames := getEnclaveArchiveEntryNamesByFilepath(manifest.FilesArtifacts)
+	persistentKeys := getEnclaveArchiveEntryNamesByFilepath(manifest.PersistentDirectories)
+	unimportableItems := manifest.UnexportedItems
+	var enclavePlan *rpc_api.GetEnclavePlanResponse
+	for {
+		header, err := tarReader.Next()
+		if errors.Is(err, io.EOF) {
+			break
+		}
+		if err != nil {
+			return nil, nil, stacktrace.Propagate(err, "An error occurred reading the next entry of the enclave archive")
+		}
+
+		if header.Name == enclaveArchivePlanFilepath {
+			serializedPlan, err := io.ReadAll(tarReader)
+			if err != nil {
+				return nil, nil, stacktrace.Propagate(err, "An error occurred reading the enclave plan from the archive")
+			}
+			enclavePlan = &rpc_api.GetEnclavePlanResponse{} // nolint: exhaustruct
+			if err := protojson.Unmarshal(serializedPlan, enclavePlan); err != nil {
+				return nil, nil, stacktrace.Propagate(err, "An error occurred deserializing the enclave plan from the archive")
+			}
+		} else if filesArtifactName, found := filesArtifactNames[header.Name]; found {
+			if err := uploadFilesArtifact(ctx, apiContainerClient, filesArtifactName, tarReader, uint64(header.Size)); err != nil {
+				return nil, nil, stacktrace.Propagate(err, "An error occurred uploading files artifact '%v' to the imported enclave", filesArtifactName)
+			}
+			delete(filesArtifactNames, header.Name)
+		} else if persistentKey, found := persistentKeys[header.Name]; found {
+			if err := manager.kurtosisBackend.ImportEnclavePersistentDirectory(ctx, importedEnclaveUuid, service_directory.DirectoryPersistentKey(persistentKey), tarReader); err != nil {
+				logrus.Warnf("An error occurred importing persistent directory '%v' into enclave '%v', services using it will start with an empty directory. Error was:\n%v", persistentKey, importedEnclaveUuid, err)
+				unimportableItems = append(unimportableItems, fmt.Sprintf("persistent directory '%v': it couldn't be imported, services using it start with an empty directory", persistentKey))
+			}
+			delete(persistentKeys, header.Name)
+		} else {
+			return nil, nil, stacktrace.NewError("The enclave archive has an unexpected entry '%v' which isn't listed in its manifest", header.Name)
+		}
+	}
+	if enclavePlan == nil {
+		return nil, nil, stacktrace.NewError("The enclave archive has no '%v' entry", enclaveArchivePlanFilepath)
+	}
+	for _, filesArtifactName := range getSortedMapValues(filesArtifactNames) {
+		unimportableItems = append(unimportableItems, fmt.Sprintf("files artifact '%v': it's listed in the archive manifest but missing from the archive", filesArtifactName))
+	}
+	for _, persistentKey := range getSortedMapValues(persistentKeys) {
+		unimportableItems = append(unimportableItems, fmt.Sprintf("persistent directory '%v': it's listed in the archive manifest but missing from the archive", persistentKey))
+	}
+
+	replayScript, unreplayableInstructions := buildEnclavePlanReplayScript(enclavePlan.GetInstructions())
+	unimportableItems = append(unimportableItems, unreplayableInstructions...)
+	if replayScript != "" {
+		if err := runEnclavePlanReplayScript(ctx, apiContainerClient, replayScript); err != nil {
+			return nil, nil, stacktrace.Propagate(err, "An error occurred recreating the services of the archived enclave in enclave '%v'", importedEnclaveUuid)
+		}
+	}
+
+	importedEnclaves, err := manager.GetEnclavesByUuid(ctx, []enclave.EnclaveUUID{importedEnclaveUuid})
+	if err != nil {
+		return nil, nil, stacktrace.Propagate(err, "An error occurred getting imported enclave '%v'", importedEnclaveUuid)
+	}
+	if refreshedEnclaveInfo, found := importedEnclaves[string(importedEnclaveUuid)]; found {
+		importedEnclaveInfo = refreshedEnclaveInfo
+	}
+
+	shouldDestroyImportedEnclave = false
+	return importedEnclaveInfo, unimportableItems, nil
+}
+
+func (manager *EnclaveManager) exportPersistentDirectoryToFile(ctx context.Context, enclaveUuid enclave.EnclaveUUID, persistentKey string, destinationFilepath string) error {
+	destinationFile, err := os.Create(destinationFilepath)
+	if err != nil {
+		return stacktrace.Propagate(err, "An error occurred creating file '%v'", destinationFilepath)
+	}
+	defer destinationFile.Close()
+	if err := manager.kurtosisBackend.ExportEnclavePersistentDirectory(ctx, enclaveUuid, service_directory.DirectoryPersistentKey(persistentKey), destinationFile); err != nil {
+		return stacktrace.Propagate(err, "An error occurred exporting persistent directory '%v' to file '%v'", persistentKey, destinationFilepath)
+	}
+	return nil
+}
+
+func exportFilesArtifactToFile(ctx context.Context, apiContainerClient rpc_api.ApiContainerServiceClient, filesArtifactName string, destinationFilepath string) error {
+	content, err := downloadFilesArtifact(ctx, apiContainerClient, filesArtifactName)
+	if err != nil {
+		return stacktrace.Propagate(err, "An error occurred starting to download files artifact '%v'", filesArtifactName)
+	}
+	defer content.Close()
+	destinationFile, err := os.Create(destinationFilepath)
+	if err != nil {
+		return stacktrace.Propagate(err, "An error occurred creating file '%v'", destinationFilepath)
+	}
+	defer destinationFile.Close()
+	if _, err := io.Copy(destinationFile, content); err != nil {
+		return stacktrace.Propagate(err, "An error occurred downloading files artifact '%v' to file '%v'", filesArtifactName, destinationFilepath)
+	}
+	return nil
+}
+
+func writeEnclaveArchiveManifest(tarWriter *tar.Writer, manifest *enclaveArchiveManifest) error {
+	serializedManifest, err := json.Marshal(manifest)
+	if err != nil {
+		return stacktrace.Propagate(err, "An error occurred serializing the enclave archive manifest")
+	}
+	if err := writeEnclaveArchiveEntry(tarWriter, enclaveArchiveManifestFilepath, serializedManifest); err != nil {
+		return stacktrace.Propagate(err, "An error occurred writing the enclave archive manifest")
+	}
+	return nil
+}
+
+// readEnclaveArchiveManifest reads the manifest, which must be the first entry of the archive
+func readEnclaveArchiveManifest(tarReader *tar.Reader) (*enclaveArchiveManifest, error) {
+	header, err := tarReader.Next()
+	if err != nil {
+		return nil, stacktrace.Propagate(err, "An error occurred reading the first entry of the enclave archive")
+	}
+	if header.Name != enclaveArchiveManifestFilepath {
+		return nil, stacktrace.NewError("Expected the first entry of the enclave archive to be '%v' but was '%v'", enclaveArchiveManifestFilepath, header.Name)
+	}
+	serializedManifest, err := io.ReadAll(tarReader)
+	if err != nil {
+		return nil, stacktrace.Propagate(err, "An error occurred reading the enclave archive manifest")
+	}
+	manifest := &enclaveArchiveManifest{} // nolint: exhaustruct
+	if err := json.Unmarshal(serializedManifest, manifest); err != nil {
+		return nil, stacktrace.Propagate(err, "An error occurred deserializing the enclave archive manifest")
+	}
+	if manifest.FormatVersion != EnclaveArchiveFormatVersion {
+		return nil, stacktrace.NewError("The enclave archive has format version '%v' but this engine only supports version '%v'", manifest.FormatVersion, EnclaveArchiveFormatVersion)
+	}
+	return manifest, nil
+}
+
+func writeEnclaveArchiveEntry(tarWriter *tar.Writer, entryFilepath string, content []byte) error {
+	if err := tarWriter.WriteHeader(newEnclaveArchiveEntryHeader(entryFilepath, int64(len(content)))); err != nil {
+		return stacktrace.Propagate(err, "An error occurred writing the header of archive entry '%v'", entryFilepath)
+	}
+	if _, err := tarWriter.Write(content); err != nil {
+		return stacktrace.Propagate(err, "An error occurred writing archive entry '%v'", entryFilepath)
+	}
+	return nil
+}
+
+func writeEnclaveArchiveFileEntry(tarWriter *tar.Writer, entryFilepath string, srcFilepath string) error {
+	srcFile, err := os.Open(srcFilepath)
+	if err != nil {
+		return stacktrace.Propagate(err, "An error occurred opening file '%v'", srcFilepath)
+	}
+	defer srcFile.Close()
+	srcFileInfo, err := srcFile.Stat()
+	if err != nil {
+		return stacktrace.Propagate(err, "An error occurred getting the size of file '%v'", srcFilepath)
+	}
+	if err := tarWriter.WriteHeader(newEnclaveArchiveEntryHeader(entryFilepath, srcFileInfo.Size())); err != nil {
+		return stacktrace.Propagate(err, "An error occurred writing the header of archive entry '%v'", entryFilepath)
+	}
+	if _, err := io.Copy(tarWriter, srcFile); err != nil {
+		return stacktrace.Propagate(err, "An error occurred writing archive entry '%v'", entryFilepath)
+	}
+	return nil
+}
+
+func newEnclaveArchiveEntryHeader(entryFilepath string, size int64) *tar.Header {
+	return &tar.Header{ // nolint: exhaustruct
+		Typeflag: tar.TypeReg,
+		Name:     entryFilepath,
+		Size:     size,
+		Mode:     enclaveArchiveEntryFileMode,
+	}
+}
+
+// Persistent directories are persistent volume claims on Kubernetes, which the engine can't read nor write as it would
+// need a pod mounting them
+func canTransferPersistentDirectories(kurtosisBackendType args.KurtosisBackendType) bool {
+	return kurtosisBackendType != args.KurtosisBackendType_Kubernetes
+}
+
+func getSortedPersistentKeys(services map[string]*rpc_api.ServiceInfo) []string {
+	persistentKeySet := map[string]bool{}
+	for _, serviceInfo := range services {
+		for _, persistentKey := range serviceInfo.GetServiceDirPathsToPersistentKeys() {
+			persistentKeySet[persistentKey] = true
+		}
+	}
+	persistentKeys := []string{}
+	for persistentKey := range persistentKeySet {
+		persistentKeys = append(persistentKeys, persistentKey)
+	}
+	sort.Strings(persistentKeys)
+	return persistentKeys
+}
+
+func getEnclaveArchiveEntryNamesByFilepath(entries []enclaveArchiveEntry) map[string]string {
+	namesByFilepath := map[string]string{}
+	for _, entry := range entries {
+		namesByFilepath[entry.Filepath] = entry.Name
+	}
+	return namesByFilepath
+}
+
+func getSortedMapValues(values map[string]string) []string {
+	result := []string{}
+	for _, value := range values {
+		result = append(result, value)
+	}
+	sort.Strings(result)
+	return result
+}
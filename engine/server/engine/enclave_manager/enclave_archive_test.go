@@ -0,0 +1,59 @@
+package enclave_manager
+
+import (
+	"archive/tar"
+	"bytes"
+	"testing"
+
+	"github.com/kurtosis-tech/kurtosis/engine/server/engine/types"
+	"github.com/stretchr/testify/require"
+)
+
+func TestEnclaveArchiveManifest_RoundTrip(t *testing.T) {
+	manifest := &enclaveArchiveManifest{
+		FormatVersion:         EnclaveArchiveFormatVersion,
+		Name:                  "testnet",
+		Mode:                  types.EnclaveMode_PRODUCTION,
+		Labels:                map[string]string{"team": "infra"},
+		FilesArtifacts:        []enclaveArchiveEntry{{Name: "genesis", Filepath: "files_artifacts/0.tgz"}},
+		PersistentDirectories: []enclaveArchiveEntry{{Name: "geth-data", Filepath: "persistent_directories/0.tar"}},
+		UnexportedItems:       []string{"service 'beacon': it has no persistent directory"},
+	}
+
+	archive := &bytes.Buffer{}
+	tarWriter := tar.NewWriter(archive)
+	require.NoError(t, writeEnclaveArchiveManifest(tarWriter, manifest))
+	require.NoError(t, writeEnclaveArchiveEntry(tarWriter, enclaveArchivePlanFilepath, []byte("{}")))
+	require.NoError(t, tarWriter.Close())
+
+	tarReader := tar.NewReader(archive)
+	readManifest, err := readEnclaveArchiveManifest(tarReader)
+	require.NoError(t, err)
+	require.Equal(t, manifest, readManifest)
+
+	header, err := tarReader.Next()
+	require.NoError(t, err)
+	require.Equal(t, enclaveArchivePlanFilepath, header.Name)
+}
+
+func TestReadEnclaveArchiveManifest_UnsupportedFormatVersion(t *testing.T) {
+	archive := &bytes.Buffer{}
+	tarWriter := tar.NewWriter(archive)
+	require.NoError(t, writeEnclaveArchiveManifest(tarWriter, &enclaveArchiveManifest{ // nolint: exhaustruct
+		FormatVersion: EnclaveArchiveFormatVersion + 1,
+	}))
+	require.NoError(t, tarWriter.Close())
+
+	_, err := readEnclaveArchiveManifest(tar.NewReader(archive))
+	require.Error(t, err)
+}
+
+func TestReadEnclaveArchiveManifest_ManifestNotFirst(t *testing.T) {
+	archive := &bytes.Buffer{}
+	tarWriter := tar.NewWriter(archive)
+	require.NoError(t, writeEnclaveArchiveEntry(tarWriter, enclaveArchivePlanFilepath, []byte("{}")))
+	require.NoError(t, tarWriter.Close())
+
+	_, err := readEnclaveArchiveManifest(tar.NewReader(archive))
+	require.Error(t, err)
+}
@@ -0,0 +1,91 @@
+package enclave_manager
+
+import (
+	"context"
+	"time"
+
+	"github.com/kurtosis-tech/kurtosis/container-engine-lib/lib/backend_interface/objects/enclave"
+	"github.com/kurtosis-tech/kurtosis/engine/server/engine/audit_log"
+	"github.com/kurtosis-tech/kurtosis/engine/server/engine/types"
+	"github.com/kurtosis-tech/stacktrace"
+	"github.com/sirupsen/logrus"
+	"google.golang.org/protobuf/types/known/emptypb"
+)
+
+const (
+	getApiContainerAuditLogTimeout = 10 * time.Second
+)
+
+// GetApiContainerAuditLogEntries returns the entries of the audit logs of the API containers, including the ones of the
+// enclaves that are stopped or destroyed. The entries of the running API containers are archived first, the ones that
+// can't be reached being skipped as the rest of the audit log is still worth returning
+func (manager *EnclaveManager) GetApiContainerAuditLogEntries(ctx context.Context) ([]*audit_log.Entry, error) {
+	enclaves, err := manager.GetAllEnclaves(ctx)
+	if err != nil {
+		return nil, stacktrace.Propagate(err, "An error occurred getting the enclaves to get the audit logs of their API containers")
+	}
+	for _, enclaveInfo := range enclaves {
+		manager.archiveApiContainerAuditLog(ctx, enclaveInfo)
+	}
+	return manager.apiContainerAuditLogArchive.GetEntries(), nil
+}
+
+// The API container audit log is in the enclave, so it's archived before the enclave gets stopped or destroyed
+func (manager *EnclaveManager) archiveApiContainerAuditLogsWithoutMutex(ctx context.Context, enclaveUuids []enclave.EnclaveUUID) {
+	enclaves, err := manager.getEnclavesByUuidWithoutMutex(ctx, enclaveUuids)
+	if err != nil {
+		logrus.Warnf("The audit logs of the API containers of enclaves '%v' couldn't be archived as the enclaves couldn't be retrieved. Error was:\n%v", enclaveUuids, err)
+		return
+	}
+	for _, enclaveInfo := range enclaves {
+		manager.archiveApiContainerAuditLog(ctx, enclaveInfo)
+	}
+}
+
+// Failing to archive the entries doesn't fail the caller, it's only logged
+func (manager *EnclaveManager) archiveApiContainerAuditLog(ctx context.Context, enclaveInfo *types.EnclaveInfo) {
+	if enclaveInfo.ApiContainerStatus != types.ContainerStatus_RUNNING {
+		return
+	}
+	entries, err := manager.getApiContainerAuditLogEntries(ctx, enclaveInfo)
+	if err != nil {
+		logrus.Warnf("The audit log of the API container of enclave '%v' couldn't be retrieved to be archived. Error was:\n%v", enclaveInfo.Name, err)
+		return
+	}
+	if err := manager.apiContainerAuditLogArchive.Archive(enclaveInfo.EnclaveUuid, entries); err != nil {
+		logrus.Warnf("The audit log of the API container of enclave '%v' couldn't be archived. Error was:\n%v", enclaveInfo.Name, err)
+	}
+}
+
+func (manager *EnclaveManager) getApiContainerAuditLogEntries(ctx context.Context, enclaveInfo *types.EnclaveInfo) ([]*audit_log.Entry, error) {
+	ctxWithTimeout, cancelCtxFunc := context.WithTimeout(ctx, getApiContainerAuditLogTimeout)
+	defer cancelCtxFunc()
+
+	apiContainerClient, closeClientFunc, err := manager.getApiContainerClient(ctxWithTimeout, enclave.EnclaveUUID(enclaveInfo.EnclaveUuid))
+	if err != nil {
+		return nil, stacktrace.Propagate(err, "An error occurred getting a client to the API container of enclave '%v'", enclaveInfo.Name)
+	}
+	if apiContainerClient == nil {
+		return nil, nil
+	}
+	defer closeClientFunc()
+
+	response, err := apiContainerClient.GetAuditLog(ctxWithTimeout, &emptypb.Empty{})
+	if err != nil {
+		return nil, stacktrace.Propagate(err, "An error occurred getting the audit log of the API container of enclave '%v'", enclaveInfo.Name)
+	}
+	entries := []*audit_log.Entry{}
+	for _, apiContainerEntry := range response.GetEntries() {
+		entries = append(entries, &audit_log.Entry{
+			SequenceNumber: apiContainerEntry.GetSequenceNumber(),
+			Timestamp:      apiContainerEntry.GetTimestamp().AsTime(),
+			Caller:         apiContainerEntry.GetCaller(),
+			Action:         apiContainerEntry.GetAction(),
+			EnclaveUuid:    enclaveInfo.EnclaveUuid,
+			EnclaveName:    enclaveInfo.Name,
+			Arguments:      apiContainerEntry.GetArguments(),
+			Error:          apiContainerEntry.GetError(),
+		})
+	}
+	return entries, nil
+}
@@ -0,0 +1,337 @@
+package enclave_manager
+
+import (
+	"context"
+	"fmt"
+	"io"
+	"maps"
+	"regexp"
+	"sort"
+	"strconv"
+	"strings"
+
+	rpc_api "github.com/kurtosis-tech/kurtosis/api/golang/core/kurtosis_core_rpc_api_bindings"
+	"github.com/kurtosis-tech/kurtosis/container-engine-lib/lib/backend_interface/objects/enclave"
+	"github.com/kurtosis-tech/kurtosis/container-engine-lib/lib/tracing"
+	"github.com/kurtosis-tech/kurtosis/engine/server/engine/types"
+	"github.com/kurtosis-tech/kurtosis/grpc-file-transfer/golang/grpc_file_streaming"
+	"github.com/kurtosis-tech/stacktrace"
+	"github.com/sirupsen/logrus"
+	"go.opentelemetry.io/otel/attribute"
+	"google.golang.org/protobuf/types/known/emptypb"
+)
+
+const (
+	cloneEnclaveSpanName = "clone_enclave"
+
+	replayScriptMainFunctionName = "run"
+	replayScriptHeader           = "def " + replayScriptMainFunctionName + "(plan):\n"
+	replayScriptInstructionLine  = "    plan.%v\n"
+	replayScriptParams           = "{}"
+
+	shouldCloneEnclaveApiContainerRunInDebugMode = false
+
+	// Files artifacts are copied as they get downloaded, before their size is known
+	unknownFilesArtifactSize = 0
+)
+
+var (
+	// Runtime values only exist in the source enclave, an instruction using one can't be replayed as is
+	runtimeValuePlaceholderRegex = regexp.MustCompile(`\{\{kurtosis:[a-f0-9]{32}:[a-zA-Z0-9-_.]+\.runtime_value\}\}`)
+
+	// Secrets are never persisted by the API container, so the source enclave can't hand them to the clone
+	secretPlaceholderRegex = regexp.MustCompile(`\{\{kurtosis:secret:[a-zA-Z0-9_\-.]+\}\}`)
+)
+
+// CloneEnclave creates a new enclave with the files artifacts, persistent directories and services of an existing one.
+// Besides the new enclave, it returns a description of everything that couldn't be carried over to the clone, and of
+// what may differ from the existing enclave.
+func (manager *EnclaveManager) CloneEnclave(
+	ctx context.Context,
+	// If blank, will use the default
+	engineVersion string,
+	enclaveIdentifier string,
+	// If blank, will use a random one
+	newEnclaveName string,
+	// If false, the enclave doesn't get cloned when some instructions of its plan can't be replayed
+	allowPartial bool,
+) (resultEnclaveInfo *types.EnclaveInfo, resultUncloneableItems []string, resultWarnings []string, resultErr error) {
+	ctx, span := tracing.StartSpan(ctx, cloneEnclaveSpanName,
+		attribute.String(enclaveIdentifierSpanAttributeKey, enclaveIdentifier),
+		attribute.String(enclaveNameSpanAttributeKey, newEnclaveName),
+	)
+	defer func() {
+		tracing.EndSpan(span, resultErr)
+	}()
+
+	sourceEnclaveUuid, err := manager.GetEnclaveUuidForEnclaveIdentifier(ctx, enclaveIdentifier)
+	if err != nil {
+		return nil, nil, nil, stacktrace.Propagate(err, "An error occurred getting the UUID of enclave '%v'", enclaveIdentifier)
+	}
+	sourceEnclaves, err := manager.GetEnclavesByUuid(ctx, []enclave.EnclaveUUID{sourceEnclaveUuid})
+	if err != nil {
+		return nil, nil, nil, stacktrace.Propagate(err, "An error occurred getting enclave '%v'", sourceEnclaveUuid)
+	}
+	sourceEnclaveInfo, found := sourceEnclaves[string(sourceEnclaveUuid)]
+	if !found {
+		return nil, nil, nil, stacktrace.NewError("Enclave '%v' wasn't found", sourceEnclaveUuid)
+	}
+
+	sourceApiContainerClient, closeSourceClientFunc, err := manager.getApiContainerClient(ctx, sourceEnclaveUuid)
+	if err != nil {
+		return nil, nil, nil, stacktrace.Propagate(err, "An error occurred getting a client to the API container of enclave '%v'", enclaveIdentifier)
+	}
+	if sourceApiContainerClient == nil {
+		return nil, nil, nil, stacktrace.NewError("Enclave '%v' has no running API container; only running enclaves can be cloned", enclaveIdentifier)
+	}
+	defer closeSourceClientFunc()
+
+	// Everything needed from the source enclave is read before creating the clone, so that a source enclave that can't
+	// be cloned doesn't leave a half-created enclave behind
+	enclavePlan, err := sourceApiContainerClient.GetEnclavePlan(ctx, &emptypb.Empty{})
+	if err != nil {
+		return nil, nil, nil, stacktrace.Propagate(err, "An error occurred getting the plan of enclave '%v'", enclaveIdentifier)
+	}
+	filesArtifacts, err := sourceApiContainerClient.ListFilesArtifactNamesAndUuids(ctx, &emptypb.Empty{})
+	if err != nil {
+		return nil, nil, nil, stacktrace.Propagate(err, "An error occurred listing the files artifacts of enclave '%v'", enclaveIdentifier)
+	}
+	services, err := sourceApiContainerClient.GetServices(ctx, &rpc_api.GetServicesArgs{ServiceIdentifiers: map[string]bool{}})
+	if err != nil {
+		return nil, nil, nil, stacktrace.Propagate(err, "An error occurred getting the services of enclave '%v'", enclaveIdentifier)
+	}
+
+	replayScript, unreplayableInstructions := buildEnclavePlanReplayScript(enclavePlan.GetInstructions())
+	if len(unreplayableInstructions) > 0 && !allowPartial {
+		return nil, nil, nil, stacktrace.NewError("Enclave '%v' can't be fully cloned as some instructions of its plan can't be replayed, allow a partial clone to clone it without them and the services they'd create:\n%v", enclaveIdentifier, strings.Join(unreplayableInstructions, "\n"))
+	}
+	persistentKeys := getSortedPersistentKeys(services.GetServiceInfo())
+	if len(persistentKeys) > 0 && !canTransferPersistentDirectories(manager.kurtosisBackendType) {
+		return nil, nil, nil, stacktrace.NewError("Enclave '%v' has persistent directories '%v', which can't be copied on Kubernetes", enclaveIdentifier, strings.Join(persistentKeys, "', '"))
+	}
+
+	clonedEnclaveInfo, err := manager.CreateEnclave(
+		ctx,
+		engineVersion,
+		"",
+		defaultApiContainerLogLevel,
+		newEnclaveName,
+		sourceEnclaveInfo.Mode == types.EnclaveMode_PRODUCTION,
+		shouldCloneEnclaveApiContainerRunInDebugMode,
+		0,
+		0,
+		enclave.ExpiredEnclaveAction_Destroy,
+		maps.Clone(sourceEnclaveInfo.Labels),
+		nil,
+	)
+	if err != nil {
+		return nil, nil, nil, stacktrace.Propagate(err, "An error occurred creating the enclave to clone enclave '%v' into", enclaveIdentifier)
+	}
+	shouldDestroyClonedEnclave := true
+	defer func() {
+		if shouldDestroyClonedEnclave {
+			if err := manager.DestroyEnclave(context.Background(), clonedEnclaveInfo.EnclaveUuid); err != nil {
+				logrus.Errorf("Cloning enclave '%v' failed, but destroying the partially cloned enclave '%v' failed too. Error was:\n%v", enclaveIdentifier, clonedEnclaveInfo.EnclaveUuid, err)
+				logrus.Errorf("ACTION REQUIRED: You'll need to manually destroy enclave '%v'!!!!!!!", clonedEnclaveInfo.EnclaveUuid)
+			}
+		}
+	}()
+
+	clonedEnclaveUuid := enclave.EnclaveUUID(clonedEnclaveInfo.EnclaveUuid)
+	clonedApiContainerClient, closeClonedClientFunc, err := manager.getApiContainerClient(ctx, clonedEnclaveUuid)
+	if err != nil {
+		return nil, nil, nil, stacktrace.Propagate(err, "An error occurred getting a client to the API container of cloned enclave '%v'", clonedEnclaveUuid)
+	}
+	if clonedApiContainerClient == nil {
+		return nil, nil, nil, stacktrace.NewError("Cloned enclave '%v' has no API container", clonedEnclaveUuid)
+	}
+	defer closeClonedClientFunc()
+
+	for _, filesArtifact := range filesArtifacts.GetFileNamesAndUuids() {
+		if err := copyFilesArtifact(ctx, sourceApiContainerClient, clonedApiContainerClient, filesArtifact.GetFileName()); err != nil {
+			return nil, nil, nil, stacktrace.Propagate(err, "An error occurred copying files artifact '%v' to the cloned enclave", filesArtifact.GetFileName())
+		}
+	}
+
+	uncloneableItems := []string{}
+	warnings := []string{}
+	if canTransferPersistentDirectories(manager.kurtosisBackendType) {
+		// The services of the source enclave keep running, as stopping them would disrupt whoever uses it
+		if _, err := manager.kurtosisBackend.CopyEnclavePersistentDirectories(ctx, sourceEnclaveUuid, clonedEnclaveUuid); err != nil {
+			logrus.Warnf("An error occurred copying the persistent directories of enclave '%v' to cloned enclave '%v', the services will start with empty persistent directories. Error was:\n%v", sourceEnclaveUuid, clonedEnclaveUuid, err)
+			uncloneableItems = append(uncloneableItems, "persistent directories: they couldn't be copied, services of the clone start with empty persistent directories")
+		} else if runningServiceNames := getRunningServiceNamesWithPersistentDirectories(services.GetServiceInfo()); len(runningServiceNames) > 0 {
+			warnings = append(warnings, fmt.Sprintf("persistent directories: they were copied while services '%v' kept running, so they may hold writes these services were in the middle of", strings.Join(runningServiceNames, "', '")))
+		}
+	}
+
+	uncloneableItems = append(uncloneableItems, unreplayableInstructions...)
+	if replayScript != "" {
+		if err := runEnclavePlanReplayScript(ctx, clonedApiContainerClient, replayScript); err != nil {
+			return nil, nil, nil, stacktrace.Propagate(err, "An error occurred recreating the services of enclave '%v' in the cloned enclave", enclaveIdentifier)
+		}
+	}
+
+	for _, serviceName := range getSortedServiceNames(services.GetServiceInfo()) {
+		if len(services.GetServiceInfo()[serviceName].GetServiceDirPathsToPersistentKeys()) == 0 {
+			uncloneableItems = append(uncloneableItems, fmt.Sprintf("service '%v': it has no persistent directory, so its runtime state isn't cloned and it starts fresh", serviceName))
+		}
+	}
+
+	clonedEnclaves, err := manager.GetEnclavesByUuid(ctx, []enclave.EnclaveUUID{clonedEnclaveUuid})
+	if err != nil {
+		return nil, nil, nil, stacktrace.Propagate(err, "An error occurred getting cloned enclave '%v'", clonedEnclaveUuid)
+	}
+	if refreshedEnclaveInfo, found := clonedEnclaves[string(clonedEnclaveUuid)]; found {
+		clonedEnclaveInfo = refreshedEnclaveInfo
+	}
+
+	shouldDestroyClonedEnclave = false
+	return clonedEnclaveInfo, uncloneableItems, warnings, nil
+}
+
+// buildEnclavePlanReplayScript turns the plan of an enclave into a script recreating its services. Instructions which
+// can't be replayed in another enclave are left out, along with the ones depending on the services they'd create, and
+// are returned as descriptions of what couldn't be cloned. The script is empty if there is nothing to replay.
+func buildEnclavePlanReplayScript(instructions []*rpc_api.EnclavePlanInstruction) (string, []string) {
+	unreplayableInstructions := []string{}
+	skippedServiceNames := map[string]bool{}
+	replayedInstructionLines := []string{}
+	for _, instruction := range instructions {
+		// The files artifacts created by the instruction are copied to the clone by name, producing them again would clash
+		if len(instruction.GetFilesArtifactNames()) > 0 {
+			continue
+		}
+
+		reason := ""
+		switch {
+		case runtimeValuePlaceholderRegex.MatchString(instruction.GetStarlarkCode()):
+			reason = "it uses values computed at runtime in the source enclave"
+		case secretPlaceholderRegex.MatchString(instruction.GetStarlarkCode()):
+			reason = "it uses secrets, which aren't persisted"
+		default:
+			if serviceName, found := getSkippedServiceUsedByInstruction(instruction, skippedServiceNames); found {
+				reason = fmt.Sprintf("it depends on service '%v' which couldn't be cloned", serviceName)
+			}
+		}
+		if reason != "" {
+			for _, serviceName := range instruction.GetServiceNames() {
+				skippedServiceNames[serviceName] = true
+			}
+			unreplayableInstructions = append(unreplayableInstructions, fmt.Sprintf("instruction '%v' (%v): %v", instruction.GetType(), instruction.GetUuid(), reason))
+			continue
+		}
+		replayedInstructionLines = append(replayedInstructionLines, fmt.Sprintf(replayScriptInstructionLine, instruction.GetStarlarkCode()))
+	}
+
+	if len(replayedInstructionLines) == 0 {
+		return "", unreplayableInstructions
+	}
+	return replayScriptHeader + strings.Join(replayedInstructionLines, ""), unreplayableInstructions
+}
+
+func getSkippedServiceUsedByInstruction(instruction *rpc_api.EnclavePlanInstruction, skippedServiceNames map[string]bool) (string, bool) {
+	for _, serviceName := range instruction.GetServiceNames() {
+		if skippedServiceNames[serviceName] {
+			return serviceName, true
+		}
+	}
+	for serviceName := range skippedServiceNames {
+		if strings.Contains(instruction.GetStarlarkCode(), strconv.Quote(serviceName)) {
+			return serviceName, true
+		}
+	}
+	return "", false
+}
+
+func runEnclavePlanReplayScript(ctx context.Context, apiContainerClient rpc_api.ApiContainerServiceClient, replayScript string) error {
+	mainFunctionName := replayScriptMainFunctionName
+	serializedParams := replayScriptParams
+	runStarlarkScriptArgs := &rpc_api.RunStarlarkScriptArgs{ // nolint: exhaustruct
+		SerializedScript: replayScript,
+		SerializedParams: &serializedParams,
+		MainFunctionName: &mainFunctionName,
+	}
+	stream, err := apiContainerClient.RunStarlarkScript(ctx, runStarlarkScriptArgs)
+	if err != nil {
+		return stacktrace.Propagate(err, "An error occurred starting to run the script replaying the enclave plan")
+	}
+	if err := waitForStarlarkRunToSucceed(stream); err != nil {
+		return stacktrace.Propagate(err, "The script replaying the enclave plan didn't run successfully")
+	}
+	return nil
+}
+
+func copyFilesArtifact(ctx context.Context, sourceApiContainerClient rpc_api.ApiContainerServiceClient, destinationApiContainerClient rpc_api.ApiContainerServiceClient, filesArtifactName string) error {
+	content, err := downloadFilesArtifact(ctx, sourceApiContainerClient, filesArtifactName)
+	if err != nil {
+		return stacktrace.Propagate(err, "An error occurred downloading files artifact '%v'", filesArtifactName)
+	}
+	defer content.Close()
+	if err := uploadFilesArtifact(ctx, destinationApiContainerClient, filesArtifactName, content, unknownFilesArtifactSize); err != nil {
+		return stacktrace.Propagate(err, "An error occurred uploading files artifact '%v'", filesArtifactName)
+	}
+	return nil
+}
+
+// Returns the files artifact compressed, the way the API container stores it, as it gets downloaded. The reader
+// returns the error of the download if it fails, and must be closed
+func downloadFilesArtifact(ctx context.Context, apiContainerClient rpc_api.ApiContainerServiceClient, filesArtifactName string) (io.ReadCloser, error) {
+	downloadStream, err := apiContainerClient.DownloadFilesArtifact(ctx, &rpc_api.DownloadFilesArtifactArgs{Identifier: filesArtifactName})
+	if err != nil {
+		return nil, stacktrace.Propagate(err, "An error occurred starting to download files artifact '%v'", filesArtifactName)
+	}
+	content := grpc_file_streaming.NewClientStream[rpc_api.StreamedDataChunk, []byte](downloadStream).PipeReader(
+		filesArtifactName,
+		func(dataChunk *rpc_api.StreamedDataChunk) ([]byte, string, error) {
+			return dataChunk.Data, dataChunk.PreviousChunkHash, nil
+		},
+	)
+	return content, nil
+}
+
+// The size of the content is only used to report the progress of the upload
+func uploadFilesArtifact(ctx context.Context, apiContainerClient rpc_api.ApiContainerServiceClient, filesArtifactName string, content io.Reader, contentSize uint64) error {
+	uploadStream, err := apiContainerClient.UploadFilesArtifact(ctx)
+	if err != nil {
+		return stacktrace.Propagate(err, "An error occurred starting to upload files artifact '%v'", filesArtifactName)
+	}
+	_, err = grpc_file_streaming.NewClientStream[rpc_api.StreamedDataChunk, rpc_api.UploadFilesArtifactResponse](uploadStream).SendData(
+		filesArtifactName,
+		content,
+		contentSize,
+		func(previousChunkHash string, contentChunk []byte) (*rpc_api.StreamedDataChunk, error) {
+			return &rpc_api.StreamedDataChunk{
+				Data:              contentChunk,
+				PreviousChunkHash: previousChunkHash,
+				Metadata: &rpc_api.DataChunkMetadata{
+					Name: filesArtifactName,
+				},
+			}, nil
+		},
+	)
+	if err != nil {
+		return stacktrace.Propagate(err, "An error occurred uploading files artifact '%v'", filesArtifactName)
+	}
+	return nil
+}
+
+func getRunningServiceNamesWithPersistentDirectories(serviceInfos map[string]*rpc_api.ServiceInfo) []string {
+	runningServiceNames := []string{}
+	for _, serviceName := range getSortedServiceNames(serviceInfos) {
+		serviceInfo := serviceInfos[serviceName]
+		if serviceInfo.GetServiceStatus() == rpc_api.ServiceStatus_RUNNING && len(serviceInfo.GetServiceDirPathsToPersistentKeys()) > 0 {
+			runningServiceNames = append(runningServiceNames, serviceName)
+		}
+	}
+	return runningServiceNames
+}
+
+func getSortedServiceNames(serviceInfos map[string]*rpc_api.ServiceInfo) []string {
+	serviceNames := make([]string, 0, len(serviceInfos))
+	for serviceName := range serviceInfos {
+		serviceNames = append(serviceNames, serviceName)
+	}
+	sort.Strings(serviceNames)
+	return serviceNames
+}
@@ -0,0 +1,70 @@
+package enclave_manager
+
+import (
+	"testing"
+
+	rpc_api "github.com/kurtosis-tech/kurtosis/api/golang/core/kurtosis_core_rpc_api_bindings"
+	"github.com/stretchr/testify/require"
+)
+
+func TestBuildEnclavePlanReplayScript(t *testing.T) {
+	instructions := []*rpc_api.EnclavePlanInstruction{
+		newTestEnclavePlanInstruction("1", "upload_files", `upload_files(src="./genesis", name="genesis")`, nil, []string{"genesis"}),
+		newTestEnclavePlanInstruction("2", "add_service", `add_service(name="geth", config=ServiceConfig(image="geth", files={"/genesis": "genesis"}))`, []string{"geth"}, nil),
+		newTestEnclavePlanInstruction("3", "add_service", `add_service(name="beacon", config=ServiceConfig(image="lighthouse", env_vars={"TOKEN": "{{kurtosis:secret:token}}"}))`, []string{"beacon"}, nil),
+		newTestEnclavePlanInstruction("4", "exec", `exec(service_name="beacon", recipe=ExecRecipe(command=["ls"]))`, nil, nil),
+		newTestEnclavePlanInstruction("5", "add_service", `add_service(name="validator", config=ServiceConfig(image="lighthouse", cmd=["{{kurtosis:4cb1c0b6a7b44d0da1f0b3e8b04d7e2e:code.runtime_value}}"]))`, []string{"validator"}, nil),
+		newTestEnclavePlanInstruction("6", "remove_service", `remove_service(name="geth")`, []string{"geth"}, nil),
+	}
+
+	script, unreplayableInstructions := buildEnclavePlanReplayScript(instructions)
+
+	expectedScript := `def run(plan):
+    plan.add_service(name="geth", config=ServiceConfig(image="geth", files={"/genesis": "genesis"}))
+    plan.remove_service(name="geth")
+`
+	require.Equal(t, expectedScript, script)
+	require.Equal(t, []string{
+		"instruction 'add_service' (3): it uses secrets, which aren't persisted",
+		"instruction 'exec' (4): it depends on service 'beacon' which couldn't be cloned",
+		"instruction 'add_service' (5): it uses values computed at runtime in the source enclave",
+	}, unreplayableInstructions)
+}
+
+func TestBuildEnclavePlanReplayScript_NothingToReplay(t *testing.T) {
+	instructions := []*rpc_api.EnclavePlanInstruction{
+		newTestEnclavePlanInstruction("1", "upload_files", `upload_files(src="./genesis", name="genesis")`, nil, []string{"genesis"}),
+	}
+
+	script, unreplayableInstructions := buildEnclavePlanReplayScript(instructions)
+	require.Empty(t, script)
+	require.Empty(t, unreplayableInstructions)
+}
+
+func newTestEnclavePlanInstruction(uuid string, instructionType string, starlarkCode string, serviceNames []string, filesArtifactNames []string) *rpc_api.EnclavePlanInstruction {
+	return &rpc_api.EnclavePlanInstruction{ // nolint: exhaustruct
+		Uuid:               uuid,
+		Type:               instructionType,
+		StarlarkCode:       starlarkCode,
+		ServiceNames:       serviceNames,
+		FilesArtifactNames: filesArtifactNames,
+	}
+}
+
+func TestGetRunningServiceNamesWithPersistentDirectories(t *testing.T) {
+	serviceInfos := map[string]*rpc_api.ServiceInfo{
+		"geth":      newTestServiceInfo(rpc_api.ServiceStatus_RUNNING, map[string]string{"/data": "geth-data"}),
+		"beacon":    newTestServiceInfo(rpc_api.ServiceStatus_RUNNING, map[string]string{}),
+		"validator": newTestServiceInfo(rpc_api.ServiceStatus_STOPPED, map[string]string{"/data": "validator-data"}),
+		"reth":      newTestServiceInfo(rpc_api.ServiceStatus_RUNNING, map[string]string{"/data": "reth-data"}),
+	}
+
+	require.Equal(t, []string{"geth", "reth"}, getRunningServiceNamesWithPersistentDirectories(serviceInfos))
+}
+
+func newTestServiceInfo(serviceStatus rpc_api.ServiceStatus, serviceDirPathsToPersistentKeys map[string]string) *rpc_api.ServiceInfo {
+	return &rpc_api.ServiceInfo{ // nolint: exhaustruct
+		ServiceStatus:                   serviceStatus,
+		ServiceDirPathsToPersistentKeys: serviceDirPathsToPersistentKeys,
+	}
+}
@@ -10,6 +10,7 @@ import (
 	"github.com/kurtosis-tech/kurtosis/container-engine-lib/lib/uuid_generator"
 	"github.com/kurtosis-tech/kurtosis/core/launcher/api_container_launcher"
 	"github.com/kurtosis-tech/kurtosis/engine/launcher/args"
+	"github.com/kurtosis-tech/kurtosis/engine/server/engine/audit_log"
 	"github.com/kurtosis-tech/kurtosis/engine/server/engine/types"
 	"github.com/kurtosis-tech/kurtosis/metrics-library/golang/lib/metrics_client"
 	"github.com/kurtosis-tech/stacktrace"
@@ -24,16 +25,26 @@ const (
 type EnclaveCreator struct {
 	kurtosisBackend                           backend_interface.KurtosisBackend
 	apiContainerKurtosisBackendConfigSupplier api_container_launcher.KurtosisBackendConfigSupplier
+
+	// Passed to every API container so that their traces end up in the same place as the engine ones
+	otlpEndpoint string
+
+	// Every API container gets the key of its enclave, to tell the callers the engine forwards from the others
+	callerKey *audit_log.CallerKey
 }
 
 func newEnclaveCreator(
 	kurtosisBackend backend_interface.KurtosisBackend,
 	apiContainerKurtosisBackendConfigSupplier api_container_launcher.KurtosisBackendConfigSupplier,
+	otlpEndpoint string,
+	callerKey *audit_log.CallerKey,
 ) *EnclaveCreator {
 
 	return &EnclaveCreator{
 		kurtosisBackend: kurtosisBackend,
 		apiContainerKurtosisBackendConfigSupplier: apiContainerKurtosisBackendConfigSupplier,
+		otlpEndpoint: otlpEndpoint,
+		callerKey:    callerKey,
 	}
 }
 
@@ -223,7 +234,9 @@ func (creator *EnclaveCreator) LaunchApiContainer(
 			isCI,
 			cloudUserID,
 			cloudInstanceID,
-			shouldStartInDebugMode)
+			shouldStartInDebugMode,
+			creator.otlpEndpoint,
+			creator.callerKey.GetEnclaveCallerKey(string(enclaveUuid)))
 		if err != nil {
 			return nil, stacktrace.Propagate(err, "Expected to be able to launch api container for enclave '%v' with custom version '%v', but an error occurred", enclaveUuid, apiContainerImageVersionTag)
 		}
@@ -243,6 +256,8 @@ func (creator *EnclaveCreator) LaunchApiContainer(
 		cloudUserID,
 		cloudInstanceID,
 		shouldStartInDebugMode,
+		creator.otlpEndpoint,
+		creator.callerKey.GetEnclaveCallerKey(string(enclaveUuid)),
 	)
 	if err != nil {
 		return nil, stacktrace.Propagate(err, "Expected to be able to launch api container for enclave '%v' with the default version, but an error occurred", enclaveUuid)
@@ -0,0 +1,28 @@
+package enclave_manager
+
+import "maps"
+
+// An enclave matches a label selector when it has every label of the selector with the same value
+// An empty selector matches every enclave
+func doEnclaveLabelsMatchSelector(enclaveLabels map[string]string, labelSelector map[string]string) bool {
+	for selectorKey, selectorValue := range labelSelector {
+		enclaveLabelValue, found := enclaveLabels[selectorKey]
+		if !found || enclaveLabelValue != selectorValue {
+			return false
+		}
+	}
+	return true
+}
+
+// Removals are applied after the labels get set, so a label that is both set and removed ends up removed
+func getUpdatedEnclaveLabels(currentLabels map[string]string, labelsToSet map[string]string, labelsToRemove []string) map[string]string {
+	updatedLabels := maps.Clone(currentLabels)
+	if updatedLabels == nil {
+		updatedLabels = map[string]string{}
+	}
+	maps.Copy(updatedLabels, labelsToSet)
+	for _, labelKey := range labelsToRemove {
+		delete(updatedLabels, labelKey)
+	}
+	return updatedLabels
+}
@@ -0,0 +1,36 @@
+package enclave_manager
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+var enclaveLabels = map[string]string{
+	"team": "infra",
+	"pr":   "1234",
+}
+
+func TestDoEnclaveLabelsMatchSelector_EmptySelectorMatchesEverything(t *testing.T) {
+	require.True(t, doEnclaveLabelsMatchSelector(enclaveLabels, nil))
+	require.True(t, doEnclaveLabelsMatchSelector(nil, map[string]string{}))
+}
+
+func TestDoEnclaveLabelsMatchSelector_AllLabelsMustMatch(t *testing.T) {
+	require.True(t, doEnclaveLabelsMatchSelector(enclaveLabels, map[string]string{"team": "infra"}))
+	require.True(t, doEnclaveLabelsMatchSelector(enclaveLabels, map[string]string{"team": "infra", "pr": "1234"}))
+	require.False(t, doEnclaveLabelsMatchSelector(enclaveLabels, map[string]string{"team": "infra", "pr": "5678"}))
+	require.False(t, doEnclaveLabelsMatchSelector(enclaveLabels, map[string]string{"commit": "abc"}))
+}
+
+func TestGetUpdatedEnclaveLabels(t *testing.T) {
+	updatedLabels := getUpdatedEnclaveLabels(enclaveLabels, map[string]string{"team": "core", "commit": "abc"}, []string{"pr", "unknown"})
+	require.Equal(t, map[string]string{"team": "core", "commit": "abc"}, updatedLabels)
+	// The current labels are left untouched
+	require.Equal(t, "infra", enclaveLabels["team"])
+}
+
+func TestGetUpdatedEnclaveLabels_RemovalWinsOverSet(t *testing.T) {
+	updatedLabels := getUpdatedEnclaveLabels(nil, map[string]string{"team": "core"}, []string{"team"})
+	require.Empty(t, updatedLabels)
+}
@@ -8,6 +8,7 @@ import (
 	"sync"
 	"time"
 
+	rpc_api "github.com/kurtosis-tech/kurtosis/api/golang/core/kurtosis_core_rpc_api_bindings"
 	"github.com/kurtosis-tech/kurtosis/engine/server/engine/centralized_logs"
 
 	"github.com/kurtosis-tech/kurtosis/metrics-library/golang/lib/metrics_client"
@@ -18,13 +19,20 @@ import (
 	"github.com/kurtosis-tech/kurtosis/container-engine-lib/lib/backend_interface/objects/container"
 	"github.com/kurtosis-tech/kurtosis/container-engine-lib/lib/backend_interface/objects/enclave"
 	"github.com/kurtosis-tech/kurtosis/container-engine-lib/lib/backend_interface/objects/logs_collector"
+	"github.com/kurtosis-tech/kurtosis/container-engine-lib/lib/event_broadcaster"
+	"github.com/kurtosis-tech/kurtosis/container-engine-lib/lib/tracing"
 	"github.com/kurtosis-tech/kurtosis/container-engine-lib/lib/uuid_generator"
 	"github.com/kurtosis-tech/kurtosis/core/launcher/api_container_launcher"
 	"github.com/kurtosis-tech/kurtosis/engine/launcher/args"
+	"github.com/kurtosis-tech/kurtosis/engine/server/engine/audit_log"
+	"github.com/kurtosis-tech/kurtosis/engine/server/engine/operational_metrics"
 	"github.com/kurtosis-tech/kurtosis/engine/server/engine/types"
 	"github.com/kurtosis-tech/kurtosis/name_generator"
 	"github.com/kurtosis-tech/stacktrace"
 	"github.com/sirupsen/logrus"
+	"go.opentelemetry.io/otel/attribute"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/credentials/insecure"
 )
 
 const (
@@ -37,6 +45,13 @@ const (
 	errorDelimiter = ", "
 
 	enclaveNameNotFound = "Name Not Found"
+
+	createEnclaveSpanName  = "create_enclave"
+	destroyEnclaveSpanName = "destroy_enclave"
+
+	enclaveNameSpanAttributeKey         = "kurtosis.enclave.name"
+	enclaveIdentifierSpanAttributeKey   = "kurtosis.enclave.identifier"
+	isProductionEnclaveSpanAttributeKey = "kurtosis.enclave.is_production"
 )
 
 // TODO Move this to the KurtosisBackend to calculate!!
@@ -70,6 +85,11 @@ type EnclaveManager struct {
 	enclavePool    *EnclavePool
 	enclaveEnvVars string
 
+	callerKey *audit_log.CallerKey
+
+	// The entries of the API container audit logs are copied there before the enclaves get stopped or destroyed
+	apiContainerAuditLogArchive *audit_log.ApiContainerAuditLogArchive
+
 	logsDbClient centralized_logs.LogsDatabaseClient
 
 	metricsUserID               string
@@ -80,6 +100,8 @@ type EnclaveManager struct {
 
 	logsCollectorFilters []logs_collector.Filter
 	logsCollectorParsers []logs_collector.Parser
+
+	eventBroadcaster *event_broadcaster.EventBroadcaster[*types.EngineEvent]
 }
 
 func CreateEnclaveManager(
@@ -97,8 +119,13 @@ func CreateEnclaveManager(
 	cloudInstanceID metrics_client.CloudInstanceID,
 	logsCollectorFilters []logs_collector.Filter,
 	logsCollectorParsers []logs_collector.Parser,
+	otlpEndpoint string,
+	// If nil, the idle enclaves of the pool are left blank
+	poolSeedPackage *EnclaveSeedPackage,
+	callerKey *audit_log.CallerKey,
+	apiContainerAuditLogArchive *audit_log.ApiContainerAuditLogArchive,
 ) (*EnclaveManager, error) {
-	enclaveCreator := newEnclaveCreator(kurtosisBackend, apiContainerKurtosisBackendConfigSupplier)
+	enclaveCreator := newEnclaveCreator(kurtosisBackend, apiContainerKurtosisBackendConfigSupplier, otlpEndpoint, callerKey)
 
 	var (
 		err         error
@@ -107,7 +134,7 @@ func CreateEnclaveManager(
 
 	// The enclave pool feature is only available for Kubernetes so far
 	if kurtosisBackendType == args.KurtosisBackendType_Kubernetes {
-		enclavePool, err = CreateEnclavePool(kurtosisBackend, enclaveCreator, poolSize, engineVersion, enclaveEnvVars, metricsUserID, didUserAcceptSendingMetrics, isCI, cloudUserID, cloudInstanceID, logsCollectorFilters, logsCollectorParsers)
+		enclavePool, err = CreateEnclavePool(kurtosisBackend, enclaveCreator, poolSize, engineVersion, enclaveEnvVars, metricsUserID, didUserAcceptSendingMetrics, isCI, cloudUserID, cloudInstanceID, logsCollectorFilters, logsCollectorParsers, poolSeedPackage)
 		if err != nil {
 			return nil, stacktrace.Propagate(err, "An error occurred creating enclave pool with pool-size '%v' and engine version '%v'", poolSize, engineVersion)
 		}
@@ -122,6 +149,8 @@ func CreateEnclaveManager(
 		enclaveCreator:                            enclaveCreator,
 		enclavePool:                               enclavePool,
 		enclaveEnvVars:                            enclaveEnvVars,
+		callerKey:                                 callerKey,
+		apiContainerAuditLogArchive:               apiContainerAuditLogArchive,
 		logsDbClient:                              logsDbClient,
 		metricsUserID:                             metricsUserID,
 		didUserAcceptSendingMetrics:               didUserAcceptSendingMetrics,
@@ -130,6 +159,7 @@ func CreateEnclaveManager(
 		cloudInstanceID:                           cloudInstanceID,
 		logsCollectorFilters:                      logsCollectorFilters,
 		logsCollectorParsers:                      logsCollectorParsers,
+		eventBroadcaster:                          event_broadcaster.NewEventBroadcaster[*types.EngineEvent](),
 	}
 
 	return enclaveManager, nil
@@ -148,18 +178,83 @@ func (manager *EnclaveManager) CreateEnclave(
 	enclaveName string,
 	isProduction bool,
 	shouldAPICRunInDebugMode bool,
-) (*types.EnclaveInfo, error) {
+	// If zero, the enclave doesn't expire with age
+	ttl time.Duration,
+	// If zero, the enclave doesn't expire for being idle
+	idleTimeout time.Duration,
+	expiredEnclaveAction enclave.ExpiredEnclaveAction,
+	labels map[string]string,
+	// If nil, the enclave is left blank
+	seedPackage *EnclaveSeedPackage,
+) (resultEnclaveInfo *types.EnclaveInfo, resultErr error) {
+	setupCtx, span := tracing.StartSpan(setupCtx, createEnclaveSpanName,
+		attribute.String(enclaveNameSpanAttributeKey, enclaveName),
+		attribute.Bool(isProductionEnclaveSpanAttributeKey, isProduction),
+	)
+	defer func() {
+		tracing.EndSpan(span, resultErr)
+	}()
+
+	enclaveInfo, isFromPool, err := manager.createEnclaveWithMutex(
+		setupCtx,
+		engineVersion,
+		apiContainerImageVersionTag,
+		apiContainerLogLevel,
+		enclaveName,
+		isProduction,
+		shouldAPICRunInDebugMode,
+		ttl,
+		idleTimeout,
+		expiredEnclaveAction,
+		labels,
+		seedPackage,
+	)
+	if err != nil {
+		return nil, err
+	}
+
+	// The pool only hands out enclaves already seeded with the requested package. Otherwise, the package is run here,
+	// without holding the mutex as it can take minutes
+	if seedPackage != nil && !isFromPool {
+		enclaveUuid := enclave.EnclaveUUID(enclaveInfo.EnclaveUuid)
+		if err := runSeedPackageInEnclave(setupCtx, manager.kurtosisBackend, manager.callerKey, enclaveUuid, seedPackage); err != nil {
+			if destroyErr := manager.DestroyEnclave(setupCtx, enclaveInfo.EnclaveUuid); destroyErr != nil {
+				logrus.Errorf("Running the seed package in enclave '%v' failed so we tried to destroy it, but an error occurred doing so. You'll need to destroy it manually. Error was:\n%v", enclaveInfo.Name, destroyErr)
+			}
+			return nil, stacktrace.Propagate(err, "An error occurred running seed package '%v' in new enclave '%v'", seedPackage.PackageLocator, enclaveInfo.Name)
+		}
+	}
+
+	return enclaveInfo, nil
+}
+
+// Returns whether the enclave was handed out by the pool, besides the enclave
+func (manager *EnclaveManager) createEnclaveWithMutex(
+	setupCtx context.Context,
+	engineVersion string,
+	apiContainerImageVersionTag string,
+	apiContainerLogLevel logrus.Level,
+	enclaveName string,
+	isProduction bool,
+	shouldAPICRunInDebugMode bool,
+	ttl time.Duration,
+	idleTimeout time.Duration,
+	expiredEnclaveAction enclave.ExpiredEnclaveAction,
+	labels map[string]string,
+	seedPackage *EnclaveSeedPackage,
+) (*types.EnclaveInfo, bool, error) {
 	manager.mutex.Lock()
 	defer manager.mutex.Unlock()
 
 	var (
 		enclaveInfo *types.EnclaveInfo
+		isFromPool  bool
 		err         error
 	)
 
 	allExistingAndHistoricalIdentifiers, err := manager.getExistingAndHistoricalEnclaveIdentifiersWithoutMutex()
 	if err != nil {
-		return nil, stacktrace.Propagate(err, "An error occurred getting existing and historical enclave identifiers")
+		return nil, false, stacktrace.Propagate(err, "An error occurred getting existing and historical enclave identifiers")
 	}
 
 	allEnclaveNames := []string{}
@@ -173,7 +268,11 @@ func (manager *EnclaveManager) CreateEnclave(
 	}
 
 	if err := validateEnclaveName(enclaveName); err != nil {
-		return nil, stacktrace.Propagate(err, "An error occurred validating enclave name '%v'", enclaveName)
+		return nil, false, stacktrace.Propagate(err, "An error occurred validating enclave name '%v'", enclaveName)
+	}
+
+	if err := enclave.ValidateEnclaveLabels(labels); err != nil {
+		return nil, false, stacktrace.Propagate(err, "An error occurred validating the labels of enclave '%v'", enclaveName)
 	}
 
 	// TODO(victor.colombo): Extend enclave pool to have warm production enclaves
@@ -185,10 +284,17 @@ func (manager *EnclaveManager) CreateEnclave(
 			apiContainerImageVersionTag,
 			apiContainerLogLevel,
 			shouldAPICRunInDebugMode,
+			seedPackage,
 		)
 		if err != nil {
 			logrus.Errorf("An error occurred when trying to get an enclave from the enclave pool. Err:\n%v", err)
 		}
+		if enclaveInfo != nil {
+			isFromPool = true
+			operational_metrics.RecordEnclavePoolHit()
+		} else {
+			operational_metrics.RecordEnclavePoolMiss()
+		}
 	}
 
 	if enclaveInfo == nil {
@@ -210,7 +316,7 @@ func (manager *EnclaveManager) CreateEnclave(
 			manager.logsCollectorParsers,
 		)
 		if err != nil {
-			return nil, stacktrace.Propagate(
+			return nil, false, stacktrace.Propagate(
 				err,
 				"An error occurred creating new enclave with name '%s' using api container image version '%s' and api container log level '%v'",
 				enclaveName,
@@ -220,14 +326,39 @@ func (manager *EnclaveManager) CreateEnclave(
 		}
 	}
 
+	if ttl > 0 || idleTimeout > 0 {
+		enclaveUuid := enclave.EnclaveUUID(enclaveInfo.EnclaveUuid)
+		expiration := newEnclaveExpiration(time.Now(), ttl, idleTimeout, expiredEnclaveAction)
+		if err := manager.kurtosisBackend.UpdateEnclaveExpiration(setupCtx, enclaveUuid, expiration); err != nil {
+			if destroyErr := manager.destroyEnclaveWithoutMutex(setupCtx, enclaveUuid); destroyErr != nil {
+				logrus.Errorf("Setting the expiration of enclave '%v' failed so we tried to destroy it, but an error occurred doing so. You'll need to destroy it manually. Error was:\n%v", enclaveName, destroyErr)
+			}
+			return nil, false, stacktrace.Propagate(err, "An error occurred setting the expiration of new enclave '%v'", enclaveName)
+		}
+		enclaveInfo.Expiration = getEnclaveExpirationInfo(expiration)
+	}
+
+	if len(labels) > 0 {
+		enclaveUuid := enclave.EnclaveUUID(enclaveInfo.EnclaveUuid)
+		if err := manager.kurtosisBackend.UpdateEnclaveLabels(setupCtx, enclaveUuid, labels); err != nil {
+			if destroyErr := manager.destroyEnclaveWithoutMutex(setupCtx, enclaveUuid); destroyErr != nil {
+				logrus.Errorf("Setting the labels of enclave '%v' failed so we tried to destroy it, but an error occurred doing so. You'll need to destroy it manually. Error was:\n%v", enclaveName, destroyErr)
+			}
+			return nil, false, stacktrace.Propagate(err, "An error occurred setting the labels of new enclave '%v'", enclaveName)
+		}
+		enclaveInfo.Labels = labels
+	}
+
 	enclaveIdentifier := &types.EnclaveIdentifiers{
 		EnclaveUuid:   enclaveInfo.EnclaveUuid,
 		Name:          enclaveInfo.Name,
 		ShortenedUuid: enclaveInfo.ShortenedUuid,
 	}
 	manager.allExistingAndHistoricalIdentifiers = append(manager.allExistingAndHistoricalIdentifiers, enclaveIdentifier)
+	operational_metrics.RecordEnclaveCreated()
+	manager.publishEnclaveEvent(types.EngineEventType_ENCLAVE_CREATED, enclaveInfo.EnclaveUuid, enclaveInfo.Name)
 
-	return enclaveInfo, nil
+	return enclaveInfo, isFromPool, nil
 }
 
 // It's a liiiitle weird that we return an EnclaveInfo object (which is a Protobuf object), but as of 2021-10-21 this class
@@ -253,6 +384,28 @@ func (manager *EnclaveManager) GetAllEnclaves(
 	return enclaveMapKeyedWithUuidStr, nil
 }
 
+// GetEnclavesMatchingLabels returns the enclaves having all the labels of the selector, keyed by enclave UUID
+func (manager *EnclaveManager) GetEnclavesMatchingLabels(
+	ctx context.Context,
+	labelSelector map[string]string,
+) (map[string]*types.EnclaveInfo, error) {
+	manager.mutex.Lock()
+	defer manager.mutex.Unlock()
+
+	enclaves, err := manager.getEnclavesMatchingLabelsWithoutMutex(ctx, labelSelector)
+	if err != nil {
+		return nil, stacktrace.Propagate(err, "An error occurred getting the enclaves matching label selector '%+v' without the mutex", labelSelector)
+	}
+
+	// Transform map[enclave.EnclaveUUID]*EnclaveInfo -> map[string]*EnclaveInfo
+	enclaveMapKeyedWithUuidStr := map[string]*types.EnclaveInfo{}
+	for enclaveUuid, enclaveInfo := range enclaves {
+		enclaveMapKeyedWithUuidStr[string(enclaveUuid)] = enclaveInfo
+	}
+
+	return enclaveMapKeyedWithUuidStr, nil
+}
+
 func (manager *EnclaveManager) GetEnclavesByUuid(
 	ctx context.Context,
 	enclaveUuids []enclave.EnclaveUUID,
@@ -290,7 +443,12 @@ func (manager *EnclaveManager) StopEnclave(ctx context.Context, enclaveIdentifie
 // DestroyEnclave
 // TODO remove these notes - this should be working on active enclaves as well
 // Destroys an enclave, deleting all objects associated with it in the container engine (containers, volumes, networks, etc.)
-func (manager *EnclaveManager) DestroyEnclave(ctx context.Context, enclaveIdentifier string) error {
+func (manager *EnclaveManager) DestroyEnclave(ctx context.Context, enclaveIdentifier string) (resultErr error) {
+	ctx, span := tracing.StartSpan(ctx, destroyEnclaveSpanName, attribute.String(enclaveIdentifierSpanAttributeKey, enclaveIdentifier))
+	defer func() {
+		tracing.EndSpan(span, resultErr)
+	}()
+
 	manager.mutex.Lock()
 	defer manager.mutex.Unlock()
 
@@ -299,37 +457,92 @@ func (manager *EnclaveManager) DestroyEnclave(ctx context.Context, enclaveIdenti
 		return stacktrace.Propagate(err, "An error occurred while fetching enclave uuid for identifier '%v'", enclaveIdentifier)
 	}
 
-	enclaveDestroyFilter := &enclave.EnclaveFilters{
-		UUIDs: map[enclave.EnclaveUUID]bool{
-			enclaveUuid: true,
-		},
-		Statuses: nil,
+	return manager.destroyEnclaveWithoutMutex(ctx, enclaveUuid)
+}
+
+// ExtendEnclaveTtl makes the enclave expire once the given time-to-live has elapsed, counting from now. The idle timeout
+// and the expired enclave action the enclave was created with are kept.
+func (manager *EnclaveManager) ExtendEnclaveTtl(ctx context.Context, enclaveIdentifier string, ttl time.Duration) error {
+	if ttl <= 0 {
+		return stacktrace.NewError("The time-to-live of enclave '%v' must be strictly positive but was '%v'", enclaveIdentifier, ttl)
 	}
-	successfullyDestroyedEnclaves, erroredEnclaves, err := manager.kurtosisBackend.DestroyEnclaves(ctx, enclaveDestroyFilter)
+
+	manager.mutex.Lock()
+	defer manager.mutex.Unlock()
+
+	enclaveUuid, err := manager.getEnclaveUuidForIdentifierUnlocked(ctx, enclaveIdentifier)
 	if err != nil {
-		return stacktrace.Propagate(err, "An error occurred destroying the enclave")
+		return stacktrace.Propagate(err, "An error occurred while fetching enclave uuid for identifier '%v'", enclaveIdentifier)
 	}
-	if _, found := successfullyDestroyedEnclaves[enclaveUuid]; found {
-		if err = manager.logsDbClient.RemoveEnclaveLogs(string(enclaveUuid)); err != nil {
-			return stacktrace.Propagate(err, "An error occurred attempting to remove enclave '%v' logs after it was destroyed.", enclaveIdentifier)
-		}
-		return nil
+
+	enclaves, err := manager.kurtosisBackend.GetEnclaves(ctx, getEnclaveByEnclaveIdFilter(enclaveUuid))
+	if err != nil {
+		return stacktrace.Propagate(err, "An error occurred getting enclave '%v'", enclaveUuid)
 	}
-	destructionErr, found := erroredEnclaves[enclaveUuid]
+	enclaveObj, found := enclaves[enclaveUuid]
 	if !found {
-		return stacktrace.NewError("The requested enclave UUD '%v' for identifier '%v' wasn't found in the successfully-destroyed enclaves map, nor in the errors map; this is a bug in Kurtosis!", enclaveUuid, enclaveIdentifier)
+		return stacktrace.NewError("Couldn't find enclave '%v' in the backend even though it was just resolved from identifier '%v'", enclaveUuid, enclaveIdentifier)
 	}
-	return destructionErr
+
+	idleTimeout := time.Duration(0)
+	expiredEnclaveAction := enclave.ExpiredEnclaveAction_Destroy
+	if currentExpiration := enclaveObj.GetExpiration(); currentExpiration != nil {
+		idleTimeout = currentExpiration.GetIdleTimeout()
+		expiredEnclaveAction = currentExpiration.GetAction()
+	}
+	expiration := newEnclaveExpiration(time.Now(), ttl, idleTimeout, expiredEnclaveAction)
+	if err := manager.kurtosisBackend.UpdateEnclaveExpiration(ctx, enclaveUuid, expiration); err != nil {
+		return stacktrace.Propagate(err, "An error occurred updating the expiration of enclave '%v'", enclaveIdentifier)
+	}
+	logrus.Infof("Enclave '%v' will now expire at '%v'", enclaveIdentifier, expiration.GetExpirationTime())
+	return nil
 }
 
-func (manager *EnclaveManager) Clean(ctx context.Context, shouldCleanAll bool) ([]*types.EnclaveNameAndUuid, error) {
+// UpdateEnclaveLabels sets and removes labels of an enclave, leaving its other labels as they are
+func (manager *EnclaveManager) UpdateEnclaveLabels(
+	ctx context.Context,
+	enclaveIdentifier string,
+	labelsToSet map[string]string,
+	labelsToRemove []string,
+) error {
+	if err := enclave.ValidateEnclaveLabels(labelsToSet); err != nil {
+		return stacktrace.Propagate(err, "An error occurred validating the labels to set on enclave '%v'", enclaveIdentifier)
+	}
+
+	manager.mutex.Lock()
+	defer manager.mutex.Unlock()
+
+	enclaveUuid, err := manager.getEnclaveUuidForIdentifierUnlocked(ctx, enclaveIdentifier)
+	if err != nil {
+		return stacktrace.Propagate(err, "An error occurred while fetching enclave uuid for identifier '%v'", enclaveIdentifier)
+	}
+
+	enclaves, err := manager.kurtosisBackend.GetEnclaves(ctx, getEnclaveByEnclaveIdFilter(enclaveUuid))
+	if err != nil {
+		return stacktrace.Propagate(err, "An error occurred getting enclave '%v'", enclaveUuid)
+	}
+	enclaveObj, found := enclaves[enclaveUuid]
+	if !found {
+		return stacktrace.NewError("Couldn't find enclave '%v' in the backend even though it was just resolved from identifier '%v'", enclaveUuid, enclaveIdentifier)
+	}
+
+	updatedLabels := getUpdatedEnclaveLabels(enclaveObj.GetLabels(), labelsToSet, labelsToRemove)
+	if err := manager.kurtosisBackend.UpdateEnclaveLabels(ctx, enclaveUuid, updatedLabels); err != nil {
+		return stacktrace.Propagate(err, "An error occurred updating the labels of enclave '%v' to '%+v'", enclaveIdentifier, updatedLabels)
+	}
+	return nil
+}
+
+// Clean destroys the stopped enclaves, or all of them if shouldCleanAll is set
+// If the label selector isn't empty, only the enclaves having all of its labels get destroyed
+func (manager *EnclaveManager) Clean(ctx context.Context, shouldCleanAll bool, labelSelector map[string]string) ([]*types.EnclaveNameAndUuid, error) {
 	manager.mutex.Lock()
 	defer manager.mutex.Unlock()
 	// TODO: Refactor with kurtosis backend
 	var resultEnclaveNameAndUuids []*types.EnclaveNameAndUuid
 
 	// we prefetch the enclaves before deletion so that we have metadata
-	enclavesForUuidNameMapping, err := manager.getAllEnclavesWithoutMutex(ctx)
+	enclavesForUuidNameMapping, err := manager.getEnclavesMatchingLabelsWithoutMutex(ctx, labelSelector)
 	if err != nil {
 		return nil, stacktrace.Propagate(err, "Tried retrieving existing enclaves but failed")
 	}
@@ -338,6 +551,13 @@ func (manager *EnclaveManager) Clean(ctx context.Context, shouldCleanAll bool) (
 	for enclaveUUID := range enclavesForUuidNameMapping {
 		enclaveUUIDsToClean[enclaveUUID] = true
 	}
+	// An empty set of UUIDs would match every enclave when destroying them
+	if len(labelSelector) > 0 && len(enclaveUUIDsToClean) == 0 {
+		return resultEnclaveNameAndUuids, nil
+	}
+	for _, enclaveInfo := range enclavesForUuidNameMapping {
+		manager.archiveApiContainerAuditLog(ctx, enclaveInfo)
+	}
 
 	successfullyRemovedEnclaveUuidStrs, removalErrors, err := manager.cleanEnclaves(ctx, enclaveUUIDsToClean, shouldCleanAll)
 	if err != nil {
@@ -371,6 +591,7 @@ func (manager *EnclaveManager) Clean(ctx context.Context, shouldCleanAll bool) (
 				nameAndUuid.Name = enclave.Name
 			}
 			resultEnclaveNameAndUuids = append(resultEnclaveNameAndUuids, nameAndUuid)
+			manager.publishEnclaveEvent(types.EngineEventType_ENCLAVE_DESTROYED, nameAndUuid.Uuid, nameAndUuid.Name)
 			logrus.Infof("Enclave Uuid '%v'", successfullyRemovedEnclaveUuidStr)
 		}
 	}
@@ -385,6 +606,25 @@ func (manager *EnclaveManager) GetEnclaveUuidForEnclaveIdentifier(ctx context.Co
 	return manager.getEnclaveUuidForIdentifierUnlocked(ctx, enclaveIdentifier)
 }
 
+func (manager *EnclaveManager) GetEnclaveNameForEnclaveIdentifier(ctx context.Context, enclaveIdentifier string) (string, error) {
+	manager.mutex.Lock()
+	defer manager.mutex.Unlock()
+
+	enclaveUuid, err := manager.getEnclaveUuidForIdentifierUnlocked(ctx, enclaveIdentifier)
+	if err != nil {
+		return "", stacktrace.Propagate(err, "An error occurred getting the UUID of enclave '%v'", enclaveIdentifier)
+	}
+	enclaves, err := manager.getAllEnclavesWithoutMutex(ctx)
+	if err != nil {
+		return "", stacktrace.Propagate(err, "An error occurred getting the enclaves")
+	}
+	enclaveInfo, found := enclaves[enclaveUuid]
+	if !found {
+		return "", stacktrace.NewError("Enclave '%v' disappeared while getting its name", enclaveIdentifier)
+	}
+	return enclaveInfo.Name, nil
+}
+
 func (manager *EnclaveManager) GetExistingAndHistoricalEnclaveIdentifiers() ([]*types.EnclaveIdentifiers, error) {
 	manager.mutex.Lock()
 	defer manager.mutex.Unlock()
@@ -473,6 +713,7 @@ func (manager *EnclaveManager) RestartAllEnclaveAPIContainers(ctx context.Contex
 		if err != nil {
 			return stacktrace.Propagate(err, "An error occurred launching the API container")
 		}
+		manager.publishEnclaveEvent(types.EngineEventType_API_CONTAINER_RESTARTED, string(enclaveUuid), manager.getEnclaveName(ctx, enclaveUuid))
 	}
 
 	logrus.Info("...all API containers restarted.")
@@ -572,10 +813,49 @@ func getEnclaveApiContainerInformation(
 	return resultApiContainerStatus, resultApiContainerInfo, resultApiContainerHostMachineInfo, nil
 }
 
+// Returns a nil client if the enclave has no API container, otherwise the function closing the client must be called once done
+func (manager *EnclaveManager) getApiContainerClient(ctx context.Context, enclaveUuid enclave.EnclaveUUID) (rpc_api.ApiContainerServiceClient, func(), error) {
+	return getApiContainerClient(ctx, manager.kurtosisBackend, manager.callerKey, enclaveUuid)
+}
+
+func getApiContainerClient(ctx context.Context, kurtosisBackend backend_interface.KurtosisBackend, callerKey *audit_log.CallerKey, enclaveUuid enclave.EnclaveUUID) (rpc_api.ApiContainerServiceClient, func(), error) {
+	_, apiContainerInfo, _, err := getEnclaveApiContainerInformation(ctx, kurtosisBackend, enclaveUuid)
+	if err != nil {
+		return nil, nil, stacktrace.Propagate(err, "An error occurred getting the API container information of enclave '%v'", enclaveUuid)
+	}
+	if apiContainerInfo == nil {
+		return nil, nil, nil
+	}
+
+	// The engine is attached to the bridge network on Docker, while on Kubernetes it can reach the API container directly
+	apiContainerIp := apiContainerInfo.BridgeIpAddress
+	if apiContainerIp == "" {
+		apiContainerIp = apiContainerInfo.IpInsideEnclave
+	}
+	apiContainerAddress := fmt.Sprintf("%v:%v", apiContainerIp, apiContainerInfo.GrpcPortInsideEnclave)
+	conn, err := grpc.Dial(
+		apiContainerAddress,
+		grpc.WithTransportCredentials(insecure.NewCredentials()),
+		grpc.WithChainUnaryInterceptor(callerKey.NewForwardCallerUnaryClientInterceptor(string(enclaveUuid)), tracing.UnaryClientInterceptor()),
+		grpc.WithChainStreamInterceptor(callerKey.NewForwardCallerStreamClientInterceptor(string(enclaveUuid)), tracing.StreamClientInterceptor()),
+	)
+	if err != nil {
+		return nil, nil, stacktrace.Propagate(err, "An error occurred creating a client connection to the API container on address '%v'", apiContainerAddress)
+	}
+	closeClientFunc := func() {
+		if err := conn.Close(); err != nil {
+			logrus.Warnf("An error occurred closing the connection to the API container on address '%v'. Error was:\n%v", apiContainerAddress, err)
+		}
+	}
+	return rpc_api.NewApiContainerServiceClient(conn), closeClientFunc, nil
+}
+
 // Both StopEnclave and DestroyEnclave need to be able to stop enclaves, but both have a mutex guard. Because Go mutexes
 //
 //	aren't reentrant, DestroyEnclave can't just call StopEnclave so we use this helper function
 func (manager *EnclaveManager) stopEnclaveWithoutMutex(ctx context.Context, enclaveId enclave.EnclaveUUID) error {
+	enclaveName := manager.getEnclaveName(ctx, enclaveId)
+	manager.archiveApiContainerAuditLogsWithoutMutex(ctx, []enclave.EnclaveUUID{enclaveId})
 	_, enclaveStopErrs, err := manager.kurtosisBackend.StopEnclaves(ctx, getEnclaveByEnclaveIdFilter(enclaveId))
 	if err != nil {
 		return stacktrace.Propagate(err, "Attempted to stop enclave '%v' but the backend threw an error", enclaveId)
@@ -600,9 +880,34 @@ func (manager *EnclaveManager) stopEnclaveWithoutMutex(ctx context.Context, encl
 				"\n\n",
 			))
 	}
+	manager.publishEnclaveEvent(types.EngineEventType_ENCLAVE_STOPPED, string(enclaveId), enclaveName)
 	return nil
 }
 
+// Both DestroyEnclave and the expired enclaves reaper need to destroy enclaves while holding the mutex
+func (manager *EnclaveManager) destroyEnclaveWithoutMutex(ctx context.Context, enclaveUuid enclave.EnclaveUUID) error {
+	// The name and the API container audit log have to be retrieved before the enclave is gone
+	enclaveName := manager.getEnclaveName(ctx, enclaveUuid)
+	manager.archiveApiContainerAuditLogsWithoutMutex(ctx, []enclave.EnclaveUUID{enclaveUuid})
+	successfullyDestroyedEnclaves, erroredEnclaves, err := manager.kurtosisBackend.DestroyEnclaves(ctx, getEnclaveByEnclaveIdFilter(enclaveUuid))
+	if err != nil {
+		return stacktrace.Propagate(err, "An error occurred destroying the enclave")
+	}
+	if _, found := successfullyDestroyedEnclaves[enclaveUuid]; found {
+		operational_metrics.RecordEnclavesDestroyed(len(successfullyDestroyedEnclaves))
+		manager.publishEnclaveEvent(types.EngineEventType_ENCLAVE_DESTROYED, string(enclaveUuid), enclaveName)
+		if err = manager.logsDbClient.RemoveEnclaveLogs(string(enclaveUuid)); err != nil {
+			return stacktrace.Propagate(err, "An error occurred attempting to remove enclave '%v' logs after it was destroyed.", enclaveUuid)
+		}
+		return nil
+	}
+	destructionErr, found := erroredEnclaves[enclaveUuid]
+	if !found {
+		return stacktrace.NewError("The requested enclave UUD '%v' wasn't found in the successfully-destroyed enclaves map, nor in the errors map; this is a bug in Kurtosis!", enclaveUuid)
+	}
+	return destructionErr
+}
+
 func (manager *EnclaveManager) cleanEnclaves(
 	ctx context.Context,
 	enclaveUUIDs map[enclave.EnclaveUUID]bool,
@@ -624,6 +929,7 @@ func (manager *EnclaveManager) cleanEnclaves(
 	if err != nil {
 		return nil, nil, stacktrace.Propagate(err, "An error occurred destroying enclaves during cleaning")
 	}
+	operational_metrics.RecordEnclavesDestroyed(len(successfullyDestroyedEnclaves))
 
 	enclaveDestructionErrors := []error{}
 	for _, destructionError := range erroredEnclaves {
@@ -645,6 +951,13 @@ func (manager *EnclaveManager) cleanEnclaves(
 
 func (manager *EnclaveManager) getAllEnclavesWithoutMutex(
 	ctx context.Context,
+) (map[enclave.EnclaveUUID]*types.EnclaveInfo, error) {
+	return manager.getEnclavesMatchingLabelsWithoutMutex(ctx, nil)
+}
+
+func (manager *EnclaveManager) getEnclavesMatchingLabelsWithoutMutex(
+	ctx context.Context,
+	labelSelector map[string]string,
 ) (map[enclave.EnclaveUUID]*types.EnclaveInfo, error) {
 	enclaves, err := manager.kurtosisBackend.GetEnclaves(ctx, getAllEnclavesFilter())
 	if err != nil {
@@ -657,6 +970,10 @@ func (manager *EnclaveManager) getAllEnclavesWithoutMutex(
 		if isIdleEnclave(*enclaveObj) {
 			continue
 		}
+		// The labels are checked first so that the API containers of the enclaves that don't match don't get looked up
+		if !doEnclaveLabelsMatchSelector(enclaveObj.GetLabels(), labelSelector) {
+			continue
+		}
 
 		enclaveInfo, err := getEnclaveInfoForEnclave(ctx, manager.kurtosisBackend, enclaveObj)
 		if err != nil {
@@ -777,6 +1094,8 @@ func getEnclaveInfoForEnclave(ctx context.Context, kurtosisBackend backend_inter
 		ApiContainerHostMachineInfo: apiContainerHostMachineInfo,
 		CreationTime:                *creationTimestamp,
 		Mode:                        mode,
+		Expiration:                  getEnclaveExpirationInfo(enclave.GetExpiration()),
+		Labels:                      enclave.GetLabels(),
 	}, nil
 }
 
@@ -854,6 +1173,39 @@ func getApiContainerStatusFromContainerStatus(status container.ContainerStatus)
 	}
 }
 
+// Returns nil if the enclave never expires
+func getEnclaveExpirationInfo(expiration *enclave.EnclaveExpiration) *types.EnclaveExpiration {
+	if expiration == nil {
+		return nil
+	}
+
+	var idleTimeoutSeconds *uint32
+	if expiration.GetIdleTimeout() > 0 {
+		idleTimeoutSecondsValue := uint32(expiration.GetIdleTimeout().Seconds())
+		idleTimeoutSeconds = &idleTimeoutSecondsValue
+	}
+
+	action := types.ExpiredEnclaveAction_DESTROY
+	if expiration.GetAction() == enclave.ExpiredEnclaveAction_Stop {
+		action = types.ExpiredEnclaveAction_STOP
+	}
+
+	return &types.EnclaveExpiration{
+		Action:             action,
+		ExpirationTime:     expiration.GetExpirationTime(),
+		IdleTimeoutSeconds: idleTimeoutSeconds,
+	}
+}
+
+func newEnclaveExpiration(now time.Time, ttl time.Duration, idleTimeout time.Duration, action enclave.ExpiredEnclaveAction) *enclave.EnclaveExpiration {
+	var expirationTime *time.Time
+	if ttl > 0 {
+		expirationTimeValue := now.Add(ttl)
+		expirationTime = &expirationTimeValue
+	}
+	return enclave.NewEnclaveExpiration(expirationTime, idleTimeout, action)
+}
+
 func getEnclaveCreationTimestamp(enclave *enclave.Enclave) (*time.Time, error) {
 	enclaveCreationTime := enclave.GetCreationTime()
 	if enclaveCreationTime == nil {
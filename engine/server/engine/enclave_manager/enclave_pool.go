@@ -42,6 +42,10 @@ type EnclavePool struct {
 	cloudInstanceID             metrics_client.CloudInstanceID
 	logsCollectorFilters        []logs_collector.Filter
 	logsCollectorParsers        []logs_collector.Parser
+
+	// The package pre-run in the idle enclaves, nil if they're left blank
+	seedPackage *EnclaveSeedPackage
+	seedKey     enclaveSeedKey
 }
 
 // CreateEnclavePool will do the following:
@@ -62,6 +66,7 @@ func CreateEnclavePool(
 	cloudInstanceID metrics_client.CloudInstanceID,
 	logsCollectorFilters []logs_collector.Filter,
 	logsCollectorParsers []logs_collector.Parser,
+	seedPackage *EnclaveSeedPackage,
 ) (*EnclavePool, error) {
 
 	//TODO the current implementation only removes the previous idle enclave, it's pending to implement the reusable feature
@@ -111,6 +116,8 @@ func CreateEnclavePool(
 		cloudInstanceID:             cloudInstanceID,
 		logsCollectorFilters:        logsCollectorFilters,
 		logsCollectorParsers:        logsCollectorParsers,
+		seedPackage:                 seedPackage,
+		seedKey:                     newEnclaveSeedKey(engineVersion, seedPackage),
 	}
 
 	go enclavePool.run(ctxWithCancel)
@@ -122,7 +129,8 @@ func CreateEnclavePool(
 
 // GetEnclave returns the first idle enclave from the pool, and the enclave is renamed with the
 // name set by the caller before returning it. It returns nil if there is no enclave on the pool
-// or if the requested enclave params are different from the enclave in the pool params
+// or if the requested enclave params are different from the enclave in the pool params, which
+// includes the package the idle enclaves were pre-seeded with
 func (pool *EnclavePool) GetEnclave(
 	ctx context.Context,
 	newEnclaveName string,
@@ -130,6 +138,7 @@ func (pool *EnclavePool) GetEnclave(
 	apiContainerVersion string,
 	apiContainerLogLevel logrus.Level,
 	shouldAPICRunInDebugMode bool,
+	seedPackage *EnclaveSeedPackage,
 ) (*types.EnclaveInfo, error) {
 
 	logrus.Debugf(
@@ -153,6 +162,15 @@ func (pool *EnclavePool) GetEnclave(
 		return nil, nil
 	}
 
+	// A blank enclave can't be handed out to a caller expecting a package to have run in it, and the other way around
+	if apiContainerVersion == "" {
+		apiContainerVersion = engineVersion
+	}
+	if requestedSeedKey := newEnclaveSeedKey(apiContainerVersion, seedPackage); requestedSeedKey != pool.seedKey {
+		logrus.Debugf("The requested enclave state '%+v' is different from the state of the enclaves in the pool '%+v'", requestedSeedKey, pool.seedKey)
+		return nil, nil
+	}
+
 	// If there is no idle enclave in the pool returns nil
 	// for not to block the caller
 	if len(pool.idleEnclavesChan) == 0 {
@@ -314,6 +332,19 @@ func (pool *EnclavePool) createNewIdleEnclave(ctx context.Context) (*types.Encla
 		)
 	}
 
+	if pool.seedPackage != nil {
+		enclaveUUID := enclave.EnclaveUUID(newEnclaveInfo.EnclaveUuid)
+		if err := runSeedPackageInEnclave(ctx, pool.kurtosisBackend, pool.enclaveCreator.callerKey, enclaveUUID, pool.seedPackage); err != nil {
+			idleEnclavesToRemove := map[enclave.EnclaveUUID]bool{
+				enclaveUUID: true,
+			}
+			if destroyErr := destroyEnclavesByUUID(ctx, pool.kurtosisBackend, idleEnclavesToRemove); destroyErr != nil {
+				logrus.Errorf("Seeding idle enclave with UUID '%v' failed so we tried to destroy it, but this also failed, so you will have to manually destroy it. Error:\n%v", enclaveUUID, destroyErr)
+			}
+			return nil, stacktrace.Propagate(err, "An error occurred running seed package '%v' in idle enclave '%s'", pool.seedPackage.PackageLocator, enclaveName)
+		}
+	}
+
 	logrus.Debugf("New idle enclave created '%+v'", newEnclaveInfo)
 	return newEnclaveInfo, nil
 }
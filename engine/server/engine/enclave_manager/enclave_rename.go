@@ -0,0 +1,61 @@
+package enclave_manager
+
+import (
+	"context"
+
+	"github.com/kurtosis-tech/kurtosis/container-engine-lib/lib/backend_interface/objects/enclave"
+	"github.com/kurtosis-tech/kurtosis/engine/server/engine/types"
+	"github.com/kurtosis-tech/stacktrace"
+)
+
+// RenameEnclave changes the name of an existing enclave, which can't take the name of another existing enclave
+// The UUID of the enclave stays the same, so everything referring to the enclave by UUID keeps working
+func (manager *EnclaveManager) RenameEnclave(ctx context.Context, enclaveIdentifier string, newEnclaveName string) error {
+	if err := validateEnclaveName(newEnclaveName); err != nil {
+		return stacktrace.Propagate(err, "An error occurred validating new enclave name '%v'", newEnclaveName)
+	}
+
+	manager.mutex.Lock()
+	defer manager.mutex.Unlock()
+
+	enclaveUuid, err := manager.getEnclaveUuidForIdentifierUnlocked(ctx, enclaveIdentifier)
+	if err != nil {
+		return stacktrace.Propagate(err, "An error occurred while fetching enclave uuid for identifier '%v'", enclaveIdentifier)
+	}
+
+	enclaves, err := manager.getAllEnclavesWithoutMutex(ctx)
+	if err != nil {
+		return stacktrace.Propagate(err, "An error occurred getting the existing enclaves")
+	}
+	enclaveInfo, found := enclaves[enclaveUuid]
+	if !found {
+		return stacktrace.NewError("Couldn't find enclave '%v' even though it was just resolved from identifier '%v'", enclaveUuid, enclaveIdentifier)
+	}
+	if enclaveInfo.Name == newEnclaveName {
+		return nil
+	}
+
+	existingEnclaveNames := []string{}
+	for _, existingEnclaveInfo := range enclaves {
+		existingEnclaveNames = append(existingEnclaveNames, existingEnclaveInfo.Name)
+	}
+	if isEnclaveNameInUse(newEnclaveName, existingEnclaveNames) {
+		return stacktrace.NewError("Cannot rename enclave '%v' to '%v' because another enclave already has that name", enclaveIdentifier, newEnclaveName)
+	}
+
+	if err := manager.kurtosisBackend.UpdateEnclave(ctx, enclaveUuid, newEnclaveName, nil); err != nil {
+		return stacktrace.Propagate(err, "An error occurred renaming enclave '%v' to '%v'", enclaveIdentifier, newEnclaveName)
+	}
+	renameEnclaveInIdentifiers(manager.allExistingAndHistoricalIdentifiers, enclaveUuid, newEnclaveName)
+	return nil
+}
+
+// The identifiers of an enclave are kept after it's destroyed so its logs can still be found, so only the name of the
+// renamed enclave changes and the names it had before stop resolving to it
+func renameEnclaveInIdentifiers(allIdentifiers []*types.EnclaveIdentifiers, enclaveUuid enclave.EnclaveUUID, newEnclaveName string) {
+	for _, identifiers := range allIdentifiers {
+		if identifiers.EnclaveUuid == string(enclaveUuid) {
+			identifiers.Name = newEnclaveName
+		}
+	}
+}
@@ -0,0 +1,27 @@
+package enclave_manager
+
+import (
+	"testing"
+
+	"github.com/kurtosis-tech/kurtosis/engine/server/engine/types"
+	"github.com/stretchr/testify/require"
+)
+
+func TestRenameEnclaveInIdentifiers(t *testing.T) {
+	renamedEnclave := &types.EnclaveIdentifiers{
+		EnclaveUuid:   "65d2fb6d673249b8b4a91a2f4ae616de",
+		Name:          "tpyo",
+		ShortenedUuid: "65d2fb6d6732",
+	}
+	otherEnclave := &types.EnclaveIdentifiers{
+		EnclaveUuid:   "8f1a2e0c1d4b4f6a9c3e5b7d9f0a1b2c",
+		Name:          "other",
+		ShortenedUuid: "8f1a2e0c1d4b",
+	}
+
+	renameEnclaveInIdentifiers([]*types.EnclaveIdentifiers{renamedEnclave, otherEnclave}, "65d2fb6d673249b8b4a91a2f4ae616de", "typo")
+
+	require.Equal(t, "typo", renamedEnclave.Name)
+	require.Equal(t, "65d2fb6d673249b8b4a91a2f4ae616de", renamedEnclave.EnclaveUuid)
+	require.Equal(t, "other", otherEnclave.Name)
+}
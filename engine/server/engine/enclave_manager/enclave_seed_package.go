@@ -0,0 +1,147 @@
+package enclave_manager
+
+import (
+	"bytes"
+	"context"
+	"crypto/sha256"
+	"encoding/hex"
+	"encoding/json"
+	"errors"
+	"io"
+	"strings"
+
+	rpc_api "github.com/kurtosis-tech/kurtosis/api/golang/core/kurtosis_core_rpc_api_bindings"
+	"github.com/kurtosis-tech/kurtosis/container-engine-lib/lib/backend_interface"
+	"github.com/kurtosis-tech/kurtosis/container-engine-lib/lib/backend_interface/objects/enclave"
+	"github.com/kurtosis-tech/kurtosis/engine/server/engine/audit_log"
+	"github.com/kurtosis-tech/stacktrace"
+	"github.com/sirupsen/logrus"
+)
+
+const (
+	emptySeedPackageParams = "{}"
+
+	shouldCloneSeedPackage = true
+)
+
+// EnclaveSeedPackage is a package run in an enclave before it gets handed out to the caller
+type EnclaveSeedPackage struct {
+	PackageLocator string
+
+	// The serialized JSON params of the package
+	PackageParams string
+}
+
+// The state the enclaves of the pool are in, a pooled enclave is only handed out to the callers requesting this state
+type enclaveSeedKey struct {
+	apiContainerVersion string
+
+	// Empty if the enclave is blank
+	packageLocator    string
+	packageParamsHash string
+}
+
+func newEnclaveSeedKey(apiContainerVersion string, seedPackage *EnclaveSeedPackage) enclaveSeedKey {
+	if seedPackage == nil {
+		return enclaveSeedKey{
+			apiContainerVersion: apiContainerVersion,
+			packageLocator:      "",
+			packageParamsHash:   "",
+		}
+	}
+	return enclaveSeedKey{
+		apiContainerVersion: apiContainerVersion,
+		packageLocator:      seedPackage.PackageLocator,
+		packageParamsHash:   hashSeedPackageParams(seedPackage.PackageParams),
+	}
+}
+
+// The params are compacted before being hashed, so that the same params formatted differently get the same hash
+func hashSeedPackageParams(packageParams string) string {
+	packageParams = strings.TrimSpace(packageParams)
+	if packageParams == "" {
+		packageParams = emptySeedPackageParams
+	}
+	compactedParams := &bytes.Buffer{}
+	if err := json.Compact(compactedParams, []byte(packageParams)); err == nil {
+		packageParams = compactedParams.String()
+	}
+	hash := sha256.Sum256([]byte(packageParams))
+	return hex.EncodeToString(hash[:])
+}
+
+// runSeedPackageInEnclave runs the package in the enclave, blocking until the run finishes
+func runSeedPackageInEnclave(ctx context.Context, kurtosisBackend backend_interface.KurtosisBackend, callerKey *audit_log.CallerKey, enclaveUuid enclave.EnclaveUUID, seedPackage *EnclaveSeedPackage) error {
+	apiContainerClient, closeClientFunc, err := getApiContainerClient(ctx, kurtosisBackend, callerKey, enclaveUuid)
+	if err != nil {
+		return stacktrace.Propagate(err, "An error occurred getting a client to the API container of enclave '%v'", enclaveUuid)
+	}
+	if apiContainerClient == nil {
+		return stacktrace.NewError("Enclave '%v' has no API container to run seed package '%v' in", enclaveUuid, seedPackage.PackageLocator)
+	}
+	defer closeClientFunc()
+	return runSeedPackage(ctx, apiContainerClient, seedPackage)
+}
+
+func runSeedPackage(ctx context.Context, apiContainerClient rpc_api.ApiContainerServiceClient, seedPackage *EnclaveSeedPackage) error {
+	packageParams := seedPackage.PackageParams
+	if strings.TrimSpace(packageParams) == "" {
+		packageParams = emptySeedPackageParams
+	}
+	clonePackage := shouldCloneSeedPackage
+	runStarlarkPackageArgs := &rpc_api.RunStarlarkPackageArgs{ // nolint: exhaustruct
+		PackageId:        seedPackage.PackageLocator,
+		SerializedParams: &packageParams,
+		ClonePackage:     &clonePackage,
+	}
+
+	logrus.Infof("Running seed package '%v'...", seedPackage.PackageLocator)
+	stream, err := apiContainerClient.RunStarlarkPackage(ctx, runStarlarkPackageArgs)
+	if err != nil {
+		return stacktrace.Propagate(err, "An error occurred starting to run seed package '%v'", seedPackage.PackageLocator)
+	}
+	if err := waitForStarlarkRunToSucceed(stream); err != nil {
+		return stacktrace.Propagate(err, "Seed package '%v' didn't run successfully", seedPackage.PackageLocator)
+	}
+	logrus.Infof("Seed package '%v' ran successfully", seedPackage.PackageLocator)
+	return nil
+}
+
+type starlarkRunResponseLineStream interface {
+	Recv() (*rpc_api.StarlarkRunResponseLine, error)
+}
+
+// waitForStarlarkRunToSucceed reads the output of the run until it finishes, returning an error if the run failed
+func waitForStarlarkRunToSucceed(stream starlarkRunResponseLineStream) error {
+	for {
+		responseLine, err := stream.Recv()
+		if errors.Is(err, io.EOF) {
+			return stacktrace.NewError("The run ended without reporting whether it succeeded")
+		}
+		if err != nil {
+			return stacktrace.Propagate(err, "An error occurred reading the output of the run")
+		}
+		if runError := responseLine.GetError(); runError != nil {
+			return stacktrace.NewError("The run failed:\n%v", getStarlarkRunErrorMessage(runError))
+		}
+		if runFinishedEvent := responseLine.GetRunFinishedEvent(); runFinishedEvent != nil {
+			if !runFinishedEvent.GetIsRunSuccessful() {
+				return stacktrace.NewError("The run finished without being successful")
+			}
+			return nil
+		}
+	}
+}
+
+func getStarlarkRunErrorMessage(runError *rpc_api.StarlarkError) string {
+	switch {
+	case runError.GetInterpretationError() != nil:
+		return runError.GetInterpretationError().GetErrorMessage()
+	case runError.GetValidationError() != nil:
+		return runError.GetValidationError().GetErrorMessage()
+	case runError.GetExecutionError() != nil:
+		return runError.GetExecutionError().GetErrorMessage()
+	default:
+		return runError.String()
+	}
+}
@@ -0,0 +1,58 @@
+package enclave_manager
+
+import (
+	"context"
+	"testing"
+
+	"github.com/kurtosis-tech/kurtosis/engine/server/engine/types"
+	"github.com/sirupsen/logrus"
+	"github.com/stretchr/testify/require"
+)
+
+const (
+	testApiContainerVersion = "1.0.0"
+	testPackageLocator      = "github.com/kurtosis-tech/ethereum-package"
+)
+
+func TestNewEnclaveSeedKey_ParamsFormattingDoesNotMatter(t *testing.T) {
+	compactParams := &EnclaveSeedPackage{PackageLocator: testPackageLocator, PackageParams: `{"participants":[{"el_type":"geth"}]}`}
+	indentedParams := &EnclaveSeedPackage{PackageLocator: testPackageLocator, PackageParams: "{\n  \"participants\": [\n    {\"el_type\": \"geth\"}\n  ]\n}\n"}
+	require.Equal(t, newEnclaveSeedKey(testApiContainerVersion, compactParams), newEnclaveSeedKey(testApiContainerVersion, indentedParams))
+
+	noParams := &EnclaveSeedPackage{PackageLocator: testPackageLocator, PackageParams: ""}
+	emptyParams := &EnclaveSeedPackage{PackageLocator: testPackageLocator, PackageParams: "{}"}
+	require.Equal(t, newEnclaveSeedKey(testApiContainerVersion, noParams), newEnclaveSeedKey(testApiContainerVersion, emptyParams))
+}
+
+func TestNewEnclaveSeedKey_DifferentStates(t *testing.T) {
+	seedPackage := &EnclaveSeedPackage{PackageLocator: testPackageLocator, PackageParams: `{"network":"mainnet"}`}
+	seedKey := newEnclaveSeedKey(testApiContainerVersion, seedPackage)
+
+	otherParams := &EnclaveSeedPackage{PackageLocator: testPackageLocator, PackageParams: `{"network":"sepolia"}`}
+	require.NotEqual(t, seedKey, newEnclaveSeedKey(testApiContainerVersion, otherParams))
+	require.NotEqual(t, seedKey, newEnclaveSeedKey("2.0.0", seedPackage))
+	require.NotEqual(t, seedKey, newEnclaveSeedKey(testApiContainerVersion, nil))
+}
+
+func TestEnclavePoolGetEnclave_SeedPackageMismatch(t *testing.T) {
+	seedPackage := &EnclaveSeedPackage{PackageLocator: testPackageLocator, PackageParams: ""}
+	pool := &EnclavePool{ // nolint: exhaustruct
+		idleEnclavesChan: make(chan *types.EnclaveInfo, 1),
+		fillChan:         make(chan bool, 1),
+		seedPackage:      seedPackage,
+		seedKey:          newEnclaveSeedKey(testApiContainerVersion, seedPackage),
+	}
+	pool.idleEnclavesChan <- &types.EnclaveInfo{} // nolint: exhaustruct
+
+	// A blank enclave is requested, so the pre-seeded enclave of the pool isn't handed out
+	enclaveInfo, err := pool.GetEnclave(context.Background(), "test-enclave", testApiContainerVersion, "", defaultApiContainerLogLevel, false, nil)
+	require.NoError(t, err)
+	require.Nil(t, enclaveInfo)
+	require.Len(t, pool.idleEnclavesChan, 1)
+
+	otherSeedPackage := &EnclaveSeedPackage{PackageLocator: "github.com/kurtosis-tech/redis-package", PackageParams: ""}
+	enclaveInfo, err = pool.GetEnclave(context.Background(), "test-enclave", testApiContainerVersion, "", logrus.DebugLevel, false, otherSeedPackage)
+	require.NoError(t, err)
+	require.Nil(t, enclaveInfo)
+	require.Len(t, pool.idleEnclavesChan, 1)
+}
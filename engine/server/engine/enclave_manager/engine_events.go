@@ -0,0 +1,142 @@
+package enclave_manager
+
+import (
+	"context"
+	"sync"
+	"time"
+
+	rpc_api "github.com/kurtosis-tech/kurtosis/api/golang/core/kurtosis_core_rpc_api_bindings"
+	"github.com/kurtosis-tech/kurtosis/container-engine-lib/lib/backend_interface/objects/enclave"
+	"github.com/kurtosis-tech/kurtosis/engine/server/engine/types"
+	"github.com/kurtosis-tech/stacktrace"
+	"github.com/sirupsen/logrus"
+	"google.golang.org/protobuf/types/known/emptypb"
+)
+
+var apiContainerEventTypesToEngineEventTypes = map[rpc_api.ApiContainerEventType]types.EngineEventType{
+	rpc_api.ApiContainerEventType_STARLARK_RUN_STARTED:  types.EngineEventType_STARLARK_RUN_STARTED,
+	rpc_api.ApiContainerEventType_STARLARK_RUN_FINISHED: types.EngineEventType_STARLARK_RUN_FINISHED,
+	rpc_api.ApiContainerEventType_SERVICE_ADDED:         types.EngineEventType_SERVICE_ADDED,
+	rpc_api.ApiContainerEventType_SERVICE_STOPPED:       types.EngineEventType_SERVICE_STOPPED,
+	rpc_api.ApiContainerEventType_SERVICE_REMOVED:       types.EngineEventType_SERVICE_REMOVED,
+}
+
+// WatchEvents returns a channel receiving the events happening from now on, along with a function that stops watching
+// them, which must be called once done
+func (manager *EnclaveManager) WatchEvents() (<-chan *types.EngineEvent, func()) {
+	return manager.eventBroadcaster.Subscribe()
+}
+
+// RunApiContainerEventsWatcher forwards the Starlark runs and service changes that the API containers of the running
+// enclaves stream as engine events. It looks for API containers that aren't watched yet every interval until the context
+// is done, which is how the API containers that got restarted or that run in enclaves that got created are picked up.
+func (manager *EnclaveManager) RunApiContainerEventsWatcher(ctx context.Context, interval time.Duration) {
+	ticker := time.NewTicker(interval)
+	defer ticker.Stop()
+
+	watchedEnclaveUuids := &sync.Map{}
+	for {
+		manager.watchUnwatchedApiContainers(ctx, watchedEnclaveUuids)
+		select {
+		case <-ctx.Done():
+			return
+		case <-ticker.C:
+		}
+	}
+}
+
+func (manager *EnclaveManager) watchUnwatchedApiContainers(ctx context.Context, watchedEnclaveUuids *sync.Map) {
+	enclaves, err := manager.kurtosisBackend.GetEnclaves(ctx, getAllEnclavesFilter())
+	if err != nil {
+		logrus.Errorf("An error occurred getting the enclaves to watch the events of their API containers, they'll be looked for again later. Error was:\n%v", err)
+		return
+	}
+
+	for enclaveUuid, enclaveObj := range enclaves {
+		if enclaveObj.GetStatus() != enclave.EnclaveStatus_Running || isIdleEnclave(*enclaveObj) {
+			continue
+		}
+		if _, isWatched := watchedEnclaveUuids.LoadOrStore(enclaveUuid, true); isWatched {
+			continue
+		}
+		go func(enclaveUuid enclave.EnclaveUUID, enclaveName string) {
+			defer watchedEnclaveUuids.Delete(enclaveUuid)
+			if err := manager.forwardApiContainerEvents(ctx, enclaveUuid, enclaveName); err != nil {
+				// This is expected whenever the enclave gets stopped or destroyed, so it's only worth debugging
+				logrus.Debugf("Stopped watching the events of the API container of enclave '%v', it'll be watched again if it's still running. Error was:\n%v", enclaveName, err)
+			}
+		}(enclaveUuid, enclaveObj.GetName())
+	}
+}
+
+// Blocks until the event stream of the API container ends
+func (manager *EnclaveManager) forwardApiContainerEvents(ctx context.Context, enclaveUuid enclave.EnclaveUUID, enclaveName string) error {
+	apiContainerClient, closeClientFunc, err := manager.getApiContainerClient(ctx, enclaveUuid)
+	if err != nil {
+		return stacktrace.Propagate(err, "An error occurred getting a client for the API container of enclave '%v'", enclaveUuid)
+	}
+	if apiContainerClient == nil {
+		return nil
+	}
+	defer closeClientFunc()
+
+	eventStream, err := apiContainerClient.WatchEvents(ctx, &emptypb.Empty{})
+	if err != nil {
+		return stacktrace.Propagate(err, "An error occurred opening the event stream of the API container of enclave '%v'", enclaveUuid)
+	}
+	for {
+		apiContainerEvent, err := eventStream.Recv()
+		if err != nil {
+			return stacktrace.Propagate(err, "An error occurred receiving an event from the API container of enclave '%v'", enclaveUuid)
+		}
+		engineEvent, found := newEngineEventFromApiContainerEvent(apiContainerEvent, enclaveUuid, enclaveName)
+		if !found {
+			logrus.Warnf("Received event of unknown type '%v' from the API container of enclave '%v', it won't be forwarded", apiContainerEvent.GetType(), enclaveName)
+			continue
+		}
+		manager.eventBroadcaster.Publish(engineEvent)
+	}
+}
+
+func (manager *EnclaveManager) publishEnclaveEvent(eventType types.EngineEventType, enclaveUuid string, enclaveName string) {
+	manager.eventBroadcaster.Publish(&types.EngineEvent{
+		Type:                 eventType,
+		Timestamp:            time.Now(),
+		EnclaveUuid:          enclaveUuid,
+		EnclaveName:          enclaveName,
+		ServiceName:          nil,
+		StarlarkPackageId:    nil,
+		StarlarkRunSucceeded: nil,
+	})
+}
+
+// The events only carry the name of the enclave for convenience, so a failure to get it isn't worth failing the operation
+func (manager *EnclaveManager) getEnclaveName(ctx context.Context, enclaveUuid enclave.EnclaveUUID) string {
+	enclaves, err := manager.kurtosisBackend.GetEnclaves(ctx, getEnclaveByEnclaveIdFilter(enclaveUuid))
+	if err != nil {
+		logrus.Warnf("An error occurred getting the name of enclave '%v' for its events. Error was:\n%v", enclaveUuid, err)
+		return enclaveNameNotFound
+	}
+	enclaveObj, found := enclaves[enclaveUuid]
+	if !found {
+		return enclaveNameNotFound
+	}
+	return enclaveObj.GetName()
+}
+
+// Returns false if the event type isn't known, which can happen with API containers running a newer version than the engine
+func newEngineEventFromApiContainerEvent(apiContainerEvent *rpc_api.ApiContainerEvent, enclaveUuid enclave.EnclaveUUID, enclaveName string) (*types.EngineEvent, bool) {
+	eventType, found := apiContainerEventTypesToEngineEventTypes[apiContainerEvent.GetType()]
+	if !found {
+		return nil, false
+	}
+	return &types.EngineEvent{
+		Type:                 eventType,
+		Timestamp:            apiContainerEvent.GetTimestamp().AsTime(),
+		EnclaveUuid:          string(enclaveUuid),
+		EnclaveName:          enclaveName,
+		ServiceName:          apiContainerEvent.ServiceName,
+		StarlarkPackageId:    apiContainerEvent.StarlarkPackageId,
+		StarlarkRunSucceeded: apiContainerEvent.StarlarkRunSucceeded,
+	}, true
+}
@@ -0,0 +1,36 @@
+package enclave_manager
+
+import (
+	"testing"
+
+	rpc_api "github.com/kurtosis-tech/kurtosis/api/golang/core/kurtosis_core_rpc_api_bindings"
+	"github.com/kurtosis-tech/kurtosis/engine/server/engine/types"
+	"github.com/stretchr/testify/require"
+	"google.golang.org/protobuf/types/known/timestamppb"
+)
+
+func TestApiContainerEventTypesToEngineEventTypes_AllTypesAreMapped(t *testing.T) {
+	for eventTypeValue := range rpc_api.ApiContainerEventType_name {
+		_, found := apiContainerEventTypesToEngineEventTypes[rpc_api.ApiContainerEventType(eventTypeValue)]
+		require.True(t, found, "API container event type '%v' isn't mapped to an engine event type", rpc_api.ApiContainerEventType(eventTypeValue))
+	}
+}
+
+func TestNewEngineEventFromApiContainerEvent(t *testing.T) {
+	serviceName := "service"
+	apiContainerEvent := &rpc_api.ApiContainerEvent{
+		Type:                 rpc_api.ApiContainerEventType_SERVICE_ADDED,
+		Timestamp:            timestamppb.New(now),
+		ServiceName:          &serviceName,
+		StarlarkPackageId:    nil,
+		StarlarkRunSucceeded: nil,
+	}
+
+	event, ok := newEngineEventFromApiContainerEvent(apiContainerEvent, "enclave-uuid", "enclave-name")
+	require.True(t, ok)
+	require.Equal(t, types.EngineEventType_SERVICE_ADDED, event.Type)
+	require.Equal(t, now, event.Timestamp)
+	require.Equal(t, "enclave-uuid", event.EnclaveUuid)
+	require.Equal(t, "enclave-name", event.EnclaveName)
+	require.Equal(t, &serviceName, event.ServiceName)
+}
@@ -0,0 +1,162 @@
+package enclave_manager
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/kurtosis-tech/kurtosis/container-engine-lib/lib/backend_interface/objects/enclave"
+	"github.com/kurtosis-tech/stacktrace"
+	"github.com/sirupsen/logrus"
+	"google.golang.org/protobuf/types/known/emptypb"
+)
+
+const (
+	getLastActivityTimeTimeout = 10 * time.Second
+)
+
+// RunExpiredEnclavesReaper stops or destroys, depending on what they were created with, the enclaves that outlived their
+// time-to-live or that stayed idle for longer than their idle timeout. It checks every interval until the context is done.
+// The expirations live in the backend, so enclaves that expired while the engine was down get reaped on the first check.
+func (manager *EnclaveManager) RunExpiredEnclavesReaper(ctx context.Context, interval time.Duration) {
+	ticker := time.NewTicker(interval)
+	defer ticker.Stop()
+
+	for {
+		manager.reapExpiredEnclaves(ctx)
+		select {
+		case <-ctx.Done():
+			return
+		case <-ticker.C:
+		}
+	}
+}
+
+func (manager *EnclaveManager) reapExpiredEnclaves(ctx context.Context) {
+	// The mutex isn't held while looking for expired enclaves because asking the API containers for their last activity
+	// can be slow, it's only held while checking again and reaping the ones that were found expired
+	enclaves, err := manager.kurtosisBackend.GetEnclaves(ctx, getAllEnclavesFilter())
+	if err != nil {
+		logrus.Errorf("An error occurred getting the enclaves to look for expired ones, they'll be checked again later. Error was:\n%v", err)
+		return
+	}
+
+	for enclaveUuid, enclaveObj := range enclaves {
+		if _, isExpired := manager.getEnclaveExpirationReason(ctx, enclaveObj); !isExpired {
+			continue
+		}
+
+		expirationReason, action, isReaped, err := manager.reapEnclaveIfStillExpired(ctx, enclaveUuid)
+		if err != nil {
+			logrus.Errorf("Enclave '%v' expired but an error occurred reaping it, it'll be retried later. Error was:\n%v", enclaveObj.GetName(), err)
+			continue
+		}
+		if !isReaped {
+			logrus.Debugf("Enclave '%v' was found expired but was changed before it could be reaped, it was left as is", enclaveObj.GetName())
+			continue
+		}
+		logrus.Infof("Reaped enclave '%v' with action '%v' because %s", enclaveObj.GetName(), action, expirationReason)
+	}
+}
+
+// Returns a human-readable reason for the expiration and true if the enclave should be reaped
+func (manager *EnclaveManager) getEnclaveExpirationReason(ctx context.Context, enclaveObj *enclave.Enclave) (string, bool) {
+	expiration := enclaveObj.GetExpiration()
+	if expiration == nil || isIdleEnclave(*enclaveObj) {
+		return "", false
+	}
+
+	var lastActivityTime *time.Time
+	if expiration.GetIdleTimeout() > 0 && enclaveObj.GetStatus() == enclave.EnclaveStatus_Running {
+		var err error
+		lastActivityTime, err = manager.getApiContainerLastActivityTime(ctx, enclaveObj.GetUUID())
+		if err != nil {
+			logrus.Warnf("An error occurred getting the last activity time of enclave '%v', its idle timeout will be checked again later. Error was:\n%v", enclaveObj.GetName(), err)
+		}
+	}
+
+	return getEnclaveExpirationReason(expiration, enclaveObj.GetStatus(), lastActivityTime, time.Now())
+}
+
+// The enclave is fetched and checked again under the mutex, as it may have been given a new expiration, or been
+// stopped or destroyed, since it was found expired
+// Returns the reason for the expiration, the action the enclave was reaped with and whether it was reaped
+func (manager *EnclaveManager) reapEnclaveIfStillExpired(ctx context.Context, enclaveUuid enclave.EnclaveUUID) (string, enclave.ExpiredEnclaveAction, bool, error) {
+	manager.mutex.Lock()
+	defer manager.mutex.Unlock()
+
+	enclaves, err := manager.kurtosisBackend.GetEnclaves(ctx, getEnclaveByEnclaveIdFilter(enclaveUuid))
+	if err != nil {
+		return "", 0, false, stacktrace.Propagate(err, "An error occurred getting enclave '%v'", enclaveUuid)
+	}
+	enclaveObj, found := enclaves[enclaveUuid]
+	if !found {
+		return "", 0, false, nil
+	}
+	expirationReason, isExpired := manager.getEnclaveExpirationReason(ctx, enclaveObj)
+	if !isExpired {
+		return "", 0, false, nil
+	}
+
+	action := enclaveObj.GetExpiration().GetAction()
+	switch action {
+	case enclave.ExpiredEnclaveAction_Stop:
+		if err := manager.stopEnclaveWithoutMutex(ctx, enclaveUuid); err != nil {
+			return "", 0, false, stacktrace.Propagate(err, "An error occurred stopping expired enclave '%v'", enclaveUuid)
+		}
+	case enclave.ExpiredEnclaveAction_Destroy:
+		if err := manager.destroyEnclaveWithoutMutex(ctx, enclaveUuid); err != nil {
+			return "", 0, false, stacktrace.Propagate(err, "An error occurred destroying expired enclave '%v'", enclaveUuid)
+		}
+	default:
+		return "", 0, false, stacktrace.NewError("Unrecognized expired enclave action '%v'; this is a bug in Kurtosis", action)
+	}
+	return expirationReason, action, true, nil
+}
+
+// Returns nil if the API container of the enclave isn't reachable
+func (manager *EnclaveManager) getApiContainerLastActivityTime(ctx context.Context, enclaveUuid enclave.EnclaveUUID) (*time.Time, error) {
+	apiContainerClient, closeClientFunc, err := manager.getApiContainerClient(ctx, enclaveUuid)
+	if err != nil {
+		return nil, stacktrace.Propagate(err, "An error occurred getting a client for the API container of enclave '%v'", enclaveUuid)
+	}
+	if apiContainerClient == nil {
+		return nil, nil
+	}
+	defer closeClientFunc()
+
+	ctxWithTimeout, cancel := context.WithTimeout(ctx, getLastActivityTimeTimeout)
+	defer cancel()
+	response, err := apiContainerClient.GetLastActivityTime(ctxWithTimeout, &emptypb.Empty{})
+	if err != nil {
+		return nil, stacktrace.Propagate(err, "An error occurred getting the last activity time from the API container of enclave '%v'", enclaveUuid)
+	}
+	lastActivityTime := response.GetLastActivityTime().AsTime()
+	return &lastActivityTime, nil
+}
+
+// Returns a human-readable reason for the expiration and true if the enclave should be reaped
+// The idle timeout is only checked when the last activity time of the enclave is known, and enclaves that get stopped when
+// they expire are only reaped while they're still running
+func getEnclaveExpirationReason(
+	expiration *enclave.EnclaveExpiration,
+	status enclave.EnclaveStatus,
+	lastActivityTime *time.Time,
+	now time.Time,
+) (string, bool) {
+	if expiration.GetAction() == enclave.ExpiredEnclaveAction_Stop && status != enclave.EnclaveStatus_Running {
+		return "", false
+	}
+
+	if expirationTime := expiration.GetExpirationTime(); expirationTime != nil && !now.Before(*expirationTime) {
+		return fmt.Sprintf("its time-to-live ran out at '%v'", expirationTime.Format(time.RFC3339)), true
+	}
+
+	if idleTimeout := expiration.GetIdleTimeout(); idleTimeout > 0 && lastActivityTime != nil {
+		if idleDuration := now.Sub(*lastActivityTime); idleDuration >= idleTimeout {
+			return fmt.Sprintf("it has been idle since '%v', which is longer than its '%v' idle timeout", lastActivityTime.Format(time.RFC3339), idleTimeout), true
+		}
+	}
+
+	return "", false
+}
@@ -0,0 +1,84 @@
+package enclave_manager
+
+import (
+	"context"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/kurtosis-tech/kurtosis/container-engine-lib/lib/backend_impls/in_memory/in_memory_kurtosis_backend"
+	"github.com/kurtosis-tech/kurtosis/container-engine-lib/lib/backend_interface/objects/enclave"
+	"github.com/stretchr/testify/require"
+)
+
+const (
+	testExpiringEnclaveUuid = enclave.EnclaveUUID("expiring-enclave-uuid")
+	testExpiringEnclaveName = "expiring-enclave"
+)
+
+var now = time.Date(2024, time.January, 1, 12, 0, 0, 0, time.UTC)
+
+func TestGetEnclaveExpirationReason_TtlRanOut(t *testing.T) {
+	expiration := newEnclaveExpiration(now.Add(-2*time.Hour), time.Hour, 0, enclave.ExpiredEnclaveAction_Destroy)
+	reason, isExpired := getEnclaveExpirationReason(expiration, enclave.EnclaveStatus_Stopped, nil, now)
+	require.True(t, isExpired)
+	require.Contains(t, reason, "time-to-live")
+}
+
+func TestGetEnclaveExpirationReason_TtlNotRanOut(t *testing.T) {
+	expiration := newEnclaveExpiration(now, time.Hour, 0, enclave.ExpiredEnclaveAction_Destroy)
+	_, isExpired := getEnclaveExpirationReason(expiration, enclave.EnclaveStatus_Running, nil, now)
+	require.False(t, isExpired)
+}
+
+func TestGetEnclaveExpirationReason_IdleForTooLong(t *testing.T) {
+	expiration := newEnclaveExpiration(now, 0, 10*time.Minute, enclave.ExpiredEnclaveAction_Stop)
+	lastActivityTime := now.Add(-15 * time.Minute)
+	reason, isExpired := getEnclaveExpirationReason(expiration, enclave.EnclaveStatus_Running, &lastActivityTime, now)
+	require.True(t, isExpired)
+	require.Contains(t, reason, "idle")
+}
+
+func TestGetEnclaveExpirationReason_RecentlyActive(t *testing.T) {
+	expiration := newEnclaveExpiration(now, 0, 10*time.Minute, enclave.ExpiredEnclaveAction_Destroy)
+	lastActivityTime := now.Add(-5 * time.Minute)
+	_, isExpired := getEnclaveExpirationReason(expiration, enclave.EnclaveStatus_Running, &lastActivityTime, now)
+	require.False(t, isExpired)
+}
+
+func TestGetEnclaveExpirationReason_UnknownActivityIsNotIdle(t *testing.T) {
+	expiration := newEnclaveExpiration(now, 0, 10*time.Minute, enclave.ExpiredEnclaveAction_Destroy)
+	_, isExpired := getEnclaveExpirationReason(expiration, enclave.EnclaveStatus_Running, nil, now)
+	require.False(t, isExpired)
+}
+
+func TestGetEnclaveExpirationReason_StoppedEnclaveIsNotStoppedAgain(t *testing.T) {
+	expiration := newEnclaveExpiration(now.Add(-2*time.Hour), time.Hour, 0, enclave.ExpiredEnclaveAction_Stop)
+	_, isExpired := getEnclaveExpirationReason(expiration, enclave.EnclaveStatus_Stopped, nil, now)
+	require.False(t, isExpired)
+}
+
+func TestReapEnclaveIfStillExpired_ExpirationExtendedSinceFoundExpired(t *testing.T) {
+	ctx := context.Background()
+	backend := in_memory_kurtosis_backend.NewInMemoryKurtosisBackend()
+	_, err := backend.CreateEnclave(ctx, testExpiringEnclaveUuid, testExpiringEnclaveName)
+	require.NoError(t, err)
+	// The enclave got a new time-to-live after the reaper found the previous one ran out
+	require.NoError(t, backend.UpdateEnclaveExpiration(ctx, testExpiringEnclaveUuid, newEnclaveExpiration(time.Now(), time.Hour, 0, enclave.ExpiredEnclaveAction_Destroy)))
+	manager := &EnclaveManager{mutex: &sync.Mutex{}, kurtosisBackend: backend} // nolint: exhaustruct
+
+	_, _, isReaped, err := manager.reapEnclaveIfStillExpired(ctx, testExpiringEnclaveUuid)
+	require.NoError(t, err)
+	require.False(t, isReaped)
+	enclaves, err := backend.GetEnclaves(ctx, getAllEnclavesFilter())
+	require.NoError(t, err)
+	require.Contains(t, enclaves, testExpiringEnclaveUuid)
+}
+
+func TestReapEnclaveIfStillExpired_DestroyedSinceFoundExpired(t *testing.T) {
+	manager := &EnclaveManager{mutex: &sync.Mutex{}, kurtosisBackend: in_memory_kurtosis_backend.NewInMemoryKurtosisBackend()} // nolint: exhaustruct
+
+	_, _, isReaped, err := manager.reapEnclaveIfStillExpired(context.Background(), testExpiringEnclaveUuid)
+	require.NoError(t, err)
+	require.False(t, isReaped)
+}
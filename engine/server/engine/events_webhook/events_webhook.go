@@ -0,0 +1,111 @@
+package events_webhook
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"io"
+	"net/http"
+	"time"
+
+	"github.com/kurtosis-tech/kurtosis/engine/server/engine/mapping/to_http"
+	"github.com/kurtosis-tech/kurtosis/engine/server/engine/types"
+	"github.com/kurtosis-tech/stacktrace"
+	"github.com/sirupsen/logrus"
+)
+
+const (
+	contentTypeHeader = "Content-Type"
+	jsonContentType   = "application/json"
+
+	requestTimeout = 10 * time.Second
+
+	defaultInitialRetryDelay = 500 * time.Millisecond
+	maxRetryDelay            = 30 * time.Second
+)
+
+// EventsWebhook POSTs the engine events, as the JSON the REST API streams, to a user-provided URL. The delivery of an
+// event is retried with an exponential backoff, and the event gets dropped once all the retries have failed
+type EventsWebhook struct {
+	url string
+
+	maxRetries uint32
+
+	initialRetryDelay time.Duration
+
+	httpClient *http.Client
+}
+
+func NewEventsWebhook(url string, maxRetries uint32) *EventsWebhook {
+	return &EventsWebhook{
+		url:               url,
+		maxRetries:        maxRetries,
+		initialRetryDelay: defaultInitialRetryDelay,
+		httpClient: &http.Client{ // nolint: exhaustruct
+			Timeout: requestTimeout,
+		},
+	}
+}
+
+// Run delivers the events, one at a time and in order, until the channel gets closed or the context is done
+func (webhook *EventsWebhook) Run(ctx context.Context, events <-chan *types.EngineEvent) {
+	for {
+		select {
+		case <-ctx.Done():
+			return
+		case event, isChannelOpen := <-events:
+			if !isChannelOpen {
+				return
+			}
+			if err := webhook.deliver(ctx, event); err != nil {
+				logrus.Errorf("Dropping the '%v' event of enclave '%v' as it couldn't be delivered to the events webhook. Error was:\n%v", event.Type, event.EnclaveName, err)
+			}
+		}
+	}
+}
+
+func (webhook *EventsWebhook) deliver(ctx context.Context, event *types.EngineEvent) error {
+	body, err := json.Marshal(to_http.ToHttpEngineEvent(*event))
+	if err != nil {
+		return stacktrace.Propagate(err, "An error occurred serializing event '%+v'", event)
+	}
+
+	retryDelay := webhook.initialRetryDelay
+	var lastErr error
+	for attempt := uint32(0); attempt <= webhook.maxRetries; attempt++ {
+		if attempt > 0 {
+			select {
+			case <-ctx.Done():
+				return stacktrace.Propagate(ctx.Err(), "The context got cancelled while waiting to retry the delivery of the event")
+			case <-time.After(retryDelay):
+			}
+			retryDelay = min(2*retryDelay, maxRetryDelay)
+		}
+		if lastErr = webhook.post(ctx, body); lastErr == nil {
+			return nil
+		}
+		logrus.Debugf("Attempt %d to deliver an event to the events webhook failed. Error was:\n%v", attempt+1, lastErr)
+	}
+	return stacktrace.Propagate(lastErr, "Delivering the event to the events webhook at '%v' still failed after %d retries", webhook.url, webhook.maxRetries)
+}
+
+func (webhook *EventsWebhook) post(ctx context.Context, body []byte) error {
+	request, err := http.NewRequestWithContext(ctx, http.MethodPost, webhook.url, bytes.NewReader(body))
+	if err != nil {
+		return stacktrace.Propagate(err, "An error occurred creating the request to the events webhook at '%v'", webhook.url)
+	}
+	request.Header.Set(contentTypeHeader, jsonContentType)
+
+	response, err := webhook.httpClient.Do(request)
+	if err != nil {
+		return stacktrace.Propagate(err, "An error occurred sending the request to the events webhook at '%v'", webhook.url)
+	}
+	defer response.Body.Close()
+	// Drained so that the connection can be reused
+	_, _ = io.Copy(io.Discard, response.Body)
+
+	if response.StatusCode < http.StatusOK || response.StatusCode >= http.StatusMultipleChoices {
+		return stacktrace.NewError("The events webhook at '%v' answered with status '%v'", webhook.url, response.Status)
+	}
+	return nil
+}
@@ -0,0 +1,83 @@
+package events_webhook
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"sync/atomic"
+	"testing"
+	"time"
+
+	api_type "github.com/kurtosis-tech/kurtosis/api/golang/http_rest/api_types"
+	"github.com/kurtosis-tech/kurtosis/engine/server/engine/types"
+	"github.com/stretchr/testify/require"
+)
+
+const (
+	testMaxRetries        = 2
+	testInitialRetryDelay = time.Millisecond
+)
+
+func TestRun_RetriesUntilTheEventIsDelivered(t *testing.T) {
+	var numRequests atomic.Int32
+	receivedEvents := make(chan api_type.EngineEvent, 1)
+	server := httptest.NewServer(http.HandlerFunc(func(writer http.ResponseWriter, request *http.Request) {
+		if numRequests.Add(1) <= testMaxRetries {
+			writer.WriteHeader(http.StatusServiceUnavailable)
+			return
+		}
+		var event api_type.EngineEvent
+		require.NoError(t, json.NewDecoder(request.Body).Decode(&event))
+		receivedEvents <- event
+	}))
+	defer server.Close()
+
+	sendEvent(t, server.URL)
+
+	select {
+	case event := <-receivedEvents:
+		require.Equal(t, api_type.ENCLAVECREATED, event.Type)
+		require.Equal(t, "enclave-uuid", event.EnclaveUuid)
+		require.Equal(t, "enclave-name", event.EnclaveName)
+	case <-time.After(5 * time.Second):
+		require.Fail(t, "The event was never delivered to the webhook")
+	}
+	require.Equal(t, int32(testMaxRetries+1), numRequests.Load())
+}
+
+func TestRun_GivesUpAfterTheMaxRetries(t *testing.T) {
+	var numRequests atomic.Int32
+	server := httptest.NewServer(http.HandlerFunc(func(writer http.ResponseWriter, request *http.Request) {
+		numRequests.Add(1)
+		writer.WriteHeader(http.StatusInternalServerError)
+	}))
+	defer server.Close()
+
+	sendEvent(t, server.URL)
+
+	require.Equal(t, int32(testMaxRetries+1), numRequests.Load())
+}
+
+// Blocks until the webhook is done with the event
+func sendEvent(t *testing.T, url string) {
+	webhook := NewEventsWebhook(url, testMaxRetries)
+	webhook.initialRetryDelay = testInitialRetryDelay
+
+	events := make(chan *types.EngineEvent, 1)
+	events <- &types.EngineEvent{
+		Type:                 types.EngineEventType_ENCLAVE_CREATED,
+		Timestamp:            time.Now(),
+		EnclaveUuid:          "enclave-uuid",
+		EnclaveName:          "enclave-name",
+		ServiceName:          nil,
+		StarlarkPackageId:    nil,
+		StarlarkRunSucceeded: nil,
+	}
+	close(events)
+
+	ctx, cancel := context.WithTimeout(context.Background(), 5*time.Second)
+	defer cancel()
+	webhook.Run(ctx, events)
+	require.NoError(t, ctx.Err())
+}
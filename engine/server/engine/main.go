@@ -20,6 +20,7 @@ import (
 	"strings"
 	"time"
 
+	"connectrpc.com/connect"
 	"github.com/kurtosis-tech/kurtosis/api/golang/core/kurtosis_core_rpc_api_bindings"
 	"github.com/kurtosis-tech/kurtosis/api/golang/engine/kurtosis_engine_rpc_api_bindings/kurtosis_engine_rpc_api_bindingsconnect"
 	enclaveApi "github.com/kurtosis-tech/kurtosis/api/golang/http_rest/server/core_rest_api"
@@ -28,23 +29,30 @@ import (
 	connect_server "github.com/kurtosis-tech/kurtosis/connect-server"
 	"github.com/kurtosis-tech/kurtosis/container-engine-lib/lib/backend_impls/docker/docker_kurtosis_backend/backend_creator"
 	"github.com/kurtosis-tech/kurtosis/container-engine-lib/lib/backend_impls/docker/docker_kurtosis_backend/consts"
+	"github.com/kurtosis-tech/kurtosis/container-engine-lib/lib/backend_impls/in_memory/in_memory_kurtosis_backend"
 	"github.com/kurtosis-tech/kurtosis/container-engine-lib/lib/backend_impls/kubernetes/kubernetes_kurtosis_backend"
 	"github.com/kurtosis-tech/kurtosis/container-engine-lib/lib/backend_interface"
 	"github.com/kurtosis-tech/kurtosis/container-engine-lib/lib/backend_interface/objects/configs"
 	"github.com/kurtosis-tech/kurtosis/container-engine-lib/lib/backend_interface/objects/engine"
 	"github.com/kurtosis-tech/kurtosis/container-engine-lib/lib/backend_interface/objects/logs_collector"
+	"github.com/kurtosis-tech/kurtosis/container-engine-lib/lib/tracing"
 	"github.com/kurtosis-tech/kurtosis/core/launcher/api_container_launcher"
 	em_api "github.com/kurtosis-tech/kurtosis/enclave-manager/server"
 	"github.com/kurtosis-tech/kurtosis/engine/launcher/args"
 	"github.com/kurtosis-tech/kurtosis/engine/launcher/args/kurtosis_backend_config"
+	"github.com/kurtosis-tech/kurtosis/engine/server/engine/api_auth"
+	"github.com/kurtosis-tech/kurtosis/engine/server/engine/audit_log"
 	"github.com/kurtosis-tech/kurtosis/engine/server/engine/centralized_logs"
 	"github.com/kurtosis-tech/kurtosis/engine/server/engine/centralized_logs/client_implementations/persistent_volume"
 	"github.com/kurtosis-tech/kurtosis/engine/server/engine/centralized_logs/client_implementations/persistent_volume/file_layout"
 	"github.com/kurtosis-tech/kurtosis/engine/server/engine/centralized_logs/client_implementations/persistent_volume/log_file_manager"
 	"github.com/kurtosis-tech/kurtosis/engine/server/engine/centralized_logs/client_implementations/persistent_volume/logs_clock"
 	"github.com/kurtosis-tech/kurtosis/engine/server/engine/centralized_logs/client_implementations/persistent_volume/stream_logs_strategy"
+	"github.com/kurtosis-tech/kurtosis/engine/server/engine/centralized_logs/client_implementations/persistent_volume/volume_consts"
 	"github.com/kurtosis-tech/kurtosis/engine/server/engine/centralized_logs/client_implementations/persistent_volume/volume_filesystem"
 	"github.com/kurtosis-tech/kurtosis/engine/server/engine/enclave_manager"
+	"github.com/kurtosis-tech/kurtosis/engine/server/engine/events_webhook"
+	"github.com/kurtosis-tech/kurtosis/engine/server/engine/rpc_tracing"
 	"github.com/kurtosis-tech/kurtosis/engine/server/engine/server"
 	"github.com/kurtosis-tech/kurtosis/engine/server/engine/streaming"
 	"github.com/kurtosis-tech/kurtosis/engine/server/engine/utils"
@@ -54,6 +62,7 @@ import (
 	"github.com/kurtosis-tech/stacktrace"
 	"github.com/labstack/echo/v4"
 	echomiddleware "github.com/labstack/echo/v4/middleware"
+	"github.com/prometheus/client_golang/prometheus/promhttp"
 	"github.com/rs/cors"
 	"github.com/sirupsen/logrus"
 )
@@ -73,6 +82,7 @@ const (
 	emptyFunctionName         = ""
 	webappPortAddr            = ":9711"
 	pprofPath                 = "/debug/pprof/"
+	prometheusMetricsPath     = "/metrics"
 
 	remoteBackendConfigFilename = "remote_backend_config.json"
 	pathToStaticFolder          = "/run/webapp"
@@ -91,11 +101,30 @@ const (
 
 	envJsFilename = "env.js"
 	envJsFilePerm = 0644
+
+	tracingServiceName = "kurtosis-engine"
+
+	expiredEnclavesReaperInterval = time.Minute
+
+	apiContainerEventsWatcherInterval = 5 * time.Second
+
+	// Kept on the logs storage volume so that it outlives the engine, the logs retention only removing the yearly logs
+	// directories
+	auditLogFilepath = volume_consts.LogsStorageDirpath + "audit/engine_audit_log.jsonl"
+
+	// The copies of the API container audit logs, which would otherwise be gone along with the enclaves
+	apiContainerAuditLogArchiveFilepath = volume_consts.LogsStorageDirpath + "audit/api_container_audit_log.jsonl"
+
+	// The API containers are launched with keys derived from it, so it must outlive the engine as well
+	auditLogCallerKeyFilepath = volume_consts.LogsStorageDirpath + "audit/engine_caller.key"
+
+	// Also kept on the logs storage volume, so that the API tokens created through the engine survive its restarts
+	apiTokensDatabaseFilepath = volume_consts.LogsStorageDirpath + "api-auth/api_tokens.db"
 )
 
 var (
 	defaultCORSOrigins []string = []string{"*"}
-	defaultCORSHeaders []string = []string{echo.HeaderOrigin, echo.HeaderContentType, echo.HeaderAccept}
+	defaultCORSHeaders []string = []string{echo.HeaderOrigin, echo.HeaderContentType, echo.HeaderAccept, echo.HeaderAuthorization}
 )
 
 // Nil indicates that the KurtosisBackend should not operate in API container mode, which is appropriate here
@@ -152,6 +181,16 @@ func runMain() error {
 	}
 	logrus.SetLevel(logLevel)
 
+	shutdownTracerProviderFunc, err := tracing.InitTracerProvider(ctx, serverArgs.OtlpEndpoint, tracingServiceName, serverArgs.ImageVersionTag)
+	if err != nil {
+		return stacktrace.Propagate(err, "An error occurred initializing the tracer provider")
+	}
+	defer func() {
+		if err := shutdownTracerProviderFunc(ctx); err != nil {
+			logrus.Warnf("An error occurred shutting down the tracer provider, the last engine traces might not have been exported. Error was:\n%v", err)
+		}
+	}()
+
 	backendConfig := serverArgs.KurtosisLocalBackendConfig
 	if backendConfig == nil {
 		return stacktrace.NewError("Backend configuration parameters are null - there must be backend configuration parameters.")
@@ -183,6 +222,16 @@ func runMain() error {
 	logsDatabaseClient := getLogsDatabaseClient(kurtosisBackend, logRetentionPeriodDuration)
 	logsDatabaseClient.StartLogFileManagement(ctx)
 
+	callerKey, err := audit_log.GetOrCreateCallerKey(auditLogCallerKeyFilepath)
+	if err != nil {
+		return stacktrace.Propagate(err, "An error occurred getting the key the audit log callers are forwarded to the API containers with")
+	}
+
+	apiContainerAuditLogArchive, err := audit_log.GetOrCreateApiContainerAuditLogArchive(apiContainerAuditLogArchiveFilepath)
+	if err != nil {
+		return stacktrace.Propagate(err, "An error occurred getting the archive of the API container audit logs")
+	}
+
 	enclaveManager, err := getEnclaveManager(
 		kurtosisBackend,
 		serverArgs.KurtosisBackendType,
@@ -198,6 +247,10 @@ func runMain() error {
 		serverArgs.KurtosisLocalBackendConfig,
 		serverArgs.LogsCollectorFilters,
 		serverArgs.LogsCollectorParsers,
+		serverArgs.OtlpEndpoint,
+		serverArgs.EnclavePoolSeedPackage,
+		callerKey,
+		apiContainerAuditLogArchive,
 	)
 	if err != nil {
 		return stacktrace.Propagate(err, "Failed to create an enclave manager for backend type '%v' and config '%+v'", serverArgs.KurtosisBackendType, backendConfig)
@@ -241,6 +294,7 @@ func runMain() error {
 			fileServer.ServeHTTP(w, r)
 		})
 		handler.Handle(pprofPath, http.HandlerFunc(http.DefaultServeMux.ServeHTTP))
+		handler.Handle(prometheusMetricsPath, promhttp.Handler())
 
 		err := http.ListenAndServe(webappPortAddr, handler)
 		if err != nil {
@@ -250,7 +304,8 @@ func runMain() error {
 
 	go func() {
 		enforceAuth := serverArgs.OnBastionHost
-		err = em_api.RunEnclaveManagerApiServer(enforceAuth)
+		isEngineApiAuthEnabled := serverArgs.ApiAuth != nil
+		err = em_api.RunEnclaveManagerApiServer(enforceAuth, isEngineApiAuthEnabled)
 		if err != nil {
 			logrus.Fatal("an error occurred while processing the auth settings, exiting!", err)
 			fmt.Fprintln(logrus.StandardLogger().Out, err)
@@ -283,12 +338,41 @@ func runMain() error {
 		}
 	}()
 
+	// Subscribed before the API containers get restarted so that the webhook gets notified of it
+	if serverArgs.EventsWebhook != nil {
+		events, stopWatchingEvents := enclaveManager.WatchEvents()
+		defer stopWatchingEvents()
+		go events_webhook.NewEventsWebhook(serverArgs.EventsWebhook.Url, serverArgs.EventsWebhook.MaxRetries).Run(ctx, events)
+	}
+
 	if serverArgs.RestartAPIContainers {
 		if err := enclaveManager.RestartAllEnclaveAPIContainers(ctx); err != nil {
 			return stacktrace.Propagate(err, "An error occurred restarting all API containers.")
 		}
 	}
 
+	go enclaveManager.RunExpiredEnclavesReaper(ctx, expiredEnclavesReaperInterval)
+	go enclaveManager.RunApiContainerEventsWatcher(ctx, apiContainerEventsWatcherInterval)
+
+	auditLog, err := audit_log.GetOrCreateAuditLog(auditLogFilepath)
+	if err != nil {
+		return stacktrace.Propagate(err, "An error occurred getting the audit log")
+	}
+
+	var apiTokenStore *api_auth.ApiTokenStore
+	if serverArgs.ApiAuth != nil {
+		apiTokenStore, err = api_auth.GetOrCreateApiTokenStore(apiTokensDatabaseFilepath, serverArgs.ApiAuth)
+		if err != nil {
+			return stacktrace.Propagate(err, "An error occurred getting the API token store")
+		}
+		defer func() {
+			if err := apiTokenStore.Close(); err != nil {
+				logrus.Warnf("We tried to close the API token store but something failed. Err:\n%v", err)
+			}
+		}()
+		logrus.Info("API authentication is enforced")
+	}
+
 	go func() {
 		err := restApiServer(
 			ctx,
@@ -296,6 +380,9 @@ func runMain() error {
 			enclaveManager,
 			logsDatabaseClient,
 			metricsClient,
+			apiTokenStore,
+			auditLog,
+			callerKey,
 		)
 		if err != nil {
 			logrus.Fatal("The REST API server is down, exiting!", err)
@@ -310,8 +397,23 @@ func runMain() error {
 		serverArgs.MetricsUserID,
 		serverArgs.DidUserAcceptSendingMetrics,
 		logsDatabaseClient,
-		metricsClient)
-	apiPath, handler := kurtosis_engine_rpc_api_bindingsconnect.NewEngineServiceHandler(engineConnectServer)
+		metricsClient,
+		apiTokenStore,
+		auditLog,
+		callerKey,
+		serverArgs.LogRetentionPeriod,
+		serverArgs.LogLevelStr,
+		serverArgs.PoolSize,
+		serverArgs.IsDebugModeEnabled,
+		serverArgs.Domain)
+	// The tracing interceptor comes first so that the spans cover the whole RPC, and the audit log interceptor comes
+	// before the API authentication so that the latter can identify the callers by their API token
+	handlerInterceptors := []connect.Interceptor{rpc_tracing.NewConnectInterceptor(), audit_log.NewConnectInterceptor()}
+	if apiTokenStore != nil {
+		handlerInterceptors = append(handlerInterceptors, api_auth.NewConnectInterceptor(apiTokenStore, enclaveManager.GetEnclaveNameForEnclaveIdentifier))
+	}
+	handlerOptions := []connect.HandlerOption{connect.WithInterceptors(handlerInterceptors...)}
+	apiPath, handler := kurtosis_engine_rpc_api_bindingsconnect.NewEngineServiceHandler(engineConnectServer, handlerOptions...)
 	defer func() {
 		if err := engineConnectServer.Close(); err != nil {
 			logrus.Errorf("We tried to close the engine connect server service but something fails. Err:\n%v", err)
@@ -341,6 +443,10 @@ func getEnclaveManager(
 	kurtosisLocalBackendConfig interface{},
 	logsCollectorFilters []logs_collector.Filter,
 	logsCollectorParsers []logs_collector.Parser,
+	otlpEndpoint string,
+	poolSeedPackageConfig *args.EnclavePoolSeedPackageConfig,
+	callerKey *audit_log.CallerKey,
+	apiContainerAuditLogArchive *audit_log.ApiContainerAuditLogArchive,
 ) (*enclave_manager.EnclaveManager, error) {
 	var apiContainerKurtosisBackendConfigSupplier api_container_launcher.KurtosisBackendConfigSupplier
 	switch kurtosisBackendType {
@@ -353,11 +459,24 @@ func getEnclaveManager(
 		if !ok {
 			return nil, stacktrace.NewError("Failed to cast cluster configuration interface to the appropriate type, even though Kurtosis backend type is '%v'", args.KurtosisBackendType_Kubernetes.String())
 		}
-		apiContainerKurtosisBackendConfigSupplier = api_container_launcher.NewKubernetesKurtosisBackendConfigSupplier(kurtosisLocalBackendConfigKubernetesType.StorageClass)
+		apiContainerKurtosisBackendConfigSupplier = api_container_launcher.NewKubernetesKurtosisBackendConfigSupplier(
+			kurtosisLocalBackendConfigKubernetesType.StorageClass,
+			kurtosisLocalBackendConfigKubernetesType.ImageBuildRegistry,
+		)
+	case args.KurtosisBackendType_InMemory:
+		apiContainerKurtosisBackendConfigSupplier = api_container_launcher.NewInMemoryKurtosisBackendConfigSupplier()
 	default:
 		return nil, stacktrace.NewError("Backend type '%v' was not recognized by engine server.", kurtosisBackendType.String())
 	}
 
+	var poolSeedPackage *enclave_manager.EnclaveSeedPackage
+	if poolSeedPackageConfig != nil {
+		poolSeedPackage = &enclave_manager.EnclaveSeedPackage{
+			PackageLocator: poolSeedPackageConfig.PackageLocator,
+			PackageParams:  poolSeedPackageConfig.PackageParams,
+		}
+	}
+
 	enclaveManager, err := enclave_manager.CreateEnclaveManager(
 		kurtosisBackend,
 		kurtosisBackendType,
@@ -373,6 +492,10 @@ func getEnclaveManager(
 		cloudInstanceId,
 		logsCollectorFilters,
 		logsCollectorParsers,
+		otlpEndpoint,
+		poolSeedPackage,
+		callerKey,
+		apiContainerAuditLogArchive,
 	)
 	if err != nil {
 		return nil, stacktrace.Propagate(err, "An error occurred creating enclave manager for backend type '%+v' using pool-size '%v' and engine version '%v'", kurtosisBackendType, poolSize, engineVersion)
@@ -406,13 +529,20 @@ func getKurtosisBackend(ctx context.Context, kurtosisBackendType args.KurtosisBa
 		if !ok {
 			return nil, stacktrace.NewError("Failed to cast cluster configuration interface to the appropriate type, even though Kurtosis backend type is '%v'", args.KurtosisBackendType_Kubernetes.String())
 		}
-		kurtosisBackend, err = kubernetes_kurtosis_backend.GetEngineServerBackend(ctx, clusterConfigK8s.StorageClass)
+		kurtosisBackend, err = kubernetes_kurtosis_backend.GetEngineServerBackend(ctx, clusterConfigK8s.StorageClass, clusterConfigK8s.EnclaveNamespace)
 		if err != nil {
 			return nil, stacktrace.Propagate(
 				err,
 				"An error occurred getting Kurtosis Kubernetes backend for engine",
 			)
 		}
+	case args.KurtosisBackendType_InMemory:
+		if remoteBackendConfigMaybe != nil {
+			return nil, stacktrace.NewError("Using a Remote Kurtosis Backend isn't allowed with the in-memory backend")
+		}
+		// Shared with the API containers served in the same process, so they all see the same enclaves and services;
+		// enclaves can only be created once their start handler is set, see core/server's in_memory_api_container
+		kurtosisBackend = in_memory_kurtosis_backend.GetDefaultInMemoryKurtosisBackend()
 	default:
 		return nil, stacktrace.NewError("Backend type '%v' was not recognized by engine server.", kurtosisBackendType.String())
 	}
@@ -455,6 +585,9 @@ func restApiServer(
 	enclave_manager *enclave_manager.EnclaveManager,
 	logsDatabaseClient centralized_logs.LogsDatabaseClient,
 	metricsClient metrics_client.MetricsClient,
+	apiTokenStore *api_auth.ApiTokenStore,
+	auditLog *audit_log.AuditLog,
+	callerKey *audit_log.CallerKey,
 ) error {
 
 	asyncStarlarkLogs := streaming.NewStreamerPool[*kurtosis_core_rpc_api_bindings.StarlarkRunResponseLine](streamerPoolSize, streamerExpirationTime)
@@ -477,12 +610,18 @@ func restApiServer(
 		AllowHeaders: defaultCORSHeaders,
 	}))
 
+	echoApiRouter.Use(audit_log.NewEchoMiddleware())
+	if apiTokenStore != nil {
+		echoApiRouter.Use(api_auth.NewEchoMiddleware(apiTokenStore, enclave_manager.GetEnclaveNameForEnclaveIdentifier))
+	}
+
 	// ============================== Engine Management API ======================================
 	engineRuntime := server.EngineRuntime{
 		ImageVersionTag: serverArgs.ImageVersionTag,
 		EnclaveManager:  enclave_manager,
 		LogsDbClient:    logsDatabaseClient,
 		MetricsClient:   metricsClient,
+		AuditLog:        auditLog,
 	}
 	engineApi.RegisterHandlers(echoApiRouter, engineApi.NewStrictHandler(engineRuntime, nil))
 
@@ -505,7 +644,7 @@ func restApiServer(
 	loggingApi.RegisterHandlers(echoApiRouter, webSocketRuntime)
 
 	// ============================== Engine Management API ======================================
-	enclaveRuntime, err := server.NewEnclaveRuntime(ctx, *enclave_manager, asyncStarlarkLogs, false)
+	enclaveRuntime, err := server.NewEnclaveRuntime(ctx, *enclave_manager, asyncStarlarkLogs, false, callerKey)
 	if err != nil {
 		newErr := stacktrace.Propagate(err, "Failed to initialize %T", enclaveRuntime)
 		return newErr
@@ -3,6 +3,7 @@ package to_http
 import (
 	"fmt"
 
+	"github.com/kurtosis-tech/kurtosis/engine/server/engine/audit_log"
 	"github.com/kurtosis-tech/kurtosis/engine/server/engine/types"
 	"github.com/kurtosis-tech/kurtosis/engine/server/engine/utils"
 	"github.com/sirupsen/logrus"
@@ -73,7 +74,31 @@ func ToHttpEnclaveMode(mode types.EnclaveMode) api_type.EnclaveMode {
 	}
 }
 
+func ToHttpExpiredEnclaveAction(action types.ExpiredEnclaveAction) api_type.ExpiredEnclaveAction {
+	switch action {
+	case types.ExpiredEnclaveAction_DESTROY:
+		return api_type.ExpiredEnclaveActionDESTROY
+	case types.ExpiredEnclaveAction_STOP:
+		return api_type.ExpiredEnclaveActionSTOP
+	default:
+		warnUnmatchedValue(action)
+		panic(fmt.Sprintf("Undefined mapping of value: %s", action))
+	}
+}
+
+func ToHttpEnclaveExpiration(expiration types.EnclaveExpiration) api_type.EnclaveExpiration {
+	return api_type.EnclaveExpiration{
+		Action:             ToHttpExpiredEnclaveAction(expiration.Action),
+		ExpirationTime:     expiration.ExpirationTime,
+		IdleTimeoutSeconds: expiration.IdleTimeoutSeconds,
+	}
+}
+
 func ToHttpEnclaveInfo(info types.EnclaveInfo) api_type.EnclaveInfo {
+	var labels *map[string]string
+	if len(info.Labels) > 0 {
+		labels = &info.Labels
+	}
 	return api_type.EnclaveInfo{
 		EnclaveUuid:                 info.EnclaveUuid,
 		ShortenedUuid:               info.ShortenedUuid,
@@ -84,6 +109,46 @@ func ToHttpEnclaveInfo(info types.EnclaveInfo) api_type.EnclaveInfo {
 		ApiContainerHostMachineInfo: utils.MapPointer(info.ApiContainerHostMachineInfo, ToHttpApiContainerHostMachineInfo),
 		CreationTime:                info.CreationTime,
 		Mode:                        ToHttpEnclaveMode(info.Mode),
+		Expiration:                  utils.MapPointer(info.Expiration, ToHttpEnclaveExpiration),
+		Labels:                      labels,
+	}
+}
+
+func ToHttpEngineEventType(eventType types.EngineEventType) api_type.EngineEventType {
+	switch eventType {
+	case types.EngineEventType_ENCLAVE_CREATED:
+		return api_type.ENCLAVECREATED
+	case types.EngineEventType_ENCLAVE_STOPPED:
+		return api_type.ENCLAVESTOPPED
+	case types.EngineEventType_ENCLAVE_DESTROYED:
+		return api_type.ENCLAVEDESTROYED
+	case types.EngineEventType_STARLARK_RUN_STARTED:
+		return api_type.STARLARKRUNSTARTED
+	case types.EngineEventType_STARLARK_RUN_FINISHED:
+		return api_type.STARLARKRUNFINISHED
+	case types.EngineEventType_SERVICE_ADDED:
+		return api_type.SERVICEADDED
+	case types.EngineEventType_SERVICE_STOPPED:
+		return api_type.SERVICESTOPPED
+	case types.EngineEventType_SERVICE_REMOVED:
+		return api_type.SERVICEREMOVED
+	case types.EngineEventType_API_CONTAINER_RESTARTED:
+		return api_type.APICONTAINERRESTARTED
+	default:
+		warnUnmatchedValue(eventType)
+		panic(fmt.Sprintf("Undefined mapping of value: %s", eventType))
+	}
+}
+
+func ToHttpEngineEvent(event types.EngineEvent) api_type.EngineEvent {
+	return api_type.EngineEvent{
+		Type:                 ToHttpEngineEventType(event.Type),
+		Timestamp:            event.Timestamp,
+		EnclaveUuid:          event.EnclaveUuid,
+		EnclaveName:          event.EnclaveName,
+		ServiceName:          event.ServiceName,
+		StarlarkPackageId:    event.StarlarkPackageId,
+		StarlarkRunSucceeded: event.StarlarkRunSucceeded,
 	}
 }
 
@@ -183,6 +248,27 @@ func ToHttpServiceInfo(service *rpc_api.ServiceInfo) api_type.ServiceInfo {
 	}
 }
 
+func ToHttpServiceStats(stats *rpc_api.ServiceStats) api_type.ServiceStats {
+	return api_type.ServiceStats{
+		CpuPercent:           stats.CpuPercent,
+		DiskReadBytes:        int64(stats.DiskReadBytes),
+		DiskWrittenBytes:     int64(stats.DiskWrittenBytes),
+		MemoryLimitBytes:     int64(stats.MemoryLimitBytes),
+		MemoryUsageBytes:     int64(stats.MemoryUsageBytes),
+		NetworkReceivedBytes: int64(stats.NetworkReceivedBytes),
+		NetworkSentBytes:     int64(stats.NetworkSentBytes),
+		ServiceName:          stats.ServiceName,
+		ServiceUuid:          stats.ServiceUuid,
+	}
+}
+
+func ToHttpServiceStatsSample(sample *rpc_api.GetServiceStatsResponse) api_type.ServiceStatsSample {
+	return api_type.ServiceStatsSample{
+		Services:  utils.MapList(sample.ServiceStats, ToHttpServiceStats),
+		Timestamp: sample.Timestamp.AsTime(),
+	}
+}
+
 func ToHttpFeatureFlag(flag rpc_api.KurtosisFeatureFlag) api_type.KurtosisFeatureFlag {
 	switch flag {
 	case rpc_api.KurtosisFeatureFlag_NO_INSTRUCTIONS_CACHING:
@@ -204,3 +290,22 @@ func ToHttpRestartPolicy(policy rpc_api.RestartPolicy) api_type.RestartPolicy {
 		panic(fmt.Sprintf("Missing conversion of Restart Policy Enum value: %s", policy))
 	}
 }
+
+func ToHttpAuditLogEntry(entry *audit_log.Entry) api_type.AuditLogEntry {
+	return api_type.AuditLogEntry{
+		Action:      entry.Action,
+		Arguments:   entry.Arguments,
+		Caller:      entry.Caller,
+		EnclaveName: nilIfEmpty(entry.EnclaveName),
+		EnclaveUuid: nilIfEmpty(entry.EnclaveUuid),
+		Error:       nilIfEmpty(entry.Error),
+		Timestamp:   entry.Timestamp,
+	}
+}
+
+func nilIfEmpty(value string) *string {
+	if value == "" {
+		return nil
+	}
+	return &value
+}
@@ -0,0 +1,78 @@
+package operational_metrics
+
+import (
+	"github.com/prometheus/client_golang/prometheus"
+	"github.com/prometheus/client_golang/prometheus/promauto"
+)
+
+// These are the engine's Prometheus metrics, served on the webapp port next to pprof. They're meant for operating
+// Kurtosis and are unrelated to the product analytics sent through the metrics library
+
+const (
+	metricsNamespace      = "kurtosis"
+	engineMetricSubsystem = "engine"
+)
+
+var (
+	enclavesCreatedTotal = promauto.NewCounter(prometheus.CounterOpts{
+		Namespace:   metricsNamespace,
+		Subsystem:   engineMetricSubsystem,
+		Name:        "enclaves_created_total",
+		Help:        "Number of enclaves created at the request of a user, whether they came from the enclave pool or not.",
+		ConstLabels: nil,
+	})
+
+	enclavesDestroyedTotal = promauto.NewCounter(prometheus.CounterOpts{
+		Namespace:   metricsNamespace,
+		Subsystem:   engineMetricSubsystem,
+		Name:        "enclaves_destroyed_total",
+		Help:        "Number of enclaves destroyed, either one by one or by cleaning.",
+		ConstLabels: nil,
+	})
+
+	enclavePoolHitsTotal = promauto.NewCounter(prometheus.CounterOpts{
+		Namespace:   metricsNamespace,
+		Subsystem:   engineMetricSubsystem,
+		Name:        "enclave_pool_hits_total",
+		Help:        "Number of enclave creations served by an idle enclave from the enclave pool.",
+		ConstLabels: nil,
+	})
+
+	enclavePoolMissesTotal = promauto.NewCounter(prometheus.CounterOpts{
+		Namespace:   metricsNamespace,
+		Subsystem:   engineMetricSubsystem,
+		Name:        "enclave_pool_misses_total",
+		Help:        "Number of enclave creations that asked the enclave pool for an enclave but had to create a new one.",
+		ConstLabels: nil,
+	})
+
+	logStreamSubscribers = promauto.NewGauge(prometheus.GaugeOpts{
+		Namespace:   metricsNamespace,
+		Subsystem:   engineMetricSubsystem,
+		Name:        "log_stream_subscribers",
+		Help:        "Number of clients currently streaming service logs, through either the gRPC or the REST API.",
+		ConstLabels: nil,
+	})
+)
+
+func RecordEnclaveCreated() {
+	enclavesCreatedTotal.Inc()
+}
+
+func RecordEnclavesDestroyed(numEnclaves int) {
+	enclavesDestroyedTotal.Add(float64(numEnclaves))
+}
+
+func RecordEnclavePoolHit() {
+	enclavePoolHitsTotal.Inc()
+}
+
+func RecordEnclavePoolMiss() {
+	enclavePoolMissesTotal.Inc()
+}
+
+// RecordLogStreamSubscribed must be paired with a call to the returned function once the subscriber is gone
+func RecordLogStreamSubscribed() func() {
+	logStreamSubscribers.Inc()
+	return logStreamSubscribers.Dec
+}
@@ -0,0 +1,28 @@
+package operational_metrics
+
+import (
+	"testing"
+
+	"github.com/prometheus/client_golang/prometheus/testutil"
+	"github.com/stretchr/testify/require"
+)
+
+func TestRecordLogStreamSubscribed_UnsubscribingRestoresTheGauge(t *testing.T) {
+	initialNumSubscribers := testutil.ToFloat64(logStreamSubscribers)
+
+	recordFirstUnsubscribed := RecordLogStreamSubscribed()
+	recordSecondUnsubscribed := RecordLogStreamSubscribed()
+	require.Equal(t, initialNumSubscribers+2, testutil.ToFloat64(logStreamSubscribers))
+
+	recordFirstUnsubscribed()
+	recordSecondUnsubscribed()
+	require.Equal(t, initialNumSubscribers, testutil.ToFloat64(logStreamSubscribers))
+}
+
+func TestRecordEnclavesDestroyed_AddsTheNumberOfEnclaves(t *testing.T) {
+	initialNumDestroyed := testutil.ToFloat64(enclavesDestroyedTotal)
+
+	RecordEnclavesDestroyed(3)
+	RecordEnclavesDestroyed(0)
+	require.Equal(t, initialNumDestroyed+3, testutil.ToFloat64(enclavesDestroyedTotal))
+}
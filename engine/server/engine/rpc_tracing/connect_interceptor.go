@@ -0,0 +1,50 @@
+package rpc_tracing
+
+import (
+	"context"
+
+	"connectrpc.com/connect"
+	"github.com/kurtosis-tech/kurtosis/container-engine-lib/lib/tracing"
+	"go.opentelemetry.io/otel"
+	"go.opentelemetry.io/otel/attribute"
+	"go.opentelemetry.io/otel/propagation"
+)
+
+const (
+	procedureSpanAttributeKey = "rpc.procedure"
+)
+
+// ConnectInterceptor creates a span per engine RPC, child of the span the caller propagated in the request headers if
+// any. The API container calls the engine makes while serving the RPC belong to the same trace
+type ConnectInterceptor struct{}
+
+func NewConnectInterceptor() *ConnectInterceptor {
+	return &ConnectInterceptor{}
+}
+
+func (interceptor *ConnectInterceptor) WrapUnary(next connect.UnaryFunc) connect.UnaryFunc {
+	return func(ctx context.Context, request connect.AnyRequest) (response connect.AnyResponse, resultErr error) {
+		ctx = otel.GetTextMapPropagator().Extract(ctx, propagation.HeaderCarrier(request.Header()))
+		ctx, span := tracing.StartSpan(ctx, request.Spec().Procedure, attribute.String(procedureSpanAttributeKey, request.Spec().Procedure))
+		defer func() {
+			tracing.EndSpan(span, resultErr)
+		}()
+		return next(ctx, request)
+	}
+}
+
+// WrapStreamingClient is a no-op, the engine doesn't call other services through this interceptor
+func (interceptor *ConnectInterceptor) WrapStreamingClient(next connect.StreamingClientFunc) connect.StreamingClientFunc {
+	return next
+}
+
+func (interceptor *ConnectInterceptor) WrapStreamingHandler(next connect.StreamingHandlerFunc) connect.StreamingHandlerFunc {
+	return func(ctx context.Context, conn connect.StreamingHandlerConn) (resultErr error) {
+		ctx = otel.GetTextMapPropagator().Extract(ctx, propagation.HeaderCarrier(conn.RequestHeader()))
+		ctx, span := tracing.StartSpan(ctx, conn.Spec().Procedure, attribute.String(procedureSpanAttributeKey, conn.Spec().Procedure))
+		defer func() {
+			tracing.EndSpan(span, resultErr)
+		}()
+		return next(ctx, conn)
+	}
+}
@@ -0,0 +1,49 @@
+package rpc_tracing
+
+import (
+	"context"
+	"net/http/httptest"
+	"testing"
+
+	"connectrpc.com/connect"
+	"github.com/kurtosis-tech/kurtosis/api/golang/engine/kurtosis_engine_rpc_api_bindings/kurtosis_engine_rpc_api_bindingsconnect"
+	"github.com/stretchr/testify/require"
+	"go.opentelemetry.io/otel"
+	"go.opentelemetry.io/otel/codes"
+	"go.opentelemetry.io/otel/propagation"
+	sdktrace "go.opentelemetry.io/otel/sdk/trace"
+	"go.opentelemetry.io/otel/sdk/trace/tracetest"
+	"go.opentelemetry.io/otel/trace"
+	"google.golang.org/protobuf/types/known/emptypb"
+)
+
+func TestConnectInterceptor_ContinuesTraceOfCaller(t *testing.T) {
+	spanRecorder := tracetest.NewSpanRecorder()
+	otel.SetTracerProvider(sdktrace.NewTracerProvider(sdktrace.WithSpanProcessor(spanRecorder)))
+	otel.SetTextMapPropagator(propagation.TraceContext{})
+	defer otel.SetTracerProvider(trace.NewNoopTracerProvider())
+
+	apiPath, handler := kurtosis_engine_rpc_api_bindingsconnect.NewEngineServiceHandler(
+		kurtosis_engine_rpc_api_bindingsconnect.UnimplementedEngineServiceHandler{},
+		connect.WithInterceptors(NewConnectInterceptor()),
+	)
+	require.NotEmpty(t, apiPath)
+	httpServer := httptest.NewServer(handler)
+	defer httpServer.Close()
+
+	callerCtx, callerSpan := otel.Tracer("test").Start(context.Background(), "caller")
+	request := connect.NewRequest(&emptypb.Empty{})
+	otel.GetTextMapPropagator().Inject(callerCtx, propagation.HeaderCarrier(request.Header()))
+	client := kurtosis_engine_rpc_api_bindingsconnect.NewEngineServiceClient(httpServer.Client(), httpServer.URL)
+	_, err := client.GetEngineInfo(context.Background(), request)
+	require.Error(t, err)
+	callerSpan.End()
+
+	endedSpans := spanRecorder.Ended()
+	require.Len(t, endedSpans, 2)
+	rpcSpan := endedSpans[0]
+	require.Equal(t, kurtosis_engine_rpc_api_bindingsconnect.EngineServiceGetEngineInfoProcedure, rpcSpan.Name())
+	require.Equal(t, callerSpan.SpanContext().SpanID(), rpcSpan.Parent().SpanID())
+	require.Equal(t, callerSpan.SpanContext().TraceID(), rpcSpan.SpanContext().TraceID())
+	require.Equal(t, codes.Error, rpcSpan.Status().Code)
+}
@@ -0,0 +1,130 @@
+package server
+
+import (
+	"context"
+	"time"
+
+	"github.com/kurtosis-tech/kurtosis/container-engine-lib/lib/backend_interface/objects/enclave"
+	"github.com/kurtosis-tech/kurtosis/engine/server/engine/audit_log"
+	"github.com/kurtosis-tech/kurtosis/engine/server/engine/enclave_manager"
+	"github.com/kurtosis-tech/kurtosis/engine/server/engine/types"
+	"github.com/kurtosis-tech/stacktrace"
+	"github.com/sirupsen/logrus"
+)
+
+const (
+	// The actions are named after the engine RPCs, the REST endpoints making the same changes recording the same actions
+	createEnclaveAuditLogAction       = "CreateEnclave"
+	stopEnclaveAuditLogAction         = "StopEnclave"
+	destroyEnclaveAuditLogAction      = "DestroyEnclave"
+	extendEnclaveTtlAuditLogAction    = "ExtendEnclaveTtl"
+	updateEnclaveLabelsAuditLogAction = "UpdateEnclaveLabels"
+	renameEnclaveAuditLogAction       = "RenameEnclave"
+	cloneEnclaveAuditLogAction        = "CloneEnclave"
+	importEnclaveAuditLogAction       = "ImportEnclave"
+	cleanAuditLogAction               = "Clean"
+	createApiTokenAuditLogAction      = "CreateApiToken"
+	revokeApiTokenAuditLogAction      = "RevokeApiToken"
+
+	enclaveIdentifierAuditLogArgument = "enclave_identifier"
+)
+
+// recordEnclaveChange makes a change to an existing enclave and records it in the audit log. The enclave gets looked up
+// before the change is made, as it may not exist anymore afterward
+func recordEnclaveChange(
+	ctx context.Context,
+	auditLog *audit_log.AuditLog,
+	enclaveManager *enclave_manager.EnclaveManager,
+	action string,
+	enclaveIdentifier string,
+	arguments map[string]string,
+	change func() error,
+) error {
+	enclaveUuid := ""
+	enclaveName := ""
+	if uuid, err := enclaveManager.GetEnclaveUuidForEnclaveIdentifier(ctx, enclaveIdentifier); err == nil {
+		enclaveUuid = string(uuid)
+	}
+	if name, err := enclaveManager.GetEnclaveNameForEnclaveIdentifier(ctx, enclaveIdentifier); err == nil {
+		enclaveName = name
+	}
+	if enclaveUuid == "" || enclaveName == "" {
+		logrus.Debugf("Enclave '%v' couldn't be looked up, its '%v' entry in the audit log only has the identifier it was called with", enclaveIdentifier, action)
+	}
+
+	if arguments == nil {
+		arguments = map[string]string{}
+	}
+	arguments[enclaveIdentifierAuditLogArgument] = enclaveIdentifier
+
+	err := change()
+	auditLog.Record(ctx, action, enclaveUuid, enclaveName, arguments, err)
+	return err
+}
+
+// recordEnclaveCreation records the creation of an enclave, which is only known once it's been created
+func recordEnclaveCreation(ctx context.Context, auditLog *audit_log.AuditLog, action string, enclaveInfo *types.EnclaveInfo, arguments map[string]string, err error) {
+	enclaveUuid := ""
+	enclaveName := ""
+	if enclaveInfo != nil {
+		enclaveUuid = enclaveInfo.EnclaveUuid
+		enclaveName = enclaveInfo.Name
+	}
+	auditLog.Record(ctx, action, enclaveUuid, enclaveName, arguments, err)
+}
+
+// The REST API and the RPC creating enclaves take the same arguments, recorded under the names the RPC gives them
+func getCreateEnclaveAuditLogArguments(
+	enclaveName string,
+	apiContainerVersionTag string,
+	isProduction bool,
+	ttl time.Duration,
+	idleTimeout time.Duration,
+	expiredEnclaveAction enclave.ExpiredEnclaveAction,
+	labels map[string]string,
+	seedPackage *enclave_manager.EnclaveSeedPackage,
+) map[string]string {
+	arguments := map[string]string{
+		"enclave_name":              enclaveName,
+		"api_container_version_tag": apiContainerVersionTag,
+		"is_production":             audit_log.FormatArgument(isProduction),
+		"ttl":                       ttl.String(),
+		"idle_timeout":              idleTimeout.String(),
+		"expired_enclave_action":    expiredEnclaveAction.String(),
+		"labels":                    audit_log.FormatArgument(labels),
+	}
+	if seedPackage != nil {
+		arguments["seed_package_locator"] = seedPackage.PackageLocator
+		arguments["seed_package_params"] = seedPackage.PackageParams
+	}
+	return arguments
+}
+
+// getAuditLogPage gets a page of the entries of the engine audit log along with the ones of the audit logs of the API
+// containers, newest first
+func getAuditLogPage(
+	ctx context.Context,
+	auditLog *audit_log.AuditLog,
+	enclaveManager *enclave_manager.EnclaveManager,
+	enclaveIdentifier string,
+	pageSize uint32,
+	pageToken string,
+) ([]*audit_log.Entry, string, error) {
+	apiContainerEntries, err := enclaveManager.GetApiContainerAuditLogEntries(ctx)
+	if err != nil {
+		return nil, "", stacktrace.Propagate(err, "An error occurred getting the audit log entries of the API containers")
+	}
+	entries := append(auditLog.GetEntries(), apiContainerEntries...)
+	if enclaveIdentifier != "" {
+		// The entries recorded before an enclave got renamed have its previous name, so existing enclaves get matched by UUID
+		if enclaveUuid, err := enclaveManager.GetEnclaveUuidForEnclaveIdentifier(ctx, enclaveIdentifier); err == nil {
+			enclaveIdentifier = string(enclaveUuid)
+		}
+		entries = audit_log.FilterByEnclave(entries, enclaveIdentifier)
+	}
+	page, nextPageToken, err := audit_log.GetPage(entries, pageSize, pageToken)
+	if err != nil {
+		return nil, "", stacktrace.Propagate(err, "An error occurred getting the requested page of the audit log")
+	}
+	return page, nextPageToken, nil
+}
@@ -8,6 +8,8 @@ import (
 	"net/http"
 	"sync"
 
+	"github.com/kurtosis-tech/kurtosis/container-engine-lib/lib/tracing"
+	"github.com/kurtosis-tech/kurtosis/engine/server/engine/audit_log"
 	"github.com/kurtosis-tech/kurtosis/engine/server/engine/enclave_manager"
 	"github.com/kurtosis-tech/kurtosis/engine/server/engine/mapping/to_grpc"
 	"github.com/kurtosis-tech/kurtosis/engine/server/engine/mapping/to_http"
@@ -37,9 +39,10 @@ type enclaveRuntime struct {
 	ctx                      context.Context
 	lock                     sync.Mutex
 	asyncStarlarkLogs        streaming.StreamerPool[*rpc_api.StarlarkRunResponseLine]
+	callerKey                *audit_log.CallerKey
 }
 
-func NewEnclaveRuntime(ctx context.Context, manager enclave_manager.EnclaveManager, asyncStarlarkLogs streaming.StreamerPool[*rpc_api.StarlarkRunResponseLine], connectOnHostMachine bool) (*enclaveRuntime, error) {
+func NewEnclaveRuntime(ctx context.Context, manager enclave_manager.EnclaveManager, asyncStarlarkLogs streaming.StreamerPool[*rpc_api.StarlarkRunResponseLine], connectOnHostMachine bool, callerKey *audit_log.CallerKey) (*enclaveRuntime, error) {
 
 	runtime := enclaveRuntime{
 		enclaveManager:           manager,
@@ -48,6 +51,7 @@ func NewEnclaveRuntime(ctx context.Context, manager enclave_manager.EnclaveManag
 		ctx:                      ctx,
 		asyncStarlarkLogs:        asyncStarlarkLogs,
 		lock:                     sync.Mutex{},
+		callerKey:                callerKey,
 	}
 
 	err := runtime.refreshEnclaveConnections()
@@ -337,6 +341,32 @@ func (manager *enclaveRuntime) GetEnclavesEnclaveIdentifierServicesHistory(ctx c
 	return api.GetEnclavesEnclaveIdentifierServicesHistory200JSONResponse(response), nil
 }
 
+// (GET /enclaves/{enclave_identifier}/services/stats)
+func (manager *enclaveRuntime) GetEnclavesEnclaveIdentifierServicesStats(ctx context.Context, request api.GetEnclavesEnclaveIdentifierServicesStatsRequestObject) (api.GetEnclavesEnclaveIdentifierServicesStatsResponseObject, error) {
+	enclaveIdentifier := request.EnclaveIdentifier
+	apiContainerClient, responseErr := manager.getApiClientOrResponseError(enclaveIdentifier)
+	if responseErr != nil {
+		return api.GetEnclavesEnclaveIdentifierServicesStatsdefaultJSONResponse{Body: *responseErr, StatusCode: int(responseErr.Code)}, nil
+	}
+	logrus.Infof("Getting the stats of services from enclave %s", enclaveIdentifier)
+
+	getServiceStatsArgs := rpc_api.GetServiceStatsArgs{
+		ServiceIdentifiers: utils.DerefWith(request.Params.Services, []string{}),
+		Follow:             false,
+		Interval:           nil,
+	}
+	stream, err := (*apiContainerClient).GetServiceStats(ctx, &getServiceStatsArgs)
+	if err != nil {
+		return nil, stacktrace.Propagate(err, "Can't get the stats of services using gRPC call with enclave %s", enclaveIdentifier)
+	}
+	sample, err := stream.Recv()
+	if err != nil {
+		return nil, stacktrace.Propagate(err, "Can't receive the stats of services from enclave %s", enclaveIdentifier)
+	}
+
+	return api.GetEnclavesEnclaveIdentifierServicesStats200JSONResponse(to_http.ToHttpServiceStatsSample(sample)), nil
+}
+
 // (POST /enclaves/{enclave_identifier}/services/connection)
 func (manager *enclaveRuntime) PostEnclavesEnclaveIdentifierServicesConnection(ctx context.Context, request api.PostEnclavesEnclaveIdentifierServicesConnectionRequestObject) (api.PostEnclavesEnclaveIdentifierServicesConnectionResponseObject, error) {
 	enclaveIdentifier := request.EnclaveIdentifier
@@ -606,7 +636,8 @@ func (manager *enclaveRuntime) PostEnclavesEnclaveIdentifierStarlarkPackagesPack
 		Parallel:               request.Body.Parallel,
 	}
 
-	ctxWithCancel, cancelCtxFunc := context.WithCancel(context.Background())
+	// Detached from the request so that the asynchronous runs outlive it, the run still being made on behalf of its caller
+	ctxWithCancel, cancelCtxFunc := context.WithCancel(audit_log.ContextWithCaller(context.Background(), audit_log.GetCaller(ctx)))
 	stream, err := (*apiContainerClient).RunStarlarkPackage(ctxWithCancel, &runStarlarkPackageArgs)
 	if err != nil {
 		cancelCtxFunc()
@@ -636,7 +667,12 @@ func (manager *enclaveRuntime) PostEnclavesEnclaveIdentifierStarlarkPackagesPack
 			return nil, stacktrace.Propagate(err, "failed to serialize %T", logs)
 		}
 	} else {
-		asyncUuid := manager.asyncStarlarkLogs.Add(&asyncLogs)
+		enclaveName, err := manager.enclaveManager.GetEnclaveNameForEnclaveIdentifier(ctx, enclaveIdentifier)
+		if err != nil {
+			cancelCtxFunc()
+			return nil, stacktrace.Propagate(err, "Failed to get the name of enclave %s", enclaveIdentifier)
+		}
+		asyncUuid := manager.asyncStarlarkLogs.Add(&asyncLogs, enclaveName)
 		var asyncLogs api_type.AsyncStarlarkExecutionLogs
 		asyncLogs.AsyncStarlarkExecutionLogs.StarlarkExecutionUuid = string(asyncUuid)
 		if err := syncLogs.FromAsyncStarlarkExecutionLogs(asyncLogs); err != nil {
@@ -680,7 +716,8 @@ func (manager *enclaveRuntime) PostEnclavesEnclaveIdentifierStarlarkScripts(ctx
 		Parallel:             request.Body.Parallel,
 	}
 
-	ctxWithCancel, cancelCtxFunc := context.WithCancel(context.Background())
+	// Detached from the request so that the asynchronous runs outlive it, the run still being made on behalf of its caller
+	ctxWithCancel, cancelCtxFunc := context.WithCancel(audit_log.ContextWithCaller(context.Background(), audit_log.GetCaller(ctx)))
 	stream, err := (*apiContainerClient).RunStarlarkScript(ctxWithCancel, &runStarlarkScriptArgs)
 	if err != nil {
 		cancelCtxFunc()
@@ -718,7 +755,7 @@ func (manager *enclaveRuntime) PostEnclavesEnclaveIdentifierStarlarkScripts(ctx
 
 // GetGrpcClientConn returns a client conn dialed in to the local port
 // It is the caller's responsibility to call resultClientConn.close()
-func getGrpcClientConn(enclaveInfo types.EnclaveInfo, connectOnHostMachine bool) (resultClientConn *grpc.ClientConn, resultErr error) {
+func getGrpcClientConn(enclaveInfo types.EnclaveInfo, connectOnHostMachine bool, callerKey *audit_log.CallerKey) (resultClientConn *grpc.ClientConn, resultErr error) {
 	enclaveAPIContainerInfo := enclaveInfo.ApiContainerInfo
 	if enclaveAPIContainerInfo == nil {
 		logrus.Infof("No API container info is available for enclave %s", enclaveInfo.EnclaveUuid)
@@ -738,7 +775,12 @@ func getGrpcClientConn(enclaveInfo types.EnclaveInfo, connectOnHostMachine bool)
 	}
 
 	grpcServerAddress := fmt.Sprintf("%v:%v", apiContainerIP, apiContainerGrpcPort)
-	grpcConnection, err := grpc.Dial(grpcServerAddress, grpc.WithTransportCredentials(insecure.NewCredentials()))
+	grpcConnection, err := grpc.Dial(
+		grpcServerAddress,
+		grpc.WithTransportCredentials(insecure.NewCredentials()),
+		grpc.WithChainUnaryInterceptor(callerKey.NewForwardCallerUnaryClientInterceptor(enclaveInfo.EnclaveUuid), tracing.UnaryClientInterceptor()),
+		grpc.WithChainStreamInterceptor(callerKey.NewForwardCallerStreamClientInterceptor(enclaveInfo.EnclaveUuid), tracing.StreamClientInterceptor()),
+	)
 	if err != nil {
 		return nil, stacktrace.Propagate(err, "Expected to be able to create a GRPC client connection on address '%v', but a non-nil error was returned", grpcServerAddress)
 	}
@@ -800,7 +842,7 @@ func (runtime *enclaveRuntime) refreshEnclaveConnections() error {
 	for uuid, info := range enclaves {
 		_, found := runtime.remoteApiContainerClient[uuid]
 		if !found && info != nil {
-			conn, err := getGrpcClientConn(*info, runtime.connectOnHostMachine)
+			conn, err := getGrpcClientConn(*info, runtime.connectOnHostMachine, runtime.callerKey)
 			if err != nil {
 				return stacktrace.Propagate(err, "Failed to establish gRPC connection with enclave manager service on enclave %s", uuid)
 			}
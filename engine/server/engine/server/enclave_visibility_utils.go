@@ -0,0 +1,29 @@
+package server
+
+import (
+	"context"
+
+	"github.com/kurtosis-tech/kurtosis/engine/server/engine/api_auth"
+	"github.com/kurtosis-tech/kurtosis/engine/server/engine/types"
+)
+
+// The enclaves an enclave-scoped API token isn't scoped to are left out of the enclaves listed to its caller
+func getVisibleEnclaves(ctx context.Context, infoForEnclaves map[string]*types.EnclaveInfo) map[string]*types.EnclaveInfo {
+	visibleInfoForEnclaves := make(map[string]*types.EnclaveInfo, len(infoForEnclaves))
+	for enclaveUuid, enclaveInfo := range infoForEnclaves {
+		if api_auth.CanSeeEnclave(ctx, enclaveInfo.Name) {
+			visibleInfoForEnclaves[enclaveUuid] = enclaveInfo
+		}
+	}
+	return visibleInfoForEnclaves
+}
+
+func getVisibleEnclaveIdentifiers(ctx context.Context, allIdentifiers []*types.EnclaveIdentifiers) []*types.EnclaveIdentifiers {
+	visibleIdentifiers := make([]*types.EnclaveIdentifiers, 0, len(allIdentifiers))
+	for _, identifiers := range allIdentifiers {
+		if api_auth.CanSeeEnclave(ctx, identifiers.Name) {
+			visibleIdentifiers = append(visibleIdentifiers, identifiers)
+		}
+	}
+	return visibleIdentifiers
+}
@@ -2,16 +2,22 @@ package server
 
 import (
 	"context"
+	"errors"
 	"fmt"
+	"io"
 	"time"
 
 	"connectrpc.com/connect"
 	"github.com/kurtosis-tech/kurtosis/api/golang/engine/kurtosis_engine_rpc_api_bindings"
 	"github.com/kurtosis-tech/kurtosis/container-engine-lib/lib/backend_interface/objects/enclave"
 	user_service "github.com/kurtosis-tech/kurtosis/container-engine-lib/lib/backend_interface/objects/service"
+	"github.com/kurtosis-tech/kurtosis/engine/launcher/args"
+	"github.com/kurtosis-tech/kurtosis/engine/server/engine/api_auth"
+	"github.com/kurtosis-tech/kurtosis/engine/server/engine/audit_log"
 	"github.com/kurtosis-tech/kurtosis/engine/server/engine/centralized_logs"
 	"github.com/kurtosis-tech/kurtosis/engine/server/engine/centralized_logs/logline"
 	"github.com/kurtosis-tech/kurtosis/engine/server/engine/enclave_manager"
+	"github.com/kurtosis-tech/kurtosis/engine/server/engine/operational_metrics"
 	"github.com/kurtosis-tech/kurtosis/engine/server/engine/types"
 	"github.com/kurtosis-tech/kurtosis/engine/server/engine/utils"
 	"github.com/kurtosis-tech/kurtosis/metrics-library/golang/lib/metrics_client"
@@ -23,6 +29,11 @@ import (
 
 const (
 	subnetworkDisableBecauseItIsDeprecated = false
+
+	enclaveArchiveChunkSizeBytes = 1024 * 1024
+
+	// The callers calling the API containers directly get a new credential before it expires
+	enclaveCallerCredentialTtl = time.Hour
 )
 
 type EngineConnectServerService struct {
@@ -41,6 +52,23 @@ type EngineConnectServerService struct {
 	logsDatabaseClient centralized_logs.LogsDatabaseClient
 
 	metricsClient metrics_client.MetricsClient
+
+	// Nil if the engine doesn't enforce API authentication
+	apiTokenStore *api_auth.ApiTokenStore
+
+	auditLog *audit_log.AuditLog
+
+	// Proves to the API containers who's calling them, for their audit logs
+	callerKey *audit_log.CallerKey
+
+	// How long the engine keeps the logs of the services, reported so that an exported engine config can restore it
+	logRetentionPeriod string
+
+	// Reported so that the engine can be restarted with the settings it's running with
+	logLevel           string
+	poolSize           uint8
+	isDebugModeEnabled bool
+	domain             string
 }
 
 func NewEngineConnectServerService(
@@ -50,6 +78,14 @@ func NewEngineConnectServerService(
 	didUserAcceptSendingMetrics bool,
 	logsDatabaseClient centralized_logs.LogsDatabaseClient,
 	metricsClient metrics_client.MetricsClient,
+	apiTokenStore *api_auth.ApiTokenStore,
+	auditLog *audit_log.AuditLog,
+	callerKey *audit_log.CallerKey,
+	logRetentionPeriod string,
+	logLevel string,
+	poolSize uint8,
+	isDebugModeEnabled bool,
+	domain string,
 ) *EngineConnectServerService {
 	service := &EngineConnectServerService{
 		imageVersionTag:             imageVersionTag,
@@ -58,10 +94,42 @@ func NewEngineConnectServerService(
 		didUserAcceptSendingMetrics: didUserAcceptSendingMetrics,
 		logsDatabaseClient:          logsDatabaseClient,
 		metricsClient:               metricsClient,
+		apiTokenStore:               apiTokenStore,
+		auditLog:                    auditLog,
+		callerKey:                   callerKey,
+		logRetentionPeriod:          logRetentionPeriod,
+		logLevel:                    logLevel,
+		poolSize:                    poolSize,
+		isDebugModeEnabled:          isDebugModeEnabled,
+		domain:                      domain,
 	}
 	return service
 }
 
+func toApiTokenRole(role kurtosis_engine_rpc_api_bindings.ApiTokenRole) (args.ApiTokenRole, error) {
+	switch role {
+	case kurtosis_engine_rpc_api_bindings.ApiTokenRole_ApiTokenRole_READ_ONLY:
+		return args.ApiTokenRole_ReadOnly, nil
+	case kurtosis_engine_rpc_api_bindings.ApiTokenRole_ApiTokenRole_OPERATOR:
+		return args.ApiTokenRole_Operator, nil
+	case kurtosis_engine_rpc_api_bindings.ApiTokenRole_ApiTokenRole_ADMIN:
+		return args.ApiTokenRole_Admin, nil
+	default:
+		return "", stacktrace.NewError("Unknown API token role '%v'", role)
+	}
+}
+
+// Returns nil if there's no seed package
+func toEnclaveSeedPackage(packageLocator string, packageParams string) *enclave_manager.EnclaveSeedPackage {
+	if packageLocator == "" {
+		return nil
+	}
+	return &enclave_manager.EnclaveSeedPackage{
+		PackageLocator: packageLocator,
+		PackageParams:  packageParams,
+	}
+}
+
 func toGrpcEnclaveStatus(status types.EnclaveStatus) kurtosis_engine_rpc_api_bindings.EnclaveContainersStatus {
 	switch status {
 	case types.EnclaveStatus_EMPTY:
@@ -119,9 +187,33 @@ func toGrpcEnclaveMode(mode types.EnclaveMode) kurtosis_engine_rpc_api_bindings.
 	}
 }
 
+func toGrpcExpiredEnclaveAction(action types.ExpiredEnclaveAction) kurtosis_engine_rpc_api_bindings.ExpiredEnclaveAction {
+	switch action {
+	case types.ExpiredEnclaveAction_DESTROY:
+		return kurtosis_engine_rpc_api_bindings.ExpiredEnclaveAction_ExpiredEnclaveAction_DESTROY
+	case types.ExpiredEnclaveAction_STOP:
+		return kurtosis_engine_rpc_api_bindings.ExpiredEnclaveAction_ExpiredEnclaveAction_STOP
+	default:
+		panic(fmt.Sprintf("Undefined mapping of value: %s", action))
+	}
+}
+
+func toGrpcEnclaveExpiration(expiration types.EnclaveExpiration) kurtosis_engine_rpc_api_bindings.EnclaveExpiration {
+	var expirationTime *timestamppb.Timestamp
+	if expiration.ExpirationTime != nil {
+		expirationTime = toGrpcTimestamp(*expiration.ExpirationTime)
+	}
+	return kurtosis_engine_rpc_api_bindings.EnclaveExpiration{
+		ExpirationTime:     expirationTime,
+		IdleTimeoutSeconds: expiration.IdleTimeoutSeconds,
+		Action:             toGrpcExpiredEnclaveAction(expiration.Action),
+	}
+}
+
 func toGrpcEnclaveInfo(info types.EnclaveInfo) kurtosis_engine_rpc_api_bindings.EnclaveInfo {
 	containerInfo := utils.MapPointer(info.ApiContainerInfo, toGrpcEnclaveAPIContainerInfo)
 	apiHostMachine := utils.MapPointer(info.ApiContainerHostMachineInfo, toGrpcApiContainerHostMachineInfo)
+	expiration := utils.MapPointer(info.Expiration, toGrpcEnclaveExpiration)
 	return kurtosis_engine_rpc_api_bindings.EnclaveInfo{
 		EnclaveUuid:                 info.EnclaveUuid,
 		ShortenedUuid:               info.ShortenedUuid,
@@ -132,6 +224,8 @@ func toGrpcEnclaveInfo(info types.EnclaveInfo) kurtosis_engine_rpc_api_bindings.
 		ApiContainerHostMachineInfo: apiHostMachine,
 		CreationTime:                toGrpcTimestamp(info.CreationTime),
 		Mode:                        toGrpcEnclaveMode(info.Mode),
+		Expiration:                  expiration,
+		Labels:                      info.Labels,
 	}
 }
 
@@ -150,9 +244,73 @@ func toGrpcEnclaveNameAndUuid(identifier types.EnclaveNameAndUuid) kurtosis_engi
 	}
 }
 
+func toGrpcEngineEventType(eventType types.EngineEventType) kurtosis_engine_rpc_api_bindings.EngineEventType {
+	switch eventType {
+	case types.EngineEventType_ENCLAVE_CREATED:
+		return kurtosis_engine_rpc_api_bindings.EngineEventType_EngineEventType_ENCLAVE_CREATED
+	case types.EngineEventType_ENCLAVE_STOPPED:
+		return kurtosis_engine_rpc_api_bindings.EngineEventType_EngineEventType_ENCLAVE_STOPPED
+	case types.EngineEventType_ENCLAVE_DESTROYED:
+		return kurtosis_engine_rpc_api_bindings.EngineEventType_EngineEventType_ENCLAVE_DESTROYED
+	case types.EngineEventType_STARLARK_RUN_STARTED:
+		return kurtosis_engine_rpc_api_bindings.EngineEventType_EngineEventType_STARLARK_RUN_STARTED
+	case types.EngineEventType_STARLARK_RUN_FINISHED:
+		return kurtosis_engine_rpc_api_bindings.EngineEventType_EngineEventType_STARLARK_RUN_FINISHED
+	case types.EngineEventType_SERVICE_ADDED:
+		return kurtosis_engine_rpc_api_bindings.EngineEventType_EngineEventType_SERVICE_ADDED
+	case types.EngineEventType_SERVICE_STOPPED:
+		return kurtosis_engine_rpc_api_bindings.EngineEventType_EngineEventType_SERVICE_STOPPED
+	case types.EngineEventType_SERVICE_REMOVED:
+		return kurtosis_engine_rpc_api_bindings.EngineEventType_EngineEventType_SERVICE_REMOVED
+	case types.EngineEventType_API_CONTAINER_RESTARTED:
+		return kurtosis_engine_rpc_api_bindings.EngineEventType_EngineEventType_API_CONTAINER_RESTARTED
+	default:
+		panic(fmt.Sprintf("Undefined mapping of value: %s", eventType))
+	}
+}
+
+func toGrpcEngineEvent(event types.EngineEvent) kurtosis_engine_rpc_api_bindings.EngineEvent {
+	return kurtosis_engine_rpc_api_bindings.EngineEvent{
+		Type:                 toGrpcEngineEventType(event.Type),
+		Timestamp:            toGrpcTimestamp(event.Timestamp),
+		EnclaveUuid:          event.EnclaveUuid,
+		EnclaveName:          event.EnclaveName,
+		ServiceName:          event.ServiceName,
+		StarlarkPackageId:    event.StarlarkPackageId,
+		StarlarkRunSucceeded: event.StarlarkRunSucceeded,
+	}
+}
+
+func toGrpcAuditLogEntry(entry *audit_log.Entry) *kurtosis_engine_rpc_api_bindings.AuditLogEntry {
+	grpcEntry := &kurtosis_engine_rpc_api_bindings.AuditLogEntry{
+		Timestamp:   toGrpcTimestamp(entry.Timestamp),
+		Caller:      entry.Caller,
+		Action:      entry.Action,
+		EnclaveUuid: nil,
+		EnclaveName: nil,
+		Arguments:   entry.Arguments,
+		Error:       nil,
+	}
+	if entry.EnclaveUuid != "" {
+		grpcEntry.EnclaveUuid = &entry.EnclaveUuid
+	}
+	if entry.EnclaveName != "" {
+		grpcEntry.EnclaveName = &entry.EnclaveName
+	}
+	if entry.Error != "" {
+		grpcEntry.Error = &entry.Error
+	}
+	return grpcEntry
+}
+
 func (service *EngineConnectServerService) GetEngineInfo(context.Context, *connect.Request[emptypb.Empty]) (*connect.Response[kurtosis_engine_rpc_api_bindings.GetEngineInfoResponse], error) {
 	result := &kurtosis_engine_rpc_api_bindings.GetEngineInfoResponse{
-		EngineVersion: service.imageVersionTag,
+		EngineVersion:      service.imageVersionTag,
+		LogRetentionPeriod: service.logRetentionPeriod,
+		LogLevel:           service.logLevel,
+		PoolSize:           uint32(service.poolSize),
+		IsDebugModeEnabled: service.isDebugModeEnabled,
+		Domain:             service.domain,
 	}
 	return connect.NewResponse(result), nil
 }
@@ -172,6 +330,14 @@ func (service *EngineConnectServerService) CreateEnclave(ctx context.Context, co
 
 	isProduction := args.GetMode() == kurtosis_engine_rpc_api_bindings.EnclaveMode_PRODUCTION
 
+	expiredEnclaveAction := enclave.ExpiredEnclaveAction_Destroy
+	if args.GetExpiredEnclaveAction() == kurtosis_engine_rpc_api_bindings.ExpiredEnclaveAction_ExpiredEnclaveAction_STOP {
+		expiredEnclaveAction = enclave.ExpiredEnclaveAction_Stop
+	}
+
+	ttl := time.Duration(args.GetTtlSeconds()) * time.Second
+	idleTimeout := time.Duration(args.GetIdleTimeoutSeconds()) * time.Second
+	seedPackage := toEnclaveSeedPackage(args.GetSeedPackageLocator(), args.GetSeedPackageParams())
 	enclaveInfo, err := service.enclaveManager.CreateEnclave(
 		ctx,
 		service.imageVersionTag,
@@ -180,7 +346,14 @@ func (service *EngineConnectServerService) CreateEnclave(ctx context.Context, co
 		args.GetEnclaveName(),
 		isProduction,
 		args.GetShouldApicRunInDebugMode(),
+		ttl,
+		idleTimeout,
+		expiredEnclaveAction,
+		args.GetLabels(),
+		seedPackage,
 	)
+	auditLogArguments := getCreateEnclaveAuditLogArguments(args.GetEnclaveName(), args.GetApiContainerVersionTag(), isProduction, ttl, idleTimeout, expiredEnclaveAction, args.GetLabels(), seedPackage)
+	recordEnclaveCreation(ctx, service.auditLog, createEnclaveAuditLogAction, enclaveInfo, auditLogArguments, err)
 	if err != nil {
 		return nil, stacktrace.Propagate(err, "An error occurred creating new enclave with name '%v'", args.GetEnclaveName())
 	}
@@ -193,14 +366,15 @@ func (service *EngineConnectServerService) CreateEnclave(ctx context.Context, co
 	return connect.NewResponse(response), nil
 }
 
-func (service *EngineConnectServerService) GetEnclaves(ctx context.Context, _ *connect.Request[emptypb.Empty]) (*connect.Response[kurtosis_engine_rpc_api_bindings.GetEnclavesResponse], error) {
-	infoForEnclaves, err := service.enclaveManager.GetAllEnclaves(ctx)
+func (service *EngineConnectServerService) GetEnclaves(ctx context.Context, connectArgs *connect.Request[kurtosis_engine_rpc_api_bindings.GetEnclavesArgs]) (*connect.Response[kurtosis_engine_rpc_api_bindings.GetEnclavesResponse], error) {
+	labelSelector := connectArgs.Msg.GetLabelSelector()
+	infoForEnclaves, err := service.enclaveManager.GetEnclavesMatchingLabels(ctx, labelSelector)
 	if err != nil {
-		return nil, stacktrace.Propagate(err, "An error occurred getting info for enclaves")
+		return nil, stacktrace.Propagate(err, "An error occurred getting info for enclaves matching label selector '%+v'", labelSelector)
 	}
 	response := &kurtosis_engine_rpc_api_bindings.GetEnclavesResponse{
 		EnclaveInfo: utils.MapMapValues(
-			infoForEnclaves,
+			getVisibleEnclaves(ctx, infoForEnclaves),
 			func(info *types.EnclaveInfo) *kurtosis_engine_rpc_api_bindings.EnclaveInfo {
 				return utils.MapPointer(info, toGrpcEnclaveInfo)
 			})}
@@ -229,21 +403,21 @@ func (service *EngineConnectServerService) GetEnclavesByUuids(ctx context.Contex
 
 	response := &kurtosis_engine_rpc_api_bindings.GetEnclavesResponse{
 		EnclaveInfo: utils.MapMapValues(
-			enclaveInfos,
+			getVisibleEnclaves(ctx, enclaveInfos),
 			func(info *types.EnclaveInfo) *kurtosis_engine_rpc_api_bindings.EnclaveInfo {
 				return utils.MapPointer(info, toGrpcEnclaveInfo)
 			})}
 	return connect.NewResponse(response), nil
 }
 
-func (service *EngineConnectServerService) GetExistingAndHistoricalEnclaveIdentifiers(context.Context, *connect.Request[emptypb.Empty]) (*connect.Response[kurtosis_engine_rpc_api_bindings.GetExistingAndHistoricalEnclaveIdentifiersResponse], error) {
+func (service *EngineConnectServerService) GetExistingAndHistoricalEnclaveIdentifiers(ctx context.Context, _ *connect.Request[emptypb.Empty]) (*connect.Response[kurtosis_engine_rpc_api_bindings.GetExistingAndHistoricalEnclaveIdentifiersResponse], error) {
 	allIdentifiers, err := service.enclaveManager.GetExistingAndHistoricalEnclaveIdentifiers()
 	if err != nil {
 		return nil, stacktrace.Propagate(err, "An error occurred while fetching enclave identifiers")
 	}
 	response := &kurtosis_engine_rpc_api_bindings.GetExistingAndHistoricalEnclaveIdentifiersResponse{
 		AllIdentifiers: utils.MapList(
-			allIdentifiers,
+			getVisibleEnclaveIdentifiers(ctx, allIdentifiers),
 			func(identifier *types.EnclaveIdentifiers) *kurtosis_engine_rpc_api_bindings.EnclaveIdentifiers {
 				return utils.MapPointer(identifier, toGrpcEnclaveIdentifiers)
 			})}
@@ -258,7 +432,9 @@ func (service *EngineConnectServerService) StopEnclave(ctx context.Context, conn
 		logrus.Warnf("An error occurred while logging the stop enclave event for enclave '%v'", enclaveIdentifier)
 	}
 
-	if err := service.enclaveManager.StopEnclave(ctx, enclaveIdentifier); err != nil {
+	if err := recordEnclaveChange(ctx, service.auditLog, service.enclaveManager, stopEnclaveAuditLogAction, enclaveIdentifier, nil, func() error {
+		return service.enclaveManager.StopEnclave(ctx, enclaveIdentifier)
+	}); err != nil {
 		return nil, stacktrace.Propagate(err, "An error occurred stopping enclave '%v'", enclaveIdentifier)
 	}
 
@@ -273,19 +449,238 @@ func (service *EngineConnectServerService) DestroyEnclave(ctx context.Context, c
 		logrus.Warnf("An error occurred while logging the destroy enclave event for enclave '%v'", enclaveIdentifier)
 	}
 
-	if err := service.enclaveManager.DestroyEnclave(ctx, enclaveIdentifier); err != nil {
+	if err := recordEnclaveChange(ctx, service.auditLog, service.enclaveManager, destroyEnclaveAuditLogAction, enclaveIdentifier, nil, func() error {
+		return service.enclaveManager.DestroyEnclave(ctx, enclaveIdentifier)
+	}); err != nil {
 		return nil, stacktrace.Propagate(err, "An error occurred destroying enclave with identifier '%v':", args.EnclaveIdentifier)
 	}
 	return connect.NewResponse(&emptypb.Empty{}), nil
 }
 
+func (service *EngineConnectServerService) ExtendEnclaveTtl(ctx context.Context, connectArgs *connect.Request[kurtosis_engine_rpc_api_bindings.ExtendEnclaveTtlArgs]) (*connect.Response[emptypb.Empty], error) {
+	args := connectArgs.Msg
+	enclaveIdentifier := args.GetEnclaveIdentifier()
+
+	ttl := time.Duration(args.GetTtlSeconds()) * time.Second
+	arguments := map[string]string{
+		"ttl_seconds": audit_log.FormatArgument(args.GetTtlSeconds()),
+	}
+	if err := recordEnclaveChange(ctx, service.auditLog, service.enclaveManager, extendEnclaveTtlAuditLogAction, enclaveIdentifier, arguments, func() error {
+		return service.enclaveManager.ExtendEnclaveTtl(ctx, enclaveIdentifier, ttl)
+	}); err != nil {
+		return nil, stacktrace.Propagate(err, "An error occurred extending the time-to-live of enclave '%v'", enclaveIdentifier)
+	}
+	return connect.NewResponse(&emptypb.Empty{}), nil
+}
+
+func (service *EngineConnectServerService) UpdateEnclaveLabels(ctx context.Context, connectArgs *connect.Request[kurtosis_engine_rpc_api_bindings.UpdateEnclaveLabelsArgs]) (*connect.Response[emptypb.Empty], error) {
+	args := connectArgs.Msg
+	enclaveIdentifier := args.GetEnclaveIdentifier()
+
+	arguments := map[string]string{
+		"labels_to_set":    audit_log.FormatArgument(args.GetLabelsToSet()),
+		"labels_to_remove": audit_log.FormatArgument(args.GetLabelsToRemove()),
+	}
+	if err := recordEnclaveChange(ctx, service.auditLog, service.enclaveManager, updateEnclaveLabelsAuditLogAction, enclaveIdentifier, arguments, func() error {
+		return service.enclaveManager.UpdateEnclaveLabels(ctx, enclaveIdentifier, args.GetLabelsToSet(), args.GetLabelsToRemove())
+	}); err != nil {
+		return nil, stacktrace.Propagate(err, "An error occurred updating the labels of enclave '%v'", enclaveIdentifier)
+	}
+	return connect.NewResponse(&emptypb.Empty{}), nil
+}
+
+func (service *EngineConnectServerService) RenameEnclave(ctx context.Context, connectArgs *connect.Request[kurtosis_engine_rpc_api_bindings.RenameEnclaveArgs]) (*connect.Response[emptypb.Empty], error) {
+	args := connectArgs.Msg
+	enclaveIdentifier := args.GetEnclaveIdentifier()
+
+	arguments := map[string]string{
+		"new_name": args.GetNewName(),
+	}
+	if err := recordEnclaveChange(ctx, service.auditLog, service.enclaveManager, renameEnclaveAuditLogAction, enclaveIdentifier, arguments, func() error {
+		return service.enclaveManager.RenameEnclave(ctx, enclaveIdentifier, args.GetNewName())
+	}); err != nil {
+		return nil, stacktrace.Propagate(err, "An error occurred renaming enclave '%v' to '%v'", enclaveIdentifier, args.GetNewName())
+	}
+	return connect.NewResponse(&emptypb.Empty{}), nil
+}
+
+func (service *EngineConnectServerService) CloneEnclave(ctx context.Context, connectArgs *connect.Request[kurtosis_engine_rpc_api_bindings.CloneEnclaveArgs]) (*connect.Response[kurtosis_engine_rpc_api_bindings.CloneEnclaveResponse], error) {
+	args := connectArgs.Msg
+	enclaveIdentifier := args.GetEnclaveIdentifier()
+
+	enclaveInfo, uncloneableItems, warnings, err := service.enclaveManager.CloneEnclave(ctx, service.imageVersionTag, enclaveIdentifier, args.GetEnclaveName(), args.GetAllowPartial())
+	arguments := map[string]string{
+		enclaveIdentifierAuditLogArgument: enclaveIdentifier,
+		"enclave_name":                    args.GetEnclaveName(),
+		"allow_partial":                   audit_log.FormatArgument(args.GetAllowPartial()),
+	}
+	recordEnclaveCreation(ctx, service.auditLog, cloneEnclaveAuditLogAction, enclaveInfo, arguments, err)
+	if err != nil {
+		return nil, stacktrace.Propagate(err, "An error occurred cloning enclave '%v'", enclaveIdentifier)
+	}
+
+	grpcEnclaveInfo := toGrpcEnclaveInfo(*enclaveInfo)
+	response := &kurtosis_engine_rpc_api_bindings.CloneEnclaveResponse{
+		EnclaveInfo:      &grpcEnclaveInfo,
+		UncloneableItems: uncloneableItems,
+		Warnings:         warnings,
+	}
+	return connect.NewResponse(response), nil
+}
+
+func (service *EngineConnectServerService) ExportEnclave(ctx context.Context, connectArgs *connect.Request[kurtosis_engine_rpc_api_bindings.ExportEnclaveArgs], stream *connect.ServerStream[kurtosis_engine_rpc_api_bindings.EnclaveArchiveChunk]) error {
+	enclaveIdentifier := connectArgs.Msg.GetEnclaveIdentifier()
+
+	archiveReader, archiveWriter := io.Pipe()
+	defer archiveReader.Close()
+	go func() {
+		archiveWriter.CloseWithError(service.enclaveManager.ExportEnclave(ctx, enclaveIdentifier, archiveWriter))
+	}()
+
+	chunk := make([]byte, enclaveArchiveChunkSizeBytes)
+	for {
+		numBytesRead, err := io.ReadFull(archiveReader, chunk)
+		if numBytesRead > 0 {
+			if err := stream.Send(&kurtosis_engine_rpc_api_bindings.EnclaveArchiveChunk{Data: chunk[:numBytesRead]}); err != nil {
+				return stacktrace.Propagate(err, "An error occurred sending a chunk of the archive of enclave '%v'", enclaveIdentifier)
+			}
+		}
+		if errors.Is(err, io.EOF) || errors.Is(err, io.ErrUnexpectedEOF) {
+			return nil
+		}
+		if err != nil {
+			return stacktrace.Propagate(err, "An error occurred exporting enclave '%v'", enclaveIdentifier)
+		}
+	}
+}
+
+func (service *EngineConnectServerService) ImportEnclave(ctx context.Context, stream *connect.ClientStream[kurtosis_engine_rpc_api_bindings.ImportEnclaveArgs]) (*connect.Response[kurtosis_engine_rpc_api_bindings.ImportEnclaveResponse], error) {
+	if !stream.Receive() {
+		if err := stream.Err(); err != nil {
+			return nil, stacktrace.Propagate(err, "An error occurred receiving the enclave archive")
+		}
+		return nil, stacktrace.NewError("No enclave archive was received")
+	}
+	enclaveName := stream.Msg().GetEnclaveName()
+
+	archiveReader, archiveWriter := io.Pipe()
+	defer archiveReader.Close()
+	go func() {
+		for {
+			if _, err := archiveWriter.Write(stream.Msg().GetData()); err != nil {
+				// The import stopped reading the archive, it reports why
+				return
+			}
+			if !stream.Receive() {
+				archiveWriter.CloseWithError(stream.Err())
+				return
+			}
+		}
+	}()
+
+	enclaveInfo, unimportableItems, err := service.enclaveManager.ImportEnclave(ctx, service.imageVersionTag, enclaveName, archiveReader)
+	arguments := map[string]string{
+		"enclave_name": enclaveName,
+	}
+	recordEnclaveCreation(ctx, service.auditLog, importEnclaveAuditLogAction, enclaveInfo, arguments, err)
+	if err != nil {
+		return nil, stacktrace.Propagate(err, "An error occurred importing the enclave archive")
+	}
+
+	grpcEnclaveInfo := toGrpcEnclaveInfo(*enclaveInfo)
+	response := &kurtosis_engine_rpc_api_bindings.ImportEnclaveResponse{
+		EnclaveInfo:       &grpcEnclaveInfo,
+		UnimportableItems: unimportableItems,
+	}
+	return connect.NewResponse(response), nil
+}
+
+func (service *EngineConnectServerService) CreateApiToken(ctx context.Context, connectArgs *connect.Request[kurtosis_engine_rpc_api_bindings.CreateApiTokenArgs]) (*connect.Response[kurtosis_engine_rpc_api_bindings.CreateApiTokenResponse], error) {
+	if service.apiTokenStore == nil {
+		return nil, stacktrace.NewError("API tokens can't be created as this engine doesn't enforce API authentication, enable it in the Kurtosis config and restart the engine")
+	}
+	args := connectArgs.Msg
+
+	role, err := toApiTokenRole(args.GetRole())
+	if err != nil {
+		return nil, stacktrace.Propagate(err, "An error occurred converting the role of API token '%v'", args.GetName())
+	}
+	token, bearerToken, err := service.apiTokenStore.CreateToken(args.GetName(), role, args.GetEnclaveNames())
+	// The bearer token is only ever given to the caller
+	service.auditLog.Record(ctx, createApiTokenAuditLogAction, "", "", map[string]string{
+		"name":          args.GetName(),
+		"role":          string(role),
+		"enclave_names": audit_log.FormatArgument(args.GetEnclaveNames()),
+	}, err)
+	if err != nil {
+		return nil, stacktrace.Propagate(err, "An error occurred creating API token '%v'", args.GetName())
+	}
+	logrus.Infof("Created API token '%v' with ID '%v' and role '%v'", token.Name, token.Id, token.Role)
+	response := &kurtosis_engine_rpc_api_bindings.CreateApiTokenResponse{
+		TokenId: token.Id,
+		Token:   bearerToken,
+	}
+	return connect.NewResponse(response), nil
+}
+
+func (service *EngineConnectServerService) RevokeApiToken(ctx context.Context, connectArgs *connect.Request[kurtosis_engine_rpc_api_bindings.RevokeApiTokenArgs]) (*connect.Response[emptypb.Empty], error) {
+	if service.apiTokenStore == nil {
+		return nil, stacktrace.NewError("API tokens can't be revoked as this engine doesn't enforce API authentication")
+	}
+	tokenId := connectArgs.Msg.GetTokenId()
+	err := service.apiTokenStore.RevokeToken(tokenId)
+	service.auditLog.Record(ctx, revokeApiTokenAuditLogAction, "", "", map[string]string{"token_id": tokenId}, err)
+	if err != nil {
+		return nil, stacktrace.Propagate(err, "An error occurred revoking API token '%v'", tokenId)
+	}
+	logrus.Infof("Revoked API token with ID '%v'", tokenId)
+	return connect.NewResponse(&emptypb.Empty{}), nil
+}
+
+func (service *EngineConnectServerService) GetAuditLog(ctx context.Context, connectArgs *connect.Request[kurtosis_engine_rpc_api_bindings.GetAuditLogArgs]) (*connect.Response[kurtosis_engine_rpc_api_bindings.GetAuditLogResponse], error) {
+	args := connectArgs.Msg
+	entries, nextPageToken, err := getAuditLogPage(ctx, service.auditLog, service.enclaveManager, args.GetEnclaveIdentifier(), args.GetPageSize(), args.GetPageToken())
+	if err != nil {
+		return nil, stacktrace.Propagate(err, "An error occurred getting the audit log")
+	}
+	response := &kurtosis_engine_rpc_api_bindings.GetAuditLogResponse{
+		Entries:       utils.MapList(entries, toGrpcAuditLogEntry),
+		NextPageToken: nextPageToken,
+	}
+	return connect.NewResponse(response), nil
+}
+
+func (service *EngineConnectServerService) GetEnclaveCallerCredential(ctx context.Context, connectArgs *connect.Request[kurtosis_engine_rpc_api_bindings.GetEnclaveCallerCredentialArgs]) (*connect.Response[kurtosis_engine_rpc_api_bindings.GetEnclaveCallerCredentialResponse], error) {
+	enclaveIdentifier := connectArgs.Msg.GetEnclaveIdentifier()
+	enclaveUuid, err := service.enclaveManager.GetEnclaveUuidForEnclaveIdentifier(ctx, enclaveIdentifier)
+	if err != nil {
+		return nil, stacktrace.Propagate(err, "An error occurred getting the UUID of enclave '%v'", enclaveIdentifier)
+	}
+	caller := audit_log.GetCaller(ctx)
+	expirationTime := time.Now().Add(enclaveCallerCredentialTtl)
+	credential, err := service.callerKey.NewCallerCredential(string(enclaveUuid), caller, expirationTime)
+	if err != nil {
+		return nil, stacktrace.Propagate(err, "An error occurred creating the credential of caller '%v' for enclave '%v'", caller, enclaveIdentifier)
+	}
+	response := &kurtosis_engine_rpc_api_bindings.GetEnclaveCallerCredentialResponse{
+		Credential:     credential,
+		ExpirationTime: toGrpcTimestamp(expirationTime),
+	}
+	return connect.NewResponse(response), nil
+}
+
 func (service *EngineConnectServerService) Clean(ctx context.Context, connectArgs *connect.Request[kurtosis_engine_rpc_api_bindings.CleanArgs]) (*connect.Response[kurtosis_engine_rpc_api_bindings.CleanResponse], error) {
 	args := connectArgs.Msg
-	removedEnclaveUuidsAndNames, err := service.enclaveManager.Clean(ctx, args.GetShouldCleanAll())
+	removedEnclaveUuidsAndNames, err := service.enclaveManager.Clean(ctx, args.GetShouldCleanAll(), args.GetLabelSelector())
+	service.auditLog.Record(ctx, cleanAuditLogAction, "", "", map[string]string{
+		"should_clean_all": audit_log.FormatArgument(args.GetShouldCleanAll()),
+		"label_selector":   audit_log.FormatArgument(args.GetLabelSelector()),
+	}, err)
 	if err != nil {
 		return nil, stacktrace.Propagate(err, "An error occurred while cleaning enclaves")
 	}
-	if args.GetShouldCleanAll() {
+	// The logs of the enclaves that didn't match the label selector have to be kept
+	if args.GetShouldCleanAll() && len(args.GetLabelSelector()) == 0 {
 		if err = service.logsDatabaseClient.RemoveAllLogs(); err != nil {
 			return nil, stacktrace.Propagate(err, "An error occurred removing all logs.")
 		}
@@ -368,6 +763,9 @@ func (service *EngineConnectServerService) GetServiceLogs(ctx context.Context, c
 		}
 	}()
 
+	recordLogStreamUnsubscribed := operational_metrics.RecordLogStreamSubscribed()
+	defer recordLogStreamUnsubscribed()
+
 	for {
 		select {
 		//stream case
@@ -400,6 +798,37 @@ func (service *EngineConnectServerService) GetServiceLogs(ctx context.Context, c
 	}
 }
 
+func (service *EngineConnectServerService) WatchEvents(ctx context.Context, connectArgs *connect.Request[kurtosis_engine_rpc_api_bindings.WatchEventsArgs], stream *connect.ServerStream[kurtosis_engine_rpc_api_bindings.EngineEvent]) error {
+	// All the events get streamed if no types were requested
+	requestedEventTypes := map[kurtosis_engine_rpc_api_bindings.EngineEventType]bool{}
+	for _, eventType := range connectArgs.Msg.GetEventTypes() {
+		requestedEventTypes[eventType] = true
+	}
+
+	events, stopWatchingEvents := service.enclaveManager.WatchEvents()
+	defer stopWatchingEvents()
+
+	for {
+		select {
+		case event := <-events:
+			// The enclave-scoped API tokens only get the events of their enclaves
+			if !api_auth.CanSeeEnclave(ctx, event.EnclaveName) {
+				continue
+			}
+			grpcEvent := toGrpcEngineEvent(*event)
+			if len(requestedEventTypes) > 0 && !requestedEventTypes[grpcEvent.GetType()] {
+				continue
+			}
+			if err := stream.Send(&grpcEvent); err != nil {
+				return stacktrace.Propagate(err, "An error occurred sending event '%v' of enclave '%v'", event.Type, event.EnclaveName)
+			}
+		case <-ctx.Done():
+			logrus.Debug("The engine events stream has done")
+			return nil
+		}
+	}
+}
+
 func (service *EngineConnectServerService) Close() error {
 	if err := service.enclaveManager.Close(); err != nil {
 		return stacktrace.Propagate(err, "An error occurred closing the enclave manager")
@@ -4,7 +4,11 @@ import (
 	"context"
 	"fmt"
 	"net/http"
+	"strings"
+	"time"
 
+	"github.com/kurtosis-tech/kurtosis/container-engine-lib/lib/backend_interface/objects/enclave"
+	"github.com/kurtosis-tech/kurtosis/engine/server/engine/audit_log"
 	"github.com/kurtosis-tech/kurtosis/engine/server/engine/centralized_logs"
 
 	"github.com/kurtosis-tech/kurtosis/engine/server/engine/enclave_manager"
@@ -12,12 +16,17 @@ import (
 	"github.com/kurtosis-tech/kurtosis/engine/server/engine/types"
 	"github.com/kurtosis-tech/kurtosis/engine/server/engine/utils"
 	"github.com/kurtosis-tech/kurtosis/metrics-library/golang/lib/metrics_client"
+	"github.com/kurtosis-tech/stacktrace"
 	"github.com/sirupsen/logrus"
 
 	api_type "github.com/kurtosis-tech/kurtosis/api/golang/http_rest/api_types"
 	api "github.com/kurtosis-tech/kurtosis/api/golang/http_rest/server/engine_rest_api"
 )
 
+const (
+	labelSelectorSeparator = ","
+)
+
 type EngineRuntime struct {
 	// The version tag of the engine server image, so it can report its own version
 	ImageVersionTag string
@@ -27,13 +36,27 @@ type EngineRuntime struct {
 	LogsDbClient centralized_logs.LogsDatabaseClient
 
 	MetricsClient metrics_client.MetricsClient
+
+	AuditLog *audit_log.AuditLog
 }
 
 // Delete Enclaves
 // (DELETE /enclaves)
 func (engine EngineRuntime) DeleteEnclaves(ctx context.Context, request api.DeleteEnclavesRequestObject) (api.DeleteEnclavesResponseObject, error) {
 	removeAll := utils.DerefWith(request.Params.RemoveAll, false)
-	removedEnclaveUuidsAndNames, err := engine.EnclaveManager.Clean(ctx, removeAll)
+	labelSelector, err := parseLabelSelector(request.Params.LabelSelector)
+	if err != nil {
+		response := invalidLabelSelectorResponseInfo(err, *request.Params.LabelSelector)
+		return api.DeleteEnclavesdefaultJSONResponse{
+			Body:       response,
+			StatusCode: int(response.Code),
+		}, nil
+	}
+	removedEnclaveUuidsAndNames, err := engine.EnclaveManager.Clean(ctx, removeAll, labelSelector)
+	engine.AuditLog.Record(ctx, cleanAuditLogAction, "", "", map[string]string{
+		"should_clean_all": audit_log.FormatArgument(removeAll),
+		"label_selector":   audit_log.FormatArgument(labelSelector),
+	}, err)
 	if err != nil {
 		response := internalErrorResponseInfof(err, "An error occurred while cleaning enclaves")
 		return api.DeleteEnclavesdefaultJSONResponse{
@@ -41,7 +64,8 @@ func (engine EngineRuntime) DeleteEnclaves(ctx context.Context, request api.Dele
 			StatusCode: int(response.Code),
 		}, nil
 	}
-	if removeAll {
+	// Only a full clean gets rid of all the logs, the ones of the enclaves left behind by a label selector must stay
+	if removeAll && len(labelSelector) == 0 {
 		if err = engine.LogsDbClient.RemoveAllLogs(); err != nil {
 			response := internalErrorResponseInfof(err, "An error occurred removing all logs")
 			return api.DeleteEnclavesdefaultJSONResponse{
@@ -57,7 +81,15 @@ func (engine EngineRuntime) DeleteEnclaves(ctx context.Context, request api.Dele
 // Get Enclaves
 // (GET /enclaves)
 func (engine EngineRuntime) GetEnclaves(ctx context.Context, request api.GetEnclavesRequestObject) (api.GetEnclavesResponseObject, error) {
-	infoForEnclaves, err := engine.EnclaveManager.GetAllEnclaves(ctx)
+	labelSelector, err := parseLabelSelector(request.Params.LabelSelector)
+	if err != nil {
+		response := invalidLabelSelectorResponseInfo(err, *request.Params.LabelSelector)
+		return api.GetEnclavesdefaultJSONResponse{
+			Body:       response,
+			StatusCode: int(response.Code),
+		}, nil
+	}
+	infoForEnclaves, err := engine.EnclaveManager.GetEnclavesMatchingLabels(ctx, labelSelector)
 	if err != nil {
 		response := internalErrorResponseInfof(err, "An error occurred getting info for enclaves")
 		return api.GetEnclavesdefaultJSONResponse{
@@ -65,7 +97,7 @@ func (engine EngineRuntime) GetEnclaves(ctx context.Context, request api.GetEncl
 			StatusCode: int(response.Code),
 		}, nil
 	}
-	response := utils.MapMapValues(infoForEnclaves, func(enclave *types.EnclaveInfo) api_type.EnclaveInfo { return to_http.ToHttpEnclaveInfo(*enclave) })
+	response := utils.MapMapValues(getVisibleEnclaves(ctx, infoForEnclaves), func(enclave *types.EnclaveInfo) api_type.EnclaveInfo { return to_http.ToHttpEnclaveInfo(*enclave) })
 	return api.GetEnclaves200JSONResponse(response), nil
 }
 
@@ -98,6 +130,15 @@ func (engine EngineRuntime) PostEnclaves(ctx context.Context, request api.PostEn
 
 	isProduction := enclaveMode == api_type.PRODUCTION
 
+	ttl := time.Duration(utils.DerefWith(request.Body.TtlSeconds, 0)) * time.Second
+	idleTimeout := time.Duration(utils.DerefWith(request.Body.IdleTimeoutSeconds, 0)) * time.Second
+	expiredEnclaveAction := enclave.ExpiredEnclaveAction_Destroy
+	if utils.DerefWith(request.Body.ExpiredEnclaveAction, api_type.ExpiredEnclaveActionDESTROY) == api_type.ExpiredEnclaveActionSTOP {
+		expiredEnclaveAction = enclave.ExpiredEnclaveAction_Stop
+	}
+
+	labels := utils.DerefWith(request.Body.Labels, map[string]string{})
+	seedPackage := toEnclaveSeedPackage(utils.DerefWith(request.Body.SeedPackageLocator, ""), utils.DerefWith(request.Body.SeedPackageParams, ""))
 	enclaveInfo, err := engine.EnclaveManager.CreateEnclave(
 		ctx,
 		engine.ImageVersionTag,
@@ -106,7 +147,14 @@ func (engine EngineRuntime) PostEnclaves(ctx context.Context, request api.PostEn
 		enclaveName,
 		isProduction,
 		bool(shouldApicRunInDebugMode),
+		ttl,
+		idleTimeout,
+		expiredEnclaveAction,
+		labels,
+		seedPackage,
 	)
+	auditLogArguments := getCreateEnclaveAuditLogArguments(enclaveName, apicVersionTag, isProduction, ttl, idleTimeout, expiredEnclaveAction, labels, seedPackage)
+	recordEnclaveCreation(ctx, engine.AuditLog, createEnclaveAuditLogAction, enclaveInfo, auditLogArguments, err)
 	if err != nil {
 		response := internalErrorResponseInfof(err, "An error occurred creating new enclave with name '%v'", request.Body.EnclaveName)
 		return api.PostEnclavesdefaultJSONResponse{
@@ -131,7 +179,7 @@ func (engine EngineRuntime) GetEnclavesHistory(ctx context.Context, request api.
 		}, nil
 	}
 
-	identifiersMapApi := utils.MapList(allIdentifiers, to_http.ToHttpEnclaveIdentifiers)
+	identifiersMapApi := utils.MapList(getVisibleEnclaveIdentifiers(ctx, allIdentifiers), to_http.ToHttpEnclaveIdentifiers)
 	return api.GetEnclavesHistory200JSONResponse(identifiersMapApi), nil
 }
 
@@ -144,7 +192,9 @@ func (engine EngineRuntime) DeleteEnclavesEnclaveIdentifier(ctx context.Context,
 		logrus.Warnf("An error occurred while logging the destroy enclave event for enclave '%v'", enclaveIdentifier)
 	}
 
-	if err := engine.EnclaveManager.DestroyEnclave(ctx, enclaveIdentifier); err != nil {
+	if err := recordEnclaveChange(ctx, engine.AuditLog, engine.EnclaveManager, destroyEnclaveAuditLogAction, enclaveIdentifier, nil, func() error {
+		return engine.EnclaveManager.DestroyEnclave(ctx, enclaveIdentifier)
+	}); err != nil {
 		response := internalErrorResponseInfof(err, "An error occurred destroying enclave with identifier '%v':", enclaveIdentifier)
 		return api.DeleteEnclavesEnclaveIdentifierdefaultJSONResponse{
 			Body:       response,
@@ -212,12 +262,14 @@ func (engine EngineRuntime) PostEnclavesEnclaveIdentifierStatus(ctx context.Cont
 	targetState := request.Body
 
 	switch *targetState {
-	case api_type.STOP:
+	case api_type.EnclaveTargetStatusSTOP:
 		if err := engine.MetricsClient.TrackStopEnclave(enclaveIdentifier); err != nil {
 			logrus.Warnf("An error occurred while logging the stop enclave event for enclave '%v'", enclaveIdentifier)
 		}
 
-		if err := engine.EnclaveManager.StopEnclave(ctx, enclaveIdentifier); err != nil {
+		if err := recordEnclaveChange(ctx, engine.AuditLog, engine.EnclaveManager, stopEnclaveAuditLogAction, enclaveIdentifier, nil, func() error {
+			return engine.EnclaveManager.StopEnclave(ctx, enclaveIdentifier)
+		}); err != nil {
 			response := internalErrorResponseInfof(err, "An error occurred stopping enclave '%v'", enclaveIdentifier)
 			return api.PostEnclavesEnclaveIdentifierStatusdefaultJSONResponse{
 				Body:       response,
@@ -248,10 +300,64 @@ func (engine EngineRuntime) GetEngineInfo(ctx context.Context, request api.GetEn
 	return api.GetEngineInfo200JSONResponse(result), nil
 }
 
+// Get the audit log
+// (GET /engine/audit)
+func (engine EngineRuntime) GetEngineAudit(ctx context.Context, request api.GetEngineAuditRequestObject) (api.GetEngineAuditResponseObject, error) {
+	pageSize := utils.DerefWith(request.Params.PageSize, 0)
+	if pageSize < 0 {
+		response := api_type.ResponseInfo{
+			Code:    http.StatusBadRequest,
+			Type:    api_type.ERROR,
+			Message: fmt.Sprintf("The page size must not be negative but was %d", pageSize),
+		}
+		return api.GetEngineAuditdefaultJSONResponse{
+			Body:       response,
+			StatusCode: int(response.Code),
+		}, nil
+	}
+	enclaveIdentifier := utils.DerefWith(request.Params.EnclaveIdentifier, "")
+	pageToken := utils.DerefWith(request.Params.PageToken, "")
+
+	entries, nextPageToken, err := getAuditLogPage(ctx, engine.AuditLog, engine.EnclaveManager, enclaveIdentifier, uint32(pageSize), pageToken)
+	if err != nil {
+		response := internalErrorResponseInfof(err, "An error occurred getting the audit log")
+		return api.GetEngineAuditdefaultJSONResponse{
+			Body:       response,
+			StatusCode: int(response.Code),
+		}, nil
+	}
+	result := api_type.AuditLog{
+		Entries:       utils.MapList(entries, to_http.ToHttpAuditLogEntry),
+		NextPageToken: nextPageToken,
+	}
+	return api.GetEngineAudit200JSONResponse(result), nil
+}
+
 // ===============================================================================================================
 // ===================================== Internal Functions =====================================================
 // ===============================================================================================================
 
+// The label selector is a comma-separated list of 'key=value' labels, an empty selector matches every enclave
+func parseLabelSelector(labelSelectorStr *api_type.LabelSelector) (map[string]string, error) {
+	if labelSelectorStr == nil || strings.TrimSpace(*labelSelectorStr) == "" {
+		return map[string]string{}, nil
+	}
+	labelSelector, err := enclave.ParseEnclaveLabels(strings.Split(*labelSelectorStr, labelSelectorSeparator))
+	if err != nil {
+		return nil, stacktrace.Propagate(err, "An error occurred parsing label selector '%v'", *labelSelectorStr)
+	}
+	return labelSelector, nil
+}
+
+func invalidLabelSelectorResponseInfo(err error, labelSelectorStr string) api_type.ResponseInfo {
+	logrus.Infof("Invalid label selector '%s': %v", labelSelectorStr, err)
+	return api_type.ResponseInfo{
+		Code:    http.StatusBadRequest,
+		Type:    api_type.WARNING,
+		Message: fmt.Sprintf("Invalid label selector '%s', it must be a comma-separated list of 'key=value' labels", labelSelectorStr),
+	}
+}
+
 func enclaveNotFoundResponseInfo(enclaveIdentifier string) api_type.ResponseInfo {
 	logrus.Infof("Enclave '%s' not found.", enclaveIdentifier)
 	return api_type.ResponseInfo{
@@ -6,6 +6,7 @@ import (
 	"net/http"
 
 	user_service "github.com/kurtosis-tech/kurtosis/container-engine-lib/lib/backend_interface/objects/service"
+	"github.com/kurtosis-tech/kurtosis/engine/server/engine/api_auth"
 	"github.com/kurtosis-tech/kurtosis/engine/server/engine/centralized_logs"
 	"github.com/kurtosis-tech/kurtosis/engine/server/engine/enclave_manager"
 	"github.com/kurtosis-tech/kurtosis/engine/server/engine/mapping/to_http"
@@ -122,10 +123,35 @@ func (engine WebSocketRuntime) GetEnclavesEnclaveIdentifierServicesServiceIdenti
 	return nil
 }
 
+// (GET /engine/events)
+func (engine WebSocketRuntime) GetEngineEvents(ctx echo.Context, params api_type.GetEngineEventsParams) error {
+	streamer := streaming.NewEngineEventStreamer(ctx.Request().Context(), engine.EnclaveManager, params.EventTypes)
+
+	if ctx.IsWebSocket() {
+		logrus.Infof("Starting engine events stream using Websocket")
+		streamEngineEventsWithWebsocket(ctx, engine.CorsConfig, *streamer)
+	} else {
+		logrus.Infof("Starting engine events stream using plain HTTP")
+		streamEngineEventsWithHTTP(ctx, *streamer)
+	}
+	return nil
+}
+
 // (GET /starlark/executions/{starlark_execution_uuid}/logs)
 func (engine WebSocketRuntime) GetStarlarkExecutionsStarlarkExecutionUuidLogs(ctx echo.Context, starlarkExecutionUuid api_type.StarlarkExecutionUuid) error {
 	asyncLogUuid := streaming.StreamerUUID(starlarkExecutionUuid)
 
+	// Like the other per-enclave routes, the enclave-scoped API tokens can only read the runs of their enclaves
+	enclaveName, found := engine.AsyncStarlarkLogs.GetEnclaveName(asyncLogUuid)
+	if found && !api_auth.CanSeeEnclave(ctx.Request().Context(), enclaveName) {
+		writeResponseInfo(ctx, api_type.ResponseInfo{
+			Type:    api_type.ERROR,
+			Message: fmt.Sprintf("The API token can't act on the enclave of Starlark execution '%s'", starlarkExecutionUuid),
+			Code:    http.StatusForbidden,
+		})
+		return nil
+	}
+
 	if ctx.IsWebSocket() {
 		logrus.Infof("Starting log stream using Websocket for streamer UUUID: %s", starlarkExecutionUuid)
 		streamStarlarkLogsWithWebsocket(ctx, engine.CorsConfig, engine.AsyncStarlarkLogs, asyncLogUuid)
@@ -300,3 +326,56 @@ func streamServiceLogsWithHTTP(ctx echo.Context, streamer streaming.ServiceLogSt
 		}
 	}
 }
+
+func streamEngineEventsWithWebsocket(ctx echo.Context, cors cors.Cors, streamer streaming.EngineEventStreamer) {
+	wsPump, err := streaming.NewWebsocketPump[api_type.EngineEvent](ctx, cors)
+	if err != nil {
+		streamer.Close()
+		logrus.WithError(err).Error("Failed to start websocket connection")
+		writeResponseInfo(ctx, api_type.ResponseInfo{
+			Type:    api_type.ERROR,
+			Message: "Failed to start websocket connection",
+			Code:    http.StatusInternalServerError,
+		})
+		return
+	}
+	defer wsPump.Close()
+	wsPump.OnClose(func() { streamer.Close() })
+
+	err = streamer.Consume(func(event *api_type.EngineEvent) error {
+		return wsPump.PumpMessage(event)
+	})
+
+	if err != nil {
+		logrus.WithError(err).Warn("Failed to stream all engine events")
+	}
+}
+
+func streamEngineEventsWithHTTP(ctx echo.Context, streamer streaming.EngineEventStreamer) {
+	ctx.Response().Header().Set(echo.HeaderContentType, echo.MIMEApplicationJSON)
+	enc := json.NewEncoder(ctx.Response())
+	ctx.Response().WriteHeader(http.StatusOK)
+	// Flushing right away lets the client know that the stream started, as there might not be any event for a while
+	ctx.Response().Flush()
+	err := streamer.Consume(func(event *api_type.EngineEvent) error {
+		if err := enc.Encode(event); err != nil {
+			return err
+		}
+		ctx.Response().Flush()
+		return nil
+	})
+
+	if err != nil {
+		logrus.WithError(err).WithFields(logrus.Fields{
+			"stacktrace": fmt.Sprintf("%+v", err),
+		}).Error("Failed to stream all engine events")
+		streamingErr := api_type.ResponseInfo{
+			Type:    api_type.ERROR,
+			Message: "Engine events streaming failed while sending the data",
+			Code:    http.StatusInternalServerError,
+		}
+		if err := enc.Encode(streamingErr); err != nil {
+			logrus.WithError(err).Errorf("Failed to send value via http streaming")
+		}
+	}
+}
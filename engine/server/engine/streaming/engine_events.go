@@ -0,0 +1,75 @@
+package streaming
+
+import (
+	"context"
+
+	"github.com/kurtosis-tech/kurtosis/engine/server/engine/api_auth"
+	"github.com/kurtosis-tech/kurtosis/engine/server/engine/enclave_manager"
+	"github.com/kurtosis-tech/kurtosis/engine/server/engine/mapping/to_http"
+	"github.com/kurtosis-tech/kurtosis/engine/server/engine/types"
+	"github.com/kurtosis-tech/kurtosis/engine/server/engine/utils"
+	"github.com/kurtosis-tech/stacktrace"
+	"github.com/sirupsen/logrus"
+
+	api_type "github.com/kurtosis-tech/kurtosis/api/golang/http_rest/api_types"
+)
+
+type EngineEventStreamer struct {
+	ctx                 context.Context
+	events              <-chan *types.EngineEvent
+	stopWatchingEvents  func()
+	requestedEventTypes map[api_type.EngineEventType]bool
+}
+
+// NewEngineEventStreamer starts watching the engine events right away, so none gets missed before consuming them
+// All the events get streamed if no event types are requested, leaving out the events of the enclaves the caller can't see
+func NewEngineEventStreamer(
+	ctx context.Context,
+	enclaveManager *enclave_manager.EnclaveManager,
+	maybeEventTypes *[]api_type.EngineEventType,
+) *EngineEventStreamer {
+	requestedEventTypes := map[api_type.EngineEventType]bool{}
+	for _, eventType := range utils.DerefWith(maybeEventTypes, []api_type.EngineEventType{}) {
+		requestedEventTypes[eventType] = true
+	}
+
+	events, stopWatchingEvents := enclaveManager.WatchEvents()
+	return &EngineEventStreamer{
+		ctx:                 ctx,
+		events:              events,
+		stopWatchingEvents:  stopWatchingEvents,
+		requestedEventTypes: requestedEventTypes,
+	}
+}
+
+func (streamer EngineEventStreamer) Close() {
+	streamer.stopWatchingEvents()
+}
+
+// Consume blocks until the streamer gets closed, its context is done or the consumer fails
+func (streamer EngineEventStreamer) Consume(consumer func(*api_type.EngineEvent) error) error {
+	defer streamer.Close()
+
+	for {
+		select {
+		case event, isChanOpen := <-streamer.events:
+			if !isChanOpen {
+				logrus.Debug("Exiting the stream loop after the engine events stopped being watched")
+				return nil
+			}
+			if !api_auth.CanSeeEnclave(streamer.ctx, event.EnclaveName) {
+				continue
+			}
+			httpEvent := to_http.ToHttpEngineEvent(*event)
+			if len(streamer.requestedEventTypes) > 0 && !streamer.requestedEventTypes[httpEvent.Type] {
+				continue
+			}
+			if err := consumer(&httpEvent); err != nil {
+				return stacktrace.Propagate(err, "An error occurred consuming event '%v' of enclave '%v'", httpEvent.Type, httpEvent.EnclaveName)
+			}
+		case <-streamer.ctx.Done():
+			logrus.Debug("The engine events stream has done")
+			return nil
+		}
+	}
+}
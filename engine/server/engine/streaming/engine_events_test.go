@@ -0,0 +1,80 @@
+package streaming
+
+import (
+	"context"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/kurtosis-tech/kurtosis/engine/launcher/args"
+	"github.com/kurtosis-tech/kurtosis/engine/server/engine/api_auth"
+	"github.com/kurtosis-tech/kurtosis/engine/server/engine/types"
+	"github.com/labstack/echo/v4"
+	"github.com/stretchr/testify/require"
+
+	api_type "github.com/kurtosis-tech/kurtosis/api/golang/http_rest/api_types"
+)
+
+const (
+	scopedEnclaveName = "scoped-enclave"
+	otherEnclaveName  = "other-enclave"
+)
+
+func TestEngineEventStreamer_EnclaveScopedTokensOnlyGetTheEventsOfTheirEnclaves(t *testing.T) {
+	store, err := api_auth.GetOrCreateApiTokenStore("", &args.ApiAuthConfig{Tokens: nil})
+	require.NoError(t, err)
+	_, readOnlyToken, err := store.CreateToken("read-only", args.ApiTokenRole_ReadOnly, nil)
+	require.NoError(t, err)
+	_, scopedReadOnlyToken, err := store.CreateToken("scoped-read-only", args.ApiTokenRole_ReadOnly, []string{scopedEnclaveName})
+	require.NoError(t, err)
+	resolveEnclaveName := func(ctx context.Context, enclaveIdentifier string) (string, error) {
+		return enclaveIdentifier, nil
+	}
+
+	router := echo.New()
+	router.Use(api_auth.NewEchoMiddleware(store, resolveEnclaveName))
+	router.GET("/engine/events", func(ctx echo.Context) error {
+		events := make(chan *types.EngineEvent, 2)
+		events <- newEnclaveCreatedEventForTest(scopedEnclaveName)
+		events <- newEnclaveCreatedEventForTest(otherEnclaveName)
+		close(events)
+		streamer := EngineEventStreamer{
+			ctx:                 ctx.Request().Context(),
+			events:              events,
+			stopWatchingEvents:  func() {},
+			requestedEventTypes: map[api_type.EngineEventType]bool{},
+		}
+
+		receivedEnclaveNames := []string{}
+		err := streamer.Consume(func(event *api_type.EngineEvent) error {
+			receivedEnclaveNames = append(receivedEnclaveNames, event.EnclaveName)
+			return nil
+		})
+		require.NoError(t, err)
+		return ctx.JSON(http.StatusOK, receivedEnclaveNames)
+	})
+
+	watchEvents := func(bearerToken string) string {
+		request := httptest.NewRequest(http.MethodGet, "/engine/events", nil)
+		request.Header.Set("Authorization", "Bearer "+bearerToken)
+		recorder := httptest.NewRecorder()
+		router.ServeHTTP(recorder, request)
+		require.Equal(t, http.StatusOK, recorder.Code)
+		return recorder.Body.String()
+	}
+
+	require.JSONEq(t, `["`+scopedEnclaveName+`","`+otherEnclaveName+`"]`, watchEvents(readOnlyToken))
+	require.JSONEq(t, `["`+scopedEnclaveName+`"]`, watchEvents(scopedReadOnlyToken))
+}
+
+func newEnclaveCreatedEventForTest(enclaveName string) *types.EngineEvent {
+	return &types.EngineEvent{
+		Type:                 types.EngineEventType_ENCLAVE_CREATED,
+		Timestamp:            types.Timestamp{},
+		EnclaveUuid:          enclaveName + "-uuid",
+		EnclaveName:          enclaveName,
+		ServiceName:          nil,
+		StarlarkPackageId:    nil,
+		StarlarkRunSucceeded: nil,
+	}
+}
@@ -10,6 +10,7 @@ import (
 	"github.com/kurtosis-tech/kurtosis/engine/server/engine/enclave_manager"
 	"github.com/kurtosis-tech/kurtosis/engine/server/engine/mapping/to_http"
 	"github.com/kurtosis-tech/kurtosis/engine/server/engine/mapping/to_logline"
+	"github.com/kurtosis-tech/kurtosis/engine/server/engine/operational_metrics"
 	"github.com/kurtosis-tech/kurtosis/engine/server/engine/utils"
 	"github.com/kurtosis-tech/stacktrace"
 	"github.com/sirupsen/logrus"
@@ -107,6 +108,9 @@ func (streamer ServiceLogStreamer) Close() {
 }
 
 func (streamer ServiceLogStreamer) Consume(consumer func(*api_type.ServiceLogs) error) error {
+	recordLogStreamUnsubscribed := operational_metrics.RecordLogStreamSubscribed()
+	defer recordLogStreamUnsubscribed()
+
 	for {
 		select {
 		//stream case
@@ -17,6 +17,8 @@ type asyncStarlarkLogs struct {
 	cancelCtxFunc               context.CancelFunc
 	starlarkRunResponseLineChan chan *rpc_api.StarlarkRunResponseLine
 	markedForConsumption        bool
+	// The name of the enclave the Starlark run happens in, so that only the callers that can see it consume its logs
+	enclaveName string
 }
 
 func NewAsyncStarlarkLogs(cancelCtxFunc context.CancelFunc) asyncStarlarkLogs {
@@ -28,6 +30,7 @@ func NewAsyncStarlarkLogs(cancelCtxFunc context.CancelFunc) asyncStarlarkLogs {
 		cancelCtxFunc:               cancel,
 		starlarkRunResponseLineChan: starlarkResponseLineChan,
 		markedForConsumption:        markedForConsumption,
+		enclaveName:                 "",
 	}
 }
 
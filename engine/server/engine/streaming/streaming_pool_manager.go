@@ -50,13 +50,23 @@ func (streamerPool StreamerPool[T]) Contains(uuid StreamerUUID) bool {
 	return streamerPool.pool.Contains(uuid)
 }
 
-func (streamerPool StreamerPool[T]) Add(streamer *asyncStarlarkLogs) StreamerUUID {
+func (streamerPool StreamerPool[T]) Add(streamer *asyncStarlarkLogs, enclaveName string) StreamerUUID {
 	id := uuid.New()
 	id_str := StreamerUUID(id.String())
+	streamer.enclaveName = enclaveName
 	streamerPool.pool.Add(id_str, streamer)
 	return id_str
 }
 
+// GetEnclaveName returns the name of the enclave the Starlark run of the streamer happens in, if it's in the pool
+func (streamerPool StreamerPool[T]) GetEnclaveName(uuid StreamerUUID) (string, bool) {
+	streamer, found := streamerPool.pool.Peek(uuid)
+	if !found {
+		return "", false
+	}
+	return streamer.enclaveName, true
+}
+
 func (streamerPool StreamerPool[T]) Consume(uuid StreamerUUID, consumer func(*kurtosis_core_rpc_api_bindings.StarlarkRunResponseLine) error) (bool, error) {
 	streamer, found := streamerPool.pool.Get(uuid)
 	if !found {
@@ -30,6 +30,29 @@ const (
 	EnclaveMode_TEST       EnclaveMode = "TEST"
 )
 
+// Defines values for ExpiredEnclaveAction.
+type ExpiredEnclaveAction string
+
+const (
+	ExpiredEnclaveAction_DESTROY ExpiredEnclaveAction = "DESTROY"
+	ExpiredEnclaveAction_STOP    ExpiredEnclaveAction = "STOP"
+)
+
+// Defines values for EngineEventType.
+type EngineEventType string
+
+const (
+	EngineEventType_ENCLAVE_CREATED         EngineEventType = "ENCLAVE_CREATED"
+	EngineEventType_ENCLAVE_STOPPED         EngineEventType = "ENCLAVE_STOPPED"
+	EngineEventType_ENCLAVE_DESTROYED       EngineEventType = "ENCLAVE_DESTROYED"
+	EngineEventType_STARLARK_RUN_STARTED    EngineEventType = "STARLARK_RUN_STARTED"
+	EngineEventType_STARLARK_RUN_FINISHED   EngineEventType = "STARLARK_RUN_FINISHED"
+	EngineEventType_SERVICE_ADDED           EngineEventType = "SERVICE_ADDED"
+	EngineEventType_SERVICE_STOPPED         EngineEventType = "SERVICE_STOPPED"
+	EngineEventType_SERVICE_REMOVED         EngineEventType = "SERVICE_REMOVED"
+	EngineEventType_API_CONTAINER_RESTARTED EngineEventType = "API_CONTAINER_RESTARTED"
+)
+
 // CreateEnclaveArgs defines model for CreateEnclaveArgs.
 type CreateEnclaveArgs struct {
 	ApiContainerLogLevel   string
@@ -67,11 +90,20 @@ type EnclaveInfo struct {
 	EnclaveStatus               EnclaveStatus
 	CreationTime                Timestamp
 	EnclaveUuid                 string
+	Expiration                  *EnclaveExpiration
+	Labels                      map[string]string
 	Mode                        EnclaveMode
 	Name                        string
 	ShortenedUuid               string
 }
 
+// EnclaveExpiration defines model for EnclaveExpiration.
+type EnclaveExpiration struct {
+	Action             ExpiredEnclaveAction
+	ExpirationTime     *Timestamp
+	IdleTimeoutSeconds *uint32
+}
+
 // EnclaveNameAndUuid defines model for EnclaveNameAndUuid.
 type EnclaveNameAndUuid struct {
 	Name string
@@ -87,6 +119,17 @@ type EngineVersion struct {
 	EngineVersion string
 }
 
+// EngineEvent defines model for EngineEvent.
+type EngineEvent struct {
+	Type                 EngineEventType
+	Timestamp            Timestamp
+	EnclaveUuid          string
+	EnclaveName          string
+	ServiceName          *string
+	StarlarkPackageId    *string
+	StarlarkRunSucceeded *bool
+}
+
 // GetServiceLogsArgs defines model for GetServiceLogsArgs.
 type GetServiceLogsArgs struct {
 	ConjunctiveFilters *[]LogLineFilter